@@ -0,0 +1,298 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+
+	"github.com/warthurton/slash/client"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+const (
+	defaultClientProfile = "default"
+	deviceClientName     = "Slash CLI"
+)
+
+// clientScopes are the scopes of the access tokens of the CLI, which only manages shortcuts.
+var clientScopes = []string{"shortcuts:read", "shortcuts:write"}
+
+// clientConfig is the config file of the client commands, with the instances they talk to as profiles.
+type clientConfig struct {
+	CurrentProfile string                    `json:"currentProfile"`
+	Profiles       map[string]*clientProfile `json:"profiles"`
+}
+
+type clientProfile struct {
+	URL         string `json:"url"`
+	AccessToken string `json:"accessToken"`
+	// SnapshotPublicKey is the key of the instance signing the resolution snapshots, pinned by the first sync.
+	SnapshotPublicKey []byte `json:"snapshotPublicKey,omitempty"`
+}
+
+var (
+	loginCmd = &cobra.Command{
+		Use:   "login <url>",
+		Short: "Log in to a Slash instance for the client commands.",
+		Long: `Log in to the Slash instance at the url, and store its access token in the profile.
+Without --token, the CLI is connected with a code to confirm in the web app, from any device.
+The profile becomes the current one, used by the client commands without --profile.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profileName, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return err
+			}
+			accessToken, err := cmd.Flags().GetString("token")
+			if err != nil {
+				return err
+			}
+			return login(cmd.Context(), profileName, args[0], accessToken)
+		},
+	}
+	logoutCmd = &cobra.Command{
+		Use:   "logout",
+		Short: "Remove the profile and its access token.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			profileName, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return err
+			}
+			return logout(profileName)
+		},
+	}
+)
+
+func init() {
+	loginCmd.Flags().String("token", "", "access token to log in with, instead of confirming a code in the web app")
+	for _, cmd := range []*cobra.Command{loginCmd, logoutCmd} {
+		addProfileFlag(cmd)
+		// The usage doesn't help with the errors of the instance.
+		cmd.SilenceUsage = true
+		rootCmd.AddCommand(cmd)
+	}
+}
+
+func login(ctx context.Context, profileName, instanceURL, accessToken string) error {
+	instanceURL = strings.TrimRight(instanceURL, "/")
+	if !strings.HasPrefix(instanceURL, "http://") && !strings.HasPrefix(instanceURL, "https://") {
+		return errors.Errorf("invalid url %s, it must start with http:// or https://", instanceURL)
+	}
+	config, err := readClientConfig()
+	if err != nil {
+		return err
+	}
+	if profileName == "" {
+		profileName = config.CurrentProfile
+	}
+	if accessToken == "" {
+		if accessToken, err = authorizeDevice(ctx, instanceURL); err != nil {
+			return err
+		}
+	}
+	user, err := client.NewClient(instanceURL, accessToken).GetAuthStatus(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to log in")
+	}
+
+	config.Profiles[profileName] = &clientProfile{
+		URL:         instanceURL,
+		AccessToken: accessToken,
+	}
+	config.CurrentProfile = profileName
+	if err := writeClientConfig(config); err != nil {
+		return err
+	}
+	fmt.Printf("Logged in to %s as %s (profile: %s)\n", instanceURL, user.Username, profileName)
+	return nil
+}
+
+// authorizeDevice returns an access token once the user confirms the code of the CLI in the web app.
+func authorizeDevice(ctx context.Context, instanceURL string) (string, error) {
+	c := client.NewClient(instanceURL, "")
+	hostname, _ := os.Hostname()
+	clientName := deviceClientName
+	if hostname != "" {
+		clientName = fmt.Sprintf("%s on %s", deviceClientName, hostname)
+	}
+	authorization, err := c.CreateDeviceAuthorization(ctx, &v1pb.CreateDeviceAuthorizationRequest{
+		ClientName: clientName,
+		Scopes:     clientScopes,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to start the authorization")
+	}
+	fmt.Printf("Open %s\nand confirm the code %s\n", authorization.VerificationUriComplete, authorization.UserCode)
+
+	interval := time.Duration(authorization.Interval) * time.Second
+	expireTime := time.Now().Add(time.Duration(authorization.ExpiresIn) * time.Second)
+	for time.Now().Before(expireTime) {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(interval):
+		}
+		response, err := c.PollDeviceToken(ctx, authorization.DeviceCode)
+		if err != nil {
+			var apiError *client.Error
+			if errors.As(err, &apiError) && apiError.Code == codes.ResourceExhausted {
+				interval += 5 * time.Second
+				continue
+			}
+			return "", errors.Wrap(err, "failed to get the access token")
+		}
+		switch response.State {
+		case v1pb.PollDeviceTokenResponse_APPROVED:
+			return response.AccessToken.GetAccessToken(), nil
+		case v1pb.PollDeviceTokenResponse_DENIED:
+			return "", errors.New("the authorization was denied")
+		}
+	}
+	return "", errors.New("the code expired, log in again")
+}
+
+func logout(profileName string) error {
+	config, err := readClientConfig()
+	if err != nil {
+		return err
+	}
+	if profileName == "" {
+		profileName = config.CurrentProfile
+	}
+	if _, ok := config.Profiles[profileName]; !ok {
+		return errors.Errorf("profile %s not found", profileName)
+	}
+	delete(config.Profiles, profileName)
+	if err := writeClientConfig(config); err != nil {
+		return err
+	}
+	cachePath, err := getResolutionCachePath(profileName)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(cachePath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to remove cache")
+	}
+	return nil
+}
+
+// updateClientProfile saves the profile into the config.
+func updateClientProfile(profileName string, profile *clientProfile) error {
+	config, err := readClientConfig()
+	if err != nil {
+		return err
+	}
+	config.Profiles[profileName] = profile
+	return writeClientConfig(config)
+}
+
+// addProfileFlag adds the --profile flag to the client command, completed with the profiles of the config.
+func addProfileFlag(cmd *cobra.Command) {
+	cmd.Flags().String("profile", "", "name of the profile, the current one by default")
+	if err := cmd.RegisterFlagCompletionFunc("profile", completeProfiles); err != nil {
+		panic(err)
+	}
+}
+
+func completeProfiles(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	config, err := readClientConfig()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	profileNames := make([]string, 0, len(config.Profiles))
+	for profileName, profile := range config.Profiles {
+		profileNames = append(profileNames, fmt.Sprintf("%s\t%s", profileName, profile.URL))
+	}
+	return profileNames, cobra.ShellCompDirectiveNoFileComp
+}
+
+// newClient creates the client of the profile, the current one when the name is empty.
+func newClient(profileName string) (*client.Client, error) {
+	_, profile, err := getClientProfile(profileName)
+	if err != nil {
+		return nil, err
+	}
+	return client.NewClient(profile.URL, profile.AccessToken), nil
+}
+
+// getClientProfile returns the name and the profile, the current one when the name is empty.
+func getClientProfile(profileName string) (string, *clientProfile, error) {
+	config, err := readClientConfig()
+	if err != nil {
+		return "", nil, err
+	}
+	if profileName == "" {
+		profileName = config.CurrentProfile
+	}
+	profile, ok := config.Profiles[profileName]
+	if !ok {
+		return "", nil, errors.Errorf("profile %s not found, run `slash login <url>` first", profileName)
+	}
+	return profileName, profile, nil
+}
+
+// getClientConfigPath returns the path of the config file, e.g. ~/.config/slash/config.json on Linux.
+// SLASH_CONFIG overrides it.
+func getClientConfigPath() (string, error) {
+	if configPath := os.Getenv("SLASH_CONFIG"); configPath != "" {
+		return configPath, nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get the config directory")
+	}
+	return filepath.Join(configDir, "slash", "config.json"), nil
+}
+
+func readClientConfig() (*clientConfig, error) {
+	config := &clientConfig{
+		CurrentProfile: defaultClientProfile,
+		Profiles:       map[string]*clientProfile{},
+	}
+	configPath, err := getClientConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	configBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return config, nil
+		}
+		return nil, errors.Wrap(err, "failed to read config")
+	}
+	if err := json.Unmarshal(configBytes, config); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config %s", configPath)
+	}
+	if config.Profiles == nil {
+		config.Profiles = map[string]*clientProfile{}
+	}
+	return config, nil
+}
+
+func writeClientConfig(config *clientConfig) error {
+	configPath, err := getClientConfigPath()
+	if err != nil {
+		return err
+	}
+	configBytes, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal config")
+	}
+	if err := os.MkdirAll(filepath.Dir(configPath), 0700); err != nil {
+		return errors.Wrap(err, "failed to create config directory")
+	}
+	// The config holds the access tokens.
+	if err := os.WriteFile(configPath, configBytes, 0600); err != nil {
+		return errors.Wrap(err, "failed to write config")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/warthurton/slash/client"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Create the shortcuts of a CSV export or a bookmark file.",
+	Long: `Create the shortcuts of a CSV export of an instance, or of a Netscape bookmark file exported by a browser.
+Large imports are throttled by the import quota of the user on the instance, and the progress is printed as they go.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profileName, err := cmd.Flags().GetString("profile")
+		if err != nil {
+			return err
+		}
+		format, err := cmd.Flags().GetString("format")
+		if err != nil {
+			return err
+		}
+		if format == "" {
+			switch strings.ToLower(filepath.Ext(args[0])) {
+			case ".csv":
+				format = "csv"
+			case ".html", ".htm":
+				format = "netscape"
+			default:
+				return errors.Errorf("unknown format of %s, see --format", args[0])
+			}
+		}
+		return importShortcuts(cmd.Context(), profileName, args[0], format)
+	},
+}
+
+func init() {
+	importCmd.Flags().String("format", "", "format of the file, csv or netscape, from its extension by default")
+	if err := importCmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions([]string{"csv", "netscape"}, cobra.ShellCompDirectiveNoFileComp)); err != nil {
+		panic(err)
+	}
+	addProfileFlag(importCmd)
+	// The usage doesn't help with the errors of the instance.
+	importCmd.SilenceUsage = true
+	rootCmd.AddCommand(importCmd)
+}
+
+func importShortcuts(ctx context.Context, profileName, path, format string) error {
+	c, err := newClient(profileName)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open file")
+	}
+	defer file.Close()
+
+	last, err := c.ImportShortcuts(ctx, format, file, func(event *client.ImportEvent) {
+		switch event.Type {
+		case "row":
+			if event.Error != "" {
+				fmt.Fprintf(os.Stderr, "Row %d (%s) failed: %s\n", event.Row, event.Name, event.Error)
+			}
+		case "throttled":
+			fmt.Fprintf(os.Stderr, "Imported %d/%d, waiting %.1fs for the import quota...\n", event.Processed, event.Total, event.WaitSeconds)
+		}
+	})
+	if last != nil {
+		fmt.Printf("Imported %d/%d shortcuts, %d created, %d failed\n", last.Processed, last.Total, last.Created, last.Failed)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to import shortcuts")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/warthurton/slash/server/profile"
+	"github.com/warthurton/slash/store"
+	"github.com/warthurton/slash/store/db"
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Initialize the instance with the first admin, so that no manual sign-up is needed.",
+	Long: `Initialize the instance: migrate the database, generate the instance secret and create the first admin.
+It is idempotent, so it can run on every deployment, e.g. as a Kubernetes init container.
+The admin is only created when the instance has no admin yet, and an existing admin is never modified.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		adminEmail, err := cmd.Flags().GetString("admin-email")
+		if err != nil {
+			return err
+		}
+		adminPasswordFile, err := cmd.Flags().GetString("admin-password-file")
+		if err != nil {
+			return err
+		}
+		serverProfile := newServerProfile()
+		if err := serverProfile.Validate(); err != nil {
+			return err
+		}
+		return initInstance(cmd.Context(), serverProfile, adminEmail, adminPasswordFile)
+	},
+}
+
+func init() {
+	initCmd.Flags().String("admin-email", "", "email of the first admin")
+	initCmd.Flags().String("admin-password-file", "", "file containing the password of the first admin, e.g. a mounted secret")
+	if err := initCmd.MarkFlagRequired("admin-email"); err != nil {
+		panic(err)
+	}
+	if err := initCmd.MarkFlagRequired("admin-password-file"); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(initCmd)
+}
+
+func initInstance(ctx context.Context, serverProfile *profile.Profile, adminEmail, adminPasswordFile string) error {
+	if adminEmail == "" {
+		return errors.New("admin email is required")
+	}
+	passwordBytes, err := os.ReadFile(adminPasswordFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to read admin password file")
+	}
+	// Secrets mounted from files usually end with a newline.
+	adminPassword := strings.TrimRight(string(passwordBytes), "\r\n")
+	if adminPassword == "" {
+		return errors.New("admin password is empty")
+	}
+
+	dbDriver, err := db.NewDBDriver(serverProfile)
+	if err != nil {
+		return errors.Wrap(err, "failed to create db driver")
+	}
+	storeInstance := store.New(dbDriver, serverProfile)
+	defer storeInstance.Close()
+	if err := storeInstance.Migrate(ctx); err != nil {
+		return errors.Wrap(err, "failed to migrate db")
+	}
+	if _, err := storeInstance.GetOrCreateWorkspaceSecretSession(ctx); err != nil {
+		return errors.Wrap(err, "failed to create instance secret")
+	}
+
+	adminRole := store.RoleAdmin
+	admins, err := storeInstance.ListUsers(ctx, &store.FindUser{
+		Role: &adminRole,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list admins")
+	}
+	if len(admins) > 0 {
+		slog.Info("instance already has an admin, skip creating the admin", slog.String("email", admins[0].Email))
+		return nil
+	}
+	existingUser, err := storeInstance.GetUser(ctx, &store.FindUser{
+		Email: &adminEmail,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if existingUser != nil {
+		return errors.Errorf("user %s exists but is not an admin", adminEmail)
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(adminPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate password hash")
+	}
+	nickname, _, _ := strings.Cut(adminEmail, "@")
+	admin, err := storeInstance.CreateUser(ctx, &store.User{
+		Email:         adminEmail,
+		Nickname:      nickname,
+		PasswordHash:  string(passwordHash),
+		Role:          store.RoleAdmin,
+		EmailVerified: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create admin")
+	}
+	fmt.Printf("Created admin %s (username: %s)\n", admin.Email, admin.Username)
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -28,17 +29,11 @@ var (
 		Use:   "slash",
 		Short: `An open source, self-hosted platform for sharing and managing your most frequently used links.`,
 		Run: func(_ *cobra.Command, _ []string) {
-			serverProfile := &profile.Profile{
-				Mode:    viper.GetString("mode"),
-				Port:    viper.GetInt("port"),
-				Data:    viper.GetString("data"),
-				DSN:     viper.GetString("dsn"),
-				Driver:  viper.GetString("driver"),
-				Version: common.GetCurrentVersion(viper.GetString("mode")),
-			}
+			serverProfile := newServerProfile()
 			if err := serverProfile.Validate(); err != nil {
 				panic(err)
 			}
+			slog.SetDefault(newLogger(serverProfile))
 
 			ctx, cancel := context.WithCancel(context.Background())
 			dbDriver, err := db.NewDBDriver(serverProfile)
@@ -73,7 +68,16 @@ var (
 				cancel()
 			}()
 
-			printGreetings(serverProfile)
+			if !serverProfile.Quiet {
+				logGreetings(serverProfile)
+			}
+			go func() {
+				select {
+				case <-s.Listening():
+					logStarted(serverProfile)
+				case <-ctx.Done():
+				}
+			}()
 
 			if err := s.Start(ctx); err != nil {
 				if err != http.ErrServerClosed {
@@ -88,6 +92,41 @@ var (
 	}
 )
 
+func newServerProfile() *profile.Profile {
+	return &profile.Profile{
+		Mode:                 viper.GetString("mode"),
+		Port:                 viper.GetInt("port"),
+		Data:                 viper.GetString("data"),
+		DSN:                  viper.GetString("dsn"),
+		Driver:               viper.GetString("driver"),
+		ShadowDriver:         viper.GetString("shadow_driver"),
+		ShadowDSN:            viper.GetString("shadow_dsn"),
+		Version:              common.GetCurrentVersion(viper.GetString("mode")),
+		CookieDomain:         viper.GetString("cookie_domain"),
+		CookieSecure:         viper.GetBool("cookie_secure"),
+		CookieSameSite:       viper.GetString("cookie_samesite"),
+		TLSCert:              viper.GetString("tls_cert"),
+		TLSKey:               viper.GetString("tls_key"),
+		ACMEDomain:           viper.GetString("acme_domain"),
+		BasePath:             viper.GetString("base_path"),
+		Metrics:              viper.GetBool("metrics"),
+		MetricsTopShortcuts:  viper.GetInt("metrics_top_shortcuts"),
+		ActivityArchiveDays:  viper.GetInt("activity_archive_days"),
+		AuthRateLimit:        viper.GetInt("auth_rate_limit"),
+		AuthLockoutThreshold: viper.GetInt("auth_lockout_threshold"),
+		TrustedProxies:       viper.GetString("trusted_proxies"),
+		ImportRateLimit:      viper.GetInt("import_rate_limit"),
+		ImportBurst:          viper.GetInt("import_burst"),
+		DrainTimeout:         viper.GetDuration("drain_timeout"),
+		LogLevel:             viper.GetString("log_level"),
+		LogFormat:            viper.GetString("log_format"),
+		Quiet:                viper.GetBool("quiet"),
+		// The break-glass credential is only read from the environment, so it's not visible in the process list.
+		BreakGlassEmail:        viper.GetString("break_glass_email"),
+		BreakGlassPasswordHash: viper.GetString("break_glass_password_hash"),
+	}
+}
+
 func init() {
 	viper.SetDefault("mode", "dev")
 	viper.SetDefault("driver", "sqlite")
@@ -99,6 +138,27 @@ func init() {
 	rootCmd.PersistentFlags().String("data", "", "data directory")
 	rootCmd.PersistentFlags().String("driver", "sqlite", "database driver")
 	rootCmd.PersistentFlags().String("dsn", "", "database source name(aka. DSN)")
+	rootCmd.PersistentFlags().String("shadow-driver", "", "database driver of the shadow database which mirrors the writes")
+	rootCmd.PersistentFlags().String("shadow-dsn", "", "database source name of the shadow database, empty means disabled")
+	rootCmd.PersistentFlags().String("cookie-domain", "", "domain attribute of the access token cookie")
+	rootCmd.PersistentFlags().Bool("cookie-secure", false, "whether to set the Secure attribute of the access token cookie")
+	rootCmd.PersistentFlags().String("cookie-samesite", "Strict", `SameSite mode of the access token cookie, can be "Strict", "Lax" or "None"`)
+	rootCmd.PersistentFlags().String("tls-cert", "", "path of the certificate file to serve HTTPS, with --tls-key")
+	rootCmd.PersistentFlags().String("tls-key", "", "path of the private key file of the --tls-cert")
+	rootCmd.PersistentFlags().String("acme-domain", "", "comma-separated domains to serve HTTPS with certificates from Let's Encrypt")
+	rootCmd.PersistentFlags().String("base-path", "", `URL path prefix to serve Slash under, e.g. "/slash"`)
+	rootCmd.PersistentFlags().Bool("metrics", false, "whether to expose Prometheus metrics at /metrics")
+	rootCmd.PersistentFlags().Int("metrics-top-shortcuts", 0, "number of top shortcuts labelled in the metrics, at most 100")
+	rootCmd.PersistentFlags().Int("activity-archive-days", 0, "age in days after which the shortcut views are archived into blobs, 0 means never")
+	rootCmd.PersistentFlags().Int("auth-rate-limit", 20, "max attempts per minute to sign in or up from an IP, 0 means unlimited")
+	rootCmd.PersistentFlags().Int("auth-lockout-threshold", 5, "number of consecutive failures to sign in after which an account is locked out, 0 means never")
+	rootCmd.PersistentFlags().String("trusted-proxies", "", "comma-separated IPs or CIDRs of the reverse proxies whose X-Forwarded-For header is trusted for the IP of the client")
+	rootCmd.PersistentFlags().Int("import-rate-limit", 300, "max shortcuts per minute imported by a user after the burst, 0 means unlimited")
+	rootCmd.PersistentFlags().Int("import-burst", 100, "number of shortcuts a user can import at once before the import rate limit applies")
+	rootCmd.PersistentFlags().Duration("drain-timeout", 10*time.Second, "max duration to wait for the in-flight requests on shutdown before cutting them")
+	rootCmd.PersistentFlags().String("log-level", "info", `min level of the logs, can be "debug", "info", "warn" or "error"`)
+	rootCmd.PersistentFlags().String("log-format", "text", `format of the logs, can be "text" or "json"`)
+	rootCmd.PersistentFlags().Bool("quiet", false, `whether to suppress the greeting banner and the server profile, except the "server started" line`)
 
 	if err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode")); err != nil {
 		panic(err)
@@ -115,29 +175,121 @@ func init() {
 	if err := viper.BindPFlag("dsn", rootCmd.PersistentFlags().Lookup("dsn")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("shadow_driver", rootCmd.PersistentFlags().Lookup("shadow-driver")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("shadow_dsn", rootCmd.PersistentFlags().Lookup("shadow-dsn")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("cookie_domain", rootCmd.PersistentFlags().Lookup("cookie-domain")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("cookie_secure", rootCmd.PersistentFlags().Lookup("cookie-secure")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("cookie_samesite", rootCmd.PersistentFlags().Lookup("cookie-samesite")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("tls_cert", rootCmd.PersistentFlags().Lookup("tls-cert")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("tls_key", rootCmd.PersistentFlags().Lookup("tls-key")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("acme_domain", rootCmd.PersistentFlags().Lookup("acme-domain")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("base_path", rootCmd.PersistentFlags().Lookup("base-path")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("metrics", rootCmd.PersistentFlags().Lookup("metrics")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("metrics_top_shortcuts", rootCmd.PersistentFlags().Lookup("metrics-top-shortcuts")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("activity_archive_days", rootCmd.PersistentFlags().Lookup("activity-archive-days")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("auth_rate_limit", rootCmd.PersistentFlags().Lookup("auth-rate-limit")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("auth_lockout_threshold", rootCmd.PersistentFlags().Lookup("auth-lockout-threshold")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("trusted_proxies", rootCmd.PersistentFlags().Lookup("trusted-proxies")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("import_rate_limit", rootCmd.PersistentFlags().Lookup("import-rate-limit")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("import_burst", rootCmd.PersistentFlags().Lookup("import-burst")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("drain_timeout", rootCmd.PersistentFlags().Lookup("drain-timeout")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("log_level", rootCmd.PersistentFlags().Lookup("log-level")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("log_format", rootCmd.PersistentFlags().Lookup("log-format")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("quiet", rootCmd.PersistentFlags().Lookup("quiet")); err != nil {
+		panic(err)
+	}
 
 	viper.SetEnvPrefix("slash")
 	viper.AutomaticEnv()
 }
 
-func printGreetings(serverProfile *profile.Profile) {
-	println("---")
-	println("Server profile")
-	println("dsn:", serverProfile.DSN)
-	println("port:", serverProfile.Port)
-	println("mode:", serverProfile.Mode)
-	println("version:", serverProfile.Version)
-	println("---")
-	println(greetingBanner)
-	fmt.Printf("Version %s has been started on port %d\n", serverProfile.Version, serverProfile.Port)
-	println("---")
-	println("See more in:")
-	fmt.Printf("👉GitHub: %s\n", "https://github.com/warthurton/slash")
-	println("---")
+// newLogger returns the logger of the server, with the level and format of the profile.
+func newLogger(serverProfile *profile.Profile) *slog.Logger {
+	options := &slog.HandlerOptions{Level: serverProfile.GetLogLevel()}
+	if serverProfile.LogFormat == "json" {
+		return slog.New(slog.NewJSONHandler(os.Stderr, options))
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, options))
+}
+
+// logGreetings logs the greeting banner and the server profile.
+func logGreetings(serverProfile *profile.Profile) {
+	slog.Info(greetingBanner, slog.String("github", "https://github.com/warthurton/slash"))
+	attrs := []any{
+		slog.String("mode", serverProfile.Mode),
+		slog.Int("port", serverProfile.Port),
+		slog.String("driver", serverProfile.Driver),
+		slog.String("dsn", serverProfile.DSN),
+		slog.String("version", serverProfile.Version),
+	}
+	if serverProfile.ACMEDomain != "" {
+		attrs = append(attrs, slog.String("acme_domain", serverProfile.ACMEDomain))
+	} else if serverProfile.TLSCert != "" {
+		attrs = append(attrs, slog.String("tls_cert", serverProfile.TLSCert))
+	}
+	if serverProfile.BasePath != "" {
+		attrs = append(attrs, slog.String("base_path", serverProfile.BasePath))
+	}
+	if serverProfile.BreakGlassEmail != "" {
+		attrs = append(attrs, slog.String("break_glass_admin", serverProfile.BreakGlassEmail))
+	}
+	slog.Info("server profile", attrs...)
+}
+
+// logStarted prints the single "server started" line once the server accepts the connections, for the log scrapers
+// and the supervisors. It's a JSON object on the stdout whatever the log format, unlike the other logs on the stderr.
+func logStarted(serverProfile *profile.Profile) {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	logger.Info("server started",
+		slog.Int("port", serverProfile.Port),
+		slog.String("version", serverProfile.Version),
+		slog.String("driver", serverProfile.Driver),
+	)
 }
 
 func main() {
+	// The error is printed by cobra.
 	if err := rootCmd.Execute(); err != nil {
-		panic(err)
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// addOutputFlag adds the --output flag to the client command, for scripts to read its output as JSON.
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", outputTable, "format of the output, table or json")
+	if err := cmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions([]string{outputTable, outputJSON}, cobra.ShellCompDirectiveNoFileComp)); err != nil {
+		panic(err)
+	}
+}
+
+func getOutputFormat(cmd *cobra.Command) (string, error) {
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return "", err
+	}
+	if output != outputTable && output != outputJSON {
+		return "", errors.Errorf("invalid output %s, it must be table or json", output)
+	}
+	return output, nil
+}
+
+// printJSON prints the message with the field names of the REST API.
+func printJSON(message proto.Message) error {
+	messageBytes, err := marshalJSON(message)
+	if err != nil {
+		return err
+	}
+	return printIndentedJSON(json.RawMessage(messageBytes))
+}
+
+// printJSONArray prints the messages as an array, empty rather than null without messages.
+func printJSONArray[T proto.Message](messages []T) error {
+	rawMessages := make([]json.RawMessage, 0, len(messages))
+	for _, message := range messages {
+		messageBytes, err := marshalJSON(message)
+		if err != nil {
+			return err
+		}
+		rawMessages = append(rawMessages, messageBytes)
+	}
+	return printIndentedJSON(rawMessages)
+}
+
+func marshalJSON(message proto.Message) ([]byte, error) {
+	// The unpopulated fields are emitted so that the scripts don't need to handle them missing.
+	messageBytes, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(message)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal output")
+	}
+	return messageBytes, nil
+}
+
+func printIndentedJSON(value any) error {
+	outputBytes, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal output")
+	}
+	fmt.Fprintln(os.Stdout, string(outputBytes))
+	return nil
+}
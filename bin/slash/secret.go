@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/warthurton/slash/server/profile"
+	"github.com/warthurton/slash/server/service/secret"
+	"github.com/warthurton/slash/store"
+	"github.com/warthurton/slash/store/db"
+)
+
+var (
+	secretCmd = &cobra.Command{
+		Use:   "secret",
+		Short: "Manage the workspace secret which signs the access tokens.",
+	}
+	secretRotateCmd = &cobra.Command{
+		Use:   "rotate",
+		Short: "Rotate the workspace secret.",
+		Long: `Rotate the workspace secret which signs the access tokens, and record the rotation in the activities.
+The policy decides what happens to the access tokens signed with the previous secret:
+  revoke: revoke all the access tokens, so every user has to sign in again.
+  resign: re-sign the personal access tokens with the new secret and revoke the sign-in sessions.
+          The re-signed tokens are printed once, as only their hash is stored, while the previous token strings stop working.
+Restart the server afterwards, as a running server keeps using the previous secret.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			policy, err := cmd.Flags().GetString("policy")
+			if err != nil {
+				return err
+			}
+			serverProfile := newServerProfile()
+			if err := serverProfile.Validate(); err != nil {
+				return err
+			}
+			return rotateSecret(cmd.Context(), serverProfile, secret.Policy(policy))
+		},
+	}
+)
+
+func init() {
+	secretRotateCmd.Flags().String("policy", string(secret.PolicyRevoke), "what happens to the existing access tokens, revoke or resign")
+	secretCmd.AddCommand(secretRotateCmd)
+	rootCmd.AddCommand(secretCmd)
+}
+
+func rotateSecret(ctx context.Context, serverProfile *profile.Profile, policy secret.Policy) error {
+	// The dev mode signs the access tokens with a fixed secret.
+	if serverProfile.Mode != "prod" {
+		return errors.New("the workspace secret is only used in prod mode")
+	}
+	dbDriver, err := db.NewDBDriver(serverProfile)
+	if err != nil {
+		return errors.Wrap(err, "failed to create db driver")
+	}
+	storeInstance := store.New(dbDriver, serverProfile)
+	defer storeInstance.Close()
+	if err := storeInstance.Migrate(ctx); err != nil {
+		return errors.Wrap(err, "failed to migrate db")
+	}
+	result, err := secret.Rotate(ctx, storeInstance, policy)
+	if err != nil {
+		return errors.Wrap(err, "failed to rotate secret")
+	}
+	fmt.Printf("Rotated the workspace secret: %d access tokens re-signed, %d revoked. Restart the server to use the new secret.\n", result.ResignedTokenCount, result.RevokedTokenCount)
+	// The re-signed tokens can't be shown later, as only their hash is stored.
+	for _, resignedToken := range result.ResignedTokens {
+		fmt.Printf("user %d\t%s\t%s\n", resignedToken.UserID, resignedToken.Description, resignedToken.AccessToken)
+	}
+	return nil
+}
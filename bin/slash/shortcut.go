@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/warthurton/slash/client"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+// completionTimeout bounds the request of the shortcut names to complete.
+const completionTimeout = 3 * time.Second
+
+var (
+	getCmd = &cobra.Command{
+		Use:   "get <name>",
+		Short: "Print the link a shortcut redirects to.",
+		Long: `Print the link the shortcut redirects to now, e.g. to open it with open "$(slash get docs)".
+The view isn't counted, and the aliases resolve like visiting the shortcut.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeShortcutNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profileName, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return err
+			}
+			output, err := getOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			return getShortcut(cmd.Context(), profileName, args[0], output)
+		},
+	}
+	addCmd = &cobra.Command{
+		Use:   "add <name> <link>",
+		Short: "Create a shortcut.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profileName, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return err
+			}
+			output, err := getOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			tags, err := cmd.Flags().GetStringSlice("tag")
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString("description")
+			if err != nil {
+				return err
+			}
+			visibility, err := cmd.Flags().GetString("visibility")
+			if err != nil {
+				return err
+			}
+			shortcut := &v1pb.Shortcut{
+				Name:        args[0],
+				Link:        args[1],
+				Tags:        tags,
+				Title:       title,
+				Description: description,
+			}
+			if visibility != "" {
+				value, ok := v1pb.Visibility_value[strings.ToUpper(visibility)]
+				if !ok || value == int32(v1pb.Visibility_VISIBILITY_UNSPECIFIED) {
+					return errors.Errorf("invalid visibility %s", visibility)
+				}
+				shortcut.Visibility = v1pb.Visibility(value)
+			}
+			return addShortcut(cmd.Context(), profileName, shortcut, output)
+		},
+	}
+	searchCmd = &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search the shortcuts.",
+		Long: `Search the shortcuts by keywords and filters, e.g. slash search jira tag:eng.
+The keywords are matched as word prefixes against the name, title, description, tags and link.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profileName, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return err
+			}
+			output, err := getOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetInt("limit")
+			if err != nil {
+				return err
+			}
+			return searchShortcuts(cmd.Context(), profileName, strings.Join(args, " "), limit, output)
+		},
+	}
+)
+
+func init() {
+	addCmd.Flags().StringSlice("tag", nil, "tag of the shortcut, can be repeated")
+	addCmd.Flags().String("title", "", "title of the shortcut")
+	addCmd.Flags().String("description", "", "description of the shortcut")
+	addCmd.Flags().String("visibility", "", "visibility of the shortcut, workspace, public or private, the workspace default by default")
+	if err := addCmd.RegisterFlagCompletionFunc("visibility", cobra.FixedCompletions([]string{"workspace", "public", "private"}, cobra.ShellCompDirectiveNoFileComp)); err != nil {
+		panic(err)
+	}
+	searchCmd.Flags().Int("limit", 20, "max number of shortcuts, 0 means all of them")
+	for _, cmd := range []*cobra.Command{getCmd, addCmd, searchCmd} {
+		addProfileFlag(cmd)
+		addOutputFlag(cmd)
+		// The usage doesn't help with the errors of the instance.
+		cmd.SilenceUsage = true
+		rootCmd.AddCommand(cmd)
+	}
+}
+
+func getShortcut(ctx context.Context, profileName, name, output string) error {
+	c, err := newClient(profileName)
+	if err != nil {
+		return err
+	}
+	response, err := c.ResolveShortcut(ctx, name)
+	if err != nil {
+		var apiError *client.Error
+		if errors.As(err, &apiError) {
+			return errors.Wrap(err, "failed to get shortcut")
+		}
+		// The instance is unreachable, e.g. offline, so the shortcut is resolved with the cache of `slash sync`.
+		cachedResponse, cacheErr := resolveShortcutFromCache(profileName, name)
+		if cacheErr != nil {
+			return errors.Wrapf(err, "failed to get shortcut (offline: %v)", cacheErr)
+		}
+		response = cachedResponse
+		fmt.Fprintf(os.Stderr, "The instance is unreachable, %s.\n", response.Reason)
+	}
+	if output == outputJSON {
+		if err := printJSON(response); err != nil {
+			return err
+		}
+	}
+	switch response.Outcome {
+	case v1pb.ResolvePreviewResponse_REDIRECT, v1pb.ResolvePreviewResponse_PLAIN_TEXT, v1pb.ResolvePreviewResponse_FALLBACK_REDIRECT:
+		if output == outputTable {
+			fmt.Println(response.Target)
+		}
+		return nil
+	case v1pb.ResolvePreviewResponse_NOT_FOUND:
+		if len(response.Suggestions) > 0 {
+			return errors.Errorf("shortcut %s not found, did you mean %s?", name, strings.Join(response.Suggestions, ", "))
+		}
+		return errors.Errorf("shortcut %s not found", name)
+	default:
+		return errors.Errorf("shortcut %s doesn't redirect: %s", name, response.Reason)
+	}
+}
+
+func addShortcut(ctx context.Context, profileName string, shortcut *v1pb.Shortcut, output string) error {
+	c, err := newClient(profileName)
+	if err != nil {
+		return err
+	}
+	createdShortcut, err := c.CreateShortcut(ctx, shortcut)
+	if err != nil {
+		return errors.Wrap(err, "failed to create shortcut")
+	}
+	if output == outputJSON {
+		return printJSON(createdShortcut)
+	}
+	fmt.Printf("Created %s -> %s\n", createdShortcut.Name, createdShortcut.Link)
+	return nil
+}
+
+func searchShortcuts(ctx context.Context, profileName, query string, limit int, output string) error {
+	c, err := newClient(profileName)
+	if err != nil {
+		return err
+	}
+	shortcuts, err := c.SearchShortcuts(ctx, query, limit)
+	if err != nil {
+		return errors.Wrap(err, "failed to search shortcuts")
+	}
+	if output == outputJSON {
+		return printJSONArray(shortcuts)
+	}
+	if len(shortcuts) == 0 {
+		fmt.Fprintln(os.Stderr, "No shortcuts found.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tLINK\tTITLE")
+	for _, shortcut := range shortcuts {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", shortcut.Name, shortcut.Link, shortcut.Title)
+	}
+	return w.Flush()
+}
+
+// completeShortcutNames completes the name of a shortcut with the shortcuts of the instance.
+func completeShortcutNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	profileName, err := cmd.Flags().GetString("profile")
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	c, err := newClient(profileName)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	// The shell waits for the completion, so an unreachable instance mustn't hang it.
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	shortcuts, err := c.ListShortcuts(ctx)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := []string{}
+	for _, shortcut := range shortcuts {
+		if strings.HasPrefix(shortcut.Name, toComplete) {
+			names = append(names, fmt.Sprintf("%s\t%s", shortcut.Name, shortcut.Link))
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
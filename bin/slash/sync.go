@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/warthurton/slash/client"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Cache the links of the shortcuts to resolve them offline.",
+	Long: `Cache the links the shortcuts redirect to, signed by the instance, so that slash get works offline.
+Only the changes since the last sync are downloaded, e.g. to sync from a cron job.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		profileName, err := cmd.Flags().GetString("profile")
+		if err != nil {
+			return err
+		}
+		return syncResolutionCache(cmd.Context(), profileName)
+	},
+}
+
+func init() {
+	addProfileFlag(syncCmd)
+	// The usage doesn't help with the errors of the instance.
+	syncCmd.SilenceUsage = true
+	rootCmd.AddCommand(syncCmd)
+}
+
+func syncResolutionCache(ctx context.Context, profileName string) error {
+	profileName, profile, err := getClientProfile(profileName)
+	if err != nil {
+		return err
+	}
+	cache, err := readResolutionCache(profileName)
+	if err != nil {
+		return err
+	}
+	snapshot, err := client.NewClient(profile.URL, profile.AccessToken).GetResolutionSnapshot(ctx, cache.Version)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the snapshot")
+	}
+	// The key is trusted on the first sync, then the snapshots signed with another key are rejected.
+	if profile.SnapshotPublicKey == nil {
+		profile.SnapshotPublicKey = snapshot.PublicKey
+		if err := updateClientProfile(profileName, profile); err != nil {
+			return err
+		}
+	} else if !bytes.Equal(profile.SnapshotPublicKey, snapshot.PublicKey) {
+		return errors.New("the instance signs the snapshots with another key, e.g. its secret was rotated, run `slash login <url>` again to trust it")
+	}
+	if err := cache.Apply(snapshot, profile.SnapshotPublicKey); err != nil {
+		return errors.Wrap(err, "failed to apply the snapshot")
+	}
+	if err := writeResolutionCache(profileName, cache); err != nil {
+		return err
+	}
+	if snapshot.BaseVersion != "" {
+		fmt.Printf("Synced %d changes, %d shortcuts cached\n", len(snapshot.Links)+len(snapshot.RemovedNames), len(cache.Links))
+	} else {
+		fmt.Printf("Synced %d shortcuts\n", len(cache.Links))
+	}
+	return nil
+}
+
+// resolveShortcutFromCache resolves the shortcut with the cache of the profile, verified with the pinned key.
+func resolveShortcutFromCache(profileName, name string) (*v1pb.ResolvePreviewResponse, error) {
+	profileName, profile, err := getClientProfile(profileName)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := readResolutionCache(profileName)
+	if err != nil {
+		return nil, err
+	}
+	if cache.Version == "" {
+		return nil, errors.New("no cache, see `slash sync`")
+	}
+	if err := cache.Verify(profile.SnapshotPublicKey); err != nil {
+		return nil, errors.Wrap(err, "invalid cache, run `slash sync` again")
+	}
+	reason := fmt.Sprintf("resolved offline with the cache of %s", cache.UpdatedTime.Local().Format(time.RFC3339))
+	target, ok := cache.Links[name]
+	if !ok {
+		return &v1pb.ResolvePreviewResponse{
+			Outcome: v1pb.ResolvePreviewResponse_NOT_FOUND,
+			Reason:  reason,
+		}, nil
+	}
+	return &v1pb.ResolvePreviewResponse{
+		Outcome: v1pb.ResolvePreviewResponse_REDIRECT,
+		Target:  target,
+		Reason:  reason,
+	}, nil
+}
+
+// getResolutionCachePath returns the path of the cache of the profile, next to the config file.
+func getResolutionCachePath(profileName string) (string, error) {
+	configPath, err := getClientConfigPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(configPath), "cache", profileName+".json"), nil
+}
+
+func readResolutionCache(profileName string) (*client.ResolutionCache, error) {
+	cache := &client.ResolutionCache{}
+	cachePath, err := getResolutionCachePath(profileName)
+	if err != nil {
+		return nil, err
+	}
+	cacheBytes, err := os.ReadFile(cachePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cache, nil
+		}
+		return nil, errors.Wrap(err, "failed to read cache")
+	}
+	if err := json.Unmarshal(cacheBytes, cache); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse cache %s", cachePath)
+	}
+	return cache, nil
+}
+
+func writeResolutionCache(profileName string, cache *client.ResolutionCache) error {
+	cachePath, err := getResolutionCachePath(profileName)
+	if err != nil {
+		return err
+	}
+	cacheBytes, err := json.Marshal(cache)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal cache")
+	}
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0700); err != nil {
+		return errors.Wrap(err, "failed to create cache directory")
+	}
+	// The cache lists the private shortcuts of the user.
+	if err := os.WriteFile(cachePath, cacheBytes, 0600); err != nil {
+		return errors.Wrap(err, "failed to write cache")
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Package client is the Go client of the REST API of a Slash instance, used by the CLI.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+// maxResponseSize is the max size of a response of the instance.
+const maxResponseSize = 32 << 20
+
+// Client calls the REST API of a Slash instance with an access token.
+type Client struct {
+	baseURL     string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// Error is the error returned by the API, with the gRPC code of the failure.
+type Error struct {
+	StatusCode int
+	Code       codes.Code
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return http.StatusText(e.StatusCode)
+	}
+	return e.Message
+}
+
+// NewClient creates a client of the Slash instance at baseURL. The access token may be empty for the public APIs.
+func NewClient(baseURL, accessToken string) *Client {
+	return &Client{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		accessToken: accessToken,
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// GetAuthStatus returns the user of the access token.
+func (c *Client) GetAuthStatus(ctx context.Context) (*v1pb.User, error) {
+	user := &v1pb.User{}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/auth/status", nil, nil, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// CreateDeviceAuthorization starts the authorization of the client, which the user approves in the web app.
+func (c *Client) CreateDeviceAuthorization(ctx context.Context, request *v1pb.CreateDeviceAuthorizationRequest) (*v1pb.CreateDeviceAuthorizationResponse, error) {
+	response := &v1pb.CreateDeviceAuthorizationResponse{}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/auth/device", nil, request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// PollDeviceToken returns the state of the device authorization, with the access token once it's approved.
+func (c *Client) PollDeviceToken(ctx context.Context, deviceCode string) (*v1pb.PollDeviceTokenResponse, error) {
+	response := &v1pb.PollDeviceTokenResponse{}
+	request := &v1pb.PollDeviceTokenRequest{
+		DeviceCode: deviceCode,
+	}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/auth/device/token", nil, request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ResolveShortcut returns how visiting the shortcut resolves now, without recording a view.
+func (c *Client) ResolveShortcut(ctx context.Context, name string) (*v1pb.ResolvePreviewResponse, error) {
+	response := &v1pb.ResolvePreviewResponse{}
+	query := url.Values{"name": {name}}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/shortcuts:resolvePreview", query, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListShortcuts returns all the shortcuts visible to the user.
+func (c *Client) ListShortcuts(ctx context.Context) ([]*v1pb.Shortcut, error) {
+	response := &v1pb.ListShortcutsResponse{}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/shortcuts", nil, nil, response); err != nil {
+		return nil, err
+	}
+	return response.Shortcuts, nil
+}
+
+// SearchShortcuts returns the shortcuts matching the query, ordered by relevance.
+// The limit is the max number of shortcuts, 0 means all of them.
+func (c *Client) SearchShortcuts(ctx context.Context, query string, limit int) ([]*v1pb.Shortcut, error) {
+	response := &v1pb.SearchShortcutsResponse{}
+	values := url.Values{"query": {query}}
+	if limit > 0 {
+		values.Set("pageSize", fmt.Sprint(limit))
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/shortcuts:search", values, nil, response); err != nil {
+		return nil, err
+	}
+	return response.Shortcuts, nil
+}
+
+// CreateShortcut creates the shortcut.
+func (c *Client) CreateShortcut(ctx context.Context, shortcut *v1pb.Shortcut) (*v1pb.Shortcut, error) {
+	createdShortcut := &v1pb.Shortcut{}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/shortcuts", nil, shortcut, createdShortcut); err != nil {
+		return nil, err
+	}
+	return createdShortcut, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, request, response proto.Message) error {
+	urlStr := c.baseURL + path
+	if len(query) > 0 {
+		urlStr += "?" + query.Encode()
+	}
+	var body io.Reader
+	if request != nil {
+		requestBytes, err := protojson.Marshal(request)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request")
+		}
+		body = bytes.NewReader(requestBytes)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, body)
+	if err != nil {
+		return errors.Wrap(err, "invalid url")
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to request %s", path)
+	}
+	defer resp.Body.Close()
+
+	responseBytes, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the response of %s", path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return parseError(resp.StatusCode, responseBytes)
+	}
+	// The instance may be of a newer version with unknown fields.
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(responseBytes, response); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal the response of %s", path)
+	}
+	return nil
+}
+
+// parseError parses the error of the gateway, e.g. `{"code": 5, "message": "shortcut not found"}`,
+// or of the endpoints outside of it, e.g. `{"message": "invalid access token"}`.
+func parseError(statusCode int, body []byte) error {
+	apiError := &Error{
+		StatusCode: statusCode,
+		Code:       codes.Unknown,
+	}
+	var status struct {
+		Code    int32  `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &status); err == nil {
+		// The endpoints outside of the gateway only have a message.
+		if status.Code != 0 {
+			apiError.Code = codes.Code(status.Code)
+		}
+		apiError.Message = status.Message
+	}
+	return apiError
+}
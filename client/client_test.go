@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+func TestClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code": 16, "message": "invalid access token", "details": []}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/shortcuts:resolvePreview":
+			if r.URL.Query().Get("name") != "docs" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(`{"outcome": "REDIRECT", "target": "https://docs.example.com", "unknownField": true}`))
+		case "/api/v1/shortcuts":
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(`{"shortcuts": [{"id": 1, "name": "docs"}, {"id": 2, "name": "wiki"}]}`))
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			require.JSONEq(t, `{"name": "wiki", "link": "https://wiki.example.com", "tags": ["infra"]}`, string(body))
+			_, _ = w.Write([]byte(`{"id": 2, "name": "wiki", "link": "https://wiki.example.com", "tags": ["infra"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "Not Found", "details": []}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := NewClient(server.URL+"/", "token")
+	response, err := c.ResolveShortcut(ctx, "docs")
+	require.NoError(t, err)
+	require.Equal(t, v1pb.ResolvePreviewResponse_REDIRECT, response.Outcome)
+	require.Equal(t, "https://docs.example.com", response.Target)
+
+	shortcut, err := c.CreateShortcut(ctx, &v1pb.Shortcut{
+		Name: "wiki",
+		Link: "https://wiki.example.com",
+		Tags: []string{"infra"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), shortcut.Id)
+
+	shortcuts, err := c.ListShortcuts(ctx)
+	require.NoError(t, err)
+	require.Len(t, shortcuts, 2)
+	require.Equal(t, "wiki", shortcuts[1].Name)
+
+	_, err = NewClient(server.URL, "").GetAuthStatus(ctx)
+	var apiError *Error
+	require.True(t, errors.As(err, &apiError))
+	require.Equal(t, codes.Unauthenticated, apiError.Code)
+	require.Equal(t, "invalid access token", apiError.Error())
+}
+
+func TestImportShortcuts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/import", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Query().Get("format") != "csv" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message": "unsupported import format"}`))
+			return
+		}
+		require.Equal(t, "name,link\ndocs,https://docs.example.com\n", string(body))
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		_, _ = w.Write([]byte(`{"type": "throttled", "row": 1, "waitSeconds": 0.5, "processed": 0, "total": 1}` + "\n"))
+		_, _ = w.Write([]byte(`{"type": "row", "row": 1, "name": "docs", "processed": 1, "total": 1, "created": 1}` + "\n"))
+		if r.Header.Get("Authorization") == "Bearer stop" {
+			return
+		}
+		_, _ = w.Write([]byte(`{"type": "done", "processed": 1, "total": 1, "created": 1}` + "\n"))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	file := "name,link\ndocs,https://docs.example.com\n"
+	events := []*ImportEvent{}
+	last, err := NewClient(server.URL, "token").ImportShortcuts(ctx, "csv", strings.NewReader(file), func(event *ImportEvent) {
+		events = append(events, event)
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, 0.5, events[0].WaitSeconds)
+	require.Equal(t, "docs", events[1].Name)
+	require.Equal(t, 1, last.Created)
+
+	// The import stopped before the done event, e.g. the instance restarted.
+	last, err = NewClient(server.URL, "stop").ImportShortcuts(ctx, "csv", strings.NewReader(file), nil)
+	require.Error(t, err)
+	require.Equal(t, 1, last.Processed)
+
+	_, err = NewClient(server.URL, "token").ImportShortcuts(ctx, "xml", strings.NewReader(file), nil)
+	var apiError *Error
+	require.True(t, errors.As(err, &apiError))
+	require.Equal(t, codes.Unknown, apiError.Code)
+	require.Equal(t, "unsupported import format", apiError.Error())
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// ImportEvent is a line of the progress of an import.
+type ImportEvent struct {
+	// Type is "row" for an imported row, "throttled" when the import waits for the quota, and "done" at the end.
+	Type        string  `json:"type"`
+	Row         int     `json:"row"`
+	Name        string  `json:"name"`
+	Error       string  `json:"error"`
+	WaitSeconds float64 `json:"waitSeconds"`
+	Processed   int     `json:"processed"`
+	Total       int     `json:"total"`
+	Created     int     `json:"created"`
+	Failed      int     `json:"failed"`
+}
+
+// ImportShortcuts creates the shortcuts of the file in the format, "csv" or "netscape", and calls onEvent with the
+// progress. The import is throttled by the quota of the user, so it isn't bounded by the timeout of the client.
+// It returns the last event, or an error if the import stopped before it was done.
+func (c *Client) ImportShortcuts(ctx context.Context, format string, file io.Reader, onEvent func(*ImportEvent)) (*ImportEvent, error) {
+	urlStr := c.baseURL + "/api/v1/import?" + url.Values{"format": {format}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, urlStr, file)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+	httpClient := *c.httpClient
+	httpClient.Timeout = 0
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request /api/v1/import")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		responseBytes, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the response of /api/v1/import")
+		}
+		return nil, parseError(resp.StatusCode, responseBytes)
+	}
+	var last *ImportEvent
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		event := &ImportEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return last, errors.Wrap(err, "failed to unmarshal the import event")
+		}
+		last = event
+		if onEvent != nil {
+			onEvent(event)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return last, errors.Wrap(err, "failed to read the import progress")
+	}
+	if last == nil || last.Type != "done" {
+		return last, errors.New("the import stopped before it was done")
+	}
+	return last, nil
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+// resolutionSnapshotSignaturePrefix versions the payload signed by the resolution snapshots.
+const resolutionSnapshotSignaturePrefix = "slash-snapshot-v1"
+
+// ResolutionCache is the links of the shortcuts of a user cached by a client, to resolve them offline.
+// It's updated with the deltas of the resolution snapshots, and keeps the signature of the instance.
+type ResolutionCache struct {
+	Version     string            `json:"version"`
+	Links       map[string]string `json:"links"`
+	Signature   []byte            `json:"signature"`
+	PublicKey   []byte            `json:"publicKey"`
+	UpdatedTime time.Time         `json:"updatedTime"`
+}
+
+// GetResolutionSnapshot returns the resolution snapshot of the user, as a delta from the version when possible.
+func (c *Client) GetResolutionSnapshot(ctx context.Context, sinceVersion string) (*v1pb.ResolutionSnapshot, error) {
+	response := &v1pb.ResolutionSnapshot{}
+	var query url.Values
+	if sinceVersion != "" {
+		query = url.Values{"sinceVersion": {sinceVersion}}
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/shortcuts:snapshot", query, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Apply updates the cache with the snapshot, a full one or a delta from the version of the cache,
+// and verifies the result with the public key. The cache is unchanged on error.
+func (r *ResolutionCache) Apply(snapshot *v1pb.ResolutionSnapshot, publicKey []byte) error {
+	links := map[string]string{}
+	if snapshot.BaseVersion != "" {
+		if snapshot.BaseVersion != r.Version {
+			return errors.Errorf("the snapshot is a delta from version %s, not from the cached version %s", snapshot.BaseVersion, r.Version)
+		}
+		maps.Copy(links, r.Links)
+		for _, name := range snapshot.RemovedNames {
+			delete(links, name)
+		}
+	}
+	maps.Copy(links, snapshot.Links)
+
+	updated := &ResolutionCache{
+		Version:     snapshot.Version,
+		Links:       links,
+		Signature:   snapshot.Signature,
+		PublicKey:   snapshot.PublicKey,
+		UpdatedTime: snapshot.CreateTime.AsTime(),
+	}
+	if err := updated.Verify(publicKey); err != nil {
+		return err
+	}
+	*r = *updated
+	return nil
+}
+
+// Verify checks that the links are the ones signed by the instance with the public key.
+func (r *ResolutionCache) Verify(publicKey []byte) error {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return errors.New("invalid public key")
+	}
+	if !bytes.Equal(publicKey, r.PublicKey) {
+		return errors.New("the snapshot is signed with another key")
+	}
+	version, payload := buildResolutionSnapshotPayload(r.Links)
+	if version != r.Version {
+		return errors.Errorf("the links don't match the version %s", r.Version)
+	}
+	if !ed25519.Verify(publicKey, payload, r.Signature) {
+		return errors.New("invalid signature of the snapshot")
+	}
+	return nil
+}
+
+// buildResolutionSnapshotPayload returns the version of the links and the payload signed by the instance.
+func buildResolutionSnapshotPayload(links map[string]string) (string, []byte) {
+	var builder strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(links)) {
+		builder.WriteString(name)
+		builder.WriteByte('\t')
+		builder.WriteString(links[name])
+		builder.WriteByte('\n')
+	}
+	hash := sha256.Sum256([]byte(builder.String()))
+	version := hex.EncodeToString(hash[:16])
+	return version, []byte(resolutionSnapshotSignaturePrefix + "\n" + version + "\n" + builder.String())
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+func newSignedSnapshot(t *testing.T, privateKey ed25519.PrivateKey, links map[string]string) *v1pb.ResolutionSnapshot {
+	t.Helper()
+	version, payload := buildResolutionSnapshotPayload(links)
+	return &v1pb.ResolutionSnapshot{
+		Version:    version,
+		Links:      links,
+		Signature:  ed25519.Sign(privateKey, payload),
+		PublicKey:  privateKey.Public().(ed25519.PublicKey),
+		CreateTime: timestamppb.New(time.Unix(1700000000, 0)),
+	}
+}
+
+func TestResolutionCache(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	cache := &ResolutionCache{}
+	full := newSignedSnapshot(t, privateKey, map[string]string{
+		"docs": "https://docs.example.com",
+		"wiki": "https://wiki.example.com",
+	})
+	require.NoError(t, cache.Apply(full, publicKey))
+	require.Equal(t, full.Version, cache.Version)
+	require.Len(t, cache.Links, 2)
+
+	// The delta changes docs, removes wiki and adds jira, so its links are all the links.
+	delta := newSignedSnapshot(t, privateKey, map[string]string{
+		"docs": "https://docs.example.com/v2",
+		"jira": "https://jira.example.com",
+	})
+	delta.BaseVersion = full.Version
+	delta.RemovedNames = []string{"wiki"}
+	require.NoError(t, cache.Apply(delta, publicKey))
+	require.Equal(t, map[string]string{"docs": "https://docs.example.com/v2", "jira": "https://jira.example.com"}, cache.Links)
+	require.NoError(t, cache.Verify(publicKey))
+
+	// A delta from another version isn't applied.
+	require.Error(t, cache.Apply(delta, publicKey))
+	require.Equal(t, delta.Version, cache.Version)
+
+	// The tampered cache and the snapshots of another key are rejected.
+	cache.Links["docs"] = "https://evil.example.com"
+	require.Error(t, cache.Verify(publicKey))
+	otherPublicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	require.Error(t, (&ResolutionCache{}).Apply(full, otherPublicKey))
+}
@@ -1,31 +1,107 @@
 import classNames from "classnames";
 import { useEffect, useState } from "react";
+import toast from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { shortcutServiceClient } from "@/grpcweb";
-import { GetShortcutAnalyticsResponse } from "@/types/proto/api/v1/shortcut_service";
+import {
+  GetShortcutAnalyticsRequest_Interval,
+  GetShortcutAnalyticsResponse,
+  SharedShortcutAnalytics,
+} from "@/types/proto/api/v1/shortcut_service";
 import Icon from "./Icon";
 
 interface Props {
   shortcutId: number;
+  // The token of the analytics share link, to view the analytics without an account.
+  shareToken?: string;
   className?: string;
+  onSharedAnalyticsLoad?: (sharedAnalytics: SharedShortcutAnalytics) => void;
 }
 
 const AnalyticsView: React.FC<Props> = (props: Props) => {
-  const { shortcutId, className } = props;
+  const { shortcutId, shareToken, className, onSharedAnalyticsLoad } = props;
   const { t } = useTranslation();
   const [analytics, setAnalytics] = useState<GetShortcutAnalyticsResponse | null>(null);
-  const [selectedDeviceTab, setSelectedDeviceTab] = useState<"os" | "browser">("browser");
+  const [selectedDeviceTab, setSelectedDeviceTab] = useState<"os" | "browser" | "country">("browser");
+  const [interval, setInterval] = useState<GetShortcutAnalyticsRequest_Interval>(GetShortcutAnalyticsRequest_Interval.DAY);
+  const maxTimeseriesCount = Math.max(1, ...(analytics?.timeseries.map((item) => item.count) || []));
+  const variantViewCount = Math.max(1, analytics?.variants.reduce((count, variant) => count + variant.count, 0) || 0);
 
   useEffect(() => {
-    shortcutServiceClient.getShortcutAnalytics({ id: shortcutId }).then((response) => {
+    if (shareToken) {
+      shortcutServiceClient
+        .getSharedShortcutAnalytics({ token: shareToken, interval })
+        .then((response) => {
+          setAnalytics(response.analytics || null);
+          onSharedAnalyticsLoad?.(response);
+        })
+        .catch((error: any) => {
+          toast.error(error.details);
+        });
+      return;
+    }
+    shortcutServiceClient.getShortcutAnalytics({ id: shortcutId, interval }).then((response) => {
       setAnalytics(response);
     });
-  }, []);
+  }, [interval]);
 
   return (
     <div className={classNames("relative w-full", className)}>
       {analytics ? (
         <>
+          {analytics.clickGoalProgress && (
+            <div className="w-full mb-4 px-2">
+              <div className="w-full flex flex-row justify-between items-center dark:text-gray-500">
+                <span>Click goal</span>
+                <span className="text-sm text-gray-500">
+                  {analytics.clickGoalProgress.viewCount} / {analytics.clickGoalProgress.target}
+                  {analytics.clickGoalProgress.reachedTime && (
+                    <span className="ml-1 text-green-600">(reached {analytics.clickGoalProgress.reachedTime.toLocaleDateString()})</span>
+                  )}
+                </span>
+              </div>
+              <div className="w-full h-2 mt-1 rounded-full bg-gray-200 dark:bg-zinc-800 overflow-hidden">
+                <div
+                  className="h-full bg-blue-600"
+                  style={{
+                    width: `${Math.min(100, (analytics.clickGoalProgress.viewCount / analytics.clickGoalProgress.target) * 100)}%`,
+                  }}
+                />
+              </div>
+            </div>
+          )}
+          <div className="w-full mb-4">
+            <div className="w-full h-8 px-2 flex flex-row justify-between items-center">
+              <span className="dark:text-gray-500">Views</span>
+              <div>
+                {[GetShortcutAnalyticsRequest_Interval.DAY, GetShortcutAnalyticsRequest_Interval.WEEK].map((item, index) => (
+                  <span key={item}>
+                    {index > 0 && <span className="text-gray-200 font-mono mx-1 dark:text-gray-500">/</span>}
+                    <button
+                      className={`whitespace-nowrap border-b-2 px-1 text-sm font-medium ${
+                        interval === item
+                          ? "border-blue-600 text-blue-600"
+                          : "border-transparent text-gray-500 hover:border-gray-300 hover:text-gray-700 dark:hover:border-zinc-700"
+                      }`}
+                      onClick={() => setInterval(item)}
+                    >
+                      {item === GetShortcutAnalyticsRequest_Interval.DAY ? "Daily" : "Weekly"}
+                    </button>
+                  </span>
+                ))}
+              </div>
+            </div>
+            <div className="w-full h-24 mt-1 px-2 flex flex-row justify-start items-end gap-px">
+              {analytics.timeseries.map((item) => (
+                <div
+                  key={item.startTime?.getTime()}
+                  className="grow min-w-[2px] bg-blue-600 opacity-80 hover:opacity-100 rounded-t-sm"
+                  style={{ height: `${(item.count / maxTimeseriesCount) * 100}%` }}
+                  title={`${item.startTime?.toLocaleDateString()}: ${item.count}`}
+                />
+              ))}
+            </div>
+          </div>
           <div className="w-full">
             <p className="w-full h-8 px-2 dark:text-gray-500">{t("analytics.top-sources")}</p>
             <div className="w-full mt-1 overflow-hidden shadow ring-1 ring-black ring-opacity-5 rounded-lg dark:ring-zinc-800">
@@ -60,6 +136,56 @@ const AnalyticsView: React.FC<Props> = (props: Props) => {
             </div>
           </div>
 
+          {analytics.users.length > 0 && (
+            <div className="w-full">
+              <p className="w-full h-8 px-2 dark:text-gray-500">Users</p>
+              <div className="w-full mt-1 overflow-hidden shadow ring-1 ring-black ring-opacity-5 rounded-lg dark:ring-zinc-800">
+                <div className="w-full divide-y divide-gray-300 dark:divide-zinc-700">
+                  <div className="w-full flex flex-row justify-between items-center">
+                    <span className="py-2 px-2 text-left font-semibold text-sm text-gray-500">User</span>
+                    <span className="py-2 pr-2 text-right font-semibold text-sm text-gray-500">{t("analytics.visitors")}</span>
+                  </div>
+                  <div className="w-full divide-y divide-gray-200 dark:divide-zinc-800">
+                    {analytics.users.map((user) => (
+                      <div key={user.name} className="w-full flex flex-row justify-between items-center">
+                        <span className="whitespace-nowrap py-2 px-2 text-sm truncate text-gray-900 dark:text-gray-500">
+                          {user.name || "Not signed in"}
+                        </span>
+                        <span className="whitespace-nowrap py-2 pr-2 text-sm text-gray-500 text-right shrink-0">{user.count}</span>
+                      </div>
+                    ))}
+                  </div>
+                </div>
+              </div>
+            </div>
+          )}
+
+          {analytics.variants.length > 0 && (
+            <div className="w-full">
+              <p className="w-full h-8 px-2 dark:text-gray-500">Variants</p>
+              <div className="w-full mt-1 overflow-hidden shadow ring-1 ring-black ring-opacity-5 rounded-lg dark:ring-zinc-800">
+                <div className="w-full divide-y divide-gray-300 dark:divide-zinc-700">
+                  <div className="w-full flex flex-row justify-between items-center">
+                    <span className="py-2 px-2 text-left font-semibold text-sm text-gray-500">Variant</span>
+                    <span className="py-2 pr-2 text-right font-semibold text-sm text-gray-500">{t("analytics.visitors")}</span>
+                  </div>
+                  <div className="w-full divide-y divide-gray-200 dark:divide-zinc-800">
+                    {analytics.variants.map((variant) => (
+                      <div key={variant.name} className="w-full flex flex-row justify-between items-center">
+                        <span className="whitespace-nowrap py-2 px-2 text-sm truncate text-gray-900 dark:text-gray-500">
+                          {variant.name || "No variant"}
+                        </span>
+                        <span className="whitespace-nowrap py-2 pr-2 text-sm text-gray-500 text-right shrink-0">
+                          {variant.count} ({Math.round((variant.count / variantViewCount) * 100)}%)
+                        </span>
+                      </div>
+                    ))}
+                  </div>
+                </div>
+              </div>
+            </div>
+          )}
+
           <div className="w-full">
             <div className="w-full h-8 px-2 flex flex-row justify-between items-center">
               <span className="dark:text-gray-500">{t("analytics.devices")}</span>
@@ -85,6 +211,17 @@ const AnalyticsView: React.FC<Props> = (props: Props) => {
                 >
                   OS
                 </button>
+                <span className="text-gray-200 font-mono mx-1 dark:text-gray-500">/</span>
+                <button
+                  className={`whitespace-nowrap border-b-2 px-1 text-sm font-medium ${
+                    selectedDeviceTab === "country"
+                      ? "border-blue-600 text-blue-600"
+                      : "border-transparent text-gray-500 hover:border-gray-300 hover:text-gray-700 dark:hover:border-zinc-700"
+                  }`}
+                  onClick={() => setSelectedDeviceTab("country")}
+                >
+                  Country
+                </button>
               </div>
             </div>
 
@@ -112,6 +249,29 @@ const AnalyticsView: React.FC<Props> = (props: Props) => {
                     ))}
                   </div>
                 </div>
+              ) : selectedDeviceTab === "country" ? (
+                <div className="w-full divide-y divide-gray-300 dark:divide-zinc-700">
+                  <div className="w-full flex flex-row justify-between items-center">
+                    <span className="py-2 px-2 text-left text-sm font-semibold text-gray-500">Country</span>
+                    <span className="py-2 pr-2 text-right text-sm font-semibold text-gray-500">{t("analytics.visitors")}</span>
+                  </div>
+                  <div className="w-full divide-y divide-gray-200 dark:divide-zinc-800">
+                    {analytics.countries.length === 0 && (
+                      <div className="w-full flex flex-row justify-center items-center py-6 text-gray-400">
+                        <Icon.PackageOpen className="w-6 h-auto" />
+                        <p className="ml-2">No data found.</p>
+                      </div>
+                    )}
+                    {analytics.countries.map((country) => (
+                      <div key={country.name} className="w-full flex flex-row justify-between items-center">
+                        <span className="whitespace-nowrap py-2 px-2 text-sm text-gray-900 truncate dark:text-gray-500">
+                          {country.name || "Unknown"}
+                        </span>
+                        <span className="whitespace-nowrap py-2 pr-2 text-sm text-gray-500 text-right shrink-0">{country.count}</span>
+                      </div>
+                    ))}
+                  </div>
+                </div>
               ) : (
                 <div className="w-full divide-y divide-gray-300 dark:divide-zinc-700">
                   <div className="w-full flex flex-row justify-between items-center">
@@ -5,16 +5,19 @@ import { useState } from "react";
 import toast from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { Link } from "react-router-dom";
-import { absolutifyLink } from "@/helpers/utils";
+import { absolutifyLink, getShortcutPath } from "@/helpers/utils";
 import useNavigateTo from "@/hooks/useNavigateTo";
 import useResponsiveWidth from "@/hooks/useResponsiveWidth";
 import { useCollectionStore, useShortcutStore, useUserStore } from "@/stores";
 import { Collection } from "@/types/proto/api/v1/collection_service";
+import { State, Visibility } from "@/types/proto/api/v1/common";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 import { showCommonDialog } from "./Alert";
 import CreateCollectionDialog from "./CreateCollectionDrawer";
 import Icon from "./Icon";
+import ShareCollectionDialog from "./ShareCollectionDialog";
 import ShortcutView from "./ShortcutView";
+import TransferOwnershipDialog from "./TransferOwnershipDialog";
 import Dropdown from "./common/Dropdown";
 
 interface Props {
@@ -31,10 +34,13 @@ const CollectionView = (props: Props) => {
   const collectionStore = useCollectionStore();
   const shortcutList = useShortcutStore().getShortcutList();
   const [showEditDialog, setShowEditDialog] = useState<boolean>(false);
+  const [showShareDialog, setShowShareDialog] = useState<boolean>(false);
+  const [showTransferDialog, setShowTransferDialog] = useState<boolean>(false);
   const shortcuts = collection.shortcutIds
     .map((shortcutId) => shortcutList.find((shortcut) => shortcut?.id === shortcutId))
     .filter(Boolean) as any as Shortcut[];
   const showAdminActions = currentUser.id === collection.creatorId;
+  const isArchived = collection.state === State.INACTIVE;
 
   const handleCopyCollectionLink = () => {
     copy(absolutifyLink(`/c/${collection.name}`));
@@ -52,12 +58,22 @@ const CollectionView = (props: Props) => {
     });
   };
 
+  const handleArchiveCollectionButtonClick = async () => {
+    if (isArchived) {
+      await collectionStore.unarchiveCollection(collection.id);
+      toast.success("Collection unarchived.");
+    } else {
+      await collectionStore.archiveCollection(collection.id);
+      toast.success("Collection archived.");
+    }
+  };
+
   const handleShortcutClick = (shortcut: Shortcut) => {
     navigateTo(`/shortcut/${shortcut.id}`);
   };
 
   const handleOpenAllShortcutsButtonClick = () => {
-    shortcuts.forEach((shortcut: Shortcut) => window.open(`/s/${shortcut.name}`));
+    shortcuts.forEach((shortcut: Shortcut) => window.open(absolutifyLink(getShortcutPath(shortcut.name, collection.name))));
   };
 
   return (
@@ -72,6 +88,9 @@ const CollectionView = (props: Props) => {
               <span className="ml-1 leading-6 text-gray-500 dark:text-gray-400" onClick={handleCopyCollectionLink}>
                 (c/{collection.name})
               </span>
+              {isArchived && (
+                <span className="ml-1 text-xs px-1 rounded border text-gray-500 dark:text-gray-400 dark:border-zinc-700">Archived</span>
+              )}
             </div>
             <p className="text-sm text-gray-500">{collection.description}</p>
           </div>
@@ -96,7 +115,7 @@ const CollectionView = (props: Props) => {
                     <Icon.MoreVertical className="w-4 h-auto" />
                   </button>
                 }
-                actionsClassName="!w-28 text-sm"
+                actionsClassName="!w-40 text-sm"
                 actions={
                   <>
                     <button
@@ -105,6 +124,34 @@ const CollectionView = (props: Props) => {
                     >
                       <Icon.Edit className="w-4 h-auto mr-2" /> {t("common.edit")}
                     </button>
+                    {collection.visibility === Visibility.WORKSPACE && (
+                      <button
+                        className="w-full px-2 flex flex-row justify-start items-center text-left dark:text-gray-400 leading-8 cursor-pointer rounded hover:bg-gray-100 dark:hover:bg-zinc-800 disabled:cursor-not-allowed disabled:bg-gray-100 disabled:opacity-60"
+                        onClick={() => setShowShareDialog(true)}
+                      >
+                        <Icon.UserPlus className="w-4 h-auto mr-2" /> Share with guests
+                      </button>
+                    )}
+                    <button
+                      className="w-full px-2 flex flex-row justify-start items-center text-left dark:text-gray-400 leading-8 cursor-pointer rounded hover:bg-gray-100 dark:hover:bg-zinc-800 disabled:cursor-not-allowed disabled:bg-gray-100 disabled:opacity-60"
+                      onClick={() => setShowTransferDialog(true)}
+                    >
+                      <Icon.ArrowRightLeft className="w-4 h-auto mr-2" /> Transfer
+                    </button>
+                    <button
+                      className="w-full px-2 flex flex-row justify-start items-center text-left dark:text-gray-400 leading-8 cursor-pointer rounded hover:bg-gray-100 dark:hover:bg-zinc-800 disabled:cursor-not-allowed disabled:bg-gray-100 disabled:opacity-60"
+                      onClick={() => handleArchiveCollectionButtonClick()}
+                    >
+                      {isArchived ? (
+                        <>
+                          <Icon.ArchiveRestore className="w-4 h-auto mr-2" /> Unarchive
+                        </>
+                      ) : (
+                        <>
+                          <Icon.Archive className="w-4 h-auto mr-2" /> Archive
+                        </>
+                      )}
+                    </button>
                     <button
                       className="w-full px-2 flex flex-row justify-start items-center text-left text-red-600 dark:text-gray-400 leading-8 cursor-pointer rounded hover:bg-gray-100 dark:hover:bg-zinc-800 disabled:cursor-not-allowed disabled:bg-gray-100 disabled:opacity-60"
                       onClick={() => {
@@ -141,6 +188,17 @@ const CollectionView = (props: Props) => {
           onConfirm={() => setShowEditDialog(false)}
         />
       )}
+
+      {showShareDialog && <ShareCollectionDialog collection={collection} onClose={() => setShowShareDialog(false)} />}
+
+      {showTransferDialog && (
+        <TransferOwnershipDialog
+          name={collection.name}
+          creatorId={collection.creatorId}
+          onTransfer={(userId) => collectionStore.transferCollection(collection.id, userId)}
+          onClose={() => setShowTransferDialog(false)}
+        />
+      )}
     </>
   );
 };
@@ -0,0 +1,171 @@
+import { Input, Modal, ModalDialog } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+import useDebounce from "react-use/lib/useDebounce";
+import { searchServiceClient, shortcutServiceClient } from "@/grpcweb";
+import useNavigateTo from "@/hooks/useNavigateTo";
+import { useViewStore, useWorkspaceStore } from "@/stores";
+import { SearchResult, SearchResult_Highlight, SearchResult_Type } from "@/types/proto/api/v1/search_service";
+import Icon from "./Icon";
+
+// semanticSearchLimit is the max number of the shortcuts of the semantic search added after the results of the search.
+const semanticSearchLimit = 5;
+
+const getSearchResultName = (result: SearchResult) => {
+  return result.shortcut?.name || result.collection?.name || result.tag?.name || "";
+};
+
+const HighlightView = ({ highlight }: { highlight?: SearchResult_Highlight }) => {
+  return (
+    <>
+      {highlight?.segments.map((segment, index) =>
+        segment.matched ? (
+          <mark key={index} className="bg-yellow-200 dark:bg-yellow-700 dark:text-gray-200 rounded-sm">
+            {segment.text}
+          </mark>
+        ) : (
+          <span key={index}>{segment.text}</span>
+        ),
+      )}
+    </>
+  );
+};
+
+const CommandPalette: React.FC = () => {
+  const { t } = useTranslation();
+  const navigateTo = useNavigateTo();
+  const viewStore = useViewStore();
+  const workspaceStore = useWorkspaceStore();
+  const [open, setOpen] = useState<boolean>(false);
+  const [query, setQuery] = useState<string>("");
+  const [results, setResults] = useState<SearchResult[]>([]);
+  const [selectedIndex, setSelectedIndex] = useState<number>(0);
+
+  useEffect(() => {
+    const handleKeyDown = (event: KeyboardEvent) => {
+      if ((event.metaKey || event.ctrlKey) && event.key === "k") {
+        event.preventDefault();
+        setOpen((open) => !open);
+      }
+    };
+    document.addEventListener("keydown", handleKeyDown);
+    return () => document.removeEventListener("keydown", handleKeyDown);
+  }, []);
+
+  useDebounce(
+    () => {
+      if (!query.trim()) {
+        setResults([]);
+        return;
+      }
+      (async () => {
+        const { results } = await searchServiceClient.search({ query });
+        if (workspaceStore.setting.semanticSearchEnabled) {
+          // The shortcuts close in meaning are added after the ones matching the words, without highlights.
+          try {
+            const { results: semanticResults } = await shortcutServiceClient.semanticSearchShortcuts({ query, limit: semanticSearchLimit });
+            for (const { shortcut, score } of semanticResults) {
+              if (!results.some((result) => result.shortcut?.id === shortcut?.id)) {
+                results.push(SearchResult.fromPartial({ type: SearchResult_Type.SHORTCUT, score, shortcut }));
+              }
+            }
+          } catch {
+            // The results of the search are shown when the embedding provider fails.
+          }
+        }
+        setResults(results);
+        setSelectedIndex(0);
+      })();
+    },
+    200,
+    [query],
+  );
+
+  const handleClose = () => {
+    setOpen(false);
+    setQuery("");
+    setResults([]);
+  };
+
+  const handleSelect = (result: SearchResult) => {
+    handleClose();
+    if (result.shortcut) {
+      navigateTo(`/shortcut/${result.shortcut.id}`);
+    } else if (result.collection) {
+      navigateTo(`/c/${result.collection.name}`);
+    } else if (result.tag) {
+      viewStore.setFilter({ tag: result.tag.name });
+      navigateTo("/shortcuts");
+    }
+  };
+
+  const handleInputKeyDown = (event: React.KeyboardEvent) => {
+    if (event.key === "ArrowDown") {
+      event.preventDefault();
+      setSelectedIndex(Math.min(selectedIndex + 1, results.length - 1));
+    } else if (event.key === "ArrowUp") {
+      event.preventDefault();
+      setSelectedIndex(Math.max(selectedIndex - 1, 0));
+    } else if (event.key === "Enter" && results[selectedIndex]) {
+      event.preventDefault();
+      handleSelect(results[selectedIndex]);
+    }
+  };
+
+  return (
+    <Modal open={open} onClose={handleClose}>
+      <ModalDialog className="w-full max-w-lg" layout="center">
+        <Input
+          autoFocus
+          startDecorator={<Icon.Search className="w-4 h-auto" />}
+          placeholder={t("common.search")}
+          value={query}
+          onChange={(e) => setQuery(e.target.value)}
+          onKeyDown={handleInputKeyDown}
+        />
+        {results.length > 0 && (
+          <div className="w-full max-h-96 overflow-y-auto flex flex-col justify-start items-start">
+            {results.map((result, index) => {
+              const nameHighlight = result.highlights.find((highlight) => highlight.field === "name");
+              const otherHighlight = result.highlights.find((highlight) => highlight.field !== "name");
+              return (
+                <div
+                  key={`${result.type}-${getSearchResultName(result)}`}
+                  className={`w-full flex flex-row justify-start items-center gap-2 px-2 py-1.5 rounded-md cursor-pointer ${
+                    index === selectedIndex ? "bg-gray-100 dark:bg-zinc-800" : ""
+                  }`}
+                  onMouseEnter={() => setSelectedIndex(index)}
+                  onClick={() => handleSelect(result)}
+                >
+                  {result.type === SearchResult_Type.SHORTCUT &&
+                    (result.highlights.length > 0 ? (
+                      <Icon.Link className="w-4 h-auto shrink-0 text-gray-500" />
+                    ) : (
+                      <Icon.Sparkles className="w-4 h-auto shrink-0 text-gray-500" />
+                    ))}
+                  {result.type === SearchResult_Type.COLLECTION && <Icon.LibrarySquare className="w-4 h-auto shrink-0 text-gray-500" />}
+                  {result.type === SearchResult_Type.TAG && <Icon.Tag className="w-4 h-auto shrink-0 text-gray-500" />}
+                  <div className="flex flex-col justify-start items-start truncate dark:text-gray-400">
+                    <span className="truncate">
+                      {nameHighlight ? <HighlightView highlight={nameHighlight} /> : getSearchResultName(result)}
+                      {result.tag && <span className="ml-1 text-sm text-gray-400">({result.tag.shortcutCount})</span>}
+                    </span>
+                    {otherHighlight ? (
+                      <span className="text-sm text-gray-500 truncate">
+                        <HighlightView highlight={otherHighlight} />
+                      </span>
+                    ) : (
+                      result.shortcut?.title && <span className="text-sm text-gray-500 truncate">{result.shortcut.title}</span>
+                    )}
+                  </div>
+                </div>
+              );
+            })}
+          </div>
+        )}
+      </ModalDialog>
+    </Modal>
+  );
+};
+
+export default CommandPalette;
@@ -1,10 +1,12 @@
-import { Button, Input, Modal, ModalDialog, Radio, RadioGroup } from "@mui/joy";
+import { Button, Checkbox, Input, Modal, ModalDialog, Radio, RadioGroup } from "@mui/joy";
+import copy from "copy-to-clipboard";
 import { useState } from "react";
 import { toast } from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { userServiceClient } from "@/grpcweb";
 import useLoading from "@/hooks/useLoading";
 import { useUserStore } from "@/stores";
+import { Role } from "@/types/proto/api/v1/user_service";
 import Icon from "./Icon";
 
 interface Props {
@@ -27,20 +29,33 @@ const expirationOptions = [
   },
 ];
 
+// The scopes an access token can be restricted to, where the write scopes include the read ones.
+const scopeOptions = [
+  { label: "Read shortcuts", value: "shortcuts:read" },
+  { label: "Write shortcuts", value: "shortcuts:write" },
+  { label: "Read collections", value: "collections:read" },
+  { label: "Write collections", value: "collections:write" },
+  { label: "Admin", value: "admin", adminOnly: true },
+];
+
 interface State {
   description: string;
   expiration: number;
+  scopes: string[];
 }
 
 const CreateAccessTokenDialog: React.FC<Props> = (props: Props) => {
   const { onClose, onConfirm } = props;
   const { t } = useTranslation();
   const currentUser = useUserStore().getCurrentUser();
-  const [state, setState] = useState({
+  const [state, setState] = useState<State>({
     description: "",
     expiration: 3600 * 8,
+    scopes: [],
   });
   const requestState = useLoading(false);
+  // The created access token is only shown once, as only its hash is stored.
+  const [createdAccessToken, setCreatedAccessToken] = useState<string>("");
 
   const setPartialState = (partialState: Partial<State>) => {
     setState({
@@ -61,6 +76,12 @@ const CreateAccessTokenDialog: React.FC<Props> = (props: Props) => {
     });
   };
 
+  const handleScopeChange = (scope: string, checked: boolean) => {
+    setPartialState({
+      scopes: checked ? [...state.scopes, scope] : state.scopes.filter((s) => s !== scope),
+    });
+  };
+
   const handleSaveBtnClick = async () => {
     if (!state.description) {
       toast.error("Description is required");
@@ -68,22 +89,57 @@ const CreateAccessTokenDialog: React.FC<Props> = (props: Props) => {
     }
 
     try {
-      await userServiceClient.createUserAccessToken({
+      const { accessToken } = await userServiceClient.createUserAccessToken({
         id: currentUser.id,
         description: state.description,
         expiresAt: state.expiration ? new Date(Date.now() + state.expiration * 1000) : undefined,
+        scopes: state.scopes,
       });
 
       if (onConfirm) {
         onConfirm();
       }
-      onClose();
+      setCreatedAccessToken(accessToken);
     } catch (error: any) {
       console.error(error);
       toast.error(error.details);
     }
   };
 
+  const handleCopyButtonClick = () => {
+    copy(createdAccessToken);
+    toast.success("Access token copied to clipboard");
+  };
+
+  if (createdAccessToken) {
+    return (
+      <Modal open={true}>
+        <ModalDialog>
+          <div className="flex flex-row justify-between items-center w-80">
+            <span className="text-lg font-medium">Access Token Created</span>
+            <Button variant="plain" onClick={onClose}>
+              <Icon.X className="w-5 h-auto text-gray-600" />
+            </Button>
+          </div>
+          <div className="w-80 flex flex-col justify-start items-start gap-2">
+            <p className="text-sm text-gray-500 leading-tight">Copy the access token now, it won't be shown again.</p>
+            <div className="w-full flex flex-row justify-start items-center gap-1">
+              <Input className="grow font-mono" readOnly value={createdAccessToken} />
+              <Button color="neutral" variant="plain" onClick={handleCopyButtonClick}>
+                <Icon.Clipboard className="w-4 h-auto text-gray-500" />
+              </Button>
+            </div>
+            <div className="w-full flex flex-row justify-end items-center mt-2">
+              <Button color="primary" onClick={onClose}>
+                Done
+              </Button>
+            </div>
+          </div>
+        </ModalDialog>
+      </Modal>
+    );
+  }
+
   return (
     <Modal open={true}>
       <ModalDialog>
@@ -120,6 +176,22 @@ const CreateAccessTokenDialog: React.FC<Props> = (props: Props) => {
               </RadioGroup>
             </div>
           </div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">Scopes</span>
+            <div className="w-full grid grid-cols-2 gap-2">
+              {scopeOptions
+                .filter((option) => !option.adminOnly || currentUser.role === Role.ADMIN)
+                .map((option) => (
+                  <Checkbox
+                    key={option.value}
+                    label={option.label}
+                    checked={state.scopes.includes(option.value)}
+                    onChange={(e) => handleScopeChange(option.value, e.target.checked)}
+                  />
+                ))}
+            </div>
+            <p className="mt-2 text-sm text-gray-500 leading-tight">Without scopes, the token has full access to your account.</p>
+          </div>
           <div className="w-full flex flex-row justify-end items-center mt-4 space-x-2">
             <Button color="neutral" variant="plain" disabled={requestState.isLoading} loading={requestState.isLoading} onClick={onClose}>
               {t("common.cancel")}
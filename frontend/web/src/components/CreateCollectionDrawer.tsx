@@ -1,15 +1,17 @@
-import { Button, Checkbox, DialogActions, DialogContent, DialogTitle, Divider, Drawer, Input, ModalClose } from "@mui/joy";
+import { Button, Checkbox, DialogActions, DialogContent, DialogTitle, Divider, Drawer, Input, ModalClose, Option, Select } from "@mui/joy";
 import { isUndefined } from "lodash-es";
 import { useEffect, useState } from "react";
 import { toast } from "react-hot-toast";
 import { useTranslation } from "react-i18next";
+import { collectionServiceClient } from "@/grpcweb";
 import useLoading from "@/hooks/useLoading";
 import { useCollectionStore, useShortcutStore, useWorkspaceStore } from "@/stores";
-import { Collection } from "@/types/proto/api/v1/collection_service";
+import { Collection, CollectionTemplate } from "@/types/proto/api/v1/collection_service";
 import { Visibility } from "@/types/proto/api/v1/common";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 import Icon from "./Icon";
 import ShortcutView from "./ShortcutView";
+import TeamSelect from "./TeamSelect";
 
 interface Props {
   collectionId?: number;
@@ -26,13 +28,16 @@ const CreateCollectionDrawer: React.FC<Props> = (props: Props) => {
   const { t } = useTranslation();
   const workspaceStore = useWorkspaceStore();
   const collectionStore = useCollectionStore();
-  const shortcutList = useShortcutStore().getShortcutList();
+  const shortcutStore = useShortcutStore();
+  const shortcutList = shortcutStore.getShortcutList();
   const [state, setState] = useState<State>({
     collectionCreate: Collection.fromPartial({
       visibility: Visibility.WORKSPACE,
     }),
   });
   const [selectedShortcuts, setSelectedShortcuts] = useState<Shortcut[]>([]);
+  const [collectionTemplates, setCollectionTemplates] = useState<CollectionTemplate[]>([]);
+  const [selectedTemplate, setSelectedTemplate] = useState<CollectionTemplate>();
   const isCreating = isUndefined(collectionId);
   const loadingState = useLoading(!isCreating);
   const requestState = useLoading(false);
@@ -65,6 +70,15 @@ const CreateCollectionDrawer: React.FC<Props> = (props: Props) => {
     }
   }, []);
 
+  useEffect(() => {
+    if (!isCreating) {
+      return;
+    }
+    collectionServiceClient.listCollectionTemplates({}).then(({ templates }) => {
+      setCollectionTemplates(templates);
+    });
+  }, []);
+
   useEffect(() => {
     (async () => {
       if (collectionId) {
@@ -116,6 +130,28 @@ const CreateCollectionDrawer: React.FC<Props> = (props: Props) => {
   };
 
   const handleSaveBtnClick = async () => {
+    if (selectedTemplate) {
+      if (!state.collectionCreate.name) {
+        toast.error("Please fill in required fields.");
+        return;
+      }
+
+      try {
+        await collectionStore.createCollectionFromTemplate(selectedTemplate.id, state.collectionCreate);
+        // The template creates the missing shortcuts of the collection.
+        await shortcutStore.fetchShortcutList();
+        if (onConfirm) {
+          onConfirm();
+        } else {
+          onClose();
+        }
+      } catch (error: any) {
+        console.error(error);
+        toast.error(error.details);
+      }
+      return;
+    }
+
     if (!state.collectionCreate.name || !state.collectionCreate.title) {
       toast.error("Please fill in required fields.");
       return;
@@ -134,9 +170,10 @@ const CreateCollectionDrawer: React.FC<Props> = (props: Props) => {
             title: state.collectionCreate.title,
             description: state.collectionCreate.description,
             visibility: state.collectionCreate.visibility,
+            teamId: state.collectionCreate.teamId,
             shortcutIds: selectedShortcuts.map((shortcut) => shortcut.id),
           },
-          ["name", "title", "description", "visibility", "shortcut_ids"],
+          ["name", "title", "description", "visibility", "team_id", "shortcut_ids"],
         );
       } else {
         await collectionStore.createCollection({
@@ -162,6 +199,25 @@ const CreateCollectionDrawer: React.FC<Props> = (props: Props) => {
       <ModalClose />
       <DialogContent className="w-full max-w-full">
         <div className="overflow-y-auto w-full mt-2 px-4 pb-4 sm:w-[24rem]">
+          {isCreating && collectionTemplates.length > 0 && (
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Template</span>
+              <Select
+                className="w-full"
+                placeholder="Start from scratch"
+                value={selectedTemplate?.id ?? null}
+                onChange={(_, value) => setSelectedTemplate(collectionTemplates.find((template) => template.id === value))}
+              >
+                <Option value={null}>Start from scratch</Option>
+                {collectionTemplates.map((template) => (
+                  <Option key={template.id} value={template.id}>
+                    {template.title}
+                  </Option>
+                ))}
+              </Select>
+              {selectedTemplate?.description && <p className="mt-1 text-sm text-gray-500">{selectedTemplate.description}</p>}
+            </div>
+          )}
           <div className="w-full flex flex-col justify-start items-start mb-3">
             <span className="mb-2">
               Name <span className="text-red-600">*</span>
@@ -177,13 +233,13 @@ const CreateCollectionDrawer: React.FC<Props> = (props: Props) => {
           </div>
           <div className="w-full flex flex-col justify-start items-start mb-3">
             <span className="mb-2">
-              Title <span className="text-red-600">*</span>
+              Title {!selectedTemplate && <span className="text-red-600">*</span>}
             </span>
             <div className="relative w-full">
               <Input
                 className="w-full"
                 type="text"
-                placeholder="A short title of your collection"
+                placeholder={selectedTemplate ? selectedTemplate.title : "A short title of your collection"}
                 value={state.collectionCreate.title}
                 onChange={handleTitleInputChange}
               />
@@ -215,46 +271,79 @@ const CreateCollectionDrawer: React.FC<Props> = (props: Props) => {
               }
             />
           </div>
+          <TeamSelect
+            visibility={state.collectionCreate.visibility}
+            teamId={state.collectionCreate.teamId}
+            onChange={(visibility, teamId) =>
+              setPartialState({
+                collectionCreate: Object.assign(state.collectionCreate, {
+                  visibility,
+                  teamId,
+                }),
+              })
+            }
+          />
           <Divider className="text-gray-500" />
-          <div className="w-full flex flex-col justify-start items-start mt-3 mb-3">
-            <p className="mb-2">
-              <span>Shortcuts</span>
-              <span className="opacity-60">({selectedShortcuts.length})</span>
-              {selectedShortcuts.length === 0 && <span className="ml-2 italic opacity-80 text-sm">(Select a shortcut first)</span>}
-            </p>
-            <div className="w-full py-1 px-px flex flex-row justify-start items-start flex-wrap overflow-hidden gap-2">
-              {selectedShortcuts.map((shortcut) => {
-                return (
-                  <ShortcutView
-                    key={shortcut.id}
-                    className="!w-auto select-none max-w-[40%] cursor-pointer bg-gray-100 shadow dark:bg-zinc-800 dark:border-zinc-700 dark:text-gray-400"
-                    shortcut={shortcut}
-                    onClick={() => {
-                      setSelectedShortcuts([...selectedShortcuts.filter((selectedShortcut) => selectedShortcut.id !== shortcut.id)]);
-                    }}
-                  />
-                );
-              })}
-              {unselectedShortcuts.map((shortcut) => {
-                return (
-                  <ShortcutView
-                    key={shortcut.id}
-                    className="!w-auto select-none max-w-[40%] border-dashed cursor-pointer"
-                    shortcut={shortcut}
-                    onClick={() => {
-                      setSelectedShortcuts([...selectedShortcuts, shortcut]);
-                    }}
-                  />
-                );
-              })}
-              {selectedShortcuts.length + unselectedShortcuts.length === 0 && (
-                <div className="w-full flex flex-row justify-center items-center text-gray-400">
-                  <Icon.PackageOpen className="w-6 h-auto" />
-                  <p className="ml-2">No shortcuts found.</p>
-                </div>
-              )}
+          {selectedTemplate ? (
+            <div className="w-full flex flex-col justify-start items-start mt-3 mb-3">
+              <p className="mb-2">
+                <span>Shortcuts</span>
+                <span className="opacity-60">({selectedTemplate.shortcuts.length})</span>
+              </p>
+              <p className="mb-2 text-sm text-gray-500">
+                The placeholders <code>{"{name}"}</code> and <code>{"{title}"}</code> are replaced by the name and the title of the
+                collection. The existing shortcuts are added as they are.
+              </p>
+              <div className="w-full flex flex-col justify-start items-start gap-1">
+                {selectedTemplate.shortcuts.map((shortcutTemplate) => (
+                  <div key={shortcutTemplate.name} className="w-full flex flex-row justify-start items-center text-sm truncate">
+                    <span className="font-mono dark:text-gray-400">{shortcutTemplate.name}</span>
+                    <span className="ml-2 text-gray-500 truncate">{shortcutTemplate.link}</span>
+                  </div>
+                ))}
+              </div>
             </div>
-          </div>
+          ) : (
+            <div className="w-full flex flex-col justify-start items-start mt-3 mb-3">
+              <p className="mb-2">
+                <span>Shortcuts</span>
+                <span className="opacity-60">({selectedShortcuts.length})</span>
+                {selectedShortcuts.length === 0 && <span className="ml-2 italic opacity-80 text-sm">(Select a shortcut first)</span>}
+              </p>
+              <div className="w-full py-1 px-px flex flex-row justify-start items-start flex-wrap overflow-hidden gap-2">
+                {selectedShortcuts.map((shortcut) => {
+                  return (
+                    <ShortcutView
+                      key={shortcut.id}
+                      className="!w-auto select-none max-w-[40%] cursor-pointer bg-gray-100 shadow dark:bg-zinc-800 dark:border-zinc-700 dark:text-gray-400"
+                      shortcut={shortcut}
+                      onClick={() => {
+                        setSelectedShortcuts([...selectedShortcuts.filter((selectedShortcut) => selectedShortcut.id !== shortcut.id)]);
+                      }}
+                    />
+                  );
+                })}
+                {unselectedShortcuts.map((shortcut) => {
+                  return (
+                    <ShortcutView
+                      key={shortcut.id}
+                      className="!w-auto select-none max-w-[40%] border-dashed cursor-pointer"
+                      shortcut={shortcut}
+                      onClick={() => {
+                        setSelectedShortcuts([...selectedShortcuts, shortcut]);
+                      }}
+                    />
+                  );
+                })}
+                {selectedShortcuts.length + unselectedShortcuts.length === 0 && (
+                  <div className="w-full flex flex-row justify-center items-center text-gray-400">
+                    <Icon.PackageOpen className="w-6 h-auto" />
+                    <p className="ml-2">No shortcuts found.</p>
+                  </div>
+                )}
+              </div>
+            </div>
+          )}
         </div>
       </DialogContent>
       <DialogActions>
@@ -1,4 +1,17 @@
-import { Button, DialogActions, DialogContent, DialogTitle, Divider, Drawer, Input, ModalClose } from "@mui/joy";
+import {
+  Button,
+  Checkbox,
+  DialogActions,
+  DialogContent,
+  DialogTitle,
+  Divider,
+  Drawer,
+  Input,
+  ModalClose,
+  Option,
+  Select,
+  Textarea,
+} from "@mui/joy";
 import { isUndefined } from "lodash-es";
 import { useState } from "react";
 import { toast } from "react-hot-toast";
@@ -8,7 +21,14 @@ import { workspaceServiceClient } from "@/grpcweb";
 import { absolutifyLink } from "@/helpers/utils";
 import useLoading from "@/hooks/useLoading";
 import { useWorkspaceStore } from "@/stores";
-import { IdentityProvider, IdentityProvider_Type, IdentityProviderConfig_OAuth2Config } from "@/types/proto/api/v1/workspace_service";
+import {
+  IdentityProvider,
+  IdentityProvider_Type,
+  IdentityProviderConfig,
+  IdentityProviderConfig_LDAPConfig,
+  IdentityProviderConfig_OAuth2Config,
+  IdentityProviderConfig_SAMLConfig,
+} from "@/types/proto/api/v1/workspace_service";
 
 interface Props {
   identityProvider?: IdentityProvider;
@@ -20,6 +40,33 @@ interface State {
   identityProviderCreate: IdentityProvider;
 }
 
+const getDefaultConfig = (type: IdentityProvider_Type): IdentityProviderConfig => {
+  if (type === IdentityProvider_Type.SAML) {
+    return {
+      saml: IdentityProviderConfig_SAMLConfig.fromPartial({
+        fieldMapping: {},
+      }),
+    };
+  }
+  if (type === IdentityProvider_Type.LDAP) {
+    return {
+      ldap: IdentityProviderConfig_LDAPConfig.fromPartial({
+        userFilter: "(uid=%s)",
+        fieldMapping: {
+          identifier: "mail",
+          displayName: "cn",
+        },
+      }),
+    };
+  }
+  return {
+    oauth2: IdentityProviderConfig_OAuth2Config.fromPartial({
+      scopes: [],
+      fieldMapping: {},
+    }),
+  };
+};
+
 const CreateIdentityProviderDrawer: React.FC<Props> = (props: Props) => {
   const { onClose, onConfirm, identityProvider } = props;
   const { t } = useTranslation();
@@ -29,16 +76,15 @@ const CreateIdentityProviderDrawer: React.FC<Props> = (props: Props) => {
       identityProvider || {
         id: uuidv4(),
         type: IdentityProvider_Type.OAUTH2,
-        config: {
-          oauth2: IdentityProviderConfig_OAuth2Config.fromPartial({
-            scopes: [],
-            fieldMapping: {},
-          }),
-        },
+        config: getDefaultConfig(IdentityProvider_Type.OAUTH2),
       },
     ),
   });
   const isCreating = isUndefined(identityProvider);
+  const isSAML = state.identityProviderCreate.type === IdentityProvider_Type.SAML;
+  const isLDAP = state.identityProviderCreate.type === IdentityProvider_Type.LDAP;
+  const config = state.identityProviderCreate.config;
+  const fieldMapping = (config?.oauth2 || config?.saml || config?.ldap)?.fieldMapping;
   const requestState = useLoading(false);
 
   const setPartialState = (partialState: Partial<State>) => {
@@ -56,6 +102,15 @@ const CreateIdentityProviderDrawer: React.FC<Props> = (props: Props) => {
     });
   };
 
+  const handleTypeChange = (type: IdentityProvider_Type) => {
+    setPartialState({
+      identityProviderCreate: Object.assign(state.identityProviderCreate, {
+        type,
+        config: getDefaultConfig(type),
+      }),
+    });
+  };
+
   const handleOAuth2ConfigChange = (e: React.ChangeEvent<HTMLInputElement>, field: string) => {
     if (!state.identityProviderCreate.config || !state.identityProviderCreate.config.oauth2) {
       return;
@@ -73,28 +128,68 @@ const CreateIdentityProviderDrawer: React.FC<Props> = (props: Props) => {
     });
   };
 
-  const handleFieldMappingChange = (e: React.ChangeEvent<HTMLInputElement>, field: string) => {
-    if (
-      !state.identityProviderCreate.config ||
-      !state.identityProviderCreate.config.oauth2 ||
-      !state.identityProviderCreate.config.oauth2.fieldMapping
-    ) {
+  const handleSAMLConfigChange = (e: React.ChangeEvent<HTMLInputElement | HTMLTextAreaElement>, field: string) => {
+    if (!state.identityProviderCreate.config || !state.identityProviderCreate.config.saml) {
       return;
     }
 
     setPartialState({
       identityProviderCreate: Object.assign(state.identityProviderCreate, {
         config: Object.assign(state.identityProviderCreate.config, {
-          oauth2: Object.assign(state.identityProviderCreate.config.oauth2, {
-            fieldMapping: Object.assign(state.identityProviderCreate.config.oauth2.fieldMapping, {
-              [field]: e.target.value,
-            }),
+          saml: Object.assign(state.identityProviderCreate.config.saml, {
+            [field]: e.target.value,
           }),
         }),
       }),
     });
   };
 
+  const handleLDAPConfigChange = (field: string, value: string | boolean) => {
+    if (!state.identityProviderCreate.config || !state.identityProviderCreate.config.ldap) {
+      return;
+    }
+
+    setPartialState({
+      identityProviderCreate: Object.assign(state.identityProviderCreate, {
+        config: Object.assign(state.identityProviderCreate.config, {
+          ldap: Object.assign(state.identityProviderCreate.config.ldap, {
+            [field]: value,
+          }),
+        }),
+      }),
+    });
+  };
+
+  const handleFieldMappingChange = (e: React.ChangeEvent<HTMLInputElement>, field: string) => {
+    if (!fieldMapping) {
+      return;
+    }
+
+    Object.assign(fieldMapping, {
+      [field]: e.target.value,
+    });
+    setPartialState({
+      identityProviderCreate: state.identityProviderCreate,
+    });
+  };
+
+  const onTest = async () => {
+    try {
+      const { ok, checks } = await workspaceServiceClient.testIdentityProvider({
+        identityProvider: state.identityProviderCreate,
+      });
+      if (ok) {
+        toast.success("Identity provider is reachable.");
+      } else {
+        const failedChecks = checks.filter((check) => !check.ok).map((check) => `${check.name}: ${check.message}`);
+        toast.error(failedChecks.join("\n"));
+      }
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
   const onSave = async () => {
     if (!state.identityProviderCreate.id || !state.identityProviderCreate.title) {
       toast.error("Please fill in required fields.");
@@ -151,127 +246,345 @@ const CreateIdentityProviderDrawer: React.FC<Props> = (props: Props) => {
               />
             </div>
           </div>
+          {isCreating && (
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Type</span>
+              <Select
+                className="w-full"
+                value={state.identityProviderCreate.type}
+                onChange={(_, type) => type && handleTypeChange(type)}
+              >
+                <Option value={IdentityProvider_Type.OAUTH2}>OAuth 2.0</Option>
+                <Option value={IdentityProvider_Type.SAML}>SAML 2.0</Option>
+                <Option value={IdentityProvider_Type.LDAP}>LDAP</Option>
+              </Select>
+            </div>
+          )}
           <Divider className="!mb-3" />
           <p className="font-medium mb-2">Identity provider information</p>
-          {isCreating && (
+          {isSAML ? (
             <p className="shadow-sm rounded-md py-1 px-2 bg-zinc-100 dark:bg-zinc-900 text-sm w-full mb-2 break-all">
-              <span className="opacity-60">Redirect URL</span>
+              <span className="opacity-60">Metadata URL</span>
+              <br />
+              <code>{absolutifyLink(`/api/v1/saml/${state.identityProviderCreate.id}/metadata`)}</code>
               <br />
-              <code>{absolutifyLink("/auth/callback")}</code>
+              <span className="opacity-60">Assertion consumer service URL</span>
+              <br />
+              <code>{absolutifyLink(`/api/v1/saml/${state.identityProviderCreate.id}/acs`)}</code>
             </p>
+          ) : (
+            isCreating && !isLDAP && (
+              <p className="shadow-sm rounded-md py-1 px-2 bg-zinc-100 dark:bg-zinc-900 text-sm w-full mb-2 break-all">
+                <span className="opacity-60">Redirect URL</span>
+                <br />
+                <code>{absolutifyLink("/auth/callback")}</code>
+              </p>
+            )
           )}
+          {isSAML && (
+            <>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">
+                  Entity ID <span className="text-red-600">*</span>
+                </span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="Entity ID (issuer) of the SAML identity provider"
+                    value={state.identityProviderCreate.config?.saml?.entityId}
+                    onChange={(e) => handleSAMLConfigChange(e, "entityId")}
+                  />
+                </div>
+              </div>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">
+                  SSO URL <span className="text-red-600">*</span>
+                </span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="Single sign-on URL with the HTTP-Redirect binding"
+                    value={state.identityProviderCreate.config?.saml?.ssoUrl}
+                    onChange={(e) => handleSAMLConfigChange(e, "ssoUrl")}
+                  />
+                </div>
+              </div>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">
+                  Certificate <span className="text-red-600">*</span>
+                </span>
+                <Textarea
+                  className="w-full font-mono"
+                  minRows={3}
+                  maxRows={8}
+                  placeholder="-----BEGIN CERTIFICATE-----"
+                  value={state.identityProviderCreate.config?.saml?.certificate}
+                  onChange={(e) => handleSAMLConfigChange(e, "certificate")}
+                />
+              </div>
+            </>
+          )}
+          {isLDAP && (
+            <>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">
+                  Server URL <span className="text-red-600">*</span>
+                </span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="ldaps://ldap.example.com:636"
+                    value={config?.ldap?.url}
+                    onChange={(e) => handleLDAPConfigChange("url", e.target.value)}
+                  />
+                </div>
+                <Checkbox
+                  className="mt-2"
+                  size="sm"
+                  label="Use StartTLS with ldap://"
+                  checked={config?.ldap?.startTls}
+                  onChange={(e) => handleLDAPConfigChange("startTls", e.target.checked)}
+                />
+              </div>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">Bind DN</span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="DN to search the users with, anonymous if empty"
+                    value={config?.ldap?.bindDn}
+                    onChange={(e) => handleLDAPConfigChange("bindDn", e.target.value)}
+                  />
+                </div>
+              </div>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">Bind password</span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="password"
+                    placeholder="Password of the bind DN"
+                    value={config?.ldap?.bindPassword}
+                    onChange={(e) => handleLDAPConfigChange("bindPassword", e.target.value)}
+                  />
+                </div>
+              </div>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">
+                  Search base <span className="text-red-600">*</span>
+                </span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="ou=people,dc=example,dc=com"
+                    value={config?.ldap?.searchBase}
+                    onChange={(e) => handleLDAPConfigChange("searchBase", e.target.value)}
+                  />
+                </div>
+              </div>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">
+                  User filter <span className="text-red-600">*</span>
+                </span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="(uid=%s), where %s is the username"
+                    value={config?.ldap?.userFilter}
+                    onChange={(e) => handleLDAPConfigChange("userFilter", e.target.value)}
+                  />
+                </div>
+              </div>
+            </>
+          )}
+          {!isSAML && !isLDAP && (
+            <>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">
+                  Client ID <span className="text-red-600">*</span>
+                </span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="Client ID of the OAuth2 provider"
+                    value={state.identityProviderCreate.config?.oauth2?.clientId}
+                    onChange={(e) => handleOAuth2ConfigChange(e, "clientId")}
+                  />
+                </div>
+              </div>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">
+                  Client Secret <span className="text-red-600">*</span>
+                </span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="Client Secret of the OAuth2 provider"
+                    value={state.identityProviderCreate.config?.oauth2?.clientSecret}
+                    onChange={(e) => handleOAuth2ConfigChange(e, "clientSecret")}
+                  />
+                </div>
+              </div>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">Issuer URL</span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="e.g. https://accounts.google.com"
+                    value={state.identityProviderCreate.config?.oauth2?.issuerUrl}
+                    onChange={(e) => handleOAuth2ConfigChange(e, "issuerUrl")}
+                  />
+                </div>
+                <p className="mt-1 text-sm text-gray-500 leading-tight">
+                  For OpenID Connect providers, the endpoints are discovered from the issuer and the ID tokens are verified.
+                </p>
+              </div>
+              {!state.identityProviderCreate.config?.oauth2?.issuerUrl && (
+                <>
+                  <div className="w-full flex flex-col justify-start items-start mb-3">
+                    <span className="mb-2">
+                      Authorization endpoint <span className="text-red-600">*</span>
+                    </span>
+                    <div className="relative w-full">
+                      <Input
+                        className="w-full"
+                        type="text"
+                        placeholder="Authorization endpoint of the OAuth2 provider"
+                        value={state.identityProviderCreate.config?.oauth2?.authUrl}
+                        onChange={(e) => handleOAuth2ConfigChange(e, "authUrl")}
+                      />
+                    </div>
+                  </div>
+                  <div className="w-full flex flex-col justify-start items-start mb-3">
+                    <span className="mb-2">
+                      Token endpoint <span className="text-red-600">*</span>
+                    </span>
+                    <div className="relative w-full">
+                      <Input
+                        className="w-full"
+                        type="text"
+                        placeholder="Token endpoint of the OAuth2 provider"
+                        value={state.identityProviderCreate.config?.oauth2?.tokenUrl}
+                        onChange={(e) => handleOAuth2ConfigChange(e, "tokenUrl")}
+                      />
+                    </div>
+                  </div>
+                  <div className="w-full flex flex-col justify-start items-start mb-3">
+                    <span className="mb-2">
+                      User endpoint <span className="text-red-600">*</span>
+                    </span>
+                    <div className="relative w-full">
+                      <Input
+                        className="w-full"
+                        type="text"
+                        placeholder="User endpoint of the OAuth2 provider"
+                        value={state.identityProviderCreate.config?.oauth2?.userInfoUrl}
+                        onChange={(e) => handleOAuth2ConfigChange(e, "userInfoUrl")}
+                      />
+                    </div>
+                  </div>
+                </>
+              )}
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">
+                  Scopes <span className="text-red-600">*</span>
+                </span>
+                <div className="relative w-full">
+                  <Input
+                    className="w-full"
+                    type="text"
+                    placeholder="Scopes of the OAuth2 provider, separated by space"
+                    value={state.identityProviderCreate.config?.oauth2?.scopes.join(" ")}
+                    onChange={(e) => handleOAuth2ConfigChange(e, "scopes")}
+                  />
+                </div>
+              </div>
+            </>
+          )}
+          <Divider className="!mb-3" />
+          <p className="font-medium mb-2">Field mapping</p>
           <div className="w-full flex flex-col justify-start items-start mb-3">
             <span className="mb-2">
-              Client ID <span className="text-red-600">*</span>
-            </span>
-            <div className="relative w-full">
-              <Input
-                className="w-full"
-                type="text"
-                placeholder="Client ID of the OAuth2 provider"
-                value={state.identityProviderCreate.config?.oauth2?.clientId}
-                onChange={(e) => handleOAuth2ConfigChange(e, "clientId")}
-              />
-            </div>
-          </div>
-          <div className="w-full flex flex-col justify-start items-start mb-3">
-            <span className="mb-2">
-              Client Secret <span className="text-red-600">*</span>
-            </span>
-            <div className="relative w-full">
-              <Input
-                className="w-full"
-                type="text"
-                placeholder="Client Secret of the OAuth2 provider"
-                value={state.identityProviderCreate.config?.oauth2?.clientSecret}
-                onChange={(e) => handleOAuth2ConfigChange(e, "clientSecret")}
-              />
-            </div>
-          </div>
-          <div className="w-full flex flex-col justify-start items-start mb-3">
-            <span className="mb-2">
-              Authorization endpoint <span className="text-red-600">*</span>
-            </span>
-            <div className="relative w-full">
-              <Input
-                className="w-full"
-                type="text"
-                placeholder="Authorization endpoint of the OAuth2 provider"
-                value={state.identityProviderCreate.config?.oauth2?.authUrl}
-                onChange={(e) => handleOAuth2ConfigChange(e, "authUrl")}
-              />
-            </div>
-          </div>
-          <div className="w-full flex flex-col justify-start items-start mb-3">
-            <span className="mb-2">
-              Token endpoint <span className="text-red-600">*</span>
-            </span>
-            <div className="relative w-full">
-              <Input
-                className="w-full"
-                type="text"
-                placeholder="Token endpoint of the OAuth2 provider"
-                value={state.identityProviderCreate.config?.oauth2?.tokenUrl}
-                onChange={(e) => handleOAuth2ConfigChange(e, "tokenUrl")}
-              />
-            </div>
-          </div>
-          <div className="w-full flex flex-col justify-start items-start mb-3">
-            <span className="mb-2">
-              User endpoint <span className="text-red-600">*</span>
+              Identifier {!isSAML && <span className="text-red-600">*</span>}
             </span>
             <div className="relative w-full">
               <Input
                 className="w-full"
                 type="text"
-                placeholder="User endpoint of the OAuth2 provider"
-                value={state.identityProviderCreate.config?.oauth2?.userInfoUrl}
-                onChange={(e) => handleOAuth2ConfigChange(e, "userInfoUrl")}
+                placeholder={
+                  isSAML
+                    ? "The attribute in the assertion to identify the user, NameID if empty"
+                    : isLDAP
+                      ? "The attribute of the email in the user entry, e.g. mail"
+                      : "The field in the user info response to identify the user"
+                }
+                value={fieldMapping?.identifier}
+                onChange={(e) => handleFieldMappingChange(e, "identifier")}
               />
             </div>
           </div>
-          <div className="w-full flex flex-col justify-start items-start mb-3">
-            <span className="mb-2">
-              Scopes <span className="text-red-600">*</span>
-            </span>
+          <div className="w-full flex flex-col justify-start items-start">
+            <span className="mb-2">Display name</span>
             <div className="relative w-full">
               <Input
                 className="w-full"
                 type="text"
-                placeholder="Scopes of the OAuth2 provider, separated by space"
-                value={state.identityProviderCreate.config?.oauth2?.scopes.join(" ")}
-                onChange={(e) => handleOAuth2ConfigChange(e, "scopes")}
+                placeholder={
+                  isSAML
+                    ? "The attribute in the assertion to display the user"
+                    : isLDAP
+                      ? "The attribute in the user entry to display the user, e.g. cn"
+                      : "The field in the user info response to display the user"
+                }
+                value={fieldMapping?.displayName}
+                onChange={(e) => handleFieldMappingChange(e, "displayName")}
               />
             </div>
           </div>
-          <Divider className="!mb-3" />
-          <p className="font-medium mb-2">Field mapping</p>
-          <div className="w-full flex flex-col justify-start items-start mb-3">
-            <span className="mb-2">
-              Identifier <span className="text-red-600">*</span>
-            </span>
+          <div className="w-full flex flex-col justify-start items-start mt-3">
+            <span className="mb-2">Groups</span>
             <div className="relative w-full">
               <Input
                 className="w-full"
                 type="text"
-                placeholder="The field in the user info response to identify the user"
-                value={state.identityProviderCreate.config?.oauth2?.fieldMapping?.identifier}
-                onChange={(e) => handleFieldMappingChange(e, "identifier")}
+                placeholder={
+                  isSAML
+                    ? "The attribute in the assertion with the groups of the user"
+                    : isLDAP
+                      ? "The attribute in the user entry with the groups of the user, e.g. memberOf"
+                      : "The field in the user info response with the groups of the user"
+                }
+                value={fieldMapping?.groups}
+                onChange={(e) => handleFieldMappingChange(e, "groups")}
               />
             </div>
           </div>
-          <div className="w-full flex flex-col justify-start items-start">
-            <span className="mb-2">Display name</span>
-            <div className="relative w-full">
-              <Input
-                className="w-full"
-                type="text"
-                placeholder="The field in the user info response to display the user"
-                value={state.identityProviderCreate.config?.oauth2?.fieldMapping?.displayName}
-                onChange={(e) => handleFieldMappingChange(e, "displayName")}
-              />
+          {fieldMapping?.groups && (
+            <div className="w-full flex flex-col justify-start items-start mt-3">
+              <span className="mb-2">
+                Admin group <span className="text-red-600">*</span>
+              </span>
+              <div className="relative w-full">
+                <Input
+                  className="w-full"
+                  type="text"
+                  placeholder="The group whose members are admins, the others are users"
+                  value={fieldMapping?.adminGroup}
+                  onChange={(e) => handleFieldMappingChange(e, "adminGroup")}
+                />
+              </div>
+              <p className="mt-1 text-sm text-gray-500 leading-tight">The role of the user is synced from the groups on every sign in.</p>
             </div>
-          </div>
+          )}
         </div>
       </DialogContent>
       <DialogActions>
@@ -279,6 +592,9 @@ const CreateIdentityProviderDrawer: React.FC<Props> = (props: Props) => {
           <Button color="neutral" variant="plain" disabled={requestState.isLoading} loading={requestState.isLoading} onClick={onClose}>
             {t("common.cancel")}
           </Button>
+          <Button color="neutral" variant="outlined" disabled={requestState.isLoading} onClick={onTest}>
+            Test
+          </Button>
           <Button color="primary" disabled={requestState.isLoading} loading={requestState.isLoading} onClick={onSave}>
             {t("common.save")}
           </Button>
@@ -1,15 +1,39 @@
-import { Button, Checkbox, DialogActions, DialogContent, DialogTitle, Divider, Drawer, Input, ModalClose, Textarea } from "@mui/joy";
+import {
+  Button,
+  Checkbox,
+  DialogActions,
+  DialogContent,
+  DialogTitle,
+  Divider,
+  Drawer,
+  Input,
+  ModalClose,
+  Option,
+  Select,
+  Textarea,
+} from "@mui/joy";
 import classnames from "classnames";
 import { isUndefined, uniq } from "lodash-es";
 import { useEffect, useState } from "react";
 import { toast } from "react-hot-toast";
 import { useTranslation } from "react-i18next";
+import { shortcutServiceClient } from "@/grpcweb";
 import useLoading from "@/hooks/useLoading";
-import { useShortcutStore, useWorkspaceStore } from "@/stores";
-import { getShortcutUpdateMask } from "@/stores/shortcut";
+import { useShortcutStore, useUserStore, useWorkspaceStore } from "@/stores";
+import { getShortcutUpdateMask, proposableShortcutPaths } from "@/stores/shortcut";
 import { Visibility } from "@/types/proto/api/v1/common";
-import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
+import {
+  Shortcut,
+  Shortcut_QueryParam,
+  Shortcut_RedirectRule,
+  Shortcut_RedirectRule_DeviceType,
+  Shortcut_Variant,
+} from "@/types/proto/api/v1/shortcut_service";
+import { Role } from "@/types/proto/api/v1/user_service";
+import DocumentModeSelect from "./DocumentModeSelect";
 import Icon from "./Icon";
+import RedirectCodeSelect from "./RedirectCodeSelect";
+import TeamSelect from "./TeamSelect";
 
 interface Props {
   shortcutId?: number;
@@ -22,6 +46,16 @@ interface State {
   shortcutCreate: Shortcut;
 }
 
+// splitRedirectRuleValues splits the space separated countries or languages of a redirect rule, keeping the trailing
+// space while typing.
+const splitRedirectRuleValues = (value: string) => value.trimStart().split(/\s+/);
+
+// toDateTimeLocalString formats the date as the value of a datetime-local input in the local timezone.
+const toDateTimeLocalString = (date: Date) => {
+  const localDate = new Date(date.getTime() - date.getTimezoneOffset() * 60 * 1000);
+  return localDate.toISOString().slice(0, 16);
+};
+
 const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
   const { onClose, onConfirm, shortcutId, initialShortcut } = props;
   const { t } = useTranslation();
@@ -38,11 +72,20 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
   });
   const shortcutStore = useShortcutStore();
   const workspaceStore = useWorkspaceStore();
+  const currentUser = useUserStore().getCurrentUser();
   const [showOpenGraphMetadata, setShowOpenGraphMetadata] = useState<boolean>(false);
   const shortcutList = shortcutStore.getShortcutList();
   const [tag, setTag] = useState<string>("");
+  const [alias, setAlias] = useState<string>("");
   const tagSuggestions = uniq(shortcutList.map((shortcut) => shortcut.tags).flat());
   const isCreating = isUndefined(shortcutId);
+  const originShortcut = shortcutId ? shortcutStore.getShortcutById(shortcutId) : undefined;
+  // The users other than the creator and admins can only propose changes to a protected shortcut.
+  const isProposing =
+    !isUndefined(originShortcut) &&
+    originShortcut.protected &&
+    currentUser.role !== Role.ADMIN &&
+    originShortcut.creatorId !== currentUser.id;
   const loadingState = useLoading(!isCreating);
   const requestState = useLoading(false);
 
@@ -75,10 +118,22 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
             title: shortcut.title,
             description: shortcut.description,
             visibility: shortcut.visibility,
+            teamId: shortcut.teamId,
             ogMetadata: shortcut.ogMetadata,
+            clickGoal: shortcut.clickGoal,
+            expireTime: shortcut.expireTime,
+            activateTime: shortcut.activateTime,
+            queryParams: shortcut.queryParams,
+            protected: shortcut.protected,
+            redirectCode: shortcut.redirectCode,
+            documentMode: shortcut.documentMode,
+            internalDescription: shortcut.internalDescription,
+            redirectRules: shortcut.redirectRules,
+            variants: shortcut.variants,
           }),
         });
         setTag(shortcut.tags.join(" "));
+        setAlias(shortcut.aliases.join(" "));
         loadingState.setFinish();
       }
     }
@@ -125,6 +180,56 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
     setTag(text);
   };
 
+  const handleAliasesInputChange = (e: React.ChangeEvent<HTMLInputElement>) => {
+    setAlias(e.target.value);
+  };
+
+  // The suggestions only fill the form, the user saves them or not.
+  const handleSuggestBtnClick = async () => {
+    if (!state.shortcutCreate.link) {
+      return;
+    }
+    requestState.setLoading();
+    try {
+      const suggestion = await shortcutServiceClient.suggestShortcut({
+        link: state.shortcutCreate.link,
+      });
+      setPartialState({
+        shortcutCreate: Object.assign(state.shortcutCreate, {
+          title: suggestion.title || state.shortcutCreate.title,
+          description: suggestion.description || state.shortcutCreate.description,
+        }),
+      });
+      if (suggestion.tags.length > 0) {
+        setTag(uniq([...tag.split(" ").filter(Boolean), ...suggestion.tags]).join(" "));
+      }
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+    requestState.setFinish();
+  };
+
+  const handleRefreshMetadataBtnClick = async () => {
+    if (!shortcutId) {
+      return;
+    }
+    requestState.setLoading();
+    try {
+      const shortcut = await shortcutStore.refreshShortcutMetadata(shortcutId);
+      setPartialState({
+        shortcutCreate: Object.assign(state.shortcutCreate, {
+          ogMetadata: shortcut.ogMetadata,
+        }),
+      });
+      toast.success("Metadata refreshed");
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+    requestState.setFinish();
+  };
+
   const handleOpenGraphMetadataImageChange = (e: React.ChangeEvent<HTMLInputElement>) => {
     setPartialState({
       shortcutCreate: Object.assign(state.shortcutCreate, {
@@ -158,6 +263,126 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
     });
   };
 
+  const handleClickGoalTargetChange = (e: React.ChangeEvent<HTMLInputElement>) => {
+    const target = Math.max(0, parseInt(e.target.value) || 0);
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        clickGoal: {
+          ...state.shortcutCreate.clickGoal,
+          target,
+        },
+      }),
+    });
+  };
+
+  const handleClickGoalWebhookUrlChange = (e: React.ChangeEvent<HTMLInputElement>) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        clickGoal: {
+          ...state.shortcutCreate.clickGoal,
+          webhookUrl: e.target.value,
+        },
+      }),
+    });
+  };
+
+  const handleExpireTimeChange = (e: React.ChangeEvent<HTMLInputElement>) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        expireTime: e.target.value ? new Date(e.target.value) : undefined,
+      }),
+    });
+  };
+
+  const handleActivateTimeChange = (e: React.ChangeEvent<HTMLInputElement>) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        activateTime: e.target.value ? new Date(e.target.value) : undefined,
+      }),
+    });
+  };
+
+  const handleQueryParamChange = (index: number, queryParam: Partial<Shortcut_QueryParam>) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        queryParams: state.shortcutCreate.queryParams.map((item, i) => (i === index ? { ...item, ...queryParam } : item)),
+      }),
+    });
+  };
+
+  const handleAddQueryParamClick = () => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        queryParams: [...state.shortcutCreate.queryParams, Shortcut_QueryParam.fromPartial({})],
+      }),
+    });
+  };
+
+  const handleRemoveQueryParamClick = (index: number) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        queryParams: state.shortcutCreate.queryParams.filter((_, i) => i !== index),
+      }),
+    });
+  };
+
+  const handleRedirectRuleChange = (index: number, redirectRule: Partial<Shortcut_RedirectRule>) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        redirectRules: state.shortcutCreate.redirectRules.map((item, i) => (i === index ? { ...item, ...redirectRule } : item)),
+      }),
+    });
+  };
+
+  const handleAddRedirectRuleClick = () => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        redirectRules: [...state.shortcutCreate.redirectRules, Shortcut_RedirectRule.fromPartial({})],
+      }),
+    });
+  };
+
+  const handleRemoveRedirectRuleClick = (index: number) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        redirectRules: state.shortcutCreate.redirectRules.filter((_, i) => i !== index),
+      }),
+    });
+  };
+
+  const handleVariantChange = (index: number, variant: Partial<Shortcut_Variant>) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        variants: state.shortcutCreate.variants.map((item, i) => (i === index ? { ...item, ...variant } : item)),
+      }),
+    });
+  };
+
+  const handleAddVariantClick = () => {
+    const variants = state.shortcutCreate.variants;
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        // The first variants are named A and B, and start with the link of the shortcut.
+        variants: [
+          ...variants,
+          Shortcut_Variant.fromPartial({
+            name: String.fromCharCode(65 + variants.length),
+            link: variants.length === 0 ? state.shortcutCreate.link : "",
+            weight: 50,
+          }),
+        ],
+      }),
+    });
+  };
+
+  const handleRemoveVariantClick = (index: number) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        variants: state.shortcutCreate.variants.filter((_, i) => i !== index),
+      }),
+    });
+  };
+
   const handleTagSuggestionsClick = (suggestion: string) => {
     if (tag === "") {
       setTag(suggestion);
@@ -174,18 +399,33 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
 
     try {
       const tags = tag.split(" ").filter(Boolean);
-      if (shortcutId) {
-        const originShortcut = shortcutStore.getShortcutById(shortcutId);
+      const aliases = alias.split(" ").filter(Boolean).sort();
+      const redirectRules = state.shortcutCreate.redirectRules.map((redirectRule) => ({
+        ...redirectRule,
+        countries: redirectRule.countries.filter(Boolean),
+        languages: redirectRule.languages.filter(Boolean),
+      }));
+      if (shortcutId && originShortcut) {
         const updatingShortcut = {
           ...state.shortcutCreate,
           id: shortcutId,
           tags,
+          aliases,
+          redirectRules,
         };
-        await shortcutStore.updateShortcut(updatingShortcut, getShortcutUpdateMask(originShortcut, updatingShortcut));
+        const updateMask = getShortcutUpdateMask(originShortcut, updatingShortcut);
+        if (isProposing) {
+          await shortcutStore.updateShortcut(updatingShortcut, updateMask.filter((path) => proposableShortcutPaths.includes(path)));
+          toast.success("The change is proposed to the owner of the shortcut.");
+        } else {
+          await shortcutStore.updateShortcut(updatingShortcut, updateMask);
+        }
       } else {
         await shortcutStore.createShortcut({
           ...state.shortcutCreate,
           tags,
+          aliases,
+          redirectRules,
         });
       }
 
@@ -202,7 +442,7 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
 
   return (
     <Drawer anchor="right" open={true} onClose={onClose}>
-      <DialogTitle>{isCreating ? "Create Shortcut" : "Edit Shortcut"}</DialogTitle>
+      <DialogTitle>{isCreating ? "Create Shortcut" : isProposing ? "Propose Change" : "Edit Shortcut"}</DialogTitle>
       <ModalClose />
       <DialogContent className="w-full max-w-full">
         <div className="overflow-y-auto w-full mt-2 px-4 pb-4 sm:w-[24rem]">
@@ -219,6 +459,18 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
               onChange={handleNameInputChange}
             />
           </div>
+          {!isProposing && (
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Aliases</span>
+              <Input
+                className="w-full"
+                type="text"
+                placeholder="Other names, e.g. in other languages like hilfe for help"
+                value={alias}
+                onChange={handleAliasesInputChange}
+              />
+            </div>
+          )}
           <div className="w-full flex flex-col justify-start items-start mb-3">
             <span className="mb-2">
               Link <span className="text-red-600">*</span>
@@ -229,6 +481,20 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
               placeholder="The destination link of the shortcut"
               value={state.shortcutCreate.link}
               onChange={handleLinkInputChange}
+              endDecorator={
+                isCreating &&
+                workspaceStore.setting.completionEnabled && (
+                  <Button
+                    variant="plain"
+                    size="sm"
+                    startDecorator={<Icon.Sparkles className="w-4 h-auto" />}
+                    disabled={!state.shortcutCreate.link || requestState.isLoading}
+                    onClick={handleSuggestBtnClick}
+                  >
+                    Suggest
+                  </Button>
+                )
+              }
             />
           </div>
           <div className="w-full flex flex-col justify-start items-start mb-3">
@@ -250,6 +516,20 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
               value={state.shortcutCreate.description}
               onChange={handleDescriptionInputChange}
             />
+            {!isProposing && (
+              <Checkbox
+                className="w-full mt-2 dark:text-gray-400"
+                checked={state.shortcutCreate.internalDescription}
+                label="Internal, hidden from the public pages and the link previews"
+                onChange={(e) =>
+                  setPartialState({
+                    shortcutCreate: Object.assign(state.shortcutCreate, {
+                      internalDescription: e.target.checked,
+                    }),
+                  })
+                }
+              />
+            )}
           </div>
           <div className="w-full flex flex-col justify-start items-start mb-3">
             <span className="mb-2">Tags</span>
@@ -285,61 +565,320 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
               }
             />
           </div>
-          <Divider className="text-gray-500">More</Divider>
-          <div className="w-full flex flex-col justify-start items-start border rounded-md mt-3 overflow-hidden dark:border-zinc-800">
-            <div
-              className={classnames(
-                "w-full flex flex-row justify-between items-center px-2 py-1 cursor-pointer hover:bg-gray-100 dark:hover:bg-zinc-800",
-                showOpenGraphMetadata ? "bg-gray-100 border-b dark:bg-zinc-800 dark:border-b-zinc-700" : "",
-              )}
-              onClick={() => setShowOpenGraphMetadata(!showOpenGraphMetadata)}
-            >
-              <span className="text-sm flex flex-row justify-start items-center">
-                Social media metadata
-                <Icon.Sparkles className="w-4 h-auto shrink-0 ml-1 text-blue-600 dark:text-blue-500" />
+          <TeamSelect
+            visibility={state.shortcutCreate.visibility}
+            teamId={state.shortcutCreate.teamId}
+            onChange={(visibility, teamId) =>
+              setPartialState({
+                shortcutCreate: Object.assign(state.shortcutCreate, {
+                  visibility,
+                  teamId,
+                }),
+              })
+            }
+          />
+          {!isProposing && (
+            <>
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <Checkbox
+                className="w-full dark:text-gray-400"
+                checked={state.shortcutCreate.protected}
+                label="Protected, the changes of other users need the approval of the creator or an admin"
+                onChange={(e) =>
+                  setPartialState({
+                    shortcutCreate: Object.assign(state.shortcutCreate, {
+                      protected: e.target.checked,
+                    }),
+                  })
+                }
+              />
+            </div>
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Redirect</span>
+              <RedirectCodeSelect
+                className="w-full"
+                value={state.shortcutCreate.redirectCode}
+                defaultLabel="Workspace default"
+                onChange={(redirectCode) =>
+                  setPartialState({
+                    shortcutCreate: Object.assign(state.shortcutCreate, {
+                      redirectCode,
+                    }),
+                  })
+                }
+              />
+            </div>
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Documents</span>
+              <DocumentModeSelect
+                className="w-full"
+                value={state.shortcutCreate.documentMode}
+                onChange={(documentMode) =>
+                  setPartialState({
+                    shortcutCreate: Object.assign(state.shortcutCreate, {
+                      documentMode,
+                    }),
+                  })
+                }
+              />
+              <span className="text-sm text-gray-500 mt-1">
+                When the link is a document, e.g. a PDF, Slash serves it from the short link. The links to web pages are redirected as usual.
               </span>
-              <button className="w-7 h-7 p-1 rounded-md">
-                <Icon.ChevronDown className={classnames("w-4 h-auto text-gray-500", showOpenGraphMetadata ? "transform rotate-180" : "")} />
-              </button>
             </div>
-            {showOpenGraphMetadata && (
-              <div className="w-full px-2 py-1">
-                <div className="w-full flex flex-col justify-start items-start mb-3">
-                  <span className="mb-2 text-sm">Image URL</span>
-                  <Input
-                    className="w-full"
-                    type="text"
-                    placeholder="https://the.link.to/the/image.png"
-                    size="sm"
-                    value={state.shortcutCreate.ogMetadata?.image}
-                    onChange={handleOpenGraphMetadataImageChange}
-                  />
-                </div>
-                <div className="w-full flex flex-col justify-start items-start mb-3">
-                  <span className="mb-2 text-sm">Title</span>
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Expires at</span>
+              <Input
+                className="w-full"
+                type="datetime-local"
+                value={state.shortcutCreate.expireTime ? toDateTimeLocalString(state.shortcutCreate.expireTime) : ""}
+                onChange={handleExpireTimeChange}
+              />
+            </div>
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Activates at</span>
+              <Input
+                className="w-full"
+                type="datetime-local"
+                value={state.shortcutCreate.activateTime ? toDateTimeLocalString(state.shortcutCreate.activateTime) : ""}
+                onChange={handleActivateTimeChange}
+              />
+            </div>
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Click goal</span>
+              <div className="w-full flex flex-col justify-start items-start gap-2">
+                <Input
+                  className="w-full"
+                  type="number"
+                  placeholder="The target number of visits, e.g. 1000"
+                  value={state.shortcutCreate.clickGoal?.target || ""}
+                  onChange={handleClickGoalTargetChange}
+                />
+                {(state.shortcutCreate.clickGoal?.target || 0) > 0 && (
                   <Input
                     className="w-full"
                     type="text"
-                    placeholder="Slash - An open source, self-hosted platform for sharing and managing your most frequently used links"
-                    size="sm"
-                    value={state.shortcutCreate.ogMetadata?.title}
-                    onChange={handleOpenGraphMetadataTitleChange}
+                    placeholder="Webhook URL notified when the goal is reached (optional)"
+                    value={state.shortcutCreate.clickGoal?.webhookUrl}
+                    onChange={handleClickGoalWebhookUrlChange}
                   />
-                </div>
-                <div className="w-full flex flex-col justify-start items-start mb-3">
-                  <span className="mb-2 text-sm">Description</span>
-                  <Textarea
-                    className="w-full"
-                    placeholder="An open source, self-hosted platform for sharing and managing your most frequently used links."
-                    size="sm"
-                    maxRows={3}
-                    value={state.shortcutCreate.ogMetadata?.description}
-                    onChange={handleOpenGraphMetadataDescriptionChange}
+                )}
+              </div>
+            </div>
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Query parameters</span>
+              <div className="w-full flex flex-col justify-start items-start gap-2">
+                {state.shortcutCreate.queryParams.map((queryParam, index) => (
+                  <div key={index} className="w-full flex flex-row justify-start items-center gap-2">
+                    <Input
+                      className="w-1/3"
+                      type="text"
+                      placeholder="utm_source"
+                      value={queryParam.key}
+                      onChange={(e) => handleQueryParamChange(index, { key: e.target.value })}
+                    />
+                    <Input
+                      className="grow"
+                      type="text"
+                      placeholder="slash-{collection}"
+                      value={queryParam.value}
+                      onChange={(e) => handleQueryParamChange(index, { value: e.target.value })}
+                    />
+                    <button className="w-6 h-6 p-1 rounded-md shrink-0" onClick={() => handleRemoveQueryParamClick(index)}>
+                      <Icon.X className="w-4 h-auto text-gray-500" />
+                    </button>
+                  </div>
+                ))}
+                <Button variant="plain" size="sm" startDecorator={<Icon.Plus className="w-4 h-auto" />} onClick={handleAddQueryParamClick}>
+                  Add parameter
+                </Button>
+                <p className="text-sm text-gray-500">
+                  Appended to the link on redirect. <code>{"{name}"}</code> and <code>{"{collection}"}</code> are replaced by the shortcut
+                  name and the collection it&apos;s opened from.
+                </p>
+              </div>
+            </div>
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Redirect rules</span>
+              <div className="w-full flex flex-col justify-start items-start gap-2">
+                {state.shortcutCreate.redirectRules.map((redirectRule, index) => (
+                  <div
+                    key={index}
+                    className="w-full flex flex-col justify-start items-start gap-1 border rounded-md p-2 dark:border-zinc-800"
+                  >
+                    <div className="w-full flex flex-row justify-start items-center gap-2">
+                      <Input
+                        className="w-1/3"
+                        type="text"
+                        size="sm"
+                        placeholder="DE FR"
+                        value={redirectRule.countries.join(" ")}
+                        onChange={(e) => handleRedirectRuleChange(index, { countries: splitRedirectRuleValues(e.target.value) })}
+                      />
+                      <Input
+                        className="w-1/3"
+                        type="text"
+                        size="sm"
+                        placeholder="de pt-BR"
+                        value={redirectRule.languages.join(" ")}
+                        onChange={(e) => handleRedirectRuleChange(index, { languages: splitRedirectRuleValues(e.target.value) })}
+                      />
+                      <Select
+                        className="grow"
+                        size="sm"
+                        value={redirectRule.devices[0] || Shortcut_RedirectRule_DeviceType.DEVICE_TYPE_UNSPECIFIED}
+                        onChange={(_, device) =>
+                          handleRedirectRuleChange(index, {
+                            devices: device && device !== Shortcut_RedirectRule_DeviceType.DEVICE_TYPE_UNSPECIFIED ? [device] : [],
+                          })
+                        }
+                      >
+                        <Option value={Shortcut_RedirectRule_DeviceType.DEVICE_TYPE_UNSPECIFIED}>Any device</Option>
+                        <Option value={Shortcut_RedirectRule_DeviceType.DESKTOP}>Desktop</Option>
+                        <Option value={Shortcut_RedirectRule_DeviceType.MOBILE}>Mobile</Option>
+                      </Select>
+                    </div>
+                    <div className="w-full flex flex-row justify-start items-center gap-2">
+                      <Input
+                        className="grow"
+                        type="text"
+                        size="sm"
+                        placeholder="https://the.link.for/them"
+                        value={redirectRule.link}
+                        onChange={(e) => handleRedirectRuleChange(index, { link: e.target.value })}
+                      />
+                      <button className="w-6 h-6 p-1 rounded-md shrink-0" onClick={() => handleRemoveRedirectRuleClick(index)}>
+                        <Icon.X className="w-4 h-auto text-gray-500" />
+                      </button>
+                    </div>
+                  </div>
+                ))}
+                <Button
+                  variant="plain"
+                  size="sm"
+                  startDecorator={<Icon.Plus className="w-4 h-auto" />}
+                  onClick={handleAddRedirectRuleClick}
+                >
+                  Add rule
+                </Button>
+                <p className="text-sm text-gray-500">
+                  Visitors matching the countries, the languages and the device of a rule are redirected to its link instead. The first
+                  matching rule wins.
+                </p>
+              </div>
+            </div>
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">A/B split</span>
+              <div className="w-full flex flex-col justify-start items-start gap-2">
+                {state.shortcutCreate.variants.map((variant, index) => (
+                  <div key={index} className="w-full flex flex-row justify-start items-center gap-2">
+                    <Input
+                      className="w-16"
+                      type="text"
+                      placeholder="A"
+                      value={variant.name}
+                      onChange={(e) => handleVariantChange(index, { name: e.target.value })}
+                    />
+                    <Input
+                      className="grow"
+                      type="text"
+                      placeholder="https://the.link.of/the/variant"
+                      value={variant.link}
+                      onChange={(e) => handleVariantChange(index, { link: e.target.value })}
+                    />
+                    <Input
+                      className="w-20"
+                      type="number"
+                      placeholder="50"
+                      value={variant.weight}
+                      onChange={(e) => handleVariantChange(index, { weight: Number(e.target.value) || 0 })}
+                    />
+                    <button className="w-6 h-6 p-1 rounded-md shrink-0" onClick={() => handleRemoveVariantClick(index)}>
+                      <Icon.X className="w-4 h-auto text-gray-500" />
+                    </button>
+                  </div>
+                ))}
+                <Button variant="plain" size="sm" startDecorator={<Icon.Plus className="w-4 h-auto" />} onClick={handleAddVariantClick}>
+                  Add variant
+                </Button>
+                <p className="text-sm text-gray-500">
+                  Each visit goes to one of the variants instead of the link, picked by weight, e.g. 90 and 10. The analytics count the
+                  views per variant.
+                </p>
+              </div>
+            </div>
+            <Divider className="text-gray-500">More</Divider>
+            <div className="w-full flex flex-col justify-start items-start border rounded-md mt-3 overflow-hidden dark:border-zinc-800">
+              <div
+                className={classnames(
+                  "w-full flex flex-row justify-between items-center px-2 py-1 cursor-pointer hover:bg-gray-100 dark:hover:bg-zinc-800",
+                  showOpenGraphMetadata ? "bg-gray-100 border-b dark:bg-zinc-800 dark:border-b-zinc-700" : "",
+                )}
+                onClick={() => setShowOpenGraphMetadata(!showOpenGraphMetadata)}
+              >
+                <span className="text-sm flex flex-row justify-start items-center">
+                  Social media metadata
+                  <Icon.Sparkles className="w-4 h-auto shrink-0 ml-1 text-blue-600 dark:text-blue-500" />
+                </span>
+                <button className="w-7 h-7 p-1 rounded-md">
+                  <Icon.ChevronDown
+                    className={classnames("w-4 h-auto text-gray-500", showOpenGraphMetadata ? "transform rotate-180" : "")}
                   />
-                </div>
+                </button>
               </div>
-            )}
-          </div>
+              {showOpenGraphMetadata && (
+                <div className="w-full px-2 py-1">
+                  {!isCreating && !isProposing && (
+                    <div className="w-full flex flex-row justify-between items-center mb-3 gap-2">
+                      <span className="text-sm text-gray-500">Fetched from the link when it changes.</span>
+                      <Button
+                        variant="plain"
+                        size="sm"
+                        startDecorator={<Icon.RefreshCw className="w-4 h-auto" />}
+                        disabled={requestState.isLoading}
+                        onClick={handleRefreshMetadataBtnClick}
+                      >
+                        Refresh
+                      </Button>
+                    </div>
+                  )}
+                  <div className="w-full flex flex-col justify-start items-start mb-3">
+                    <span className="mb-2 text-sm">Image URL</span>
+                    <Input
+                      className="w-full"
+                      type="text"
+                      placeholder="https://the.link.to/the/image.png"
+                      size="sm"
+                      value={state.shortcutCreate.ogMetadata?.image}
+                      onChange={handleOpenGraphMetadataImageChange}
+                    />
+                  </div>
+                  <div className="w-full flex flex-col justify-start items-start mb-3">
+                    <span className="mb-2 text-sm">Title</span>
+                    <Input
+                      className="w-full"
+                      type="text"
+                      placeholder="Slash - An open source, self-hosted platform for sharing and managing your most frequently used links"
+                      size="sm"
+                      value={state.shortcutCreate.ogMetadata?.title}
+                      onChange={handleOpenGraphMetadataTitleChange}
+                    />
+                  </div>
+                  <div className="w-full flex flex-col justify-start items-start mb-3">
+                    <span className="mb-2 text-sm">Description</span>
+                    <Textarea
+                      className="w-full"
+                      placeholder="An open source, self-hosted platform for sharing and managing your most frequently used links."
+                      size="sm"
+                      maxRows={3}
+                      value={state.shortcutCreate.ogMetadata?.description}
+                      onChange={handleOpenGraphMetadataDescriptionChange}
+                    />
+                  </div>
+                </div>
+              )}
+            </div>
+            </>
+          )}
         </div>
       </DialogContent>
       <DialogActions>
@@ -0,0 +1,92 @@
+import { Button, Input, Modal, ModalDialog, Radio, RadioGroup } from "@mui/joy";
+import { useState } from "react";
+import { toast } from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { userServiceClient } from "@/grpcweb";
+import { absolutifyLink } from "@/helpers/utils";
+import useLoading from "@/hooks/useLoading";
+import { useUserStore, useWorkspaceStore } from "@/stores";
+import { DeleteMyAccountRequest_DataHandling } from "@/types/proto/api/v1/user_service";
+import Icon from "./Icon";
+
+interface Props {
+  onClose: () => void;
+}
+
+const DeleteAccountDialog: React.FC<Props> = (props: Props) => {
+  const { onClose } = props;
+  const { t } = useTranslation();
+  const currentUser = useUserStore().getCurrentUser();
+  const workspaceStore = useWorkspaceStore();
+  const canTransfer = workspaceStore.setting.accountHandoverUserId !== 0 && workspaceStore.setting.accountHandoverUserId !== currentUser.id;
+  const [dataHandling, setDataHandling] = useState<DeleteMyAccountRequest_DataHandling>(
+    canTransfer ? DeleteMyAccountRequest_DataHandling.TRANSFER : DeleteMyAccountRequest_DataHandling.DELETE,
+  );
+  const [confirmEmail, setConfirmEmail] = useState("");
+  const requestState = useLoading(false);
+
+  const handleDeleteBtnClick = async () => {
+    requestState.setLoading();
+    try {
+      await userServiceClient.deleteMyAccount({
+        dataHandling,
+      });
+      window.location.href = absolutifyLink("/auth");
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+      requestState.setFinish();
+    }
+  };
+
+  return (
+    <Modal open={true}>
+      <ModalDialog>
+        <div className="flex flex-row justify-between items-center w-80 sm:w-96">
+          <span className="text-lg font-medium">Delete Account</span>
+          <Button variant="plain" onClick={onClose}>
+            <Icon.X className="w-5 h-auto text-gray-600" />
+          </Button>
+        </div>
+        <div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">Your shortcuts and collections</span>
+            <RadioGroup
+              value={dataHandling}
+              onChange={(e) => setDataHandling(e.target.value as DeleteMyAccountRequest_DataHandling)}
+            >
+              <Radio value={DeleteMyAccountRequest_DataHandling.TRANSFER} label="Transfer them to the workspace" disabled={!canTransfer} />
+              <Radio value={DeleteMyAccountRequest_DataHandling.DELETE} label="Delete them with my account" />
+            </RadioGroup>
+            <p className="mt-2 text-sm text-gray-500 leading-tight">
+              {canTransfer
+                ? "Transferred shortcuts keep working for the others. The shortcuts in your personal namespace are deleted."
+                : "No admin designated a user to transfer the shortcuts and collections to."}
+            </p>
+          </div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">
+              Type <span className="font-mono">{currentUser.email}</span> to confirm
+            </span>
+            <Input className="w-full" type="text" value={confirmEmail} onChange={(e) => setConfirmEmail(e.target.value)} />
+          </div>
+          <div className="w-full flex flex-row justify-end items-center space-x-2">
+            <Button variant="plain" disabled={requestState.isLoading} onClick={onClose}>
+              {t("common.cancel")}
+            </Button>
+            <Button
+              color="danger"
+              disabled={requestState.isLoading || confirmEmail !== currentUser.email}
+              loading={requestState.isLoading}
+              onClick={handleDeleteBtnClick}
+            >
+              {t("common.delete")}
+            </Button>
+          </div>
+        </div>
+      </ModalDialog>
+    </Modal>
+  );
+};
+
+export default DeleteAccountDialog;
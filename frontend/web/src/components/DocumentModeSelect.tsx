@@ -0,0 +1,39 @@
+import { Option, Select } from "@mui/joy";
+import { Shortcut_DocumentMode } from "@/types/proto/api/v1/shortcut_service";
+
+interface Props {
+  className?: string;
+  value: Shortcut_DocumentMode;
+  onChange: (value: Shortcut_DocumentMode) => void;
+}
+
+const documentModeOptions = [
+  {
+    label: "Redirect to the link",
+    value: Shortcut_DocumentMode.DOCUMENT_MODE_UNSPECIFIED,
+  },
+  {
+    label: "Show the document in a viewer page",
+    value: Shortcut_DocumentMode.INLINE,
+  },
+  {
+    label: "Download the document",
+    value: Shortcut_DocumentMode.DOWNLOAD,
+  },
+];
+
+const DocumentModeSelect = (props: Props) => {
+  const { className, value, onChange } = props;
+
+  return (
+    <Select className={className} value={value} onChange={(_, value) => onChange(value || Shortcut_DocumentMode.DOCUMENT_MODE_UNSPECIFIED)}>
+      {documentModeOptions.map((option) => (
+        <Option key={option.value} value={option.value}>
+          {option.label}
+        </Option>
+      ))}
+    </Select>
+  );
+};
+
+export default DocumentModeSelect;
@@ -0,0 +1,51 @@
+import { Button } from "@mui/joy";
+import toast from "react-hot-toast";
+import { authServiceClient } from "@/grpcweb";
+import { absolutifyLink } from "@/helpers/utils";
+import useLoading from "@/hooks/useLoading";
+import { useUserStore } from "@/stores";
+import Icon from "./Icon";
+
+// EmailVerificationNotice is shown instead of the app until the current user verifies their email.
+const EmailVerificationNotice = () => {
+  const currentUser = useUserStore().getCurrentUser();
+  const sendingState = useLoading(false);
+
+  const handleResendButtonClick = async () => {
+    sendingState.setLoading();
+    try {
+      await authServiceClient.sendVerificationEmail({});
+      toast.success(`Verification email sent to ${currentUser.email}`);
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+    sendingState.setFinish();
+  };
+
+  const handleSignOutButtonClick = async () => {
+    await authServiceClient.signOut({});
+    window.location.href = absolutifyLink("/auth");
+  };
+
+  return (
+    <div className="w-full h-auto pt-12 sm:pt-24 flex flex-row justify-center items-center dark:bg-zinc-900">
+      <div className="w-80 max-w-full flex flex-col justify-start items-center gap-4">
+        <Icon.MailCheck className="w-12 h-auto text-gray-500" />
+        <p className="text-xl font-medium dark:text-gray-400">Verify your email</p>
+        <p className="text-center text-gray-500">
+          Open the link sent to <span className="font-medium">{currentUser.email}</span> to start using Slash.
+        </p>
+        <div className="flex flex-row justify-center items-center gap-2">
+          <Button variant="outlined" loading={sendingState.isLoading} onClick={handleResendButtonClick}>
+            Resend email
+          </Button>
+          <Button variant="plain" color="neutral" onClick={handleSignOutButtonClick}>
+            Sign out
+          </Button>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default EmailVerificationNotice;
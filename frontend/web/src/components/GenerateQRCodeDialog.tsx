@@ -1,9 +1,5 @@
 import { Button, Modal, ModalDialog } from "@mui/joy";
-import { QRCodeCanvas } from "qrcode.react";
-import { useRef } from "react";
-import { toast } from "react-hot-toast";
 import { useTranslation } from "react-i18next";
-import { absolutifyLink } from "@/helpers/utils";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 import Icon from "./Icon";
 
@@ -12,30 +8,20 @@ interface Props {
   onClose: () => void;
 }
 
+// The size in pixels of the downloaded QR codes.
+const downloadSize = 1024;
+
 const GenerateQRCodeDialog: React.FC<Props> = (props: Props) => {
   const { shortcut, onClose } = props;
   const { t } = useTranslation();
-  const containerRef = useRef<HTMLDivElement | null>(null);
-  const shortcutLink = absolutifyLink(`/s/${shortcut.name}`);
+  // The QR codes are rendered by the server, with the branding of the workspace.
+  const qrCodePath = `/s/${shortcut.name}/qrcode`;
+  const fileName = `${shortcut.title || shortcut.name}-qrcode`;
 
   const handleCloseBtnClick = () => {
     onClose();
   };
 
-  const handleDownloadQRCodeClick = () => {
-    const canvas = containerRef.current?.querySelector("canvas");
-    if (!canvas) {
-      toast.error("Failed to get QR code canvas");
-      return;
-    }
-
-    const link = document.createElement("a");
-    link.download = `${shortcut.title || shortcut.name}-qrcode.png`;
-    link.href = canvas.toDataURL();
-    link.click();
-    handleCloseBtnClick();
-  };
-
   return (
     <Modal open={true}>
       <ModalDialog>
@@ -46,13 +32,31 @@ const GenerateQRCodeDialog: React.FC<Props> = (props: Props) => {
           </Button>
         </div>
         <div>
-          <div ref={containerRef} className="w-full flex flex-row justify-center items-center mt-2 mb-6">
-            <QRCodeCanvas value={shortcutLink} size={180} bgColor={"#ffffff"} fgColor={"#000000"} includeMargin={false} level={"L"} />
+          <div className="w-full flex flex-row justify-center items-center mt-2 mb-6">
+            <img className="w-[180px] h-[180px]" src={`${qrCodePath}?size=360`} alt={shortcut.name} />
           </div>
-          <div className="w-full flex flex-row justify-center items-center px-4">
-            <Button className="w-full" color="neutral" onClick={handleDownloadQRCodeClick}>
+          <div className="w-full flex flex-row justify-center items-center px-4 gap-2">
+            <Button
+              className="w-full"
+              color="neutral"
+              component="a"
+              href={`${qrCodePath}?size=${downloadSize}`}
+              download={`${fileName}.png`}
+              onClick={handleCloseBtnClick}
+            >
               <Icon.Download className="w-4 h-auto mr-1" />
-              {t("common.download")}
+              {t("common.download")} PNG
+            </Button>
+            <Button
+              className="w-full"
+              color="neutral"
+              variant="outlined"
+              component="a"
+              href={`${qrCodePath}?format=svg&size=${downloadSize}`}
+              download={`${fileName}.svg`}
+              onClick={handleCloseBtnClick}
+            >
+              SVG
             </Button>
           </div>
         </div>
@@ -2,6 +2,7 @@ import { useState } from "react";
 import { useTranslation } from "react-i18next";
 import { Link, useLocation } from "react-router-dom";
 import { authServiceClient } from "@/grpcweb";
+import { absolutifyLink } from "@/helpers/utils";
 import { useWorkspaceStore, useUserStore } from "@/stores";
 import { stringifyPlanType } from "@/stores/subscription";
 import { PlanType } from "@/types/proto/api/v1/subscription_service";
@@ -24,7 +25,7 @@ const Header: React.FC = () => {
 
   const handleSignOutButtonClick = async () => {
     await authServiceClient.signOut({});
-    window.location.href = "/auth";
+    window.location.href = absolutifyLink("/auth");
   };
 
   return (
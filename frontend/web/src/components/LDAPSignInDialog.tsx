@@ -0,0 +1,115 @@
+import { Button, Input, Modal, ModalDialog } from "@mui/joy";
+import { ClientError, Status } from "nice-grpc-web";
+import { useState } from "react";
+import { useTranslation } from "react-i18next";
+import { authServiceClient } from "@/grpcweb";
+import useLoading from "@/hooks/useLoading";
+import useNavigateTo from "@/hooks/useNavigateTo";
+import { useUserStore } from "@/stores";
+import { IdentityProvider } from "@/types/proto/api/v1/workspace_service";
+import Icon from "./Icon";
+
+interface Props {
+  identityProvider: IdentityProvider;
+  onClose: () => void;
+}
+
+const LDAPSignInDialog: React.FC<Props> = (props: Props) => {
+  const { identityProvider, onClose } = props;
+  const { t } = useTranslation();
+  const navigateTo = useNavigateTo();
+  const userStore = useUserStore();
+  const [username, setUsername] = useState("");
+  const [password, setPassword] = useState("");
+  const [errorMessage, setErrorMessage] = useState("");
+  // Whether the directory identity is waiting to be linked to the existing account.
+  const [linkRequired, setLinkRequired] = useState(false);
+  const [accountPassword, setAccountPassword] = useState("");
+  const requestState = useLoading(false);
+
+  const handleSignIn = async (signIn: () => Promise<unknown>) => {
+    requestState.setLoading();
+    try {
+      await signIn();
+      await userStore.fetchCurrentUser();
+      navigateTo("/");
+    } catch (error: any) {
+      console.error(error);
+      setErrorMessage((error as ClientError).details);
+      if ((error as ClientError).code === Status.FAILED_PRECONDITION) {
+        setLinkRequired(true);
+      }
+    }
+    requestState.setFinish();
+  };
+
+  const handleSignInButtonClick = () =>
+    handleSignIn(() =>
+      authServiceClient.signInWithLDAP({
+        idpId: identityProvider.id,
+        username,
+        password,
+      }),
+    );
+
+  const handleLinkButtonClick = () => handleSignIn(() => authServiceClient.linkIdentityProvider({ password: accountPassword }));
+
+  return (
+    <Modal open={true}>
+      <ModalDialog>
+        <div className="flex flex-row justify-between items-center w-80">
+          <span className="text-lg font-medium">{t("auth.sign-in-with", { provider: identityProvider.title })}</span>
+          <Button variant="plain" onClick={onClose}>
+            <Icon.X className="w-5 h-auto text-gray-600" />
+          </Button>
+        </div>
+        <div className="w-80">
+          {!linkRequired ? (
+            <>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">Username</span>
+                <Input className="w-full" type="text" value={username} onChange={(e) => setUsername(e.target.value)} />
+              </div>
+              <div className="w-full flex flex-col justify-start items-start mb-3">
+                <span className="mb-2">Password</span>
+                <Input className="w-full" type="password" value={password} onChange={(e) => setPassword(e.target.value)} />
+              </div>
+            </>
+          ) : (
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">Password of the existing account</span>
+              <Input className="w-full" type="password" value={accountPassword} onChange={(e) => setAccountPassword(e.target.value)} />
+            </div>
+          )}
+          {errorMessage && <p className="w-full mb-3 text-sm text-red-600">{errorMessage}</p>}
+          <div className="w-full flex flex-row justify-end items-center space-x-2">
+            <Button variant="plain" disabled={requestState.isLoading} onClick={onClose}>
+              {t("common.cancel")}
+            </Button>
+            {!linkRequired ? (
+              <Button
+                color="primary"
+                disabled={requestState.isLoading || !username || !password}
+                loading={requestState.isLoading}
+                onClick={handleSignInButtonClick}
+              >
+                {t("auth.sign-in")}
+              </Button>
+            ) : (
+              <Button
+                color="primary"
+                disabled={requestState.isLoading || !accountPassword}
+                loading={requestState.isLoading}
+                onClick={handleLinkButtonClick}
+              >
+                Link account
+              </Button>
+            )}
+          </div>
+        </div>
+      </ModalDialog>
+    </Modal>
+  );
+};
+
+export default LDAPSignInDialog;
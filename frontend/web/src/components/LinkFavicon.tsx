@@ -3,6 +3,8 @@ import Icon from "./Icon";
 
 interface Props {
   url: string;
+  // The favicon fetched by the server from the link, the favicon provider is used without it.
+  favicon?: string;
 }
 
 const getFaviconUrlWithProvider = (url: string, provider: string) => {
@@ -17,12 +19,14 @@ const getFaviconUrlWithProvider = (url: string, provider: string) => {
 };
 
 const LinkFavicon = (props: Props) => {
-  const { url } = props;
+  const { url, favicon } = props;
   const faviconProvider = "https://www.google.com/s2/favicons";
-  const [faviconUrl, setFaviconUrl] = useState<string>(getFaviconUrlWithProvider(url, faviconProvider));
+  const providerFaviconUrl = getFaviconUrlWithProvider(url, faviconProvider);
+  const [faviconUrl, setFaviconUrl] = useState<string>(favicon || providerFaviconUrl);
 
   const handleImgError = () => {
-    setFaviconUrl("");
+    // Fall back to the favicon provider when the fetched favicon can't be loaded.
+    setFaviconUrl(faviconUrl !== providerFaviconUrl ? providerFaviconUrl : "");
   };
 
   return faviconUrl ? (
@@ -0,0 +1,102 @@
+import { Button } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { shortcutServiceClient } from "@/grpcweb";
+import { useShortcutStore } from "@/stores";
+import { ProposedChange, ProposedChange_Status, Shortcut } from "@/types/proto/api/v1/shortcut_service";
+import Icon from "./Icon";
+
+interface Props {
+  shortcut: Shortcut;
+}
+
+const ProposedChangesView = (props: Props) => {
+  const { shortcut } = props;
+  const shortcutStore = useShortcutStore();
+  const [proposedChanges, setProposedChanges] = useState<ProposedChange[]>([]);
+
+  const fetchProposedChanges = async () => {
+    const { proposedChanges } = await shortcutServiceClient.listProposedChanges({
+      shortcutId: shortcut.id,
+      status: ProposedChange_Status.PENDING,
+    });
+    setProposedChanges(proposedChanges);
+  };
+
+  useEffect(() => {
+    fetchProposedChanges();
+  }, [shortcut.id]);
+
+  const handleApproveButtonClick = async (proposedChange: ProposedChange) => {
+    try {
+      await shortcutServiceClient.approveProposedChange({
+        shortcutId: shortcut.id,
+        id: proposedChange.id,
+      });
+      await shortcutStore.fetchShortcutById(shortcut.id);
+      toast.success("The change is applied to the shortcut.");
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+    await fetchProposedChanges();
+  };
+
+  const handleRejectButtonClick = async (proposedChange: ProposedChange) => {
+    try {
+      await shortcutServiceClient.rejectProposedChange({
+        shortcutId: shortcut.id,
+        id: proposedChange.id,
+      });
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+    await fetchProposedChanges();
+  };
+
+  if (proposedChanges.length === 0) {
+    return null;
+  }
+
+  return (
+    <div className="w-full flex flex-col mt-8">
+      <h3 className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+        <Icon.GitPullRequest className="w-6 h-auto mr-1" />
+        Proposed changes
+      </h3>
+      <div className="mt-4 w-full flex flex-col justify-start items-start gap-4">
+        {proposedChanges.map((proposedChange) => (
+          <div key={proposedChange.id} className="w-full border rounded-lg px-4 py-3 dark:border-zinc-800">
+            <div className="w-full flex flex-row justify-between items-center">
+              <span className="text-sm text-gray-500">
+                Proposed by {proposedChange.proposerUsername} at {proposedChange.createdTime?.toLocaleString()}
+              </span>
+              <div className="flex flex-row justify-end items-center gap-2">
+                <Button size="sm" color="neutral" variant="plain" onClick={() => handleRejectButtonClick(proposedChange)}>
+                  Reject
+                </Button>
+                <Button size="sm" onClick={() => handleApproveButtonClick(proposedChange)}>
+                  Approve
+                </Button>
+              </div>
+            </div>
+            <table className="mt-2 w-full text-sm">
+              <tbody>
+                {proposedChange.changes.map((change) => (
+                  <tr key={change.field} className="align-top">
+                    <td className="w-24 py-1 pr-2 text-gray-500">{change.field}</td>
+                    <td className="py-1 pr-2 break-all text-red-600 line-through">{change.previousValue}</td>
+                    <td className="py-1 break-all text-green-600">{change.proposedValue}</td>
+                  </tr>
+                ))}
+              </tbody>
+            </table>
+          </div>
+        ))}
+      </div>
+    </div>
+  );
+};
+
+export default ProposedChangesView;
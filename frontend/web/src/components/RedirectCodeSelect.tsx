@@ -0,0 +1,41 @@
+import { Option, Select } from "@mui/joy";
+
+interface Props {
+  className?: string;
+  value: number;
+  // The label of the 0 code, e.g. the default of the workspace.
+  defaultLabel: string;
+  onChange: (value: number) => void;
+}
+
+const redirectCodeOptions = [
+  {
+    label: "301 Permanent, cached by browsers",
+    value: 301,
+  },
+  {
+    label: "302 Temporary",
+    value: 302,
+  },
+  {
+    label: "307 Temporary, preserving the method",
+    value: 307,
+  },
+];
+
+const RedirectCodeSelect = (props: Props) => {
+  const { className, value, defaultLabel, onChange } = props;
+
+  return (
+    <Select className={className} value={value} onChange={(_, value) => onChange(value || 0)}>
+      <Option value={0}>{defaultLabel}</Option>
+      {redirectCodeOptions.map((option) => (
+        <Option key={option.value} value={option.value}>
+          {option.label}
+        </Option>
+      ))}
+    </Select>
+  );
+};
+
+export default RedirectCodeSelect;
@@ -0,0 +1,143 @@
+import { Button, IconButton, Input, Modal, ModalDialog, Radio, RadioGroup } from "@mui/joy";
+import copy from "copy-to-clipboard";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { shortcutServiceClient } from "@/grpcweb";
+import { absolutifyLink } from "@/helpers/utils";
+import useLoading from "@/hooks/useLoading";
+import { Shortcut, ShortcutAnalyticsShare } from "@/types/proto/api/v1/shortcut_service";
+import Icon from "./Icon";
+
+interface Props {
+  shortcut: Shortcut;
+  onClose: () => void;
+}
+
+const expirationOptions = [
+  {
+    label: "1 day",
+    value: 3600 * 24,
+  },
+  {
+    label: "7 days",
+    value: 3600 * 24 * 7,
+  },
+  {
+    label: "30 days",
+    value: 3600 * 24 * 30,
+  },
+];
+
+const getShareLink = (shortcutAnalyticsShare: ShortcutAnalyticsShare) => {
+  return absolutifyLink(`/analytics/${shortcutAnalyticsShare.token}`);
+};
+
+const ShareAnalyticsDialog: React.FC<Props> = (props: Props) => {
+  const { shortcut, onClose } = props;
+  const [description, setDescription] = useState<string>("");
+  const [expiration, setExpiration] = useState<number>(3600 * 24 * 7);
+  const [shortcutAnalyticsShares, setShortcutAnalyticsShares] = useState<ShortcutAnalyticsShare[]>([]);
+  const requestState = useLoading(false);
+
+  useEffect(() => {
+    shortcutServiceClient.listShortcutAnalyticsShares({ shortcutId: shortcut.id }).then(({ shares }) => {
+      setShortcutAnalyticsShares(shares);
+    });
+  }, [shortcut.id]);
+
+  const copyShareLink = (shortcutAnalyticsShare: ShortcutAnalyticsShare) => {
+    copy(getShareLink(shortcutAnalyticsShare));
+    toast.success("Share link copied to clipboard");
+  };
+
+  const handleCreateBtnClick = async () => {
+    requestState.setLoading();
+    try {
+      const shortcutAnalyticsShare = await shortcutServiceClient.createShortcutAnalyticsShare({
+        shortcutId: shortcut.id,
+        description,
+        expireTime: new Date(Date.now() + expiration * 1000),
+      });
+      setShortcutAnalyticsShares([shortcutAnalyticsShare, ...shortcutAnalyticsShares]);
+      setDescription("");
+      copyShareLink(shortcutAnalyticsShare);
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+    requestState.setFinish();
+  };
+
+  const handleRevokeBtnClick = async (shortcutAnalyticsShare: ShortcutAnalyticsShare) => {
+    try {
+      await shortcutServiceClient.deleteShortcutAnalyticsShare({
+        shortcutId: shortcut.id,
+        id: shortcutAnalyticsShare.id,
+      });
+      setShortcutAnalyticsShares(shortcutAnalyticsShares.filter((share) => share.id !== shortcutAnalyticsShare.id));
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <Modal open={true}>
+      <ModalDialog>
+        <div className="flex flex-row justify-between items-center w-96 max-w-full">
+          <span className="text-lg font-medium">Share analytics</span>
+          <Button variant="plain" onClick={onClose}>
+            <Icon.X className="w-5 h-auto text-gray-600" />
+          </Button>
+        </div>
+        <div className="w-96 max-w-full">
+          <p className="mb-3 text-sm text-gray-500">
+            Let people outside of the workspace view the analytics of this shortcut with a read-only link, without an account. The link of
+            the shortcut is not shared.
+          </p>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">Description</span>
+            <Input className="w-full" placeholder="e.g. Acme Corp" value={description} onChange={(e) => setDescription(e.target.value)} />
+          </div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">Expiration</span>
+            <RadioGroup orientation="horizontal" value={expiration} onChange={(e) => setExpiration(Number(e.target.value))}>
+              {expirationOptions.map((option) => (
+                <Radio key={option.value} value={option.value} checked={expiration === option.value} label={option.label} />
+              ))}
+            </RadioGroup>
+          </div>
+          <div className="w-full flex flex-row justify-end items-center mt-4 space-x-2">
+            <Button color="primary" disabled={requestState.isLoading} loading={requestState.isLoading} onClick={handleCreateBtnClick}>
+              Create and copy link
+            </Button>
+          </div>
+          {shortcutAnalyticsShares.length > 0 && (
+            <div className="w-full mt-4 flex flex-col justify-start items-start divide-y dark:divide-zinc-800">
+              {shortcutAnalyticsShares.map((shortcutAnalyticsShare) => (
+                <div key={shortcutAnalyticsShare.id} className="w-full py-2 flex flex-row justify-between items-center gap-2">
+                  <div className="flex flex-col justify-start items-start truncate">
+                    <span className="truncate">{shortcutAnalyticsShare.description || "Untitled link"}</span>
+                    <span className="text-xs text-gray-500">
+                      {shortcutAnalyticsShare.viewCount} views · expires {shortcutAnalyticsShare.expireTime?.toLocaleString()}
+                    </span>
+                  </div>
+                  <div className="flex flex-row justify-end items-center shrink-0">
+                    <IconButton color="neutral" variant="plain" size="sm" onClick={() => copyShareLink(shortcutAnalyticsShare)}>
+                      <Icon.Clipboard className="w-4 h-auto" />
+                    </IconButton>
+                    <IconButton color="danger" variant="plain" size="sm" onClick={() => handleRevokeBtnClick(shortcutAnalyticsShare)}>
+                      <Icon.Trash className="w-4 h-auto" />
+                    </IconButton>
+                  </div>
+                </div>
+              ))}
+            </div>
+          )}
+        </div>
+      </ModalDialog>
+    </Modal>
+  );
+};
+
+export default ShareAnalyticsDialog;
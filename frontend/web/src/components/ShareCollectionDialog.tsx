@@ -0,0 +1,155 @@
+import { Button, IconButton, Input, Modal, ModalDialog, Radio, RadioGroup } from "@mui/joy";
+import copy from "copy-to-clipboard";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { collectionServiceClient } from "@/grpcweb";
+import { absolutifyLink } from "@/helpers/utils";
+import useLoading from "@/hooks/useLoading";
+import { Collection, CollectionShare } from "@/types/proto/api/v1/collection_service";
+import Icon from "./Icon";
+
+interface Props {
+  collection: Collection;
+  onClose: () => void;
+}
+
+const expirationOptions = [
+  {
+    label: "1 day",
+    value: 3600 * 24,
+  },
+  {
+    label: "7 days",
+    value: 3600 * 24 * 7,
+  },
+  {
+    label: "30 days",
+    value: 3600 * 24 * 30,
+  },
+];
+
+const getGuestLink = (collection: Collection, collectionShare: CollectionShare) => {
+  return absolutifyLink(`/c/${collection.name}?${new URLSearchParams({ share: collectionShare.token })}`);
+};
+
+const ShareCollectionDialog: React.FC<Props> = (props: Props) => {
+  const { collection, onClose } = props;
+  const [email, setEmail] = useState<string>("");
+  const [expiration, setExpiration] = useState<number>(3600 * 24 * 7);
+  const [collectionShares, setCollectionShares] = useState<CollectionShare[]>([]);
+  const requestState = useLoading(false);
+
+  useEffect(() => {
+    collectionServiceClient.listCollectionShares({ collectionId: collection.id }).then(({ shares }) => {
+      setCollectionShares(shares);
+    });
+  }, [collection.id]);
+
+  const copyGuestLink = (collectionShare: CollectionShare) => {
+    copy(getGuestLink(collection, collectionShare));
+    toast.success("Guest link copied to clipboard");
+  };
+
+  const handleInviteBtnClick = async () => {
+    if (!email) {
+      toast.error("Email is required");
+      return;
+    }
+
+    requestState.setLoading();
+    try {
+      const collectionShare = await collectionServiceClient.createCollectionShare({
+        collectionId: collection.id,
+        email,
+        expireTime: new Date(Date.now() + expiration * 1000),
+      });
+      setCollectionShares([collectionShare, ...collectionShares]);
+      setEmail("");
+      copyGuestLink(collectionShare);
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+    requestState.setFinish();
+  };
+
+  const handleRevokeBtnClick = async (collectionShare: CollectionShare) => {
+    try {
+      await collectionServiceClient.deleteCollectionShare({
+        collectionId: collection.id,
+        id: collectionShare.id,
+      });
+      setCollectionShares(collectionShares.filter((share) => share.id !== collectionShare.id));
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <Modal open={true}>
+      <ModalDialog>
+        <div className="flex flex-row justify-between items-center w-96 max-w-full">
+          <span className="text-lg font-medium">Share with guests</span>
+          <Button variant="plain" onClick={onClose}>
+            <Icon.X className="w-5 h-auto text-gray-600" />
+          </Button>
+        </div>
+        <div className="w-96 max-w-full">
+          <p className="mb-3 text-sm text-gray-500">
+            Invite people outside of the workspace to view this collection with a guest link, without an account.
+          </p>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">
+              Email <span className="text-red-600">*</span>
+            </span>
+            <Input
+              className="w-full"
+              type="email"
+              placeholder="guest@example.com"
+              value={email}
+              onChange={(e) => setEmail(e.target.value)}
+            />
+          </div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">Expiration</span>
+            <RadioGroup orientation="horizontal" value={expiration} onChange={(e) => setExpiration(Number(e.target.value))}>
+              {expirationOptions.map((option) => (
+                <Radio key={option.value} value={option.value} checked={expiration === option.value} label={option.label} />
+              ))}
+            </RadioGroup>
+          </div>
+          <div className="w-full flex flex-row justify-end items-center mt-4 space-x-2">
+            <Button color="primary" disabled={requestState.isLoading} loading={requestState.isLoading} onClick={handleInviteBtnClick}>
+              Invite and copy link
+            </Button>
+          </div>
+          {collectionShares.length > 0 && (
+            <div className="w-full mt-4 flex flex-col justify-start items-start divide-y dark:divide-zinc-800">
+              {collectionShares.map((collectionShare) => (
+                <div key={collectionShare.id} className="w-full py-2 flex flex-row justify-between items-center gap-2">
+                  <div className="flex flex-col justify-start items-start truncate">
+                    <span className="truncate">{collectionShare.email}</span>
+                    <span className="text-xs text-gray-500">
+                      {collectionShare.viewCount} views · expires {collectionShare.expireTime?.toLocaleString()}
+                    </span>
+                  </div>
+                  <div className="flex flex-row justify-end items-center shrink-0">
+                    <IconButton color="neutral" variant="plain" size="sm" onClick={() => copyGuestLink(collectionShare)}>
+                      <Icon.Clipboard className="w-4 h-auto" />
+                    </IconButton>
+                    <IconButton color="danger" variant="plain" size="sm" onClick={() => handleRevokeBtnClick(collectionShare)}>
+                      <Icon.Trash className="w-4 h-auto" />
+                    </IconButton>
+                  </div>
+                </div>
+              ))}
+            </div>
+          )}
+        </div>
+      </ModalDialog>
+    </Modal>
+  );
+};
+
+export default ShareCollectionDialog;
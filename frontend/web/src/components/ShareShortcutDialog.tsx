@@ -0,0 +1,163 @@
+import { Button, IconButton, Modal, ModalDialog, Option, Select } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { shortcutServiceClient } from "@/grpcweb";
+import useLoading from "@/hooks/useLoading";
+import { useUserStore } from "@/stores";
+import { State } from "@/types/proto/api/v1/common";
+import { Shortcut, ShortcutACL, ShortcutACL_Role } from "@/types/proto/api/v1/shortcut_service";
+import Icon from "./Icon";
+
+interface Props {
+  shortcut: Shortcut;
+  onClose: () => void;
+}
+
+const roleOptions = [
+  {
+    label: "Can view",
+    value: ShortcutACL_Role.READ,
+  },
+  {
+    label: "Can edit",
+    value: ShortcutACL_Role.EDIT,
+  },
+];
+
+const ShareShortcutDialog: React.FC<Props> = (props: Props) => {
+  const { shortcut, onClose } = props;
+  const userStore = useUserStore();
+  const [userId, setUserId] = useState<number>();
+  const [role, setRole] = useState<ShortcutACL_Role>(ShortcutACL_Role.READ);
+  const [shortcutACLs, setShortcutACLs] = useState<ShortcutACL[]>([]);
+  const requestState = useLoading(false);
+  const candidates = Object.values(userStore.userMapById).filter(
+    (user) => user.id !== shortcut.creatorId && user.state !== State.INACTIVE && !shortcutACLs.some((acl) => acl.userId === user.id),
+  );
+
+  useEffect(() => {
+    userStore.fetchUserList();
+    shortcutServiceClient.listShortcutACLs({ shortcutId: shortcut.id }).then(({ acls }) => {
+      setShortcutACLs(acls);
+    });
+  }, [shortcut.id]);
+
+  const upsertShortcutACL = async (userId: number, role: ShortcutACL_Role) => {
+    try {
+      const shortcutACL = await shortcutServiceClient.upsertShortcutACL({
+        shortcutId: shortcut.id,
+        acl: { userId, role },
+      });
+      const index = shortcutACLs.findIndex((acl) => acl.userId === userId);
+      setShortcutACLs(index === -1 ? [...shortcutACLs, shortcutACL] : shortcutACLs.map((acl, i) => (i === index ? shortcutACL : acl)));
+      return true;
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+      return false;
+    }
+  };
+
+  const handleShareBtnClick = async () => {
+    if (!userId) {
+      return;
+    }
+
+    requestState.setLoading();
+    if (await upsertShortcutACL(userId, role)) {
+      setUserId(undefined);
+    }
+    requestState.setFinish();
+  };
+
+  const handleRemoveBtnClick = async (shortcutACL: ShortcutACL) => {
+    try {
+      await shortcutServiceClient.deleteShortcutACL({
+        shortcutId: shortcut.id,
+        userId: shortcutACL.userId,
+      });
+      setShortcutACLs(shortcutACLs.filter((acl) => acl.userId !== shortcutACL.userId));
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <Modal open={true}>
+      <ModalDialog>
+        <div className="flex flex-row justify-between items-center w-96 max-w-full">
+          <span className="text-lg font-medium">Share with people</span>
+          <Button variant="plain" onClick={onClose}>
+            <Icon.X className="w-5 h-auto text-gray-600" />
+          </Button>
+        </div>
+        <div className="w-96 max-w-full">
+          <p className="mb-3 text-sm text-gray-500">
+            The people you share <span className="font-mono">{shortcut.name}</span> with can use it whatever its visibility, e.g. when
+            it's private.
+          </p>
+          <div className="w-full flex flex-row justify-start items-center gap-2">
+            <Select className="grow" placeholder="Select a user" value={userId ?? null} onChange={(_, value) => setUserId(value as number)}>
+              {candidates.map((user) => (
+                <Option key={user.id} value={user.id}>
+                  {user.nickname} ({user.email})
+                </Option>
+              ))}
+            </Select>
+            <Select className="shrink-0" value={role} onChange={(_, value) => value && setRole(value)}>
+              {roleOptions.map((option) => (
+                <Option key={option.value} value={option.value}>
+                  {option.label}
+                </Option>
+              ))}
+            </Select>
+          </div>
+          <div className="w-full flex flex-row justify-end items-center mt-4 space-x-2">
+            <Button
+              color="primary"
+              disabled={!userId || requestState.isLoading}
+              loading={requestState.isLoading}
+              onClick={handleShareBtnClick}
+            >
+              Share
+            </Button>
+          </div>
+          {shortcutACLs.length > 0 && (
+            <div className="w-full mt-4 flex flex-col justify-start items-start divide-y dark:divide-zinc-800">
+              {shortcutACLs.map((shortcutACL) => {
+                const user = userStore.getUserById(shortcutACL.userId);
+                return (
+                  <div key={shortcutACL.userId} className="w-full py-2 flex flex-row justify-between items-center gap-2">
+                    <div className="flex flex-col justify-start items-start truncate">
+                      <span className="truncate">{user?.nickname}</span>
+                      <span className="text-xs text-gray-500 truncate">{user?.email}</span>
+                    </div>
+                    <div className="flex flex-row justify-end items-center shrink-0 gap-1">
+                      <Select
+                        size="sm"
+                        value={shortcutACL.role}
+                        onChange={(_, value) => value && value !== shortcutACL.role && upsertShortcutACL(shortcutACL.userId, value)}
+                      >
+                        {roleOptions.map((option) => (
+                          <Option key={option.value} value={option.value}>
+                            {option.label}
+                          </Option>
+                        ))}
+                      </Select>
+                      <IconButton color="danger" variant="plain" size="sm" onClick={() => handleRemoveBtnClick(shortcutACL)}>
+                        <Icon.Trash className="w-4 h-auto" />
+                      </IconButton>
+                    </div>
+                  </div>
+                );
+              })}
+            </div>
+          )}
+        </div>
+      </ModalDialog>
+    </Modal>
+  );
+};
+
+export default ShareShortcutDialog;
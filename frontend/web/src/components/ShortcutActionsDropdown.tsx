@@ -8,6 +8,8 @@ import { showCommonDialog } from "./Alert";
 import CreateShortcutDrawer from "./CreateShortcutDrawer";
 import GenerateQRCodeDialog from "./GenerateQRCodeDialog";
 import Icon from "./Icon";
+import ShareShortcutDialog from "./ShareShortcutDialog";
+import TransferOwnershipDialog from "./TransferOwnershipDialog";
 import Dropdown from "./common/Dropdown";
 
 interface Props {
@@ -22,6 +24,8 @@ const ShortcutActionsDropdown = (props: Props) => {
   const currentUser = useUserStore().getCurrentUser();
   const [showEditDrawer, setShowEditDrawer] = useState<boolean>(false);
   const [showQRCodeDialog, setShowQRCodeDialog] = useState<boolean>(false);
+  const [showTransferDialog, setShowTransferDialog] = useState<boolean>(false);
+  const [showShareDialog, setShowShareDialog] = useState<boolean>(false);
   const havePermission = currentUser.role === Role.ADMIN || shortcut.creatorId === currentUser.id;
 
   const handleDeleteShortcutButtonClick = (shortcut: Shortcut) => {
@@ -65,6 +69,22 @@ const ShortcutActionsDropdown = (props: Props) => {
             >
               <Icon.BarChart2 className="w-4 h-auto mr-2 opacity-70" /> {t("analytics.self")}
             </button>
+            {havePermission && (
+              <button
+                className="w-full px-2 flex flex-row justify-start items-center text-left leading-8 cursor-pointer rounded hover:bg-gray-100 disabled:cursor-not-allowed disabled:bg-gray-100 disabled:opacity-60 dark:hover:bg-zinc-800"
+                onClick={() => setShowShareDialog(true)}
+              >
+                <Icon.UserPlus className="w-4 h-auto mr-2 opacity-70" /> Share
+              </button>
+            )}
+            {havePermission && (
+              <button
+                className="w-full px-2 flex flex-row justify-start items-center text-left leading-8 cursor-pointer rounded hover:bg-gray-100 disabled:cursor-not-allowed disabled:bg-gray-100 disabled:opacity-60 dark:hover:bg-zinc-800"
+                onClick={() => setShowTransferDialog(true)}
+              >
+                <Icon.ArrowRightLeft className="w-4 h-auto mr-2 opacity-70" /> Transfer
+              </button>
+            )}
             {havePermission && (
               <button
                 className="w-full px-2 flex flex-row justify-start items-center text-left leading-8 cursor-pointer rounded text-red-600 hover:bg-gray-100 disabled:cursor-not-allowed disabled:bg-gray-100 disabled:opacity-60 dark:hover:bg-zinc-800"
@@ -88,6 +108,17 @@ const ShortcutActionsDropdown = (props: Props) => {
       )}
 
       {showQRCodeDialog && <GenerateQRCodeDialog shortcut={shortcut} onClose={() => setShowQRCodeDialog(false)} />}
+
+      {showShareDialog && <ShareShortcutDialog shortcut={shortcut} onClose={() => setShowShareDialog(false)} />}
+
+      {showTransferDialog && (
+        <TransferOwnershipDialog
+          name={shortcut.name}
+          creatorId={shortcut.creatorId}
+          onTransfer={(userId) => shortcutStore.transferShortcut(shortcut.id, userId)}
+          onClose={() => setShowTransferDialog(false)}
+        />
+      )}
     </>
   );
 };
@@ -47,7 +47,7 @@ const ShortcutCard = (props: Props) => {
             to={`/shortcut/${shortcut.id}`}
             viewTransition
           >
-            <LinkFavicon url={shortcut.link} />
+            <LinkFavicon url={shortcut.link} favicon={shortcut.ogMetadata?.favicon} />
           </Link>
           <div className="ml-2 w-[calc(100%-24px)] flex flex-col justify-start items-start">
             <div className="w-full flex flex-row justify-start items-center leading-tight">
@@ -113,6 +113,7 @@ const ShortcutCard = (props: Props) => {
             sx={{
               "--Avatar-size": "24px",
             }}
+            src={absolutifyLink(creator.avatarUrl)}
             alt={creator.nickname.toUpperCase()}
           ></Avatar>
         </Tooltip>
@@ -135,6 +136,19 @@ const ShortcutCard = (props: Props) => {
             {t("shortcut.visits", { count: shortcut.viewCount })}
           </Link>
         </Tooltip>
+        {shortcut.linkHealth?.broken && (
+          <Tooltip
+            title={`Broken link: ${shortcut.linkHealth.error || shortcut.linkHealth.statusCode}`}
+            variant="solid"
+            placement="top"
+            arrow
+          >
+            <div className="w-auto leading-5 flex flex-row justify-start items-center flex-nowrap whitespace-nowrap text-red-500 text-sm">
+              <Icon.Unlink className="w-4 h-auto mr-1 opacity-70" />
+              Broken
+            </div>
+          </Tooltip>
+        )}
       </div>
     </div>
   );
@@ -1,24 +1,29 @@
 import { Divider } from "@mui/joy";
 import classNames from "classnames";
 import { Link } from "react-router-dom";
+import { getShortcutPath } from "@/helpers/utils";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 import Icon from "./Icon";
 import LinkFavicon from "./LinkFavicon";
 
 interface Props {
   shortcut: Shortcut;
+  // The name of the collection the shortcut is opened from.
+  collectionName?: string;
+  // Whether to open the link directly, e.g. for the guests who can't open the shortcut.
+  openLink?: boolean;
 }
 
-const ShortcutFrame = ({ shortcut }: Props) => {
+const ShortcutFrame = ({ shortcut, collectionName, openLink }: Props) => {
   return (
     <div className="w-full h-full flex flex-col justify-center items-center p-8">
       <Link
         className="w-72 max-w-full border dark:border-zinc-900 dark:bg-zinc-900 p-6 pb-4 rounded-2xl shadow-xl dark:text-gray-400 hover:opacity-80"
-        to={`/s/${shortcut.name}`}
+        to={openLink ? shortcut.link : getShortcutPath(shortcut.name, collectionName)}
         target="_blank"
       >
         <div className={classNames("w-12 h-12 flex justify-center items-center overflow-clip rounded-lg shrink-0")}>
-          <LinkFavicon url={shortcut.link} />
+          <LinkFavicon url={shortcut.link} favicon={shortcut.ogMetadata?.favicon} />
         </div>
         <p className="text-lg font-medium leading-8 mt-2 truncate">{shortcut.title || shortcut.name}</p>
         <p className="text-gray-500 truncate">{shortcut.description}</p>
@@ -0,0 +1,127 @@
+import { Button, IconButton, Input } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { shortcutServiceClient } from "@/grpcweb";
+import useLoading from "@/hooks/useLoading";
+import { useShortcutStore } from "@/stores";
+import { Shortcut, ShortcutRotation } from "@/types/proto/api/v1/shortcut_service";
+import Icon from "./Icon";
+
+interface Props {
+  shortcut: Shortcut;
+}
+
+const ShortcutRotationView = (props: Props) => {
+  const { shortcut } = props;
+  const shortcutStore = useShortcutStore();
+  const [shortcutRotations, setShortcutRotations] = useState<ShortcutRotation[]>([]);
+  const [link, setLink] = useState<string>("");
+  const [startTime, setStartTime] = useState<string>("");
+  const [endTime, setEndTime] = useState<string>("");
+  const requestState = useLoading(false);
+  const now = new Date();
+  // The rotations are ordered by start time, the latest first, so the first active one wins like on the server.
+  const activeShortcutRotation = shortcutRotations.find(
+    ({ startTime, endTime }) => startTime && startTime <= now && !(endTime && endTime <= now),
+  );
+
+  const fetchShortcutRotations = async () => {
+    const { rotations } = await shortcutServiceClient.listShortcutRotations({ shortcutId: shortcut.id });
+    setShortcutRotations(rotations);
+  };
+
+  useEffect(() => {
+    fetchShortcutRotations();
+  }, [shortcut.id]);
+
+  const handleAddButtonClick = async () => {
+    if (!link || !startTime) {
+      toast.error("Please fill in the link and the start time.");
+      return;
+    }
+
+    requestState.setLoading();
+    try {
+      await shortcutServiceClient.createShortcutRotation({
+        shortcutId: shortcut.id,
+        rotation: {
+          link,
+          startTime: new Date(startTime),
+          endTime: endTime ? new Date(endTime) : undefined,
+        },
+      });
+      setLink("");
+      setStartTime("");
+      setEndTime("");
+      await fetchShortcutRotations();
+      await shortcutStore.fetchShortcutById(shortcut.id);
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+    requestState.setFinish();
+  };
+
+  const handleDeleteButtonClick = async (shortcutRotation: ShortcutRotation) => {
+    await shortcutServiceClient.deleteShortcutRotation({
+      shortcutId: shortcut.id,
+      id: shortcutRotation.id,
+    });
+    await fetchShortcutRotations();
+    await shortcutStore.fetchShortcutById(shortcut.id);
+  };
+
+  return (
+    <div className="w-full flex flex-col mt-8">
+      <h3 className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+        <Icon.CalendarClock className="w-6 h-auto mr-1" />
+        Rotation
+      </h3>
+      <p className="pl-1 mt-1 text-sm text-gray-500">
+        Schedule links for the shortcut to resolve to during a time range, e.g. this week&apos;s menu. Outside of them, it resolves to
+        its link. Now it resolves to <span className="break-all">{shortcut.currentLink}</span>.
+      </p>
+      <div className="mt-4 w-full flex flex-col justify-start items-start gap-2">
+        {shortcutRotations.map((shortcutRotation) => {
+          const isActive = shortcutRotation.id === activeShortcutRotation?.id;
+          const isPast = shortcutRotation.endTime && shortcutRotation.endTime <= now;
+          return (
+            <div
+              key={shortcutRotation.id}
+              className="w-full flex flex-row justify-between items-center border rounded-lg px-3 py-2 dark:border-zinc-800"
+            >
+              <div className="flex flex-col justify-start items-start">
+                <span className={isPast ? "break-all text-gray-400 line-through" : "break-all dark:text-gray-400"}>
+                  {shortcutRotation.link}
+                  {isActive && <span className="ml-2 text-xs text-green-600">Active</span>}
+                </span>
+                <span className="text-sm text-gray-500">
+                  {shortcutRotation.startTime?.toLocaleString()} - {shortcutRotation.endTime?.toLocaleString() || "until the next rotation"}
+                </span>
+              </div>
+              <IconButton size="sm" variant="plain" color="danger" onClick={() => handleDeleteButtonClick(shortcutRotation)}>
+                <Icon.Trash className="w-4 h-auto" />
+              </IconButton>
+            </div>
+          );
+        })}
+        <div className="w-full flex flex-row justify-start items-center flex-wrap gap-2">
+          <Input
+            className="grow"
+            type="text"
+            placeholder="https://example.com/menu/week-1"
+            value={link}
+            onChange={(e) => setLink(e.target.value)}
+          />
+          <Input type="datetime-local" value={startTime} onChange={(e) => setStartTime(e.target.value)} />
+          <Input type="datetime-local" value={endTime} onChange={(e) => setEndTime(e.target.value)} />
+          <Button disabled={requestState.isLoading} loading={requestState.isLoading} onClick={handleAddButtonClick}>
+            Add
+          </Button>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default ShortcutRotationView;
@@ -25,7 +25,7 @@ const ShortcutView = (props: Props) => {
       onClick={onClick}
     >
       <div className={classNames("w-5 h-5 flex justify-center items-center overflow-clip shrink-0")}>
-        <LinkFavicon url={shortcut.link} />
+        <LinkFavicon url={shortcut.link} favicon={shortcut.ogMetadata?.favicon} />
       </div>
       <div className="ml-2 w-full truncate">
         {shortcut.title ? (
@@ -0,0 +1,61 @@
+import { Option, Select } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+import { teamServiceClient } from "@/grpcweb";
+import { Visibility } from "@/types/proto/api/v1/common";
+import { Team } from "@/types/proto/api/v1/team_service";
+
+interface Props {
+  visibility: Visibility;
+  teamId: number;
+  onChange: (visibility: Visibility, teamId: number) => void;
+}
+
+// privateValue is the option value of the private visibility, as the team ids are positive.
+const privateValue = -1;
+
+// TeamSelect restricts a non public shortcut or collection to a team, or makes it private.
+const TeamSelect = (props: Props) => {
+  const { visibility, teamId, onChange } = props;
+  const { t } = useTranslation();
+  const [teams, setTeams] = useState<Team[]>([]);
+
+  useEffect(() => {
+    teamServiceClient.listTeams({}).then(({ teams }) => {
+      setTeams(teams);
+    });
+  }, []);
+
+  if (visibility === Visibility.PUBLIC) {
+    return null;
+  }
+
+  const value = visibility === Visibility.TEAM ? teamId : visibility === Visibility.PRIVATE ? privateValue : 0;
+  const handleChange = (value: number | null) => {
+    if (value === privateValue) {
+      onChange(Visibility.PRIVATE, 0);
+    } else if (value) {
+      onChange(Visibility.TEAM, value);
+    } else {
+      onChange(Visibility.WORKSPACE, 0);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col justify-start items-start mb-3">
+      <span className="mb-2">{t("shortcut.visibility.audience")}</span>
+      <Select className="w-full" value={value} onChange={(_, value) => handleChange(value)}>
+        <Option value={0}>{t("shortcut.visibility.workspace.description")}</Option>
+        <Option value={privateValue}>{t("shortcut.visibility.private.description")}</Option>
+        {teams.map((team) => (
+          <Option key={team.id} value={team.id}>
+            {team.name}
+          </Option>
+        ))}
+      </Select>
+      {visibility === Visibility.TEAM && <p className="mt-1 text-sm text-gray-500">{t("shortcut.visibility.team.description")}</p>}
+    </div>
+  );
+};
+
+export default TeamSelect;
@@ -0,0 +1,89 @@
+import { Button, Modal, ModalDialog, Option, Select } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import useLoading from "@/hooks/useLoading";
+import { useUserStore } from "@/stores";
+import { State } from "@/types/proto/api/v1/common";
+import Icon from "./Icon";
+
+interface Props {
+  // The name of the transferred shortcut or collection.
+  name: string;
+  creatorId: number;
+  onTransfer: (userId: number) => Promise<unknown>;
+  onClose: () => void;
+}
+
+const TransferOwnershipDialog: React.FC<Props> = (props: Props) => {
+  const { name, creatorId, onTransfer, onClose } = props;
+  const { t } = useTranslation();
+  const userStore = useUserStore();
+  const [userId, setUserId] = useState<number>();
+  const requestState = useLoading(false);
+  const recipients = Object.values(userStore.userMapById).filter((user) => user.id !== creatorId && user.state !== State.INACTIVE);
+
+  useEffect(() => {
+    userStore.fetchUserList();
+  }, []);
+
+  const handleTransferBtnClick = async () => {
+    if (!userId) {
+      return;
+    }
+
+    requestState.setLoading();
+    try {
+      await onTransfer(userId);
+      toast.success(`Transferred \`${name}\` to ${userStore.getUserById(userId).nickname}`);
+      onClose();
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+    requestState.setFinish();
+  };
+
+  return (
+    <Modal open={true}>
+      <ModalDialog>
+        <div className="flex flex-row justify-between items-center w-80">
+          <span className="text-lg font-medium">Transfer Ownership</span>
+          <Button variant="plain" onClick={onClose}>
+            <Icon.X className="w-5 h-auto text-gray-600" />
+          </Button>
+        </div>
+        <div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">
+              New owner of <span className="font-mono">{name}</span>
+            </span>
+            <Select className="w-full" placeholder="Select a user" value={userId} onChange={(_, value) => setUserId(value as number)}>
+              {recipients.map((user) => (
+                <Option key={user.id} value={user.id}>
+                  {user.nickname} ({user.email})
+                </Option>
+              ))}
+            </Select>
+            <p className="mt-2 text-sm text-gray-500 leading-tight">You can no longer edit it unless you are an admin.</p>
+          </div>
+          <div className="w-full flex flex-row justify-end items-center space-x-2">
+            <Button variant="plain" disabled={requestState.isLoading} onClick={onClose}>
+              {t("common.cancel")}
+            </Button>
+            <Button
+              color="primary"
+              disabled={!userId || requestState.isLoading}
+              loading={requestState.isLoading}
+              onClick={handleTransferBtnClick}
+            >
+              Transfer
+            </Button>
+          </div>
+        </div>
+      </ModalDialog>
+    </Modal>
+  );
+};
+
+export default TransferOwnershipDialog;
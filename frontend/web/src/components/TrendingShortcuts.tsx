@@ -0,0 +1,46 @@
+import { absolutifyLink } from "@/helpers/utils";
+import { GetTrendingShortcutsResponse_TrendingShortcut } from "@/types/proto/api/v1/shortcut_service";
+import Icon from "./Icon";
+import LinkFavicon from "./LinkFavicon";
+
+interface Props {
+  trendingShortcuts: GetTrendingShortcutsResponse_TrendingShortcut[];
+}
+
+const TrendingShortcuts = (props: Props) => {
+  const { trendingShortcuts } = props;
+
+  if (trendingShortcuts.length === 0) {
+    return null;
+  }
+
+  return (
+    <div className="w-full flex flex-col justify-start items-start mb-4">
+      <p className="flex flex-row justify-start items-center text-sm text-gray-500 mb-2">
+        <Icon.TrendingUp className="w-4 h-auto mr-1" />
+        Trending in your workspace
+      </p>
+      <div className="w-full flex flex-row justify-start items-center gap-2 overflow-x-auto">
+        {trendingShortcuts.map(({ shortcut, viewCount, previousViewCount }) => {
+          if (!shortcut) {
+            return null;
+          }
+          return (
+            <a
+              key={shortcut.id}
+              className="shrink-0 flex flex-row justify-start items-center gap-1 px-3 py-1 rounded-full border dark:border-zinc-800 hover:bg-gray-100 dark:hover:bg-zinc-800"
+              href={absolutifyLink(`/s/${shortcut.name}`)}
+              target="_blank"
+            >
+              <LinkFavicon url={shortcut.link} favicon={shortcut.ogMetadata?.favicon} />
+              <span className="max-w-[10rem] truncate dark:text-gray-400">{shortcut.title || shortcut.name}</span>
+              <span className="text-xs text-green-600">+{viewCount - previousViewCount}</span>
+            </a>
+          );
+        })}
+      </div>
+    </div>
+  );
+};
+
+export default TrendingShortcuts;
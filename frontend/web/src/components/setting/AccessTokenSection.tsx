@@ -1,7 +1,5 @@
 import { Button, IconButton } from "@mui/joy";
-import copy from "copy-to-clipboard";
 import { useEffect, useState } from "react";
-import { toast } from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { showCommonDialog } from "@/components/Alert";
 import CreateAccessTokenDialog from "@/components/CreateAccessTokenDialog";
@@ -34,30 +32,21 @@ const AccessTokenSection = () => {
     setUserAccessTokens(accessTokens);
   };
 
-  const copyAccessToken = (accessToken: string) => {
-    copy(accessToken);
-    toast.success("Access token copied to clipboard");
-  };
-
-  const handleDeleteAccessToken = async (accessToken: string) => {
+  const handleDeleteAccessToken = async (userAccessToken: UserAccessToken) => {
     showCommonDialog({
       title: "Delete Access Token",
-      content: `Are you sure to delete access token \`${getFormatedAccessToken(accessToken)}\`? You cannot undo this action.`,
+      content: `Are you sure to delete access token \`${userAccessToken.description}\`? You cannot undo this action.`,
       style: "danger",
       onConfirm: async () => {
         await userServiceClient.deleteUserAccessToken({
           id: currentUser.id,
-          accessToken: accessToken,
+          accessToken: userAccessToken.id,
         });
-        setUserAccessTokens(userAccessTokens.filter((token) => token.accessToken !== accessToken));
+        setUserAccessTokens(userAccessTokens.filter((token) => token.id !== userAccessToken.id));
       },
     });
   };
 
-  const getFormatedAccessToken = (accessToken: string) => {
-    return `${accessToken.slice(0, 4)}****${accessToken.slice(-4)}`;
-  };
-
   return (
     <>
       <div className="w-full flex flex-col justify-start items-start space-y-4">
@@ -85,12 +74,12 @@ const AccessTokenSection = () => {
                 <table className="min-w-full divide-y divide-gray-300 dark:divide-zinc-700">
                   <thead>
                     <tr>
-                      <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
-                        Token
-                      </th>
                       <th scope="col" className="py-3.5 pl-4 pr-3 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
                         Description
                       </th>
+                      <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                        Scopes
+                      </th>
                       <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
                         Created At
                       </th>
@@ -104,16 +93,13 @@ const AccessTokenSection = () => {
                   </thead>
                   <tbody className="divide-y divide-gray-200 dark:divide-zinc-800">
                     {userAccessTokens.map((userAccessToken) => (
-                      <tr key={userAccessToken.accessToken}>
-                        <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-900 flex flex-row justify-start items-center gap-x-1 dark:text-gray-500">
-                          <span className="font-mono">{getFormatedAccessToken(userAccessToken.accessToken)}</span>
-                          <Button color="neutral" variant="plain" size="sm" onClick={() => copyAccessToken(userAccessToken.accessToken)}>
-                            <Icon.Clipboard className="w-4 h-auto text-gray-500" />
-                          </Button>
-                        </td>
+                      <tr key={userAccessToken.id}>
                         <td className="whitespace-nowrap py-4 pl-4 pr-3 text-sm text-gray-900 dark:text-gray-500">
                           {userAccessToken.description}
                         </td>
+                        <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">
+                          {userAccessToken.scopes.length > 0 ? userAccessToken.scopes.join(", ") : "Full access"}
+                        </td>
                         <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">{userAccessToken.issuedAt?.toLocaleString()}</td>
                         <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">
                           {userAccessToken.expiresAt?.toLocaleString() ?? "Never"}
@@ -124,7 +110,7 @@ const AccessTokenSection = () => {
                             variant="plain"
                             size="sm"
                             onClick={() => {
-                              handleDeleteAccessToken(userAccessToken.accessToken);
+                              handleDeleteAccessToken(userAccessToken);
                             }}
                           >
                             <Icon.Trash className="w-4 h-auto" />
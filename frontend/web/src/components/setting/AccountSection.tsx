@@ -1,23 +1,51 @@
-import { Button } from "@mui/joy";
-import { useState } from "react";
+import { Avatar, Button } from "@mui/joy";
+import { useRef, useState } from "react";
+import { toast } from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import ChangePasswordDialog from "@/components/ChangePasswordDialog";
+import DeleteAccountDialog from "@/components/DeleteAccountDialog";
 import EditUserinfoDialog from "@/components/EditUserinfoDialog";
+import { absolutifyLink } from "@/helpers/utils";
 import { useUserStore } from "@/stores";
 import { Role } from "@/types/proto/api/v1/user_service";
 
 const AccountSection: React.FC = () => {
   const { t } = useTranslation();
-  const currentUser = useUserStore().getCurrentUser();
+  const userStore = useUserStore();
+  const currentUser = userStore.getCurrentUser();
   const [showEditUserinfoDialog, setShowEditUserinfoDialog] = useState<boolean>(false);
   const [showChangePasswordDialog, setShowChangePasswordDialog] = useState<boolean>(false);
+  const [showDeleteAccountDialog, setShowDeleteAccountDialog] = useState<boolean>(false);
+  const avatarInputRef = useRef<HTMLInputElement>(null);
   const isAdmin = currentUser.role === Role.ADMIN;
 
+  const updateAvatarUrl = async (avatarUrl: string) => {
+    try {
+      await userStore.patchUser({ id: currentUser.id, avatarUrl }, ["avatar_url"]);
+      toast.success("Avatar updated");
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  const handleAvatarFileChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
+    const file = e.target.files?.[0];
+    e.target.value = "";
+    if (!file) {
+      return;
+    }
+    const reader = new FileReader();
+    reader.onload = () => updateAvatarUrl(reader.result as string);
+    reader.readAsDataURL(file);
+  };
+
   return (
     <>
       <div className="w-full flex flex-col justify-start items-start gap-y-2">
         <p className="text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">{t("common.account")}</p>
         <p className="flex flex-row justify-start items-center mt-2 dark:text-gray-400">
+          <Avatar className="mr-2" size="sm" src={absolutifyLink(currentUser.avatarUrl)} alt={currentUser.nickname.toUpperCase()} />
           <span className="text-xl">{currentUser.nickname}</span>
           {isAdmin && <span className="ml-2 bg-blue-600 text-white px-2 leading-6 text-sm rounded-full">Admin</span>}
         </p>
@@ -25,6 +53,12 @@ const AccountSection: React.FC = () => {
           <span className="mr-3 text-gray-500">{t("common.email")}: </span>
           {currentUser.email}
         </p>
+        <p className="flex flex-row justify-start items-center dark:text-gray-400">
+          <span className="mr-3 text-gray-500">Profile: </span>
+          <a className="text-blue-600 hover:underline" href={absolutifyLink(`/u/${currentUser.username}`)} target="_blank">
+            /u/{currentUser.username}
+          </a>
+        </p>
         <div className="flex flex-row justify-start items-center gap-2 mt-2">
           <Button variant="outlined" color="neutral" onClick={() => setShowEditUserinfoDialog(true)}>
             {t("common.edit")}
@@ -32,12 +66,21 @@ const AccountSection: React.FC = () => {
           <Button variant="outlined" color="neutral" onClick={() => setShowChangePasswordDialog(true)}>
             Change password
           </Button>
+          <Button variant="outlined" color="neutral" onClick={() => avatarInputRef.current?.click()}>
+            Change avatar
+          </Button>
+          <input ref={avatarInputRef} className="hidden" type="file" accept="image/png,image/jpeg,image/gif,image/webp" onChange={handleAvatarFileChanged} />
+          <Button variant="outlined" color="danger" onClick={() => setShowDeleteAccountDialog(true)}>
+            Delete account
+          </Button>
         </div>
       </div>
 
       {showEditUserinfoDialog && <EditUserinfoDialog onClose={() => setShowEditUserinfoDialog(false)} />}
 
       {showChangePasswordDialog && <ChangePasswordDialog onClose={() => setShowChangePasswordDialog(false)} />}
+
+      {showDeleteAccountDialog && <DeleteAccountDialog onClose={() => setShowDeleteAccountDialog(false)} />}
     </>
   );
 };
@@ -0,0 +1,84 @@
+import { Button, Textarea } from "@mui/joy";
+import { useRef, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { workspaceServiceClient } from "@/grpcweb";
+import { useWorkspaceStore } from "@/stores";
+import { CollectionTemplate } from "@/types/proto/api/v1/collection_service";
+import { WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+
+const stringifyCollectionTemplates = (collectionTemplates: CollectionTemplate[]) => {
+  if (collectionTemplates.length === 0) {
+    return "";
+  }
+  return JSON.stringify(
+    collectionTemplates.map((template) => CollectionTemplate.toJSON(template)),
+    null,
+    2,
+  );
+};
+
+const CollectionTemplateSection = () => {
+  const { t } = useTranslation();
+  const workspaceStore = useWorkspaceStore();
+  const [content, setContent] = useState<string>(stringifyCollectionTemplates(workspaceStore.setting.collectionTemplates));
+  const originalContent = useRef<string>(content);
+  const allowSave = originalContent.current !== content;
+
+  const handleSave = async () => {
+    let collectionTemplates: CollectionTemplate[] = [];
+    try {
+      const parsed = content.trim() ? JSON.parse(content) : [];
+      if (!Array.isArray(parsed)) {
+        throw new Error("not an array");
+      }
+      collectionTemplates = parsed.map((template) => CollectionTemplate.fromJSON(template));
+    } catch (error: any) {
+      toast.error(`Invalid templates: ${error.message}`);
+      return;
+    }
+
+    try {
+      const setting = await workspaceServiceClient.updateWorkspaceSetting({
+        setting: WorkspaceSetting.fromPartial({ collectionTemplates }),
+        updateMask: ["collection_templates"],
+      });
+      const updated = stringifyCollectionTemplates(setting.collectionTemplates);
+      setContent(updated);
+      originalContent.current = updated;
+      await workspaceStore.fetchWorkspaceSetting();
+      toast.success("Workspace setting saved successfully");
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Collection templates</p>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <div className="w-full flex flex-col justify-start items-start gap-1">
+          <p className="text-sm text-gray-500 leading-tight">
+            The templates of your workspace, besides the built-in ones, as a JSON array. The placeholders <code>{"{name}"}</code> and{" "}
+            <code>{"{title}"}</code> in the shortcuts are replaced by the name and the title of the new collection.
+          </p>
+          <Textarea
+            className="w-full font-mono"
+            minRows={4}
+            maxRows={16}
+            placeholder={`[{"id": "launch", "title": "Product launch", "shortcuts": [{"name": "{name}-brief", "link": "https://docs.example.com/{name}"}]}]`}
+            value={content}
+            onChange={(event) => setContent(event.target.value)}
+          />
+        </div>
+        <div>
+          <Button color="primary" disabled={!allowSave} onClick={handleSave}>
+            {t("common.save")}
+          </Button>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default CollectionTemplateSection;
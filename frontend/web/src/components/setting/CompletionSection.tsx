@@ -0,0 +1,83 @@
+import { Button, Input } from "@mui/joy";
+import { isEqual } from "lodash-es";
+import { useRef, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { workspaceServiceClient } from "@/grpcweb";
+import { useWorkspaceStore } from "@/stores";
+import { CompletionSetting, WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+
+const CompletionSection = () => {
+  const { t } = useTranslation();
+  const workspaceStore = useWorkspaceStore();
+  const [completion, setCompletion] = useState<CompletionSetting>(CompletionSetting.fromPartial(workspaceStore.setting.completion || {}));
+  const originalCompletion = useRef<CompletionSetting>(completion);
+  const allowSave = !isEqual(originalCompletion.current, completion);
+
+  const handleCompletionChange = (partial: Partial<CompletionSetting>) => {
+    setCompletion(CompletionSetting.fromPartial({ ...completion, ...partial }));
+  };
+
+  const handleSave = async () => {
+    try {
+      const setting = await workspaceServiceClient.updateWorkspaceSetting({
+        setting: WorkspaceSetting.fromPartial({ completion }),
+        updateMask: ["completion"],
+      });
+      const updated = CompletionSetting.fromPartial(setting.completion || {});
+      setCompletion(updated);
+      originalCompletion.current = updated;
+      await workspaceStore.fetchWorkspaceSetting();
+      toast.success("Workspace setting saved successfully");
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Suggestions</p>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <div className="w-full flex flex-col justify-start items-start gap-1">
+          <p className="font-medium dark:text-gray-400">Completion endpoint</p>
+          <Input
+            className="w-full"
+            placeholder="e.g. https://api.openai.com/v1"
+            value={completion.endpoint}
+            onChange={(event) => handleCompletionChange({ endpoint: event.target.value })}
+          />
+          <p className="text-sm text-gray-500 leading-tight">
+            The OpenAI-compatible API suggesting the title, description and tags of the new shortcuts from their pages with the model, and
+            searching the shortcuts by meaning with the embedding model. Leave it empty to disable them.
+          </p>
+        </div>
+        <div className="w-full flex flex-row justify-start items-center gap-2">
+          <Input
+            className="grow"
+            placeholder="Model, e.g. gpt-4o-mini"
+            value={completion.model}
+            onChange={(event) => handleCompletionChange({ model: event.target.value })}
+          />
+          <Input
+            className="grow"
+            type="password"
+            placeholder="API key"
+            value={completion.apiKey}
+            onChange={(event) => handleCompletionChange({ apiKey: event.target.value })}
+          />
+        </div>
+        <Input
+          className="w-full"
+          placeholder="Embedding model, e.g. text-embedding-3-small"
+          value={completion.embeddingModel}
+          onChange={(event) => handleCompletionChange({ embeddingModel: event.target.value })}
+        />
+        <Button color="primary" disabled={!allowSave} onClick={handleSave}>
+          {t("common.save")}
+        </Button>
+      </div>
+    </div>
+  );
+};
+
+export default CompletionSection;
@@ -0,0 +1,120 @@
+import { Button, IconButton, Input, Switch } from "@mui/joy";
+import { isEqual } from "lodash-es";
+import { useRef, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { v4 as uuidv4 } from "uuid";
+import Icon from "@/components/Icon";
+import { workspaceServiceClient } from "@/grpcweb";
+import { useWorkspaceStore } from "@/stores";
+import { FederationSource, WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+
+const FederationSection = () => {
+  const { t } = useTranslation();
+  const workspaceStore = useWorkspaceStore();
+  const [sources, setSources] = useState<FederationSource[]>(workspaceStore.setting.federationSources);
+  const originalSources = useRef<FederationSource[]>(sources);
+  const allowSave = !isEqual(originalSources.current, sources);
+
+  const handleSourceChange = (id: string, partial: Partial<FederationSource>) => {
+    setSources(sources.map((source) => (source.id === id ? FederationSource.fromPartial({ ...source, ...partial }) : source)));
+  };
+
+  const handleAddSource = () => {
+    setSources([...sources, FederationSource.fromPartial({ id: uuidv4(), enabled: true })]);
+  };
+
+  const handleRemoveSource = (id: string) => {
+    setSources(sources.filter((source) => source.id !== id));
+  };
+
+  const handleSave = async () => {
+    try {
+      const setting = await workspaceServiceClient.updateWorkspaceSetting({
+        setting: WorkspaceSetting.fromPartial({ federationSources: sources }),
+        updateMask: ["federation_sources"],
+      });
+      setSources(setting.federationSources);
+      originalSources.current = setting.federationSources;
+      await workspaceStore.fetchWorkspaceSetting();
+      toast.success("Workspace setting saved successfully");
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Federation</p>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <div className="w-full flex flex-col justify-start items-start">
+          <p className="font-medium dark:text-gray-400">Import from other Slash instances</p>
+          <p className="text-sm text-gray-500 leading-tight">
+            Import the public shortcuts and collections of other instances, e.g. of other departments, with a prefix of their names. The
+            imported ones are kept in sync and deleted when they're removed from the instance.
+          </p>
+        </div>
+        {sources.map((source) => (
+          <div key={source.id} className="w-full flex flex-col justify-start items-start gap-2 border rounded-md p-3 dark:border-zinc-800">
+            <div className="w-full flex flex-row justify-between items-center">
+              <Switch
+                className="dark:text-gray-500"
+                checked={source.enabled}
+                onChange={(event) => handleSourceChange(source.id, { enabled: event.target.checked })}
+                endDecorator={<span>Enabled</span>}
+              />
+              <IconButton color="danger" variant="plain" size="sm" onClick={() => handleRemoveSource(source.id)}>
+                <Icon.Trash className="w-4 h-auto" />
+              </IconButton>
+            </div>
+            <div className="w-full grid grid-cols-1 sm:grid-cols-3 gap-2">
+              <Input
+                className="sm:col-span-2"
+                placeholder="Instance URL, e.g. https://slash.eng.example.com"
+                value={source.url}
+                onChange={(event) => handleSourceChange(source.id, { url: event.target.value })}
+              />
+              <Input
+                placeholder="Prefix, e.g. eng/"
+                value={source.prefix}
+                onChange={(event) => handleSourceChange(source.id, { prefix: event.target.value })}
+              />
+            </div>
+            <div className="w-full grid grid-cols-1 sm:grid-cols-3 gap-2">
+              <Input
+                className="sm:col-span-2"
+                type="password"
+                placeholder="Access token of the instance"
+                value={source.accessToken}
+                onChange={(event) => handleSourceChange(source.id, { accessToken: event.target.value })}
+              />
+              <Input
+                type="number"
+                placeholder="Interval, 60 by default"
+                endDecorator="min"
+                value={source.intervalMinutes || ""}
+                onChange={(event) => handleSourceChange(source.id, { intervalMinutes: Number(event.target.value) })}
+              />
+            </div>
+            {source.lastSyncTime && (
+              <p className="text-sm text-gray-500">
+                Last synced {source.lastSyncTime.toLocaleString()}: {source.shortcutCount} shortcuts, {source.collectionCount} collections
+              </p>
+            )}
+            {source.lastError && <p className="text-sm text-red-600 break-all">{source.lastError}</p>}
+          </div>
+        ))}
+        <div className="flex flex-row justify-start items-center gap-2">
+          <Button variant="outlined" color="neutral" startDecorator={<Icon.Plus className="w-4 h-auto" />} onClick={handleAddSource}>
+            Add instance
+          </Button>
+          <Button color="primary" disabled={!allowSave} onClick={handleSave}>
+            {t("common.save")}
+          </Button>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default FederationSection;
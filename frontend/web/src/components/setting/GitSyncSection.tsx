@@ -0,0 +1,109 @@
+import { Button, Input, Switch } from "@mui/joy";
+import { isEqual } from "lodash-es";
+import { useRef, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { workspaceServiceClient } from "@/grpcweb";
+import { absolutifyLink } from "@/helpers/utils";
+import { useWorkspaceStore } from "@/stores";
+import { GitSyncSetting, WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+
+const GitSyncSection = () => {
+  const { t } = useTranslation();
+  const workspaceStore = useWorkspaceStore();
+  const [gitSync, setGitSync] = useState<GitSyncSetting>(GitSyncSetting.fromPartial(workspaceStore.setting.gitSync || {}));
+  const originalGitSync = useRef<GitSyncSetting>(gitSync);
+  const allowSave = !isEqual(originalGitSync.current, gitSync);
+
+  const handleGitSyncChange = (partial: Partial<GitSyncSetting>) => {
+    setGitSync(GitSyncSetting.fromPartial({ ...gitSync, ...partial }));
+  };
+
+  const handleSave = async () => {
+    try {
+      const setting = await workspaceServiceClient.updateWorkspaceSetting({
+        setting: WorkspaceSetting.fromPartial({ gitSync }),
+        updateMask: ["git_sync"],
+      });
+      const updated = GitSyncSetting.fromPartial(setting.gitSync || {});
+      setGitSync(updated);
+      originalGitSync.current = updated;
+      await workspaceStore.fetchWorkspaceSetting();
+      toast.success("Workspace setting saved successfully");
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Git sync</p>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Sync shortcuts from GitHub</p>
+            <p className="text-sm text-gray-500 leading-tight">
+              Apply a YAML/JSON file of shortcut definitions from a repository. The shortcuts removed from the file are deleted.
+            </p>
+          </div>
+          <Switch checked={gitSync.enabled} onChange={(event) => handleGitSyncChange({ enabled: event.target.checked })} />
+        </div>
+        {gitSync.enabled && (
+          <>
+            <div className="w-full grid grid-cols-1 sm:grid-cols-3 gap-2">
+              <Input
+                placeholder="Repository, e.g. acme/links"
+                value={gitSync.repository}
+                onChange={(event) => handleGitSyncChange({ repository: event.target.value })}
+              />
+              <Input
+                placeholder="Branch, main by default"
+                value={gitSync.branch}
+                onChange={(event) => handleGitSyncChange({ branch: event.target.value })}
+              />
+              <Input
+                placeholder="Path, e.g. shortcuts.yaml"
+                value={gitSync.path}
+                onChange={(event) => handleGitSyncChange({ path: event.target.value })}
+              />
+            </div>
+            <Input
+              className="w-full"
+              type="password"
+              placeholder="GitHub access token"
+              value={gitSync.accessToken}
+              onChange={(event) => handleGitSyncChange({ accessToken: event.target.value })}
+            />
+            <div className="w-full flex flex-col justify-start items-start gap-1">
+              <Input
+                className="w-full"
+                type="password"
+                placeholder="Webhook secret (optional)"
+                value={gitSync.webhookSecret}
+                onChange={(event) => handleGitSyncChange({ webhookSecret: event.target.value })}
+              />
+              <p className="text-sm text-gray-500 leading-tight">
+                Add a push webhook to <code>{absolutifyLink("/api/v1/git-sync/webhook")}</code> with the secret to sync right away.
+                Otherwise the file is polled every 5 minutes.
+              </p>
+            </div>
+            <Switch
+              className="dark:text-gray-500"
+              checked={gitSync.writeBack}
+              onChange={(event) => handleGitSyncChange({ writeBack: event.target.checked })}
+              endDecorator={<span>Propose the manual changes of the synced shortcuts as pull requests</span>}
+            />
+            {gitSync.lastSyncedSha && <p className="text-sm text-gray-500">Last synced file: {gitSync.lastSyncedSha.slice(0, 7)}</p>}
+          </>
+        )}
+        <div>
+          <Button color="primary" disabled={!allowSave} onClick={handleSave}>
+            {t("common.save")}
+          </Button>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default GitSyncSection;
@@ -0,0 +1,115 @@
+import { Button, Input, Option, Select, Switch, Textarea } from "@mui/joy";
+import { isEqual } from "lodash-es";
+import { useRef, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { workspaceServiceClient } from "@/grpcweb";
+import { useWorkspaceStore } from "@/stores";
+import { LandingSetting, LandingSetting_Type, WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+
+const LandingSection = () => {
+  const { t } = useTranslation();
+  const workspaceStore = useWorkspaceStore();
+  const [landing, setLanding] = useState<LandingSetting>(LandingSetting.fromPartial(workspaceStore.setting.landing || {}));
+  const originalLanding = useRef<LandingSetting>(landing);
+  const allowSave = !isEqual(originalLanding.current, landing);
+
+  const handleLandingChange = (partial: Partial<LandingSetting>) => {
+    setLanding(LandingSetting.fromPartial({ ...landing, ...partial }));
+  };
+
+  const handleSave = async () => {
+    try {
+      const setting = await workspaceServiceClient.updateWorkspaceSetting({
+        setting: WorkspaceSetting.fromPartial({ landing }),
+        updateMask: ["landing"],
+      });
+      const updated = LandingSetting.fromPartial(setting.landing || {});
+      setLanding(updated);
+      originalLanding.current = updated;
+      await workspaceStore.fetchWorkspaceSetting();
+      toast.success("Workspace setting saved successfully");
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Landing page</p>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <div className="w-full flex flex-col justify-start items-start gap-1">
+          <p className="font-medium dark:text-gray-400">Root path</p>
+          <Select
+            className="w-full"
+            value={landing.type}
+            onChange={(_, value) => handleLandingChange({ type: value as LandingSetting_Type })}
+          >
+            <Option value={LandingSetting_Type.TYPE_UNSPECIFIED}>Show the app</Option>
+            <Option value={LandingSetting_Type.COLLECTION}>Redirect to a collection</Option>
+            <Option value={LandingSetting_Type.PAGE}>Show a custom page</Option>
+            <Option value={LandingSetting_Type.REDIRECT}>Redirect to an external url</Option>
+          </Select>
+          <p className="text-sm text-gray-500 leading-tight">
+            What <code>/</code> serves, e.g. when people type <code>go/</code> in the address bar.
+          </p>
+        </div>
+        {landing.type === LandingSetting_Type.COLLECTION && (
+          <div className="w-full flex flex-col justify-start items-start gap-1">
+            <p className="font-medium dark:text-gray-400">Collection name</p>
+            <Input
+              className="w-full"
+              placeholder="e.g. onboarding"
+              value={landing.collectionName}
+              onChange={(event) => handleLandingChange({ collectionName: event.target.value })}
+            />
+          </div>
+        )}
+        {landing.type === LandingSetting_Type.PAGE && (
+          <div className="w-full flex flex-col justify-start items-start gap-1">
+            <p className="font-medium dark:text-gray-400">Page HTML</p>
+            <Textarea
+              className="w-full font-mono"
+              minRows={4}
+              maxRows={12}
+              placeholder="<!doctype html>..."
+              value={landing.pageHtml}
+              onChange={(event) => handleLandingChange({ pageHtml: event.target.value })}
+            />
+          </div>
+        )}
+        {landing.type === LandingSetting_Type.REDIRECT && (
+          <div className="w-full flex flex-col justify-start items-start gap-1">
+            <p className="font-medium dark:text-gray-400">Redirect url</p>
+            <Input
+              className="w-full"
+              placeholder="e.g. https://intranet.example.com"
+              value={landing.redirectUrl}
+              onChange={(event) => handleLandingChange({ redirectUrl: event.target.value })}
+            />
+          </div>
+        )}
+        {landing.type !== LandingSetting_Type.TYPE_UNSPECIFIED && (
+          <div className="w-full flex flex-col justify-start items-start gap-1">
+            <Switch
+              className="dark:text-gray-500"
+              checked={landing.applyToSignedInUsers}
+              onChange={(event) => handleLandingChange({ applyToSignedInUsers: event.target.checked })}
+              endDecorator={<span>Also for signed-in users</span>}
+            />
+            <p className="text-sm text-gray-500 leading-tight">
+              The app stays available at <code>/shortcuts</code>.
+            </p>
+          </div>
+        )}
+        <div>
+          <Button color="primary" disabled={!allowSave} onClick={handleSave}>
+            {t("common.save")}
+          </Button>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default LandingSection;
@@ -0,0 +1,148 @@
+import { Button, Input, Option, Select, Switch } from "@mui/joy";
+import { isEqual } from "lodash-es";
+import { useRef, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { workspaceServiceClient } from "@/grpcweb";
+import { useWorkspaceStore } from "@/stores";
+import { SmtpConfig, SmtpConfig_Encryption, WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+
+const MailSection = () => {
+  const { t } = useTranslation();
+  const workspaceStore = useWorkspaceStore();
+  const [smtp, setSmtp] = useState<SmtpConfig>(SmtpConfig.fromPartial(workspaceStore.setting.smtp || { port: 587 }));
+  const originalSmtp = useRef<SmtpConfig>(smtp);
+  const allowSave = !isEqual(originalSmtp.current, smtp);
+
+  const handleSmtpChange = (partial: Partial<SmtpConfig>) => {
+    setSmtp(SmtpConfig.fromPartial({ ...smtp, ...partial }));
+  };
+
+  const handleSave = async () => {
+    try {
+      const setting = await workspaceServiceClient.updateWorkspaceSetting({
+        setting: WorkspaceSetting.fromPartial({ smtp }),
+        updateMask: ["smtp"],
+      });
+      const updated = SmtpConfig.fromPartial(setting.smtp || {});
+      setSmtp(updated);
+      originalSmtp.current = updated;
+      await workspaceStore.fetchWorkspaceSetting();
+      toast.success("Workspace setting saved successfully");
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  const handleTest = async () => {
+    try {
+      const { ok, checks } = await workspaceServiceClient.testSmtp({
+        smtpConfig: smtp,
+      });
+      if (ok) {
+        toast.success("Test email sent to your email.");
+      } else {
+        const failedChecks = checks.filter((check) => !check.ok).map((check) => `${check.name}: ${check.message}`);
+        toast.error(failedChecks.join("\n"));
+      }
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  const toggleRequireEmailVerification = async (on: boolean) => {
+    try {
+      await workspaceServiceClient.updateWorkspaceSetting({
+        setting: WorkspaceSetting.fromPartial({ requireEmailVerification: on }),
+        updateMask: ["require_email_verification"],
+      });
+      await workspaceStore.fetchWorkspaceSetting();
+      toast.success("Workspace setting saved successfully");
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Mail</p>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <div className="w-full flex flex-col justify-start items-start gap-1">
+          <p className="font-medium dark:text-gray-400">SMTP server</p>
+          <div className="w-full flex flex-row justify-start items-center gap-2">
+            <Input
+              className="grow"
+              placeholder="e.g. smtp.example.com"
+              value={smtp.host}
+              onChange={(event) => handleSmtpChange({ host: event.target.value })}
+            />
+            <Input
+              className="w-24"
+              type="number"
+              placeholder="Port"
+              value={smtp.port || ""}
+              onChange={(event) => handleSmtpChange({ port: Number(event.target.value) })}
+            />
+            <Select
+              className="w-36"
+              value={smtp.encryption}
+              onChange={(_, value) => handleSmtpChange({ encryption: value as SmtpConfig_Encryption })}
+            >
+              <Option value={SmtpConfig_Encryption.ENCRYPTION_UNSPECIFIED}>None</Option>
+              <Option value={SmtpConfig_Encryption.SSL_TLS}>SSL/TLS</Option>
+              <Option value={SmtpConfig_Encryption.STARTTLS}>STARTTLS</Option>
+            </Select>
+          </div>
+          <p className="text-sm text-gray-500 leading-tight">Leave the host empty to disable the emails.</p>
+        </div>
+        <div className="w-full flex flex-row justify-start items-center gap-2">
+          <Input
+            className="grow"
+            placeholder="Username"
+            value={smtp.username}
+            onChange={(event) => handleSmtpChange({ username: event.target.value })}
+          />
+          <Input
+            className="grow"
+            type="password"
+            placeholder="Password"
+            value={smtp.password}
+            onChange={(event) => handleSmtpChange({ password: event.target.value })}
+          />
+        </div>
+        <div className="w-full flex flex-col justify-start items-start gap-1">
+          <p className="font-medium dark:text-gray-400">Sender</p>
+          <Input
+            className="w-full"
+            placeholder="e.g. Slash <noreply@example.com>"
+            value={smtp.from}
+            onChange={(event) => handleSmtpChange({ from: event.target.value })}
+          />
+        </div>
+        <div className="flex flex-row justify-start items-center gap-2">
+          <Button color="primary" disabled={!allowSave} onClick={handleSave}>
+            {t("common.save")}
+          </Button>
+          <Button color="neutral" variant="outlined" disabled={!smtp.host} onClick={handleTest}>
+            Test
+          </Button>
+        </div>
+        <div className="w-full flex flex-col justify-start items-start gap-1">
+          <Switch
+            className="dark:text-gray-500"
+            size="lg"
+            checked={workspaceStore.setting.requireEmailVerification}
+            onChange={(event) => toggleRequireEmailVerification(event.target.checked)}
+            endDecorator={<span>Require email verification on sign up</span>}
+          />
+          <p className="text-sm text-gray-500 leading-tight">
+            The users signing up with a password can only use Slash once they open the link sent to their email. It needs the SMTP
+            server and the instance URL.
+          </p>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default MailSection;
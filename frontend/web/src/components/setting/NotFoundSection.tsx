@@ -0,0 +1,85 @@
+import { Button, Input, Switch, Textarea } from "@mui/joy";
+import { isEqual } from "lodash-es";
+import { useRef, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { workspaceServiceClient } from "@/grpcweb";
+import { useWorkspaceStore } from "@/stores";
+import { NotFoundSetting, WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+
+const NotFoundSection = () => {
+  const { t } = useTranslation();
+  const workspaceStore = useWorkspaceStore();
+  const [notFound, setNotFound] = useState<NotFoundSetting>(NotFoundSetting.fromPartial(workspaceStore.setting.notFound || {}));
+  const originalNotFound = useRef<NotFoundSetting>(notFound);
+  const allowSave = !isEqual(originalNotFound.current, notFound);
+
+  const handleNotFoundChange = (partial: Partial<NotFoundSetting>) => {
+    setNotFound(NotFoundSetting.fromPartial({ ...notFound, ...partial }));
+  };
+
+  const handleSave = async () => {
+    try {
+      const setting = await workspaceServiceClient.updateWorkspaceSetting({
+        setting: WorkspaceSetting.fromPartial({ notFound }),
+        updateMask: ["not_found"],
+      });
+      const updated = NotFoundSetting.fromPartial(setting.notFound || {});
+      setNotFound(updated);
+      originalNotFound.current = updated;
+      await workspaceStore.fetchWorkspaceSetting();
+      toast.success("Workspace setting saved successfully");
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Missing shortcuts</p>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <div className="w-full flex flex-col justify-start items-start gap-1">
+          <p className="font-medium dark:text-gray-400">Fallback redirect</p>
+          <Input
+            className="w-full"
+            placeholder="e.g. https://search.example.com/?q={name}"
+            value={notFound.redirectUrl}
+            onChange={(event) => handleNotFoundChange({ redirectUrl: event.target.value })}
+          />
+          <p className="text-sm text-gray-500 leading-tight">
+            Redirect to this url when a shortcut doesn't exist, where <code>{"{name}"}</code> is replaced by the shortcut name. Leave it
+            empty to show the not found page.
+          </p>
+        </div>
+        {!notFound.redirectUrl && (
+          <>
+            <div className="w-full flex flex-col justify-start items-start gap-1">
+              <p className="font-medium dark:text-gray-400">Not found page message</p>
+              <Textarea
+                className="w-full"
+                minRows={2}
+                maxRows={5}
+                placeholder="e.g. Ask in #help for the shortcut you are looking for."
+                value={notFound.message}
+                onChange={(event) => handleNotFoundChange({ message: event.target.value })}
+              />
+            </div>
+            <Switch
+              className="dark:text-gray-500"
+              checked={!notFound.disableCreateOffer}
+              onChange={(event) => handleNotFoundChange({ disableCreateOffer: !event.target.checked })}
+              endDecorator={<span>Offer signed-in users to create the missing shortcut</span>}
+            />
+          </>
+        )}
+        <div>
+          <Button color="primary" disabled={!allowSave} onClick={handleSave}>
+            {t("common.save")}
+          </Button>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default NotFoundSection;
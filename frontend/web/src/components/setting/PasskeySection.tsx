@@ -0,0 +1,129 @@
+import { Button, IconButton } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { showCommonDialog } from "@/components/Alert";
+import Icon from "@/components/Icon";
+import { authServiceClient, userServiceClient } from "@/grpcweb";
+import { createPasskey, isPasskeySupported } from "@/helpers/passkey";
+import { useUserStore, useWorkspaceStore } from "@/stores";
+import { UserPasskey } from "@/types/proto/api/v1/user_service";
+
+const listPasskeys = async (userId: number) => {
+  const { passkeys } = await userServiceClient.listUserPasskeys({
+    id: userId,
+  });
+  return passkeys;
+};
+
+const PasskeySection = () => {
+  const { t } = useTranslation();
+  const currentUser = useUserStore().getCurrentUser();
+  const workspaceStore = useWorkspaceStore();
+  const [userPasskeys, setUserPasskeys] = useState<UserPasskey[]>([]);
+  // Passkeys are bound to the domain of the instance url.
+  const allowRegistration = workspaceStore.setting.instanceUrl !== "" && isPasskeySupported();
+
+  useEffect(() => {
+    listPasskeys(currentUser.id).then((passkeys) => {
+      setUserPasskeys(passkeys);
+    });
+  }, []);
+
+  const handleRegisterPasskey = async () => {
+    const name = window.prompt("Name of the passkey, e.g. the device", "Passkey");
+    if (name === null) {
+      return;
+    }
+
+    try {
+      const { options } = await authServiceClient.beginPasskeyRegistration({});
+      const credential = await createPasskey(options);
+      await authServiceClient.finishPasskeyRegistration({ credential, name });
+      setUserPasskeys(await listPasskeys(currentUser.id));
+      toast.success("Passkey registered");
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details ?? error.message);
+    }
+  };
+
+  const handleDeletePasskey = async (userPasskey: UserPasskey) => {
+    showCommonDialog({
+      title: "Delete Passkey",
+      content: `Are you sure to delete passkey \`${userPasskey.name}\`? You cannot sign in with it anymore.`,
+      style: "danger",
+      onConfirm: async () => {
+        await userServiceClient.deleteUserPasskey({
+          id: currentUser.id,
+          passkeyId: userPasskey.id,
+        });
+        setUserPasskeys(userPasskeys.filter((passkey) => passkey.id !== userPasskey.id));
+      },
+    });
+  };
+
+  return (
+    <div className="w-full flex flex-col justify-start items-start space-y-4">
+      <div className="w-full">
+        <div className="sm:flex sm:items-center">
+          <div className="sm:flex-auto">
+            <p className="text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Passkeys</p>
+            <p className="mt-2 text-sm text-gray-700 dark:text-gray-600">
+              {allowRegistration
+                ? "Sign in without a password with the passkeys of your devices."
+                : "Passkeys are available once an admin sets the instance URL in the workspace settings."}
+            </p>
+          </div>
+          <div className="mt-4 sm:ml-16 sm:mt-0 sm:flex-none">
+            <Button variant="outlined" color="neutral" disabled={!allowRegistration} onClick={handleRegisterPasskey}>
+              {t("common.create")}
+            </Button>
+          </div>
+        </div>
+        <div className="mt-2 flow-root">
+          <div className="overflow-x-auto">
+            <div className="inline-block min-w-full py-2 align-middle">
+              <table className="min-w-full divide-y divide-gray-300 dark:divide-zinc-700">
+                <thead>
+                  <tr>
+                    <th scope="col" className="py-3.5 pl-4 pr-3 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      Name
+                    </th>
+                    <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      Created At
+                    </th>
+                    <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      Last Used At
+                    </th>
+                    <th scope="col" className="relative py-3.5 pl-3 pr-4">
+                      <span className="sr-only">{t("common.delete")}</span>
+                    </th>
+                  </tr>
+                </thead>
+                <tbody className="divide-y divide-gray-200 dark:divide-zinc-800">
+                  {userPasskeys.map((userPasskey) => (
+                    <tr key={userPasskey.id}>
+                      <td className="whitespace-nowrap py-4 pl-4 pr-3 text-sm text-gray-900 dark:text-gray-500">{userPasskey.name}</td>
+                      <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">{userPasskey.createdTime?.toLocaleString()}</td>
+                      <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">
+                        {userPasskey.lastUsedTime?.toLocaleString() ?? "Never"}
+                      </td>
+                      <td className="relative whitespace-nowrap py-4 pl-3 pr-4 text-right text-sm">
+                        <IconButton color="danger" variant="plain" size="sm" onClick={() => handleDeletePasskey(userPasskey)}>
+                          <Icon.Trash className="w-4 h-auto" />
+                        </IconButton>
+                      </td>
+                    </tr>
+                  ))}
+                </tbody>
+              </table>
+            </div>
+          </div>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default PasskeySection;
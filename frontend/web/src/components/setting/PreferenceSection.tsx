@@ -1,4 +1,4 @@
-import { Option, Select } from "@mui/joy";
+import { Option, Select, Switch } from "@mui/joy";
 import { useTranslation } from "react-i18next";
 import BetaBadge from "@/components/BetaBadge";
 import { useUserStore } from "@/stores";
@@ -10,6 +10,8 @@ const PreferenceSection: React.FC = () => {
   const userSetting = userStore.getCurrentUserSetting();
   const language = userSetting.general?.locale || "EN";
   const colorTheme = userSetting.general?.colorTheme || "SYSTEM";
+  const disablePublicProfile = userSetting.general?.disablePublicProfile || false;
+  const externalRedirectConfirmation = userSetting.general?.externalRedirectConfirmation || "WORKSPACE";
 
   const languageOptions = [
     {
@@ -57,6 +59,21 @@ const PreferenceSection: React.FC = () => {
     },
   ];
 
+  const externalRedirectConfirmationOptions = [
+    {
+      value: "WORKSPACE",
+      label: "Workspace default",
+    },
+    {
+      value: "ALWAYS",
+      label: "Always",
+    },
+    {
+      value: "NEVER",
+      label: "Never",
+    },
+  ];
+
   const handleSelectLanguage = async (locale: string) => {
     await userStore.updateUserSetting(
       {
@@ -83,6 +100,32 @@ const PreferenceSection: React.FC = () => {
     );
   };
 
+  const handleDisablePublicProfileChange = async (disablePublicProfile: boolean) => {
+    await userStore.updateUserSetting(
+      {
+        ...userSetting,
+        general: {
+          ...userSetting.general,
+          disablePublicProfile,
+        },
+      } as UserSetting,
+      ["general"],
+    );
+  };
+
+  const handleSelectExternalRedirectConfirmation = async (externalRedirectConfirmation: string) => {
+    await userStore.updateUserSetting(
+      {
+        ...userSetting,
+        general: {
+          ...userSetting.general,
+          externalRedirectConfirmation,
+        },
+      } as UserSetting,
+      ["general"],
+    );
+  };
+
   return (
     <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
       <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">{t("settings.preference.self")}</p>
@@ -116,6 +159,25 @@ const PreferenceSection: React.FC = () => {
             })}
           </Select>
         </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <span className="dark:text-gray-400">Disable public profile</span>
+          <Switch checked={disablePublicProfile} onChange={(event) => handleDisablePublicProfileChange(event.target.checked)} />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <span className="dark:text-gray-400">Confirm external redirects</span>
+          <Select
+            defaultValue={externalRedirectConfirmation}
+            onChange={(_, value) => handleSelectExternalRedirectConfirmation(value as string)}
+          >
+            {externalRedirectConfirmationOptions.map((option) => {
+              return (
+                <Option key={option.value} value={option.value}>
+                  {option.label}
+                </Option>
+              );
+            })}
+          </Select>
+        </div>
       </div>
     </div>
   );
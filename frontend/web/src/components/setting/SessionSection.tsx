@@ -0,0 +1,111 @@
+import { IconButton } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+import { showCommonDialog } from "@/components/Alert";
+import Icon from "@/components/Icon";
+import { userServiceClient } from "@/grpcweb";
+import { useUserStore } from "@/stores";
+import { UserSession } from "@/types/proto/api/v1/user_service";
+
+const listSessions = async (userId: number) => {
+  const { sessions } = await userServiceClient.listUserSessions({
+    id: userId,
+  });
+  return sessions;
+};
+
+const SessionSection = () => {
+  const { t } = useTranslation();
+  const currentUser = useUserStore().getCurrentUser();
+  const [userSessions, setUserSessions] = useState<UserSession[]>([]);
+
+  useEffect(() => {
+    listSessions(currentUser.id).then((sessions) => {
+      setUserSessions(sessions);
+    });
+  }, []);
+
+  const handleRevokeSession = async (userSession: UserSession) => {
+    showCommonDialog({
+      title: "Revoke Session",
+      content: `Are you sure to sign out \`${userSession.device || "Unknown device"}\` from ${userSession.ip || "an unknown IP"}?`,
+      style: "danger",
+      onConfirm: async () => {
+        await userServiceClient.revokeUserSession({
+          id: currentUser.id,
+          sessionId: userSession.id,
+        });
+        setUserSessions(userSessions.filter((session) => session.id !== userSession.id));
+      },
+    });
+  };
+
+  return (
+    <div className="w-full flex flex-col justify-start items-start space-y-4">
+      <div className="w-full">
+        <div className="sm:flex sm:items-center">
+          <div className="sm:flex-auto">
+            <p className="text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Sessions</p>
+            <p className="mt-2 text-sm text-gray-700 dark:text-gray-600">
+              The devices signed in to your account. Revoke a session to sign out the device, e.g. a lost laptop.
+            </p>
+          </div>
+        </div>
+        <div className="mt-2 flow-root">
+          <div className="overflow-x-auto">
+            <div className="inline-block min-w-full py-2 align-middle">
+              <table className="min-w-full divide-y divide-gray-300 dark:divide-zinc-700">
+                <thead>
+                  <tr>
+                    <th scope="col" className="py-3.5 pl-4 pr-3 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      Device
+                    </th>
+                    <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      IP
+                    </th>
+                    <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      Signed In At
+                    </th>
+                    <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      Last Seen At
+                    </th>
+                    <th scope="col" className="relative py-3.5 pl-3 pr-4">
+                      <span className="sr-only">{t("common.delete")}</span>
+                    </th>
+                  </tr>
+                </thead>
+                <tbody className="divide-y divide-gray-200 dark:divide-zinc-800">
+                  {userSessions.map((userSession) => (
+                    <tr key={userSession.id}>
+                      <td
+                        className="whitespace-nowrap py-4 pl-4 pr-3 text-sm text-gray-900 dark:text-gray-500"
+                        title={userSession.userAgent}
+                      >
+                        {userSession.device || "Unknown device"}
+                        {userSession.current && <span className="ml-2 text-xs text-green-600">This device</span>}
+                      </td>
+                      <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">{userSession.ip}</td>
+                      <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">{userSession.createdTime?.toLocaleString()}</td>
+                      <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">
+                        {userSession.lastSeenTime?.toLocaleString() ?? "Never"}
+                      </td>
+                      <td className="relative whitespace-nowrap py-4 pl-3 pr-4 text-right text-sm">
+                        {!userSession.current && (
+                          <IconButton color="danger" variant="plain" size="sm" onClick={() => handleRevokeSession(userSession)}>
+                            <Icon.LogOut className="w-4 h-auto" />
+                          </IconButton>
+                        )}
+                      </td>
+                    </tr>
+                  ))}
+                </tbody>
+              </table>
+            </div>
+          </div>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default SessionSection;
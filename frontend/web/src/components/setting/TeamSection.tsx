@@ -0,0 +1,124 @@
+import { Button, IconButton, Input, Option, Select } from "@mui/joy";
+import { useEffect, useState } from "react";
+import toast from "react-hot-toast";
+import { showCommonDialog } from "@/components/Alert";
+import Icon from "@/components/Icon";
+import { teamServiceClient } from "@/grpcweb";
+import { useUserStore } from "@/stores";
+import { Team } from "@/types/proto/api/v1/team_service";
+
+const TeamSection = () => {
+  const userStore = useUserStore();
+  const [teams, setTeams] = useState<Team[]>([]);
+  const [name, setName] = useState<string>("");
+  const userList = Object.values(userStore.userMapById);
+
+  useEffect(() => {
+    userStore.fetchUserList();
+    teamServiceClient.listTeams({}).then(({ teams }) => {
+      setTeams(teams);
+    });
+  }, []);
+
+  const replaceTeam = (team: Team) => {
+    setTeams(teams.map((item) => (item.id === team.id ? team : item)));
+  };
+
+  const handleCreateTeam = async () => {
+    try {
+      const team = await teamServiceClient.createTeam({ team: { name } });
+      setTeams([...teams, team].sort((a, b) => a.name.localeCompare(b.name)));
+      setName("");
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  const handleDeleteTeam = (team: Team) => {
+    showCommonDialog({
+      title: "Delete Team",
+      content: `Delete team \`${team.name}\`? Its shortcuts and collections will only be visible to their creators and admins.`,
+      style: "danger",
+      onConfirm: async () => {
+        try {
+          await teamServiceClient.deleteTeam({ id: team.id });
+          setTeams(teams.filter((item) => item.id !== team.id));
+        } catch (error: any) {
+          toast.error(error.details);
+        }
+      },
+    });
+  };
+
+  const handleAddTeamMember = async (team: Team, userId: number) => {
+    try {
+      replaceTeam(await teamServiceClient.addTeamMember({ id: team.id, userId }));
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  const handleRemoveTeamMember = async (team: Team, userId: number) => {
+    try {
+      replaceTeam(await teamServiceClient.removeTeamMember({ id: team.id, userId }));
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Teams</p>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <p className="text-sm text-gray-500 leading-tight">
+          The shortcuts and collections shared with a team are only visible to its members, their creators and the admins.
+        </p>
+        <div className="w-full flex flex-row justify-start items-center gap-2">
+          <Input className="grow" placeholder="Team name, e.g. engineering" value={name} onChange={(e) => setName(e.target.value)} />
+          <Button variant="outlined" color="neutral" disabled={!name} onClick={handleCreateTeam}>
+            Create
+          </Button>
+        </div>
+        {teams.map((team) => (
+          <div key={team.id} className="w-full flex flex-col justify-start items-start gap-2 border rounded-lg p-3 dark:border-zinc-700">
+            <div className="w-full flex flex-row justify-between items-center">
+              <span className="font-medium dark:text-gray-400">{team.name}</span>
+              <IconButton size="sm" color="danger" variant="plain" onClick={() => handleDeleteTeam(team)}>
+                <Icon.Trash className="w-4 h-auto" />
+              </IconButton>
+            </div>
+            <div className="w-full flex flex-row flex-wrap justify-start items-center gap-2">
+              {team.memberIds.map((userId) => (
+                <span
+                  key={userId}
+                  className="flex flex-row items-center text-sm text-gray-600 dark:text-gray-400 border rounded-full pl-2 pr-1"
+                >
+                  {userStore.userMapById[userId]?.nickname ?? userId}
+                  <IconButton size="sm" variant="plain" onClick={() => handleRemoveTeamMember(team, userId)}>
+                    <Icon.X className="w-3 h-auto" />
+                  </IconButton>
+                </span>
+              ))}
+              <Select
+                size="sm"
+                placeholder="Add member"
+                value={null}
+                onChange={(_, value) => value && handleAddTeamMember(team, value)}
+              >
+                {userList
+                  .filter((user) => !team.memberIds.includes(user.id))
+                  .map((user) => (
+                    <Option key={user.id} value={user.id}>
+                      {user.nickname}
+                    </Option>
+                  ))}
+              </Select>
+            </div>
+          </div>
+        ))}
+      </div>
+    </div>
+  );
+};
+
+export default TeamSection;
@@ -0,0 +1,27 @@
+import { Button } from "@mui/joy";
+import { absolutifyLink } from "@/helpers/utils";
+import Icon from "../Icon";
+
+const WorkspaceExportSection = () => {
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <p className="sm:w-1/4 text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">Export</p>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <div className="w-full flex flex-col justify-start items-start">
+          <p className="font-medium dark:text-gray-400">Export shortcuts and collections</p>
+          <p className="text-sm text-gray-500 leading-tight">Download all the shortcuts and collections with their metadata to back up or migrate the workspace.</p>
+        </div>
+        <div className="flex flex-row justify-start items-center gap-2">
+          <Button component="a" href={absolutifyLink("/api/v1/export?format=json")} variant="outlined" startDecorator={<Icon.Download className="w-4 h-auto" />}>
+            JSON
+          </Button>
+          <Button component="a" href={absolutifyLink("/api/v1/export?format=csv")} variant="outlined" startDecorator={<Icon.Download className="w-4 h-auto" />}>
+            CSV
+          </Button>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default WorkspaceExportSection;
@@ -1,4 +1,4 @@
-import { Button, Option, Select, Textarea } from "@mui/joy";
+import { Button, Input, Option, Select, Switch, Textarea } from "@mui/joy";
 import { head, isEqual } from "lodash-es";
 import { useRef, useState } from "react";
 import toast from "react-hot-toast";
@@ -7,9 +7,10 @@ import { workspaceServiceClient } from "@/grpcweb";
 import { useWorkspaceStore } from "@/stores";
 import { FeatureType } from "@/stores/workspace";
 import { Visibility } from "@/types/proto/api/v1/common";
-import { WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+import { AnomalyAlertSetting, LinkParamRules, WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
 import FeatureBadge from "../FeatureBadge";
 import Icon from "../Icon";
+import RedirectCodeSelect from "../RedirectCodeSelect";
 
 const getDefaultVisibility = (visibility?: Visibility) => {
   if (!visibility || [Visibility.VISIBILITY_UNSPECIFIED, Visibility.UNRECOGNIZED].includes(visibility)) {
@@ -47,6 +48,13 @@ const WorkspaceGeneralSettingSection = () => {
     setWorkspaceSetting({ ...workspaceSetting, branding: new TextEncoder().encode(base64) });
   };
 
+  const handleInstanceUrlChange = async (value: string) => {
+    setWorkspaceSetting({
+      ...workspaceSetting,
+      instanceUrl: value,
+    });
+  };
+
   const handleCustomStyleChange = async (value: string) => {
     setWorkspaceSetting({
       ...workspaceSetting,
@@ -61,17 +69,71 @@ const WorkspaceGeneralSettingSection = () => {
     });
   };
 
+  const handleAnomalyAlertChange = (anomalyAlert: Partial<AnomalyAlertSetting>) => {
+    setWorkspaceSetting({
+      ...workspaceSetting,
+      anomalyAlert: AnomalyAlertSetting.fromPartial({
+        ...workspaceSetting.anomalyAlert,
+        ...anomalyAlert,
+      }),
+    });
+  };
+
+  const handleLinkParamRulesChange = (field: "deny" | "allow", value: string) => {
+    setWorkspaceSetting({
+      ...workspaceSetting,
+      linkParamRules: LinkParamRules.fromPartial({
+        ...workspaceSetting.linkParamRules,
+        [field]: value.split(" "),
+      }),
+    });
+  };
+
+  const handleViewDedupeWindowChange = (value: string) => {
+    setWorkspaceSetting({
+      ...workspaceSetting,
+      viewDedupeWindowSeconds: Number(value) || 0,
+    });
+  };
+
   const handleSaveWorkspaceSetting = async () => {
     const updateMask: string[] = [];
     if (!isEqual(originalWorkspaceSetting.current.branding, workspaceSetting.branding)) {
       updateMask.push("branding");
     }
+    if (!isEqual(originalWorkspaceSetting.current.instanceUrl, workspaceSetting.instanceUrl)) {
+      updateMask.push("instance_url");
+    }
     if (!isEqual(originalWorkspaceSetting.current.customStyle, workspaceSetting.customStyle)) {
       updateMask.push("custom_style");
     }
     if (!isEqual(originalWorkspaceSetting.current.defaultVisibility, workspaceSetting.defaultVisibility)) {
       updateMask.push("default_visibility");
     }
+    if (!isEqual(originalWorkspaceSetting.current.anomalyAlert, workspaceSetting.anomalyAlert)) {
+      updateMask.push("anomaly_alert");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.linkParamRules, workspaceSetting.linkParamRules)) {
+      updateMask.push("link_param_rules");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.viewDedupeWindowSeconds, workspaceSetting.viewDedupeWindowSeconds)) {
+      updateMask.push("view_dedupe_window_seconds");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.attributeViewsToUsers, workspaceSetting.attributeViewsToUsers)) {
+      updateMask.push("attribute_views_to_users");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.defaultRedirectCode, workspaceSetting.defaultRedirectCode)) {
+      updateMask.push("default_redirect_code");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.internalDomains, workspaceSetting.internalDomains)) {
+      updateMask.push("internal_domains");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.confirmExternalRedirects, workspaceSetting.confirmExternalRedirects)) {
+      updateMask.push("confirm_external_redirects");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.reservedShortcutNames, workspaceSetting.reservedShortcutNames)) {
+      updateMask.push("reserved_shortcut_names");
+    }
     if (updateMask.length === 0) {
       toast.error("No changes made");
       return;
@@ -79,7 +141,15 @@ const WorkspaceGeneralSettingSection = () => {
 
     try {
       const setting = await workspaceServiceClient.updateWorkspaceSetting({
-        setting: workspaceSetting,
+        setting: {
+          ...workspaceSetting,
+          linkParamRules: LinkParamRules.fromPartial({
+            deny: workspaceSetting.linkParamRules?.deny.filter(Boolean),
+            allow: workspaceSetting.linkParamRules?.allow.filter(Boolean),
+          }),
+          internalDomains: workspaceSetting.internalDomains.filter(Boolean),
+          reservedShortcutNames: workspaceSetting.reservedShortcutNames.filter(Boolean),
+        },
         updateMask: updateMask,
       });
       setWorkspaceSetting(setting);
@@ -125,6 +195,18 @@ const WorkspaceGeneralSettingSection = () => {
             <p className="text-xs opacity-60">(Click to select file)</p>
           </div>
         </div>
+        <div className="w-full flex flex-col justify-start items-start gap-2">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Instance URL</p>
+            <p className="text-sm text-gray-500 leading-tight">The URL users open Slash at, required for passkeys.</p>
+          </div>
+          <Input
+            className="w-full"
+            placeholder="e.g. https://slash.example.com"
+            value={workspaceSetting.instanceUrl}
+            onChange={(event) => handleInstanceUrlChange(event.target.value)}
+          />
+        </div>
         <div className="w-full flex flex-row justify-between items-center">
           <div className="w-full flex flex-col justify-start items-start">
             <p className="font-medium dark:text-gray-400">{t("settings.workspace.default-visibility")}</p>
@@ -139,6 +221,128 @@ const WorkspaceGeneralSettingSection = () => {
             <Option value={Visibility.PUBLIC}>{t(`shortcut.visibility.public.self`)}</Option>
           </Select>
         </div>
+        <div className="w-full flex flex-col justify-start items-start gap-2">
+          <div className="w-full flex flex-row justify-between items-center">
+            <div className="w-full flex flex-col justify-start items-start">
+              <p className="font-medium dark:text-gray-400">Traffic anomaly alerts</p>
+              <p className="text-sm text-gray-500 leading-tight">Alert on unusual spikes or drops of the hourly views of shortcuts.</p>
+            </div>
+            <Switch
+              checked={workspaceSetting.anomalyAlert?.enabled || false}
+              onChange={(event) => handleAnomalyAlertChange({ enabled: event.target.checked })}
+            />
+          </div>
+          {workspaceSetting.anomalyAlert?.enabled && (
+            <Input
+              className="w-full"
+              placeholder="Webhook URL, e.g. https://hooks.example.com/slash"
+              value={workspaceSetting.anomalyAlert.webhookUrl}
+              onChange={(event) => handleAnomalyAlertChange({ webhookUrl: event.target.value })}
+            />
+          )}
+        </div>
+        <div className="w-full flex flex-col justify-start items-start gap-2">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Link parameters</p>
+            <p className="text-sm text-gray-500 leading-tight">
+              The query parameters stripped from the links when saving shortcuts, separated by space, where <code>*</code> matches any
+              characters. The allowed ones are kept even if they match.
+            </p>
+          </div>
+          <Input
+            className="w-full"
+            startDecorator={<span className="text-sm text-gray-500">Strip</span>}
+            placeholder="e.g. utm_* fbclid gclid"
+            value={workspaceSetting.linkParamRules?.deny.join(" ") || ""}
+            onChange={(event) => handleLinkParamRulesChange("deny", event.target.value)}
+          />
+          <Input
+            className="w-full"
+            startDecorator={<span className="text-sm text-gray-500">Allow</span>}
+            placeholder="e.g. utm_id"
+            value={workspaceSetting.linkParamRules?.allow.join(" ") || ""}
+            onChange={(event) => handleLinkParamRulesChange("allow", event.target.value)}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">View dedupe window</p>
+            <p className="text-sm text-gray-500 leading-tight">
+              Repeated views of a shortcut from the same IP and browser within the window are counted once. 0 counts every view.
+            </p>
+          </div>
+          <Input
+            className="w-36 shrink-0"
+            type="number"
+            placeholder="0"
+            endDecorator="sec"
+            value={workspaceSetting.viewDedupeWindowSeconds || ""}
+            onChange={(event) => handleViewDedupeWindowChange(event.target.value)}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Attribute views to users</p>
+            <p className="text-sm text-gray-500 leading-tight">
+              Record the signed-in users viewing the shortcuts, so that admins see who uses them in the analytics.
+            </p>
+          </div>
+          <Switch
+            checked={workspaceSetting.attributeViewsToUsers}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, attributeViewsToUsers: event.target.checked })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Default redirect</p>
+            <p className="text-sm text-gray-500 leading-tight">
+              The HTTP redirect of the shortcuts without their own. The shortcut page redirects in the browser and shows the link previews.
+            </p>
+          </div>
+          <RedirectCodeSelect
+            className="w-56 shrink-0"
+            value={workspaceSetting.defaultRedirectCode}
+            defaultLabel="Shortcut page"
+            onChange={(defaultRedirectCode) => setWorkspaceSetting({ ...workspaceSetting, defaultRedirectCode })}
+          />
+        </div>
+        <div className="w-full flex flex-col justify-start items-start gap-2">
+          <div className="w-full flex flex-row justify-between items-center">
+            <div className="w-full flex flex-col justify-start items-start">
+              <p className="font-medium dark:text-gray-400">Confirm external redirects</p>
+              <p className="text-sm text-gray-500 leading-tight">
+                Shortcuts leading outside of the internal domains, separated by space, ask before redirecting. Their subdomains are internal
+                too, and users can opt in or out in their preferences.
+              </p>
+            </div>
+            <Switch
+              checked={workspaceSetting.confirmExternalRedirects}
+              onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, confirmExternalRedirects: event.target.checked })}
+            />
+          </div>
+          <Input
+            className="w-full"
+            startDecorator={<span className="text-sm text-gray-500">Internal</span>}
+            placeholder="e.g. example.com example.net"
+            value={workspaceSetting.internalDomains.join(" ")}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, internalDomains: event.target.value.split(" ") })}
+          />
+        </div>
+        <div className="w-full flex flex-col justify-start items-start gap-2">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Reserved names</p>
+            <p className="text-sm text-gray-500 leading-tight">
+              The names, separated by space, which the shortcuts can't take nor start with, e.g. "admin" also reserves "admin/users". The
+              routes of Slash, like "api" and "s", are always reserved.
+            </p>
+          </div>
+          <Input
+            className="w-full"
+            placeholder="e.g. admin login"
+            value={workspaceSetting.reservedShortcutNames.join(" ")}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, reservedShortcutNames: event.target.value.split(" ") })}
+          />
+        </div>
         <div className="w-full flex flex-col justify-start items-start">
           <p className="mt-2 font-medium dark:text-gray-400">{t("settings.workspace.custom-style")}</p>
           <Textarea
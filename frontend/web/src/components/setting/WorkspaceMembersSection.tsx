@@ -5,8 +5,11 @@ import { useTranslation } from "react-i18next";
 import { showCommonDialog } from "@/components/Alert";
 import CreateUserDialog from "@/components/CreateUserDialog";
 import Icon from "@/components/Icon";
+import { userServiceClient } from "@/grpcweb";
+import { absolutifyLink } from "@/helpers/utils";
 import { useUserStore } from "@/stores";
-import { User } from "@/types/proto/api/v1/user_service";
+import { State } from "@/types/proto/api/v1/common";
+import { Role, User } from "@/types/proto/api/v1/user_service";
 
 const WorkspaceMembersSection = () => {
   const { t } = useTranslation();
@@ -40,6 +43,32 @@ const WorkspaceMembersSection = () => {
     });
   };
 
+  const handleToggleUserState = async (user: User) => {
+    const archived = user.state === State.INACTIVE;
+    try {
+      await userStore.patchUser({ id: user.id, state: archived ? State.ACTIVE : State.INACTIVE }, ["state"]);
+      toast.success(`User \`${user.nickname}\` ${archived ? "restored" : "archived"} successfully`);
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  const handleImpersonateUser = async (user: User) => {
+    showCommonDialog({
+      title: "Impersonate User",
+      content: `Sign in as \`${user.nickname}\` for an hour to see what they see? You'll be signed out, and the impersonation is recorded.`,
+      style: "warning",
+      onConfirm: async () => {
+        try {
+          await userServiceClient.impersonateUser({ id: user.id });
+          window.location.href = absolutifyLink("/");
+        } catch (error: any) {
+          toast.error(`Failed to impersonate user \`${user.nickname}\`: ${error.details}`);
+        }
+      },
+    });
+  };
+
   return (
     <>
       <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
@@ -82,7 +111,10 @@ const WorkspaceMembersSection = () => {
                       <tr key={user.email}>
                         <td className="whitespace-nowrap py-2 pl-4 pr-3 text-sm text-gray-900 dark:text-gray-500">{user.nickname}</td>
                         <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500">{user.email}</td>
-                        <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500">{user.role}</td>
+                        <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500">
+                          {user.role}
+                          {user.state === State.INACTIVE && " (archived)"}
+                        </td>
                         <td className="relative whitespace-nowrap py-2 pl-3 pr-4 text-right text-sm">
                           <IconButton
                             size="sm"
@@ -94,6 +126,20 @@ const WorkspaceMembersSection = () => {
                           >
                             <Icon.PenBox className="w-4 h-auto" />
                           </IconButton>
+                          {user.role !== Role.ADMIN && (
+                            <IconButton size="sm" variant="plain" onClick={() => handleImpersonateUser(user)}>
+                              <Icon.VenetianMask className="w-4 h-auto" />
+                            </IconButton>
+                          )}
+                          {user.id !== userStore.currentUserId && (
+                            <IconButton size="sm" variant="plain" onClick={() => handleToggleUserState(user)}>
+                              {user.state === State.INACTIVE ? (
+                                <Icon.ArchiveRestore className="w-4 h-auto" />
+                              ) : (
+                                <Icon.Archive className="w-4 h-auto" />
+                              )}
+                            </IconButton>
+                          )}
                           <IconButton size="sm" color="danger" variant="plain" onClick={() => handleDeleteUser(user)}>
                             <Icon.Trash className="w-4 h-auto" />
                           </IconButton>
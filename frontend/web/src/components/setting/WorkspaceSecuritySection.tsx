@@ -1,14 +1,22 @@
-import { Switch } from "@mui/joy";
+import { Option, Select, Switch } from "@mui/joy";
+import { useEffect } from "react";
 import toast from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { workspaceServiceClient } from "@/grpcweb";
-import { useWorkspaceStore } from "@/stores";
+import { useUserStore, useWorkspaceStore } from "@/stores";
+import { State } from "@/types/proto/api/v1/common";
 import { WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
 import SSOSection from "./SSOSection";
 
 const WorkspaceSecuritySection = () => {
   const { t } = useTranslation();
   const workspaceStore = useWorkspaceStore();
+  const userStore = useUserStore();
+  const activeUsers = Object.values(userStore.userMapById).filter((user) => user.state !== State.INACTIVE);
+
+  useEffect(() => {
+    userStore.fetchUserList();
+  }, []);
 
   const toggleDisallowUserRegistration = async (on: boolean) => {
     if (on) {
@@ -42,6 +50,15 @@ const WorkspaceSecuritySection = () => {
     );
   };
 
+  const handleAccountHandoverUserChange = async (userId: number) => {
+    await updateWorkspaceSetting(
+      WorkspaceSetting.fromPartial({
+        accountHandoverUserId: userId,
+      }),
+      ["account_handover_user_id"],
+    );
+  };
+
   const updateWorkspaceSetting = async (workspaceSetting: WorkspaceSetting, updateMask: string[]) => {
     if (updateMask.length === 0) {
       toast.error("No changes made");
@@ -83,6 +100,24 @@ const WorkspaceSecuritySection = () => {
             endDecorator={<span>{"Disallow password auth"}</span>}
           />
         </div>
+        <div className="w-full flex flex-col justify-start items-start gap-1">
+          <p className="font-medium dark:text-gray-400">Account handover</p>
+          <Select
+            className="w-full sm:w-64"
+            value={workspaceStore.setting.accountHandoverUserId}
+            onChange={(_, value) => handleAccountHandoverUserChange(value as number)}
+          >
+            <Option value={0}>No handover</Option>
+            {activeUsers.map((user) => (
+              <Option key={user.id} value={user.id}>
+                {user.nickname} ({user.email})
+              </Option>
+            ))}
+          </Select>
+          <p className="text-sm text-gray-500 leading-tight">
+            The users deleting their account can transfer their shortcuts and collections to this user instead of deleting them.
+          </p>
+        </div>
       </div>
     </div>
   );
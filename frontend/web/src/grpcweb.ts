@@ -1,13 +1,17 @@
 import { createChannel, createClientFactory, FetchTransport } from "nice-grpc-web";
+import { basePath } from "./helpers/utils";
 import { AuthServiceDefinition } from "./types/proto/api/v1/auth_service";
 import { CollectionServiceDefinition } from "./types/proto/api/v1/collection_service";
+import { DashboardServiceDefinition } from "./types/proto/api/v1/dashboard_service";
+import { SearchServiceDefinition } from "./types/proto/api/v1/search_service";
 import { ShortcutServiceDefinition } from "./types/proto/api/v1/shortcut_service";
 import { SubscriptionServiceDefinition } from "./types/proto/api/v1/subscription_service";
+import { TeamServiceDefinition } from "./types/proto/api/v1/team_service";
 import { UserServiceDefinition } from "./types/proto/api/v1/user_service";
 import { UserSettingServiceDefinition } from "./types/proto/api/v1/user_setting_service";
 import { WorkspaceServiceDefinition } from "./types/proto/api/v1/workspace_service";
 
-const address = import.meta.env.MODE === "development" ? "http://localhost:8082" : window.location.origin;
+const address = import.meta.env.MODE === "development" ? "http://localhost:8082" : `${window.location.origin}${basePath}`;
 
 const channel = createChannel(
   address,
@@ -31,3 +35,9 @@ export const userSettingServiceClient = clientFactory.create(UserSettingServiceD
 export const shortcutServiceClient = clientFactory.create(ShortcutServiceDefinition, channel);
 
 export const collectionServiceClient = clientFactory.create(CollectionServiceDefinition, channel);
+
+export const searchServiceClient = clientFactory.create(SearchServiceDefinition, channel);
+
+export const teamServiceClient = clientFactory.create(TeamServiceDefinition, channel);
+
+export const dashboardServiceClient = clientFactory.create(DashboardServiceDefinition, channel);
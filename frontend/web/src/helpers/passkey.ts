@@ -0,0 +1,84 @@
+// The WebAuthn options and credentials are exchanged with the server in JSON, where the binary fields are base64url encoded.
+
+const base64URLToBuffer = (value: string): ArrayBuffer => {
+  const base64 = value.replace(/-/g, "+").replace(/_/g, "/").padEnd(Math.ceil(value.length / 4) * 4, "=");
+  const binary = atob(base64);
+  const bytes = new Uint8Array(binary.length);
+  for (let i = 0; i < binary.length; i++) {
+    bytes[i] = binary.charCodeAt(i);
+  }
+  return bytes.buffer;
+};
+
+const bufferToBase64URL = (buffer: ArrayBuffer): string => {
+  const bytes = new Uint8Array(buffer);
+  let binary = "";
+  for (const byte of bytes) {
+    binary += String.fromCharCode(byte);
+  }
+  return btoa(binary).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+};
+
+const convertCredentialDescriptors = (descriptors?: any[]): PublicKeyCredentialDescriptor[] | undefined => {
+  return descriptors?.map((descriptor) => ({ ...descriptor, id: base64URLToBuffer(descriptor.id) }));
+};
+
+export const isPasskeySupported = (): boolean => {
+  return typeof window !== "undefined" && window.PublicKeyCredential !== undefined;
+};
+
+// createPasskey creates a passkey with the options of BeginPasskeyRegistration, and returns the credential for FinishPasskeyRegistration.
+export const createPasskey = async (options: string): Promise<string> => {
+  const { publicKey } = JSON.parse(options);
+  const credential = (await navigator.credentials.create({
+    publicKey: {
+      ...publicKey,
+      challenge: base64URLToBuffer(publicKey.challenge),
+      user: { ...publicKey.user, id: base64URLToBuffer(publicKey.user.id) },
+      excludeCredentials: convertCredentialDescriptors(publicKey.excludeCredentials),
+    },
+  })) as PublicKeyCredential | null;
+  if (!credential) {
+    throw new Error("Passkey creation was cancelled");
+  }
+  const response = credential.response as AuthenticatorAttestationResponse;
+  return JSON.stringify({
+    id: credential.id,
+    rawId: bufferToBase64URL(credential.rawId),
+    type: credential.type,
+    authenticatorAttachment: credential.authenticatorAttachment,
+    response: {
+      clientDataJSON: bufferToBase64URL(response.clientDataJSON),
+      attestationObject: bufferToBase64URL(response.attestationObject),
+      transports: response.getTransports?.() ?? [],
+    },
+  });
+};
+
+// getPasskey gets an assertion with the options of BeginPasskeySignIn, and returns the credential for SignInWithPasskey.
+export const getPasskey = async (options: string): Promise<string> => {
+  const { publicKey } = JSON.parse(options);
+  const credential = (await navigator.credentials.get({
+    publicKey: {
+      ...publicKey,
+      challenge: base64URLToBuffer(publicKey.challenge),
+      allowCredentials: convertCredentialDescriptors(publicKey.allowCredentials),
+    },
+  })) as PublicKeyCredential | null;
+  if (!credential) {
+    throw new Error("Passkey sign in was cancelled");
+  }
+  const response = credential.response as AuthenticatorAssertionResponse;
+  return JSON.stringify({
+    id: credential.id,
+    rawId: bufferToBase64URL(credential.rawId),
+    type: credential.type,
+    authenticatorAttachment: credential.authenticatorAttachment,
+    response: {
+      clientDataJSON: bufferToBase64URL(response.clientDataJSON),
+      authenticatorData: bufferToBase64URL(response.authenticatorData),
+      signature: bufferToBase64URL(response.signature),
+      userHandle: response.userHandle ? bufferToBase64URL(response.userHandle) : undefined,
+    },
+  });
+};
@@ -1,14 +1,53 @@
+// basePath is the URL path prefix Slash is served under, e.g. "/slash", set by the server. Empty means the root.
+export const basePath = document.querySelector<HTMLMetaElement>('meta[name="slash:base-path"]')?.content ?? "";
+
+// absolutifyLink returns the absolute url of the link, with the absolute paths under the base path.
 export const absolutifyLink = (rel: string): string => {
   const anchor = document.createElement("a");
-  anchor.setAttribute("href", rel);
+  anchor.setAttribute("href", rel.startsWith("/") && !rel.startsWith("//") ? `${basePath}${rel}` : rel);
   return anchor.href;
 };
 
+// collectionSearchParam is the search param of the shortcut page with the name of the collection the shortcut is opened from.
+export const collectionSearchParam = "slash_collection";
+
+// oidcNonceStorageKey is the session storage key of the nonce sent to the OpenID Connect provider, checked against the ID token.
+export const oidcNonceStorageKey = "slash.oidc-nonce";
+
+export const getShortcutPath = (shortcutName: string, collectionName?: string): string => {
+  if (!collectionName) {
+    return `/s/${shortcutName}`;
+  }
+  return `/s/${shortcutName}?${new URLSearchParams({ [collectionSearchParam]: collectionName })}`;
+};
+
 export const isURL = (str: string): boolean => {
   const urlRegex = /^(https?|ftp):\/\/[^\s/$.?#].[^\s]*$/i;
   return urlRegex.test(str);
 };
 
+// isInternalDomain returns whether the host, e.g. "wiki.example.com", is one of the internal domains of the workspace or their subdomains.
+export const isInternalDomain = (host: string, internalDomains: string[]): boolean => {
+  host = host.toLowerCase().replace(/\.$/, "");
+  return internalDomains.some((domain) => host === domain || host.endsWith(`.${domain}`));
+};
+
+// needsRedirectConfirmation returns whether the redirect to the host is confirmed first, from the preference of the user,
+// "ALWAYS", "NEVER" or empty to follow the workspace, and the internal domains of the workspace.
+export const needsRedirectConfirmation = (
+  host: string,
+  userPreference: string,
+  confirmExternalRedirects: boolean,
+  internalDomains: string[],
+): boolean => {
+  if (userPreference === "ALWAYS") {
+    confirmExternalRedirects = true;
+  } else if (userPreference === "NEVER") {
+    confirmExternalRedirects = false;
+  }
+  return confirmExternalRedirects && !isInternalDomain(host, internalDomains);
+};
+
 export const generateRandomString = () => {
   const characters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789";
   let randomString = "";
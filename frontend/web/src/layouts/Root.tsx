@@ -2,19 +2,23 @@ import { useColorScheme } from "@mui/joy";
 import { useEffect } from "react";
 import { useTranslation } from "react-i18next";
 import { Outlet } from "react-router-dom";
+import CommandPalette from "@/components/CommandPalette";
+import EmailVerificationNotice from "@/components/EmailVerificationNotice";
 import Header from "@/components/Header";
 import Navigator from "@/components/Navigator";
 import useNavigateTo from "@/hooks/useNavigateTo";
-import { useUserStore } from "@/stores";
+import { useUserStore, useWorkspaceStore } from "@/stores";
 
 const Root: React.FC = () => {
   const navigateTo = useNavigateTo();
   const { setMode } = useColorScheme();
   const { i18n } = useTranslation();
   const userStore = useUserStore();
+  const workspaceStore = useWorkspaceStore();
   const currentUser = userStore.getCurrentUser();
   const currentUserSetting = userStore.getCurrentUserSetting();
   const isInitialized = Boolean(currentUser) && Boolean(currentUserSetting);
+  const isEmailVerificationPending = Boolean(currentUser) && !currentUser.emailVerified && workspaceStore.setting.requireEmailVerification;
 
   useEffect(() => {
     if (!currentUser) {
@@ -44,12 +48,17 @@ const Root: React.FC = () => {
     }
   }, [currentUserSetting]);
 
+  if (isEmailVerificationPending) {
+    return <EmailVerificationNotice />;
+  }
+
   return (
     isInitialized && (
       <div className="w-full h-auto flex flex-col justify-start items-start dark:bg-zinc-900">
         <Header />
         <Navigator />
         <Outlet />
+        <CommandPalette />
       </div>
     )
   );
@@ -1,15 +1,18 @@
-import { ClientError } from "nice-grpc-web";
+import { Button, Input } from "@mui/joy";
+import { ClientError, Status } from "nice-grpc-web";
 import { useEffect, useState } from "react";
 import { useSearchParams } from "react-router-dom";
 import Icon from "@/components/Icon";
 import { authServiceClient } from "@/grpcweb";
-import { absolutifyLink } from "@/helpers/utils";
+import { absolutifyLink, oidcNonceStorageKey } from "@/helpers/utils";
 import useNavigateTo from "@/hooks/useNavigateTo";
 import { useUserStore } from "@/stores";
 
 interface State {
   loading: boolean;
   errorMessage: string;
+  // Whether the SSO identity is waiting to be linked to the existing account.
+  linkRequired: boolean;
 }
 
 const AuthCallback = () => {
@@ -19,7 +22,9 @@ const AuthCallback = () => {
   const [state, setState] = useState<State>({
     loading: true,
     errorMessage: "",
+    linkRequired: false,
   });
+  const [password, setPassword] = useState("");
 
   useEffect(() => {
     const code = searchParams.get("code");
@@ -29,6 +34,7 @@ const AuthCallback = () => {
       setState({
         loading: false,
         errorMessage: "Failed to authorize. Invalid state passed to the auth callback.",
+        linkRequired: false,
       });
       return;
     }
@@ -38,21 +44,26 @@ const AuthCallback = () => {
       setState({
         loading: false,
         errorMessage: "No identity provider found in the state parameter.",
+        linkRequired: false,
       });
       return;
     }
 
     const redirectUri = absolutifyLink("/auth/callback");
+    const nonce = sessionStorage.getItem(oidcNonceStorageKey) || "";
+    sessionStorage.removeItem(oidcNonceStorageKey);
     (async () => {
       try {
         await authServiceClient.signInWithSSO({
           idpId,
           code,
           redirectUri,
+          nonce,
         });
         setState({
           loading: false,
           errorMessage: "",
+          linkRequired: false,
         });
         await userStore.fetchCurrentUser();
         navigateTo("/");
@@ -61,17 +72,52 @@ const AuthCallback = () => {
         setState({
           loading: false,
           errorMessage: (error as ClientError).details,
+          linkRequired: (error as ClientError).code === Status.FAILED_PRECONDITION,
         });
       }
     })();
   }, [searchParams]);
 
+  const handleLinkButtonClick = async () => {
+    try {
+      await authServiceClient.linkIdentityProvider({
+        password,
+      });
+      await userStore.fetchCurrentUser();
+      navigateTo("/");
+    } catch (error: any) {
+      console.error(error);
+      setState({
+        ...state,
+        errorMessage: (error as ClientError).details,
+      });
+    }
+  };
+
   return (
     <div className="p-4 py-24 w-full h-full flex justify-center items-center">
       {state.loading ? (
         <Icon.Loader className="animate-spin dark:text-gray-200" />
       ) : (
-        <div className="max-w-lg font-mono whitespace-pre-wrap opacity-80">{state.errorMessage}</div>
+        <div className="max-w-lg flex flex-col justify-start items-start gap-y-3">
+          <div className="font-mono whitespace-pre-wrap opacity-80">{state.errorMessage}</div>
+          {state.linkRequired && (
+            <div className="w-full flex flex-row justify-start items-center gap-x-2">
+              {!userStore.currentUserId && (
+                <Input
+                  className="grow"
+                  type="password"
+                  placeholder="Password"
+                  value={password}
+                  onChange={(e) => setPassword(e.target.value)}
+                />
+              )}
+              <Button disabled={!password && !userStore.currentUserId} onClick={handleLinkButtonClick}>
+                Link account
+              </Button>
+            </div>
+          )}
+        </div>
       )}
     </div>
   );
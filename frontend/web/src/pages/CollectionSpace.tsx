@@ -2,10 +2,12 @@ import { Divider } from "@mui/joy";
 import classNames from "classnames";
 import { useEffect, useState } from "react";
 import toast from "react-hot-toast";
-import { useParams } from "react-router-dom";
+import { useParams, useSearchParams } from "react-router-dom";
 import Icon from "@/components/Icon";
 import ShortcutFrame from "@/components/ShortcutFrame";
 import ShortcutView from "@/components/ShortcutView";
+import { collectionServiceClient } from "@/grpcweb";
+import { absolutifyLink, getShortcutPath } from "@/helpers/utils";
 import useResponsiveWidth from "@/hooks/useResponsiveWidth";
 import { useUserStore, useCollectionStore, useShortcutStore } from "@/stores";
 import { Collection } from "@/types/proto/api/v1/collection_service";
@@ -14,6 +16,9 @@ import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 const CollectionSpace = () => {
   const params = useParams();
   const collectionName = params["*"];
+  const [searchParams] = useSearchParams();
+  // The token of the guest link, for the guests who aren't members of the workspace.
+  const shareToken = searchParams.get("share") || "";
   const { sm } = useResponsiveWidth();
   const userStore = useUserStore();
   const collectionStore = useCollectionStore();
@@ -29,6 +34,17 @@ const CollectionSpace = () => {
   useEffect(() => {
     (async () => {
       try {
+        if (shareToken) {
+          const { collection, shortcuts } = await collectionServiceClient.getSharedCollection({ token: shareToken });
+          if (!collection) {
+            return;
+          }
+          setCollection(collection);
+          setShortcuts(shortcuts);
+          document.title = `${collection.title} - Slash`;
+          return;
+        }
+
         const collection = await collectionStore.fetchCollectionByName(collectionName);
         setCollection(collection);
         setShortcuts([]);
@@ -48,7 +64,7 @@ const CollectionSpace = () => {
         toast.error(error.details);
       }
     })();
-  }, [collectionName]);
+  }, [collectionName, shareToken]);
 
   if (!collection) {
     return null;
@@ -60,7 +76,7 @@ const CollectionSpace = () => {
     if (sm) {
       setSelectedShortcut(shortcut);
     } else {
-      window.open(`/s/${shortcut.name}`);
+      window.open(shareToken ? shortcut.link : absolutifyLink(getShortcutPath(shortcut.name, collection.name)));
     }
   };
 
@@ -98,7 +114,12 @@ const CollectionSpace = () => {
         {sm && (
           <div className="w-full h-full overflow-clip rounded-lg border dark:border-zinc-800 bg-white dark:bg-zinc-800">
             {selectedShortcut ? (
-              <ShortcutFrame key={selectedShortcut.id} shortcut={selectedShortcut} />
+              <ShortcutFrame
+                key={selectedShortcut.id}
+                shortcut={selectedShortcut}
+                collectionName={collection.name}
+                openLink={shareToken !== ""}
+              />
             ) : (
               <div className="w-full h-full flex flex-col justify-center items-center p-8">
                 <div className="w-72 max-w-full border dark:border-zinc-900 dark:bg-zinc-900 dark:text-gray-400 p-6 pb-4 rounded-2xl shadow-xl">
@@ -106,7 +127,7 @@ const CollectionSpace = () => {
                   <p className="text-lg font-medium">Click on a tab in the Sidebar to get started.</p>
                   <Divider className="!my-2" />
                   <p className="text-gray-400 dark:text-gray-600 text-sm mt-2 italic">
-                    Shared by <span className="font-medium not-italic">{creator.nickname}</span>
+                    Shared by <span className="font-medium not-italic">{creator.nickname || collection.creatorUsername}</span>
                   </p>
                 </div>
               </div>
@@ -0,0 +1,122 @@
+import { Button, Input } from "@mui/joy";
+import { ClientError } from "nice-grpc-web";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { useSearchParams } from "react-router-dom";
+import Icon from "@/components/Icon";
+import { authServiceClient } from "@/grpcweb";
+import useLoading from "@/hooks/useLoading";
+import { DeviceAuthorization as DeviceAuthorizationPb } from "@/types/proto/api/v1/auth_service";
+
+type Result = "approved" | "denied";
+
+const DeviceAuthorization = () => {
+  const [searchParams] = useSearchParams();
+  const [userCode, setUserCode] = useState<string>(searchParams.get("code") || "");
+  const [deviceAuthorization, setDeviceAuthorization] = useState<DeviceAuthorizationPb>();
+  const [result, setResult] = useState<Result>();
+  const requestState = useLoading(false);
+
+  useEffect(() => {
+    if (userCode) {
+      handleContinueBtnClick();
+    }
+  }, []);
+
+  const handleContinueBtnClick = async () => {
+    requestState.setLoading();
+    try {
+      setDeviceAuthorization(await authServiceClient.getDeviceAuthorization({ userCode }));
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ClientError).details);
+    }
+    requestState.setFinish();
+  };
+
+  const handleApproveBtnClick = async () => {
+    requestState.setLoading();
+    try {
+      await authServiceClient.approveDeviceAuthorization({ userCode });
+      setResult("approved");
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ClientError).details);
+    }
+    requestState.setFinish();
+  };
+
+  const handleDenyBtnClick = async () => {
+    requestState.setLoading();
+    try {
+      await authServiceClient.denyDeviceAuthorization({ userCode });
+      setResult("denied");
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ClientError).details);
+    }
+    requestState.setFinish();
+  };
+
+  return (
+    <div className="mx-auto max-w-8xl w-full px-4 sm:px-6 md:px-12 py-24 flex flex-col justify-start items-center">
+      <div className="w-full max-w-sm flex flex-col justify-start items-start gap-y-3">
+        <p className="text-2xl dark:text-gray-400">Connect a device</p>
+        {result ? (
+          <div className="w-full flex flex-row justify-start items-center gap-2 text-gray-600 dark:text-gray-400">
+            {result === "approved" ? <Icon.CircleCheck className="w-5 h-auto" /> : <Icon.CircleX className="w-5 h-auto" />}
+            <span>
+              {result === "approved"
+                ? `${deviceAuthorization?.clientName} is connected, you can go back to it.`
+                : `${deviceAuthorization?.clientName} is denied.`}
+            </span>
+          </div>
+        ) : deviceAuthorization ? (
+          <>
+            <p className="text-gray-600 dark:text-gray-400">
+              <span className="font-medium">{deviceAuthorization.clientName}</span> requests an access token of your account with{" "}
+              {deviceAuthorization.scopes.length > 0 ? (
+                <>
+                  the scopes <span className="font-mono">{deviceAuthorization.scopes.join(", ")}</span>
+                </>
+              ) : (
+                "full access"
+              )}
+              . Only approve it if you started connecting it yourself.
+            </p>
+            <div className="w-full flex flex-row justify-end items-center gap-2">
+              <Button variant="plain" color="neutral" disabled={requestState.isLoading} onClick={handleDenyBtnClick}>
+                Deny
+              </Button>
+              <Button color="primary" disabled={requestState.isLoading} loading={requestState.isLoading} onClick={handleApproveBtnClick}>
+                Approve
+              </Button>
+            </div>
+          </>
+        ) : (
+          <>
+            <p className="text-gray-600 dark:text-gray-400">Enter the code shown by the browser extension or the CLI.</p>
+            <Input
+              className="w-full font-mono"
+              placeholder="XXXX-XXXX"
+              value={userCode}
+              onChange={(e) => setUserCode(e.target.value.toUpperCase())}
+            />
+            <div className="w-full flex flex-row justify-end items-center">
+              <Button
+                color="primary"
+                disabled={!userCode || requestState.isLoading}
+                loading={requestState.isLoading}
+                onClick={handleContinueBtnClick}
+              >
+                Continue
+              </Button>
+            </div>
+          </>
+        )}
+      </div>
+    </div>
+  );
+};
+
+export default DeviceAuthorization;
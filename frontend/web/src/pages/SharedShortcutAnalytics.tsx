@@ -0,0 +1,41 @@
+import { useState } from "react";
+import { useParams } from "react-router-dom";
+import AnalyticsView from "@/components/AnalyticsView";
+import Icon from "@/components/Icon";
+import { SharedShortcutAnalytics as SharedShortcutAnalyticsType } from "@/types/proto/api/v1/shortcut_service";
+
+const SharedShortcutAnalytics = () => {
+  const params = useParams();
+  // The token of the analytics share link, for the people who aren't members of the workspace.
+  const shareToken = params["token"] || "";
+  const [sharedAnalytics, setSharedAnalytics] = useState<SharedShortcutAnalyticsType>();
+
+  const handleSharedAnalyticsLoad = (sharedAnalytics: SharedShortcutAnalyticsType) => {
+    setSharedAnalytics(sharedAnalytics);
+    document.title = `${sharedAnalytics.shortcutTitle || sharedAnalytics.shortcutName} - Analytics - Slash`;
+  };
+
+  return (
+    <div className="mx-auto max-w-8xl w-full px-4 sm:px-6 pt-8 pb-24 flex flex-col justify-start items-start">
+      {sharedAnalytics && (
+        <div className="w-full flex flex-col justify-start items-start">
+          <h3 className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+            <Icon.BarChart2 className="w-6 h-auto mr-1" />
+            {sharedAnalytics.shortcutTitle || sharedAnalytics.shortcutName}
+          </h3>
+          <p className="pl-1 text-sm text-gray-500">
+            s/{sharedAnalytics.shortcutName} · This link expires {sharedAnalytics.expireTime?.toLocaleString()}
+          </p>
+        </div>
+      )}
+      <AnalyticsView
+        className="mt-4 w-full grid grid-cols-1 sm:grid-cols-2 gap-2 sm:gap-4"
+        shortcutId={0}
+        shareToken={shareToken}
+        onSharedAnalyticsLoad={handleSharedAnalyticsLoad}
+      />
+    </div>
+  );
+};
+
+export default SharedShortcutAnalytics;
@@ -1,16 +1,21 @@
 import { Button, Input } from "@mui/joy";
 import { useEffect, useState } from "react";
+import toast from "react-hot-toast";
 import { useTranslation } from "react-i18next";
+import useDebounce from "react-use/lib/useDebounce";
 import useLocalStorage from "react-use/lib/useLocalStorage";
 import CreateShortcutDrawer from "@/components/CreateShortcutDrawer";
 import FilterView from "@/components/FilterView";
 import Icon from "@/components/Icon";
 import ShortcutsContainer from "@/components/ShortcutsContainer";
 import ShortcutsNavigator from "@/components/ShortcutsNavigator";
+import TrendingShortcuts from "@/components/TrendingShortcuts";
 import ViewSetting from "@/components/ViewSetting";
 import useLoading from "@/hooks/useLoading";
 import { useShortcutStore, useUserStore, useViewStore } from "@/stores";
 import { getFilteredShortcutList, getOrderedShortcutList } from "@/stores/view";
+import { Dashboard_Section } from "@/types/proto/api/v1/dashboard_service";
+import { GetTrendingShortcutsRequest_Window, GetTrendingShortcutsResponse_TrendingShortcut } from "@/types/proto/api/v1/shortcut_service";
 
 interface State {
   showCreateShortcutDrawer: boolean;
@@ -27,17 +32,51 @@ const ShortcutDashboard: React.FC = () => {
   const [state, setState] = useState<State>({
     showCreateShortcutDrawer: false,
   });
+  const [searchedShortcutIds, setSearchedShortcutIds] = useState<number[]>();
+  const [trendingShortcuts, setTrendingShortcuts] = useState<GetTrendingShortcutsResponse_TrendingShortcut[]>([]);
   const filter = viewStore.filter;
-  const filteredShortcutList = getFilteredShortcutList(shortcutList, filter, currentUser);
+  const filteredShortcutList = getFilteredShortcutList(
+    filter.search && searchedShortcutIds ? shortcutList.filter((shortcut) => searchedShortcutIds.includes(shortcut.id)) : shortcutList,
+    filter,
+    currentUser,
+  );
   const orderedShortcutList = getOrderedShortcutList(filteredShortcutList, viewStore.order);
 
   useEffect(() => {
     setLastVisited("/shortcuts");
-    Promise.all([shortcutStore.fetchShortcutList()]).finally(() => {
-      loadingState.setFinish();
-    });
+    // The shortcuts and the trending ones are loaded in one round trip.
+    shortcutStore
+      .fetchDashboard({ trendingWindow: GetTrendingShortcutsRequest_Window.WEEK, trendingLimit: 5 })
+      .then((dashboard) => {
+        setTrendingShortcuts(dashboard.trendingShortcuts);
+        for (const error of dashboard.errors) {
+          console.error(error.message);
+          if (error.section === Dashboard_Section.SHORTCUTS) {
+            toast.error(error.message);
+          }
+        }
+      })
+      .catch((error: any) => {
+        console.error(error);
+        toast.error(error.details);
+      })
+      .finally(() => {
+        loadingState.setFinish();
+      });
   }, []);
 
+  useDebounce(
+    () => {
+      if (!filter.search) {
+        setSearchedShortcutIds(undefined);
+        return;
+      }
+      shortcutStore.searchShortcuts(filter.search).then((shortcuts) => setSearchedShortcutIds(shortcuts.map((shortcut) => shortcut.id)));
+    },
+    300,
+    [filter.search],
+  );
+
   const setShowCreateShortcutDrawer = (show: boolean) => {
     setState({
       ...state,
@@ -70,6 +109,7 @@ const ShortcutDashboard: React.FC = () => {
           </div>
         </div>
         <FilterView />
+        {!filter.search && <TrendingShortcuts trendingShortcuts={trendingShortcuts} />}
         {loadingState.isLoading ? (
           <div className="py-12 w-full flex flex-row justify-center items-center opacity-80 dark:text-gray-500">
             <Icon.Loader className="mr-2 w-5 h-auto animate-spin" />
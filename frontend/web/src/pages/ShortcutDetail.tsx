@@ -11,6 +11,9 @@ import CreateShortcutDrawer from "@/components/CreateShortcutDrawer";
 import GenerateQRCodeDialog from "@/components/GenerateQRCodeDialog";
 import Icon from "@/components/Icon";
 import LinkFavicon from "@/components/LinkFavicon";
+import ProposedChangesView from "@/components/ProposedChangesView";
+import ShareAnalyticsDialog from "@/components/ShareAnalyticsDialog";
+import ShortcutRotationView from "@/components/ShortcutRotationView";
 import VisibilityIcon from "@/components/VisibilityIcon";
 import Dropdown from "@/components/common/Dropdown";
 import { absolutifyLink } from "@/helpers/utils";
@@ -37,6 +40,7 @@ const ShortcutDetail = () => {
     showEditDrawer: false,
   });
   const [showQRCodeDialog, setShowQRCodeDialog] = useState<boolean>(false);
+  const [showShareAnalyticsDialog, setShowShareAnalyticsDialog] = useState<boolean>(false);
   const loadingState = useLoading(true);
   const creator = userStore.getUserById(shortcut.creatorId);
   const havePermission = currentUser.role === Role.ADMIN || shortcut.creatorId === currentUser.id;
@@ -77,7 +81,7 @@ const ShortcutDetail = () => {
     <>
       <div className="mx-auto max-w-8xl w-full px-4 sm:px-6 md:px-12 pt-4 pb-6 flex flex-col justify-start items-start">
         <div className="mt-4 sm:mt-8 w-12 h-12 flex justify-center items-center overflow-clip">
-          <LinkFavicon url={shortcut.link} />
+          <LinkFavicon url={shortcut.link} favicon={shortcut.ogMetadata?.favicon} />
         </div>
         <a
           className={classNames(
@@ -149,6 +153,21 @@ const ShortcutDetail = () => {
               }
             ></Dropdown>
           )}
+          {!havePermission && shortcut.protected && (
+            <Tooltip title="Propose a change" variant="solid" placement="top" arrow>
+              <button
+                className="w-8 h-8 cursor-pointer border rounded-full text-gray-500 hover:bg-gray-100 hover:shadow dark:border-zinc-800 dark:hover:bg-zinc-800"
+                onClick={() =>
+                  setState({
+                    ...state,
+                    showEditDrawer: true,
+                  })
+                }
+              >
+                <Icon.GitPullRequest className="w-4 h-auto mx-auto" />
+              </button>
+            </Tooltip>
+          )}
         </div>
         {shortcut.description && <p className="w-full break-all mt-4 text-gray-500 dark:text-gray-400">{shortcut.description}</p>}
         <div className="mt-2 flex flex-row justify-start items-start flex-wrap gap-2">
@@ -174,6 +193,14 @@ const ShortcutDetail = () => {
               {t(`shortcut.visibility.${shortcut.visibility.toLowerCase()}.self`)}
             </div>
           </Tooltip>
+          {shortcut.protected && (
+            <Tooltip title="Changes by other users need the approval of the creator or an admin" variant="solid" placement="top" arrow>
+              <div className="w-auto px-2 leading-6 flex flex-row justify-start items-center border rounded-full text-gray-500 text-sm dark:border-zinc-800">
+                <Icon.ShieldCheck className="w-4 h-auto mr-1" />
+                Protected
+              </div>
+            </Tooltip>
+          )}
           <Tooltip title="View count" variant="solid" placement="top" arrow>
             <div className="w-auto px-2 leading-6 flex flex-row justify-start items-center border rounded-full text-gray-500 text-sm dark:border-zinc-800">
               <Icon.BarChart2 className="w-4 h-auto mr-1" />
@@ -182,17 +209,34 @@ const ShortcutDetail = () => {
           </Tooltip>
         </div>
 
+        {havePermission && shortcut.protected && <ProposedChangesView shortcut={shortcut} />}
+
+        {havePermission && <ShortcutRotationView shortcut={shortcut} />}
+
         <div className="w-full flex flex-col mt-8">
-          <h3 id="analytics" className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
-            <Icon.BarChart2 className="w-6 h-auto mr-1" />
-            {t("analytics.self")}
-          </h3>
+          <div className="w-full flex flex-row justify-between items-center">
+            <h3 id="analytics" className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+              <Icon.BarChart2 className="w-6 h-auto mr-1" />
+              {t("analytics.self")}
+            </h3>
+            {havePermission && (
+              <button
+                className="flex flex-row justify-start items-center text-sm text-gray-500 hover:text-gray-700 dark:hover:text-gray-400"
+                onClick={() => setShowShareAnalyticsDialog(true)}
+              >
+                <Icon.Share className="w-4 h-auto mr-1" />
+                Share
+              </button>
+            )}
+          </div>
           <AnalyticsView className="mt-4 w-full grid grid-cols-1 sm:grid-cols-2 gap-2 sm:gap-4" shortcutId={shortcut.id} />
         </div>
       </div>
 
       {showQRCodeDialog && <GenerateQRCodeDialog shortcut={shortcut} onClose={() => setShowQRCodeDialog(false)} />}
 
+      {showShareAnalyticsDialog && <ShareAnalyticsDialog shortcut={shortcut} onClose={() => setShowShareAnalyticsDialog(false)} />}
+
       {state.showEditDrawer && (
         <CreateShortcutDrawer
           shortcutId={shortcut.id}
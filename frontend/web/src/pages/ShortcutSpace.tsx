@@ -1,11 +1,15 @@
 import { Button } from "@mui/joy";
+import { ClientError, Status } from "nice-grpc-web";
 import { useEffect, useState } from "react";
 import toast from "react-hot-toast";
 import { useParams, useSearchParams } from "react-router-dom";
 import CreateShortcutDrawer from "@/components/CreateShortcutDrawer";
-import { isURL } from "@/helpers/utils";
+import Logo from "@/components/Logo";
+import { shortcutServiceClient } from "@/grpcweb";
+import { absolutifyLink, collectionSearchParam, isURL, needsRedirectConfirmation } from "@/helpers/utils";
 import useNavigateTo from "@/hooks/useNavigateTo";
-import { useShortcutStore, useUserStore } from "@/stores";
+import { useShortcutStore, useUserStore, useWorkspaceStore } from "@/stores";
+import { State } from "@/types/proto/api/v1/common";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 
 const ShortcutSpace = () => {
@@ -16,7 +20,9 @@ const ShortcutSpace = () => {
   const userStore = useUserStore();
   const currentUser = userStore.getCurrentUser();
   const shortcutStore = useShortcutStore();
+  const workspaceStore = useWorkspaceStore();
   const [shortcut, setShortcut] = useState<Shortcut>();
+  const [suggestions, setSuggestions] = useState<string[]>([]);
   const [loading, setLoading] = useState(true);
   const [showCreateShortcutDrawer, setShowCreateShortcutDrawer] = useState(false);
 
@@ -24,10 +30,20 @@ const ShortcutSpace = () => {
     (async () => {
       try {
         const shortcut = await shortcutStore.fetchShortcutByName(shortcutName);
+        // The preference of the user on confirming the external redirects.
+        if (currentUser && !userStore.getCurrentUserSetting()) {
+          await userStore.fetchUserSetting(currentUser.id);
+        }
         setShortcut(shortcut);
       } catch (error: any) {
-        console.error(error);
-        toast.error(error.details);
+        if ((error as ClientError).code === Status.NOT_FOUND) {
+          // Suggest the shortcuts close to the name, e.g. when it's mistyped.
+          const { suggestions } = await shortcutServiceClient.listShortcutSuggestions({ name: shortcutName });
+          setSuggestions(suggestions);
+        } else {
+          console.error(error);
+          toast.error(error.details);
+        }
       }
       setLoading(false);
     })();
@@ -38,23 +54,43 @@ const ShortcutSpace = () => {
   }
 
   if (!shortcut) {
-    if (!currentUser) {
+    const notFoundSetting = workspaceStore.setting.notFound;
+    if (notFoundSetting?.redirectUrl) {
+      window.location.href = notFoundSetting.redirectUrl.replaceAll("{name}", encodeURIComponent(shortcutName));
+      return null;
+    }
+    const allowCreate = currentUser && !notFoundSetting?.disableCreateOffer;
+    if (!allowCreate && !notFoundSetting?.message && suggestions.length === 0) {
       navigateTo("/404");
       return null;
     }
 
-    // If shortcut is not found, prompt user to create it.
+    // If shortcut is not found, show the not found page of the workspace.
     return (
       <>
         <div className="w-full h-[100svh] flex flex-col justify-center items-center p-4">
+          <Logo className="mb-4" />
           <p className="text-xl">
             Shortcut <span className="font-mono">{shortcutName}</span> Not Found.
           </p>
-          <div className="mt-4">
-            <Button variant="plain" size="sm" onClick={() => setShowCreateShortcutDrawer(true)}>
-              👉 Click here to create it
-            </Button>
-          </div>
+          {notFoundSetting?.message && <p className="mt-2 text-gray-500 whitespace-pre-wrap text-center">{notFoundSetting.message}</p>}
+          {suggestions.length > 0 && (
+            <div className="mt-4 flex flex-col justify-center items-center">
+              <p className="text-gray-500">Did you mean:</p>
+              {suggestions.map((suggestion) => (
+                <a key={suggestion} className="mt-1 font-mono text-blue-600 hover:underline" href={absolutifyLink(`/s/${suggestion}`)}>
+                  {suggestion}
+                </a>
+              ))}
+            </div>
+          )}
+          {allowCreate && (
+            <div className="mt-4">
+              <Button variant="plain" size="sm" onClick={() => setShowCreateShortcutDrawer(true)}>
+                👉 Click here to create it
+              </Button>
+            </div>
+          )}
         </div>
         {showCreateShortcutDrawer && (
           <CreateShortcutDrawer
@@ -67,19 +103,72 @@ const ShortcutSpace = () => {
     );
   }
 
-  // If shortcut is a URL, redirect to it directly.
-  if (isURL(shortcut.link)) {
+  if (shortcut.state === State.INACTIVE || (shortcut.expireTime && shortcut.expireTime <= new Date())) {
+    return (
+      <div className="w-full h-[100svh] flex flex-col justify-center items-center p-4">
+        <p className="text-xl">
+          Shortcut <span className="font-mono">{shortcutName}</span> has expired.
+        </p>
+      </div>
+    );
+  }
+
+  if (shortcut.activateTime && shortcut.activateTime > new Date()) {
+    return (
+      <div className="w-full h-[100svh] flex flex-col justify-center items-center p-4">
+        <p className="text-xl">
+          Shortcut <span className="font-mono">{shortcutName}</span> is coming soon.
+        </p>
+        <p className="mt-2 text-gray-500">It will be available on {shortcut.activateTime.toLocaleString()}.</p>
+      </div>
+    );
+  }
+
+  // If shortcut is a URL, redirect to it directly. The current link follows the rotation schedule of the shortcut.
+  if (isURL(shortcut.currentLink)) {
     window.document.title = "Redirecting...";
-    const url = new URL(shortcut.link);
+    const url = new URL(shortcut.currentLink);
+    const collectionName = searchParams.get(collectionSearchParam) || "";
     searchParams.forEach((value, key) => {
-      url.searchParams.append(key, value);
+      if (key !== collectionSearchParam) {
+        url.searchParams.append(key, value);
+      }
     });
+    // The query params of the shortcut don't override the ones in the link or the request.
+    for (const queryParam of shortcut.queryParams) {
+      if (!url.searchParams.has(queryParam.key)) {
+        const value = queryParam.value.replaceAll("{name}", shortcut.name).replaceAll("{collection}", collectionName);
+        url.searchParams.set(queryParam.key, value);
+      }
+    }
+    const { confirmExternalRedirects, internalDomains } = workspaceStore.setting;
+    const userPreference = userStore.getCurrentUserSetting()?.general?.externalRedirectConfirmation || "";
+    if (needsRedirectConfirmation(url.hostname, userPreference, confirmExternalRedirects, internalDomains)) {
+      window.document.title = `Leaving for ${url.hostname}`;
+      return (
+        <div className="w-full h-[100svh] flex flex-col justify-center items-center p-4">
+          <Logo className="mb-4" />
+          <p className="text-xl text-center">
+            Shortcut <span className="font-mono">{shortcutName}</span> leads outside of the workspace.
+          </p>
+          <p className="mt-2 max-w-full text-gray-500 break-all text-center">{url.toString()}</p>
+          <div className="mt-4 flex flex-row justify-center items-center gap-2">
+            <Button variant="plain" color="neutral" onClick={() => navigateTo("/")}>
+              Cancel
+            </Button>
+            <Button component="a" href={url.toString()}>
+              Continue to {url.hostname}
+            </Button>
+          </div>
+        </div>
+      );
+    }
     window.location.href = url.toString();
     return null;
   }
 
   // Otherwise, render the shortcut link as plain text.
-  return <div>{shortcut.link}</div>;
+  return <div>{shortcut.currentLink}</div>;
 };
 
 export default ShortcutSpace;
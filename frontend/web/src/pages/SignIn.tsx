@@ -1,17 +1,35 @@
 import { Button, Divider } from "@mui/joy";
-import React from "react";
+import React, { useEffect, useState } from "react";
 import { toast } from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { Link } from "react-router-dom";
+import LDAPSignInDialog from "@/components/LDAPSignInDialog";
 import Logo from "@/components/Logo";
 import PasswordAuthForm from "@/components/PasswordAuthForm";
-import { absolutifyLink } from "@/helpers/utils";
-import { useWorkspaceStore } from "@/stores";
+import { authServiceClient } from "@/grpcweb";
+import { getPasskey, isPasskeySupported } from "@/helpers/passkey";
+import { absolutifyLink, oidcNonceStorageKey } from "@/helpers/utils";
+import useNavigateTo from "@/hooks/useNavigateTo";
+import { useUserStore, useWorkspaceStore } from "@/stores";
 import { IdentityProvider, IdentityProvider_Type } from "@/types/proto/api/v1/workspace_service";
 
 const SignIn: React.FC = () => {
   const { t } = useTranslation();
+  const navigateTo = useNavigateTo();
   const workspaceStore = useWorkspaceStore();
+  const userStore = useUserStore();
+  const identityProviders = workspaceStore.setting.identityProviders;
+  // Passkeys are bound to the domain of the instance url.
+  const allowPasskey = workspaceStore.setting.instanceUrl !== "" && isPasskeySupported();
+  // The LDAP identity provider to sign in with the username and password of the directory.
+  const [ldapIdentityProvider, setLDAPIdentityProvider] = useState<IdentityProvider>();
+
+  useEffect(() => {
+    // Redirect to the identity provider directly when it's the only sign in method.
+    if (workspaceStore.setting.disallowPasswordAuth && identityProviders.length === 1 && identityProviders[0].autoRedirect) {
+      handleSignInWithIdentityProvider(identityProviders[0]);
+    }
+  }, [workspaceStore.setting]);
 
   const handleSignInWithIdentityProvider = async (identityProvider: IdentityProvider) => {
     const stateQueryParameter = identityProvider.id;
@@ -22,12 +40,37 @@ const SignIn: React.FC = () => {
         toast.error("Identity provider configuration is invalid.");
         return;
       }
-      const authUrl = `${oauth2Config.authUrl}?client_id=${
+      let authUrl = `${oauth2Config.authUrl}?client_id=${
         oauth2Config.clientId
       }&redirect_uri=${redirectUri}&state=${stateQueryParameter}&response_type=code&scope=${encodeURIComponent(
         oauth2Config.scopes.join(" "),
       )}`;
+      if (oauth2Config.issuerUrl) {
+        // The ID token of an OpenID Connect provider must carry the nonce, which is checked on the callback.
+        const nonce = crypto.randomUUID();
+        sessionStorage.setItem(oidcNonceStorageKey, nonce);
+        authUrl += `&nonce=${nonce}`;
+      }
       window.location.href = authUrl;
+    } else if (identityProvider.type === IdentityProvider_Type.SAML) {
+      // The server redirects to the identity provider with the authentication request.
+      window.location.href = absolutifyLink(`/api/v1/saml/${encodeURIComponent(identityProvider.id)}/login`);
+    } else if (identityProvider.type === IdentityProvider_Type.LDAP) {
+      setLDAPIdentityProvider(identityProvider);
+    }
+  };
+
+  const handleSignInWithPasskey = async () => {
+    try {
+      const { options } = await authServiceClient.beginPasskeySignIn({});
+      const credential = await getPasskey(options);
+      const user = await authServiceClient.signInWithPasskey({ credential });
+      userStore.setCurrentUserId(user.id);
+      await userStore.fetchCurrentUser();
+      navigateTo("/");
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details ?? error.message);
     }
   };
 
@@ -52,17 +95,25 @@ const SignIn: React.FC = () => {
               </Link>
             </p>
           )}
-          {workspaceStore.setting.identityProviders.length > 0 && (
+          {(identityProviders.length > 0 || allowPasskey) && (
             <>
               <Divider className="!my-4">{t("common.or")}</Divider>
               <div className="w-full flex flex-col space-y-2">
-                {workspaceStore.setting.identityProviders.map((identityProvider) => (
+                {allowPasskey && (
+                  <Button variant="outlined" color="neutral" className="w-full" size="md" onClick={handleSignInWithPasskey}>
+                    {t("auth.sign-in-with", { provider: "passkey" })}
+                  </Button>
+                )}
+                {identityProviders.map((identityProvider) => (
                   <Button
                     key={identityProvider.id}
                     variant="outlined"
                     color="neutral"
                     className="w-full"
                     size="md"
+                    startDecorator={
+                      identityProvider.iconUrl ? <img className="w-5 h-5 object-contain" src={identityProvider.iconUrl} alt="" /> : undefined
+                    }
                     onClick={() => handleSignInWithIdentityProvider(identityProvider)}
                   >
                     {t("auth.sign-in-with", { provider: identityProvider.title })}
@@ -73,6 +124,12 @@ const SignIn: React.FC = () => {
           )}
         </div>
       </div>
+      {ldapIdentityProvider && (
+        <LDAPSignInDialog
+          identityProvider={ldapIdentityProvider}
+          onClose={() => setLDAPIdentityProvider(undefined)}
+        />
+      )}
     </div>
   );
 };
@@ -0,0 +1,85 @@
+import { Avatar, Divider } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { useParams } from "react-router-dom";
+import Icon from "@/components/Icon";
+import LinkFavicon from "@/components/LinkFavicon";
+import { userServiceClient } from "@/grpcweb";
+import { absolutifyLink } from "@/helpers/utils";
+import { UserPublicProfile } from "@/types/proto/api/v1/user_service";
+import NotFound from "./NotFound";
+
+const UserProfile = () => {
+  const params = useParams();
+  const username = params.username || "";
+  const [profile, setProfile] = useState<UserPublicProfile>();
+  const [notFound, setNotFound] = useState<boolean>(false);
+
+  useEffect(() => {
+    (async () => {
+      try {
+        const profile = await userServiceClient.getUserPublicProfile({ username });
+        setProfile(profile);
+        document.title = `${profile.nickname} - Slash`;
+      } catch (error: any) {
+        console.error(error);
+        setNotFound(true);
+      }
+    })();
+  }, [username]);
+
+  if (notFound) {
+    return <NotFound />;
+  }
+  if (!profile) {
+    return null;
+  }
+
+  return (
+    <div className="w-full h-full overflow-y-auto bg-gray-50 dark:bg-zinc-900">
+      <div className="w-full max-w-3xl mx-auto px-4 py-10 flex flex-col justify-start items-start gap-4">
+        <div className="flex flex-row justify-start items-center gap-3">
+          <Avatar size="lg" src={absolutifyLink(profile.avatarUrl)} alt={profile.nickname.toUpperCase()} />
+          <div className="flex flex-col justify-start items-start">
+            <span className="text-2xl font-medium dark:text-gray-300">{profile.nickname}</span>
+            <span className="text-gray-500">@{profile.username}</span>
+          </div>
+        </div>
+        <Divider />
+        <p className="text-lg font-medium dark:text-gray-400">Shortcuts</p>
+        {profile.shortcuts.length === 0 && <p className="text-gray-400 italic">No public shortcuts</p>}
+        <div className="w-full flex flex-col justify-start items-start gap-2">
+          {profile.shortcuts.map((shortcut) => (
+            <a
+              key={shortcut.id}
+              className="w-full flex flex-row justify-start items-center gap-2 px-3 py-2 rounded-lg border dark:border-zinc-800 hover:bg-gray-100 dark:hover:bg-zinc-800"
+              href={absolutifyLink(`/s/${shortcut.name}`)}
+              target="_blank"
+            >
+              <LinkFavicon url={shortcut.link} favicon={shortcut.ogMetadata?.favicon} />
+              <span className="truncate dark:text-gray-400">{shortcut.title || shortcut.name}</span>
+              <span className="truncate text-gray-400 text-sm">s/{shortcut.name}</span>
+            </a>
+          ))}
+        </div>
+        <p className="text-lg font-medium dark:text-gray-400">Collections</p>
+        {profile.collections.length === 0 && <p className="text-gray-400 italic">No public collections</p>}
+        <div className="w-full flex flex-col justify-start items-start gap-2">
+          {profile.collections.map((collection) => (
+            <a
+              key={collection.id}
+              className="w-full flex flex-row justify-start items-center gap-2 px-3 py-2 rounded-lg border dark:border-zinc-800 hover:bg-gray-100 dark:hover:bg-zinc-800"
+              href={absolutifyLink(`/c/${collection.name}`)}
+              target="_blank"
+            >
+              <Icon.LibrarySquare className="w-5 h-auto opacity-70" />
+              <span className="truncate dark:text-gray-400">{collection.title}</span>
+              <span className="truncate text-gray-400 text-sm">c/{collection.name}</span>
+            </a>
+          ))}
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default UserProfile;
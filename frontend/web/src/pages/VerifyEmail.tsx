@@ -0,0 +1,74 @@
+import { Button } from "@mui/joy";
+import { ClientError } from "nice-grpc-web";
+import { useEffect, useState } from "react";
+import { useSearchParams } from "react-router-dom";
+import Icon from "@/components/Icon";
+import { authServiceClient } from "@/grpcweb";
+import useNavigateTo from "@/hooks/useNavigateTo";
+import { useUserStore } from "@/stores";
+
+interface State {
+  loading: boolean;
+  errorMessage: string;
+}
+
+const VerifyEmail = () => {
+  const navigateTo = useNavigateTo();
+  const [searchParams] = useSearchParams();
+  const userStore = useUserStore();
+  const [state, setState] = useState<State>({
+    loading: true,
+    errorMessage: "",
+  });
+
+  useEffect(() => {
+    const token = searchParams.get("token");
+    if (!token) {
+      setState({
+        loading: false,
+        errorMessage: "No verification token found in the link.",
+      });
+      return;
+    }
+
+    (async () => {
+      try {
+        const user = await authServiceClient.verifyEmail({
+          token,
+        });
+        // The link may be opened where the user isn't signed in.
+        if (user.id === userStore.currentUserId) {
+          await userStore.fetchCurrentUser();
+        }
+        setState({
+          loading: false,
+          errorMessage: "",
+        });
+      } catch (error: any) {
+        console.error(error);
+        setState({
+          loading: false,
+          errorMessage: (error as ClientError).details,
+        });
+      }
+    })();
+  }, [searchParams]);
+
+  return (
+    <div className="p-4 py-24 w-full h-full flex justify-center items-center">
+      {state.loading ? (
+        <Icon.Loader className="animate-spin dark:text-gray-200" />
+      ) : state.errorMessage ? (
+        <div className="max-w-lg font-mono whitespace-pre-wrap opacity-80">{state.errorMessage}</div>
+      ) : (
+        <div className="max-w-lg flex flex-col justify-start items-center gap-y-3">
+          <Icon.MailCheck className="w-12 h-auto text-gray-500" />
+          <p className="text-xl font-medium dark:text-gray-400">Your email is verified</p>
+          <Button onClick={() => navigateTo("/")}>Continue to Slash</Button>
+        </div>
+      )}
+    </div>
+  );
+};
+
+export default VerifyEmail;
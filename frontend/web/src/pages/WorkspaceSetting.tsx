@@ -2,9 +2,19 @@ import { Alert, Button, Divider } from "@mui/joy";
 import { useEffect } from "react";
 import { Link } from "react-router-dom";
 import Icon from "@/components/Icon";
+import CollectionTemplateSection from "@/components/setting/CollectionTemplateSection";
+import CompletionSection from "@/components/setting/CompletionSection";
+import FederationSection from "@/components/setting/FederationSection";
+import GitSyncSection from "@/components/setting/GitSyncSection";
+import LandingSection from "@/components/setting/LandingSection";
+import MailSection from "@/components/setting/MailSection";
+import NotFoundSection from "@/components/setting/NotFoundSection";
+import TeamSection from "@/components/setting/TeamSection";
+import WorkspaceExportSection from "@/components/setting/WorkspaceExportSection";
 import WorkspaceGeneralSettingSection from "@/components/setting/WorkspaceGeneralSettingSection";
 import WorkspaceMembersSection from "@/components/setting/WorkspaceMembersSection";
 import WorkspaceSecuritySection from "@/components/setting/WorkspaceSecuritySection";
+import { absolutifyLink } from "@/helpers/utils";
 import { useUserStore, useWorkspaceStore } from "@/stores";
 import { stringifyPlanType } from "@/stores/subscription";
 import { Role } from "@/types/proto/api/v1/user_service";
@@ -16,7 +26,7 @@ const WorkspaceSetting = () => {
 
   useEffect(() => {
     if (!isAdmin) {
-      window.location.href = "/";
+      window.location.href = absolutifyLink("/");
     }
   }, []);
 
@@ -44,9 +54,27 @@ const WorkspaceSetting = () => {
       <Divider />
       <WorkspaceMembersSection />
       <Divider />
+      <TeamSection />
+      <Divider />
       <WorkspaceGeneralSettingSection />
       <Divider />
       <WorkspaceSecuritySection />
+      <Divider />
+      <MailSection />
+      <Divider />
+      <NotFoundSection />
+      <Divider />
+      <LandingSection />
+      <Divider />
+      <CollectionTemplateSection />
+      <Divider />
+      <CompletionSection />
+      <Divider />
+      <GitSyncSection />
+      <Divider />
+      <FederationSection />
+      <Divider />
+      <WorkspaceExportSection />
     </div>
   );
 };
@@ -1,95 +1,121 @@
 import { createBrowserRouter } from "react-router-dom";
 import App from "@/App";
+import { basePath } from "@/helpers/utils";
 import Root from "@/layouts/Root";
 import AdminSignIn from "@/pages/AdminSignIn";
 import AuthCallback from "@/pages/AuthCallback";
 import CollectionDashboard from "@/pages/CollectionDashboard";
 import CollectionSpace from "@/pages/CollectionSpace";
+import DeviceAuthorization from "@/pages/DeviceAuthorization";
 import Home from "@/pages/Home";
 import NotFound from "@/pages/NotFound";
+import SharedShortcutAnalytics from "@/pages/SharedShortcutAnalytics";
 import ShortcutDashboard from "@/pages/ShortcutDashboard";
 import ShortcutDetail from "@/pages/ShortcutDetail";
 import ShortcutSpace from "@/pages/ShortcutSpace";
 import SignIn from "@/pages/SignIn";
 import SignUp from "@/pages/SignUp";
 import SubscriptionSetting from "@/pages/SubscriptionSetting";
+import UserProfile from "@/pages/UserProfile";
 import UserSetting from "@/pages/UserSetting";
+import VerifyEmail from "@/pages/VerifyEmail";
 import WorkspaceSetting from "@/pages/WorkspaceSetting";
 
-const router = createBrowserRouter([
+const router = createBrowserRouter(
+  [
+    {
+      path: "/",
+      element: <App />,
+      children: [
+        {
+          path: "/auth",
+          children: [
+            {
+              path: "",
+              element: <SignIn />,
+            },
+            {
+              path: "admin",
+              element: <AdminSignIn />,
+            },
+            {
+              path: "signup",
+              element: <SignUp />,
+            },
+            {
+              path: "callback",
+              element: <AuthCallback />,
+            },
+            {
+              path: "verify-email",
+              element: <VerifyEmail />,
+            },
+          ],
+        },
+        {
+          path: "",
+          element: <Root />,
+          children: [
+            {
+              path: "/",
+              element: <Home />,
+            },
+            {
+              path: "/shortcuts",
+              element: <ShortcutDashboard />,
+            },
+            {
+              path: "/collections",
+              element: <CollectionDashboard />,
+            },
+            {
+              path: "/shortcut/:shortcutId",
+              element: <ShortcutDetail />,
+            },
+            {
+              path: "/setting/general",
+              element: <UserSetting />,
+            },
+            {
+              path: "/setting/workspace",
+              element: <WorkspaceSetting />,
+            },
+            {
+              path: "/setting/subscription",
+              element: <SubscriptionSetting />,
+            },
+            {
+              path: "/device",
+              element: <DeviceAuthorization />,
+            },
+          ],
+        },
+        {
+          path: "s/*",
+          element: <ShortcutSpace />,
+        },
+        {
+          path: "c/*",
+          element: <CollectionSpace />,
+        },
+        {
+          path: "u/:username",
+          element: <UserProfile />,
+        },
+        {
+          path: "analytics/:token",
+          element: <SharedShortcutAnalytics />,
+        },
+        {
+          path: "*",
+          element: <NotFound />,
+        },
+      ],
+    },
+  ],
   {
-    path: "/",
-    element: <App />,
-    children: [
-      {
-        path: "/auth",
-        children: [
-          {
-            path: "",
-            element: <SignIn />,
-          },
-          {
-            path: "admin",
-            element: <AdminSignIn />,
-          },
-          {
-            path: "signup",
-            element: <SignUp />,
-          },
-          {
-            path: "callback",
-            element: <AuthCallback />,
-          },
-        ],
-      },
-      {
-        path: "",
-        element: <Root />,
-        children: [
-          {
-            path: "/",
-            element: <Home />,
-          },
-          {
-            path: "/shortcuts",
-            element: <ShortcutDashboard />,
-          },
-          {
-            path: "/collections",
-            element: <CollectionDashboard />,
-          },
-          {
-            path: "/shortcut/:shortcutId",
-            element: <ShortcutDetail />,
-          },
-          {
-            path: "/setting/general",
-            element: <UserSetting />,
-          },
-          {
-            path: "/setting/workspace",
-            element: <WorkspaceSetting />,
-          },
-          {
-            path: "/setting/subscription",
-            element: <SubscriptionSetting />,
-          },
-        ],
-      },
-      {
-        path: "s/*",
-        element: <ShortcutSpace />,
-      },
-      {
-        path: "c/*",
-        element: <CollectionSpace />,
-      },
-      {
-        path: "*",
-        element: <NotFound />,
-      },
-    ],
+    basename: basePath || "/",
   },
-]);
+);
 
 export default router;
@@ -10,7 +10,11 @@ interface CollectionState {
   getCollectionList: () => Collection[];
   fetchCollectionByName: (collectionName: string) => Promise<Collection>;
   createCollection: (collection: Collection) => Promise<Collection>;
+  createCollectionFromTemplate: (templateId: string, collection: Partial<Collection>) => Promise<Collection>;
   updateCollection: (collection: Partial<Collection>, updateMask: string[]) => Promise<Collection>;
+  transferCollection: (id: number, userId: number) => Promise<Collection>;
+  archiveCollection: (id: number) => Promise<Collection>;
+  unarchiveCollection: (id: number) => Promise<Collection>;
   deleteCollection: (id: number) => Promise<void>;
 }
 
@@ -63,6 +67,16 @@ const useCollectionStore = create<CollectionState>()((set, get) => ({
     set(collectionMap);
     return createdCollection;
   },
+  createCollectionFromTemplate: async (templateId: string, collection: Partial<Collection>) => {
+    const createdCollection = await collectionServiceClient.createCollectionFromTemplate({
+      templateId,
+      collection: collection,
+    });
+    const collectionMap = get().collectionMapById;
+    collectionMap[createdCollection.id] = createdCollection;
+    set(collectionMap);
+    return createdCollection;
+  },
   updateCollection: async (collection: Partial<Collection>, updateMask: string[]) => {
     const updatedCollection = await collectionServiceClient.updateCollection({
       collection: collection,
@@ -73,6 +87,34 @@ const useCollectionStore = create<CollectionState>()((set, get) => ({
     set(collectionMap);
     return updatedCollection;
   },
+  transferCollection: async (id: number, userId: number) => {
+    const transferredCollection = await collectionServiceClient.transferCollection({
+      id,
+      userId,
+    });
+    const collectionMap = get().collectionMapById;
+    collectionMap[transferredCollection.id] = transferredCollection;
+    set(collectionMap);
+    return transferredCollection;
+  },
+  archiveCollection: async (id: number) => {
+    const archivedCollection = await collectionServiceClient.archiveCollection({
+      id,
+    });
+    const collectionMap = get().collectionMapById;
+    collectionMap[archivedCollection.id] = archivedCollection;
+    set(collectionMap);
+    return archivedCollection;
+  },
+  unarchiveCollection: async (id: number) => {
+    const unarchivedCollection = await collectionServiceClient.unarchiveCollection({
+      id,
+    });
+    const collectionMap = get().collectionMapById;
+    collectionMap[unarchivedCollection.id] = unarchivedCollection;
+    set(collectionMap);
+    return unarchivedCollection;
+  },
   deleteCollection: async (id: number) => {
     await collectionServiceClient.deleteCollection({
       id,
@@ -1,7 +1,8 @@
 import { isEqual } from "lodash-es";
 import { create } from "zustand";
 import { combine } from "zustand/middleware";
-import { shortcutServiceClient } from "@/grpcweb";
+import { dashboardServiceClient, shortcutServiceClient } from "@/grpcweb";
+import { GetDashboardRequest } from "@/types/proto/api/v1/dashboard_service";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 
 interface State {
@@ -25,12 +26,39 @@ const useShortcutStore = create(
       set({ shortcutMapById: shortcutMap });
       return shortcuts;
     },
+    fetchDashboard: async (request: Partial<GetDashboardRequest>) => {
+      const dashboard = await dashboardServiceClient.getDashboard(request);
+      const shortcutMap = get().shortcutMapById;
+      dashboard.shortcuts.forEach((shortcut) => {
+        shortcutMap[shortcut.id] = shortcut;
+      });
+      set({ shortcutMapById: shortcutMap });
+      return dashboard;
+    },
+    searchShortcuts: async (query: string) => {
+      const { shortcuts } = await shortcutServiceClient.searchShortcuts({ query });
+      const shortcutMap = get().shortcutMapById;
+      shortcuts.forEach((shortcut) => {
+        shortcutMap[shortcut.id] = shortcut;
+      });
+      set({ shortcutMapById: shortcutMap });
+      return shortcuts;
+    },
     fetchShortcutByName: async (name: string) => {
       const shortcut = await shortcutServiceClient.getShortcutByName({
         name,
       });
       return shortcut;
     },
+    fetchShortcutById: async (id: number) => {
+      const shortcut = await shortcutServiceClient.getShortcut({
+        id,
+      });
+      const shortcutMap = get().shortcutMapById;
+      shortcutMap[id] = shortcut;
+      set({ shortcutMapById: shortcutMap });
+      return shortcut;
+    },
     getOrFetchShortcutById: async (id: number) => {
       const shortcutMap = get().shortcutMapById;
       if (shortcutMap[id]) {
@@ -70,6 +98,25 @@ const useShortcutStore = create(
       set({ shortcutMapById: shortcutMap });
       return updatedShortcut;
     },
+    transferShortcut: async (id: number, userId: number) => {
+      const transferredShortcut = await shortcutServiceClient.transferShortcut({
+        id,
+        userId,
+      });
+      const shortcutMap = get().shortcutMapById;
+      shortcutMap[transferredShortcut.id] = transferredShortcut;
+      set({ shortcutMapById: shortcutMap });
+      return transferredShortcut;
+    },
+    refreshShortcutMetadata: async (id: number) => {
+      const refreshedShortcut = await shortcutServiceClient.refreshShortcutMetadata({
+        id,
+      });
+      const shortcutMap = get().shortcutMapById;
+      shortcutMap[refreshedShortcut.id] = refreshedShortcut;
+      set({ shortcutMapById: shortcutMap });
+      return refreshedShortcut;
+    },
     deleteShortcut: async (id: number) => {
       await shortcutServiceClient.deleteShortcut({
         id,
@@ -86,6 +133,9 @@ const unknownShortcut: Shortcut = Shortcut.fromPartial({
   name: "Unknown",
 });
 
+// proposableShortcutPaths are the paths of the fields which the other users can propose to change in a protected shortcut.
+export const proposableShortcutPaths = ["name", "link", "title", "description", "tags", "visibility"];
+
 export const getShortcutUpdateMask = (shortcut: Shortcut, updatingShortcut: Shortcut) => {
   const updateMask: string[] = [];
   if (!isEqual(shortcut.name, updatingShortcut.name)) {
@@ -103,12 +153,48 @@ export const getShortcutUpdateMask = (shortcut: Shortcut, updatingShortcut: Shor
   if (!isEqual(shortcut.tags, updatingShortcut.tags)) {
     updateMask.push("tags");
   }
+  if (!isEqual(shortcut.aliases, updatingShortcut.aliases)) {
+    updateMask.push("aliases");
+  }
   if (!isEqual(shortcut.visibility, updatingShortcut.visibility)) {
     updateMask.push("visibility");
   }
+  if (!isEqual(shortcut.teamId, updatingShortcut.teamId)) {
+    updateMask.push("team_id");
+  }
   if (!isEqual(shortcut.ogMetadata, updatingShortcut.ogMetadata)) {
     updateMask.push("og_metadata");
   }
+  if (!isEqual(shortcut.clickGoal, updatingShortcut.clickGoal)) {
+    updateMask.push("click_goal");
+  }
+  if (!isEqual(shortcut.expireTime, updatingShortcut.expireTime)) {
+    updateMask.push("expire_time");
+  }
+  if (!isEqual(shortcut.queryParams, updatingShortcut.queryParams)) {
+    updateMask.push("query_params");
+  }
+  if (!isEqual(shortcut.activateTime, updatingShortcut.activateTime)) {
+    updateMask.push("activate_time");
+  }
+  if (!isEqual(shortcut.protected, updatingShortcut.protected)) {
+    updateMask.push("protected");
+  }
+  if (!isEqual(shortcut.redirectCode, updatingShortcut.redirectCode)) {
+    updateMask.push("redirect_code");
+  }
+  if (!isEqual(shortcut.documentMode, updatingShortcut.documentMode)) {
+    updateMask.push("document_mode");
+  }
+  if (!isEqual(shortcut.internalDescription, updatingShortcut.internalDescription)) {
+    updateMask.push("internal_description");
+  }
+  if (!isEqual(shortcut.redirectRules, updatingShortcut.redirectRules)) {
+    updateMask.push("redirect_rules");
+  }
+  if (!isEqual(shortcut.variants, updatingShortcut.variants)) {
+    updateMask.push("variants");
+  }
   return updateMask;
 };
 
@@ -59,8 +59,9 @@ const useViewStore = create<ViewState>()(
   ),
 );
 
+// The search is done by the server with SearchShortcuts, so the search filter isn't applied here.
 export const getFilteredShortcutList = (shortcutList: Shortcut[], filter: Filter, currentUser: User) => {
-  const { tab, tag, visibility, search } = filter;
+  const { tab, tag, visibility } = filter;
   const filteredShortcutList = shortcutList.filter((shortcut) => {
     if (tag) {
       if (!shortcut.tags.includes(tag)) {
@@ -72,16 +73,6 @@ export const getFilteredShortcutList = (shortcutList: Shortcut[], filter: Filter
         return false;
       }
     }
-    if (search) {
-      if (
-        !shortcut.name.toLowerCase().includes(search.toLowerCase()) &&
-        !shortcut.description.toLowerCase().includes(search.toLowerCase()) &&
-        !shortcut.tags.some((tag) => tag.toLowerCase().includes(search.toLowerCase())) &&
-        !shortcut.link.toLowerCase().includes(search.toLowerCase())
-      ) {
-        return false;
-      }
-    }
     if (tab) {
       if (tab === "tab:mine") {
         return shortcut.creatorId === currentUser.id;
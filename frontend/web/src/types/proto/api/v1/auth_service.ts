@@ -7,7 +7,8 @@
 /* eslint-disable */
 import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
 import { Empty } from "../../google/protobuf/empty";
-import { User } from "./user_service";
+import { Timestamp } from "../../google/protobuf/timestamp";
+import { User, UserAccessToken, UserPasskey } from "./user_service";
 
 export const protobufPackage = "slash.api.v1";
 
@@ -25,6 +26,14 @@ export interface SignUpRequest {
   password: string;
 }
 
+export interface VerifyEmailRequest {
+  /** The token of the verification email. */
+  token: string;
+}
+
+export interface SendVerificationEmailRequest {
+}
+
 export interface SignInWithSSORequest {
   /** The id of the SSO provider. */
   idpId: string;
@@ -32,11 +41,158 @@ export interface SignInWithSSORequest {
   code: string;
   /** The redirect URI. */
   redirectUri: string;
+  /**
+   * The nonce sent in the authorization request of the OpenID Connect provider,
+   * which must match the nonce of the ID token.
+   */
+  nonce: string;
+}
+
+export interface SignInWithLDAPRequest {
+  /** The id of the LDAP identity provider. */
+  idpId: string;
+  /** The username in the directory, which is matched by the user filter. */
+  username: string;
+  password: string;
+}
+
+export interface LinkIdentityProviderRequest {
+  /** The password of the existing account, not required when signed in as the account. */
+  password: string;
+}
+
+export interface BeginPasskeySignInRequest {
+}
+
+export interface BeginPasskeySignInResponse {
+  /** The credential request options in JSON, i.e. {"publicKey": {...}}. */
+  options: string;
+}
+
+export interface SignInWithPasskeyRequest {
+  /** The public key credential of the assertion in JSON. */
+  credential: string;
+}
+
+export interface BeginPasskeyRegistrationRequest {
+}
+
+export interface BeginPasskeyRegistrationResponse {
+  /** The credential creation options in JSON, i.e. {"publicKey": {...}}. */
+  options: string;
+}
+
+export interface FinishPasskeyRegistrationRequest {
+  /** The public key credential of the attestation in JSON. */
+  credential: string;
+  /** A name for the passkey, e.g. the device. */
+  name: string;
 }
 
 export interface SignOutRequest {
 }
 
+export interface SignOutAllSessionsRequest {
+}
+
+export interface CreateDeviceAuthorizationRequest {
+  /** The name of the client shown to the user, e.g. "Slash CLI". */
+  clientName: string;
+  /** The scopes of the access token. Empty means full access. */
+  scopes: string[];
+}
+
+export interface CreateDeviceAuthorizationResponse {
+  /** The secret code the client polls for its access token with. */
+  deviceCode: string;
+  /** The code the user enters at the verification uri, e.g. "WDJB-MJHT". */
+  userCode: string;
+  /** The page where the user enters the user code. */
+  verificationUri: string;
+  /** The verification uri with the user code filled in. */
+  verificationUriComplete: string;
+  /** The seconds until the codes expire. */
+  expiresIn: number;
+  /** The minimum seconds between the polls. */
+  interval: number;
+}
+
+export interface DeviceAuthorization {
+  userCode: string;
+  clientName: string;
+  scopes: string[];
+  expireTime?: Date | undefined;
+}
+
+export interface GetDeviceAuthorizationRequest {
+  userCode: string;
+}
+
+export interface ApproveDeviceAuthorizationRequest {
+  userCode: string;
+}
+
+export interface DenyDeviceAuthorizationRequest {
+  userCode: string;
+}
+
+export interface PollDeviceTokenRequest {
+  deviceCode: string;
+}
+
+export interface PollDeviceTokenResponse {
+  state: PollDeviceTokenResponse_State;
+  accessToken?: UserAccessToken | undefined;
+}
+
+export enum PollDeviceTokenResponse_State {
+  STATE_UNSPECIFIED = "STATE_UNSPECIFIED",
+  /** PENDING - The user hasn't approved nor denied the client yet. */
+  PENDING = "PENDING",
+  /** APPROVED - The user approved the client, and the access token is returned once. */
+  APPROVED = "APPROVED",
+  /** DENIED - The user denied the client. */
+  DENIED = "DENIED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function pollDeviceTokenResponse_StateFromJSON(object: any): PollDeviceTokenResponse_State {
+  switch (object) {
+    case 0:
+    case "STATE_UNSPECIFIED":
+      return PollDeviceTokenResponse_State.STATE_UNSPECIFIED;
+    case 1:
+    case "PENDING":
+      return PollDeviceTokenResponse_State.PENDING;
+    case 2:
+    case "APPROVED":
+      return PollDeviceTokenResponse_State.APPROVED;
+    case 3:
+    case "DENIED":
+      return PollDeviceTokenResponse_State.DENIED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PollDeviceTokenResponse_State.UNRECOGNIZED;
+  }
+}
+
+export function pollDeviceTokenResponse_StateToNumber(object: PollDeviceTokenResponse_State): number {
+  switch (object) {
+    case PollDeviceTokenResponse_State.STATE_UNSPECIFIED:
+      return 0;
+    case PollDeviceTokenResponse_State.PENDING:
+      return 1;
+    case PollDeviceTokenResponse_State.APPROVED:
+      return 2;
+    case PollDeviceTokenResponse_State.DENIED:
+      return 3;
+    case PollDeviceTokenResponse_State.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 function createBaseGetAuthStatusRequest(): GetAuthStatusRequest {
   return {};
 }
@@ -199,8 +355,88 @@ export const SignUpRequest: MessageFns<SignUpRequest> = {
   },
 };
 
+function createBaseVerifyEmailRequest(): VerifyEmailRequest {
+  return { token: "" };
+}
+
+export const VerifyEmailRequest: MessageFns<VerifyEmailRequest> = {
+  encode(message: VerifyEmailRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.token !== "") {
+      writer.uint32(10).string(message.token);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): VerifyEmailRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVerifyEmailRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<VerifyEmailRequest>): VerifyEmailRequest {
+    return VerifyEmailRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<VerifyEmailRequest>): VerifyEmailRequest {
+    const message = createBaseVerifyEmailRequest();
+    message.token = object.token ?? "";
+    return message;
+  },
+};
+
+function createBaseSendVerificationEmailRequest(): SendVerificationEmailRequest {
+  return {};
+}
+
+export const SendVerificationEmailRequest: MessageFns<SendVerificationEmailRequest> = {
+  encode(_: SendVerificationEmailRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SendVerificationEmailRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSendVerificationEmailRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SendVerificationEmailRequest>): SendVerificationEmailRequest {
+    return SendVerificationEmailRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<SendVerificationEmailRequest>): SendVerificationEmailRequest {
+    const message = createBaseSendVerificationEmailRequest();
+    return message;
+  },
+};
+
 function createBaseSignInWithSSORequest(): SignInWithSSORequest {
-  return { idpId: "", code: "", redirectUri: "" };
+  return { idpId: "", code: "", redirectUri: "", nonce: "" };
 }
 
 export const SignInWithSSORequest: MessageFns<SignInWithSSORequest> = {
@@ -214,6 +450,9 @@ export const SignInWithSSORequest: MessageFns<SignInWithSSORequest> = {
     if (message.redirectUri !== "") {
       writer.uint32(26).string(message.redirectUri);
     }
+    if (message.nonce !== "") {
+      writer.uint32(34).string(message.nonce);
+    }
     return writer;
   },
 
@@ -248,6 +487,14 @@ export const SignInWithSSORequest: MessageFns<SignInWithSSORequest> = {
           message.redirectUri = reader.string();
           continue;
         }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.nonce = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -265,23 +512,140 @@ export const SignInWithSSORequest: MessageFns<SignInWithSSORequest> = {
     message.idpId = object.idpId ?? "";
     message.code = object.code ?? "";
     message.redirectUri = object.redirectUri ?? "";
+    message.nonce = object.nonce ?? "";
     return message;
   },
 };
 
-function createBaseSignOutRequest(): SignOutRequest {
+function createBaseSignInWithLDAPRequest(): SignInWithLDAPRequest {
+  return { idpId: "", username: "", password: "" };
+}
+
+export const SignInWithLDAPRequest: MessageFns<SignInWithLDAPRequest> = {
+  encode(message: SignInWithLDAPRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.idpId !== "") {
+      writer.uint32(10).string(message.idpId);
+    }
+    if (message.username !== "") {
+      writer.uint32(18).string(message.username);
+    }
+    if (message.password !== "") {
+      writer.uint32(26).string(message.password);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SignInWithLDAPRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSignInWithLDAPRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.idpId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SignInWithLDAPRequest>): SignInWithLDAPRequest {
+    return SignInWithLDAPRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SignInWithLDAPRequest>): SignInWithLDAPRequest {
+    const message = createBaseSignInWithLDAPRequest();
+    message.idpId = object.idpId ?? "";
+    message.username = object.username ?? "";
+    message.password = object.password ?? "";
+    return message;
+  },
+};
+
+function createBaseLinkIdentityProviderRequest(): LinkIdentityProviderRequest {
+  return { password: "" };
+}
+
+export const LinkIdentityProviderRequest: MessageFns<LinkIdentityProviderRequest> = {
+  encode(message: LinkIdentityProviderRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.password !== "") {
+      writer.uint32(10).string(message.password);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): LinkIdentityProviderRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLinkIdentityProviderRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<LinkIdentityProviderRequest>): LinkIdentityProviderRequest {
+    return LinkIdentityProviderRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LinkIdentityProviderRequest>): LinkIdentityProviderRequest {
+    const message = createBaseLinkIdentityProviderRequest();
+    message.password = object.password ?? "";
+    return message;
+  },
+};
+
+function createBaseBeginPasskeySignInRequest(): BeginPasskeySignInRequest {
   return {};
 }
 
-export const SignOutRequest: MessageFns<SignOutRequest> = {
-  encode(_: SignOutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const BeginPasskeySignInRequest: MessageFns<BeginPasskeySignInRequest> = {
+  encode(_: BeginPasskeySignInRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): SignOutRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): BeginPasskeySignInRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSignOutRequest();
+    const message = createBaseBeginPasskeySignInRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -294,61 +658,849 @@ export const SignOutRequest: MessageFns<SignOutRequest> = {
     return message;
   },
 
-  create(base?: DeepPartial<SignOutRequest>): SignOutRequest {
-    return SignOutRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<BeginPasskeySignInRequest>): BeginPasskeySignInRequest {
+    return BeginPasskeySignInRequest.fromPartial(base ?? {});
   },
-  fromPartial(_: DeepPartial<SignOutRequest>): SignOutRequest {
-    const message = createBaseSignOutRequest();
+  fromPartial(_: DeepPartial<BeginPasskeySignInRequest>): BeginPasskeySignInRequest {
+    const message = createBaseBeginPasskeySignInRequest();
     return message;
   },
 };
 
-export type AuthServiceDefinition = typeof AuthServiceDefinition;
-export const AuthServiceDefinition = {
-  name: "AuthService",
-  fullName: "slash.api.v1.AuthService",
-  methods: {
-    /** GetAuthStatus returns the current auth status of the user. */
-    getAuthStatus: {
-      name: "GetAuthStatus",
-      requestType: GetAuthStatusRequest,
-      requestStream: false,
-      responseType: User,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          578365826: [
-            new Uint8Array([
-              21,
-              34,
-              19,
-              47,
-              97,
-              112,
-              105,
-              47,
-              118,
-              49,
-              47,
-              97,
-              117,
-              116,
-              104,
-              47,
-              115,
-              116,
-              97,
-              116,
-              117,
-              115,
-            ]),
-          ],
-        },
-      },
-    },
-    /** SignIn signs in the user with the given username and password. */
-    signIn: {
-      name: "SignIn",
+function createBaseBeginPasskeySignInResponse(): BeginPasskeySignInResponse {
+  return { options: "" };
+}
+
+export const BeginPasskeySignInResponse: MessageFns<BeginPasskeySignInResponse> = {
+  encode(message: BeginPasskeySignInResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.options !== "") {
+      writer.uint32(10).string(message.options);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): BeginPasskeySignInResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBeginPasskeySignInResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.options = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<BeginPasskeySignInResponse>): BeginPasskeySignInResponse {
+    return BeginPasskeySignInResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<BeginPasskeySignInResponse>): BeginPasskeySignInResponse {
+    const message = createBaseBeginPasskeySignInResponse();
+    message.options = object.options ?? "";
+    return message;
+  },
+};
+
+function createBaseSignInWithPasskeyRequest(): SignInWithPasskeyRequest {
+  return { credential: "" };
+}
+
+export const SignInWithPasskeyRequest: MessageFns<SignInWithPasskeyRequest> = {
+  encode(message: SignInWithPasskeyRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.credential !== "") {
+      writer.uint32(10).string(message.credential);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SignInWithPasskeyRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSignInWithPasskeyRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.credential = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SignInWithPasskeyRequest>): SignInWithPasskeyRequest {
+    return SignInWithPasskeyRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SignInWithPasskeyRequest>): SignInWithPasskeyRequest {
+    const message = createBaseSignInWithPasskeyRequest();
+    message.credential = object.credential ?? "";
+    return message;
+  },
+};
+
+function createBaseBeginPasskeyRegistrationRequest(): BeginPasskeyRegistrationRequest {
+  return {};
+}
+
+export const BeginPasskeyRegistrationRequest: MessageFns<BeginPasskeyRegistrationRequest> = {
+  encode(_: BeginPasskeyRegistrationRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): BeginPasskeyRegistrationRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBeginPasskeyRegistrationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<BeginPasskeyRegistrationRequest>): BeginPasskeyRegistrationRequest {
+    return BeginPasskeyRegistrationRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<BeginPasskeyRegistrationRequest>): BeginPasskeyRegistrationRequest {
+    const message = createBaseBeginPasskeyRegistrationRequest();
+    return message;
+  },
+};
+
+function createBaseBeginPasskeyRegistrationResponse(): BeginPasskeyRegistrationResponse {
+  return { options: "" };
+}
+
+export const BeginPasskeyRegistrationResponse: MessageFns<BeginPasskeyRegistrationResponse> = {
+  encode(message: BeginPasskeyRegistrationResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.options !== "") {
+      writer.uint32(10).string(message.options);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): BeginPasskeyRegistrationResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBeginPasskeyRegistrationResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.options = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<BeginPasskeyRegistrationResponse>): BeginPasskeyRegistrationResponse {
+    return BeginPasskeyRegistrationResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<BeginPasskeyRegistrationResponse>): BeginPasskeyRegistrationResponse {
+    const message = createBaseBeginPasskeyRegistrationResponse();
+    message.options = object.options ?? "";
+    return message;
+  },
+};
+
+function createBaseFinishPasskeyRegistrationRequest(): FinishPasskeyRegistrationRequest {
+  return { credential: "", name: "" };
+}
+
+export const FinishPasskeyRegistrationRequest: MessageFns<FinishPasskeyRegistrationRequest> = {
+  encode(message: FinishPasskeyRegistrationRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.credential !== "") {
+      writer.uint32(10).string(message.credential);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): FinishPasskeyRegistrationRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFinishPasskeyRegistrationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.credential = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<FinishPasskeyRegistrationRequest>): FinishPasskeyRegistrationRequest {
+    return FinishPasskeyRegistrationRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<FinishPasskeyRegistrationRequest>): FinishPasskeyRegistrationRequest {
+    const message = createBaseFinishPasskeyRegistrationRequest();
+    message.credential = object.credential ?? "";
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseSignOutRequest(): SignOutRequest {
+  return {};
+}
+
+export const SignOutRequest: MessageFns<SignOutRequest> = {
+  encode(_: SignOutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SignOutRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSignOutRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SignOutRequest>): SignOutRequest {
+    return SignOutRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<SignOutRequest>): SignOutRequest {
+    const message = createBaseSignOutRequest();
+    return message;
+  },
+};
+
+function createBaseSignOutAllSessionsRequest(): SignOutAllSessionsRequest {
+  return {};
+}
+
+export const SignOutAllSessionsRequest: MessageFns<SignOutAllSessionsRequest> = {
+  encode(_: SignOutAllSessionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SignOutAllSessionsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSignOutAllSessionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SignOutAllSessionsRequest>): SignOutAllSessionsRequest {
+    return SignOutAllSessionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<SignOutAllSessionsRequest>): SignOutAllSessionsRequest {
+    const message = createBaseSignOutAllSessionsRequest();
+    return message;
+  },
+};
+
+function createBaseCreateDeviceAuthorizationRequest(): CreateDeviceAuthorizationRequest {
+  return { clientName: "", scopes: [] };
+}
+
+export const CreateDeviceAuthorizationRequest: MessageFns<CreateDeviceAuthorizationRequest> = {
+  encode(message: CreateDeviceAuthorizationRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.clientName !== "") {
+      writer.uint32(10).string(message.clientName);
+    }
+    for (const v of message.scopes) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateDeviceAuthorizationRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateDeviceAuthorizationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.clientName = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.scopes.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateDeviceAuthorizationRequest>): CreateDeviceAuthorizationRequest {
+    return CreateDeviceAuthorizationRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateDeviceAuthorizationRequest>): CreateDeviceAuthorizationRequest {
+    const message = createBaseCreateDeviceAuthorizationRequest();
+    message.clientName = object.clientName ?? "";
+    message.scopes = object.scopes?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseCreateDeviceAuthorizationResponse(): CreateDeviceAuthorizationResponse {
+  return { deviceCode: "", userCode: "", verificationUri: "", verificationUriComplete: "", expiresIn: 0, interval: 0 };
+}
+
+export const CreateDeviceAuthorizationResponse: MessageFns<CreateDeviceAuthorizationResponse> = {
+  encode(message: CreateDeviceAuthorizationResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.deviceCode !== "") {
+      writer.uint32(10).string(message.deviceCode);
+    }
+    if (message.userCode !== "") {
+      writer.uint32(18).string(message.userCode);
+    }
+    if (message.verificationUri !== "") {
+      writer.uint32(26).string(message.verificationUri);
+    }
+    if (message.verificationUriComplete !== "") {
+      writer.uint32(34).string(message.verificationUriComplete);
+    }
+    if (message.expiresIn !== 0) {
+      writer.uint32(40).int32(message.expiresIn);
+    }
+    if (message.interval !== 0) {
+      writer.uint32(48).int32(message.interval);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateDeviceAuthorizationResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateDeviceAuthorizationResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deviceCode = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.userCode = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.verificationUri = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.verificationUriComplete = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.expiresIn = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.interval = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateDeviceAuthorizationResponse>): CreateDeviceAuthorizationResponse {
+    return CreateDeviceAuthorizationResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateDeviceAuthorizationResponse>): CreateDeviceAuthorizationResponse {
+    const message = createBaseCreateDeviceAuthorizationResponse();
+    message.deviceCode = object.deviceCode ?? "";
+    message.userCode = object.userCode ?? "";
+    message.verificationUri = object.verificationUri ?? "";
+    message.verificationUriComplete = object.verificationUriComplete ?? "";
+    message.expiresIn = object.expiresIn ?? 0;
+    message.interval = object.interval ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceAuthorization(): DeviceAuthorization {
+  return { userCode: "", clientName: "", scopes: [], expireTime: undefined };
+}
+
+export const DeviceAuthorization: MessageFns<DeviceAuthorization> = {
+  encode(message: DeviceAuthorization, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.userCode !== "") {
+      writer.uint32(10).string(message.userCode);
+    }
+    if (message.clientName !== "") {
+      writer.uint32(18).string(message.clientName);
+    }
+    for (const v of message.scopes) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceAuthorization {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceAuthorization();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userCode = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.clientName = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.scopes.push(reader.string());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeviceAuthorization>): DeviceAuthorization {
+    return DeviceAuthorization.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeviceAuthorization>): DeviceAuthorization {
+    const message = createBaseDeviceAuthorization();
+    message.userCode = object.userCode ?? "";
+    message.clientName = object.clientName ?? "";
+    message.scopes = object.scopes?.map((e) => e) || [];
+    message.expireTime = object.expireTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseGetDeviceAuthorizationRequest(): GetDeviceAuthorizationRequest {
+  return { userCode: "" };
+}
+
+export const GetDeviceAuthorizationRequest: MessageFns<GetDeviceAuthorizationRequest> = {
+  encode(message: GetDeviceAuthorizationRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.userCode !== "") {
+      writer.uint32(10).string(message.userCode);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetDeviceAuthorizationRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetDeviceAuthorizationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userCode = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetDeviceAuthorizationRequest>): GetDeviceAuthorizationRequest {
+    return GetDeviceAuthorizationRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetDeviceAuthorizationRequest>): GetDeviceAuthorizationRequest {
+    const message = createBaseGetDeviceAuthorizationRequest();
+    message.userCode = object.userCode ?? "";
+    return message;
+  },
+};
+
+function createBaseApproveDeviceAuthorizationRequest(): ApproveDeviceAuthorizationRequest {
+  return { userCode: "" };
+}
+
+export const ApproveDeviceAuthorizationRequest: MessageFns<ApproveDeviceAuthorizationRequest> = {
+  encode(message: ApproveDeviceAuthorizationRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.userCode !== "") {
+      writer.uint32(10).string(message.userCode);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ApproveDeviceAuthorizationRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApproveDeviceAuthorizationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userCode = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ApproveDeviceAuthorizationRequest>): ApproveDeviceAuthorizationRequest {
+    return ApproveDeviceAuthorizationRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ApproveDeviceAuthorizationRequest>): ApproveDeviceAuthorizationRequest {
+    const message = createBaseApproveDeviceAuthorizationRequest();
+    message.userCode = object.userCode ?? "";
+    return message;
+  },
+};
+
+function createBaseDenyDeviceAuthorizationRequest(): DenyDeviceAuthorizationRequest {
+  return { userCode: "" };
+}
+
+export const DenyDeviceAuthorizationRequest: MessageFns<DenyDeviceAuthorizationRequest> = {
+  encode(message: DenyDeviceAuthorizationRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.userCode !== "") {
+      writer.uint32(10).string(message.userCode);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DenyDeviceAuthorizationRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDenyDeviceAuthorizationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userCode = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DenyDeviceAuthorizationRequest>): DenyDeviceAuthorizationRequest {
+    return DenyDeviceAuthorizationRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DenyDeviceAuthorizationRequest>): DenyDeviceAuthorizationRequest {
+    const message = createBaseDenyDeviceAuthorizationRequest();
+    message.userCode = object.userCode ?? "";
+    return message;
+  },
+};
+
+function createBasePollDeviceTokenRequest(): PollDeviceTokenRequest {
+  return { deviceCode: "" };
+}
+
+export const PollDeviceTokenRequest: MessageFns<PollDeviceTokenRequest> = {
+  encode(message: PollDeviceTokenRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.deviceCode !== "") {
+      writer.uint32(10).string(message.deviceCode);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PollDeviceTokenRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePollDeviceTokenRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deviceCode = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<PollDeviceTokenRequest>): PollDeviceTokenRequest {
+    return PollDeviceTokenRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PollDeviceTokenRequest>): PollDeviceTokenRequest {
+    const message = createBasePollDeviceTokenRequest();
+    message.deviceCode = object.deviceCode ?? "";
+    return message;
+  },
+};
+
+function createBasePollDeviceTokenResponse(): PollDeviceTokenResponse {
+  return { state: PollDeviceTokenResponse_State.STATE_UNSPECIFIED, accessToken: undefined };
+}
+
+export const PollDeviceTokenResponse: MessageFns<PollDeviceTokenResponse> = {
+  encode(message: PollDeviceTokenResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.state !== PollDeviceTokenResponse_State.STATE_UNSPECIFIED) {
+      writer.uint32(8).int32(pollDeviceTokenResponse_StateToNumber(message.state));
+    }
+    if (message.accessToken !== undefined) {
+      UserAccessToken.encode(message.accessToken, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PollDeviceTokenResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePollDeviceTokenResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.state = pollDeviceTokenResponse_StateFromJSON(reader.int32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.accessToken = UserAccessToken.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<PollDeviceTokenResponse>): PollDeviceTokenResponse {
+    return PollDeviceTokenResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PollDeviceTokenResponse>): PollDeviceTokenResponse {
+    const message = createBasePollDeviceTokenResponse();
+    message.state = object.state ?? PollDeviceTokenResponse_State.STATE_UNSPECIFIED;
+    message.accessToken = (object.accessToken !== undefined && object.accessToken !== null)
+      ? UserAccessToken.fromPartial(object.accessToken)
+      : undefined;
+    return message;
+  },
+};
+
+export type AuthServiceDefinition = typeof AuthServiceDefinition;
+export const AuthServiceDefinition = {
+  name: "AuthService",
+  fullName: "slash.api.v1.AuthService",
+  methods: {
+    /** GetAuthStatus returns the current auth status of the user. */
+    getAuthStatus: {
+      name: "GetAuthStatus",
+      requestType: GetAuthStatusRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              21,
+              34,
+              19,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              115,
+              116,
+              97,
+              116,
+              117,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** SignIn signs in the user with the given username and password. */
+    signIn: {
+      name: "SignIn",
       requestType: SignInRequest,
       requestStream: false,
       responseType: User,
@@ -377,17 +1529,391 @@ export const AuthServiceDefinition = {
               105,
               103,
               110,
-              105,
-              110,
+              105,
+              110,
+            ]),
+          ],
+        },
+      },
+    },
+    /** SignInWithSSO signs in the user with the given SSO code. */
+    signInWithSSO: {
+      name: "SignInWithSSO",
+      requestType: SignInWithSSORequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              25,
+              34,
+              23,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              115,
+              105,
+              103,
+              110,
+              105,
+              110,
+              47,
+              115,
+              115,
+              111,
+            ]),
+          ],
+        },
+      },
+    },
+    /** SignInWithLDAP signs in the user with the username and password of the LDAP identity provider. */
+    signInWithLDAP: {
+      name: "SignInWithLDAP",
+      requestType: SignInWithLDAPRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              29,
+              58,
+              1,
+              42,
+              34,
+              24,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              115,
+              105,
+              103,
+              110,
+              105,
+              110,
+              47,
+              108,
+              100,
+              97,
+              112,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * LinkIdentityProvider links the pending SSO identity to the existing account with the same email,
+     * after confirming the password of the account, unless signed in as the account, and signs in the user.
+     */
+    linkIdentityProvider: {
+      name: "LinkIdentityProvider",
+      requestType: LinkIdentityProviderRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              30,
+              34,
+              28,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              115,
+              105,
+              103,
+              110,
+              105,
+              110,
+              47,
+              115,
+              115,
+              111,
+              47,
+              108,
+              105,
+              110,
+              107,
+            ]),
+          ],
+        },
+      },
+    },
+    /** BeginPasskeySignIn starts signing in with a passkey, and returns the options for navigator.credentials.get. */
+    beginPasskeySignIn: {
+      name: "BeginPasskeySignIn",
+      requestType: BeginPasskeySignInRequest,
+      requestStream: false,
+      responseType: BeginPasskeySignInResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              35,
+              34,
+              33,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              115,
+              105,
+              103,
+              110,
+              105,
+              110,
+              47,
+              112,
+              97,
+              115,
+              115,
+              107,
+              101,
+              121,
+              47,
+              98,
+              101,
+              103,
+              105,
+              110,
+            ]),
+          ],
+        },
+      },
+    },
+    /** SignInWithPasskey signs in the user with the passkey assertion of navigator.credentials.get. */
+    signInWithPasskey: {
+      name: "SignInWithPasskey",
+      requestType: SignInWithPasskeyRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              32,
+              58,
+              1,
+              42,
+              34,
+              27,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              115,
+              105,
+              103,
+              110,
+              105,
+              110,
+              47,
+              112,
+              97,
+              115,
+              115,
+              107,
+              101,
+              121,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * BeginPasskeyRegistration starts registering a passkey for the current user,
+     * and returns the options for navigator.credentials.create.
+     */
+    beginPasskeyRegistration: {
+      name: "BeginPasskeyRegistration",
+      requestType: BeginPasskeyRegistrationRequest,
+      requestStream: false,
+      responseType: BeginPasskeyRegistrationResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              28,
+              34,
+              26,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              112,
+              97,
+              115,
+              115,
+              107,
+              101,
+              121,
+              47,
+              98,
+              101,
+              103,
+              105,
+              110,
+            ]),
+          ],
+        },
+      },
+    },
+    /** FinishPasskeyRegistration registers the passkey created by navigator.credentials.create for the current user. */
+    finishPasskeyRegistration: {
+      name: "FinishPasskeyRegistration",
+      requestType: FinishPasskeyRegistrationRequest,
+      requestStream: false,
+      responseType: UserPasskey,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              32,
+              58,
+              1,
+              42,
+              34,
+              27,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              112,
+              97,
+              115,
+              115,
+              107,
+              101,
+              121,
+              47,
+              102,
+              105,
+              110,
+              105,
+              115,
+              104,
+            ]),
+          ],
+        },
+      },
+    },
+    /** SignUp signs up the user with the given username and password. */
+    signUp: {
+      name: "SignUp",
+      requestType: SignUpRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              21,
+              34,
+              19,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              115,
+              105,
+              103,
+              110,
+              117,
+              112,
             ]),
           ],
         },
       },
     },
-    /** SignInWithSSO signs in the user with the given SSO code. */
-    signInWithSSO: {
-      name: "SignInWithSSO",
-      requestType: SignInWithSSORequest,
+    /** VerifyEmail confirms the email of the user with the token of the verification email. */
+    verifyEmail: {
+      name: "VerifyEmail",
+      requestType: VerifyEmailRequest,
       requestStream: false,
       responseType: User,
       responseStream: false,
@@ -395,9 +1921,56 @@ export const AuthServiceDefinition = {
         _unknownFields: {
           578365826: [
             new Uint8Array([
+              30,
+              58,
+              1,
+              42,
+              34,
               25,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              118,
+              101,
+              114,
+              105,
+              102,
+              121,
+              45,
+              101,
+              109,
+              97,
+              105,
+              108,
+            ]),
+          ],
+        },
+      },
+    },
+    /** SendVerificationEmail sends the verification email to the current user again. */
+    sendVerificationEmail: {
+      name: "SendVerificationEmail",
+      requestType: SendVerificationEmailRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              33,
               34,
-              23,
+              31,
               47,
               97,
               112,
@@ -411,35 +1984,82 @@ export const AuthServiceDefinition = {
               116,
               104,
               47,
-              115,
+              118,
+              101,
+              114,
               105,
-              103,
-              110,
+              102,
+              105,
+              99,
+              97,
+              116,
               105,
+              111,
               110,
+              45,
+              101,
+              109,
+              97,
+              105,
+              108,
+            ]),
+          ],
+        },
+      },
+    },
+    /** SignOut signs out the user. */
+    signOut: {
+      name: "SignOut",
+      requestType: SignOutRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              22,
+              34,
+              20,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
               47,
               115,
-              115,
+              105,
+              103,
+              110,
               111,
+              117,
+              116,
             ]),
           ],
         },
       },
     },
-    /** SignUp signs up the user with the given username and password. */
-    signUp: {
-      name: "SignUp",
-      requestType: SignUpRequest,
+    /** SignOutAllSessions revokes all sign-in sessions of the current user. */
+    signOutAllSessions: {
+      name: "SignOutAllSessions",
+      requestType: SignOutAllSessionsRequest,
       requestStream: false,
-      responseType: User,
+      responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
           578365826: [
             new Uint8Array([
-              21,
+              26,
               34,
-              19,
+              24,
               47,
               97,
               112,
@@ -457,17 +2077,116 @@ export const AuthServiceDefinition = {
               105,
               103,
               110,
+              111,
+              117,
+              116,
+              47,
+              97,
+              108,
+              108,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * CreateDeviceAuthorization starts the device authorization of a client, e.g. the browser extension or the CLI,
+     * which polls for its access token while the user approves the user code in the browser.
+     */
+    createDeviceAuthorization: {
+      name: "CreateDeviceAuthorization",
+      requestType: CreateDeviceAuthorizationRequest,
+      requestStream: false,
+      responseType: CreateDeviceAuthorizationResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              24,
+              58,
+              1,
+              42,
+              34,
+              19,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
               117,
+              116,
+              104,
+              47,
+              100,
+              101,
+              118,
+              105,
+              99,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetDeviceAuthorization returns the pending device authorization of the user code, for the user to review. */
+    getDeviceAuthorization: {
+      name: "GetDeviceAuthorization",
+      requestType: GetDeviceAuthorizationRequest,
+      requestStream: false,
+      responseType: DeviceAuthorization,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              33,
+              18,
+              31,
+              47,
+              97,
               112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              100,
+              101,
+              118,
+              105,
+              99,
+              101,
+              47,
+              123,
+              117,
+              115,
+              101,
+              114,
+              95,
+              99,
+              111,
+              100,
+              101,
+              125,
             ]),
           ],
         },
       },
     },
-    /** SignOut signs out the user. */
-    signOut: {
-      name: "SignOut",
-      requestType: SignOutRequest,
+    /** ApproveDeviceAuthorization grants the client of the user code an access token of the current user. */
+    approveDeviceAuthorization: {
+      name: "ApproveDeviceAuthorization",
+      requestType: ApproveDeviceAuthorizationRequest,
       requestStream: false,
       responseType: Empty,
       responseStream: false,
@@ -475,9 +2194,9 @@ export const AuthServiceDefinition = {
         _unknownFields: {
           578365826: [
             new Uint8Array([
-              22,
+              41,
               34,
-              20,
+              39,
               47,
               97,
               112,
@@ -491,13 +2210,134 @@ export const AuthServiceDefinition = {
               116,
               104,
               47,
+              100,
+              101,
+              118,
+              105,
+              99,
+              101,
+              47,
+              123,
+              117,
               115,
+              101,
+              114,
+              95,
+              99,
+              111,
+              100,
+              101,
+              125,
+              58,
+              97,
+              112,
+              112,
+              114,
+              111,
+              118,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DenyDeviceAuthorization denies the client of the user code. */
+    denyDeviceAuthorization: {
+      name: "DenyDeviceAuthorization",
+      requestType: DenyDeviceAuthorizationRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              38,
+              34,
+              36,
+              47,
+              97,
+              112,
               105,
-              103,
-              110,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              100,
+              101,
+              118,
+              105,
+              99,
+              101,
+              47,
+              123,
+              117,
+              115,
+              101,
+              114,
+              95,
+              99,
               111,
+              100,
+              101,
+              125,
+              58,
+              100,
+              101,
+              110,
+              121,
+            ]),
+          ],
+        },
+      },
+    },
+    /** PollDeviceToken returns the state of the device authorization, with the access token once it's approved. */
+    pollDeviceToken: {
+      name: "PollDeviceToken",
+      requestType: PollDeviceTokenRequest,
+      requestStream: false,
+      responseType: PollDeviceTokenResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              30,
+              58,
+              1,
+              42,
+              34,
+              25,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              97,
               117,
               116,
+              104,
+              47,
+              100,
+              101,
+              118,
+              105,
+              99,
+              101,
+              47,
+              116,
+              111,
+              107,
+              101,
+              110,
             ]),
           ],
         },
@@ -514,6 +2354,18 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = Math.trunc(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
 export interface MessageFns<T> {
   encode(message: T, writer?: BinaryWriter): BinaryWriter;
   decode(input: BinaryReader | Uint8Array, length?: number): T;
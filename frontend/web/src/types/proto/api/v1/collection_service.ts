@@ -9,7 +9,8 @@ import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
 import { Empty } from "../../google/protobuf/empty";
 import { FieldMask } from "../../google/protobuf/field_mask";
 import { Timestamp } from "../../google/protobuf/timestamp";
-import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+import { State, Visibility, stateFromJSON, stateToNumber, visibilityFromJSON, visibilityToNumber } from "./common";
+import { Shortcut } from "./shortcut_service";
 
 export const protobufPackage = "slash.api.v1";
 
@@ -23,13 +24,31 @@ export interface Collection {
   description: string;
   shortcutIds: number[];
   visibility: Visibility;
+  /** The username of the creator. */
+  creatorUsername: string;
+  /** The id of the team the collection is visible to, when the visibility is TEAM. */
+  teamId: number;
+  /** The state of the collection, INACTIVE when archived. */
+  state: State;
 }
 
 export interface ListCollectionsRequest {
+  /** The max number of collections to return. Unset or 0 returns all of them, and the max is 1000. */
+  pageSize: number;
+  /** The next_page_token of the previous response to get the next page. */
+  pageToken: string;
+  /** Filters the collections by their state, ACTIVE or INACTIVE for the archived ones. Unset returns all of them. */
+  state: State;
+  /** Filters the collections by the id of their creator. Unset or 0 returns the collections of all the users. */
+  creatorId: number;
+  /** Returns only the collections created by the current user. */
+  mineOnly: boolean;
 }
 
 export interface ListCollectionsResponse {
   collections: Collection[];
+  /** The token of the next page. Empty when there are no more pages. */
+  nextPageToken: string;
 }
 
 export interface GetCollectionRequest {
@@ -53,6 +72,112 @@ export interface DeleteCollectionRequest {
   id: number;
 }
 
+export interface ArchiveCollectionRequest {
+  id: number;
+}
+
+export interface UnarchiveCollectionRequest {
+  id: number;
+}
+
+export interface TransferCollectionRequest {
+  id: number;
+  /** The id of the user to transfer the collection to. */
+  userId: number;
+}
+
+/** CollectionShare is a guest link to view a collection, issued to an email that isn't a member of the workspace. */
+export interface CollectionShare {
+  id: number;
+  collectionId: number;
+  creatorId: number;
+  createdTime?:
+    | Date
+    | undefined;
+  /** The email the guest link is issued to. */
+  email: string;
+  /** The secret of the guest link, which is opened at /c/{collection_name}?share={token}. */
+  token: string;
+  expireTime?:
+    | Date
+    | undefined;
+  /** The number of times the guest link was opened. */
+  viewCount: number;
+  lastViewedTime?: Date | undefined;
+}
+
+export interface CreateCollectionShareRequest {
+  collectionId: number;
+  email: string;
+  /** The expiration time of the guest link. Defaults to 7 days later, and the max is 90 days later. */
+  expireTime?: Date | undefined;
+}
+
+export interface ListCollectionSharesRequest {
+  collectionId: number;
+}
+
+export interface ListCollectionSharesResponse {
+  shares: CollectionShare[];
+}
+
+export interface DeleteCollectionShareRequest {
+  collectionId: number;
+  id: number;
+}
+
+export interface GetSharedCollectionRequest {
+  token: string;
+}
+
+export interface SharedCollection {
+  collection?:
+    | Collection
+    | undefined;
+  /** The shortcuts of the collection, except the archived, expired and scheduled ones. */
+  shortcuts: Shortcut[];
+  expireTime?: Date | undefined;
+}
+
+/** CollectionTemplate is a blueprint of a collection with its placeholder shortcuts. */
+export interface CollectionTemplate {
+  /** The unique identifier of the template, e.g. "project-onboarding". */
+  id: string;
+  title: string;
+  description: string;
+  shortcuts: CollectionTemplate_ShortcutTemplate[];
+  /** Output only. Whether the template is built in, which can't be changed. */
+  builtIn: boolean;
+}
+
+export interface CollectionTemplate_ShortcutTemplate {
+  /**
+   * The name, title, link and description may contain the placeholders
+   * `{name}` and `{title}` of the collection created from the template.
+   */
+  name: string;
+  title: string;
+  link: string;
+  description: string;
+  tags: string[];
+}
+
+export interface ListCollectionTemplatesRequest {
+}
+
+export interface ListCollectionTemplatesResponse {
+  templates: CollectionTemplate[];
+}
+
+export interface CreateCollectionFromTemplateRequest {
+  templateId: string;
+  /**
+   * The name, title, description and visibility of the collection.
+   * The title defaults to the title of the template.
+   */
+  collection?: Collection | undefined;
+}
+
 function createBaseCollection(): Collection {
   return {
     id: 0,
@@ -64,6 +189,9 @@ function createBaseCollection(): Collection {
     description: "",
     shortcutIds: [],
     visibility: Visibility.VISIBILITY_UNSPECIFIED,
+    creatorUsername: "",
+    teamId: 0,
+    state: State.STATE_UNSPECIFIED,
   };
 }
 
@@ -98,6 +226,15 @@ export const Collection: MessageFns<Collection> = {
     if (message.visibility !== Visibility.VISIBILITY_UNSPECIFIED) {
       writer.uint32(80).int32(visibilityToNumber(message.visibility));
     }
+    if (message.creatorUsername !== "") {
+      writer.uint32(90).string(message.creatorUsername);
+    }
+    if (message.teamId !== 0) {
+      writer.uint32(96).int32(message.teamId);
+    }
+    if (message.state !== State.STATE_UNSPECIFIED) {
+      writer.uint32(104).int32(stateToNumber(message.state));
+    }
     return writer;
   },
 
@@ -190,6 +327,30 @@ export const Collection: MessageFns<Collection> = {
           message.visibility = visibilityFromJSON(reader.int32());
           continue;
         }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.creatorUsername = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 96) {
+            break;
+          }
+
+          message.teamId = reader.int32();
+          continue;
+        }
+        case 13: {
+          if (tag !== 104) {
+            break;
+          }
+
+          message.state = stateFromJSON(reader.int32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -213,16 +374,34 @@ export const Collection: MessageFns<Collection> = {
     message.description = object.description ?? "";
     message.shortcutIds = object.shortcutIds?.map((e) => e) || [];
     message.visibility = object.visibility ?? Visibility.VISIBILITY_UNSPECIFIED;
+    message.creatorUsername = object.creatorUsername ?? "";
+    message.teamId = object.teamId ?? 0;
+    message.state = object.state ?? State.STATE_UNSPECIFIED;
     return message;
   },
 };
 
 function createBaseListCollectionsRequest(): ListCollectionsRequest {
-  return {};
+  return { pageSize: 0, pageToken: "", state: State.STATE_UNSPECIFIED, creatorId: 0, mineOnly: false };
 }
 
 export const ListCollectionsRequest: MessageFns<ListCollectionsRequest> = {
-  encode(_: ListCollectionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+  encode(message: ListCollectionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.pageSize !== 0) {
+      writer.uint32(8).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(18).string(message.pageToken);
+    }
+    if (message.state !== State.STATE_UNSPECIFIED) {
+      writer.uint32(24).int32(stateToNumber(message.state));
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(32).int32(message.creatorId);
+    }
+    if (message.mineOnly !== false) {
+      writer.uint32(40).bool(message.mineOnly);
+    }
     return writer;
   },
 
@@ -233,6 +412,46 @@ export const ListCollectionsRequest: MessageFns<ListCollectionsRequest> = {
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.state = stateFromJSON(reader.int32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.mineOnly = reader.bool();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -245,14 +464,19 @@ export const ListCollectionsRequest: MessageFns<ListCollectionsRequest> = {
   create(base?: DeepPartial<ListCollectionsRequest>): ListCollectionsRequest {
     return ListCollectionsRequest.fromPartial(base ?? {});
   },
-  fromPartial(_: DeepPartial<ListCollectionsRequest>): ListCollectionsRequest {
+  fromPartial(object: DeepPartial<ListCollectionsRequest>): ListCollectionsRequest {
     const message = createBaseListCollectionsRequest();
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    message.state = object.state ?? State.STATE_UNSPECIFIED;
+    message.creatorId = object.creatorId ?? 0;
+    message.mineOnly = object.mineOnly ?? false;
     return message;
   },
 };
 
 function createBaseListCollectionsResponse(): ListCollectionsResponse {
-  return { collections: [] };
+  return { collections: [], nextPageToken: "" };
 }
 
 export const ListCollectionsResponse: MessageFns<ListCollectionsResponse> = {
@@ -260,6 +484,9 @@ export const ListCollectionsResponse: MessageFns<ListCollectionsResponse> = {
     for (const v of message.collections) {
       Collection.encode(v!, writer.uint32(10).fork()).join();
     }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
     return writer;
   },
 
@@ -278,6 +505,14 @@ export const ListCollectionsResponse: MessageFns<ListCollectionsResponse> = {
           message.collections.push(Collection.decode(reader, reader.uint32()));
           continue;
         }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -293,6 +528,7 @@ export const ListCollectionsResponse: MessageFns<ListCollectionsResponse> = {
   fromPartial(object: DeepPartial<ListCollectionsResponse>): ListCollectionsResponse {
     const message = createBaseListCollectionsResponse();
     message.collections = object.collections?.map((e) => Collection.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
     return message;
   },
 };
@@ -543,153 +779,1121 @@ export const DeleteCollectionRequest: MessageFns<DeleteCollectionRequest> = {
   },
 };
 
-export type CollectionServiceDefinition = typeof CollectionServiceDefinition;
-export const CollectionServiceDefinition = {
-  name: "CollectionService",
-  fullName: "slash.api.v1.CollectionService",
-  methods: {
-    /** ListCollections returns a list of collections. */
-    listCollections: {
-      name: "ListCollections",
-      requestType: ListCollectionsRequest,
-      requestStream: false,
-      responseType: ListCollectionsResponse,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          578365826: [
-            new Uint8Array([
-              21,
-              18,
-              19,
-              47,
-              97,
-              112,
-              105,
-              47,
-              118,
-              49,
-              47,
-              99,
-              111,
-              108,
-              108,
-              101,
-              99,
-              116,
-              105,
-              111,
-              110,
-              115,
-            ]),
-          ],
-        },
-      },
-    },
-    /** GetCollection returns a collection by id. */
-    getCollection: {
-      name: "GetCollection",
-      requestType: GetCollectionRequest,
-      requestStream: false,
-      responseType: Collection,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
-          578365826: [
-            new Uint8Array([
-              26,
-              18,
-              24,
-              47,
-              97,
-              112,
-              105,
-              47,
-              118,
-              49,
-              47,
-              99,
-              111,
-              108,
-              108,
-              101,
-              99,
-              116,
-              105,
-              111,
-              110,
-              115,
-              47,
-              123,
-              105,
-              100,
-              125,
-            ]),
-          ],
-        },
-      },
-    },
-    /** GetCollectionByName returns a collection by name. */
-    getCollectionByName: {
-      name: "GetCollectionByName",
-      requestType: GetCollectionByNameRequest,
-      requestStream: false,
-      responseType: Collection,
-      responseStream: false,
-      options: {},
-    },
-    /** CreateCollection creates a collection. */
-    createCollection: {
-      name: "CreateCollection",
-      requestType: CreateCollectionRequest,
-      requestStream: false,
-      responseType: Collection,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          578365826: [
-            new Uint8Array([
-              33,
-              58,
-              10,
-              99,
-              111,
-              108,
-              108,
-              101,
-              99,
-              116,
-              105,
-              111,
-              110,
-              34,
-              19,
-              47,
-              97,
-              112,
-              105,
-              47,
-              118,
-              49,
-              47,
-              99,
-              111,
-              108,
-              108,
-              101,
-              99,
-              116,
-              105,
-              111,
-              110,
-              115,
-            ]),
-          ],
-        },
-      },
-    },
-    /** UpdateCollection updates a collection. */
+function createBaseArchiveCollectionRequest(): ArchiveCollectionRequest {
+  return { id: 0 };
+}
+
+export const ArchiveCollectionRequest: MessageFns<ArchiveCollectionRequest> = {
+  encode(message: ArchiveCollectionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ArchiveCollectionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseArchiveCollectionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ArchiveCollectionRequest>): ArchiveCollectionRequest {
+    return ArchiveCollectionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ArchiveCollectionRequest>): ArchiveCollectionRequest {
+    const message = createBaseArchiveCollectionRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseUnarchiveCollectionRequest(): UnarchiveCollectionRequest {
+  return { id: 0 };
+}
+
+export const UnarchiveCollectionRequest: MessageFns<UnarchiveCollectionRequest> = {
+  encode(message: UnarchiveCollectionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UnarchiveCollectionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUnarchiveCollectionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UnarchiveCollectionRequest>): UnarchiveCollectionRequest {
+    return UnarchiveCollectionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UnarchiveCollectionRequest>): UnarchiveCollectionRequest {
+    const message = createBaseUnarchiveCollectionRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseTransferCollectionRequest(): TransferCollectionRequest {
+  return { id: 0, userId: 0 };
+}
+
+export const TransferCollectionRequest: MessageFns<TransferCollectionRequest> = {
+  encode(message: TransferCollectionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TransferCollectionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTransferCollectionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<TransferCollectionRequest>): TransferCollectionRequest {
+    return TransferCollectionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TransferCollectionRequest>): TransferCollectionRequest {
+    const message = createBaseTransferCollectionRequest();
+    message.id = object.id ?? 0;
+    message.userId = object.userId ?? 0;
+    return message;
+  },
+};
+
+function createBaseCollectionShare(): CollectionShare {
+  return {
+    id: 0,
+    collectionId: 0,
+    creatorId: 0,
+    createdTime: undefined,
+    email: "",
+    token: "",
+    expireTime: undefined,
+    viewCount: 0,
+    lastViewedTime: undefined,
+  };
+}
+
+export const CollectionShare: MessageFns<CollectionShare> = {
+  encode(message: CollectionShare, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.collectionId !== 0) {
+      writer.uint32(16).int32(message.collectionId);
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(24).int32(message.creatorId);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(34).fork()).join();
+    }
+    if (message.email !== "") {
+      writer.uint32(42).string(message.email);
+    }
+    if (message.token !== "") {
+      writer.uint32(50).string(message.token);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(58).fork()).join();
+    }
+    if (message.viewCount !== 0) {
+      writer.uint32(64).int32(message.viewCount);
+    }
+    if (message.lastViewedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastViewedTime), writer.uint32(74).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CollectionShare {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCollectionShare();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.collectionId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.email = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.viewCount = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.lastViewedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CollectionShare>): CollectionShare {
+    return CollectionShare.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CollectionShare>): CollectionShare {
+    const message = createBaseCollectionShare();
+    message.id = object.id ?? 0;
+    message.collectionId = object.collectionId ?? 0;
+    message.creatorId = object.creatorId ?? 0;
+    message.createdTime = object.createdTime ?? undefined;
+    message.email = object.email ?? "";
+    message.token = object.token ?? "";
+    message.expireTime = object.expireTime ?? undefined;
+    message.viewCount = object.viewCount ?? 0;
+    message.lastViewedTime = object.lastViewedTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseCreateCollectionShareRequest(): CreateCollectionShareRequest {
+  return { collectionId: 0, email: "", expireTime: undefined };
+}
+
+export const CreateCollectionShareRequest: MessageFns<CreateCollectionShareRequest> = {
+  encode(message: CreateCollectionShareRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.collectionId !== 0) {
+      writer.uint32(8).int32(message.collectionId);
+    }
+    if (message.email !== "") {
+      writer.uint32(18).string(message.email);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateCollectionShareRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateCollectionShareRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.collectionId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.email = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateCollectionShareRequest>): CreateCollectionShareRequest {
+    return CreateCollectionShareRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateCollectionShareRequest>): CreateCollectionShareRequest {
+    const message = createBaseCreateCollectionShareRequest();
+    message.collectionId = object.collectionId ?? 0;
+    message.email = object.email ?? "";
+    message.expireTime = object.expireTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseListCollectionSharesRequest(): ListCollectionSharesRequest {
+  return { collectionId: 0 };
+}
+
+export const ListCollectionSharesRequest: MessageFns<ListCollectionSharesRequest> = {
+  encode(message: ListCollectionSharesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.collectionId !== 0) {
+      writer.uint32(8).int32(message.collectionId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListCollectionSharesRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListCollectionSharesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.collectionId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListCollectionSharesRequest>): ListCollectionSharesRequest {
+    return ListCollectionSharesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListCollectionSharesRequest>): ListCollectionSharesRequest {
+    const message = createBaseListCollectionSharesRequest();
+    message.collectionId = object.collectionId ?? 0;
+    return message;
+  },
+};
+
+function createBaseListCollectionSharesResponse(): ListCollectionSharesResponse {
+  return { shares: [] };
+}
+
+export const ListCollectionSharesResponse: MessageFns<ListCollectionSharesResponse> = {
+  encode(message: ListCollectionSharesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.shares) {
+      CollectionShare.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListCollectionSharesResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListCollectionSharesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shares.push(CollectionShare.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListCollectionSharesResponse>): ListCollectionSharesResponse {
+    return ListCollectionSharesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListCollectionSharesResponse>): ListCollectionSharesResponse {
+    const message = createBaseListCollectionSharesResponse();
+    message.shares = object.shares?.map((e) => CollectionShare.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDeleteCollectionShareRequest(): DeleteCollectionShareRequest {
+  return { collectionId: 0, id: 0 };
+}
+
+export const DeleteCollectionShareRequest: MessageFns<DeleteCollectionShareRequest> = {
+  encode(message: DeleteCollectionShareRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.collectionId !== 0) {
+      writer.uint32(8).int32(message.collectionId);
+    }
+    if (message.id !== 0) {
+      writer.uint32(16).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteCollectionShareRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteCollectionShareRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.collectionId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeleteCollectionShareRequest>): DeleteCollectionShareRequest {
+    return DeleteCollectionShareRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteCollectionShareRequest>): DeleteCollectionShareRequest {
+    const message = createBaseDeleteCollectionShareRequest();
+    message.collectionId = object.collectionId ?? 0;
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetSharedCollectionRequest(): GetSharedCollectionRequest {
+  return { token: "" };
+}
+
+export const GetSharedCollectionRequest: MessageFns<GetSharedCollectionRequest> = {
+  encode(message: GetSharedCollectionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.token !== "") {
+      writer.uint32(10).string(message.token);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetSharedCollectionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetSharedCollectionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetSharedCollectionRequest>): GetSharedCollectionRequest {
+    return GetSharedCollectionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetSharedCollectionRequest>): GetSharedCollectionRequest {
+    const message = createBaseGetSharedCollectionRequest();
+    message.token = object.token ?? "";
+    return message;
+  },
+};
+
+function createBaseSharedCollection(): SharedCollection {
+  return { collection: undefined, shortcuts: [], expireTime: undefined };
+}
+
+export const SharedCollection: MessageFns<SharedCollection> = {
+  encode(message: SharedCollection, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.collection !== undefined) {
+      Collection.encode(message.collection, writer.uint32(10).fork()).join();
+    }
+    for (const v of message.shortcuts) {
+      Shortcut.encode(v!, writer.uint32(18).fork()).join();
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SharedCollection {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSharedCollection();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.collection = Collection.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.shortcuts.push(Shortcut.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SharedCollection>): SharedCollection {
+    return SharedCollection.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SharedCollection>): SharedCollection {
+    const message = createBaseSharedCollection();
+    message.collection = (object.collection !== undefined && object.collection !== null)
+      ? Collection.fromPartial(object.collection)
+      : undefined;
+    message.shortcuts = object.shortcuts?.map((e) => Shortcut.fromPartial(e)) || [];
+    message.expireTime = object.expireTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseCollectionTemplate(): CollectionTemplate {
+  return { id: "", title: "", description: "", shortcuts: [], builtIn: false };
+}
+
+export const CollectionTemplate: MessageFns<CollectionTemplate> = {
+  encode(message: CollectionTemplate, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.description !== "") {
+      writer.uint32(26).string(message.description);
+    }
+    for (const v of message.shortcuts) {
+      CollectionTemplate_ShortcutTemplate.encode(v!, writer.uint32(34).fork()).join();
+    }
+    if (message.builtIn !== false) {
+      writer.uint32(40).bool(message.builtIn);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CollectionTemplate {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCollectionTemplate();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.shortcuts.push(CollectionTemplate_ShortcutTemplate.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.builtIn = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CollectionTemplate>): CollectionTemplate {
+    return CollectionTemplate.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CollectionTemplate>): CollectionTemplate {
+    const message = createBaseCollectionTemplate();
+    message.id = object.id ?? "";
+    message.title = object.title ?? "";
+    message.description = object.description ?? "";
+    message.shortcuts = object.shortcuts?.map((e) => CollectionTemplate_ShortcutTemplate.fromPartial(e)) || [];
+    message.builtIn = object.builtIn ?? false;
+    return message;
+  },
+};
+
+function createBaseCollectionTemplate_ShortcutTemplate(): CollectionTemplate_ShortcutTemplate {
+  return { name: "", title: "", link: "", description: "", tags: [] };
+}
+
+export const CollectionTemplate_ShortcutTemplate: MessageFns<CollectionTemplate_ShortcutTemplate> = {
+  encode(message: CollectionTemplate_ShortcutTemplate, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.link !== "") {
+      writer.uint32(26).string(message.link);
+    }
+    if (message.description !== "") {
+      writer.uint32(34).string(message.description);
+    }
+    for (const v of message.tags) {
+      writer.uint32(42).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CollectionTemplate_ShortcutTemplate {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCollectionTemplate_ShortcutTemplate();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CollectionTemplate_ShortcutTemplate>): CollectionTemplate_ShortcutTemplate {
+    return CollectionTemplate_ShortcutTemplate.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CollectionTemplate_ShortcutTemplate>): CollectionTemplate_ShortcutTemplate {
+    const message = createBaseCollectionTemplate_ShortcutTemplate();
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.link = object.link ?? "";
+    message.description = object.description ?? "";
+    message.tags = object.tags?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseListCollectionTemplatesRequest(): ListCollectionTemplatesRequest {
+  return {};
+}
+
+export const ListCollectionTemplatesRequest: MessageFns<ListCollectionTemplatesRequest> = {
+  encode(_: ListCollectionTemplatesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListCollectionTemplatesRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListCollectionTemplatesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListCollectionTemplatesRequest>): ListCollectionTemplatesRequest {
+    return ListCollectionTemplatesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<ListCollectionTemplatesRequest>): ListCollectionTemplatesRequest {
+    const message = createBaseListCollectionTemplatesRequest();
+    return message;
+  },
+};
+
+function createBaseListCollectionTemplatesResponse(): ListCollectionTemplatesResponse {
+  return { templates: [] };
+}
+
+export const ListCollectionTemplatesResponse: MessageFns<ListCollectionTemplatesResponse> = {
+  encode(message: ListCollectionTemplatesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.templates) {
+      CollectionTemplate.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListCollectionTemplatesResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListCollectionTemplatesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.templates.push(CollectionTemplate.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListCollectionTemplatesResponse>): ListCollectionTemplatesResponse {
+    return ListCollectionTemplatesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListCollectionTemplatesResponse>): ListCollectionTemplatesResponse {
+    const message = createBaseListCollectionTemplatesResponse();
+    message.templates = object.templates?.map((e) => CollectionTemplate.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseCreateCollectionFromTemplateRequest(): CreateCollectionFromTemplateRequest {
+  return { templateId: "", collection: undefined };
+}
+
+export const CreateCollectionFromTemplateRequest: MessageFns<CreateCollectionFromTemplateRequest> = {
+  encode(message: CreateCollectionFromTemplateRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.templateId !== "") {
+      writer.uint32(10).string(message.templateId);
+    }
+    if (message.collection !== undefined) {
+      Collection.encode(message.collection, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateCollectionFromTemplateRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateCollectionFromTemplateRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.templateId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.collection = Collection.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateCollectionFromTemplateRequest>): CreateCollectionFromTemplateRequest {
+    return CreateCollectionFromTemplateRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateCollectionFromTemplateRequest>): CreateCollectionFromTemplateRequest {
+    const message = createBaseCreateCollectionFromTemplateRequest();
+    message.templateId = object.templateId ?? "";
+    message.collection = (object.collection !== undefined && object.collection !== null)
+      ? Collection.fromPartial(object.collection)
+      : undefined;
+    return message;
+  },
+};
+
+export type CollectionServiceDefinition = typeof CollectionServiceDefinition;
+export const CollectionServiceDefinition = {
+  name: "CollectionService",
+  fullName: "slash.api.v1.CollectionService",
+  methods: {
+    /** ListCollections returns a list of collections. */
+    listCollections: {
+      name: "ListCollections",
+      requestType: ListCollectionsRequest,
+      requestStream: false,
+      responseType: ListCollectionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              21,
+              18,
+              19,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetCollection returns a collection by id. */
+    getCollection: {
+      name: "GetCollection",
+      requestType: GetCollectionRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              26,
+              18,
+              24,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetCollectionByName returns a collection by name. */
+    getCollectionByName: {
+      name: "GetCollectionByName",
+      requestType: GetCollectionByNameRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {},
+    },
+    /** CreateCollection creates a collection. */
+    createCollection: {
+      name: "CreateCollection",
+      requestType: CreateCollectionRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              33,
+              58,
+              10,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              34,
+              19,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** UpdateCollection updates a collection. */
     updateCollection: {
       name: "UpdateCollection",
       requestType: UpdateCollectionRequest,
@@ -698,9 +1902,272 @@ export const CollectionServiceDefinition = {
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [
+          8410: [
+            new Uint8Array([
+              22,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
+            ]),
+          ],
+          578365826: [
+            new Uint8Array([
+              49,
+              58,
+              10,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              26,
+              35,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              46,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteCollection deletes a collection by id. */
+    deleteCollection: {
+      name: "DeleteCollection",
+      requestType: DeleteCollectionRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              26,
+              42,
+              24,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ArchiveCollection archives a collection, which is kept rather than deleted. Only for its creator and admins. */
+    archiveCollection: {
+      name: "ArchiveCollection",
+      requestType: ArchiveCollectionRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              37,
+              58,
+              1,
+              42,
+              34,
+              32,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              58,
+              97,
+              114,
+              99,
+              104,
+              105,
+              118,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** UnarchiveCollection restores an archived collection. Only for its creator and admins. */
+    unarchiveCollection: {
+      name: "UnarchiveCollection",
+      requestType: UnarchiveCollectionRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              39,
+              58,
+              1,
+              42,
+              34,
+              34,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              58,
+              117,
+              110,
+              97,
+              114,
+              99,
+              104,
+              105,
+              118,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** TransferCollection transfers the ownership of a collection to another user. Only for its creator and admins. */
+    transferCollection: {
+      name: "TransferCollection",
+      requestType: TransferCollectionRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([10, 105, 100, 44, 117, 115, 101, 114, 95, 105, 100])],
+          578365826: [
             new Uint8Array([
-              22,
+              38,
+              58,
+              1,
+              42,
+              34,
+              33,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
               99,
               111,
               108,
@@ -711,25 +2178,51 @@ export const CollectionServiceDefinition = {
               105,
               111,
               110,
-              44,
-              117,
-              112,
+              115,
+              47,
+              123,
+              105,
               100,
-              97,
+              125,
+              58,
               116,
-              101,
-              95,
-              109,
+              114,
               97,
+              110,
               115,
-              107,
+              102,
+              101,
+              114,
             ]),
           ],
+        },
+      },
+    },
+    /** CreateCollectionShare invites an email that isn't a member of the workspace to view the collection with a guest link. */
+    createCollectionShare: {
+      name: "CreateCollectionShare",
+      requestType: CreateCollectionShareRequest,
+      requestStream: false,
+      responseType: CollectionShare,
+      responseStream: false,
+      options: {
+        _unknownFields: {
           578365826: [
             new Uint8Array([
-              49,
+              47,
               58,
-              10,
+              1,
+              42,
+              34,
+              42,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
               99,
               111,
               108,
@@ -740,8 +2233,50 @@ export const CollectionServiceDefinition = {
               105,
               111,
               110,
-              26,
-              35,
+              115,
+              47,
+              123,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              95,
+              105,
+              100,
+              125,
+              47,
+              115,
+              104,
+              97,
+              114,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListCollectionShares returns the guest links of the collection. */
+    listCollectionShares: {
+      name: "ListCollectionShares",
+      requestType: ListCollectionSharesRequest,
+      requestStream: false,
+      responseType: ListCollectionSharesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([13, 99, 111, 108, 108, 101, 99, 116, 105, 111, 110, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              44,
+              18,
+              42,
               47,
               97,
               112,
@@ -773,30 +2308,36 @@ export const CollectionServiceDefinition = {
               105,
               111,
               110,
-              46,
+              95,
               105,
               100,
               125,
+              47,
+              115,
+              104,
+              97,
+              114,
+              101,
+              115,
             ]),
           ],
         },
       },
     },
-    /** DeleteCollection deletes a collection by id. */
-    deleteCollection: {
-      name: "DeleteCollection",
-      requestType: DeleteCollectionRequest,
+    /** DeleteCollectionShare revokes a guest link of the collection. */
+    deleteCollectionShare: {
+      name: "DeleteCollectionShare",
+      requestType: DeleteCollectionShareRequest,
       requestStream: false,
       responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
           578365826: [
             new Uint8Array([
-              26,
+              49,
               42,
-              24,
+              47,
               47,
               97,
               112,
@@ -818,14 +2359,192 @@ export const CollectionServiceDefinition = {
               115,
               47,
               123,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              95,
+              105,
+              100,
+              125,
+              47,
+              115,
+              104,
+              97,
+              114,
+              101,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetSharedCollection returns the collection of a guest link with its shortcuts, and counts the view. */
+    getSharedCollection: {
+      name: "GetSharedCollection",
+      requestType: GetSharedCollectionRequest,
+      requestStream: false,
+      responseType: SharedCollection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([5, 116, 111, 107, 101, 110])],
+          578365826: [
+            new Uint8Array([
+              36,
+              18,
+              34,
+              47,
+              97,
+              112,
               105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              97,
+              114,
+              101,
               100,
+              45,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              116,
+              111,
+              107,
+              101,
+              110,
               125,
             ]),
           ],
         },
       },
     },
+    /** ListCollectionTemplates returns the built-in and the admin-defined collection templates. */
+    listCollectionTemplates: {
+      name: "ListCollectionTemplates",
+      requestType: ListCollectionTemplatesRequest,
+      requestStream: false,
+      responseType: ListCollectionTemplatesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              30,
+              18,
+              28,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              45,
+              116,
+              101,
+              109,
+              112,
+              108,
+              97,
+              116,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** CreateCollectionFromTemplate creates a collection with the shortcuts of a template. */
+    createCollectionFromTemplate: {
+      name: "CreateCollectionFromTemplate",
+      requestType: CreateCollectionFromTemplateRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              37,
+              58,
+              1,
+              42,
+              34,
+              32,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              58,
+              102,
+              114,
+              111,
+              109,
+              84,
+              101,
+              109,
+              112,
+              108,
+              97,
+              116,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -51,6 +51,10 @@ export enum Visibility {
   VISIBILITY_UNSPECIFIED = "VISIBILITY_UNSPECIFIED",
   WORKSPACE = "WORKSPACE",
   PUBLIC = "PUBLIC",
+  /** TEAM - Only visible to the members of the team of the resource, its creator and the admins. */
+  TEAM = "TEAM",
+  /** PRIVATE - Only visible to its creator, the admins and the users it's shared with. */
+  PRIVATE = "PRIVATE",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -65,6 +69,12 @@ export function visibilityFromJSON(object: any): Visibility {
     case 2:
     case "PUBLIC":
       return Visibility.PUBLIC;
+    case 3:
+    case "TEAM":
+      return Visibility.TEAM;
+    case 4:
+    case "PRIVATE":
+      return Visibility.PRIVATE;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -80,6 +90,10 @@ export function visibilityToNumber(object: Visibility): number {
       return 1;
     case Visibility.PUBLIC:
       return 2;
+    case Visibility.TEAM:
+      return 3;
+    case Visibility.PRIVATE:
+      return 4;
     case Visibility.UNRECOGNIZED:
     default:
       return -1;
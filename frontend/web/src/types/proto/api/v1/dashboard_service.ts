@@ -0,0 +1,416 @@
+// Code generated by protoc-gen-ts_proto. DO NOT EDIT.
+// versions:
+//   protoc-gen-ts_proto  v2.6.1
+//   protoc               unknown
+// source: api/v1/dashboard_service.proto
+
+/* eslint-disable */
+import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
+import {
+  GetTrendingShortcutsRequest_Window,
+  GetTrendingShortcutsResponse_TrendingShortcut,
+  Shortcut,
+  getTrendingShortcutsRequest_WindowFromJSON,
+  getTrendingShortcutsRequest_WindowToNumber,
+} from "./shortcut_service";
+
+export const protobufPackage = "slash.api.v1";
+
+export interface GetDashboardRequest {
+  /** The window of the trending shortcuts. Defaults to DAY. */
+  trendingWindow: GetTrendingShortcutsRequest_Window;
+  /** The max number of trending shortcuts to return. Defaults to 10, and the max is 50. */
+  trendingLimit: number;
+}
+
+export interface Dashboard {
+  /** The shortcuts visible to the user, like ListShortcuts. */
+  shortcuts: Shortcut[];
+  /** The shortcuts with growing views, like GetTrendingShortcuts. */
+  trendingShortcuts: GetTrendingShortcutsResponse_TrendingShortcut[];
+  /** The collections visible to the user, without their shortcuts. */
+  collections: Dashboard_CollectionSummary[];
+  /** The sections which failed to load, and are empty. */
+  errors: Dashboard_SectionError[];
+}
+
+export enum Dashboard_Section {
+  SECTION_UNSPECIFIED = "SECTION_UNSPECIFIED",
+  SHORTCUTS = "SHORTCUTS",
+  TRENDING_SHORTCUTS = "TRENDING_SHORTCUTS",
+  COLLECTIONS = "COLLECTIONS",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function dashboard_SectionFromJSON(object: any): Dashboard_Section {
+  switch (object) {
+    case 0:
+    case "SECTION_UNSPECIFIED":
+      return Dashboard_Section.SECTION_UNSPECIFIED;
+    case 1:
+    case "SHORTCUTS":
+      return Dashboard_Section.SHORTCUTS;
+    case 2:
+    case "TRENDING_SHORTCUTS":
+      return Dashboard_Section.TRENDING_SHORTCUTS;
+    case 3:
+    case "COLLECTIONS":
+      return Dashboard_Section.COLLECTIONS;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Dashboard_Section.UNRECOGNIZED;
+  }
+}
+
+export function dashboard_SectionToNumber(object: Dashboard_Section): number {
+  switch (object) {
+    case Dashboard_Section.SECTION_UNSPECIFIED:
+      return 0;
+    case Dashboard_Section.SHORTCUTS:
+      return 1;
+    case Dashboard_Section.TRENDING_SHORTCUTS:
+      return 2;
+    case Dashboard_Section.COLLECTIONS:
+      return 3;
+    case Dashboard_Section.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface Dashboard_CollectionSummary {
+  id: number;
+  name: string;
+  title: string;
+  /** The number of shortcuts in the collection. */
+  shortcutCount: number;
+}
+
+export interface Dashboard_SectionError {
+  section: Dashboard_Section;
+  /** The error of the section, with an error id instead of the details of the server errors. */
+  message: string;
+}
+
+function createBaseGetDashboardRequest(): GetDashboardRequest {
+  return { trendingWindow: GetTrendingShortcutsRequest_Window.WINDOW_UNSPECIFIED, trendingLimit: 0 };
+}
+
+export const GetDashboardRequest: MessageFns<GetDashboardRequest> = {
+  encode(message: GetDashboardRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.trendingWindow !== GetTrendingShortcutsRequest_Window.WINDOW_UNSPECIFIED) {
+      writer.uint32(8).int32(getTrendingShortcutsRequest_WindowToNumber(message.trendingWindow));
+    }
+    if (message.trendingLimit !== 0) {
+      writer.uint32(16).int32(message.trendingLimit);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetDashboardRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetDashboardRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.trendingWindow = getTrendingShortcutsRequest_WindowFromJSON(reader.int32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.trendingLimit = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetDashboardRequest>): GetDashboardRequest {
+    return GetDashboardRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetDashboardRequest>): GetDashboardRequest {
+    const message = createBaseGetDashboardRequest();
+    message.trendingWindow = object.trendingWindow ?? GetTrendingShortcutsRequest_Window.WINDOW_UNSPECIFIED;
+    message.trendingLimit = object.trendingLimit ?? 0;
+    return message;
+  },
+};
+
+function createBaseDashboard(): Dashboard {
+  return { shortcuts: [], trendingShortcuts: [], collections: [], errors: [] };
+}
+
+export const Dashboard: MessageFns<Dashboard> = {
+  encode(message: Dashboard, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.shortcuts) {
+      Shortcut.encode(v!, writer.uint32(10).fork()).join();
+    }
+    for (const v of message.trendingShortcuts) {
+      GetTrendingShortcutsResponse_TrendingShortcut.encode(v!, writer.uint32(18).fork()).join();
+    }
+    for (const v of message.collections) {
+      Dashboard_CollectionSummary.encode(v!, writer.uint32(26).fork()).join();
+    }
+    for (const v of message.errors) {
+      Dashboard_SectionError.encode(v!, writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Dashboard {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDashboard();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shortcuts.push(Shortcut.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.trendingShortcuts.push(GetTrendingShortcutsResponse_TrendingShortcut.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.collections.push(Dashboard_CollectionSummary.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.errors.push(Dashboard_SectionError.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Dashboard>): Dashboard {
+    return Dashboard.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Dashboard>): Dashboard {
+    const message = createBaseDashboard();
+    message.shortcuts = object.shortcuts?.map((e) => Shortcut.fromPartial(e)) || [];
+    message.trendingShortcuts =
+      object.trendingShortcuts?.map((e) => GetTrendingShortcutsResponse_TrendingShortcut.fromPartial(e)) || [];
+    message.collections = object.collections?.map((e) => Dashboard_CollectionSummary.fromPartial(e)) || [];
+    message.errors = object.errors?.map((e) => Dashboard_SectionError.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDashboard_CollectionSummary(): Dashboard_CollectionSummary {
+  return { id: 0, name: "", title: "", shortcutCount: 0 };
+}
+
+export const Dashboard_CollectionSummary: MessageFns<Dashboard_CollectionSummary> = {
+  encode(message: Dashboard_CollectionSummary, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(26).string(message.title);
+    }
+    if (message.shortcutCount !== 0) {
+      writer.uint32(32).int32(message.shortcutCount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Dashboard_CollectionSummary {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDashboard_CollectionSummary();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.shortcutCount = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Dashboard_CollectionSummary>): Dashboard_CollectionSummary {
+    return Dashboard_CollectionSummary.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Dashboard_CollectionSummary>): Dashboard_CollectionSummary {
+    const message = createBaseDashboard_CollectionSummary();
+    message.id = object.id ?? 0;
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.shortcutCount = object.shortcutCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseDashboard_SectionError(): Dashboard_SectionError {
+  return { section: Dashboard_Section.SECTION_UNSPECIFIED, message: "" };
+}
+
+export const Dashboard_SectionError: MessageFns<Dashboard_SectionError> = {
+  encode(message: Dashboard_SectionError, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.section !== Dashboard_Section.SECTION_UNSPECIFIED) {
+      writer.uint32(8).int32(dashboard_SectionToNumber(message.section));
+    }
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Dashboard_SectionError {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDashboard_SectionError();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.section = dashboard_SectionFromJSON(reader.int32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Dashboard_SectionError>): Dashboard_SectionError {
+    return Dashboard_SectionError.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Dashboard_SectionError>): Dashboard_SectionError {
+    const message = createBaseDashboard_SectionError();
+    message.section = object.section ?? Dashboard_Section.SECTION_UNSPECIFIED;
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+export type DashboardServiceDefinition = typeof DashboardServiceDefinition;
+export const DashboardServiceDefinition = {
+  name: "DashboardService",
+  fullName: "slash.api.v1.DashboardService",
+  methods: {
+    /**
+     * GetDashboard returns the sections of the home view of the current user, gathered concurrently.
+     * The sections failing to load are reported in the errors, while the others are returned.
+     */
+    getDashboard: {
+      name: "GetDashboard",
+      requestType: GetDashboardRequest,
+      requestStream: false,
+      responseType: Dashboard,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([19, 18, 17, 47, 97, 112, 105, 47, 118, 49, 47, 100, 97, 115, 104, 98, 111, 97, 114, 100]),
+          ],
+        },
+      },
+    },
+  },
+} as const;
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+export interface MessageFns<T> {
+  encode(message: T, writer?: BinaryWriter): BinaryWriter;
+  decode(input: BinaryReader | Uint8Array, length?: number): T;
+  create(base?: DeepPartial<T>): T;
+  fromPartial(object: DeepPartial<T>): T;
+}
@@ -0,0 +1,557 @@
+// Code generated by protoc-gen-ts_proto. DO NOT EDIT.
+// versions:
+//   protoc-gen-ts_proto  v2.6.1
+//   protoc               unknown
+// source: api/v1/search_service.proto
+
+/* eslint-disable */
+import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
+import { Collection } from "./collection_service";
+import { Shortcut } from "./shortcut_service";
+
+export const protobufPackage = "slash.api.v1";
+
+export interface SearchRequest {
+  /** The words to search for. A result matches when each word is the prefix of a word of its fields. */
+  query: string;
+  /** The types of the results to return. Empty returns all of them. */
+  types: SearchResult_Type[];
+  /** The max number of results to return. Unset or 0 returns 20, and the max is 100. */
+  limit: number;
+}
+
+export interface SearchResponse {
+  /** The results, the best ranked first. */
+  results: SearchResult[];
+}
+
+export interface SearchResult {
+  type: SearchResult_Type;
+  /** The score of the result. The higher, the better the result matches the query. */
+  score: number;
+  /** The fields of the result which match the query. */
+  highlights: SearchResult_Highlight[];
+  shortcut?: Shortcut | undefined;
+  collection?: Collection | undefined;
+  tag?: SearchResult_Tag | undefined;
+}
+
+export enum SearchResult_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  SHORTCUT = "SHORTCUT",
+  COLLECTION = "COLLECTION",
+  TAG = "TAG",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function searchResult_TypeFromJSON(object: any): SearchResult_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return SearchResult_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "SHORTCUT":
+      return SearchResult_Type.SHORTCUT;
+    case 2:
+    case "COLLECTION":
+      return SearchResult_Type.COLLECTION;
+    case 3:
+    case "TAG":
+      return SearchResult_Type.TAG;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return SearchResult_Type.UNRECOGNIZED;
+  }
+}
+
+export function searchResult_TypeToNumber(object: SearchResult_Type): number {
+  switch (object) {
+    case SearchResult_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case SearchResult_Type.SHORTCUT:
+      return 1;
+    case SearchResult_Type.COLLECTION:
+      return 2;
+    case SearchResult_Type.TAG:
+      return 3;
+    case SearchResult_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface SearchResult_Highlight {
+  /** The name of the field, e.g. `name` or `title`. */
+  field: string;
+  /** The value of the field split into the parts matching the query and the others. */
+  segments: SearchResult_Segment[];
+}
+
+export interface SearchResult_Segment {
+  text: string;
+  matched: boolean;
+}
+
+export interface SearchResult_Tag {
+  name: string;
+  /** The number of shortcuts with the tag. */
+  shortcutCount: number;
+}
+
+function createBaseSearchRequest(): SearchRequest {
+  return { query: "", types: [], limit: 0 };
+}
+
+export const SearchRequest: MessageFns<SearchRequest> = {
+  encode(message: SearchRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.query !== "") {
+      writer.uint32(10).string(message.query);
+    }
+    writer.uint32(18).fork();
+    for (const v of message.types) {
+      writer.int32(searchResult_TypeToNumber(v));
+    }
+    writer.join();
+    if (message.limit !== 0) {
+      writer.uint32(24).int32(message.limit);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSearchRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.query = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag === 16) {
+            message.types.push(searchResult_TypeFromJSON(reader.int32()));
+
+            continue;
+          }
+
+          if (tag === 18) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.types.push(searchResult_TypeFromJSON(reader.int32()));
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SearchRequest>): SearchRequest {
+    return SearchRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SearchRequest>): SearchRequest {
+    const message = createBaseSearchRequest();
+    message.query = object.query ?? "";
+    message.types = object.types?.map((e) => e) || [];
+    message.limit = object.limit ?? 0;
+    return message;
+  },
+};
+
+function createBaseSearchResponse(): SearchResponse {
+  return { results: [] };
+}
+
+export const SearchResponse: MessageFns<SearchResponse> = {
+  encode(message: SearchResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.results) {
+      SearchResult.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSearchResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.results.push(SearchResult.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SearchResponse>): SearchResponse {
+    return SearchResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SearchResponse>): SearchResponse {
+    const message = createBaseSearchResponse();
+    message.results = object.results?.map((e) => SearchResult.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSearchResult(): SearchResult {
+  return {
+    type: SearchResult_Type.TYPE_UNSPECIFIED,
+    score: 0,
+    highlights: [],
+    shortcut: undefined,
+    collection: undefined,
+    tag: undefined,
+  };
+}
+
+export const SearchResult: MessageFns<SearchResult> = {
+  encode(message: SearchResult, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.type !== SearchResult_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(searchResult_TypeToNumber(message.type));
+    }
+    if (message.score !== 0) {
+      writer.uint32(17).double(message.score);
+    }
+    for (const v of message.highlights) {
+      SearchResult_Highlight.encode(v!, writer.uint32(26).fork()).join();
+    }
+    if (message.shortcut !== undefined) {
+      Shortcut.encode(message.shortcut, writer.uint32(34).fork()).join();
+    }
+    if (message.collection !== undefined) {
+      Collection.encode(message.collection, writer.uint32(42).fork()).join();
+    }
+    if (message.tag !== undefined) {
+      SearchResult_Tag.encode(message.tag, writer.uint32(50).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchResult {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSearchResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = searchResult_TypeFromJSON(reader.int32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.score = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.highlights.push(SearchResult_Highlight.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.collection = Collection.decode(reader, reader.uint32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.tag = SearchResult_Tag.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SearchResult>): SearchResult {
+    return SearchResult.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SearchResult>): SearchResult {
+    const message = createBaseSearchResult();
+    message.type = object.type ?? SearchResult_Type.TYPE_UNSPECIFIED;
+    message.score = object.score ?? 0;
+    message.highlights = object.highlights?.map((e) => SearchResult_Highlight.fromPartial(e)) || [];
+    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
+      ? Shortcut.fromPartial(object.shortcut)
+      : undefined;
+    message.collection = (object.collection !== undefined && object.collection !== null)
+      ? Collection.fromPartial(object.collection)
+      : undefined;
+    message.tag = (object.tag !== undefined && object.tag !== null)
+      ? SearchResult_Tag.fromPartial(object.tag)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseSearchResult_Highlight(): SearchResult_Highlight {
+  return { field: "", segments: [] };
+}
+
+export const SearchResult_Highlight: MessageFns<SearchResult_Highlight> = {
+  encode(message: SearchResult_Highlight, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.field !== "") {
+      writer.uint32(10).string(message.field);
+    }
+    for (const v of message.segments) {
+      SearchResult_Segment.encode(v!, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchResult_Highlight {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSearchResult_Highlight();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.field = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.segments.push(SearchResult_Segment.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SearchResult_Highlight>): SearchResult_Highlight {
+    return SearchResult_Highlight.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SearchResult_Highlight>): SearchResult_Highlight {
+    const message = createBaseSearchResult_Highlight();
+    message.field = object.field ?? "";
+    message.segments = object.segments?.map((e) => SearchResult_Segment.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSearchResult_Segment(): SearchResult_Segment {
+  return { text: "", matched: false };
+}
+
+export const SearchResult_Segment: MessageFns<SearchResult_Segment> = {
+  encode(message: SearchResult_Segment, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.text !== "") {
+      writer.uint32(10).string(message.text);
+    }
+    if (message.matched !== false) {
+      writer.uint32(16).bool(message.matched);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchResult_Segment {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSearchResult_Segment();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.text = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.matched = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SearchResult_Segment>): SearchResult_Segment {
+    return SearchResult_Segment.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SearchResult_Segment>): SearchResult_Segment {
+    const message = createBaseSearchResult_Segment();
+    message.text = object.text ?? "";
+    message.matched = object.matched ?? false;
+    return message;
+  },
+};
+
+function createBaseSearchResult_Tag(): SearchResult_Tag {
+  return { name: "", shortcutCount: 0 };
+}
+
+export const SearchResult_Tag: MessageFns<SearchResult_Tag> = {
+  encode(message: SearchResult_Tag, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.shortcutCount !== 0) {
+      writer.uint32(16).int32(message.shortcutCount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchResult_Tag {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSearchResult_Tag();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.shortcutCount = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SearchResult_Tag>): SearchResult_Tag {
+    return SearchResult_Tag.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SearchResult_Tag>): SearchResult_Tag {
+    const message = createBaseSearchResult_Tag();
+    message.name = object.name ?? "";
+    message.shortcutCount = object.shortcutCount ?? 0;
+    return message;
+  },
+};
+
+export type SearchServiceDefinition = typeof SearchServiceDefinition;
+export const SearchServiceDefinition = {
+  name: "SearchService",
+  fullName: "slash.api.v1.SearchService",
+  methods: {
+    /** Search returns the shortcuts, collections and tags matching the query, ranked together. */
+    search: {
+      name: "Search",
+      requestType: SearchRequest,
+      requestStream: false,
+      responseType: SearchResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [new Uint8Array([16, 18, 14, 47, 97, 112, 105, 47, 118, 49, 47, 115, 101, 97, 114, 99, 104])],
+        },
+      },
+    },
+  },
+} as const;
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+export interface MessageFns<T> {
+  encode(message: T, writer?: BinaryWriter): BinaryWriter;
+  decode(input: BinaryReader | Uint8Array, length?: number): T;
+  create(base?: DeepPartial<T>): T;
+  fromPartial(object: DeepPartial<T>): T;
+}
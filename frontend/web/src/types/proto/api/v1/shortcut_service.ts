@@ -9,7 +9,7 @@ import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
 import { Empty } from "../../google/protobuf/empty";
 import { FieldMask } from "../../google/protobuf/field_mask";
 import { Timestamp } from "../../google/protobuf/timestamp";
-import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+import { State, Visibility, stateFromJSON, stateToNumber, visibilityFromJSON, visibilityToNumber } from "./common";
 
 export const protobufPackage = "slash.api.v1";
 
@@ -17,7 +17,11 @@ export interface Shortcut {
   id: number;
   creatorId: number;
   createdTime?: Date | undefined;
-  updatedTime?: Date | undefined;
+  updatedTime?:
+    | Date
+    | undefined;
+  /** Output only. Expired shortcuts are archived as INACTIVE. */
+  state: State;
   name: string;
   link: string;
   title: string;
@@ -25,20 +29,361 @@ export interface Shortcut {
   description: string;
   visibility: Visibility;
   viewCount: number;
-  ogMetadata?: Shortcut_OpenGraphMetadata | undefined;
+  ogMetadata?:
+    | Shortcut_OpenGraphMetadata
+    | undefined;
+  /** The username of the creator. */
+  creatorUsername: string;
+  clickGoal?:
+    | Shortcut_ClickGoal
+    | undefined;
+  /** The time the shortcut expires. Unset means never. */
+  expireTime?:
+    | Date
+    | undefined;
+  /**
+   * The query parameters appended to the link on redirect, e.g. utm_source.
+   * The parameters already in the link or in the request are kept.
+   */
+  queryParams: Shortcut_QueryParam[];
+  /**
+   * The time the shortcut starts resolving. Unset means immediately.
+   * Until then, the link is only visible to the creator and admins.
+   */
+  activateTime?:
+    | Date
+    | undefined;
+  /**
+   * The other names resolving to the shortcut, e.g. its names in other languages like "hilfe" for "help",
+   * or the names of the shortcuts merged into it. They are updated as a set with the "aliases" path, and
+   * must be unique among the names and the aliases of the other shortcuts.
+   */
+  aliases: string[];
+  /**
+   * Whether the edits of the users other than the creator and admins are proposed changes, which the creator
+   * or an admin has to approve. Only the creator and admins can change it.
+   */
+  protected: boolean;
+  /** The link the shortcut resolves to now, i.e. the link of its active rotation if any, otherwise its link. */
+  currentLink: string;
+  /** The id of the team the shortcut is visible to, when the visibility is TEAM. */
+  teamId: number;
+  /**
+   * The HTTP status code of the redirect: 301 (permanent), 302 (temporary) or 307 (temporary, preserving the method).
+   * 0 means the default redirect code of the workspace.
+   */
+  redirectCode: number;
+  /** Output only. The result of the last health check of the link, when the workspace checks the links. */
+  linkHealth?:
+    | Shortcut_LinkHealth
+    | undefined;
+  /**
+   * How the link is served when it's a document, e.g. a PDF, rather than a web page. The documents are
+   * proxied by the server, and the links to web pages or to the private networks are redirected as usual.
+   */
+  documentMode: Shortcut_DocumentMode;
+  /**
+   * Whether the description is internal context, only shown to the signed-in users. The public pages,
+   * e.g. the shared collections, and the unfurls of the short link show the title without it.
+   */
+  internalDescription: boolean;
+  /**
+   * The rules redirecting the visitors to other links than the current link, e.g. the visitors of the EU to a regional
+   * site. The first rule matching the visitor wins, and the current link is the fallback. Only applied to the visits.
+   */
+  redirectRules: Shortcut_RedirectRule[];
+  /**
+   * The weighted links splitting the visits, e.g. for an A/B test. On each visit, one is picked with the probability of
+   * its weight instead of the current link, unless a redirect rule matches. Only applied to the visits.
+   */
+  variants: Shortcut_Variant[];
+}
+
+export enum Shortcut_DocumentMode {
+  /** DOCUMENT_MODE_UNSPECIFIED - The visitors are redirected to the link. */
+  DOCUMENT_MODE_UNSPECIFIED = "DOCUMENT_MODE_UNSPECIFIED",
+  /** INLINE - The document is shown in a viewer page of the short link. */
+  INLINE = "INLINE",
+  /** DOWNLOAD - The document is downloaded as an attachment, with its file name. */
+  DOWNLOAD = "DOWNLOAD",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function shortcut_DocumentModeFromJSON(object: any): Shortcut_DocumentMode {
+  switch (object) {
+    case 0:
+    case "DOCUMENT_MODE_UNSPECIFIED":
+      return Shortcut_DocumentMode.DOCUMENT_MODE_UNSPECIFIED;
+    case 1:
+    case "INLINE":
+      return Shortcut_DocumentMode.INLINE;
+    case 2:
+    case "DOWNLOAD":
+      return Shortcut_DocumentMode.DOWNLOAD;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Shortcut_DocumentMode.UNRECOGNIZED;
+  }
+}
+
+export function shortcut_DocumentModeToNumber(object: Shortcut_DocumentMode): number {
+  switch (object) {
+    case Shortcut_DocumentMode.DOCUMENT_MODE_UNSPECIFIED:
+      return 0;
+    case Shortcut_DocumentMode.INLINE:
+      return 1;
+    case Shortcut_DocumentMode.DOWNLOAD:
+      return 2;
+    case Shortcut_DocumentMode.UNRECOGNIZED:
+    default:
+      return -1;
+  }
 }
 
 export interface Shortcut_OpenGraphMetadata {
   title: string;
   description: string;
   image: string;
+  /** The url of the favicon of the link, fetched with the metadata. */
+  favicon: string;
+}
+
+export interface Shortcut_ClickGoal {
+  /** The target view count. 0 means no goal. */
+  target: number;
+  /**
+   * The webhook url to post to when the goal is reached.
+   * Only visible to the creator and admins.
+   */
+  webhookUrl: string;
+  /** Output only. The time the goal was reached. */
+  reachedTime?: Date | undefined;
+}
+
+export interface Shortcut_QueryParam {
+  key: string;
+  /**
+   * The value template, where {name} is replaced by the shortcut name,
+   * and {collection} by the name of the collection the shortcut is opened from, or empty.
+   */
+  value: string;
+}
+
+export interface Shortcut_RedirectRule {
+  /**
+   * The ISO 3166-1 alpha-2 codes of the countries, e.g. "DE". The country of the visitor is read from the headers
+   * of the proxy, e.g. CF-IPCountry of Cloudflare.
+   */
+  countries: string[];
+  /** The languages matching the preferred language of the Accept-Language header, e.g. "pt-BR", or its base language, e.g. "pt". */
+  languages: string[];
+  devices: Shortcut_RedirectRule_DeviceType[];
+  link: string;
+}
+
+export enum Shortcut_RedirectRule_DeviceType {
+  DEVICE_TYPE_UNSPECIFIED = "DEVICE_TYPE_UNSPECIFIED",
+  DESKTOP = "DESKTOP",
+  MOBILE = "MOBILE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function shortcut_RedirectRule_DeviceTypeFromJSON(object: any): Shortcut_RedirectRule_DeviceType {
+  switch (object) {
+    case 0:
+    case "DEVICE_TYPE_UNSPECIFIED":
+      return Shortcut_RedirectRule_DeviceType.DEVICE_TYPE_UNSPECIFIED;
+    case 1:
+    case "DESKTOP":
+      return Shortcut_RedirectRule_DeviceType.DESKTOP;
+    case 2:
+    case "MOBILE":
+      return Shortcut_RedirectRule_DeviceType.MOBILE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Shortcut_RedirectRule_DeviceType.UNRECOGNIZED;
+  }
+}
+
+export function shortcut_RedirectRule_DeviceTypeToNumber(object: Shortcut_RedirectRule_DeviceType): number {
+  switch (object) {
+    case Shortcut_RedirectRule_DeviceType.DEVICE_TYPE_UNSPECIFIED:
+      return 0;
+    case Shortcut_RedirectRule_DeviceType.DESKTOP:
+      return 1;
+    case Shortcut_RedirectRule_DeviceType.MOBILE:
+      return 2;
+    case Shortcut_RedirectRule_DeviceType.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface Shortcut_Variant {
+  /** The name of the variant in the analytics, e.g. "A". Unique among the variants of the shortcut. */
+  name: string;
+  link: string;
+  /** The relative weight of the variant, e.g. 90 and 10 send about 90% of the visits to the first variant. */
+  weight: number;
+}
+
+export interface Shortcut_LinkHealth {
+  /** The time of the last check. */
+  checkTime?:
+    | Date
+    | undefined;
+  /** The HTTP status code of the last check. 0 means the link couldn't be requested. */
+  statusCode: number;
+  /** The error of the last check. Empty means the link is healthy. */
+  error: string;
+  /** Whether the link failed its last checks in a row. */
+  broken: boolean;
 }
 
 export interface ListShortcutsRequest {
+  /** The max number of shortcuts to return. Unset or 0 returns all of them, and the max is 1000. */
+  pageSize: number;
+  /** The next_page_token of the previous response to get the next page. */
+  pageToken: string;
+  /** Filters the shortcuts by their state, ACTIVE or INACTIVE for the archived ones. Unset returns all of them. */
+  state: State;
+  /** Filters the shortcuts by the id of their creator. Unset or 0 returns the shortcuts of all the users. */
+  creatorId: number;
+  /** Returns only the shortcuts created by the current user. */
+  mineOnly: boolean;
 }
 
 export interface ListShortcutsResponse {
   shortcuts: Shortcut[];
+  /** The token of the next page. Empty when there are no more pages. */
+  nextPageToken: string;
+}
+
+export interface SearchShortcutsRequest {
+  /**
+   * The query is made of keywords and filters separated by spaces, e.g. `docs tag:eng visibility:public creator:steven`.
+   * The keywords are matched as word prefixes against the name, title, description, tags and link.
+   * The filters are `tag:<tag>`, `visibility:<workspace|public>` and `creator:<username>`.
+   */
+  query: string;
+  /** The max number of shortcuts to return. Unset or 0 returns all of them, and the max is 1000. */
+  pageSize: number;
+  /** The next_page_token of the previous response to get the next page. */
+  pageToken: string;
+}
+
+export interface SearchShortcutsResponse {
+  shortcuts: Shortcut[];
+  /** The token of the next page. Empty when there are no more pages. */
+  nextPageToken: string;
+}
+
+export interface BulkUpdateShortcutTagsRequest {
+  /** The filter of the shortcuts, with the query syntax of SearchShortcuts. Empty matches all the shortcuts. */
+  filter: string;
+  operation: BulkUpdateShortcutTagsRequest_Operation;
+  tag: string;
+  /** The new tag of REPLACE. */
+  newTag: string;
+  /** Only return the shortcuts which would be updated, without updating them. */
+  dryRun: boolean;
+}
+
+export enum BulkUpdateShortcutTagsRequest_Operation {
+  OPERATION_UNSPECIFIED = "OPERATION_UNSPECIFIED",
+  /** ADD - Add the tag to the shortcuts without it. */
+  ADD = "ADD",
+  /** REMOVE - Remove the tag from the shortcuts with it. */
+  REMOVE = "REMOVE",
+  /** REPLACE - Replace the tag with the new tag in the shortcuts with it. */
+  REPLACE = "REPLACE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function bulkUpdateShortcutTagsRequest_OperationFromJSON(object: any): BulkUpdateShortcutTagsRequest_Operation {
+  switch (object) {
+    case 0:
+    case "OPERATION_UNSPECIFIED":
+      return BulkUpdateShortcutTagsRequest_Operation.OPERATION_UNSPECIFIED;
+    case 1:
+    case "ADD":
+      return BulkUpdateShortcutTagsRequest_Operation.ADD;
+    case 2:
+    case "REMOVE":
+      return BulkUpdateShortcutTagsRequest_Operation.REMOVE;
+    case 3:
+    case "REPLACE":
+      return BulkUpdateShortcutTagsRequest_Operation.REPLACE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return BulkUpdateShortcutTagsRequest_Operation.UNRECOGNIZED;
+  }
+}
+
+export function bulkUpdateShortcutTagsRequest_OperationToNumber(object: BulkUpdateShortcutTagsRequest_Operation): number {
+  switch (object) {
+    case BulkUpdateShortcutTagsRequest_Operation.OPERATION_UNSPECIFIED:
+      return 0;
+    case BulkUpdateShortcutTagsRequest_Operation.ADD:
+      return 1;
+    case BulkUpdateShortcutTagsRequest_Operation.REMOVE:
+      return 2;
+    case BulkUpdateShortcutTagsRequest_Operation.REPLACE:
+      return 3;
+    case BulkUpdateShortcutTagsRequest_Operation.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface BulkUpdateShortcutTagsResponse {
+  /** The number of shortcuts updated, or which would be updated in a dry run. */
+  affectedCount: number;
+  /** A sample of the affected shortcuts, with their tags after the update. */
+  sample: Shortcut[];
+}
+
+export interface MergeShortcutsRequest {
+  /** The ids of the duplicate shortcuts, including the survivor. */
+  ids: number[];
+  /** The id of the shortcut to keep. Unset picks the most viewed shortcut, then the oldest. */
+  survivorId: number;
+}
+
+export interface ValidateLinksRequest {
+  /** The links to validate, at most 100. */
+  links: string[];
+  /** Whether to request the links to check they're reachable, which is slower. */
+  checkReachability: boolean;
+}
+
+export interface ValidateLinksResponse {
+  /** The results in the order of the links. */
+  results: ValidateLinksResponse_Result[];
+}
+
+export interface ValidateLinksResponse_Result {
+  link: string;
+  /**
+   * Whether the link is a url which the shortcuts redirect to.
+   * The other links are kept as plain text.
+   */
+  valid: boolean;
+  /** Why the link is not valid. */
+  error: string;
+  /** The link as it would be saved, with the query parameters denied by the workspace stripped. */
+  normalizedLink: string;
+  /** Whether the link is reachable. Only set when the reachability is checked. */
+  reachable?:
+    | boolean
+    | undefined;
+  /** The status code of the response to the link, 0 when it's not reachable at all. */
+  statusCode: number;
+  /** Why the link is not reachable. */
+  reachabilityError: string;
 }
 
 export interface GetShortcutRequest {
@@ -49,6 +394,137 @@ export interface GetShortcutByNameRequest {
   name: string;
 }
 
+/** ShortcutNotFoundDetails are the details of the not found error of GetShortcutByName. */
+export interface ShortcutNotFoundDetails {
+  name: string;
+  /** The names of the shortcuts close to the name, the closest first. */
+  suggestions: string[];
+}
+
+export interface ListShortcutSuggestionsRequest {
+  name: string;
+}
+
+export interface ListShortcutSuggestionsResponse {
+  /** The names of the shortcuts close to the name, the closest first. */
+  suggestions: string[];
+}
+
+export interface ResolvePreviewRequest {
+  /** The name visited at /s/{name}, which may be an alias of the shortcut. */
+  name: string;
+  /** The simulated context of the visit. */
+  context?: ResolveContext | undefined;
+}
+
+export interface ResolveContext {
+  /**
+   * The device of the visitor, "mobile" or "desktop", which the redirect rules match.
+   * Unset matches none of the rules with devices.
+   */
+  device: string;
+  /** The ISO 3166-1 alpha-2 country code of the visitor, e.g. "US", which the redirect rules match. */
+  country: string;
+  /** The time of the visit. Unset means now. */
+  time?:
+    | Date
+    | undefined;
+  /** The name of the collection the shortcut is opened from, if any. */
+  collection: string;
+  /** The query string of the visit, e.g. "q=slash", which is passed on to the target. */
+  query: string;
+  /** The Accept-Language header of the visitor, e.g. "pt-BR,en;q=0.8", which the redirect rules match. */
+  language: string;
+}
+
+export interface ResolvePreviewResponse {
+  outcome: ResolvePreviewResponse_Outcome;
+  /** The url redirected to, or the link shown as plain text. */
+  target: string;
+  /** The shortcut the name resolves to. Unset when it doesn't exist. */
+  shortcut?:
+    | Shortcut
+    | undefined;
+  /** Why the shortcut resolves this way, e.g. "resolved by the alias of docs". */
+  reason: string;
+  /** The names of the shortcuts close to the name, the closest first. Only set when the outcome is NOT_FOUND. */
+  suggestions: string[];
+  /**
+   * The HTTP status code of the redirect when the outcome is REDIRECT.
+   * 0 means the shortcut page redirects in the browser.
+   */
+  redirectCode: number;
+}
+
+export enum ResolvePreviewResponse_Outcome {
+  OUTCOME_UNSPECIFIED = "OUTCOME_UNSPECIFIED",
+  /** REDIRECT - Redirects to the target. */
+  REDIRECT = "REDIRECT",
+  /** PLAIN_TEXT - The link is not a url, so it's shown as plain text. */
+  PLAIN_TEXT = "PLAIN_TEXT",
+  /** NOT_ACTIVE - The shortcut is scheduled to activate after the time of the visit. */
+  NOT_ACTIVE = "NOT_ACTIVE",
+  /** EXPIRED - The shortcut is expired or archived at the time of the visit. */
+  EXPIRED = "EXPIRED",
+  /** FALLBACK_REDIRECT - The shortcut doesn't exist and the visit is redirected to the fallback url of the workspace. */
+  FALLBACK_REDIRECT = "FALLBACK_REDIRECT",
+  /** NOT_FOUND - The shortcut doesn't exist and the not found page is shown. */
+  NOT_FOUND = "NOT_FOUND",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function resolvePreviewResponse_OutcomeFromJSON(object: any): ResolvePreviewResponse_Outcome {
+  switch (object) {
+    case 0:
+    case "OUTCOME_UNSPECIFIED":
+      return ResolvePreviewResponse_Outcome.OUTCOME_UNSPECIFIED;
+    case 1:
+    case "REDIRECT":
+      return ResolvePreviewResponse_Outcome.REDIRECT;
+    case 2:
+    case "PLAIN_TEXT":
+      return ResolvePreviewResponse_Outcome.PLAIN_TEXT;
+    case 3:
+    case "NOT_ACTIVE":
+      return ResolvePreviewResponse_Outcome.NOT_ACTIVE;
+    case 4:
+    case "EXPIRED":
+      return ResolvePreviewResponse_Outcome.EXPIRED;
+    case 5:
+    case "FALLBACK_REDIRECT":
+      return ResolvePreviewResponse_Outcome.FALLBACK_REDIRECT;
+    case 6:
+    case "NOT_FOUND":
+      return ResolvePreviewResponse_Outcome.NOT_FOUND;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ResolvePreviewResponse_Outcome.UNRECOGNIZED;
+  }
+}
+
+export function resolvePreviewResponse_OutcomeToNumber(object: ResolvePreviewResponse_Outcome): number {
+  switch (object) {
+    case ResolvePreviewResponse_Outcome.OUTCOME_UNSPECIFIED:
+      return 0;
+    case ResolvePreviewResponse_Outcome.REDIRECT:
+      return 1;
+    case ResolvePreviewResponse_Outcome.PLAIN_TEXT:
+      return 2;
+    case ResolvePreviewResponse_Outcome.NOT_ACTIVE:
+      return 3;
+    case ResolvePreviewResponse_Outcome.EXPIRED:
+      return 4;
+    case ResolvePreviewResponse_Outcome.FALLBACK_REDIRECT:
+      return 5;
+    case ResolvePreviewResponse_Outcome.NOT_FOUND:
+      return 6;
+    case ResolvePreviewResponse_Outcome.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 export interface CreateShortcutRequest {
   shortcut?: Shortcut | undefined;
 }
@@ -62,14 +538,79 @@ export interface DeleteShortcutRequest {
   id: number;
 }
 
+export interface TransferShortcutRequest {
+  id: number;
+  /** The id of the user to transfer the shortcut to. */
+  userId: number;
+}
+
 export interface GetShortcutAnalyticsRequest {
   id: number;
+  /** The interval of the timeseries. Defaults to DAY. */
+  interval: GetShortcutAnalyticsRequest_Interval;
+}
+
+export enum GetShortcutAnalyticsRequest_Interval {
+  INTERVAL_UNSPECIFIED = "INTERVAL_UNSPECIFIED",
+  DAY = "DAY",
+  /** WEEK - Weeks start on Monday in UTC. */
+  WEEK = "WEEK",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function getShortcutAnalyticsRequest_IntervalFromJSON(object: any): GetShortcutAnalyticsRequest_Interval {
+  switch (object) {
+    case 0:
+    case "INTERVAL_UNSPECIFIED":
+      return GetShortcutAnalyticsRequest_Interval.INTERVAL_UNSPECIFIED;
+    case 1:
+    case "DAY":
+      return GetShortcutAnalyticsRequest_Interval.DAY;
+    case 2:
+    case "WEEK":
+      return GetShortcutAnalyticsRequest_Interval.WEEK;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return GetShortcutAnalyticsRequest_Interval.UNRECOGNIZED;
+  }
+}
+
+export function getShortcutAnalyticsRequest_IntervalToNumber(object: GetShortcutAnalyticsRequest_Interval): number {
+  switch (object) {
+    case GetShortcutAnalyticsRequest_Interval.INTERVAL_UNSPECIFIED:
+      return 0;
+    case GetShortcutAnalyticsRequest_Interval.DAY:
+      return 1;
+    case GetShortcutAnalyticsRequest_Interval.WEEK:
+      return 2;
+    case GetShortcutAnalyticsRequest_Interval.UNRECOGNIZED:
+    default:
+      return -1;
+  }
 }
 
 export interface GetShortcutAnalyticsResponse {
   references: GetShortcutAnalyticsResponse_AnalyticsItem[];
   devices: GetShortcutAnalyticsResponse_AnalyticsItem[];
   browsers: GetShortcutAnalyticsResponse_AnalyticsItem[];
+  /** The progress of the click goal, empty when the shortcut has no goal. */
+  clickGoalProgress?:
+    | GetShortcutAnalyticsResponse_ClickGoalProgress
+    | undefined;
+  /** The view counts per interval, ordered by time. */
+  timeseries: GetShortcutAnalyticsResponse_TimeseriesItem[];
+  countries: GetShortcutAnalyticsResponse_AnalyticsItem[];
+  /**
+   * The views per signed-in user by email, recorded when the workspace attributes the views to the users.
+   * The views of the visitors not signed in are named empty. Only visible to admins.
+   */
+  users: GetShortcutAnalyticsResponse_AnalyticsItem[];
+  /**
+   * The views per variant of the A/B split by name, recorded since the shortcut has variants.
+   * The views without a variant are named empty.
+   */
+  variants: GetShortcutAnalyticsResponse_AnalyticsItem[];
 }
 
 export interface GetShortcutAnalyticsResponse_AnalyticsItem {
@@ -77,12 +618,585 @@ export interface GetShortcutAnalyticsResponse_AnalyticsItem {
   count: number;
 }
 
+export interface GetShortcutAnalyticsResponse_ClickGoalProgress {
+  target: number;
+  /** The total view count of the shortcut. */
+  viewCount: number;
+  reachedTime?: Date | undefined;
+}
+
+export interface GetShortcutAnalyticsResponse_TimeseriesItem {
+  /** The start time of the interval. */
+  startTime?: Date | undefined;
+  count: number;
+}
+
+/** ShortcutAnalyticsShare is a read-only link to view the analytics of a shortcut without an account. */
+export interface ShortcutAnalyticsShare {
+  id: number;
+  shortcutId: number;
+  creatorId: number;
+  createdTime?:
+    | Date
+    | undefined;
+  /** Whom the link is shared with, e.g. a client. */
+  description: string;
+  /** The secret of the share link, which is opened at /analytics/{token}. */
+  token: string;
+  expireTime?:
+    | Date
+    | undefined;
+  /** The number of times the share link was opened. */
+  viewCount: number;
+  lastViewedTime?: Date | undefined;
+}
+
+export interface CreateShortcutAnalyticsShareRequest {
+  shortcutId: number;
+  description: string;
+  /** The expiration time of the share link. Defaults to 7 days later, and the max is 90 days later. */
+  expireTime?: Date | undefined;
+}
+
+export interface ListShortcutAnalyticsSharesRequest {
+  shortcutId: number;
+}
+
+export interface ListShortcutAnalyticsSharesResponse {
+  shares: ShortcutAnalyticsShare[];
+}
+
+export interface DeleteShortcutAnalyticsShareRequest {
+  shortcutId: number;
+  id: number;
+}
+
+export interface GetSharedShortcutAnalyticsRequest {
+  token: string;
+  /** The interval of the timeseries. Defaults to DAY. */
+  interval: GetShortcutAnalyticsRequest_Interval;
+}
+
+export interface SharedShortcutAnalytics {
+  /** The name of the shortcut. Its link and the other details are not shared. */
+  shortcutName: string;
+  shortcutTitle: string;
+  analytics?: GetShortcutAnalyticsResponse | undefined;
+  expireTime?: Date | undefined;
+}
+
+export interface GetShortcutQRCodeRequest {
+  id: number;
+  /** The format of the image. Defaults to PNG. */
+  format: GetShortcutQRCodeRequest_Format;
+  /** The width and height of the image in pixels. Defaults to 256, between 64 and 2048. */
+  size: number;
+}
+
+export enum GetShortcutQRCodeRequest_Format {
+  FORMAT_UNSPECIFIED = "FORMAT_UNSPECIFIED",
+  PNG = "PNG",
+  SVG = "SVG",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function getShortcutQRCodeRequest_FormatFromJSON(object: any): GetShortcutQRCodeRequest_Format {
+  switch (object) {
+    case 0:
+    case "FORMAT_UNSPECIFIED":
+      return GetShortcutQRCodeRequest_Format.FORMAT_UNSPECIFIED;
+    case 1:
+    case "PNG":
+      return GetShortcutQRCodeRequest_Format.PNG;
+    case 2:
+    case "SVG":
+      return GetShortcutQRCodeRequest_Format.SVG;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return GetShortcutQRCodeRequest_Format.UNRECOGNIZED;
+  }
+}
+
+export function getShortcutQRCodeRequest_FormatToNumber(object: GetShortcutQRCodeRequest_Format): number {
+  switch (object) {
+    case GetShortcutQRCodeRequest_Format.FORMAT_UNSPECIFIED:
+      return 0;
+    case GetShortcutQRCodeRequest_Format.PNG:
+      return 1;
+    case GetShortcutQRCodeRequest_Format.SVG:
+      return 2;
+    case GetShortcutQRCodeRequest_Format.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface GetShortcutQRCodeResponse {
+  /** The content type of the image, e.g. image/png. */
+  contentType: string;
+  /** The image. */
+  content: Uint8Array;
+}
+
+export interface ListBrokenShortcutsRequest {
+}
+
+export interface ListBrokenShortcutsResponse {
+  /** The broken shortcuts, the most recently checked first. */
+  shortcuts: Shortcut[];
+}
+
+export interface RefreshShortcutMetadataRequest {
+  id: number;
+}
+
+export interface SuggestShortcutRequest {
+  /** The link of the shortcut, whose page is summarized. */
+  link: string;
+}
+
+export interface SuggestShortcutResponse {
+  title: string;
+  description: string;
+  tags: string[];
+}
+
+export interface SemanticSearchShortcutsRequest {
+  /** The query in natural language. */
+  query: string;
+  /** The max number of shortcuts to return. Unset or 0 returns 10, and the max is 50. */
+  limit: number;
+}
+
+export interface SemanticSearchShortcutsResponse {
+  /** The results, the closest first. The shortcuts not embedded yet aren't returned. */
+  results: SemanticSearchShortcutsResponse_Result[];
+}
+
+export interface SemanticSearchShortcutsResponse_Result {
+  shortcut?:
+    | Shortcut
+    | undefined;
+  /** The cosine similarity of the embeddings of the shortcut and the query, between -1 and 1. */
+  score: number;
+}
+
+export interface GenerateSignedRedirectRequest {
+  id: number;
+  /** The time the signed short link expires. Unset means in 7 days, and the max is in 90 days. */
+  expireTime?: Date | undefined;
+}
+
+export interface GenerateSignedRedirectResponse {
+  /**
+   * The signed short link on the instance url, e.g. "https://slash.example.com/s/invoice?exp=1767225600&sig=...".
+   * The query parameters added to it are passed to the link as usual.
+   */
+  url: string;
+  expireTime?: Date | undefined;
+}
+
+export interface GetResolutionSnapshotRequest {
+  /** The version of the snapshot the client has, to get the delta from it. Empty for a full snapshot. */
+  sinceVersion: string;
+}
+
+export interface ResolutionSnapshot {
+  /** The version of the snapshot, a hash of its links. It doesn't change while the links don't. */
+  version: string;
+  /**
+   * The version the delta applies to, i.e. since_version. Empty for a full snapshot, which is returned
+   * when the server doesn't have the snapshot of since_version anymore, e.g. after a restart.
+   */
+  baseVersion: string;
+  /**
+   * The links by the names and the aliases of the shortcuts which redirect now.
+   * In a delta, only the added and changed ones.
+   */
+  links: { [key: string]: string };
+  /** The names removed since base_version, only in a delta. */
+  removedNames: string[];
+  /**
+   * The Ed25519 signature of the full snapshot at this version, i.e. with the delta applied.
+   * The signed payload is "slash-snapshot-v1\n{version}\n" followed by a "{name}\t{link}\n" line
+   * per link, sorted by name.
+   */
+  signature: Uint8Array;
+  /** The public key of the workspace to verify the signature with. It changes with the workspace secret. */
+  publicKey: Uint8Array;
+  createTime?: Date | undefined;
+}
+
+export interface ResolutionSnapshot_LinksEntry {
+  key: string;
+  value: string;
+}
+
+export interface GetTrendingShortcutsRequest {
+  /** The window to compare with the previous one. Defaults to DAY. */
+  window: GetTrendingShortcutsRequest_Window;
+  /** The max number of shortcuts to return. Defaults to 10, and the max is 50. */
+  limit: number;
+}
+
+export enum GetTrendingShortcutsRequest_Window {
+  WINDOW_UNSPECIFIED = "WINDOW_UNSPECIFIED",
+  DAY = "DAY",
+  WEEK = "WEEK",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function getTrendingShortcutsRequest_WindowFromJSON(object: any): GetTrendingShortcutsRequest_Window {
+  switch (object) {
+    case 0:
+    case "WINDOW_UNSPECIFIED":
+      return GetTrendingShortcutsRequest_Window.WINDOW_UNSPECIFIED;
+    case 1:
+    case "DAY":
+      return GetTrendingShortcutsRequest_Window.DAY;
+    case 2:
+    case "WEEK":
+      return GetTrendingShortcutsRequest_Window.WEEK;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return GetTrendingShortcutsRequest_Window.UNRECOGNIZED;
+  }
+}
+
+export function getTrendingShortcutsRequest_WindowToNumber(object: GetTrendingShortcutsRequest_Window): number {
+  switch (object) {
+    case GetTrendingShortcutsRequest_Window.WINDOW_UNSPECIFIED:
+      return 0;
+    case GetTrendingShortcutsRequest_Window.DAY:
+      return 1;
+    case GetTrendingShortcutsRequest_Window.WEEK:
+      return 2;
+    case GetTrendingShortcutsRequest_Window.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface GetTrendingShortcutsResponse {
+  trendingShortcuts: GetTrendingShortcutsResponse_TrendingShortcut[];
+}
+
+export interface GetTrendingShortcutsResponse_TrendingShortcut {
+  shortcut?:
+    | Shortcut
+    | undefined;
+  /** The view count in the current window. */
+  viewCount: number;
+  /** The view count in the previous window. */
+  previousViewCount: number;
+}
+
+export interface ProposedChange {
+  id: number;
+  shortcutId: number;
+  /** The id of the user who proposed the change. */
+  proposerId: number;
+  /** The username of the user who proposed the change. */
+  proposerUsername: string;
+  createdTime?: Date | undefined;
+  status: ProposedChange_Status;
+  /** The changed fields, to show as a diff. */
+  changes: ProposedChange_FieldChange[];
+  /** The id of the user who approved or rejected the change. */
+  reviewerId: number;
+  reviewedTime?: Date | undefined;
+}
+
+export enum ProposedChange_Status {
+  STATUS_UNSPECIFIED = "STATUS_UNSPECIFIED",
+  /** PENDING - The change waits for the approval of the creator or an admin. */
+  PENDING = "PENDING",
+  /** APPROVED - The change is applied to the shortcut. */
+  APPROVED = "APPROVED",
+  REJECTED = "REJECTED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function proposedChange_StatusFromJSON(object: any): ProposedChange_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return ProposedChange_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "PENDING":
+      return ProposedChange_Status.PENDING;
+    case 2:
+    case "APPROVED":
+      return ProposedChange_Status.APPROVED;
+    case 3:
+    case "REJECTED":
+      return ProposedChange_Status.REJECTED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ProposedChange_Status.UNRECOGNIZED;
+  }
+}
+
+export function proposedChange_StatusToNumber(object: ProposedChange_Status): number {
+  switch (object) {
+    case ProposedChange_Status.STATUS_UNSPECIFIED:
+      return 0;
+    case ProposedChange_Status.PENDING:
+      return 1;
+    case ProposedChange_Status.APPROVED:
+      return 2;
+    case ProposedChange_Status.REJECTED:
+      return 3;
+    case ProposedChange_Status.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ProposedChange_FieldChange {
+  /** The field, as the path of the update mask, e.g. "link". */
+  field: string;
+  /** The value when the change was proposed. The tags are separated by spaces. */
+  previousValue: string;
+  proposedValue: string;
+}
+
+export interface ListProposedChangesRequest {
+  shortcutId: number;
+  /** Filters the changes by status. Unspecified returns all of them. */
+  status: ProposedChange_Status;
+}
+
+export interface ListProposedChangesResponse {
+  proposedChanges: ProposedChange[];
+}
+
+export interface ApproveProposedChangeRequest {
+  shortcutId: number;
+  id: number;
+}
+
+export interface RejectProposedChangeRequest {
+  shortcutId: number;
+  id: number;
+}
+
+/**
+ * ShortcutRotation is a scheduled link of a shortcut. From its start time until its end time, the shortcut resolves
+ * to its link instead. When rotations overlap, the one started last wins.
+ */
+export interface ShortcutRotation {
+  id: number;
+  shortcutId: number;
+  creatorId: number;
+  createdTime?: Date | undefined;
+  link: string;
+  startTime?:
+    | Date
+    | undefined;
+  /** The time the rotation stops. Empty means until a later rotation starts. */
+  endTime?: Date | undefined;
+}
+
+export interface ListShortcutRotationsRequest {
+  shortcutId: number;
+}
+
+export interface ListShortcutRotationsResponse {
+  rotations: ShortcutRotation[];
+}
+
+export interface CreateShortcutRotationRequest {
+  shortcutId: number;
+  rotation?: ShortcutRotation | undefined;
+}
+
+export interface DeleteShortcutRotationRequest {
+  shortcutId: number;
+  id: number;
+}
+
+/** ShortcutACL shares a shortcut with a user, whatever the visibility of the shortcut. */
+export interface ShortcutACL {
+  shortcutId: number;
+  userId: number;
+  role: ShortcutACL_Role;
+  createdTime?: Date | undefined;
+}
+
+export enum ShortcutACL_Role {
+  ROLE_UNSPECIFIED = "ROLE_UNSPECIFIED",
+  /** READ - The user can view the shortcut and be redirected by it. */
+  READ = "READ",
+  /** EDIT - The user can also update the shortcut, except its visibility and protection. */
+  EDIT = "EDIT",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function shortcutACL_RoleFromJSON(object: any): ShortcutACL_Role {
+  switch (object) {
+    case 0:
+    case "ROLE_UNSPECIFIED":
+      return ShortcutACL_Role.ROLE_UNSPECIFIED;
+    case 1:
+    case "READ":
+      return ShortcutACL_Role.READ;
+    case 2:
+    case "EDIT":
+      return ShortcutACL_Role.EDIT;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ShortcutACL_Role.UNRECOGNIZED;
+  }
+}
+
+export function shortcutACL_RoleToNumber(object: ShortcutACL_Role): number {
+  switch (object) {
+    case ShortcutACL_Role.ROLE_UNSPECIFIED:
+      return 0;
+    case ShortcutACL_Role.READ:
+      return 1;
+    case ShortcutACL_Role.EDIT:
+      return 2;
+    case ShortcutACL_Role.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ListShortcutACLsRequest {
+  shortcutId: number;
+}
+
+export interface ListShortcutACLsResponse {
+  acls: ShortcutACL[];
+}
+
+export interface UpsertShortcutACLRequest {
+  shortcutId: number;
+  acl?: ShortcutACL | undefined;
+}
+
+export interface DeleteShortcutACLRequest {
+  shortcutId: number;
+  userId: number;
+}
+
+export interface CreateImportJobRequest {
+  /** The format of the file, "csv" for the columns of the CSV export, or "netscape" for a bookmark file. */
+  format: string;
+  /** The content of the file. */
+  content: string;
+}
+
+export interface GetImportJobRequest {
+  id: number;
+}
+
+export interface ListImportJobsRequest {
+}
+
+export interface ListImportJobsResponse {
+  importJobs: ImportJob[];
+}
+
+export interface ResumeImportJobRequest {
+  id: number;
+}
+
+export interface ImportJob {
+  id: number;
+  creatorId: number;
+  createdTime?:
+    | Date
+    | undefined;
+  /** The time of the last progress of the job. */
+  updatedTime?: Date | undefined;
+  status: ImportJob_Status;
+  format: string;
+  totalRows: number;
+  /** The number of rows processed, created or failed. The job resumes from the next one. */
+  processedRows: number;
+  createdCount: number;
+  failedCount: number;
+  /** The errors of the rows which failed, e.g. with a taken name, at most the first 1000. */
+  rowErrors: ImportJob_RowError[];
+  /** The reason the job failed. */
+  error: string;
+}
+
+export enum ImportJob_Status {
+  STATUS_UNSPECIFIED = "STATUS_UNSPECIFIED",
+  /** PENDING - The job waits to be run. */
+  PENDING = "PENDING",
+  RUNNING = "RUNNING",
+  /** SUCCEEDED - All the rows are processed, some may have failed. */
+  SUCCEEDED = "SUCCEEDED",
+  /** FAILED - The job stopped at a row, e.g. the database was unavailable. It can be resumed. */
+  FAILED = "FAILED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function importJob_StatusFromJSON(object: any): ImportJob_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return ImportJob_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "PENDING":
+      return ImportJob_Status.PENDING;
+    case 2:
+    case "RUNNING":
+      return ImportJob_Status.RUNNING;
+    case 3:
+    case "SUCCEEDED":
+      return ImportJob_Status.SUCCEEDED;
+    case 4:
+    case "FAILED":
+      return ImportJob_Status.FAILED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ImportJob_Status.UNRECOGNIZED;
+  }
+}
+
+export function importJob_StatusToNumber(object: ImportJob_Status): number {
+  switch (object) {
+    case ImportJob_Status.STATUS_UNSPECIFIED:
+      return 0;
+    case ImportJob_Status.PENDING:
+      return 1;
+    case ImportJob_Status.RUNNING:
+      return 2;
+    case ImportJob_Status.SUCCEEDED:
+      return 3;
+    case ImportJob_Status.FAILED:
+      return 4;
+    case ImportJob_Status.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ImportJob_RowError {
+  /** The 1-based index of the row. */
+  row: number;
+  name: string;
+  error: string;
+}
+
 function createBaseShortcut(): Shortcut {
   return {
     id: 0,
     creatorId: 0,
     createdTime: undefined,
     updatedTime: undefined,
+    state: State.STATE_UNSPECIFIED,
     name: "",
     link: "",
     title: "",
@@ -91,6 +1205,21 @@ function createBaseShortcut(): Shortcut {
     visibility: Visibility.VISIBILITY_UNSPECIFIED,
     viewCount: 0,
     ogMetadata: undefined,
+    creatorUsername: "",
+    clickGoal: undefined,
+    expireTime: undefined,
+    queryParams: [],
+    activateTime: undefined,
+    aliases: [],
+    protected: false,
+    currentLink: "",
+    teamId: 0,
+    redirectCode: 0,
+    linkHealth: undefined,
+    documentMode: Shortcut_DocumentMode.DOCUMENT_MODE_UNSPECIFIED,
+    internalDescription: false,
+    redirectRules: [],
+    variants: [],
   };
 }
 
@@ -108,6 +1237,9 @@ export const Shortcut: MessageFns<Shortcut> = {
     if (message.updatedTime !== undefined) {
       Timestamp.encode(toTimestamp(message.updatedTime), writer.uint32(34).fork()).join();
     }
+    if (message.state !== State.STATE_UNSPECIFIED) {
+      writer.uint32(40).int32(stateToNumber(message.state));
+    }
     if (message.name !== "") {
       writer.uint32(50).string(message.name);
     }
@@ -132,17 +1264,62 @@ export const Shortcut: MessageFns<Shortcut> = {
     if (message.ogMetadata !== undefined) {
       Shortcut_OpenGraphMetadata.encode(message.ogMetadata, writer.uint32(106).fork()).join();
     }
-    return writer;
-  },
-
-  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseShortcut();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
+    if (message.creatorUsername !== "") {
+      writer.uint32(114).string(message.creatorUsername);
+    }
+    if (message.clickGoal !== undefined) {
+      Shortcut_ClickGoal.encode(message.clickGoal, writer.uint32(122).fork()).join();
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(130).fork()).join();
+    }
+    for (const v of message.queryParams) {
+      Shortcut_QueryParam.encode(v!, writer.uint32(138).fork()).join();
+    }
+    if (message.activateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.activateTime), writer.uint32(146).fork()).join();
+    }
+    for (const v of message.aliases) {
+      writer.uint32(154).string(v!);
+    }
+    if (message.protected !== false) {
+      writer.uint32(160).bool(message.protected);
+    }
+    if (message.currentLink !== "") {
+      writer.uint32(170).string(message.currentLink);
+    }
+    if (message.teamId !== 0) {
+      writer.uint32(176).int32(message.teamId);
+    }
+    if (message.redirectCode !== 0) {
+      writer.uint32(184).int32(message.redirectCode);
+    }
+    if (message.linkHealth !== undefined) {
+      Shortcut_LinkHealth.encode(message.linkHealth, writer.uint32(194).fork()).join();
+    }
+    if (message.documentMode !== Shortcut_DocumentMode.DOCUMENT_MODE_UNSPECIFIED) {
+      writer.uint32(200).int32(shortcut_DocumentModeToNumber(message.documentMode));
+    }
+    if (message.internalDescription !== false) {
+      writer.uint32(208).bool(message.internalDescription);
+    }
+    for (const v of message.redirectRules) {
+      Shortcut_RedirectRule.encode(v!, writer.uint32(218).fork()).join();
+    }
+    for (const v of message.variants) {
+      Shortcut_Variant.encode(v!, writer.uint32(226).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcut();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
           if (tag !== 8) {
             break;
           }
@@ -174,6 +1351,14 @@ export const Shortcut: MessageFns<Shortcut> = {
           message.updatedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.state = stateFromJSON(reader.int32());
+          continue;
+        }
         case 6: {
           if (tag !== 50) {
             break;
@@ -238,6 +1423,126 @@ export const Shortcut: MessageFns<Shortcut> = {
           message.ogMetadata = Shortcut_OpenGraphMetadata.decode(reader, reader.uint32());
           continue;
         }
+        case 14: {
+          if (tag !== 114) {
+            break;
+          }
+
+          message.creatorUsername = reader.string();
+          continue;
+        }
+        case 15: {
+          if (tag !== 122) {
+            break;
+          }
+
+          message.clickGoal = Shortcut_ClickGoal.decode(reader, reader.uint32());
+          continue;
+        }
+        case 16: {
+          if (tag !== 130) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 17: {
+          if (tag !== 138) {
+            break;
+          }
+
+          message.queryParams.push(Shortcut_QueryParam.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 18: {
+          if (tag !== 146) {
+            break;
+          }
+
+          message.activateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 19: {
+          if (tag !== 154) {
+            break;
+          }
+
+          message.aliases.push(reader.string());
+          continue;
+        }
+        case 20: {
+          if (tag !== 160) {
+            break;
+          }
+
+          message.protected = reader.bool();
+          continue;
+        }
+        case 21: {
+          if (tag !== 170) {
+            break;
+          }
+
+          message.currentLink = reader.string();
+          continue;
+        }
+        case 22: {
+          if (tag !== 176) {
+            break;
+          }
+
+          message.teamId = reader.int32();
+          continue;
+        }
+        case 23: {
+          if (tag !== 184) {
+            break;
+          }
+
+          message.redirectCode = reader.int32();
+          continue;
+        }
+        case 24: {
+          if (tag !== 194) {
+            break;
+          }
+
+          message.linkHealth = Shortcut_LinkHealth.decode(reader, reader.uint32());
+          continue;
+        }
+        case 25: {
+          if (tag !== 200) {
+            break;
+          }
+
+          message.documentMode = shortcut_DocumentModeFromJSON(reader.int32());
+          continue;
+        }
+        case 26: {
+          if (tag !== 208) {
+            break;
+          }
+
+          message.internalDescription = reader.bool();
+          continue;
+        }
+        case 27: {
+          if (tag !== 218) {
+            break;
+          }
+
+          message.redirectRules.push(Shortcut_RedirectRule.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 28: {
+          if (tag !== 226) {
+            break;
+          }
+
+          message.variants.push(Shortcut_Variant.decode(reader, reader.uint32()));
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -256,6 +1561,7 @@ export const Shortcut: MessageFns<Shortcut> = {
     message.creatorId = object.creatorId ?? 0;
     message.createdTime = object.createdTime ?? undefined;
     message.updatedTime = object.updatedTime ?? undefined;
+    message.state = object.state ?? State.STATE_UNSPECIFIED;
     message.name = object.name ?? "";
     message.link = object.link ?? "";
     message.title = object.title ?? "";
@@ -266,12 +1572,31 @@ export const Shortcut: MessageFns<Shortcut> = {
     message.ogMetadata = (object.ogMetadata !== undefined && object.ogMetadata !== null)
       ? Shortcut_OpenGraphMetadata.fromPartial(object.ogMetadata)
       : undefined;
+    message.creatorUsername = object.creatorUsername ?? "";
+    message.clickGoal = (object.clickGoal !== undefined && object.clickGoal !== null)
+      ? Shortcut_ClickGoal.fromPartial(object.clickGoal)
+      : undefined;
+    message.expireTime = object.expireTime ?? undefined;
+    message.queryParams = object.queryParams?.map((e) => Shortcut_QueryParam.fromPartial(e)) || [];
+    message.activateTime = object.activateTime ?? undefined;
+    message.aliases = object.aliases?.map((e) => e) || [];
+    message.protected = object.protected ?? false;
+    message.currentLink = object.currentLink ?? "";
+    message.teamId = object.teamId ?? 0;
+    message.redirectCode = object.redirectCode ?? 0;
+    message.linkHealth = (object.linkHealth !== undefined && object.linkHealth !== null)
+      ? Shortcut_LinkHealth.fromPartial(object.linkHealth)
+      : undefined;
+    message.documentMode = object.documentMode ?? Shortcut_DocumentMode.DOCUMENT_MODE_UNSPECIFIED;
+    message.internalDescription = object.internalDescription ?? false;
+    message.redirectRules = object.redirectRules?.map((e) => Shortcut_RedirectRule.fromPartial(e)) || [];
+    message.variants = object.variants?.map((e) => Shortcut_Variant.fromPartial(e)) || [];
     return message;
   },
 };
 
 function createBaseShortcut_OpenGraphMetadata(): Shortcut_OpenGraphMetadata {
-  return { title: "", description: "", image: "" };
+  return { title: "", description: "", image: "", favicon: "" };
 }
 
 export const Shortcut_OpenGraphMetadata: MessageFns<Shortcut_OpenGraphMetadata> = {
@@ -285,6 +1610,9 @@ export const Shortcut_OpenGraphMetadata: MessageFns<Shortcut_OpenGraphMetadata>
     if (message.image !== "") {
       writer.uint32(26).string(message.image);
     }
+    if (message.favicon !== "") {
+      writer.uint32(34).string(message.favicon);
+    }
     return writer;
   },
 
@@ -319,6 +1647,14 @@ export const Shortcut_OpenGraphMetadata: MessageFns<Shortcut_OpenGraphMetadata>
           message.image = reader.string();
           continue;
         }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.favicon = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -336,26 +1672,60 @@ export const Shortcut_OpenGraphMetadata: MessageFns<Shortcut_OpenGraphMetadata>
     message.title = object.title ?? "";
     message.description = object.description ?? "";
     message.image = object.image ?? "";
+    message.favicon = object.favicon ?? "";
     return message;
   },
 };
 
-function createBaseListShortcutsRequest(): ListShortcutsRequest {
-  return {};
+function createBaseShortcut_ClickGoal(): Shortcut_ClickGoal {
+  return { target: 0, webhookUrl: "", reachedTime: undefined };
 }
 
-export const ListShortcutsRequest: MessageFns<ListShortcutsRequest> = {
-  encode(_: ListShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const Shortcut_ClickGoal: MessageFns<Shortcut_ClickGoal> = {
+  encode(message: Shortcut_ClickGoal, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.target !== 0) {
+      writer.uint32(8).int32(message.target);
+    }
+    if (message.webhookUrl !== "") {
+      writer.uint32(18).string(message.webhookUrl);
+    }
+    if (message.reachedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.reachedTime), writer.uint32(26).fork()).join();
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutsRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut_ClickGoal {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListShortcutsRequest();
+    const message = createBaseShortcut_ClickGoal();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.target = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.webhookUrl = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.reachedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -365,31 +1735,37 @@ export const ListShortcutsRequest: MessageFns<ListShortcutsRequest> = {
     return message;
   },
 
-  create(base?: DeepPartial<ListShortcutsRequest>): ListShortcutsRequest {
-    return ListShortcutsRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<Shortcut_ClickGoal>): Shortcut_ClickGoal {
+    return Shortcut_ClickGoal.fromPartial(base ?? {});
   },
-  fromPartial(_: DeepPartial<ListShortcutsRequest>): ListShortcutsRequest {
-    const message = createBaseListShortcutsRequest();
+  fromPartial(object: DeepPartial<Shortcut_ClickGoal>): Shortcut_ClickGoal {
+    const message = createBaseShortcut_ClickGoal();
+    message.target = object.target ?? 0;
+    message.webhookUrl = object.webhookUrl ?? "";
+    message.reachedTime = object.reachedTime ?? undefined;
     return message;
   },
 };
 
-function createBaseListShortcutsResponse(): ListShortcutsResponse {
-  return { shortcuts: [] };
+function createBaseShortcut_QueryParam(): Shortcut_QueryParam {
+  return { key: "", value: "" };
 }
 
-export const ListShortcutsResponse: MessageFns<ListShortcutsResponse> = {
-  encode(message: ListShortcutsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.shortcuts) {
-      Shortcut.encode(v!, writer.uint32(10).fork()).join();
+export const Shortcut_QueryParam: MessageFns<Shortcut_QueryParam> = {
+  encode(message: Shortcut_QueryParam, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutsResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut_QueryParam {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListShortcutsResponse();
+    const message = createBaseShortcut_QueryParam();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -398,7 +1774,15 @@ export const ListShortcutsResponse: MessageFns<ListShortcutsResponse> = {
             break;
           }
 
-          message.shortcuts.push(Shortcut.decode(reader, reader.uint32()));
+          message.key = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
           continue;
         }
       }
@@ -410,41 +1794,87 @@ export const ListShortcutsResponse: MessageFns<ListShortcutsResponse> = {
     return message;
   },
 
-  create(base?: DeepPartial<ListShortcutsResponse>): ListShortcutsResponse {
-    return ListShortcutsResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<Shortcut_QueryParam>): Shortcut_QueryParam {
+    return Shortcut_QueryParam.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ListShortcutsResponse>): ListShortcutsResponse {
-    const message = createBaseListShortcutsResponse();
-    message.shortcuts = object.shortcuts?.map((e) => Shortcut.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<Shortcut_QueryParam>): Shortcut_QueryParam {
+    const message = createBaseShortcut_QueryParam();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBaseGetShortcutRequest(): GetShortcutRequest {
-  return { id: 0 };
+function createBaseShortcut_RedirectRule(): Shortcut_RedirectRule {
+  return { countries: [], languages: [], devices: [], link: "" };
 }
 
-export const GetShortcutRequest: MessageFns<GetShortcutRequest> = {
-  encode(message: GetShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).int32(message.id);
+export const Shortcut_RedirectRule: MessageFns<Shortcut_RedirectRule> = {
+  encode(message: Shortcut_RedirectRule, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.countries) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.languages) {
+      writer.uint32(18).string(v!);
+    }
+    writer.uint32(26).fork();
+    for (const v of message.devices) {
+      writer.int32(shortcut_RedirectRule_DeviceTypeToNumber(v));
+    }
+    writer.join();
+    if (message.link !== "") {
+      writer.uint32(34).string(message.link);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut_RedirectRule {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetShortcutRequest();
+    const message = createBaseShortcut_RedirectRule();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = reader.int32();
+          message.countries.push(reader.string());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.languages.push(reader.string());
+          continue;
+        }
+        case 3: {
+          if (tag === 24) {
+            message.devices.push(shortcut_RedirectRule_DeviceTypeFromJSON(reader.int32()));
+
+            continue;
+          }
+
+          if (tag === 26) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.devices.push(shortcut_RedirectRule_DeviceTypeFromJSON(reader.int32()));
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.link = reader.string();
           continue;
         }
       }
@@ -456,32 +1886,41 @@ export const GetShortcutRequest: MessageFns<GetShortcutRequest> = {
     return message;
   },
 
-  create(base?: DeepPartial<GetShortcutRequest>): GetShortcutRequest {
-    return GetShortcutRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<Shortcut_RedirectRule>): Shortcut_RedirectRule {
+    return Shortcut_RedirectRule.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GetShortcutRequest>): GetShortcutRequest {
-    const message = createBaseGetShortcutRequest();
-    message.id = object.id ?? 0;
+  fromPartial(object: DeepPartial<Shortcut_RedirectRule>): Shortcut_RedirectRule {
+    const message = createBaseShortcut_RedirectRule();
+    message.countries = object.countries?.map((e) => e) || [];
+    message.languages = object.languages?.map((e) => e) || [];
+    message.devices = object.devices?.map((e) => e) || [];
+    message.link = object.link ?? "";
     return message;
   },
 };
 
-function createBaseGetShortcutByNameRequest(): GetShortcutByNameRequest {
-  return { name: "" };
+function createBaseShortcut_Variant(): Shortcut_Variant {
+  return { name: "", link: "", weight: 0 };
 }
 
-export const GetShortcutByNameRequest: MessageFns<GetShortcutByNameRequest> = {
-  encode(message: GetShortcutByNameRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const Shortcut_Variant: MessageFns<Shortcut_Variant> = {
+  encode(message: Shortcut_Variant, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
+    if (message.link !== "") {
+      writer.uint32(18).string(message.link);
+    }
+    if (message.weight !== 0) {
+      writer.uint32(24).int32(message.weight);
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutByNameRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut_Variant {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetShortcutByNameRequest();
+    const message = createBaseShortcut_Variant();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -493,6 +1932,22 @@ export const GetShortcutByNameRequest: MessageFns<GetShortcutByNameRequest> = {
           message.name = reader.string();
           continue;
         }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.weight = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -502,32 +1957,43 @@ export const GetShortcutByNameRequest: MessageFns<GetShortcutByNameRequest> = {
     return message;
   },
 
-  create(base?: DeepPartial<GetShortcutByNameRequest>): GetShortcutByNameRequest {
-    return GetShortcutByNameRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<Shortcut_Variant>): Shortcut_Variant {
+    return Shortcut_Variant.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GetShortcutByNameRequest>): GetShortcutByNameRequest {
-    const message = createBaseGetShortcutByNameRequest();
+  fromPartial(object: DeepPartial<Shortcut_Variant>): Shortcut_Variant {
+    const message = createBaseShortcut_Variant();
     message.name = object.name ?? "";
+    message.link = object.link ?? "";
+    message.weight = object.weight ?? 0;
     return message;
   },
 };
 
-function createBaseCreateShortcutRequest(): CreateShortcutRequest {
-  return { shortcut: undefined };
+function createBaseShortcut_LinkHealth(): Shortcut_LinkHealth {
+  return { checkTime: undefined, statusCode: 0, error: "", broken: false };
 }
 
-export const CreateShortcutRequest: MessageFns<CreateShortcutRequest> = {
-  encode(message: CreateShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.shortcut !== undefined) {
-      Shortcut.encode(message.shortcut, writer.uint32(10).fork()).join();
+export const Shortcut_LinkHealth: MessageFns<Shortcut_LinkHealth> = {
+  encode(message: Shortcut_LinkHealth, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.checkTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.checkTime), writer.uint32(10).fork()).join();
+    }
+    if (message.statusCode !== 0) {
+      writer.uint32(16).int32(message.statusCode);
+    }
+    if (message.error !== "") {
+      writer.uint32(26).string(message.error);
+    }
+    if (message.broken !== false) {
+      writer.uint32(32).bool(message.broken);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): CreateShortcutRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut_LinkHealth {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCreateShortcutRequest();
+    const message = createBaseShortcut_LinkHealth();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -536,10 +2002,34 @@ export const CreateShortcutRequest: MessageFns<CreateShortcutRequest> = {
             break;
           }
 
-          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          message.checkTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
-      }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.statusCode = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.broken = reader.bool();
+          continue;
+        }
+      }
       if ((tag & 7) === 4 || tag === 0) {
         break;
       }
@@ -548,37 +2038,132 @@ export const CreateShortcutRequest: MessageFns<CreateShortcutRequest> = {
     return message;
   },
 
-  create(base?: DeepPartial<CreateShortcutRequest>): CreateShortcutRequest {
-    return CreateShortcutRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<Shortcut_LinkHealth>): Shortcut_LinkHealth {
+    return Shortcut_LinkHealth.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<CreateShortcutRequest>): CreateShortcutRequest {
-    const message = createBaseCreateShortcutRequest();
-    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
-      ? Shortcut.fromPartial(object.shortcut)
-      : undefined;
+  fromPartial(object: DeepPartial<Shortcut_LinkHealth>): Shortcut_LinkHealth {
+    const message = createBaseShortcut_LinkHealth();
+    message.checkTime = object.checkTime ?? undefined;
+    message.statusCode = object.statusCode ?? 0;
+    message.error = object.error ?? "";
+    message.broken = object.broken ?? false;
     return message;
   },
 };
 
-function createBaseUpdateShortcutRequest(): UpdateShortcutRequest {
-  return { shortcut: undefined, updateMask: undefined };
+function createBaseListShortcutsRequest(): ListShortcutsRequest {
+  return { pageSize: 0, pageToken: "", state: State.STATE_UNSPECIFIED, creatorId: 0, mineOnly: false };
 }
 
-export const UpdateShortcutRequest: MessageFns<UpdateShortcutRequest> = {
-  encode(message: UpdateShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.shortcut !== undefined) {
-      Shortcut.encode(message.shortcut, writer.uint32(10).fork()).join();
+export const ListShortcutsRequest: MessageFns<ListShortcutsRequest> = {
+  encode(message: ListShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.pageSize !== 0) {
+      writer.uint32(8).int32(message.pageSize);
     }
-    if (message.updateMask !== undefined) {
-      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).join();
+    if (message.pageToken !== "") {
+      writer.uint32(18).string(message.pageToken);
+    }
+    if (message.state !== State.STATE_UNSPECIFIED) {
+      writer.uint32(24).int32(stateToNumber(message.state));
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(32).int32(message.creatorId);
+    }
+    if (message.mineOnly !== false) {
+      writer.uint32(40).bool(message.mineOnly);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): UpdateShortcutRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutsRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdateShortcutRequest();
+    const message = createBaseListShortcutsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.state = stateFromJSON(reader.int32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.mineOnly = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutsRequest>): ListShortcutsRequest {
+    return ListShortcutsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutsRequest>): ListShortcutsRequest {
+    const message = createBaseListShortcutsRequest();
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    message.state = object.state ?? State.STATE_UNSPECIFIED;
+    message.creatorId = object.creatorId ?? 0;
+    message.mineOnly = object.mineOnly ?? false;
+    return message;
+  },
+};
+
+function createBaseListShortcutsResponse(): ListShortcutsResponse {
+  return { shortcuts: [], nextPageToken: "" };
+}
+
+export const ListShortcutsResponse: MessageFns<ListShortcutsResponse> = {
+  encode(message: ListShortcutsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.shortcuts) {
+      Shortcut.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -587,7 +2172,7 @@ export const UpdateShortcutRequest: MessageFns<UpdateShortcutRequest> = {
             break;
           }
 
-          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          message.shortcuts.push(Shortcut.decode(reader, reader.uint32()));
           continue;
         }
         case 2: {
@@ -595,7 +2180,7 @@ export const UpdateShortcutRequest: MessageFns<UpdateShortcutRequest> = {
             break;
           }
 
-          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          message.nextPageToken = reader.string();
           continue;
         }
       }
@@ -607,44 +2192,64 @@ export const UpdateShortcutRequest: MessageFns<UpdateShortcutRequest> = {
     return message;
   },
 
-  create(base?: DeepPartial<UpdateShortcutRequest>): UpdateShortcutRequest {
-    return UpdateShortcutRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListShortcutsResponse>): ListShortcutsResponse {
+    return ListShortcutsResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<UpdateShortcutRequest>): UpdateShortcutRequest {
-    const message = createBaseUpdateShortcutRequest();
-    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
-      ? Shortcut.fromPartial(object.shortcut)
-      : undefined;
-    message.updateMask = object.updateMask ?? undefined;
+  fromPartial(object: DeepPartial<ListShortcutsResponse>): ListShortcutsResponse {
+    const message = createBaseListShortcutsResponse();
+    message.shortcuts = object.shortcuts?.map((e) => Shortcut.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
     return message;
   },
 };
 
-function createBaseDeleteShortcutRequest(): DeleteShortcutRequest {
-  return { id: 0 };
+function createBaseSearchShortcutsRequest(): SearchShortcutsRequest {
+  return { query: "", pageSize: 0, pageToken: "" };
 }
 
-export const DeleteShortcutRequest: MessageFns<DeleteShortcutRequest> = {
-  encode(message: DeleteShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).int32(message.id);
+export const SearchShortcutsRequest: MessageFns<SearchShortcutsRequest> = {
+  encode(message: SearchShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.query !== "") {
+      writer.uint32(10).string(message.query);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): DeleteShortcutRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchShortcutsRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDeleteShortcutRequest();
+    const message = createBaseSearchShortcutsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = reader.int32();
+          message.query = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
           continue;
         }
       }
@@ -656,41 +2261,54 @@ export const DeleteShortcutRequest: MessageFns<DeleteShortcutRequest> = {
     return message;
   },
 
-  create(base?: DeepPartial<DeleteShortcutRequest>): DeleteShortcutRequest {
-    return DeleteShortcutRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<SearchShortcutsRequest>): SearchShortcutsRequest {
+    return SearchShortcutsRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DeleteShortcutRequest>): DeleteShortcutRequest {
-    const message = createBaseDeleteShortcutRequest();
-    message.id = object.id ?? 0;
+  fromPartial(object: DeepPartial<SearchShortcutsRequest>): SearchShortcutsRequest {
+    const message = createBaseSearchShortcutsRequest();
+    message.query = object.query ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
     return message;
   },
 };
 
-function createBaseGetShortcutAnalyticsRequest(): GetShortcutAnalyticsRequest {
-  return { id: 0 };
+function createBaseSearchShortcutsResponse(): SearchShortcutsResponse {
+  return { shortcuts: [], nextPageToken: "" };
 }
 
-export const GetShortcutAnalyticsRequest: MessageFns<GetShortcutAnalyticsRequest> = {
-  encode(message: GetShortcutAnalyticsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).int32(message.id);
+export const SearchShortcutsResponse: MessageFns<SearchShortcutsResponse> = {
+  encode(message: SearchShortcutsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.shortcuts) {
+      Shortcut.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchShortcutsResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetShortcutAnalyticsRequest();
+    const message = createBaseSearchShortcutsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = reader.int32();
+          message.shortcuts.push(Shortcut.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
           continue;
         }
       }
@@ -702,38 +2320,51 @@ export const GetShortcutAnalyticsRequest: MessageFns<GetShortcutAnalyticsRequest
     return message;
   },
 
-  create(base?: DeepPartial<GetShortcutAnalyticsRequest>): GetShortcutAnalyticsRequest {
-    return GetShortcutAnalyticsRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<SearchShortcutsResponse>): SearchShortcutsResponse {
+    return SearchShortcutsResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GetShortcutAnalyticsRequest>): GetShortcutAnalyticsRequest {
-    const message = createBaseGetShortcutAnalyticsRequest();
-    message.id = object.id ?? 0;
+  fromPartial(object: DeepPartial<SearchShortcutsResponse>): SearchShortcutsResponse {
+    const message = createBaseSearchShortcutsResponse();
+    message.shortcuts = object.shortcuts?.map((e) => Shortcut.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
     return message;
   },
 };
 
-function createBaseGetShortcutAnalyticsResponse(): GetShortcutAnalyticsResponse {
-  return { references: [], devices: [], browsers: [] };
+function createBaseBulkUpdateShortcutTagsRequest(): BulkUpdateShortcutTagsRequest {
+  return {
+    filter: "",
+    operation: BulkUpdateShortcutTagsRequest_Operation.OPERATION_UNSPECIFIED,
+    tag: "",
+    newTag: "",
+    dryRun: false,
+  };
 }
 
-export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsResponse> = {
-  encode(message: GetShortcutAnalyticsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.references) {
-      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(10).fork()).join();
+export const BulkUpdateShortcutTagsRequest: MessageFns<BulkUpdateShortcutTagsRequest> = {
+  encode(message: BulkUpdateShortcutTagsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.filter !== "") {
+      writer.uint32(10).string(message.filter);
     }
-    for (const v of message.devices) {
-      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(18).fork()).join();
+    if (message.operation !== BulkUpdateShortcutTagsRequest_Operation.OPERATION_UNSPECIFIED) {
+      writer.uint32(16).int32(bulkUpdateShortcutTagsRequest_OperationToNumber(message.operation));
     }
-    for (const v of message.browsers) {
-      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(26).fork()).join();
+    if (message.tag !== "") {
+      writer.uint32(26).string(message.tag);
+    }
+    if (message.newTag !== "") {
+      writer.uint32(34).string(message.newTag);
+    }
+    if (message.dryRun !== false) {
+      writer.uint32(40).bool(message.dryRun);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): BulkUpdateShortcutTagsRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetShortcutAnalyticsResponse();
+    const message = createBaseBulkUpdateShortcutTagsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -742,15 +2373,15 @@ export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsRespon
             break;
           }
 
-          message.references.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          message.filter = reader.string();
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.devices.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          message.operation = bulkUpdateShortcutTagsRequest_OperationFromJSON(reader.int32());
           continue;
         }
         case 3: {
@@ -758,7 +2389,23 @@ export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsRespon
             break;
           }
 
-          message.browsers.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          message.tag = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.newTag = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.dryRun = reader.bool();
           continue;
         }
       }
@@ -770,54 +2417,56 @@ export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsRespon
     return message;
   },
 
-  create(base?: DeepPartial<GetShortcutAnalyticsResponse>): GetShortcutAnalyticsResponse {
-    return GetShortcutAnalyticsResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<BulkUpdateShortcutTagsRequest>): BulkUpdateShortcutTagsRequest {
+    return BulkUpdateShortcutTagsRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GetShortcutAnalyticsResponse>): GetShortcutAnalyticsResponse {
-    const message = createBaseGetShortcutAnalyticsResponse();
-    message.references = object.references?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
-    message.devices = object.devices?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
-    message.browsers = object.browsers?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<BulkUpdateShortcutTagsRequest>): BulkUpdateShortcutTagsRequest {
+    const message = createBaseBulkUpdateShortcutTagsRequest();
+    message.filter = object.filter ?? "";
+    message.operation = object.operation ?? BulkUpdateShortcutTagsRequest_Operation.OPERATION_UNSPECIFIED;
+    message.tag = object.tag ?? "";
+    message.newTag = object.newTag ?? "";
+    message.dryRun = object.dryRun ?? false;
     return message;
   },
 };
 
-function createBaseGetShortcutAnalyticsResponse_AnalyticsItem(): GetShortcutAnalyticsResponse_AnalyticsItem {
-  return { name: "", count: 0 };
+function createBaseBulkUpdateShortcutTagsResponse(): BulkUpdateShortcutTagsResponse {
+  return { affectedCount: 0, sample: [] };
 }
 
-export const GetShortcutAnalyticsResponse_AnalyticsItem: MessageFns<GetShortcutAnalyticsResponse_AnalyticsItem> = {
-  encode(message: GetShortcutAnalyticsResponse_AnalyticsItem, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const BulkUpdateShortcutTagsResponse: MessageFns<BulkUpdateShortcutTagsResponse> = {
+  encode(message: BulkUpdateShortcutTagsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.affectedCount !== 0) {
+      writer.uint32(8).int32(message.affectedCount);
     }
-    if (message.count !== 0) {
-      writer.uint32(16).int32(message.count);
+    for (const v of message.sample) {
+      Shortcut.encode(v!, writer.uint32(18).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsResponse_AnalyticsItem {
+  decode(input: BinaryReader | Uint8Array, length?: number): BulkUpdateShortcutTagsResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetShortcutAnalyticsResponse_AnalyticsItem();
+    const message = createBaseBulkUpdateShortcutTagsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.name = reader.string();
+          message.affectedCount = reader.int32();
           continue;
         }
         case 2: {
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.count = reader.int32();
+          message.sample.push(Shortcut.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -829,21 +2478,4599 @@ export const GetShortcutAnalyticsResponse_AnalyticsItem: MessageFns<GetShortcutA
     return message;
   },
 
-  create(base?: DeepPartial<GetShortcutAnalyticsResponse_AnalyticsItem>): GetShortcutAnalyticsResponse_AnalyticsItem {
-    return GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(base ?? {});
+  create(base?: DeepPartial<BulkUpdateShortcutTagsResponse>): BulkUpdateShortcutTagsResponse {
+    return BulkUpdateShortcutTagsResponse.fromPartial(base ?? {});
   },
-  fromPartial(
-    object: DeepPartial<GetShortcutAnalyticsResponse_AnalyticsItem>,
-  ): GetShortcutAnalyticsResponse_AnalyticsItem {
-    const message = createBaseGetShortcutAnalyticsResponse_AnalyticsItem();
-    message.name = object.name ?? "";
-    message.count = object.count ?? 0;
+  fromPartial(object: DeepPartial<BulkUpdateShortcutTagsResponse>): BulkUpdateShortcutTagsResponse {
+    const message = createBaseBulkUpdateShortcutTagsResponse();
+    message.affectedCount = object.affectedCount ?? 0;
+    message.sample = object.sample?.map((e) => Shortcut.fromPartial(e)) || [];
     return message;
   },
 };
 
-export type ShortcutServiceDefinition = typeof ShortcutServiceDefinition;
-export const ShortcutServiceDefinition = {
+function createBaseMergeShortcutsRequest(): MergeShortcutsRequest {
+  return { ids: [], survivorId: 0 };
+}
+
+export const MergeShortcutsRequest: MessageFns<MergeShortcutsRequest> = {
+  encode(message: MergeShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    writer.uint32(10).fork();
+    for (const v of message.ids) {
+      writer.int32(v);
+    }
+    writer.join();
+    if (message.survivorId !== 0) {
+      writer.uint32(16).int32(message.survivorId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): MergeShortcutsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMergeShortcutsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag === 8) {
+            message.ids.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 10) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.ids.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.survivorId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<MergeShortcutsRequest>): MergeShortcutsRequest {
+    return MergeShortcutsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<MergeShortcutsRequest>): MergeShortcutsRequest {
+    const message = createBaseMergeShortcutsRequest();
+    message.ids = object.ids?.map((e) => e) || [];
+    message.survivorId = object.survivorId ?? 0;
+    return message;
+  },
+};
+
+function createBaseValidateLinksRequest(): ValidateLinksRequest {
+  return { links: [], checkReachability: false };
+}
+
+export const ValidateLinksRequest: MessageFns<ValidateLinksRequest> = {
+  encode(message: ValidateLinksRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.links) {
+      writer.uint32(10).string(v!);
+    }
+    if (message.checkReachability !== false) {
+      writer.uint32(16).bool(message.checkReachability);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ValidateLinksRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseValidateLinksRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.links.push(reader.string());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.checkReachability = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ValidateLinksRequest>): ValidateLinksRequest {
+    return ValidateLinksRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ValidateLinksRequest>): ValidateLinksRequest {
+    const message = createBaseValidateLinksRequest();
+    message.links = object.links?.map((e) => e) || [];
+    message.checkReachability = object.checkReachability ?? false;
+    return message;
+  },
+};
+
+function createBaseValidateLinksResponse(): ValidateLinksResponse {
+  return { results: [] };
+}
+
+export const ValidateLinksResponse: MessageFns<ValidateLinksResponse> = {
+  encode(message: ValidateLinksResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.results) {
+      ValidateLinksResponse_Result.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ValidateLinksResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseValidateLinksResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.results.push(ValidateLinksResponse_Result.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ValidateLinksResponse>): ValidateLinksResponse {
+    return ValidateLinksResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ValidateLinksResponse>): ValidateLinksResponse {
+    const message = createBaseValidateLinksResponse();
+    message.results = object.results?.map((e) => ValidateLinksResponse_Result.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseValidateLinksResponse_Result(): ValidateLinksResponse_Result {
+  return {
+    link: "",
+    valid: false,
+    error: "",
+    normalizedLink: "",
+    reachable: undefined,
+    statusCode: 0,
+    reachabilityError: "",
+  };
+}
+
+export const ValidateLinksResponse_Result: MessageFns<ValidateLinksResponse_Result> = {
+  encode(message: ValidateLinksResponse_Result, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.link !== "") {
+      writer.uint32(10).string(message.link);
+    }
+    if (message.valid !== false) {
+      writer.uint32(16).bool(message.valid);
+    }
+    if (message.error !== "") {
+      writer.uint32(26).string(message.error);
+    }
+    if (message.normalizedLink !== "") {
+      writer.uint32(34).string(message.normalizedLink);
+    }
+    if (message.reachable !== undefined) {
+      writer.uint32(40).bool(message.reachable);
+    }
+    if (message.statusCode !== 0) {
+      writer.uint32(48).int32(message.statusCode);
+    }
+    if (message.reachabilityError !== "") {
+      writer.uint32(58).string(message.reachabilityError);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ValidateLinksResponse_Result {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseValidateLinksResponse_Result();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.valid = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.normalizedLink = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.reachable = reader.bool();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.statusCode = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.reachabilityError = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ValidateLinksResponse_Result>): ValidateLinksResponse_Result {
+    return ValidateLinksResponse_Result.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ValidateLinksResponse_Result>): ValidateLinksResponse_Result {
+    const message = createBaseValidateLinksResponse_Result();
+    message.link = object.link ?? "";
+    message.valid = object.valid ?? false;
+    message.error = object.error ?? "";
+    message.normalizedLink = object.normalizedLink ?? "";
+    message.reachable = object.reachable ?? undefined;
+    message.statusCode = object.statusCode ?? 0;
+    message.reachabilityError = object.reachabilityError ?? "";
+    return message;
+  },
+};
+
+function createBaseGetShortcutRequest(): GetShortcutRequest {
+  return { id: 0 };
+}
+
+export const GetShortcutRequest: MessageFns<GetShortcutRequest> = {
+  encode(message: GetShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetShortcutRequest>): GetShortcutRequest {
+    return GetShortcutRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetShortcutRequest>): GetShortcutRequest {
+    const message = createBaseGetShortcutRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetShortcutByNameRequest(): GetShortcutByNameRequest {
+  return { name: "" };
+}
+
+export const GetShortcutByNameRequest: MessageFns<GetShortcutByNameRequest> = {
+  encode(message: GetShortcutByNameRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutByNameRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutByNameRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetShortcutByNameRequest>): GetShortcutByNameRequest {
+    return GetShortcutByNameRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetShortcutByNameRequest>): GetShortcutByNameRequest {
+    const message = createBaseGetShortcutByNameRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseShortcutNotFoundDetails(): ShortcutNotFoundDetails {
+  return { name: "", suggestions: [] };
+}
+
+export const ShortcutNotFoundDetails: MessageFns<ShortcutNotFoundDetails> = {
+  encode(message: ShortcutNotFoundDetails, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.suggestions) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutNotFoundDetails {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutNotFoundDetails();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.suggestions.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutNotFoundDetails>): ShortcutNotFoundDetails {
+    return ShortcutNotFoundDetails.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutNotFoundDetails>): ShortcutNotFoundDetails {
+    const message = createBaseShortcutNotFoundDetails();
+    message.name = object.name ?? "";
+    message.suggestions = object.suggestions?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseListShortcutSuggestionsRequest(): ListShortcutSuggestionsRequest {
+  return { name: "" };
+}
+
+export const ListShortcutSuggestionsRequest: MessageFns<ListShortcutSuggestionsRequest> = {
+  encode(message: ListShortcutSuggestionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutSuggestionsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutSuggestionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutSuggestionsRequest>): ListShortcutSuggestionsRequest {
+    return ListShortcutSuggestionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutSuggestionsRequest>): ListShortcutSuggestionsRequest {
+    const message = createBaseListShortcutSuggestionsRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseListShortcutSuggestionsResponse(): ListShortcutSuggestionsResponse {
+  return { suggestions: [] };
+}
+
+export const ListShortcutSuggestionsResponse: MessageFns<ListShortcutSuggestionsResponse> = {
+  encode(message: ListShortcutSuggestionsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.suggestions) {
+      writer.uint32(10).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutSuggestionsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutSuggestionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.suggestions.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutSuggestionsResponse>): ListShortcutSuggestionsResponse {
+    return ListShortcutSuggestionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutSuggestionsResponse>): ListShortcutSuggestionsResponse {
+    const message = createBaseListShortcutSuggestionsResponse();
+    message.suggestions = object.suggestions?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseResolvePreviewRequest(): ResolvePreviewRequest {
+  return { name: "", context: undefined };
+}
+
+export const ResolvePreviewRequest: MessageFns<ResolvePreviewRequest> = {
+  encode(message: ResolvePreviewRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.context !== undefined) {
+      ResolveContext.encode(message.context, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResolvePreviewRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResolvePreviewRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.context = ResolveContext.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ResolvePreviewRequest>): ResolvePreviewRequest {
+    return ResolvePreviewRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ResolvePreviewRequest>): ResolvePreviewRequest {
+    const message = createBaseResolvePreviewRequest();
+    message.name = object.name ?? "";
+    message.context = (object.context !== undefined && object.context !== null)
+      ? ResolveContext.fromPartial(object.context)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseResolveContext(): ResolveContext {
+  return { device: "", country: "", time: undefined, collection: "", query: "", language: "" };
+}
+
+export const ResolveContext: MessageFns<ResolveContext> = {
+  encode(message: ResolveContext, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.device !== "") {
+      writer.uint32(10).string(message.device);
+    }
+    if (message.country !== "") {
+      writer.uint32(18).string(message.country);
+    }
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(26).fork()).join();
+    }
+    if (message.collection !== "") {
+      writer.uint32(34).string(message.collection);
+    }
+    if (message.query !== "") {
+      writer.uint32(42).string(message.query);
+    }
+    if (message.language !== "") {
+      writer.uint32(50).string(message.language);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResolveContext {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResolveContext();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.device = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.country = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.collection = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.query = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.language = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ResolveContext>): ResolveContext {
+    return ResolveContext.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ResolveContext>): ResolveContext {
+    const message = createBaseResolveContext();
+    message.device = object.device ?? "";
+    message.country = object.country ?? "";
+    message.time = object.time ?? undefined;
+    message.collection = object.collection ?? "";
+    message.query = object.query ?? "";
+    message.language = object.language ?? "";
+    return message;
+  },
+};
+
+function createBaseResolvePreviewResponse(): ResolvePreviewResponse {
+  return {
+    outcome: ResolvePreviewResponse_Outcome.OUTCOME_UNSPECIFIED,
+    target: "",
+    shortcut: undefined,
+    reason: "",
+    suggestions: [],
+    redirectCode: 0,
+  };
+}
+
+export const ResolvePreviewResponse: MessageFns<ResolvePreviewResponse> = {
+  encode(message: ResolvePreviewResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.outcome !== ResolvePreviewResponse_Outcome.OUTCOME_UNSPECIFIED) {
+      writer.uint32(8).int32(resolvePreviewResponse_OutcomeToNumber(message.outcome));
+    }
+    if (message.target !== "") {
+      writer.uint32(18).string(message.target);
+    }
+    if (message.shortcut !== undefined) {
+      Shortcut.encode(message.shortcut, writer.uint32(26).fork()).join();
+    }
+    if (message.reason !== "") {
+      writer.uint32(34).string(message.reason);
+    }
+    for (const v of message.suggestions) {
+      writer.uint32(42).string(v!);
+    }
+    if (message.redirectCode !== 0) {
+      writer.uint32(48).int32(message.redirectCode);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResolvePreviewResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResolvePreviewResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.outcome = resolvePreviewResponse_OutcomeFromJSON(reader.int32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.target = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.reason = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.suggestions.push(reader.string());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.redirectCode = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ResolvePreviewResponse>): ResolvePreviewResponse {
+    return ResolvePreviewResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ResolvePreviewResponse>): ResolvePreviewResponse {
+    const message = createBaseResolvePreviewResponse();
+    message.outcome = object.outcome ?? ResolvePreviewResponse_Outcome.OUTCOME_UNSPECIFIED;
+    message.target = object.target ?? "";
+    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
+      ? Shortcut.fromPartial(object.shortcut)
+      : undefined;
+    message.reason = object.reason ?? "";
+    message.suggestions = object.suggestions?.map((e) => e) || [];
+    message.redirectCode = object.redirectCode ?? 0;
+    return message;
+  },
+};
+
+function createBaseCreateShortcutRequest(): CreateShortcutRequest {
+  return { shortcut: undefined };
+}
+
+export const CreateShortcutRequest: MessageFns<CreateShortcutRequest> = {
+  encode(message: CreateShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcut !== undefined) {
+      Shortcut.encode(message.shortcut, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateShortcutRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateShortcutRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateShortcutRequest>): CreateShortcutRequest {
+    return CreateShortcutRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateShortcutRequest>): CreateShortcutRequest {
+    const message = createBaseCreateShortcutRequest();
+    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
+      ? Shortcut.fromPartial(object.shortcut)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseUpdateShortcutRequest(): UpdateShortcutRequest {
+  return { shortcut: undefined, updateMask: undefined };
+}
+
+export const UpdateShortcutRequest: MessageFns<UpdateShortcutRequest> = {
+  encode(message: UpdateShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcut !== undefined) {
+      Shortcut.encode(message.shortcut, writer.uint32(10).fork()).join();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UpdateShortcutRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateShortcutRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UpdateShortcutRequest>): UpdateShortcutRequest {
+    return UpdateShortcutRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateShortcutRequest>): UpdateShortcutRequest {
+    const message = createBaseUpdateShortcutRequest();
+    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
+      ? Shortcut.fromPartial(object.shortcut)
+      : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBaseDeleteShortcutRequest(): DeleteShortcutRequest {
+  return { id: 0 };
+}
+
+export const DeleteShortcutRequest: MessageFns<DeleteShortcutRequest> = {
+  encode(message: DeleteShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteShortcutRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteShortcutRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeleteShortcutRequest>): DeleteShortcutRequest {
+    return DeleteShortcutRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteShortcutRequest>): DeleteShortcutRequest {
+    const message = createBaseDeleteShortcutRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseTransferShortcutRequest(): TransferShortcutRequest {
+  return { id: 0, userId: 0 };
+}
+
+export const TransferShortcutRequest: MessageFns<TransferShortcutRequest> = {
+  encode(message: TransferShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TransferShortcutRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTransferShortcutRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<TransferShortcutRequest>): TransferShortcutRequest {
+    return TransferShortcutRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TransferShortcutRequest>): TransferShortcutRequest {
+    const message = createBaseTransferShortcutRequest();
+    message.id = object.id ?? 0;
+    message.userId = object.userId ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetShortcutAnalyticsRequest(): GetShortcutAnalyticsRequest {
+  return { id: 0, interval: GetShortcutAnalyticsRequest_Interval.INTERVAL_UNSPECIFIED };
+}
+
+export const GetShortcutAnalyticsRequest: MessageFns<GetShortcutAnalyticsRequest> = {
+  encode(message: GetShortcutAnalyticsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.interval !== GetShortcutAnalyticsRequest_Interval.INTERVAL_UNSPECIFIED) {
+      writer.uint32(16).int32(getShortcutAnalyticsRequest_IntervalToNumber(message.interval));
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutAnalyticsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.interval = getShortcutAnalyticsRequest_IntervalFromJSON(reader.int32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetShortcutAnalyticsRequest>): GetShortcutAnalyticsRequest {
+    return GetShortcutAnalyticsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetShortcutAnalyticsRequest>): GetShortcutAnalyticsRequest {
+    const message = createBaseGetShortcutAnalyticsRequest();
+    message.id = object.id ?? 0;
+    message.interval = object.interval ?? GetShortcutAnalyticsRequest_Interval.INTERVAL_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseGetShortcutAnalyticsResponse(): GetShortcutAnalyticsResponse {
+  return {
+    references: [],
+    devices: [],
+    browsers: [],
+    clickGoalProgress: undefined,
+    timeseries: [],
+    countries: [],
+    users: [],
+    variants: [],
+  };
+}
+
+export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsResponse> = {
+  encode(message: GetShortcutAnalyticsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.references) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(10).fork()).join();
+    }
+    for (const v of message.devices) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(18).fork()).join();
+    }
+    for (const v of message.browsers) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(26).fork()).join();
+    }
+    if (message.clickGoalProgress !== undefined) {
+      GetShortcutAnalyticsResponse_ClickGoalProgress.encode(message.clickGoalProgress, writer.uint32(34).fork()).join();
+    }
+    for (const v of message.timeseries) {
+      GetShortcutAnalyticsResponse_TimeseriesItem.encode(v!, writer.uint32(42).fork()).join();
+    }
+    for (const v of message.countries) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(50).fork()).join();
+    }
+    for (const v of message.users) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(58).fork()).join();
+    }
+    for (const v of message.variants) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(66).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutAnalyticsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.references.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.devices.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.browsers.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.clickGoalProgress = GetShortcutAnalyticsResponse_ClickGoalProgress.decode(reader, reader.uint32());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.timeseries.push(GetShortcutAnalyticsResponse_TimeseriesItem.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.countries.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.users.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.variants.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetShortcutAnalyticsResponse>): GetShortcutAnalyticsResponse {
+    return GetShortcutAnalyticsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetShortcutAnalyticsResponse>): GetShortcutAnalyticsResponse {
+    const message = createBaseGetShortcutAnalyticsResponse();
+    message.references = object.references?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
+    message.devices = object.devices?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
+    message.browsers = object.browsers?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
+    message.clickGoalProgress = (object.clickGoalProgress !== undefined && object.clickGoalProgress !== null)
+      ? GetShortcutAnalyticsResponse_ClickGoalProgress.fromPartial(object.clickGoalProgress)
+      : undefined;
+    message.timeseries =
+      object.timeseries?.map((e) => GetShortcutAnalyticsResponse_TimeseriesItem.fromPartial(e)) || [];
+    message.countries = object.countries?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
+    message.users = object.users?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
+    message.variants = object.variants?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGetShortcutAnalyticsResponse_AnalyticsItem(): GetShortcutAnalyticsResponse_AnalyticsItem {
+  return { name: "", count: 0 };
+}
+
+export const GetShortcutAnalyticsResponse_AnalyticsItem: MessageFns<GetShortcutAnalyticsResponse_AnalyticsItem> = {
+  encode(message: GetShortcutAnalyticsResponse_AnalyticsItem, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.count !== 0) {
+      writer.uint32(16).int32(message.count);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsResponse_AnalyticsItem {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutAnalyticsResponse_AnalyticsItem();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.count = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetShortcutAnalyticsResponse_AnalyticsItem>): GetShortcutAnalyticsResponse_AnalyticsItem {
+    return GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<GetShortcutAnalyticsResponse_AnalyticsItem>,
+  ): GetShortcutAnalyticsResponse_AnalyticsItem {
+    const message = createBaseGetShortcutAnalyticsResponse_AnalyticsItem();
+    message.name = object.name ?? "";
+    message.count = object.count ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetShortcutAnalyticsResponse_ClickGoalProgress(): GetShortcutAnalyticsResponse_ClickGoalProgress {
+  return { target: 0, viewCount: 0, reachedTime: undefined };
+}
+
+export const GetShortcutAnalyticsResponse_ClickGoalProgress: MessageFns<GetShortcutAnalyticsResponse_ClickGoalProgress> = {
+  encode(
+    message: GetShortcutAnalyticsResponse_ClickGoalProgress,
+    writer: BinaryWriter = new BinaryWriter(),
+  ): BinaryWriter {
+    if (message.target !== 0) {
+      writer.uint32(8).int32(message.target);
+    }
+    if (message.viewCount !== 0) {
+      writer.uint32(16).int32(message.viewCount);
+    }
+    if (message.reachedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.reachedTime), writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsResponse_ClickGoalProgress {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutAnalyticsResponse_ClickGoalProgress();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.target = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.viewCount = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.reachedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(
+    base?: DeepPartial<GetShortcutAnalyticsResponse_ClickGoalProgress>,
+  ): GetShortcutAnalyticsResponse_ClickGoalProgress {
+    return GetShortcutAnalyticsResponse_ClickGoalProgress.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<GetShortcutAnalyticsResponse_ClickGoalProgress>,
+  ): GetShortcutAnalyticsResponse_ClickGoalProgress {
+    const message = createBaseGetShortcutAnalyticsResponse_ClickGoalProgress();
+    message.target = object.target ?? 0;
+    message.viewCount = object.viewCount ?? 0;
+    message.reachedTime = object.reachedTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseGetShortcutAnalyticsResponse_TimeseriesItem(): GetShortcutAnalyticsResponse_TimeseriesItem {
+  return { startTime: undefined, count: 0 };
+}
+
+export const GetShortcutAnalyticsResponse_TimeseriesItem: MessageFns<GetShortcutAnalyticsResponse_TimeseriesItem> = {
+  encode(
+    message: GetShortcutAnalyticsResponse_TimeseriesItem,
+    writer: BinaryWriter = new BinaryWriter(),
+  ): BinaryWriter {
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(10).fork()).join();
+    }
+    if (message.count !== 0) {
+      writer.uint32(16).int32(message.count);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsResponse_TimeseriesItem {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutAnalyticsResponse_TimeseriesItem();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.count = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetShortcutAnalyticsResponse_TimeseriesItem>): GetShortcutAnalyticsResponse_TimeseriesItem {
+    return GetShortcutAnalyticsResponse_TimeseriesItem.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<GetShortcutAnalyticsResponse_TimeseriesItem>,
+  ): GetShortcutAnalyticsResponse_TimeseriesItem {
+    const message = createBaseGetShortcutAnalyticsResponse_TimeseriesItem();
+    message.startTime = object.startTime ?? undefined;
+    message.count = object.count ?? 0;
+    return message;
+  },
+};
+
+function createBaseShortcutAnalyticsShare(): ShortcutAnalyticsShare {
+  return {
+    id: 0,
+    shortcutId: 0,
+    creatorId: 0,
+    createdTime: undefined,
+    description: "",
+    token: "",
+    expireTime: undefined,
+    viewCount: 0,
+    lastViewedTime: undefined,
+  };
+}
+
+export const ShortcutAnalyticsShare: MessageFns<ShortcutAnalyticsShare> = {
+  encode(message: ShortcutAnalyticsShare, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.shortcutId !== 0) {
+      writer.uint32(16).int32(message.shortcutId);
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(24).int32(message.creatorId);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(34).fork()).join();
+    }
+    if (message.description !== "") {
+      writer.uint32(42).string(message.description);
+    }
+    if (message.token !== "") {
+      writer.uint32(50).string(message.token);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(58).fork()).join();
+    }
+    if (message.viewCount !== 0) {
+      writer.uint32(64).int32(message.viewCount);
+    }
+    if (message.lastViewedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastViewedTime), writer.uint32(74).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutAnalyticsShare {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutAnalyticsShare();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.viewCount = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.lastViewedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutAnalyticsShare>): ShortcutAnalyticsShare {
+    return ShortcutAnalyticsShare.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutAnalyticsShare>): ShortcutAnalyticsShare {
+    const message = createBaseShortcutAnalyticsShare();
+    message.id = object.id ?? 0;
+    message.shortcutId = object.shortcutId ?? 0;
+    message.creatorId = object.creatorId ?? 0;
+    message.createdTime = object.createdTime ?? undefined;
+    message.description = object.description ?? "";
+    message.token = object.token ?? "";
+    message.expireTime = object.expireTime ?? undefined;
+    message.viewCount = object.viewCount ?? 0;
+    message.lastViewedTime = object.lastViewedTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseCreateShortcutAnalyticsShareRequest(): CreateShortcutAnalyticsShareRequest {
+  return { shortcutId: 0, description: "", expireTime: undefined };
+}
+
+export const CreateShortcutAnalyticsShareRequest: MessageFns<CreateShortcutAnalyticsShareRequest> = {
+  encode(message: CreateShortcutAnalyticsShareRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.description !== "") {
+      writer.uint32(18).string(message.description);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateShortcutAnalyticsShareRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateShortcutAnalyticsShareRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateShortcutAnalyticsShareRequest>): CreateShortcutAnalyticsShareRequest {
+    return CreateShortcutAnalyticsShareRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateShortcutAnalyticsShareRequest>): CreateShortcutAnalyticsShareRequest {
+    const message = createBaseCreateShortcutAnalyticsShareRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.description = object.description ?? "";
+    message.expireTime = object.expireTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseListShortcutAnalyticsSharesRequest(): ListShortcutAnalyticsSharesRequest {
+  return { shortcutId: 0 };
+}
+
+export const ListShortcutAnalyticsSharesRequest: MessageFns<ListShortcutAnalyticsSharesRequest> = {
+  encode(message: ListShortcutAnalyticsSharesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutAnalyticsSharesRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutAnalyticsSharesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutAnalyticsSharesRequest>): ListShortcutAnalyticsSharesRequest {
+    return ListShortcutAnalyticsSharesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutAnalyticsSharesRequest>): ListShortcutAnalyticsSharesRequest {
+    const message = createBaseListShortcutAnalyticsSharesRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    return message;
+  },
+};
+
+function createBaseListShortcutAnalyticsSharesResponse(): ListShortcutAnalyticsSharesResponse {
+  return { shares: [] };
+}
+
+export const ListShortcutAnalyticsSharesResponse: MessageFns<ListShortcutAnalyticsSharesResponse> = {
+  encode(message: ListShortcutAnalyticsSharesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.shares) {
+      ShortcutAnalyticsShare.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutAnalyticsSharesResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutAnalyticsSharesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shares.push(ShortcutAnalyticsShare.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutAnalyticsSharesResponse>): ListShortcutAnalyticsSharesResponse {
+    return ListShortcutAnalyticsSharesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutAnalyticsSharesResponse>): ListShortcutAnalyticsSharesResponse {
+    const message = createBaseListShortcutAnalyticsSharesResponse();
+    message.shares = object.shares?.map((e) => ShortcutAnalyticsShare.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDeleteShortcutAnalyticsShareRequest(): DeleteShortcutAnalyticsShareRequest {
+  return { shortcutId: 0, id: 0 };
+}
+
+export const DeleteShortcutAnalyticsShareRequest: MessageFns<DeleteShortcutAnalyticsShareRequest> = {
+  encode(message: DeleteShortcutAnalyticsShareRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.id !== 0) {
+      writer.uint32(16).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteShortcutAnalyticsShareRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteShortcutAnalyticsShareRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeleteShortcutAnalyticsShareRequest>): DeleteShortcutAnalyticsShareRequest {
+    return DeleteShortcutAnalyticsShareRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteShortcutAnalyticsShareRequest>): DeleteShortcutAnalyticsShareRequest {
+    const message = createBaseDeleteShortcutAnalyticsShareRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetSharedShortcutAnalyticsRequest(): GetSharedShortcutAnalyticsRequest {
+  return { token: "", interval: GetShortcutAnalyticsRequest_Interval.INTERVAL_UNSPECIFIED };
+}
+
+export const GetSharedShortcutAnalyticsRequest: MessageFns<GetSharedShortcutAnalyticsRequest> = {
+  encode(message: GetSharedShortcutAnalyticsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.token !== "") {
+      writer.uint32(10).string(message.token);
+    }
+    if (message.interval !== GetShortcutAnalyticsRequest_Interval.INTERVAL_UNSPECIFIED) {
+      writer.uint32(16).int32(getShortcutAnalyticsRequest_IntervalToNumber(message.interval));
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetSharedShortcutAnalyticsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetSharedShortcutAnalyticsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.interval = getShortcutAnalyticsRequest_IntervalFromJSON(reader.int32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetSharedShortcutAnalyticsRequest>): GetSharedShortcutAnalyticsRequest {
+    return GetSharedShortcutAnalyticsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetSharedShortcutAnalyticsRequest>): GetSharedShortcutAnalyticsRequest {
+    const message = createBaseGetSharedShortcutAnalyticsRequest();
+    message.token = object.token ?? "";
+    message.interval = object.interval ?? GetShortcutAnalyticsRequest_Interval.INTERVAL_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseSharedShortcutAnalytics(): SharedShortcutAnalytics {
+  return { shortcutName: "", shortcutTitle: "", analytics: undefined, expireTime: undefined };
+}
+
+export const SharedShortcutAnalytics: MessageFns<SharedShortcutAnalytics> = {
+  encode(message: SharedShortcutAnalytics, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutName !== "") {
+      writer.uint32(10).string(message.shortcutName);
+    }
+    if (message.shortcutTitle !== "") {
+      writer.uint32(18).string(message.shortcutTitle);
+    }
+    if (message.analytics !== undefined) {
+      GetShortcutAnalyticsResponse.encode(message.analytics, writer.uint32(26).fork()).join();
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SharedShortcutAnalytics {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSharedShortcutAnalytics();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shortcutName = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.shortcutTitle = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.analytics = GetShortcutAnalyticsResponse.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SharedShortcutAnalytics>): SharedShortcutAnalytics {
+    return SharedShortcutAnalytics.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SharedShortcutAnalytics>): SharedShortcutAnalytics {
+    const message = createBaseSharedShortcutAnalytics();
+    message.shortcutName = object.shortcutName ?? "";
+    message.shortcutTitle = object.shortcutTitle ?? "";
+    message.analytics = (object.analytics !== undefined && object.analytics !== null)
+      ? GetShortcutAnalyticsResponse.fromPartial(object.analytics)
+      : undefined;
+    message.expireTime = object.expireTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseGetShortcutQRCodeRequest(): GetShortcutQRCodeRequest {
+  return { id: 0, format: GetShortcutQRCodeRequest_Format.FORMAT_UNSPECIFIED, size: 0 };
+}
+
+export const GetShortcutQRCodeRequest: MessageFns<GetShortcutQRCodeRequest> = {
+  encode(message: GetShortcutQRCodeRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.format !== GetShortcutQRCodeRequest_Format.FORMAT_UNSPECIFIED) {
+      writer.uint32(16).int32(getShortcutQRCodeRequest_FormatToNumber(message.format));
+    }
+    if (message.size !== 0) {
+      writer.uint32(24).int32(message.size);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutQRCodeRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutQRCodeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.format = getShortcutQRCodeRequest_FormatFromJSON(reader.int32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.size = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetShortcutQRCodeRequest>): GetShortcutQRCodeRequest {
+    return GetShortcutQRCodeRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetShortcutQRCodeRequest>): GetShortcutQRCodeRequest {
+    const message = createBaseGetShortcutQRCodeRequest();
+    message.id = object.id ?? 0;
+    message.format = object.format ?? GetShortcutQRCodeRequest_Format.FORMAT_UNSPECIFIED;
+    message.size = object.size ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetShortcutQRCodeResponse(): GetShortcutQRCodeResponse {
+  return { contentType: "", content: new Uint8Array(0) };
+}
+
+export const GetShortcutQRCodeResponse: MessageFns<GetShortcutQRCodeResponse> = {
+  encode(message: GetShortcutQRCodeResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.contentType !== "") {
+      writer.uint32(10).string(message.contentType);
+    }
+    if (message.content.length !== 0) {
+      writer.uint32(18).bytes(message.content);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutQRCodeResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutQRCodeResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.contentType = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.content = reader.bytes();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetShortcutQRCodeResponse>): GetShortcutQRCodeResponse {
+    return GetShortcutQRCodeResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetShortcutQRCodeResponse>): GetShortcutQRCodeResponse {
+    const message = createBaseGetShortcutQRCodeResponse();
+    message.contentType = object.contentType ?? "";
+    message.content = object.content ?? new Uint8Array(0);
+    return message;
+  },
+};
+
+function createBaseListBrokenShortcutsRequest(): ListBrokenShortcutsRequest {
+  return {};
+}
+
+export const ListBrokenShortcutsRequest: MessageFns<ListBrokenShortcutsRequest> = {
+  encode(_: ListBrokenShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListBrokenShortcutsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListBrokenShortcutsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListBrokenShortcutsRequest>): ListBrokenShortcutsRequest {
+    return ListBrokenShortcutsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<ListBrokenShortcutsRequest>): ListBrokenShortcutsRequest {
+    const message = createBaseListBrokenShortcutsRequest();
+    return message;
+  },
+};
+
+function createBaseListBrokenShortcutsResponse(): ListBrokenShortcutsResponse {
+  return { shortcuts: [] };
+}
+
+export const ListBrokenShortcutsResponse: MessageFns<ListBrokenShortcutsResponse> = {
+  encode(message: ListBrokenShortcutsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.shortcuts) {
+      Shortcut.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListBrokenShortcutsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListBrokenShortcutsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shortcuts.push(Shortcut.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListBrokenShortcutsResponse>): ListBrokenShortcutsResponse {
+    return ListBrokenShortcutsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListBrokenShortcutsResponse>): ListBrokenShortcutsResponse {
+    const message = createBaseListBrokenShortcutsResponse();
+    message.shortcuts = object.shortcuts?.map((e) => Shortcut.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRefreshShortcutMetadataRequest(): RefreshShortcutMetadataRequest {
+  return { id: 0 };
+}
+
+export const RefreshShortcutMetadataRequest: MessageFns<RefreshShortcutMetadataRequest> = {
+  encode(message: RefreshShortcutMetadataRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RefreshShortcutMetadataRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRefreshShortcutMetadataRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RefreshShortcutMetadataRequest>): RefreshShortcutMetadataRequest {
+    return RefreshShortcutMetadataRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RefreshShortcutMetadataRequest>): RefreshShortcutMetadataRequest {
+    const message = createBaseRefreshShortcutMetadataRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseSuggestShortcutRequest(): SuggestShortcutRequest {
+  return { link: "" };
+}
+
+export const SuggestShortcutRequest: MessageFns<SuggestShortcutRequest> = {
+  encode(message: SuggestShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.link !== "") {
+      writer.uint32(10).string(message.link);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SuggestShortcutRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSuggestShortcutRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SuggestShortcutRequest>): SuggestShortcutRequest {
+    return SuggestShortcutRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SuggestShortcutRequest>): SuggestShortcutRequest {
+    const message = createBaseSuggestShortcutRequest();
+    message.link = object.link ?? "";
+    return message;
+  },
+};
+
+function createBaseSuggestShortcutResponse(): SuggestShortcutResponse {
+  return { title: "", description: "", tags: [] };
+}
+
+export const SuggestShortcutResponse: MessageFns<SuggestShortcutResponse> = {
+  encode(message: SuggestShortcutResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
+    }
+    if (message.description !== "") {
+      writer.uint32(18).string(message.description);
+    }
+    for (const v of message.tags) {
+      writer.uint32(26).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SuggestShortcutResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSuggestShortcutResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SuggestShortcutResponse>): SuggestShortcutResponse {
+    return SuggestShortcutResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SuggestShortcutResponse>): SuggestShortcutResponse {
+    const message = createBaseSuggestShortcutResponse();
+    message.title = object.title ?? "";
+    message.description = object.description ?? "";
+    message.tags = object.tags?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseSemanticSearchShortcutsRequest(): SemanticSearchShortcutsRequest {
+  return { query: "", limit: 0 };
+}
+
+export const SemanticSearchShortcutsRequest: MessageFns<SemanticSearchShortcutsRequest> = {
+  encode(message: SemanticSearchShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.query !== "") {
+      writer.uint32(10).string(message.query);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(16).int32(message.limit);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SemanticSearchShortcutsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSemanticSearchShortcutsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.query = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SemanticSearchShortcutsRequest>): SemanticSearchShortcutsRequest {
+    return SemanticSearchShortcutsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SemanticSearchShortcutsRequest>): SemanticSearchShortcutsRequest {
+    const message = createBaseSemanticSearchShortcutsRequest();
+    message.query = object.query ?? "";
+    message.limit = object.limit ?? 0;
+    return message;
+  },
+};
+
+function createBaseSemanticSearchShortcutsResponse(): SemanticSearchShortcutsResponse {
+  return { results: [] };
+}
+
+export const SemanticSearchShortcutsResponse: MessageFns<SemanticSearchShortcutsResponse> = {
+  encode(message: SemanticSearchShortcutsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.results) {
+      SemanticSearchShortcutsResponse_Result.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SemanticSearchShortcutsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSemanticSearchShortcutsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.results.push(SemanticSearchShortcutsResponse_Result.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SemanticSearchShortcutsResponse>): SemanticSearchShortcutsResponse {
+    return SemanticSearchShortcutsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SemanticSearchShortcutsResponse>): SemanticSearchShortcutsResponse {
+    const message = createBaseSemanticSearchShortcutsResponse();
+    message.results = object.results?.map((e) => SemanticSearchShortcutsResponse_Result.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSemanticSearchShortcutsResponse_Result(): SemanticSearchShortcutsResponse_Result {
+  return { shortcut: undefined, score: 0 };
+}
+
+export const SemanticSearchShortcutsResponse_Result: MessageFns<SemanticSearchShortcutsResponse_Result> = {
+  encode(message: SemanticSearchShortcutsResponse_Result, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcut !== undefined) {
+      Shortcut.encode(message.shortcut, writer.uint32(10).fork()).join();
+    }
+    if (message.score !== 0) {
+      writer.uint32(17).double(message.score);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SemanticSearchShortcutsResponse_Result {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSemanticSearchShortcutsResponse_Result();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.score = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SemanticSearchShortcutsResponse_Result>): SemanticSearchShortcutsResponse_Result {
+    return SemanticSearchShortcutsResponse_Result.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SemanticSearchShortcutsResponse_Result>): SemanticSearchShortcutsResponse_Result {
+    const message = createBaseSemanticSearchShortcutsResponse_Result();
+    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
+      ? Shortcut.fromPartial(object.shortcut)
+      : undefined;
+    message.score = object.score ?? 0;
+    return message;
+  },
+};
+
+function createBaseGenerateSignedRedirectRequest(): GenerateSignedRedirectRequest {
+  return { id: 0, expireTime: undefined };
+}
+
+export const GenerateSignedRedirectRequest: MessageFns<GenerateSignedRedirectRequest> = {
+  encode(message: GenerateSignedRedirectRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GenerateSignedRedirectRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGenerateSignedRedirectRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GenerateSignedRedirectRequest>): GenerateSignedRedirectRequest {
+    return GenerateSignedRedirectRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GenerateSignedRedirectRequest>): GenerateSignedRedirectRequest {
+    const message = createBaseGenerateSignedRedirectRequest();
+    message.id = object.id ?? 0;
+    message.expireTime = object.expireTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseGenerateSignedRedirectResponse(): GenerateSignedRedirectResponse {
+  return { url: "", expireTime: undefined };
+}
+
+export const GenerateSignedRedirectResponse: MessageFns<GenerateSignedRedirectResponse> = {
+  encode(message: GenerateSignedRedirectResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.url !== "") {
+      writer.uint32(10).string(message.url);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GenerateSignedRedirectResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGenerateSignedRedirectResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GenerateSignedRedirectResponse>): GenerateSignedRedirectResponse {
+    return GenerateSignedRedirectResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GenerateSignedRedirectResponse>): GenerateSignedRedirectResponse {
+    const message = createBaseGenerateSignedRedirectResponse();
+    message.url = object.url ?? "";
+    message.expireTime = object.expireTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseGetResolutionSnapshotRequest(): GetResolutionSnapshotRequest {
+  return { sinceVersion: "" };
+}
+
+export const GetResolutionSnapshotRequest: MessageFns<GetResolutionSnapshotRequest> = {
+  encode(message: GetResolutionSnapshotRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.sinceVersion !== "") {
+      writer.uint32(10).string(message.sinceVersion);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetResolutionSnapshotRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResolutionSnapshotRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.sinceVersion = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetResolutionSnapshotRequest>): GetResolutionSnapshotRequest {
+    return GetResolutionSnapshotRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetResolutionSnapshotRequest>): GetResolutionSnapshotRequest {
+    const message = createBaseGetResolutionSnapshotRequest();
+    message.sinceVersion = object.sinceVersion ?? "";
+    return message;
+  },
+};
+
+function createBaseResolutionSnapshot(): ResolutionSnapshot {
+  return {
+    version: "",
+    baseVersion: "",
+    links: {},
+    removedNames: [],
+    signature: new Uint8Array(0),
+    publicKey: new Uint8Array(0),
+    createTime: undefined,
+  };
+}
+
+export const ResolutionSnapshot: MessageFns<ResolutionSnapshot> = {
+  encode(message: ResolutionSnapshot, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.version !== "") {
+      writer.uint32(10).string(message.version);
+    }
+    if (message.baseVersion !== "") {
+      writer.uint32(18).string(message.baseVersion);
+    }
+    Object.entries(message.links).forEach(([key, value]) => {
+      ResolutionSnapshot_LinksEntry.encode({ key: key as any, value }, writer.uint32(26).fork()).join();
+    });
+    for (const v of message.removedNames) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.signature.length !== 0) {
+      writer.uint32(42).bytes(message.signature);
+    }
+    if (message.publicKey.length !== 0) {
+      writer.uint32(50).bytes(message.publicKey);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(58).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResolutionSnapshot {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResolutionSnapshot();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.baseVersion = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          const entry3 = ResolutionSnapshot_LinksEntry.decode(reader, reader.uint32());
+          if (entry3.value !== undefined) {
+            message.links[entry3.key] = entry3.value;
+          }
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.removedNames.push(reader.string());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.signature = reader.bytes();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.publicKey = reader.bytes();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ResolutionSnapshot>): ResolutionSnapshot {
+    return ResolutionSnapshot.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ResolutionSnapshot>): ResolutionSnapshot {
+    const message = createBaseResolutionSnapshot();
+    message.version = object.version ?? "";
+    message.baseVersion = object.baseVersion ?? "";
+    message.links = Object.entries(object.links ?? {}).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+      if (value !== undefined) {
+        acc[key] = globalThis.String(value);
+      }
+      return acc;
+    }, {});
+    message.removedNames = object.removedNames?.map((e) => e) || [];
+    message.signature = object.signature ?? new Uint8Array(0);
+    message.publicKey = object.publicKey ?? new Uint8Array(0);
+    message.createTime = object.createTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseResolutionSnapshot_LinksEntry(): ResolutionSnapshot_LinksEntry {
+  return { key: "", value: "" };
+}
+
+export const ResolutionSnapshot_LinksEntry: MessageFns<ResolutionSnapshot_LinksEntry> = {
+  encode(message: ResolutionSnapshot_LinksEntry, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResolutionSnapshot_LinksEntry {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResolutionSnapshot_LinksEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ResolutionSnapshot_LinksEntry>): ResolutionSnapshot_LinksEntry {
+    return ResolutionSnapshot_LinksEntry.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ResolutionSnapshot_LinksEntry>): ResolutionSnapshot_LinksEntry {
+    const message = createBaseResolutionSnapshot_LinksEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseGetTrendingShortcutsRequest(): GetTrendingShortcutsRequest {
+  return { window: GetTrendingShortcutsRequest_Window.WINDOW_UNSPECIFIED, limit: 0 };
+}
+
+export const GetTrendingShortcutsRequest: MessageFns<GetTrendingShortcutsRequest> = {
+  encode(message: GetTrendingShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.window !== GetTrendingShortcutsRequest_Window.WINDOW_UNSPECIFIED) {
+      writer.uint32(8).int32(getTrendingShortcutsRequest_WindowToNumber(message.window));
+    }
+    if (message.limit !== 0) {
+      writer.uint32(16).int32(message.limit);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetTrendingShortcutsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetTrendingShortcutsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.window = getTrendingShortcutsRequest_WindowFromJSON(reader.int32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetTrendingShortcutsRequest>): GetTrendingShortcutsRequest {
+    return GetTrendingShortcutsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetTrendingShortcutsRequest>): GetTrendingShortcutsRequest {
+    const message = createBaseGetTrendingShortcutsRequest();
+    message.window = object.window ?? GetTrendingShortcutsRequest_Window.WINDOW_UNSPECIFIED;
+    message.limit = object.limit ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetTrendingShortcutsResponse(): GetTrendingShortcutsResponse {
+  return { trendingShortcuts: [] };
+}
+
+export const GetTrendingShortcutsResponse: MessageFns<GetTrendingShortcutsResponse> = {
+  encode(message: GetTrendingShortcutsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.trendingShortcuts) {
+      GetTrendingShortcutsResponse_TrendingShortcut.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetTrendingShortcutsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetTrendingShortcutsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.trendingShortcuts.push(GetTrendingShortcutsResponse_TrendingShortcut.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetTrendingShortcutsResponse>): GetTrendingShortcutsResponse {
+    return GetTrendingShortcutsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetTrendingShortcutsResponse>): GetTrendingShortcutsResponse {
+    const message = createBaseGetTrendingShortcutsResponse();
+    message.trendingShortcuts =
+      object.trendingShortcuts?.map((e) => GetTrendingShortcutsResponse_TrendingShortcut.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGetTrendingShortcutsResponse_TrendingShortcut(): GetTrendingShortcutsResponse_TrendingShortcut {
+  return { shortcut: undefined, viewCount: 0, previousViewCount: 0 };
+}
+
+export const GetTrendingShortcutsResponse_TrendingShortcut: MessageFns<GetTrendingShortcutsResponse_TrendingShortcut> = {
+  encode(
+    message: GetTrendingShortcutsResponse_TrendingShortcut,
+    writer: BinaryWriter = new BinaryWriter(),
+  ): BinaryWriter {
+    if (message.shortcut !== undefined) {
+      Shortcut.encode(message.shortcut, writer.uint32(10).fork()).join();
+    }
+    if (message.viewCount !== 0) {
+      writer.uint32(16).int32(message.viewCount);
+    }
+    if (message.previousViewCount !== 0) {
+      writer.uint32(24).int32(message.previousViewCount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetTrendingShortcutsResponse_TrendingShortcut {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetTrendingShortcutsResponse_TrendingShortcut();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.viewCount = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.previousViewCount = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(
+    base?: DeepPartial<GetTrendingShortcutsResponse_TrendingShortcut>,
+  ): GetTrendingShortcutsResponse_TrendingShortcut {
+    return GetTrendingShortcutsResponse_TrendingShortcut.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<GetTrendingShortcutsResponse_TrendingShortcut>,
+  ): GetTrendingShortcutsResponse_TrendingShortcut {
+    const message = createBaseGetTrendingShortcutsResponse_TrendingShortcut();
+    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
+      ? Shortcut.fromPartial(object.shortcut)
+      : undefined;
+    message.viewCount = object.viewCount ?? 0;
+    message.previousViewCount = object.previousViewCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseProposedChange(): ProposedChange {
+  return {
+    id: 0,
+    shortcutId: 0,
+    proposerId: 0,
+    proposerUsername: "",
+    createdTime: undefined,
+    status: ProposedChange_Status.STATUS_UNSPECIFIED,
+    changes: [],
+    reviewerId: 0,
+    reviewedTime: undefined,
+  };
+}
+
+export const ProposedChange: MessageFns<ProposedChange> = {
+  encode(message: ProposedChange, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.shortcutId !== 0) {
+      writer.uint32(16).int32(message.shortcutId);
+    }
+    if (message.proposerId !== 0) {
+      writer.uint32(24).int32(message.proposerId);
+    }
+    if (message.proposerUsername !== "") {
+      writer.uint32(34).string(message.proposerUsername);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(42).fork()).join();
+    }
+    if (message.status !== ProposedChange_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(48).int32(proposedChange_StatusToNumber(message.status));
+    }
+    for (const v of message.changes) {
+      ProposedChange_FieldChange.encode(v!, writer.uint32(58).fork()).join();
+    }
+    if (message.reviewerId !== 0) {
+      writer.uint32(64).int32(message.reviewerId);
+    }
+    if (message.reviewedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.reviewedTime), writer.uint32(74).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ProposedChange {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProposedChange();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.proposerId = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.proposerUsername = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.status = proposedChange_StatusFromJSON(reader.int32());
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.changes.push(ProposedChange_FieldChange.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.reviewerId = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.reviewedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ProposedChange>): ProposedChange {
+    return ProposedChange.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ProposedChange>): ProposedChange {
+    const message = createBaseProposedChange();
+    message.id = object.id ?? 0;
+    message.shortcutId = object.shortcutId ?? 0;
+    message.proposerId = object.proposerId ?? 0;
+    message.proposerUsername = object.proposerUsername ?? "";
+    message.createdTime = object.createdTime ?? undefined;
+    message.status = object.status ?? ProposedChange_Status.STATUS_UNSPECIFIED;
+    message.changes = object.changes?.map((e) => ProposedChange_FieldChange.fromPartial(e)) || [];
+    message.reviewerId = object.reviewerId ?? 0;
+    message.reviewedTime = object.reviewedTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseProposedChange_FieldChange(): ProposedChange_FieldChange {
+  return { field: "", previousValue: "", proposedValue: "" };
+}
+
+export const ProposedChange_FieldChange: MessageFns<ProposedChange_FieldChange> = {
+  encode(message: ProposedChange_FieldChange, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.field !== "") {
+      writer.uint32(10).string(message.field);
+    }
+    if (message.previousValue !== "") {
+      writer.uint32(18).string(message.previousValue);
+    }
+    if (message.proposedValue !== "") {
+      writer.uint32(26).string(message.proposedValue);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ProposedChange_FieldChange {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProposedChange_FieldChange();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.field = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.previousValue = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.proposedValue = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ProposedChange_FieldChange>): ProposedChange_FieldChange {
+    return ProposedChange_FieldChange.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ProposedChange_FieldChange>): ProposedChange_FieldChange {
+    const message = createBaseProposedChange_FieldChange();
+    message.field = object.field ?? "";
+    message.previousValue = object.previousValue ?? "";
+    message.proposedValue = object.proposedValue ?? "";
+    return message;
+  },
+};
+
+function createBaseListProposedChangesRequest(): ListProposedChangesRequest {
+  return { shortcutId: 0, status: ProposedChange_Status.STATUS_UNSPECIFIED };
+}
+
+export const ListProposedChangesRequest: MessageFns<ListProposedChangesRequest> = {
+  encode(message: ListProposedChangesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.status !== ProposedChange_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(16).int32(proposedChange_StatusToNumber(message.status));
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListProposedChangesRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListProposedChangesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.status = proposedChange_StatusFromJSON(reader.int32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListProposedChangesRequest>): ListProposedChangesRequest {
+    return ListProposedChangesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListProposedChangesRequest>): ListProposedChangesRequest {
+    const message = createBaseListProposedChangesRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.status = object.status ?? ProposedChange_Status.STATUS_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseListProposedChangesResponse(): ListProposedChangesResponse {
+  return { proposedChanges: [] };
+}
+
+export const ListProposedChangesResponse: MessageFns<ListProposedChangesResponse> = {
+  encode(message: ListProposedChangesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.proposedChanges) {
+      ProposedChange.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListProposedChangesResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListProposedChangesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.proposedChanges.push(ProposedChange.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListProposedChangesResponse>): ListProposedChangesResponse {
+    return ListProposedChangesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListProposedChangesResponse>): ListProposedChangesResponse {
+    const message = createBaseListProposedChangesResponse();
+    message.proposedChanges = object.proposedChanges?.map((e) => ProposedChange.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseApproveProposedChangeRequest(): ApproveProposedChangeRequest {
+  return { shortcutId: 0, id: 0 };
+}
+
+export const ApproveProposedChangeRequest: MessageFns<ApproveProposedChangeRequest> = {
+  encode(message: ApproveProposedChangeRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.id !== 0) {
+      writer.uint32(16).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ApproveProposedChangeRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApproveProposedChangeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ApproveProposedChangeRequest>): ApproveProposedChangeRequest {
+    return ApproveProposedChangeRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ApproveProposedChangeRequest>): ApproveProposedChangeRequest {
+    const message = createBaseApproveProposedChangeRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseRejectProposedChangeRequest(): RejectProposedChangeRequest {
+  return { shortcutId: 0, id: 0 };
+}
+
+export const RejectProposedChangeRequest: MessageFns<RejectProposedChangeRequest> = {
+  encode(message: RejectProposedChangeRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.id !== 0) {
+      writer.uint32(16).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RejectProposedChangeRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRejectProposedChangeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RejectProposedChangeRequest>): RejectProposedChangeRequest {
+    return RejectProposedChangeRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RejectProposedChangeRequest>): RejectProposedChangeRequest {
+    const message = createBaseRejectProposedChangeRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseShortcutRotation(): ShortcutRotation {
+  return {
+    id: 0,
+    shortcutId: 0,
+    creatorId: 0,
+    createdTime: undefined,
+    link: "",
+    startTime: undefined,
+    endTime: undefined,
+  };
+}
+
+export const ShortcutRotation: MessageFns<ShortcutRotation> = {
+  encode(message: ShortcutRotation, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.shortcutId !== 0) {
+      writer.uint32(16).int32(message.shortcutId);
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(24).int32(message.creatorId);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(34).fork()).join();
+    }
+    if (message.link !== "") {
+      writer.uint32(42).string(message.link);
+    }
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(50).fork()).join();
+    }
+    if (message.endTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.endTime), writer.uint32(58).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutRotation {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutRotation();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.endTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutRotation>): ShortcutRotation {
+    return ShortcutRotation.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutRotation>): ShortcutRotation {
+    const message = createBaseShortcutRotation();
+    message.id = object.id ?? 0;
+    message.shortcutId = object.shortcutId ?? 0;
+    message.creatorId = object.creatorId ?? 0;
+    message.createdTime = object.createdTime ?? undefined;
+    message.link = object.link ?? "";
+    message.startTime = object.startTime ?? undefined;
+    message.endTime = object.endTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseListShortcutRotationsRequest(): ListShortcutRotationsRequest {
+  return { shortcutId: 0 };
+}
+
+export const ListShortcutRotationsRequest: MessageFns<ListShortcutRotationsRequest> = {
+  encode(message: ListShortcutRotationsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutRotationsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutRotationsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutRotationsRequest>): ListShortcutRotationsRequest {
+    return ListShortcutRotationsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutRotationsRequest>): ListShortcutRotationsRequest {
+    const message = createBaseListShortcutRotationsRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    return message;
+  },
+};
+
+function createBaseListShortcutRotationsResponse(): ListShortcutRotationsResponse {
+  return { rotations: [] };
+}
+
+export const ListShortcutRotationsResponse: MessageFns<ListShortcutRotationsResponse> = {
+  encode(message: ListShortcutRotationsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.rotations) {
+      ShortcutRotation.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutRotationsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutRotationsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.rotations.push(ShortcutRotation.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutRotationsResponse>): ListShortcutRotationsResponse {
+    return ListShortcutRotationsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutRotationsResponse>): ListShortcutRotationsResponse {
+    const message = createBaseListShortcutRotationsResponse();
+    message.rotations = object.rotations?.map((e) => ShortcutRotation.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseCreateShortcutRotationRequest(): CreateShortcutRotationRequest {
+  return { shortcutId: 0, rotation: undefined };
+}
+
+export const CreateShortcutRotationRequest: MessageFns<CreateShortcutRotationRequest> = {
+  encode(message: CreateShortcutRotationRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.rotation !== undefined) {
+      ShortcutRotation.encode(message.rotation, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateShortcutRotationRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateShortcutRotationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.rotation = ShortcutRotation.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateShortcutRotationRequest>): CreateShortcutRotationRequest {
+    return CreateShortcutRotationRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateShortcutRotationRequest>): CreateShortcutRotationRequest {
+    const message = createBaseCreateShortcutRotationRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.rotation = (object.rotation !== undefined && object.rotation !== null)
+      ? ShortcutRotation.fromPartial(object.rotation)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseDeleteShortcutRotationRequest(): DeleteShortcutRotationRequest {
+  return { shortcutId: 0, id: 0 };
+}
+
+export const DeleteShortcutRotationRequest: MessageFns<DeleteShortcutRotationRequest> = {
+  encode(message: DeleteShortcutRotationRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.id !== 0) {
+      writer.uint32(16).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteShortcutRotationRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteShortcutRotationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeleteShortcutRotationRequest>): DeleteShortcutRotationRequest {
+    return DeleteShortcutRotationRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteShortcutRotationRequest>): DeleteShortcutRotationRequest {
+    const message = createBaseDeleteShortcutRotationRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseShortcutACL(): ShortcutACL {
+  return { shortcutId: 0, userId: 0, role: ShortcutACL_Role.ROLE_UNSPECIFIED, createdTime: undefined };
+}
+
+export const ShortcutACL: MessageFns<ShortcutACL> = {
+  encode(message: ShortcutACL, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    if (message.role !== ShortcutACL_Role.ROLE_UNSPECIFIED) {
+      writer.uint32(24).int32(shortcutACL_RoleToNumber(message.role));
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutACL {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutACL();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.role = shortcutACL_RoleFromJSON(reader.int32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutACL>): ShortcutACL {
+    return ShortcutACL.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutACL>): ShortcutACL {
+    const message = createBaseShortcutACL();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.userId = object.userId ?? 0;
+    message.role = object.role ?? ShortcutACL_Role.ROLE_UNSPECIFIED;
+    message.createdTime = object.createdTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseListShortcutACLsRequest(): ListShortcutACLsRequest {
+  return { shortcutId: 0 };
+}
+
+export const ListShortcutACLsRequest: MessageFns<ListShortcutACLsRequest> = {
+  encode(message: ListShortcutACLsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutACLsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutACLsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutACLsRequest>): ListShortcutACLsRequest {
+    return ListShortcutACLsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutACLsRequest>): ListShortcutACLsRequest {
+    const message = createBaseListShortcutACLsRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    return message;
+  },
+};
+
+function createBaseListShortcutACLsResponse(): ListShortcutACLsResponse {
+  return { acls: [] };
+}
+
+export const ListShortcutACLsResponse: MessageFns<ListShortcutACLsResponse> = {
+  encode(message: ListShortcutACLsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.acls) {
+      ShortcutACL.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutACLsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutACLsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.acls.push(ShortcutACL.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutACLsResponse>): ListShortcutACLsResponse {
+    return ListShortcutACLsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutACLsResponse>): ListShortcutACLsResponse {
+    const message = createBaseListShortcutACLsResponse();
+    message.acls = object.acls?.map((e) => ShortcutACL.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseUpsertShortcutACLRequest(): UpsertShortcutACLRequest {
+  return { shortcutId: 0, acl: undefined };
+}
+
+export const UpsertShortcutACLRequest: MessageFns<UpsertShortcutACLRequest> = {
+  encode(message: UpsertShortcutACLRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.acl !== undefined) {
+      ShortcutACL.encode(message.acl, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UpsertShortcutACLRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpsertShortcutACLRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.acl = ShortcutACL.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UpsertShortcutACLRequest>): UpsertShortcutACLRequest {
+    return UpsertShortcutACLRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpsertShortcutACLRequest>): UpsertShortcutACLRequest {
+    const message = createBaseUpsertShortcutACLRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.acl = (object.acl !== undefined && object.acl !== null) ? ShortcutACL.fromPartial(object.acl) : undefined;
+    return message;
+  },
+};
+
+function createBaseDeleteShortcutACLRequest(): DeleteShortcutACLRequest {
+  return { shortcutId: 0, userId: 0 };
+}
+
+export const DeleteShortcutACLRequest: MessageFns<DeleteShortcutACLRequest> = {
+  encode(message: DeleteShortcutACLRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteShortcutACLRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteShortcutACLRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeleteShortcutACLRequest>): DeleteShortcutACLRequest {
+    return DeleteShortcutACLRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteShortcutACLRequest>): DeleteShortcutACLRequest {
+    const message = createBaseDeleteShortcutACLRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.userId = object.userId ?? 0;
+    return message;
+  },
+};
+
+function createBaseCreateImportJobRequest(): CreateImportJobRequest {
+  return { format: "", content: "" };
+}
+
+export const CreateImportJobRequest: MessageFns<CreateImportJobRequest> = {
+  encode(message: CreateImportJobRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.format !== "") {
+      writer.uint32(10).string(message.format);
+    }
+    if (message.content !== "") {
+      writer.uint32(18).string(message.content);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateImportJobRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateImportJobRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.format = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateImportJobRequest>): CreateImportJobRequest {
+    return CreateImportJobRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateImportJobRequest>): CreateImportJobRequest {
+    const message = createBaseCreateImportJobRequest();
+    message.format = object.format ?? "";
+    message.content = object.content ?? "";
+    return message;
+  },
+};
+
+function createBaseGetImportJobRequest(): GetImportJobRequest {
+  return { id: 0 };
+}
+
+export const GetImportJobRequest: MessageFns<GetImportJobRequest> = {
+  encode(message: GetImportJobRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetImportJobRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetImportJobRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetImportJobRequest>): GetImportJobRequest {
+    return GetImportJobRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetImportJobRequest>): GetImportJobRequest {
+    const message = createBaseGetImportJobRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseListImportJobsRequest(): ListImportJobsRequest {
+  return {};
+}
+
+export const ListImportJobsRequest: MessageFns<ListImportJobsRequest> = {
+  encode(_: ListImportJobsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListImportJobsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListImportJobsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListImportJobsRequest>): ListImportJobsRequest {
+    return ListImportJobsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<ListImportJobsRequest>): ListImportJobsRequest {
+    const message = createBaseListImportJobsRequest();
+    return message;
+  },
+};
+
+function createBaseListImportJobsResponse(): ListImportJobsResponse {
+  return { importJobs: [] };
+}
+
+export const ListImportJobsResponse: MessageFns<ListImportJobsResponse> = {
+  encode(message: ListImportJobsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.importJobs) {
+      ImportJob.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListImportJobsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListImportJobsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.importJobs.push(ImportJob.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListImportJobsResponse>): ListImportJobsResponse {
+    return ListImportJobsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListImportJobsResponse>): ListImportJobsResponse {
+    const message = createBaseListImportJobsResponse();
+    message.importJobs = object.importJobs?.map((e) => ImportJob.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseResumeImportJobRequest(): ResumeImportJobRequest {
+  return { id: 0 };
+}
+
+export const ResumeImportJobRequest: MessageFns<ResumeImportJobRequest> = {
+  encode(message: ResumeImportJobRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResumeImportJobRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResumeImportJobRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ResumeImportJobRequest>): ResumeImportJobRequest {
+    return ResumeImportJobRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ResumeImportJobRequest>): ResumeImportJobRequest {
+    const message = createBaseResumeImportJobRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseImportJob(): ImportJob {
+  return {
+    id: 0,
+    creatorId: 0,
+    createdTime: undefined,
+    updatedTime: undefined,
+    status: ImportJob_Status.STATUS_UNSPECIFIED,
+    format: "",
+    totalRows: 0,
+    processedRows: 0,
+    createdCount: 0,
+    failedCount: 0,
+    rowErrors: [],
+    error: "",
+  };
+}
+
+export const ImportJob: MessageFns<ImportJob> = {
+  encode(message: ImportJob, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(16).int32(message.creatorId);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(26).fork()).join();
+    }
+    if (message.updatedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updatedTime), writer.uint32(34).fork()).join();
+    }
+    if (message.status !== ImportJob_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(40).int32(importJob_StatusToNumber(message.status));
+    }
+    if (message.format !== "") {
+      writer.uint32(50).string(message.format);
+    }
+    if (message.totalRows !== 0) {
+      writer.uint32(56).int32(message.totalRows);
+    }
+    if (message.processedRows !== 0) {
+      writer.uint32(64).int32(message.processedRows);
+    }
+    if (message.createdCount !== 0) {
+      writer.uint32(72).int32(message.createdCount);
+    }
+    if (message.failedCount !== 0) {
+      writer.uint32(80).int32(message.failedCount);
+    }
+    for (const v of message.rowErrors) {
+      ImportJob_RowError.encode(v!, writer.uint32(90).fork()).join();
+    }
+    if (message.error !== "") {
+      writer.uint32(98).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ImportJob {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportJob();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.updatedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.status = importJob_StatusFromJSON(reader.int32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.format = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.totalRows = reader.int32();
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.processedRows = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.createdCount = reader.int32();
+          continue;
+        }
+        case 10: {
+          if (tag !== 80) {
+            break;
+          }
+
+          message.failedCount = reader.int32();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.rowErrors.push(ImportJob_RowError.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ImportJob>): ImportJob {
+    return ImportJob.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportJob>): ImportJob {
+    const message = createBaseImportJob();
+    message.id = object.id ?? 0;
+    message.creatorId = object.creatorId ?? 0;
+    message.createdTime = object.createdTime ?? undefined;
+    message.updatedTime = object.updatedTime ?? undefined;
+    message.status = object.status ?? ImportJob_Status.STATUS_UNSPECIFIED;
+    message.format = object.format ?? "";
+    message.totalRows = object.totalRows ?? 0;
+    message.processedRows = object.processedRows ?? 0;
+    message.createdCount = object.createdCount ?? 0;
+    message.failedCount = object.failedCount ?? 0;
+    message.rowErrors = object.rowErrors?.map((e) => ImportJob_RowError.fromPartial(e)) || [];
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+function createBaseImportJob_RowError(): ImportJob_RowError {
+  return { row: 0, name: "", error: "" };
+}
+
+export const ImportJob_RowError: MessageFns<ImportJob_RowError> = {
+  encode(message: ImportJob_RowError, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.row !== 0) {
+      writer.uint32(8).int32(message.row);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.error !== "") {
+      writer.uint32(26).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ImportJob_RowError {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportJob_RowError();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.row = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ImportJob_RowError>): ImportJob_RowError {
+    return ImportJob_RowError.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportJob_RowError>): ImportJob_RowError {
+    const message = createBaseImportJob_RowError();
+    message.row = object.row ?? 0;
+    message.name = object.name ?? "";
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+export type ShortcutServiceDefinition = typeof ShortcutServiceDefinition;
+export const ShortcutServiceDefinition = {
   name: "ShortcutService",
   fullName: "slash.api.v1.ShortcutService",
   methods: {
@@ -852,31 +7079,1750 @@ export const ShortcutServiceDefinition = {
       name: "ListShortcuts",
       requestType: ListShortcutsRequest,
       requestStream: false,
-      responseType: ListShortcutsResponse,
+      responseType: ListShortcutsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([19, 18, 17, 47, 97, 112, 105, 47, 118, 49, 47, 115, 104, 111, 114, 116, 99, 117, 116, 115]),
+          ],
+        },
+      },
+    },
+    /** SearchShortcuts returns the shortcuts matching the query, ordered by relevance. */
+    searchShortcuts: {
+      name: "SearchShortcuts",
+      requestType: SearchShortcutsRequest,
+      requestStream: false,
+      responseType: SearchShortcutsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              26,
+              18,
+              24,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              58,
+              115,
+              101,
+              97,
+              114,
+              99,
+              104,
+            ]),
+          ],
+        },
+      },
+    },
+    /** BulkUpdateShortcutTags adds, removes or replaces a tag of the shortcuts matching the filter. Only for admins. */
+    bulkUpdateShortcutTags: {
+      name: "BulkUpdateShortcutTags",
+      requestType: BulkUpdateShortcutTagsRequest,
+      requestStream: false,
+      responseType: BulkUpdateShortcutTagsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              37,
+              58,
+              1,
+              42,
+              34,
+              32,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              58,
+              98,
+              117,
+              108,
+              107,
+              85,
+              112,
+              100,
+              97,
+              116,
+              101,
+              84,
+              97,
+              103,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** MergeShortcuts merges duplicate shortcuts into a survivor, whose aliases the other names become. Only for admins. */
+    mergeShortcuts: {
+      name: "MergeShortcuts",
+      requestType: MergeShortcutsRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              28,
+              58,
+              1,
+              42,
+              34,
+              23,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              58,
+              109,
+              101,
+              114,
+              103,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * ValidateLinks checks the syntax of the links, normalizes them with the link parameter rules of the workspace,
+     * and optionally checks whether they're reachable, e.g. before importing shortcuts.
+     */
+    validateLinks: {
+      name: "ValidateLinks",
+      requestType: ValidateLinksRequest,
+      requestStream: false,
+      responseType: ValidateLinksResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              36,
+              58,
+              1,
+              42,
+              34,
+              31,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              58,
+              118,
+              97,
+              108,
+              105,
+              100,
+              97,
+              116,
+              101,
+              76,
+              105,
+              110,
+              107,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetShortcut returns a shortcut by id. */
+    getShortcut: {
+      name: "GetShortcut",
+      requestType: GetShortcutRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              24,
+              18,
+              22,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * GetShortcutByName returns a shortcut by name.
+     * When it's not found, the error has ShortcutNotFoundDetails with the names of similar shortcuts.
+     */
+    getShortcutByName: {
+      name: "GetShortcutByName",
+      requestType: GetShortcutByNameRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {},
+    },
+    /** ListShortcutSuggestions returns the names of the shortcuts close to a name, e.g. a mistyped one. */
+    listShortcutSuggestions: {
+      name: "ListShortcutSuggestions",
+      requestType: ListShortcutSuggestionsRequest,
+      requestStream: false,
+      responseType: ListShortcutSuggestionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              27,
+              18,
+              25,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              58,
+              115,
+              117,
+              103,
+              103,
+              101,
+              115,
+              116,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * ResolvePreview returns how visiting the shortcut would resolve in the simulated context,
+     * without redirecting or recording the view.
+     */
+    resolvePreview: {
+      name: "ResolvePreview",
+      requestType: ResolvePreviewRequest,
+      requestStream: false,
+      responseType: ResolvePreviewResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              34,
+              18,
+              32,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              58,
+              114,
+              101,
+              115,
+              111,
+              108,
+              118,
+              101,
+              80,
+              114,
+              101,
+              118,
+              105,
+              101,
+              119,
+            ]),
+          ],
+        },
+      },
+    },
+    /** CreateShortcut creates a shortcut. */
+    createShortcut: {
+      name: "CreateShortcut",
+      requestType: CreateShortcutRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              29,
+              58,
+              8,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              34,
+              17,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** UpdateShortcut updates a shortcut. */
+    updateShortcut: {
+      name: "UpdateShortcut",
+      requestType: UpdateShortcutRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [
+            new Uint8Array([
+              20,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
+            ]),
+          ],
+          578365826: [
+            new Uint8Array([
+              43,
+              58,
+              8,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              26,
+              31,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              46,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteShortcut deletes a shortcut by name. */
+    deleteShortcut: {
+      name: "DeleteShortcut",
+      requestType: DeleteShortcutRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              24,
+              42,
+              22,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** TransferShortcut transfers the ownership of a shortcut to another user. Only for its creator and admins. */
+    transferShortcut: {
+      name: "TransferShortcut",
+      requestType: TransferShortcutRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([10, 105, 100, 44, 117, 115, 101, 114, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              36,
+              58,
+              1,
+              42,
+              34,
+              31,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              58,
+              116,
+              114,
+              97,
+              110,
+              115,
+              102,
+              101,
+              114,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetShortcutAnalytics returns the analytics for a shortcut. */
+    getShortcutAnalytics: {
+      name: "GetShortcutAnalytics",
+      requestType: GetShortcutAnalyticsRequest,
+      requestStream: false,
+      responseType: GetShortcutAnalyticsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              34,
+              18,
+              32,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              97,
+              110,
+              97,
+              108,
+              121,
+              116,
+              105,
+              99,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** CreateShortcutAnalyticsShare creates a read-only link to view the analytics of the shortcut without an account. */
+    createShortcutAnalyticsShare: {
+      name: "CreateShortcutAnalyticsShare",
+      requestType: CreateShortcutAnalyticsShareRequest,
+      requestStream: false,
+      responseType: ShortcutAnalyticsShare,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              53,
+              58,
+              1,
+              42,
+              34,
+              48,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              97,
+              110,
+              97,
+              108,
+              121,
+              116,
+              105,
+              99,
+              115,
+              47,
+              115,
+              104,
+              97,
+              114,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListShortcutAnalyticsShares returns the analytics share links of the shortcut. */
+    listShortcutAnalyticsShares: {
+      name: "ListShortcutAnalyticsShares",
+      requestType: ListShortcutAnalyticsSharesRequest,
+      requestStream: false,
+      responseType: ListShortcutAnalyticsSharesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([11, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              50,
+              18,
+              48,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              97,
+              110,
+              97,
+              108,
+              121,
+              116,
+              105,
+              99,
+              115,
+              47,
+              115,
+              104,
+              97,
+              114,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteShortcutAnalyticsShare revokes an analytics share link of the shortcut. */
+    deleteShortcutAnalyticsShare: {
+      name: "DeleteShortcutAnalyticsShare",
+      requestType: DeleteShortcutAnalyticsShareRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              55,
+              42,
+              53,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              97,
+              110,
+              97,
+              108,
+              121,
+              116,
+              105,
+              99,
+              115,
+              47,
+              115,
+              104,
+              97,
+              114,
+              101,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetSharedShortcutAnalytics returns the analytics of the shortcut of a share link, and counts the view. */
+    getSharedShortcutAnalytics: {
+      name: "GetSharedShortcutAnalytics",
+      requestType: GetSharedShortcutAnalyticsRequest,
+      requestStream: false,
+      responseType: SharedShortcutAnalytics,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([5, 116, 111, 107, 101, 110])],
+          578365826: [
+            new Uint8Array([
+              34,
+              18,
+              32,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              97,
+              114,
+              101,
+              100,
+              45,
+              97,
+              110,
+              97,
+              108,
+              121,
+              116,
+              105,
+              99,
+              115,
+              47,
+              123,
+              116,
+              111,
+              107,
+              101,
+              110,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * ListProposedChanges returns the changes proposed to the protected shortcut, with the previous and proposed values
+     * of the fields to show as a diff. The creator and admins get all of them, the other users their own.
+     */
+    listProposedChanges: {
+      name: "ListProposedChanges",
+      requestType: ListProposedChangesRequest,
+      requestStream: false,
+      responseType: ListProposedChangesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([11, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              50,
+              18,
+              48,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              112,
+              114,
+              111,
+              112,
+              111,
+              115,
+              101,
+              100,
+              45,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ApproveProposedChange applies a pending change proposed to the shortcut. Only for the creator and admins. */
+    approveProposedChange: {
+      name: "ApproveProposedChange",
+      requestType: ApproveProposedChangeRequest,
+      requestStream: false,
+      responseType: ProposedChange,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              66,
+              58,
+              1,
+              42,
+              34,
+              61,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              112,
+              114,
+              111,
+              112,
+              111,
+              115,
+              101,
+              100,
+              45,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              58,
+              97,
+              112,
+              112,
+              114,
+              111,
+              118,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** RejectProposedChange rejects a pending change proposed to the shortcut. Only for the creator and admins. */
+    rejectProposedChange: {
+      name: "RejectProposedChange",
+      requestType: RejectProposedChangeRequest,
+      requestStream: false,
+      responseType: ProposedChange,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              65,
+              58,
+              1,
+              42,
+              34,
+              60,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              112,
+              114,
+              111,
+              112,
+              111,
+              115,
+              101,
+              100,
+              45,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              58,
+              114,
+              101,
+              106,
+              101,
+              99,
+              116,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListShortcutRotations returns the rotation calendar of the shortcut, the latest first. */
+    listShortcutRotations: {
+      name: "ListShortcutRotations",
+      requestType: ListShortcutRotationsRequest,
+      requestStream: false,
+      responseType: ListShortcutRotationsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([11, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              43,
+              18,
+              41,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              114,
+              111,
+              116,
+              97,
+              116,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * CreateShortcutRotation schedules a link for the shortcut to resolve to during a time range.
+     * Only for the creator and admins.
+     */
+    createShortcutRotation: {
+      name: "CreateShortcutRotation",
+      requestType: CreateShortcutRotationRequest,
+      requestStream: false,
+      responseType: ShortcutRotation,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              53,
+              58,
+              8,
+              114,
+              111,
+              116,
+              97,
+              116,
+              105,
+              111,
+              110,
+              34,
+              41,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              114,
+              111,
+              116,
+              97,
+              116,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteShortcutRotation removes a rotation from the calendar of the shortcut. Only for the creator and admins. */
+    deleteShortcutRotation: {
+      name: "DeleteShortcutRotation",
+      requestType: DeleteShortcutRotationRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              48,
+              42,
+              46,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              114,
+              111,
+              116,
+              97,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListShortcutACLs returns the users the shortcut is shared with. Only for the creator and admins. */
+    listShortcutACLs: {
+      name: "ListShortcutACLs",
+      requestType: ListShortcutACLsRequest,
+      requestStream: false,
+      responseType: ListShortcutACLsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([11, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              38,
+              18,
+              36,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              97,
+              99,
+              108,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * UpsertShortcutACL shares the shortcut with a user, or changes the role of the user.
+     * Only for the creator and admins.
+     */
+    upsertShortcutACL: {
+      name: "UpsertShortcutACL",
+      requestType: UpsertShortcutACLRequest,
+      requestStream: false,
+      responseType: ShortcutACL,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              43,
+              58,
+              3,
+              97,
+              99,
+              108,
+              34,
+              36,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              97,
+              99,
+              108,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteShortcutACL stops sharing the shortcut with a user. Only for the creator and admins. */
+    deleteShortcutACL: {
+      name: "DeleteShortcutACL",
+      requestType: DeleteShortcutACLRequest,
+      requestStream: false,
+      responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
           578365826: [
-            new Uint8Array([19, 18, 17, 47, 97, 112, 105, 47, 118, 49, 47, 115, 104, 111, 114, 116, 99, 117, 116, 115]),
+            new Uint8Array([
+              48,
+              42,
+              46,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              97,
+              99,
+              108,
+              115,
+              47,
+              123,
+              117,
+              115,
+              101,
+              114,
+              95,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetTrendingShortcuts returns the shortcuts with the largest view growth over the window. */
+    getTrendingShortcuts: {
+      name: "GetTrendingShortcuts",
+      requestType: GetTrendingShortcutsRequest,
+      requestStream: false,
+      responseType: GetTrendingShortcutsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              28,
+              18,
+              26,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              114,
+              101,
+              110,
+              100,
+              105,
+              110,
+              103,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * GetShortcutQRCode returns the QR code image of the short link of the shortcut,
+     * with the branding of the workspace in the center when it's set.
+     */
+    getShortcutQRCode: {
+      name: "GetShortcutQRCode",
+      requestType: GetShortcutQRCodeRequest,
+      requestStream: false,
+      responseType: GetShortcutQRCodeResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              31,
+              18,
+              29,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              113,
+              114,
+              99,
+              111,
+              100,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListBrokenShortcuts returns the shortcuts the user can view whose link failed its last health checks. */
+    listBrokenShortcuts: {
+      name: "ListBrokenShortcuts",
+      requestType: ListBrokenShortcutsRequest,
+      requestStream: false,
+      responseType: ListBrokenShortcutsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              26,
+              18,
+              24,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              58,
+              98,
+              114,
+              111,
+              107,
+              101,
+              110,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * RefreshShortcutMetadata fetches the title, description, image and favicon of the link of the shortcut again
+     * into its Open Graph metadata.
+     */
+    refreshShortcutMetadata: {
+      name: "RefreshShortcutMetadata",
+      requestType: RefreshShortcutMetadataRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              43,
+              58,
+              1,
+              42,
+              34,
+              38,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              58,
+              114,
+              101,
+              102,
+              114,
+              101,
+              115,
+              104,
+              77,
+              101,
+              116,
+              97,
+              100,
+              97,
+              116,
+              97,
+            ]),
           ],
         },
       },
     },
-    /** GetShortcut returns a shortcut by id. */
-    getShortcut: {
-      name: "GetShortcut",
-      requestType: GetShortcutRequest,
+    /**
+     * SuggestShortcut suggests the title, description and tags of a new shortcut from the page of its link,
+     * with the completion provider of the workspace. The suggestions aren't saved.
+     */
+    suggestShortcut: {
+      name: "SuggestShortcut",
+      requestType: SuggestShortcutRequest,
       requestStream: false,
-      responseType: Shortcut,
+      responseType: SuggestShortcutResponse,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
+          8410: [new Uint8Array([4, 108, 105, 110, 107])],
           578365826: [
             new Uint8Array([
-              24,
-              18,
-              22,
+              30,
+              58,
+              1,
+              42,
+              34,
+              25,
               47,
               97,
               112,
@@ -894,49 +8840,37 @@ export const ShortcutServiceDefinition = {
               117,
               116,
               115,
-              47,
-              123,
-              105,
-              100,
-              125,
+              58,
+              115,
+              117,
+              103,
+              103,
+              101,
+              115,
+              116,
             ]),
           ],
         },
       },
     },
-    /** GetShortcutByName returns a shortcut by name. */
-    getShortcutByName: {
-      name: "GetShortcutByName",
-      requestType: GetShortcutByNameRequest,
-      requestStream: false,
-      responseType: Shortcut,
-      responseStream: false,
-      options: {},
-    },
-    /** CreateShortcut creates a shortcut. */
-    createShortcut: {
-      name: "CreateShortcut",
-      requestType: CreateShortcutRequest,
+    /**
+     * SemanticSearchShortcuts returns the shortcuts the user can view whose meaning is the closest to the query,
+     * e.g. "billing dashboard", with the embeddings of the workspace, even without the exact words.
+     */
+    semanticSearchShortcuts: {
+      name: "SemanticSearchShortcuts",
+      requestType: SemanticSearchShortcutsRequest,
       requestStream: false,
-      responseType: Shortcut,
+      responseType: SemanticSearchShortcutsResponse,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([5, 113, 117, 101, 114, 121])],
           578365826: [
             new Uint8Array([
-              29,
-              58,
-              8,
-              115,
-              104,
-              111,
-              114,
-              116,
-              99,
-              117,
-              116,
               34,
-              17,
+              18,
+              32,
               47,
               97,
               112,
@@ -954,23 +8888,56 @@ export const ShortcutServiceDefinition = {
               117,
               116,
               115,
+              58,
+              115,
+              101,
+              109,
+              97,
+              110,
+              116,
+              105,
+              99,
+              83,
+              101,
+              97,
+              114,
+              99,
+              104,
             ]),
           ],
         },
       },
     },
-    /** UpdateShortcut updates a shortcut. */
-    updateShortcut: {
-      name: "UpdateShortcut",
-      requestType: UpdateShortcutRequest,
+    /**
+     * GenerateSignedRedirect returns a short link of the shortcut signed until it expires, e.g. for transactional emails,
+     * which redirects right away: also the visitors who can't view the shortcut, and without confirming the external
+     * redirects. Only for its creator and admins.
+     */
+    generateSignedRedirect: {
+      name: "GenerateSignedRedirect",
+      requestType: GenerateSignedRedirectRequest,
       requestStream: false,
-      responseType: Shortcut,
+      responseType: GenerateSignedRedirectResponse,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
             new Uint8Array([
-              20,
+              40,
+              58,
+              1,
+              42,
+              34,
+              35,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
               115,
               104,
               111,
@@ -979,35 +8946,47 @@ export const ShortcutServiceDefinition = {
               99,
               117,
               116,
-              44,
-              117,
-              112,
-              100,
-              97,
-              116,
-              101,
-              95,
-              109,
-              97,
               115,
-              107,
-            ]),
-          ],
-          578365826: [
-            new Uint8Array([
-              43,
+              47,
+              123,
+              105,
+              100,
+              125,
               58,
-              8,
               115,
-              104,
-              111,
+              105,
+              103,
+              110,
+              82,
+              101,
+              100,
+              105,
               114,
-              116,
+              101,
               99,
-              117,
               116,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * GetResolutionSnapshot returns the links the shortcuts of the user redirect to, signed, for the clients to
+     * cache and resolve them offline. With since_version, it returns the delta from that snapshot when possible.
+     */
+    getResolutionSnapshot: {
+      name: "GetResolutionSnapshot",
+      requestType: GetResolutionSnapshotRequest,
+      requestStream: false,
+      responseType: ResolutionSnapshot,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              28,
+              18,
               26,
-              31,
               47,
               97,
               112,
@@ -1025,40 +9004,40 @@ export const ShortcutServiceDefinition = {
               117,
               116,
               115,
-              47,
-              123,
+              58,
+              115,
+              110,
+              97,
+              112,
               115,
               104,
               111,
-              114,
               116,
-              99,
-              117,
-              116,
-              46,
-              105,
-              100,
-              125,
             ]),
           ],
         },
       },
     },
-    /** DeleteShortcut deletes a shortcut by name. */
-    deleteShortcut: {
-      name: "DeleteShortcut",
-      requestType: DeleteShortcutRequest,
+    /**
+     * CreateImportJob creates a job to import the shortcuts of a file as the user in the background,
+     * throttled by the import quota of the user.
+     */
+    createImportJob: {
+      name: "CreateImportJob",
+      requestType: CreateImportJobRequest,
       requestStream: false,
-      responseType: Empty,
+      responseType: ImportJob,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
           578365826: [
             new Uint8Array([
               24,
+              58,
+              1,
               42,
-              22,
+              34,
+              19,
               47,
               97,
               112,
@@ -1067,14 +9046,55 @@ export const ShortcutServiceDefinition = {
               118,
               49,
               47,
-              115,
-              104,
+              105,
+              109,
+              112,
               111,
               114,
               116,
-              99,
-              117,
+              45,
+              106,
+              111,
+              98,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetImportJob returns the progress and the row errors of an import job. Only for its creator and admins. */
+    getImportJob: {
+      name: "GetImportJob",
+      requestType: GetImportJobRequest,
+      requestStream: false,
+      responseType: ImportJob,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              26,
+              18,
+              24,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              105,
+              109,
+              112,
+              111,
+              114,
               116,
+              45,
+              106,
+              111,
+              98,
               115,
               47,
               123,
@@ -1086,21 +9106,20 @@ export const ShortcutServiceDefinition = {
         },
       },
     },
-    /** GetShortcutAnalytics returns the analytics for a shortcut. */
-    getShortcutAnalytics: {
-      name: "GetShortcutAnalytics",
-      requestType: GetShortcutAnalyticsRequest,
+    /** ListImportJobs returns the import jobs of the user, the latest first, without their row errors. */
+    listImportJobs: {
+      name: "ListImportJobs",
+      requestType: ListImportJobsRequest,
       requestStream: false,
-      responseType: GetShortcutAnalyticsResponse,
+      responseType: ListImportJobsResponse,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
           578365826: [
             new Uint8Array([
-              34,
+              21,
               18,
-              32,
+              19,
               47,
               97,
               112,
@@ -1109,30 +9128,74 @@ export const ShortcutServiceDefinition = {
               118,
               49,
               47,
-              115,
-              104,
+              105,
+              109,
+              112,
               111,
               114,
               116,
-              99,
-              117,
+              45,
+              106,
+              111,
+              98,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * ResumeImportJob queues a failed import job again, to continue from the row after the last processed one.
+     * Only for its creator and admins.
+     */
+    resumeImportJob: {
+      name: "ResumeImportJob",
+      requestType: ResumeImportJobRequest,
+      requestStream: false,
+      responseType: ImportJob,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              36,
+              58,
+              1,
+              42,
+              34,
+              31,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              105,
+              109,
+              112,
+              111,
+              114,
               116,
+              45,
+              106,
+              111,
+              98,
               115,
               47,
               123,
               105,
               100,
               125,
-              47,
-              97,
-              110,
-              97,
-              108,
-              121,
-              116,
-              105,
-              99,
+              58,
+              114,
+              101,
               115,
+              117,
+              109,
+              101,
             ]),
           ],
         },
@@ -0,0 +1,891 @@
+// Code generated by protoc-gen-ts_proto. DO NOT EDIT.
+// versions:
+//   protoc-gen-ts_proto  v2.6.1
+//   protoc               unknown
+// source: api/v1/team_service.proto
+
+/* eslint-disable */
+import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
+import { Empty } from "../../google/protobuf/empty";
+import { FieldMask } from "../../google/protobuf/field_mask";
+import { Timestamp } from "../../google/protobuf/timestamp";
+
+export const protobufPackage = "slash.api.v1";
+
+export interface Team {
+  id: number;
+  createdTime?: Date | undefined;
+  updatedTime?:
+    | Date
+    | undefined;
+  /** The unique name of the team, e.g. "engineering". */
+  name: string;
+  description: string;
+  /** Output only. The ids of the members, changed with AddTeamMember and RemoveTeamMember. */
+  memberIds: number[];
+}
+
+export interface ListTeamsRequest {
+}
+
+export interface ListTeamsResponse {
+  teams: Team[];
+}
+
+export interface GetTeamRequest {
+  id: number;
+}
+
+export interface CreateTeamRequest {
+  team?: Team | undefined;
+}
+
+export interface UpdateTeamRequest {
+  team?: Team | undefined;
+  updateMask?: string[] | undefined;
+}
+
+export interface DeleteTeamRequest {
+  id: number;
+}
+
+export interface AddTeamMemberRequest {
+  /** The id of the team. */
+  id: number;
+  userId: number;
+}
+
+export interface RemoveTeamMemberRequest {
+  /** The id of the team. */
+  id: number;
+  userId: number;
+}
+
+function createBaseTeam(): Team {
+  return { id: 0, createdTime: undefined, updatedTime: undefined, name: "", description: "", memberIds: [] };
+}
+
+export const Team: MessageFns<Team> = {
+  encode(message: Team, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(18).fork()).join();
+    }
+    if (message.updatedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updatedTime), writer.uint32(26).fork()).join();
+    }
+    if (message.name !== "") {
+      writer.uint32(34).string(message.name);
+    }
+    if (message.description !== "") {
+      writer.uint32(42).string(message.description);
+    }
+    writer.uint32(50).fork();
+    for (const v of message.memberIds) {
+      writer.int32(v);
+    }
+    writer.join();
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Team {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTeam();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag === 48) {
+            message.memberIds.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 50) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.memberIds.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Team>): Team {
+    return Team.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Team>): Team {
+    const message = createBaseTeam();
+    message.id = object.id ?? 0;
+    message.createdTime = object.createdTime ?? undefined;
+    message.updatedTime = object.updatedTime ?? undefined;
+    message.name = object.name ?? "";
+    message.description = object.description ?? "";
+    message.memberIds = object.memberIds?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseListTeamsRequest(): ListTeamsRequest {
+  return {};
+}
+
+export const ListTeamsRequest: MessageFns<ListTeamsRequest> = {
+  encode(_: ListTeamsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListTeamsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListTeamsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListTeamsRequest>): ListTeamsRequest {
+    return ListTeamsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<ListTeamsRequest>): ListTeamsRequest {
+    const message = createBaseListTeamsRequest();
+    return message;
+  },
+};
+
+function createBaseListTeamsResponse(): ListTeamsResponse {
+  return { teams: [] };
+}
+
+export const ListTeamsResponse: MessageFns<ListTeamsResponse> = {
+  encode(message: ListTeamsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.teams) {
+      Team.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListTeamsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListTeamsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.teams.push(Team.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListTeamsResponse>): ListTeamsResponse {
+    return ListTeamsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListTeamsResponse>): ListTeamsResponse {
+    const message = createBaseListTeamsResponse();
+    message.teams = object.teams?.map((e) => Team.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGetTeamRequest(): GetTeamRequest {
+  return { id: 0 };
+}
+
+export const GetTeamRequest: MessageFns<GetTeamRequest> = {
+  encode(message: GetTeamRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetTeamRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetTeamRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetTeamRequest>): GetTeamRequest {
+    return GetTeamRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetTeamRequest>): GetTeamRequest {
+    const message = createBaseGetTeamRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseCreateTeamRequest(): CreateTeamRequest {
+  return { team: undefined };
+}
+
+export const CreateTeamRequest: MessageFns<CreateTeamRequest> = {
+  encode(message: CreateTeamRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.team !== undefined) {
+      Team.encode(message.team, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateTeamRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateTeamRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.team = Team.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateTeamRequest>): CreateTeamRequest {
+    return CreateTeamRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateTeamRequest>): CreateTeamRequest {
+    const message = createBaseCreateTeamRequest();
+    message.team = (object.team !== undefined && object.team !== null) ? Team.fromPartial(object.team) : undefined;
+    return message;
+  },
+};
+
+function createBaseUpdateTeamRequest(): UpdateTeamRequest {
+  return { team: undefined, updateMask: undefined };
+}
+
+export const UpdateTeamRequest: MessageFns<UpdateTeamRequest> = {
+  encode(message: UpdateTeamRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.team !== undefined) {
+      Team.encode(message.team, writer.uint32(10).fork()).join();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UpdateTeamRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateTeamRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.team = Team.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UpdateTeamRequest>): UpdateTeamRequest {
+    return UpdateTeamRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateTeamRequest>): UpdateTeamRequest {
+    const message = createBaseUpdateTeamRequest();
+    message.team = (object.team !== undefined && object.team !== null) ? Team.fromPartial(object.team) : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBaseDeleteTeamRequest(): DeleteTeamRequest {
+  return { id: 0 };
+}
+
+export const DeleteTeamRequest: MessageFns<DeleteTeamRequest> = {
+  encode(message: DeleteTeamRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteTeamRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteTeamRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeleteTeamRequest>): DeleteTeamRequest {
+    return DeleteTeamRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteTeamRequest>): DeleteTeamRequest {
+    const message = createBaseDeleteTeamRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseAddTeamMemberRequest(): AddTeamMemberRequest {
+  return { id: 0, userId: 0 };
+}
+
+export const AddTeamMemberRequest: MessageFns<AddTeamMemberRequest> = {
+  encode(message: AddTeamMemberRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AddTeamMemberRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAddTeamMemberRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<AddTeamMemberRequest>): AddTeamMemberRequest {
+    return AddTeamMemberRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AddTeamMemberRequest>): AddTeamMemberRequest {
+    const message = createBaseAddTeamMemberRequest();
+    message.id = object.id ?? 0;
+    message.userId = object.userId ?? 0;
+    return message;
+  },
+};
+
+function createBaseRemoveTeamMemberRequest(): RemoveTeamMemberRequest {
+  return { id: 0, userId: 0 };
+}
+
+export const RemoveTeamMemberRequest: MessageFns<RemoveTeamMemberRequest> = {
+  encode(message: RemoveTeamMemberRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RemoveTeamMemberRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRemoveTeamMemberRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RemoveTeamMemberRequest>): RemoveTeamMemberRequest {
+    return RemoveTeamMemberRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RemoveTeamMemberRequest>): RemoveTeamMemberRequest {
+    const message = createBaseRemoveTeamMemberRequest();
+    message.id = object.id ?? 0;
+    message.userId = object.userId ?? 0;
+    return message;
+  },
+};
+
+export type TeamServiceDefinition = typeof TeamServiceDefinition;
+export const TeamServiceDefinition = {
+  name: "TeamService",
+  fullName: "slash.api.v1.TeamService",
+  methods: {
+    /** ListTeams returns the teams of the workspace. */
+    listTeams: {
+      name: "ListTeams",
+      requestType: ListTeamsRequest,
+      requestStream: false,
+      responseType: ListTeamsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [new Uint8Array([15, 18, 13, 47, 97, 112, 105, 47, 118, 49, 47, 116, 101, 97, 109, 115])],
+        },
+      },
+    },
+    /** GetTeam returns a team by id. */
+    getTeam: {
+      name: "GetTeam",
+      requestType: GetTeamRequest,
+      requestStream: false,
+      responseType: Team,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              20,
+              18,
+              18,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              101,
+              97,
+              109,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** CreateTeam creates a team. Only for admins. */
+    createTeam: {
+      name: "CreateTeam",
+      requestType: CreateTeamRequest,
+      requestStream: false,
+      responseType: Team,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              21,
+              58,
+              4,
+              116,
+              101,
+              97,
+              109,
+              34,
+              13,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              101,
+              97,
+              109,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** UpdateTeam updates a team. Only for admins. */
+    updateTeam: {
+      name: "UpdateTeam",
+      requestType: UpdateTeamRequest,
+      requestStream: false,
+      responseType: Team,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([16, 116, 101, 97, 109, 44, 117, 112, 100, 97, 116, 101, 95, 109, 97, 115, 107])],
+          578365826: [
+            new Uint8Array([
+              31,
+              58,
+              4,
+              116,
+              101,
+              97,
+              109,
+              50,
+              23,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              101,
+              97,
+              109,
+              115,
+              47,
+              123,
+              116,
+              101,
+              97,
+              109,
+              46,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * DeleteTeam deletes a team by id. Only for admins.
+     * The shortcuts and collections of the team stay visible to their creators and the admins only.
+     */
+    deleteTeam: {
+      name: "DeleteTeam",
+      requestType: DeleteTeamRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              20,
+              42,
+              18,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              101,
+              97,
+              109,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** AddTeamMember adds a user to a team. Only for admins. */
+    addTeamMember: {
+      name: "AddTeamMember",
+      requestType: AddTeamMemberRequest,
+      requestStream: false,
+      responseType: Team,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([10, 105, 100, 44, 117, 115, 101, 114, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              31,
+              58,
+              1,
+              42,
+              34,
+              26,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              101,
+              97,
+              109,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              109,
+              101,
+              109,
+              98,
+              101,
+              114,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** RemoveTeamMember removes a user from a team. Only for admins. */
+    removeTeamMember: {
+      name: "RemoveTeamMember",
+      requestType: RemoveTeamMemberRequest,
+      requestStream: false,
+      responseType: Team,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([10, 105, 100, 44, 117, 115, 101, 114, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              38,
+              42,
+              36,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              101,
+              97,
+              109,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              109,
+              101,
+              109,
+              98,
+              101,
+              114,
+              115,
+              47,
+              123,
+              117,
+              115,
+              101,
+              114,
+              95,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+  },
+} as const;
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = Math.trunc(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+export interface MessageFns<T> {
+  encode(message: T, writer?: BinaryWriter): BinaryWriter;
+  decode(input: BinaryReader | Uint8Array, length?: number): T;
+  create(base?: DeepPartial<T>): T;
+  fromPartial(object: DeepPartial<T>): T;
+}
@@ -9,7 +9,9 @@ import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
 import { Empty } from "../../google/protobuf/empty";
 import { FieldMask } from "../../google/protobuf/field_mask";
 import { Timestamp } from "../../google/protobuf/timestamp";
+import { Collection } from "./collection_service";
 import { State, stateFromJSON, stateToNumber } from "./common";
+import { Shortcut } from "./shortcut_service";
 
 export const protobufPackage = "slash.api.v1";
 
@@ -61,13 +63,31 @@ export interface User {
   email: string;
   nickname: string;
   password: string;
+  /** The unique handle of the user, used in personal namespaces like "~username/". */
+  username: string;
+  /**
+   * The url of the avatar, e.g. "/u/1/avatar".
+   * When updating, it accepts a data uri like "data:image/png;base64,..." or empty to use the Gravatar fallback.
+   */
+  avatarUrl: string;
+  /**
+   * Whether the user has confirmed the email signed up with. Only false when the workspace requires
+   * the verification and the user hasn't confirmed it yet.
+   */
+  emailVerified: boolean;
 }
 
 export interface ListUsersRequest {
+  /** The max number of users to return. Unset or 0 returns all of them, and the max is 1000. */
+  pageSize: number;
+  /** The next_page_token of the previous response to get the next page. */
+  pageToken: string;
 }
 
 export interface ListUsersResponse {
   users: User[];
+  /** The token of the next page. Empty when there are no more pages. */
+  nextPageToken: string;
 }
 
 export interface GetUserRequest {
@@ -87,6 +107,55 @@ export interface DeleteUserRequest {
   id: number;
 }
 
+export interface DeleteMyAccountRequest {
+  /** What to do with the shortcuts and collections of the account. */
+  dataHandling: DeleteMyAccountRequest_DataHandling;
+}
+
+export enum DeleteMyAccountRequest_DataHandling {
+  DATA_HANDLING_UNSPECIFIED = "DATA_HANDLING_UNSPECIFIED",
+  /** DELETE - Delete the shortcuts and collections with the account. */
+  DELETE = "DELETE",
+  /**
+   * TRANSFER - Transfer the shortcuts and collections to the handover user of the workspace.
+   * The shortcuts in the personal namespace are deleted.
+   */
+  TRANSFER = "TRANSFER",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function deleteMyAccountRequest_DataHandlingFromJSON(object: any): DeleteMyAccountRequest_DataHandling {
+  switch (object) {
+    case 0:
+    case "DATA_HANDLING_UNSPECIFIED":
+      return DeleteMyAccountRequest_DataHandling.DATA_HANDLING_UNSPECIFIED;
+    case 1:
+    case "DELETE":
+      return DeleteMyAccountRequest_DataHandling.DELETE;
+    case 2:
+    case "TRANSFER":
+      return DeleteMyAccountRequest_DataHandling.TRANSFER;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DeleteMyAccountRequest_DataHandling.UNRECOGNIZED;
+  }
+}
+
+export function deleteMyAccountRequest_DataHandlingToNumber(object: DeleteMyAccountRequest_DataHandling): number {
+  switch (object) {
+    case DeleteMyAccountRequest_DataHandling.DATA_HANDLING_UNSPECIFIED:
+      return 0;
+    case DeleteMyAccountRequest_DataHandling.DELETE:
+      return 1;
+    case DeleteMyAccountRequest_DataHandling.TRANSFER:
+      return 2;
+    case DeleteMyAccountRequest_DataHandling.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 export interface ListUserAccessTokensRequest {
   /** id is the user id. */
   id: number;
@@ -105,21 +174,153 @@ export interface CreateUserAccessTokenRequest {
    * expires_at is the expiration time of the access token.
    * If expires_at is not set, the access token will never expire.
    */
-  expiresAt?: Date | undefined;
+  expiresAt?:
+    | Date
+    | undefined;
+  /**
+   * scopes restricts the access token, e.g. "shortcuts:read". Empty means full access.
+   * The scopes are "shortcuts:read", "shortcuts:write", "collections:read", "collections:write" and "admin",
+   * where the write scopes include the read ones, and "admin" is full access, only for admins.
+   */
+  scopes: string[];
 }
 
 export interface DeleteUserAccessTokenRequest {
   /** id is the user id. */
   id: number;
-  /** access_token is the access token to delete. */
+  /** access_token is the id of the access token to delete, or the access token itself. */
   accessToken: string;
 }
 
 export interface UserAccessToken {
+  /** The access token is only returned on creation, as only its hash is stored. */
   accessToken: string;
   description: string;
   issuedAt?: Date | undefined;
   expiresAt?: Date | undefined;
+  lastUsedAt?:
+    | Date
+    | undefined;
+  /** The scopes the access token is restricted to. Empty means full access. */
+  scopes: string[];
+  /** The id of the access token. */
+  id: string;
+}
+
+export interface ListUserPasskeysRequest {
+  /** id is the user id. */
+  id: number;
+}
+
+export interface ListUserPasskeysResponse {
+  passkeys: UserPasskey[];
+}
+
+export interface DeleteUserPasskeyRequest {
+  /** id is the user id. */
+  id: number;
+  /** passkey_id is the id of the passkey to delete. */
+  passkeyId: string;
+}
+
+export interface UserPasskey {
+  /** The credential id in unpadded base64url. */
+  id: string;
+  name: string;
+  createdTime?: Date | undefined;
+  lastUsedTime?: Date | undefined;
+}
+
+export interface ListUserSessionsRequest {
+  /** id is the user id. */
+  id: number;
+}
+
+export interface ListUserSessionsResponse {
+  sessions: UserSession[];
+}
+
+export interface RevokeUserSessionRequest {
+  /** id is the user id. */
+  id: number;
+  /** session_id is the id of the session to revoke. */
+  sessionId: number;
+}
+
+export interface UserSession {
+  id: number;
+  /** The device of the session, e.g. "Chrome on macOS". */
+  device: string;
+  userAgent: string;
+  /** The IP address the session is signed in from. */
+  ip: string;
+  createdTime?: Date | undefined;
+  lastSeenTime?: Date | undefined;
+  expireTime?:
+    | Date
+    | undefined;
+  /** Whether it's the session of the request. */
+  current: boolean;
+}
+
+export interface ImpersonateUserRequest {
+  /** id is the id of the user to impersonate. */
+  id: number;
+}
+
+export interface ImpersonateUserResponse {
+  /** The access token acting as the user, until it expires. */
+  accessToken: string;
+  expireTime?: Date | undefined;
+  user?: User | undefined;
+}
+
+export interface UserEmail {
+  email: string;
+  /** Only verified emails can be used to sign in and be set as the primary email. */
+  verified: boolean;
+  createdTime?: Date | undefined;
+}
+
+export interface ListUserEmailsRequest {
+  /** id is the user id. */
+  id: number;
+}
+
+export interface ListUserEmailsResponse {
+  emails: UserEmail[];
+}
+
+export interface CreateUserEmailRequest {
+  /** id is the user id. */
+  id: number;
+  email: string;
+}
+
+export interface DeleteUserEmailRequest {
+  /** id is the user id. */
+  id: number;
+  email: string;
+}
+
+export interface SetUserPrimaryEmailRequest {
+  /** id is the user id. */
+  id: number;
+  email: string;
+}
+
+export interface GetUserPublicProfileRequest {
+  username: string;
+}
+
+export interface UserPublicProfile {
+  username: string;
+  nickname: string;
+  avatarUrl: string;
+  /** The public shortcuts of the user. */
+  shortcuts: Shortcut[];
+  /** The public collections of the user. */
+  collections: Collection[];
 }
 
 function createBaseUser(): User {
@@ -132,6 +333,9 @@ function createBaseUser(): User {
     email: "",
     nickname: "",
     password: "",
+    username: "",
+    avatarUrl: "",
+    emailVerified: false,
   };
 }
 
@@ -161,6 +365,15 @@ export const User: MessageFns<User> = {
     if (message.password !== "") {
       writer.uint32(74).string(message.password);
     }
+    if (message.username !== "") {
+      writer.uint32(82).string(message.username);
+    }
+    if (message.avatarUrl !== "") {
+      writer.uint32(90).string(message.avatarUrl);
+    }
+    if (message.emailVerified !== false) {
+      writer.uint32(96).bool(message.emailVerified);
+    }
     return writer;
   },
 
@@ -235,6 +448,30 @@ export const User: MessageFns<User> = {
           message.password = reader.string();
           continue;
         }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.avatarUrl = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 96) {
+            break;
+          }
+
+          message.emailVerified = reader.bool();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -257,16 +494,25 @@ export const User: MessageFns<User> = {
     message.email = object.email ?? "";
     message.nickname = object.nickname ?? "";
     message.password = object.password ?? "";
+    message.username = object.username ?? "";
+    message.avatarUrl = object.avatarUrl ?? "";
+    message.emailVerified = object.emailVerified ?? false;
     return message;
   },
 };
 
 function createBaseListUsersRequest(): ListUsersRequest {
-  return {};
+  return { pageSize: 0, pageToken: "" };
 }
 
 export const ListUsersRequest: MessageFns<ListUsersRequest> = {
-  encode(_: ListUsersRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+  encode(message: ListUsersRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.pageSize !== 0) {
+      writer.uint32(8).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(18).string(message.pageToken);
+    }
     return writer;
   },
 
@@ -277,6 +523,22 @@ export const ListUsersRequest: MessageFns<ListUsersRequest> = {
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -289,14 +551,16 @@ export const ListUsersRequest: MessageFns<ListUsersRequest> = {
   create(base?: DeepPartial<ListUsersRequest>): ListUsersRequest {
     return ListUsersRequest.fromPartial(base ?? {});
   },
-  fromPartial(_: DeepPartial<ListUsersRequest>): ListUsersRequest {
+  fromPartial(object: DeepPartial<ListUsersRequest>): ListUsersRequest {
     const message = createBaseListUsersRequest();
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
     return message;
   },
 };
 
 function createBaseListUsersResponse(): ListUsersResponse {
-  return { users: [] };
+  return { users: [], nextPageToken: "" };
 }
 
 export const ListUsersResponse: MessageFns<ListUsersResponse> = {
@@ -304,6 +568,9 @@ export const ListUsersResponse: MessageFns<ListUsersResponse> = {
     for (const v of message.users) {
       User.encode(v!, writer.uint32(10).fork()).join();
     }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
     return writer;
   },
 
@@ -322,6 +589,14 @@ export const ListUsersResponse: MessageFns<ListUsersResponse> = {
           message.users.push(User.decode(reader, reader.uint32()));
           continue;
         }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -337,6 +612,7 @@ export const ListUsersResponse: MessageFns<ListUsersResponse> = {
   fromPartial(object: DeepPartial<ListUsersResponse>): ListUsersResponse {
     const message = createBaseListUsersResponse();
     message.users = object.users?.map((e) => User.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
     return message;
   },
 };
@@ -537,6 +813,52 @@ export const DeleteUserRequest: MessageFns<DeleteUserRequest> = {
   },
 };
 
+function createBaseDeleteMyAccountRequest(): DeleteMyAccountRequest {
+  return { dataHandling: DeleteMyAccountRequest_DataHandling.DATA_HANDLING_UNSPECIFIED };
+}
+
+export const DeleteMyAccountRequest: MessageFns<DeleteMyAccountRequest> = {
+  encode(message: DeleteMyAccountRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.dataHandling !== DeleteMyAccountRequest_DataHandling.DATA_HANDLING_UNSPECIFIED) {
+      writer.uint32(8).int32(deleteMyAccountRequest_DataHandlingToNumber(message.dataHandling));
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteMyAccountRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteMyAccountRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.dataHandling = deleteMyAccountRequest_DataHandlingFromJSON(reader.int32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeleteMyAccountRequest>): DeleteMyAccountRequest {
+    return DeleteMyAccountRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteMyAccountRequest>): DeleteMyAccountRequest {
+    const message = createBaseDeleteMyAccountRequest();
+    message.dataHandling = object.dataHandling ?? DeleteMyAccountRequest_DataHandling.DATA_HANDLING_UNSPECIFIED;
+    return message;
+  },
+};
+
 function createBaseListUserAccessTokensRequest(): ListUserAccessTokensRequest {
   return { id: 0 };
 }
@@ -630,7 +952,7 @@ export const ListUserAccessTokensResponse: MessageFns<ListUserAccessTokensRespon
 };
 
 function createBaseCreateUserAccessTokenRequest(): CreateUserAccessTokenRequest {
-  return { id: 0, description: "", expiresAt: undefined };
+  return { id: 0, description: "", expiresAt: undefined, scopes: [] };
 }
 
 export const CreateUserAccessTokenRequest: MessageFns<CreateUserAccessTokenRequest> = {
@@ -644,6 +966,9 @@ export const CreateUserAccessTokenRequest: MessageFns<CreateUserAccessTokenReque
     if (message.expiresAt !== undefined) {
       Timestamp.encode(toTimestamp(message.expiresAt), writer.uint32(26).fork()).join();
     }
+    for (const v of message.scopes) {
+      writer.uint32(34).string(v!);
+    }
     return writer;
   },
 
@@ -678,6 +1003,14 @@ export const CreateUserAccessTokenRequest: MessageFns<CreateUserAccessTokenReque
           message.expiresAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.scopes.push(reader.string());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -695,6 +1028,7 @@ export const CreateUserAccessTokenRequest: MessageFns<CreateUserAccessTokenReque
     message.id = object.id ?? 0;
     message.description = object.description ?? "";
     message.expiresAt = object.expiresAt ?? undefined;
+    message.scopes = object.scopes?.map((e) => e) || [];
     return message;
   },
 };
@@ -758,7 +1092,15 @@ export const DeleteUserAccessTokenRequest: MessageFns<DeleteUserAccessTokenReque
 };
 
 function createBaseUserAccessToken(): UserAccessToken {
-  return { accessToken: "", description: "", issuedAt: undefined, expiresAt: undefined };
+  return {
+    accessToken: "",
+    description: "",
+    issuedAt: undefined,
+    expiresAt: undefined,
+    lastUsedAt: undefined,
+    scopes: [],
+    id: "",
+  };
 }
 
 export const UserAccessToken: MessageFns<UserAccessToken> = {
@@ -775,6 +1117,15 @@ export const UserAccessToken: MessageFns<UserAccessToken> = {
     if (message.expiresAt !== undefined) {
       Timestamp.encode(toTimestamp(message.expiresAt), writer.uint32(34).fork()).join();
     }
+    if (message.lastUsedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastUsedAt), writer.uint32(42).fork()).join();
+    }
+    for (const v of message.scopes) {
+      writer.uint32(50).string(v!);
+    }
+    if (message.id !== "") {
+      writer.uint32(58).string(message.id);
+    }
     return writer;
   },
 
@@ -817,6 +1168,30 @@ export const UserAccessToken: MessageFns<UserAccessToken> = {
           message.expiresAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.lastUsedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.scopes.push(reader.string());
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -835,86 +1210,1647 @@ export const UserAccessToken: MessageFns<UserAccessToken> = {
     message.description = object.description ?? "";
     message.issuedAt = object.issuedAt ?? undefined;
     message.expiresAt = object.expiresAt ?? undefined;
+    message.lastUsedAt = object.lastUsedAt ?? undefined;
+    message.scopes = object.scopes?.map((e) => e) || [];
+    message.id = object.id ?? "";
     return message;
   },
 };
 
-export type UserServiceDefinition = typeof UserServiceDefinition;
-export const UserServiceDefinition = {
-  name: "UserService",
-  fullName: "slash.api.v1.UserService",
-  methods: {
-    /** ListUsers returns a list of users. */
-    listUsers: {
-      name: "ListUsers",
-      requestType: ListUsersRequest,
-      requestStream: false,
-      responseType: ListUsersResponse,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          578365826: [new Uint8Array([15, 18, 13, 47, 97, 112, 105, 47, 118, 49, 47, 117, 115, 101, 114, 115])],
-        },
-      },
-    },
-    /** GetUser returns a user by id. */
-    getUser: {
-      name: "GetUser",
-      requestType: GetUserRequest,
-      requestStream: false,
-      responseType: User,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
-          578365826: [
-            new Uint8Array([
-              20,
-              18,
-              18,
-              47,
-              97,
-              112,
-              105,
-              47,
-              118,
-              49,
-              47,
-              117,
-              115,
-              101,
-              114,
-              115,
-              47,
-              123,
-              105,
-              100,
-              125,
-            ]),
-          ],
-        },
+function createBaseListUserPasskeysRequest(): ListUserPasskeysRequest {
+  return { id: 0 };
+}
+
+export const ListUserPasskeysRequest: MessageFns<ListUserPasskeysRequest> = {
+  encode(message: ListUserPasskeysRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListUserPasskeysRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListUserPasskeysRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListUserPasskeysRequest>): ListUserPasskeysRequest {
+    return ListUserPasskeysRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListUserPasskeysRequest>): ListUserPasskeysRequest {
+    const message = createBaseListUserPasskeysRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseListUserPasskeysResponse(): ListUserPasskeysResponse {
+  return { passkeys: [] };
+}
+
+export const ListUserPasskeysResponse: MessageFns<ListUserPasskeysResponse> = {
+  encode(message: ListUserPasskeysResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.passkeys) {
+      UserPasskey.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListUserPasskeysResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListUserPasskeysResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.passkeys.push(UserPasskey.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListUserPasskeysResponse>): ListUserPasskeysResponse {
+    return ListUserPasskeysResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListUserPasskeysResponse>): ListUserPasskeysResponse {
+    const message = createBaseListUserPasskeysResponse();
+    message.passkeys = object.passkeys?.map((e) => UserPasskey.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDeleteUserPasskeyRequest(): DeleteUserPasskeyRequest {
+  return { id: 0, passkeyId: "" };
+}
+
+export const DeleteUserPasskeyRequest: MessageFns<DeleteUserPasskeyRequest> = {
+  encode(message: DeleteUserPasskeyRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.passkeyId !== "") {
+      writer.uint32(18).string(message.passkeyId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteUserPasskeyRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteUserPasskeyRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.passkeyId = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeleteUserPasskeyRequest>): DeleteUserPasskeyRequest {
+    return DeleteUserPasskeyRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteUserPasskeyRequest>): DeleteUserPasskeyRequest {
+    const message = createBaseDeleteUserPasskeyRequest();
+    message.id = object.id ?? 0;
+    message.passkeyId = object.passkeyId ?? "";
+    return message;
+  },
+};
+
+function createBaseUserPasskey(): UserPasskey {
+  return { id: "", name: "", createdTime: undefined, lastUsedTime: undefined };
+}
+
+export const UserPasskey: MessageFns<UserPasskey> = {
+  encode(message: UserPasskey, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(26).fork()).join();
+    }
+    if (message.lastUsedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastUsedTime), writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UserPasskey {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserPasskey();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.lastUsedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UserPasskey>): UserPasskey {
+    return UserPasskey.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UserPasskey>): UserPasskey {
+    const message = createBaseUserPasskey();
+    message.id = object.id ?? "";
+    message.name = object.name ?? "";
+    message.createdTime = object.createdTime ?? undefined;
+    message.lastUsedTime = object.lastUsedTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseListUserSessionsRequest(): ListUserSessionsRequest {
+  return { id: 0 };
+}
+
+export const ListUserSessionsRequest: MessageFns<ListUserSessionsRequest> = {
+  encode(message: ListUserSessionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListUserSessionsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListUserSessionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListUserSessionsRequest>): ListUserSessionsRequest {
+    return ListUserSessionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListUserSessionsRequest>): ListUserSessionsRequest {
+    const message = createBaseListUserSessionsRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseListUserSessionsResponse(): ListUserSessionsResponse {
+  return { sessions: [] };
+}
+
+export const ListUserSessionsResponse: MessageFns<ListUserSessionsResponse> = {
+  encode(message: ListUserSessionsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.sessions) {
+      UserSession.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListUserSessionsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListUserSessionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.sessions.push(UserSession.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListUserSessionsResponse>): ListUserSessionsResponse {
+    return ListUserSessionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListUserSessionsResponse>): ListUserSessionsResponse {
+    const message = createBaseListUserSessionsResponse();
+    message.sessions = object.sessions?.map((e) => UserSession.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRevokeUserSessionRequest(): RevokeUserSessionRequest {
+  return { id: 0, sessionId: 0 };
+}
+
+export const RevokeUserSessionRequest: MessageFns<RevokeUserSessionRequest> = {
+  encode(message: RevokeUserSessionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.sessionId !== 0) {
+      writer.uint32(16).int32(message.sessionId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RevokeUserSessionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRevokeUserSessionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.sessionId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RevokeUserSessionRequest>): RevokeUserSessionRequest {
+    return RevokeUserSessionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RevokeUserSessionRequest>): RevokeUserSessionRequest {
+    const message = createBaseRevokeUserSessionRequest();
+    message.id = object.id ?? 0;
+    message.sessionId = object.sessionId ?? 0;
+    return message;
+  },
+};
+
+function createBaseUserSession(): UserSession {
+  return {
+    id: 0,
+    device: "",
+    userAgent: "",
+    ip: "",
+    createdTime: undefined,
+    lastSeenTime: undefined,
+    expireTime: undefined,
+    current: false,
+  };
+}
+
+export const UserSession: MessageFns<UserSession> = {
+  encode(message: UserSession, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.device !== "") {
+      writer.uint32(18).string(message.device);
+    }
+    if (message.userAgent !== "") {
+      writer.uint32(26).string(message.userAgent);
+    }
+    if (message.ip !== "") {
+      writer.uint32(34).string(message.ip);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(42).fork()).join();
+    }
+    if (message.lastSeenTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastSeenTime), writer.uint32(50).fork()).join();
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(58).fork()).join();
+    }
+    if (message.current !== false) {
+      writer.uint32(64).bool(message.current);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UserSession {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserSession();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.device = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.userAgent = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.ip = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.lastSeenTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.current = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UserSession>): UserSession {
+    return UserSession.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UserSession>): UserSession {
+    const message = createBaseUserSession();
+    message.id = object.id ?? 0;
+    message.device = object.device ?? "";
+    message.userAgent = object.userAgent ?? "";
+    message.ip = object.ip ?? "";
+    message.createdTime = object.createdTime ?? undefined;
+    message.lastSeenTime = object.lastSeenTime ?? undefined;
+    message.expireTime = object.expireTime ?? undefined;
+    message.current = object.current ?? false;
+    return message;
+  },
+};
+
+function createBaseImpersonateUserRequest(): ImpersonateUserRequest {
+  return { id: 0 };
+}
+
+export const ImpersonateUserRequest: MessageFns<ImpersonateUserRequest> = {
+  encode(message: ImpersonateUserRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ImpersonateUserRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImpersonateUserRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ImpersonateUserRequest>): ImpersonateUserRequest {
+    return ImpersonateUserRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImpersonateUserRequest>): ImpersonateUserRequest {
+    const message = createBaseImpersonateUserRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseImpersonateUserResponse(): ImpersonateUserResponse {
+  return { accessToken: "", expireTime: undefined, user: undefined };
+}
+
+export const ImpersonateUserResponse: MessageFns<ImpersonateUserResponse> = {
+  encode(message: ImpersonateUserResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.accessToken !== "") {
+      writer.uint32(10).string(message.accessToken);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(18).fork()).join();
+    }
+    if (message.user !== undefined) {
+      User.encode(message.user, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ImpersonateUserResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImpersonateUserResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.accessToken = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.user = User.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ImpersonateUserResponse>): ImpersonateUserResponse {
+    return ImpersonateUserResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImpersonateUserResponse>): ImpersonateUserResponse {
+    const message = createBaseImpersonateUserResponse();
+    message.accessToken = object.accessToken ?? "";
+    message.expireTime = object.expireTime ?? undefined;
+    message.user = (object.user !== undefined && object.user !== null) ? User.fromPartial(object.user) : undefined;
+    return message;
+  },
+};
+
+function createBaseUserEmail(): UserEmail {
+  return { email: "", verified: false, createdTime: undefined };
+}
+
+export const UserEmail: MessageFns<UserEmail> = {
+  encode(message: UserEmail, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.email !== "") {
+      writer.uint32(10).string(message.email);
+    }
+    if (message.verified !== false) {
+      writer.uint32(16).bool(message.verified);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UserEmail {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserEmail();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.email = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.verified = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UserEmail>): UserEmail {
+    return UserEmail.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UserEmail>): UserEmail {
+    const message = createBaseUserEmail();
+    message.email = object.email ?? "";
+    message.verified = object.verified ?? false;
+    message.createdTime = object.createdTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseListUserEmailsRequest(): ListUserEmailsRequest {
+  return { id: 0 };
+}
+
+export const ListUserEmailsRequest: MessageFns<ListUserEmailsRequest> = {
+  encode(message: ListUserEmailsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListUserEmailsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListUserEmailsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListUserEmailsRequest>): ListUserEmailsRequest {
+    return ListUserEmailsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListUserEmailsRequest>): ListUserEmailsRequest {
+    const message = createBaseListUserEmailsRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseListUserEmailsResponse(): ListUserEmailsResponse {
+  return { emails: [] };
+}
+
+export const ListUserEmailsResponse: MessageFns<ListUserEmailsResponse> = {
+  encode(message: ListUserEmailsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.emails) {
+      UserEmail.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListUserEmailsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListUserEmailsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.emails.push(UserEmail.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListUserEmailsResponse>): ListUserEmailsResponse {
+    return ListUserEmailsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListUserEmailsResponse>): ListUserEmailsResponse {
+    const message = createBaseListUserEmailsResponse();
+    message.emails = object.emails?.map((e) => UserEmail.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseCreateUserEmailRequest(): CreateUserEmailRequest {
+  return { id: 0, email: "" };
+}
+
+export const CreateUserEmailRequest: MessageFns<CreateUserEmailRequest> = {
+  encode(message: CreateUserEmailRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.email !== "") {
+      writer.uint32(18).string(message.email);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateUserEmailRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateUserEmailRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.email = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateUserEmailRequest>): CreateUserEmailRequest {
+    return CreateUserEmailRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateUserEmailRequest>): CreateUserEmailRequest {
+    const message = createBaseCreateUserEmailRequest();
+    message.id = object.id ?? 0;
+    message.email = object.email ?? "";
+    return message;
+  },
+};
+
+function createBaseDeleteUserEmailRequest(): DeleteUserEmailRequest {
+  return { id: 0, email: "" };
+}
+
+export const DeleteUserEmailRequest: MessageFns<DeleteUserEmailRequest> = {
+  encode(message: DeleteUserEmailRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.email !== "") {
+      writer.uint32(18).string(message.email);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteUserEmailRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteUserEmailRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.email = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DeleteUserEmailRequest>): DeleteUserEmailRequest {
+    return DeleteUserEmailRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteUserEmailRequest>): DeleteUserEmailRequest {
+    const message = createBaseDeleteUserEmailRequest();
+    message.id = object.id ?? 0;
+    message.email = object.email ?? "";
+    return message;
+  },
+};
+
+function createBaseSetUserPrimaryEmailRequest(): SetUserPrimaryEmailRequest {
+  return { id: 0, email: "" };
+}
+
+export const SetUserPrimaryEmailRequest: MessageFns<SetUserPrimaryEmailRequest> = {
+  encode(message: SetUserPrimaryEmailRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.email !== "") {
+      writer.uint32(18).string(message.email);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SetUserPrimaryEmailRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSetUserPrimaryEmailRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.email = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SetUserPrimaryEmailRequest>): SetUserPrimaryEmailRequest {
+    return SetUserPrimaryEmailRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SetUserPrimaryEmailRequest>): SetUserPrimaryEmailRequest {
+    const message = createBaseSetUserPrimaryEmailRequest();
+    message.id = object.id ?? 0;
+    message.email = object.email ?? "";
+    return message;
+  },
+};
+
+function createBaseGetUserPublicProfileRequest(): GetUserPublicProfileRequest {
+  return { username: "" };
+}
+
+export const GetUserPublicProfileRequest: MessageFns<GetUserPublicProfileRequest> = {
+  encode(message: GetUserPublicProfileRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.username !== "") {
+      writer.uint32(10).string(message.username);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetUserPublicProfileRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetUserPublicProfileRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetUserPublicProfileRequest>): GetUserPublicProfileRequest {
+    return GetUserPublicProfileRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetUserPublicProfileRequest>): GetUserPublicProfileRequest {
+    const message = createBaseGetUserPublicProfileRequest();
+    message.username = object.username ?? "";
+    return message;
+  },
+};
+
+function createBaseUserPublicProfile(): UserPublicProfile {
+  return { username: "", nickname: "", avatarUrl: "", shortcuts: [], collections: [] };
+}
+
+export const UserPublicProfile: MessageFns<UserPublicProfile> = {
+  encode(message: UserPublicProfile, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.username !== "") {
+      writer.uint32(10).string(message.username);
+    }
+    if (message.nickname !== "") {
+      writer.uint32(18).string(message.nickname);
+    }
+    if (message.avatarUrl !== "") {
+      writer.uint32(26).string(message.avatarUrl);
+    }
+    for (const v of message.shortcuts) {
+      Shortcut.encode(v!, writer.uint32(34).fork()).join();
+    }
+    for (const v of message.collections) {
+      Collection.encode(v!, writer.uint32(42).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UserPublicProfile {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserPublicProfile();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nickname = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.avatarUrl = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.shortcuts.push(Shortcut.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.collections.push(Collection.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UserPublicProfile>): UserPublicProfile {
+    return UserPublicProfile.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UserPublicProfile>): UserPublicProfile {
+    const message = createBaseUserPublicProfile();
+    message.username = object.username ?? "";
+    message.nickname = object.nickname ?? "";
+    message.avatarUrl = object.avatarUrl ?? "";
+    message.shortcuts = object.shortcuts?.map((e) => Shortcut.fromPartial(e)) || [];
+    message.collections = object.collections?.map((e) => Collection.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+export type UserServiceDefinition = typeof UserServiceDefinition;
+export const UserServiceDefinition = {
+  name: "UserService",
+  fullName: "slash.api.v1.UserService",
+  methods: {
+    /** ListUsers returns a list of users. */
+    listUsers: {
+      name: "ListUsers",
+      requestType: ListUsersRequest,
+      requestStream: false,
+      responseType: ListUsersResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [new Uint8Array([15, 18, 13, 47, 97, 112, 105, 47, 118, 49, 47, 117, 115, 101, 114, 115])],
+        },
+      },
+    },
+    /** GetUser returns a user by id. */
+    getUser: {
+      name: "GetUser",
+      requestType: GetUserRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              20,
+              18,
+              18,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** CreateUser creates a new user. */
+    createUser: {
+      name: "CreateUser",
+      requestType: CreateUserRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              21,
+              58,
+              4,
+              117,
+              115,
+              101,
+              114,
+              34,
+              13,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    updateUser: {
+      name: "UpdateUser",
+      requestType: UpdateUserRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([16, 117, 115, 101, 114, 44, 117, 112, 100, 97, 116, 101, 95, 109, 97, 115, 107])],
+          578365826: [
+            new Uint8Array([
+              31,
+              58,
+              4,
+              117,
+              115,
+              101,
+              114,
+              50,
+              23,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              123,
+              117,
+              115,
+              101,
+              114,
+              46,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteUser deletes a user by id. */
+    deleteUser: {
+      name: "DeleteUser",
+      requestType: DeleteUserRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              20,
+              42,
+              18,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteMyAccount deletes the account of the current user. */
+    deleteMyAccount: {
+      name: "DeleteMyAccount",
+      requestType: DeleteMyAccountRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              28,
+              58,
+              1,
+              42,
+              34,
+              23,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              109,
+              101,
+              58,
+              100,
+              101,
+              108,
+              101,
+              116,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListUserAccessTokens returns a list of access tokens for a user. */
+    listUserAccessTokens: {
+      name: "ListUserAccessTokens",
+      requestType: ListUserAccessTokensRequest,
+      requestStream: false,
+      responseType: ListUserAccessTokensResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              34,
+              18,
+              32,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              97,
+              99,
+              99,
+              101,
+              115,
+              115,
+              95,
+              116,
+              111,
+              107,
+              101,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** CreateUserAccessToken creates a new access token for a user. */
+    createUserAccessToken: {
+      name: "CreateUserAccessToken",
+      requestType: CreateUserAccessTokenRequest,
+      requestStream: false,
+      responseType: UserAccessToken,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              37,
+              58,
+              1,
+              42,
+              34,
+              32,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              97,
+              99,
+              99,
+              101,
+              115,
+              115,
+              95,
+              116,
+              111,
+              107,
+              101,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteUserAccessToken deletes an access token for a user. */
+    deleteUserAccessToken: {
+      name: "DeleteUserAccessToken",
+      requestType: DeleteUserAccessTokenRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([15, 105, 100, 44, 97, 99, 99, 101, 115, 115, 95, 116, 111, 107, 101, 110])],
+          578365826: [
+            new Uint8Array([
+              49,
+              42,
+              47,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              97,
+              99,
+              99,
+              101,
+              115,
+              115,
+              95,
+              116,
+              111,
+              107,
+              101,
+              110,
+              115,
+              47,
+              123,
+              97,
+              99,
+              99,
+              101,
+              115,
+              115,
+              95,
+              116,
+              111,
+              107,
+              101,
+              110,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListUserPasskeys returns the passkeys of the user. */
+    listUserPasskeys: {
+      name: "ListUserPasskeys",
+      requestType: ListUserPasskeysRequest,
+      requestStream: false,
+      responseType: ListUserPasskeysResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              29,
+              18,
+              27,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              112,
+              97,
+              115,
+              115,
+              107,
+              101,
+              121,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteUserPasskey deletes a passkey of the user. */
+    deleteUserPasskey: {
+      name: "DeleteUserPasskey",
+      requestType: DeleteUserPasskeyRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([13, 105, 100, 44, 112, 97, 115, 115, 107, 101, 121, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              42,
+              42,
+              40,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              112,
+              97,
+              115,
+              115,
+              107,
+              101,
+              121,
+              115,
+              47,
+              123,
+              112,
+              97,
+              115,
+              115,
+              107,
+              101,
+              121,
+              95,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
       },
     },
-    /** CreateUser creates a new user. */
-    createUser: {
-      name: "CreateUser",
-      requestType: CreateUserRequest,
+    /** ListUserSessions returns the active sign-in sessions of the user. */
+    listUserSessions: {
+      name: "ListUserSessions",
+      requestType: ListUserSessionsRequest,
       requestStream: false,
-      responseType: User,
+      responseType: ListUserSessionsResponse,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
           578365826: [
             new Uint8Array([
-              21,
-              58,
-              4,
-              117,
-              115,
-              101,
-              114,
-              34,
-              13,
+              29,
+              18,
+              27,
               47,
               97,
               112,
@@ -928,31 +2864,40 @@ export const UserServiceDefinition = {
               101,
               114,
               115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              115,
+              101,
+              115,
+              115,
+              105,
+              111,
+              110,
+              115,
             ]),
           ],
         },
       },
     },
-    updateUser: {
-      name: "UpdateUser",
-      requestType: UpdateUserRequest,
+    /** RevokeUserSession signs the user out of a session, e.g. on a lost device. */
+    revokeUserSession: {
+      name: "RevokeUserSession",
+      requestType: RevokeUserSessionRequest,
       requestStream: false,
-      responseType: User,
+      responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([16, 117, 115, 101, 114, 44, 117, 112, 100, 97, 116, 101, 95, 109, 97, 115, 107])],
+          8410: [new Uint8Array([13, 105, 100, 44, 115, 101, 115, 115, 105, 111, 110, 95, 105, 100])],
           578365826: [
             new Uint8Array([
-              31,
-              58,
-              4,
-              117,
-              115,
-              101,
-              114,
-              50,
-              23,
+              42,
+              42,
+              40,
               47,
               97,
               112,
@@ -968,11 +2913,28 @@ export const UserServiceDefinition = {
               115,
               47,
               123,
-              117,
+              105,
+              100,
+              125,
+              47,
               115,
               101,
-              114,
-              46,
+              115,
+              115,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              115,
+              101,
+              115,
+              115,
+              105,
+              111,
+              110,
+              95,
               105,
               100,
               125,
@@ -981,21 +2943,27 @@ export const UserServiceDefinition = {
         },
       },
     },
-    /** DeleteUser deletes a user by id. */
-    deleteUser: {
-      name: "DeleteUser",
-      requestType: DeleteUserRequest,
+    /**
+     * ImpersonateUser signs the admin in as another user for a limited time, to debug what the user sees.
+     * The access token is set as the cookie and returned, and the impersonation is recorded in the activities.
+     */
+    impersonateUser: {
+      name: "ImpersonateUser",
+      requestType: ImpersonateUserRequest,
       requestStream: false,
-      responseType: Empty,
+      responseType: ImpersonateUserResponse,
       responseStream: false,
       options: {
         _unknownFields: {
           8410: [new Uint8Array([2, 105, 100])],
           578365826: [
             new Uint8Array([
-              20,
+              35,
+              58,
+              1,
               42,
-              18,
+              34,
+              30,
               47,
               97,
               112,
@@ -1014,26 +2982,38 @@ export const UserServiceDefinition = {
               105,
               100,
               125,
+              58,
+              105,
+              109,
+              112,
+              101,
+              114,
+              115,
+              111,
+              110,
+              97,
+              116,
+              101,
             ]),
           ],
         },
       },
     },
-    /** ListUserAccessTokens returns a list of access tokens for a user. */
-    listUserAccessTokens: {
-      name: "ListUserAccessTokens",
-      requestType: ListUserAccessTokensRequest,
+    /** ListUserEmails returns the secondary emails of a user. */
+    listUserEmails: {
+      name: "ListUserEmails",
+      requestType: ListUserEmailsRequest,
       requestStream: false,
-      responseType: ListUserAccessTokensResponse,
+      responseType: ListUserEmailsResponse,
       responseStream: false,
       options: {
         _unknownFields: {
           8410: [new Uint8Array([2, 105, 100])],
           578365826: [
             new Uint8Array([
-              34,
+              27,
               18,
-              32,
+              25,
               47,
               97,
               112,
@@ -1053,42 +3033,35 @@ export const UserServiceDefinition = {
               100,
               125,
               47,
-              97,
-              99,
-              99,
-              101,
-              115,
-              115,
-              95,
-              116,
-              111,
-              107,
               101,
-              110,
+              109,
+              97,
+              105,
+              108,
               115,
             ]),
           ],
         },
       },
     },
-    /** CreateUserAccessToken creates a new access token for a user. */
-    createUserAccessToken: {
-      name: "CreateUserAccessToken",
-      requestType: CreateUserAccessTokenRequest,
+    /** CreateUserEmail adds a secondary email to a user. */
+    createUserEmail: {
+      name: "CreateUserEmail",
+      requestType: CreateUserEmailRequest,
       requestStream: false,
-      responseType: UserAccessToken,
+      responseType: UserEmail,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
+          8410: [new Uint8Array([8, 105, 100, 44, 101, 109, 97, 105, 108])],
           578365826: [
             new Uint8Array([
-              37,
+              30,
               58,
               1,
               42,
               34,
-              32,
+              25,
               47,
               97,
               112,
@@ -1108,39 +3081,32 @@ export const UserServiceDefinition = {
               100,
               125,
               47,
-              97,
-              99,
-              99,
-              101,
-              115,
-              115,
-              95,
-              116,
-              111,
-              107,
               101,
-              110,
+              109,
+              97,
+              105,
+              108,
               115,
             ]),
           ],
         },
       },
     },
-    /** DeleteUserAccessToken deletes an access token for a user. */
-    deleteUserAccessToken: {
-      name: "DeleteUserAccessToken",
-      requestType: DeleteUserAccessTokenRequest,
+    /** DeleteUserEmail removes a secondary email from a user. */
+    deleteUserEmail: {
+      name: "DeleteUserEmail",
+      requestType: DeleteUserEmailRequest,
       requestStream: false,
       responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([15, 105, 100, 44, 97, 99, 99, 101, 115, 115, 95, 116, 111, 107, 101, 110])],
+          8410: [new Uint8Array([8, 105, 100, 44, 101, 109, 97, 105, 108])],
           578365826: [
             new Uint8Array([
-              49,
+              35,
               42,
-              47,
+              33,
               47,
               97,
               112,
@@ -1160,33 +3126,136 @@ export const UserServiceDefinition = {
               100,
               125,
               47,
+              101,
+              109,
               97,
-              99,
-              99,
+              105,
+              108,
+              115,
+              47,
+              123,
               101,
+              109,
+              97,
+              105,
+              108,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * SetUserPrimaryEmail makes a verified secondary email the primary email of a user.
+     * The previous primary email is kept as a verified secondary email.
+     */
+    setUserPrimaryEmail: {
+      name: "SetUserPrimaryEmail",
+      requestType: SetUserPrimaryEmailRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([8, 105, 100, 44, 101, 109, 97, 105, 108])],
+          578365826: [
+            new Uint8Array([
+              46,
+              58,
+              1,
+              42,
+              34,
+              41,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
               115,
+              101,
+              114,
               115,
-              95,
-              116,
-              111,
-              107,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
               101,
-              110,
+              109,
+              97,
+              105,
+              108,
               115,
               47,
               123,
+              101,
+              109,
               97,
-              99,
-              99,
+              105,
+              108,
+              125,
+              47,
+              112,
+              114,
+              105,
+              109,
+              97,
+              114,
+              121,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * GetUserPublicProfile returns the public profile of a user by username.
+     * It's not found when the user disabled the public profile.
+     */
+    getUserPublicProfile: {
+      name: "GetUserPublicProfile",
+      requestType: GetUserPublicProfileRequest,
+      requestStream: false,
+      responseType: UserPublicProfile,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([8, 117, 115, 101, 114, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              29,
+              18,
+              27,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              112,
+              114,
+              111,
+              102,
+              105,
+              108,
               101,
               115,
+              47,
+              123,
+              117,
               115,
-              95,
-              116,
-              111,
-              107,
               101,
+              114,
               110,
+              97,
+              109,
+              101,
               125,
             ]),
           ],
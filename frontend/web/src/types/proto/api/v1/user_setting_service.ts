@@ -19,6 +19,13 @@ export interface UserSetting {
 export interface UserSetting_GeneralSetting {
   locale: string;
   colorTheme: string;
+  /** Whether the public profile page of the user is disabled. */
+  disablePublicProfile: boolean;
+  /**
+   * Whether to confirm the redirects to the external domains of the workspace, "ALWAYS" or "NEVER".
+   * "WORKSPACE" or empty follows the workspace.
+   */
+  externalRedirectConfirmation: string;
 }
 
 export interface UserSetting_AccessTokensSetting {
@@ -124,7 +131,7 @@ export const UserSetting: MessageFns<UserSetting> = {
 };
 
 function createBaseUserSetting_GeneralSetting(): UserSetting_GeneralSetting {
-  return { locale: "", colorTheme: "" };
+  return { locale: "", colorTheme: "", disablePublicProfile: false, externalRedirectConfirmation: "" };
 }
 
 export const UserSetting_GeneralSetting: MessageFns<UserSetting_GeneralSetting> = {
@@ -135,6 +142,12 @@ export const UserSetting_GeneralSetting: MessageFns<UserSetting_GeneralSetting>
     if (message.colorTheme !== "") {
       writer.uint32(18).string(message.colorTheme);
     }
+    if (message.disablePublicProfile !== false) {
+      writer.uint32(24).bool(message.disablePublicProfile);
+    }
+    if (message.externalRedirectConfirmation !== "") {
+      writer.uint32(34).string(message.externalRedirectConfirmation);
+    }
     return writer;
   },
 
@@ -161,6 +174,22 @@ export const UserSetting_GeneralSetting: MessageFns<UserSetting_GeneralSetting>
           message.colorTheme = reader.string();
           continue;
         }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.disablePublicProfile = reader.bool();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.externalRedirectConfirmation = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -177,6 +206,8 @@ export const UserSetting_GeneralSetting: MessageFns<UserSetting_GeneralSetting>
     const message = createBaseUserSetting_GeneralSetting();
     message.locale = object.locale ?? "";
     message.colorTheme = object.colorTheme ?? "";
+    message.disablePublicProfile = object.disablePublicProfile ?? false;
+    message.externalRedirectConfirmation = object.externalRedirectConfirmation ?? "";
     return message;
   },
 };
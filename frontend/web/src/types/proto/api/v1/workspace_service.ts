@@ -7,6 +7,8 @@
 /* eslint-disable */
 import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
 import { FieldMask } from "../../google/protobuf/field_mask";
+import { Timestamp } from "../../google/protobuf/timestamp";
+import { CollectionTemplate } from "./collection_service";
 import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
 import { Subscription } from "./subscription_service";
 
@@ -47,6 +49,234 @@ export interface WorkspaceSetting {
   disallowUserRegistration: boolean;
   /** Whether to disallow password authentication. */
   disallowPasswordAuth: boolean;
+  /**
+   * The number of days after which unused access tokens are revoked.
+   * 0 means access tokens are never revoked for inactivity.
+   */
+  accessTokenInactivityDays: number;
+  /** The alerts on traffic spikes and drops of shortcuts. */
+  anomalyAlert?:
+    | AnomalyAlertSetting
+    | undefined;
+  /** The sync of the shortcuts with a file in a GitHub repository. Only visible to admins. */
+  gitSync?:
+    | GitSyncSetting
+    | undefined;
+  /** The behavior when the shortcut doesn't exist. */
+  notFound?:
+    | NotFoundSetting
+    | undefined;
+  /** The admin-defined collection templates, besides the built-in ones. */
+  collectionTemplates: CollectionTemplate[];
+  /** The remote Slash instances to import the public shortcuts and collections from. Only visible to admins. */
+  federationSources: FederationSource[];
+  /** The rules of the query parameters stripped from the links of the shortcuts when saving. */
+  linkParamRules?:
+    | LinkParamRules
+    | undefined;
+  /**
+   * The window in seconds within which the repeated views of a shortcut from the same IP and user agent are counted once.
+   * 0 counts every view.
+   */
+  viewDedupeWindowSeconds: number;
+  /** The SMTP server to send the emails with. Only visible to admins. */
+  smtp?:
+    | SmtpConfig
+    | undefined;
+  /** Whether the users signing up with a password have to verify their email before using Slash. */
+  requireEmailVerification: boolean;
+  /** Whether to record the signed-in users viewing the shortcuts, to see who uses them in the analytics. */
+  attributeViewsToUsers: boolean;
+  /**
+   * The user the shortcuts and collections are transferred to when their creator deletes their account.
+   * 0 means the users can only delete their data with their account.
+   */
+  accountHandoverUserId: number;
+  /** What the root path serves. */
+  landing?:
+    | LandingSetting
+    | undefined;
+  /**
+   * The HTTP status code of the redirects of the shortcuts without one: 301, 302 or 307.
+   * 0 serves the shortcut page, which redirects in the browser with the Open Graph metadata for link previews.
+   */
+  defaultRedirectCode: number;
+  /** The domains of the internal links, e.g. "example.com", which also covers its subdomains. */
+  internalDomains: string[];
+  /** Whether to confirm the redirects to the domains outside of the internal domains, unless the user opts out. */
+  confirmExternalRedirects: boolean;
+  /** The daily health checks of the links of the shortcuts. */
+  linkHealthCheck?:
+    | LinkHealthCheckSetting
+    | undefined;
+  /** The completion provider suggesting the title, description and tags of the new shortcuts. Only visible to admins. */
+  completion?:
+    | CompletionSetting
+    | undefined;
+  /** Whether the suggestions of the shortcut metadata are enabled. */
+  completionEnabled: boolean;
+  /** Whether the semantic search of the shortcuts is enabled. */
+  semanticSearchEnabled: boolean;
+  /**
+   * The names the shortcuts and their aliases can't take, nor start with as their first segment, e.g. "admin".
+   * The routes of Slash, e.g. "api" and "s", are always reserved.
+   */
+  reservedShortcutNames: string[];
+}
+
+export interface CompletionSetting {
+  /** The base url of the OpenAI-compatible API, e.g. "https://api.openai.com/v1". Empty disables the suggestions. */
+  endpoint: string;
+  /** The API key sent as a bearer token. Empty sends none, e.g. for a local model. */
+  apiKey: string;
+  /** The model of the chat completions, e.g. "gpt-4o-mini". */
+  model: string;
+  /** The model of the embeddings of the semantic search, e.g. "text-embedding-3-small". Empty disables the semantic search. */
+  embeddingModel: string;
+}
+
+export interface LinkParamRules {
+  /** The patterns of the query parameters to strip, where "*" matches any characters, e.g. "utm_*" and "fbclid". */
+  deny: string[];
+  /** The patterns of the query parameters to keep even if they match the deny patterns, e.g. "utm_id". */
+  allow: string[];
+}
+
+export interface LandingSetting {
+  type: LandingSetting_Type;
+  /** The name of the collection to redirect to. */
+  collectionName: string;
+  /** The HTML of the custom page. */
+  pageHtml: string;
+  /** The external url to redirect to. */
+  redirectUrl: string;
+  /** Whether the signed-in users get the landing too, instead of the app, which stays at /shortcuts. */
+  applyToSignedInUsers: boolean;
+}
+
+export enum LandingSetting_Type {
+  /** TYPE_UNSPECIFIED - Serves the app. */
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  /** COLLECTION - Redirects to a collection. */
+  COLLECTION = "COLLECTION",
+  /** PAGE - Serves a custom HTML page, e.g. a marketing page. */
+  PAGE = "PAGE",
+  /** REDIRECT - Redirects to an external url, e.g. the intranet. */
+  REDIRECT = "REDIRECT",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function landingSetting_TypeFromJSON(object: any): LandingSetting_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return LandingSetting_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "COLLECTION":
+      return LandingSetting_Type.COLLECTION;
+    case 2:
+    case "PAGE":
+      return LandingSetting_Type.PAGE;
+    case 3:
+    case "REDIRECT":
+      return LandingSetting_Type.REDIRECT;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return LandingSetting_Type.UNRECOGNIZED;
+  }
+}
+
+export function landingSetting_TypeToNumber(object: LandingSetting_Type): number {
+  switch (object) {
+    case LandingSetting_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case LandingSetting_Type.COLLECTION:
+      return 1;
+    case LandingSetting_Type.PAGE:
+      return 2;
+    case LandingSetting_Type.REDIRECT:
+      return 3;
+    case LandingSetting_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface NotFoundSetting {
+  /**
+   * The url to redirect to when the shortcut doesn't exist, where `{name}` is replaced by the shortcut name,
+   * e.g. "https://search.example.com/?q={name}". Empty shows the not found page.
+   */
+  redirectUrl: string;
+  /** The message of the not found page. */
+  message: string;
+  /** Whether to hide the offer to create the missing shortcut from the signed-in users. */
+  disableCreateOffer: boolean;
+}
+
+export interface GitSyncSetting {
+  /** Whether to sync the shortcuts from the file in the GitHub repository. */
+  enabled: boolean;
+  /** The GitHub repository in the format of "owner/repo". */
+  repository: string;
+  /** The branch of the file. Defaults to "main". */
+  branch: string;
+  /** The path of the YAML/JSON file of the shortcut definitions. */
+  path: string;
+  /** The GitHub token to read the file and to open pull requests. */
+  accessToken: string;
+  /** The secret to verify the signatures of the GitHub push webhooks. */
+  webhookSecret: string;
+  /** Whether to propose the manual changes of the synced shortcuts as pull requests. */
+  writeBack: boolean;
+  /** Output only. The blob sha of the last applied file. */
+  lastSyncedSha: string;
+}
+
+export interface FederationSource {
+  /** The unique id of the source. */
+  id: string;
+  /** Whether to sync the source. */
+  enabled: boolean;
+  /** The url of the remote Slash instance, e.g. "https://slash.eng.example.com". */
+  url: string;
+  /** The access token of a user of the remote instance to list its shortcuts and collections. */
+  accessToken: string;
+  /** The prefix of the names of the imported shortcuts and collections, e.g. "eng/". */
+  prefix: string;
+  /** The minutes between the syncs. Defaults to 60. */
+  intervalMinutes: number;
+  /** Output only. The time of the last sync, successful or not. */
+  lastSyncTime?:
+    | Date
+    | undefined;
+  /** Output only. The error of the last sync. Empty when it succeeded. */
+  lastError: string;
+  /** Output only. The number of the shortcuts imported from the source. */
+  shortcutCount: number;
+  /** Output only. The number of the collections imported from the source. */
+  collectionCount: number;
+}
+
+export interface AnomalyAlertSetting {
+  /** Whether to detect traffic spikes and drops of shortcuts. */
+  enabled: boolean;
+  /** The webhook url to post the alerts to. */
+  webhookUrl: string;
+  /** The z-score of the hourly views to be an anomaly. Defaults to 3. */
+  zScoreThreshold: number;
+  /** The min hourly views of a spike, or of the baseline of a drop. Defaults to 10. */
+  minViews: number;
+}
+
+export interface LinkHealthCheckSetting {
+  /** Whether to check the links of the shortcuts daily. */
+  enabled: boolean;
+  /** The webhook url to post the newly broken links to. Only visible to admins. */
+  webhookUrl: string;
+  /** Whether to email the creators of the shortcuts with newly broken links, with the SMTP server of the workspace. */
+  notifyCreators: boolean;
 }
 
 export interface IdentityProvider {
@@ -54,12 +284,25 @@ export interface IdentityProvider {
   id: string;
   title: string;
   type: IdentityProvider_Type;
-  config?: IdentityProviderConfig | undefined;
+  config?:
+    | IdentityProviderConfig
+    | undefined;
+  /** The display order of the sign in button, ascending. */
+  displayOrder: number;
+  /** The icon url of the sign in button. */
+  iconUrl: string;
+  /**
+   * Whether to redirect to the identity provider automatically
+   * when it's the only available sign in method.
+   */
+  autoRedirect: boolean;
 }
 
 export enum IdentityProvider_Type {
   TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
   OAUTH2 = "OAUTH2",
+  SAML = "SAML",
+  LDAP = "LDAP",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -71,6 +314,12 @@ export function identityProvider_TypeFromJSON(object: any): IdentityProvider_Typ
     case 1:
     case "OAUTH2":
       return IdentityProvider_Type.OAUTH2;
+    case 2:
+    case "SAML":
+      return IdentityProvider_Type.SAML;
+    case 3:
+    case "LDAP":
+      return IdentityProvider_Type.LDAP;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -84,6 +333,10 @@ export function identityProvider_TypeToNumber(object: IdentityProvider_Type): nu
       return 0;
     case IdentityProvider_Type.OAUTH2:
       return 1;
+    case IdentityProvider_Type.SAML:
+      return 2;
+    case IdentityProvider_Type.LDAP:
+      return 3;
     case IdentityProvider_Type.UNRECOGNIZED:
     default:
       return -1;
@@ -92,11 +345,20 @@ export function identityProvider_TypeToNumber(object: IdentityProvider_Type): nu
 
 export interface IdentityProviderConfig {
   oauth2?: IdentityProviderConfig_OAuth2Config | undefined;
+  saml?: IdentityProviderConfig_SAMLConfig | undefined;
+  ldap?: IdentityProviderConfig_LDAPConfig | undefined;
 }
 
 export interface IdentityProviderConfig_FieldMapping {
   identifier: string;
   displayName: string;
+  /**
+   * groups is the claim or attribute with the groups of the user.
+   * When set, the role of the user is synced from the groups on every sign in.
+   */
+  groups: string;
+  /** admin_group is the group whose members are admins, the others are users. */
+  adminGroup: string;
 }
 
 export interface IdentityProviderConfig_OAuth2Config {
@@ -106,6 +368,50 @@ export interface IdentityProviderConfig_OAuth2Config {
   tokenUrl: string;
   userInfoUrl: string;
   scopes: string[];
+  fieldMapping?:
+    | IdentityProviderConfig_FieldMapping
+    | undefined;
+  /**
+   * The issuer url of the OpenID Connect provider, e.g. "https://accounts.google.com".
+   * When set, the endpoints are discovered from its "/.well-known/openid-configuration",
+   * and the ID token is verified on sign in.
+   */
+  issuerUrl: string;
+}
+
+/**
+ * SAMLConfig is the config of a SAML 2.0 identity provider, where Slash is the service provider.
+ * The identifier of the field mapping is the attribute name of the email, or empty for the NameID.
+ */
+export interface IdentityProviderConfig_SAMLConfig {
+  /** The entity id of the identity provider, i.e. the issuer of the responses. */
+  entityId: string;
+  /** The single sign-on url of the identity provider with the HTTP-Redirect binding. */
+  ssoUrl: string;
+  /** The PEM encoded certificate to verify the signatures of the identity provider. */
+  certificate: string;
+  fieldMapping?: IdentityProviderConfig_FieldMapping | undefined;
+}
+
+/**
+ * LDAPConfig is the config of an LDAP or Active Directory server, where users sign in with their directory credentials.
+ * The identifier of the field mapping is the attribute of the email, e.g. "mail".
+ */
+export interface IdentityProviderConfig_LDAPConfig {
+  /** The url of the server, e.g. "ldaps://ldap.example.com:636" or "ldap://ldap.example.com:389". */
+  url: string;
+  /** Whether to upgrade the "ldap://" connection with StartTLS. */
+  startTls: boolean;
+  /** The DN and password to bind with to search the users, or empty to search anonymously. */
+  bindDn: string;
+  bindPassword: string;
+  /** The base DN to search the users under, e.g. "ou=people,dc=example,dc=com". */
+  searchBase: string;
+  /**
+   * The filter to search the user, where "%s" is replaced with the escaped username,
+   * e.g. "(uid=%s)" or "(&(objectClass=user)(sAMAccountName=%s))".
+   */
+  userFilter: string;
   fieldMapping?: IdentityProviderConfig_FieldMapping | undefined;
 }
 
@@ -124,6 +430,149 @@ export interface UpdateWorkspaceSettingRequest {
   updateMask?: string[] | undefined;
 }
 
+export interface SmtpConfig {
+  host: string;
+  port: number;
+  username: string;
+  password: string;
+  encryption: SmtpConfig_Encryption;
+  /** The sender address, e.g. "Slash <noreply@example.com>". */
+  from: string;
+}
+
+export enum SmtpConfig_Encryption {
+  ENCRYPTION_UNSPECIFIED = "ENCRYPTION_UNSPECIFIED",
+  SSL_TLS = "SSL_TLS",
+  STARTTLS = "STARTTLS",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function smtpConfig_EncryptionFromJSON(object: any): SmtpConfig_Encryption {
+  switch (object) {
+    case 0:
+    case "ENCRYPTION_UNSPECIFIED":
+      return SmtpConfig_Encryption.ENCRYPTION_UNSPECIFIED;
+    case 1:
+    case "SSL_TLS":
+      return SmtpConfig_Encryption.SSL_TLS;
+    case 2:
+    case "STARTTLS":
+      return SmtpConfig_Encryption.STARTTLS;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return SmtpConfig_Encryption.UNRECOGNIZED;
+  }
+}
+
+export function smtpConfig_EncryptionToNumber(object: SmtpConfig_Encryption): number {
+  switch (object) {
+    case SmtpConfig_Encryption.ENCRYPTION_UNSPECIFIED:
+      return 0;
+    case SmtpConfig_Encryption.SSL_TLS:
+      return 1;
+    case SmtpConfig_Encryption.STARTTLS:
+      return 2;
+    case SmtpConfig_Encryption.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface TestIdentityProviderRequest {
+  identityProvider?: IdentityProvider | undefined;
+}
+
+export interface TestSmtpRequest {
+  smtpConfig?:
+    | SmtpConfig
+    | undefined;
+  /** The recipient of the test message. Defaults to the email of current user. */
+  recipient: string;
+}
+
+export interface TestConnectionResponse {
+  /** Whether all checks are passed. */
+  ok: boolean;
+  checks: TestConnectionResponse_Check[];
+}
+
+export interface TestConnectionResponse_Check {
+  /** The name of the check, e.g. "token_url". */
+  name: string;
+  ok: boolean;
+  /** The diagnostic message of the check. */
+  message: string;
+}
+
+export interface ExportWorkspaceRequest {
+  /** The format of the export. Defaults to JSON. */
+  format: ExportWorkspaceRequest_Format;
+}
+
+export enum ExportWorkspaceRequest_Format {
+  FORMAT_UNSPECIFIED = "FORMAT_UNSPECIFIED",
+  JSON = "JSON",
+  CSV = "CSV",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function exportWorkspaceRequest_FormatFromJSON(object: any): ExportWorkspaceRequest_Format {
+  switch (object) {
+    case 0:
+    case "FORMAT_UNSPECIFIED":
+      return ExportWorkspaceRequest_Format.FORMAT_UNSPECIFIED;
+    case 1:
+    case "JSON":
+      return ExportWorkspaceRequest_Format.JSON;
+    case 2:
+    case "CSV":
+      return ExportWorkspaceRequest_Format.CSV;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ExportWorkspaceRequest_Format.UNRECOGNIZED;
+  }
+}
+
+export function exportWorkspaceRequest_FormatToNumber(object: ExportWorkspaceRequest_Format): number {
+  switch (object) {
+    case ExportWorkspaceRequest_Format.FORMAT_UNSPECIFIED:
+      return 0;
+    case ExportWorkspaceRequest_Format.JSON:
+      return 1;
+    case ExportWorkspaceRequest_Format.CSV:
+      return 2;
+    case ExportWorkspaceRequest_Format.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ExportWorkspaceResponse {
+  /** The content of the exported file. */
+  content: Uint8Array;
+  contentType: string;
+  filename: string;
+}
+
+export interface GetInstanceStatsRequest {
+}
+
+export interface InstanceStats {
+  shortcutCount: number;
+  collectionCount: number;
+  userCount: number;
+  /** The number of activities, e.g. the shortcut views, not archived. */
+  activityCount: number;
+  /** The estimated size of the database in bytes. */
+  databaseSizeBytes: number;
+  /** The ratio of the shortcut lookups by id served by the cache of this instance since it started, from 0 to 1. */
+  cacheHitRate: number;
+  /** The average shortcut views per minute in the last 10 minutes, across the instances. */
+  redirectsPerMinute: number;
+}
+
 function createBaseWorkspaceProfile(): WorkspaceProfile {
   return { mode: "", version: "", owner: "", subscription: undefined, customStyle: "", branding: new Uint8Array(0) };
 }
@@ -241,6 +690,27 @@ function createBaseWorkspaceSetting(): WorkspaceSetting {
     identityProviders: [],
     disallowUserRegistration: false,
     disallowPasswordAuth: false,
+    accessTokenInactivityDays: 0,
+    anomalyAlert: undefined,
+    gitSync: undefined,
+    notFound: undefined,
+    collectionTemplates: [],
+    federationSources: [],
+    linkParamRules: undefined,
+    viewDedupeWindowSeconds: 0,
+    smtp: undefined,
+    requireEmailVerification: false,
+    attributeViewsToUsers: false,
+    accountHandoverUserId: 0,
+    landing: undefined,
+    defaultRedirectCode: 0,
+    internalDomains: [],
+    confirmExternalRedirects: false,
+    linkHealthCheck: undefined,
+    completion: undefined,
+    completionEnabled: false,
+    semanticSearchEnabled: false,
+    reservedShortcutNames: [],
   };
 }
 
@@ -267,6 +737,69 @@ export const WorkspaceSetting: MessageFns<WorkspaceSetting> = {
     if (message.disallowPasswordAuth !== false) {
       writer.uint32(56).bool(message.disallowPasswordAuth);
     }
+    if (message.accessTokenInactivityDays !== 0) {
+      writer.uint32(64).int32(message.accessTokenInactivityDays);
+    }
+    if (message.anomalyAlert !== undefined) {
+      AnomalyAlertSetting.encode(message.anomalyAlert, writer.uint32(74).fork()).join();
+    }
+    if (message.gitSync !== undefined) {
+      GitSyncSetting.encode(message.gitSync, writer.uint32(82).fork()).join();
+    }
+    if (message.notFound !== undefined) {
+      NotFoundSetting.encode(message.notFound, writer.uint32(90).fork()).join();
+    }
+    for (const v of message.collectionTemplates) {
+      CollectionTemplate.encode(v!, writer.uint32(98).fork()).join();
+    }
+    for (const v of message.federationSources) {
+      FederationSource.encode(v!, writer.uint32(106).fork()).join();
+    }
+    if (message.linkParamRules !== undefined) {
+      LinkParamRules.encode(message.linkParamRules, writer.uint32(114).fork()).join();
+    }
+    if (message.viewDedupeWindowSeconds !== 0) {
+      writer.uint32(120).int32(message.viewDedupeWindowSeconds);
+    }
+    if (message.smtp !== undefined) {
+      SmtpConfig.encode(message.smtp, writer.uint32(130).fork()).join();
+    }
+    if (message.requireEmailVerification !== false) {
+      writer.uint32(136).bool(message.requireEmailVerification);
+    }
+    if (message.attributeViewsToUsers !== false) {
+      writer.uint32(144).bool(message.attributeViewsToUsers);
+    }
+    if (message.accountHandoverUserId !== 0) {
+      writer.uint32(152).int32(message.accountHandoverUserId);
+    }
+    if (message.landing !== undefined) {
+      LandingSetting.encode(message.landing, writer.uint32(162).fork()).join();
+    }
+    if (message.defaultRedirectCode !== 0) {
+      writer.uint32(168).int32(message.defaultRedirectCode);
+    }
+    for (const v of message.internalDomains) {
+      writer.uint32(178).string(v!);
+    }
+    if (message.confirmExternalRedirects !== false) {
+      writer.uint32(184).bool(message.confirmExternalRedirects);
+    }
+    if (message.linkHealthCheck !== undefined) {
+      LinkHealthCheckSetting.encode(message.linkHealthCheck, writer.uint32(194).fork()).join();
+    }
+    if (message.completion !== undefined) {
+      CompletionSetting.encode(message.completion, writer.uint32(202).fork()).join();
+    }
+    if (message.completionEnabled !== false) {
+      writer.uint32(208).bool(message.completionEnabled);
+    }
+    if (message.semanticSearchEnabled !== false) {
+      writer.uint32(216).bool(message.semanticSearchEnabled);
+    }
+    for (const v of message.reservedShortcutNames) {
+      writer.uint32(226).string(v!);
+    }
     return writer;
   },
 
@@ -333,131 +866,261 @@ export const WorkspaceSetting: MessageFns<WorkspaceSetting> = {
           message.disallowPasswordAuth = reader.bool();
           continue;
         }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
-
-  create(base?: DeepPartial<WorkspaceSetting>): WorkspaceSetting {
-    return WorkspaceSetting.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<WorkspaceSetting>): WorkspaceSetting {
-    const message = createBaseWorkspaceSetting();
-    message.instanceUrl = object.instanceUrl ?? "";
-    message.branding = object.branding ?? new Uint8Array(0);
-    message.customStyle = object.customStyle ?? "";
-    message.defaultVisibility = object.defaultVisibility ?? Visibility.VISIBILITY_UNSPECIFIED;
-    message.identityProviders = object.identityProviders?.map((e) => IdentityProvider.fromPartial(e)) || [];
-    message.disallowUserRegistration = object.disallowUserRegistration ?? false;
-    message.disallowPasswordAuth = object.disallowPasswordAuth ?? false;
-    return message;
-  },
-};
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
 
-function createBaseIdentityProvider(): IdentityProvider {
-  return { id: "", title: "", type: IdentityProvider_Type.TYPE_UNSPECIFIED, config: undefined };
-}
+          message.accessTokenInactivityDays = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
 
-export const IdentityProvider: MessageFns<IdentityProvider> = {
-  encode(message: IdentityProvider, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== "") {
-      writer.uint32(10).string(message.id);
-    }
-    if (message.title !== "") {
-      writer.uint32(18).string(message.title);
-    }
-    if (message.type !== IdentityProvider_Type.TYPE_UNSPECIFIED) {
-      writer.uint32(24).int32(identityProvider_TypeToNumber(message.type));
-    }
-    if (message.config !== undefined) {
-      IdentityProviderConfig.encode(message.config, writer.uint32(34).fork()).join();
-    }
-    return writer;
-  },
+          message.anomalyAlert = AnomalyAlertSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
 
-  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProvider {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIdentityProvider();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 10) {
+          message.gitSync = GitSyncSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
             break;
           }
 
-          message.id = reader.string();
+          message.notFound = NotFoundSetting.decode(reader, reader.uint32());
           continue;
         }
-        case 2: {
-          if (tag !== 18) {
+        case 12: {
+          if (tag !== 98) {
             break;
           }
 
-          message.title = reader.string();
+          message.collectionTemplates.push(CollectionTemplate.decode(reader, reader.uint32()));
           continue;
         }
-        case 3: {
-          if (tag !== 24) {
+        case 13: {
+          if (tag !== 106) {
             break;
           }
 
-          message.type = identityProvider_TypeFromJSON(reader.int32());
+          message.federationSources.push(FederationSource.decode(reader, reader.uint32()));
           continue;
         }
-        case 4: {
-          if (tag !== 34) {
+        case 14: {
+          if (tag !== 114) {
             break;
           }
 
-          message.config = IdentityProviderConfig.decode(reader, reader.uint32());
+          message.linkParamRules = LinkParamRules.decode(reader, reader.uint32());
           continue;
         }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
+        case 15: {
+          if (tag !== 120) {
+            break;
+          }
 
-  create(base?: DeepPartial<IdentityProvider>): IdentityProvider {
-    return IdentityProvider.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<IdentityProvider>): IdentityProvider {
-    const message = createBaseIdentityProvider();
-    message.id = object.id ?? "";
-    message.title = object.title ?? "";
-    message.type = object.type ?? IdentityProvider_Type.TYPE_UNSPECIFIED;
-    message.config = (object.config !== undefined && object.config !== null)
-      ? IdentityProviderConfig.fromPartial(object.config)
-      : undefined;
-    return message;
-  },
-};
+          message.viewDedupeWindowSeconds = reader.int32();
+          continue;
+        }
+        case 16: {
+          if (tag !== 130) {
+            break;
+          }
 
-function createBaseIdentityProviderConfig(): IdentityProviderConfig {
-  return { oauth2: undefined };
+          message.smtp = SmtpConfig.decode(reader, reader.uint32());
+          continue;
+        }
+        case 17: {
+          if (tag !== 136) {
+            break;
+          }
+
+          message.requireEmailVerification = reader.bool();
+          continue;
+        }
+        case 18: {
+          if (tag !== 144) {
+            break;
+          }
+
+          message.attributeViewsToUsers = reader.bool();
+          continue;
+        }
+        case 19: {
+          if (tag !== 152) {
+            break;
+          }
+
+          message.accountHandoverUserId = reader.int32();
+          continue;
+        }
+        case 20: {
+          if (tag !== 162) {
+            break;
+          }
+
+          message.landing = LandingSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 21: {
+          if (tag !== 168) {
+            break;
+          }
+
+          message.defaultRedirectCode = reader.int32();
+          continue;
+        }
+        case 22: {
+          if (tag !== 178) {
+            break;
+          }
+
+          message.internalDomains.push(reader.string());
+          continue;
+        }
+        case 23: {
+          if (tag !== 184) {
+            break;
+          }
+
+          message.confirmExternalRedirects = reader.bool();
+          continue;
+        }
+        case 24: {
+          if (tag !== 194) {
+            break;
+          }
+
+          message.linkHealthCheck = LinkHealthCheckSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 25: {
+          if (tag !== 202) {
+            break;
+          }
+
+          message.completion = CompletionSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 26: {
+          if (tag !== 208) {
+            break;
+          }
+
+          message.completionEnabled = reader.bool();
+          continue;
+        }
+        case 27: {
+          if (tag !== 216) {
+            break;
+          }
+
+          message.semanticSearchEnabled = reader.bool();
+          continue;
+        }
+        case 28: {
+          if (tag !== 226) {
+            break;
+          }
+
+          message.reservedShortcutNames.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting>): WorkspaceSetting {
+    return WorkspaceSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting>): WorkspaceSetting {
+    const message = createBaseWorkspaceSetting();
+    message.instanceUrl = object.instanceUrl ?? "";
+    message.branding = object.branding ?? new Uint8Array(0);
+    message.customStyle = object.customStyle ?? "";
+    message.defaultVisibility = object.defaultVisibility ?? Visibility.VISIBILITY_UNSPECIFIED;
+    message.identityProviders = object.identityProviders?.map((e) => IdentityProvider.fromPartial(e)) || [];
+    message.disallowUserRegistration = object.disallowUserRegistration ?? false;
+    message.disallowPasswordAuth = object.disallowPasswordAuth ?? false;
+    message.accessTokenInactivityDays = object.accessTokenInactivityDays ?? 0;
+    message.anomalyAlert = (object.anomalyAlert !== undefined && object.anomalyAlert !== null)
+      ? AnomalyAlertSetting.fromPartial(object.anomalyAlert)
+      : undefined;
+    message.gitSync = (object.gitSync !== undefined && object.gitSync !== null)
+      ? GitSyncSetting.fromPartial(object.gitSync)
+      : undefined;
+    message.notFound = (object.notFound !== undefined && object.notFound !== null)
+      ? NotFoundSetting.fromPartial(object.notFound)
+      : undefined;
+    message.collectionTemplates = object.collectionTemplates?.map((e) => CollectionTemplate.fromPartial(e)) || [];
+    message.federationSources = object.federationSources?.map((e) => FederationSource.fromPartial(e)) || [];
+    message.linkParamRules = (object.linkParamRules !== undefined && object.linkParamRules !== null)
+      ? LinkParamRules.fromPartial(object.linkParamRules)
+      : undefined;
+    message.viewDedupeWindowSeconds = object.viewDedupeWindowSeconds ?? 0;
+    message.smtp = (object.smtp !== undefined && object.smtp !== null)
+      ? SmtpConfig.fromPartial(object.smtp)
+      : undefined;
+    message.requireEmailVerification = object.requireEmailVerification ?? false;
+    message.attributeViewsToUsers = object.attributeViewsToUsers ?? false;
+    message.accountHandoverUserId = object.accountHandoverUserId ?? 0;
+    message.landing = (object.landing !== undefined && object.landing !== null)
+      ? LandingSetting.fromPartial(object.landing)
+      : undefined;
+    message.defaultRedirectCode = object.defaultRedirectCode ?? 0;
+    message.internalDomains = object.internalDomains?.map((e) => e) || [];
+    message.confirmExternalRedirects = object.confirmExternalRedirects ?? false;
+    message.linkHealthCheck = (object.linkHealthCheck !== undefined && object.linkHealthCheck !== null)
+      ? LinkHealthCheckSetting.fromPartial(object.linkHealthCheck)
+      : undefined;
+    message.completion = (object.completion !== undefined && object.completion !== null)
+      ? CompletionSetting.fromPartial(object.completion)
+      : undefined;
+    message.completionEnabled = object.completionEnabled ?? false;
+    message.semanticSearchEnabled = object.semanticSearchEnabled ?? false;
+    message.reservedShortcutNames = object.reservedShortcutNames?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseCompletionSetting(): CompletionSetting {
+  return { endpoint: "", apiKey: "", model: "", embeddingModel: "" };
 }
 
-export const IdentityProviderConfig: MessageFns<IdentityProviderConfig> = {
-  encode(message: IdentityProviderConfig, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.oauth2 !== undefined) {
-      IdentityProviderConfig_OAuth2Config.encode(message.oauth2, writer.uint32(10).fork()).join();
+export const CompletionSetting: MessageFns<CompletionSetting> = {
+  encode(message: CompletionSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.endpoint !== "") {
+      writer.uint32(10).string(message.endpoint);
+    }
+    if (message.apiKey !== "") {
+      writer.uint32(18).string(message.apiKey);
+    }
+    if (message.model !== "") {
+      writer.uint32(26).string(message.model);
+    }
+    if (message.embeddingModel !== "") {
+      writer.uint32(34).string(message.embeddingModel);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig {
+  decode(input: BinaryReader | Uint8Array, length?: number): CompletionSetting {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIdentityProviderConfig();
+    const message = createBaseCompletionSetting();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -466,7 +1129,31 @@ export const IdentityProviderConfig: MessageFns<IdentityProviderConfig> = {
             break;
           }
 
-          message.oauth2 = IdentityProviderConfig_OAuth2Config.decode(reader, reader.uint32());
+          message.endpoint = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.apiKey = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.model = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.embeddingModel = reader.string();
           continue;
         }
       }
@@ -478,37 +1165,38 @@ export const IdentityProviderConfig: MessageFns<IdentityProviderConfig> = {
     return message;
   },
 
-  create(base?: DeepPartial<IdentityProviderConfig>): IdentityProviderConfig {
-    return IdentityProviderConfig.fromPartial(base ?? {});
+  create(base?: DeepPartial<CompletionSetting>): CompletionSetting {
+    return CompletionSetting.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IdentityProviderConfig>): IdentityProviderConfig {
-    const message = createBaseIdentityProviderConfig();
-    message.oauth2 = (object.oauth2 !== undefined && object.oauth2 !== null)
-      ? IdentityProviderConfig_OAuth2Config.fromPartial(object.oauth2)
-      : undefined;
+  fromPartial(object: DeepPartial<CompletionSetting>): CompletionSetting {
+    const message = createBaseCompletionSetting();
+    message.endpoint = object.endpoint ?? "";
+    message.apiKey = object.apiKey ?? "";
+    message.model = object.model ?? "";
+    message.embeddingModel = object.embeddingModel ?? "";
     return message;
   },
 };
 
-function createBaseIdentityProviderConfig_FieldMapping(): IdentityProviderConfig_FieldMapping {
-  return { identifier: "", displayName: "" };
+function createBaseLinkParamRules(): LinkParamRules {
+  return { deny: [], allow: [] };
 }
 
-export const IdentityProviderConfig_FieldMapping: MessageFns<IdentityProviderConfig_FieldMapping> = {
-  encode(message: IdentityProviderConfig_FieldMapping, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.identifier !== "") {
-      writer.uint32(10).string(message.identifier);
+export const LinkParamRules: MessageFns<LinkParamRules> = {
+  encode(message: LinkParamRules, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.deny) {
+      writer.uint32(10).string(v!);
     }
-    if (message.displayName !== "") {
-      writer.uint32(18).string(message.displayName);
+    for (const v of message.allow) {
+      writer.uint32(18).string(v!);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig_FieldMapping {
+  decode(input: BinaryReader | Uint8Array, length?: number): LinkParamRules {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIdentityProviderConfig_FieldMapping();
+    const message = createBaseLinkParamRules();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -517,7 +1205,7 @@ export const IdentityProviderConfig_FieldMapping: MessageFns<IdentityProviderCon
             break;
           }
 
-          message.identifier = reader.string();
+          message.deny.push(reader.string());
           continue;
         }
         case 2: {
@@ -525,7 +1213,7 @@ export const IdentityProviderConfig_FieldMapping: MessageFns<IdentityProviderCon
             break;
           }
 
-          message.displayName = reader.string();
+          message.allow.push(reader.string());
           continue;
         }
       }
@@ -537,68 +1225,60 @@ export const IdentityProviderConfig_FieldMapping: MessageFns<IdentityProviderCon
     return message;
   },
 
-  create(base?: DeepPartial<IdentityProviderConfig_FieldMapping>): IdentityProviderConfig_FieldMapping {
-    return IdentityProviderConfig_FieldMapping.fromPartial(base ?? {});
+  create(base?: DeepPartial<LinkParamRules>): LinkParamRules {
+    return LinkParamRules.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IdentityProviderConfig_FieldMapping>): IdentityProviderConfig_FieldMapping {
-    const message = createBaseIdentityProviderConfig_FieldMapping();
-    message.identifier = object.identifier ?? "";
-    message.displayName = object.displayName ?? "";
+  fromPartial(object: DeepPartial<LinkParamRules>): LinkParamRules {
+    const message = createBaseLinkParamRules();
+    message.deny = object.deny?.map((e) => e) || [];
+    message.allow = object.allow?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseIdentityProviderConfig_OAuth2Config(): IdentityProviderConfig_OAuth2Config {
+function createBaseLandingSetting(): LandingSetting {
   return {
-    clientId: "",
-    clientSecret: "",
-    authUrl: "",
-    tokenUrl: "",
-    userInfoUrl: "",
-    scopes: [],
-    fieldMapping: undefined,
+    type: LandingSetting_Type.TYPE_UNSPECIFIED,
+    collectionName: "",
+    pageHtml: "",
+    redirectUrl: "",
+    applyToSignedInUsers: false,
   };
 }
 
-export const IdentityProviderConfig_OAuth2Config: MessageFns<IdentityProviderConfig_OAuth2Config> = {
-  encode(message: IdentityProviderConfig_OAuth2Config, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.clientId !== "") {
-      writer.uint32(10).string(message.clientId);
-    }
-    if (message.clientSecret !== "") {
-      writer.uint32(18).string(message.clientSecret);
-    }
-    if (message.authUrl !== "") {
-      writer.uint32(26).string(message.authUrl);
+export const LandingSetting: MessageFns<LandingSetting> = {
+  encode(message: LandingSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.type !== LandingSetting_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(landingSetting_TypeToNumber(message.type));
     }
-    if (message.tokenUrl !== "") {
-      writer.uint32(34).string(message.tokenUrl);
+    if (message.collectionName !== "") {
+      writer.uint32(18).string(message.collectionName);
     }
-    if (message.userInfoUrl !== "") {
-      writer.uint32(42).string(message.userInfoUrl);
+    if (message.pageHtml !== "") {
+      writer.uint32(26).string(message.pageHtml);
     }
-    for (const v of message.scopes) {
-      writer.uint32(50).string(v!);
+    if (message.redirectUrl !== "") {
+      writer.uint32(34).string(message.redirectUrl);
     }
-    if (message.fieldMapping !== undefined) {
-      IdentityProviderConfig_FieldMapping.encode(message.fieldMapping, writer.uint32(58).fork()).join();
+    if (message.applyToSignedInUsers !== false) {
+      writer.uint32(40).bool(message.applyToSignedInUsers);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig_OAuth2Config {
+  decode(input: BinaryReader | Uint8Array, length?: number): LandingSetting {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIdentityProviderConfig_OAuth2Config();
+    const message = createBaseLandingSetting();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.clientId = reader.string();
+          message.type = landingSetting_TypeFromJSON(reader.int32());
           continue;
         }
         case 2: {
@@ -606,7 +1286,7 @@ export const IdentityProviderConfig_OAuth2Config: MessageFns<IdentityProviderCon
             break;
           }
 
-          message.clientSecret = reader.string();
+          message.collectionName = reader.string();
           continue;
         }
         case 3: {
@@ -614,7 +1294,7 @@ export const IdentityProviderConfig_OAuth2Config: MessageFns<IdentityProviderCon
             break;
           }
 
-          message.authUrl = reader.string();
+          message.pageHtml = reader.string();
           continue;
         }
         case 4: {
@@ -622,31 +1302,1707 @@ export const IdentityProviderConfig_OAuth2Config: MessageFns<IdentityProviderCon
             break;
           }
 
-          message.tokenUrl = reader.string();
+          message.redirectUrl = reader.string();
           continue;
         }
         case 5: {
-          if (tag !== 42) {
+          if (tag !== 40) {
             break;
           }
 
-          message.userInfoUrl = reader.string();
+          message.applyToSignedInUsers = reader.bool();
           continue;
         }
-        case 6: {
-          if (tag !== 50) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<LandingSetting>): LandingSetting {
+    return LandingSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LandingSetting>): LandingSetting {
+    const message = createBaseLandingSetting();
+    message.type = object.type ?? LandingSetting_Type.TYPE_UNSPECIFIED;
+    message.collectionName = object.collectionName ?? "";
+    message.pageHtml = object.pageHtml ?? "";
+    message.redirectUrl = object.redirectUrl ?? "";
+    message.applyToSignedInUsers = object.applyToSignedInUsers ?? false;
+    return message;
+  },
+};
+
+function createBaseNotFoundSetting(): NotFoundSetting {
+  return { redirectUrl: "", message: "", disableCreateOffer: false };
+}
+
+export const NotFoundSetting: MessageFns<NotFoundSetting> = {
+  encode(message: NotFoundSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.redirectUrl !== "") {
+      writer.uint32(10).string(message.redirectUrl);
+    }
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    if (message.disableCreateOffer !== false) {
+      writer.uint32(24).bool(message.disableCreateOffer);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): NotFoundSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNotFoundSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
             break;
           }
 
-          message.scopes.push(reader.string());
+          message.redirectUrl = reader.string();
           continue;
         }
-        case 7: {
-          if (tag !== 58) {
+        case 2: {
+          if (tag !== 18) {
             break;
           }
 
-          message.fieldMapping = IdentityProviderConfig_FieldMapping.decode(reader, reader.uint32());
+          message.message = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.disableCreateOffer = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<NotFoundSetting>): NotFoundSetting {
+    return NotFoundSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<NotFoundSetting>): NotFoundSetting {
+    const message = createBaseNotFoundSetting();
+    message.redirectUrl = object.redirectUrl ?? "";
+    message.message = object.message ?? "";
+    message.disableCreateOffer = object.disableCreateOffer ?? false;
+    return message;
+  },
+};
+
+function createBaseGitSyncSetting(): GitSyncSetting {
+  return {
+    enabled: false,
+    repository: "",
+    branch: "",
+    path: "",
+    accessToken: "",
+    webhookSecret: "",
+    writeBack: false,
+    lastSyncedSha: "",
+  };
+}
+
+export const GitSyncSetting: MessageFns<GitSyncSetting> = {
+  encode(message: GitSyncSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.enabled !== false) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.repository !== "") {
+      writer.uint32(18).string(message.repository);
+    }
+    if (message.branch !== "") {
+      writer.uint32(26).string(message.branch);
+    }
+    if (message.path !== "") {
+      writer.uint32(34).string(message.path);
+    }
+    if (message.accessToken !== "") {
+      writer.uint32(42).string(message.accessToken);
+    }
+    if (message.webhookSecret !== "") {
+      writer.uint32(50).string(message.webhookSecret);
+    }
+    if (message.writeBack !== false) {
+      writer.uint32(56).bool(message.writeBack);
+    }
+    if (message.lastSyncedSha !== "") {
+      writer.uint32(66).string(message.lastSyncedSha);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GitSyncSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGitSyncSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.repository = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.branch = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.path = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.accessToken = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.webhookSecret = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.writeBack = reader.bool();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.lastSyncedSha = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GitSyncSetting>): GitSyncSetting {
+    return GitSyncSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GitSyncSetting>): GitSyncSetting {
+    const message = createBaseGitSyncSetting();
+    message.enabled = object.enabled ?? false;
+    message.repository = object.repository ?? "";
+    message.branch = object.branch ?? "";
+    message.path = object.path ?? "";
+    message.accessToken = object.accessToken ?? "";
+    message.webhookSecret = object.webhookSecret ?? "";
+    message.writeBack = object.writeBack ?? false;
+    message.lastSyncedSha = object.lastSyncedSha ?? "";
+    return message;
+  },
+};
+
+function createBaseFederationSource(): FederationSource {
+  return {
+    id: "",
+    enabled: false,
+    url: "",
+    accessToken: "",
+    prefix: "",
+    intervalMinutes: 0,
+    lastSyncTime: undefined,
+    lastError: "",
+    shortcutCount: 0,
+    collectionCount: 0,
+  };
+}
+
+export const FederationSource: MessageFns<FederationSource> = {
+  encode(message: FederationSource, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.enabled !== false) {
+      writer.uint32(16).bool(message.enabled);
+    }
+    if (message.url !== "") {
+      writer.uint32(26).string(message.url);
+    }
+    if (message.accessToken !== "") {
+      writer.uint32(34).string(message.accessToken);
+    }
+    if (message.prefix !== "") {
+      writer.uint32(42).string(message.prefix);
+    }
+    if (message.intervalMinutes !== 0) {
+      writer.uint32(48).int32(message.intervalMinutes);
+    }
+    if (message.lastSyncTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastSyncTime), writer.uint32(58).fork()).join();
+    }
+    if (message.lastError !== "") {
+      writer.uint32(66).string(message.lastError);
+    }
+    if (message.shortcutCount !== 0) {
+      writer.uint32(72).int32(message.shortcutCount);
+    }
+    if (message.collectionCount !== 0) {
+      writer.uint32(80).int32(message.collectionCount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): FederationSource {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFederationSource();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.accessToken = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.prefix = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.intervalMinutes = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.lastSyncTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.lastError = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.shortcutCount = reader.int32();
+          continue;
+        }
+        case 10: {
+          if (tag !== 80) {
+            break;
+          }
+
+          message.collectionCount = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<FederationSource>): FederationSource {
+    return FederationSource.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<FederationSource>): FederationSource {
+    const message = createBaseFederationSource();
+    message.id = object.id ?? "";
+    message.enabled = object.enabled ?? false;
+    message.url = object.url ?? "";
+    message.accessToken = object.accessToken ?? "";
+    message.prefix = object.prefix ?? "";
+    message.intervalMinutes = object.intervalMinutes ?? 0;
+    message.lastSyncTime = object.lastSyncTime ?? undefined;
+    message.lastError = object.lastError ?? "";
+    message.shortcutCount = object.shortcutCount ?? 0;
+    message.collectionCount = object.collectionCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseAnomalyAlertSetting(): AnomalyAlertSetting {
+  return { enabled: false, webhookUrl: "", zScoreThreshold: 0, minViews: 0 };
+}
+
+export const AnomalyAlertSetting: MessageFns<AnomalyAlertSetting> = {
+  encode(message: AnomalyAlertSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.enabled !== false) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.webhookUrl !== "") {
+      writer.uint32(18).string(message.webhookUrl);
+    }
+    if (message.zScoreThreshold !== 0) {
+      writer.uint32(25).double(message.zScoreThreshold);
+    }
+    if (message.minViews !== 0) {
+      writer.uint32(32).int32(message.minViews);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AnomalyAlertSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomalyAlertSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.webhookUrl = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.zScoreThreshold = reader.double();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.minViews = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<AnomalyAlertSetting>): AnomalyAlertSetting {
+    return AnomalyAlertSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AnomalyAlertSetting>): AnomalyAlertSetting {
+    const message = createBaseAnomalyAlertSetting();
+    message.enabled = object.enabled ?? false;
+    message.webhookUrl = object.webhookUrl ?? "";
+    message.zScoreThreshold = object.zScoreThreshold ?? 0;
+    message.minViews = object.minViews ?? 0;
+    return message;
+  },
+};
+
+function createBaseLinkHealthCheckSetting(): LinkHealthCheckSetting {
+  return { enabled: false, webhookUrl: "", notifyCreators: false };
+}
+
+export const LinkHealthCheckSetting: MessageFns<LinkHealthCheckSetting> = {
+  encode(message: LinkHealthCheckSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.enabled !== false) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.webhookUrl !== "") {
+      writer.uint32(18).string(message.webhookUrl);
+    }
+    if (message.notifyCreators !== false) {
+      writer.uint32(24).bool(message.notifyCreators);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): LinkHealthCheckSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLinkHealthCheckSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.webhookUrl = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.notifyCreators = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<LinkHealthCheckSetting>): LinkHealthCheckSetting {
+    return LinkHealthCheckSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LinkHealthCheckSetting>): LinkHealthCheckSetting {
+    const message = createBaseLinkHealthCheckSetting();
+    message.enabled = object.enabled ?? false;
+    message.webhookUrl = object.webhookUrl ?? "";
+    message.notifyCreators = object.notifyCreators ?? false;
+    return message;
+  },
+};
+
+function createBaseIdentityProvider(): IdentityProvider {
+  return {
+    id: "",
+    title: "",
+    type: IdentityProvider_Type.TYPE_UNSPECIFIED,
+    config: undefined,
+    displayOrder: 0,
+    iconUrl: "",
+    autoRedirect: false,
+  };
+}
+
+export const IdentityProvider: MessageFns<IdentityProvider> = {
+  encode(message: IdentityProvider, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.type !== IdentityProvider_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(24).int32(identityProvider_TypeToNumber(message.type));
+    }
+    if (message.config !== undefined) {
+      IdentityProviderConfig.encode(message.config, writer.uint32(34).fork()).join();
+    }
+    if (message.displayOrder !== 0) {
+      writer.uint32(40).int32(message.displayOrder);
+    }
+    if (message.iconUrl !== "") {
+      writer.uint32(50).string(message.iconUrl);
+    }
+    if (message.autoRedirect !== false) {
+      writer.uint32(56).bool(message.autoRedirect);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProvider {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProvider();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.type = identityProvider_TypeFromJSON(reader.int32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.config = IdentityProviderConfig.decode(reader, reader.uint32());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.displayOrder = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.iconUrl = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.autoRedirect = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<IdentityProvider>): IdentityProvider {
+    return IdentityProvider.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IdentityProvider>): IdentityProvider {
+    const message = createBaseIdentityProvider();
+    message.id = object.id ?? "";
+    message.title = object.title ?? "";
+    message.type = object.type ?? IdentityProvider_Type.TYPE_UNSPECIFIED;
+    message.config = (object.config !== undefined && object.config !== null)
+      ? IdentityProviderConfig.fromPartial(object.config)
+      : undefined;
+    message.displayOrder = object.displayOrder ?? 0;
+    message.iconUrl = object.iconUrl ?? "";
+    message.autoRedirect = object.autoRedirect ?? false;
+    return message;
+  },
+};
+
+function createBaseIdentityProviderConfig(): IdentityProviderConfig {
+  return { oauth2: undefined, saml: undefined, ldap: undefined };
+}
+
+export const IdentityProviderConfig: MessageFns<IdentityProviderConfig> = {
+  encode(message: IdentityProviderConfig, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.oauth2 !== undefined) {
+      IdentityProviderConfig_OAuth2Config.encode(message.oauth2, writer.uint32(10).fork()).join();
+    }
+    if (message.saml !== undefined) {
+      IdentityProviderConfig_SAMLConfig.encode(message.saml, writer.uint32(18).fork()).join();
+    }
+    if (message.ldap !== undefined) {
+      IdentityProviderConfig_LDAPConfig.encode(message.ldap, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProviderConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.oauth2 = IdentityProviderConfig_OAuth2Config.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.saml = IdentityProviderConfig_SAMLConfig.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ldap = IdentityProviderConfig_LDAPConfig.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<IdentityProviderConfig>): IdentityProviderConfig {
+    return IdentityProviderConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IdentityProviderConfig>): IdentityProviderConfig {
+    const message = createBaseIdentityProviderConfig();
+    message.oauth2 = (object.oauth2 !== undefined && object.oauth2 !== null)
+      ? IdentityProviderConfig_OAuth2Config.fromPartial(object.oauth2)
+      : undefined;
+    message.saml = (object.saml !== undefined && object.saml !== null)
+      ? IdentityProviderConfig_SAMLConfig.fromPartial(object.saml)
+      : undefined;
+    message.ldap = (object.ldap !== undefined && object.ldap !== null)
+      ? IdentityProviderConfig_LDAPConfig.fromPartial(object.ldap)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseIdentityProviderConfig_FieldMapping(): IdentityProviderConfig_FieldMapping {
+  return { identifier: "", displayName: "", groups: "", adminGroup: "" };
+}
+
+export const IdentityProviderConfig_FieldMapping: MessageFns<IdentityProviderConfig_FieldMapping> = {
+  encode(message: IdentityProviderConfig_FieldMapping, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.identifier !== "") {
+      writer.uint32(10).string(message.identifier);
+    }
+    if (message.displayName !== "") {
+      writer.uint32(18).string(message.displayName);
+    }
+    if (message.groups !== "") {
+      writer.uint32(26).string(message.groups);
+    }
+    if (message.adminGroup !== "") {
+      writer.uint32(34).string(message.adminGroup);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig_FieldMapping {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProviderConfig_FieldMapping();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.identifier = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.displayName = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.groups = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.adminGroup = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<IdentityProviderConfig_FieldMapping>): IdentityProviderConfig_FieldMapping {
+    return IdentityProviderConfig_FieldMapping.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IdentityProviderConfig_FieldMapping>): IdentityProviderConfig_FieldMapping {
+    const message = createBaseIdentityProviderConfig_FieldMapping();
+    message.identifier = object.identifier ?? "";
+    message.displayName = object.displayName ?? "";
+    message.groups = object.groups ?? "";
+    message.adminGroup = object.adminGroup ?? "";
+    return message;
+  },
+};
+
+function createBaseIdentityProviderConfig_OAuth2Config(): IdentityProviderConfig_OAuth2Config {
+  return {
+    clientId: "",
+    clientSecret: "",
+    authUrl: "",
+    tokenUrl: "",
+    userInfoUrl: "",
+    scopes: [],
+    fieldMapping: undefined,
+    issuerUrl: "",
+  };
+}
+
+export const IdentityProviderConfig_OAuth2Config: MessageFns<IdentityProviderConfig_OAuth2Config> = {
+  encode(message: IdentityProviderConfig_OAuth2Config, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.clientId !== "") {
+      writer.uint32(10).string(message.clientId);
+    }
+    if (message.clientSecret !== "") {
+      writer.uint32(18).string(message.clientSecret);
+    }
+    if (message.authUrl !== "") {
+      writer.uint32(26).string(message.authUrl);
+    }
+    if (message.tokenUrl !== "") {
+      writer.uint32(34).string(message.tokenUrl);
+    }
+    if (message.userInfoUrl !== "") {
+      writer.uint32(42).string(message.userInfoUrl);
+    }
+    for (const v of message.scopes) {
+      writer.uint32(50).string(v!);
+    }
+    if (message.fieldMapping !== undefined) {
+      IdentityProviderConfig_FieldMapping.encode(message.fieldMapping, writer.uint32(58).fork()).join();
+    }
+    if (message.issuerUrl !== "") {
+      writer.uint32(66).string(message.issuerUrl);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig_OAuth2Config {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProviderConfig_OAuth2Config();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.clientId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.clientSecret = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.authUrl = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.tokenUrl = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.userInfoUrl = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.scopes.push(reader.string());
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.fieldMapping = IdentityProviderConfig_FieldMapping.decode(reader, reader.uint32());
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.issuerUrl = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<IdentityProviderConfig_OAuth2Config>): IdentityProviderConfig_OAuth2Config {
+    return IdentityProviderConfig_OAuth2Config.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IdentityProviderConfig_OAuth2Config>): IdentityProviderConfig_OAuth2Config {
+    const message = createBaseIdentityProviderConfig_OAuth2Config();
+    message.clientId = object.clientId ?? "";
+    message.clientSecret = object.clientSecret ?? "";
+    message.authUrl = object.authUrl ?? "";
+    message.tokenUrl = object.tokenUrl ?? "";
+    message.userInfoUrl = object.userInfoUrl ?? "";
+    message.scopes = object.scopes?.map((e) => e) || [];
+    message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
+      ? IdentityProviderConfig_FieldMapping.fromPartial(object.fieldMapping)
+      : undefined;
+    message.issuerUrl = object.issuerUrl ?? "";
+    return message;
+  },
+};
+
+function createBaseIdentityProviderConfig_SAMLConfig(): IdentityProviderConfig_SAMLConfig {
+  return { entityId: "", ssoUrl: "", certificate: "", fieldMapping: undefined };
+}
+
+export const IdentityProviderConfig_SAMLConfig: MessageFns<IdentityProviderConfig_SAMLConfig> = {
+  encode(message: IdentityProviderConfig_SAMLConfig, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.entityId !== "") {
+      writer.uint32(10).string(message.entityId);
+    }
+    if (message.ssoUrl !== "") {
+      writer.uint32(18).string(message.ssoUrl);
+    }
+    if (message.certificate !== "") {
+      writer.uint32(26).string(message.certificate);
+    }
+    if (message.fieldMapping !== undefined) {
+      IdentityProviderConfig_FieldMapping.encode(message.fieldMapping, writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig_SAMLConfig {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProviderConfig_SAMLConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.entityId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ssoUrl = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.certificate = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.fieldMapping = IdentityProviderConfig_FieldMapping.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<IdentityProviderConfig_SAMLConfig>): IdentityProviderConfig_SAMLConfig {
+    return IdentityProviderConfig_SAMLConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IdentityProviderConfig_SAMLConfig>): IdentityProviderConfig_SAMLConfig {
+    const message = createBaseIdentityProviderConfig_SAMLConfig();
+    message.entityId = object.entityId ?? "";
+    message.ssoUrl = object.ssoUrl ?? "";
+    message.certificate = object.certificate ?? "";
+    message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
+      ? IdentityProviderConfig_FieldMapping.fromPartial(object.fieldMapping)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseIdentityProviderConfig_LDAPConfig(): IdentityProviderConfig_LDAPConfig {
+  return {
+    url: "",
+    startTls: false,
+    bindDn: "",
+    bindPassword: "",
+    searchBase: "",
+    userFilter: "",
+    fieldMapping: undefined,
+  };
+}
+
+export const IdentityProviderConfig_LDAPConfig: MessageFns<IdentityProviderConfig_LDAPConfig> = {
+  encode(message: IdentityProviderConfig_LDAPConfig, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.url !== "") {
+      writer.uint32(10).string(message.url);
+    }
+    if (message.startTls !== false) {
+      writer.uint32(16).bool(message.startTls);
+    }
+    if (message.bindDn !== "") {
+      writer.uint32(26).string(message.bindDn);
+    }
+    if (message.bindPassword !== "") {
+      writer.uint32(34).string(message.bindPassword);
+    }
+    if (message.searchBase !== "") {
+      writer.uint32(42).string(message.searchBase);
+    }
+    if (message.userFilter !== "") {
+      writer.uint32(50).string(message.userFilter);
+    }
+    if (message.fieldMapping !== undefined) {
+      IdentityProviderConfig_FieldMapping.encode(message.fieldMapping, writer.uint32(58).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig_LDAPConfig {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProviderConfig_LDAPConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.startTls = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.bindDn = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.bindPassword = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.searchBase = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.userFilter = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.fieldMapping = IdentityProviderConfig_FieldMapping.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<IdentityProviderConfig_LDAPConfig>): IdentityProviderConfig_LDAPConfig {
+    return IdentityProviderConfig_LDAPConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IdentityProviderConfig_LDAPConfig>): IdentityProviderConfig_LDAPConfig {
+    const message = createBaseIdentityProviderConfig_LDAPConfig();
+    message.url = object.url ?? "";
+    message.startTls = object.startTls ?? false;
+    message.bindDn = object.bindDn ?? "";
+    message.bindPassword = object.bindPassword ?? "";
+    message.searchBase = object.searchBase ?? "";
+    message.userFilter = object.userFilter ?? "";
+    message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
+      ? IdentityProviderConfig_FieldMapping.fromPartial(object.fieldMapping)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseGetWorkspaceProfileRequest(): GetWorkspaceProfileRequest {
+  return {};
+}
+
+export const GetWorkspaceProfileRequest: MessageFns<GetWorkspaceProfileRequest> = {
+  encode(_: GetWorkspaceProfileRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetWorkspaceProfileRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetWorkspaceProfileRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetWorkspaceProfileRequest>): GetWorkspaceProfileRequest {
+    return GetWorkspaceProfileRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<GetWorkspaceProfileRequest>): GetWorkspaceProfileRequest {
+    const message = createBaseGetWorkspaceProfileRequest();
+    return message;
+  },
+};
+
+function createBaseGetWorkspaceSettingRequest(): GetWorkspaceSettingRequest {
+  return {};
+}
+
+export const GetWorkspaceSettingRequest: MessageFns<GetWorkspaceSettingRequest> = {
+  encode(_: GetWorkspaceSettingRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetWorkspaceSettingRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetWorkspaceSettingRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetWorkspaceSettingRequest>): GetWorkspaceSettingRequest {
+    return GetWorkspaceSettingRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<GetWorkspaceSettingRequest>): GetWorkspaceSettingRequest {
+    const message = createBaseGetWorkspaceSettingRequest();
+    return message;
+  },
+};
+
+function createBaseUpdateWorkspaceSettingRequest(): UpdateWorkspaceSettingRequest {
+  return { setting: undefined, updateMask: undefined };
+}
+
+export const UpdateWorkspaceSettingRequest: MessageFns<UpdateWorkspaceSettingRequest> = {
+  encode(message: UpdateWorkspaceSettingRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.setting !== undefined) {
+      WorkspaceSetting.encode(message.setting, writer.uint32(10).fork()).join();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UpdateWorkspaceSettingRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateWorkspaceSettingRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.setting = WorkspaceSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UpdateWorkspaceSettingRequest>): UpdateWorkspaceSettingRequest {
+    return UpdateWorkspaceSettingRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateWorkspaceSettingRequest>): UpdateWorkspaceSettingRequest {
+    const message = createBaseUpdateWorkspaceSettingRequest();
+    message.setting = (object.setting !== undefined && object.setting !== null)
+      ? WorkspaceSetting.fromPartial(object.setting)
+      : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBaseSmtpConfig(): SmtpConfig {
+  return {
+    host: "",
+    port: 0,
+    username: "",
+    password: "",
+    encryption: SmtpConfig_Encryption.ENCRYPTION_UNSPECIFIED,
+    from: "",
+  };
+}
+
+export const SmtpConfig: MessageFns<SmtpConfig> = {
+  encode(message: SmtpConfig, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.host !== "") {
+      writer.uint32(10).string(message.host);
+    }
+    if (message.port !== 0) {
+      writer.uint32(16).int32(message.port);
+    }
+    if (message.username !== "") {
+      writer.uint32(26).string(message.username);
+    }
+    if (message.password !== "") {
+      writer.uint32(34).string(message.password);
+    }
+    if (message.encryption !== SmtpConfig_Encryption.ENCRYPTION_UNSPECIFIED) {
+      writer.uint32(40).int32(smtpConfig_EncryptionToNumber(message.encryption));
+    }
+    if (message.from !== "") {
+      writer.uint32(50).string(message.from);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SmtpConfig {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSmtpConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.host = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.port = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.encryption = smtpConfig_EncryptionFromJSON(reader.int32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.from = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<SmtpConfig>): SmtpConfig {
+    return SmtpConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SmtpConfig>): SmtpConfig {
+    const message = createBaseSmtpConfig();
+    message.host = object.host ?? "";
+    message.port = object.port ?? 0;
+    message.username = object.username ?? "";
+    message.password = object.password ?? "";
+    message.encryption = object.encryption ?? SmtpConfig_Encryption.ENCRYPTION_UNSPECIFIED;
+    message.from = object.from ?? "";
+    return message;
+  },
+};
+
+function createBaseTestIdentityProviderRequest(): TestIdentityProviderRequest {
+  return { identityProvider: undefined };
+}
+
+export const TestIdentityProviderRequest: MessageFns<TestIdentityProviderRequest> = {
+  encode(message: TestIdentityProviderRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.identityProvider !== undefined) {
+      IdentityProvider.encode(message.identityProvider, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TestIdentityProviderRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTestIdentityProviderRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.identityProvider = IdentityProvider.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<TestIdentityProviderRequest>): TestIdentityProviderRequest {
+    return TestIdentityProviderRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TestIdentityProviderRequest>): TestIdentityProviderRequest {
+    const message = createBaseTestIdentityProviderRequest();
+    message.identityProvider = (object.identityProvider !== undefined && object.identityProvider !== null)
+      ? IdentityProvider.fromPartial(object.identityProvider)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseTestSmtpRequest(): TestSmtpRequest {
+  return { smtpConfig: undefined, recipient: "" };
+}
+
+export const TestSmtpRequest: MessageFns<TestSmtpRequest> = {
+  encode(message: TestSmtpRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.smtpConfig !== undefined) {
+      SmtpConfig.encode(message.smtpConfig, writer.uint32(10).fork()).join();
+    }
+    if (message.recipient !== "") {
+      writer.uint32(18).string(message.recipient);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TestSmtpRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTestSmtpRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.smtpConfig = SmtpConfig.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.recipient = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<TestSmtpRequest>): TestSmtpRequest {
+    return TestSmtpRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TestSmtpRequest>): TestSmtpRequest {
+    const message = createBaseTestSmtpRequest();
+    message.smtpConfig = (object.smtpConfig !== undefined && object.smtpConfig !== null)
+      ? SmtpConfig.fromPartial(object.smtpConfig)
+      : undefined;
+    message.recipient = object.recipient ?? "";
+    return message;
+  },
+};
+
+function createBaseTestConnectionResponse(): TestConnectionResponse {
+  return { ok: false, checks: [] };
+}
+
+export const TestConnectionResponse: MessageFns<TestConnectionResponse> = {
+  encode(message: TestConnectionResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.ok !== false) {
+      writer.uint32(8).bool(message.ok);
+    }
+    for (const v of message.checks) {
+      TestConnectionResponse_Check.encode(v!, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TestConnectionResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTestConnectionResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.ok = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.checks.push(TestConnectionResponse_Check.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<TestConnectionResponse>): TestConnectionResponse {
+    return TestConnectionResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TestConnectionResponse>): TestConnectionResponse {
+    const message = createBaseTestConnectionResponse();
+    message.ok = object.ok ?? false;
+    message.checks = object.checks?.map((e) => TestConnectionResponse_Check.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTestConnectionResponse_Check(): TestConnectionResponse_Check {
+  return { name: "", ok: false, message: "" };
+}
+
+export const TestConnectionResponse_Check: MessageFns<TestConnectionResponse_Check> = {
+  encode(message: TestConnectionResponse_Check, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.ok !== false) {
+      writer.uint32(16).bool(message.ok);
+    }
+    if (message.message !== "") {
+      writer.uint32(26).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TestConnectionResponse_Check {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTestConnectionResponse_Check();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.ok = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<TestConnectionResponse_Check>): TestConnectionResponse_Check {
+    return TestConnectionResponse_Check.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TestConnectionResponse_Check>): TestConnectionResponse_Check {
+    const message = createBaseTestConnectionResponse_Check();
+    message.name = object.name ?? "";
+    message.ok = object.ok ?? false;
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBaseExportWorkspaceRequest(): ExportWorkspaceRequest {
+  return { format: ExportWorkspaceRequest_Format.FORMAT_UNSPECIFIED };
+}
+
+export const ExportWorkspaceRequest: MessageFns<ExportWorkspaceRequest> = {
+  encode(message: ExportWorkspaceRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.format !== ExportWorkspaceRequest_Format.FORMAT_UNSPECIFIED) {
+      writer.uint32(8).int32(exportWorkspaceRequest_FormatToNumber(message.format));
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ExportWorkspaceRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExportWorkspaceRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.format = exportWorkspaceRequest_FormatFromJSON(reader.int32());
           continue;
         }
       }
@@ -658,40 +3014,65 @@ export const IdentityProviderConfig_OAuth2Config: MessageFns<IdentityProviderCon
     return message;
   },
 
-  create(base?: DeepPartial<IdentityProviderConfig_OAuth2Config>): IdentityProviderConfig_OAuth2Config {
-    return IdentityProviderConfig_OAuth2Config.fromPartial(base ?? {});
+  create(base?: DeepPartial<ExportWorkspaceRequest>): ExportWorkspaceRequest {
+    return ExportWorkspaceRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IdentityProviderConfig_OAuth2Config>): IdentityProviderConfig_OAuth2Config {
-    const message = createBaseIdentityProviderConfig_OAuth2Config();
-    message.clientId = object.clientId ?? "";
-    message.clientSecret = object.clientSecret ?? "";
-    message.authUrl = object.authUrl ?? "";
-    message.tokenUrl = object.tokenUrl ?? "";
-    message.userInfoUrl = object.userInfoUrl ?? "";
-    message.scopes = object.scopes?.map((e) => e) || [];
-    message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
-      ? IdentityProviderConfig_FieldMapping.fromPartial(object.fieldMapping)
-      : undefined;
+  fromPartial(object: DeepPartial<ExportWorkspaceRequest>): ExportWorkspaceRequest {
+    const message = createBaseExportWorkspaceRequest();
+    message.format = object.format ?? ExportWorkspaceRequest_Format.FORMAT_UNSPECIFIED;
     return message;
   },
 };
 
-function createBaseGetWorkspaceProfileRequest(): GetWorkspaceProfileRequest {
-  return {};
+function createBaseExportWorkspaceResponse(): ExportWorkspaceResponse {
+  return { content: new Uint8Array(0), contentType: "", filename: "" };
 }
 
-export const GetWorkspaceProfileRequest: MessageFns<GetWorkspaceProfileRequest> = {
-  encode(_: GetWorkspaceProfileRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const ExportWorkspaceResponse: MessageFns<ExportWorkspaceResponse> = {
+  encode(message: ExportWorkspaceResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.content.length !== 0) {
+      writer.uint32(10).bytes(message.content);
+    }
+    if (message.contentType !== "") {
+      writer.uint32(18).string(message.contentType);
+    }
+    if (message.filename !== "") {
+      writer.uint32(26).string(message.filename);
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetWorkspaceProfileRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): ExportWorkspaceResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetWorkspaceProfileRequest();
+    const message = createBaseExportWorkspaceResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.content = reader.bytes();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.contentType = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.filename = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -701,28 +3082,31 @@ export const GetWorkspaceProfileRequest: MessageFns<GetWorkspaceProfileRequest>
     return message;
   },
 
-  create(base?: DeepPartial<GetWorkspaceProfileRequest>): GetWorkspaceProfileRequest {
-    return GetWorkspaceProfileRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ExportWorkspaceResponse>): ExportWorkspaceResponse {
+    return ExportWorkspaceResponse.fromPartial(base ?? {});
   },
-  fromPartial(_: DeepPartial<GetWorkspaceProfileRequest>): GetWorkspaceProfileRequest {
-    const message = createBaseGetWorkspaceProfileRequest();
+  fromPartial(object: DeepPartial<ExportWorkspaceResponse>): ExportWorkspaceResponse {
+    const message = createBaseExportWorkspaceResponse();
+    message.content = object.content ?? new Uint8Array(0);
+    message.contentType = object.contentType ?? "";
+    message.filename = object.filename ?? "";
     return message;
   },
 };
 
-function createBaseGetWorkspaceSettingRequest(): GetWorkspaceSettingRequest {
+function createBaseGetInstanceStatsRequest(): GetInstanceStatsRequest {
   return {};
 }
 
-export const GetWorkspaceSettingRequest: MessageFns<GetWorkspaceSettingRequest> = {
-  encode(_: GetWorkspaceSettingRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const GetInstanceStatsRequest: MessageFns<GetInstanceStatsRequest> = {
+  encode(_: GetInstanceStatsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetWorkspaceSettingRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): GetInstanceStatsRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetWorkspaceSettingRequest();
+    const message = createBaseGetInstanceStatsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -735,51 +3119,114 @@ export const GetWorkspaceSettingRequest: MessageFns<GetWorkspaceSettingRequest>
     return message;
   },
 
-  create(base?: DeepPartial<GetWorkspaceSettingRequest>): GetWorkspaceSettingRequest {
-    return GetWorkspaceSettingRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<GetInstanceStatsRequest>): GetInstanceStatsRequest {
+    return GetInstanceStatsRequest.fromPartial(base ?? {});
   },
-  fromPartial(_: DeepPartial<GetWorkspaceSettingRequest>): GetWorkspaceSettingRequest {
-    const message = createBaseGetWorkspaceSettingRequest();
+  fromPartial(_: DeepPartial<GetInstanceStatsRequest>): GetInstanceStatsRequest {
+    const message = createBaseGetInstanceStatsRequest();
     return message;
   },
 };
 
-function createBaseUpdateWorkspaceSettingRequest(): UpdateWorkspaceSettingRequest {
-  return { setting: undefined, updateMask: undefined };
+function createBaseInstanceStats(): InstanceStats {
+  return {
+    shortcutCount: 0,
+    collectionCount: 0,
+    userCount: 0,
+    activityCount: 0,
+    databaseSizeBytes: 0,
+    cacheHitRate: 0,
+    redirectsPerMinute: 0,
+  };
 }
 
-export const UpdateWorkspaceSettingRequest: MessageFns<UpdateWorkspaceSettingRequest> = {
-  encode(message: UpdateWorkspaceSettingRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.setting !== undefined) {
-      WorkspaceSetting.encode(message.setting, writer.uint32(10).fork()).join();
+export const InstanceStats: MessageFns<InstanceStats> = {
+  encode(message: InstanceStats, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutCount !== 0) {
+      writer.uint32(8).int64(message.shortcutCount);
     }
-    if (message.updateMask !== undefined) {
-      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).join();
+    if (message.collectionCount !== 0) {
+      writer.uint32(16).int64(message.collectionCount);
+    }
+    if (message.userCount !== 0) {
+      writer.uint32(24).int64(message.userCount);
+    }
+    if (message.activityCount !== 0) {
+      writer.uint32(32).int64(message.activityCount);
+    }
+    if (message.databaseSizeBytes !== 0) {
+      writer.uint32(40).int64(message.databaseSizeBytes);
+    }
+    if (message.cacheHitRate !== 0) {
+      writer.uint32(49).double(message.cacheHitRate);
+    }
+    if (message.redirectsPerMinute !== 0) {
+      writer.uint32(57).double(message.redirectsPerMinute);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): UpdateWorkspaceSettingRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): InstanceStats {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdateWorkspaceSettingRequest();
+    const message = createBaseInstanceStats();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.setting = WorkspaceSetting.decode(reader, reader.uint32());
+          message.shortcutCount = longToNumber(reader.int64());
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          message.collectionCount = longToNumber(reader.int64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.userCount = longToNumber(reader.int64());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.activityCount = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.databaseSizeBytes = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 49) {
+            break;
+          }
+
+          message.cacheHitRate = reader.double();
+          continue;
+        }
+        case 7: {
+          if (tag !== 57) {
+            break;
+          }
+
+          message.redirectsPerMinute = reader.double();
           continue;
         }
       }
@@ -791,15 +3238,18 @@ export const UpdateWorkspaceSettingRequest: MessageFns<UpdateWorkspaceSettingReq
     return message;
   },
 
-  create(base?: DeepPartial<UpdateWorkspaceSettingRequest>): UpdateWorkspaceSettingRequest {
-    return UpdateWorkspaceSettingRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<InstanceStats>): InstanceStats {
+    return InstanceStats.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<UpdateWorkspaceSettingRequest>): UpdateWorkspaceSettingRequest {
-    const message = createBaseUpdateWorkspaceSettingRequest();
-    message.setting = (object.setting !== undefined && object.setting !== null)
-      ? WorkspaceSetting.fromPartial(object.setting)
-      : undefined;
-    message.updateMask = object.updateMask ?? undefined;
+  fromPartial(object: DeepPartial<InstanceStats>): InstanceStats {
+    const message = createBaseInstanceStats();
+    message.shortcutCount = object.shortcutCount ?? 0;
+    message.collectionCount = object.collectionCount ?? 0;
+    message.userCount = object.userCount ?? 0;
+    message.activityCount = object.activityCount ?? 0;
+    message.databaseSizeBytes = object.databaseSizeBytes ?? 0;
+    message.cacheHitRate = object.cacheHitRate ?? 0;
+    message.redirectsPerMinute = object.redirectsPerMinute ?? 0;
     return message;
   },
 };
@@ -971,6 +3421,172 @@ export const WorkspaceServiceDefinition = {
         },
       },
     },
+    /** TestIdentityProvider checks the identity provider config before saving it. */
+    testIdentityProvider: {
+      name: "TestIdentityProvider",
+      requestType: TestIdentityProviderRequest,
+      requestStream: false,
+      responseType: TestConnectionResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              46,
+              58,
+              1,
+              42,
+              34,
+              41,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              119,
+              111,
+              114,
+              107,
+              115,
+              112,
+              97,
+              99,
+              101,
+              47,
+              105,
+              100,
+              101,
+              110,
+              116,
+              105,
+              116,
+              121,
+              95,
+              112,
+              114,
+              111,
+              118,
+              105,
+              100,
+              101,
+              114,
+              115,
+              47,
+              116,
+              101,
+              115,
+              116,
+            ]),
+          ],
+        },
+      },
+    },
+    /** TestSmtp checks the SMTP config by connecting to the server and sending a test message. */
+    testSmtp: {
+      name: "TestSmtp",
+      requestType: TestSmtpRequest,
+      requestStream: false,
+      responseType: TestConnectionResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              32,
+              58,
+              1,
+              42,
+              34,
+              27,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              119,
+              111,
+              114,
+              107,
+              115,
+              112,
+              97,
+              99,
+              101,
+              47,
+              115,
+              109,
+              116,
+              112,
+              47,
+              116,
+              101,
+              115,
+              116,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * ExportWorkspace exports all the shortcuts and collections with their metadata.
+     * The export is also streamed by the HTTP endpoint "/api/v1/export?format={json|csv}".
+     */
+    exportWorkspace: {
+      name: "ExportWorkspace",
+      requestType: ExportWorkspaceRequest,
+      requestStream: false,
+      responseType: ExportWorkspaceResponse,
+      responseStream: false,
+      options: {},
+    },
+    /** GetInstanceStats returns the size and the load of the instance, for capacity planning. Only for admins. */
+    getInstanceStats: {
+      name: "GetInstanceStats",
+      requestType: GetInstanceStatsRequest,
+      requestStream: false,
+      responseType: InstanceStats,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              25,
+              18,
+              23,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              119,
+              111,
+              114,
+              107,
+              115,
+              112,
+              97,
+              99,
+              101,
+              47,
+              115,
+              116,
+              97,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -982,6 +3598,29 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = Math.trunc(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function longToNumber(int64: { toString(): string }): number {
+  const num = globalThis.Number(int64.toString());
+  if (num > globalThis.Number.MAX_SAFE_INTEGER) {
+    throw new globalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  if (num < globalThis.Number.MIN_SAFE_INTEGER) {
+    throw new globalThis.Error("Value is smaller than Number.MIN_SAFE_INTEGER");
+  }
+  return num;
+}
+
 export interface MessageFns<T> {
   encode(message: T, writer?: BinaryWriter): BinaryWriter;
   decode(input: BinaryReader | Uint8Array, length?: number): T;
@@ -19,6 +19,12 @@ export interface ActivityShorcutViewPayload {
   referer: string;
   userAgent: string;
   params: { [key: string]: ActivityShorcutViewPayload_ValueList };
+  /** The ISO 3166-1 alpha-2 country code of the visitor, from the headers of the proxy/CDN. */
+  country: string;
+  /** The id of the signed-in visitor, when the workspace attributes the views to the users. 0 otherwise. */
+  userId: number;
+  /** The name of the variant of the shortcut the visitor was redirected to, empty without variants. */
+  variant: string;
 }
 
 export interface ActivityShorcutViewPayload_ParamsEntry {
@@ -30,6 +36,106 @@ export interface ActivityShorcutViewPayload_ValueList {
   values: string[];
 }
 
+export interface ActivityShortcutAnomalyPayload {
+  shortcutId: number;
+  direction: ActivityShortcutAnomalyPayload_Direction;
+  /** The views in the detected hour. */
+  viewCount: number;
+  /** The mean of the hourly views in the baseline. */
+  baselineMean: number;
+  zScore: number;
+}
+
+export enum ActivityShortcutAnomalyPayload_Direction {
+  DIRECTION_UNSPECIFIED = "DIRECTION_UNSPECIFIED",
+  SPIKE = "SPIKE",
+  DROP = "DROP",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function activityShortcutAnomalyPayload_DirectionFromJSON(object: any): ActivityShortcutAnomalyPayload_Direction {
+  switch (object) {
+    case 0:
+    case "DIRECTION_UNSPECIFIED":
+      return ActivityShortcutAnomalyPayload_Direction.DIRECTION_UNSPECIFIED;
+    case 1:
+    case "SPIKE":
+      return ActivityShortcutAnomalyPayload_Direction.SPIKE;
+    case 2:
+    case "DROP":
+      return ActivityShortcutAnomalyPayload_Direction.DROP;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ActivityShortcutAnomalyPayload_Direction.UNRECOGNIZED;
+  }
+}
+
+export function activityShortcutAnomalyPayload_DirectionToNumber(object: ActivityShortcutAnomalyPayload_Direction): number {
+  switch (object) {
+    case ActivityShortcutAnomalyPayload_Direction.DIRECTION_UNSPECIFIED:
+      return 0;
+    case ActivityShortcutAnomalyPayload_Direction.SPIKE:
+      return 1;
+    case ActivityShortcutAnomalyPayload_Direction.DROP:
+      return 2;
+    case ActivityShortcutAnomalyPayload_Direction.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ActivityShortcutClickGoalPayload {
+  shortcutId: number;
+  target: number;
+  viewCount: number;
+}
+
+export interface ActivityShortcutLinkBrokenPayload {
+  shortcutId: number;
+  /** The status code of the last check, 0 if the link couldn't be requested. */
+  statusCode: number;
+  error: string;
+}
+
+export interface ActivityWorkspaceSecretRotatePayload {
+  /** The policy applied to the access tokens signed with the previous secret. */
+  policy: string;
+  /** The access tokens re-signed with the new secret. */
+  resignedTokenCount: number;
+  /** The access tokens revoked, including the expired ones. */
+  revokedTokenCount: number;
+}
+
+export interface ActivityUserImpersonatePayload {
+  /** The id of the impersonated user. The admin is the creator of the activity. */
+  userId: number;
+  /** The time the impersonation ends, in unix seconds. */
+  expireTs: number;
+  ip: string;
+  userAgent: string;
+}
+
+export interface ActivityUserBreakGlassPayload {
+  /** The email of the break-glass admin account signing in. */
+  email: string;
+  /** The time the break-glass session ends, in unix seconds. */
+  expireTs: number;
+  ip: string;
+  userAgent: string;
+}
+
+export interface ActivityArchivePayload {
+  /** The id of the blob with the gzipped JSON lines of the archived activities. */
+  blobId: number;
+  /** The type of the archived activities. */
+  type: string;
+  /** The archived activities were created in [start_ts, end_ts). */
+  startTs: number;
+  endTs: number;
+  activityCount: number;
+}
+
 function createBaseActivityShorcutCreatePayload(): ActivityShorcutCreatePayload {
   return { shortcutId: 0 };
 }
@@ -77,7 +183,7 @@ export const ActivityShorcutCreatePayload: MessageFns<ActivityShorcutCreatePaylo
 };
 
 function createBaseActivityShorcutViewPayload(): ActivityShorcutViewPayload {
-  return { shortcutId: 0, ip: "", referer: "", userAgent: "", params: {} };
+  return { shortcutId: 0, ip: "", referer: "", userAgent: "", params: {}, country: "", userId: 0, variant: "" };
 }
 
 export const ActivityShorcutViewPayload: MessageFns<ActivityShorcutViewPayload> = {
@@ -97,6 +203,15 @@ export const ActivityShorcutViewPayload: MessageFns<ActivityShorcutViewPayload>
     Object.entries(message.params).forEach(([key, value]) => {
       ActivityShorcutViewPayload_ParamsEntry.encode({ key: key as any, value }, writer.uint32(42).fork()).join();
     });
+    if (message.country !== "") {
+      writer.uint32(50).string(message.country);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(56).int32(message.userId);
+    }
+    if (message.variant !== "") {
+      writer.uint32(66).string(message.variant);
+    }
     return writer;
   },
 
@@ -150,6 +265,30 @@ export const ActivityShorcutViewPayload: MessageFns<ActivityShorcutViewPayload>
           }
           continue;
         }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.country = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.variant = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -176,6 +315,9 @@ export const ActivityShorcutViewPayload: MessageFns<ActivityShorcutViewPayload>
       }
       return acc;
     }, {});
+    message.country = object.country ?? "";
+    message.userId = object.userId ?? 0;
+    message.variant = object.variant ?? "";
     return message;
   },
 };
@@ -286,6 +428,574 @@ export const ActivityShorcutViewPayload_ValueList: MessageFns<ActivityShorcutVie
   },
 };
 
+function createBaseActivityShortcutAnomalyPayload(): ActivityShortcutAnomalyPayload {
+  return {
+    shortcutId: 0,
+    direction: ActivityShortcutAnomalyPayload_Direction.DIRECTION_UNSPECIFIED,
+    viewCount: 0,
+    baselineMean: 0,
+    zScore: 0,
+  };
+}
+
+export const ActivityShortcutAnomalyPayload: MessageFns<ActivityShortcutAnomalyPayload> = {
+  encode(message: ActivityShortcutAnomalyPayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.direction !== ActivityShortcutAnomalyPayload_Direction.DIRECTION_UNSPECIFIED) {
+      writer.uint32(16).int32(activityShortcutAnomalyPayload_DirectionToNumber(message.direction));
+    }
+    if (message.viewCount !== 0) {
+      writer.uint32(24).int32(message.viewCount);
+    }
+    if (message.baselineMean !== 0) {
+      writer.uint32(33).double(message.baselineMean);
+    }
+    if (message.zScore !== 0) {
+      writer.uint32(41).double(message.zScore);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityShortcutAnomalyPayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityShortcutAnomalyPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.direction = activityShortcutAnomalyPayload_DirectionFromJSON(reader.int32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.viewCount = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.baselineMean = reader.double();
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.zScore = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityShortcutAnomalyPayload>): ActivityShortcutAnomalyPayload {
+    return ActivityShortcutAnomalyPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityShortcutAnomalyPayload>): ActivityShortcutAnomalyPayload {
+    const message = createBaseActivityShortcutAnomalyPayload();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.direction = object.direction ?? ActivityShortcutAnomalyPayload_Direction.DIRECTION_UNSPECIFIED;
+    message.viewCount = object.viewCount ?? 0;
+    message.baselineMean = object.baselineMean ?? 0;
+    message.zScore = object.zScore ?? 0;
+    return message;
+  },
+};
+
+function createBaseActivityShortcutClickGoalPayload(): ActivityShortcutClickGoalPayload {
+  return { shortcutId: 0, target: 0, viewCount: 0 };
+}
+
+export const ActivityShortcutClickGoalPayload: MessageFns<ActivityShortcutClickGoalPayload> = {
+  encode(message: ActivityShortcutClickGoalPayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.target !== 0) {
+      writer.uint32(16).int32(message.target);
+    }
+    if (message.viewCount !== 0) {
+      writer.uint32(24).int32(message.viewCount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityShortcutClickGoalPayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityShortcutClickGoalPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.target = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.viewCount = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityShortcutClickGoalPayload>): ActivityShortcutClickGoalPayload {
+    return ActivityShortcutClickGoalPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityShortcutClickGoalPayload>): ActivityShortcutClickGoalPayload {
+    const message = createBaseActivityShortcutClickGoalPayload();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.target = object.target ?? 0;
+    message.viewCount = object.viewCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseActivityShortcutLinkBrokenPayload(): ActivityShortcutLinkBrokenPayload {
+  return { shortcutId: 0, statusCode: 0, error: "" };
+}
+
+export const ActivityShortcutLinkBrokenPayload: MessageFns<ActivityShortcutLinkBrokenPayload> = {
+  encode(message: ActivityShortcutLinkBrokenPayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.statusCode !== 0) {
+      writer.uint32(16).int32(message.statusCode);
+    }
+    if (message.error !== "") {
+      writer.uint32(26).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityShortcutLinkBrokenPayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityShortcutLinkBrokenPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.statusCode = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityShortcutLinkBrokenPayload>): ActivityShortcutLinkBrokenPayload {
+    return ActivityShortcutLinkBrokenPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityShortcutLinkBrokenPayload>): ActivityShortcutLinkBrokenPayload {
+    const message = createBaseActivityShortcutLinkBrokenPayload();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.statusCode = object.statusCode ?? 0;
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+function createBaseActivityWorkspaceSecretRotatePayload(): ActivityWorkspaceSecretRotatePayload {
+  return { policy: "", resignedTokenCount: 0, revokedTokenCount: 0 };
+}
+
+export const ActivityWorkspaceSecretRotatePayload: MessageFns<ActivityWorkspaceSecretRotatePayload> = {
+  encode(message: ActivityWorkspaceSecretRotatePayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.policy !== "") {
+      writer.uint32(10).string(message.policy);
+    }
+    if (message.resignedTokenCount !== 0) {
+      writer.uint32(16).int32(message.resignedTokenCount);
+    }
+    if (message.revokedTokenCount !== 0) {
+      writer.uint32(24).int32(message.revokedTokenCount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityWorkspaceSecretRotatePayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityWorkspaceSecretRotatePayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.policy = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.resignedTokenCount = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.revokedTokenCount = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityWorkspaceSecretRotatePayload>): ActivityWorkspaceSecretRotatePayload {
+    return ActivityWorkspaceSecretRotatePayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityWorkspaceSecretRotatePayload>): ActivityWorkspaceSecretRotatePayload {
+    const message = createBaseActivityWorkspaceSecretRotatePayload();
+    message.policy = object.policy ?? "";
+    message.resignedTokenCount = object.resignedTokenCount ?? 0;
+    message.revokedTokenCount = object.revokedTokenCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseActivityUserImpersonatePayload(): ActivityUserImpersonatePayload {
+  return { userId: 0, expireTs: 0, ip: "", userAgent: "" };
+}
+
+export const ActivityUserImpersonatePayload: MessageFns<ActivityUserImpersonatePayload> = {
+  encode(message: ActivityUserImpersonatePayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.userId !== 0) {
+      writer.uint32(8).int32(message.userId);
+    }
+    if (message.expireTs !== 0) {
+      writer.uint32(16).int64(message.expireTs);
+    }
+    if (message.ip !== "") {
+      writer.uint32(26).string(message.ip);
+    }
+    if (message.userAgent !== "") {
+      writer.uint32(34).string(message.userAgent);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityUserImpersonatePayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityUserImpersonatePayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.expireTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ip = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.userAgent = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityUserImpersonatePayload>): ActivityUserImpersonatePayload {
+    return ActivityUserImpersonatePayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityUserImpersonatePayload>): ActivityUserImpersonatePayload {
+    const message = createBaseActivityUserImpersonatePayload();
+    message.userId = object.userId ?? 0;
+    message.expireTs = object.expireTs ?? 0;
+    message.ip = object.ip ?? "";
+    message.userAgent = object.userAgent ?? "";
+    return message;
+  },
+};
+
+function createBaseActivityUserBreakGlassPayload(): ActivityUserBreakGlassPayload {
+  return { email: "", expireTs: 0, ip: "", userAgent: "" };
+}
+
+export const ActivityUserBreakGlassPayload: MessageFns<ActivityUserBreakGlassPayload> = {
+  encode(message: ActivityUserBreakGlassPayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.email !== "") {
+      writer.uint32(10).string(message.email);
+    }
+    if (message.expireTs !== 0) {
+      writer.uint32(16).int64(message.expireTs);
+    }
+    if (message.ip !== "") {
+      writer.uint32(26).string(message.ip);
+    }
+    if (message.userAgent !== "") {
+      writer.uint32(34).string(message.userAgent);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityUserBreakGlassPayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityUserBreakGlassPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.email = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.expireTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ip = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.userAgent = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityUserBreakGlassPayload>): ActivityUserBreakGlassPayload {
+    return ActivityUserBreakGlassPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityUserBreakGlassPayload>): ActivityUserBreakGlassPayload {
+    const message = createBaseActivityUserBreakGlassPayload();
+    message.email = object.email ?? "";
+    message.expireTs = object.expireTs ?? 0;
+    message.ip = object.ip ?? "";
+    message.userAgent = object.userAgent ?? "";
+    return message;
+  },
+};
+
+function createBaseActivityArchivePayload(): ActivityArchivePayload {
+  return { blobId: 0, type: "", startTs: 0, endTs: 0, activityCount: 0 };
+}
+
+export const ActivityArchivePayload: MessageFns<ActivityArchivePayload> = {
+  encode(message: ActivityArchivePayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.blobId !== 0) {
+      writer.uint32(8).int32(message.blobId);
+    }
+    if (message.type !== "") {
+      writer.uint32(18).string(message.type);
+    }
+    if (message.startTs !== 0) {
+      writer.uint32(24).int64(message.startTs);
+    }
+    if (message.endTs !== 0) {
+      writer.uint32(32).int64(message.endTs);
+    }
+    if (message.activityCount !== 0) {
+      writer.uint32(40).int32(message.activityCount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityArchivePayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityArchivePayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.blobId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.startTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.endTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.activityCount = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityArchivePayload>): ActivityArchivePayload {
+    return ActivityArchivePayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityArchivePayload>): ActivityArchivePayload {
+    const message = createBaseActivityArchivePayload();
+    message.blobId = object.blobId ?? 0;
+    message.type = object.type ?? "";
+    message.startTs = object.startTs ?? 0;
+    message.endTs = object.endTs ?? 0;
+    message.activityCount = object.activityCount ?? 0;
+    return message;
+  },
+};
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -294,6 +1004,17 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function longToNumber(int64: { toString(): string }): number {
+  const num = globalThis.Number(int64.toString());
+  if (num > globalThis.Number.MAX_SAFE_INTEGER) {
+    throw new globalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  if (num < globalThis.Number.MIN_SAFE_INTEGER) {
+    throw new globalThis.Error("Value is smaller than Number.MIN_SAFE_INTEGER");
+  }
+  return num;
+}
+
 export interface MessageFns<T> {
   encode(message: T, writer?: BinaryWriter): BinaryWriter;
   decode(input: BinaryReader | Uint8Array, length?: number): T;
@@ -6,7 +6,14 @@
 
 /* eslint-disable */
 import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
-import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+import {
+  RowStatus,
+  Visibility,
+  rowStatusFromJSON,
+  rowStatusToNumber,
+  visibilityFromJSON,
+  visibilityToNumber,
+} from "./common";
 
 export const protobufPackage = "slash.store";
 
@@ -15,11 +22,34 @@ export interface Collection {
   creatorId: number;
   createdTs: number;
   updatedTs: number;
+  rowStatus: RowStatus;
   name: string;
   title: string;
   description: string;
   shortcutIds: number[];
   visibility: Visibility;
+  /** The id of the team the collection is visible to, when the visibility is TEAM. */
+  teamId: number;
+}
+
+export interface CollectionTemplate {
+  /** The unique identifier of the template, e.g. "project-onboarding". */
+  id: string;
+  title: string;
+  description: string;
+  shortcuts: ShortcutTemplate[];
+}
+
+export interface ShortcutTemplate {
+  /**
+   * The name, title, link and description may contain the placeholders
+   * `{name}` and `{title}` of the collection created from the template.
+   */
+  name: string;
+  title: string;
+  link: string;
+  description: string;
+  tags: string[];
 }
 
 function createBaseCollection(): Collection {
@@ -28,11 +58,13 @@ function createBaseCollection(): Collection {
     creatorId: 0,
     createdTs: 0,
     updatedTs: 0,
+    rowStatus: RowStatus.ROW_STATUS_UNSPECIFIED,
     name: "",
     title: "",
     description: "",
     shortcutIds: [],
     visibility: Visibility.VISIBILITY_UNSPECIFIED,
+    teamId: 0,
   };
 }
 
@@ -50,6 +82,9 @@ export const Collection: MessageFns<Collection> = {
     if (message.updatedTs !== 0) {
       writer.uint32(32).int64(message.updatedTs);
     }
+    if (message.rowStatus !== RowStatus.ROW_STATUS_UNSPECIFIED) {
+      writer.uint32(40).int32(rowStatusToNumber(message.rowStatus));
+    }
     if (message.name !== "") {
       writer.uint32(50).string(message.name);
     }
@@ -67,6 +102,9 @@ export const Collection: MessageFns<Collection> = {
     if (message.visibility !== Visibility.VISIBILITY_UNSPECIFIED) {
       writer.uint32(80).int32(visibilityToNumber(message.visibility));
     }
+    if (message.teamId !== 0) {
+      writer.uint32(88).int32(message.teamId);
+    }
     return writer;
   },
 
@@ -109,6 +147,14 @@ export const Collection: MessageFns<Collection> = {
           message.updatedTs = longToNumber(reader.int64());
           continue;
         }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.rowStatus = rowStatusFromJSON(reader.int32());
+          continue;
+        }
         case 6: {
           if (tag !== 50) {
             break;
@@ -159,6 +205,14 @@ export const Collection: MessageFns<Collection> = {
           message.visibility = visibilityFromJSON(reader.int32());
           continue;
         }
+        case 11: {
+          if (tag !== 88) {
+            break;
+          }
+
+          message.teamId = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -177,11 +231,189 @@ export const Collection: MessageFns<Collection> = {
     message.creatorId = object.creatorId ?? 0;
     message.createdTs = object.createdTs ?? 0;
     message.updatedTs = object.updatedTs ?? 0;
+    message.rowStatus = object.rowStatus ?? RowStatus.ROW_STATUS_UNSPECIFIED;
     message.name = object.name ?? "";
     message.title = object.title ?? "";
     message.description = object.description ?? "";
     message.shortcutIds = object.shortcutIds?.map((e) => e) || [];
     message.visibility = object.visibility ?? Visibility.VISIBILITY_UNSPECIFIED;
+    message.teamId = object.teamId ?? 0;
+    return message;
+  },
+};
+
+function createBaseCollectionTemplate(): CollectionTemplate {
+  return { id: "", title: "", description: "", shortcuts: [] };
+}
+
+export const CollectionTemplate: MessageFns<CollectionTemplate> = {
+  encode(message: CollectionTemplate, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.description !== "") {
+      writer.uint32(26).string(message.description);
+    }
+    for (const v of message.shortcuts) {
+      ShortcutTemplate.encode(v!, writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CollectionTemplate {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCollectionTemplate();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.shortcuts.push(ShortcutTemplate.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CollectionTemplate>): CollectionTemplate {
+    return CollectionTemplate.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CollectionTemplate>): CollectionTemplate {
+    const message = createBaseCollectionTemplate();
+    message.id = object.id ?? "";
+    message.title = object.title ?? "";
+    message.description = object.description ?? "";
+    message.shortcuts = object.shortcuts?.map((e) => ShortcutTemplate.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseShortcutTemplate(): ShortcutTemplate {
+  return { name: "", title: "", link: "", description: "", tags: [] };
+}
+
+export const ShortcutTemplate: MessageFns<ShortcutTemplate> = {
+  encode(message: ShortcutTemplate, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.link !== "") {
+      writer.uint32(26).string(message.link);
+    }
+    if (message.description !== "") {
+      writer.uint32(34).string(message.description);
+    }
+    for (const v of message.tags) {
+      writer.uint32(42).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutTemplate {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutTemplate();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutTemplate>): ShortcutTemplate {
+    return ShortcutTemplate.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutTemplate>): ShortcutTemplate {
+    const message = createBaseShortcutTemplate();
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.link = object.link ?? "";
+    message.description = object.description ?? "";
+    message.tags = object.tags?.map((e) => e) || [];
     return message;
   },
 };
@@ -14,12 +14,25 @@ export interface IdentityProvider {
   id: string;
   title: string;
   type: IdentityProvider_Type;
-  config?: IdentityProviderConfig | undefined;
+  config?:
+    | IdentityProviderConfig
+    | undefined;
+  /** The display order of the sign in button, ascending. */
+  displayOrder: number;
+  /** The icon url of the sign in button. */
+  iconUrl: string;
+  /**
+   * Whether to redirect to the identity provider automatically
+   * when it's the only available sign in method.
+   */
+  autoRedirect: boolean;
 }
 
 export enum IdentityProvider_Type {
   TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
   OAUTH2 = "OAUTH2",
+  SAML = "SAML",
+  LDAP = "LDAP",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -31,6 +44,12 @@ export function identityProvider_TypeFromJSON(object: any): IdentityProvider_Typ
     case 1:
     case "OAUTH2":
       return IdentityProvider_Type.OAUTH2;
+    case 2:
+    case "SAML":
+      return IdentityProvider_Type.SAML;
+    case 3:
+    case "LDAP":
+      return IdentityProvider_Type.LDAP;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -44,6 +63,10 @@ export function identityProvider_TypeToNumber(object: IdentityProvider_Type): nu
       return 0;
     case IdentityProvider_Type.OAUTH2:
       return 1;
+    case IdentityProvider_Type.SAML:
+      return 2;
+    case IdentityProvider_Type.LDAP:
+      return 3;
     case IdentityProvider_Type.UNRECOGNIZED:
     default:
       return -1;
@@ -52,11 +75,20 @@ export function identityProvider_TypeToNumber(object: IdentityProvider_Type): nu
 
 export interface IdentityProviderConfig {
   oauth2?: IdentityProviderConfig_OAuth2Config | undefined;
+  saml?: IdentityProviderConfig_SAMLConfig | undefined;
+  ldap?: IdentityProviderConfig_LDAPConfig | undefined;
 }
 
 export interface IdentityProviderConfig_FieldMapping {
   identifier: string;
   displayName: string;
+  /**
+   * groups is the claim or attribute with the groups of the user.
+   * When set, the role of the user is synced from the groups on every sign in.
+   */
+  groups: string;
+  /** admin_group is the group whose members are admins, the others are users. */
+  adminGroup: string;
 }
 
 export interface IdentityProviderConfig_OAuth2Config {
@@ -66,11 +98,63 @@ export interface IdentityProviderConfig_OAuth2Config {
   tokenUrl: string;
   userInfoUrl: string;
   scopes: string[];
+  fieldMapping?:
+    | IdentityProviderConfig_FieldMapping
+    | undefined;
+  /**
+   * The issuer url of the OpenID Connect provider, e.g. "https://accounts.google.com".
+   * When set, the endpoints are discovered from its "/.well-known/openid-configuration",
+   * and the ID token is verified on sign in.
+   */
+  issuerUrl: string;
+}
+
+/**
+ * SAMLConfig is the config of a SAML 2.0 identity provider, where Slash is the service provider.
+ * The identifier of the field mapping is the attribute name of the email, or empty for the NameID.
+ */
+export interface IdentityProviderConfig_SAMLConfig {
+  /** The entity id of the identity provider, i.e. the issuer of the responses. */
+  entityId: string;
+  /** The single sign-on url of the identity provider with the HTTP-Redirect binding. */
+  ssoUrl: string;
+  /** The PEM encoded certificate to verify the signatures of the identity provider. */
+  certificate: string;
+  fieldMapping?: IdentityProviderConfig_FieldMapping | undefined;
+}
+
+/**
+ * LDAPConfig is the config of an LDAP or Active Directory server, where users sign in with their directory credentials.
+ * The identifier of the field mapping is the attribute of the email, e.g. "mail".
+ */
+export interface IdentityProviderConfig_LDAPConfig {
+  /** The url of the server, e.g. "ldaps://ldap.example.com:636" or "ldap://ldap.example.com:389". */
+  url: string;
+  /** Whether to upgrade the "ldap://" connection with StartTLS. */
+  startTls: boolean;
+  /** The DN and password to bind with to search the users, or empty to search anonymously. */
+  bindDn: string;
+  bindPassword: string;
+  /** The base DN to search the users under, e.g. "ou=people,dc=example,dc=com". */
+  searchBase: string;
+  /**
+   * The filter to search the user, where "%s" is replaced with the escaped username,
+   * e.g. "(uid=%s)" or "(&(objectClass=user)(sAMAccountName=%s))".
+   */
+  userFilter: string;
   fieldMapping?: IdentityProviderConfig_FieldMapping | undefined;
 }
 
 function createBaseIdentityProvider(): IdentityProvider {
-  return { id: "", title: "", type: IdentityProvider_Type.TYPE_UNSPECIFIED, config: undefined };
+  return {
+    id: "",
+    title: "",
+    type: IdentityProvider_Type.TYPE_UNSPECIFIED,
+    config: undefined,
+    displayOrder: 0,
+    iconUrl: "",
+    autoRedirect: false,
+  };
 }
 
 export const IdentityProvider: MessageFns<IdentityProvider> = {
@@ -87,6 +171,15 @@ export const IdentityProvider: MessageFns<IdentityProvider> = {
     if (message.config !== undefined) {
       IdentityProviderConfig.encode(message.config, writer.uint32(34).fork()).join();
     }
+    if (message.displayOrder !== 0) {
+      writer.uint32(40).int32(message.displayOrder);
+    }
+    if (message.iconUrl !== "") {
+      writer.uint32(50).string(message.iconUrl);
+    }
+    if (message.autoRedirect !== false) {
+      writer.uint32(56).bool(message.autoRedirect);
+    }
     return writer;
   },
 
@@ -129,6 +222,30 @@ export const IdentityProvider: MessageFns<IdentityProvider> = {
           message.config = IdentityProviderConfig.decode(reader, reader.uint32());
           continue;
         }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.displayOrder = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.iconUrl = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.autoRedirect = reader.bool();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -149,12 +266,15 @@ export const IdentityProvider: MessageFns<IdentityProvider> = {
     message.config = (object.config !== undefined && object.config !== null)
       ? IdentityProviderConfig.fromPartial(object.config)
       : undefined;
+    message.displayOrder = object.displayOrder ?? 0;
+    message.iconUrl = object.iconUrl ?? "";
+    message.autoRedirect = object.autoRedirect ?? false;
     return message;
   },
 };
 
 function createBaseIdentityProviderConfig(): IdentityProviderConfig {
-  return { oauth2: undefined };
+  return { oauth2: undefined, saml: undefined, ldap: undefined };
 }
 
 export const IdentityProviderConfig: MessageFns<IdentityProviderConfig> = {
@@ -162,6 +282,12 @@ export const IdentityProviderConfig: MessageFns<IdentityProviderConfig> = {
     if (message.oauth2 !== undefined) {
       IdentityProviderConfig_OAuth2Config.encode(message.oauth2, writer.uint32(10).fork()).join();
     }
+    if (message.saml !== undefined) {
+      IdentityProviderConfig_SAMLConfig.encode(message.saml, writer.uint32(18).fork()).join();
+    }
+    if (message.ldap !== undefined) {
+      IdentityProviderConfig_LDAPConfig.encode(message.ldap, writer.uint32(26).fork()).join();
+    }
     return writer;
   },
 
@@ -180,6 +306,22 @@ export const IdentityProviderConfig: MessageFns<IdentityProviderConfig> = {
           message.oauth2 = IdentityProviderConfig_OAuth2Config.decode(reader, reader.uint32());
           continue;
         }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.saml = IdentityProviderConfig_SAMLConfig.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ldap = IdentityProviderConfig_LDAPConfig.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -197,12 +339,18 @@ export const IdentityProviderConfig: MessageFns<IdentityProviderConfig> = {
     message.oauth2 = (object.oauth2 !== undefined && object.oauth2 !== null)
       ? IdentityProviderConfig_OAuth2Config.fromPartial(object.oauth2)
       : undefined;
+    message.saml = (object.saml !== undefined && object.saml !== null)
+      ? IdentityProviderConfig_SAMLConfig.fromPartial(object.saml)
+      : undefined;
+    message.ldap = (object.ldap !== undefined && object.ldap !== null)
+      ? IdentityProviderConfig_LDAPConfig.fromPartial(object.ldap)
+      : undefined;
     return message;
   },
 };
 
 function createBaseIdentityProviderConfig_FieldMapping(): IdentityProviderConfig_FieldMapping {
-  return { identifier: "", displayName: "" };
+  return { identifier: "", displayName: "", groups: "", adminGroup: "" };
 }
 
 export const IdentityProviderConfig_FieldMapping: MessageFns<IdentityProviderConfig_FieldMapping> = {
@@ -213,6 +361,12 @@ export const IdentityProviderConfig_FieldMapping: MessageFns<IdentityProviderCon
     if (message.displayName !== "") {
       writer.uint32(18).string(message.displayName);
     }
+    if (message.groups !== "") {
+      writer.uint32(26).string(message.groups);
+    }
+    if (message.adminGroup !== "") {
+      writer.uint32(34).string(message.adminGroup);
+    }
     return writer;
   },
 
@@ -239,6 +393,22 @@ export const IdentityProviderConfig_FieldMapping: MessageFns<IdentityProviderCon
           message.displayName = reader.string();
           continue;
         }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.groups = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.adminGroup = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -255,6 +425,8 @@ export const IdentityProviderConfig_FieldMapping: MessageFns<IdentityProviderCon
     const message = createBaseIdentityProviderConfig_FieldMapping();
     message.identifier = object.identifier ?? "";
     message.displayName = object.displayName ?? "";
+    message.groups = object.groups ?? "";
+    message.adminGroup = object.adminGroup ?? "";
     return message;
   },
 };
@@ -268,6 +440,7 @@ function createBaseIdentityProviderConfig_OAuth2Config(): IdentityProviderConfig
     userInfoUrl: "",
     scopes: [],
     fieldMapping: undefined,
+    issuerUrl: "",
   };
 }
 
@@ -294,6 +467,9 @@ export const IdentityProviderConfig_OAuth2Config: MessageFns<IdentityProviderCon
     if (message.fieldMapping !== undefined) {
       IdentityProviderConfig_FieldMapping.encode(message.fieldMapping, writer.uint32(58).fork()).join();
     }
+    if (message.issuerUrl !== "") {
+      writer.uint32(66).string(message.issuerUrl);
+    }
     return writer;
   },
 
@@ -360,6 +536,14 @@ export const IdentityProviderConfig_OAuth2Config: MessageFns<IdentityProviderCon
           message.fieldMapping = IdentityProviderConfig_FieldMapping.decode(reader, reader.uint32());
           continue;
         }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.issuerUrl = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -380,6 +564,219 @@ export const IdentityProviderConfig_OAuth2Config: MessageFns<IdentityProviderCon
     message.tokenUrl = object.tokenUrl ?? "";
     message.userInfoUrl = object.userInfoUrl ?? "";
     message.scopes = object.scopes?.map((e) => e) || [];
+    message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
+      ? IdentityProviderConfig_FieldMapping.fromPartial(object.fieldMapping)
+      : undefined;
+    message.issuerUrl = object.issuerUrl ?? "";
+    return message;
+  },
+};
+
+function createBaseIdentityProviderConfig_SAMLConfig(): IdentityProviderConfig_SAMLConfig {
+  return { entityId: "", ssoUrl: "", certificate: "", fieldMapping: undefined };
+}
+
+export const IdentityProviderConfig_SAMLConfig: MessageFns<IdentityProviderConfig_SAMLConfig> = {
+  encode(message: IdentityProviderConfig_SAMLConfig, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.entityId !== "") {
+      writer.uint32(10).string(message.entityId);
+    }
+    if (message.ssoUrl !== "") {
+      writer.uint32(18).string(message.ssoUrl);
+    }
+    if (message.certificate !== "") {
+      writer.uint32(26).string(message.certificate);
+    }
+    if (message.fieldMapping !== undefined) {
+      IdentityProviderConfig_FieldMapping.encode(message.fieldMapping, writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig_SAMLConfig {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProviderConfig_SAMLConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.entityId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ssoUrl = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.certificate = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.fieldMapping = IdentityProviderConfig_FieldMapping.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<IdentityProviderConfig_SAMLConfig>): IdentityProviderConfig_SAMLConfig {
+    return IdentityProviderConfig_SAMLConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IdentityProviderConfig_SAMLConfig>): IdentityProviderConfig_SAMLConfig {
+    const message = createBaseIdentityProviderConfig_SAMLConfig();
+    message.entityId = object.entityId ?? "";
+    message.ssoUrl = object.ssoUrl ?? "";
+    message.certificate = object.certificate ?? "";
+    message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
+      ? IdentityProviderConfig_FieldMapping.fromPartial(object.fieldMapping)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseIdentityProviderConfig_LDAPConfig(): IdentityProviderConfig_LDAPConfig {
+  return {
+    url: "",
+    startTls: false,
+    bindDn: "",
+    bindPassword: "",
+    searchBase: "",
+    userFilter: "",
+    fieldMapping: undefined,
+  };
+}
+
+export const IdentityProviderConfig_LDAPConfig: MessageFns<IdentityProviderConfig_LDAPConfig> = {
+  encode(message: IdentityProviderConfig_LDAPConfig, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.url !== "") {
+      writer.uint32(10).string(message.url);
+    }
+    if (message.startTls !== false) {
+      writer.uint32(16).bool(message.startTls);
+    }
+    if (message.bindDn !== "") {
+      writer.uint32(26).string(message.bindDn);
+    }
+    if (message.bindPassword !== "") {
+      writer.uint32(34).string(message.bindPassword);
+    }
+    if (message.searchBase !== "") {
+      writer.uint32(42).string(message.searchBase);
+    }
+    if (message.userFilter !== "") {
+      writer.uint32(50).string(message.userFilter);
+    }
+    if (message.fieldMapping !== undefined) {
+      IdentityProviderConfig_FieldMapping.encode(message.fieldMapping, writer.uint32(58).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IdentityProviderConfig_LDAPConfig {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProviderConfig_LDAPConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.startTls = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.bindDn = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.bindPassword = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.searchBase = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.userFilter = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.fieldMapping = IdentityProviderConfig_FieldMapping.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<IdentityProviderConfig_LDAPConfig>): IdentityProviderConfig_LDAPConfig {
+    return IdentityProviderConfig_LDAPConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IdentityProviderConfig_LDAPConfig>): IdentityProviderConfig_LDAPConfig {
+    const message = createBaseIdentityProviderConfig_LDAPConfig();
+    message.url = object.url ?? "";
+    message.startTls = object.startTls ?? false;
+    message.bindDn = object.bindDn ?? "";
+    message.bindPassword = object.bindPassword ?? "";
+    message.searchBase = object.searchBase ?? "";
+    message.userFilter = object.userFilter ?? "";
     message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
       ? IdentityProviderConfig_FieldMapping.fromPartial(object.fieldMapping)
       : undefined;
@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-ts_proto. DO NOT EDIT.
+// versions:
+//   protoc-gen-ts_proto  v2.6.1
+//   protoc               unknown
+// source: store/import_job.proto
+
+/* eslint-disable */
+import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
+import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+
+export const protobufPackage = "slash.store";
+
+export interface ImportJobPayload {
+  /** The shortcuts to import, in the order of the file. */
+  rows: ImportJobRow[];
+}
+
+export interface ImportJobRow {
+  name: string;
+  title: string;
+  link: string;
+  description: string;
+  tags: string[];
+  /** The visibility of the shortcut, the workspace default when unspecified. */
+  visibility: Visibility;
+}
+
+export interface ImportJobResult {
+  /** The errors of the rows which failed, at most the first 1000. */
+  rowErrors: ImportJobRowError[];
+}
+
+export interface ImportJobRowError {
+  /** The 1-based index of the row. */
+  row: number;
+  name: string;
+  error: string;
+}
+
+function createBaseImportJobPayload(): ImportJobPayload {
+  return { rows: [] };
+}
+
+export const ImportJobPayload: MessageFns<ImportJobPayload> = {
+  encode(message: ImportJobPayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.rows) {
+      ImportJobRow.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ImportJobPayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportJobPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.rows.push(ImportJobRow.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ImportJobPayload>): ImportJobPayload {
+    return ImportJobPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportJobPayload>): ImportJobPayload {
+    const message = createBaseImportJobPayload();
+    message.rows = object.rows?.map((e) => ImportJobRow.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseImportJobRow(): ImportJobRow {
+  return { name: "", title: "", link: "", description: "", tags: [], visibility: Visibility.VISIBILITY_UNSPECIFIED };
+}
+
+export const ImportJobRow: MessageFns<ImportJobRow> = {
+  encode(message: ImportJobRow, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.link !== "") {
+      writer.uint32(26).string(message.link);
+    }
+    if (message.description !== "") {
+      writer.uint32(34).string(message.description);
+    }
+    for (const v of message.tags) {
+      writer.uint32(42).string(v!);
+    }
+    if (message.visibility !== Visibility.VISIBILITY_UNSPECIFIED) {
+      writer.uint32(48).int32(visibilityToNumber(message.visibility));
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ImportJobRow {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportJobRow();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.visibility = visibilityFromJSON(reader.int32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ImportJobRow>): ImportJobRow {
+    return ImportJobRow.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportJobRow>): ImportJobRow {
+    const message = createBaseImportJobRow();
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.link = object.link ?? "";
+    message.description = object.description ?? "";
+    message.tags = object.tags?.map((e) => e) || [];
+    message.visibility = object.visibility ?? Visibility.VISIBILITY_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseImportJobResult(): ImportJobResult {
+  return { rowErrors: [] };
+}
+
+export const ImportJobResult: MessageFns<ImportJobResult> = {
+  encode(message: ImportJobResult, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.rowErrors) {
+      ImportJobRowError.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ImportJobResult {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportJobResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.rowErrors.push(ImportJobRowError.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ImportJobResult>): ImportJobResult {
+    return ImportJobResult.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportJobResult>): ImportJobResult {
+    const message = createBaseImportJobResult();
+    message.rowErrors = object.rowErrors?.map((e) => ImportJobRowError.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseImportJobRowError(): ImportJobRowError {
+  return { row: 0, name: "", error: "" };
+}
+
+export const ImportJobRowError: MessageFns<ImportJobRowError> = {
+  encode(message: ImportJobRowError, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.row !== 0) {
+      writer.uint32(8).int32(message.row);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.error !== "") {
+      writer.uint32(26).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ImportJobRowError {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportJobRowError();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.row = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ImportJobRowError>): ImportJobRowError {
+    return ImportJobRowError.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportJobRowError>): ImportJobRowError {
+    const message = createBaseImportJobRowError();
+    message.row = object.row ?? 0;
+    message.name = object.name ?? "";
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+export interface MessageFns<T> {
+  encode(message: T, writer?: BinaryWriter): BinaryWriter;
+  decode(input: BinaryReader | Uint8Array, length?: number): T;
+  create(base?: DeepPartial<T>): T;
+  fromPartial(object: DeepPartial<T>): T;
+}
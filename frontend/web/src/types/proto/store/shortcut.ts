@@ -6,15 +6,106 @@
 
 /* eslint-disable */
 import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
-import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+import {
+  RowStatus,
+  Visibility,
+  rowStatusFromJSON,
+  rowStatusToNumber,
+  visibilityFromJSON,
+  visibilityToNumber,
+} from "./common";
 
 export const protobufPackage = "slash.store";
 
+/** DeviceType is the type of the device of a visitor, from its user agent. */
+export enum DeviceType {
+  DEVICE_TYPE_UNSPECIFIED = "DEVICE_TYPE_UNSPECIFIED",
+  DESKTOP = "DESKTOP",
+  MOBILE = "MOBILE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function deviceTypeFromJSON(object: any): DeviceType {
+  switch (object) {
+    case 0:
+    case "DEVICE_TYPE_UNSPECIFIED":
+      return DeviceType.DEVICE_TYPE_UNSPECIFIED;
+    case 1:
+    case "DESKTOP":
+      return DeviceType.DESKTOP;
+    case 2:
+    case "MOBILE":
+      return DeviceType.MOBILE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DeviceType.UNRECOGNIZED;
+  }
+}
+
+export function deviceTypeToNumber(object: DeviceType): number {
+  switch (object) {
+    case DeviceType.DEVICE_TYPE_UNSPECIFIED:
+      return 0;
+    case DeviceType.DESKTOP:
+      return 1;
+    case DeviceType.MOBILE:
+      return 2;
+    case DeviceType.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/** DocumentMode is how a shortcut serves the documents it links to, which are proxied by the server. */
+export enum DocumentMode {
+  /** DOCUMENT_MODE_UNSPECIFIED - The visitors are redirected to the link. */
+  DOCUMENT_MODE_UNSPECIFIED = "DOCUMENT_MODE_UNSPECIFIED",
+  /** INLINE - The document is shown in a viewer page. */
+  INLINE = "INLINE",
+  /** DOWNLOAD - The document is downloaded as an attachment. */
+  DOWNLOAD = "DOWNLOAD",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function documentModeFromJSON(object: any): DocumentMode {
+  switch (object) {
+    case 0:
+    case "DOCUMENT_MODE_UNSPECIFIED":
+      return DocumentMode.DOCUMENT_MODE_UNSPECIFIED;
+    case 1:
+    case "INLINE":
+      return DocumentMode.INLINE;
+    case 2:
+    case "DOWNLOAD":
+      return DocumentMode.DOWNLOAD;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DocumentMode.UNRECOGNIZED;
+  }
+}
+
+export function documentModeToNumber(object: DocumentMode): number {
+  switch (object) {
+    case DocumentMode.DOCUMENT_MODE_UNSPECIFIED:
+      return 0;
+    case DocumentMode.INLINE:
+      return 1;
+    case DocumentMode.DOWNLOAD:
+      return 2;
+    case DocumentMode.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 export interface Shortcut {
   id: number;
   creatorId: number;
   createdTs: number;
   updatedTs: number;
+  rowStatus: RowStatus;
   name: string;
   link: string;
   title: string;
@@ -22,12 +113,126 @@ export interface Shortcut {
   description: string;
   visibility: Visibility;
   ogMetadata?: OpenGraphMetadata | undefined;
+  clickGoal?:
+    | ClickGoal
+    | undefined;
+  /** The time the shortcut expires, in unix seconds. 0 means never. */
+  expireTs: number;
+  /** The time the shortcut starts resolving, in unix seconds. 0 means immediately. */
+  activateTs: number;
+  /** The edits of the users other than the creator and the admins are proposed changes to approve. */
+  protected: boolean;
+  /** The id of the team the shortcut is visible to, when the visibility is TEAM. */
+  teamId: number;
+  /** The HTTP status code of the redirect, e.g. 301. 0 means the default of the workspace. */
+  redirectCode: number;
+  /** The result of the last health check of the link. */
+  linkHealth?:
+    | LinkHealth
+    | undefined;
+  /** How the link is served when it's a document, e.g. a PDF, rather than a page. */
+  documentMode: DocumentMode;
+  /** Whether the description is internal, i.e. only shown to the signed-in users. */
+  internalDescription: boolean;
+  /** The rules redirecting the visitors to other links, e.g. by country. The first matching rule wins over the link. */
+  redirectRules?:
+    | RedirectRules
+    | undefined;
+  /** The weighted links splitting the visits, e.g. for an A/B test. One is picked by weight on each visit instead of the link. */
+  variants?: Variants | undefined;
+}
+
+export interface RedirectRules {
+  rules: RedirectRule[];
+}
+
+/**
+ * RedirectRule redirects the visitors matching all of its conditions to its link.
+ * The conditions without values match any visitor.
+ */
+export interface RedirectRule {
+  /** The ISO 3166-1 alpha-2 codes of the countries, e.g. "DE". */
+  countries: string[];
+  /** The languages matching the preferred language of the visitor, e.g. "pt-BR", or its base language, e.g. "pt". */
+  languages: string[];
+  devices: DeviceType[];
+  link: string;
+}
+
+export interface Variants {
+  variants: Variant[];
+}
+
+/** Variant is a link of an A/B split, picked with the probability of its weight among the weights of the variants. */
+export interface Variant {
+  /** The name of the variant in the analytics, e.g. "A". */
+  name: string;
+  link: string;
+  weight: number;
+}
+
+/** LinkHealth is the result of the last health check of the link of a shortcut. */
+export interface LinkHealth {
+  /** The time of the last check, in unix seconds. 0 means never checked. */
+  checkedTs: number;
+  /** The HTTP status code of the last check. 0 means the link couldn't be requested. */
+  statusCode: number;
+  /** The error of the last check. Empty means the link is healthy. */
+  error: string;
+  /** The number of the consecutive failed checks, after which the link is broken. */
+  failureCount: number;
+}
+
+export interface ShortcutProposedChangePayload {
+  /** The proposed fields, as the paths of the update mask, e.g. "link". */
+  paths: string[];
+  /** The values of the fields when the change was proposed. */
+  previous?:
+    | ShortcutContent
+    | undefined;
+  /** The proposed values of the fields. */
+  proposed?: ShortcutContent | undefined;
+}
+
+/** ShortcutContent is the content of a shortcut which can be proposed to change. */
+export interface ShortcutContent {
+  name: string;
+  link: string;
+  title: string;
+  description: string;
+  tags: string[];
+  visibility: Visibility;
 }
 
 export interface OpenGraphMetadata {
   title: string;
   description: string;
   image: string;
+  /**
+   * The query parameters appended to the link on redirect.
+   * They are kept with the metadata, as both are stored as JSON in the same column.
+   */
+  queryParams: QueryParam[];
+  /** The url of the favicon of the link, fetched with the metadata. */
+  favicon: string;
+}
+
+export interface QueryParam {
+  key: string;
+  /**
+   * The value template, where {name} and {collection} are replaced by the shortcut name
+   * and the name of the collection the shortcut is opened from.
+   */
+  value: string;
+}
+
+export interface ClickGoal {
+  /** The target view count. 0 means no goal. */
+  target: number;
+  /** The webhook url to post to when the goal is reached. */
+  webhookUrl: string;
+  /** The time the goal was reached, in unix seconds. 0 means not reached yet. */
+  reachedTs: number;
 }
 
 function createBaseShortcut(): Shortcut {
@@ -36,6 +241,7 @@ function createBaseShortcut(): Shortcut {
     creatorId: 0,
     createdTs: 0,
     updatedTs: 0,
+    rowStatus: RowStatus.ROW_STATUS_UNSPECIFIED,
     name: "",
     link: "",
     title: "",
@@ -43,6 +249,17 @@ function createBaseShortcut(): Shortcut {
     description: "",
     visibility: Visibility.VISIBILITY_UNSPECIFIED,
     ogMetadata: undefined,
+    clickGoal: undefined,
+    expireTs: 0,
+    activateTs: 0,
+    protected: false,
+    teamId: 0,
+    redirectCode: 0,
+    linkHealth: undefined,
+    documentMode: DocumentMode.DOCUMENT_MODE_UNSPECIFIED,
+    internalDescription: false,
+    redirectRules: undefined,
+    variants: undefined,
   };
 }
 
@@ -60,6 +277,9 @@ export const Shortcut: MessageFns<Shortcut> = {
     if (message.updatedTs !== 0) {
       writer.uint32(32).int64(message.updatedTs);
     }
+    if (message.rowStatus !== RowStatus.ROW_STATUS_UNSPECIFIED) {
+      writer.uint32(40).int32(rowStatusToNumber(message.rowStatus));
+    }
     if (message.name !== "") {
       writer.uint32(50).string(message.name);
     }
@@ -81,6 +301,39 @@ export const Shortcut: MessageFns<Shortcut> = {
     if (message.ogMetadata !== undefined) {
       OpenGraphMetadata.encode(message.ogMetadata, writer.uint32(98).fork()).join();
     }
+    if (message.clickGoal !== undefined) {
+      ClickGoal.encode(message.clickGoal, writer.uint32(106).fork()).join();
+    }
+    if (message.expireTs !== 0) {
+      writer.uint32(112).int64(message.expireTs);
+    }
+    if (message.activateTs !== 0) {
+      writer.uint32(120).int64(message.activateTs);
+    }
+    if (message.protected !== false) {
+      writer.uint32(128).bool(message.protected);
+    }
+    if (message.teamId !== 0) {
+      writer.uint32(136).int32(message.teamId);
+    }
+    if (message.redirectCode !== 0) {
+      writer.uint32(144).int32(message.redirectCode);
+    }
+    if (message.linkHealth !== undefined) {
+      LinkHealth.encode(message.linkHealth, writer.uint32(154).fork()).join();
+    }
+    if (message.documentMode !== DocumentMode.DOCUMENT_MODE_UNSPECIFIED) {
+      writer.uint32(160).int32(documentModeToNumber(message.documentMode));
+    }
+    if (message.internalDescription !== false) {
+      writer.uint32(168).bool(message.internalDescription);
+    }
+    if (message.redirectRules !== undefined) {
+      RedirectRules.encode(message.redirectRules, writer.uint32(178).fork()).join();
+    }
+    if (message.variants !== undefined) {
+      Variants.encode(message.variants, writer.uint32(186).fork()).join();
+    }
     return writer;
   },
 
@@ -123,6 +376,14 @@ export const Shortcut: MessageFns<Shortcut> = {
           message.updatedTs = longToNumber(reader.int64());
           continue;
         }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.rowStatus = rowStatusFromJSON(reader.int32());
+          continue;
+        }
         case 6: {
           if (tag !== 50) {
             break;
@@ -179,6 +440,94 @@ export const Shortcut: MessageFns<Shortcut> = {
           message.ogMetadata = OpenGraphMetadata.decode(reader, reader.uint32());
           continue;
         }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.clickGoal = ClickGoal.decode(reader, reader.uint32());
+          continue;
+        }
+        case 14: {
+          if (tag !== 112) {
+            break;
+          }
+
+          message.expireTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 15: {
+          if (tag !== 120) {
+            break;
+          }
+
+          message.activateTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 16: {
+          if (tag !== 128) {
+            break;
+          }
+
+          message.protected = reader.bool();
+          continue;
+        }
+        case 17: {
+          if (tag !== 136) {
+            break;
+          }
+
+          message.teamId = reader.int32();
+          continue;
+        }
+        case 18: {
+          if (tag !== 144) {
+            break;
+          }
+
+          message.redirectCode = reader.int32();
+          continue;
+        }
+        case 19: {
+          if (tag !== 154) {
+            break;
+          }
+
+          message.linkHealth = LinkHealth.decode(reader, reader.uint32());
+          continue;
+        }
+        case 20: {
+          if (tag !== 160) {
+            break;
+          }
+
+          message.documentMode = documentModeFromJSON(reader.int32());
+          continue;
+        }
+        case 21: {
+          if (tag !== 168) {
+            break;
+          }
+
+          message.internalDescription = reader.bool();
+          continue;
+        }
+        case 22: {
+          if (tag !== 178) {
+            break;
+          }
+
+          message.redirectRules = RedirectRules.decode(reader, reader.uint32());
+          continue;
+        }
+        case 23: {
+          if (tag !== 186) {
+            break;
+          }
+
+          message.variants = Variants.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -197,6 +546,7 @@ export const Shortcut: MessageFns<Shortcut> = {
     message.creatorId = object.creatorId ?? 0;
     message.createdTs = object.createdTs ?? 0;
     message.updatedTs = object.updatedTs ?? 0;
+    message.rowStatus = object.rowStatus ?? RowStatus.ROW_STATUS_UNSPECIFIED;
     message.name = object.name ?? "";
     message.link = object.link ?? "";
     message.title = object.title ?? "";
@@ -206,32 +556,102 @@ export const Shortcut: MessageFns<Shortcut> = {
     message.ogMetadata = (object.ogMetadata !== undefined && object.ogMetadata !== null)
       ? OpenGraphMetadata.fromPartial(object.ogMetadata)
       : undefined;
+    message.clickGoal = (object.clickGoal !== undefined && object.clickGoal !== null)
+      ? ClickGoal.fromPartial(object.clickGoal)
+      : undefined;
+    message.expireTs = object.expireTs ?? 0;
+    message.activateTs = object.activateTs ?? 0;
+    message.protected = object.protected ?? false;
+    message.teamId = object.teamId ?? 0;
+    message.redirectCode = object.redirectCode ?? 0;
+    message.linkHealth = (object.linkHealth !== undefined && object.linkHealth !== null)
+      ? LinkHealth.fromPartial(object.linkHealth)
+      : undefined;
+    message.documentMode = object.documentMode ?? DocumentMode.DOCUMENT_MODE_UNSPECIFIED;
+    message.internalDescription = object.internalDescription ?? false;
+    message.redirectRules = (object.redirectRules !== undefined && object.redirectRules !== null)
+      ? RedirectRules.fromPartial(object.redirectRules)
+      : undefined;
+    message.variants = (object.variants !== undefined && object.variants !== null)
+      ? Variants.fromPartial(object.variants)
+      : undefined;
     return message;
   },
 };
 
-function createBaseOpenGraphMetadata(): OpenGraphMetadata {
-  return { title: "", description: "", image: "" };
+function createBaseRedirectRules(): RedirectRules {
+  return { rules: [] };
 }
 
-export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
-  encode(message: OpenGraphMetadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.title !== "") {
-      writer.uint32(10).string(message.title);
+export const RedirectRules: MessageFns<RedirectRules> = {
+  encode(message: RedirectRules, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.rules) {
+      RedirectRule.encode(v!, writer.uint32(10).fork()).join();
     }
-    if (message.description !== "") {
-      writer.uint32(18).string(message.description);
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RedirectRules {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRedirectRules();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.rules.push(RedirectRule.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
     }
-    if (message.image !== "") {
-      writer.uint32(26).string(message.image);
+    return message;
+  },
+
+  create(base?: DeepPartial<RedirectRules>): RedirectRules {
+    return RedirectRules.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RedirectRules>): RedirectRules {
+    const message = createBaseRedirectRules();
+    message.rules = object.rules?.map((e) => RedirectRule.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRedirectRule(): RedirectRule {
+  return { countries: [], languages: [], devices: [], link: "" };
+}
+
+export const RedirectRule: MessageFns<RedirectRule> = {
+  encode(message: RedirectRule, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.countries) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.languages) {
+      writer.uint32(18).string(v!);
+    }
+    writer.uint32(26).fork();
+    for (const v of message.devices) {
+      writer.int32(deviceTypeToNumber(v));
+    }
+    writer.join();
+    if (message.link !== "") {
+      writer.uint32(34).string(message.link);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): OpenGraphMetadata {
+  decode(input: BinaryReader | Uint8Array, length?: number): RedirectRule {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOpenGraphMetadata();
+    const message = createBaseRedirectRule();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -240,7 +660,7 @@ export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
             break;
           }
 
-          message.title = reader.string();
+          message.countries.push(reader.string());
           continue;
         }
         case 2: {
@@ -248,15 +668,33 @@ export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
             break;
           }
 
-          message.description = reader.string();
+          message.languages.push(reader.string());
           continue;
         }
         case 3: {
-          if (tag !== 26) {
+          if (tag === 24) {
+            message.devices.push(deviceTypeFromJSON(reader.int32()));
+
+            continue;
+          }
+
+          if (tag === 26) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.devices.push(deviceTypeFromJSON(reader.int32()));
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 4: {
+          if (tag !== 34) {
             break;
           }
 
-          message.image = reader.string();
+          message.link = reader.string();
           continue;
         }
       }
@@ -268,14 +706,615 @@ export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
     return message;
   },
 
-  create(base?: DeepPartial<OpenGraphMetadata>): OpenGraphMetadata {
-    return OpenGraphMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<RedirectRule>): RedirectRule {
+    return RedirectRule.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<OpenGraphMetadata>): OpenGraphMetadata {
-    const message = createBaseOpenGraphMetadata();
-    message.title = object.title ?? "";
-    message.description = object.description ?? "";
-    message.image = object.image ?? "";
+  fromPartial(object: DeepPartial<RedirectRule>): RedirectRule {
+    const message = createBaseRedirectRule();
+    message.countries = object.countries?.map((e) => e) || [];
+    message.languages = object.languages?.map((e) => e) || [];
+    message.devices = object.devices?.map((e) => e) || [];
+    message.link = object.link ?? "";
+    return message;
+  },
+};
+
+function createBaseVariants(): Variants {
+  return { variants: [] };
+}
+
+export const Variants: MessageFns<Variants> = {
+  encode(message: Variants, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.variants) {
+      Variant.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Variants {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVariants();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.variants.push(Variant.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Variants>): Variants {
+    return Variants.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Variants>): Variants {
+    const message = createBaseVariants();
+    message.variants = object.variants?.map((e) => Variant.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseVariant(): Variant {
+  return { name: "", link: "", weight: 0 };
+}
+
+export const Variant: MessageFns<Variant> = {
+  encode(message: Variant, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.link !== "") {
+      writer.uint32(18).string(message.link);
+    }
+    if (message.weight !== 0) {
+      writer.uint32(24).int32(message.weight);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Variant {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVariant();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.weight = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Variant>): Variant {
+    return Variant.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Variant>): Variant {
+    const message = createBaseVariant();
+    message.name = object.name ?? "";
+    message.link = object.link ?? "";
+    message.weight = object.weight ?? 0;
+    return message;
+  },
+};
+
+function createBaseLinkHealth(): LinkHealth {
+  return { checkedTs: 0, statusCode: 0, error: "", failureCount: 0 };
+}
+
+export const LinkHealth: MessageFns<LinkHealth> = {
+  encode(message: LinkHealth, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.checkedTs !== 0) {
+      writer.uint32(8).int64(message.checkedTs);
+    }
+    if (message.statusCode !== 0) {
+      writer.uint32(16).int32(message.statusCode);
+    }
+    if (message.error !== "") {
+      writer.uint32(26).string(message.error);
+    }
+    if (message.failureCount !== 0) {
+      writer.uint32(32).int32(message.failureCount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): LinkHealth {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLinkHealth();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.checkedTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.statusCode = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.failureCount = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<LinkHealth>): LinkHealth {
+    return LinkHealth.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LinkHealth>): LinkHealth {
+    const message = createBaseLinkHealth();
+    message.checkedTs = object.checkedTs ?? 0;
+    message.statusCode = object.statusCode ?? 0;
+    message.error = object.error ?? "";
+    message.failureCount = object.failureCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseShortcutProposedChangePayload(): ShortcutProposedChangePayload {
+  return { paths: [], previous: undefined, proposed: undefined };
+}
+
+export const ShortcutProposedChangePayload: MessageFns<ShortcutProposedChangePayload> = {
+  encode(message: ShortcutProposedChangePayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.paths) {
+      writer.uint32(10).string(v!);
+    }
+    if (message.previous !== undefined) {
+      ShortcutContent.encode(message.previous, writer.uint32(18).fork()).join();
+    }
+    if (message.proposed !== undefined) {
+      ShortcutContent.encode(message.proposed, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutProposedChangePayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutProposedChangePayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.paths.push(reader.string());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.previous = ShortcutContent.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.proposed = ShortcutContent.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutProposedChangePayload>): ShortcutProposedChangePayload {
+    return ShortcutProposedChangePayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutProposedChangePayload>): ShortcutProposedChangePayload {
+    const message = createBaseShortcutProposedChangePayload();
+    message.paths = object.paths?.map((e) => e) || [];
+    message.previous = (object.previous !== undefined && object.previous !== null)
+      ? ShortcutContent.fromPartial(object.previous)
+      : undefined;
+    message.proposed = (object.proposed !== undefined && object.proposed !== null)
+      ? ShortcutContent.fromPartial(object.proposed)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseShortcutContent(): ShortcutContent {
+  return { name: "", link: "", title: "", description: "", tags: [], visibility: Visibility.VISIBILITY_UNSPECIFIED };
+}
+
+export const ShortcutContent: MessageFns<ShortcutContent> = {
+  encode(message: ShortcutContent, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.link !== "") {
+      writer.uint32(18).string(message.link);
+    }
+    if (message.title !== "") {
+      writer.uint32(26).string(message.title);
+    }
+    if (message.description !== "") {
+      writer.uint32(34).string(message.description);
+    }
+    for (const v of message.tags) {
+      writer.uint32(42).string(v!);
+    }
+    if (message.visibility !== Visibility.VISIBILITY_UNSPECIFIED) {
+      writer.uint32(48).int32(visibilityToNumber(message.visibility));
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutContent {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutContent();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.visibility = visibilityFromJSON(reader.int32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutContent>): ShortcutContent {
+    return ShortcutContent.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutContent>): ShortcutContent {
+    const message = createBaseShortcutContent();
+    message.name = object.name ?? "";
+    message.link = object.link ?? "";
+    message.title = object.title ?? "";
+    message.description = object.description ?? "";
+    message.tags = object.tags?.map((e) => e) || [];
+    message.visibility = object.visibility ?? Visibility.VISIBILITY_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseOpenGraphMetadata(): OpenGraphMetadata {
+  return { title: "", description: "", image: "", queryParams: [], favicon: "" };
+}
+
+export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
+  encode(message: OpenGraphMetadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
+    }
+    if (message.description !== "") {
+      writer.uint32(18).string(message.description);
+    }
+    if (message.image !== "") {
+      writer.uint32(26).string(message.image);
+    }
+    for (const v of message.queryParams) {
+      QueryParam.encode(v!, writer.uint32(34).fork()).join();
+    }
+    if (message.favicon !== "") {
+      writer.uint32(42).string(message.favicon);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): OpenGraphMetadata {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOpenGraphMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.image = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.queryParams.push(QueryParam.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.favicon = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<OpenGraphMetadata>): OpenGraphMetadata {
+    return OpenGraphMetadata.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<OpenGraphMetadata>): OpenGraphMetadata {
+    const message = createBaseOpenGraphMetadata();
+    message.title = object.title ?? "";
+    message.description = object.description ?? "";
+    message.image = object.image ?? "";
+    message.queryParams = object.queryParams?.map((e) => QueryParam.fromPartial(e)) || [];
+    message.favicon = object.favicon ?? "";
+    return message;
+  },
+};
+
+function createBaseQueryParam(): QueryParam {
+  return { key: "", value: "" };
+}
+
+export const QueryParam: MessageFns<QueryParam> = {
+  encode(message: QueryParam, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): QueryParam {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryParam();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<QueryParam>): QueryParam {
+    return QueryParam.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<QueryParam>): QueryParam {
+    const message = createBaseQueryParam();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseClickGoal(): ClickGoal {
+  return { target: 0, webhookUrl: "", reachedTs: 0 };
+}
+
+export const ClickGoal: MessageFns<ClickGoal> = {
+  encode(message: ClickGoal, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.target !== 0) {
+      writer.uint32(8).int32(message.target);
+    }
+    if (message.webhookUrl !== "") {
+      writer.uint32(18).string(message.webhookUrl);
+    }
+    if (message.reachedTs !== 0) {
+      writer.uint32(24).int64(message.reachedTs);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ClickGoal {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseClickGoal();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.target = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.webhookUrl = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.reachedTs = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ClickGoal>): ClickGoal {
+    return ClickGoal.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ClickGoal>): ClickGoal {
+    const message = createBaseClickGoal();
+    message.target = object.target ?? 0;
+    message.webhookUrl = object.webhookUrl ?? "";
+    message.reachedTs = object.reachedTs ?? 0;
     return message;
   },
 };
@@ -15,6 +15,8 @@ export enum UserSettingKey {
   USER_SETTING_GENERAL = "USER_SETTING_GENERAL",
   /** USER_SETTING_ACCESS_TOKENS - User access tokens. */
   USER_SETTING_ACCESS_TOKENS = "USER_SETTING_ACCESS_TOKENS",
+  /** USER_SETTING_PASSKEYS - User passkeys. */
+  USER_SETTING_PASSKEYS = "USER_SETTING_PASSKEYS",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -29,6 +31,9 @@ export function userSettingKeyFromJSON(object: any): UserSettingKey {
     case 2:
     case "USER_SETTING_ACCESS_TOKENS":
       return UserSettingKey.USER_SETTING_ACCESS_TOKENS;
+    case 4:
+    case "USER_SETTING_PASSKEYS":
+      return UserSettingKey.USER_SETTING_PASSKEYS;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -44,6 +49,8 @@ export function userSettingKeyToNumber(object: UserSettingKey): number {
       return 1;
     case UserSettingKey.USER_SETTING_ACCESS_TOKENS:
       return 2;
+    case UserSettingKey.USER_SETTING_PASSKEYS:
+      return 4;
     case UserSettingKey.UNRECOGNIZED:
     default:
       return -1;
@@ -55,11 +62,19 @@ export interface UserSetting {
   key: UserSettingKey;
   general?: UserSetting_GeneralSetting | undefined;
   accessTokens?: UserSetting_AccessTokensSetting | undefined;
+  passkeys?: UserSetting_PasskeysSetting | undefined;
 }
 
 export interface UserSetting_GeneralSetting {
   locale: string;
   colorTheme: string;
+  /** Whether the public profile page of the user is disabled. */
+  disablePublicProfile: boolean;
+  /**
+   * Whether to confirm the redirects to the external domains of the workspace, "ALWAYS" or "NEVER".
+   * "WORKSPACE" or empty follows the workspace.
+   */
+  externalRedirectConfirmation: string;
 }
 
 export interface UserSetting_AccessTokensSetting {
@@ -68,14 +83,69 @@ export interface UserSetting_AccessTokensSetting {
 }
 
 export interface UserSetting_AccessTokensSetting_AccessToken {
-  /** The access token is a JWT token, including expiration time, issuer, etc. */
+  /**
+   * The access token is a JWT token, including expiration time, issuer, etc.
+   * Deprecated: the access tokens are no longer stored in plaintext, the stored ones are
+   * replaced by their hash on startup.
+   */
   accessToken: string;
   /** A description for the access token. */
   description: string;
+  /** The last time the access token was used, in unix seconds. */
+  lastUsedTs: number;
+  /** The scopes the access token is restricted to, e.g. "shortcuts:read". Empty means full access. */
+  scopes: string[];
+  /** The id of the sign-in session the access token is issued on, 0 for the access tokens created by the user. */
+  sessionId: number;
+  /** The id of the admin acting as the user with the access token, 0 if it's not an impersonation. */
+  impersonatorId: number;
+  /** The salted SHA-256 hash of the access token in hex, which also identifies it. */
+  accessTokenHash: string;
+  /** The random salt of the hash in hex. */
+  salt: string;
+  /** The time the access token was issued, in unix seconds. */
+  issuedTs: number;
+  /** The time the access token expires, in unix seconds. 0 means never. */
+  expiresTs: number;
+}
+
+export interface UserSetting_PasskeysSetting {
+  passkeys: UserSetting_PasskeysSetting_Passkey[];
+}
+
+/** Passkey is a WebAuthn credential of the user. */
+export interface UserSetting_PasskeysSetting_Passkey {
+  /** The credential id. */
+  id: Uint8Array;
+  /** The COSE encoded public key of the credential. */
+  publicKey: Uint8Array;
+  attestationType: string;
+  transports: string[];
+  userPresent: boolean;
+  userVerified: boolean;
+  backupEligible: boolean;
+  backupState: boolean;
+  /** The AAGUID of the authenticator model. */
+  aaguid: Uint8Array;
+  /** The signature counter of the authenticator, to detect cloned authenticators. */
+  signCount: number;
+  attachment: string;
+  /** A name for the passkey, e.g. the device. */
+  name: string;
+  /** The time the passkey was registered, in unix seconds. */
+  createdTs: number;
+  /** The last time the passkey was used to sign in, in unix seconds. */
+  lastUsedTs: number;
 }
 
 function createBaseUserSetting(): UserSetting {
-  return { userId: 0, key: UserSettingKey.USER_SETTING_KEY_UNSPECIFIED, general: undefined, accessTokens: undefined };
+  return {
+    userId: 0,
+    key: UserSettingKey.USER_SETTING_KEY_UNSPECIFIED,
+    general: undefined,
+    accessTokens: undefined,
+    passkeys: undefined,
+  };
 }
 
 export const UserSetting: MessageFns<UserSetting> = {
@@ -92,6 +162,9 @@ export const UserSetting: MessageFns<UserSetting> = {
     if (message.accessTokens !== undefined) {
       UserSetting_AccessTokensSetting.encode(message.accessTokens, writer.uint32(34).fork()).join();
     }
+    if (message.passkeys !== undefined) {
+      UserSetting_PasskeysSetting.encode(message.passkeys, writer.uint32(50).fork()).join();
+    }
     return writer;
   },
 
@@ -134,6 +207,14 @@ export const UserSetting: MessageFns<UserSetting> = {
           message.accessTokens = UserSetting_AccessTokensSetting.decode(reader, reader.uint32());
           continue;
         }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.passkeys = UserSetting_PasskeysSetting.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -156,12 +237,15 @@ export const UserSetting: MessageFns<UserSetting> = {
     message.accessTokens = (object.accessTokens !== undefined && object.accessTokens !== null)
       ? UserSetting_AccessTokensSetting.fromPartial(object.accessTokens)
       : undefined;
+    message.passkeys = (object.passkeys !== undefined && object.passkeys !== null)
+      ? UserSetting_PasskeysSetting.fromPartial(object.passkeys)
+      : undefined;
     return message;
   },
 };
 
 function createBaseUserSetting_GeneralSetting(): UserSetting_GeneralSetting {
-  return { locale: "", colorTheme: "" };
+  return { locale: "", colorTheme: "", disablePublicProfile: false, externalRedirectConfirmation: "" };
 }
 
 export const UserSetting_GeneralSetting: MessageFns<UserSetting_GeneralSetting> = {
@@ -172,6 +256,12 @@ export const UserSetting_GeneralSetting: MessageFns<UserSetting_GeneralSetting>
     if (message.colorTheme !== "") {
       writer.uint32(18).string(message.colorTheme);
     }
+    if (message.disablePublicProfile !== false) {
+      writer.uint32(24).bool(message.disablePublicProfile);
+    }
+    if (message.externalRedirectConfirmation !== "") {
+      writer.uint32(34).string(message.externalRedirectConfirmation);
+    }
     return writer;
   },
 
@@ -198,6 +288,22 @@ export const UserSetting_GeneralSetting: MessageFns<UserSetting_GeneralSetting>
           message.colorTheme = reader.string();
           continue;
         }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.disablePublicProfile = reader.bool();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.externalRedirectConfirmation = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -214,6 +320,8 @@ export const UserSetting_GeneralSetting: MessageFns<UserSetting_GeneralSetting>
     const message = createBaseUserSetting_GeneralSetting();
     message.locale = object.locale ?? "";
     message.colorTheme = object.colorTheme ?? "";
+    message.disablePublicProfile = object.disablePublicProfile ?? false;
+    message.externalRedirectConfirmation = object.externalRedirectConfirmation ?? "";
     return message;
   },
 };
@@ -266,7 +374,18 @@ export const UserSetting_AccessTokensSetting: MessageFns<UserSetting_AccessToken
 };
 
 function createBaseUserSetting_AccessTokensSetting_AccessToken(): UserSetting_AccessTokensSetting_AccessToken {
-  return { accessToken: "", description: "" };
+  return {
+    accessToken: "",
+    description: "",
+    lastUsedTs: 0,
+    scopes: [],
+    sessionId: 0,
+    impersonatorId: 0,
+    accessTokenHash: "",
+    salt: "",
+    issuedTs: 0,
+    expiresTs: 0,
+  };
 }
 
 export const UserSetting_AccessTokensSetting_AccessToken: MessageFns<UserSetting_AccessTokensSetting_AccessToken> = {
@@ -280,6 +399,30 @@ export const UserSetting_AccessTokensSetting_AccessToken: MessageFns<UserSetting
     if (message.description !== "") {
       writer.uint32(18).string(message.description);
     }
+    if (message.lastUsedTs !== 0) {
+      writer.uint32(24).int64(message.lastUsedTs);
+    }
+    for (const v of message.scopes) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.sessionId !== 0) {
+      writer.uint32(40).int32(message.sessionId);
+    }
+    if (message.impersonatorId !== 0) {
+      writer.uint32(48).int32(message.impersonatorId);
+    }
+    if (message.accessTokenHash !== "") {
+      writer.uint32(58).string(message.accessTokenHash);
+    }
+    if (message.salt !== "") {
+      writer.uint32(66).string(message.salt);
+    }
+    if (message.issuedTs !== 0) {
+      writer.uint32(72).int64(message.issuedTs);
+    }
+    if (message.expiresTs !== 0) {
+      writer.uint32(80).int64(message.expiresTs);
+    }
     return writer;
   },
 
@@ -306,6 +449,70 @@ export const UserSetting_AccessTokensSetting_AccessToken: MessageFns<UserSetting
           message.description = reader.string();
           continue;
         }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.lastUsedTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.scopes.push(reader.string());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.sessionId = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.impersonatorId = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.accessTokenHash = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.salt = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.issuedTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 10: {
+          if (tag !== 80) {
+            break;
+          }
+
+          message.expiresTs = longToNumber(reader.int64());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -324,6 +531,277 @@ export const UserSetting_AccessTokensSetting_AccessToken: MessageFns<UserSetting
     const message = createBaseUserSetting_AccessTokensSetting_AccessToken();
     message.accessToken = object.accessToken ?? "";
     message.description = object.description ?? "";
+    message.lastUsedTs = object.lastUsedTs ?? 0;
+    message.scopes = object.scopes?.map((e) => e) || [];
+    message.sessionId = object.sessionId ?? 0;
+    message.impersonatorId = object.impersonatorId ?? 0;
+    message.accessTokenHash = object.accessTokenHash ?? "";
+    message.salt = object.salt ?? "";
+    message.issuedTs = object.issuedTs ?? 0;
+    message.expiresTs = object.expiresTs ?? 0;
+    return message;
+  },
+};
+
+function createBaseUserSetting_PasskeysSetting(): UserSetting_PasskeysSetting {
+  return { passkeys: [] };
+}
+
+export const UserSetting_PasskeysSetting: MessageFns<UserSetting_PasskeysSetting> = {
+  encode(message: UserSetting_PasskeysSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.passkeys) {
+      UserSetting_PasskeysSetting_Passkey.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UserSetting_PasskeysSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserSetting_PasskeysSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.passkeys.push(UserSetting_PasskeysSetting_Passkey.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UserSetting_PasskeysSetting>): UserSetting_PasskeysSetting {
+    return UserSetting_PasskeysSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UserSetting_PasskeysSetting>): UserSetting_PasskeysSetting {
+    const message = createBaseUserSetting_PasskeysSetting();
+    message.passkeys = object.passkeys?.map((e) => UserSetting_PasskeysSetting_Passkey.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseUserSetting_PasskeysSetting_Passkey(): UserSetting_PasskeysSetting_Passkey {
+  return {
+    id: new Uint8Array(0),
+    publicKey: new Uint8Array(0),
+    attestationType: "",
+    transports: [],
+    userPresent: false,
+    userVerified: false,
+    backupEligible: false,
+    backupState: false,
+    aaguid: new Uint8Array(0),
+    signCount: 0,
+    attachment: "",
+    name: "",
+    createdTs: 0,
+    lastUsedTs: 0,
+  };
+}
+
+export const UserSetting_PasskeysSetting_Passkey: MessageFns<UserSetting_PasskeysSetting_Passkey> = {
+  encode(message: UserSetting_PasskeysSetting_Passkey, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id.length !== 0) {
+      writer.uint32(10).bytes(message.id);
+    }
+    if (message.publicKey.length !== 0) {
+      writer.uint32(18).bytes(message.publicKey);
+    }
+    if (message.attestationType !== "") {
+      writer.uint32(26).string(message.attestationType);
+    }
+    for (const v of message.transports) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.userPresent !== false) {
+      writer.uint32(40).bool(message.userPresent);
+    }
+    if (message.userVerified !== false) {
+      writer.uint32(48).bool(message.userVerified);
+    }
+    if (message.backupEligible !== false) {
+      writer.uint32(56).bool(message.backupEligible);
+    }
+    if (message.backupState !== false) {
+      writer.uint32(64).bool(message.backupState);
+    }
+    if (message.aaguid.length !== 0) {
+      writer.uint32(74).bytes(message.aaguid);
+    }
+    if (message.signCount !== 0) {
+      writer.uint32(80).uint32(message.signCount);
+    }
+    if (message.attachment !== "") {
+      writer.uint32(90).string(message.attachment);
+    }
+    if (message.name !== "") {
+      writer.uint32(98).string(message.name);
+    }
+    if (message.createdTs !== 0) {
+      writer.uint32(104).int64(message.createdTs);
+    }
+    if (message.lastUsedTs !== 0) {
+      writer.uint32(112).int64(message.lastUsedTs);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UserSetting_PasskeysSetting_Passkey {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserSetting_PasskeysSetting_Passkey();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.bytes();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.publicKey = reader.bytes();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.attestationType = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.transports.push(reader.string());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.userPresent = reader.bool();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.userVerified = reader.bool();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.backupEligible = reader.bool();
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.backupState = reader.bool();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.aaguid = reader.bytes();
+          continue;
+        }
+        case 10: {
+          if (tag !== 80) {
+            break;
+          }
+
+          message.signCount = reader.uint32();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.attachment = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 13: {
+          if (tag !== 104) {
+            break;
+          }
+
+          message.createdTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 14: {
+          if (tag !== 112) {
+            break;
+          }
+
+          message.lastUsedTs = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<UserSetting_PasskeysSetting_Passkey>): UserSetting_PasskeysSetting_Passkey {
+    return UserSetting_PasskeysSetting_Passkey.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UserSetting_PasskeysSetting_Passkey>): UserSetting_PasskeysSetting_Passkey {
+    const message = createBaseUserSetting_PasskeysSetting_Passkey();
+    message.id = object.id ?? new Uint8Array(0);
+    message.publicKey = object.publicKey ?? new Uint8Array(0);
+    message.attestationType = object.attestationType ?? "";
+    message.transports = object.transports?.map((e) => e) || [];
+    message.userPresent = object.userPresent ?? false;
+    message.userVerified = object.userVerified ?? false;
+    message.backupEligible = object.backupEligible ?? false;
+    message.backupState = object.backupState ?? false;
+    message.aaguid = object.aaguid ?? new Uint8Array(0);
+    message.signCount = object.signCount ?? 0;
+    message.attachment = object.attachment ?? "";
+    message.name = object.name ?? "";
+    message.createdTs = object.createdTs ?? 0;
+    message.lastUsedTs = object.lastUsedTs ?? 0;
     return message;
   },
 };
@@ -336,6 +814,17 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function longToNumber(int64: { toString(): string }): number {
+  const num = globalThis.Number(int64.toString());
+  if (num > globalThis.Number.MAX_SAFE_INTEGER) {
+    throw new globalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  if (num < globalThis.Number.MIN_SAFE_INTEGER) {
+    throw new globalThis.Error("Value is smaller than Number.MIN_SAFE_INTEGER");
+  }
+  return num;
+}
+
 export interface MessageFns<T> {
   encode(message: T, writer?: BinaryWriter): BinaryWriter;
   decode(input: BinaryReader | Uint8Array, length?: number): T;
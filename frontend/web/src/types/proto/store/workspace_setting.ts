@@ -6,6 +6,7 @@
 
 /* eslint-disable */
 import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
+import { CollectionTemplate } from "./collection";
 import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
 import { IdentityProvider } from "./idp";
 
@@ -21,6 +22,20 @@ export enum WorkspaceSettingKey {
   WORKSPACE_SETTING_SHORTCUT_RELATED = "WORKSPACE_SETTING_SHORTCUT_RELATED",
   /** WORKSPACE_SETTING_IDENTITY_PROVIDER - Workspace identity provider settings. */
   WORKSPACE_SETTING_IDENTITY_PROVIDER = "WORKSPACE_SETTING_IDENTITY_PROVIDER",
+  /** WORKSPACE_SETTING_GIT_SYNC - Workspace git sync settings. */
+  WORKSPACE_SETTING_GIT_SYNC = "WORKSPACE_SETTING_GIT_SYNC",
+  /** WORKSPACE_SETTING_NOT_FOUND - Workspace settings of the missing shortcuts. */
+  WORKSPACE_SETTING_NOT_FOUND = "WORKSPACE_SETTING_NOT_FOUND",
+  /** WORKSPACE_SETTING_COLLECTION_TEMPLATE - Workspace collection template settings. */
+  WORKSPACE_SETTING_COLLECTION_TEMPLATE = "WORKSPACE_SETTING_COLLECTION_TEMPLATE",
+  /** WORKSPACE_SETTING_FEDERATION - Workspace federation settings. */
+  WORKSPACE_SETTING_FEDERATION = "WORKSPACE_SETTING_FEDERATION",
+  /** WORKSPACE_SETTING_MAIL - Workspace mail settings. */
+  WORKSPACE_SETTING_MAIL = "WORKSPACE_SETTING_MAIL",
+  /** WORKSPACE_SETTING_LANDING - Workspace settings of the root path. */
+  WORKSPACE_SETTING_LANDING = "WORKSPACE_SETTING_LANDING",
+  /** WORKSPACE_SETTING_COMPLETION - Workspace settings of the suggestions of the shortcut metadata. */
+  WORKSPACE_SETTING_COMPLETION = "WORKSPACE_SETTING_COMPLETION",
   /**
    * WORKSPACE_SETTING_LICENSE_KEY - TODO: remove the following keys.
    * The license key.
@@ -52,6 +67,27 @@ export function workspaceSettingKeyFromJSON(object: any): WorkspaceSettingKey {
     case 4:
     case "WORKSPACE_SETTING_IDENTITY_PROVIDER":
       return WorkspaceSettingKey.WORKSPACE_SETTING_IDENTITY_PROVIDER;
+    case 5:
+    case "WORKSPACE_SETTING_GIT_SYNC":
+      return WorkspaceSettingKey.WORKSPACE_SETTING_GIT_SYNC;
+    case 6:
+    case "WORKSPACE_SETTING_NOT_FOUND":
+      return WorkspaceSettingKey.WORKSPACE_SETTING_NOT_FOUND;
+    case 7:
+    case "WORKSPACE_SETTING_COLLECTION_TEMPLATE":
+      return WorkspaceSettingKey.WORKSPACE_SETTING_COLLECTION_TEMPLATE;
+    case 8:
+    case "WORKSPACE_SETTING_FEDERATION":
+      return WorkspaceSettingKey.WORKSPACE_SETTING_FEDERATION;
+    case 9:
+    case "WORKSPACE_SETTING_MAIL":
+      return WorkspaceSettingKey.WORKSPACE_SETTING_MAIL;
+    case 14:
+    case "WORKSPACE_SETTING_LANDING":
+      return WorkspaceSettingKey.WORKSPACE_SETTING_LANDING;
+    case 15:
+    case "WORKSPACE_SETTING_COMPLETION":
+      return WorkspaceSettingKey.WORKSPACE_SETTING_COMPLETION;
     case 10:
     case "WORKSPACE_SETTING_LICENSE_KEY":
       return WorkspaceSettingKey.WORKSPACE_SETTING_LICENSE_KEY;
@@ -83,6 +119,20 @@ export function workspaceSettingKeyToNumber(object: WorkspaceSettingKey): number
       return 3;
     case WorkspaceSettingKey.WORKSPACE_SETTING_IDENTITY_PROVIDER:
       return 4;
+    case WorkspaceSettingKey.WORKSPACE_SETTING_GIT_SYNC:
+      return 5;
+    case WorkspaceSettingKey.WORKSPACE_SETTING_NOT_FOUND:
+      return 6;
+    case WorkspaceSettingKey.WORKSPACE_SETTING_COLLECTION_TEMPLATE:
+      return 7;
+    case WorkspaceSettingKey.WORKSPACE_SETTING_FEDERATION:
+      return 8;
+    case WorkspaceSettingKey.WORKSPACE_SETTING_MAIL:
+      return 9;
+    case WorkspaceSettingKey.WORKSPACE_SETTING_LANDING:
+      return 14;
+    case WorkspaceSettingKey.WORKSPACE_SETTING_COMPLETION:
+      return 15;
     case WorkspaceSettingKey.WORKSPACE_SETTING_LICENSE_KEY:
       return 10;
     case WorkspaceSettingKey.WORKSPACE_SETTING_SECRET_SESSION:
@@ -104,6 +154,13 @@ export interface WorkspaceSetting {
   security?: WorkspaceSetting_SecuritySetting | undefined;
   shortcutRelated?: WorkspaceSetting_ShortcutRelatedSetting | undefined;
   identityProvider?: WorkspaceSetting_IdentityProviderSetting | undefined;
+  gitSync?: WorkspaceSetting_GitSyncSetting | undefined;
+  notFound?: WorkspaceSetting_NotFoundSetting | undefined;
+  collectionTemplate?: WorkspaceSetting_CollectionTemplateSetting | undefined;
+  federation?: WorkspaceSetting_FederationSetting | undefined;
+  mail?: WorkspaceSetting_MailSetting | undefined;
+  landing?: WorkspaceSetting_LandingSetting | undefined;
+  completion?: WorkspaceSetting_CompletionSetting | undefined;
 }
 
 export interface WorkspaceSetting_GeneralSetting {
@@ -117,16 +174,266 @@ export interface WorkspaceSetting_GeneralSetting {
 export interface WorkspaceSetting_SecuritySetting {
   disallowUserRegistration: boolean;
   disallowPasswordAuth: boolean;
+  /** Access tokens unused for this many days are revoked. 0 disables the policy. */
+  accessTokenInactivityDays: number;
+  /**
+   * The user the shortcuts and collections are transferred to when their creator deletes their account.
+   * 0 means the users can only delete their data with their account.
+   */
+  accountHandoverUserId: number;
 }
 
 export interface WorkspaceSetting_ShortcutRelatedSetting {
   defaultVisibility: Visibility;
+  anomalyAlert?: WorkspaceSetting_AnomalyAlertSetting | undefined;
+  linkParamRules?:
+    | WorkspaceSetting_LinkParamRules
+    | undefined;
+  /**
+   * The window in seconds within which the repeated views of a shortcut from the same IP and user agent are counted once.
+   * 0 counts every view.
+   */
+  viewDedupeWindowSeconds: number;
+  /** Whether to record the signed-in users viewing the shortcuts, to see who uses them in the analytics. */
+  attributeViewsToUsers: boolean;
+  /**
+   * The HTTP status code of the redirects of the shortcuts without one, e.g. 302.
+   * 0 serves the shortcut page, which redirects in the browser with the Open Graph metadata for link previews.
+   */
+  defaultRedirectCode: number;
+  /** The domains of the internal links, e.g. "example.com", which also covers its subdomains. */
+  internalDomains: string[];
+  /** Whether to confirm the redirects to the domains outside of the internal domains, unless the user opts out. */
+  confirmExternalRedirects: boolean;
+  linkHealthCheck?:
+    | WorkspaceSetting_LinkHealthCheckSetting
+    | undefined;
+  /** The names the shortcuts can't take, in addition to the routes of Slash, e.g. "admin". */
+  reservedNames: string[];
+}
+
+export interface WorkspaceSetting_LinkHealthCheckSetting {
+  /** Whether to check the links of the shortcuts daily. */
+  enabled: boolean;
+  /** The webhook url to post the newly broken links to. */
+  webhookUrl: string;
+  /** Whether to email the creators of the shortcuts with newly broken links, with the SMTP server of the workspace. */
+  notifyCreators: boolean;
+}
+
+export interface WorkspaceSetting_LinkParamRules {
+  /**
+   * The patterns of the query parameters to strip from the links when saving the shortcuts,
+   * where "*" matches any characters, e.g. "utm_*" and "fbclid".
+   */
+  deny: string[];
+  /** The patterns of the query parameters to keep even if they match the deny patterns, e.g. "utm_id". */
+  allow: string[];
+}
+
+export interface WorkspaceSetting_AnomalyAlertSetting {
+  /** Whether to detect traffic spikes and drops of shortcuts. */
+  enabled: boolean;
+  /** The webhook url to post the alerts to. Alerts are only recorded as activities when empty. */
+  webhookUrl: string;
+  /** The z-score of the hourly views to be an anomaly. Defaults to 3. */
+  zScoreThreshold: number;
+  /** The min hourly views of a spike, or of the baseline of a drop, to filter out noise. Defaults to 10. */
+  minViews: number;
 }
 
 export interface WorkspaceSetting_IdentityProviderSetting {
   identityProviders: IdentityProvider[];
 }
 
+export interface WorkspaceSetting_GitSyncSetting {
+  /** Whether to sync the shortcuts from the file in the GitHub repository. */
+  enabled: boolean;
+  /** The GitHub repository in the format of "owner/repo". */
+  repository: string;
+  /** The branch of the file. Defaults to "main". */
+  branch: string;
+  /** The path of the YAML/JSON file of the shortcut definitions. */
+  path: string;
+  /** The GitHub token to read the file and to open pull requests. */
+  accessToken: string;
+  /** The secret to verify the signatures of the GitHub push webhooks. */
+  webhookSecret: string;
+  /** Whether to propose the manual changes of the synced shortcuts as pull requests. */
+  writeBack: boolean;
+  /** The blob sha of the last applied file. */
+  lastSyncedSha: string;
+  /** The names of the shortcuts managed by the file. */
+  managedShortcuts: string[];
+}
+
+export interface WorkspaceSetting_NotFoundSetting {
+  /**
+   * The url to redirect to when the shortcut doesn't exist, where `{name}` is replaced by the shortcut name.
+   * Empty shows the not found page.
+   */
+  redirectUrl: string;
+  /** The message of the not found page. */
+  message: string;
+  /** Whether to hide the offer to create the missing shortcut from the signed-in users. */
+  disableCreateOffer: boolean;
+}
+
+export interface WorkspaceSetting_CollectionTemplateSetting {
+  /** The admin-defined templates, in addition to the built-in ones. */
+  templates: CollectionTemplate[];
+}
+
+export interface WorkspaceSetting_MailSetting {
+  /** The SMTP server to send the emails with. Empty host disables the emails. */
+  smtpHost: string;
+  smtpPort: number;
+  smtpUsername: string;
+  smtpPassword: string;
+  smtpEncryption: WorkspaceSetting_MailSetting_Encryption;
+  /** The sender address, e.g. "Slash <noreply@example.com>". */
+  from: string;
+  /** Whether the users signing up with a password have to verify their email before using Slash. */
+  requireEmailVerification: boolean;
+}
+
+export enum WorkspaceSetting_MailSetting_Encryption {
+  ENCRYPTION_UNSPECIFIED = "ENCRYPTION_UNSPECIFIED",
+  SSL_TLS = "SSL_TLS",
+  STARTTLS = "STARTTLS",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function workspaceSetting_MailSetting_EncryptionFromJSON(object: any): WorkspaceSetting_MailSetting_Encryption {
+  switch (object) {
+    case 0:
+    case "ENCRYPTION_UNSPECIFIED":
+      return WorkspaceSetting_MailSetting_Encryption.ENCRYPTION_UNSPECIFIED;
+    case 1:
+    case "SSL_TLS":
+      return WorkspaceSetting_MailSetting_Encryption.SSL_TLS;
+    case 2:
+    case "STARTTLS":
+      return WorkspaceSetting_MailSetting_Encryption.STARTTLS;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return WorkspaceSetting_MailSetting_Encryption.UNRECOGNIZED;
+  }
+}
+
+export function workspaceSetting_MailSetting_EncryptionToNumber(object: WorkspaceSetting_MailSetting_Encryption): number {
+  switch (object) {
+    case WorkspaceSetting_MailSetting_Encryption.ENCRYPTION_UNSPECIFIED:
+      return 0;
+    case WorkspaceSetting_MailSetting_Encryption.SSL_TLS:
+      return 1;
+    case WorkspaceSetting_MailSetting_Encryption.STARTTLS:
+      return 2;
+    case WorkspaceSetting_MailSetting_Encryption.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface WorkspaceSetting_LandingSetting {
+  /** What the root path serves to the visitors. */
+  type: WorkspaceSetting_LandingSetting_Type;
+  /** The name of the collection to redirect to. */
+  collectionName: string;
+  /** The HTML of the custom page, e.g. a marketing page. */
+  pageHtml: string;
+  /** The external url to redirect to, e.g. the intranet. */
+  redirectUrl: string;
+  /** Whether the signed-in users get the landing too, instead of the app. */
+  applyToSignedInUsers: boolean;
+}
+
+export enum WorkspaceSetting_LandingSetting_Type {
+  /** TYPE_UNSPECIFIED - Serves the app. */
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  COLLECTION = "COLLECTION",
+  PAGE = "PAGE",
+  REDIRECT = "REDIRECT",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function workspaceSetting_LandingSetting_TypeFromJSON(object: any): WorkspaceSetting_LandingSetting_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return WorkspaceSetting_LandingSetting_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "COLLECTION":
+      return WorkspaceSetting_LandingSetting_Type.COLLECTION;
+    case 2:
+    case "PAGE":
+      return WorkspaceSetting_LandingSetting_Type.PAGE;
+    case 3:
+    case "REDIRECT":
+      return WorkspaceSetting_LandingSetting_Type.REDIRECT;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return WorkspaceSetting_LandingSetting_Type.UNRECOGNIZED;
+  }
+}
+
+export function workspaceSetting_LandingSetting_TypeToNumber(object: WorkspaceSetting_LandingSetting_Type): number {
+  switch (object) {
+    case WorkspaceSetting_LandingSetting_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case WorkspaceSetting_LandingSetting_Type.COLLECTION:
+      return 1;
+    case WorkspaceSetting_LandingSetting_Type.PAGE:
+      return 2;
+    case WorkspaceSetting_LandingSetting_Type.REDIRECT:
+      return 3;
+    case WorkspaceSetting_LandingSetting_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface WorkspaceSetting_CompletionSetting {
+  /** The base url of the OpenAI-compatible API, e.g. "https://api.openai.com/v1". Empty disables the suggestions. */
+  endpoint: string;
+  /** The API key sent as a bearer token. Empty sends none, e.g. for a local model. */
+  apiKey: string;
+  /** The model of the chat completions, e.g. "gpt-4o-mini". */
+  model: string;
+  /** The model of the embeddings of the semantic search, e.g. "text-embedding-3-small". Empty disables the semantic search. */
+  embeddingModel: string;
+}
+
+export interface WorkspaceSetting_FederationSetting {
+  /** The remote Slash instances to import the public shortcuts and collections from. */
+  sources: WorkspaceSetting_FederationSource[];
+}
+
+export interface WorkspaceSetting_FederationSource {
+  /** The unique id of the source. */
+  id: string;
+  /** Whether to sync the source. */
+  enabled: boolean;
+  /** The url of the remote Slash instance, e.g. "https://slash.eng.example.com". */
+  url: string;
+  /** The access token of a user of the remote instance to list its shortcuts and collections. */
+  accessToken: string;
+  /** The prefix of the names of the imported shortcuts and collections, e.g. "eng/". */
+  prefix: string;
+  /** The minutes between the syncs. Defaults to 60. */
+  intervalMinutes: number;
+  /** The timestamp of the last sync, successful or not. */
+  lastSyncedTs: number;
+  /** The error of the last sync. Empty when it succeeded. */
+  lastError: string;
+  /** The names of the shortcuts managed by the source. */
+  managedShortcuts: string[];
+  /** The names of the collections managed by the source. */
+  managedCollections: string[];
+}
+
 function createBaseWorkspaceSetting(): WorkspaceSetting {
   return {
     key: WorkspaceSettingKey.WORKSPACE_SETTING_KEY_UNSPECIFIED,
@@ -135,6 +442,13 @@ function createBaseWorkspaceSetting(): WorkspaceSetting {
     security: undefined,
     shortcutRelated: undefined,
     identityProvider: undefined,
+    gitSync: undefined,
+    notFound: undefined,
+    collectionTemplate: undefined,
+    federation: undefined,
+    mail: undefined,
+    landing: undefined,
+    completion: undefined,
   };
 }
 
@@ -158,6 +472,27 @@ export const WorkspaceSetting: MessageFns<WorkspaceSetting> = {
     if (message.identityProvider !== undefined) {
       WorkspaceSetting_IdentityProviderSetting.encode(message.identityProvider, writer.uint32(50).fork()).join();
     }
+    if (message.gitSync !== undefined) {
+      WorkspaceSetting_GitSyncSetting.encode(message.gitSync, writer.uint32(58).fork()).join();
+    }
+    if (message.notFound !== undefined) {
+      WorkspaceSetting_NotFoundSetting.encode(message.notFound, writer.uint32(66).fork()).join();
+    }
+    if (message.collectionTemplate !== undefined) {
+      WorkspaceSetting_CollectionTemplateSetting.encode(message.collectionTemplate, writer.uint32(74).fork()).join();
+    }
+    if (message.federation !== undefined) {
+      WorkspaceSetting_FederationSetting.encode(message.federation, writer.uint32(82).fork()).join();
+    }
+    if (message.mail !== undefined) {
+      WorkspaceSetting_MailSetting.encode(message.mail, writer.uint32(90).fork()).join();
+    }
+    if (message.landing !== undefined) {
+      WorkspaceSetting_LandingSetting.encode(message.landing, writer.uint32(98).fork()).join();
+    }
+    if (message.completion !== undefined) {
+      WorkspaceSetting_CompletionSetting.encode(message.completion, writer.uint32(106).fork()).join();
+    }
     return writer;
   },
 
@@ -216,6 +551,62 @@ export const WorkspaceSetting: MessageFns<WorkspaceSetting> = {
           message.identityProvider = WorkspaceSetting_IdentityProviderSetting.decode(reader, reader.uint32());
           continue;
         }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.gitSync = WorkspaceSetting_GitSyncSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.notFound = WorkspaceSetting_NotFoundSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.collectionTemplate = WorkspaceSetting_CollectionTemplateSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.federation = WorkspaceSetting_FederationSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.mail = WorkspaceSetting_MailSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.landing = WorkspaceSetting_LandingSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.completion = WorkspaceSetting_CompletionSetting.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -244,6 +635,27 @@ export const WorkspaceSetting: MessageFns<WorkspaceSetting> = {
     message.identityProvider = (object.identityProvider !== undefined && object.identityProvider !== null)
       ? WorkspaceSetting_IdentityProviderSetting.fromPartial(object.identityProvider)
       : undefined;
+    message.gitSync = (object.gitSync !== undefined && object.gitSync !== null)
+      ? WorkspaceSetting_GitSyncSetting.fromPartial(object.gitSync)
+      : undefined;
+    message.notFound = (object.notFound !== undefined && object.notFound !== null)
+      ? WorkspaceSetting_NotFoundSetting.fromPartial(object.notFound)
+      : undefined;
+    message.collectionTemplate = (object.collectionTemplate !== undefined && object.collectionTemplate !== null)
+      ? WorkspaceSetting_CollectionTemplateSetting.fromPartial(object.collectionTemplate)
+      : undefined;
+    message.federation = (object.federation !== undefined && object.federation !== null)
+      ? WorkspaceSetting_FederationSetting.fromPartial(object.federation)
+      : undefined;
+    message.mail = (object.mail !== undefined && object.mail !== null)
+      ? WorkspaceSetting_MailSetting.fromPartial(object.mail)
+      : undefined;
+    message.landing = (object.landing !== undefined && object.landing !== null)
+      ? WorkspaceSetting_LandingSetting.fromPartial(object.landing)
+      : undefined;
+    message.completion = (object.completion !== undefined && object.completion !== null)
+      ? WorkspaceSetting_CompletionSetting.fromPartial(object.completion)
+      : undefined;
     return message;
   },
 };
@@ -343,7 +755,12 @@ export const WorkspaceSetting_GeneralSetting: MessageFns<WorkspaceSetting_Genera
 };
 
 function createBaseWorkspaceSetting_SecuritySetting(): WorkspaceSetting_SecuritySetting {
-  return { disallowUserRegistration: false, disallowPasswordAuth: false };
+  return {
+    disallowUserRegistration: false,
+    disallowPasswordAuth: false,
+    accessTokenInactivityDays: 0,
+    accountHandoverUserId: 0,
+  };
 }
 
 export const WorkspaceSetting_SecuritySetting: MessageFns<WorkspaceSetting_SecuritySetting> = {
@@ -354,6 +771,12 @@ export const WorkspaceSetting_SecuritySetting: MessageFns<WorkspaceSetting_Secur
     if (message.disallowPasswordAuth !== false) {
       writer.uint32(16).bool(message.disallowPasswordAuth);
     }
+    if (message.accessTokenInactivityDays !== 0) {
+      writer.uint32(24).int32(message.accessTokenInactivityDays);
+    }
+    if (message.accountHandoverUserId !== 0) {
+      writer.uint32(32).int32(message.accountHandoverUserId);
+    }
     return writer;
   },
 
@@ -380,6 +803,22 @@ export const WorkspaceSetting_SecuritySetting: MessageFns<WorkspaceSetting_Secur
           message.disallowPasswordAuth = reader.bool();
           continue;
         }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.accessTokenInactivityDays = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.accountHandoverUserId = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -396,12 +835,25 @@ export const WorkspaceSetting_SecuritySetting: MessageFns<WorkspaceSetting_Secur
     const message = createBaseWorkspaceSetting_SecuritySetting();
     message.disallowUserRegistration = object.disallowUserRegistration ?? false;
     message.disallowPasswordAuth = object.disallowPasswordAuth ?? false;
+    message.accessTokenInactivityDays = object.accessTokenInactivityDays ?? 0;
+    message.accountHandoverUserId = object.accountHandoverUserId ?? 0;
     return message;
   },
 };
 
 function createBaseWorkspaceSetting_ShortcutRelatedSetting(): WorkspaceSetting_ShortcutRelatedSetting {
-  return { defaultVisibility: Visibility.VISIBILITY_UNSPECIFIED };
+  return {
+    defaultVisibility: Visibility.VISIBILITY_UNSPECIFIED,
+    anomalyAlert: undefined,
+    linkParamRules: undefined,
+    viewDedupeWindowSeconds: 0,
+    attributeViewsToUsers: false,
+    defaultRedirectCode: 0,
+    internalDomains: [],
+    confirmExternalRedirects: false,
+    linkHealthCheck: undefined,
+    reservedNames: [],
+  };
 }
 
 export const WorkspaceSetting_ShortcutRelatedSetting: MessageFns<WorkspaceSetting_ShortcutRelatedSetting> = {
@@ -409,6 +861,33 @@ export const WorkspaceSetting_ShortcutRelatedSetting: MessageFns<WorkspaceSettin
     if (message.defaultVisibility !== Visibility.VISIBILITY_UNSPECIFIED) {
       writer.uint32(8).int32(visibilityToNumber(message.defaultVisibility));
     }
+    if (message.anomalyAlert !== undefined) {
+      WorkspaceSetting_AnomalyAlertSetting.encode(message.anomalyAlert, writer.uint32(18).fork()).join();
+    }
+    if (message.linkParamRules !== undefined) {
+      WorkspaceSetting_LinkParamRules.encode(message.linkParamRules, writer.uint32(26).fork()).join();
+    }
+    if (message.viewDedupeWindowSeconds !== 0) {
+      writer.uint32(32).int32(message.viewDedupeWindowSeconds);
+    }
+    if (message.attributeViewsToUsers !== false) {
+      writer.uint32(40).bool(message.attributeViewsToUsers);
+    }
+    if (message.defaultRedirectCode !== 0) {
+      writer.uint32(48).int32(message.defaultRedirectCode);
+    }
+    for (const v of message.internalDomains) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.confirmExternalRedirects !== false) {
+      writer.uint32(64).bool(message.confirmExternalRedirects);
+    }
+    if (message.linkHealthCheck !== undefined) {
+      WorkspaceSetting_LinkHealthCheckSetting.encode(message.linkHealthCheck, writer.uint32(74).fork()).join();
+    }
+    for (const v of message.reservedNames) {
+      writer.uint32(82).string(v!);
+    }
     return writer;
   },
 
@@ -427,6 +906,78 @@ export const WorkspaceSetting_ShortcutRelatedSetting: MessageFns<WorkspaceSettin
           message.defaultVisibility = visibilityFromJSON(reader.int32());
           continue;
         }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.anomalyAlert = WorkspaceSetting_AnomalyAlertSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.linkParamRules = WorkspaceSetting_LinkParamRules.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.viewDedupeWindowSeconds = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.attributeViewsToUsers = reader.bool();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.defaultRedirectCode = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.internalDomains.push(reader.string());
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.confirmExternalRedirects = reader.bool();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.linkHealthCheck = WorkspaceSetting_LinkHealthCheckSetting.decode(reader, reader.uint32());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.reservedNames.push(reader.string());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -442,35 +993,72 @@ export const WorkspaceSetting_ShortcutRelatedSetting: MessageFns<WorkspaceSettin
   fromPartial(object: DeepPartial<WorkspaceSetting_ShortcutRelatedSetting>): WorkspaceSetting_ShortcutRelatedSetting {
     const message = createBaseWorkspaceSetting_ShortcutRelatedSetting();
     message.defaultVisibility = object.defaultVisibility ?? Visibility.VISIBILITY_UNSPECIFIED;
+    message.anomalyAlert = (object.anomalyAlert !== undefined && object.anomalyAlert !== null)
+      ? WorkspaceSetting_AnomalyAlertSetting.fromPartial(object.anomalyAlert)
+      : undefined;
+    message.linkParamRules = (object.linkParamRules !== undefined && object.linkParamRules !== null)
+      ? WorkspaceSetting_LinkParamRules.fromPartial(object.linkParamRules)
+      : undefined;
+    message.viewDedupeWindowSeconds = object.viewDedupeWindowSeconds ?? 0;
+    message.attributeViewsToUsers = object.attributeViewsToUsers ?? false;
+    message.defaultRedirectCode = object.defaultRedirectCode ?? 0;
+    message.internalDomains = object.internalDomains?.map((e) => e) || [];
+    message.confirmExternalRedirects = object.confirmExternalRedirects ?? false;
+    message.linkHealthCheck = (object.linkHealthCheck !== undefined && object.linkHealthCheck !== null)
+      ? WorkspaceSetting_LinkHealthCheckSetting.fromPartial(object.linkHealthCheck)
+      : undefined;
+    message.reservedNames = object.reservedNames?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseWorkspaceSetting_IdentityProviderSetting(): WorkspaceSetting_IdentityProviderSetting {
-  return { identityProviders: [] };
+function createBaseWorkspaceSetting_LinkHealthCheckSetting(): WorkspaceSetting_LinkHealthCheckSetting {
+  return { enabled: false, webhookUrl: "", notifyCreators: false };
 }
 
-export const WorkspaceSetting_IdentityProviderSetting: MessageFns<WorkspaceSetting_IdentityProviderSetting> = {
-  encode(message: WorkspaceSetting_IdentityProviderSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.identityProviders) {
-      IdentityProvider.encode(v!, writer.uint32(10).fork()).join();
+export const WorkspaceSetting_LinkHealthCheckSetting: MessageFns<WorkspaceSetting_LinkHealthCheckSetting> = {
+  encode(message: WorkspaceSetting_LinkHealthCheckSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.enabled !== false) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.webhookUrl !== "") {
+      writer.uint32(18).string(message.webhookUrl);
+    }
+    if (message.notifyCreators !== false) {
+      writer.uint32(24).bool(message.notifyCreators);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_IdentityProviderSetting {
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_LinkHealthCheckSetting {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWorkspaceSetting_IdentityProviderSetting();
+    const message = createBaseWorkspaceSetting_LinkHealthCheckSetting();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.identityProviders.push(IdentityProvider.decode(reader, reader.uint32()));
+          message.enabled = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.webhookUrl = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.notifyCreators = reader.bool();
           continue;
         }
       }
@@ -482,23 +1070,1011 @@ export const WorkspaceSetting_IdentityProviderSetting: MessageFns<WorkspaceSetti
     return message;
   },
 
-  create(base?: DeepPartial<WorkspaceSetting_IdentityProviderSetting>): WorkspaceSetting_IdentityProviderSetting {
-    return WorkspaceSetting_IdentityProviderSetting.fromPartial(base ?? {});
+  create(base?: DeepPartial<WorkspaceSetting_LinkHealthCheckSetting>): WorkspaceSetting_LinkHealthCheckSetting {
+    return WorkspaceSetting_LinkHealthCheckSetting.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<WorkspaceSetting_IdentityProviderSetting>): WorkspaceSetting_IdentityProviderSetting {
-    const message = createBaseWorkspaceSetting_IdentityProviderSetting();
-    message.identityProviders = object.identityProviders?.map((e) => IdentityProvider.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<WorkspaceSetting_LinkHealthCheckSetting>): WorkspaceSetting_LinkHealthCheckSetting {
+    const message = createBaseWorkspaceSetting_LinkHealthCheckSetting();
+    message.enabled = object.enabled ?? false;
+    message.webhookUrl = object.webhookUrl ?? "";
+    message.notifyCreators = object.notifyCreators ?? false;
     return message;
   },
 };
 
-type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+function createBaseWorkspaceSetting_LinkParamRules(): WorkspaceSetting_LinkParamRules {
+  return { deny: [], allow: [] };
+}
 
-export type DeepPartial<T> = T extends Builtin ? T
-  : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
-  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
-  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
-  : Partial<T>;
+export const WorkspaceSetting_LinkParamRules: MessageFns<WorkspaceSetting_LinkParamRules> = {
+  encode(message: WorkspaceSetting_LinkParamRules, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.deny) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.allow) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_LinkParamRules {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_LinkParamRules();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deny.push(reader.string());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.allow.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_LinkParamRules>): WorkspaceSetting_LinkParamRules {
+    return WorkspaceSetting_LinkParamRules.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_LinkParamRules>): WorkspaceSetting_LinkParamRules {
+    const message = createBaseWorkspaceSetting_LinkParamRules();
+    message.deny = object.deny?.map((e) => e) || [];
+    message.allow = object.allow?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_AnomalyAlertSetting(): WorkspaceSetting_AnomalyAlertSetting {
+  return { enabled: false, webhookUrl: "", zScoreThreshold: 0, minViews: 0 };
+}
+
+export const WorkspaceSetting_AnomalyAlertSetting: MessageFns<WorkspaceSetting_AnomalyAlertSetting> = {
+  encode(message: WorkspaceSetting_AnomalyAlertSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.enabled !== false) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.webhookUrl !== "") {
+      writer.uint32(18).string(message.webhookUrl);
+    }
+    if (message.zScoreThreshold !== 0) {
+      writer.uint32(25).double(message.zScoreThreshold);
+    }
+    if (message.minViews !== 0) {
+      writer.uint32(32).int32(message.minViews);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_AnomalyAlertSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_AnomalyAlertSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.webhookUrl = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.zScoreThreshold = reader.double();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.minViews = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_AnomalyAlertSetting>): WorkspaceSetting_AnomalyAlertSetting {
+    return WorkspaceSetting_AnomalyAlertSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_AnomalyAlertSetting>): WorkspaceSetting_AnomalyAlertSetting {
+    const message = createBaseWorkspaceSetting_AnomalyAlertSetting();
+    message.enabled = object.enabled ?? false;
+    message.webhookUrl = object.webhookUrl ?? "";
+    message.zScoreThreshold = object.zScoreThreshold ?? 0;
+    message.minViews = object.minViews ?? 0;
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_IdentityProviderSetting(): WorkspaceSetting_IdentityProviderSetting {
+  return { identityProviders: [] };
+}
+
+export const WorkspaceSetting_IdentityProviderSetting: MessageFns<WorkspaceSetting_IdentityProviderSetting> = {
+  encode(message: WorkspaceSetting_IdentityProviderSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.identityProviders) {
+      IdentityProvider.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_IdentityProviderSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_IdentityProviderSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.identityProviders.push(IdentityProvider.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_IdentityProviderSetting>): WorkspaceSetting_IdentityProviderSetting {
+    return WorkspaceSetting_IdentityProviderSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_IdentityProviderSetting>): WorkspaceSetting_IdentityProviderSetting {
+    const message = createBaseWorkspaceSetting_IdentityProviderSetting();
+    message.identityProviders = object.identityProviders?.map((e) => IdentityProvider.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_GitSyncSetting(): WorkspaceSetting_GitSyncSetting {
+  return {
+    enabled: false,
+    repository: "",
+    branch: "",
+    path: "",
+    accessToken: "",
+    webhookSecret: "",
+    writeBack: false,
+    lastSyncedSha: "",
+    managedShortcuts: [],
+  };
+}
+
+export const WorkspaceSetting_GitSyncSetting: MessageFns<WorkspaceSetting_GitSyncSetting> = {
+  encode(message: WorkspaceSetting_GitSyncSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.enabled !== false) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.repository !== "") {
+      writer.uint32(18).string(message.repository);
+    }
+    if (message.branch !== "") {
+      writer.uint32(26).string(message.branch);
+    }
+    if (message.path !== "") {
+      writer.uint32(34).string(message.path);
+    }
+    if (message.accessToken !== "") {
+      writer.uint32(42).string(message.accessToken);
+    }
+    if (message.webhookSecret !== "") {
+      writer.uint32(50).string(message.webhookSecret);
+    }
+    if (message.writeBack !== false) {
+      writer.uint32(56).bool(message.writeBack);
+    }
+    if (message.lastSyncedSha !== "") {
+      writer.uint32(66).string(message.lastSyncedSha);
+    }
+    for (const v of message.managedShortcuts) {
+      writer.uint32(74).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_GitSyncSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_GitSyncSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.repository = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.branch = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.path = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.accessToken = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.webhookSecret = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.writeBack = reader.bool();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.lastSyncedSha = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.managedShortcuts.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_GitSyncSetting>): WorkspaceSetting_GitSyncSetting {
+    return WorkspaceSetting_GitSyncSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_GitSyncSetting>): WorkspaceSetting_GitSyncSetting {
+    const message = createBaseWorkspaceSetting_GitSyncSetting();
+    message.enabled = object.enabled ?? false;
+    message.repository = object.repository ?? "";
+    message.branch = object.branch ?? "";
+    message.path = object.path ?? "";
+    message.accessToken = object.accessToken ?? "";
+    message.webhookSecret = object.webhookSecret ?? "";
+    message.writeBack = object.writeBack ?? false;
+    message.lastSyncedSha = object.lastSyncedSha ?? "";
+    message.managedShortcuts = object.managedShortcuts?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_NotFoundSetting(): WorkspaceSetting_NotFoundSetting {
+  return { redirectUrl: "", message: "", disableCreateOffer: false };
+}
+
+export const WorkspaceSetting_NotFoundSetting: MessageFns<WorkspaceSetting_NotFoundSetting> = {
+  encode(message: WorkspaceSetting_NotFoundSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.redirectUrl !== "") {
+      writer.uint32(10).string(message.redirectUrl);
+    }
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    if (message.disableCreateOffer !== false) {
+      writer.uint32(24).bool(message.disableCreateOffer);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_NotFoundSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_NotFoundSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.redirectUrl = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.disableCreateOffer = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_NotFoundSetting>): WorkspaceSetting_NotFoundSetting {
+    return WorkspaceSetting_NotFoundSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_NotFoundSetting>): WorkspaceSetting_NotFoundSetting {
+    const message = createBaseWorkspaceSetting_NotFoundSetting();
+    message.redirectUrl = object.redirectUrl ?? "";
+    message.message = object.message ?? "";
+    message.disableCreateOffer = object.disableCreateOffer ?? false;
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_CollectionTemplateSetting(): WorkspaceSetting_CollectionTemplateSetting {
+  return { templates: [] };
+}
+
+export const WorkspaceSetting_CollectionTemplateSetting: MessageFns<WorkspaceSetting_CollectionTemplateSetting> = {
+  encode(message: WorkspaceSetting_CollectionTemplateSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.templates) {
+      CollectionTemplate.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_CollectionTemplateSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_CollectionTemplateSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.templates.push(CollectionTemplate.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_CollectionTemplateSetting>): WorkspaceSetting_CollectionTemplateSetting {
+    return WorkspaceSetting_CollectionTemplateSetting.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<WorkspaceSetting_CollectionTemplateSetting>,
+  ): WorkspaceSetting_CollectionTemplateSetting {
+    const message = createBaseWorkspaceSetting_CollectionTemplateSetting();
+    message.templates = object.templates?.map((e) => CollectionTemplate.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_MailSetting(): WorkspaceSetting_MailSetting {
+  return {
+    smtpHost: "",
+    smtpPort: 0,
+    smtpUsername: "",
+    smtpPassword: "",
+    smtpEncryption: WorkspaceSetting_MailSetting_Encryption.ENCRYPTION_UNSPECIFIED,
+    from: "",
+    requireEmailVerification: false,
+  };
+}
+
+export const WorkspaceSetting_MailSetting: MessageFns<WorkspaceSetting_MailSetting> = {
+  encode(message: WorkspaceSetting_MailSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.smtpHost !== "") {
+      writer.uint32(10).string(message.smtpHost);
+    }
+    if (message.smtpPort !== 0) {
+      writer.uint32(16).int32(message.smtpPort);
+    }
+    if (message.smtpUsername !== "") {
+      writer.uint32(26).string(message.smtpUsername);
+    }
+    if (message.smtpPassword !== "") {
+      writer.uint32(34).string(message.smtpPassword);
+    }
+    if (message.smtpEncryption !== WorkspaceSetting_MailSetting_Encryption.ENCRYPTION_UNSPECIFIED) {
+      writer.uint32(40).int32(workspaceSetting_MailSetting_EncryptionToNumber(message.smtpEncryption));
+    }
+    if (message.from !== "") {
+      writer.uint32(50).string(message.from);
+    }
+    if (message.requireEmailVerification !== false) {
+      writer.uint32(56).bool(message.requireEmailVerification);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_MailSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_MailSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.smtpHost = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.smtpPort = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.smtpUsername = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.smtpPassword = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.smtpEncryption = workspaceSetting_MailSetting_EncryptionFromJSON(reader.int32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.from = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.requireEmailVerification = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_MailSetting>): WorkspaceSetting_MailSetting {
+    return WorkspaceSetting_MailSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_MailSetting>): WorkspaceSetting_MailSetting {
+    const message = createBaseWorkspaceSetting_MailSetting();
+    message.smtpHost = object.smtpHost ?? "";
+    message.smtpPort = object.smtpPort ?? 0;
+    message.smtpUsername = object.smtpUsername ?? "";
+    message.smtpPassword = object.smtpPassword ?? "";
+    message.smtpEncryption = object.smtpEncryption ?? WorkspaceSetting_MailSetting_Encryption.ENCRYPTION_UNSPECIFIED;
+    message.from = object.from ?? "";
+    message.requireEmailVerification = object.requireEmailVerification ?? false;
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_LandingSetting(): WorkspaceSetting_LandingSetting {
+  return {
+    type: WorkspaceSetting_LandingSetting_Type.TYPE_UNSPECIFIED,
+    collectionName: "",
+    pageHtml: "",
+    redirectUrl: "",
+    applyToSignedInUsers: false,
+  };
+}
+
+export const WorkspaceSetting_LandingSetting: MessageFns<WorkspaceSetting_LandingSetting> = {
+  encode(message: WorkspaceSetting_LandingSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.type !== WorkspaceSetting_LandingSetting_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(workspaceSetting_LandingSetting_TypeToNumber(message.type));
+    }
+    if (message.collectionName !== "") {
+      writer.uint32(18).string(message.collectionName);
+    }
+    if (message.pageHtml !== "") {
+      writer.uint32(26).string(message.pageHtml);
+    }
+    if (message.redirectUrl !== "") {
+      writer.uint32(34).string(message.redirectUrl);
+    }
+    if (message.applyToSignedInUsers !== false) {
+      writer.uint32(40).bool(message.applyToSignedInUsers);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_LandingSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_LandingSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = workspaceSetting_LandingSetting_TypeFromJSON(reader.int32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.collectionName = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageHtml = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.redirectUrl = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.applyToSignedInUsers = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_LandingSetting>): WorkspaceSetting_LandingSetting {
+    return WorkspaceSetting_LandingSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_LandingSetting>): WorkspaceSetting_LandingSetting {
+    const message = createBaseWorkspaceSetting_LandingSetting();
+    message.type = object.type ?? WorkspaceSetting_LandingSetting_Type.TYPE_UNSPECIFIED;
+    message.collectionName = object.collectionName ?? "";
+    message.pageHtml = object.pageHtml ?? "";
+    message.redirectUrl = object.redirectUrl ?? "";
+    message.applyToSignedInUsers = object.applyToSignedInUsers ?? false;
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_CompletionSetting(): WorkspaceSetting_CompletionSetting {
+  return { endpoint: "", apiKey: "", model: "", embeddingModel: "" };
+}
+
+export const WorkspaceSetting_CompletionSetting: MessageFns<WorkspaceSetting_CompletionSetting> = {
+  encode(message: WorkspaceSetting_CompletionSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.endpoint !== "") {
+      writer.uint32(10).string(message.endpoint);
+    }
+    if (message.apiKey !== "") {
+      writer.uint32(18).string(message.apiKey);
+    }
+    if (message.model !== "") {
+      writer.uint32(26).string(message.model);
+    }
+    if (message.embeddingModel !== "") {
+      writer.uint32(34).string(message.embeddingModel);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_CompletionSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_CompletionSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.endpoint = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.apiKey = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.model = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.embeddingModel = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_CompletionSetting>): WorkspaceSetting_CompletionSetting {
+    return WorkspaceSetting_CompletionSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_CompletionSetting>): WorkspaceSetting_CompletionSetting {
+    const message = createBaseWorkspaceSetting_CompletionSetting();
+    message.endpoint = object.endpoint ?? "";
+    message.apiKey = object.apiKey ?? "";
+    message.model = object.model ?? "";
+    message.embeddingModel = object.embeddingModel ?? "";
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_FederationSetting(): WorkspaceSetting_FederationSetting {
+  return { sources: [] };
+}
+
+export const WorkspaceSetting_FederationSetting: MessageFns<WorkspaceSetting_FederationSetting> = {
+  encode(message: WorkspaceSetting_FederationSetting, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.sources) {
+      WorkspaceSetting_FederationSource.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_FederationSetting {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_FederationSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.sources.push(WorkspaceSetting_FederationSource.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_FederationSetting>): WorkspaceSetting_FederationSetting {
+    return WorkspaceSetting_FederationSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_FederationSetting>): WorkspaceSetting_FederationSetting {
+    const message = createBaseWorkspaceSetting_FederationSetting();
+    message.sources = object.sources?.map((e) => WorkspaceSetting_FederationSource.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseWorkspaceSetting_FederationSource(): WorkspaceSetting_FederationSource {
+  return {
+    id: "",
+    enabled: false,
+    url: "",
+    accessToken: "",
+    prefix: "",
+    intervalMinutes: 0,
+    lastSyncedTs: 0,
+    lastError: "",
+    managedShortcuts: [],
+    managedCollections: [],
+  };
+}
+
+export const WorkspaceSetting_FederationSource: MessageFns<WorkspaceSetting_FederationSource> = {
+  encode(message: WorkspaceSetting_FederationSource, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.enabled !== false) {
+      writer.uint32(16).bool(message.enabled);
+    }
+    if (message.url !== "") {
+      writer.uint32(26).string(message.url);
+    }
+    if (message.accessToken !== "") {
+      writer.uint32(34).string(message.accessToken);
+    }
+    if (message.prefix !== "") {
+      writer.uint32(42).string(message.prefix);
+    }
+    if (message.intervalMinutes !== 0) {
+      writer.uint32(48).int32(message.intervalMinutes);
+    }
+    if (message.lastSyncedTs !== 0) {
+      writer.uint32(56).int64(message.lastSyncedTs);
+    }
+    if (message.lastError !== "") {
+      writer.uint32(66).string(message.lastError);
+    }
+    for (const v of message.managedShortcuts) {
+      writer.uint32(74).string(v!);
+    }
+    for (const v of message.managedCollections) {
+      writer.uint32(82).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceSetting_FederationSource {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceSetting_FederationSource();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.accessToken = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.prefix = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.intervalMinutes = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.lastSyncedTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.lastError = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.managedShortcuts.push(reader.string());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.managedCollections.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceSetting_FederationSource>): WorkspaceSetting_FederationSource {
+    return WorkspaceSetting_FederationSource.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceSetting_FederationSource>): WorkspaceSetting_FederationSource {
+    const message = createBaseWorkspaceSetting_FederationSource();
+    message.id = object.id ?? "";
+    message.enabled = object.enabled ?? false;
+    message.url = object.url ?? "";
+    message.accessToken = object.accessToken ?? "";
+    message.prefix = object.prefix ?? "";
+    message.intervalMinutes = object.intervalMinutes ?? 0;
+    message.lastSyncedTs = object.lastSyncedTs ?? 0;
+    message.lastError = object.lastError ?? "";
+    message.managedShortcuts = object.managedShortcuts?.map((e) => e) || [];
+    message.managedCollections = object.managedCollections?.map((e) => e) || [];
+    return message;
+  },
+};
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+function longToNumber(int64: { toString(): string }): number {
+  const num = globalThis.Number(int64.toString());
+  if (num > globalThis.Number.MAX_SAFE_INTEGER) {
+    throw new globalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  if (num < globalThis.Number.MIN_SAFE_INTEGER) {
+    throw new globalThis.Error("Value is smaller than Number.MIN_SAFE_INTEGER");
+  }
+  return num;
+}
 
 export interface MessageFns<T> {
   encode(message: T, writer?: BinaryWriter): BinaryWriter;
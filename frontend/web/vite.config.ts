@@ -19,6 +19,10 @@ export default defineConfig({
         target: devProxyServer,
         xfwd: true,
       },
+      "^/u/\\d+/avatar": {
+        target: devProxyServer,
+        xfwd: true,
+      },
     },
   },
   resolve: {
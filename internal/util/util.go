@@ -6,6 +6,8 @@ import (
 	"math/big"
 	"net/mail"
 	"net/url"
+	"path"
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -42,6 +44,31 @@ func ValidateEmail(email string) bool {
 	return true
 }
 
+var usernameMatcher = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,31}$`)
+
+// ValidateUsername validates the username.
+// A username has 2 to 32 characters of lowercase letters, digits, "-" and "_", and starts with a letter or digit.
+func ValidateUsername(username string) bool {
+	return usernameMatcher.MatchString(username)
+}
+
+var invalidUsernameCharsMatcher = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// NormalizeUsername converts the local part of the email into a valid username.
+// The result may be taken by another user, callers should make it unique.
+func NormalizeUsername(email string) string {
+	localPart, _, _ := strings.Cut(email, "@")
+	username := invalidUsernameCharsMatcher.ReplaceAllString(strings.ToLower(localPart), "-")
+	username = strings.TrimLeft(username, "-_")
+	if len(username) > 24 {
+		username = username[:24]
+	}
+	if !ValidateUsername(username) {
+		return "user"
+	}
+	return username
+}
+
 func GenUUID() string {
 	return uuid.New().String()
 }
@@ -161,3 +188,61 @@ func ValidateURI(uri string) bool {
 	u, err := url.Parse(uri)
 	return err == nil && u.Scheme != "" && u.Host != ""
 }
+
+// MatchQueryParam reports whether the query parameter name matches the pattern case-insensitively,
+// where "*" matches any characters, e.g. "utm_*".
+func MatchQueryParam(pattern, name string) bool {
+	matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(name))
+	return err == nil && matched
+}
+
+// ValidateQueryParamPattern validates the pattern of the query parameter names for MatchQueryParam.
+func ValidateQueryParamPattern(pattern string) error {
+	if pattern == "" {
+		return errors.New("empty pattern")
+	}
+	if strings.ContainsAny(pattern, "?&=#") {
+		return errors.New(`the pattern must not contain "?", "&", "=" or "#"`)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return errors.Wrap(err, "malformed pattern")
+	}
+	return nil
+}
+
+// StripQueryParams removes the query parameters of the link matching any of the deny patterns but none of the allow patterns.
+// The rest of the link, including the order and the encoding of the kept parameters, is kept as is.
+func StripQueryParams(link string, deny, allow []string) string {
+	matchAny := func(patterns []string, name string) bool {
+		for _, pattern := range patterns {
+			if MatchQueryParam(pattern, name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	base, fragment, hasFragment := strings.Cut(link, "#")
+	base, query, hasQuery := strings.Cut(base, "?")
+	if !hasQuery || len(deny) == 0 {
+		return link
+	}
+	params := []string{}
+	for _, param := range strings.Split(query, "&") {
+		name, _, _ := strings.Cut(param, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		if name != "" && matchAny(deny, name) && !matchAny(allow, name) {
+			continue
+		}
+		params = append(params, param)
+	}
+	if len(params) > 0 {
+		base += "?" + strings.Join(params, "&")
+	}
+	if hasFragment {
+		base += "#" + fragment
+	}
+	return base
+}
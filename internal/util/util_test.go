@@ -141,3 +141,79 @@ func TestValidatePhone(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalizeUsername(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{
+			email: "steven@example.com",
+			want:  "steven",
+		},
+		{
+			email: "John.Doe+work@example.com",
+			want:  "john-doe-work",
+		},
+		{
+			email: "_x@example.com",
+			want:  "user",
+		},
+		{
+			email: "a-very-long-local-part-of-an-email@example.com",
+			want:  "a-very-long-local-part-o",
+		},
+	}
+
+	for _, test := range tests {
+		got := NormalizeUsername(test.email)
+		if got != test.want {
+			t.Errorf("NormalizeUsername %s, got %s, want %s", test.email, got, test.want)
+		}
+		if !ValidateUsername(got) {
+			t.Errorf("NormalizeUsername %s returns invalid username %s", test.email, got)
+		}
+	}
+}
+
+func TestStripQueryParams(t *testing.T) {
+	deny := []string{"utm_*", "fbclid"}
+	allow := []string{"utm_id"}
+	tests := []struct {
+		link string
+		want string
+	}{
+		{
+			link: "https://example.com/docs",
+			want: "https://example.com/docs",
+		},
+		{
+			link: "https://example.com/docs?utm_source=mail&fbclid=abc",
+			want: "https://example.com/docs",
+		},
+		{
+			link: "https://example.com/docs?b=2&UTM_Medium=x&a=1&utm_id=7#intro",
+			want: "https://example.com/docs?b=2&a=1&utm_id=7#intro",
+		},
+		{
+			link: "https://example.com/search?q=a%20b&utm%5Fsource=mail&flag",
+			want: "https://example.com/search?q=a%20b&flag",
+		},
+		{
+			link: "https://example.com/#/page?utm_source=mail",
+			want: "https://example.com/#/page?utm_source=mail",
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, StripQueryParams(test.link, deny, allow))
+	}
+	assert.Equal(t, "https://example.com/?fbclid=abc", StripQueryParams("https://example.com/?fbclid=abc", nil, nil))
+}
+
+func TestValidateQueryParamPattern(t *testing.T) {
+	assert.NoError(t, ValidateQueryParamPattern("utm_*"))
+	assert.NoError(t, ValidateQueryParamPattern("fbclid"))
+	assert.Error(t, ValidateQueryParamPattern(""))
+	assert.Error(t, ValidateQueryParamPattern("utm_source=mail"))
+	assert.Error(t, ValidateQueryParamPattern("utm_[*"))
+}
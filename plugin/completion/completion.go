@@ -0,0 +1,175 @@
+// Package completion provides the providers suggesting the title, description and tags of the shortcuts
+// from the pages of their links.
+package completion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// timeout is the timeout of a completion request, which can be slow with the large models.
+	timeout = 30 * time.Second
+	// maxResponseSize bounds the read of the completion response.
+	maxResponseSize = 1 << 20
+	// maxTags is the max number of the suggested tags.
+	maxTags = 5
+)
+
+// systemPrompt asks the model for the suggestions as a JSON object.
+const systemPrompt = `You suggest the metadata of a go link, a short name redirecting to a web page, from the content of the page.
+Reply with only a JSON object with the fields:
+- "title": a short title of the page, at most 60 characters.
+- "description": one sentence describing what the page is for, at most 200 characters.
+- "tags": at most 5 lowercase tags of one or two words joined by "-", e.g. "docs" or "on-call".`
+
+// Page is the page of the link of a shortcut.
+type Page struct {
+	URL         string
+	Title       string
+	Description string
+	// Text is the visible text of the page, truncated.
+	Text string
+}
+
+// Suggestion is the suggested metadata of a shortcut.
+type Suggestion struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+// Provider suggests the metadata of the shortcuts from their pages.
+type Provider interface {
+	Suggest(ctx context.Context, page *Page) (*Suggestion, error)
+}
+
+// ValidateEndpoint checks that the endpoint is an absolute http(s) url.
+func ValidateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return errors.Wrapf(err, "invalid completion endpoint %s", endpoint)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid completion endpoint %s, it must be an absolute http(s) url", endpoint)
+	}
+	return nil
+}
+
+// OpenAIProvider suggests with the chat completions API of OpenAI, or of a compatible server, e.g. a local model.
+type OpenAIProvider struct {
+	// Endpoint is the base url of the API, e.g. "https://api.openai.com/v1".
+	Endpoint string
+	// APIKey is sent as a bearer token, unless empty.
+	APIKey string
+	Model  string
+
+	client *http.Client
+}
+
+func NewOpenAIProvider(endpoint, apiKey, model string) *OpenAIProvider {
+	return &OpenAIProvider{
+		Endpoint: strings.TrimRight(endpoint, "/"),
+		APIKey:   apiKey,
+		Model:    model,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float64       `json:"temperature"`
+}
+
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+func (p *OpenAIProvider) Suggest(ctx context.Context, page *Page) (*Suggestion, error) {
+	body, err := json.Marshal(&chatCompletionRequest{
+		Model: p.Model,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: fmt.Sprintf("URL: %s\nTitle: %s\nDescription: %s\nContent: %s", page.URL, page.Title, page.Description, page.Text)},
+		},
+		Temperature: 0.2,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal completion request")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.Endpoint+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create completion request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.APIKey)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request completion")
+	}
+	defer resp.Body.Close()
+	responseBytes, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read completion response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.Errorf("failed to request completion, status code: %d", resp.StatusCode)
+	}
+
+	response := &chatCompletionResponse{}
+	if err := json.Unmarshal(responseBytes, response); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal completion response")
+	}
+	if len(response.Choices) == 0 {
+		return nil, errors.New("the completion response has no choices")
+	}
+	return parseSuggestion(response.Choices[0].Message.Content)
+}
+
+// tagRegexp matches the characters which aren't allowed in the suggested tags.
+var tagRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// parseSuggestion parses the JSON object of the completion, which the models may wrap in a code block or text.
+func parseSuggestion(content string) (*Suggestion, error) {
+	start, end := strings.Index(content, "{"), strings.LastIndex(content, "}")
+	if start < 0 || end < start {
+		return nil, errors.New("the completion has no JSON object")
+	}
+	suggestion := &Suggestion{}
+	if err := json.Unmarshal([]byte(content[start:end+1]), suggestion); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the suggestion of the completion")
+	}
+
+	suggestion.Title = strings.TrimSpace(suggestion.Title)
+	suggestion.Description = strings.TrimSpace(suggestion.Description)
+	tags := []string{}
+	for _, tag := range suggestion.Tags {
+		tag = strings.Trim(tagRegexp.ReplaceAllString(strings.ToLower(strings.TrimSpace(tag)), "-"), "-")
+		if tag != "" && !slices.Contains(tags, tag) && len(tags) < maxTags {
+			tags = append(tags, tag)
+		}
+	}
+	suggestion.Tags = tags
+	return suggestion, nil
+}
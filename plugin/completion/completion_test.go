@@ -0,0 +1,55 @@
+package completion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIProviderSuggest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+		request := &chatCompletionRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		require.Equal(t, "test-model", request.Model)
+		require.Len(t, request.Messages, 2)
+		require.Contains(t, request.Messages[1].Content, "https://docs.example.com")
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"{\"title\":\"Example Docs\",\"description\":\"The docs.\",\"tags\":[\"docs\"]}"}}]}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(server.URL+"/v1/", "sk-test", "test-model")
+	suggestion, err := provider.Suggest(context.Background(), &Page{URL: "https://docs.example.com"})
+	require.NoError(t, err)
+	require.Equal(t, &Suggestion{Title: "Example Docs", Description: "The docs.", Tags: []string{"docs"}}, suggestion)
+
+	provider = NewOpenAIProvider(server.URL+"/v2", "sk-test", "test-model")
+	_, err = provider.Suggest(context.Background(), &Page{URL: "https://docs.example.com"})
+	require.Error(t, err)
+}
+
+func TestParseSuggestion(t *testing.T) {
+	suggestion, err := parseSuggestion("```json\n{\"title\": \" On-call \", \"description\": \"The runbook.\", \"tags\": [\"On Call\", \"on-call\", \"ops!\", \"\", \"a\", \"b\", \"c\", \"d\"]}\n```")
+	require.NoError(t, err)
+	require.Equal(t, "On-call", suggestion.Title)
+	require.Equal(t, "The runbook.", suggestion.Description)
+	require.Equal(t, []string{"on-call", "ops", "a", "b", "c"}, suggestion.Tags)
+
+	_, err = parseSuggestion("I can't help with that.")
+	require.Error(t, err)
+}
+
+func TestValidateEndpoint(t *testing.T) {
+	require.NoError(t, ValidateEndpoint("https://api.openai.com/v1"))
+	require.NoError(t, ValidateEndpoint("http://localhost:11434/v1"))
+	require.Error(t, ValidateEndpoint("api.openai.com"))
+	require.Error(t, ValidateEndpoint("https://"))
+}
@@ -0,0 +1,127 @@
+// Package embedding provides the providers embedding the texts of the shortcuts and the queries as vectors,
+// whose similarity ranks the shortcuts by meaning.
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// timeout is the timeout of an embedding request.
+	timeout = 30 * time.Second
+	// maxResponseSize bounds the read of the embedding response, which has a vector per input.
+	maxResponseSize = 32 << 20
+)
+
+// Provider embeds the texts as vectors, in the order of the texts.
+type Provider interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// OpenAIProvider embeds with the embeddings API of OpenAI, or of a compatible server, e.g. a local model.
+type OpenAIProvider struct {
+	// Endpoint is the base url of the API, e.g. "https://api.openai.com/v1".
+	Endpoint string
+	// APIKey is sent as a bearer token, unless empty.
+	APIKey string
+	Model  string
+
+	client *http.Client
+}
+
+func NewOpenAIProvider(endpoint, apiKey, model string) *OpenAIProvider {
+	return &OpenAIProvider{
+		Endpoint: strings.TrimRight(endpoint, "/"),
+		APIKey:   apiKey,
+		Model:    model,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+type embeddingRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (p *OpenAIProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(&embeddingRequest{
+		Model: p.Model,
+		Input: texts,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal embedding request")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.Endpoint+"/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create embedding request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.APIKey)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request embeddings")
+	}
+	defer resp.Body.Close()
+	responseBytes, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read embedding response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.Errorf("failed to request embeddings, status code: %d", resp.StatusCode)
+	}
+
+	response := &embeddingResponse{}
+	if err := json.Unmarshal(responseBytes, response); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal embedding response")
+	}
+	// The embeddings are ordered by the index of their input, which the servers may not follow.
+	embeddings := make([][]float32, len(texts))
+	for _, data := range response.Data {
+		if data.Index < 0 || data.Index >= len(texts) || len(data.Embedding) == 0 {
+			return nil, errors.Errorf("invalid embedding of index %d", data.Index)
+		}
+		embeddings[data.Index] = data.Embedding
+	}
+	for i, embedding := range embeddings {
+		if embedding == nil {
+			return nil, errors.Errorf("the embedding response has no embedding of index %d", i)
+		}
+	}
+	return embeddings, nil
+}
+
+// CosineSimilarity returns the cosine of the angle between the vectors, between -1 and 1,
+// or 0 if their dimensions differ or one of them is zero.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
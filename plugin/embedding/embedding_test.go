@@ -0,0 +1,50 @@
+package embedding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIProviderEmbed(t *testing.T) {
+	var inputs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+		request := &embeddingRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		require.Equal(t, "test-model", request.Model)
+		inputs = request.Input
+		// The embeddings of the first two inputs are returned, out of order.
+		_, _ = w.Write([]byte(`{"data":[{"index":1,"embedding":[0,1]},{"index":0,"embedding":[1,0.5]}]}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(server.URL+"/v1/", "sk-test", "test-model")
+	embeddings, err := provider.Embed(context.Background(), []string{"billing", "docs"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"billing", "docs"}, inputs)
+	require.Equal(t, [][]float32{{1, 0.5}, {0, 1}}, embeddings)
+
+	_, err = provider.Embed(context.Background(), []string{"billing", "docs", "wiki"})
+	require.Error(t, err)
+
+	provider = NewOpenAIProvider(server.URL+"/v2", "sk-test", "test-model")
+	_, err = provider.Embed(context.Background(), []string{"billing", "docs"})
+	require.Error(t, err)
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	require.InDelta(t, 1, CosineSimilarity([]float32{1, 2}, []float32{2, 4}), 1e-9)
+	require.InDelta(t, 0, CosineSimilarity([]float32{1, 0}, []float32{0, 3}), 1e-9)
+	require.InDelta(t, -1, CosineSimilarity([]float32{1, 1}, []float32{-1, -1}), 1e-9)
+	require.Zero(t, CosineSimilarity([]float32{1, 2}, []float32{1, 2, 3}))
+	require.Zero(t, CosineSimilarity([]float32{0, 0}, []float32{1, 2}))
+}
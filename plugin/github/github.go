@@ -0,0 +1,200 @@
+// Package github provides a minimal client of the GitHub REST API to read
+// and update files in a repository and to open pull requests.
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultBaseURL is the base url of the GitHub REST API.
+const DefaultBaseURL = "https://api.github.com"
+
+// timeout is the timeout of a GitHub API request.
+const timeout = 15 * time.Second
+
+// ErrNotFound is returned when the requested resource does not exist.
+var ErrNotFound = errors.New("not found")
+
+type Client struct {
+	// Token is the GitHub token used to authenticate the requests.
+	Token string
+	// Repository is the repository in the format of "owner/repo".
+	Repository string
+	// BaseURL is the base url of the API. Defaults to DefaultBaseURL.
+	BaseURL string
+}
+
+// NewClient creates a new GitHub client for the repository.
+func NewClient(token, repository string) *Client {
+	return &Client{
+		Token:      token,
+		Repository: repository,
+		BaseURL:    DefaultBaseURL,
+	}
+}
+
+// ValidateRepository checks that the repository is in the format of "owner/repo".
+func ValidateRepository(repository string) error {
+	owner, repo, ok := strings.Cut(repository, "/")
+	if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return errors.Errorf("invalid repository %q, it must be in the format of owner/repo", repository)
+	}
+	return nil
+}
+
+// File is a file in the repository.
+type File struct {
+	Content []byte
+	// SHA is the blob sha of the file.
+	SHA string
+}
+
+type contentResponse struct {
+	SHA      string `json:"sha"`
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+// GetFile gets the file at the path on the ref.
+func (c *Client) GetFile(ctx context.Context, ref, path string) (*File, error) {
+	resp := &contentResponse{}
+	endpoint := fmt.Sprintf("/repos/%s/contents/%s?ref=%s", c.Repository, escapePath(path), url.QueryEscape(ref))
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, resp); err != nil {
+		return nil, err
+	}
+	if resp.Encoding != "base64" {
+		return nil, errors.Errorf("unsupported encoding %q of file %s", resp.Encoding, path)
+	}
+	content, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(resp.Content, "\n", ""))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode file %s", path)
+	}
+	return &File{
+		Content: content,
+		SHA:     resp.SHA,
+	}, nil
+}
+
+// GetBranchSHA gets the sha of the head commit of the branch.
+func (c *Client) GetBranchSHA(ctx context.Context, branch string) (string, error) {
+	resp := &struct {
+		Object struct {
+			SHA string `json:"sha"`
+		} `json:"object"`
+	}{}
+	endpoint := fmt.Sprintf("/repos/%s/git/ref/heads/%s", c.Repository, escapePath(branch))
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, resp); err != nil {
+		return "", err
+	}
+	return resp.Object.SHA, nil
+}
+
+// CreateBranch creates the branch from the commit sha.
+func (c *Client) CreateBranch(ctx context.Context, branch, sha string) error {
+	body := map[string]string{
+		"ref": "refs/heads/" + branch,
+		"sha": sha,
+	}
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/git/refs", c.Repository), body, nil)
+}
+
+// UpdateFile commits the content of the file on the branch.
+// The sha is the blob sha of the file being replaced.
+func (c *Client) UpdateFile(ctx context.Context, branch, path string, content []byte, sha, message string) error {
+	body := map[string]string{
+		"message": message,
+		"content": base64.StdEncoding.EncodeToString(content),
+		"sha":     sha,
+		"branch":  branch,
+	}
+	return c.do(ctx, http.MethodPut, fmt.Sprintf("/repos/%s/contents/%s", c.Repository, escapePath(path)), body, nil)
+}
+
+// CreatePullRequest opens a pull request from head to base and returns its url.
+func (c *Client) CreatePullRequest(ctx context.Context, base, head, title, body string) (string, error) {
+	req := map[string]string{
+		"title": title,
+		"head":  head,
+		"base":  base,
+		"body":  body,
+	}
+	resp := &struct {
+		HTMLURL string `json:"html_url"`
+	}{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/pulls", c.Repository), req, resp); err != nil {
+		return "", err
+	}
+	return resp.HTMLURL, nil
+}
+
+func (c *Client) do(ctx context.Context, method, endpoint string, body, result any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request body")
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(baseURL, "/")+endpoint, reader)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request %s %s", method, endpoint)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to request %s %s", method, endpoint)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return errors.Wrapf(ErrNotFound, "%s %s", method, endpoint)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("failed to request %s %s, status code: %d, body: %s", method, endpoint, resp.StatusCode, string(message))
+	}
+	if result == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.Wrapf(err, "failed to decode response of %s %s", method, endpoint)
+	}
+	return nil
+}
+
+// escapePath escapes each segment of the slash separated path.
+func escapePath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
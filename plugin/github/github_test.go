@@ -0,0 +1,67 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		if r.URL.Path != "/repos/owner/repo/contents/links/shortcuts.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.Equal(t, "main", r.URL.Query().Get("ref"))
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"sha":      "abc",
+			"encoding": "base64",
+			"content":  base64.StdEncoding.EncodeToString([]byte("shortcuts: []")),
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("token", "owner/repo")
+	client.BaseURL = server.URL
+	ctx := context.Background()
+	file, err := client.GetFile(ctx, "main", "links/shortcuts.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "abc", file.SHA)
+	require.Equal(t, "shortcuts: []", string(file.Content))
+
+	_, err = client.GetFile(ctx, "main", "missing.yaml")
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/repos/owner/repo/pulls", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_ = json.NewEncoder(w).Encode(map[string]string{"html_url": "https://github.com/owner/repo/pull/1"})
+	}))
+	defer server.Close()
+
+	client := NewClient("token", "owner/repo")
+	client.BaseURL = server.URL
+	url, err := client.CreatePullRequest(context.Background(), "main", "slash/update", "Update", "")
+	require.NoError(t, err)
+	require.Equal(t, "https://github.com/owner/repo/pull/1", url)
+	require.Equal(t, "slash/update", received["head"])
+	require.Equal(t, "main", received["base"])
+}
+
+func TestValidateRepository(t *testing.T) {
+	require.NoError(t, ValidateRepository("owner/repo"))
+	require.Error(t, ValidateRepository("owner"))
+	require.Error(t, ValidateRepository("owner/"))
+	require.Error(t, ValidateRepository("owner/repo/extra"))
+}
@@ -0,0 +1,97 @@
+package httpgetter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+// documentTimeout bounds the time of proxying a document, longer than of the pages as the documents are larger.
+const documentTimeout = 5 * time.Minute
+
+// documentClient is the safe client with the timeout of the documents.
+var documentClient = &http.Client{
+	Timeout:       documentTimeout,
+	Transport:     safeClient.Transport,
+	CheckRedirect: safeClient.CheckRedirect,
+}
+
+// ErrNotDocument is returned when the link is a web page rather than a document.
+var ErrNotDocument = errors.New("not a document")
+
+// Document is a document requested from a link, whose body is read and closed by the caller.
+type Document struct {
+	Body io.ReadCloser
+	// ContentType is the content type of the response, e.g. "application/pdf".
+	ContentType string
+	// ContentLength is -1 when unknown.
+	ContentLength int64
+	// Filename is the file name of the Content-Disposition of the response, or else the last segment of the url path.
+	Filename string
+}
+
+// GetDocument requests the document of the url with the safe client, to proxy it.
+// The url must be http(s), and the requests to the addresses of the private networks are forbidden.
+// ErrNotDocument is returned for the HTML pages.
+func GetDocument(ctx context.Context, urlStr string) (*Document, error) {
+	return getDocument(ctx, documentClient, urlStr)
+}
+
+func getDocument(ctx context.Context, client *http.Client, urlStr string) (*Document, error) {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("not a http(s) url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode >= http.StatusBadRequest {
+		response.Body.Close()
+		return nil, errors.New(response.Status)
+	}
+
+	contentType := response.Header.Get("content-type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	mediatype, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+	if mediatype == "text/html" || mediatype == "application/xhtml+xml" {
+		response.Body.Close()
+		return nil, ErrNotDocument
+	}
+	return &Document{
+		Body:          response.Body,
+		ContentType:   contentType,
+		ContentLength: response.ContentLength,
+		Filename:      getDocumentFilename(response),
+	}, nil
+}
+
+func getDocumentFilename(response *http.Response) string {
+	if _, params, err := mime.ParseMediaType(response.Header.Get("content-disposition")); err == nil && params["filename"] != "" {
+		return path.Base(params["filename"])
+	}
+	// The url is the one the request was redirected to.
+	if name := path.Base(response.Request.URL.Path); name != "/" && name != "." {
+		return name
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+package httpgetter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/files/handbook.pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+			_, _ = w.Write([]byte("%PDF-1.7"))
+		case "/download":
+			w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.wordprocessingml.document")
+			w.Header().Set("Content-Disposition", `attachment; filename="Q3 report.docx"`)
+			_, _ = w.Write([]byte("docx"))
+		case "/page":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte("<html></html>"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	document, err := getDocument(ctx, http.DefaultClient, server.URL+"/files/handbook.pdf")
+	require.NoError(t, err)
+	body, err := io.ReadAll(document.Body)
+	require.NoError(t, err)
+	document.Body.Close()
+	require.Equal(t, "%PDF-1.7", string(body))
+	require.Equal(t, "application/pdf", document.ContentType)
+	require.Equal(t, int64(8), document.ContentLength)
+	require.Equal(t, "handbook.pdf", document.Filename)
+
+	document, err = getDocument(ctx, http.DefaultClient, server.URL+"/download")
+	require.NoError(t, err)
+	document.Body.Close()
+	require.Equal(t, "Q3 report.docx", document.Filename)
+
+	_, err = getDocument(ctx, http.DefaultClient, server.URL+"/page")
+	require.True(t, errors.Is(err, ErrNotDocument))
+	_, err = getDocument(ctx, http.DefaultClient, server.URL+"/missing.pdf")
+	require.Error(t, err)
+	_, err = getDocument(ctx, http.DefaultClient, "ftp://example.com/handbook.pdf")
+	require.Error(t, err)
+
+	// The documents of the private networks aren't proxied.
+	_, err = GetDocument(ctx, server.URL+"/files/handbook.pdf")
+	require.True(t, errors.Is(err, ErrForbiddenAddress))
+}
@@ -1,47 +1,90 @@
 package httpgetter
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 )
 
+// maxHTMLSize bounds the HTML read for the metadata, which is in the head of the page.
+const maxHTMLSize = 1 << 20
+
 type HTMLMeta struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	Image       string `json:"image"`
+	Favicon     string `json:"favicon"`
 }
 
-func GetHTMLMeta(urlStr string) (*HTMLMeta, error) {
-	if _, err := url.Parse(urlStr); err != nil {
+// GetHTMLMeta requests the HTML page of the url and returns its metadata, with the image and favicon urls absolute.
+// The url must be http(s), and the requests to the addresses of the private networks are forbidden.
+func GetHTMLMeta(ctx context.Context, urlStr string) (*HTMLMeta, error) {
+	response, err := getHTMLResponse(ctx, urlStr)
+	if err != nil {
 		return nil, err
 	}
+	defer response.Body.Close()
 
-	response, err := http.Get(urlStr)
+	htmlMeta := extractHTMLMeta(io.LimitReader(response.Body, maxHTMLSize))
+	resolveHTMLMetaLinks(response.Request.URL, htmlMeta)
+	return htmlMeta, nil
+}
+
+// getHTMLResponse requests the HTML page of the url with the safe client.
+func getHTMLResponse(ctx context.Context, urlStr string) (*http.Response, error) {
+	u, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("not a http(s) url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := safeClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode >= http.StatusBadRequest {
+		response.Body.Close()
+		return nil, errors.New(response.Status)
+	}
 
 	mediatype, err := getMediatype(response)
 	if err != nil {
+		response.Body.Close()
 		return nil, err
 	}
 	if mediatype != "text/html" {
+		response.Body.Close()
 		return nil, errors.New("not a HTML page")
 	}
+	return response, nil
+}
 
-	htmlMeta := extractHTMLMeta(response.Body)
-	return htmlMeta, nil
+// resolveHTMLMetaLinks resolves the image and favicon of the page, which are relative to the url it was redirected to.
+func resolveHTMLMetaLinks(baseURL *url.URL, htmlMeta *HTMLMeta) {
+	htmlMeta.Image = resolveReference(baseURL, htmlMeta.Image)
+	if htmlMeta.Favicon == "" {
+		htmlMeta.Favicon = "/favicon.ico"
+	}
+	htmlMeta.Favicon = resolveReference(baseURL, htmlMeta.Favicon)
 }
 
 func extractHTMLMeta(resp io.Reader) *HTMLMeta {
 	tokenizer := html.NewTokenizer(resp)
 	htmlMeta := new(HTMLMeta)
+	appleTouchIcon := ""
 
 	for {
 		tokenType := tokenizer.Next()
@@ -56,10 +99,13 @@ func extractHTMLMeta(resp io.Reader) *HTMLMeta {
 			if token.DataAtom == atom.Title {
 				tokenizer.Next()
 				token := tokenizer.Token()
-				htmlMeta.Title = token.Data
+				// The Open Graph title takes precedence over the title of the page.
+				if htmlMeta.Title == "" {
+					htmlMeta.Title = strings.TrimSpace(token.Data)
+				}
 			} else if token.DataAtom == atom.Meta {
 				description, ok := extractMetaProperty(token, "description")
-				if ok {
+				if ok && htmlMeta.Description == "" {
 					htmlMeta.Description = description
 				}
 
@@ -77,17 +123,32 @@ func extractHTMLMeta(resp io.Reader) *HTMLMeta {
 				if ok {
 					htmlMeta.Image = ogImage
 				}
+			} else if token.DataAtom == atom.Link {
+				rel, href := strings.Fields(strings.ToLower(getAttr(token, "rel"))), getAttr(token, "href")
+				if href == "" {
+					continue
+				}
+				if slices.Contains(rel, "icon") && htmlMeta.Favicon == "" {
+					htmlMeta.Favicon = href
+				} else if slices.Contains(rel, "apple-touch-icon") && appleTouchIcon == "" {
+					appleTouchIcon = href
+				}
 			}
 		}
 	}
+	if htmlMeta.Favicon == "" {
+		htmlMeta.Favicon = appleTouchIcon
+	}
 
 	return htmlMeta
 }
 
+// extractMetaProperty returns the content of the meta tag of the property, e.g. `<meta property="og:title">`,
+// or of the name, e.g. `<meta name="description">`.
 func extractMetaProperty(token html.Token, prop string) (content string, ok bool) {
 	content, ok = "", false
 	for _, attr := range token.Attr {
-		if attr.Key == "property" && attr.Val == prop {
+		if (attr.Key == "property" || attr.Key == "name") && attr.Val == prop {
 			ok = true
 		}
 		if attr.Key == "content" {
@@ -96,3 +157,24 @@ func extractMetaProperty(token html.Token, prop string) (content string, ok bool
 	}
 	return content, ok
 }
+
+func getAttr(token html.Token, key string) string {
+	for _, attr := range token.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// resolveReference resolves the link of the page against its url, or returns "" if it isn't a http(s) link.
+func resolveReference(baseURL *url.URL, link string) string {
+	if link == "" {
+		return ""
+	}
+	u, err := baseURL.Parse(strings.TrimSpace(link))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return u.String()
+}
@@ -1,8 +1,14 @@
 package httpgetter
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -12,8 +18,57 @@ func TestGetHTMLMeta(t *testing.T) {
 		htmlMeta HTMLMeta
 	}{}
 	for _, test := range tests {
-		metadata, err := GetHTMLMeta(test.urlStr)
+		metadata, err := GetHTMLMeta(context.Background(), test.urlStr)
 		require.NoError(t, err)
 		require.Equal(t, test.htmlMeta, *metadata)
 	}
 }
+
+func TestGetHTMLMetaForbiddenAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html><head><title>Internal</title></head></html>"))
+	}))
+	defer server.Close()
+
+	_, err := GetHTMLMeta(context.Background(), server.URL)
+	require.True(t, errors.Is(err, ErrForbiddenAddress))
+	_, err = GetHTMLMeta(context.Background(), "file:///etc/passwd")
+	require.Error(t, err)
+}
+
+func TestExtractHTMLMeta(t *testing.T) {
+	page := `<html><head>
+		<title> Example Docs </title>
+		<meta name="description" content="The docs of the example.">
+		<meta property="og:image" content="/images/cover.png">
+		<link rel="apple-touch-icon" href="/apple-touch-icon.png">
+		<link rel="shortcut icon" href="/static/favicon.png">
+	</head><body><meta property="og:title" content="Ignored"></body></html>`
+	htmlMeta := extractHTMLMeta(strings.NewReader(page))
+	require.Equal(t, HTMLMeta{
+		Title:       "Example Docs",
+		Description: "The docs of the example.",
+		Image:       "/images/cover.png",
+		Favicon:     "/static/favicon.png",
+	}, *htmlMeta)
+
+	page = `<html><head>
+		<meta property="og:title" content="Open Graph Title">
+		<title>Page Title</title>
+		<link rel="apple-touch-icon" href="/apple-touch-icon.png">
+	</head></html>`
+	htmlMeta = extractHTMLMeta(strings.NewReader(page))
+	require.Equal(t, "Open Graph Title", htmlMeta.Title)
+	require.Equal(t, "/apple-touch-icon.png", htmlMeta.Favicon)
+}
+
+func TestResolveReference(t *testing.T) {
+	baseURL, err := url.Parse("https://docs.example.com/guide/intro")
+	require.NoError(t, err)
+	require.Equal(t, "https://docs.example.com/favicon.ico", resolveReference(baseURL, "/favicon.ico"))
+	require.Equal(t, "https://docs.example.com/guide/cover.png", resolveReference(baseURL, "cover.png"))
+	require.Equal(t, "https://cdn.example.com/icon.png", resolveReference(baseURL, "//cdn.example.com/icon.png"))
+	require.Equal(t, "", resolveReference(baseURL, "javascript:alert(1)"))
+	require.Equal(t, "", resolveReference(baseURL, ""))
+}
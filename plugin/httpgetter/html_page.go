@@ -0,0 +1,85 @@
+package httpgetter
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// HTMLPage is the metadata and the text of a HTML page.
+type HTMLPage struct {
+	HTMLMeta
+	// Text is the visible text of the body, with the whitespace collapsed.
+	Text string
+}
+
+// GetHTMLPage requests the HTML page of the url and returns its metadata and at most maxTextLength bytes of its text.
+// Like GetHTMLMeta, the requests to the addresses of the private networks are forbidden.
+func GetHTMLPage(ctx context.Context, urlStr string, maxTextLength int) (*HTMLPage, error) {
+	response, err := getHTMLResponse(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	htmlBytes, err := io.ReadAll(io.LimitReader(response.Body, maxHTMLSize))
+	if err != nil {
+		return nil, err
+	}
+	htmlMeta := extractHTMLMeta(bytes.NewReader(htmlBytes))
+	resolveHTMLMetaLinks(response.Request.URL, htmlMeta)
+	return &HTMLPage{
+		HTMLMeta: *htmlMeta,
+		Text:     extractHTMLText(bytes.NewReader(htmlBytes), maxTextLength),
+	}, nil
+}
+
+// extractHTMLText returns at most maxLength bytes of the text of the body, without the scripts, styles and templates.
+func extractHTMLText(r io.Reader, maxLength int) string {
+	tokenizer := html.NewTokenizer(r)
+	builder := strings.Builder{}
+	inBody, skipDepth := false, 0
+	for builder.Len() < maxLength {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+		token := tokenizer.Token()
+		switch tokenType {
+		case html.StartTagToken:
+			if token.DataAtom == atom.Body {
+				inBody = true
+			} else if isHiddenTextTag(token.DataAtom) {
+				skipDepth++
+			}
+		case html.EndTagToken:
+			if isHiddenTextTag(token.DataAtom) && skipDepth > 0 {
+				skipDepth--
+			}
+		case html.TextToken:
+			if !inBody || skipDepth > 0 {
+				continue
+			}
+			for _, word := range strings.Fields(token.Data) {
+				if builder.Len() > 0 {
+					builder.WriteByte(' ')
+				}
+				builder.WriteString(word)
+			}
+		}
+	}
+	text := builder.String()
+	if len(text) > maxLength {
+		// The text is cut at a rune boundary.
+		text = strings.ToValidUTF8(text[:maxLength], "")
+	}
+	return text
+}
+
+func isHiddenTextTag(tag atom.Atom) bool {
+	return tag == atom.Script || tag == atom.Style || tag == atom.Noscript || tag == atom.Template || tag == atom.Svg
+}
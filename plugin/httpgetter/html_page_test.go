@@ -0,0 +1,24 @@
+package httpgetter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractHTMLText(t *testing.T) {
+	page := `<html><head><title>Example Docs</title><style>body { color: red; }</style></head>
+	<body>
+		<h1>Getting   started</h1>
+		<script>console.log("hidden");</script>
+		<p>Install the <b>CLI</b>,
+		then sign in.</p>
+		<noscript>Enable JavaScript</noscript>
+	</body></html>`
+	require.Equal(t, "Getting started Install the CLI , then sign in.", extractHTMLText(strings.NewReader(page), 1000))
+	require.Equal(t, "Getting st", extractHTMLText(strings.NewReader(page), 10))
+	require.Equal(t, "", extractHTMLText(strings.NewReader("<html><head><title>Empty</title></head></html>"), 1000))
+	// The text isn't cut in the middle of a rune.
+	require.Equal(t, "caf", extractHTMLText(strings.NewReader("<body>café</body>"), 4))
+}
@@ -0,0 +1,50 @@
+package httpgetter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// reachabilityTimeout is the timeout of checking whether a url is reachable.
+const reachabilityTimeout = 5 * time.Second
+
+// reachabilityClient is the safe client with the timeout of the reachability checks, so that the checks don't reveal
+// the hosts and the ports of the network of the server.
+var reachabilityClient = NewSafeClient(reachabilityTimeout)
+
+// CheckReachability requests the url and returns the status code of the response.
+// The url is requested with HEAD first, and with GET when the server doesn't support HEAD.
+// An error is returned when the url can't be requested or the status code is an error.
+func CheckReachability(ctx context.Context, urlStr string) (int, error) {
+	statusCode, err := requestStatusCode(ctx, http.MethodHead, urlStr)
+	if err == nil && (statusCode == http.StatusMethodNotAllowed || statusCode == http.StatusNotImplemented) {
+		statusCode, err = requestStatusCode(ctx, http.MethodGet, urlStr)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if statusCode >= http.StatusBadRequest {
+		return statusCode, fmt.Errorf("unexpected status: %d %s", statusCode, http.StatusText(statusCode))
+	}
+	return statusCode, nil
+}
+
+func requestStatusCode(ctx context.Context, method, urlStr string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, nil)
+	if err != nil {
+		return 0, err
+	}
+	response, err := reachabilityClient.Do(req)
+	if err != nil {
+		var urlErr interface{ Timeout() bool }
+		if errors.As(err, &urlErr) && urlErr.Timeout() {
+			return 0, errors.New("timed out")
+		}
+		return 0, err
+	}
+	defer response.Body.Close()
+	return response.StatusCode, nil
+}
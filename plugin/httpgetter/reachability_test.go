@@ -0,0 +1,55 @@
+package httpgetter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckReachability(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/get-only", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	// The test server listens on the loopback address, which the safe client doesn't connect to.
+	ctx := context.Background()
+	_, err := CheckReachability(ctx, s.URL+"/ok")
+	require.True(t, errors.Is(err, ErrForbiddenAddress))
+	_, err = CheckReachability(ctx, "http://169.254.169.254/latest/meta-data/")
+	require.True(t, errors.Is(err, ErrForbiddenAddress))
+
+	safeReachabilityClient := reachabilityClient
+	reachabilityClient = s.Client()
+	defer func() {
+		reachabilityClient = safeReachabilityClient
+	}()
+	statusCode, err := CheckReachability(ctx, s.URL+"/ok")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+
+	statusCode, err = CheckReachability(ctx, s.URL+"/get-only")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+
+	statusCode, err = CheckReachability(ctx, s.URL+"/missing")
+	assert.ErrorContains(t, err, "404")
+	assert.Equal(t, http.StatusNotFound, statusCode)
+
+	_, err = CheckReachability(ctx, "http://127.0.0.1:1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,84 @@
+package httpgetter
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	safeClientTimeout = 10 * time.Second
+	maxSafeRedirects  = 5
+)
+
+// ErrForbiddenAddress is returned when a request would connect to a loopback, private or link-local address.
+var ErrForbiddenAddress = errors.New("the address of the link is forbidden")
+
+// sharedAddressSpace is the carrier-grade NAT range, which net.IP.IsPrivate doesn't cover.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// safeClient requests the links of the users from the server, so that it doesn't connect to the addresses of
+// its own network, e.g. the cloud metadata endpoints. The addresses are checked when connecting, after the
+// host is resolved, so that the host can't be resolved to another address after it's checked.
+var safeClient = &http.Client{
+	Timeout: safeClientTimeout,
+	Transport: &http.Transport{
+		// The proxy would be connected to instead of the host, so the checks would miss the host.
+		Proxy: nil,
+		DialContext: (&net.Dialer{
+			Timeout: safeClientTimeout,
+			Control: func(_, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				if ip := net.ParseIP(host); ip == nil || isForbiddenIP(ip) {
+					return ErrForbiddenAddress
+				}
+				return nil
+			},
+		}).DialContext,
+		TLSHandshakeTimeout:   safeClientTimeout,
+		ResponseHeaderTimeout: safeClientTimeout,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxSafeRedirects {
+			return errors.New("too many redirects")
+		}
+		if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+			return errors.New("redirected to a link which isn't http(s)")
+		}
+		return nil
+	},
+}
+
+// NewSafeClient returns a client with the transport of the safe client and the timeout, e.g. to post to the urls
+// set by the users.
+func NewSafeClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:       timeout,
+		Transport:     safeClient.Transport,
+		CheckRedirect: safeClient.CheckRedirect,
+	}
+}
+
+// IsForbiddenHost returns true if the host of a url is a loopback, private or link-local address, or a name of the
+// loopback. The other names are checked when connecting with the safe client, once they're resolved.
+func IsForbiddenHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	if ip := net.ParseIP(strings.Trim(host, "[]")); ip != nil {
+		return isForbiddenIP(ip)
+	}
+	return false
+}
+
+func isForbiddenIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || sharedAddressSpace.Contains(ip)
+}
@@ -4,4 +4,6 @@ type IdentityProviderUserInfo struct {
 	Identifier  string
 	Email       string
 	DisplayName string
+	// Groups is the groups of the user, mapped when the groups field mapping is set.
+	Groups []string
 }
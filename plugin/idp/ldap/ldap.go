@@ -0,0 +1,229 @@
+// Package ldap is the plugin for LDAP and Active Directory Identity Provider,
+// where users sign in with the username and password of the directory.
+package ldap
+
+import (
+	"crypto/tls"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+
+	"github.com/warthurton/slash/plugin/idp"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+const (
+	// dialTimeout is the timeout of connecting to the server.
+	dialTimeout = 10 * time.Second
+	// requestTimeout is the timeout of each request to the server.
+	requestTimeout = 10 * time.Second
+	// usernamePlaceholder is replaced with the escaped username in the user filter.
+	usernamePlaceholder = "%s"
+)
+
+// ErrInvalidCredentials is returned when the user isn't found or the password doesn't match.
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// IdentityProvider represents an LDAP Identity Provider.
+type IdentityProvider struct {
+	config *storepb.IdentityProviderConfig_LDAPConfig
+	// dial connects to the server, which is replaced in tests.
+	dial func() (ldap.Client, error)
+}
+
+// NewIdentityProvider initializes a new LDAP Identity Provider with the given configuration.
+func NewIdentityProvider(config *storepb.IdentityProviderConfig_LDAPConfig) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.Url:                               "url",
+		config.SearchBase:                        "searchBase",
+		config.UserFilter:                        "userFilter",
+		config.GetFieldMapping().GetIdentifier(): "fieldMapping.identifier",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
+		}
+	}
+	serverURL, err := url.Parse(config.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+	if serverURL.Scheme != "ldap" && serverURL.Scheme != "ldaps" {
+		return nil, errors.Errorf(`the url must start with "ldap://" or "ldaps://"`)
+	}
+	if config.StartTls && serverURL.Scheme == "ldaps" {
+		return nil, errors.New(`StartTLS is only supported with "ldap://"`)
+	}
+	if config.BindDn != "" && config.BindPassword == "" {
+		return nil, errors.New("the bind password is required with the bind DN")
+	}
+	if !strings.Contains(config.UserFilter, usernamePlaceholder) {
+		return nil, errors.Errorf("the user filter must contain %q for the username", usernamePlaceholder)
+	}
+	if _, err := ldap.CompileFilter(buildUserFilter(config.UserFilter, "username")); err != nil {
+		return nil, errors.Wrap(err, "invalid user filter")
+	}
+
+	p := &IdentityProvider{
+		config: config,
+	}
+	p.dial = func() (ldap.Client, error) {
+		return dial(config, serverURL.Hostname())
+	}
+	return p, nil
+}
+
+func dial(config *storepb.IdentityProviderConfig_LDAPConfig, hostname string) (ldap.Client, error) {
+	conn, err := ldap.DialURL(config.Url, ldap.DialWithDialer(&net.Dialer{Timeout: dialTimeout}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect")
+	}
+	conn.SetTimeout(requestTimeout)
+	if config.StartTls {
+		if err := conn.StartTLS(&tls.Config{ServerName: hostname}); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	return conn, nil
+}
+
+// bind binds with the service account to search the users, or keeps the connection anonymous without the bind DN.
+func (p *IdentityProvider) bind(conn ldap.Client) error {
+	if p.config.BindDn == "" {
+		return nil
+	}
+	if err := conn.Bind(p.config.BindDn, p.config.BindPassword); err != nil {
+		return errors.Wrap(err, "failed to bind")
+	}
+	return nil
+}
+
+func buildUserFilter(userFilter, username string) string {
+	return strings.ReplaceAll(userFilter, usernamePlaceholder, ldap.EscapeFilter(username))
+}
+
+// Authenticate searches the user by the username, verifies the password by binding as the user,
+// and returns the user information of the entry.
+func (p *IdentityProvider) Authenticate(username, password string) (*idp.IdentityProviderUserInfo, error) {
+	// An empty password is an unauthenticated bind, which most servers accept without checking anything.
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := p.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := p.bind(conn); err != nil {
+		return nil, err
+	}
+
+	fieldMapping := p.config.GetFieldMapping()
+	attributes := []string{fieldMapping.GetIdentifier()}
+	if fieldMapping.GetDisplayName() != "" {
+		attributes = append(attributes, fieldMapping.GetDisplayName())
+	}
+	if fieldMapping.GetGroups() != "" {
+		attributes = append(attributes, fieldMapping.GetGroups())
+	}
+	result, err := conn.Search(ldap.NewSearchRequest(
+		p.config.SearchBase,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		// Two entries are enough to tell the username is ambiguous.
+		2,
+		int(requestTimeout.Seconds()),
+		false,
+		buildUserFilter(p.config.UserFilter, username),
+		attributes,
+		nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, errors.Wrap(err, "failed to search user")
+	}
+	if result == nil || len(result.Entries) == 0 {
+		return nil, ErrInvalidCredentials
+	}
+	if len(result.Entries) > 1 {
+		return nil, errors.Errorf("multiple users match the username %q", username)
+	}
+
+	entry := result.Entries[0]
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, errors.Wrap(err, "failed to bind as user")
+	}
+	return p.userInfoFromEntry(entry)
+}
+
+func (p *IdentityProvider) userInfoFromEntry(entry *ldap.Entry) (*idp.IdentityProviderUserInfo, error) {
+	fieldMapping := p.config.GetFieldMapping()
+	userInfo := &idp.IdentityProviderUserInfo{
+		Identifier: entry.GetAttributeValue(fieldMapping.GetIdentifier()),
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the attribute %q is not found in the user entry or has empty value", fieldMapping.GetIdentifier())
+	}
+
+	// Best effort to map optional fields.
+	if fieldMapping.GetDisplayName() != "" {
+		userInfo.DisplayName = entry.GetAttributeValue(fieldMapping.GetDisplayName())
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if fieldMapping.GetGroups() != "" {
+		userInfo.Groups = entry.GetAttributeValues(fieldMapping.GetGroups())
+	}
+	return userInfo, nil
+}
+
+// EndpointCheck is the check result of the config of the identity provider.
+type EndpointCheck struct {
+	// Name is the config field name, e.g. "url".
+	Name string
+	// Err is nil when the check passes.
+	Err error
+}
+
+// CheckEndpoints checks whether the server is reachable, the bind DN is accepted and the search base exists.
+// The following checks are skipped when a check fails.
+func (p *IdentityProvider) CheckEndpoints() []*EndpointCheck {
+	conn, err := p.dial()
+	checks := []*EndpointCheck{{Name: "url", Err: err}}
+	if err != nil {
+		return checks
+	}
+	defer conn.Close()
+
+	err = p.bind(conn)
+	if p.config.BindDn != "" {
+		checks = append(checks, &EndpointCheck{Name: "bindDn", Err: err})
+	}
+	if err != nil {
+		return checks
+	}
+
+	_, err = conn.Search(ldap.NewSearchRequest(
+		p.config.SearchBase,
+		ldap.ScopeBaseObject,
+		ldap.NeverDerefAliases,
+		1,
+		int(requestTimeout.Seconds()),
+		false,
+		"(objectClass=*)",
+		[]string{"dn"},
+		nil,
+	))
+	if err != nil {
+		err = errors.Wrap(err, "failed to find the search base")
+	}
+	return append(checks, &EndpointCheck{Name: "searchBase", Err: err})
+}
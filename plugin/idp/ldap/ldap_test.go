@@ -0,0 +1,188 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warthurton/slash/plugin/idp"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+// fakeClient is a directory with the users of the given DNs and passwords, where the entries are found by the filter.
+type fakeClient struct {
+	ldap.Client
+	passwords map[string]string
+	entries   map[string][]*ldap.Entry
+	filters   []string
+}
+
+func (c *fakeClient) Bind(username, password string) error {
+	if c.passwords[username] == "" || c.passwords[username] != password {
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, nil)
+	}
+	return nil
+}
+
+func (c *fakeClient) Search(request *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	c.filters = append(c.filters, request.Filter)
+	return &ldap.SearchResult{Entries: c.entries[request.Filter]}, nil
+}
+
+func (*fakeClient) Close() error {
+	return nil
+}
+
+func newTestConfig() *storepb.IdentityProviderConfig_LDAPConfig {
+	return &storepb.IdentityProviderConfig_LDAPConfig{
+		Url:          "ldap://ldap.example.com",
+		BindDn:       "cn=slash,dc=example,dc=com",
+		BindPassword: "service-password",
+		SearchBase:   "ou=people,dc=example,dc=com",
+		UserFilter:   "(uid=%s)",
+		FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+			Identifier:  "mail",
+			DisplayName: "cn",
+		},
+	}
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	_, err := NewIdentityProvider(newTestConfig())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		update      func(config *storepb.IdentityProviderConfig_LDAPConfig)
+		containsErr string
+	}{
+		{
+			name:        "no url",
+			update:      func(config *storepb.IdentityProviderConfig_LDAPConfig) { config.Url = "" },
+			containsErr: `the field "url" is empty but required`,
+		},
+		{
+			name:        "no identifier",
+			update:      func(config *storepb.IdentityProviderConfig_LDAPConfig) { config.FieldMapping = nil },
+			containsErr: `the field "fieldMapping.identifier" is empty but required`,
+		},
+		{
+			name:        "invalid scheme",
+			update:      func(config *storepb.IdentityProviderConfig_LDAPConfig) { config.Url = "https://ldap.example.com" },
+			containsErr: `the url must start with "ldap://" or "ldaps://"`,
+		},
+		{
+			name: "StartTLS with ldaps",
+			update: func(config *storepb.IdentityProviderConfig_LDAPConfig) {
+				config.Url = "ldaps://ldap.example.com"
+				config.StartTls = true
+			},
+			containsErr: "StartTLS is only supported",
+		},
+		{
+			name:        "no bind password",
+			update:      func(config *storepb.IdentityProviderConfig_LDAPConfig) { config.BindPassword = "" },
+			containsErr: "the bind password is required",
+		},
+		{
+			name:        "no username placeholder",
+			update:      func(config *storepb.IdentityProviderConfig_LDAPConfig) { config.UserFilter = "(uid=jane)" },
+			containsErr: "the user filter must contain",
+		},
+		{
+			name:        "invalid user filter",
+			update:      func(config *storepb.IdentityProviderConfig_LDAPConfig) { config.UserFilter = "(uid=%s" },
+			containsErr: "invalid user filter",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := newTestConfig()
+			test.update(config)
+			_, err := NewIdentityProvider(config)
+			require.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	identityProvider, err := NewIdentityProvider(newTestConfig())
+	require.NoError(t, err)
+	client := &fakeClient{
+		passwords: map[string]string{
+			"cn=slash,dc=example,dc=com":           "service-password",
+			"uid=jane,ou=people,dc=example,dc=com": "jane-password",
+			"uid=john,ou=people,dc=example,dc=com": "john-password",
+		},
+		entries: map[string][]*ldap.Entry{
+			"(uid=jane)": {
+				ldap.NewEntry("uid=jane,ou=people,dc=example,dc=com", map[string][]string{
+					"mail": {"jane@example.com"},
+					"cn":   {"Jane Doe"},
+				}),
+			},
+			"(uid=john)": {
+				ldap.NewEntry("uid=john,ou=people,dc=example,dc=com", map[string][]string{
+					"cn": {"John Doe"},
+				}),
+			},
+			"(uid=doe)": {
+				ldap.NewEntry("uid=jane,ou=people,dc=example,dc=com", nil),
+				ldap.NewEntry("uid=john,ou=people,dc=example,dc=com", nil),
+			},
+		},
+	}
+	identityProvider.dial = func() (ldap.Client, error) {
+		return client, nil
+	}
+
+	userInfo, err := identityProvider.Authenticate("jane", "jane-password")
+	require.NoError(t, err)
+	require.Equal(t, &idp.IdentityProviderUserInfo{
+		Identifier:  "jane@example.com",
+		DisplayName: "Jane Doe",
+	}, userInfo)
+
+	_, err = identityProvider.Authenticate("jane", "john-password")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = identityProvider.Authenticate("jane", "")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = identityProvider.Authenticate("unknown", "jane-password")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = identityProvider.Authenticate("doe", "jane-password")
+	require.ErrorContains(t, err, "multiple users match")
+	_, err = identityProvider.Authenticate("john", "john-password")
+	require.ErrorContains(t, err, `the attribute "mail" is not found`)
+
+	// The username is escaped in the filter.
+	_, err = identityProvider.Authenticate("*)(uid=jane", "jane-password")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	require.Equal(t, `(uid=\2a\29\28uid=jane)`, client.filters[len(client.filters)-1])
+}
+
+func TestCheckEndpoints(t *testing.T) {
+	identityProvider, err := NewIdentityProvider(newTestConfig())
+	require.NoError(t, err)
+	client := &fakeClient{
+		passwords: map[string]string{
+			"cn=slash,dc=example,dc=com": "wrong-password",
+		},
+	}
+	identityProvider.dial = func() (ldap.Client, error) {
+		return client, nil
+	}
+
+	checks := identityProvider.CheckEndpoints()
+	require.Len(t, checks, 2)
+	require.Equal(t, "url", checks[0].Name)
+	require.NoError(t, checks[0].Err)
+	require.Equal(t, "bindDn", checks[1].Name)
+	require.Error(t, checks[1].Err)
+
+	client.passwords["cn=slash,dc=example,dc=com"] = "service-password"
+	checks = identityProvider.CheckEndpoints()
+	require.Len(t, checks, 3)
+	require.Equal(t, "searchBase", checks[2].Name)
+	require.NoError(t, checks[2].Err)
+}
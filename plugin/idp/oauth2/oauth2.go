@@ -7,7 +7,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/pkg/errors"
 	"golang.org/x/oauth2"
 
@@ -39,8 +44,28 @@ func NewIdentityProvider(config *storepb.IdentityProviderConfig_OAuth2Config) (*
 	}, nil
 }
 
+// Discover populates the endpoints of the config from the discovery document of its OpenID Connect issuer,
+// and adds the "openid" scope to request the ID token.
+func Discover(ctx context.Context, config *storepb.IdentityProviderConfig_OAuth2Config) error {
+	provider, err := oidc.NewProvider(ctx, config.IssuerUrl)
+	if err != nil {
+		return errors.Wrap(err, "failed to discover OpenID Connect provider")
+	}
+	if provider.UserInfoEndpoint() == "" {
+		return errors.New(`the discovery document has no "userinfo_endpoint"`)
+	}
+	config.AuthUrl = provider.Endpoint().AuthURL
+	config.TokenUrl = provider.Endpoint().TokenURL
+	config.UserInfoUrl = provider.UserInfoEndpoint()
+	if !slices.Contains(config.Scopes, oidc.ScopeOpenID) {
+		config.Scopes = append([]string{oidc.ScopeOpenID}, config.Scopes...)
+	}
+	return nil
+}
+
 // ExchangeToken returns the exchanged OAuth2 token using the given authorization code.
-func (p *IdentityProvider) ExchangeToken(ctx context.Context, redirectURL, code string) (string, error) {
+// For an OpenID Connect provider, the ID token of the response is verified to be issued to the client with the nonce.
+func (p *IdentityProvider) ExchangeToken(ctx context.Context, redirectURL, code, nonce string) (string, error) {
 	conf := &oauth2.Config{
 		ClientID:     p.config.ClientId,
 		ClientSecret: p.config.ClientSecret,
@@ -62,10 +87,34 @@ func (p *IdentityProvider) ExchangeToken(ctx context.Context, redirectURL, code
 	if !ok {
 		return "", errors.New(`missing "access_token" from authorization response`)
 	}
+	if p.config.IssuerUrl != "" {
+		if err := p.verifyIDToken(ctx, token, nonce); err != nil {
+			return "", err
+		}
+	}
 
 	return accessToken, nil
 }
 
+func (p *IdentityProvider) verifyIDToken(ctx context.Context, token *oauth2.Token, nonce string) error {
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return errors.New(`missing "id_token" from authorization response`)
+	}
+	provider, err := oidc.NewProvider(ctx, p.config.IssuerUrl)
+	if err != nil {
+		return errors.Wrap(err, "failed to discover OpenID Connect provider")
+	}
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: p.config.ClientId}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return errors.Wrap(err, "invalid ID token")
+	}
+	if nonce == "" || idToken.Nonce != nonce {
+		return errors.New("the nonce of the ID token doesn't match")
+	}
+	return nil
+}
+
 // UserInfo returns the parsed user information using the given OAuth2 token.
 func (p *IdentityProvider) UserInfo(token string) (*idp.IdentityProviderUserInfo, error) {
 	client := &http.Client{}
@@ -108,5 +157,80 @@ func (p *IdentityProvider) UserInfo(token string) (*idp.IdentityProviderUserInfo
 	if userInfo.DisplayName == "" {
 		userInfo.DisplayName = userInfo.Identifier
 	}
+	if p.config.FieldMapping.Groups != "" {
+		// The groups claim is either a list or a single group.
+		switch v := claims[p.config.FieldMapping.Groups].(type) {
+		case []any:
+			for _, group := range v {
+				if group, ok := group.(string); ok {
+					userInfo.Groups = append(userInfo.Groups, group)
+				}
+			}
+		case string:
+			userInfo.Groups = []string{v}
+		}
+	}
 	return userInfo, nil
 }
+
+// EndpointCheck is the reachability check result of an endpoint of the identity provider.
+type EndpointCheck struct {
+	// Name is the config field name of the endpoint, e.g. "tokenUrl".
+	Name string
+	// Err is nil when the endpoint is reachable.
+	Err error
+}
+
+// checkTimeout is the timeout of checking each endpoint.
+const checkTimeout = 10 * time.Second
+
+// CheckEndpoints sends a request to each configured endpoint and reports whether it's reachable.
+// The token endpoint is requested with a dummy authorization code, so rejected client credentials are reported as well.
+func (p *IdentityProvider) CheckEndpoints(ctx context.Context) []*EndpointCheck {
+	client := &http.Client{Timeout: checkTimeout}
+	checks := []*EndpointCheck{}
+	if p.config.AuthUrl != "" {
+		checks = append(checks, &EndpointCheck{Name: "authUrl", Err: checkEndpoint(ctx, client, http.MethodGet, p.config.AuthUrl, nil)})
+	}
+	tokenForm := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {"slash-connection-test"},
+		"client_id":     {p.config.ClientId},
+		"client_secret": {p.config.ClientSecret},
+	}
+	checks = append(checks, &EndpointCheck{Name: "tokenUrl", Err: checkEndpoint(ctx, client, http.MethodPost, p.config.TokenUrl, tokenForm)})
+	checks = append(checks, &EndpointCheck{Name: "userInfoUrl", Err: checkEndpoint(ctx, client, http.MethodGet, p.config.UserInfoUrl, nil)})
+	return checks
+}
+
+func checkEndpoint(ctx context.Context, client *http.Client, method, endpoint string, form url.Values) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return errors.Wrap(err, "invalid url")
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "unreachable")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return errors.Errorf("server error: %s", resp.Status)
+	}
+	if form != nil {
+		errorResponse := struct {
+			Error string `json:"error"`
+		}{}
+		if err := json.NewDecoder(resp.Body).Decode(&errorResponse); err == nil && errorResponse.Error == "invalid_client" {
+			return errors.New("client credentials are rejected")
+		}
+	}
+	return nil
+}
@@ -2,14 +2,20 @@ package oauth2
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -121,8 +127,9 @@ func TestIdentityProvider(t *testing.T) {
 	)
 	userInfo, err := json.Marshal(
 		map[string]any{
-			"email": testEmail,
-			"name":  testName,
+			"email":  testEmail,
+			"name":   testName,
+			"groups": []string{"eng", "slash-admins"},
 		},
 	)
 	require.NoError(t, err)
@@ -138,13 +145,15 @@ func TestIdentityProvider(t *testing.T) {
 			FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
 				Identifier:  "email",
 				DisplayName: "name",
+				Groups:      "groups",
+				AdminGroup:  "slash-admins",
 			},
 		},
 	)
 	require.NoError(t, err)
 
 	redirectURL := "https://example.com/oauth/callback"
-	oauthToken, err := oauth2.ExchangeToken(ctx, redirectURL, testCode)
+	oauthToken, err := oauth2.ExchangeToken(ctx, redirectURL, testCode, "")
 	require.NoError(t, err)
 	require.Equal(t, testAccessToken, oauthToken)
 
@@ -154,6 +163,114 @@ func TestIdentityProvider(t *testing.T) {
 	wantUserInfo := &idp.IdentityProviderUserInfo{
 		Identifier:  testEmail,
 		DisplayName: testName,
+		Groups:      []string{"eng", "slash-admins"},
 	}
 	assert.Equal(t, wantUserInfo, userInfoResult)
 }
+
+func newOIDCMockServer(t *testing.T, code, clientID, nonce string) *httptest.Server {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	const keyID = "test-key"
+
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                s.URL,
+			"authorization_endpoint":                s.URL + "/oauth2/authorize",
+			"token_endpoint":                        s.URL + "/oauth2/token",
+			"userinfo_endpoint":                     s.URL + "/oauth2/userinfo",
+			"jwks_uri":                              s.URL + "/oauth2/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/oauth2/keys", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]any{
+				{
+					"kty": "RSA",
+					"kid": keyID,
+					"alg": "RS256",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+				},
+			},
+		})
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, code, r.PostForm.Get("code"))
+
+		idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   s.URL,
+			"sub":   "john",
+			"aud":   clientID,
+			"nonce": nonce,
+			"iat":   time.Now().Unix(),
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		})
+		idToken.Header["kid"] = keyID
+		rawIDToken, err := idToken.SignedString(privateKey)
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "test-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     rawIDToken,
+		})
+		require.NoError(t, err)
+	})
+	return s
+}
+
+func TestOIDCIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		testClientID = "test-client-id"
+		testCode     = "test-code"
+		testNonce    = "test-nonce"
+	)
+	s := newOIDCMockServer(t, testCode, testClientID, testNonce)
+	defer s.Close()
+
+	config := &storepb.IdentityProviderConfig_OAuth2Config{
+		ClientId:     testClientID,
+		ClientSecret: "test-client-secret",
+		IssuerUrl:    s.URL,
+		Scopes:       []string{"email"},
+		FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+			Identifier: "email",
+		},
+	}
+	require.NoError(t, Discover(ctx, config))
+	assert.Equal(t, s.URL+"/oauth2/authorize", config.AuthUrl)
+	assert.Equal(t, s.URL+"/oauth2/token", config.TokenUrl)
+	assert.Equal(t, s.URL+"/oauth2/userinfo", config.UserInfoUrl)
+	assert.Equal(t, []string{"openid", "email"}, config.Scopes)
+
+	oauth2, err := NewIdentityProvider(config)
+	require.NoError(t, err)
+
+	redirectURL := "https://example.com/oauth/callback"
+	oauthToken, err := oauth2.ExchangeToken(ctx, redirectURL, testCode, testNonce)
+	require.NoError(t, err)
+	assert.Equal(t, "test-access-token", oauthToken)
+
+	_, err = oauth2.ExchangeToken(ctx, redirectURL, testCode, "another-nonce")
+	assert.ErrorContains(t, err, "nonce")
+	_, err = oauth2.ExchangeToken(ctx, redirectURL, testCode, "")
+	assert.ErrorContains(t, err, "nonce")
+
+	config.ClientId = "another-client-id"
+	_, err = oauth2.ExchangeToken(ctx, redirectURL, testCode, testNonce)
+	assert.ErrorContains(t, err, "invalid ID token")
+}
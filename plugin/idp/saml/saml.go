@@ -0,0 +1,239 @@
+// Package saml is the plugin for SAML 2.0 Identity Provider, where Slash is the service provider.
+package saml
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/pkg/errors"
+
+	"github.com/warthurton/slash/plugin/idp"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+// IdentityProvider represents a SAML 2.0 Identity Provider.
+type IdentityProvider struct {
+	config      *storepb.IdentityProviderConfig_SAMLConfig
+	certificate *x509.Certificate
+	sp          *saml.ServiceProvider
+}
+
+// NewIdentityProvider initializes a new SAML Identity Provider with the given configuration,
+// and the entity id and the assertion consumer service url of Slash as the service provider.
+func NewIdentityProvider(config *storepb.IdentityProviderConfig_SAMLConfig, entityID, acsURL string) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.EntityId:    "entityId",
+		config.SsoUrl:      "ssoUrl",
+		config.Certificate: "certificate",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
+		}
+	}
+	certificate, err := ParseCertificate(config.Certificate)
+	if err != nil {
+		return nil, err
+	}
+	parsedACSURL, err := url.Parse(acsURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid assertion consumer service url")
+	}
+
+	return &IdentityProvider{
+		config:      config,
+		certificate: certificate,
+		sp: &saml.ServiceProvider{
+			EntityID:          entityID,
+			AcsURL:            *parsedACSURL,
+			AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+			IDPMetadata: &saml.EntityDescriptor{
+				EntityID: config.EntityId,
+				IDPSSODescriptors: []saml.IDPSSODescriptor{
+					{
+						SSODescriptor: saml.SSODescriptor{
+							RoleDescriptor: saml.RoleDescriptor{
+								KeyDescriptors: []saml.KeyDescriptor{
+									{
+										Use: "signing",
+										KeyInfo: saml.KeyInfo{
+											X509Data: saml.X509Data{
+												X509Certificates: []saml.X509Certificate{
+													{Data: base64.StdEncoding.EncodeToString(certificate.Raw)},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						SingleSignOnServices: []saml.Endpoint{
+							{
+								Binding:  saml.HTTPRedirectBinding,
+								Location: config.SsoUrl,
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// ParseCertificate parses the PEM encoded certificate of the identity provider.
+func ParseCertificate(certificate string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("the certificate is not PEM encoded")
+	}
+	parsedCertificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse certificate")
+	}
+	return parsedCertificate, nil
+}
+
+// Metadata returns the XML metadata of Slash as the service provider, which is registered to the identity provider.
+func (p *IdentityProvider) Metadata() ([]byte, error) {
+	metadata, err := xml.MarshalIndent(p.sp.Metadata(), "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal metadata")
+	}
+	return append([]byte(xml.Header), metadata...), nil
+}
+
+// AuthnRequestURL returns the url to redirect the user to sign in with the identity provider,
+// and the id of the authentication request to validate the response with.
+func (p *IdentityProvider) AuthnRequestURL(relayState string) (string, string, error) {
+	request, err := p.sp.MakeAuthenticationRequest(p.config.SsoUrl, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to make authentication request")
+	}
+	redirectURL, err := request.Redirect(relayState, p.sp)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to encode authentication request")
+	}
+	return redirectURL.String(), request.ID, nil
+}
+
+// UserInfo validates the base64 encoded SAML response posted to the assertion consumer service,
+// and returns the user information of its assertion.
+func (p *IdentityProvider) UserInfo(samlResponse string, possibleRequestIDs []string) (*idp.IdentityProviderUserInfo, error) {
+	responseXML, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode SAML response")
+	}
+	assertion, err := p.sp.ParseXMLResponse(responseXML, possibleRequestIDs, p.sp.AcsURL)
+	if err != nil {
+		// The private error contains the reason of the invalid response.
+		invalidResponseError := &saml.InvalidResponseError{}
+		if errors.As(err, &invalidResponseError) {
+			return nil, errors.Wrap(invalidResponseError.PrivateErr, "invalid SAML response")
+		}
+		return nil, errors.Wrap(err, "invalid SAML response")
+	}
+	return p.userInfoFromAssertion(assertion)
+}
+
+func (p *IdentityProvider) userInfoFromAssertion(assertion *saml.Assertion) (*idp.IdentityProviderUserInfo, error) {
+	fieldMapping := p.config.GetFieldMapping()
+	attributes := map[string]string{}
+	var groups []string
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			if len(attribute.Values) == 0 {
+				continue
+			}
+			// The attributes are matched by either the name or the friendly name.
+			attributes[attribute.Name] = attribute.Values[0].Value
+			if attribute.FriendlyName != "" {
+				attributes[attribute.FriendlyName] = attribute.Values[0].Value
+			}
+			// The groups attribute is multi-valued.
+			if fieldMapping.GetGroups() != "" && (attribute.Name == fieldMapping.Groups || attribute.FriendlyName == fieldMapping.Groups) {
+				for _, value := range attribute.Values {
+					groups = append(groups, value.Value)
+				}
+			}
+		}
+	}
+
+	userInfo := &idp.IdentityProviderUserInfo{}
+	if fieldMapping.GetIdentifier() == "" {
+		if assertion.Subject != nil && assertion.Subject.NameID != nil {
+			userInfo.Identifier = assertion.Subject.NameID.Value
+		}
+		if userInfo.Identifier == "" {
+			return nil, errors.New("the NameID is not found in the assertion")
+		}
+	} else {
+		userInfo.Identifier = attributes[fieldMapping.Identifier]
+		if userInfo.Identifier == "" {
+			return nil, errors.Errorf("the attribute %q is not found in the assertion or has empty value", fieldMapping.Identifier)
+		}
+	}
+
+	// Best effort to map optional fields.
+	if fieldMapping.GetDisplayName() != "" {
+		userInfo.DisplayName = attributes[fieldMapping.DisplayName]
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	userInfo.Groups = groups
+	return userInfo, nil
+}
+
+// EndpointCheck is the check result of the config of the identity provider.
+type EndpointCheck struct {
+	// Name is the config field name, e.g. "ssoUrl".
+	Name string
+	// Err is nil when the check passes.
+	Err error
+}
+
+// checkTimeout is the timeout of checking the single sign-on url.
+const checkTimeout = 10 * time.Second
+
+// CheckEndpoints checks whether the certificate is currently valid and the single sign-on url is reachable.
+func (p *IdentityProvider) CheckEndpoints(ctx context.Context) []*EndpointCheck {
+	checks := []*EndpointCheck{
+		{Name: "certificate", Err: checkCertificate(p.certificate, time.Now())},
+	}
+	client := &http.Client{Timeout: checkTimeout}
+	checks = append(checks, &EndpointCheck{Name: "ssoUrl", Err: checkEndpoint(ctx, client, p.config.SsoUrl)})
+	return checks
+}
+
+func checkCertificate(certificate *x509.Certificate, now time.Time) error {
+	if now.Before(certificate.NotBefore) {
+		return errors.Errorf("the certificate is not valid until %s", certificate.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(certificate.NotAfter) {
+		return errors.Errorf("the certificate has expired at %s", certificate.NotAfter.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func checkEndpoint(ctx context.Context, client *http.Client, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return errors.Wrap(err, "invalid url")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "unreachable")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return errors.Errorf("server error: %s", resp.Status)
+	}
+	return nil
+}
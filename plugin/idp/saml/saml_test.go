@@ -0,0 +1,248 @@
+package saml
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warthurton/slash/plugin/idp"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+const (
+	testEntityID = "https://slash.example.com/api/v1/saml/okta/metadata"
+	testACSURL   = "https://slash.example.com/api/v1/saml/okta/acs"
+	testIdPURL   = "https://idp.example.com/saml"
+)
+
+// newTestIdentityProvider returns a SAML identity provider which signs the responses with a generated key.
+func newTestIdentityProvider(t *testing.T) (*saml.IdentityProvider, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificateDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(certificateDER)
+	require.NoError(t, err)
+
+	metadataURL, _ := url.Parse(testIdPURL + "/metadata")
+	ssoURL, _ := url.Parse(testIdPURL + "/sso")
+	identityProvider := &saml.IdentityProvider{
+		Key:         key,
+		Certificate: certificate,
+		MetadataURL: *metadataURL,
+		SSOURL:      *ssoURL,
+	}
+	certificatePEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDER})
+	return identityProvider, string(certificatePEM)
+}
+
+type testServiceProviderProvider struct {
+	metadata *saml.EntityDescriptor
+}
+
+func (p *testServiceProviderProvider) GetServiceProvider(_ *http.Request, _ string) (*saml.EntityDescriptor, error) {
+	return p.metadata, nil
+}
+
+// signIn responds to the authentication request of the redirect url with a signed SAML response of the session.
+func signIn(t *testing.T, identityProvider *saml.IdentityProvider, serviceProvider *IdentityProvider, redirectURL string, session *saml.Session) string {
+	t.Helper()
+	identityProvider.ServiceProviderProvider = &testServiceProviderProvider{metadata: serviceProvider.sp.Metadata()}
+	request, err := saml.NewIdpAuthnRequest(identityProvider, httptest.NewRequest(http.MethodGet, redirectURL, nil))
+	require.NoError(t, err)
+	require.NoError(t, request.Validate())
+	require.NoError(t, saml.DefaultAssertionMaker{}.MakeAssertion(request, session))
+	require.NoError(t, request.MakeResponse())
+	doc := etree.NewDocument()
+	doc.SetRoot(request.ResponseEl)
+	response, err := doc.WriteToBytes()
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(response)
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	_, certificate := newTestIdentityProvider(t)
+	tests := []struct {
+		name        string
+		config      *storepb.IdentityProviderConfig_SAMLConfig
+		containsErr string
+	}{
+		{
+			name: "no ssoUrl",
+			config: &storepb.IdentityProviderConfig_SAMLConfig{
+				EntityId:    testIdPURL + "/metadata",
+				Certificate: certificate,
+			},
+			containsErr: `the field "ssoUrl" is empty but required`,
+		},
+		{
+			name: "no certificate",
+			config: &storepb.IdentityProviderConfig_SAMLConfig{
+				EntityId: testIdPURL + "/metadata",
+				SsoUrl:   testIdPURL + "/sso",
+			},
+			containsErr: `the field "certificate" is empty but required`,
+		},
+		{
+			name: "invalid certificate",
+			config: &storepb.IdentityProviderConfig_SAMLConfig{
+				EntityId:    testIdPURL + "/metadata",
+				SsoUrl:      testIdPURL + "/sso",
+				Certificate: "MIIC...",
+			},
+			containsErr: "the certificate is not PEM encoded",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewIdentityProvider(test.config, testEntityID, testACSURL)
+			assert.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+func TestIdentityProvider(t *testing.T) {
+	identityProvider, certificate := newTestIdentityProvider(t)
+	serviceProvider, err := NewIdentityProvider(&storepb.IdentityProviderConfig_SAMLConfig{
+		EntityId:    testIdPURL + "/metadata",
+		SsoUrl:      testIdPURL + "/sso",
+		Certificate: certificate,
+		FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+			Identifier:  "email",
+			DisplayName: "cn",
+		},
+	}, testEntityID, testACSURL)
+	require.NoError(t, err)
+
+	metadata, err := serviceProvider.Metadata()
+	require.NoError(t, err)
+	assert.Contains(t, string(metadata), `entityID="`+testEntityID+`"`)
+	assert.Contains(t, string(metadata), `Location="`+testACSURL+`"`)
+
+	redirectURL, requestID, err := serviceProvider.AuthnRequestURL("okta")
+	require.NoError(t, err)
+	assert.Contains(t, redirectURL, testIdPURL+"/sso?")
+	assert.Contains(t, redirectURL, "RelayState=okta")
+	session := &saml.Session{
+		ID:         "session",
+		CreateTime: time.Now(),
+		ExpireTime: time.Now().Add(time.Hour),
+		NameID:     "u-1",
+		CustomAttributes: []saml.Attribute{
+			{Name: "email", Values: []saml.AttributeValue{{Value: "jane@example.com"}}},
+			{Name: "cn", Values: []saml.AttributeValue{{Value: "Jane Doe"}}},
+		},
+	}
+	samlResponse := signIn(t, identityProvider, serviceProvider, redirectURL, session)
+
+	userInfo, err := serviceProvider.UserInfo(samlResponse, []string{requestID})
+	require.NoError(t, err)
+	assert.Equal(t, &idp.IdentityProviderUserInfo{
+		Identifier:  "jane@example.com",
+		DisplayName: "Jane Doe",
+	}, userInfo)
+
+	// The response must be requested by the browser.
+	_, err = serviceProvider.UserInfo(samlResponse, []string{"id-other"})
+	assert.ErrorContains(t, err, "invalid SAML response")
+
+	// The response must be signed by the configured certificate.
+	otherIdentityProvider, _ := newTestIdentityProvider(t)
+	_, err = serviceProvider.UserInfo(signIn(t, otherIdentityProvider, serviceProvider, redirectURL, session), []string{requestID})
+	assert.ErrorContains(t, err, "invalid SAML response")
+}
+
+func TestUserInfoFromAssertion(t *testing.T) {
+	assertion := &saml.Assertion{
+		Subject: &saml.Subject{
+			NameID: &saml.NameID{Value: "jane@example.com"},
+		},
+		AttributeStatements: []saml.AttributeStatement{
+			{
+				Attributes: []saml.Attribute{
+					{
+						Name:         "urn:oid:2.16.840.1.113730.3.1.241",
+						FriendlyName: "displayName",
+						Values:       []saml.AttributeValue{{Value: "Jane"}},
+					},
+					{
+						Name:   "groups",
+						Values: []saml.AttributeValue{{Value: "eng"}, {Value: "slash-admins"}},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		fieldMapping *storepb.IdentityProviderConfig_FieldMapping
+		want         *idp.IdentityProviderUserInfo
+		containsErr  string
+	}{
+		{
+			name:         "name id",
+			fieldMapping: &storepb.IdentityProviderConfig_FieldMapping{DisplayName: "displayName"},
+			want:         &idp.IdentityProviderUserInfo{Identifier: "jane@example.com", DisplayName: "Jane"},
+		},
+		{
+			name:         "attribute name",
+			fieldMapping: &storepb.IdentityProviderConfig_FieldMapping{Identifier: "urn:oid:2.16.840.1.113730.3.1.241"},
+			want:         &idp.IdentityProviderUserInfo{Identifier: "Jane", DisplayName: "Jane"},
+		},
+		{
+			name:         "groups",
+			fieldMapping: &storepb.IdentityProviderConfig_FieldMapping{Groups: "groups", AdminGroup: "slash-admins"},
+			want:         &idp.IdentityProviderUserInfo{Identifier: "jane@example.com", DisplayName: "jane@example.com", Groups: []string{"eng", "slash-admins"}},
+		},
+		{
+			name:         "missing attribute",
+			fieldMapping: &storepb.IdentityProviderConfig_FieldMapping{Identifier: "mail"},
+			containsErr:  `the attribute "mail" is not found`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			identityProvider := &IdentityProvider{
+				config: &storepb.IdentityProviderConfig_SAMLConfig{FieldMapping: test.fieldMapping},
+			}
+			userInfo, err := identityProvider.userInfoFromAssertion(assertion)
+			if test.containsErr != "" {
+				assert.ErrorContains(t, err, test.containsErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, userInfo)
+		})
+	}
+}
+
+func TestCheckCertificate(t *testing.T) {
+	certificate := &x509.Certificate{
+		NotBefore: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	assert.NoError(t, checkCertificate(certificate, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)))
+	assert.ErrorContains(t, checkCertificate(certificate, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)), "is not valid until")
+	assert.ErrorContains(t, checkCertificate(certificate, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)), "has expired")
+}
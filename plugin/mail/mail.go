@@ -4,8 +4,11 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io"
+	"net"
 	"net/mail"
 	"net/smtp"
+	"strconv"
+	"time"
 
 	"github.com/jordan-wright/email"
 	"github.com/pkg/errors"
@@ -42,6 +45,7 @@ func (e *Email) SetFrom(from string) *Email {
 	parsedAddr, err := mail.ParseAddress(from)
 	if err != nil {
 		e.err = errors.Wrapf(err, "Invalid from address: %s", from)
+		return e
 	}
 	e.from = parsedAddr.Address
 	e.e.From = parsedAddr.String()
@@ -174,6 +178,44 @@ func (c *SMTPClient) SendMail(e *Email) error {
 	}
 }
 
+// dialTimeout is the timeout of connecting to the SMTP server.
+const dialTimeout = 10 * time.Second
+
+// Verify connects and authenticates to the SMTP server without sending any email.
+func (c *SMTPClient) Verify() error {
+	addr := net.JoinHostPort(c.host, strconv.Itoa(c.port))
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	var conn net.Conn
+	var err error
+	if c.encryptionType == SMTPEncryptionTypeSSLTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{ServerName: c.host})
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to %s", addr)
+	}
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrap(err, "failed to handshake")
+	}
+	defer client.Close()
+
+	if c.encryptionType == SMTPEncryptionTypeSTARTTLS {
+		if err := client.StartTLS(&tls.Config{InsecureSkipVerify: true}); err != nil {
+			return errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	if auth := c.getAuth(); auth != nil {
+		if err := client.Auth(auth); err != nil {
+			return errors.Wrap(err, "failed to authenticate")
+		}
+	}
+	return client.Quit()
+}
+
 // SetAuthType sets the auth type of the SMTP client.
 func (c *SMTPClient) SetAuthType(authType SMTPAuthType) *SMTPClient {
 	c.authType = authType
@@ -0,0 +1,165 @@
+package qrcode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"strings"
+
+	"github.com/pkg/errors"
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+)
+
+// ContentType returns the content type of the QR codes of the format.
+func (f Format) ContentType() string {
+	if f == FormatSVG {
+		return "image/svg+xml"
+	}
+	return "image/png"
+}
+
+const (
+	// DefaultSize is the size in pixels of the QR codes without one.
+	DefaultSize = 256
+	// MinSize and MaxSize bound the size in pixels of the QR codes.
+	MinSize = 64
+	MaxSize = 2048
+	// logoRatio is the width of the logo relative to the QR code, small enough for the error correction
+	// to recover the modules it covers.
+	logoRatio = 0.2
+)
+
+// Options are the options of a QR code.
+type Options struct {
+	Format Format
+	// Size is the width and height of the QR code in pixels.
+	Size int
+	// Logo is the data url of the image drawn in the center of the QR code, e.g. the branding of the workspace.
+	// Empty means no logo.
+	Logo string
+}
+
+// Encode renders the QR code of the content, e.g. a short link.
+func Encode(content string, options *Options) ([]byte, error) {
+	if options.Size < MinSize || options.Size > MaxSize {
+		return nil, errors.Errorf("size must be between %d and %d", MinSize, MaxSize)
+	}
+	// The logo hides some of the modules, which the highest error correction recovers.
+	level := goqrcode.Medium
+	if options.Logo != "" {
+		level = goqrcode.Highest
+	}
+	qrCode, err := goqrcode.New(content, level)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode qr code")
+	}
+
+	switch options.Format {
+	case FormatPNG:
+		return encodePNG(qrCode, options)
+	case FormatSVG:
+		return encodeSVG(qrCode, options), nil
+	default:
+		return nil, errors.Errorf("unsupported format %q", options.Format)
+	}
+}
+
+func encodePNG(qrCode *goqrcode.QRCode, options *Options) ([]byte, error) {
+	qrImage := qrCode.Image(options.Size)
+	if options.Logo != "" {
+		logo, err := decodeDataURLImage(options.Logo)
+		if err != nil {
+			return nil, err
+		}
+		canvas := image.NewRGBA(qrImage.Bounds())
+		draw.Draw(canvas, canvas.Bounds(), qrImage, image.Point{}, draw.Src)
+		logoSize := int(float64(options.Size) * logoRatio)
+		offset := (options.Size - logoSize) / 2
+		// The logo sits on a white square, so it doesn't blend with the modules.
+		padding := logoSize / 10
+		background := image.Rect(offset-padding, offset-padding, offset+logoSize+padding, offset+logoSize+padding)
+		draw.Draw(canvas, background, image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.Draw(canvas, image.Rect(offset, offset, offset+logoSize, offset+logoSize), scaleImage(logo, logoSize), image.Point{}, draw.Over)
+		qrImage = canvas
+	}
+	buffer := bytes.Buffer{}
+	if err := png.Encode(&buffer, qrImage); err != nil {
+		return nil, errors.Wrap(err, "failed to encode png")
+	}
+	return buffer.Bytes(), nil
+}
+
+func encodeSVG(qrCode *goqrcode.QRCode, options *Options) []byte {
+	// The bitmap includes the quiet zone around the modules.
+	bitmap := qrCode.Bitmap()
+	modules := len(bitmap)
+	path := strings.Builder{}
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&path, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	svg := strings.Builder{}
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, options.Size, options.Size, modules, modules)
+	fmt.Fprintf(&svg, `<rect width="%d" height="%d" fill="#ffffff"/>`, modules, modules)
+	fmt.Fprintf(&svg, `<path d="%s" fill="#000000"/>`, path.String())
+	if options.Logo != "" {
+		logoSize := float64(modules) * logoRatio
+		offset := (float64(modules) - logoSize) / 2
+		padding := logoSize / 10
+		fmt.Fprintf(&svg, `<rect x="%g" y="%g" width="%g" height="%g" fill="#ffffff"/>`, offset-padding, offset-padding, logoSize+2*padding, logoSize+2*padding)
+		fmt.Fprintf(&svg, `<image x="%g" y="%g" width="%g" height="%g" href="%s"/>`, offset, offset, logoSize, logoSize, escapeXMLAttribute(options.Logo))
+	}
+	svg.WriteString("</svg>")
+	return []byte(svg.String())
+}
+
+// decodeDataURLImage decodes the png, jpeg or gif image of a base64 data url, e.g. "data:image/png;base64,...".
+func decodeDataURLImage(dataURL string) (image.Image, error) {
+	header, data, ok := strings.Cut(dataURL, ",")
+	if !ok || !strings.HasPrefix(header, "data:image/") || !strings.HasSuffix(header, ";base64") {
+		return nil, errors.New("the logo is not a base64 image data url")
+	}
+	blob, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode the logo")
+	}
+	logo, _, err := image.Decode(bytes.NewReader(blob))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode the logo image")
+	}
+	return logo, nil
+}
+
+// scaleImage scales the image to a square of the size with the nearest neighbor, which is enough for a logo.
+func scaleImage(src image.Image, size int) image.Image {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dst.Set(x, y, src.At(bounds.Min.X+x*bounds.Dx()/size, bounds.Min.Y+y*bounds.Dy()/size))
+		}
+	}
+	return dst
+}
+
+var xmlAttributeReplacer = strings.NewReplacer(`&`, "&amp;", `"`, "&quot;", `<`, "&lt;", `>`, "&gt;")
+
+func escapeXMLAttribute(value string) string {
+	return xmlAttributeReplacer.Replace(value)
+}
@@ -0,0 +1,73 @@
+package qrcode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodePNG(t *testing.T) {
+	blob, err := Encode("https://slash.example.com/s/docs", &Options{
+		Format: FormatPNG,
+		Size:   256,
+	})
+	require.NoError(t, err)
+	qrImage, err := png.Decode(bytes.NewReader(blob))
+	require.NoError(t, err)
+	require.Equal(t, 256, qrImage.Bounds().Dx())
+	require.Equal(t, 256, qrImage.Bounds().Dy())
+}
+
+func TestEncodeWithLogo(t *testing.T) {
+	logo := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			logo.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	buffer := bytes.Buffer{}
+	require.NoError(t, png.Encode(&buffer, logo))
+	dataURL := "data:image/png;base64," + base64.StdEncoding.EncodeToString(buffer.Bytes())
+
+	blob, err := Encode("https://slash.example.com/s/docs", &Options{
+		Format: FormatPNG,
+		Size:   200,
+		Logo:   dataURL,
+	})
+	require.NoError(t, err)
+	qrImage, err := png.Decode(bytes.NewReader(blob))
+	require.NoError(t, err)
+	// The center of the QR code is the logo.
+	r, g, b, _ := qrImage.At(100, 100).RGBA()
+	require.Equal(t, []uint32{0xffff, 0, 0}, []uint32{r, g, b})
+
+	blob, err = Encode("https://slash.example.com/s/docs", &Options{
+		Format: FormatSVG,
+		Size:   200,
+		Logo:   dataURL,
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(blob), `<svg xmlns="http://www.w3.org/2000/svg" width="200" height="200"`))
+	require.Contains(t, string(blob), `href="data:image/png;base64,`)
+
+	_, err = Encode("https://slash.example.com/s/docs", &Options{
+		Format: FormatPNG,
+		Size:   200,
+		Logo:   "data:text/plain;base64,aGVsbG8=",
+	})
+	require.Error(t, err)
+}
+
+func TestEncodeInvalidSize(t *testing.T) {
+	_, err := Encode("https://slash.example.com/s/docs", &Options{
+		Format: FormatPNG,
+		Size:   MaxSize + 1,
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,99 @@
+// Package webhook provides a client to post JSON payloads to webhook urls.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/warthurton/slash/plugin/httpgetter"
+)
+
+// timeout is the timeout of a webhook request.
+const timeout = 10 * time.Second
+
+// userURLClient posts to the webhook urls set by the users, which can't reach the network of the server.
+var userURLClient = httpgetter.NewSafeClient(timeout)
+
+// pending is the number of the webhook requests in flight.
+var pending atomic.Int64
+
+// Pending returns the number of the webhook requests in flight, i.e. the backlog of the deliveries
+// when the webhook urls are slow to respond.
+func Pending() int64 {
+	return pending.Load()
+}
+
+// ValidateURL checks that the webhook url is an absolute http(s) url.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid webhook url %s", rawURL)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid webhook url %s, it must be an absolute http(s) url", rawURL)
+	}
+	return nil
+}
+
+// ValidateUserURL checks that the webhook url set by a user is an absolute http(s) url, which isn't
+// a loopback, private or link-local address.
+func ValidateUserURL(rawURL string) error {
+	if err := ValidateURL(rawURL); err != nil {
+		return err
+	}
+	u, _ := url.Parse(rawURL)
+	if httpgetter.IsForbiddenHost(u.Hostname()) {
+		return errors.Errorf("invalid webhook url %s, it must not be a loopback, private or link-local address", rawURL)
+	}
+	return nil
+}
+
+// Post posts the payload as JSON to the webhook url set by the admins, which can be in the network of the server.
+// It returns an error when the response status is not 2xx.
+func Post(ctx context.Context, url string, payload any) error {
+	return post(ctx, http.DefaultClient, url, payload)
+}
+
+// PostToUserURL posts the payload as JSON to the webhook url set by a user, with the safe client, so that it doesn't
+// connect to the addresses of the network of the server.
+// It returns an error when the response status is not 2xx.
+func PostToUserURL(ctx context.Context, url string, payload any) error {
+	return post(ctx, userURLClient, url, payload)
+}
+
+func post(ctx context.Context, client *http.Client, url string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal webhook payload")
+	}
+
+	pending.Add(1)
+	defer pending.Add(-1)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to create webhook request to %s", url)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to post webhook to %s", url)
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("failed to post webhook to %s, status code: %d", url, resp.StatusCode)
+	}
+	return nil
+}
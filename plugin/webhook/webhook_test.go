@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/warthurton/slash/plugin/httpgetter"
+)
+
+func TestPost(t *testing.T) {
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		if received["fail"] != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	require.NoError(t, Post(ctx, server.URL, map[string]string{"name": "slash"}))
+	require.Equal(t, "slash", received["name"])
+	require.Error(t, Post(ctx, server.URL, map[string]string{"fail": "true"}))
+}
+
+func TestPending(t *testing.T) {
+	received := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		received <- struct{}{}
+		<-release
+	}))
+	defer server.Close()
+
+	done := make(chan error)
+	go func() {
+		done <- Post(context.Background(), server.URL, map[string]string{"name": "slash"})
+	}()
+	<-received
+	require.Equal(t, int64(1), Pending())
+	close(release)
+	require.NoError(t, <-done)
+	require.Equal(t, int64(0), Pending())
+}
+
+func TestValidateURL(t *testing.T) {
+	require.NoError(t, ValidateURL("https://hooks.example.com/slash"))
+	require.NoError(t, ValidateURL("http://localhost:8080/hook"))
+	require.Error(t, ValidateURL("ftp://example.com"))
+	require.Error(t, ValidateURL("/relative/path"))
+	require.Error(t, ValidateURL("https://"))
+}
+
+func TestPostToUserURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	// The webhook urls of the users can't reach the network of the server.
+	err := PostToUserURL(context.Background(), server.URL, map[string]string{"name": "slash"})
+	require.ErrorIs(t, err, httpgetter.ErrForbiddenAddress)
+}
+
+func TestValidateUserURL(t *testing.T) {
+	require.NoError(t, ValidateUserURL("https://hooks.example.com/slash"))
+	require.Error(t, ValidateUserURL("ftp://example.com"))
+	require.Error(t, ValidateUserURL("http://localhost:8080/hook"))
+	require.Error(t, ValidateUserURL("http://127.0.0.1/hook"))
+	require.Error(t, ValidateUserURL("http://[::1]/hook"))
+	require.Error(t, ValidateUserURL("http://169.254.169.254/latest/meta-data"))
+	require.Error(t, ValidateUserURL("http://10.0.0.1/hook"))
+}
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,6 +24,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PollDeviceTokenResponse_State int32
+
+const (
+	PollDeviceTokenResponse_STATE_UNSPECIFIED PollDeviceTokenResponse_State = 0
+	// The user hasn't approved nor denied the client yet.
+	PollDeviceTokenResponse_PENDING PollDeviceTokenResponse_State = 1
+	// The user approved the client, and the access token is returned once.
+	PollDeviceTokenResponse_APPROVED PollDeviceTokenResponse_State = 2
+	// The user denied the client.
+	PollDeviceTokenResponse_DENIED PollDeviceTokenResponse_State = 3
+)
+
+// Enum value maps for PollDeviceTokenResponse_State.
+var (
+	PollDeviceTokenResponse_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "APPROVED",
+		3: "DENIED",
+	}
+	PollDeviceTokenResponse_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"APPROVED":          2,
+		"DENIED":            3,
+	}
+)
+
+func (x PollDeviceTokenResponse_State) Enum() *PollDeviceTokenResponse_State {
+	p := new(PollDeviceTokenResponse_State)
+	*p = x
+	return p
+}
+
+func (x PollDeviceTokenResponse_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PollDeviceTokenResponse_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_auth_service_proto_enumTypes[0].Descriptor()
+}
+
+func (PollDeviceTokenResponse_State) Type() protoreflect.EnumType {
+	return &file_api_v1_auth_service_proto_enumTypes[0]
+}
+
+func (x PollDeviceTokenResponse_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PollDeviceTokenResponse_State.Descriptor instead.
+func (PollDeviceTokenResponse_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{23, 0}
+}
+
 type GetAuthStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -171,6 +227,87 @@ func (x *SignUpRequest) GetPassword() string {
 	return ""
 }
 
+type VerifyEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token of the verification email.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SendVerificationEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationEmailRequest) Reset() {
+	*x = SendVerificationEmailRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationEmailRequest) ProtoMessage() {}
+
+func (x *SendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{4}
+}
+
 type SignInWithSSORequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The id of the SSO provider.
@@ -178,14 +315,17 @@ type SignInWithSSORequest struct {
 	// The code to sign in with.
 	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	// The redirect URI.
-	RedirectUri   string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	RedirectUri string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	// The nonce sent in the authorization request of the OpenID Connect provider,
+	// which must match the nonce of the ID token.
+	Nonce         string `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SignInWithSSORequest) Reset() {
 	*x = SignInWithSSORequest{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[3]
+	mi := &file_api_v1_auth_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -197,7 +337,7 @@ func (x *SignInWithSSORequest) String() string {
 func (*SignInWithSSORequest) ProtoMessage() {}
 
 func (x *SignInWithSSORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[3]
+	mi := &file_api_v1_auth_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,7 +350,7 @@ func (x *SignInWithSSORequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignInWithSSORequest.ProtoReflect.Descriptor instead.
 func (*SignInWithSSORequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SignInWithSSORequest) GetIdpId() string {
@@ -234,27 +374,141 @@ func (x *SignInWithSSORequest) GetRedirectUri() string {
 	return ""
 }
 
-type SignOutRequest struct {
+func (x *SignInWithSSORequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type SignInWithLDAPRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the LDAP identity provider.
+	IdpId string `protobuf:"bytes,1,opt,name=idp_id,json=idpId,proto3" json:"idp_id,omitempty"`
+	// The username in the directory, which is matched by the user filter.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignInWithLDAPRequest) Reset() {
+	*x = SignInWithLDAPRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInWithLDAPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInWithLDAPRequest) ProtoMessage() {}
+
+func (x *SignInWithLDAPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInWithLDAPRequest.ProtoReflect.Descriptor instead.
+func (*SignInWithLDAPRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SignInWithLDAPRequest) GetIdpId() string {
+	if x != nil {
+		return x.IdpId
+	}
+	return ""
+}
+
+func (x *SignInWithLDAPRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SignInWithLDAPRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LinkIdentityProviderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The password of the existing account, not required when signed in as the account.
+	Password      string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityProviderRequest) Reset() {
+	*x = LinkIdentityProviderRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityProviderRequest) ProtoMessage() {}
+
+func (x *LinkIdentityProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityProviderRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityProviderRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LinkIdentityProviderRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type BeginPasskeySignInRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SignOutRequest) Reset() {
-	*x = SignOutRequest{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+func (x *BeginPasskeySignInRequest) Reset() {
+	*x = BeginPasskeySignInRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignOutRequest) String() string {
+func (x *BeginPasskeySignInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignOutRequest) ProtoMessage() {}
+func (*BeginPasskeySignInRequest) ProtoMessage() {}
 
-func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+func (x *BeginPasskeySignInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,35 +519,850 @@ func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignOutRequest.ProtoReflect.Descriptor instead.
-func (*SignOutRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BeginPasskeySignInRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeySignInRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{8}
 }
 
-var File_api_v1_auth_service_proto protoreflect.FileDescriptor
+type BeginPasskeySignInResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The credential request options in JSON, i.e. {"publicKey": {...}}.
+	Options       string `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_v1_auth_service_proto_rawDesc = "" +
-	"\n" +
-	"\x19api/v1/auth_service.proto\x12\fslash.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x16\n" +
-	"\x14GetAuthStatusRequest\"A\n" +
-	"\rSignInRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"]\n" +
-	"\rSignUpRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"d\n" +
-	"\x14SignInWithSSORequest\x12\x15\n" +
-	"\x06idp_id\x18\x01 \x01(\tR\x05idpId\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
-	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\"\x10\n" +
-	"\x0eSignOutRequest2\xec\x03\n" +
+func (x *BeginPasskeySignInResponse) Reset() {
+	*x = BeginPasskeySignInResponse{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeySignInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeySignInResponse) ProtoMessage() {}
+
+func (x *BeginPasskeySignInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeySignInResponse.ProtoReflect.Descriptor instead.
+func (*BeginPasskeySignInResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BeginPasskeySignInResponse) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+type SignInWithPasskeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The public key credential of the assertion in JSON.
+	Credential    string `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignInWithPasskeyRequest) Reset() {
+	*x = SignInWithPasskeyRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInWithPasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInWithPasskeyRequest) ProtoMessage() {}
+
+func (x *SignInWithPasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInWithPasskeyRequest.ProtoReflect.Descriptor instead.
+func (*SignInWithPasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SignInWithPasskeyRequest) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+type BeginPasskeyRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationRequest) Reset() {
+	*x = BeginPasskeyRegistrationRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{11}
+}
+
+type BeginPasskeyRegistrationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The credential creation options in JSON, i.e. {"publicKey": {...}}.
+	Options       string `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationResponse) Reset() {
+	*x = BeginPasskeyRegistrationResponse{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationResponse) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BeginPasskeyRegistrationResponse) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+type FinishPasskeyRegistrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The public key credential of the attestation in JSON.
+	Credential string `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	// A name for the passkey, e.g. the device.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationRequest) Reset() {
+	*x = FinishPasskeyRegistrationRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SignOutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignOutRequest) Reset() {
+	*x = SignOutRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutRequest) ProtoMessage() {}
+
+func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignOutRequest.ProtoReflect.Descriptor instead.
+func (*SignOutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{14}
+}
+
+type SignOutAllSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignOutAllSessionsRequest) Reset() {
+	*x = SignOutAllSessionsRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignOutAllSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutAllSessionsRequest) ProtoMessage() {}
+
+func (x *SignOutAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignOutAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*SignOutAllSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{15}
+}
+
+type CreateDeviceAuthorizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the client shown to the user, e.g. "Slash CLI".
+	ClientName string `protobuf:"bytes,1,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	// The scopes of the access token. Empty means full access.
+	Scopes        []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeviceAuthorizationRequest) Reset() {
+	*x = CreateDeviceAuthorizationRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeviceAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeviceAuthorizationRequest) ProtoMessage() {}
+
+func (x *CreateDeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeviceAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeviceAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateDeviceAuthorizationRequest) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *CreateDeviceAuthorizationRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type CreateDeviceAuthorizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The secret code the client polls for its access token with.
+	DeviceCode string `protobuf:"bytes,1,opt,name=device_code,json=deviceCode,proto3" json:"device_code,omitempty"`
+	// The code the user enters at the verification uri, e.g. "WDJB-MJHT".
+	UserCode string `protobuf:"bytes,2,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	// The page where the user enters the user code.
+	VerificationUri string `protobuf:"bytes,3,opt,name=verification_uri,json=verificationUri,proto3" json:"verification_uri,omitempty"`
+	// The verification uri with the user code filled in.
+	VerificationUriComplete string `protobuf:"bytes,4,opt,name=verification_uri_complete,json=verificationUriComplete,proto3" json:"verification_uri_complete,omitempty"`
+	// The seconds until the codes expire.
+	ExpiresIn int32 `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// The minimum seconds between the polls.
+	Interval      int32 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeviceAuthorizationResponse) Reset() {
+	*x = CreateDeviceAuthorizationResponse{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeviceAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeviceAuthorizationResponse) ProtoMessage() {}
+
+func (x *CreateDeviceAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeviceAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateDeviceAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateDeviceAuthorizationResponse) GetDeviceCode() string {
+	if x != nil {
+		return x.DeviceCode
+	}
+	return ""
+}
+
+func (x *CreateDeviceAuthorizationResponse) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *CreateDeviceAuthorizationResponse) GetVerificationUri() string {
+	if x != nil {
+		return x.VerificationUri
+	}
+	return ""
+}
+
+func (x *CreateDeviceAuthorizationResponse) GetVerificationUriComplete() string {
+	if x != nil {
+		return x.VerificationUriComplete
+	}
+	return ""
+}
+
+func (x *CreateDeviceAuthorizationResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *CreateDeviceAuthorizationResponse) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type DeviceAuthorization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCode      string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	ClientName    string                 `protobuf:"bytes,2,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceAuthorization) Reset() {
+	*x = DeviceAuthorization{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceAuthorization) ProtoMessage() {}
+
+func (x *DeviceAuthorization) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceAuthorization.ProtoReflect.Descriptor instead.
+func (*DeviceAuthorization) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeviceAuthorization) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *DeviceAuthorization) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *DeviceAuthorization) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *DeviceAuthorization) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type GetDeviceAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCode      string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceAuthorizationRequest) Reset() {
+	*x = GetDeviceAuthorizationRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceAuthorizationRequest) ProtoMessage() {}
+
+func (x *GetDeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetDeviceAuthorizationRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+type ApproveDeviceAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCode      string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveDeviceAuthorizationRequest) Reset() {
+	*x = ApproveDeviceAuthorizationRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDeviceAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDeviceAuthorizationRequest) ProtoMessage() {}
+
+func (x *ApproveDeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDeviceAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveDeviceAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ApproveDeviceAuthorizationRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+type DenyDeviceAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCode      string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenyDeviceAuthorizationRequest) Reset() {
+	*x = DenyDeviceAuthorizationRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyDeviceAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyDeviceAuthorizationRequest) ProtoMessage() {}
+
+func (x *DenyDeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyDeviceAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*DenyDeviceAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DenyDeviceAuthorizationRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+type PollDeviceTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceCode    string                 `protobuf:"bytes,1,opt,name=device_code,json=deviceCode,proto3" json:"device_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollDeviceTokenRequest) Reset() {
+	*x = PollDeviceTokenRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollDeviceTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollDeviceTokenRequest) ProtoMessage() {}
+
+func (x *PollDeviceTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollDeviceTokenRequest.ProtoReflect.Descriptor instead.
+func (*PollDeviceTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PollDeviceTokenRequest) GetDeviceCode() string {
+	if x != nil {
+		return x.DeviceCode
+	}
+	return ""
+}
+
+type PollDeviceTokenResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	State         PollDeviceTokenResponse_State `protobuf:"varint,1,opt,name=state,proto3,enum=slash.api.v1.PollDeviceTokenResponse_State" json:"state,omitempty"`
+	AccessToken   *UserAccessToken              `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollDeviceTokenResponse) Reset() {
+	*x = PollDeviceTokenResponse{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollDeviceTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollDeviceTokenResponse) ProtoMessage() {}
+
+func (x *PollDeviceTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollDeviceTokenResponse.ProtoReflect.Descriptor instead.
+func (*PollDeviceTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PollDeviceTokenResponse) GetState() PollDeviceTokenResponse_State {
+	if x != nil {
+		return x.State
+	}
+	return PollDeviceTokenResponse_STATE_UNSPECIFIED
+}
+
+func (x *PollDeviceTokenResponse) GetAccessToken() *UserAccessToken {
+	if x != nil {
+		return x.AccessToken
+	}
+	return nil
+}
+
+var File_api_v1_auth_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_auth_service_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/v1/auth_service.proto\x12\fslash.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x16\n" +
+	"\x14GetAuthStatusRequest\"A\n" +
+	"\rSignInRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"]\n" +
+	"\rSignUpRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"*\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1e\n" +
+	"\x1cSendVerificationEmailRequest\"z\n" +
+	"\x14SignInWithSSORequest\x12\x15\n" +
+	"\x06idp_id\x18\x01 \x01(\tR\x05idpId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
+	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\"f\n" +
+	"\x15SignInWithLDAPRequest\x12\x15\n" +
+	"\x06idp_id\x18\x01 \x01(\tR\x05idpId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"9\n" +
+	"\x1bLinkIdentityProviderRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"\x1b\n" +
+	"\x19BeginPasskeySignInRequest\"6\n" +
+	"\x1aBeginPasskeySignInResponse\x12\x18\n" +
+	"\aoptions\x18\x01 \x01(\tR\aoptions\":\n" +
+	"\x18SignInWithPasskeyRequest\x12\x1e\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\tR\n" +
+	"credential\"!\n" +
+	"\x1fBeginPasskeyRegistrationRequest\"<\n" +
+	" BeginPasskeyRegistrationResponse\x12\x18\n" +
+	"\aoptions\x18\x01 \x01(\tR\aoptions\"V\n" +
+	" FinishPasskeyRegistrationRequest\x12\x1e\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\tR\n" +
+	"credential\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x10\n" +
+	"\x0eSignOutRequest\"\x1b\n" +
+	"\x19SignOutAllSessionsRequest\"[\n" +
+	" CreateDeviceAuthorizationRequest\x12\x1f\n" +
+	"\vclient_name\x18\x01 \x01(\tR\n" +
+	"clientName\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\"\x83\x02\n" +
+	"!CreateDeviceAuthorizationResponse\x12\x1f\n" +
+	"\vdevice_code\x18\x01 \x01(\tR\n" +
+	"deviceCode\x12\x1b\n" +
+	"\tuser_code\x18\x02 \x01(\tR\buserCode\x12)\n" +
+	"\x10verification_uri\x18\x03 \x01(\tR\x0fverificationUri\x12:\n" +
+	"\x19verification_uri_complete\x18\x04 \x01(\tR\x17verificationUriComplete\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x05 \x01(\x05R\texpiresIn\x12\x1a\n" +
+	"\binterval\x18\x06 \x01(\x05R\binterval\"\xa8\x01\n" +
+	"\x13DeviceAuthorization\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\x12\x1f\n" +
+	"\vclient_name\x18\x02 \x01(\tR\n" +
+	"clientName\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12;\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"<\n" +
+	"\x1dGetDeviceAuthorizationRequest\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\"@\n" +
+	"!ApproveDeviceAuthorizationRequest\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\"=\n" +
+	"\x1eDenyDeviceAuthorizationRequest\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\"9\n" +
+	"\x16PollDeviceTokenRequest\x12\x1f\n" +
+	"\vdevice_code\x18\x01 \x01(\tR\n" +
+	"deviceCode\"\xe5\x01\n" +
+	"\x17PollDeviceTokenResponse\x12A\n" +
+	"\x05state\x18\x01 \x01(\x0e2+.slash.api.v1.PollDeviceTokenResponse.StateR\x05state\x12@\n" +
+	"\faccess_token\x18\x02 \x01(\v2\x1d.slash.api.v1.UserAccessTokenR\vaccessToken\"E\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\f\n" +
+	"\bAPPROVED\x10\x02\x12\n" +
+	"\n" +
+	"\x06DENIED\x10\x032\xe6\x12\n" +
 	"\vAuthService\x12d\n" +
 	"\rGetAuthStatus\x12\".slash.api.v1.GetAuthStatusRequest\x1a\x12.slash.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/status\x12V\n" +
 	"\x06SignIn\x12\x1b.slash.api.v1.SignInRequest\x1a\x12.slash.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/signin\x12h\n" +
-	"\rSignInWithSSO\x12\".slash.api.v1.SignInWithSSORequest\x1a\x12.slash.api.v1.User\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/api/v1/auth/signin/sso\x12V\n" +
-	"\x06SignUp\x12\x1b.slash.api.v1.SignUpRequest\x1a\x12.slash.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/signup\x12]\n" +
-	"\aSignOut\x12\x1c.slash.api.v1.SignOutRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/api/v1/auth/signoutB.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
+	"\rSignInWithSSO\x12\".slash.api.v1.SignInWithSSORequest\x1a\x12.slash.api.v1.User\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/api/v1/auth/signin/sso\x12n\n" +
+	"\x0eSignInWithLDAP\x12#.slash.api.v1.SignInWithLDAPRequest\x1a\x12.slash.api.v1.User\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/auth/signin/ldap\x12{\n" +
+	"\x14LinkIdentityProvider\x12).slash.api.v1.LinkIdentityProviderRequest\x1a\x12.slash.api.v1.User\"$\x82\xd3\xe4\x93\x02\x1e\"\x1c/api/v1/auth/signin/sso/link\x12\x92\x01\n" +
+	"\x12BeginPasskeySignIn\x12'.slash.api.v1.BeginPasskeySignInRequest\x1a(.slash.api.v1.BeginPasskeySignInResponse\")\x82\xd3\xe4\x93\x02#\"!/api/v1/auth/signin/passkey/begin\x12w\n" +
+	"\x11SignInWithPasskey\x12&.slash.api.v1.SignInWithPasskeyRequest\x1a\x12.slash.api.v1.User\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/auth/signin/passkey\x12\x9d\x01\n" +
+	"\x18BeginPasskeyRegistration\x12-.slash.api.v1.BeginPasskeyRegistrationRequest\x1a..slash.api.v1.BeginPasskeyRegistrationResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/api/v1/auth/passkey/begin\x12\x8e\x01\n" +
+	"\x19FinishPasskeyRegistration\x12..slash.api.v1.FinishPasskeyRegistrationRequest\x1a\x19.slash.api.v1.UserPasskey\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/auth/passkey/finish\x12V\n" +
+	"\x06SignUp\x12\x1b.slash.api.v1.SignUpRequest\x1a\x12.slash.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/signup\x12i\n" +
+	"\vVerifyEmail\x12 .slash.api.v1.VerifyEmailRequest\x1a\x12.slash.api.v1.User\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/auth/verify-email\x12\x84\x01\n" +
+	"\x15SendVerificationEmail\x12*.slash.api.v1.SendVerificationEmailRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!\"\x1f/api/v1/auth/verification-email\x12]\n" +
+	"\aSignOut\x12\x1c.slash.api.v1.SignOutRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/api/v1/auth/signout\x12w\n" +
+	"\x12SignOutAllSessions\x12'.slash.api.v1.SignOutAllSessionsRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a\"\x18/api/v1/auth/signout/all\x12\x9c\x01\n" +
+	"\x19CreateDeviceAuthorization\x12..slash.api.v1.CreateDeviceAuthorizationRequest\x1a/.slash.api.v1.CreateDeviceAuthorizationResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/auth/device\x12\x91\x01\n" +
+	"\x16GetDeviceAuthorization\x12+.slash.api.v1.GetDeviceAuthorizationRequest\x1a!.slash.api.v1.DeviceAuthorization\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/auth/device/{user_code}\x12\x96\x01\n" +
+	"\x1aApproveDeviceAuthorization\x12/.slash.api.v1.ApproveDeviceAuthorizationRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02)\"'/api/v1/auth/device/{user_code}:approve\x12\x8d\x01\n" +
+	"\x17DenyDeviceAuthorization\x12,.slash.api.v1.DenyDeviceAuthorizationRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&\"$/api/v1/auth/device/{user_code}:deny\x12\x84\x01\n" +
+	"\x0fPollDeviceToken\x12$.slash.api.v1.PollDeviceTokenRequest\x1a%.slash.api.v1.PollDeviceTokenResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/auth/device/tokenB.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
 
 var (
 	file_api_v1_auth_service_proto_rawDescOnce sync.Once
@@ -307,32 +1376,87 @@ func file_api_v1_auth_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_auth_service_proto_rawDescData
 }
 
-var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_auth_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_api_v1_auth_service_proto_goTypes = []any{
-	(*GetAuthStatusRequest)(nil), // 0: slash.api.v1.GetAuthStatusRequest
-	(*SignInRequest)(nil),        // 1: slash.api.v1.SignInRequest
-	(*SignUpRequest)(nil),        // 2: slash.api.v1.SignUpRequest
-	(*SignInWithSSORequest)(nil), // 3: slash.api.v1.SignInWithSSORequest
-	(*SignOutRequest)(nil),       // 4: slash.api.v1.SignOutRequest
-	(*User)(nil),                 // 5: slash.api.v1.User
-	(*emptypb.Empty)(nil),        // 6: google.protobuf.Empty
+	(PollDeviceTokenResponse_State)(0),        // 0: slash.api.v1.PollDeviceTokenResponse.State
+	(*GetAuthStatusRequest)(nil),              // 1: slash.api.v1.GetAuthStatusRequest
+	(*SignInRequest)(nil),                     // 2: slash.api.v1.SignInRequest
+	(*SignUpRequest)(nil),                     // 3: slash.api.v1.SignUpRequest
+	(*VerifyEmailRequest)(nil),                // 4: slash.api.v1.VerifyEmailRequest
+	(*SendVerificationEmailRequest)(nil),      // 5: slash.api.v1.SendVerificationEmailRequest
+	(*SignInWithSSORequest)(nil),              // 6: slash.api.v1.SignInWithSSORequest
+	(*SignInWithLDAPRequest)(nil),             // 7: slash.api.v1.SignInWithLDAPRequest
+	(*LinkIdentityProviderRequest)(nil),       // 8: slash.api.v1.LinkIdentityProviderRequest
+	(*BeginPasskeySignInRequest)(nil),         // 9: slash.api.v1.BeginPasskeySignInRequest
+	(*BeginPasskeySignInResponse)(nil),        // 10: slash.api.v1.BeginPasskeySignInResponse
+	(*SignInWithPasskeyRequest)(nil),          // 11: slash.api.v1.SignInWithPasskeyRequest
+	(*BeginPasskeyRegistrationRequest)(nil),   // 12: slash.api.v1.BeginPasskeyRegistrationRequest
+	(*BeginPasskeyRegistrationResponse)(nil),  // 13: slash.api.v1.BeginPasskeyRegistrationResponse
+	(*FinishPasskeyRegistrationRequest)(nil),  // 14: slash.api.v1.FinishPasskeyRegistrationRequest
+	(*SignOutRequest)(nil),                    // 15: slash.api.v1.SignOutRequest
+	(*SignOutAllSessionsRequest)(nil),         // 16: slash.api.v1.SignOutAllSessionsRequest
+	(*CreateDeviceAuthorizationRequest)(nil),  // 17: slash.api.v1.CreateDeviceAuthorizationRequest
+	(*CreateDeviceAuthorizationResponse)(nil), // 18: slash.api.v1.CreateDeviceAuthorizationResponse
+	(*DeviceAuthorization)(nil),               // 19: slash.api.v1.DeviceAuthorization
+	(*GetDeviceAuthorizationRequest)(nil),     // 20: slash.api.v1.GetDeviceAuthorizationRequest
+	(*ApproveDeviceAuthorizationRequest)(nil), // 21: slash.api.v1.ApproveDeviceAuthorizationRequest
+	(*DenyDeviceAuthorizationRequest)(nil),    // 22: slash.api.v1.DenyDeviceAuthorizationRequest
+	(*PollDeviceTokenRequest)(nil),            // 23: slash.api.v1.PollDeviceTokenRequest
+	(*PollDeviceTokenResponse)(nil),           // 24: slash.api.v1.PollDeviceTokenResponse
+	(*timestamppb.Timestamp)(nil),             // 25: google.protobuf.Timestamp
+	(*UserAccessToken)(nil),                   // 26: slash.api.v1.UserAccessToken
+	(*User)(nil),                              // 27: slash.api.v1.User
+	(*UserPasskey)(nil),                       // 28: slash.api.v1.UserPasskey
+	(*emptypb.Empty)(nil),                     // 29: google.protobuf.Empty
 }
 var file_api_v1_auth_service_proto_depIdxs = []int32{
-	0, // 0: slash.api.v1.AuthService.GetAuthStatus:input_type -> slash.api.v1.GetAuthStatusRequest
-	1, // 1: slash.api.v1.AuthService.SignIn:input_type -> slash.api.v1.SignInRequest
-	3, // 2: slash.api.v1.AuthService.SignInWithSSO:input_type -> slash.api.v1.SignInWithSSORequest
-	2, // 3: slash.api.v1.AuthService.SignUp:input_type -> slash.api.v1.SignUpRequest
-	4, // 4: slash.api.v1.AuthService.SignOut:input_type -> slash.api.v1.SignOutRequest
-	5, // 5: slash.api.v1.AuthService.GetAuthStatus:output_type -> slash.api.v1.User
-	5, // 6: slash.api.v1.AuthService.SignIn:output_type -> slash.api.v1.User
-	5, // 7: slash.api.v1.AuthService.SignInWithSSO:output_type -> slash.api.v1.User
-	5, // 8: slash.api.v1.AuthService.SignUp:output_type -> slash.api.v1.User
-	6, // 9: slash.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	25, // 0: slash.api.v1.DeviceAuthorization.expire_time:type_name -> google.protobuf.Timestamp
+	0,  // 1: slash.api.v1.PollDeviceTokenResponse.state:type_name -> slash.api.v1.PollDeviceTokenResponse.State
+	26, // 2: slash.api.v1.PollDeviceTokenResponse.access_token:type_name -> slash.api.v1.UserAccessToken
+	1,  // 3: slash.api.v1.AuthService.GetAuthStatus:input_type -> slash.api.v1.GetAuthStatusRequest
+	2,  // 4: slash.api.v1.AuthService.SignIn:input_type -> slash.api.v1.SignInRequest
+	6,  // 5: slash.api.v1.AuthService.SignInWithSSO:input_type -> slash.api.v1.SignInWithSSORequest
+	7,  // 6: slash.api.v1.AuthService.SignInWithLDAP:input_type -> slash.api.v1.SignInWithLDAPRequest
+	8,  // 7: slash.api.v1.AuthService.LinkIdentityProvider:input_type -> slash.api.v1.LinkIdentityProviderRequest
+	9,  // 8: slash.api.v1.AuthService.BeginPasskeySignIn:input_type -> slash.api.v1.BeginPasskeySignInRequest
+	11, // 9: slash.api.v1.AuthService.SignInWithPasskey:input_type -> slash.api.v1.SignInWithPasskeyRequest
+	12, // 10: slash.api.v1.AuthService.BeginPasskeyRegistration:input_type -> slash.api.v1.BeginPasskeyRegistrationRequest
+	14, // 11: slash.api.v1.AuthService.FinishPasskeyRegistration:input_type -> slash.api.v1.FinishPasskeyRegistrationRequest
+	3,  // 12: slash.api.v1.AuthService.SignUp:input_type -> slash.api.v1.SignUpRequest
+	4,  // 13: slash.api.v1.AuthService.VerifyEmail:input_type -> slash.api.v1.VerifyEmailRequest
+	5,  // 14: slash.api.v1.AuthService.SendVerificationEmail:input_type -> slash.api.v1.SendVerificationEmailRequest
+	15, // 15: slash.api.v1.AuthService.SignOut:input_type -> slash.api.v1.SignOutRequest
+	16, // 16: slash.api.v1.AuthService.SignOutAllSessions:input_type -> slash.api.v1.SignOutAllSessionsRequest
+	17, // 17: slash.api.v1.AuthService.CreateDeviceAuthorization:input_type -> slash.api.v1.CreateDeviceAuthorizationRequest
+	20, // 18: slash.api.v1.AuthService.GetDeviceAuthorization:input_type -> slash.api.v1.GetDeviceAuthorizationRequest
+	21, // 19: slash.api.v1.AuthService.ApproveDeviceAuthorization:input_type -> slash.api.v1.ApproveDeviceAuthorizationRequest
+	22, // 20: slash.api.v1.AuthService.DenyDeviceAuthorization:input_type -> slash.api.v1.DenyDeviceAuthorizationRequest
+	23, // 21: slash.api.v1.AuthService.PollDeviceToken:input_type -> slash.api.v1.PollDeviceTokenRequest
+	27, // 22: slash.api.v1.AuthService.GetAuthStatus:output_type -> slash.api.v1.User
+	27, // 23: slash.api.v1.AuthService.SignIn:output_type -> slash.api.v1.User
+	27, // 24: slash.api.v1.AuthService.SignInWithSSO:output_type -> slash.api.v1.User
+	27, // 25: slash.api.v1.AuthService.SignInWithLDAP:output_type -> slash.api.v1.User
+	27, // 26: slash.api.v1.AuthService.LinkIdentityProvider:output_type -> slash.api.v1.User
+	10, // 27: slash.api.v1.AuthService.BeginPasskeySignIn:output_type -> slash.api.v1.BeginPasskeySignInResponse
+	27, // 28: slash.api.v1.AuthService.SignInWithPasskey:output_type -> slash.api.v1.User
+	13, // 29: slash.api.v1.AuthService.BeginPasskeyRegistration:output_type -> slash.api.v1.BeginPasskeyRegistrationResponse
+	28, // 30: slash.api.v1.AuthService.FinishPasskeyRegistration:output_type -> slash.api.v1.UserPasskey
+	27, // 31: slash.api.v1.AuthService.SignUp:output_type -> slash.api.v1.User
+	27, // 32: slash.api.v1.AuthService.VerifyEmail:output_type -> slash.api.v1.User
+	29, // 33: slash.api.v1.AuthService.SendVerificationEmail:output_type -> google.protobuf.Empty
+	29, // 34: slash.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
+	29, // 35: slash.api.v1.AuthService.SignOutAllSessions:output_type -> google.protobuf.Empty
+	18, // 36: slash.api.v1.AuthService.CreateDeviceAuthorization:output_type -> slash.api.v1.CreateDeviceAuthorizationResponse
+	19, // 37: slash.api.v1.AuthService.GetDeviceAuthorization:output_type -> slash.api.v1.DeviceAuthorization
+	29, // 38: slash.api.v1.AuthService.ApproveDeviceAuthorization:output_type -> google.protobuf.Empty
+	29, // 39: slash.api.v1.AuthService.DenyDeviceAuthorization:output_type -> google.protobuf.Empty
+	24, // 40: slash.api.v1.AuthService.PollDeviceToken:output_type -> slash.api.v1.PollDeviceTokenResponse
+	22, // [22:41] is the sub-list for method output_type
+	3,  // [3:22] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_auth_service_proto_init() }
@@ -346,13 +1470,14 @@ func file_api_v1_auth_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_auth_service_proto_rawDesc), len(file_api_v1_auth_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_auth_service_proto_goTypes,
 		DependencyIndexes: file_api_v1_auth_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_auth_service_proto_enumTypes,
 		MessageInfos:      file_api_v1_auth_service_proto_msgTypes,
 	}.Build()
 	File_api_v1_auth_service_proto = out.File
@@ -20,11 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_GetAuthStatus_FullMethodName = "/slash.api.v1.AuthService/GetAuthStatus"
-	AuthService_SignIn_FullMethodName        = "/slash.api.v1.AuthService/SignIn"
-	AuthService_SignInWithSSO_FullMethodName = "/slash.api.v1.AuthService/SignInWithSSO"
-	AuthService_SignUp_FullMethodName        = "/slash.api.v1.AuthService/SignUp"
-	AuthService_SignOut_FullMethodName       = "/slash.api.v1.AuthService/SignOut"
+	AuthService_GetAuthStatus_FullMethodName              = "/slash.api.v1.AuthService/GetAuthStatus"
+	AuthService_SignIn_FullMethodName                     = "/slash.api.v1.AuthService/SignIn"
+	AuthService_SignInWithSSO_FullMethodName              = "/slash.api.v1.AuthService/SignInWithSSO"
+	AuthService_SignInWithLDAP_FullMethodName             = "/slash.api.v1.AuthService/SignInWithLDAP"
+	AuthService_LinkIdentityProvider_FullMethodName       = "/slash.api.v1.AuthService/LinkIdentityProvider"
+	AuthService_BeginPasskeySignIn_FullMethodName         = "/slash.api.v1.AuthService/BeginPasskeySignIn"
+	AuthService_SignInWithPasskey_FullMethodName          = "/slash.api.v1.AuthService/SignInWithPasskey"
+	AuthService_BeginPasskeyRegistration_FullMethodName   = "/slash.api.v1.AuthService/BeginPasskeyRegistration"
+	AuthService_FinishPasskeyRegistration_FullMethodName  = "/slash.api.v1.AuthService/FinishPasskeyRegistration"
+	AuthService_SignUp_FullMethodName                     = "/slash.api.v1.AuthService/SignUp"
+	AuthService_VerifyEmail_FullMethodName                = "/slash.api.v1.AuthService/VerifyEmail"
+	AuthService_SendVerificationEmail_FullMethodName      = "/slash.api.v1.AuthService/SendVerificationEmail"
+	AuthService_SignOut_FullMethodName                    = "/slash.api.v1.AuthService/SignOut"
+	AuthService_SignOutAllSessions_FullMethodName         = "/slash.api.v1.AuthService/SignOutAllSessions"
+	AuthService_CreateDeviceAuthorization_FullMethodName  = "/slash.api.v1.AuthService/CreateDeviceAuthorization"
+	AuthService_GetDeviceAuthorization_FullMethodName     = "/slash.api.v1.AuthService/GetDeviceAuthorization"
+	AuthService_ApproveDeviceAuthorization_FullMethodName = "/slash.api.v1.AuthService/ApproveDeviceAuthorization"
+	AuthService_DenyDeviceAuthorization_FullMethodName    = "/slash.api.v1.AuthService/DenyDeviceAuthorization"
+	AuthService_PollDeviceToken_FullMethodName            = "/slash.api.v1.AuthService/PollDeviceToken"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -37,10 +51,41 @@ type AuthServiceClient interface {
 	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*User, error)
 	// SignInWithSSO signs in the user with the given SSO code.
 	SignInWithSSO(ctx context.Context, in *SignInWithSSORequest, opts ...grpc.CallOption) (*User, error)
+	// SignInWithLDAP signs in the user with the username and password of the LDAP identity provider.
+	SignInWithLDAP(ctx context.Context, in *SignInWithLDAPRequest, opts ...grpc.CallOption) (*User, error)
+	// LinkIdentityProvider links the pending SSO identity to the existing account with the same email,
+	// after confirming the password of the account, unless signed in as the account, and signs in the user.
+	LinkIdentityProvider(ctx context.Context, in *LinkIdentityProviderRequest, opts ...grpc.CallOption) (*User, error)
+	// BeginPasskeySignIn starts signing in with a passkey, and returns the options for navigator.credentials.get.
+	BeginPasskeySignIn(ctx context.Context, in *BeginPasskeySignInRequest, opts ...grpc.CallOption) (*BeginPasskeySignInResponse, error)
+	// SignInWithPasskey signs in the user with the passkey assertion of navigator.credentials.get.
+	SignInWithPasskey(ctx context.Context, in *SignInWithPasskeyRequest, opts ...grpc.CallOption) (*User, error)
+	// BeginPasskeyRegistration starts registering a passkey for the current user,
+	// and returns the options for navigator.credentials.create.
+	BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationRequest, opts ...grpc.CallOption) (*BeginPasskeyRegistrationResponse, error)
+	// FinishPasskeyRegistration registers the passkey created by navigator.credentials.create for the current user.
+	FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationRequest, opts ...grpc.CallOption) (*UserPasskey, error)
 	// SignUp signs up the user with the given username and password.
 	SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*User, error)
+	// VerifyEmail confirms the email of the user with the token of the verification email.
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*User, error)
+	// SendVerificationEmail sends the verification email to the current user again.
+	SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// SignOut signs out the user.
 	SignOut(ctx context.Context, in *SignOutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SignOutAllSessions revokes all sign-in sessions of the current user.
+	SignOutAllSessions(ctx context.Context, in *SignOutAllSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateDeviceAuthorization starts the device authorization of a client, e.g. the browser extension or the CLI,
+	// which polls for its access token while the user approves the user code in the browser.
+	CreateDeviceAuthorization(ctx context.Context, in *CreateDeviceAuthorizationRequest, opts ...grpc.CallOption) (*CreateDeviceAuthorizationResponse, error)
+	// GetDeviceAuthorization returns the pending device authorization of the user code, for the user to review.
+	GetDeviceAuthorization(ctx context.Context, in *GetDeviceAuthorizationRequest, opts ...grpc.CallOption) (*DeviceAuthorization, error)
+	// ApproveDeviceAuthorization grants the client of the user code an access token of the current user.
+	ApproveDeviceAuthorization(ctx context.Context, in *ApproveDeviceAuthorizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DenyDeviceAuthorization denies the client of the user code.
+	DenyDeviceAuthorization(ctx context.Context, in *DenyDeviceAuthorizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PollDeviceToken returns the state of the device authorization, with the access token once it's approved.
+	PollDeviceToken(ctx context.Context, in *PollDeviceTokenRequest, opts ...grpc.CallOption) (*PollDeviceTokenResponse, error)
 }
 
 type authServiceClient struct {
@@ -81,6 +126,66 @@ func (c *authServiceClient) SignInWithSSO(ctx context.Context, in *SignInWithSSO
 	return out, nil
 }
 
+func (c *authServiceClient) SignInWithLDAP(ctx context.Context, in *SignInWithLDAPRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, AuthService_SignInWithLDAP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LinkIdentityProvider(ctx context.Context, in *LinkIdentityProviderRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, AuthService_LinkIdentityProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) BeginPasskeySignIn(ctx context.Context, in *BeginPasskeySignInRequest, opts ...grpc.CallOption) (*BeginPasskeySignInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginPasskeySignInResponse)
+	err := c.cc.Invoke(ctx, AuthService_BeginPasskeySignIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SignInWithPasskey(ctx context.Context, in *SignInWithPasskeyRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, AuthService_SignInWithPasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationRequest, opts ...grpc.CallOption) (*BeginPasskeyRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginPasskeyRegistrationResponse)
+	err := c.cc.Invoke(ctx, AuthService_BeginPasskeyRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationRequest, opts ...grpc.CallOption) (*UserPasskey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPasskey)
+	err := c.cc.Invoke(ctx, AuthService_FinishPasskeyRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*User, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(User)
@@ -91,6 +196,26 @@ func (c *authServiceClient) SignUp(ctx context.Context, in *SignUpRequest, opts
 	return out, nil
 }
 
+func (c *authServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, AuthService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_SendVerificationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) SignOut(ctx context.Context, in *SignOutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -101,6 +226,66 @@ func (c *authServiceClient) SignOut(ctx context.Context, in *SignOutRequest, opt
 	return out, nil
 }
 
+func (c *authServiceClient) SignOutAllSessions(ctx context.Context, in *SignOutAllSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_SignOutAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateDeviceAuthorization(ctx context.Context, in *CreateDeviceAuthorizationRequest, opts ...grpc.CallOption) (*CreateDeviceAuthorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDeviceAuthorizationResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateDeviceAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetDeviceAuthorization(ctx context.Context, in *GetDeviceAuthorizationRequest, opts ...grpc.CallOption) (*DeviceAuthorization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceAuthorization)
+	err := c.cc.Invoke(ctx, AuthService_GetDeviceAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ApproveDeviceAuthorization(ctx context.Context, in *ApproveDeviceAuthorizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_ApproveDeviceAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DenyDeviceAuthorization(ctx context.Context, in *DenyDeviceAuthorizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_DenyDeviceAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) PollDeviceToken(ctx context.Context, in *PollDeviceTokenRequest, opts ...grpc.CallOption) (*PollDeviceTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PollDeviceTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_PollDeviceToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -111,10 +296,41 @@ type AuthServiceServer interface {
 	SignIn(context.Context, *SignInRequest) (*User, error)
 	// SignInWithSSO signs in the user with the given SSO code.
 	SignInWithSSO(context.Context, *SignInWithSSORequest) (*User, error)
+	// SignInWithLDAP signs in the user with the username and password of the LDAP identity provider.
+	SignInWithLDAP(context.Context, *SignInWithLDAPRequest) (*User, error)
+	// LinkIdentityProvider links the pending SSO identity to the existing account with the same email,
+	// after confirming the password of the account, unless signed in as the account, and signs in the user.
+	LinkIdentityProvider(context.Context, *LinkIdentityProviderRequest) (*User, error)
+	// BeginPasskeySignIn starts signing in with a passkey, and returns the options for navigator.credentials.get.
+	BeginPasskeySignIn(context.Context, *BeginPasskeySignInRequest) (*BeginPasskeySignInResponse, error)
+	// SignInWithPasskey signs in the user with the passkey assertion of navigator.credentials.get.
+	SignInWithPasskey(context.Context, *SignInWithPasskeyRequest) (*User, error)
+	// BeginPasskeyRegistration starts registering a passkey for the current user,
+	// and returns the options for navigator.credentials.create.
+	BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationRequest) (*BeginPasskeyRegistrationResponse, error)
+	// FinishPasskeyRegistration registers the passkey created by navigator.credentials.create for the current user.
+	FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationRequest) (*UserPasskey, error)
 	// SignUp signs up the user with the given username and password.
 	SignUp(context.Context, *SignUpRequest) (*User, error)
+	// VerifyEmail confirms the email of the user with the token of the verification email.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*User, error)
+	// SendVerificationEmail sends the verification email to the current user again.
+	SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*emptypb.Empty, error)
 	// SignOut signs out the user.
 	SignOut(context.Context, *SignOutRequest) (*emptypb.Empty, error)
+	// SignOutAllSessions revokes all sign-in sessions of the current user.
+	SignOutAllSessions(context.Context, *SignOutAllSessionsRequest) (*emptypb.Empty, error)
+	// CreateDeviceAuthorization starts the device authorization of a client, e.g. the browser extension or the CLI,
+	// which polls for its access token while the user approves the user code in the browser.
+	CreateDeviceAuthorization(context.Context, *CreateDeviceAuthorizationRequest) (*CreateDeviceAuthorizationResponse, error)
+	// GetDeviceAuthorization returns the pending device authorization of the user code, for the user to review.
+	GetDeviceAuthorization(context.Context, *GetDeviceAuthorizationRequest) (*DeviceAuthorization, error)
+	// ApproveDeviceAuthorization grants the client of the user code an access token of the current user.
+	ApproveDeviceAuthorization(context.Context, *ApproveDeviceAuthorizationRequest) (*emptypb.Empty, error)
+	// DenyDeviceAuthorization denies the client of the user code.
+	DenyDeviceAuthorization(context.Context, *DenyDeviceAuthorizationRequest) (*emptypb.Empty, error)
+	// PollDeviceToken returns the state of the device authorization, with the access token once it's approved.
+	PollDeviceToken(context.Context, *PollDeviceTokenRequest) (*PollDeviceTokenResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -134,12 +350,54 @@ func (UnimplementedAuthServiceServer) SignIn(context.Context, *SignInRequest) (*
 func (UnimplementedAuthServiceServer) SignInWithSSO(context.Context, *SignInWithSSORequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignInWithSSO not implemented")
 }
+func (UnimplementedAuthServiceServer) SignInWithLDAP(context.Context, *SignInWithLDAPRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignInWithLDAP not implemented")
+}
+func (UnimplementedAuthServiceServer) LinkIdentityProvider(context.Context, *LinkIdentityProviderRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIdentityProvider not implemented")
+}
+func (UnimplementedAuthServiceServer) BeginPasskeySignIn(context.Context, *BeginPasskeySignInRequest) (*BeginPasskeySignInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginPasskeySignIn not implemented")
+}
+func (UnimplementedAuthServiceServer) SignInWithPasskey(context.Context, *SignInWithPasskeyRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignInWithPasskey not implemented")
+}
+func (UnimplementedAuthServiceServer) BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationRequest) (*BeginPasskeyRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginPasskeyRegistration not implemented")
+}
+func (UnimplementedAuthServiceServer) FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationRequest) (*UserPasskey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishPasskeyRegistration not implemented")
+}
 func (UnimplementedAuthServiceServer) SignUp(context.Context, *SignUpRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignUp not implemented")
 }
+func (UnimplementedAuthServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedAuthServiceServer) SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerificationEmail not implemented")
+}
 func (UnimplementedAuthServiceServer) SignOut(context.Context, *SignOutRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignOut not implemented")
 }
+func (UnimplementedAuthServiceServer) SignOutAllSessions(context.Context, *SignOutAllSessionsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignOutAllSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateDeviceAuthorization(context.Context, *CreateDeviceAuthorizationRequest) (*CreateDeviceAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeviceAuthorization not implemented")
+}
+func (UnimplementedAuthServiceServer) GetDeviceAuthorization(context.Context, *GetDeviceAuthorizationRequest) (*DeviceAuthorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceAuthorization not implemented")
+}
+func (UnimplementedAuthServiceServer) ApproveDeviceAuthorization(context.Context, *ApproveDeviceAuthorizationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDeviceAuthorization not implemented")
+}
+func (UnimplementedAuthServiceServer) DenyDeviceAuthorization(context.Context, *DenyDeviceAuthorizationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyDeviceAuthorization not implemented")
+}
+func (UnimplementedAuthServiceServer) PollDeviceToken(context.Context, *PollDeviceTokenRequest) (*PollDeviceTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollDeviceToken not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -215,6 +473,114 @@ func _AuthService_SignInWithSSO_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_SignInWithLDAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInWithLDAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignInWithLDAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SignInWithLDAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignInWithLDAP(ctx, req.(*SignInWithLDAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LinkIdentityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LinkIdentityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LinkIdentityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LinkIdentityProvider(ctx, req.(*LinkIdentityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_BeginPasskeySignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginPasskeySignInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BeginPasskeySignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_BeginPasskeySignIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BeginPasskeySignIn(ctx, req.(*BeginPasskeySignInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SignInWithPasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInWithPasskeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignInWithPasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SignInWithPasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignInWithPasskey(ctx, req.(*SignInWithPasskeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_BeginPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginPasskeyRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BeginPasskeyRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_BeginPasskeyRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BeginPasskeyRegistration(ctx, req.(*BeginPasskeyRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_FinishPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishPasskeyRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).FinishPasskeyRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_FinishPasskeyRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).FinishPasskeyRegistration(ctx, req.(*FinishPasskeyRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignUpRequest)
 	if err := dec(in); err != nil {
@@ -233,6 +599,42 @@ func _AuthService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SendVerificationEmail(ctx, req.(*SendVerificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_SignOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignOutRequest)
 	if err := dec(in); err != nil {
@@ -251,6 +653,114 @@ func _AuthService_SignOut_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_SignOutAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignOutAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignOutAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SignOutAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignOutAllSessions(ctx, req.(*SignOutAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateDeviceAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateDeviceAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateDeviceAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateDeviceAuthorization(ctx, req.(*CreateDeviceAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetDeviceAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetDeviceAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetDeviceAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetDeviceAuthorization(ctx, req.(*GetDeviceAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ApproveDeviceAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDeviceAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ApproveDeviceAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ApproveDeviceAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ApproveDeviceAuthorization(ctx, req.(*ApproveDeviceAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DenyDeviceAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyDeviceAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DenyDeviceAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DenyDeviceAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DenyDeviceAuthorization(ctx, req.(*DenyDeviceAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_PollDeviceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollDeviceTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).PollDeviceToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_PollDeviceToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).PollDeviceToken(ctx, req.(*PollDeviceTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -270,14 +780,70 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignInWithSSO",
 			Handler:    _AuthService_SignInWithSSO_Handler,
 		},
+		{
+			MethodName: "SignInWithLDAP",
+			Handler:    _AuthService_SignInWithLDAP_Handler,
+		},
+		{
+			MethodName: "LinkIdentityProvider",
+			Handler:    _AuthService_LinkIdentityProvider_Handler,
+		},
+		{
+			MethodName: "BeginPasskeySignIn",
+			Handler:    _AuthService_BeginPasskeySignIn_Handler,
+		},
+		{
+			MethodName: "SignInWithPasskey",
+			Handler:    _AuthService_SignInWithPasskey_Handler,
+		},
+		{
+			MethodName: "BeginPasskeyRegistration",
+			Handler:    _AuthService_BeginPasskeyRegistration_Handler,
+		},
+		{
+			MethodName: "FinishPasskeyRegistration",
+			Handler:    _AuthService_FinishPasskeyRegistration_Handler,
+		},
 		{
 			MethodName: "SignUp",
 			Handler:    _AuthService_SignUp_Handler,
 		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _AuthService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "SendVerificationEmail",
+			Handler:    _AuthService_SendVerificationEmail_Handler,
+		},
 		{
 			MethodName: "SignOut",
 			Handler:    _AuthService_SignOut_Handler,
 		},
+		{
+			MethodName: "SignOutAllSessions",
+			Handler:    _AuthService_SignOutAllSessions_Handler,
+		},
+		{
+			MethodName: "CreateDeviceAuthorization",
+			Handler:    _AuthService_CreateDeviceAuthorization_Handler,
+		},
+		{
+			MethodName: "GetDeviceAuthorization",
+			Handler:    _AuthService_GetDeviceAuthorization_Handler,
+		},
+		{
+			MethodName: "ApproveDeviceAuthorization",
+			Handler:    _AuthService_ApproveDeviceAuthorization_Handler,
+		},
+		{
+			MethodName: "DenyDeviceAuthorization",
+			Handler:    _AuthService_DenyDeviceAuthorization_Handler,
+		},
+		{
+			MethodName: "PollDeviceToken",
+			Handler:    _AuthService_PollDeviceToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/auth_service.proto",
@@ -26,16 +26,22 @@ const (
 )
 
 type Collection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatorId     int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	UpdatedTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	ShortcutIds   []int32                `protobuf:"varint,9,rep,packed,name=shortcut_ids,json=shortcutIds,proto3" json:"shortcut_ids,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,10,opt,name=visibility,proto3,enum=slash.api.v1.Visibility" json:"visibility,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	Name        string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Title       string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	ShortcutIds []int32                `protobuf:"varint,9,rep,packed,name=shortcut_ids,json=shortcutIds,proto3" json:"shortcut_ids,omitempty"`
+	Visibility  Visibility             `protobuf:"varint,10,opt,name=visibility,proto3,enum=slash.api.v1.Visibility" json:"visibility,omitempty"`
+	// The username of the creator.
+	CreatorUsername string `protobuf:"bytes,11,opt,name=creator_username,json=creatorUsername,proto3" json:"creator_username,omitempty"`
+	// The id of the team the collection is visible to, when the visibility is TEAM.
+	TeamId int32 `protobuf:"varint,12,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// The state of the collection, INACTIVE when archived.
+	State         State `protobuf:"varint,13,opt,name=state,proto3,enum=slash.api.v1.State" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,8 +139,39 @@ func (x *Collection) GetVisibility() Visibility {
 	return Visibility_VISIBILITY_UNSPECIFIED
 }
 
+func (x *Collection) GetCreatorUsername() string {
+	if x != nil {
+		return x.CreatorUsername
+	}
+	return ""
+}
+
+func (x *Collection) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Collection) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
 type ListCollectionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The max number of collections to return. Unset or 0 returns all of them, and the max is 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous response to get the next page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filters the collections by their state, ACTIVE or INACTIVE for the archived ones. Unset returns all of them.
+	State State `protobuf:"varint,3,opt,name=state,proto3,enum=slash.api.v1.State" json:"state,omitempty"`
+	// Filters the collections by the id of their creator. Unset or 0 returns the collections of all the users.
+	CreatorId int32 `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// Returns only the collections created by the current user.
+	MineOnly      bool `protobuf:"varint,5,opt,name=mine_only,json=mineOnly,proto3" json:"mine_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -169,9 +206,46 @@ func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ListCollectionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *ListCollectionsRequest) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetMineOnly() bool {
+	if x != nil {
+		return x.MineOnly
+	}
+	return false
+}
+
 type ListCollectionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Collections []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	// The token of the next page. Empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -213,6 +287,13 @@ func (x *ListCollectionsResponse) GetCollections() []*Collection {
 	return nil
 }
 
+func (x *ListCollectionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetCollectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -441,45 +522,968 @@ func (x *DeleteCollectionRequest) GetId() int32 {
 	return 0
 }
 
-var File_api_v1_collection_service_proto protoreflect.FileDescriptor
+type ArchiveCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_v1_collection_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1fapi/v1/collection_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\x02\n" +
-	"\n" +
-	"Collection\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
-	"\n" +
-	"creator_id\x18\x02 \x01(\x05R\tcreatorId\x12=\n" +
-	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
-	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12\x12\n" +
-	"\x04name\x18\x06 \x01(\tR\x04name\x12\x14\n" +
-	"\x05title\x18\a \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\b \x01(\tR\vdescription\x12!\n" +
-	"\fshortcut_ids\x18\t \x03(\x05R\vshortcutIds\x128\n" +
-	"\n" +
-	"visibility\x18\n" +
-	" \x01(\x0e2\x18.slash.api.v1.VisibilityR\n" +
-	"visibility\"\x18\n" +
-	"\x16ListCollectionsRequest\"U\n" +
-	"\x17ListCollectionsResponse\x12:\n" +
-	"\vcollections\x18\x01 \x03(\v2\x18.slash.api.v1.CollectionR\vcollections\"&\n" +
-	"\x14GetCollectionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"0\n" +
-	"\x1aGetCollectionByNameRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"S\n" +
-	"\x17CreateCollectionRequest\x128\n" +
-	"\n" +
-	"collection\x18\x01 \x01(\v2\x18.slash.api.v1.CollectionR\n" +
-	"collection\"\x90\x01\n" +
-	"\x17UpdateCollectionRequest\x128\n" +
-	"\n" +
-	"collection\x18\x01 \x01(\v2\x18.slash.api.v1.CollectionR\n" +
-	"collection\x12;\n" +
-	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\")\n" +
-	"\x17DeleteCollectionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id2\x83\x06\n" +
+func (x *ArchiveCollectionRequest) Reset() {
+	*x = ArchiveCollectionRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCollectionRequest) ProtoMessage() {}
+
+func (x *ArchiveCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArchiveCollectionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UnarchiveCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveCollectionRequest) Reset() {
+	*x = UnarchiveCollectionRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveCollectionRequest) ProtoMessage() {}
+
+func (x *UnarchiveCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnarchiveCollectionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TransferCollectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The id of the user to transfer the collection to.
+	UserId        int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCollectionRequest) Reset() {
+	*x = TransferCollectionRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCollectionRequest) ProtoMessage() {}
+
+func (x *TransferCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCollectionRequest.ProtoReflect.Descriptor instead.
+func (*TransferCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransferCollectionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferCollectionRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// CollectionShare is a guest link to view a collection, issued to an email that isn't a member of the workspace.
+type CollectionShare struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionId int32                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CreatorId    int32                  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// The email the guest link is issued to.
+	Email string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	// The secret of the guest link, which is opened at /c/{collection_name}?share={token}.
+	Token      string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The number of times the guest link was opened.
+	ViewCount      int32                  `protobuf:"varint,8,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	LastViewedTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_viewed_time,json=lastViewedTime,proto3" json:"last_viewed_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CollectionShare) Reset() {
+	*x = CollectionShare{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionShare) ProtoMessage() {}
+
+func (x *CollectionShare) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionShare.ProtoReflect.Descriptor instead.
+func (*CollectionShare) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CollectionShare) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CollectionShare) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *CollectionShare) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *CollectionShare) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *CollectionShare) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *CollectionShare) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *CollectionShare) GetLastViewedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastViewedTime
+	}
+	return nil
+}
+
+type CreateCollectionShareRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId int32                  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Email        string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// The expiration time of the guest link. Defaults to 7 days later, and the max is 90 days later.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionShareRequest) Reset() {
+	*x = CreateCollectionShareRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionShareRequest) ProtoMessage() {}
+
+func (x *CreateCollectionShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateCollectionShareRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *CreateCollectionShareRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateCollectionShareRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type ListCollectionSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  int32                  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionSharesRequest) Reset() {
+	*x = ListCollectionSharesRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSharesRequest) ProtoMessage() {}
+
+func (x *ListCollectionSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListCollectionSharesRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type ListCollectionSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*CollectionShare     `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionSharesResponse) Reset() {
+	*x = ListCollectionSharesResponse{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSharesResponse) ProtoMessage() {}
+
+func (x *ListCollectionSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCollectionSharesResponse) GetShares() []*CollectionShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type DeleteCollectionShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  int32                  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionShareRequest) Reset() {
+	*x = DeleteCollectionShareRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionShareRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionShareRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteCollectionShareRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *DeleteCollectionShareRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSharedCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedCollectionRequest) Reset() {
+	*x = GetSharedCollectionRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedCollectionRequest) ProtoMessage() {}
+
+func (x *GetSharedCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSharedCollectionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SharedCollection struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Collection *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// The shortcuts of the collection, except the archived, expired and scheduled ones.
+	Shortcuts     []*Shortcut            `protobuf:"bytes,2,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedCollection) Reset() {
+	*x = SharedCollection{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollection) ProtoMessage() {}
+
+func (x *SharedCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollection.ProtoReflect.Descriptor instead.
+func (*SharedCollection) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SharedCollection) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *SharedCollection) GetShortcuts() []*Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+func (x *SharedCollection) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// CollectionTemplate is a blueprint of a collection with its placeholder shortcuts.
+type CollectionTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the template, e.g. "project-onboarding".
+	Id          string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Shortcuts   []*CollectionTemplate_ShortcutTemplate `protobuf:"bytes,4,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	// Output only. Whether the template is built in, which can't be changed.
+	BuiltIn       bool `protobuf:"varint,5,opt,name=built_in,json=builtIn,proto3" json:"built_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionTemplate) Reset() {
+	*x = CollectionTemplate{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionTemplate) ProtoMessage() {}
+
+func (x *CollectionTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionTemplate.ProtoReflect.Descriptor instead.
+func (*CollectionTemplate) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CollectionTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetShortcuts() []*CollectionTemplate_ShortcutTemplate {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+func (x *CollectionTemplate) GetBuiltIn() bool {
+	if x != nil {
+		return x.BuiltIn
+	}
+	return false
+}
+
+type ListCollectionTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionTemplatesRequest) Reset() {
+	*x = ListCollectionTemplatesRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionTemplatesRequest) ProtoMessage() {}
+
+func (x *ListCollectionTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{19}
+}
+
+type ListCollectionTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*CollectionTemplate  `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionTemplatesResponse) Reset() {
+	*x = ListCollectionTemplatesResponse{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionTemplatesResponse) ProtoMessage() {}
+
+func (x *ListCollectionTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListCollectionTemplatesResponse) GetTemplates() []*CollectionTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreateCollectionFromTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// The name, title, description and visibility of the collection.
+	// The title defaults to the title of the template.
+	Collection    *Collection `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionFromTemplateRequest) Reset() {
+	*x = CreateCollectionFromTemplateRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateCollectionFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateCollectionFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateCollectionFromTemplateRequest) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type CollectionTemplate_ShortcutTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name, title, link and description may contain the placeholders
+	// `{name}` and `{title}` of the collection created from the template.
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title         string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Link          string   `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	Description   string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionTemplate_ShortcutTemplate) Reset() {
+	*x = CollectionTemplate_ShortcutTemplate{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionTemplate_ShortcutTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionTemplate_ShortcutTemplate) ProtoMessage() {}
+
+func (x *CollectionTemplate_ShortcutTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionTemplate_ShortcutTemplate.ProtoReflect.Descriptor instead.
+func (*CollectionTemplate_ShortcutTemplate) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *CollectionTemplate_ShortcutTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionTemplate_ShortcutTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CollectionTemplate_ShortcutTemplate) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *CollectionTemplate_ShortcutTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CollectionTemplate_ShortcutTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+var File_api_v1_collection_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_collection_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1fapi/v1/collection_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a\x1dapi/v1/shortcut_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x03\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
+	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12!\n" +
+	"\fshortcut_ids\x18\t \x03(\x05R\vshortcutIds\x128\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\x0e2\x18.slash.api.v1.VisibilityR\n" +
+	"visibility\x12)\n" +
+	"\x10creator_username\x18\v \x01(\tR\x0fcreatorUsername\x12\x17\n" +
+	"\ateam_id\x18\f \x01(\x05R\x06teamId\x12)\n" +
+	"\x05state\x18\r \x01(\x0e2\x13.slash.api.v1.StateR\x05state\"\xbb\x01\n" +
+	"\x16ListCollectionsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12)\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x13.slash.api.v1.StateR\x05state\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\x05R\tcreatorId\x12\x1b\n" +
+	"\tmine_only\x18\x05 \x01(\bR\bmineOnly\"}\n" +
+	"\x17ListCollectionsResponse\x12:\n" +
+	"\vcollections\x18\x01 \x03(\v2\x18.slash.api.v1.CollectionR\vcollections\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"&\n" +
+	"\x14GetCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"0\n" +
+	"\x1aGetCollectionByNameRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"S\n" +
+	"\x17CreateCollectionRequest\x128\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x18.slash.api.v1.CollectionR\n" +
+	"collection\"\x90\x01\n" +
+	"\x17UpdateCollectionRequest\x128\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x18.slash.api.v1.CollectionR\n" +
+	"collection\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\")\n" +
+	"\x17DeleteCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"*\n" +
+	"\x18ArchiveCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\",\n" +
+	"\x1aUnarchiveCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"D\n" +
+	"\x19TransferCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xf2\x02\n" +
+	"\x0fCollectionShare\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x05R\fcollectionId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x14\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12;\n" +
+	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\b \x01(\x05R\tviewCount\x12D\n" +
+	"\x10last_viewed_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0elastViewedTime\"\x96\x01\n" +
+	"\x1cCreateCollectionShareRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\x05R\fcollectionId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12;\n" +
+	"\vexpire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"B\n" +
+	"\x1bListCollectionSharesRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\x05R\fcollectionId\"U\n" +
+	"\x1cListCollectionSharesResponse\x125\n" +
+	"\x06shares\x18\x01 \x03(\v2\x1d.slash.api.v1.CollectionShareR\x06shares\"S\n" +
+	"\x1cDeleteCollectionShareRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\x05R\fcollectionId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"2\n" +
+	"\x1aGetSharedCollectionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xbf\x01\n" +
+	"\x10SharedCollection\x128\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x18.slash.api.v1.CollectionR\n" +
+	"collection\x124\n" +
+	"\tshortcuts\x18\x02 \x03(\v2\x16.slash.api.v1.ShortcutR\tshortcuts\x12;\n" +
+	"\vexpire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\xd1\x02\n" +
+	"\x12CollectionTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12O\n" +
+	"\tshortcuts\x18\x04 \x03(\v21.slash.api.v1.CollectionTemplate.ShortcutTemplateR\tshortcuts\x12\x19\n" +
+	"\bbuilt_in\x18\x05 \x01(\bR\abuiltIn\x1a\x86\x01\n" +
+	"\x10ShortcutTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\" \n" +
+	"\x1eListCollectionTemplatesRequest\"a\n" +
+	"\x1fListCollectionTemplatesResponse\x12>\n" +
+	"\ttemplates\x18\x01 \x03(\v2 .slash.api.v1.CollectionTemplateR\ttemplates\"\x80\x01\n" +
+	"#CreateCollectionFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x128\n" +
+	"\n" +
+	"collection\x18\x02 \x01(\v2\x18.slash.api.v1.CollectionR\n" +
+	"collection2\xe9\x10\n" +
 	"\x11CollectionService\x12{\n" +
 	"\x0fListCollections\x12$.slash.api.v1.ListCollectionsRequest\x1a%.slash.api.v1.ListCollectionsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/collections\x12t\n" +
 	"\rGetCollection\x12\".slash.api.v1.GetCollectionRequest\x1a\x18.slash.api.v1.Collection\"%\xdaA\x02id\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/collections/{id}\x12[\n" +
@@ -488,7 +1492,17 @@ const file_api_v1_collection_service_proto_rawDesc = "" +
 	"collection\"\x13/api/v1/collections\x12\xa5\x01\n" +
 	"\x10UpdateCollection\x12%.slash.api.v1.UpdateCollectionRequest\x1a\x18.slash.api.v1.Collection\"P\xdaA\x16collection,update_mask\x82\xd3\xe4\x93\x021:\n" +
 	"collection\x1a#/api/v1/collections/{collection.id}\x12x\n" +
-	"\x10DeleteCollection\x12%.slash.api.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x02id\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/collections/{id}B.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
+	"\x10DeleteCollection\x12%.slash.api.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x02id\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/collections/{id}\x12\x87\x01\n" +
+	"\x11ArchiveCollection\x12&.slash.api.v1.ArchiveCollectionRequest\x1a\x18.slash.api.v1.Collection\"0\xdaA\x02id\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/collections/{id}:archive\x12\x8d\x01\n" +
+	"\x13UnarchiveCollection\x12(.slash.api.v1.UnarchiveCollectionRequest\x1a\x18.slash.api.v1.Collection\"2\xdaA\x02id\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/collections/{id}:unarchive\x12\x92\x01\n" +
+	"\x12TransferCollection\x12'.slash.api.v1.TransferCollectionRequest\x1a\x18.slash.api.v1.Collection\"9\xdaA\n" +
+	"id,user_id\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/collections/{id}:transfer\x12\x99\x01\n" +
+	"\x15CreateCollectionShare\x12*.slash.api.v1.CreateCollectionShareRequest\x1a\x1d.slash.api.v1.CollectionShare\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/collections/{collection_id}/shares\x12\xb1\x01\n" +
+	"\x14ListCollectionShares\x12).slash.api.v1.ListCollectionSharesRequest\x1a*.slash.api.v1.ListCollectionSharesResponse\"B\xdaA\rcollection_id\x82\xd3\xe4\x93\x02,\x12*/api/v1/collections/{collection_id}/shares\x12\x94\x01\n" +
+	"\x15DeleteCollectionShare\x12*.slash.api.v1.DeleteCollectionShareRequest\x1a\x16.google.protobuf.Empty\"7\x82\xd3\xe4\x93\x021*//api/v1/collections/{collection_id}/shares/{id}\x12\x93\x01\n" +
+	"\x13GetSharedCollection\x12(.slash.api.v1.GetSharedCollectionRequest\x1a\x1e.slash.api.v1.SharedCollection\"2\xdaA\x05token\x82\xd3\xe4\x93\x02$\x12\"/api/v1/shared-collections/{token}\x12\x9c\x01\n" +
+	"\x17ListCollectionTemplates\x12,.slash.api.v1.ListCollectionTemplatesRequest\x1a-.slash.api.v1.ListCollectionTemplatesResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/collection-templates\x12\x98\x01\n" +
+	"\x1cCreateCollectionFromTemplate\x121.slash.api.v1.CreateCollectionFromTemplateRequest\x1a\x18.slash.api.v1.Collection\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/collections:fromTemplateB.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
 
 var (
 	file_api_v1_collection_service_proto_rawDescOnce sync.Once
@@ -502,46 +1516,94 @@ func file_api_v1_collection_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_collection_service_proto_rawDescData
 }
 
-var file_api_v1_collection_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_collection_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_api_v1_collection_service_proto_goTypes = []any{
-	(*Collection)(nil),                 // 0: slash.api.v1.Collection
-	(*ListCollectionsRequest)(nil),     // 1: slash.api.v1.ListCollectionsRequest
-	(*ListCollectionsResponse)(nil),    // 2: slash.api.v1.ListCollectionsResponse
-	(*GetCollectionRequest)(nil),       // 3: slash.api.v1.GetCollectionRequest
-	(*GetCollectionByNameRequest)(nil), // 4: slash.api.v1.GetCollectionByNameRequest
-	(*CreateCollectionRequest)(nil),    // 5: slash.api.v1.CreateCollectionRequest
-	(*UpdateCollectionRequest)(nil),    // 6: slash.api.v1.UpdateCollectionRequest
-	(*DeleteCollectionRequest)(nil),    // 7: slash.api.v1.DeleteCollectionRequest
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
-	(Visibility)(0),                    // 9: slash.api.v1.Visibility
-	(*fieldmaskpb.FieldMask)(nil),      // 10: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),              // 11: google.protobuf.Empty
+	(*Collection)(nil),                          // 0: slash.api.v1.Collection
+	(*ListCollectionsRequest)(nil),              // 1: slash.api.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),             // 2: slash.api.v1.ListCollectionsResponse
+	(*GetCollectionRequest)(nil),                // 3: slash.api.v1.GetCollectionRequest
+	(*GetCollectionByNameRequest)(nil),          // 4: slash.api.v1.GetCollectionByNameRequest
+	(*CreateCollectionRequest)(nil),             // 5: slash.api.v1.CreateCollectionRequest
+	(*UpdateCollectionRequest)(nil),             // 6: slash.api.v1.UpdateCollectionRequest
+	(*DeleteCollectionRequest)(nil),             // 7: slash.api.v1.DeleteCollectionRequest
+	(*ArchiveCollectionRequest)(nil),            // 8: slash.api.v1.ArchiveCollectionRequest
+	(*UnarchiveCollectionRequest)(nil),          // 9: slash.api.v1.UnarchiveCollectionRequest
+	(*TransferCollectionRequest)(nil),           // 10: slash.api.v1.TransferCollectionRequest
+	(*CollectionShare)(nil),                     // 11: slash.api.v1.CollectionShare
+	(*CreateCollectionShareRequest)(nil),        // 12: slash.api.v1.CreateCollectionShareRequest
+	(*ListCollectionSharesRequest)(nil),         // 13: slash.api.v1.ListCollectionSharesRequest
+	(*ListCollectionSharesResponse)(nil),        // 14: slash.api.v1.ListCollectionSharesResponse
+	(*DeleteCollectionShareRequest)(nil),        // 15: slash.api.v1.DeleteCollectionShareRequest
+	(*GetSharedCollectionRequest)(nil),          // 16: slash.api.v1.GetSharedCollectionRequest
+	(*SharedCollection)(nil),                    // 17: slash.api.v1.SharedCollection
+	(*CollectionTemplate)(nil),                  // 18: slash.api.v1.CollectionTemplate
+	(*ListCollectionTemplatesRequest)(nil),      // 19: slash.api.v1.ListCollectionTemplatesRequest
+	(*ListCollectionTemplatesResponse)(nil),     // 20: slash.api.v1.ListCollectionTemplatesResponse
+	(*CreateCollectionFromTemplateRequest)(nil), // 21: slash.api.v1.CreateCollectionFromTemplateRequest
+	(*CollectionTemplate_ShortcutTemplate)(nil), // 22: slash.api.v1.CollectionTemplate.ShortcutTemplate
+	(*timestamppb.Timestamp)(nil),               // 23: google.protobuf.Timestamp
+	(Visibility)(0),                             // 24: slash.api.v1.Visibility
+	(State)(0),                                  // 25: slash.api.v1.State
+	(*fieldmaskpb.FieldMask)(nil),               // 26: google.protobuf.FieldMask
+	(*Shortcut)(nil),                            // 27: slash.api.v1.Shortcut
+	(*emptypb.Empty)(nil),                       // 28: google.protobuf.Empty
 }
 var file_api_v1_collection_service_proto_depIdxs = []int32{
-	8,  // 0: slash.api.v1.Collection.created_time:type_name -> google.protobuf.Timestamp
-	8,  // 1: slash.api.v1.Collection.updated_time:type_name -> google.protobuf.Timestamp
-	9,  // 2: slash.api.v1.Collection.visibility:type_name -> slash.api.v1.Visibility
-	0,  // 3: slash.api.v1.ListCollectionsResponse.collections:type_name -> slash.api.v1.Collection
-	0,  // 4: slash.api.v1.CreateCollectionRequest.collection:type_name -> slash.api.v1.Collection
-	0,  // 5: slash.api.v1.UpdateCollectionRequest.collection:type_name -> slash.api.v1.Collection
-	10, // 6: slash.api.v1.UpdateCollectionRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 7: slash.api.v1.CollectionService.ListCollections:input_type -> slash.api.v1.ListCollectionsRequest
-	3,  // 8: slash.api.v1.CollectionService.GetCollection:input_type -> slash.api.v1.GetCollectionRequest
-	4,  // 9: slash.api.v1.CollectionService.GetCollectionByName:input_type -> slash.api.v1.GetCollectionByNameRequest
-	5,  // 10: slash.api.v1.CollectionService.CreateCollection:input_type -> slash.api.v1.CreateCollectionRequest
-	6,  // 11: slash.api.v1.CollectionService.UpdateCollection:input_type -> slash.api.v1.UpdateCollectionRequest
-	7,  // 12: slash.api.v1.CollectionService.DeleteCollection:input_type -> slash.api.v1.DeleteCollectionRequest
-	2,  // 13: slash.api.v1.CollectionService.ListCollections:output_type -> slash.api.v1.ListCollectionsResponse
-	0,  // 14: slash.api.v1.CollectionService.GetCollection:output_type -> slash.api.v1.Collection
-	0,  // 15: slash.api.v1.CollectionService.GetCollectionByName:output_type -> slash.api.v1.Collection
-	0,  // 16: slash.api.v1.CollectionService.CreateCollection:output_type -> slash.api.v1.Collection
-	0,  // 17: slash.api.v1.CollectionService.UpdateCollection:output_type -> slash.api.v1.Collection
-	11, // 18: slash.api.v1.CollectionService.DeleteCollection:output_type -> google.protobuf.Empty
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	23, // 0: slash.api.v1.Collection.created_time:type_name -> google.protobuf.Timestamp
+	23, // 1: slash.api.v1.Collection.updated_time:type_name -> google.protobuf.Timestamp
+	24, // 2: slash.api.v1.Collection.visibility:type_name -> slash.api.v1.Visibility
+	25, // 3: slash.api.v1.Collection.state:type_name -> slash.api.v1.State
+	25, // 4: slash.api.v1.ListCollectionsRequest.state:type_name -> slash.api.v1.State
+	0,  // 5: slash.api.v1.ListCollectionsResponse.collections:type_name -> slash.api.v1.Collection
+	0,  // 6: slash.api.v1.CreateCollectionRequest.collection:type_name -> slash.api.v1.Collection
+	0,  // 7: slash.api.v1.UpdateCollectionRequest.collection:type_name -> slash.api.v1.Collection
+	26, // 8: slash.api.v1.UpdateCollectionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	23, // 9: slash.api.v1.CollectionShare.created_time:type_name -> google.protobuf.Timestamp
+	23, // 10: slash.api.v1.CollectionShare.expire_time:type_name -> google.protobuf.Timestamp
+	23, // 11: slash.api.v1.CollectionShare.last_viewed_time:type_name -> google.protobuf.Timestamp
+	23, // 12: slash.api.v1.CreateCollectionShareRequest.expire_time:type_name -> google.protobuf.Timestamp
+	11, // 13: slash.api.v1.ListCollectionSharesResponse.shares:type_name -> slash.api.v1.CollectionShare
+	0,  // 14: slash.api.v1.SharedCollection.collection:type_name -> slash.api.v1.Collection
+	27, // 15: slash.api.v1.SharedCollection.shortcuts:type_name -> slash.api.v1.Shortcut
+	23, // 16: slash.api.v1.SharedCollection.expire_time:type_name -> google.protobuf.Timestamp
+	22, // 17: slash.api.v1.CollectionTemplate.shortcuts:type_name -> slash.api.v1.CollectionTemplate.ShortcutTemplate
+	18, // 18: slash.api.v1.ListCollectionTemplatesResponse.templates:type_name -> slash.api.v1.CollectionTemplate
+	0,  // 19: slash.api.v1.CreateCollectionFromTemplateRequest.collection:type_name -> slash.api.v1.Collection
+	1,  // 20: slash.api.v1.CollectionService.ListCollections:input_type -> slash.api.v1.ListCollectionsRequest
+	3,  // 21: slash.api.v1.CollectionService.GetCollection:input_type -> slash.api.v1.GetCollectionRequest
+	4,  // 22: slash.api.v1.CollectionService.GetCollectionByName:input_type -> slash.api.v1.GetCollectionByNameRequest
+	5,  // 23: slash.api.v1.CollectionService.CreateCollection:input_type -> slash.api.v1.CreateCollectionRequest
+	6,  // 24: slash.api.v1.CollectionService.UpdateCollection:input_type -> slash.api.v1.UpdateCollectionRequest
+	7,  // 25: slash.api.v1.CollectionService.DeleteCollection:input_type -> slash.api.v1.DeleteCollectionRequest
+	8,  // 26: slash.api.v1.CollectionService.ArchiveCollection:input_type -> slash.api.v1.ArchiveCollectionRequest
+	9,  // 27: slash.api.v1.CollectionService.UnarchiveCollection:input_type -> slash.api.v1.UnarchiveCollectionRequest
+	10, // 28: slash.api.v1.CollectionService.TransferCollection:input_type -> slash.api.v1.TransferCollectionRequest
+	12, // 29: slash.api.v1.CollectionService.CreateCollectionShare:input_type -> slash.api.v1.CreateCollectionShareRequest
+	13, // 30: slash.api.v1.CollectionService.ListCollectionShares:input_type -> slash.api.v1.ListCollectionSharesRequest
+	15, // 31: slash.api.v1.CollectionService.DeleteCollectionShare:input_type -> slash.api.v1.DeleteCollectionShareRequest
+	16, // 32: slash.api.v1.CollectionService.GetSharedCollection:input_type -> slash.api.v1.GetSharedCollectionRequest
+	19, // 33: slash.api.v1.CollectionService.ListCollectionTemplates:input_type -> slash.api.v1.ListCollectionTemplatesRequest
+	21, // 34: slash.api.v1.CollectionService.CreateCollectionFromTemplate:input_type -> slash.api.v1.CreateCollectionFromTemplateRequest
+	2,  // 35: slash.api.v1.CollectionService.ListCollections:output_type -> slash.api.v1.ListCollectionsResponse
+	0,  // 36: slash.api.v1.CollectionService.GetCollection:output_type -> slash.api.v1.Collection
+	0,  // 37: slash.api.v1.CollectionService.GetCollectionByName:output_type -> slash.api.v1.Collection
+	0,  // 38: slash.api.v1.CollectionService.CreateCollection:output_type -> slash.api.v1.Collection
+	0,  // 39: slash.api.v1.CollectionService.UpdateCollection:output_type -> slash.api.v1.Collection
+	28, // 40: slash.api.v1.CollectionService.DeleteCollection:output_type -> google.protobuf.Empty
+	0,  // 41: slash.api.v1.CollectionService.ArchiveCollection:output_type -> slash.api.v1.Collection
+	0,  // 42: slash.api.v1.CollectionService.UnarchiveCollection:output_type -> slash.api.v1.Collection
+	0,  // 43: slash.api.v1.CollectionService.TransferCollection:output_type -> slash.api.v1.Collection
+	11, // 44: slash.api.v1.CollectionService.CreateCollectionShare:output_type -> slash.api.v1.CollectionShare
+	14, // 45: slash.api.v1.CollectionService.ListCollectionShares:output_type -> slash.api.v1.ListCollectionSharesResponse
+	28, // 46: slash.api.v1.CollectionService.DeleteCollectionShare:output_type -> google.protobuf.Empty
+	17, // 47: slash.api.v1.CollectionService.GetSharedCollection:output_type -> slash.api.v1.SharedCollection
+	20, // 48: slash.api.v1.CollectionService.ListCollectionTemplates:output_type -> slash.api.v1.ListCollectionTemplatesResponse
+	0,  // 49: slash.api.v1.CollectionService.CreateCollectionFromTemplate:output_type -> slash.api.v1.Collection
+	35, // [35:50] is the sub-list for method output_type
+	20, // [20:35] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_collection_service_proto_init() }
@@ -550,13 +1612,14 @@ func file_api_v1_collection_service_proto_init() {
 		return
 	}
 	file_api_v1_common_proto_init()
+	file_api_v1_shortcut_service_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_collection_service_proto_rawDesc), len(file_api_v1_collection_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,12 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CollectionService_ListCollections_FullMethodName     = "/slash.api.v1.CollectionService/ListCollections"
-	CollectionService_GetCollection_FullMethodName       = "/slash.api.v1.CollectionService/GetCollection"
-	CollectionService_GetCollectionByName_FullMethodName = "/slash.api.v1.CollectionService/GetCollectionByName"
-	CollectionService_CreateCollection_FullMethodName    = "/slash.api.v1.CollectionService/CreateCollection"
-	CollectionService_UpdateCollection_FullMethodName    = "/slash.api.v1.CollectionService/UpdateCollection"
-	CollectionService_DeleteCollection_FullMethodName    = "/slash.api.v1.CollectionService/DeleteCollection"
+	CollectionService_ListCollections_FullMethodName              = "/slash.api.v1.CollectionService/ListCollections"
+	CollectionService_GetCollection_FullMethodName                = "/slash.api.v1.CollectionService/GetCollection"
+	CollectionService_GetCollectionByName_FullMethodName          = "/slash.api.v1.CollectionService/GetCollectionByName"
+	CollectionService_CreateCollection_FullMethodName             = "/slash.api.v1.CollectionService/CreateCollection"
+	CollectionService_UpdateCollection_FullMethodName             = "/slash.api.v1.CollectionService/UpdateCollection"
+	CollectionService_DeleteCollection_FullMethodName             = "/slash.api.v1.CollectionService/DeleteCollection"
+	CollectionService_ArchiveCollection_FullMethodName            = "/slash.api.v1.CollectionService/ArchiveCollection"
+	CollectionService_UnarchiveCollection_FullMethodName          = "/slash.api.v1.CollectionService/UnarchiveCollection"
+	CollectionService_TransferCollection_FullMethodName           = "/slash.api.v1.CollectionService/TransferCollection"
+	CollectionService_CreateCollectionShare_FullMethodName        = "/slash.api.v1.CollectionService/CreateCollectionShare"
+	CollectionService_ListCollectionShares_FullMethodName         = "/slash.api.v1.CollectionService/ListCollectionShares"
+	CollectionService_DeleteCollectionShare_FullMethodName        = "/slash.api.v1.CollectionService/DeleteCollectionShare"
+	CollectionService_GetSharedCollection_FullMethodName          = "/slash.api.v1.CollectionService/GetSharedCollection"
+	CollectionService_ListCollectionTemplates_FullMethodName      = "/slash.api.v1.CollectionService/ListCollectionTemplates"
+	CollectionService_CreateCollectionFromTemplate_FullMethodName = "/slash.api.v1.CollectionService/CreateCollectionFromTemplate"
 )
 
 // CollectionServiceClient is the client API for CollectionService service.
@@ -44,6 +53,24 @@ type CollectionServiceClient interface {
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
 	// DeleteCollection deletes a collection by id.
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ArchiveCollection archives a collection, which is kept rather than deleted. Only for its creator and admins.
+	ArchiveCollection(ctx context.Context, in *ArchiveCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// UnarchiveCollection restores an archived collection. Only for its creator and admins.
+	UnarchiveCollection(ctx context.Context, in *UnarchiveCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// TransferCollection transfers the ownership of a collection to another user. Only for its creator and admins.
+	TransferCollection(ctx context.Context, in *TransferCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// CreateCollectionShare invites an email that isn't a member of the workspace to view the collection with a guest link.
+	CreateCollectionShare(ctx context.Context, in *CreateCollectionShareRequest, opts ...grpc.CallOption) (*CollectionShare, error)
+	// ListCollectionShares returns the guest links of the collection.
+	ListCollectionShares(ctx context.Context, in *ListCollectionSharesRequest, opts ...grpc.CallOption) (*ListCollectionSharesResponse, error)
+	// DeleteCollectionShare revokes a guest link of the collection.
+	DeleteCollectionShare(ctx context.Context, in *DeleteCollectionShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetSharedCollection returns the collection of a guest link with its shortcuts, and counts the view.
+	GetSharedCollection(ctx context.Context, in *GetSharedCollectionRequest, opts ...grpc.CallOption) (*SharedCollection, error)
+	// ListCollectionTemplates returns the built-in and the admin-defined collection templates.
+	ListCollectionTemplates(ctx context.Context, in *ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*ListCollectionTemplatesResponse, error)
+	// CreateCollectionFromTemplate creates a collection with the shortcuts of a template.
+	CreateCollectionFromTemplate(ctx context.Context, in *CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*Collection, error)
 }
 
 type collectionServiceClient struct {
@@ -114,6 +141,96 @@ func (c *collectionServiceClient) DeleteCollection(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *collectionServiceClient) ArchiveCollection(ctx context.Context, in *ArchiveCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_ArchiveCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) UnarchiveCollection(ctx context.Context, in *UnarchiveCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_UnarchiveCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) TransferCollection(ctx context.Context, in *TransferCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_TransferCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) CreateCollectionShare(ctx context.Context, in *CreateCollectionShareRequest, opts ...grpc.CallOption) (*CollectionShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionShare)
+	err := c.cc.Invoke(ctx, CollectionService_CreateCollectionShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListCollectionShares(ctx context.Context, in *ListCollectionSharesRequest, opts ...grpc.CallOption) (*ListCollectionSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionSharesResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListCollectionShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) DeleteCollectionShare(ctx context.Context, in *DeleteCollectionShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_DeleteCollectionShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetSharedCollection(ctx context.Context, in *GetSharedCollectionRequest, opts ...grpc.CallOption) (*SharedCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedCollection)
+	err := c.cc.Invoke(ctx, CollectionService_GetSharedCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListCollectionTemplates(ctx context.Context, in *ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*ListCollectionTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionTemplatesResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListCollectionTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) CreateCollectionFromTemplate(ctx context.Context, in *CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_CreateCollectionFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollectionServiceServer is the server API for CollectionService service.
 // All implementations must embed UnimplementedCollectionServiceServer
 // for forward compatibility.
@@ -130,6 +247,24 @@ type CollectionServiceServer interface {
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
 	// DeleteCollection deletes a collection by id.
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error)
+	// ArchiveCollection archives a collection, which is kept rather than deleted. Only for its creator and admins.
+	ArchiveCollection(context.Context, *ArchiveCollectionRequest) (*Collection, error)
+	// UnarchiveCollection restores an archived collection. Only for its creator and admins.
+	UnarchiveCollection(context.Context, *UnarchiveCollectionRequest) (*Collection, error)
+	// TransferCollection transfers the ownership of a collection to another user. Only for its creator and admins.
+	TransferCollection(context.Context, *TransferCollectionRequest) (*Collection, error)
+	// CreateCollectionShare invites an email that isn't a member of the workspace to view the collection with a guest link.
+	CreateCollectionShare(context.Context, *CreateCollectionShareRequest) (*CollectionShare, error)
+	// ListCollectionShares returns the guest links of the collection.
+	ListCollectionShares(context.Context, *ListCollectionSharesRequest) (*ListCollectionSharesResponse, error)
+	// DeleteCollectionShare revokes a guest link of the collection.
+	DeleteCollectionShare(context.Context, *DeleteCollectionShareRequest) (*emptypb.Empty, error)
+	// GetSharedCollection returns the collection of a guest link with its shortcuts, and counts the view.
+	GetSharedCollection(context.Context, *GetSharedCollectionRequest) (*SharedCollection, error)
+	// ListCollectionTemplates returns the built-in and the admin-defined collection templates.
+	ListCollectionTemplates(context.Context, *ListCollectionTemplatesRequest) (*ListCollectionTemplatesResponse, error)
+	// CreateCollectionFromTemplate creates a collection with the shortcuts of a template.
+	CreateCollectionFromTemplate(context.Context, *CreateCollectionFromTemplateRequest) (*Collection, error)
 	mustEmbedUnimplementedCollectionServiceServer()
 }
 
@@ -158,6 +293,33 @@ func (UnimplementedCollectionServiceServer) UpdateCollection(context.Context, *U
 func (UnimplementedCollectionServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
 }
+func (UnimplementedCollectionServiceServer) ArchiveCollection(context.Context, *ArchiveCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) UnarchiveCollection(context.Context, *UnarchiveCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) TransferCollection(context.Context, *TransferCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) CreateCollectionShare(context.Context, *CreateCollectionShareRequest) (*CollectionShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollectionShare not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListCollectionShares(context.Context, *ListCollectionSharesRequest) (*ListCollectionSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionShares not implemented")
+}
+func (UnimplementedCollectionServiceServer) DeleteCollectionShare(context.Context, *DeleteCollectionShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollectionShare not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetSharedCollection(context.Context, *GetSharedCollectionRequest) (*SharedCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListCollectionTemplates(context.Context, *ListCollectionTemplatesRequest) (*ListCollectionTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionTemplates not implemented")
+}
+func (UnimplementedCollectionServiceServer) CreateCollectionFromTemplate(context.Context, *CreateCollectionFromTemplateRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollectionFromTemplate not implemented")
+}
 func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
 func (UnimplementedCollectionServiceServer) testEmbeddedByValue()                           {}
 
@@ -287,6 +449,168 @@ func _CollectionService_DeleteCollection_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollectionService_ArchiveCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ArchiveCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ArchiveCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ArchiveCollection(ctx, req.(*ArchiveCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_UnarchiveCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).UnarchiveCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_UnarchiveCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).UnarchiveCollection(ctx, req.(*UnarchiveCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_TransferCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).TransferCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_TransferCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).TransferCollection(ctx, req.(*TransferCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_CreateCollectionShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).CreateCollectionShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_CreateCollectionShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).CreateCollectionShare(ctx, req.(*CreateCollectionShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListCollectionShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListCollectionShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListCollectionShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListCollectionShares(ctx, req.(*ListCollectionSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_DeleteCollectionShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).DeleteCollectionShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_DeleteCollectionShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).DeleteCollectionShare(ctx, req.(*DeleteCollectionShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GetSharedCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetSharedCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_GetSharedCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetSharedCollection(ctx, req.(*GetSharedCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListCollectionTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListCollectionTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListCollectionTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListCollectionTemplates(ctx, req.(*ListCollectionTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_CreateCollectionFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).CreateCollectionFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_CreateCollectionFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).CreateCollectionFromTemplate(ctx, req.(*CreateCollectionFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CollectionService_ServiceDesc is the grpc.ServiceDesc for CollectionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -318,6 +642,42 @@ var CollectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCollection",
 			Handler:    _CollectionService_DeleteCollection_Handler,
 		},
+		{
+			MethodName: "ArchiveCollection",
+			Handler:    _CollectionService_ArchiveCollection_Handler,
+		},
+		{
+			MethodName: "UnarchiveCollection",
+			Handler:    _CollectionService_UnarchiveCollection_Handler,
+		},
+		{
+			MethodName: "TransferCollection",
+			Handler:    _CollectionService_TransferCollection_Handler,
+		},
+		{
+			MethodName: "CreateCollectionShare",
+			Handler:    _CollectionService_CreateCollectionShare_Handler,
+		},
+		{
+			MethodName: "ListCollectionShares",
+			Handler:    _CollectionService_ListCollectionShares_Handler,
+		},
+		{
+			MethodName: "DeleteCollectionShare",
+			Handler:    _CollectionService_DeleteCollectionShare_Handler,
+		},
+		{
+			MethodName: "GetSharedCollection",
+			Handler:    _CollectionService_GetSharedCollection_Handler,
+		},
+		{
+			MethodName: "ListCollectionTemplates",
+			Handler:    _CollectionService_ListCollectionTemplates_Handler,
+		},
+		{
+			MethodName: "CreateCollectionFromTemplate",
+			Handler:    _CollectionService_CreateCollectionFromTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/collection_service.proto",
@@ -0,0 +1,582 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.28.3
+// source: api/v1/search_service.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchResult_Type int32
+
+const (
+	SearchResult_TYPE_UNSPECIFIED SearchResult_Type = 0
+	SearchResult_SHORTCUT         SearchResult_Type = 1
+	SearchResult_COLLECTION       SearchResult_Type = 2
+	SearchResult_TAG              SearchResult_Type = 3
+)
+
+// Enum value maps for SearchResult_Type.
+var (
+	SearchResult_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SHORTCUT",
+		2: "COLLECTION",
+		3: "TAG",
+	}
+	SearchResult_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SHORTCUT":         1,
+		"COLLECTION":       2,
+		"TAG":              3,
+	}
+)
+
+func (x SearchResult_Type) Enum() *SearchResult_Type {
+	p := new(SearchResult_Type)
+	*p = x
+	return p
+}
+
+func (x SearchResult_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchResult_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_search_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchResult_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_search_service_proto_enumTypes[0]
+}
+
+func (x SearchResult_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchResult_Type.Descriptor instead.
+func (SearchResult_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_search_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The words to search for. A result matches when each word is the prefix of a word of its fields.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The types of the results to return. Empty returns all of them.
+	Types []SearchResult_Type `protobuf:"varint,2,rep,packed,name=types,proto3,enum=slash.api.v1.SearchResult_Type" json:"types,omitempty"`
+	// The max number of results to return. Unset or 0 returns 20, and the max is 100.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_api_v1_search_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_search_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_search_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTypes() []SearchResult_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The results, the best ranked first.
+	Results       []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_api_v1_search_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_search_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_search_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  SearchResult_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=slash.api.v1.SearchResult_Type" json:"type,omitempty"`
+	// The score of the result. The higher, the better the result matches the query.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// The fields of the result which match the query.
+	Highlights []*SearchResult_Highlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	// Types that are valid to be assigned to Item:
+	//
+	//	*SearchResult_Shortcut
+	//	*SearchResult_Collection
+	//	*SearchResult_Tag_
+	Item          isSearchResult_Item `protobuf_oneof:"item"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_api_v1_search_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_search_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_search_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchResult) GetType() SearchResult_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SearchResult_TYPE_UNSPECIFIED
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetHighlights() []*SearchResult_Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *SearchResult) GetItem() isSearchResult_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *SearchResult) GetShortcut() *Shortcut {
+	if x != nil {
+		if x, ok := x.Item.(*SearchResult_Shortcut); ok {
+			return x.Shortcut
+		}
+	}
+	return nil
+}
+
+func (x *SearchResult) GetCollection() *Collection {
+	if x != nil {
+		if x, ok := x.Item.(*SearchResult_Collection); ok {
+			return x.Collection
+		}
+	}
+	return nil
+}
+
+func (x *SearchResult) GetTag() *SearchResult_Tag {
+	if x != nil {
+		if x, ok := x.Item.(*SearchResult_Tag_); ok {
+			return x.Tag
+		}
+	}
+	return nil
+}
+
+type isSearchResult_Item interface {
+	isSearchResult_Item()
+}
+
+type SearchResult_Shortcut struct {
+	Shortcut *Shortcut `protobuf:"bytes,4,opt,name=shortcut,proto3,oneof"`
+}
+
+type SearchResult_Collection struct {
+	Collection *Collection `protobuf:"bytes,5,opt,name=collection,proto3,oneof"`
+}
+
+type SearchResult_Tag_ struct {
+	Tag *SearchResult_Tag `protobuf:"bytes,6,opt,name=tag,proto3,oneof"`
+}
+
+func (*SearchResult_Shortcut) isSearchResult_Item() {}
+
+func (*SearchResult_Collection) isSearchResult_Item() {}
+
+func (*SearchResult_Tag_) isSearchResult_Item() {}
+
+type SearchResult_Highlight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the field, e.g. `name` or `title`.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The value of the field split into the parts matching the query and the others.
+	Segments      []*SearchResult_Segment `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult_Highlight) Reset() {
+	*x = SearchResult_Highlight{}
+	mi := &file_api_v1_search_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult_Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult_Highlight) ProtoMessage() {}
+
+func (x *SearchResult_Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_search_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult_Highlight.ProtoReflect.Descriptor instead.
+func (*SearchResult_Highlight) Descriptor() ([]byte, []int) {
+	return file_api_v1_search_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *SearchResult_Highlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchResult_Highlight) GetSegments() []*SearchResult_Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type SearchResult_Segment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Matched       bool                   `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult_Segment) Reset() {
+	*x = SearchResult_Segment{}
+	mi := &file_api_v1_search_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult_Segment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult_Segment) ProtoMessage() {}
+
+func (x *SearchResult_Segment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_search_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult_Segment.ProtoReflect.Descriptor instead.
+func (*SearchResult_Segment) Descriptor() ([]byte, []int) {
+	return file_api_v1_search_service_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *SearchResult_Segment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchResult_Segment) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+type SearchResult_Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of shortcuts with the tag.
+	ShortcutCount int32 `protobuf:"varint,2,opt,name=shortcut_count,json=shortcutCount,proto3" json:"shortcut_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult_Tag) Reset() {
+	*x = SearchResult_Tag{}
+	mi := &file_api_v1_search_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult_Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult_Tag) ProtoMessage() {}
+
+func (x *SearchResult_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_search_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult_Tag.ProtoReflect.Descriptor instead.
+func (*SearchResult_Tag) Descriptor() ([]byte, []int) {
+	return file_api_v1_search_service_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *SearchResult_Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchResult_Tag) GetShortcutCount() int32 {
+	if x != nil {
+		return x.ShortcutCount
+	}
+	return 0
+}
+
+var File_api_v1_search_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_search_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1bapi/v1/search_service.proto\x12\fslash.api.v1\x1a\x1fapi/v1/collection_service.proto\x1a\x1dapi/v1/shortcut_service.proto\x1a\x1cgoogle/api/annotations.proto\"r\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x125\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x1f.slash.api.v1.SearchResult.TypeR\x05types\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"F\n" +
+	"\x0eSearchResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.slash.api.v1.SearchResultR\aresults\"\xf0\x04\n" +
+	"\fSearchResult\x123\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1f.slash.api.v1.SearchResult.TypeR\x04type\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12D\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2$.slash.api.v1.SearchResult.HighlightR\n" +
+	"highlights\x124\n" +
+	"\bshortcut\x18\x04 \x01(\v2\x16.slash.api.v1.ShortcutH\x00R\bshortcut\x12:\n" +
+	"\n" +
+	"collection\x18\x05 \x01(\v2\x18.slash.api.v1.CollectionH\x00R\n" +
+	"collection\x122\n" +
+	"\x03tag\x18\x06 \x01(\v2\x1e.slash.api.v1.SearchResult.TagH\x00R\x03tag\x1aa\n" +
+	"\tHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12>\n" +
+	"\bsegments\x18\x02 \x03(\v2\".slash.api.v1.SearchResult.SegmentR\bsegments\x1a7\n" +
+	"\aSegment\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\bR\amatched\x1a@\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0eshortcut_count\x18\x02 \x01(\x05R\rshortcutCount\"C\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSHORTCUT\x10\x01\x12\x0e\n" +
+	"\n" +
+	"COLLECTION\x10\x02\x12\a\n" +
+	"\x03TAG\x10\x03B\x06\n" +
+	"\x04item2l\n" +
+	"\rSearchService\x12[\n" +
+	"\x06Search\x12\x1b.slash.api.v1.SearchRequest\x1a\x1c.slash.api.v1.SearchResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/searchB.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
+
+var (
+	file_api_v1_search_service_proto_rawDescOnce sync.Once
+	file_api_v1_search_service_proto_rawDescData []byte
+)
+
+func file_api_v1_search_service_proto_rawDescGZIP() []byte {
+	file_api_v1_search_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_search_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_search_service_proto_rawDesc), len(file_api_v1_search_service_proto_rawDesc)))
+	})
+	return file_api_v1_search_service_proto_rawDescData
+}
+
+var file_api_v1_search_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_search_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_search_service_proto_goTypes = []any{
+	(SearchResult_Type)(0),         // 0: slash.api.v1.SearchResult.Type
+	(*SearchRequest)(nil),          // 1: slash.api.v1.SearchRequest
+	(*SearchResponse)(nil),         // 2: slash.api.v1.SearchResponse
+	(*SearchResult)(nil),           // 3: slash.api.v1.SearchResult
+	(*SearchResult_Highlight)(nil), // 4: slash.api.v1.SearchResult.Highlight
+	(*SearchResult_Segment)(nil),   // 5: slash.api.v1.SearchResult.Segment
+	(*SearchResult_Tag)(nil),       // 6: slash.api.v1.SearchResult.Tag
+	(*Shortcut)(nil),               // 7: slash.api.v1.Shortcut
+	(*Collection)(nil),             // 8: slash.api.v1.Collection
+}
+var file_api_v1_search_service_proto_depIdxs = []int32{
+	0, // 0: slash.api.v1.SearchRequest.types:type_name -> slash.api.v1.SearchResult.Type
+	3, // 1: slash.api.v1.SearchResponse.results:type_name -> slash.api.v1.SearchResult
+	0, // 2: slash.api.v1.SearchResult.type:type_name -> slash.api.v1.SearchResult.Type
+	4, // 3: slash.api.v1.SearchResult.highlights:type_name -> slash.api.v1.SearchResult.Highlight
+	7, // 4: slash.api.v1.SearchResult.shortcut:type_name -> slash.api.v1.Shortcut
+	8, // 5: slash.api.v1.SearchResult.collection:type_name -> slash.api.v1.Collection
+	6, // 6: slash.api.v1.SearchResult.tag:type_name -> slash.api.v1.SearchResult.Tag
+	5, // 7: slash.api.v1.SearchResult.Highlight.segments:type_name -> slash.api.v1.SearchResult.Segment
+	1, // 8: slash.api.v1.SearchService.Search:input_type -> slash.api.v1.SearchRequest
+	2, // 9: slash.api.v1.SearchService.Search:output_type -> slash.api.v1.SearchResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_search_service_proto_init() }
+func file_api_v1_search_service_proto_init() {
+	if File_api_v1_search_service_proto != nil {
+		return
+	}
+	file_api_v1_collection_service_proto_init()
+	file_api_v1_shortcut_service_proto_init()
+	file_api_v1_search_service_proto_msgTypes[2].OneofWrappers = []any{
+		(*SearchResult_Shortcut)(nil),
+		(*SearchResult_Collection)(nil),
+		(*SearchResult_Tag_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_search_service_proto_rawDesc), len(file_api_v1_search_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_search_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_search_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_search_service_proto_enumTypes,
+		MessageInfos:      file_api_v1_search_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_search_service_proto = out.File
+	file_api_v1_search_service_proto_goTypes = nil
+	file_api_v1_search_service_proto_depIdxs = nil
+}
@@ -25,39 +25,4533 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Shortcut_DocumentMode int32
+
+const (
+	// The visitors are redirected to the link.
+	Shortcut_DOCUMENT_MODE_UNSPECIFIED Shortcut_DocumentMode = 0
+	// The document is shown in a viewer page of the short link.
+	Shortcut_INLINE Shortcut_DocumentMode = 1
+	// The document is downloaded as an attachment, with its file name.
+	Shortcut_DOWNLOAD Shortcut_DocumentMode = 2
+)
+
+// Enum value maps for Shortcut_DocumentMode.
+var (
+	Shortcut_DocumentMode_name = map[int32]string{
+		0: "DOCUMENT_MODE_UNSPECIFIED",
+		1: "INLINE",
+		2: "DOWNLOAD",
+	}
+	Shortcut_DocumentMode_value = map[string]int32{
+		"DOCUMENT_MODE_UNSPECIFIED": 0,
+		"INLINE":                    1,
+		"DOWNLOAD":                  2,
+	}
+)
+
+func (x Shortcut_DocumentMode) Enum() *Shortcut_DocumentMode {
+	p := new(Shortcut_DocumentMode)
+	*p = x
+	return p
+}
+
+func (x Shortcut_DocumentMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Shortcut_DocumentMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Shortcut_DocumentMode) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[0]
+}
+
+func (x Shortcut_DocumentMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Shortcut_DocumentMode.Descriptor instead.
+func (Shortcut_DocumentMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Shortcut_RedirectRule_DeviceType int32
+
+const (
+	Shortcut_RedirectRule_DEVICE_TYPE_UNSPECIFIED Shortcut_RedirectRule_DeviceType = 0
+	Shortcut_RedirectRule_DESKTOP                 Shortcut_RedirectRule_DeviceType = 1
+	Shortcut_RedirectRule_MOBILE                  Shortcut_RedirectRule_DeviceType = 2
+)
+
+// Enum value maps for Shortcut_RedirectRule_DeviceType.
+var (
+	Shortcut_RedirectRule_DeviceType_name = map[int32]string{
+		0: "DEVICE_TYPE_UNSPECIFIED",
+		1: "DESKTOP",
+		2: "MOBILE",
+	}
+	Shortcut_RedirectRule_DeviceType_value = map[string]int32{
+		"DEVICE_TYPE_UNSPECIFIED": 0,
+		"DESKTOP":                 1,
+		"MOBILE":                  2,
+	}
+)
+
+func (x Shortcut_RedirectRule_DeviceType) Enum() *Shortcut_RedirectRule_DeviceType {
+	p := new(Shortcut_RedirectRule_DeviceType)
+	*p = x
+	return p
+}
+
+func (x Shortcut_RedirectRule_DeviceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Shortcut_RedirectRule_DeviceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[1].Descriptor()
+}
+
+func (Shortcut_RedirectRule_DeviceType) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[1]
+}
+
+func (x Shortcut_RedirectRule_DeviceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Shortcut_RedirectRule_DeviceType.Descriptor instead.
+func (Shortcut_RedirectRule_DeviceType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 3, 0}
+}
+
+type BulkUpdateShortcutTagsRequest_Operation int32
+
+const (
+	BulkUpdateShortcutTagsRequest_OPERATION_UNSPECIFIED BulkUpdateShortcutTagsRequest_Operation = 0
+	// Add the tag to the shortcuts without it.
+	BulkUpdateShortcutTagsRequest_ADD BulkUpdateShortcutTagsRequest_Operation = 1
+	// Remove the tag from the shortcuts with it.
+	BulkUpdateShortcutTagsRequest_REMOVE BulkUpdateShortcutTagsRequest_Operation = 2
+	// Replace the tag with the new tag in the shortcuts with it.
+	BulkUpdateShortcutTagsRequest_REPLACE BulkUpdateShortcutTagsRequest_Operation = 3
+)
+
+// Enum value maps for BulkUpdateShortcutTagsRequest_Operation.
+var (
+	BulkUpdateShortcutTagsRequest_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "ADD",
+		2: "REMOVE",
+		3: "REPLACE",
+	}
+	BulkUpdateShortcutTagsRequest_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"ADD":                   1,
+		"REMOVE":                2,
+		"REPLACE":               3,
+	}
+)
+
+func (x BulkUpdateShortcutTagsRequest_Operation) Enum() *BulkUpdateShortcutTagsRequest_Operation {
+	p := new(BulkUpdateShortcutTagsRequest_Operation)
+	*p = x
+	return p
+}
+
+func (x BulkUpdateShortcutTagsRequest_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkUpdateShortcutTagsRequest_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[2].Descriptor()
+}
+
+func (BulkUpdateShortcutTagsRequest_Operation) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[2]
+}
+
+func (x BulkUpdateShortcutTagsRequest_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkUpdateShortcutTagsRequest_Operation.Descriptor instead.
+func (BulkUpdateShortcutTagsRequest_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type ResolvePreviewResponse_Outcome int32
+
+const (
+	ResolvePreviewResponse_OUTCOME_UNSPECIFIED ResolvePreviewResponse_Outcome = 0
+	// Redirects to the target.
+	ResolvePreviewResponse_REDIRECT ResolvePreviewResponse_Outcome = 1
+	// The link is not a url, so it's shown as plain text.
+	ResolvePreviewResponse_PLAIN_TEXT ResolvePreviewResponse_Outcome = 2
+	// The shortcut is scheduled to activate after the time of the visit.
+	ResolvePreviewResponse_NOT_ACTIVE ResolvePreviewResponse_Outcome = 3
+	// The shortcut is expired or archived at the time of the visit.
+	ResolvePreviewResponse_EXPIRED ResolvePreviewResponse_Outcome = 4
+	// The shortcut doesn't exist and the visit is redirected to the fallback url of the workspace.
+	ResolvePreviewResponse_FALLBACK_REDIRECT ResolvePreviewResponse_Outcome = 5
+	// The shortcut doesn't exist and the not found page is shown.
+	ResolvePreviewResponse_NOT_FOUND ResolvePreviewResponse_Outcome = 6
+)
+
+// Enum value maps for ResolvePreviewResponse_Outcome.
+var (
+	ResolvePreviewResponse_Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "REDIRECT",
+		2: "PLAIN_TEXT",
+		3: "NOT_ACTIVE",
+		4: "EXPIRED",
+		5: "FALLBACK_REDIRECT",
+		6: "NOT_FOUND",
+	}
+	ResolvePreviewResponse_Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED": 0,
+		"REDIRECT":            1,
+		"PLAIN_TEXT":          2,
+		"NOT_ACTIVE":          3,
+		"EXPIRED":             4,
+		"FALLBACK_REDIRECT":   5,
+		"NOT_FOUND":           6,
+	}
+)
+
+func (x ResolvePreviewResponse_Outcome) Enum() *ResolvePreviewResponse_Outcome {
+	p := new(ResolvePreviewResponse_Outcome)
+	*p = x
+	return p
+}
+
+func (x ResolvePreviewResponse_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResolvePreviewResponse_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ResolvePreviewResponse_Outcome) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[3]
+}
+
+func (x ResolvePreviewResponse_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResolvePreviewResponse_Outcome.Descriptor instead.
+func (ResolvePreviewResponse_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type GetShortcutAnalyticsRequest_Interval int32
+
+const (
+	GetShortcutAnalyticsRequest_INTERVAL_UNSPECIFIED GetShortcutAnalyticsRequest_Interval = 0
+	GetShortcutAnalyticsRequest_DAY                  GetShortcutAnalyticsRequest_Interval = 1
+	// Weeks start on Monday in UTC.
+	GetShortcutAnalyticsRequest_WEEK GetShortcutAnalyticsRequest_Interval = 2
+)
+
+// Enum value maps for GetShortcutAnalyticsRequest_Interval.
+var (
+	GetShortcutAnalyticsRequest_Interval_name = map[int32]string{
+		0: "INTERVAL_UNSPECIFIED",
+		1: "DAY",
+		2: "WEEK",
+	}
+	GetShortcutAnalyticsRequest_Interval_value = map[string]int32{
+		"INTERVAL_UNSPECIFIED": 0,
+		"DAY":                  1,
+		"WEEK":                 2,
+	}
+)
+
+func (x GetShortcutAnalyticsRequest_Interval) Enum() *GetShortcutAnalyticsRequest_Interval {
+	p := new(GetShortcutAnalyticsRequest_Interval)
+	*p = x
+	return p
+}
+
+func (x GetShortcutAnalyticsRequest_Interval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetShortcutAnalyticsRequest_Interval) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[4].Descriptor()
+}
+
+func (GetShortcutAnalyticsRequest_Interval) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[4]
+}
+
+func (x GetShortcutAnalyticsRequest_Interval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsRequest_Interval.Descriptor instead.
+func (GetShortcutAnalyticsRequest_Interval) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type GetShortcutQRCodeRequest_Format int32
+
+const (
+	GetShortcutQRCodeRequest_FORMAT_UNSPECIFIED GetShortcutQRCodeRequest_Format = 0
+	GetShortcutQRCodeRequest_PNG                GetShortcutQRCodeRequest_Format = 1
+	GetShortcutQRCodeRequest_SVG                GetShortcutQRCodeRequest_Format = 2
+)
+
+// Enum value maps for GetShortcutQRCodeRequest_Format.
+var (
+	GetShortcutQRCodeRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "PNG",
+		2: "SVG",
+	}
+	GetShortcutQRCodeRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"PNG":                1,
+		"SVG":                2,
+	}
+)
+
+func (x GetShortcutQRCodeRequest_Format) Enum() *GetShortcutQRCodeRequest_Format {
+	p := new(GetShortcutQRCodeRequest_Format)
+	*p = x
+	return p
+}
+
+func (x GetShortcutQRCodeRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetShortcutQRCodeRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[5].Descriptor()
+}
+
+func (GetShortcutQRCodeRequest_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[5]
+}
+
+func (x GetShortcutQRCodeRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetShortcutQRCodeRequest_Format.Descriptor instead.
+func (GetShortcutQRCodeRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type GetTrendingShortcutsRequest_Window int32
+
+const (
+	GetTrendingShortcutsRequest_WINDOW_UNSPECIFIED GetTrendingShortcutsRequest_Window = 0
+	GetTrendingShortcutsRequest_DAY                GetTrendingShortcutsRequest_Window = 1
+	GetTrendingShortcutsRequest_WEEK               GetTrendingShortcutsRequest_Window = 2
+)
+
+// Enum value maps for GetTrendingShortcutsRequest_Window.
+var (
+	GetTrendingShortcutsRequest_Window_name = map[int32]string{
+		0: "WINDOW_UNSPECIFIED",
+		1: "DAY",
+		2: "WEEK",
+	}
+	GetTrendingShortcutsRequest_Window_value = map[string]int32{
+		"WINDOW_UNSPECIFIED": 0,
+		"DAY":                1,
+		"WEEK":               2,
+	}
+)
+
+func (x GetTrendingShortcutsRequest_Window) Enum() *GetTrendingShortcutsRequest_Window {
+	p := new(GetTrendingShortcutsRequest_Window)
+	*p = x
+	return p
+}
+
+func (x GetTrendingShortcutsRequest_Window) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTrendingShortcutsRequest_Window) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[6].Descriptor()
+}
+
+func (GetTrendingShortcutsRequest_Window) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[6]
+}
+
+func (x GetTrendingShortcutsRequest_Window) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTrendingShortcutsRequest_Window.Descriptor instead.
+func (GetTrendingShortcutsRequest_Window) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{44, 0}
+}
+
+type ProposedChange_Status int32
+
+const (
+	ProposedChange_STATUS_UNSPECIFIED ProposedChange_Status = 0
+	// The change waits for the approval of the creator or an admin.
+	ProposedChange_PENDING ProposedChange_Status = 1
+	// The change is applied to the shortcut.
+	ProposedChange_APPROVED ProposedChange_Status = 2
+	ProposedChange_REJECTED ProposedChange_Status = 3
+)
+
+// Enum value maps for ProposedChange_Status.
+var (
+	ProposedChange_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "APPROVED",
+		3: "REJECTED",
+	}
+	ProposedChange_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"APPROVED":           2,
+		"REJECTED":           3,
+	}
+)
+
+func (x ProposedChange_Status) Enum() *ProposedChange_Status {
+	p := new(ProposedChange_Status)
+	*p = x
+	return p
+}
+
+func (x ProposedChange_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProposedChange_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[7].Descriptor()
+}
+
+func (ProposedChange_Status) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[7]
+}
+
+func (x ProposedChange_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProposedChange_Status.Descriptor instead.
+func (ProposedChange_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type ShortcutACL_Role int32
+
+const (
+	ShortcutACL_ROLE_UNSPECIFIED ShortcutACL_Role = 0
+	// The user can view the shortcut and be redirected by it.
+	ShortcutACL_READ ShortcutACL_Role = 1
+	// The user can also update the shortcut, except its visibility and protection.
+	ShortcutACL_EDIT ShortcutACL_Role = 2
+)
+
+// Enum value maps for ShortcutACL_Role.
+var (
+	ShortcutACL_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "READ",
+		2: "EDIT",
+	}
+	ShortcutACL_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"READ":             1,
+		"EDIT":             2,
+	}
+)
+
+func (x ShortcutACL_Role) Enum() *ShortcutACL_Role {
+	p := new(ShortcutACL_Role)
+	*p = x
+	return p
+}
+
+func (x ShortcutACL_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShortcutACL_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[8].Descriptor()
+}
+
+func (ShortcutACL_Role) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[8]
+}
+
+func (x ShortcutACL_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShortcutACL_Role.Descriptor instead.
+func (ShortcutACL_Role) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{56, 0}
+}
+
+type ImportJob_Status int32
+
+const (
+	ImportJob_STATUS_UNSPECIFIED ImportJob_Status = 0
+	// The job waits to be run.
+	ImportJob_PENDING ImportJob_Status = 1
+	ImportJob_RUNNING ImportJob_Status = 2
+	// All the rows are processed, some may have failed.
+	ImportJob_SUCCEEDED ImportJob_Status = 3
+	// The job stopped at a row, e.g. the database was unavailable. It can be resumed.
+	ImportJob_FAILED ImportJob_Status = 4
+)
+
+// Enum value maps for ImportJob_Status.
+var (
+	ImportJob_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "RUNNING",
+		3: "SUCCEEDED",
+		4: "FAILED",
+	}
+	ImportJob_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"RUNNING":            2,
+		"SUCCEEDED":          3,
+		"FAILED":             4,
+	}
+)
+
+func (x ImportJob_Status) Enum() *ImportJob_Status {
+	p := new(ImportJob_Status)
+	*p = x
+	return p
+}
+
+func (x ImportJob_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportJob_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[9].Descriptor()
+}
+
+func (ImportJob_Status) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[9]
+}
+
+func (x ImportJob_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportJob_Status.Descriptor instead.
+func (ImportJob_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{66, 0}
+}
+
 type Shortcut struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Id            int32                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatorId     int32                       `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTime   *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	UpdatedTime   *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
-	Name          string                      `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Link          string                      `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
-	Title         string                      `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
-	Tags          []string                    `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	Description   string                      `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	Visibility    Visibility                  `protobuf:"varint,11,opt,name=visibility,proto3,enum=slash.api.v1.Visibility" json:"visibility,omitempty"`
-	ViewCount     int32                       `protobuf:"varint,12,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
-	OgMetadata    *Shortcut_OpenGraphMetadata `protobuf:"bytes,13,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	// Output only. Expired shortcuts are archived as INACTIVE.
+	State       State                       `protobuf:"varint,5,opt,name=state,proto3,enum=slash.api.v1.State" json:"state,omitempty"`
+	Name        string                      `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Link        string                      `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	Title       string                      `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Tags        []string                    `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Description string                      `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  Visibility                  `protobuf:"varint,11,opt,name=visibility,proto3,enum=slash.api.v1.Visibility" json:"visibility,omitempty"`
+	ViewCount   int32                       `protobuf:"varint,12,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	OgMetadata  *Shortcut_OpenGraphMetadata `protobuf:"bytes,13,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	// The username of the creator.
+	CreatorUsername string              `protobuf:"bytes,14,opt,name=creator_username,json=creatorUsername,proto3" json:"creator_username,omitempty"`
+	ClickGoal       *Shortcut_ClickGoal `protobuf:"bytes,15,opt,name=click_goal,json=clickGoal,proto3" json:"click_goal,omitempty"`
+	// The time the shortcut expires. Unset means never.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The query parameters appended to the link on redirect, e.g. utm_source.
+	// The parameters already in the link or in the request are kept.
+	QueryParams []*Shortcut_QueryParam `protobuf:"bytes,17,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
+	// The time the shortcut starts resolving. Unset means immediately.
+	// Until then, the link is only visible to the creator and admins.
+	ActivateTime *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=activate_time,json=activateTime,proto3" json:"activate_time,omitempty"`
+	// The other names resolving to the shortcut, e.g. its names in other languages like "hilfe" for "help",
+	// or the names of the shortcuts merged into it. They are updated as a set with the "aliases" path, and
+	// must be unique among the names and the aliases of the other shortcuts.
+	Aliases []string `protobuf:"bytes,19,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// Whether the edits of the users other than the creator and admins are proposed changes, which the creator
+	// or an admin has to approve. Only the creator and admins can change it.
+	Protected bool `protobuf:"varint,20,opt,name=protected,proto3" json:"protected,omitempty"`
+	// The link the shortcut resolves to now, i.e. the link of its active rotation if any, otherwise its link.
+	CurrentLink string `protobuf:"bytes,21,opt,name=current_link,json=currentLink,proto3" json:"current_link,omitempty"`
+	// The id of the team the shortcut is visible to, when the visibility is TEAM.
+	TeamId int32 `protobuf:"varint,22,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// The HTTP status code of the redirect: 301 (permanent), 302 (temporary) or 307 (temporary, preserving the method).
+	// 0 means the default redirect code of the workspace.
+	RedirectCode int32 `protobuf:"varint,23,opt,name=redirect_code,json=redirectCode,proto3" json:"redirect_code,omitempty"`
+	// Output only. The result of the last health check of the link, when the workspace checks the links.
+	LinkHealth *Shortcut_LinkHealth `protobuf:"bytes,24,opt,name=link_health,json=linkHealth,proto3" json:"link_health,omitempty"`
+	// How the link is served when it's a document, e.g. a PDF, rather than a web page. The documents are
+	// proxied by the server, and the links to web pages or to the private networks are redirected as usual.
+	DocumentMode Shortcut_DocumentMode `protobuf:"varint,25,opt,name=document_mode,json=documentMode,proto3,enum=slash.api.v1.Shortcut_DocumentMode" json:"document_mode,omitempty"`
+	// Whether the description is internal context, only shown to the signed-in users. The public pages,
+	// e.g. the shared collections, and the unfurls of the short link show the title without it.
+	InternalDescription bool `protobuf:"varint,26,opt,name=internal_description,json=internalDescription,proto3" json:"internal_description,omitempty"`
+	// The rules redirecting the visitors to other links than the current link, e.g. the visitors of the EU to a regional
+	// site. The first rule matching the visitor wins, and the current link is the fallback. Only applied to the visits.
+	RedirectRules []*Shortcut_RedirectRule `protobuf:"bytes,27,rep,name=redirect_rules,json=redirectRules,proto3" json:"redirect_rules,omitempty"`
+	// The weighted links splitting the visits, e.g. for an A/B test. On each visit, one is picked with the probability of
+	// its weight instead of the current link, unless a redirect rule matches. Only applied to the visits.
+	Variants      []*Shortcut_Variant `protobuf:"bytes,28,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut) Reset() {
+	*x = Shortcut{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut) ProtoMessage() {}
+
+func (x *Shortcut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut.ProtoReflect.Descriptor instead.
+func (*Shortcut) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Shortcut) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Shortcut) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *Shortcut) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *Shortcut) GetUpdatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return nil
+}
+
+func (x *Shortcut) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *Shortcut) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Shortcut) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Shortcut) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Shortcut) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Shortcut) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Shortcut) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Shortcut) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *Shortcut) GetOgMetadata() *Shortcut_OpenGraphMetadata {
+	if x != nil {
+		return x.OgMetadata
+	}
+	return nil
+}
+
+func (x *Shortcut) GetCreatorUsername() string {
+	if x != nil {
+		return x.CreatorUsername
+	}
+	return ""
+}
+
+func (x *Shortcut) GetClickGoal() *Shortcut_ClickGoal {
+	if x != nil {
+		return x.ClickGoal
+	}
+	return nil
+}
+
+func (x *Shortcut) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *Shortcut) GetQueryParams() []*Shortcut_QueryParam {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+func (x *Shortcut) GetActivateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivateTime
+	}
+	return nil
+}
+
+func (x *Shortcut) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Shortcut) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *Shortcut) GetCurrentLink() string {
+	if x != nil {
+		return x.CurrentLink
+	}
+	return ""
+}
+
+func (x *Shortcut) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Shortcut) GetRedirectCode() int32 {
+	if x != nil {
+		return x.RedirectCode
+	}
+	return 0
+}
+
+func (x *Shortcut) GetLinkHealth() *Shortcut_LinkHealth {
+	if x != nil {
+		return x.LinkHealth
+	}
+	return nil
+}
+
+func (x *Shortcut) GetDocumentMode() Shortcut_DocumentMode {
+	if x != nil {
+		return x.DocumentMode
+	}
+	return Shortcut_DOCUMENT_MODE_UNSPECIFIED
+}
+
+func (x *Shortcut) GetInternalDescription() bool {
+	if x != nil {
+		return x.InternalDescription
+	}
+	return false
+}
+
+func (x *Shortcut) GetRedirectRules() []*Shortcut_RedirectRule {
+	if x != nil {
+		return x.RedirectRules
+	}
+	return nil
+}
+
+func (x *Shortcut) GetVariants() []*Shortcut_Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type ListShortcutsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The max number of shortcuts to return. Unset or 0 returns all of them, and the max is 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous response to get the next page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filters the shortcuts by their state, ACTIVE or INACTIVE for the archived ones. Unset returns all of them.
+	State State `protobuf:"varint,3,opt,name=state,proto3,enum=slash.api.v1.State" json:"state,omitempty"`
+	// Filters the shortcuts by the id of their creator. Unset or 0 returns the shortcuts of all the users.
+	CreatorId int32 `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// Returns only the shortcuts created by the current user.
+	MineOnly      bool `protobuf:"varint,5,opt,name=mine_only,json=mineOnly,proto3" json:"mine_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutsRequest) Reset() {
+	*x = ListShortcutsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutsRequest) ProtoMessage() {}
+
+func (x *ListShortcutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListShortcutsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListShortcutsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListShortcutsRequest) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *ListShortcutsRequest) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ListShortcutsRequest) GetMineOnly() bool {
+	if x != nil {
+		return x.MineOnly
+	}
+	return false
+}
+
+type ListShortcutsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Shortcuts []*Shortcut            `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	// The token of the next page. Empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutsResponse) Reset() {
+	*x = ListShortcutsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutsResponse) ProtoMessage() {}
+
+func (x *ListShortcutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutsResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListShortcutsResponse) GetShortcuts() []*Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+func (x *ListShortcutsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchShortcutsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The query is made of keywords and filters separated by spaces, e.g. `docs tag:eng visibility:public creator:steven`.
+	// The keywords are matched as word prefixes against the name, title, description, tags and link.
+	// The filters are `tag:<tag>`, `visibility:<workspace|public>` and `creator:<username>`.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The max number of shortcuts to return. Unset or 0 returns all of them, and the max is 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous response to get the next page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchShortcutsRequest) Reset() {
+	*x = SearchShortcutsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchShortcutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchShortcutsRequest) ProtoMessage() {}
+
+func (x *SearchShortcutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*SearchShortcutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchShortcutsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchShortcutsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchShortcutsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchShortcutsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Shortcuts []*Shortcut            `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	// The token of the next page. Empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchShortcutsResponse) Reset() {
+	*x = SearchShortcutsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchShortcutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchShortcutsResponse) ProtoMessage() {}
+
+func (x *SearchShortcutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchShortcutsResponse.ProtoReflect.Descriptor instead.
+func (*SearchShortcutsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchShortcutsResponse) GetShortcuts() []*Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+func (x *SearchShortcutsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BulkUpdateShortcutTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The filter of the shortcuts, with the query syntax of SearchShortcuts. Empty matches all the shortcuts.
+	Filter    string                                  `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Operation BulkUpdateShortcutTagsRequest_Operation `protobuf:"varint,2,opt,name=operation,proto3,enum=slash.api.v1.BulkUpdateShortcutTagsRequest_Operation" json:"operation,omitempty"`
+	Tag       string                                  `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The new tag of REPLACE.
+	NewTag string `protobuf:"bytes,4,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	// Only return the shortcuts which would be updated, without updating them.
+	DryRun        bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateShortcutTagsRequest) Reset() {
+	*x = BulkUpdateShortcutTagsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateShortcutTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateShortcutTagsRequest) ProtoMessage() {}
+
+func (x *BulkUpdateShortcutTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateShortcutTagsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateShortcutTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BulkUpdateShortcutTagsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BulkUpdateShortcutTagsRequest) GetOperation() BulkUpdateShortcutTagsRequest_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return BulkUpdateShortcutTagsRequest_OPERATION_UNSPECIFIED
+}
+
+func (x *BulkUpdateShortcutTagsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *BulkUpdateShortcutTagsRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+func (x *BulkUpdateShortcutTagsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BulkUpdateShortcutTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of shortcuts updated, or which would be updated in a dry run.
+	AffectedCount int32 `protobuf:"varint,1,opt,name=affected_count,json=affectedCount,proto3" json:"affected_count,omitempty"`
+	// A sample of the affected shortcuts, with their tags after the update.
+	Sample        []*Shortcut `protobuf:"bytes,2,rep,name=sample,proto3" json:"sample,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateShortcutTagsResponse) Reset() {
+	*x = BulkUpdateShortcutTagsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateShortcutTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateShortcutTagsResponse) ProtoMessage() {}
+
+func (x *BulkUpdateShortcutTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateShortcutTagsResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateShortcutTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BulkUpdateShortcutTagsResponse) GetAffectedCount() int32 {
+	if x != nil {
+		return x.AffectedCount
+	}
+	return 0
+}
+
+func (x *BulkUpdateShortcutTagsResponse) GetSample() []*Shortcut {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+type MergeShortcutsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ids of the duplicate shortcuts, including the survivor.
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// The id of the shortcut to keep. Unset picks the most viewed shortcut, then the oldest.
+	SurvivorId    int32 `protobuf:"varint,2,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeShortcutsRequest) Reset() {
+	*x = MergeShortcutsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeShortcutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeShortcutsRequest) ProtoMessage() {}
+
+func (x *MergeShortcutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*MergeShortcutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MergeShortcutsRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *MergeShortcutsRequest) GetSurvivorId() int32 {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return 0
+}
+
+type ValidateLinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The links to validate, at most 100.
+	Links []string `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	// Whether to request the links to check they're reachable, which is slower.
+	CheckReachability bool `protobuf:"varint,2,opt,name=check_reachability,json=checkReachability,proto3" json:"check_reachability,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ValidateLinksRequest) Reset() {
+	*x = ValidateLinksRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateLinksRequest) ProtoMessage() {}
+
+func (x *ValidateLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateLinksRequest.ProtoReflect.Descriptor instead.
+func (*ValidateLinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidateLinksRequest) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *ValidateLinksRequest) GetCheckReachability() bool {
+	if x != nil {
+		return x.CheckReachability
+	}
+	return false
+}
+
+type ValidateLinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The results in the order of the links.
+	Results       []*ValidateLinksResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateLinksResponse) Reset() {
+	*x = ValidateLinksResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateLinksResponse) ProtoMessage() {}
+
+func (x *ValidateLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateLinksResponse.ProtoReflect.Descriptor instead.
+func (*ValidateLinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidateLinksResponse) GetResults() []*ValidateLinksResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetShortcutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutRequest) Reset() {
+	*x = GetShortcutRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutRequest) ProtoMessage() {}
+
+func (x *GetShortcutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutRequest.ProtoReflect.Descriptor instead.
+func (*GetShortcutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetShortcutRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetShortcutByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutByNameRequest) Reset() {
+	*x = GetShortcutByNameRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutByNameRequest) ProtoMessage() {}
+
+func (x *GetShortcutByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetShortcutByNameRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetShortcutByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ShortcutNotFoundDetails are the details of the not found error of GetShortcutByName.
+type ShortcutNotFoundDetails struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The names of the shortcuts close to the name, the closest first.
+	Suggestions   []string `protobuf:"bytes,2,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutNotFoundDetails) Reset() {
+	*x = ShortcutNotFoundDetails{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutNotFoundDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutNotFoundDetails) ProtoMessage() {}
+
+func (x *ShortcutNotFoundDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutNotFoundDetails.ProtoReflect.Descriptor instead.
+func (*ShortcutNotFoundDetails) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ShortcutNotFoundDetails) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShortcutNotFoundDetails) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type ListShortcutSuggestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutSuggestionsRequest) Reset() {
+	*x = ListShortcutSuggestionsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutSuggestionsRequest) ProtoMessage() {}
+
+func (x *ListShortcutSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListShortcutSuggestionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListShortcutSuggestionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the shortcuts close to the name, the closest first.
+	Suggestions   []string `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutSuggestionsResponse) Reset() {
+	*x = ListShortcutSuggestionsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutSuggestionsResponse) ProtoMessage() {}
+
+func (x *ListShortcutSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListShortcutSuggestionsResponse) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type ResolvePreviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name visited at /s/{name}, which may be an alias of the shortcut.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The simulated context of the visit.
+	Context       *ResolveContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvePreviewRequest) Reset() {
+	*x = ResolvePreviewRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvePreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePreviewRequest) ProtoMessage() {}
+
+func (x *ResolvePreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePreviewRequest.ProtoReflect.Descriptor instead.
+func (*ResolvePreviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ResolvePreviewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResolvePreviewRequest) GetContext() *ResolveContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ResolveContext struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The device of the visitor, "mobile" or "desktop", which the redirect rules match.
+	// Unset matches none of the rules with devices.
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// The ISO 3166-1 alpha-2 country code of the visitor, e.g. "US", which the redirect rules match.
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	// The time of the visit. Unset means now.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// The name of the collection the shortcut is opened from, if any.
+	Collection string `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
+	// The query string of the visit, e.g. "q=slash", which is passed on to the target.
+	Query string `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	// The Accept-Language header of the visitor, e.g. "pt-BR,en;q=0.8", which the redirect rules match.
+	Language      string `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveContext) Reset() {
+	*x = ResolveContext{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveContext) ProtoMessage() {}
+
+func (x *ResolveContext) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveContext.ProtoReflect.Descriptor instead.
+func (*ResolveContext) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResolveContext) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *ResolveContext) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ResolveContext) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ResolveContext) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ResolveContext) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ResolveContext) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ResolvePreviewResponse struct {
+	state   protoimpl.MessageState         `protogen:"open.v1"`
+	Outcome ResolvePreviewResponse_Outcome `protobuf:"varint,1,opt,name=outcome,proto3,enum=slash.api.v1.ResolvePreviewResponse_Outcome" json:"outcome,omitempty"`
+	// The url redirected to, or the link shown as plain text.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// The shortcut the name resolves to. Unset when it doesn't exist.
+	Shortcut *Shortcut `protobuf:"bytes,3,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	// Why the shortcut resolves this way, e.g. "resolved by the alias of docs".
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The names of the shortcuts close to the name, the closest first. Only set when the outcome is NOT_FOUND.
+	Suggestions []string `protobuf:"bytes,5,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	// The HTTP status code of the redirect when the outcome is REDIRECT.
+	// 0 means the shortcut page redirects in the browser.
+	RedirectCode  int32 `protobuf:"varint,6,opt,name=redirect_code,json=redirectCode,proto3" json:"redirect_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvePreviewResponse) Reset() {
+	*x = ResolvePreviewResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvePreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePreviewResponse) ProtoMessage() {}
+
+func (x *ResolvePreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePreviewResponse.ProtoReflect.Descriptor instead.
+func (*ResolvePreviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResolvePreviewResponse) GetOutcome() ResolvePreviewResponse_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return ResolvePreviewResponse_OUTCOME_UNSPECIFIED
+}
+
+func (x *ResolvePreviewResponse) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ResolvePreviewResponse) GetShortcut() *Shortcut {
+	if x != nil {
+		return x.Shortcut
+	}
+	return nil
+}
+
+func (x *ResolvePreviewResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResolvePreviewResponse) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *ResolvePreviewResponse) GetRedirectCode() int32 {
+	if x != nil {
+		return x.RedirectCode
+	}
+	return 0
+}
+
+type CreateShortcutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shortcut      *Shortcut              `protobuf:"bytes,1,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShortcutRequest) Reset() {
+	*x = CreateShortcutRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShortcutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShortcutRequest) ProtoMessage() {}
+
+func (x *CreateShortcutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShortcutRequest.ProtoReflect.Descriptor instead.
+func (*CreateShortcutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateShortcutRequest) GetShortcut() *Shortcut {
+	if x != nil {
+		return x.Shortcut
+	}
+	return nil
+}
+
+type UpdateShortcutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shortcut      *Shortcut              `protobuf:"bytes,1,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShortcutRequest) Reset() {
+	*x = UpdateShortcutRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShortcutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShortcutRequest) ProtoMessage() {}
+
+func (x *UpdateShortcutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShortcutRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShortcutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateShortcutRequest) GetShortcut() *Shortcut {
+	if x != nil {
+		return x.Shortcut
+	}
+	return nil
+}
+
+func (x *UpdateShortcutRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteShortcutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShortcutRequest) Reset() {
+	*x = DeleteShortcutRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShortcutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShortcutRequest) ProtoMessage() {}
+
+func (x *DeleteShortcutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShortcutRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShortcutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteShortcutRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TransferShortcutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The id of the user to transfer the shortcut to.
+	UserId        int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferShortcutRequest) Reset() {
+	*x = TransferShortcutRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferShortcutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferShortcutRequest) ProtoMessage() {}
+
+func (x *TransferShortcutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferShortcutRequest.ProtoReflect.Descriptor instead.
+func (*TransferShortcutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TransferShortcutRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferShortcutRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetShortcutAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The interval of the timeseries. Defaults to DAY.
+	Interval      GetShortcutAnalyticsRequest_Interval `protobuf:"varint,2,opt,name=interval,proto3,enum=slash.api.v1.GetShortcutAnalyticsRequest_Interval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutAnalyticsRequest) Reset() {
+	*x = GetShortcutAnalyticsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetShortcutAnalyticsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetShortcutAnalyticsRequest) GetInterval() GetShortcutAnalyticsRequest_Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return GetShortcutAnalyticsRequest_INTERVAL_UNSPECIFIED
+}
+
+type GetShortcutAnalyticsResponse struct {
+	state      protoimpl.MessageState                        `protogen:"open.v1"`
+	References []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	Devices    []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
+	Browsers   []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,3,rep,name=browsers,proto3" json:"browsers,omitempty"`
+	// The progress of the click goal, empty when the shortcut has no goal.
+	ClickGoalProgress *GetShortcutAnalyticsResponse_ClickGoalProgress `protobuf:"bytes,4,opt,name=click_goal_progress,json=clickGoalProgress,proto3" json:"click_goal_progress,omitempty"`
+	// The view counts per interval, ordered by time.
+	Timeseries []*GetShortcutAnalyticsResponse_TimeseriesItem `protobuf:"bytes,5,rep,name=timeseries,proto3" json:"timeseries,omitempty"`
+	Countries  []*GetShortcutAnalyticsResponse_AnalyticsItem  `protobuf:"bytes,6,rep,name=countries,proto3" json:"countries,omitempty"`
+	// The views per signed-in user by email, recorded when the workspace attributes the views to the users.
+	// The views of the visitors not signed in are named empty. Only visible to admins.
+	Users []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,7,rep,name=users,proto3" json:"users,omitempty"`
+	// The views per variant of the A/B split by name, recorded since the shortcut has variants.
+	// The views without a variant are named empty.
+	Variants      []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,8,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutAnalyticsResponse) Reset() {
+	*x = GetShortcutAnalyticsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetShortcutAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetShortcutAnalyticsResponse) GetReferences() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetDevices() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetBrowsers() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Browsers
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetClickGoalProgress() *GetShortcutAnalyticsResponse_ClickGoalProgress {
+	if x != nil {
+		return x.ClickGoalProgress
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetTimeseries() []*GetShortcutAnalyticsResponse_TimeseriesItem {
+	if x != nil {
+		return x.Timeseries
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetCountries() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetUsers() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetVariants() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// ShortcutAnalyticsShare is a read-only link to view the analytics of a shortcut without an account.
+type ShortcutAnalyticsShare struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortcutId  int32                  `protobuf:"varint,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// Whom the link is shared with, e.g. a client.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// The secret of the share link, which is opened at /analytics/{token}.
+	Token      string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The number of times the share link was opened.
+	ViewCount      int32                  `protobuf:"varint,8,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	LastViewedTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_viewed_time,json=lastViewedTime,proto3" json:"last_viewed_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShortcutAnalyticsShare) Reset() {
+	*x = ShortcutAnalyticsShare{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutAnalyticsShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutAnalyticsShare) ProtoMessage() {}
+
+func (x *ShortcutAnalyticsShare) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutAnalyticsShare.ProtoReflect.Descriptor instead.
+func (*ShortcutAnalyticsShare) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ShortcutAnalyticsShare) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortcutAnalyticsShare) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutAnalyticsShare) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ShortcutAnalyticsShare) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *ShortcutAnalyticsShare) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShortcutAnalyticsShare) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShortcutAnalyticsShare) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *ShortcutAnalyticsShare) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *ShortcutAnalyticsShare) GetLastViewedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastViewedTime
+	}
+	return nil
+}
+
+type CreateShortcutAnalyticsShareRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId  int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The expiration time of the share link. Defaults to 7 days later, and the max is 90 days later.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShortcutAnalyticsShareRequest) Reset() {
+	*x = CreateShortcutAnalyticsShareRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShortcutAnalyticsShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShortcutAnalyticsShareRequest) ProtoMessage() {}
+
+func (x *CreateShortcutAnalyticsShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShortcutAnalyticsShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateShortcutAnalyticsShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateShortcutAnalyticsShareRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *CreateShortcutAnalyticsShareRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateShortcutAnalyticsShareRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type ListShortcutAnalyticsSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutAnalyticsSharesRequest) Reset() {
+	*x = ListShortcutAnalyticsSharesRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutAnalyticsSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutAnalyticsSharesRequest) ProtoMessage() {}
+
+func (x *ListShortcutAnalyticsSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutAnalyticsSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutAnalyticsSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListShortcutAnalyticsSharesRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+type ListShortcutAnalyticsSharesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Shares        []*ShortcutAnalyticsShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutAnalyticsSharesResponse) Reset() {
+	*x = ListShortcutAnalyticsSharesResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutAnalyticsSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutAnalyticsSharesResponse) ProtoMessage() {}
+
+func (x *ListShortcutAnalyticsSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutAnalyticsSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutAnalyticsSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListShortcutAnalyticsSharesResponse) GetShares() []*ShortcutAnalyticsShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type DeleteShortcutAnalyticsShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShortcutAnalyticsShareRequest) Reset() {
+	*x = DeleteShortcutAnalyticsShareRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShortcutAnalyticsShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShortcutAnalyticsShareRequest) ProtoMessage() {}
+
+func (x *DeleteShortcutAnalyticsShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShortcutAnalyticsShareRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShortcutAnalyticsShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteShortcutAnalyticsShareRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *DeleteShortcutAnalyticsShareRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSharedShortcutAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The interval of the timeseries. Defaults to DAY.
+	Interval      GetShortcutAnalyticsRequest_Interval `protobuf:"varint,2,opt,name=interval,proto3,enum=slash.api.v1.GetShortcutAnalyticsRequest_Interval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedShortcutAnalyticsRequest) Reset() {
+	*x = GetSharedShortcutAnalyticsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedShortcutAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedShortcutAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetSharedShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedShortcutAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedShortcutAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetSharedShortcutAnalyticsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetSharedShortcutAnalyticsRequest) GetInterval() GetShortcutAnalyticsRequest_Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return GetShortcutAnalyticsRequest_INTERVAL_UNSPECIFIED
+}
+
+type SharedShortcutAnalytics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the shortcut. Its link and the other details are not shared.
+	ShortcutName  string                        `protobuf:"bytes,1,opt,name=shortcut_name,json=shortcutName,proto3" json:"shortcut_name,omitempty"`
+	ShortcutTitle string                        `protobuf:"bytes,2,opt,name=shortcut_title,json=shortcutTitle,proto3" json:"shortcut_title,omitempty"`
+	Analytics     *GetShortcutAnalyticsResponse `protobuf:"bytes,3,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	ExpireTime    *timestamppb.Timestamp        `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedShortcutAnalytics) Reset() {
+	*x = SharedShortcutAnalytics{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedShortcutAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedShortcutAnalytics) ProtoMessage() {}
+
+func (x *SharedShortcutAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedShortcutAnalytics.ProtoReflect.Descriptor instead.
+func (*SharedShortcutAnalytics) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SharedShortcutAnalytics) GetShortcutName() string {
+	if x != nil {
+		return x.ShortcutName
+	}
+	return ""
+}
+
+func (x *SharedShortcutAnalytics) GetShortcutTitle() string {
+	if x != nil {
+		return x.ShortcutTitle
+	}
+	return ""
+}
+
+func (x *SharedShortcutAnalytics) GetAnalytics() *GetShortcutAnalyticsResponse {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+func (x *SharedShortcutAnalytics) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type GetShortcutQRCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The format of the image. Defaults to PNG.
+	Format GetShortcutQRCodeRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=slash.api.v1.GetShortcutQRCodeRequest_Format" json:"format,omitempty"`
+	// The width and height of the image in pixels. Defaults to 256, between 64 and 2048.
+	Size          int32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutQRCodeRequest) Reset() {
+	*x = GetShortcutQRCodeRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutQRCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutQRCodeRequest) ProtoMessage() {}
+
+func (x *GetShortcutQRCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutQRCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetShortcutQRCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetShortcutQRCodeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetShortcutQRCodeRequest) GetFormat() GetShortcutQRCodeRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return GetShortcutQRCodeRequest_FORMAT_UNSPECIFIED
+}
+
+func (x *GetShortcutQRCodeRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetShortcutQRCodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content type of the image, e.g. image/png.
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The image.
+	Content       []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutQRCodeResponse) Reset() {
+	*x = GetShortcutQRCodeResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutQRCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutQRCodeResponse) ProtoMessage() {}
+
+func (x *GetShortcutQRCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutQRCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetShortcutQRCodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetShortcutQRCodeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetShortcutQRCodeResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ListBrokenShortcutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBrokenShortcutsRequest) Reset() {
+	*x = ListBrokenShortcutsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBrokenShortcutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrokenShortcutsRequest) ProtoMessage() {}
+
+func (x *ListBrokenShortcutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrokenShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*ListBrokenShortcutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{33}
+}
+
+type ListBrokenShortcutsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The broken shortcuts, the most recently checked first.
+	Shortcuts     []*Shortcut `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBrokenShortcutsResponse) Reset() {
+	*x = ListBrokenShortcutsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBrokenShortcutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrokenShortcutsResponse) ProtoMessage() {}
+
+func (x *ListBrokenShortcutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrokenShortcutsResponse.ProtoReflect.Descriptor instead.
+func (*ListBrokenShortcutsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListBrokenShortcutsResponse) GetShortcuts() []*Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+type RefreshShortcutMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshShortcutMetadataRequest) Reset() {
+	*x = RefreshShortcutMetadataRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshShortcutMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshShortcutMetadataRequest) ProtoMessage() {}
+
+func (x *RefreshShortcutMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshShortcutMetadataRequest.ProtoReflect.Descriptor instead.
+func (*RefreshShortcutMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RefreshShortcutMetadataRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SuggestShortcutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The link of the shortcut, whose page is summarized.
+	Link          string `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestShortcutRequest) Reset() {
+	*x = SuggestShortcutRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestShortcutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestShortcutRequest) ProtoMessage() {}
+
+func (x *SuggestShortcutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestShortcutRequest.ProtoReflect.Descriptor instead.
+func (*SuggestShortcutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SuggestShortcutRequest) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type SuggestShortcutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestShortcutResponse) Reset() {
+	*x = SuggestShortcutResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestShortcutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestShortcutResponse) ProtoMessage() {}
+
+func (x *SuggestShortcutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestShortcutResponse.ProtoReflect.Descriptor instead.
+func (*SuggestShortcutResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SuggestShortcutResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SuggestShortcutResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SuggestShortcutResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SemanticSearchShortcutsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The query in natural language.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The max number of shortcuts to return. Unset or 0 returns 10, and the max is 50.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SemanticSearchShortcutsRequest) Reset() {
+	*x = SemanticSearchShortcutsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SemanticSearchShortcutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemanticSearchShortcutsRequest) ProtoMessage() {}
+
+func (x *SemanticSearchShortcutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticSearchShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*SemanticSearchShortcutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SemanticSearchShortcutsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SemanticSearchShortcutsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SemanticSearchShortcutsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The results, the closest first. The shortcuts not embedded yet aren't returned.
+	Results       []*SemanticSearchShortcutsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SemanticSearchShortcutsResponse) Reset() {
+	*x = SemanticSearchShortcutsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SemanticSearchShortcutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemanticSearchShortcutsResponse) ProtoMessage() {}
+
+func (x *SemanticSearchShortcutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticSearchShortcutsResponse.ProtoReflect.Descriptor instead.
+func (*SemanticSearchShortcutsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SemanticSearchShortcutsResponse) GetResults() []*SemanticSearchShortcutsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GenerateSignedRedirectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The time the signed short link expires. Unset means in 7 days, and the max is in 90 days.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSignedRedirectRequest) Reset() {
+	*x = GenerateSignedRedirectRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSignedRedirectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSignedRedirectRequest) ProtoMessage() {}
+
+func (x *GenerateSignedRedirectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSignedRedirectRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSignedRedirectRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GenerateSignedRedirectRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GenerateSignedRedirectRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type GenerateSignedRedirectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The signed short link on the instance url, e.g. "https://slash.example.com/s/invoice?exp=1767225600&sig=...".
+	// The query parameters added to it are passed to the link as usual.
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSignedRedirectResponse) Reset() {
+	*x = GenerateSignedRedirectResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSignedRedirectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSignedRedirectResponse) ProtoMessage() {}
+
+func (x *GenerateSignedRedirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSignedRedirectResponse.ProtoReflect.Descriptor instead.
+func (*GenerateSignedRedirectResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GenerateSignedRedirectResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GenerateSignedRedirectResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type GetResolutionSnapshotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version of the snapshot the client has, to get the delta from it. Empty for a full snapshot.
+	SinceVersion  string `protobuf:"bytes,1,opt,name=since_version,json=sinceVersion,proto3" json:"since_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResolutionSnapshotRequest) Reset() {
+	*x = GetResolutionSnapshotRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResolutionSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResolutionSnapshotRequest) ProtoMessage() {}
+
+func (x *GetResolutionSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResolutionSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetResolutionSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetResolutionSnapshotRequest) GetSinceVersion() string {
+	if x != nil {
+		return x.SinceVersion
+	}
+	return ""
+}
+
+type ResolutionSnapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version of the snapshot, a hash of its links. It doesn't change while the links don't.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The version the delta applies to, i.e. since_version. Empty for a full snapshot, which is returned
+	// when the server doesn't have the snapshot of since_version anymore, e.g. after a restart.
+	BaseVersion string `protobuf:"bytes,2,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"`
+	// The links by the names and the aliases of the shortcuts which redirect now.
+	// In a delta, only the added and changed ones.
+	Links map[string]string `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The names removed since base_version, only in a delta.
+	RemovedNames []string `protobuf:"bytes,4,rep,name=removed_names,json=removedNames,proto3" json:"removed_names,omitempty"`
+	// The Ed25519 signature of the full snapshot at this version, i.e. with the delta applied.
+	// The signed payload is "slash-snapshot-v1\n{version}\n" followed by a "{name}\t{link}\n" line
+	// per link, sorted by name.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The public key of the workspace to verify the signature with. It changes with the workspace secret.
+	PublicKey     []byte                 `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolutionSnapshot) Reset() {
+	*x = ResolutionSnapshot{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolutionSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolutionSnapshot) ProtoMessage() {}
+
+func (x *ResolutionSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolutionSnapshot.ProtoReflect.Descriptor instead.
+func (*ResolutionSnapshot) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResolutionSnapshot) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ResolutionSnapshot) GetBaseVersion() string {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return ""
+}
+
+func (x *ResolutionSnapshot) GetLinks() map[string]string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *ResolutionSnapshot) GetRemovedNames() []string {
+	if x != nil {
+		return x.RemovedNames
+	}
+	return nil
+}
+
+func (x *ResolutionSnapshot) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ResolutionSnapshot) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ResolutionSnapshot) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type GetTrendingShortcutsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The window to compare with the previous one. Defaults to DAY.
+	Window GetTrendingShortcutsRequest_Window `protobuf:"varint,1,opt,name=window,proto3,enum=slash.api.v1.GetTrendingShortcutsRequest_Window" json:"window,omitempty"`
+	// The max number of shortcuts to return. Defaults to 10, and the max is 50.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingShortcutsRequest) Reset() {
+	*x = GetTrendingShortcutsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingShortcutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingShortcutsRequest) ProtoMessage() {}
+
+func (x *GetTrendingShortcutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingShortcutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTrendingShortcutsRequest) GetWindow() GetTrendingShortcutsRequest_Window {
+	if x != nil {
+		return x.Window
+	}
+	return GetTrendingShortcutsRequest_WINDOW_UNSPECIFIED
+}
+
+func (x *GetTrendingShortcutsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTrendingShortcutsResponse struct {
+	state             protoimpl.MessageState                           `protogen:"open.v1"`
+	TrendingShortcuts []*GetTrendingShortcutsResponse_TrendingShortcut `protobuf:"bytes,1,rep,name=trending_shortcuts,json=trendingShortcuts,proto3" json:"trending_shortcuts,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetTrendingShortcutsResponse) Reset() {
+	*x = GetTrendingShortcutsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingShortcutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingShortcutsResponse) ProtoMessage() {}
+
+func (x *GetTrendingShortcutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingShortcutsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingShortcutsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetTrendingShortcutsResponse) GetTrendingShortcuts() []*GetTrendingShortcutsResponse_TrendingShortcut {
+	if x != nil {
+		return x.TrendingShortcuts
+	}
+	return nil
+}
+
+type ProposedChange struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortcutId int32                  `protobuf:"varint,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	// The id of the user who proposed the change.
+	ProposerId int32 `protobuf:"varint,3,opt,name=proposer_id,json=proposerId,proto3" json:"proposer_id,omitempty"`
+	// The username of the user who proposed the change.
+	ProposerUsername string                 `protobuf:"bytes,4,opt,name=proposer_username,json=proposerUsername,proto3" json:"proposer_username,omitempty"`
+	CreatedTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	Status           ProposedChange_Status  `protobuf:"varint,6,opt,name=status,proto3,enum=slash.api.v1.ProposedChange_Status" json:"status,omitempty"`
+	// The changed fields, to show as a diff.
+	Changes []*ProposedChange_FieldChange `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	// The id of the user who approved or rejected the change.
+	ReviewerId    int32                  `protobuf:"varint,8,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	ReviewedTime  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=reviewed_time,json=reviewedTime,proto3" json:"reviewed_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposedChange) Reset() {
+	*x = ProposedChange{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposedChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposedChange) ProtoMessage() {}
+
+func (x *ProposedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposedChange.ProtoReflect.Descriptor instead.
+func (*ProposedChange) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProposedChange) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProposedChange) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ProposedChange) GetProposerId() int32 {
+	if x != nil {
+		return x.ProposerId
+	}
+	return 0
+}
+
+func (x *ProposedChange) GetProposerUsername() string {
+	if x != nil {
+		return x.ProposerUsername
+	}
+	return ""
+}
+
+func (x *ProposedChange) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *ProposedChange) GetStatus() ProposedChange_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ProposedChange_STATUS_UNSPECIFIED
+}
+
+func (x *ProposedChange) GetChanges() []*ProposedChange_FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ProposedChange) GetReviewerId() int32 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ProposedChange) GetReviewedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedTime
+	}
+	return nil
+}
+
+type ListProposedChangesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	// Filters the changes by status. Unspecified returns all of them.
+	Status        ProposedChange_Status `protobuf:"varint,2,opt,name=status,proto3,enum=slash.api.v1.ProposedChange_Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProposedChangesRequest) Reset() {
+	*x = ListProposedChangesRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProposedChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposedChangesRequest) ProtoMessage() {}
+
+func (x *ListProposedChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposedChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListProposedChangesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListProposedChangesRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ListProposedChangesRequest) GetStatus() ProposedChange_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ProposedChange_STATUS_UNSPECIFIED
+}
+
+type ListProposedChangesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProposedChanges []*ProposedChange      `protobuf:"bytes,1,rep,name=proposed_changes,json=proposedChanges,proto3" json:"proposed_changes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListProposedChangesResponse) Reset() {
+	*x = ListProposedChangesResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProposedChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposedChangesResponse) ProtoMessage() {}
+
+func (x *ListProposedChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposedChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListProposedChangesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListProposedChangesResponse) GetProposedChanges() []*ProposedChange {
+	if x != nil {
+		return x.ProposedChanges
+	}
+	return nil
+}
+
+type ApproveProposedChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveProposedChangeRequest) Reset() {
+	*x = ApproveProposedChangeRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveProposedChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveProposedChangeRequest) ProtoMessage() {}
+
+func (x *ApproveProposedChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveProposedChangeRequest.ProtoReflect.Descriptor instead.
+func (*ApproveProposedChangeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ApproveProposedChangeRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ApproveProposedChangeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejectProposedChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectProposedChangeRequest) Reset() {
+	*x = RejectProposedChangeRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectProposedChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectProposedChangeRequest) ProtoMessage() {}
+
+func (x *RejectProposedChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectProposedChangeRequest.ProtoReflect.Descriptor instead.
+func (*RejectProposedChangeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RejectProposedChangeRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *RejectProposedChangeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ShortcutRotation is a scheduled link of a shortcut. From its start time until its end time, the shortcut resolves
+// to its link instead. When rotations overlap, the one started last wins.
+type ShortcutRotation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortcutId  int32                  `protobuf:"varint,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	Link        string                 `protobuf:"bytes,5,opt,name=link,proto3" json:"link,omitempty"`
+	StartTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The time the rotation stops. Empty means until a later rotation starts.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutRotation) Reset() {
+	*x = ShortcutRotation{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutRotation) ProtoMessage() {}
+
+func (x *ShortcutRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutRotation.ProtoReflect.Descriptor instead.
+func (*ShortcutRotation) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ShortcutRotation) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortcutRotation) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutRotation) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ShortcutRotation) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *ShortcutRotation) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ShortcutRotation) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ShortcutRotation) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListShortcutRotationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutRotationsRequest) Reset() {
+	*x = ListShortcutRotationsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutRotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutRotationsRequest) ProtoMessage() {}
+
+func (x *ListShortcutRotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutRotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutRotationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListShortcutRotationsRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+type ListShortcutRotationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rotations     []*ShortcutRotation    `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutRotationsResponse) Reset() {
+	*x = ListShortcutRotationsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutRotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutRotationsResponse) ProtoMessage() {}
+
+func (x *ListShortcutRotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutRotationsResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutRotationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListShortcutRotationsResponse) GetRotations() []*ShortcutRotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
+
+type CreateShortcutRotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Rotation      *ShortcutRotation      `protobuf:"bytes,2,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShortcutRotationRequest) Reset() {
+	*x = CreateShortcutRotationRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShortcutRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShortcutRotationRequest) ProtoMessage() {}
+
+func (x *CreateShortcutRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShortcutRotationRequest.ProtoReflect.Descriptor instead.
+func (*CreateShortcutRotationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateShortcutRotationRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *CreateShortcutRotationRequest) GetRotation() *ShortcutRotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+type DeleteShortcutRotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShortcutRotationRequest) Reset() {
+	*x = DeleteShortcutRotationRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShortcutRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShortcutRotationRequest) ProtoMessage() {}
+
+func (x *DeleteShortcutRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShortcutRotationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShortcutRotationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteShortcutRotationRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *DeleteShortcutRotationRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ShortcutACL shares a shortcut with a user, whatever the visibility of the shortcut.
+type ShortcutACL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ShortcutACL_Role       `protobuf:"varint,3,opt,name=role,proto3,enum=slash.api.v1.ShortcutACL_Role" json:"role,omitempty"`
+	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutACL) Reset() {
+	*x = ShortcutACL{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutACL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutACL) ProtoMessage() {}
+
+func (x *ShortcutACL) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutACL.ProtoReflect.Descriptor instead.
+func (*ShortcutACL) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ShortcutACL) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutACL) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShortcutACL) GetRole() ShortcutACL_Role {
+	if x != nil {
+		return x.Role
+	}
+	return ShortcutACL_ROLE_UNSPECIFIED
+}
+
+func (x *ShortcutACL) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+type ListShortcutACLsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutACLsRequest) Reset() {
+	*x = ListShortcutACLsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutACLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutACLsRequest) ProtoMessage() {}
+
+func (x *ListShortcutACLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutACLsRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutACLsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListShortcutACLsRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+type ListShortcutACLsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acls          []*ShortcutACL         `protobuf:"bytes,1,rep,name=acls,proto3" json:"acls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutACLsResponse) Reset() {
+	*x = ListShortcutACLsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutACLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutACLsResponse) ProtoMessage() {}
+
+func (x *ListShortcutACLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutACLsResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutACLsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListShortcutACLsResponse) GetAcls() []*ShortcutACL {
+	if x != nil {
+		return x.Acls
+	}
+	return nil
+}
+
+type UpsertShortcutACLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Acl           *ShortcutACL           `protobuf:"bytes,2,opt,name=acl,proto3" json:"acl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertShortcutACLRequest) Reset() {
+	*x = UpsertShortcutACLRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertShortcutACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertShortcutACLRequest) ProtoMessage() {}
+
+func (x *UpsertShortcutACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertShortcutACLRequest.ProtoReflect.Descriptor instead.
+func (*UpsertShortcutACLRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpsertShortcutACLRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *UpsertShortcutACLRequest) GetAcl() *ShortcutACL {
+	if x != nil {
+		return x.Acl
+	}
+	return nil
+}
+
+type DeleteShortcutACLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShortcutACLRequest) Reset() {
+	*x = DeleteShortcutACLRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShortcutACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShortcutACLRequest) ProtoMessage() {}
+
+func (x *DeleteShortcutACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShortcutACLRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShortcutACLRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteShortcutACLRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *DeleteShortcutACLRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CreateImportJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The format of the file, "csv" for the columns of the CSV export, or "netscape" for a bookmark file.
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// The content of the file.
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateImportJobRequest) Reset() {
+	*x = CreateImportJobRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImportJobRequest) ProtoMessage() {}
+
+func (x *CreateImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImportJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateImportJobRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CreateImportJobRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type GetImportJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportJobRequest) Reset() {
+	*x = GetImportJobRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportJobRequest) ProtoMessage() {}
+
+func (x *GetImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetImportJobRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListImportJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportJobsRequest) Reset() {
+	*x = ListImportJobsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportJobsRequest) ProtoMessage() {}
+
+func (x *ListImportJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListImportJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{63}
+}
+
+type ListImportJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImportJobs    []*ImportJob           `protobuf:"bytes,1,rep,name=import_jobs,json=importJobs,proto3" json:"import_jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportJobsResponse) Reset() {
+	*x = ListImportJobsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportJobsResponse) ProtoMessage() {}
+
+func (x *ListImportJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListImportJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListImportJobsResponse) GetImportJobs() []*ImportJob {
+	if x != nil {
+		return x.ImportJobs
+	}
+	return nil
+}
+
+type ResumeImportJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeImportJobRequest) Reset() {
+	*x = ResumeImportJobRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeImportJobRequest) ProtoMessage() {}
+
+func (x *ResumeImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeImportJobRequest.ProtoReflect.Descriptor instead.
+func (*ResumeImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ResumeImportJobRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ImportJob struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// The time of the last progress of the job.
+	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	Status      ImportJob_Status       `protobuf:"varint,5,opt,name=status,proto3,enum=slash.api.v1.ImportJob_Status" json:"status,omitempty"`
+	Format      string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	TotalRows   int32                  `protobuf:"varint,7,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	// The number of rows processed, created or failed. The job resumes from the next one.
+	ProcessedRows int32 `protobuf:"varint,8,opt,name=processed_rows,json=processedRows,proto3" json:"processed_rows,omitempty"`
+	CreatedCount  int32 `protobuf:"varint,9,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	FailedCount   int32 `protobuf:"varint,10,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	// The errors of the rows which failed, e.g. with a taken name, at most the first 1000.
+	RowErrors []*ImportJob_RowError `protobuf:"bytes,11,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
+	// The reason the job failed.
+	Error         string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Shortcut) Reset() {
-	*x = Shortcut{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[0]
+func (x *ImportJob) Reset() {
+	*x = ImportJob{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Shortcut) String() string {
+func (x *ImportJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Shortcut) ProtoMessage() {}
+func (*ImportJob) ProtoMessage() {}
 
-func (x *Shortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[0]
+func (x *ImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -68,116 +4562,253 @@ func (x *Shortcut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Shortcut.ProtoReflect.Descriptor instead.
-func (*Shortcut) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ImportJob.ProtoReflect.Descriptor instead.
+func (*ImportJob) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Shortcut) GetId() int32 {
+func (x *ImportJob) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Shortcut) GetCreatorId() int32 {
+func (x *ImportJob) GetCreatorId() int32 {
 	if x != nil {
 		return x.CreatorId
 	}
 	return 0
 }
 
-func (x *Shortcut) GetCreatedTime() *timestamppb.Timestamp {
+func (x *ImportJob) GetCreatedTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedTime
 	}
 	return nil
 }
 
-func (x *Shortcut) GetUpdatedTime() *timestamppb.Timestamp {
+func (x *ImportJob) GetUpdatedTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedTime
 	}
 	return nil
 }
 
-func (x *Shortcut) GetName() string {
+func (x *ImportJob) GetStatus() ImportJob_Status {
 	if x != nil {
-		return x.Name
+		return x.Status
+	}
+	return ImportJob_STATUS_UNSPECIFIED
+}
+
+func (x *ImportJob) GetFormat() string {
+	if x != nil {
+		return x.Format
 	}
 	return ""
 }
 
-func (x *Shortcut) GetLink() string {
+func (x *ImportJob) GetTotalRows() int32 {
 	if x != nil {
-		return x.Link
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportJob) GetProcessedRows() int32 {
+	if x != nil {
+		return x.ProcessedRows
+	}
+	return 0
+}
+
+func (x *ImportJob) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportJob) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ImportJob) GetRowErrors() []*ImportJob_RowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+func (x *ImportJob) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-func (x *Shortcut) GetTitle() string {
+type Shortcut_OpenGraphMetadata struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Image       string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	// The url of the favicon of the link, fetched with the metadata.
+	Favicon       string `protobuf:"bytes,4,opt,name=favicon,proto3" json:"favicon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut_OpenGraphMetadata) Reset() {
+	*x = Shortcut_OpenGraphMetadata{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut_OpenGraphMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut_OpenGraphMetadata) ProtoMessage() {}
+
+func (x *Shortcut_OpenGraphMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut_OpenGraphMetadata.ProtoReflect.Descriptor instead.
+func (*Shortcut_OpenGraphMetadata) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Shortcut_OpenGraphMetadata) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *Shortcut) GetTags() []string {
+func (x *Shortcut_OpenGraphMetadata) GetDescription() string {
 	if x != nil {
-		return x.Tags
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *Shortcut) GetDescription() string {
+func (x *Shortcut_OpenGraphMetadata) GetImage() string {
 	if x != nil {
-		return x.Description
+		return x.Image
 	}
 	return ""
 }
 
-func (x *Shortcut) GetVisibility() Visibility {
+func (x *Shortcut_OpenGraphMetadata) GetFavicon() string {
 	if x != nil {
-		return x.Visibility
+		return x.Favicon
 	}
-	return Visibility_VISIBILITY_UNSPECIFIED
+	return ""
 }
 
-func (x *Shortcut) GetViewCount() int32 {
+type Shortcut_ClickGoal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The target view count. 0 means no goal.
+	Target int32 `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The webhook url to post to when the goal is reached.
+	// Only visible to the creator and admins.
+	WebhookUrl string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Output only. The time the goal was reached.
+	ReachedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=reached_time,json=reachedTime,proto3" json:"reached_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut_ClickGoal) Reset() {
+	*x = Shortcut_ClickGoal{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut_ClickGoal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut_ClickGoal) ProtoMessage() {}
+
+func (x *Shortcut_ClickGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[68]
 	if x != nil {
-		return x.ViewCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut_ClickGoal.ProtoReflect.Descriptor instead.
+func (*Shortcut_ClickGoal) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Shortcut_ClickGoal) GetTarget() int32 {
+	if x != nil {
+		return x.Target
 	}
 	return 0
 }
 
-func (x *Shortcut) GetOgMetadata() *Shortcut_OpenGraphMetadata {
+func (x *Shortcut_ClickGoal) GetWebhookUrl() string {
 	if x != nil {
-		return x.OgMetadata
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *Shortcut_ClickGoal) GetReachedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReachedTime
 	}
 	return nil
 }
 
-type ListShortcutsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type Shortcut_QueryParam struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The value template, where {name} is replaced by the shortcut name,
+	// and {collection} by the name of the collection the shortcut is opened from, or empty.
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListShortcutsRequest) Reset() {
-	*x = ListShortcutsRequest{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[1]
+func (x *Shortcut_QueryParam) Reset() {
+	*x = Shortcut_QueryParam{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListShortcutsRequest) String() string {
+func (x *Shortcut_QueryParam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListShortcutsRequest) ProtoMessage() {}
+func (*Shortcut_QueryParam) ProtoMessage() {}
 
-func (x *ListShortcutsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[1]
+func (x *Shortcut_QueryParam) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,33 +4819,53 @@ func (x *ListShortcutsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListShortcutsRequest.ProtoReflect.Descriptor instead.
-func (*ListShortcutsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Shortcut_QueryParam.ProtoReflect.Descriptor instead.
+func (*Shortcut_QueryParam) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 2}
 }
 
-type ListShortcutsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shortcuts     []*Shortcut            `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+func (x *Shortcut_QueryParam) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Shortcut_QueryParam) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Shortcut_RedirectRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ISO 3166-1 alpha-2 codes of the countries, e.g. "DE". The country of the visitor is read from the headers
+	// of the proxy, e.g. CF-IPCountry of Cloudflare.
+	Countries []string `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	// The languages matching the preferred language of the Accept-Language header, e.g. "pt-BR", or its base language, e.g. "pt".
+	Languages     []string                           `protobuf:"bytes,2,rep,name=languages,proto3" json:"languages,omitempty"`
+	Devices       []Shortcut_RedirectRule_DeviceType `protobuf:"varint,3,rep,packed,name=devices,proto3,enum=slash.api.v1.Shortcut_RedirectRule_DeviceType" json:"devices,omitempty"`
+	Link          string                             `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListShortcutsResponse) Reset() {
-	*x = ListShortcutsResponse{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[2]
+func (x *Shortcut_RedirectRule) Reset() {
+	*x = Shortcut_RedirectRule{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListShortcutsResponse) String() string {
+func (x *Shortcut_RedirectRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListShortcutsResponse) ProtoMessage() {}
+func (*Shortcut_RedirectRule) ProtoMessage() {}
 
-func (x *ListShortcutsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[2]
+func (x *Shortcut_RedirectRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,40 +4876,65 @@ func (x *ListShortcutsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListShortcutsResponse.ProtoReflect.Descriptor instead.
-func (*ListShortcutsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Shortcut_RedirectRule.ProtoReflect.Descriptor instead.
+func (*Shortcut_RedirectRule) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 3}
 }
 
-func (x *ListShortcutsResponse) GetShortcuts() []*Shortcut {
+func (x *Shortcut_RedirectRule) GetCountries() []string {
 	if x != nil {
-		return x.Shortcuts
+		return x.Countries
 	}
 	return nil
 }
 
-type GetShortcutRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *Shortcut_RedirectRule) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *Shortcut_RedirectRule) GetDevices() []Shortcut_RedirectRule_DeviceType {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *Shortcut_RedirectRule) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type Shortcut_Variant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the variant in the analytics, e.g. "A". Unique among the variants of the shortcut.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Link string `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	// The relative weight of the variant, e.g. 90 and 10 send about 90% of the visits to the first variant.
+	Weight        int32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShortcutRequest) Reset() {
-	*x = GetShortcutRequest{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[3]
+func (x *Shortcut_Variant) Reset() {
+	*x = Shortcut_Variant{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutRequest) String() string {
+func (x *Shortcut_Variant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutRequest) ProtoMessage() {}
+func (*Shortcut_Variant) ProtoMessage() {}
 
-func (x *GetShortcutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[3]
+func (x *Shortcut_Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,40 +4945,139 @@ func (x *GetShortcutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutRequest.ProtoReflect.Descriptor instead.
-func (*GetShortcutRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Shortcut_Variant.ProtoReflect.Descriptor instead.
+func (*Shortcut_Variant) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *Shortcut_Variant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Shortcut_Variant) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Shortcut_Variant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type Shortcut_LinkHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time of the last check.
+	CheckTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=check_time,json=checkTime,proto3" json:"check_time,omitempty"`
+	// The HTTP status code of the last check. 0 means the link couldn't be requested.
+	StatusCode int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The error of the last check. Empty means the link is healthy.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether the link failed its last checks in a row.
+	Broken        bool `protobuf:"varint,4,opt,name=broken,proto3" json:"broken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut_LinkHealth) Reset() {
+	*x = Shortcut_LinkHealth{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut_LinkHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut_LinkHealth) ProtoMessage() {}
+
+func (x *Shortcut_LinkHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut_LinkHealth.ProtoReflect.Descriptor instead.
+func (*Shortcut_LinkHealth) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *Shortcut_LinkHealth) GetCheckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckTime
+	}
+	return nil
+}
+
+func (x *Shortcut_LinkHealth) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *Shortcut_LinkHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *GetShortcutRequest) GetId() int32 {
+func (x *Shortcut_LinkHealth) GetBroken() bool {
 	if x != nil {
-		return x.Id
+		return x.Broken
 	}
-	return 0
+	return false
 }
 
-type GetShortcutByNameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ValidateLinksResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Link  string                 `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	// Whether the link is a url which the shortcuts redirect to.
+	// The other links are kept as plain text.
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Why the link is not valid.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// The link as it would be saved, with the query parameters denied by the workspace stripped.
+	NormalizedLink string `protobuf:"bytes,4,opt,name=normalized_link,json=normalizedLink,proto3" json:"normalized_link,omitempty"`
+	// Whether the link is reachable. Only set when the reachability is checked.
+	Reachable *bool `protobuf:"varint,5,opt,name=reachable,proto3,oneof" json:"reachable,omitempty"`
+	// The status code of the response to the link, 0 when it's not reachable at all.
+	StatusCode int32 `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Why the link is not reachable.
+	ReachabilityError string `protobuf:"bytes,7,opt,name=reachability_error,json=reachabilityError,proto3" json:"reachability_error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetShortcutByNameRequest) Reset() {
-	*x = GetShortcutByNameRequest{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[4]
+func (x *ValidateLinksResponse_Result) Reset() {
+	*x = ValidateLinksResponse_Result{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutByNameRequest) String() string {
+func (x *ValidateLinksResponse_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutByNameRequest) ProtoMessage() {}
+func (*ValidateLinksResponse_Result) ProtoMessage() {}
 
-func (x *GetShortcutByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[4]
+func (x *ValidateLinksResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,40 +5088,83 @@ func (x *GetShortcutByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetShortcutByNameRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ValidateLinksResponse_Result.ProtoReflect.Descriptor instead.
+func (*ValidateLinksResponse_Result) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *GetShortcutByNameRequest) GetName() string {
+func (x *ValidateLinksResponse_Result) GetLink() string {
 	if x != nil {
-		return x.Name
+		return x.Link
 	}
 	return ""
 }
 
-type CreateShortcutRequest struct {
+func (x *ValidateLinksResponse_Result) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateLinksResponse_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ValidateLinksResponse_Result) GetNormalizedLink() string {
+	if x != nil {
+		return x.NormalizedLink
+	}
+	return ""
+}
+
+func (x *ValidateLinksResponse_Result) GetReachable() bool {
+	if x != nil && x.Reachable != nil {
+		return *x.Reachable
+	}
+	return false
+}
+
+func (x *ValidateLinksResponse_Result) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ValidateLinksResponse_Result) GetReachabilityError() string {
+	if x != nil {
+		return x.ReachabilityError
+	}
+	return ""
+}
+
+type GetShortcutAnalyticsResponse_AnalyticsItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shortcut      *Shortcut              `protobuf:"bytes,1,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateShortcutRequest) Reset() {
-	*x = CreateShortcutRequest{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[5]
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) Reset() {
+	*x = GetShortcutAnalyticsResponse_AnalyticsItem{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateShortcutRequest) String() string {
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShortcutRequest) ProtoMessage() {}
+func (*GetShortcutAnalyticsResponse_AnalyticsItem) ProtoMessage() {}
 
-func (x *CreateShortcutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[5]
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,41 +5175,50 @@ func (x *CreateShortcutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShortcutRequest.ProtoReflect.Descriptor instead.
-func (*CreateShortcutRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetShortcutAnalyticsResponse_AnalyticsItem.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsResponse_AnalyticsItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{23, 0}
 }
 
-func (x *CreateShortcutRequest) GetShortcut() *Shortcut {
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetName() string {
 	if x != nil {
-		return x.Shortcut
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type UpdateShortcutRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shortcut      *Shortcut              `protobuf:"bytes,1,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetShortcutAnalyticsResponse_ClickGoalProgress struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target int32                  `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The total view count of the shortcut.
+	ViewCount     int32                  `protobuf:"varint,2,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	ReachedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=reached_time,json=reachedTime,proto3" json:"reached_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateShortcutRequest) Reset() {
-	*x = UpdateShortcutRequest{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[6]
+func (x *GetShortcutAnalyticsResponse_ClickGoalProgress) Reset() {
+	*x = GetShortcutAnalyticsResponse_ClickGoalProgress{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateShortcutRequest) String() string {
+func (x *GetShortcutAnalyticsResponse_ClickGoalProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateShortcutRequest) ProtoMessage() {}
+func (*GetShortcutAnalyticsResponse_ClickGoalProgress) ProtoMessage() {}
 
-func (x *UpdateShortcutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[6]
+func (x *GetShortcutAnalyticsResponse_ClickGoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,47 +5229,56 @@ func (x *UpdateShortcutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateShortcutRequest.ProtoReflect.Descriptor instead.
-func (*UpdateShortcutRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetShortcutAnalyticsResponse_ClickGoalProgress.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsResponse_ClickGoalProgress) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{23, 1}
 }
 
-func (x *UpdateShortcutRequest) GetShortcut() *Shortcut {
+func (x *GetShortcutAnalyticsResponse_ClickGoalProgress) GetTarget() int32 {
 	if x != nil {
-		return x.Shortcut
+		return x.Target
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateShortcutRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *GetShortcutAnalyticsResponse_ClickGoalProgress) GetViewCount() int32 {
 	if x != nil {
-		return x.UpdateMask
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *GetShortcutAnalyticsResponse_ClickGoalProgress) GetReachedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReachedTime
 	}
 	return nil
 }
 
-type DeleteShortcutRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type GetShortcutAnalyticsResponse_TimeseriesItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The start time of the interval.
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteShortcutRequest) Reset() {
-	*x = DeleteShortcutRequest{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[7]
+func (x *GetShortcutAnalyticsResponse_TimeseriesItem) Reset() {
+	*x = GetShortcutAnalyticsResponse_TimeseriesItem{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteShortcutRequest) String() string {
+func (x *GetShortcutAnalyticsResponse_TimeseriesItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteShortcutRequest) ProtoMessage() {}
+func (*GetShortcutAnalyticsResponse_TimeseriesItem) ProtoMessage() {}
 
-func (x *DeleteShortcutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[7]
+func (x *GetShortcutAnalyticsResponse_TimeseriesItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,40 +5289,49 @@ func (x *DeleteShortcutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteShortcutRequest.ProtoReflect.Descriptor instead.
-func (*DeleteShortcutRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetShortcutAnalyticsResponse_TimeseriesItem.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsResponse_TimeseriesItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{23, 2}
 }
 
-func (x *DeleteShortcutRequest) GetId() int32 {
+func (x *GetShortcutAnalyticsResponse_TimeseriesItem) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse_TimeseriesItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-type GetShortcutAnalyticsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type SemanticSearchShortcutsResponse_Result struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Shortcut *Shortcut              `protobuf:"bytes,1,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	// The cosine similarity of the embeddings of the shortcut and the query, between -1 and 1.
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShortcutAnalyticsRequest) Reset() {
-	*x = GetShortcutAnalyticsRequest{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
+func (x *SemanticSearchShortcutsResponse_Result) Reset() {
+	*x = SemanticSearchShortcutsResponse_Result{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutAnalyticsRequest) String() string {
+func (x *SemanticSearchShortcutsResponse_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutAnalyticsRequest) ProtoMessage() {}
+func (*SemanticSearchShortcutsResponse_Result) ProtoMessage() {}
 
-func (x *GetShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
+func (x *SemanticSearchShortcutsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,42 +5342,51 @@ func (x *GetShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutAnalyticsRequest.ProtoReflect.Descriptor instead.
-func (*GetShortcutAnalyticsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SemanticSearchShortcutsResponse_Result.ProtoReflect.Descriptor instead.
+func (*SemanticSearchShortcutsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{39, 0}
 }
 
-func (x *GetShortcutAnalyticsRequest) GetId() int32 {
+func (x *SemanticSearchShortcutsResponse_Result) GetShortcut() *Shortcut {
 	if x != nil {
-		return x.Id
+		return x.Shortcut
+	}
+	return nil
+}
+
+func (x *SemanticSearchShortcutsResponse_Result) GetScore() float64 {
+	if x != nil {
+		return x.Score
 	}
 	return 0
 }
 
-type GetShortcutAnalyticsResponse struct {
-	state         protoimpl.MessageState                        `protogen:"open.v1"`
-	References    []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
-	Devices       []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
-	Browsers      []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,3,rep,name=browsers,proto3" json:"browsers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetTrendingShortcutsResponse_TrendingShortcut struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Shortcut *Shortcut              `protobuf:"bytes,1,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	// The view count in the current window.
+	ViewCount int32 `protobuf:"varint,2,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	// The view count in the previous window.
+	PreviousViewCount int32 `protobuf:"varint,3,opt,name=previous_view_count,json=previousViewCount,proto3" json:"previous_view_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetShortcutAnalyticsResponse) Reset() {
-	*x = GetShortcutAnalyticsResponse{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
+func (x *GetTrendingShortcutsResponse_TrendingShortcut) Reset() {
+	*x = GetTrendingShortcutsResponse_TrendingShortcut{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutAnalyticsResponse) String() string {
+func (x *GetTrendingShortcutsResponse_TrendingShortcut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutAnalyticsResponse) ProtoMessage() {}
+func (*GetTrendingShortcutsResponse_TrendingShortcut) ProtoMessage() {}
 
-func (x *GetShortcutAnalyticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
+func (x *GetTrendingShortcutsResponse_TrendingShortcut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,56 +5397,58 @@ func (x *GetShortcutAnalyticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutAnalyticsResponse.ProtoReflect.Descriptor instead.
-func (*GetShortcutAnalyticsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetTrendingShortcutsResponse_TrendingShortcut.ProtoReflect.Descriptor instead.
+func (*GetTrendingShortcutsResponse_TrendingShortcut) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{45, 0}
 }
 
-func (x *GetShortcutAnalyticsResponse) GetReferences() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+func (x *GetTrendingShortcutsResponse_TrendingShortcut) GetShortcut() *Shortcut {
 	if x != nil {
-		return x.References
+		return x.Shortcut
 	}
 	return nil
 }
 
-func (x *GetShortcutAnalyticsResponse) GetDevices() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+func (x *GetTrendingShortcutsResponse_TrendingShortcut) GetViewCount() int32 {
 	if x != nil {
-		return x.Devices
+		return x.ViewCount
 	}
-	return nil
+	return 0
 }
 
-func (x *GetShortcutAnalyticsResponse) GetBrowsers() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+func (x *GetTrendingShortcutsResponse_TrendingShortcut) GetPreviousViewCount() int32 {
 	if x != nil {
-		return x.Browsers
+		return x.PreviousViewCount
 	}
-	return nil
+	return 0
 }
 
-type Shortcut_OpenGraphMetadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+type ProposedChange_FieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The field, as the path of the update mask, e.g. "link".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The value when the change was proposed. The tags are separated by spaces.
+	PreviousValue string `protobuf:"bytes,2,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	ProposedValue string `protobuf:"bytes,3,opt,name=proposed_value,json=proposedValue,proto3" json:"proposed_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Shortcut_OpenGraphMetadata) Reset() {
-	*x = Shortcut_OpenGraphMetadata{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[10]
+func (x *ProposedChange_FieldChange) Reset() {
+	*x = ProposedChange_FieldChange{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Shortcut_OpenGraphMetadata) String() string {
+func (x *ProposedChange_FieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Shortcut_OpenGraphMetadata) ProtoMessage() {}
+func (*ProposedChange_FieldChange) ProtoMessage() {}
 
-func (x *Shortcut_OpenGraphMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[10]
+func (x *ProposedChange_FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,55 +5459,57 @@ func (x *Shortcut_OpenGraphMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Shortcut_OpenGraphMetadata.ProtoReflect.Descriptor instead.
-func (*Shortcut_OpenGraphMetadata) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use ProposedChange_FieldChange.ProtoReflect.Descriptor instead.
+func (*ProposedChange_FieldChange) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{46, 0}
 }
 
-func (x *Shortcut_OpenGraphMetadata) GetTitle() string {
+func (x *ProposedChange_FieldChange) GetField() string {
 	if x != nil {
-		return x.Title
+		return x.Field
 	}
 	return ""
 }
 
-func (x *Shortcut_OpenGraphMetadata) GetDescription() string {
+func (x *ProposedChange_FieldChange) GetPreviousValue() string {
 	if x != nil {
-		return x.Description
+		return x.PreviousValue
 	}
 	return ""
 }
 
-func (x *Shortcut_OpenGraphMetadata) GetImage() string {
+func (x *ProposedChange_FieldChange) GetProposedValue() string {
 	if x != nil {
-		return x.Image
+		return x.ProposedValue
 	}
 	return ""
 }
 
-type GetShortcutAnalyticsResponse_AnalyticsItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+type ImportJob_RowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The 1-based index of the row.
+	Row           int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) Reset() {
-	*x = GetShortcutAnalyticsResponse_AnalyticsItem{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
+func (x *ImportJob_RowError) Reset() {
+	*x = ImportJob_RowError{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) String() string {
+func (x *ImportJob_RowError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutAnalyticsResponse_AnalyticsItem) ProtoMessage() {}
+func (*ImportJob_RowError) ProtoMessage() {}
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
+func (x *ImportJob_RowError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,36 +5520,44 @@ func (x *GetShortcutAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutAnalyticsResponse_AnalyticsItem.ProtoReflect.Descriptor instead.
-func (*GetShortcutAnalyticsResponse_AnalyticsItem) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ImportJob_RowError.ProtoReflect.Descriptor instead.
+func (*ImportJob_RowError) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{66, 0}
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetName() string {
+func (x *ImportJob_RowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportJob_RowError) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetCount() int32 {
+func (x *ImportJob_RowError) GetError() string {
 	if x != nil {
-		return x.Count
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
 var File_api_v1_shortcut_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/shortcut_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x04\n" +
+	"\x1dapi/v1/shortcut_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe1\x10\n" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
 	"creator_id\x18\x02 \x01(\x05R\tcreatorId\x12=\n" +
 	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
-	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12\x12\n" +
+	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12)\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x13.slash.api.v1.StateR\x05state\x12\x12\n" +
 	"\x04name\x18\x06 \x01(\tR\x04name\x12\x12\n" +
 	"\x04link\x18\a \x01(\tR\x04link\x12\x14\n" +
 	"\x05title\x18\b \x01(\tR\x05title\x12\x12\n" +
@@ -704,18 +5570,161 @@ const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\n" +
 	"view_count\x18\f \x01(\x05R\tviewCount\x12I\n" +
 	"\vog_metadata\x18\r \x01(\v2(.slash.api.v1.Shortcut.OpenGraphMetadataR\n" +
-	"ogMetadata\x1aa\n" +
+	"ogMetadata\x12)\n" +
+	"\x10creator_username\x18\x0e \x01(\tR\x0fcreatorUsername\x12?\n" +
+	"\n" +
+	"click_goal\x18\x0f \x01(\v2 .slash.api.v1.Shortcut.ClickGoalR\tclickGoal\x12;\n" +
+	"\vexpire_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12D\n" +
+	"\fquery_params\x18\x11 \x03(\v2!.slash.api.v1.Shortcut.QueryParamR\vqueryParams\x12?\n" +
+	"\ractivate_time\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\factivateTime\x12\x18\n" +
+	"\aaliases\x18\x13 \x03(\tR\aaliases\x12\x1c\n" +
+	"\tprotected\x18\x14 \x01(\bR\tprotected\x12!\n" +
+	"\fcurrent_link\x18\x15 \x01(\tR\vcurrentLink\x12\x17\n" +
+	"\ateam_id\x18\x16 \x01(\x05R\x06teamId\x12#\n" +
+	"\rredirect_code\x18\x17 \x01(\x05R\fredirectCode\x12B\n" +
+	"\vlink_health\x18\x18 \x01(\v2!.slash.api.v1.Shortcut.LinkHealthR\n" +
+	"linkHealth\x12H\n" +
+	"\rdocument_mode\x18\x19 \x01(\x0e2#.slash.api.v1.Shortcut.DocumentModeR\fdocumentMode\x121\n" +
+	"\x14internal_description\x18\x1a \x01(\bR\x13internalDescription\x12J\n" +
+	"\x0eredirect_rules\x18\x1b \x03(\v2#.slash.api.v1.Shortcut.RedirectRuleR\rredirectRules\x12:\n" +
+	"\bvariants\x18\x1c \x03(\v2\x1e.slash.api.v1.Shortcut.VariantR\bvariants\x1a{\n" +
 	"\x11OpenGraphMetadata\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05image\x18\x03 \x01(\tR\x05image\"\x16\n" +
-	"\x14ListShortcutsRequest\"M\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x18\n" +
+	"\afavicon\x18\x04 \x01(\tR\afavicon\x1a\x83\x01\n" +
+	"\tClickGoal\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\x05R\x06target\x12\x1f\n" +
+	"\vwebhook_url\x18\x02 \x01(\tR\n" +
+	"webhookUrl\x12=\n" +
+	"\freached_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vreachedTime\x1a4\n" +
+	"\n" +
+	"QueryParam\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x1a\xec\x01\n" +
+	"\fRedirectRule\x12\x1c\n" +
+	"\tcountries\x18\x01 \x03(\tR\tcountries\x12\x1c\n" +
+	"\tlanguages\x18\x02 \x03(\tR\tlanguages\x12H\n" +
+	"\adevices\x18\x03 \x03(\x0e2..slash.api.v1.Shortcut.RedirectRule.DeviceTypeR\adevices\x12\x12\n" +
+	"\x04link\x18\x04 \x01(\tR\x04link\"B\n" +
+	"\n" +
+	"DeviceType\x12\x1b\n" +
+	"\x17DEVICE_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aDESKTOP\x10\x01\x12\n" +
+	"\n" +
+	"\x06MOBILE\x10\x02\x1aI\n" +
+	"\aVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\x1a\x96\x01\n" +
+	"\n" +
+	"LinkHealth\x129\n" +
+	"\n" +
+	"check_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckTime\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x16\n" +
+	"\x06broken\x18\x04 \x01(\bR\x06broken\"G\n" +
+	"\fDocumentMode\x12\x1d\n" +
+	"\x19DOCUMENT_MODE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06INLINE\x10\x01\x12\f\n" +
+	"\bDOWNLOAD\x10\x02\"\xb9\x01\n" +
+	"\x14ListShortcutsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12)\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x13.slash.api.v1.StateR\x05state\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\x05R\tcreatorId\x12\x1b\n" +
+	"\tmine_only\x18\x05 \x01(\bR\bmineOnly\"u\n" +
 	"\x15ListShortcutsResponse\x124\n" +
-	"\tshortcuts\x18\x01 \x03(\v2\x16.slash.api.v1.ShortcutR\tshortcuts\"$\n" +
+	"\tshortcuts\x18\x01 \x03(\v2\x16.slash.api.v1.ShortcutR\tshortcuts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"j\n" +
+	"\x16SearchShortcutsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"w\n" +
+	"\x17SearchShortcutsResponse\x124\n" +
+	"\tshortcuts\x18\x01 \x03(\v2\x16.slash.api.v1.ShortcutR\tshortcuts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9a\x02\n" +
+	"\x1dBulkUpdateShortcutTagsRequest\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\x12S\n" +
+	"\toperation\x18\x02 \x01(\x0e25.slash.api.v1.BulkUpdateShortcutTagsRequest.OperationR\toperation\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\x12\x17\n" +
+	"\anew_tag\x18\x04 \x01(\tR\x06newTag\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"H\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03ADD\x10\x01\x12\n" +
+	"\n" +
+	"\x06REMOVE\x10\x02\x12\v\n" +
+	"\aREPLACE\x10\x03\"w\n" +
+	"\x1eBulkUpdateShortcutTagsResponse\x12%\n" +
+	"\x0eaffected_count\x18\x01 \x01(\x05R\raffectedCount\x12.\n" +
+	"\x06sample\x18\x02 \x03(\v2\x16.slash.api.v1.ShortcutR\x06sample\"J\n" +
+	"\x15MergeShortcutsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\x12\x1f\n" +
+	"\vsurvivor_id\x18\x02 \x01(\x05R\n" +
+	"survivorId\"[\n" +
+	"\x14ValidateLinksRequest\x12\x14\n" +
+	"\x05links\x18\x01 \x03(\tR\x05links\x12-\n" +
+	"\x12check_reachability\x18\x02 \x01(\bR\x11checkReachability\"\xd2\x02\n" +
+	"\x15ValidateLinksResponse\x12D\n" +
+	"\aresults\x18\x01 \x03(\v2*.slash.api.v1.ValidateLinksResponse.ResultR\aresults\x1a\xf2\x01\n" +
+	"\x06Result\x12\x12\n" +
+	"\x04link\x18\x01 \x01(\tR\x04link\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12'\n" +
+	"\x0fnormalized_link\x18\x04 \x01(\tR\x0enormalizedLink\x12!\n" +
+	"\treachable\x18\x05 \x01(\bH\x00R\treachable\x88\x01\x01\x12\x1f\n" +
+	"\vstatus_code\x18\x06 \x01(\x05R\n" +
+	"statusCode\x12-\n" +
+	"\x12reachability_error\x18\a \x01(\tR\x11reachabilityErrorB\f\n" +
+	"\n" +
+	"_reachable\"$\n" +
 	"\x12GetShortcutRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\".\n" +
 	"\x18GetShortcutByNameRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"K\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"O\n" +
+	"\x17ShortcutNotFoundDetails\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vsuggestions\x18\x02 \x03(\tR\vsuggestions\"4\n" +
+	"\x1eListShortcutSuggestionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
+	"\x1fListShortcutSuggestionsResponse\x12 \n" +
+	"\vsuggestions\x18\x01 \x03(\tR\vsuggestions\"c\n" +
+	"\x15ResolvePreviewRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\acontext\x18\x02 \x01(\v2\x1c.slash.api.v1.ResolveContextR\acontext\"\xc4\x01\n" +
+	"\x0eResolveContext\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x04 \x01(\tR\n" +
+	"collection\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\"\x91\x03\n" +
+	"\x16ResolvePreviewResponse\x12F\n" +
+	"\aoutcome\x18\x01 \x01(\x0e2,.slash.api.v1.ResolvePreviewResponse.OutcomeR\aoutcome\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x122\n" +
+	"\bshortcut\x18\x03 \x01(\v2\x16.slash.api.v1.ShortcutR\bshortcut\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12 \n" +
+	"\vsuggestions\x18\x05 \x03(\tR\vsuggestions\x12#\n" +
+	"\rredirect_code\x18\x06 \x01(\x05R\fredirectCode\"\x83\x01\n" +
+	"\aOutcome\x12\x17\n" +
+	"\x13OUTCOME_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bREDIRECT\x10\x01\x12\x0e\n" +
+	"\n" +
+	"PLAIN_TEXT\x10\x02\x12\x0e\n" +
+	"\n" +
+	"NOT_ACTIVE\x10\x03\x12\v\n" +
+	"\aEXPIRED\x10\x04\x12\x15\n" +
+	"\x11FALLBACK_REDIRECT\x10\x05\x12\r\n" +
+	"\tNOT_FOUND\x10\x06\"K\n" +
 	"\x15CreateShortcutRequest\x122\n" +
 	"\bshortcut\x18\x01 \x01(\v2\x16.slash.api.v1.ShortcutR\bshortcut\"\x88\x01\n" +
 	"\x15UpdateShortcutRequest\x122\n" +
@@ -723,26 +5732,311 @@ const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\"'\n" +
 	"\x15DeleteShortcutRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"B\n" +
+	"\x17TransferShortcutRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xb6\x01\n" +
 	"\x1bGetShortcutAnalyticsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xdd\x02\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12N\n" +
+	"\binterval\x18\x02 \x01(\x0e22.slash.api.v1.GetShortcutAnalyticsRequest.IntervalR\binterval\"7\n" +
+	"\bInterval\x12\x18\n" +
+	"\x14INTERVAL_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03DAY\x10\x01\x12\b\n" +
+	"\x04WEEK\x10\x02\"\x93\b\n" +
 	"\x1cGetShortcutAnalyticsResponse\x12X\n" +
 	"\n" +
 	"references\x18\x01 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\n" +
 	"references\x12R\n" +
 	"\adevices\x18\x02 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\adevices\x12T\n" +
-	"\bbrowsers\x18\x03 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\bbrowsers\x1a9\n" +
+	"\bbrowsers\x18\x03 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\bbrowsers\x12l\n" +
+	"\x13click_goal_progress\x18\x04 \x01(\v2<.slash.api.v1.GetShortcutAnalyticsResponse.ClickGoalProgressR\x11clickGoalProgress\x12Y\n" +
+	"\n" +
+	"timeseries\x18\x05 \x03(\v29.slash.api.v1.GetShortcutAnalyticsResponse.TimeseriesItemR\n" +
+	"timeseries\x12V\n" +
+	"\tcountries\x18\x06 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\tcountries\x12N\n" +
+	"\x05users\x18\a \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\x05users\x12T\n" +
+	"\bvariants\x18\b \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\bvariants\x1a9\n" +
 	"\rAnalyticsItem\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count2\xec\x06\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x1a\x89\x01\n" +
+	"\x11ClickGoalProgress\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\x05R\x06target\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x02 \x01(\x05R\tviewCount\x12=\n" +
+	"\freached_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vreachedTime\x1aa\n" +
+	"\x0eTimeseriesItem\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x81\x03\n" +
+	"\x16ShortcutAnalyticsShare\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\x05R\n" +
+	"shortcutId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12;\n" +
+	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\b \x01(\x05R\tviewCount\x12D\n" +
+	"\x10last_viewed_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0elastViewedTime\"\xa5\x01\n" +
+	"#CreateShortcutAnalyticsShareRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12;\n" +
+	"\vexpire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"E\n" +
+	"\"ListShortcutAnalyticsSharesRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\"c\n" +
+	"#ListShortcutAnalyticsSharesResponse\x12<\n" +
+	"\x06shares\x18\x01 \x03(\v2$.slash.api.v1.ShortcutAnalyticsShareR\x06shares\"V\n" +
+	"#DeleteShortcutAnalyticsShareRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"\x89\x01\n" +
+	"!GetSharedShortcutAnalyticsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12N\n" +
+	"\binterval\x18\x02 \x01(\x0e22.slash.api.v1.GetShortcutAnalyticsRequest.IntervalR\binterval\"\xec\x01\n" +
+	"\x17SharedShortcutAnalytics\x12#\n" +
+	"\rshortcut_name\x18\x01 \x01(\tR\fshortcutName\x12%\n" +
+	"\x0eshortcut_title\x18\x02 \x01(\tR\rshortcutTitle\x12H\n" +
+	"\tanalytics\x18\x03 \x01(\v2*.slash.api.v1.GetShortcutAnalyticsResponseR\tanalytics\x12;\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\xb9\x01\n" +
+	"\x18GetShortcutQRCodeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12E\n" +
+	"\x06format\x18\x02 \x01(\x0e2-.slash.api.v1.GetShortcutQRCodeRequest.FormatR\x06format\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"2\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03PNG\x10\x01\x12\a\n" +
+	"\x03SVG\x10\x02\"X\n" +
+	"\x19GetShortcutQRCodeResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\x1c\n" +
+	"\x1aListBrokenShortcutsRequest\"S\n" +
+	"\x1bListBrokenShortcutsResponse\x124\n" +
+	"\tshortcuts\x18\x01 \x03(\v2\x16.slash.api.v1.ShortcutR\tshortcuts\"0\n" +
+	"\x1eRefreshShortcutMetadataRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\",\n" +
+	"\x16SuggestShortcutRequest\x12\x12\n" +
+	"\x04link\x18\x01 \x01(\tR\x04link\"e\n" +
+	"\x17SuggestShortcutResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"L\n" +
+	"\x1eSemanticSearchShortcutsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xc5\x01\n" +
+	"\x1fSemanticSearchShortcutsResponse\x12N\n" +
+	"\aresults\x18\x01 \x03(\v24.slash.api.v1.SemanticSearchShortcutsResponse.ResultR\aresults\x1aR\n" +
+	"\x06Result\x122\n" +
+	"\bshortcut\x18\x01 \x01(\v2\x16.slash.api.v1.ShortcutR\bshortcut\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"l\n" +
+	"\x1dGenerateSignedRedirectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"o\n" +
+	"\x1eGenerateSignedRedirectResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"C\n" +
+	"\x1cGetResolutionSnapshotRequest\x12#\n" +
+	"\rsince_version\x18\x01 \x01(\tR\fsinceVersion\"\xed\x02\n" +
+	"\x12ResolutionSnapshot\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12!\n" +
+	"\fbase_version\x18\x02 \x01(\tR\vbaseVersion\x12A\n" +
+	"\x05links\x18\x03 \x03(\v2+.slash.api.v1.ResolutionSnapshot.LinksEntryR\x05links\x12#\n" +
+	"\rremoved_names\x18\x04 \x03(\tR\fremovedNames\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\fR\tsignature\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x06 \x01(\fR\tpublicKey\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x1a8\n" +
+	"\n" +
+	"LinksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb2\x01\n" +
+	"\x1bGetTrendingShortcutsRequest\x12H\n" +
+	"\x06window\x18\x01 \x01(\x0e20.slash.api.v1.GetTrendingShortcutsRequest.WindowR\x06window\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"3\n" +
+	"\x06Window\x12\x16\n" +
+	"\x12WINDOW_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03DAY\x10\x01\x12\b\n" +
+	"\x04WEEK\x10\x02\"\xa2\x02\n" +
+	"\x1cGetTrendingShortcutsResponse\x12j\n" +
+	"\x12trending_shortcuts\x18\x01 \x03(\v2;.slash.api.v1.GetTrendingShortcutsResponse.TrendingShortcutR\x11trendingShortcuts\x1a\x95\x01\n" +
+	"\x10TrendingShortcut\x122\n" +
+	"\bshortcut\x18\x01 \x01(\v2\x16.slash.api.v1.ShortcutR\bshortcut\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x02 \x01(\x05R\tviewCount\x12.\n" +
+	"\x13previous_view_count\x18\x03 \x01(\x05R\x11previousViewCount\"\xef\x04\n" +
+	"\x0eProposedChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\x05R\n" +
+	"shortcutId\x12\x1f\n" +
+	"\vproposer_id\x18\x03 \x01(\x05R\n" +
+	"proposerId\x12+\n" +
+	"\x11proposer_username\x18\x04 \x01(\tR\x10proposerUsername\x12=\n" +
+	"\fcreated_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12;\n" +
+	"\x06status\x18\x06 \x01(\x0e2#.slash.api.v1.ProposedChange.StatusR\x06status\x12B\n" +
+	"\achanges\x18\a \x03(\v2(.slash.api.v1.ProposedChange.FieldChangeR\achanges\x12\x1f\n" +
+	"\vreviewer_id\x18\b \x01(\x05R\n" +
+	"reviewerId\x12?\n" +
+	"\rreviewed_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\freviewedTime\x1aq\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12%\n" +
+	"\x0eprevious_value\x18\x02 \x01(\tR\rpreviousValue\x12%\n" +
+	"\x0eproposed_value\x18\x03 \x01(\tR\rproposedValue\"I\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\f\n" +
+	"\bAPPROVED\x10\x02\x12\f\n" +
+	"\bREJECTED\x10\x03\"z\n" +
+	"\x1aListProposedChangesRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12;\n" +
+	"\x06status\x18\x02 \x01(\x0e2#.slash.api.v1.ProposedChange.StatusR\x06status\"f\n" +
+	"\x1bListProposedChangesResponse\x12G\n" +
+	"\x10proposed_changes\x18\x01 \x03(\v2\x1c.slash.api.v1.ProposedChangeR\x0fproposedChanges\"O\n" +
+	"\x1cApproveProposedChangeRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"N\n" +
+	"\x1bRejectProposedChangeRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"\xa7\x02\n" +
+	"\x10ShortcutRotation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\x05R\n" +
+	"shortcutId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12\x12\n" +
+	"\x04link\x18\x05 \x01(\tR\x04link\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"?\n" +
+	"\x1cListShortcutRotationsRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\"]\n" +
+	"\x1dListShortcutRotationsResponse\x12<\n" +
+	"\trotations\x18\x01 \x03(\v2\x1e.slash.api.v1.ShortcutRotationR\trotations\"|\n" +
+	"\x1dCreateShortcutRotationRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12:\n" +
+	"\brotation\x18\x02 \x01(\v2\x1e.slash.api.v1.ShortcutRotationR\brotation\"P\n" +
+	"\x1dDeleteShortcutRotationRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"\xec\x01\n" +
+	"\vShortcutACL\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x122\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1e.slash.api.v1.ShortcutACL.RoleR\x04role\x12=\n" +
+	"\fcreated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\"0\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04READ\x10\x01\x12\b\n" +
+	"\x04EDIT\x10\x02\":\n" +
+	"\x17ListShortcutACLsRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\"I\n" +
+	"\x18ListShortcutACLsResponse\x12-\n" +
+	"\x04acls\x18\x01 \x03(\v2\x19.slash.api.v1.ShortcutACLR\x04acls\"h\n" +
+	"\x18UpsertShortcutACLRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12+\n" +
+	"\x03acl\x18\x02 \x01(\v2\x19.slash.api.v1.ShortcutACLR\x03acl\"T\n" +
+	"\x18DeleteShortcutACLRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"J\n" +
+	"\x16CreateImportJobRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"%\n" +
+	"\x13GetImportJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x17\n" +
+	"\x15ListImportJobsRequest\"R\n" +
+	"\x16ListImportJobsResponse\x128\n" +
+	"\vimport_jobs\x18\x01 \x03(\v2\x17.slash.api.v1.ImportJobR\n" +
+	"importJobs\"(\n" +
+	"\x16ResumeImportJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x8c\x05\n" +
+	"\tImportJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
+	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.slash.api.v1.ImportJob.StatusR\x06status\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\a \x01(\x05R\ttotalRows\x12%\n" +
+	"\x0eprocessed_rows\x18\b \x01(\x05R\rprocessedRows\x12#\n" +
+	"\rcreated_count\x18\t \x01(\x05R\fcreatedCount\x12!\n" +
+	"\ffailed_count\x18\n" +
+	" \x01(\x05R\vfailedCount\x12?\n" +
+	"\n" +
+	"row_errors\x18\v \x03(\v2 .slash.api.v1.ImportJob.RowErrorR\trowErrors\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\x1aF\n" +
+	"\bRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"U\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\v\n" +
+	"\aRUNNING\x10\x02\x12\r\n" +
+	"\tSUCCEEDED\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x042\xec,\n" +
 	"\x0fShortcutService\x12s\n" +
-	"\rListShortcuts\x12\".slash.api.v1.ListShortcutsRequest\x1a#.slash.api.v1.ListShortcutsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/shortcuts\x12l\n" +
+	"\rListShortcuts\x12\".slash.api.v1.ListShortcutsRequest\x1a#.slash.api.v1.ListShortcutsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/shortcuts\x12\x80\x01\n" +
+	"\x0fSearchShortcuts\x12$.slash.api.v1.SearchShortcutsRequest\x1a%.slash.api.v1.SearchShortcutsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/shortcuts:search\x12\xa0\x01\n" +
+	"\x16BulkUpdateShortcutTags\x12+.slash.api.v1.BulkUpdateShortcutTagsRequest\x1a,.slash.api.v1.BulkUpdateShortcutTagsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/shortcuts:bulkUpdateTags\x12q\n" +
+	"\x0eMergeShortcuts\x12#.slash.api.v1.MergeShortcutsRequest\x1a\x16.slash.api.v1.Shortcut\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/shortcuts:merge\x12\x84\x01\n" +
+	"\rValidateLinks\x12\".slash.api.v1.ValidateLinksRequest\x1a#.slash.api.v1.ValidateLinksResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/shortcuts:validateLinks\x12l\n" +
 	"\vGetShortcut\x12 .slash.api.v1.GetShortcutRequest\x1a\x16.slash.api.v1.Shortcut\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/shortcuts/{id}\x12U\n" +
-	"\x11GetShortcutByName\x12&.slash.api.v1.GetShortcutByNameRequest\x1a\x16.slash.api.v1.Shortcut\"\x00\x12r\n" +
+	"\x11GetShortcutByName\x12&.slash.api.v1.GetShortcutByNameRequest\x1a\x16.slash.api.v1.Shortcut\"\x00\x12\x99\x01\n" +
+	"\x17ListShortcutSuggestions\x12,.slash.api.v1.ListShortcutSuggestionsRequest\x1a-.slash.api.v1.ListShortcutSuggestionsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/shortcuts:suggest\x12\x85\x01\n" +
+	"\x0eResolvePreview\x12#.slash.api.v1.ResolvePreviewRequest\x1a$.slash.api.v1.ResolvePreviewResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/shortcuts:resolvePreview\x12r\n" +
 	"\x0eCreateShortcut\x12#.slash.api.v1.CreateShortcutRequest\x1a\x16.slash.api.v1.Shortcut\"#\x82\xd3\xe4\x93\x02\x1d:\bshortcut\"\x11/api/v1/shortcuts\x12\x97\x01\n" +
 	"\x0eUpdateShortcut\x12#.slash.api.v1.UpdateShortcutRequest\x1a\x16.slash.api.v1.Shortcut\"H\xdaA\x14shortcut,update_mask\x82\xd3\xe4\x93\x02+:\bshortcut\x1a\x1f/api/v1/shortcuts/{shortcut.id}\x12r\n" +
-	"\x0eDeleteShortcut\x12#.slash.api.v1.DeleteShortcutRequest\x1a\x16.google.protobuf.Empty\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/shortcuts/{id}\x12\x9c\x01\n" +
-	"\x14GetShortcutAnalytics\x12).slash.api.v1.GetShortcutAnalyticsRequest\x1a*.slash.api.v1.GetShortcutAnalyticsResponse\"-\xdaA\x02id\x82\xd3\xe4\x93\x02\"\x12 /api/v1/shortcuts/{id}/analyticsB.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
+	"\x0eDeleteShortcut\x12#.slash.api.v1.DeleteShortcutRequest\x1a\x16.google.protobuf.Empty\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/shortcuts/{id}\x12\x8a\x01\n" +
+	"\x10TransferShortcut\x12%.slash.api.v1.TransferShortcutRequest\x1a\x16.slash.api.v1.Shortcut\"7\xdaA\n" +
+	"id,user_id\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/shortcuts/{id}:transfer\x12\x9c\x01\n" +
+	"\x14GetShortcutAnalytics\x12).slash.api.v1.GetShortcutAnalyticsRequest\x1a*.slash.api.v1.GetShortcutAnalyticsResponse\"-\xdaA\x02id\x82\xd3\xe4\x93\x02\"\x12 /api/v1/shortcuts/{id}/analytics\x12\xb4\x01\n" +
+	"\x1cCreateShortcutAnalyticsShare\x121.slash.api.v1.CreateShortcutAnalyticsShareRequest\x1a$.slash.api.v1.ShortcutAnalyticsShare\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/shortcuts/{shortcut_id}/analytics/shares\x12\xca\x01\n" +
+	"\x1bListShortcutAnalyticsShares\x120.slash.api.v1.ListShortcutAnalyticsSharesRequest\x1a1.slash.api.v1.ListShortcutAnalyticsSharesResponse\"F\xdaA\vshortcut_id\x82\xd3\xe4\x93\x022\x120/api/v1/shortcuts/{shortcut_id}/analytics/shares\x12\xa8\x01\n" +
+	"\x1cDeleteShortcutAnalyticsShare\x121.slash.api.v1.DeleteShortcutAnalyticsShareRequest\x1a\x16.google.protobuf.Empty\"=\x82\xd3\xe4\x93\x027*5/api/v1/shortcuts/{shortcut_id}/analytics/shares/{id}\x12\xa6\x01\n" +
+	"\x1aGetSharedShortcutAnalytics\x12/.slash.api.v1.GetSharedShortcutAnalyticsRequest\x1a%.slash.api.v1.SharedShortcutAnalytics\"0\xdaA\x05token\x82\xd3\xe4\x93\x02\"\x12 /api/v1/shared-analytics/{token}\x12\xb2\x01\n" +
+	"\x13ListProposedChanges\x12(.slash.api.v1.ListProposedChangesRequest\x1a).slash.api.v1.ListProposedChangesResponse\"F\xdaA\vshortcut_id\x82\xd3\xe4\x93\x022\x120/api/v1/shortcuts/{shortcut_id}/proposed-changes\x12\xab\x01\n" +
+	"\x15ApproveProposedChange\x12*.slash.api.v1.ApproveProposedChangeRequest\x1a\x1c.slash.api.v1.ProposedChange\"H\x82\xd3\xe4\x93\x02B:\x01*\"=/api/v1/shortcuts/{shortcut_id}/proposed-changes/{id}:approve\x12\xa8\x01\n" +
+	"\x14RejectProposedChange\x12).slash.api.v1.RejectProposedChangeRequest\x1a\x1c.slash.api.v1.ProposedChange\"G\x82\xd3\xe4\x93\x02A:\x01*\"</api/v1/shortcuts/{shortcut_id}/proposed-changes/{id}:reject\x12\xb1\x01\n" +
+	"\x15ListShortcutRotations\x12*.slash.api.v1.ListShortcutRotationsRequest\x1a+.slash.api.v1.ListShortcutRotationsResponse\"?\xdaA\vshortcut_id\x82\xd3\xe4\x93\x02+\x12)/api/v1/shortcuts/{shortcut_id}/rotations\x12\xa2\x01\n" +
+	"\x16CreateShortcutRotation\x12+.slash.api.v1.CreateShortcutRotationRequest\x1a\x1e.slash.api.v1.ShortcutRotation\";\x82\xd3\xe4\x93\x025:\brotation\")/api/v1/shortcuts/{shortcut_id}/rotations\x12\x95\x01\n" +
+	"\x16DeleteShortcutRotation\x12+.slash.api.v1.DeleteShortcutRotationRequest\x1a\x16.google.protobuf.Empty\"6\x82\xd3\xe4\x93\x020*./api/v1/shortcuts/{shortcut_id}/rotations/{id}\x12\x9d\x01\n" +
+	"\x10ListShortcutACLs\x12%.slash.api.v1.ListShortcutACLsRequest\x1a&.slash.api.v1.ListShortcutACLsResponse\":\xdaA\vshortcut_id\x82\xd3\xe4\x93\x02&\x12$/api/v1/shortcuts/{shortcut_id}/acls\x12\x89\x01\n" +
+	"\x11UpsertShortcutACL\x12&.slash.api.v1.UpsertShortcutACLRequest\x1a\x19.slash.api.v1.ShortcutACL\"1\x82\xd3\xe4\x93\x02+:\x03acl\"$/api/v1/shortcuts/{shortcut_id}/acls\x12\x8b\x01\n" +
+	"\x11DeleteShortcutACL\x12&.slash.api.v1.DeleteShortcutACLRequest\x1a\x16.google.protobuf.Empty\"6\x82\xd3\xe4\x93\x020*./api/v1/shortcuts/{shortcut_id}/acls/{user_id}\x12\x91\x01\n" +
+	"\x14GetTrendingShortcuts\x12).slash.api.v1.GetTrendingShortcutsRequest\x1a*.slash.api.v1.GetTrendingShortcutsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/trending/shortcuts\x12\x8b\x01\n" +
+	"\x11GetShortcutQRCode\x12&.slash.api.v1.GetShortcutQRCodeRequest\x1a'.slash.api.v1.GetShortcutQRCodeResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/shortcuts/{id}/qrcode\x12\x8c\x01\n" +
+	"\x13ListBrokenShortcuts\x12(.slash.api.v1.ListBrokenShortcutsRequest\x1a).slash.api.v1.ListBrokenShortcutsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/shortcuts:broken\x12\x97\x01\n" +
+	"\x17RefreshShortcutMetadata\x12,.slash.api.v1.RefreshShortcutMetadataRequest\x1a\x16.slash.api.v1.Shortcut\"6\xdaA\x02id\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/shortcuts/{id}:refreshMetadata\x12\x8b\x01\n" +
+	"\x0fSuggestShortcut\x12$.slash.api.v1.SuggestShortcutRequest\x1a%.slash.api.v1.SuggestShortcutResponse\"+\xdaA\x04link\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/shortcuts:suggest\x12\xa8\x01\n" +
+	"\x17SemanticSearchShortcuts\x12,.slash.api.v1.SemanticSearchShortcutsRequest\x1a-.slash.api.v1.SemanticSearchShortcutsResponse\"0\xdaA\x05query\x82\xd3\xe4\x93\x02\"\x12 /api/v1/shortcuts:semanticSearch\x12\xa8\x01\n" +
+	"\x16GenerateSignedRedirect\x12+.slash.api.v1.GenerateSignedRedirectRequest\x1a,.slash.api.v1.GenerateSignedRedirectResponse\"3\xdaA\x02id\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/shortcuts/{id}:signRedirect\x12\x89\x01\n" +
+	"\x15GetResolutionSnapshot\x12*.slash.api.v1.GetResolutionSnapshotRequest\x1a .slash.api.v1.ResolutionSnapshot\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/shortcuts:snapshot\x12p\n" +
+	"\x0fCreateImportJob\x12$.slash.api.v1.CreateImportJobRequest\x1a\x17.slash.api.v1.ImportJob\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/import-jobs\x12q\n" +
+	"\fGetImportJob\x12!.slash.api.v1.GetImportJobRequest\x1a\x17.slash.api.v1.ImportJob\"%\xdaA\x02id\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/import-jobs/{id}\x12x\n" +
+	"\x0eListImportJobs\x12#.slash.api.v1.ListImportJobsRequest\x1a$.slash.api.v1.ListImportJobsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/import-jobs\x12\x81\x01\n" +
+	"\x0fResumeImportJob\x12$.slash.api.v1.ResumeImportJobRequest\x1a\x17.slash.api.v1.ImportJob\"/\xdaA\x02id\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/import-jobs/{id}:resumeB.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
 
 var (
 	file_api_v1_shortcut_service_proto_rawDescOnce sync.Once
@@ -756,56 +6050,270 @@ func file_api_v1_shortcut_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_shortcut_service_proto_rawDescData
 }
 
-var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_v1_shortcut_service_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_api_v1_shortcut_service_proto_goTypes = []any{
-	(*Shortcut)(nil),                                   // 0: slash.api.v1.Shortcut
-	(*ListShortcutsRequest)(nil),                       // 1: slash.api.v1.ListShortcutsRequest
-	(*ListShortcutsResponse)(nil),                      // 2: slash.api.v1.ListShortcutsResponse
-	(*GetShortcutRequest)(nil),                         // 3: slash.api.v1.GetShortcutRequest
-	(*GetShortcutByNameRequest)(nil),                   // 4: slash.api.v1.GetShortcutByNameRequest
-	(*CreateShortcutRequest)(nil),                      // 5: slash.api.v1.CreateShortcutRequest
-	(*UpdateShortcutRequest)(nil),                      // 6: slash.api.v1.UpdateShortcutRequest
-	(*DeleteShortcutRequest)(nil),                      // 7: slash.api.v1.DeleteShortcutRequest
-	(*GetShortcutAnalyticsRequest)(nil),                // 8: slash.api.v1.GetShortcutAnalyticsRequest
-	(*GetShortcutAnalyticsResponse)(nil),               // 9: slash.api.v1.GetShortcutAnalyticsResponse
-	(*Shortcut_OpenGraphMetadata)(nil),                 // 10: slash.api.v1.Shortcut.OpenGraphMetadata
-	(*GetShortcutAnalyticsResponse_AnalyticsItem)(nil), // 11: slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	(*timestamppb.Timestamp)(nil),                      // 12: google.protobuf.Timestamp
-	(Visibility)(0),                                    // 13: slash.api.v1.Visibility
-	(*fieldmaskpb.FieldMask)(nil),                      // 14: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                              // 15: google.protobuf.Empty
+	(Shortcut_DocumentMode)(0),                             // 0: slash.api.v1.Shortcut.DocumentMode
+	(Shortcut_RedirectRule_DeviceType)(0),                  // 1: slash.api.v1.Shortcut.RedirectRule.DeviceType
+	(BulkUpdateShortcutTagsRequest_Operation)(0),           // 2: slash.api.v1.BulkUpdateShortcutTagsRequest.Operation
+	(ResolvePreviewResponse_Outcome)(0),                    // 3: slash.api.v1.ResolvePreviewResponse.Outcome
+	(GetShortcutAnalyticsRequest_Interval)(0),              // 4: slash.api.v1.GetShortcutAnalyticsRequest.Interval
+	(GetShortcutQRCodeRequest_Format)(0),                   // 5: slash.api.v1.GetShortcutQRCodeRequest.Format
+	(GetTrendingShortcutsRequest_Window)(0),                // 6: slash.api.v1.GetTrendingShortcutsRequest.Window
+	(ProposedChange_Status)(0),                             // 7: slash.api.v1.ProposedChange.Status
+	(ShortcutACL_Role)(0),                                  // 8: slash.api.v1.ShortcutACL.Role
+	(ImportJob_Status)(0),                                  // 9: slash.api.v1.ImportJob.Status
+	(*Shortcut)(nil),                                       // 10: slash.api.v1.Shortcut
+	(*ListShortcutsRequest)(nil),                           // 11: slash.api.v1.ListShortcutsRequest
+	(*ListShortcutsResponse)(nil),                          // 12: slash.api.v1.ListShortcutsResponse
+	(*SearchShortcutsRequest)(nil),                         // 13: slash.api.v1.SearchShortcutsRequest
+	(*SearchShortcutsResponse)(nil),                        // 14: slash.api.v1.SearchShortcutsResponse
+	(*BulkUpdateShortcutTagsRequest)(nil),                  // 15: slash.api.v1.BulkUpdateShortcutTagsRequest
+	(*BulkUpdateShortcutTagsResponse)(nil),                 // 16: slash.api.v1.BulkUpdateShortcutTagsResponse
+	(*MergeShortcutsRequest)(nil),                          // 17: slash.api.v1.MergeShortcutsRequest
+	(*ValidateLinksRequest)(nil),                           // 18: slash.api.v1.ValidateLinksRequest
+	(*ValidateLinksResponse)(nil),                          // 19: slash.api.v1.ValidateLinksResponse
+	(*GetShortcutRequest)(nil),                             // 20: slash.api.v1.GetShortcutRequest
+	(*GetShortcutByNameRequest)(nil),                       // 21: slash.api.v1.GetShortcutByNameRequest
+	(*ShortcutNotFoundDetails)(nil),                        // 22: slash.api.v1.ShortcutNotFoundDetails
+	(*ListShortcutSuggestionsRequest)(nil),                 // 23: slash.api.v1.ListShortcutSuggestionsRequest
+	(*ListShortcutSuggestionsResponse)(nil),                // 24: slash.api.v1.ListShortcutSuggestionsResponse
+	(*ResolvePreviewRequest)(nil),                          // 25: slash.api.v1.ResolvePreviewRequest
+	(*ResolveContext)(nil),                                 // 26: slash.api.v1.ResolveContext
+	(*ResolvePreviewResponse)(nil),                         // 27: slash.api.v1.ResolvePreviewResponse
+	(*CreateShortcutRequest)(nil),                          // 28: slash.api.v1.CreateShortcutRequest
+	(*UpdateShortcutRequest)(nil),                          // 29: slash.api.v1.UpdateShortcutRequest
+	(*DeleteShortcutRequest)(nil),                          // 30: slash.api.v1.DeleteShortcutRequest
+	(*TransferShortcutRequest)(nil),                        // 31: slash.api.v1.TransferShortcutRequest
+	(*GetShortcutAnalyticsRequest)(nil),                    // 32: slash.api.v1.GetShortcutAnalyticsRequest
+	(*GetShortcutAnalyticsResponse)(nil),                   // 33: slash.api.v1.GetShortcutAnalyticsResponse
+	(*ShortcutAnalyticsShare)(nil),                         // 34: slash.api.v1.ShortcutAnalyticsShare
+	(*CreateShortcutAnalyticsShareRequest)(nil),            // 35: slash.api.v1.CreateShortcutAnalyticsShareRequest
+	(*ListShortcutAnalyticsSharesRequest)(nil),             // 36: slash.api.v1.ListShortcutAnalyticsSharesRequest
+	(*ListShortcutAnalyticsSharesResponse)(nil),            // 37: slash.api.v1.ListShortcutAnalyticsSharesResponse
+	(*DeleteShortcutAnalyticsShareRequest)(nil),            // 38: slash.api.v1.DeleteShortcutAnalyticsShareRequest
+	(*GetSharedShortcutAnalyticsRequest)(nil),              // 39: slash.api.v1.GetSharedShortcutAnalyticsRequest
+	(*SharedShortcutAnalytics)(nil),                        // 40: slash.api.v1.SharedShortcutAnalytics
+	(*GetShortcutQRCodeRequest)(nil),                       // 41: slash.api.v1.GetShortcutQRCodeRequest
+	(*GetShortcutQRCodeResponse)(nil),                      // 42: slash.api.v1.GetShortcutQRCodeResponse
+	(*ListBrokenShortcutsRequest)(nil),                     // 43: slash.api.v1.ListBrokenShortcutsRequest
+	(*ListBrokenShortcutsResponse)(nil),                    // 44: slash.api.v1.ListBrokenShortcutsResponse
+	(*RefreshShortcutMetadataRequest)(nil),                 // 45: slash.api.v1.RefreshShortcutMetadataRequest
+	(*SuggestShortcutRequest)(nil),                         // 46: slash.api.v1.SuggestShortcutRequest
+	(*SuggestShortcutResponse)(nil),                        // 47: slash.api.v1.SuggestShortcutResponse
+	(*SemanticSearchShortcutsRequest)(nil),                 // 48: slash.api.v1.SemanticSearchShortcutsRequest
+	(*SemanticSearchShortcutsResponse)(nil),                // 49: slash.api.v1.SemanticSearchShortcutsResponse
+	(*GenerateSignedRedirectRequest)(nil),                  // 50: slash.api.v1.GenerateSignedRedirectRequest
+	(*GenerateSignedRedirectResponse)(nil),                 // 51: slash.api.v1.GenerateSignedRedirectResponse
+	(*GetResolutionSnapshotRequest)(nil),                   // 52: slash.api.v1.GetResolutionSnapshotRequest
+	(*ResolutionSnapshot)(nil),                             // 53: slash.api.v1.ResolutionSnapshot
+	(*GetTrendingShortcutsRequest)(nil),                    // 54: slash.api.v1.GetTrendingShortcutsRequest
+	(*GetTrendingShortcutsResponse)(nil),                   // 55: slash.api.v1.GetTrendingShortcutsResponse
+	(*ProposedChange)(nil),                                 // 56: slash.api.v1.ProposedChange
+	(*ListProposedChangesRequest)(nil),                     // 57: slash.api.v1.ListProposedChangesRequest
+	(*ListProposedChangesResponse)(nil),                    // 58: slash.api.v1.ListProposedChangesResponse
+	(*ApproveProposedChangeRequest)(nil),                   // 59: slash.api.v1.ApproveProposedChangeRequest
+	(*RejectProposedChangeRequest)(nil),                    // 60: slash.api.v1.RejectProposedChangeRequest
+	(*ShortcutRotation)(nil),                               // 61: slash.api.v1.ShortcutRotation
+	(*ListShortcutRotationsRequest)(nil),                   // 62: slash.api.v1.ListShortcutRotationsRequest
+	(*ListShortcutRotationsResponse)(nil),                  // 63: slash.api.v1.ListShortcutRotationsResponse
+	(*CreateShortcutRotationRequest)(nil),                  // 64: slash.api.v1.CreateShortcutRotationRequest
+	(*DeleteShortcutRotationRequest)(nil),                  // 65: slash.api.v1.DeleteShortcutRotationRequest
+	(*ShortcutACL)(nil),                                    // 66: slash.api.v1.ShortcutACL
+	(*ListShortcutACLsRequest)(nil),                        // 67: slash.api.v1.ListShortcutACLsRequest
+	(*ListShortcutACLsResponse)(nil),                       // 68: slash.api.v1.ListShortcutACLsResponse
+	(*UpsertShortcutACLRequest)(nil),                       // 69: slash.api.v1.UpsertShortcutACLRequest
+	(*DeleteShortcutACLRequest)(nil),                       // 70: slash.api.v1.DeleteShortcutACLRequest
+	(*CreateImportJobRequest)(nil),                         // 71: slash.api.v1.CreateImportJobRequest
+	(*GetImportJobRequest)(nil),                            // 72: slash.api.v1.GetImportJobRequest
+	(*ListImportJobsRequest)(nil),                          // 73: slash.api.v1.ListImportJobsRequest
+	(*ListImportJobsResponse)(nil),                         // 74: slash.api.v1.ListImportJobsResponse
+	(*ResumeImportJobRequest)(nil),                         // 75: slash.api.v1.ResumeImportJobRequest
+	(*ImportJob)(nil),                                      // 76: slash.api.v1.ImportJob
+	(*Shortcut_OpenGraphMetadata)(nil),                     // 77: slash.api.v1.Shortcut.OpenGraphMetadata
+	(*Shortcut_ClickGoal)(nil),                             // 78: slash.api.v1.Shortcut.ClickGoal
+	(*Shortcut_QueryParam)(nil),                            // 79: slash.api.v1.Shortcut.QueryParam
+	(*Shortcut_RedirectRule)(nil),                          // 80: slash.api.v1.Shortcut.RedirectRule
+	(*Shortcut_Variant)(nil),                               // 81: slash.api.v1.Shortcut.Variant
+	(*Shortcut_LinkHealth)(nil),                            // 82: slash.api.v1.Shortcut.LinkHealth
+	(*ValidateLinksResponse_Result)(nil),                   // 83: slash.api.v1.ValidateLinksResponse.Result
+	(*GetShortcutAnalyticsResponse_AnalyticsItem)(nil),     // 84: slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	(*GetShortcutAnalyticsResponse_ClickGoalProgress)(nil), // 85: slash.api.v1.GetShortcutAnalyticsResponse.ClickGoalProgress
+	(*GetShortcutAnalyticsResponse_TimeseriesItem)(nil),    // 86: slash.api.v1.GetShortcutAnalyticsResponse.TimeseriesItem
+	(*SemanticSearchShortcutsResponse_Result)(nil),         // 87: slash.api.v1.SemanticSearchShortcutsResponse.Result
+	nil, // 88: slash.api.v1.ResolutionSnapshot.LinksEntry
+	(*GetTrendingShortcutsResponse_TrendingShortcut)(nil), // 89: slash.api.v1.GetTrendingShortcutsResponse.TrendingShortcut
+	(*ProposedChange_FieldChange)(nil),                    // 90: slash.api.v1.ProposedChange.FieldChange
+	(*ImportJob_RowError)(nil),                            // 91: slash.api.v1.ImportJob.RowError
+	(*timestamppb.Timestamp)(nil),                         // 92: google.protobuf.Timestamp
+	(State)(0),                                            // 93: slash.api.v1.State
+	(Visibility)(0),                                       // 94: slash.api.v1.Visibility
+	(*fieldmaskpb.FieldMask)(nil),                         // 95: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                                 // 96: google.protobuf.Empty
 }
 var file_api_v1_shortcut_service_proto_depIdxs = []int32{
-	12, // 0: slash.api.v1.Shortcut.created_time:type_name -> google.protobuf.Timestamp
-	12, // 1: slash.api.v1.Shortcut.updated_time:type_name -> google.protobuf.Timestamp
-	13, // 2: slash.api.v1.Shortcut.visibility:type_name -> slash.api.v1.Visibility
-	10, // 3: slash.api.v1.Shortcut.og_metadata:type_name -> slash.api.v1.Shortcut.OpenGraphMetadata
-	0,  // 4: slash.api.v1.ListShortcutsResponse.shortcuts:type_name -> slash.api.v1.Shortcut
-	0,  // 5: slash.api.v1.CreateShortcutRequest.shortcut:type_name -> slash.api.v1.Shortcut
-	0,  // 6: slash.api.v1.UpdateShortcutRequest.shortcut:type_name -> slash.api.v1.Shortcut
-	14, // 7: slash.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 8: slash.api.v1.GetShortcutAnalyticsResponse.references:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	11, // 9: slash.api.v1.GetShortcutAnalyticsResponse.devices:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	11, // 10: slash.api.v1.GetShortcutAnalyticsResponse.browsers:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	1,  // 11: slash.api.v1.ShortcutService.ListShortcuts:input_type -> slash.api.v1.ListShortcutsRequest
-	3,  // 12: slash.api.v1.ShortcutService.GetShortcut:input_type -> slash.api.v1.GetShortcutRequest
-	4,  // 13: slash.api.v1.ShortcutService.GetShortcutByName:input_type -> slash.api.v1.GetShortcutByNameRequest
-	5,  // 14: slash.api.v1.ShortcutService.CreateShortcut:input_type -> slash.api.v1.CreateShortcutRequest
-	6,  // 15: slash.api.v1.ShortcutService.UpdateShortcut:input_type -> slash.api.v1.UpdateShortcutRequest
-	7,  // 16: slash.api.v1.ShortcutService.DeleteShortcut:input_type -> slash.api.v1.DeleteShortcutRequest
-	8,  // 17: slash.api.v1.ShortcutService.GetShortcutAnalytics:input_type -> slash.api.v1.GetShortcutAnalyticsRequest
-	2,  // 18: slash.api.v1.ShortcutService.ListShortcuts:output_type -> slash.api.v1.ListShortcutsResponse
-	0,  // 19: slash.api.v1.ShortcutService.GetShortcut:output_type -> slash.api.v1.Shortcut
-	0,  // 20: slash.api.v1.ShortcutService.GetShortcutByName:output_type -> slash.api.v1.Shortcut
-	0,  // 21: slash.api.v1.ShortcutService.CreateShortcut:output_type -> slash.api.v1.Shortcut
-	0,  // 22: slash.api.v1.ShortcutService.UpdateShortcut:output_type -> slash.api.v1.Shortcut
-	15, // 23: slash.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
-	9,  // 24: slash.api.v1.ShortcutService.GetShortcutAnalytics:output_type -> slash.api.v1.GetShortcutAnalyticsResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	92,  // 0: slash.api.v1.Shortcut.created_time:type_name -> google.protobuf.Timestamp
+	92,  // 1: slash.api.v1.Shortcut.updated_time:type_name -> google.protobuf.Timestamp
+	93,  // 2: slash.api.v1.Shortcut.state:type_name -> slash.api.v1.State
+	94,  // 3: slash.api.v1.Shortcut.visibility:type_name -> slash.api.v1.Visibility
+	77,  // 4: slash.api.v1.Shortcut.og_metadata:type_name -> slash.api.v1.Shortcut.OpenGraphMetadata
+	78,  // 5: slash.api.v1.Shortcut.click_goal:type_name -> slash.api.v1.Shortcut.ClickGoal
+	92,  // 6: slash.api.v1.Shortcut.expire_time:type_name -> google.protobuf.Timestamp
+	79,  // 7: slash.api.v1.Shortcut.query_params:type_name -> slash.api.v1.Shortcut.QueryParam
+	92,  // 8: slash.api.v1.Shortcut.activate_time:type_name -> google.protobuf.Timestamp
+	82,  // 9: slash.api.v1.Shortcut.link_health:type_name -> slash.api.v1.Shortcut.LinkHealth
+	0,   // 10: slash.api.v1.Shortcut.document_mode:type_name -> slash.api.v1.Shortcut.DocumentMode
+	80,  // 11: slash.api.v1.Shortcut.redirect_rules:type_name -> slash.api.v1.Shortcut.RedirectRule
+	81,  // 12: slash.api.v1.Shortcut.variants:type_name -> slash.api.v1.Shortcut.Variant
+	93,  // 13: slash.api.v1.ListShortcutsRequest.state:type_name -> slash.api.v1.State
+	10,  // 14: slash.api.v1.ListShortcutsResponse.shortcuts:type_name -> slash.api.v1.Shortcut
+	10,  // 15: slash.api.v1.SearchShortcutsResponse.shortcuts:type_name -> slash.api.v1.Shortcut
+	2,   // 16: slash.api.v1.BulkUpdateShortcutTagsRequest.operation:type_name -> slash.api.v1.BulkUpdateShortcutTagsRequest.Operation
+	10,  // 17: slash.api.v1.BulkUpdateShortcutTagsResponse.sample:type_name -> slash.api.v1.Shortcut
+	83,  // 18: slash.api.v1.ValidateLinksResponse.results:type_name -> slash.api.v1.ValidateLinksResponse.Result
+	26,  // 19: slash.api.v1.ResolvePreviewRequest.context:type_name -> slash.api.v1.ResolveContext
+	92,  // 20: slash.api.v1.ResolveContext.time:type_name -> google.protobuf.Timestamp
+	3,   // 21: slash.api.v1.ResolvePreviewResponse.outcome:type_name -> slash.api.v1.ResolvePreviewResponse.Outcome
+	10,  // 22: slash.api.v1.ResolvePreviewResponse.shortcut:type_name -> slash.api.v1.Shortcut
+	10,  // 23: slash.api.v1.CreateShortcutRequest.shortcut:type_name -> slash.api.v1.Shortcut
+	10,  // 24: slash.api.v1.UpdateShortcutRequest.shortcut:type_name -> slash.api.v1.Shortcut
+	95,  // 25: slash.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,   // 26: slash.api.v1.GetShortcutAnalyticsRequest.interval:type_name -> slash.api.v1.GetShortcutAnalyticsRequest.Interval
+	84,  // 27: slash.api.v1.GetShortcutAnalyticsResponse.references:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	84,  // 28: slash.api.v1.GetShortcutAnalyticsResponse.devices:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	84,  // 29: slash.api.v1.GetShortcutAnalyticsResponse.browsers:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	85,  // 30: slash.api.v1.GetShortcutAnalyticsResponse.click_goal_progress:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.ClickGoalProgress
+	86,  // 31: slash.api.v1.GetShortcutAnalyticsResponse.timeseries:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.TimeseriesItem
+	84,  // 32: slash.api.v1.GetShortcutAnalyticsResponse.countries:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	84,  // 33: slash.api.v1.GetShortcutAnalyticsResponse.users:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	84,  // 34: slash.api.v1.GetShortcutAnalyticsResponse.variants:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	92,  // 35: slash.api.v1.ShortcutAnalyticsShare.created_time:type_name -> google.protobuf.Timestamp
+	92,  // 36: slash.api.v1.ShortcutAnalyticsShare.expire_time:type_name -> google.protobuf.Timestamp
+	92,  // 37: slash.api.v1.ShortcutAnalyticsShare.last_viewed_time:type_name -> google.protobuf.Timestamp
+	92,  // 38: slash.api.v1.CreateShortcutAnalyticsShareRequest.expire_time:type_name -> google.protobuf.Timestamp
+	34,  // 39: slash.api.v1.ListShortcutAnalyticsSharesResponse.shares:type_name -> slash.api.v1.ShortcutAnalyticsShare
+	4,   // 40: slash.api.v1.GetSharedShortcutAnalyticsRequest.interval:type_name -> slash.api.v1.GetShortcutAnalyticsRequest.Interval
+	33,  // 41: slash.api.v1.SharedShortcutAnalytics.analytics:type_name -> slash.api.v1.GetShortcutAnalyticsResponse
+	92,  // 42: slash.api.v1.SharedShortcutAnalytics.expire_time:type_name -> google.protobuf.Timestamp
+	5,   // 43: slash.api.v1.GetShortcutQRCodeRequest.format:type_name -> slash.api.v1.GetShortcutQRCodeRequest.Format
+	10,  // 44: slash.api.v1.ListBrokenShortcutsResponse.shortcuts:type_name -> slash.api.v1.Shortcut
+	87,  // 45: slash.api.v1.SemanticSearchShortcutsResponse.results:type_name -> slash.api.v1.SemanticSearchShortcutsResponse.Result
+	92,  // 46: slash.api.v1.GenerateSignedRedirectRequest.expire_time:type_name -> google.protobuf.Timestamp
+	92,  // 47: slash.api.v1.GenerateSignedRedirectResponse.expire_time:type_name -> google.protobuf.Timestamp
+	88,  // 48: slash.api.v1.ResolutionSnapshot.links:type_name -> slash.api.v1.ResolutionSnapshot.LinksEntry
+	92,  // 49: slash.api.v1.ResolutionSnapshot.create_time:type_name -> google.protobuf.Timestamp
+	6,   // 50: slash.api.v1.GetTrendingShortcutsRequest.window:type_name -> slash.api.v1.GetTrendingShortcutsRequest.Window
+	89,  // 51: slash.api.v1.GetTrendingShortcutsResponse.trending_shortcuts:type_name -> slash.api.v1.GetTrendingShortcutsResponse.TrendingShortcut
+	92,  // 52: slash.api.v1.ProposedChange.created_time:type_name -> google.protobuf.Timestamp
+	7,   // 53: slash.api.v1.ProposedChange.status:type_name -> slash.api.v1.ProposedChange.Status
+	90,  // 54: slash.api.v1.ProposedChange.changes:type_name -> slash.api.v1.ProposedChange.FieldChange
+	92,  // 55: slash.api.v1.ProposedChange.reviewed_time:type_name -> google.protobuf.Timestamp
+	7,   // 56: slash.api.v1.ListProposedChangesRequest.status:type_name -> slash.api.v1.ProposedChange.Status
+	56,  // 57: slash.api.v1.ListProposedChangesResponse.proposed_changes:type_name -> slash.api.v1.ProposedChange
+	92,  // 58: slash.api.v1.ShortcutRotation.created_time:type_name -> google.protobuf.Timestamp
+	92,  // 59: slash.api.v1.ShortcutRotation.start_time:type_name -> google.protobuf.Timestamp
+	92,  // 60: slash.api.v1.ShortcutRotation.end_time:type_name -> google.protobuf.Timestamp
+	61,  // 61: slash.api.v1.ListShortcutRotationsResponse.rotations:type_name -> slash.api.v1.ShortcutRotation
+	61,  // 62: slash.api.v1.CreateShortcutRotationRequest.rotation:type_name -> slash.api.v1.ShortcutRotation
+	8,   // 63: slash.api.v1.ShortcutACL.role:type_name -> slash.api.v1.ShortcutACL.Role
+	92,  // 64: slash.api.v1.ShortcutACL.created_time:type_name -> google.protobuf.Timestamp
+	66,  // 65: slash.api.v1.ListShortcutACLsResponse.acls:type_name -> slash.api.v1.ShortcutACL
+	66,  // 66: slash.api.v1.UpsertShortcutACLRequest.acl:type_name -> slash.api.v1.ShortcutACL
+	76,  // 67: slash.api.v1.ListImportJobsResponse.import_jobs:type_name -> slash.api.v1.ImportJob
+	92,  // 68: slash.api.v1.ImportJob.created_time:type_name -> google.protobuf.Timestamp
+	92,  // 69: slash.api.v1.ImportJob.updated_time:type_name -> google.protobuf.Timestamp
+	9,   // 70: slash.api.v1.ImportJob.status:type_name -> slash.api.v1.ImportJob.Status
+	91,  // 71: slash.api.v1.ImportJob.row_errors:type_name -> slash.api.v1.ImportJob.RowError
+	92,  // 72: slash.api.v1.Shortcut.ClickGoal.reached_time:type_name -> google.protobuf.Timestamp
+	1,   // 73: slash.api.v1.Shortcut.RedirectRule.devices:type_name -> slash.api.v1.Shortcut.RedirectRule.DeviceType
+	92,  // 74: slash.api.v1.Shortcut.LinkHealth.check_time:type_name -> google.protobuf.Timestamp
+	92,  // 75: slash.api.v1.GetShortcutAnalyticsResponse.ClickGoalProgress.reached_time:type_name -> google.protobuf.Timestamp
+	92,  // 76: slash.api.v1.GetShortcutAnalyticsResponse.TimeseriesItem.start_time:type_name -> google.protobuf.Timestamp
+	10,  // 77: slash.api.v1.SemanticSearchShortcutsResponse.Result.shortcut:type_name -> slash.api.v1.Shortcut
+	10,  // 78: slash.api.v1.GetTrendingShortcutsResponse.TrendingShortcut.shortcut:type_name -> slash.api.v1.Shortcut
+	11,  // 79: slash.api.v1.ShortcutService.ListShortcuts:input_type -> slash.api.v1.ListShortcutsRequest
+	13,  // 80: slash.api.v1.ShortcutService.SearchShortcuts:input_type -> slash.api.v1.SearchShortcutsRequest
+	15,  // 81: slash.api.v1.ShortcutService.BulkUpdateShortcutTags:input_type -> slash.api.v1.BulkUpdateShortcutTagsRequest
+	17,  // 82: slash.api.v1.ShortcutService.MergeShortcuts:input_type -> slash.api.v1.MergeShortcutsRequest
+	18,  // 83: slash.api.v1.ShortcutService.ValidateLinks:input_type -> slash.api.v1.ValidateLinksRequest
+	20,  // 84: slash.api.v1.ShortcutService.GetShortcut:input_type -> slash.api.v1.GetShortcutRequest
+	21,  // 85: slash.api.v1.ShortcutService.GetShortcutByName:input_type -> slash.api.v1.GetShortcutByNameRequest
+	23,  // 86: slash.api.v1.ShortcutService.ListShortcutSuggestions:input_type -> slash.api.v1.ListShortcutSuggestionsRequest
+	25,  // 87: slash.api.v1.ShortcutService.ResolvePreview:input_type -> slash.api.v1.ResolvePreviewRequest
+	28,  // 88: slash.api.v1.ShortcutService.CreateShortcut:input_type -> slash.api.v1.CreateShortcutRequest
+	29,  // 89: slash.api.v1.ShortcutService.UpdateShortcut:input_type -> slash.api.v1.UpdateShortcutRequest
+	30,  // 90: slash.api.v1.ShortcutService.DeleteShortcut:input_type -> slash.api.v1.DeleteShortcutRequest
+	31,  // 91: slash.api.v1.ShortcutService.TransferShortcut:input_type -> slash.api.v1.TransferShortcutRequest
+	32,  // 92: slash.api.v1.ShortcutService.GetShortcutAnalytics:input_type -> slash.api.v1.GetShortcutAnalyticsRequest
+	35,  // 93: slash.api.v1.ShortcutService.CreateShortcutAnalyticsShare:input_type -> slash.api.v1.CreateShortcutAnalyticsShareRequest
+	36,  // 94: slash.api.v1.ShortcutService.ListShortcutAnalyticsShares:input_type -> slash.api.v1.ListShortcutAnalyticsSharesRequest
+	38,  // 95: slash.api.v1.ShortcutService.DeleteShortcutAnalyticsShare:input_type -> slash.api.v1.DeleteShortcutAnalyticsShareRequest
+	39,  // 96: slash.api.v1.ShortcutService.GetSharedShortcutAnalytics:input_type -> slash.api.v1.GetSharedShortcutAnalyticsRequest
+	57,  // 97: slash.api.v1.ShortcutService.ListProposedChanges:input_type -> slash.api.v1.ListProposedChangesRequest
+	59,  // 98: slash.api.v1.ShortcutService.ApproveProposedChange:input_type -> slash.api.v1.ApproveProposedChangeRequest
+	60,  // 99: slash.api.v1.ShortcutService.RejectProposedChange:input_type -> slash.api.v1.RejectProposedChangeRequest
+	62,  // 100: slash.api.v1.ShortcutService.ListShortcutRotations:input_type -> slash.api.v1.ListShortcutRotationsRequest
+	64,  // 101: slash.api.v1.ShortcutService.CreateShortcutRotation:input_type -> slash.api.v1.CreateShortcutRotationRequest
+	65,  // 102: slash.api.v1.ShortcutService.DeleteShortcutRotation:input_type -> slash.api.v1.DeleteShortcutRotationRequest
+	67,  // 103: slash.api.v1.ShortcutService.ListShortcutACLs:input_type -> slash.api.v1.ListShortcutACLsRequest
+	69,  // 104: slash.api.v1.ShortcutService.UpsertShortcutACL:input_type -> slash.api.v1.UpsertShortcutACLRequest
+	70,  // 105: slash.api.v1.ShortcutService.DeleteShortcutACL:input_type -> slash.api.v1.DeleteShortcutACLRequest
+	54,  // 106: slash.api.v1.ShortcutService.GetTrendingShortcuts:input_type -> slash.api.v1.GetTrendingShortcutsRequest
+	41,  // 107: slash.api.v1.ShortcutService.GetShortcutQRCode:input_type -> slash.api.v1.GetShortcutQRCodeRequest
+	43,  // 108: slash.api.v1.ShortcutService.ListBrokenShortcuts:input_type -> slash.api.v1.ListBrokenShortcutsRequest
+	45,  // 109: slash.api.v1.ShortcutService.RefreshShortcutMetadata:input_type -> slash.api.v1.RefreshShortcutMetadataRequest
+	46,  // 110: slash.api.v1.ShortcutService.SuggestShortcut:input_type -> slash.api.v1.SuggestShortcutRequest
+	48,  // 111: slash.api.v1.ShortcutService.SemanticSearchShortcuts:input_type -> slash.api.v1.SemanticSearchShortcutsRequest
+	50,  // 112: slash.api.v1.ShortcutService.GenerateSignedRedirect:input_type -> slash.api.v1.GenerateSignedRedirectRequest
+	52,  // 113: slash.api.v1.ShortcutService.GetResolutionSnapshot:input_type -> slash.api.v1.GetResolutionSnapshotRequest
+	71,  // 114: slash.api.v1.ShortcutService.CreateImportJob:input_type -> slash.api.v1.CreateImportJobRequest
+	72,  // 115: slash.api.v1.ShortcutService.GetImportJob:input_type -> slash.api.v1.GetImportJobRequest
+	73,  // 116: slash.api.v1.ShortcutService.ListImportJobs:input_type -> slash.api.v1.ListImportJobsRequest
+	75,  // 117: slash.api.v1.ShortcutService.ResumeImportJob:input_type -> slash.api.v1.ResumeImportJobRequest
+	12,  // 118: slash.api.v1.ShortcutService.ListShortcuts:output_type -> slash.api.v1.ListShortcutsResponse
+	14,  // 119: slash.api.v1.ShortcutService.SearchShortcuts:output_type -> slash.api.v1.SearchShortcutsResponse
+	16,  // 120: slash.api.v1.ShortcutService.BulkUpdateShortcutTags:output_type -> slash.api.v1.BulkUpdateShortcutTagsResponse
+	10,  // 121: slash.api.v1.ShortcutService.MergeShortcuts:output_type -> slash.api.v1.Shortcut
+	19,  // 122: slash.api.v1.ShortcutService.ValidateLinks:output_type -> slash.api.v1.ValidateLinksResponse
+	10,  // 123: slash.api.v1.ShortcutService.GetShortcut:output_type -> slash.api.v1.Shortcut
+	10,  // 124: slash.api.v1.ShortcutService.GetShortcutByName:output_type -> slash.api.v1.Shortcut
+	24,  // 125: slash.api.v1.ShortcutService.ListShortcutSuggestions:output_type -> slash.api.v1.ListShortcutSuggestionsResponse
+	27,  // 126: slash.api.v1.ShortcutService.ResolvePreview:output_type -> slash.api.v1.ResolvePreviewResponse
+	10,  // 127: slash.api.v1.ShortcutService.CreateShortcut:output_type -> slash.api.v1.Shortcut
+	10,  // 128: slash.api.v1.ShortcutService.UpdateShortcut:output_type -> slash.api.v1.Shortcut
+	96,  // 129: slash.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
+	10,  // 130: slash.api.v1.ShortcutService.TransferShortcut:output_type -> slash.api.v1.Shortcut
+	33,  // 131: slash.api.v1.ShortcutService.GetShortcutAnalytics:output_type -> slash.api.v1.GetShortcutAnalyticsResponse
+	34,  // 132: slash.api.v1.ShortcutService.CreateShortcutAnalyticsShare:output_type -> slash.api.v1.ShortcutAnalyticsShare
+	37,  // 133: slash.api.v1.ShortcutService.ListShortcutAnalyticsShares:output_type -> slash.api.v1.ListShortcutAnalyticsSharesResponse
+	96,  // 134: slash.api.v1.ShortcutService.DeleteShortcutAnalyticsShare:output_type -> google.protobuf.Empty
+	40,  // 135: slash.api.v1.ShortcutService.GetSharedShortcutAnalytics:output_type -> slash.api.v1.SharedShortcutAnalytics
+	58,  // 136: slash.api.v1.ShortcutService.ListProposedChanges:output_type -> slash.api.v1.ListProposedChangesResponse
+	56,  // 137: slash.api.v1.ShortcutService.ApproveProposedChange:output_type -> slash.api.v1.ProposedChange
+	56,  // 138: slash.api.v1.ShortcutService.RejectProposedChange:output_type -> slash.api.v1.ProposedChange
+	63,  // 139: slash.api.v1.ShortcutService.ListShortcutRotations:output_type -> slash.api.v1.ListShortcutRotationsResponse
+	61,  // 140: slash.api.v1.ShortcutService.CreateShortcutRotation:output_type -> slash.api.v1.ShortcutRotation
+	96,  // 141: slash.api.v1.ShortcutService.DeleteShortcutRotation:output_type -> google.protobuf.Empty
+	68,  // 142: slash.api.v1.ShortcutService.ListShortcutACLs:output_type -> slash.api.v1.ListShortcutACLsResponse
+	66,  // 143: slash.api.v1.ShortcutService.UpsertShortcutACL:output_type -> slash.api.v1.ShortcutACL
+	96,  // 144: slash.api.v1.ShortcutService.DeleteShortcutACL:output_type -> google.protobuf.Empty
+	55,  // 145: slash.api.v1.ShortcutService.GetTrendingShortcuts:output_type -> slash.api.v1.GetTrendingShortcutsResponse
+	42,  // 146: slash.api.v1.ShortcutService.GetShortcutQRCode:output_type -> slash.api.v1.GetShortcutQRCodeResponse
+	44,  // 147: slash.api.v1.ShortcutService.ListBrokenShortcuts:output_type -> slash.api.v1.ListBrokenShortcutsResponse
+	10,  // 148: slash.api.v1.ShortcutService.RefreshShortcutMetadata:output_type -> slash.api.v1.Shortcut
+	47,  // 149: slash.api.v1.ShortcutService.SuggestShortcut:output_type -> slash.api.v1.SuggestShortcutResponse
+	49,  // 150: slash.api.v1.ShortcutService.SemanticSearchShortcuts:output_type -> slash.api.v1.SemanticSearchShortcutsResponse
+	51,  // 151: slash.api.v1.ShortcutService.GenerateSignedRedirect:output_type -> slash.api.v1.GenerateSignedRedirectResponse
+	53,  // 152: slash.api.v1.ShortcutService.GetResolutionSnapshot:output_type -> slash.api.v1.ResolutionSnapshot
+	76,  // 153: slash.api.v1.ShortcutService.CreateImportJob:output_type -> slash.api.v1.ImportJob
+	76,  // 154: slash.api.v1.ShortcutService.GetImportJob:output_type -> slash.api.v1.ImportJob
+	74,  // 155: slash.api.v1.ShortcutService.ListImportJobs:output_type -> slash.api.v1.ListImportJobsResponse
+	76,  // 156: slash.api.v1.ShortcutService.ResumeImportJob:output_type -> slash.api.v1.ImportJob
+	118, // [118:157] is the sub-list for method output_type
+	79,  // [79:118] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_shortcut_service_proto_init() }
@@ -814,18 +6322,20 @@ func file_api_v1_shortcut_service_proto_init() {
 		return
 	}
 	file_api_v1_common_proto_init()
+	file_api_v1_shortcut_service_proto_msgTypes[73].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_shortcut_service_proto_rawDesc), len(file_api_v1_shortcut_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      10,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_shortcut_service_proto_goTypes,
 		DependencyIndexes: file_api_v1_shortcut_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_shortcut_service_proto_enumTypes,
 		MessageInfos:      file_api_v1_shortcut_service_proto_msgTypes,
 	}.Build()
 	File_api_v1_shortcut_service_proto = out.File
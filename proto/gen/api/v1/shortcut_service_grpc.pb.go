@@ -20,13 +20,45 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ShortcutService_ListShortcuts_FullMethodName        = "/slash.api.v1.ShortcutService/ListShortcuts"
-	ShortcutService_GetShortcut_FullMethodName          = "/slash.api.v1.ShortcutService/GetShortcut"
-	ShortcutService_GetShortcutByName_FullMethodName    = "/slash.api.v1.ShortcutService/GetShortcutByName"
-	ShortcutService_CreateShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/CreateShortcut"
-	ShortcutService_UpdateShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/UpdateShortcut"
-	ShortcutService_DeleteShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/DeleteShortcut"
-	ShortcutService_GetShortcutAnalytics_FullMethodName = "/slash.api.v1.ShortcutService/GetShortcutAnalytics"
+	ShortcutService_ListShortcuts_FullMethodName                = "/slash.api.v1.ShortcutService/ListShortcuts"
+	ShortcutService_SearchShortcuts_FullMethodName              = "/slash.api.v1.ShortcutService/SearchShortcuts"
+	ShortcutService_BulkUpdateShortcutTags_FullMethodName       = "/slash.api.v1.ShortcutService/BulkUpdateShortcutTags"
+	ShortcutService_MergeShortcuts_FullMethodName               = "/slash.api.v1.ShortcutService/MergeShortcuts"
+	ShortcutService_ValidateLinks_FullMethodName                = "/slash.api.v1.ShortcutService/ValidateLinks"
+	ShortcutService_GetShortcut_FullMethodName                  = "/slash.api.v1.ShortcutService/GetShortcut"
+	ShortcutService_GetShortcutByName_FullMethodName            = "/slash.api.v1.ShortcutService/GetShortcutByName"
+	ShortcutService_ListShortcutSuggestions_FullMethodName      = "/slash.api.v1.ShortcutService/ListShortcutSuggestions"
+	ShortcutService_ResolvePreview_FullMethodName               = "/slash.api.v1.ShortcutService/ResolvePreview"
+	ShortcutService_CreateShortcut_FullMethodName               = "/slash.api.v1.ShortcutService/CreateShortcut"
+	ShortcutService_UpdateShortcut_FullMethodName               = "/slash.api.v1.ShortcutService/UpdateShortcut"
+	ShortcutService_DeleteShortcut_FullMethodName               = "/slash.api.v1.ShortcutService/DeleteShortcut"
+	ShortcutService_TransferShortcut_FullMethodName             = "/slash.api.v1.ShortcutService/TransferShortcut"
+	ShortcutService_GetShortcutAnalytics_FullMethodName         = "/slash.api.v1.ShortcutService/GetShortcutAnalytics"
+	ShortcutService_CreateShortcutAnalyticsShare_FullMethodName = "/slash.api.v1.ShortcutService/CreateShortcutAnalyticsShare"
+	ShortcutService_ListShortcutAnalyticsShares_FullMethodName  = "/slash.api.v1.ShortcutService/ListShortcutAnalyticsShares"
+	ShortcutService_DeleteShortcutAnalyticsShare_FullMethodName = "/slash.api.v1.ShortcutService/DeleteShortcutAnalyticsShare"
+	ShortcutService_GetSharedShortcutAnalytics_FullMethodName   = "/slash.api.v1.ShortcutService/GetSharedShortcutAnalytics"
+	ShortcutService_ListProposedChanges_FullMethodName          = "/slash.api.v1.ShortcutService/ListProposedChanges"
+	ShortcutService_ApproveProposedChange_FullMethodName        = "/slash.api.v1.ShortcutService/ApproveProposedChange"
+	ShortcutService_RejectProposedChange_FullMethodName         = "/slash.api.v1.ShortcutService/RejectProposedChange"
+	ShortcutService_ListShortcutRotations_FullMethodName        = "/slash.api.v1.ShortcutService/ListShortcutRotations"
+	ShortcutService_CreateShortcutRotation_FullMethodName       = "/slash.api.v1.ShortcutService/CreateShortcutRotation"
+	ShortcutService_DeleteShortcutRotation_FullMethodName       = "/slash.api.v1.ShortcutService/DeleteShortcutRotation"
+	ShortcutService_ListShortcutACLs_FullMethodName             = "/slash.api.v1.ShortcutService/ListShortcutACLs"
+	ShortcutService_UpsertShortcutACL_FullMethodName            = "/slash.api.v1.ShortcutService/UpsertShortcutACL"
+	ShortcutService_DeleteShortcutACL_FullMethodName            = "/slash.api.v1.ShortcutService/DeleteShortcutACL"
+	ShortcutService_GetTrendingShortcuts_FullMethodName         = "/slash.api.v1.ShortcutService/GetTrendingShortcuts"
+	ShortcutService_GetShortcutQRCode_FullMethodName            = "/slash.api.v1.ShortcutService/GetShortcutQRCode"
+	ShortcutService_ListBrokenShortcuts_FullMethodName          = "/slash.api.v1.ShortcutService/ListBrokenShortcuts"
+	ShortcutService_RefreshShortcutMetadata_FullMethodName      = "/slash.api.v1.ShortcutService/RefreshShortcutMetadata"
+	ShortcutService_SuggestShortcut_FullMethodName              = "/slash.api.v1.ShortcutService/SuggestShortcut"
+	ShortcutService_SemanticSearchShortcuts_FullMethodName      = "/slash.api.v1.ShortcutService/SemanticSearchShortcuts"
+	ShortcutService_GenerateSignedRedirect_FullMethodName       = "/slash.api.v1.ShortcutService/GenerateSignedRedirect"
+	ShortcutService_GetResolutionSnapshot_FullMethodName        = "/slash.api.v1.ShortcutService/GetResolutionSnapshot"
+	ShortcutService_CreateImportJob_FullMethodName              = "/slash.api.v1.ShortcutService/CreateImportJob"
+	ShortcutService_GetImportJob_FullMethodName                 = "/slash.api.v1.ShortcutService/GetImportJob"
+	ShortcutService_ListImportJobs_FullMethodName               = "/slash.api.v1.ShortcutService/ListImportJobs"
+	ShortcutService_ResumeImportJob_FullMethodName              = "/slash.api.v1.ShortcutService/ResumeImportJob"
 )
 
 // ShortcutServiceClient is the client API for ShortcutService service.
@@ -35,18 +67,97 @@ const (
 type ShortcutServiceClient interface {
 	// ListShortcuts returns a list of shortcuts.
 	ListShortcuts(ctx context.Context, in *ListShortcutsRequest, opts ...grpc.CallOption) (*ListShortcutsResponse, error)
+	// SearchShortcuts returns the shortcuts matching the query, ordered by relevance.
+	SearchShortcuts(ctx context.Context, in *SearchShortcutsRequest, opts ...grpc.CallOption) (*SearchShortcutsResponse, error)
+	// BulkUpdateShortcutTags adds, removes or replaces a tag of the shortcuts matching the filter. Only for admins.
+	BulkUpdateShortcutTags(ctx context.Context, in *BulkUpdateShortcutTagsRequest, opts ...grpc.CallOption) (*BulkUpdateShortcutTagsResponse, error)
+	// MergeShortcuts merges duplicate shortcuts into a survivor, whose aliases the other names become. Only for admins.
+	MergeShortcuts(ctx context.Context, in *MergeShortcutsRequest, opts ...grpc.CallOption) (*Shortcut, error)
+	// ValidateLinks checks the syntax of the links, normalizes them with the link parameter rules of the workspace,
+	// and optionally checks whether they're reachable, e.g. before importing shortcuts.
+	ValidateLinks(ctx context.Context, in *ValidateLinksRequest, opts ...grpc.CallOption) (*ValidateLinksResponse, error)
 	// GetShortcut returns a shortcut by id.
 	GetShortcut(ctx context.Context, in *GetShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// GetShortcutByName returns a shortcut by name.
+	// When it's not found, the error has ShortcutNotFoundDetails with the names of similar shortcuts.
 	GetShortcutByName(ctx context.Context, in *GetShortcutByNameRequest, opts ...grpc.CallOption) (*Shortcut, error)
+	// ListShortcutSuggestions returns the names of the shortcuts close to a name, e.g. a mistyped one.
+	ListShortcutSuggestions(ctx context.Context, in *ListShortcutSuggestionsRequest, opts ...grpc.CallOption) (*ListShortcutSuggestionsResponse, error)
+	// ResolvePreview returns how visiting the shortcut would resolve in the simulated context,
+	// without redirecting or recording the view.
+	ResolvePreview(ctx context.Context, in *ResolvePreviewRequest, opts ...grpc.CallOption) (*ResolvePreviewResponse, error)
 	// CreateShortcut creates a shortcut.
 	CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// UpdateShortcut updates a shortcut.
 	UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// DeleteShortcut deletes a shortcut by name.
 	DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TransferShortcut transfers the ownership of a shortcut to another user. Only for its creator and admins.
+	TransferShortcut(ctx context.Context, in *TransferShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// GetShortcutAnalytics returns the analytics for a shortcut.
 	GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error)
+	// CreateShortcutAnalyticsShare creates a read-only link to view the analytics of the shortcut without an account.
+	CreateShortcutAnalyticsShare(ctx context.Context, in *CreateShortcutAnalyticsShareRequest, opts ...grpc.CallOption) (*ShortcutAnalyticsShare, error)
+	// ListShortcutAnalyticsShares returns the analytics share links of the shortcut.
+	ListShortcutAnalyticsShares(ctx context.Context, in *ListShortcutAnalyticsSharesRequest, opts ...grpc.CallOption) (*ListShortcutAnalyticsSharesResponse, error)
+	// DeleteShortcutAnalyticsShare revokes an analytics share link of the shortcut.
+	DeleteShortcutAnalyticsShare(ctx context.Context, in *DeleteShortcutAnalyticsShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetSharedShortcutAnalytics returns the analytics of the shortcut of a share link, and counts the view.
+	GetSharedShortcutAnalytics(ctx context.Context, in *GetSharedShortcutAnalyticsRequest, opts ...grpc.CallOption) (*SharedShortcutAnalytics, error)
+	// ListProposedChanges returns the changes proposed to the protected shortcut, with the previous and proposed values
+	// of the fields to show as a diff. The creator and admins get all of them, the other users their own.
+	ListProposedChanges(ctx context.Context, in *ListProposedChangesRequest, opts ...grpc.CallOption) (*ListProposedChangesResponse, error)
+	// ApproveProposedChange applies a pending change proposed to the shortcut. Only for the creator and admins.
+	ApproveProposedChange(ctx context.Context, in *ApproveProposedChangeRequest, opts ...grpc.CallOption) (*ProposedChange, error)
+	// RejectProposedChange rejects a pending change proposed to the shortcut. Only for the creator and admins.
+	RejectProposedChange(ctx context.Context, in *RejectProposedChangeRequest, opts ...grpc.CallOption) (*ProposedChange, error)
+	// ListShortcutRotations returns the rotation calendar of the shortcut, the latest first.
+	ListShortcutRotations(ctx context.Context, in *ListShortcutRotationsRequest, opts ...grpc.CallOption) (*ListShortcutRotationsResponse, error)
+	// CreateShortcutRotation schedules a link for the shortcut to resolve to during a time range.
+	// Only for the creator and admins.
+	CreateShortcutRotation(ctx context.Context, in *CreateShortcutRotationRequest, opts ...grpc.CallOption) (*ShortcutRotation, error)
+	// DeleteShortcutRotation removes a rotation from the calendar of the shortcut. Only for the creator and admins.
+	DeleteShortcutRotation(ctx context.Context, in *DeleteShortcutRotationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListShortcutACLs returns the users the shortcut is shared with. Only for the creator and admins.
+	ListShortcutACLs(ctx context.Context, in *ListShortcutACLsRequest, opts ...grpc.CallOption) (*ListShortcutACLsResponse, error)
+	// UpsertShortcutACL shares the shortcut with a user, or changes the role of the user.
+	// Only for the creator and admins.
+	UpsertShortcutACL(ctx context.Context, in *UpsertShortcutACLRequest, opts ...grpc.CallOption) (*ShortcutACL, error)
+	// DeleteShortcutACL stops sharing the shortcut with a user. Only for the creator and admins.
+	DeleteShortcutACL(ctx context.Context, in *DeleteShortcutACLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetTrendingShortcuts returns the shortcuts with the largest view growth over the window.
+	GetTrendingShortcuts(ctx context.Context, in *GetTrendingShortcutsRequest, opts ...grpc.CallOption) (*GetTrendingShortcutsResponse, error)
+	// GetShortcutQRCode returns the QR code image of the short link of the shortcut,
+	// with the branding of the workspace in the center when it's set.
+	GetShortcutQRCode(ctx context.Context, in *GetShortcutQRCodeRequest, opts ...grpc.CallOption) (*GetShortcutQRCodeResponse, error)
+	// ListBrokenShortcuts returns the shortcuts the user can view whose link failed its last health checks.
+	ListBrokenShortcuts(ctx context.Context, in *ListBrokenShortcutsRequest, opts ...grpc.CallOption) (*ListBrokenShortcutsResponse, error)
+	// RefreshShortcutMetadata fetches the title, description, image and favicon of the link of the shortcut again
+	// into its Open Graph metadata.
+	RefreshShortcutMetadata(ctx context.Context, in *RefreshShortcutMetadataRequest, opts ...grpc.CallOption) (*Shortcut, error)
+	// SuggestShortcut suggests the title, description and tags of a new shortcut from the page of its link,
+	// with the completion provider of the workspace. The suggestions aren't saved.
+	SuggestShortcut(ctx context.Context, in *SuggestShortcutRequest, opts ...grpc.CallOption) (*SuggestShortcutResponse, error)
+	// SemanticSearchShortcuts returns the shortcuts the user can view whose meaning is the closest to the query,
+	// e.g. "billing dashboard", with the embeddings of the workspace, even without the exact words.
+	SemanticSearchShortcuts(ctx context.Context, in *SemanticSearchShortcutsRequest, opts ...grpc.CallOption) (*SemanticSearchShortcutsResponse, error)
+	// GenerateSignedRedirect returns a short link of the shortcut signed until it expires, e.g. for transactional emails,
+	// which redirects right away: also the visitors who can't view the shortcut, and without confirming the external
+	// redirects. Only for its creator and admins.
+	GenerateSignedRedirect(ctx context.Context, in *GenerateSignedRedirectRequest, opts ...grpc.CallOption) (*GenerateSignedRedirectResponse, error)
+	// GetResolutionSnapshot returns the links the shortcuts of the user redirect to, signed, for the clients to
+	// cache and resolve them offline. With since_version, it returns the delta from that snapshot when possible.
+	GetResolutionSnapshot(ctx context.Context, in *GetResolutionSnapshotRequest, opts ...grpc.CallOption) (*ResolutionSnapshot, error)
+	// CreateImportJob creates a job to import the shortcuts of a file as the user in the background,
+	// throttled by the import quota of the user.
+	CreateImportJob(ctx context.Context, in *CreateImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	// GetImportJob returns the progress and the row errors of an import job. Only for its creator and admins.
+	GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	// ListImportJobs returns the import jobs of the user, the latest first, without their row errors.
+	ListImportJobs(ctx context.Context, in *ListImportJobsRequest, opts ...grpc.CallOption) (*ListImportJobsResponse, error)
+	// ResumeImportJob queues a failed import job again, to continue from the row after the last processed one.
+	// Only for its creator and admins.
+	ResumeImportJob(ctx context.Context, in *ResumeImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
 }
 
 type shortcutServiceClient struct {
@@ -67,6 +178,46 @@ func (c *shortcutServiceClient) ListShortcuts(ctx context.Context, in *ListShort
 	return out, nil
 }
 
+func (c *shortcutServiceClient) SearchShortcuts(ctx context.Context, in *SearchShortcutsRequest, opts ...grpc.CallOption) (*SearchShortcutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_SearchShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) BulkUpdateShortcutTags(ctx context.Context, in *BulkUpdateShortcutTagsRequest, opts ...grpc.CallOption) (*BulkUpdateShortcutTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateShortcutTagsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_BulkUpdateShortcutTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) MergeShortcuts(ctx context.Context, in *MergeShortcutsRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_MergeShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ValidateLinks(ctx context.Context, in *ValidateLinksRequest, opts ...grpc.CallOption) (*ValidateLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateLinksResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ValidateLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortcutServiceClient) GetShortcut(ctx context.Context, in *GetShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Shortcut)
@@ -84,241 +235,1272 @@ func (c *shortcutServiceClient) GetShortcutByName(ctx context.Context, in *GetSh
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutSuggestions(ctx context.Context, in *ListShortcutSuggestionsRequest, opts ...grpc.CallOption) (*ListShortcutSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutSuggestionsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ResolvePreview(ctx context.Context, in *ResolvePreviewRequest, opts ...grpc.CallOption) (*ResolvePreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolvePreviewResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ResolvePreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_CreateShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_UpdateShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShortcutService_DeleteShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) TransferShortcut(ctx context.Context, in *TransferShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_TransferShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShortcutAnalyticsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_GetShortcutAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) CreateShortcutAnalyticsShare(ctx context.Context, in *CreateShortcutAnalyticsShareRequest, opts ...grpc.CallOption) (*ShortcutAnalyticsShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortcutAnalyticsShare)
+	err := c.cc.Invoke(ctx, ShortcutService_CreateShortcutAnalyticsShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutAnalyticsShares(ctx context.Context, in *ListShortcutAnalyticsSharesRequest, opts ...grpc.CallOption) (*ListShortcutAnalyticsSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutAnalyticsSharesResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutAnalyticsShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) DeleteShortcutAnalyticsShare(ctx context.Context, in *DeleteShortcutAnalyticsShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShortcutService_DeleteShortcutAnalyticsShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GetSharedShortcutAnalytics(ctx context.Context, in *GetSharedShortcutAnalyticsRequest, opts ...grpc.CallOption) (*SharedShortcutAnalytics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedShortcutAnalytics)
+	err := c.cc.Invoke(ctx, ShortcutService_GetSharedShortcutAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListProposedChanges(ctx context.Context, in *ListProposedChangesRequest, opts ...grpc.CallOption) (*ListProposedChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProposedChangesResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListProposedChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ApproveProposedChange(ctx context.Context, in *ApproveProposedChangeRequest, opts ...grpc.CallOption) (*ProposedChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposedChange)
+	err := c.cc.Invoke(ctx, ShortcutService_ApproveProposedChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) RejectProposedChange(ctx context.Context, in *RejectProposedChangeRequest, opts ...grpc.CallOption) (*ProposedChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposedChange)
+	err := c.cc.Invoke(ctx, ShortcutService_RejectProposedChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutRotations(ctx context.Context, in *ListShortcutRotationsRequest, opts ...grpc.CallOption) (*ListShortcutRotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutRotationsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutRotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) CreateShortcutRotation(ctx context.Context, in *CreateShortcutRotationRequest, opts ...grpc.CallOption) (*ShortcutRotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortcutRotation)
+	err := c.cc.Invoke(ctx, ShortcutService_CreateShortcutRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) DeleteShortcutRotation(ctx context.Context, in *DeleteShortcutRotationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShortcutService_DeleteShortcutRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutACLs(ctx context.Context, in *ListShortcutACLsRequest, opts ...grpc.CallOption) (*ListShortcutACLsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutACLsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutACLs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) UpsertShortcutACL(ctx context.Context, in *UpsertShortcutACLRequest, opts ...grpc.CallOption) (*ShortcutACL, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortcutACL)
+	err := c.cc.Invoke(ctx, ShortcutService_UpsertShortcutACL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) DeleteShortcutACL(ctx context.Context, in *DeleteShortcutACLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShortcutService_DeleteShortcutACL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GetTrendingShortcuts(ctx context.Context, in *GetTrendingShortcutsRequest, opts ...grpc.CallOption) (*GetTrendingShortcutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_GetTrendingShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GetShortcutQRCode(ctx context.Context, in *GetShortcutQRCodeRequest, opts ...grpc.CallOption) (*GetShortcutQRCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShortcutQRCodeResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_GetShortcutQRCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListBrokenShortcuts(ctx context.Context, in *ListBrokenShortcutsRequest, opts ...grpc.CallOption) (*ListBrokenShortcutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBrokenShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListBrokenShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) RefreshShortcutMetadata(ctx context.Context, in *RefreshShortcutMetadataRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_RefreshShortcutMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) SuggestShortcut(ctx context.Context, in *SuggestShortcutRequest, opts ...grpc.CallOption) (*SuggestShortcutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestShortcutResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_SuggestShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) SemanticSearchShortcuts(ctx context.Context, in *SemanticSearchShortcutsRequest, opts ...grpc.CallOption) (*SemanticSearchShortcutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SemanticSearchShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_SemanticSearchShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GenerateSignedRedirect(ctx context.Context, in *GenerateSignedRedirectRequest, opts ...grpc.CallOption) (*GenerateSignedRedirectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateSignedRedirectResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_GenerateSignedRedirect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GetResolutionSnapshot(ctx context.Context, in *GetResolutionSnapshotRequest, opts ...grpc.CallOption) (*ResolutionSnapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolutionSnapshot)
+	err := c.cc.Invoke(ctx, ShortcutService_GetResolutionSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) CreateImportJob(ctx context.Context, in *CreateImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, ShortcutService_CreateImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, ShortcutService_GetImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListImportJobs(ctx context.Context, in *ListImportJobsRequest, opts ...grpc.CallOption) (*ListImportJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImportJobsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListImportJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ResumeImportJob(ctx context.Context, in *ResumeImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, ShortcutService_ResumeImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShortcutServiceServer is the server API for ShortcutService service.
+// All implementations must embed UnimplementedShortcutServiceServer
+// for forward compatibility.
+type ShortcutServiceServer interface {
+	// ListShortcuts returns a list of shortcuts.
+	ListShortcuts(context.Context, *ListShortcutsRequest) (*ListShortcutsResponse, error)
+	// SearchShortcuts returns the shortcuts matching the query, ordered by relevance.
+	SearchShortcuts(context.Context, *SearchShortcutsRequest) (*SearchShortcutsResponse, error)
+	// BulkUpdateShortcutTags adds, removes or replaces a tag of the shortcuts matching the filter. Only for admins.
+	BulkUpdateShortcutTags(context.Context, *BulkUpdateShortcutTagsRequest) (*BulkUpdateShortcutTagsResponse, error)
+	// MergeShortcuts merges duplicate shortcuts into a survivor, whose aliases the other names become. Only for admins.
+	MergeShortcuts(context.Context, *MergeShortcutsRequest) (*Shortcut, error)
+	// ValidateLinks checks the syntax of the links, normalizes them with the link parameter rules of the workspace,
+	// and optionally checks whether they're reachable, e.g. before importing shortcuts.
+	ValidateLinks(context.Context, *ValidateLinksRequest) (*ValidateLinksResponse, error)
+	// GetShortcut returns a shortcut by id.
+	GetShortcut(context.Context, *GetShortcutRequest) (*Shortcut, error)
+	// GetShortcutByName returns a shortcut by name.
+	// When it's not found, the error has ShortcutNotFoundDetails with the names of similar shortcuts.
+	GetShortcutByName(context.Context, *GetShortcutByNameRequest) (*Shortcut, error)
+	// ListShortcutSuggestions returns the names of the shortcuts close to a name, e.g. a mistyped one.
+	ListShortcutSuggestions(context.Context, *ListShortcutSuggestionsRequest) (*ListShortcutSuggestionsResponse, error)
+	// ResolvePreview returns how visiting the shortcut would resolve in the simulated context,
+	// without redirecting or recording the view.
+	ResolvePreview(context.Context, *ResolvePreviewRequest) (*ResolvePreviewResponse, error)
+	// CreateShortcut creates a shortcut.
+	CreateShortcut(context.Context, *CreateShortcutRequest) (*Shortcut, error)
+	// UpdateShortcut updates a shortcut.
+	UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error)
+	// DeleteShortcut deletes a shortcut by name.
+	DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error)
+	// TransferShortcut transfers the ownership of a shortcut to another user. Only for its creator and admins.
+	TransferShortcut(context.Context, *TransferShortcutRequest) (*Shortcut, error)
+	// GetShortcutAnalytics returns the analytics for a shortcut.
+	GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error)
+	// CreateShortcutAnalyticsShare creates a read-only link to view the analytics of the shortcut without an account.
+	CreateShortcutAnalyticsShare(context.Context, *CreateShortcutAnalyticsShareRequest) (*ShortcutAnalyticsShare, error)
+	// ListShortcutAnalyticsShares returns the analytics share links of the shortcut.
+	ListShortcutAnalyticsShares(context.Context, *ListShortcutAnalyticsSharesRequest) (*ListShortcutAnalyticsSharesResponse, error)
+	// DeleteShortcutAnalyticsShare revokes an analytics share link of the shortcut.
+	DeleteShortcutAnalyticsShare(context.Context, *DeleteShortcutAnalyticsShareRequest) (*emptypb.Empty, error)
+	// GetSharedShortcutAnalytics returns the analytics of the shortcut of a share link, and counts the view.
+	GetSharedShortcutAnalytics(context.Context, *GetSharedShortcutAnalyticsRequest) (*SharedShortcutAnalytics, error)
+	// ListProposedChanges returns the changes proposed to the protected shortcut, with the previous and proposed values
+	// of the fields to show as a diff. The creator and admins get all of them, the other users their own.
+	ListProposedChanges(context.Context, *ListProposedChangesRequest) (*ListProposedChangesResponse, error)
+	// ApproveProposedChange applies a pending change proposed to the shortcut. Only for the creator and admins.
+	ApproveProposedChange(context.Context, *ApproveProposedChangeRequest) (*ProposedChange, error)
+	// RejectProposedChange rejects a pending change proposed to the shortcut. Only for the creator and admins.
+	RejectProposedChange(context.Context, *RejectProposedChangeRequest) (*ProposedChange, error)
+	// ListShortcutRotations returns the rotation calendar of the shortcut, the latest first.
+	ListShortcutRotations(context.Context, *ListShortcutRotationsRequest) (*ListShortcutRotationsResponse, error)
+	// CreateShortcutRotation schedules a link for the shortcut to resolve to during a time range.
+	// Only for the creator and admins.
+	CreateShortcutRotation(context.Context, *CreateShortcutRotationRequest) (*ShortcutRotation, error)
+	// DeleteShortcutRotation removes a rotation from the calendar of the shortcut. Only for the creator and admins.
+	DeleteShortcutRotation(context.Context, *DeleteShortcutRotationRequest) (*emptypb.Empty, error)
+	// ListShortcutACLs returns the users the shortcut is shared with. Only for the creator and admins.
+	ListShortcutACLs(context.Context, *ListShortcutACLsRequest) (*ListShortcutACLsResponse, error)
+	// UpsertShortcutACL shares the shortcut with a user, or changes the role of the user.
+	// Only for the creator and admins.
+	UpsertShortcutACL(context.Context, *UpsertShortcutACLRequest) (*ShortcutACL, error)
+	// DeleteShortcutACL stops sharing the shortcut with a user. Only for the creator and admins.
+	DeleteShortcutACL(context.Context, *DeleteShortcutACLRequest) (*emptypb.Empty, error)
+	// GetTrendingShortcuts returns the shortcuts with the largest view growth over the window.
+	GetTrendingShortcuts(context.Context, *GetTrendingShortcutsRequest) (*GetTrendingShortcutsResponse, error)
+	// GetShortcutQRCode returns the QR code image of the short link of the shortcut,
+	// with the branding of the workspace in the center when it's set.
+	GetShortcutQRCode(context.Context, *GetShortcutQRCodeRequest) (*GetShortcutQRCodeResponse, error)
+	// ListBrokenShortcuts returns the shortcuts the user can view whose link failed its last health checks.
+	ListBrokenShortcuts(context.Context, *ListBrokenShortcutsRequest) (*ListBrokenShortcutsResponse, error)
+	// RefreshShortcutMetadata fetches the title, description, image and favicon of the link of the shortcut again
+	// into its Open Graph metadata.
+	RefreshShortcutMetadata(context.Context, *RefreshShortcutMetadataRequest) (*Shortcut, error)
+	// SuggestShortcut suggests the title, description and tags of a new shortcut from the page of its link,
+	// with the completion provider of the workspace. The suggestions aren't saved.
+	SuggestShortcut(context.Context, *SuggestShortcutRequest) (*SuggestShortcutResponse, error)
+	// SemanticSearchShortcuts returns the shortcuts the user can view whose meaning is the closest to the query,
+	// e.g. "billing dashboard", with the embeddings of the workspace, even without the exact words.
+	SemanticSearchShortcuts(context.Context, *SemanticSearchShortcutsRequest) (*SemanticSearchShortcutsResponse, error)
+	// GenerateSignedRedirect returns a short link of the shortcut signed until it expires, e.g. for transactional emails,
+	// which redirects right away: also the visitors who can't view the shortcut, and without confirming the external
+	// redirects. Only for its creator and admins.
+	GenerateSignedRedirect(context.Context, *GenerateSignedRedirectRequest) (*GenerateSignedRedirectResponse, error)
+	// GetResolutionSnapshot returns the links the shortcuts of the user redirect to, signed, for the clients to
+	// cache and resolve them offline. With since_version, it returns the delta from that snapshot when possible.
+	GetResolutionSnapshot(context.Context, *GetResolutionSnapshotRequest) (*ResolutionSnapshot, error)
+	// CreateImportJob creates a job to import the shortcuts of a file as the user in the background,
+	// throttled by the import quota of the user.
+	CreateImportJob(context.Context, *CreateImportJobRequest) (*ImportJob, error)
+	// GetImportJob returns the progress and the row errors of an import job. Only for its creator and admins.
+	GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error)
+	// ListImportJobs returns the import jobs of the user, the latest first, without their row errors.
+	ListImportJobs(context.Context, *ListImportJobsRequest) (*ListImportJobsResponse, error)
+	// ResumeImportJob queues a failed import job again, to continue from the row after the last processed one.
+	// Only for its creator and admins.
+	ResumeImportJob(context.Context, *ResumeImportJobRequest) (*ImportJob, error)
+	mustEmbedUnimplementedShortcutServiceServer()
+}
+
+// UnimplementedShortcutServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShortcutServiceServer struct{}
+
+func (UnimplementedShortcutServiceServer) ListShortcuts(context.Context, *ListShortcutsRequest) (*ListShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) SearchShortcuts(context.Context, *SearchShortcutsRequest) (*SearchShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) BulkUpdateShortcutTags(context.Context, *BulkUpdateShortcutTagsRequest) (*BulkUpdateShortcutTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateShortcutTags not implemented")
+}
+func (UnimplementedShortcutServiceServer) MergeShortcuts(context.Context, *MergeShortcutsRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) ValidateLinks(context.Context, *ValidateLinksRequest) (*ValidateLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateLinks not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetShortcut(context.Context, *GetShortcutRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetShortcutByName(context.Context, *GetShortcutByNameRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutByName not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutSuggestions(context.Context, *ListShortcutSuggestionsRequest) (*ListShortcutSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutSuggestions not implemented")
+}
+func (UnimplementedShortcutServiceServer) ResolvePreview(context.Context, *ResolvePreviewRequest) (*ResolvePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePreview not implemented")
+}
+func (UnimplementedShortcutServiceServer) CreateShortcut(context.Context, *CreateShortcutRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) TransferShortcut(context.Context, *TransferShortcutRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutAnalytics not implemented")
+}
+func (UnimplementedShortcutServiceServer) CreateShortcutAnalyticsShare(context.Context, *CreateShortcutAnalyticsShareRequest) (*ShortcutAnalyticsShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShortcutAnalyticsShare not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutAnalyticsShares(context.Context, *ListShortcutAnalyticsSharesRequest) (*ListShortcutAnalyticsSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutAnalyticsShares not implemented")
+}
+func (UnimplementedShortcutServiceServer) DeleteShortcutAnalyticsShare(context.Context, *DeleteShortcutAnalyticsShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortcutAnalyticsShare not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetSharedShortcutAnalytics(context.Context, *GetSharedShortcutAnalyticsRequest) (*SharedShortcutAnalytics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedShortcutAnalytics not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListProposedChanges(context.Context, *ListProposedChangesRequest) (*ListProposedChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProposedChanges not implemented")
+}
+func (UnimplementedShortcutServiceServer) ApproveProposedChange(context.Context, *ApproveProposedChangeRequest) (*ProposedChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveProposedChange not implemented")
+}
+func (UnimplementedShortcutServiceServer) RejectProposedChange(context.Context, *RejectProposedChangeRequest) (*ProposedChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectProposedChange not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutRotations(context.Context, *ListShortcutRotationsRequest) (*ListShortcutRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutRotations not implemented")
+}
+func (UnimplementedShortcutServiceServer) CreateShortcutRotation(context.Context, *CreateShortcutRotationRequest) (*ShortcutRotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShortcutRotation not implemented")
+}
+func (UnimplementedShortcutServiceServer) DeleteShortcutRotation(context.Context, *DeleteShortcutRotationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortcutRotation not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutACLs(context.Context, *ListShortcutACLsRequest) (*ListShortcutACLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutACLs not implemented")
+}
+func (UnimplementedShortcutServiceServer) UpsertShortcutACL(context.Context, *UpsertShortcutACLRequest) (*ShortcutACL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertShortcutACL not implemented")
+}
+func (UnimplementedShortcutServiceServer) DeleteShortcutACL(context.Context, *DeleteShortcutACLRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortcutACL not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetTrendingShortcuts(context.Context, *GetTrendingShortcutsRequest) (*GetTrendingShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetShortcutQRCode(context.Context, *GetShortcutQRCodeRequest) (*GetShortcutQRCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutQRCode not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListBrokenShortcuts(context.Context, *ListBrokenShortcutsRequest) (*ListBrokenShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBrokenShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) RefreshShortcutMetadata(context.Context, *RefreshShortcutMetadataRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshShortcutMetadata not implemented")
+}
+func (UnimplementedShortcutServiceServer) SuggestShortcut(context.Context, *SuggestShortcutRequest) (*SuggestShortcutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) SemanticSearchShortcuts(context.Context, *SemanticSearchShortcutsRequest) (*SemanticSearchShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SemanticSearchShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) GenerateSignedRedirect(context.Context, *GenerateSignedRedirectRequest) (*GenerateSignedRedirectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSignedRedirect not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetResolutionSnapshot(context.Context, *GetResolutionSnapshotRequest) (*ResolutionSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResolutionSnapshot not implemented")
+}
+func (UnimplementedShortcutServiceServer) CreateImportJob(context.Context, *CreateImportJobRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateImportJob not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListImportJobs(context.Context, *ListImportJobsRequest) (*ListImportJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImportJobs not implemented")
+}
+func (UnimplementedShortcutServiceServer) ResumeImportJob(context.Context, *ResumeImportJobRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeImportJob not implemented")
+}
+func (UnimplementedShortcutServiceServer) mustEmbedUnimplementedShortcutServiceServer() {}
+func (UnimplementedShortcutServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeShortcutServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShortcutServiceServer will
+// result in compilation errors.
+type UnsafeShortcutServiceServer interface {
+	mustEmbedUnimplementedShortcutServiceServer()
+}
+
+func RegisterShortcutServiceServer(s grpc.ServiceRegistrar, srv ShortcutServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShortcutServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShortcutService_ServiceDesc, srv)
+}
+
+func _ShortcutService_ListShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcuts(ctx, req.(*ListShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_SearchShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).SearchShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_SearchShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).SearchShortcuts(ctx, req.(*SearchShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_BulkUpdateShortcutTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateShortcutTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).BulkUpdateShortcutTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_BulkUpdateShortcutTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).BulkUpdateShortcutTags(ctx, req.(*BulkUpdateShortcutTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_MergeShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).MergeShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_MergeShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).MergeShortcuts(ctx, req.(*MergeShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ValidateLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ValidateLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ValidateLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ValidateLinks(ctx, req.(*ValidateLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_GetShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GetShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GetShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GetShortcut(ctx, req.(*GetShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_GetShortcutByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortcutByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GetShortcutByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GetShortcutByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GetShortcutByName(ctx, req.(*GetShortcutByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutSuggestions(ctx, req.(*ListShortcutSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ResolvePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ResolvePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ResolvePreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ResolvePreview(ctx, req.(*ResolvePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_CreateShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).CreateShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_CreateShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).CreateShortcut(ctx, req.(*CreateShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_UpdateShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).UpdateShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_UpdateShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).UpdateShortcut(ctx, req.(*UpdateShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_DeleteShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).DeleteShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_DeleteShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).DeleteShortcut(ctx, req.(*DeleteShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_TransferShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).TransferShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_TransferShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).TransferShortcut(ctx, req.(*TransferShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_GetShortcutAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortcutAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GetShortcutAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GetShortcutAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GetShortcutAnalytics(ctx, req.(*GetShortcutAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_CreateShortcutAnalyticsShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShortcutAnalyticsShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).CreateShortcutAnalyticsShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_CreateShortcutAnalyticsShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).CreateShortcutAnalyticsShare(ctx, req.(*CreateShortcutAnalyticsShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutAnalyticsShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutAnalyticsSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutAnalyticsShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutAnalyticsShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutAnalyticsShares(ctx, req.(*ListShortcutAnalyticsSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_DeleteShortcutAnalyticsShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShortcutAnalyticsShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).DeleteShortcutAnalyticsShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_DeleteShortcutAnalyticsShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).DeleteShortcutAnalyticsShare(ctx, req.(*DeleteShortcutAnalyticsShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_GetSharedShortcutAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedShortcutAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GetSharedShortcutAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GetSharedShortcutAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GetSharedShortcutAnalytics(ctx, req.(*GetSharedShortcutAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListProposedChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProposedChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListProposedChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListProposedChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListProposedChanges(ctx, req.(*ListProposedChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ApproveProposedChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveProposedChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ApproveProposedChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ApproveProposedChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ApproveProposedChange(ctx, req.(*ApproveProposedChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_RejectProposedChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectProposedChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RejectProposedChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RejectProposedChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RejectProposedChange(ctx, req.(*RejectProposedChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutRotations(ctx, req.(*ListShortcutRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_CreateShortcutRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShortcutRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).CreateShortcutRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_CreateShortcutRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).CreateShortcutRotation(ctx, req.(*CreateShortcutRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_DeleteShortcutRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShortcutRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).DeleteShortcutRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_DeleteShortcutRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).DeleteShortcutRotation(ctx, req.(*DeleteShortcutRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutACLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutACLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutACLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutACLs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutACLs(ctx, req.(*ListShortcutACLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shortcutServiceClient) CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Shortcut)
-	err := c.cc.Invoke(ctx, ShortcutService_CreateShortcut_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ShortcutService_UpsertShortcutACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertShortcutACLRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).UpsertShortcutACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_UpsertShortcutACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).UpsertShortcutACL(ctx, req.(*UpsertShortcutACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shortcutServiceClient) UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Shortcut)
-	err := c.cc.Invoke(ctx, ShortcutService_UpdateShortcut_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ShortcutService_DeleteShortcutACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShortcutACLRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).DeleteShortcutACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_DeleteShortcutACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).DeleteShortcutACL(ctx, req.(*DeleteShortcutACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shortcutServiceClient) DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, ShortcutService_DeleteShortcut_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ShortcutService_GetTrendingShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingShortcutsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GetTrendingShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GetTrendingShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GetTrendingShortcuts(ctx, req.(*GetTrendingShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shortcutServiceClient) GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetShortcutAnalyticsResponse)
-	err := c.cc.Invoke(ctx, ShortcutService_GetShortcutAnalytics_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ShortcutService_GetShortcutQRCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortcutQRCodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ShortcutServiceServer is the server API for ShortcutService service.
-// All implementations must embed UnimplementedShortcutServiceServer
-// for forward compatibility.
-type ShortcutServiceServer interface {
-	// ListShortcuts returns a list of shortcuts.
-	ListShortcuts(context.Context, *ListShortcutsRequest) (*ListShortcutsResponse, error)
-	// GetShortcut returns a shortcut by id.
-	GetShortcut(context.Context, *GetShortcutRequest) (*Shortcut, error)
-	// GetShortcutByName returns a shortcut by name.
-	GetShortcutByName(context.Context, *GetShortcutByNameRequest) (*Shortcut, error)
-	// CreateShortcut creates a shortcut.
-	CreateShortcut(context.Context, *CreateShortcutRequest) (*Shortcut, error)
-	// UpdateShortcut updates a shortcut.
-	UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error)
-	// DeleteShortcut deletes a shortcut by name.
-	DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error)
-	// GetShortcutAnalytics returns the analytics for a shortcut.
-	GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error)
-	mustEmbedUnimplementedShortcutServiceServer()
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GetShortcutQRCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GetShortcutQRCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GetShortcutQRCode(ctx, req.(*GetShortcutQRCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedShortcutServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedShortcutServiceServer struct{}
-
-func (UnimplementedShortcutServiceServer) ListShortcuts(context.Context, *ListShortcutsRequest) (*ListShortcutsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListShortcuts not implemented")
-}
-func (UnimplementedShortcutServiceServer) GetShortcut(context.Context, *GetShortcutRequest) (*Shortcut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetShortcut not implemented")
-}
-func (UnimplementedShortcutServiceServer) GetShortcutByName(context.Context, *GetShortcutByNameRequest) (*Shortcut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutByName not implemented")
-}
-func (UnimplementedShortcutServiceServer) CreateShortcut(context.Context, *CreateShortcutRequest) (*Shortcut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateShortcut not implemented")
-}
-func (UnimplementedShortcutServiceServer) UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateShortcut not implemented")
-}
-func (UnimplementedShortcutServiceServer) DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortcut not implemented")
-}
-func (UnimplementedShortcutServiceServer) GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutAnalytics not implemented")
+func _ShortcutService_ListBrokenShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBrokenShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListBrokenShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListBrokenShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListBrokenShortcuts(ctx, req.(*ListBrokenShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedShortcutServiceServer) mustEmbedUnimplementedShortcutServiceServer() {}
-func (UnimplementedShortcutServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeShortcutServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ShortcutServiceServer will
-// result in compilation errors.
-type UnsafeShortcutServiceServer interface {
-	mustEmbedUnimplementedShortcutServiceServer()
+func _ShortcutService_RefreshShortcutMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshShortcutMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RefreshShortcutMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RefreshShortcutMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RefreshShortcutMetadata(ctx, req.(*RefreshShortcutMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterShortcutServiceServer(s grpc.ServiceRegistrar, srv ShortcutServiceServer) {
-	// If the following call pancis, it indicates UnimplementedShortcutServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ShortcutService_SuggestShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ShortcutService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).SuggestShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_SuggestShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).SuggestShortcut(ctx, req.(*SuggestShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortcutService_ListShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListShortcutsRequest)
+func _ShortcutService_SemanticSearchShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SemanticSearchShortcutsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortcutServiceServer).ListShortcuts(ctx, in)
+		return srv.(ShortcutServiceServer).SemanticSearchShortcuts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortcutService_ListShortcuts_FullMethodName,
+		FullMethod: ShortcutService_SemanticSearchShortcuts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortcutServiceServer).ListShortcuts(ctx, req.(*ListShortcutsRequest))
+		return srv.(ShortcutServiceServer).SemanticSearchShortcuts(ctx, req.(*SemanticSearchShortcutsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortcutService_GetShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetShortcutRequest)
+func _ShortcutService_GenerateSignedRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSignedRedirectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortcutServiceServer).GetShortcut(ctx, in)
+		return srv.(ShortcutServiceServer).GenerateSignedRedirect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortcutService_GetShortcut_FullMethodName,
+		FullMethod: ShortcutService_GenerateSignedRedirect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortcutServiceServer).GetShortcut(ctx, req.(*GetShortcutRequest))
+		return srv.(ShortcutServiceServer).GenerateSignedRedirect(ctx, req.(*GenerateSignedRedirectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortcutService_GetShortcutByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetShortcutByNameRequest)
+func _ShortcutService_GetResolutionSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResolutionSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortcutServiceServer).GetShortcutByName(ctx, in)
+		return srv.(ShortcutServiceServer).GetResolutionSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortcutService_GetShortcutByName_FullMethodName,
+		FullMethod: ShortcutService_GetResolutionSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortcutServiceServer).GetShortcutByName(ctx, req.(*GetShortcutByNameRequest))
+		return srv.(ShortcutServiceServer).GetResolutionSnapshot(ctx, req.(*GetResolutionSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortcutService_CreateShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateShortcutRequest)
+func _ShortcutService_CreateImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateImportJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortcutServiceServer).CreateShortcut(ctx, in)
+		return srv.(ShortcutServiceServer).CreateImportJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortcutService_CreateShortcut_FullMethodName,
+		FullMethod: ShortcutService_CreateImportJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortcutServiceServer).CreateShortcut(ctx, req.(*CreateShortcutRequest))
+		return srv.(ShortcutServiceServer).CreateImportJob(ctx, req.(*CreateImportJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortcutService_UpdateShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateShortcutRequest)
+func _ShortcutService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortcutServiceServer).UpdateShortcut(ctx, in)
+		return srv.(ShortcutServiceServer).GetImportJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortcutService_UpdateShortcut_FullMethodName,
+		FullMethod: ShortcutService_GetImportJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortcutServiceServer).UpdateShortcut(ctx, req.(*UpdateShortcutRequest))
+		return srv.(ShortcutServiceServer).GetImportJob(ctx, req.(*GetImportJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortcutService_DeleteShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteShortcutRequest)
+func _ShortcutService_ListImportJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImportJobsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortcutServiceServer).DeleteShortcut(ctx, in)
+		return srv.(ShortcutServiceServer).ListImportJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortcutService_DeleteShortcut_FullMethodName,
+		FullMethod: ShortcutService_ListImportJobs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortcutServiceServer).DeleteShortcut(ctx, req.(*DeleteShortcutRequest))
+		return srv.(ShortcutServiceServer).ListImportJobs(ctx, req.(*ListImportJobsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortcutService_GetShortcutAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetShortcutAnalyticsRequest)
+func _ShortcutService_ResumeImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeImportJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortcutServiceServer).GetShortcutAnalytics(ctx, in)
+		return srv.(ShortcutServiceServer).ResumeImportJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortcutService_GetShortcutAnalytics_FullMethodName,
+		FullMethod: ShortcutService_ResumeImportJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortcutServiceServer).GetShortcutAnalytics(ctx, req.(*GetShortcutAnalyticsRequest))
+		return srv.(ShortcutServiceServer).ResumeImportJob(ctx, req.(*ResumeImportJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -334,6 +1516,22 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListShortcuts",
 			Handler:    _ShortcutService_ListShortcuts_Handler,
 		},
+		{
+			MethodName: "SearchShortcuts",
+			Handler:    _ShortcutService_SearchShortcuts_Handler,
+		},
+		{
+			MethodName: "BulkUpdateShortcutTags",
+			Handler:    _ShortcutService_BulkUpdateShortcutTags_Handler,
+		},
+		{
+			MethodName: "MergeShortcuts",
+			Handler:    _ShortcutService_MergeShortcuts_Handler,
+		},
+		{
+			MethodName: "ValidateLinks",
+			Handler:    _ShortcutService_ValidateLinks_Handler,
+		},
 		{
 			MethodName: "GetShortcut",
 			Handler:    _ShortcutService_GetShortcut_Handler,
@@ -342,6 +1540,14 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetShortcutByName",
 			Handler:    _ShortcutService_GetShortcutByName_Handler,
 		},
+		{
+			MethodName: "ListShortcutSuggestions",
+			Handler:    _ShortcutService_ListShortcutSuggestions_Handler,
+		},
+		{
+			MethodName: "ResolvePreview",
+			Handler:    _ShortcutService_ResolvePreview_Handler,
+		},
 		{
 			MethodName: "CreateShortcut",
 			Handler:    _ShortcutService_CreateShortcut_Handler,
@@ -354,10 +1560,114 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteShortcut",
 			Handler:    _ShortcutService_DeleteShortcut_Handler,
 		},
+		{
+			MethodName: "TransferShortcut",
+			Handler:    _ShortcutService_TransferShortcut_Handler,
+		},
 		{
 			MethodName: "GetShortcutAnalytics",
 			Handler:    _ShortcutService_GetShortcutAnalytics_Handler,
 		},
+		{
+			MethodName: "CreateShortcutAnalyticsShare",
+			Handler:    _ShortcutService_CreateShortcutAnalyticsShare_Handler,
+		},
+		{
+			MethodName: "ListShortcutAnalyticsShares",
+			Handler:    _ShortcutService_ListShortcutAnalyticsShares_Handler,
+		},
+		{
+			MethodName: "DeleteShortcutAnalyticsShare",
+			Handler:    _ShortcutService_DeleteShortcutAnalyticsShare_Handler,
+		},
+		{
+			MethodName: "GetSharedShortcutAnalytics",
+			Handler:    _ShortcutService_GetSharedShortcutAnalytics_Handler,
+		},
+		{
+			MethodName: "ListProposedChanges",
+			Handler:    _ShortcutService_ListProposedChanges_Handler,
+		},
+		{
+			MethodName: "ApproveProposedChange",
+			Handler:    _ShortcutService_ApproveProposedChange_Handler,
+		},
+		{
+			MethodName: "RejectProposedChange",
+			Handler:    _ShortcutService_RejectProposedChange_Handler,
+		},
+		{
+			MethodName: "ListShortcutRotations",
+			Handler:    _ShortcutService_ListShortcutRotations_Handler,
+		},
+		{
+			MethodName: "CreateShortcutRotation",
+			Handler:    _ShortcutService_CreateShortcutRotation_Handler,
+		},
+		{
+			MethodName: "DeleteShortcutRotation",
+			Handler:    _ShortcutService_DeleteShortcutRotation_Handler,
+		},
+		{
+			MethodName: "ListShortcutACLs",
+			Handler:    _ShortcutService_ListShortcutACLs_Handler,
+		},
+		{
+			MethodName: "UpsertShortcutACL",
+			Handler:    _ShortcutService_UpsertShortcutACL_Handler,
+		},
+		{
+			MethodName: "DeleteShortcutACL",
+			Handler:    _ShortcutService_DeleteShortcutACL_Handler,
+		},
+		{
+			MethodName: "GetTrendingShortcuts",
+			Handler:    _ShortcutService_GetTrendingShortcuts_Handler,
+		},
+		{
+			MethodName: "GetShortcutQRCode",
+			Handler:    _ShortcutService_GetShortcutQRCode_Handler,
+		},
+		{
+			MethodName: "ListBrokenShortcuts",
+			Handler:    _ShortcutService_ListBrokenShortcuts_Handler,
+		},
+		{
+			MethodName: "RefreshShortcutMetadata",
+			Handler:    _ShortcutService_RefreshShortcutMetadata_Handler,
+		},
+		{
+			MethodName: "SuggestShortcut",
+			Handler:    _ShortcutService_SuggestShortcut_Handler,
+		},
+		{
+			MethodName: "SemanticSearchShortcuts",
+			Handler:    _ShortcutService_SemanticSearchShortcuts_Handler,
+		},
+		{
+			MethodName: "GenerateSignedRedirect",
+			Handler:    _ShortcutService_GenerateSignedRedirect_Handler,
+		},
+		{
+			MethodName: "GetResolutionSnapshot",
+			Handler:    _ShortcutService_GetResolutionSnapshot_Handler,
+		},
+		{
+			MethodName: "CreateImportJob",
+			Handler:    _ShortcutService_CreateImportJob_Handler,
+		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _ShortcutService_GetImportJob_Handler,
+		},
+		{
+			MethodName: "ListImportJobs",
+			Handler:    _ShortcutService_ListImportJobs_Handler,
+		},
+		{
+			MethodName: "ResumeImportJob",
+			Handler:    _ShortcutService_ResumeImportJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/shortcut_service.proto",
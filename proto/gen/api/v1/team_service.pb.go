@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.28.3
+// source: api/v1/team_service.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Team struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	// The unique name of the team, e.g. "engineering".
+	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Output only. The ids of the members, changed with AddTeamMember and RemoveTeamMember.
+	MemberIds     []int32 `protobuf:"varint,6,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_api_v1_team_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_team_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_api_v1_team_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Team) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Team) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *Team) GetUpdatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return nil
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Team) GetMemberIds() []int32 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_api_v1_team_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_team_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_team_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_api_v1_team_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_team_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_team_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_api_v1_team_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_team_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_team_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTeamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_api_v1_team_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_team_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_team_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type UpdateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamRequest) Reset() {
+	*x = UpdateTeamRequest{}
+	mi := &file_api_v1_team_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamRequest) ProtoMessage() {}
+
+func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_team_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_team_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *UpdateTeamRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_api_v1_team_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_team_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_team_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTeamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AddTeamMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the team.
+	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMemberRequest) Reset() {
+	*x = AddTeamMemberRequest{}
+	mi := &file_api_v1_team_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMemberRequest) ProtoMessage() {}
+
+func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_team_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_team_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddTeamMemberRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddTeamMemberRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveTeamMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the team.
+	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMemberRequest) Reset() {
+	*x = RemoveTeamMemberRequest{}
+	mi := &file_api_v1_team_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_team_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_team_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RemoveTeamMemberRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveTeamMemberRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+var File_api_v1_team_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_team_service_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/v1/team_service.proto\x12\fslash.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x01\n" +
+	"\x04Team\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12=\n" +
+	"\fcreated_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
+	"\fupdated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x06 \x03(\x05R\tmemberIds\"\x12\n" +
+	"\x10ListTeamsRequest\"=\n" +
+	"\x11ListTeamsResponse\x12(\n" +
+	"\x05teams\x18\x01 \x03(\v2\x12.slash.api.v1.TeamR\x05teams\" \n" +
+	"\x0eGetTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\";\n" +
+	"\x11CreateTeamRequest\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.slash.api.v1.TeamR\x04team\"x\n" +
+	"\x11UpdateTeamRequest\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.slash.api.v1.TeamR\x04team\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"#\n" +
+	"\x11DeleteTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"?\n" +
+	"\x14AddTeamMemberRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"B\n" +
+	"\x17RemoveTeamMemberRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId2\x9d\x06\n" +
+	"\vTeamService\x12c\n" +
+	"\tListTeams\x12\x1e.slash.api.v1.ListTeamsRequest\x1a\x1f.slash.api.v1.ListTeamsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/teams\x12\\\n" +
+	"\aGetTeam\x12\x1c.slash.api.v1.GetTeamRequest\x1a\x12.slash.api.v1.Team\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/teams/{id}\x12^\n" +
+	"\n" +
+	"CreateTeam\x12\x1f.slash.api.v1.CreateTeamRequest\x1a\x12.slash.api.v1.Team\"\x1b\x82\xd3\xe4\x93\x02\x15:\x04team\"\r/api/v1/teams\x12{\n" +
+	"\n" +
+	"UpdateTeam\x12\x1f.slash.api.v1.UpdateTeamRequest\x1a\x12.slash.api.v1.Team\"8\xdaA\x10team,update_mask\x82\xd3\xe4\x93\x02\x1f:\x04team2\x17/api/v1/teams/{team.id}\x12f\n" +
+	"\n" +
+	"DeleteTeam\x12\x1f.slash.api.v1.DeleteTeamRequest\x1a\x16.google.protobuf.Empty\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/teams/{id}\x12{\n" +
+	"\rAddTeamMember\x12\".slash.api.v1.AddTeamMemberRequest\x1a\x12.slash.api.v1.Team\"2\xdaA\n" +
+	"id,user_id\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/teams/{id}/members\x12\x88\x01\n" +
+	"\x10RemoveTeamMember\x12%.slash.api.v1.RemoveTeamMemberRequest\x1a\x12.slash.api.v1.Team\"9\xdaA\n" +
+	"id,user_id\x82\xd3\xe4\x93\x02&*$/api/v1/teams/{id}/members/{user_id}B.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
+
+var (
+	file_api_v1_team_service_proto_rawDescOnce sync.Once
+	file_api_v1_team_service_proto_rawDescData []byte
+)
+
+func file_api_v1_team_service_proto_rawDescGZIP() []byte {
+	file_api_v1_team_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_team_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_team_service_proto_rawDesc), len(file_api_v1_team_service_proto_rawDesc)))
+	})
+	return file_api_v1_team_service_proto_rawDescData
+}
+
+var file_api_v1_team_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_team_service_proto_goTypes = []any{
+	(*Team)(nil),                    // 0: slash.api.v1.Team
+	(*ListTeamsRequest)(nil),        // 1: slash.api.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),       // 2: slash.api.v1.ListTeamsResponse
+	(*GetTeamRequest)(nil),          // 3: slash.api.v1.GetTeamRequest
+	(*CreateTeamRequest)(nil),       // 4: slash.api.v1.CreateTeamRequest
+	(*UpdateTeamRequest)(nil),       // 5: slash.api.v1.UpdateTeamRequest
+	(*DeleteTeamRequest)(nil),       // 6: slash.api.v1.DeleteTeamRequest
+	(*AddTeamMemberRequest)(nil),    // 7: slash.api.v1.AddTeamMemberRequest
+	(*RemoveTeamMemberRequest)(nil), // 8: slash.api.v1.RemoveTeamMemberRequest
+	(*timestamppb.Timestamp)(nil),   // 9: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),   // 10: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),           // 11: google.protobuf.Empty
+}
+var file_api_v1_team_service_proto_depIdxs = []int32{
+	9,  // 0: slash.api.v1.Team.created_time:type_name -> google.protobuf.Timestamp
+	9,  // 1: slash.api.v1.Team.updated_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: slash.api.v1.ListTeamsResponse.teams:type_name -> slash.api.v1.Team
+	0,  // 3: slash.api.v1.CreateTeamRequest.team:type_name -> slash.api.v1.Team
+	0,  // 4: slash.api.v1.UpdateTeamRequest.team:type_name -> slash.api.v1.Team
+	10, // 5: slash.api.v1.UpdateTeamRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 6: slash.api.v1.TeamService.ListTeams:input_type -> slash.api.v1.ListTeamsRequest
+	3,  // 7: slash.api.v1.TeamService.GetTeam:input_type -> slash.api.v1.GetTeamRequest
+	4,  // 8: slash.api.v1.TeamService.CreateTeam:input_type -> slash.api.v1.CreateTeamRequest
+	5,  // 9: slash.api.v1.TeamService.UpdateTeam:input_type -> slash.api.v1.UpdateTeamRequest
+	6,  // 10: slash.api.v1.TeamService.DeleteTeam:input_type -> slash.api.v1.DeleteTeamRequest
+	7,  // 11: slash.api.v1.TeamService.AddTeamMember:input_type -> slash.api.v1.AddTeamMemberRequest
+	8,  // 12: slash.api.v1.TeamService.RemoveTeamMember:input_type -> slash.api.v1.RemoveTeamMemberRequest
+	2,  // 13: slash.api.v1.TeamService.ListTeams:output_type -> slash.api.v1.ListTeamsResponse
+	0,  // 14: slash.api.v1.TeamService.GetTeam:output_type -> slash.api.v1.Team
+	0,  // 15: slash.api.v1.TeamService.CreateTeam:output_type -> slash.api.v1.Team
+	0,  // 16: slash.api.v1.TeamService.UpdateTeam:output_type -> slash.api.v1.Team
+	11, // 17: slash.api.v1.TeamService.DeleteTeam:output_type -> google.protobuf.Empty
+	0,  // 18: slash.api.v1.TeamService.AddTeamMember:output_type -> slash.api.v1.Team
+	0,  // 19: slash.api.v1.TeamService.RemoveTeamMember:output_type -> slash.api.v1.Team
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_team_service_proto_init() }
+func file_api_v1_team_service_proto_init() {
+	if File_api_v1_team_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_team_service_proto_rawDesc), len(file_api_v1_team_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_team_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_team_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_team_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_team_service_proto = out.File
+	file_api_v1_team_service_proto_goTypes = nil
+	file_api_v1_team_service_proto_depIdxs = nil
+}
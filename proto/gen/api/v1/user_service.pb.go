@@ -74,16 +74,76 @@ func (Role) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{0}
 }
 
+type DeleteMyAccountRequest_DataHandling int32
+
+const (
+	DeleteMyAccountRequest_DATA_HANDLING_UNSPECIFIED DeleteMyAccountRequest_DataHandling = 0
+	// Delete the shortcuts and collections with the account.
+	DeleteMyAccountRequest_DELETE DeleteMyAccountRequest_DataHandling = 1
+	// Transfer the shortcuts and collections to the handover user of the workspace.
+	// The shortcuts in the personal namespace are deleted.
+	DeleteMyAccountRequest_TRANSFER DeleteMyAccountRequest_DataHandling = 2
+)
+
+// Enum value maps for DeleteMyAccountRequest_DataHandling.
+var (
+	DeleteMyAccountRequest_DataHandling_name = map[int32]string{
+		0: "DATA_HANDLING_UNSPECIFIED",
+		1: "DELETE",
+		2: "TRANSFER",
+	}
+	DeleteMyAccountRequest_DataHandling_value = map[string]int32{
+		"DATA_HANDLING_UNSPECIFIED": 0,
+		"DELETE":                    1,
+		"TRANSFER":                  2,
+	}
+)
+
+func (x DeleteMyAccountRequest_DataHandling) Enum() *DeleteMyAccountRequest_DataHandling {
+	p := new(DeleteMyAccountRequest_DataHandling)
+	*p = x
+	return p
+}
+
+func (x DeleteMyAccountRequest_DataHandling) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteMyAccountRequest_DataHandling) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[1].Descriptor()
+}
+
+func (DeleteMyAccountRequest_DataHandling) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[1]
+}
+
+func (x DeleteMyAccountRequest_DataHandling) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteMyAccountRequest_DataHandling.Descriptor instead.
+func (DeleteMyAccountRequest_DataHandling) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	State         State                  `protobuf:"varint,2,opt,name=state,proto3,enum=slash.api.v1.State" json:"state,omitempty"`
-	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	UpdatedTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
-	Role          Role                   `protobuf:"varint,6,opt,name=role,proto3,enum=slash.api.v1.Role" json:"role,omitempty"`
-	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-	Nickname      string                 `protobuf:"bytes,8,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Password      string                 `protobuf:"bytes,9,opt,name=password,proto3" json:"password,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	State       State                  `protobuf:"varint,2,opt,name=state,proto3,enum=slash.api.v1.State" json:"state,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	Role        Role                   `protobuf:"varint,6,opt,name=role,proto3,enum=slash.api.v1.Role" json:"role,omitempty"`
+	Email       string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	Nickname    string                 `protobuf:"bytes,8,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Password    string                 `protobuf:"bytes,9,opt,name=password,proto3" json:"password,omitempty"`
+	// The unique handle of the user, used in personal namespaces like "~username/".
+	Username string `protobuf:"bytes,10,opt,name=username,proto3" json:"username,omitempty"`
+	// The url of the avatar, e.g. "/u/1/avatar".
+	// When updating, it accepts a data uri like "data:image/png;base64,..." or empty to use the Gravatar fallback.
+	AvatarUrl string `protobuf:"bytes,11,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// Whether the user has confirmed the email signed up with. Only false when the workspace requires
+	// the verification and the user hasn't confirmed it yet.
+	EmailVerified bool `protobuf:"varint,12,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -174,8 +234,33 @@ func (x *User) GetPassword() string {
 	return ""
 }
 
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
 type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The max number of users to return. Unset or 0 returns all of them, and the max is 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous response to get the next page.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,9 +295,25 @@ func (*ListUsersRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListUsersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// The token of the next page. Empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -254,6 +355,13 @@ func (x *ListUsersResponse) GetUsers() []*User {
 	return nil
 }
 
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -438,6 +546,51 @@ func (x *DeleteUserRequest) GetId() int32 {
 	return 0
 }
 
+type DeleteMyAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What to do with the shortcuts and collections of the account.
+	DataHandling  DeleteMyAccountRequest_DataHandling `protobuf:"varint,1,opt,name=data_handling,json=dataHandling,proto3,enum=slash.api.v1.DeleteMyAccountRequest_DataHandling" json:"data_handling,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAccountRequest) Reset() {
+	*x = DeleteMyAccountRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountRequest) ProtoMessage() {}
+
+func (x *DeleteMyAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteMyAccountRequest) GetDataHandling() DeleteMyAccountRequest_DataHandling {
+	if x != nil {
+		return x.DataHandling
+	}
+	return DeleteMyAccountRequest_DATA_HANDLING_UNSPECIFIED
+}
+
 type ListUserAccessTokensRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// id is the user id.
@@ -448,7 +601,7 @@ type ListUserAccessTokensRequest struct {
 
 func (x *ListUserAccessTokensRequest) Reset() {
 	*x = ListUserAccessTokensRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -460,7 +613,7 @@ func (x *ListUserAccessTokensRequest) String() string {
 func (*ListUserAccessTokensRequest) ProtoMessage() {}
 
 func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +626,7 @@ func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListUserAccessTokensRequest) GetId() int32 {
@@ -492,7 +645,7 @@ type ListUserAccessTokensResponse struct {
 
 func (x *ListUserAccessTokensResponse) Reset() {
 	*x = ListUserAccessTokensResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -504,7 +657,7 @@ func (x *ListUserAccessTokensResponse) String() string {
 func (*ListUserAccessTokensResponse) ProtoMessage() {}
 
 func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +670,7 @@ func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListUserAccessTokensResponse) GetAccessTokens() []*UserAccessToken {
@@ -535,14 +688,18 @@ type CreateUserAccessTokenRequest struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// expires_at is the expiration time of the access token.
 	// If expires_at is not set, the access token will never expire.
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// scopes restricts the access token, e.g. "shortcuts:read". Empty means full access.
+	// The scopes are "shortcuts:read", "shortcuts:write", "collections:read", "collections:write" and "admin",
+	// where the write scopes include the read ones, and "admin" is full access, only for admins.
+	Scopes        []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateUserAccessTokenRequest) Reset() {
 	*x = CreateUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +711,7 @@ func (x *CreateUserAccessTokenRequest) String() string {
 func (*CreateUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +724,7 @@ func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateUserAccessTokenRequest) GetId() int32 {
@@ -591,11 +748,18 @@ func (x *CreateUserAccessTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateUserAccessTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type DeleteUserAccessTokenRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// id is the user id.
 	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// access_token is the access token to delete.
+	// access_token is the id of the access token to delete, or the access token itself.
 	AccessToken   string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -603,7 +767,7 @@ type DeleteUserAccessTokenRequest struct {
 
 func (x *DeleteUserAccessTokenRequest) Reset() {
 	*x = DeleteUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +779,7 @@ func (x *DeleteUserAccessTokenRequest) String() string {
 func (*DeleteUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +792,7 @@ func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteUserAccessTokenRequest) GetId() int32 {
@@ -646,18 +810,24 @@ func (x *DeleteUserAccessTokenRequest) GetAccessToken() string {
 }
 
 type UserAccessToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token is only returned on creation, as only its hash is stored.
+	AccessToken string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	IssuedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// The scopes the access token is restricted to. Empty means full access.
+	Scopes []string `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The id of the access token.
+	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserAccessToken) Reset() {
 	*x = UserAccessToken{}
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,7 +839,7 @@ func (x *UserAccessToken) String() string {
 func (*UserAccessToken) ProtoMessage() {}
 
 func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +852,7 @@ func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAccessToken.ProtoReflect.Descriptor instead.
 func (*UserAccessToken) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UserAccessToken) GetAccessToken() string {
@@ -713,56 +883,1158 @@ func (x *UserAccessToken) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
-var File_api_v1_user_service_proto protoreflect.FileDescriptor
+func (x *UserAccessToken) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
 
-const file_api_v1_user_service_proto_rawDesc = "" +
-	"\n" +
-	"\x19api/v1/user_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb5\x02\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12)\n" +
-	"\x05state\x18\x02 \x01(\x0e2\x13.slash.api.v1.StateR\x05state\x12=\n" +
-	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
-	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12&\n" +
-	"\x04role\x18\x06 \x01(\x0e2\x12.slash.api.v1.RoleR\x04role\x12\x14\n" +
-	"\x05email\x18\a \x01(\tR\x05email\x12\x1a\n" +
-	"\bnickname\x18\b \x01(\tR\bnickname\x12\x1a\n" +
-	"\bpassword\x18\t \x01(\tR\bpassword\"\x12\n" +
-	"\x10ListUsersRequest\"=\n" +
-	"\x11ListUsersResponse\x12(\n" +
-	"\x05users\x18\x01 \x03(\v2\x12.slash.api.v1.UserR\x05users\" \n" +
-	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\";\n" +
-	"\x11CreateUserRequest\x12&\n" +
-	"\x04user\x18\x01 \x01(\v2\x12.slash.api.v1.UserR\x04user\"x\n" +
-	"\x11UpdateUserRequest\x12&\n" +
-	"\x04user\x18\x01 \x01(\v2\x12.slash.api.v1.UserR\x04user\x12;\n" +
-	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"#\n" +
-	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
-	"\x1bListUserAccessTokensRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"b\n" +
-	"\x1cListUserAccessTokensResponse\x12B\n" +
-	"\raccess_tokens\x18\x01 \x03(\v2\x1d.slash.api.v1.UserAccessTokenR\faccessTokens\"\x9f\x01\n" +
-	"\x1cCreateUserAccessTokenRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12>\n" +
-	"\n" +
-	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
-	"\v_expires_at\"Q\n" +
-	"\x1cDeleteUserAccessTokenRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
-	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"\xca\x01\n" +
-	"\x0fUserAccessToken\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x127\n" +
-	"\tissued_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x129\n" +
+func (x *UserAccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *UserAccessToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListUserPasskeysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the user id.
+	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPasskeysRequest) Reset() {
+	*x = ListUserPasskeysRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPasskeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPasskeysRequest) ProtoMessage() {}
+
+func (x *ListUserPasskeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPasskeysRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPasskeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListUserPasskeysRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListUserPasskeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passkeys      []*UserPasskey         `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPasskeysResponse) Reset() {
+	*x = ListUserPasskeysResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPasskeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPasskeysResponse) ProtoMessage() {}
+
+func (x *ListUserPasskeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPasskeysResponse.ProtoReflect.Descriptor instead.
+func (*ListUserPasskeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListUserPasskeysResponse) GetPasskeys() []*UserPasskey {
+	if x != nil {
+		return x.Passkeys
+	}
+	return nil
+}
+
+type DeleteUserPasskeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the user id.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// passkey_id is the id of the passkey to delete.
+	PasskeyId     string `protobuf:"bytes,2,opt,name=passkey_id,json=passkeyId,proto3" json:"passkey_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserPasskeyRequest) Reset() {
+	*x = DeleteUserPasskeyRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserPasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserPasskeyRequest) ProtoMessage() {}
+
+func (x *DeleteUserPasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserPasskeyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserPasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteUserPasskeyRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteUserPasskeyRequest) GetPasskeyId() string {
+	if x != nil {
+		return x.PasskeyId
+	}
+	return ""
+}
+
+type UserPasskey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The credential id in unpadded base64url.
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPasskey) Reset() {
+	*x = UserPasskey{}
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPasskey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPasskey) ProtoMessage() {}
+
+func (x *UserPasskey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPasskey.ProtoReflect.Descriptor instead.
+func (*UserPasskey) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserPasskey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserPasskey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserPasskey) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *UserPasskey) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+type ListUserSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the user id.
+	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSessionsRequest) Reset() {
+	*x = ListUserSessionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSessionsRequest) ProtoMessage() {}
+
+func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListUserSessionsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListUserSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*UserSession         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSessionsResponse) Reset() {
+	*x = ListUserSessionsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSessionsResponse) ProtoMessage() {}
+
+func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListUserSessionsResponse) GetSessions() []*UserSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeUserSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the user id.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// session_id is the id of the session to revoke.
+	SessionId     int32 `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserSessionRequest) Reset() {
+	*x = RevokeUserSessionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionRequest) ProtoMessage() {}
+
+func (x *RevokeUserSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RevokeUserSessionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevokeUserSessionRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type UserSession struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The device of the session, e.g. "Chrome on macOS".
+	Device    string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	UserAgent string `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// The IP address the session is signed in from.
+	Ip           string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	CreatedTime  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	LastSeenTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen_time,json=lastSeenTime,proto3" json:"last_seen_time,omitempty"`
+	ExpireTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Whether it's the session of the request.
+	Current       bool `protobuf:"varint,8,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSession) Reset() {
+	*x = UserSession{}
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSession) ProtoMessage() {}
+
+func (x *UserSession) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
+func (*UserSession) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserSession) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserSession) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *UserSession) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *UserSession) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *UserSession) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *UserSession) GetLastSeenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenTime
+	}
+	return nil
+}
+
+func (x *UserSession) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *UserSession) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ImpersonateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the id of the user to impersonate.
+	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateUserRequest) Reset() {
+	*x = ImpersonateUserRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateUserRequest) ProtoMessage() {}
+
+func (x *ImpersonateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateUserRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImpersonateUserRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ImpersonateUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token acting as the user, until it expires.
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateUserResponse) Reset() {
+	*x = ImpersonateUserResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateUserResponse) ProtoMessage() {}
+
+func (x *ImpersonateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateUserResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonateUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImpersonateUserResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ImpersonateUserResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *ImpersonateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UserEmail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Only verified emails can be used to sign in and be set as the primary email.
+	Verified      bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEmail) Reset() {
+	*x = UserEmail{}
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEmail) ProtoMessage() {}
+
+func (x *UserEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEmail.ProtoReflect.Descriptor instead.
+func (*UserEmail) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UserEmail) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserEmail) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *UserEmail) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+type ListUserEmailsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the user id.
+	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEmailsRequest) Reset() {
+	*x = ListUserEmailsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserEmailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserEmailsRequest) ProtoMessage() {}
+
+func (x *ListUserEmailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserEmailsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserEmailsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListUserEmailsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListUserEmailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emails        []*UserEmail           `protobuf:"bytes,1,rep,name=emails,proto3" json:"emails,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEmailsResponse) Reset() {
+	*x = ListUserEmailsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserEmailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserEmailsResponse) ProtoMessage() {}
+
+func (x *ListUserEmailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserEmailsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserEmailsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUserEmailsResponse) GetEmails() []*UserEmail {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+type CreateUserEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the user id.
+	Id            int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserEmailRequest) Reset() {
+	*x = CreateUserEmailRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserEmailRequest) ProtoMessage() {}
+
+func (x *CreateUserEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserEmailRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateUserEmailRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateUserEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type DeleteUserEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the user id.
+	Id            int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserEmailRequest) Reset() {
+	*x = DeleteUserEmailRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserEmailRequest) ProtoMessage() {}
+
+func (x *DeleteUserEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserEmailRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteUserEmailRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteUserEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type SetUserPrimaryEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the user id.
+	Id            int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserPrimaryEmailRequest) Reset() {
+	*x = SetUserPrimaryEmailRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserPrimaryEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserPrimaryEmailRequest) ProtoMessage() {}
+
+func (x *SetUserPrimaryEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserPrimaryEmailRequest.ProtoReflect.Descriptor instead.
+func (*SetUserPrimaryEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetUserPrimaryEmailRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetUserPrimaryEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetUserPublicProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPublicProfileRequest) Reset() {
+	*x = GetUserPublicProfileRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPublicProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPublicProfileRequest) ProtoMessage() {}
+
+func (x *GetUserPublicProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPublicProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetUserPublicProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UserPublicProfile struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Username  string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname  string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// The public shortcuts of the user.
+	Shortcuts []*Shortcut `protobuf:"bytes,4,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	// The public collections of the user.
+	Collections   []*Collection `protobuf:"bytes,5,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPublicProfile) Reset() {
+	*x = UserPublicProfile{}
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPublicProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPublicProfile) ProtoMessage() {}
+
+func (x *UserPublicProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPublicProfile.ProtoReflect.Descriptor instead.
+func (*UserPublicProfile) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UserPublicProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserPublicProfile) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UserPublicProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UserPublicProfile) GetShortcuts() []*Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+func (x *UserPublicProfile) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+var File_api_v1_user_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_user_service_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/v1/user_service.proto\x12\fslash.api.v1\x1a\x1fapi/v1/collection_service.proto\x1a\x13api/v1/common.proto\x1a\x1dapi/v1/shortcut_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x03\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12)\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x13.slash.api.v1.StateR\x05state\x12=\n" +
+	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
+	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12&\n" +
+	"\x04role\x18\x06 \x01(\x0e2\x12.slash.api.v1.RoleR\x04role\x12\x14\n" +
+	"\x05email\x18\a \x01(\tR\x05email\x12\x1a\n" +
+	"\bnickname\x18\b \x01(\tR\bnickname\x12\x1a\n" +
+	"\bpassword\x18\t \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\n" +
+	" \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\v \x01(\tR\tavatarUrl\x12%\n" +
+	"\x0eemail_verified\x18\f \x01(\bR\remailVerified\"N\n" +
+	"\x10ListUsersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"e\n" +
+	"\x11ListUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.slash.api.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\" \n" +
+	"\x0eGetUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\";\n" +
+	"\x11CreateUserRequest\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.slash.api.v1.UserR\x04user\"x\n" +
+	"\x11UpdateUserRequest\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.slash.api.v1.UserR\x04user\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"#\n" +
+	"\x11DeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xb9\x01\n" +
+	"\x16DeleteMyAccountRequest\x12V\n" +
+	"\rdata_handling\x18\x01 \x01(\x0e21.slash.api.v1.DeleteMyAccountRequest.DataHandlingR\fdataHandling\"G\n" +
+	"\fDataHandling\x12\x1d\n" +
+	"\x19DATA_HANDLING_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x01\x12\f\n" +
+	"\bTRANSFER\x10\x02\"-\n" +
+	"\x1bListUserAccessTokensRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"b\n" +
+	"\x1cListUserAccessTokensResponse\x12B\n" +
+	"\raccess_tokens\x18\x01 \x03(\v2\x1d.slash.api.v1.UserAccessTokenR\faccessTokens\"\xb7\x01\n" +
+	"\x1cCreateUserAccessTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12>\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopesB\r\n" +
+	"\v_expires_at\"Q\n" +
+	"\x1cDeleteUserAccessTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"\xb0\x02\n" +
+	"\x0fUserAccessToken\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x127\n" +
+	"\tissued_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12\x16\n" +
+	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02id\")\n" +
+	"\x17ListUserPasskeysRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"Q\n" +
+	"\x18ListUserPasskeysResponse\x125\n" +
+	"\bpasskeys\x18\x01 \x03(\v2\x19.slash.api.v1.UserPasskeyR\bpasskeys\"I\n" +
+	"\x18DeleteUserPasskeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"passkey_id\x18\x02 \x01(\tR\tpasskeyId\"\xb2\x01\n" +
+	"\vUserPasskey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12=\n" +
+	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12@\n" +
+	"\x0elast_used_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTime\")\n" +
+	"\x17ListUserSessionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"Q\n" +
+	"\x18ListUserSessionsResponse\x125\n" +
+	"\bsessions\x18\x01 \x03(\v2\x19.slash.api.v1.UserSessionR\bsessions\"I\n" +
+	"\x18RevokeUserSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\x05R\tsessionId\"\xbc\x02\n" +
+	"\vUserSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12=\n" +
+	"\fcreated_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12@\n" +
+	"\x0elast_seen_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastSeenTime\x12;\n" +
+	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x18\n" +
+	"\acurrent\x18\b \x01(\bR\acurrent\"(\n" +
+	"\x16ImpersonateUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xa1\x01\n" +
+	"\x17ImpersonateUserResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12&\n" +
+	"\x04user\x18\x03 \x01(\v2\x12.slash.api.v1.UserR\x04user\"|\n" +
+	"\tUserEmail\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bverified\x18\x02 \x01(\bR\bverified\x12=\n" +
+	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\"'\n" +
+	"\x15ListUserEmailsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"I\n" +
+	"\x16ListUserEmailsResponse\x12/\n" +
+	"\x06emails\x18\x01 \x03(\v2\x17.slash.api.v1.UserEmailR\x06emails\">\n" +
+	"\x16CreateUserEmailRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\">\n" +
+	"\x16DeleteUserEmailRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"B\n" +
+	"\x1aSetUserPrimaryEmailRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"9\n" +
+	"\x1bGetUserPublicProfileRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"\xdc\x01\n" +
+	"\x11UserPublicProfile\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1d\n" +
 	"\n" +
-	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt*1\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x124\n" +
+	"\tshortcuts\x18\x04 \x03(\v2\x16.slash.api.v1.ShortcutR\tshortcuts\x12:\n" +
+	"\vcollections\x18\x05 \x03(\v2\x18.slash.api.v1.CollectionR\vcollections*1\n" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05ADMIN\x10\x01\x12\b\n" +
-	"\x04USER\x10\x022\xf4\a\n" +
+	"\x04USER\x10\x022\x84\x14\n" +
 	"\vUserService\x12c\n" +
 	"\tListUsers\x12\x1e.slash.api.v1.ListUsersRequest\x1a\x1f.slash.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12\\\n" +
 	"\aGetUser\x12\x1c.slash.api.v1.GetUserRequest\x1a\x12.slash.api.v1.User\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/users/{id}\x12^\n" +
@@ -771,10 +2043,21 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"UpdateUser\x12\x1f.slash.api.v1.UpdateUserRequest\x1a\x12.slash.api.v1.User\"8\xdaA\x10user,update_mask\x82\xd3\xe4\x93\x02\x1f:\x04user2\x17/api/v1/users/{user.id}\x12f\n" +
 	"\n" +
-	"DeleteUser\x12\x1f.slash.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/users/{id}\x12\x9c\x01\n" +
+	"DeleteUser\x12\x1f.slash.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/users/{id}\x12s\n" +
+	"\x0fDeleteMyAccount\x12$.slash.api.v1.DeleteMyAccountRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/users/me:delete\x12\x9c\x01\n" +
 	"\x14ListUserAccessTokens\x12).slash.api.v1.ListUserAccessTokensRequest\x1a*.slash.api.v1.ListUserAccessTokensResponse\"-\xdaA\x02id\x82\xd3\xe4\x93\x02\"\x12 /api/v1/users/{id}/access_tokens\x12\x94\x01\n" +
 	"\x15CreateUserAccessToken\x12*.slash.api.v1.CreateUserAccessTokenRequest\x1a\x1d.slash.api.v1.UserAccessToken\"0\xdaA\x02id\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/users/{id}/access_tokens\x12\xa6\x01\n" +
-	"\x15DeleteUserAccessToken\x12*.slash.api.v1.DeleteUserAccessTokenRequest\x1a\x16.google.protobuf.Empty\"I\xdaA\x0fid,access_token\x82\xd3\xe4\x93\x021*//api/v1/users/{id}/access_tokens/{access_token}B.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
+	"\x15DeleteUserAccessToken\x12*.slash.api.v1.DeleteUserAccessTokenRequest\x1a\x16.google.protobuf.Empty\"I\xdaA\x0fid,access_token\x82\xd3\xe4\x93\x021*//api/v1/users/{id}/access_tokens/{access_token}\x12\x8b\x01\n" +
+	"\x10ListUserPasskeys\x12%.slash.api.v1.ListUserPasskeysRequest\x1a&.slash.api.v1.ListUserPasskeysResponse\"(\xdaA\x02id\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/users/{id}/passkeys\x12\x95\x01\n" +
+	"\x11DeleteUserPasskey\x12&.slash.api.v1.DeleteUserPasskeyRequest\x1a\x16.google.protobuf.Empty\"@\xdaA\rid,passkey_id\x82\xd3\xe4\x93\x02**(/api/v1/users/{id}/passkeys/{passkey_id}\x12\x8b\x01\n" +
+	"\x10ListUserSessions\x12%.slash.api.v1.ListUserSessionsRequest\x1a&.slash.api.v1.ListUserSessionsResponse\"(\xdaA\x02id\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/users/{id}/sessions\x12\x95\x01\n" +
+	"\x11RevokeUserSession\x12&.slash.api.v1.RevokeUserSessionRequest\x1a\x16.google.protobuf.Empty\"@\xdaA\rid,session_id\x82\xd3\xe4\x93\x02**(/api/v1/users/{id}/sessions/{session_id}\x12\x8e\x01\n" +
+	"\x0fImpersonateUser\x12$.slash.api.v1.ImpersonateUserRequest\x1a%.slash.api.v1.ImpersonateUserResponse\".\xdaA\x02id\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/users/{id}:impersonate\x12\x83\x01\n" +
+	"\x0eListUserEmails\x12#.slash.api.v1.ListUserEmailsRequest\x1a$.slash.api.v1.ListUserEmailsResponse\"&\xdaA\x02id\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/users/{id}/emails\x12\x81\x01\n" +
+	"\x0fCreateUserEmail\x12$.slash.api.v1.CreateUserEmailRequest\x1a\x17.slash.api.v1.UserEmail\"/\xdaA\bid,email\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/users/{id}/emails\x12\x85\x01\n" +
+	"\x0fDeleteUserEmail\x12$.slash.api.v1.DeleteUserEmailRequest\x1a\x16.google.protobuf.Empty\"4\xdaA\bid,email\x82\xd3\xe4\x93\x02#*!/api/v1/users/{id}/emails/{email}\x12\x94\x01\n" +
+	"\x13SetUserPrimaryEmail\x12(.slash.api.v1.SetUserPrimaryEmailRequest\x1a\x12.slash.api.v1.User\"?\xdaA\bid,email\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/users/{id}/emails/{email}/primary\x12\x92\x01\n" +
+	"\x14GetUserPublicProfile\x12).slash.api.v1.GetUserPublicProfileRequest\x1a\x1f.slash.api.v1.UserPublicProfile\".\xdaA\busername\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/profiles/{username}B.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
 
 var (
 	file_api_v1_user_service_proto_rawDescOnce sync.Once
@@ -788,61 +2071,120 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_service_proto_rawDescData
 }
 
-var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_api_v1_user_service_proto_goTypes = []any{
-	(Role)(0),                            // 0: slash.api.v1.Role
-	(*User)(nil),                         // 1: slash.api.v1.User
-	(*ListUsersRequest)(nil),             // 2: slash.api.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),            // 3: slash.api.v1.ListUsersResponse
-	(*GetUserRequest)(nil),               // 4: slash.api.v1.GetUserRequest
-	(*CreateUserRequest)(nil),            // 5: slash.api.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),            // 6: slash.api.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),            // 7: slash.api.v1.DeleteUserRequest
-	(*ListUserAccessTokensRequest)(nil),  // 8: slash.api.v1.ListUserAccessTokensRequest
-	(*ListUserAccessTokensResponse)(nil), // 9: slash.api.v1.ListUserAccessTokensResponse
-	(*CreateUserAccessTokenRequest)(nil), // 10: slash.api.v1.CreateUserAccessTokenRequest
-	(*DeleteUserAccessTokenRequest)(nil), // 11: slash.api.v1.DeleteUserAccessTokenRequest
-	(*UserAccessToken)(nil),              // 12: slash.api.v1.UserAccessToken
-	(State)(0),                           // 13: slash.api.v1.State
-	(*timestamppb.Timestamp)(nil),        // 14: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 15: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                // 16: google.protobuf.Empty
+	(Role)(0),                                // 0: slash.api.v1.Role
+	(DeleteMyAccountRequest_DataHandling)(0), // 1: slash.api.v1.DeleteMyAccountRequest.DataHandling
+	(*User)(nil),                             // 2: slash.api.v1.User
+	(*ListUsersRequest)(nil),                 // 3: slash.api.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                // 4: slash.api.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                   // 5: slash.api.v1.GetUserRequest
+	(*CreateUserRequest)(nil),                // 6: slash.api.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                // 7: slash.api.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                // 8: slash.api.v1.DeleteUserRequest
+	(*DeleteMyAccountRequest)(nil),           // 9: slash.api.v1.DeleteMyAccountRequest
+	(*ListUserAccessTokensRequest)(nil),      // 10: slash.api.v1.ListUserAccessTokensRequest
+	(*ListUserAccessTokensResponse)(nil),     // 11: slash.api.v1.ListUserAccessTokensResponse
+	(*CreateUserAccessTokenRequest)(nil),     // 12: slash.api.v1.CreateUserAccessTokenRequest
+	(*DeleteUserAccessTokenRequest)(nil),     // 13: slash.api.v1.DeleteUserAccessTokenRequest
+	(*UserAccessToken)(nil),                  // 14: slash.api.v1.UserAccessToken
+	(*ListUserPasskeysRequest)(nil),          // 15: slash.api.v1.ListUserPasskeysRequest
+	(*ListUserPasskeysResponse)(nil),         // 16: slash.api.v1.ListUserPasskeysResponse
+	(*DeleteUserPasskeyRequest)(nil),         // 17: slash.api.v1.DeleteUserPasskeyRequest
+	(*UserPasskey)(nil),                      // 18: slash.api.v1.UserPasskey
+	(*ListUserSessionsRequest)(nil),          // 19: slash.api.v1.ListUserSessionsRequest
+	(*ListUserSessionsResponse)(nil),         // 20: slash.api.v1.ListUserSessionsResponse
+	(*RevokeUserSessionRequest)(nil),         // 21: slash.api.v1.RevokeUserSessionRequest
+	(*UserSession)(nil),                      // 22: slash.api.v1.UserSession
+	(*ImpersonateUserRequest)(nil),           // 23: slash.api.v1.ImpersonateUserRequest
+	(*ImpersonateUserResponse)(nil),          // 24: slash.api.v1.ImpersonateUserResponse
+	(*UserEmail)(nil),                        // 25: slash.api.v1.UserEmail
+	(*ListUserEmailsRequest)(nil),            // 26: slash.api.v1.ListUserEmailsRequest
+	(*ListUserEmailsResponse)(nil),           // 27: slash.api.v1.ListUserEmailsResponse
+	(*CreateUserEmailRequest)(nil),           // 28: slash.api.v1.CreateUserEmailRequest
+	(*DeleteUserEmailRequest)(nil),           // 29: slash.api.v1.DeleteUserEmailRequest
+	(*SetUserPrimaryEmailRequest)(nil),       // 30: slash.api.v1.SetUserPrimaryEmailRequest
+	(*GetUserPublicProfileRequest)(nil),      // 31: slash.api.v1.GetUserPublicProfileRequest
+	(*UserPublicProfile)(nil),                // 32: slash.api.v1.UserPublicProfile
+	(State)(0),                               // 33: slash.api.v1.State
+	(*timestamppb.Timestamp)(nil),            // 34: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),            // 35: google.protobuf.FieldMask
+	(*Shortcut)(nil),                         // 36: slash.api.v1.Shortcut
+	(*Collection)(nil),                       // 37: slash.api.v1.Collection
+	(*emptypb.Empty)(nil),                    // 38: google.protobuf.Empty
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
-	13, // 0: slash.api.v1.User.state:type_name -> slash.api.v1.State
-	14, // 1: slash.api.v1.User.created_time:type_name -> google.protobuf.Timestamp
-	14, // 2: slash.api.v1.User.updated_time:type_name -> google.protobuf.Timestamp
+	33, // 0: slash.api.v1.User.state:type_name -> slash.api.v1.State
+	34, // 1: slash.api.v1.User.created_time:type_name -> google.protobuf.Timestamp
+	34, // 2: slash.api.v1.User.updated_time:type_name -> google.protobuf.Timestamp
 	0,  // 3: slash.api.v1.User.role:type_name -> slash.api.v1.Role
-	1,  // 4: slash.api.v1.ListUsersResponse.users:type_name -> slash.api.v1.User
-	1,  // 5: slash.api.v1.CreateUserRequest.user:type_name -> slash.api.v1.User
-	1,  // 6: slash.api.v1.UpdateUserRequest.user:type_name -> slash.api.v1.User
-	15, // 7: slash.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	12, // 8: slash.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> slash.api.v1.UserAccessToken
-	14, // 9: slash.api.v1.CreateUserAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
-	14, // 10: slash.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
-	14, // 11: slash.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	2,  // 12: slash.api.v1.UserService.ListUsers:input_type -> slash.api.v1.ListUsersRequest
-	4,  // 13: slash.api.v1.UserService.GetUser:input_type -> slash.api.v1.GetUserRequest
-	5,  // 14: slash.api.v1.UserService.CreateUser:input_type -> slash.api.v1.CreateUserRequest
-	6,  // 15: slash.api.v1.UserService.UpdateUser:input_type -> slash.api.v1.UpdateUserRequest
-	7,  // 16: slash.api.v1.UserService.DeleteUser:input_type -> slash.api.v1.DeleteUserRequest
-	8,  // 17: slash.api.v1.UserService.ListUserAccessTokens:input_type -> slash.api.v1.ListUserAccessTokensRequest
-	10, // 18: slash.api.v1.UserService.CreateUserAccessToken:input_type -> slash.api.v1.CreateUserAccessTokenRequest
-	11, // 19: slash.api.v1.UserService.DeleteUserAccessToken:input_type -> slash.api.v1.DeleteUserAccessTokenRequest
-	3,  // 20: slash.api.v1.UserService.ListUsers:output_type -> slash.api.v1.ListUsersResponse
-	1,  // 21: slash.api.v1.UserService.GetUser:output_type -> slash.api.v1.User
-	1,  // 22: slash.api.v1.UserService.CreateUser:output_type -> slash.api.v1.User
-	1,  // 23: slash.api.v1.UserService.UpdateUser:output_type -> slash.api.v1.User
-	16, // 24: slash.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	9,  // 25: slash.api.v1.UserService.ListUserAccessTokens:output_type -> slash.api.v1.ListUserAccessTokensResponse
-	12, // 26: slash.api.v1.UserService.CreateUserAccessToken:output_type -> slash.api.v1.UserAccessToken
-	16, // 27: slash.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	2,  // 4: slash.api.v1.ListUsersResponse.users:type_name -> slash.api.v1.User
+	2,  // 5: slash.api.v1.CreateUserRequest.user:type_name -> slash.api.v1.User
+	2,  // 6: slash.api.v1.UpdateUserRequest.user:type_name -> slash.api.v1.User
+	35, // 7: slash.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 8: slash.api.v1.DeleteMyAccountRequest.data_handling:type_name -> slash.api.v1.DeleteMyAccountRequest.DataHandling
+	14, // 9: slash.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> slash.api.v1.UserAccessToken
+	34, // 10: slash.api.v1.CreateUserAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	34, // 11: slash.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
+	34, // 12: slash.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	34, // 13: slash.api.v1.UserAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	18, // 14: slash.api.v1.ListUserPasskeysResponse.passkeys:type_name -> slash.api.v1.UserPasskey
+	34, // 15: slash.api.v1.UserPasskey.created_time:type_name -> google.protobuf.Timestamp
+	34, // 16: slash.api.v1.UserPasskey.last_used_time:type_name -> google.protobuf.Timestamp
+	22, // 17: slash.api.v1.ListUserSessionsResponse.sessions:type_name -> slash.api.v1.UserSession
+	34, // 18: slash.api.v1.UserSession.created_time:type_name -> google.protobuf.Timestamp
+	34, // 19: slash.api.v1.UserSession.last_seen_time:type_name -> google.protobuf.Timestamp
+	34, // 20: slash.api.v1.UserSession.expire_time:type_name -> google.protobuf.Timestamp
+	34, // 21: slash.api.v1.ImpersonateUserResponse.expire_time:type_name -> google.protobuf.Timestamp
+	2,  // 22: slash.api.v1.ImpersonateUserResponse.user:type_name -> slash.api.v1.User
+	34, // 23: slash.api.v1.UserEmail.created_time:type_name -> google.protobuf.Timestamp
+	25, // 24: slash.api.v1.ListUserEmailsResponse.emails:type_name -> slash.api.v1.UserEmail
+	36, // 25: slash.api.v1.UserPublicProfile.shortcuts:type_name -> slash.api.v1.Shortcut
+	37, // 26: slash.api.v1.UserPublicProfile.collections:type_name -> slash.api.v1.Collection
+	3,  // 27: slash.api.v1.UserService.ListUsers:input_type -> slash.api.v1.ListUsersRequest
+	5,  // 28: slash.api.v1.UserService.GetUser:input_type -> slash.api.v1.GetUserRequest
+	6,  // 29: slash.api.v1.UserService.CreateUser:input_type -> slash.api.v1.CreateUserRequest
+	7,  // 30: slash.api.v1.UserService.UpdateUser:input_type -> slash.api.v1.UpdateUserRequest
+	8,  // 31: slash.api.v1.UserService.DeleteUser:input_type -> slash.api.v1.DeleteUserRequest
+	9,  // 32: slash.api.v1.UserService.DeleteMyAccount:input_type -> slash.api.v1.DeleteMyAccountRequest
+	10, // 33: slash.api.v1.UserService.ListUserAccessTokens:input_type -> slash.api.v1.ListUserAccessTokensRequest
+	12, // 34: slash.api.v1.UserService.CreateUserAccessToken:input_type -> slash.api.v1.CreateUserAccessTokenRequest
+	13, // 35: slash.api.v1.UserService.DeleteUserAccessToken:input_type -> slash.api.v1.DeleteUserAccessTokenRequest
+	15, // 36: slash.api.v1.UserService.ListUserPasskeys:input_type -> slash.api.v1.ListUserPasskeysRequest
+	17, // 37: slash.api.v1.UserService.DeleteUserPasskey:input_type -> slash.api.v1.DeleteUserPasskeyRequest
+	19, // 38: slash.api.v1.UserService.ListUserSessions:input_type -> slash.api.v1.ListUserSessionsRequest
+	21, // 39: slash.api.v1.UserService.RevokeUserSession:input_type -> slash.api.v1.RevokeUserSessionRequest
+	23, // 40: slash.api.v1.UserService.ImpersonateUser:input_type -> slash.api.v1.ImpersonateUserRequest
+	26, // 41: slash.api.v1.UserService.ListUserEmails:input_type -> slash.api.v1.ListUserEmailsRequest
+	28, // 42: slash.api.v1.UserService.CreateUserEmail:input_type -> slash.api.v1.CreateUserEmailRequest
+	29, // 43: slash.api.v1.UserService.DeleteUserEmail:input_type -> slash.api.v1.DeleteUserEmailRequest
+	30, // 44: slash.api.v1.UserService.SetUserPrimaryEmail:input_type -> slash.api.v1.SetUserPrimaryEmailRequest
+	31, // 45: slash.api.v1.UserService.GetUserPublicProfile:input_type -> slash.api.v1.GetUserPublicProfileRequest
+	4,  // 46: slash.api.v1.UserService.ListUsers:output_type -> slash.api.v1.ListUsersResponse
+	2,  // 47: slash.api.v1.UserService.GetUser:output_type -> slash.api.v1.User
+	2,  // 48: slash.api.v1.UserService.CreateUser:output_type -> slash.api.v1.User
+	2,  // 49: slash.api.v1.UserService.UpdateUser:output_type -> slash.api.v1.User
+	38, // 50: slash.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	38, // 51: slash.api.v1.UserService.DeleteMyAccount:output_type -> google.protobuf.Empty
+	11, // 52: slash.api.v1.UserService.ListUserAccessTokens:output_type -> slash.api.v1.ListUserAccessTokensResponse
+	14, // 53: slash.api.v1.UserService.CreateUserAccessToken:output_type -> slash.api.v1.UserAccessToken
+	38, // 54: slash.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
+	16, // 55: slash.api.v1.UserService.ListUserPasskeys:output_type -> slash.api.v1.ListUserPasskeysResponse
+	38, // 56: slash.api.v1.UserService.DeleteUserPasskey:output_type -> google.protobuf.Empty
+	20, // 57: slash.api.v1.UserService.ListUserSessions:output_type -> slash.api.v1.ListUserSessionsResponse
+	38, // 58: slash.api.v1.UserService.RevokeUserSession:output_type -> google.protobuf.Empty
+	24, // 59: slash.api.v1.UserService.ImpersonateUser:output_type -> slash.api.v1.ImpersonateUserResponse
+	27, // 60: slash.api.v1.UserService.ListUserEmails:output_type -> slash.api.v1.ListUserEmailsResponse
+	25, // 61: slash.api.v1.UserService.CreateUserEmail:output_type -> slash.api.v1.UserEmail
+	38, // 62: slash.api.v1.UserService.DeleteUserEmail:output_type -> google.protobuf.Empty
+	2,  // 63: slash.api.v1.UserService.SetUserPrimaryEmail:output_type -> slash.api.v1.User
+	32, // 64: slash.api.v1.UserService.GetUserPublicProfile:output_type -> slash.api.v1.UserPublicProfile
+	46, // [46:65] is the sub-list for method output_type
+	27, // [27:46] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_service_proto_init() }
@@ -850,15 +2192,17 @@ func file_api_v1_user_service_proto_init() {
 	if File_api_v1_user_service_proto != nil {
 		return
 	}
+	file_api_v1_collection_service_proto_init()
 	file_api_v1_common_proto_init()
-	file_api_v1_user_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_api_v1_shortcut_service_proto_init()
+	file_api_v1_user_service_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,9 +25,20 @@ const (
 	UserService_CreateUser_FullMethodName            = "/slash.api.v1.UserService/CreateUser"
 	UserService_UpdateUser_FullMethodName            = "/slash.api.v1.UserService/UpdateUser"
 	UserService_DeleteUser_FullMethodName            = "/slash.api.v1.UserService/DeleteUser"
+	UserService_DeleteMyAccount_FullMethodName       = "/slash.api.v1.UserService/DeleteMyAccount"
 	UserService_ListUserAccessTokens_FullMethodName  = "/slash.api.v1.UserService/ListUserAccessTokens"
 	UserService_CreateUserAccessToken_FullMethodName = "/slash.api.v1.UserService/CreateUserAccessToken"
 	UserService_DeleteUserAccessToken_FullMethodName = "/slash.api.v1.UserService/DeleteUserAccessToken"
+	UserService_ListUserPasskeys_FullMethodName      = "/slash.api.v1.UserService/ListUserPasskeys"
+	UserService_DeleteUserPasskey_FullMethodName     = "/slash.api.v1.UserService/DeleteUserPasskey"
+	UserService_ListUserSessions_FullMethodName      = "/slash.api.v1.UserService/ListUserSessions"
+	UserService_RevokeUserSession_FullMethodName     = "/slash.api.v1.UserService/RevokeUserSession"
+	UserService_ImpersonateUser_FullMethodName       = "/slash.api.v1.UserService/ImpersonateUser"
+	UserService_ListUserEmails_FullMethodName        = "/slash.api.v1.UserService/ListUserEmails"
+	UserService_CreateUserEmail_FullMethodName       = "/slash.api.v1.UserService/CreateUserEmail"
+	UserService_DeleteUserEmail_FullMethodName       = "/slash.api.v1.UserService/DeleteUserEmail"
+	UserService_SetUserPrimaryEmail_FullMethodName   = "/slash.api.v1.UserService/SetUserPrimaryEmail"
+	UserService_GetUserPublicProfile_FullMethodName  = "/slash.api.v1.UserService/GetUserPublicProfile"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -43,12 +54,37 @@ type UserServiceClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
 	// DeleteUser deletes a user by id.
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteMyAccount deletes the account of the current user.
+	DeleteMyAccount(ctx context.Context, in *DeleteMyAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListUserAccessTokens returns a list of access tokens for a user.
 	ListUserAccessTokens(ctx context.Context, in *ListUserAccessTokensRequest, opts ...grpc.CallOption) (*ListUserAccessTokensResponse, error)
 	// CreateUserAccessToken creates a new access token for a user.
 	CreateUserAccessToken(ctx context.Context, in *CreateUserAccessTokenRequest, opts ...grpc.CallOption) (*UserAccessToken, error)
 	// DeleteUserAccessToken deletes an access token for a user.
 	DeleteUserAccessToken(ctx context.Context, in *DeleteUserAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserPasskeys returns the passkeys of the user.
+	ListUserPasskeys(ctx context.Context, in *ListUserPasskeysRequest, opts ...grpc.CallOption) (*ListUserPasskeysResponse, error)
+	// DeleteUserPasskey deletes a passkey of the user.
+	DeleteUserPasskey(ctx context.Context, in *DeleteUserPasskeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserSessions returns the active sign-in sessions of the user.
+	ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error)
+	// RevokeUserSession signs the user out of a session, e.g. on a lost device.
+	RevokeUserSession(ctx context.Context, in *RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ImpersonateUser signs the admin in as another user for a limited time, to debug what the user sees.
+	// The access token is set as the cookie and returned, and the impersonation is recorded in the activities.
+	ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error)
+	// ListUserEmails returns the secondary emails of a user.
+	ListUserEmails(ctx context.Context, in *ListUserEmailsRequest, opts ...grpc.CallOption) (*ListUserEmailsResponse, error)
+	// CreateUserEmail adds a secondary email to a user.
+	CreateUserEmail(ctx context.Context, in *CreateUserEmailRequest, opts ...grpc.CallOption) (*UserEmail, error)
+	// DeleteUserEmail removes a secondary email from a user.
+	DeleteUserEmail(ctx context.Context, in *DeleteUserEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetUserPrimaryEmail makes a verified secondary email the primary email of a user.
+	// The previous primary email is kept as a verified secondary email.
+	SetUserPrimaryEmail(ctx context.Context, in *SetUserPrimaryEmailRequest, opts ...grpc.CallOption) (*User, error)
+	// GetUserPublicProfile returns the public profile of a user by username.
+	// It's not found when the user disabled the public profile.
+	GetUserPublicProfile(ctx context.Context, in *GetUserPublicProfileRequest, opts ...grpc.CallOption) (*UserPublicProfile, error)
 }
 
 type userServiceClient struct {
@@ -109,6 +145,16 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) DeleteMyAccount(ctx context.Context, in *DeleteMyAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteMyAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListUserAccessTokens(ctx context.Context, in *ListUserAccessTokensRequest, opts ...grpc.CallOption) (*ListUserAccessTokensResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserAccessTokensResponse)
@@ -139,6 +185,106 @@ func (c *userServiceClient) DeleteUserAccessToken(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *userServiceClient) ListUserPasskeys(ctx context.Context, in *ListUserPasskeysRequest, opts ...grpc.CallOption) (*ListUserPasskeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserPasskeysResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserPasskeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserPasskey(ctx context.Context, in *DeleteUserPasskeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserPasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeUserSession(ctx context.Context, in *RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RevokeUserSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateUserResponse)
+	err := c.cc.Invoke(ctx, UserService_ImpersonateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserEmails(ctx context.Context, in *ListUserEmailsRequest, opts ...grpc.CallOption) (*ListUserEmailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserEmailsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserEmails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateUserEmail(ctx context.Context, in *CreateUserEmailRequest, opts ...grpc.CallOption) (*UserEmail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserEmail)
+	err := c.cc.Invoke(ctx, UserService_CreateUserEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserEmail(ctx context.Context, in *DeleteUserEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetUserPrimaryEmail(ctx context.Context, in *SetUserPrimaryEmailRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SetUserPrimaryEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserPublicProfile(ctx context.Context, in *GetUserPublicProfileRequest, opts ...grpc.CallOption) (*UserPublicProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPublicProfile)
+	err := c.cc.Invoke(ctx, UserService_GetUserPublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -152,12 +298,37 @@ type UserServiceServer interface {
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
 	// DeleteUser deletes a user by id.
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	// DeleteMyAccount deletes the account of the current user.
+	DeleteMyAccount(context.Context, *DeleteMyAccountRequest) (*emptypb.Empty, error)
 	// ListUserAccessTokens returns a list of access tokens for a user.
 	ListUserAccessTokens(context.Context, *ListUserAccessTokensRequest) (*ListUserAccessTokensResponse, error)
 	// CreateUserAccessToken creates a new access token for a user.
 	CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*UserAccessToken, error)
 	// DeleteUserAccessToken deletes an access token for a user.
 	DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*emptypb.Empty, error)
+	// ListUserPasskeys returns the passkeys of the user.
+	ListUserPasskeys(context.Context, *ListUserPasskeysRequest) (*ListUserPasskeysResponse, error)
+	// DeleteUserPasskey deletes a passkey of the user.
+	DeleteUserPasskey(context.Context, *DeleteUserPasskeyRequest) (*emptypb.Empty, error)
+	// ListUserSessions returns the active sign-in sessions of the user.
+	ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error)
+	// RevokeUserSession signs the user out of a session, e.g. on a lost device.
+	RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*emptypb.Empty, error)
+	// ImpersonateUser signs the admin in as another user for a limited time, to debug what the user sees.
+	// The access token is set as the cookie and returned, and the impersonation is recorded in the activities.
+	ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error)
+	// ListUserEmails returns the secondary emails of a user.
+	ListUserEmails(context.Context, *ListUserEmailsRequest) (*ListUserEmailsResponse, error)
+	// CreateUserEmail adds a secondary email to a user.
+	CreateUserEmail(context.Context, *CreateUserEmailRequest) (*UserEmail, error)
+	// DeleteUserEmail removes a secondary email from a user.
+	DeleteUserEmail(context.Context, *DeleteUserEmailRequest) (*emptypb.Empty, error)
+	// SetUserPrimaryEmail makes a verified secondary email the primary email of a user.
+	// The previous primary email is kept as a verified secondary email.
+	SetUserPrimaryEmail(context.Context, *SetUserPrimaryEmailRequest) (*User, error)
+	// GetUserPublicProfile returns the public profile of a user by username.
+	// It's not found when the user disabled the public profile.
+	GetUserPublicProfile(context.Context, *GetUserPublicProfileRequest) (*UserPublicProfile, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -183,6 +354,9 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserReq
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) DeleteMyAccount(context.Context, *DeleteMyAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMyAccount not implemented")
+}
 func (UnimplementedUserServiceServer) ListUserAccessTokens(context.Context, *ListUserAccessTokensRequest) (*ListUserAccessTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserAccessTokens not implemented")
 }
@@ -192,6 +366,36 @@ func (UnimplementedUserServiceServer) CreateUserAccessToken(context.Context, *Cr
 func (UnimplementedUserServiceServer) DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserAccessToken not implemented")
 }
+func (UnimplementedUserServiceServer) ListUserPasskeys(context.Context, *ListUserPasskeysRequest) (*ListUserPasskeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserPasskeys not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserPasskey(context.Context, *DeleteUserPasskeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserPasskey not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSession not implemented")
+}
+func (UnimplementedUserServiceServer) ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImpersonateUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserEmails(context.Context, *ListUserEmailsRequest) (*ListUserEmailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserEmails not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserEmail(context.Context, *CreateUserEmailRequest) (*UserEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserEmail not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserEmail(context.Context, *DeleteUserEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserEmail not implemented")
+}
+func (UnimplementedUserServiceServer) SetUserPrimaryEmail(context.Context, *SetUserPrimaryEmailRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserPrimaryEmail not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserPublicProfile(context.Context, *GetUserPublicProfileRequest) (*UserPublicProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPublicProfile not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -303,6 +507,24 @@ func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_DeleteMyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMyAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteMyAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteMyAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteMyAccount(ctx, req.(*DeleteMyAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListUserAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserAccessTokensRequest)
 	if err := dec(in); err != nil {
@@ -357,6 +579,186 @@ func _UserService_DeleteUserAccessToken_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListUserPasskeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserPasskeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserPasskeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserPasskeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserPasskeys(ctx, req.(*ListUserPasskeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserPasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserPasskeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserPasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserPasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserPasskey(ctx, req.(*DeleteUserPasskeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserSessions(ctx, req.(*ListUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeUserSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeUserSession(ctx, req.(*RevokeUserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ImpersonateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImpersonateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImpersonateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImpersonateUser(ctx, req.(*ImpersonateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserEmailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserEmails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserEmails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserEmails(ctx, req.(*ListUserEmailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUserEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUserEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUserEmail(ctx, req.(*CreateUserEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserEmail(ctx, req.(*DeleteUserEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetUserPrimaryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserPrimaryEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetUserPrimaryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetUserPrimaryEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetUserPrimaryEmail(ctx, req.(*SetUserPrimaryEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserPublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserPublicProfile(ctx, req.(*GetUserPublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -384,6 +786,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "DeleteMyAccount",
+			Handler:    _UserService_DeleteMyAccount_Handler,
+		},
 		{
 			MethodName: "ListUserAccessTokens",
 			Handler:    _UserService_ListUserAccessTokens_Handler,
@@ -396,6 +802,46 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserAccessToken",
 			Handler:    _UserService_DeleteUserAccessToken_Handler,
 		},
+		{
+			MethodName: "ListUserPasskeys",
+			Handler:    _UserService_ListUserPasskeys_Handler,
+		},
+		{
+			MethodName: "DeleteUserPasskey",
+			Handler:    _UserService_DeleteUserPasskey_Handler,
+		},
+		{
+			MethodName: "ListUserSessions",
+			Handler:    _UserService_ListUserSessions_Handler,
+		},
+		{
+			MethodName: "RevokeUserSession",
+			Handler:    _UserService_RevokeUserSession_Handler,
+		},
+		{
+			MethodName: "ImpersonateUser",
+			Handler:    _UserService_ImpersonateUser_Handler,
+		},
+		{
+			MethodName: "ListUserEmails",
+			Handler:    _UserService_ListUserEmails_Handler,
+		},
+		{
+			MethodName: "CreateUserEmail",
+			Handler:    _UserService_CreateUserEmail_Handler,
+		},
+		{
+			MethodName: "DeleteUserEmail",
+			Handler:    _UserService_DeleteUserEmail_Handler,
+		},
+		{
+			MethodName: "SetUserPrimaryEmail",
+			Handler:    _UserService_SetUserPrimaryEmail_Handler,
+		},
+		{
+			MethodName: "GetUserPublicProfile",
+			Handler:    _UserService_GetUserPublicProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/user_service.proto",
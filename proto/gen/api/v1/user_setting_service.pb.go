@@ -192,11 +192,16 @@ func (x *UpdateUserSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 }
 
 type UserSetting_GeneralSetting struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
-	ColorTheme    string                 `protobuf:"bytes,2,opt,name=color_theme,json=colorTheme,proto3" json:"color_theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Locale     string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	ColorTheme string                 `protobuf:"bytes,2,opt,name=color_theme,json=colorTheme,proto3" json:"color_theme,omitempty"`
+	// Whether the public profile page of the user is disabled.
+	DisablePublicProfile bool `protobuf:"varint,3,opt,name=disable_public_profile,json=disablePublicProfile,proto3" json:"disable_public_profile,omitempty"`
+	// Whether to confirm the redirects to the external domains of the workspace, "ALWAYS" or "NEVER".
+	// "WORKSPACE" or empty follows the workspace.
+	ExternalRedirectConfirmation string `protobuf:"bytes,4,opt,name=external_redirect_confirmation,json=externalRedirectConfirmation,proto3" json:"external_redirect_confirmation,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *UserSetting_GeneralSetting) Reset() {
@@ -243,6 +248,20 @@ func (x *UserSetting_GeneralSetting) GetColorTheme() string {
 	return ""
 }
 
+func (x *UserSetting_GeneralSetting) GetDisablePublicProfile() bool {
+	if x != nil {
+		return x.DisablePublicProfile
+	}
+	return false
+}
+
+func (x *UserSetting_GeneralSetting) GetExternalRedirectConfirmation() string {
+	if x != nil {
+		return x.ExternalRedirectConfirmation
+	}
+	return ""
+}
+
 type UserSetting_AccessTokensSetting struct {
 	state         protoimpl.MessageState                         `protogen:"open.v1"`
 	AccessTokens  []*UserSetting_AccessTokensSetting_AccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"` // Nested repeated field
@@ -345,15 +364,17 @@ var File_api_v1_user_setting_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_user_setting_service_proto_rawDesc = "" +
 	"\n" +
-	"!api/v1/user_setting_service.proto\x12\fslash.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a google/protobuf/field_mask.proto\"\xd5\x03\n" +
+	"!api/v1/user_setting_service.proto\x12\fslash.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a google/protobuf/field_mask.proto\"\xd2\x04\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12B\n" +
 	"\ageneral\x18\x02 \x01(\v2(.slash.api.v1.UserSetting.GeneralSettingR\ageneral\x12R\n" +
-	"\raccess_tokens\x18\x03 \x01(\v2-.slash.api.v1.UserSetting.AccessTokensSettingR\faccessTokens\x1aI\n" +
+	"\raccess_tokens\x18\x03 \x01(\v2-.slash.api.v1.UserSetting.AccessTokensSettingR\faccessTokens\x1a\xc5\x01\n" +
 	"\x0eGeneralSetting\x12\x16\n" +
 	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x1f\n" +
 	"\vcolor_theme\x18\x02 \x01(\tR\n" +
-	"colorTheme\x1a\xc9\x01\n" +
+	"colorTheme\x124\n" +
+	"\x16disable_public_profile\x18\x03 \x01(\bR\x14disablePublicProfile\x12D\n" +
+	"\x1eexternal_redirect_confirmation\x18\x04 \x01(\tR\x1cexternalRedirectConfirmation\x1a\xc9\x01\n" +
 	"\x13AccessTokensSetting\x12^\n" +
 	"\raccess_tokens\x18\x01 \x03(\v29.slash.api.v1.UserSetting.AccessTokensSetting.AccessTokenR\faccessTokens\x1aR\n" +
 	"\vAccessToken\x12!\n" +
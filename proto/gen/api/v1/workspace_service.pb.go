@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,11 +24,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LandingSetting_Type int32
+
+const (
+	// Serves the app.
+	LandingSetting_TYPE_UNSPECIFIED LandingSetting_Type = 0
+	// Redirects to a collection.
+	LandingSetting_COLLECTION LandingSetting_Type = 1
+	// Serves a custom HTML page, e.g. a marketing page.
+	LandingSetting_PAGE LandingSetting_Type = 2
+	// Redirects to an external url, e.g. the intranet.
+	LandingSetting_REDIRECT LandingSetting_Type = 3
+)
+
+// Enum value maps for LandingSetting_Type.
+var (
+	LandingSetting_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "COLLECTION",
+		2: "PAGE",
+		3: "REDIRECT",
+	}
+	LandingSetting_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"COLLECTION":       1,
+		"PAGE":             2,
+		"REDIRECT":         3,
+	}
+)
+
+func (x LandingSetting_Type) Enum() *LandingSetting_Type {
+	p := new(LandingSetting_Type)
+	*p = x
+	return p
+}
+
+func (x LandingSetting_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LandingSetting_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[0].Descriptor()
+}
+
+func (LandingSetting_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[0]
+}
+
+func (x LandingSetting_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LandingSetting_Type.Descriptor instead.
+func (LandingSetting_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 0}
+}
+
 type IdentityProvider_Type int32
 
 const (
 	IdentityProvider_TYPE_UNSPECIFIED IdentityProvider_Type = 0
 	IdentityProvider_OAUTH2           IdentityProvider_Type = 1
+	IdentityProvider_SAML             IdentityProvider_Type = 2
+	IdentityProvider_LDAP             IdentityProvider_Type = 3
 )
 
 // Enum value maps for IdentityProvider_Type.
@@ -35,10 +94,14 @@ var (
 	IdentityProvider_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "OAUTH2",
+		2: "SAML",
+		3: "LDAP",
 	}
 	IdentityProvider_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"OAUTH2":           1,
+		"SAML":             2,
+		"LDAP":             3,
 	}
 )
 
@@ -53,11 +116,11 @@ func (x IdentityProvider_Type) String() string {
 }
 
 func (IdentityProvider_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_workspace_service_proto_enumTypes[0].Descriptor()
+	return file_api_v1_workspace_service_proto_enumTypes[1].Descriptor()
 }
 
 func (IdentityProvider_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_workspace_service_proto_enumTypes[0]
+	return &file_api_v1_workspace_service_proto_enumTypes[1]
 }
 
 func (x IdentityProvider_Type) Number() protoreflect.EnumNumber {
@@ -66,7 +129,105 @@ func (x IdentityProvider_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IdentityProvider_Type.Descriptor instead.
 func (IdentityProvider_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 0}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type SmtpConfig_Encryption int32
+
+const (
+	SmtpConfig_ENCRYPTION_UNSPECIFIED SmtpConfig_Encryption = 0
+	SmtpConfig_SSL_TLS                SmtpConfig_Encryption = 1
+	SmtpConfig_STARTTLS               SmtpConfig_Encryption = 2
+)
+
+// Enum value maps for SmtpConfig_Encryption.
+var (
+	SmtpConfig_Encryption_name = map[int32]string{
+		0: "ENCRYPTION_UNSPECIFIED",
+		1: "SSL_TLS",
+		2: "STARTTLS",
+	}
+	SmtpConfig_Encryption_value = map[string]int32{
+		"ENCRYPTION_UNSPECIFIED": 0,
+		"SSL_TLS":                1,
+		"STARTTLS":               2,
+	}
+)
+
+func (x SmtpConfig_Encryption) Enum() *SmtpConfig_Encryption {
+	p := new(SmtpConfig_Encryption)
+	*p = x
+	return p
+}
+
+func (x SmtpConfig_Encryption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SmtpConfig_Encryption) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[2].Descriptor()
+}
+
+func (SmtpConfig_Encryption) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[2]
+}
+
+func (x SmtpConfig_Encryption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SmtpConfig_Encryption.Descriptor instead.
+func (SmtpConfig_Encryption) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type ExportWorkspaceRequest_Format int32
+
+const (
+	ExportWorkspaceRequest_FORMAT_UNSPECIFIED ExportWorkspaceRequest_Format = 0
+	ExportWorkspaceRequest_JSON               ExportWorkspaceRequest_Format = 1
+	ExportWorkspaceRequest_CSV                ExportWorkspaceRequest_Format = 2
+)
+
+// Enum value maps for ExportWorkspaceRequest_Format.
+var (
+	ExportWorkspaceRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "JSON",
+		2: "CSV",
+	}
+	ExportWorkspaceRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"JSON":               1,
+		"CSV":                2,
+	}
+)
+
+func (x ExportWorkspaceRequest_Format) Enum() *ExportWorkspaceRequest_Format {
+	p := new(ExportWorkspaceRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportWorkspaceRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportWorkspaceRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ExportWorkspaceRequest_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[3]
+}
+
+func (x ExportWorkspaceRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportWorkspaceRequest_Format.Descriptor instead.
+func (ExportWorkspaceRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type WorkspaceProfile struct {
@@ -176,8 +337,55 @@ type WorkspaceSetting struct {
 	DisallowUserRegistration bool `protobuf:"varint,6,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
 	// Whether to disallow password authentication.
 	DisallowPasswordAuth bool `protobuf:"varint,7,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// The number of days after which unused access tokens are revoked.
+	// 0 means access tokens are never revoked for inactivity.
+	AccessTokenInactivityDays int32 `protobuf:"varint,8,opt,name=access_token_inactivity_days,json=accessTokenInactivityDays,proto3" json:"access_token_inactivity_days,omitempty"`
+	// The alerts on traffic spikes and drops of shortcuts.
+	AnomalyAlert *AnomalyAlertSetting `protobuf:"bytes,9,opt,name=anomaly_alert,json=anomalyAlert,proto3" json:"anomaly_alert,omitempty"`
+	// The sync of the shortcuts with a file in a GitHub repository. Only visible to admins.
+	GitSync *GitSyncSetting `protobuf:"bytes,10,opt,name=git_sync,json=gitSync,proto3" json:"git_sync,omitempty"`
+	// The behavior when the shortcut doesn't exist.
+	NotFound *NotFoundSetting `protobuf:"bytes,11,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	// The admin-defined collection templates, besides the built-in ones.
+	CollectionTemplates []*CollectionTemplate `protobuf:"bytes,12,rep,name=collection_templates,json=collectionTemplates,proto3" json:"collection_templates,omitempty"`
+	// The remote Slash instances to import the public shortcuts and collections from. Only visible to admins.
+	FederationSources []*FederationSource `protobuf:"bytes,13,rep,name=federation_sources,json=federationSources,proto3" json:"federation_sources,omitempty"`
+	// The rules of the query parameters stripped from the links of the shortcuts when saving.
+	LinkParamRules *LinkParamRules `protobuf:"bytes,14,opt,name=link_param_rules,json=linkParamRules,proto3" json:"link_param_rules,omitempty"`
+	// The window in seconds within which the repeated views of a shortcut from the same IP and user agent are counted once.
+	// 0 counts every view.
+	ViewDedupeWindowSeconds int32 `protobuf:"varint,15,opt,name=view_dedupe_window_seconds,json=viewDedupeWindowSeconds,proto3" json:"view_dedupe_window_seconds,omitempty"`
+	// The SMTP server to send the emails with. Only visible to admins.
+	Smtp *SmtpConfig `protobuf:"bytes,16,opt,name=smtp,proto3" json:"smtp,omitempty"`
+	// Whether the users signing up with a password have to verify their email before using Slash.
+	RequireEmailVerification bool `protobuf:"varint,17,opt,name=require_email_verification,json=requireEmailVerification,proto3" json:"require_email_verification,omitempty"`
+	// Whether to record the signed-in users viewing the shortcuts, to see who uses them in the analytics.
+	AttributeViewsToUsers bool `protobuf:"varint,18,opt,name=attribute_views_to_users,json=attributeViewsToUsers,proto3" json:"attribute_views_to_users,omitempty"`
+	// The user the shortcuts and collections are transferred to when their creator deletes their account.
+	// 0 means the users can only delete their data with their account.
+	AccountHandoverUserId int32 `protobuf:"varint,19,opt,name=account_handover_user_id,json=accountHandoverUserId,proto3" json:"account_handover_user_id,omitempty"`
+	// What the root path serves.
+	Landing *LandingSetting `protobuf:"bytes,20,opt,name=landing,proto3" json:"landing,omitempty"`
+	// The HTTP status code of the redirects of the shortcuts without one: 301, 302 or 307.
+	// 0 serves the shortcut page, which redirects in the browser with the Open Graph metadata for link previews.
+	DefaultRedirectCode int32 `protobuf:"varint,21,opt,name=default_redirect_code,json=defaultRedirectCode,proto3" json:"default_redirect_code,omitempty"`
+	// The domains of the internal links, e.g. "example.com", which also covers its subdomains.
+	InternalDomains []string `protobuf:"bytes,22,rep,name=internal_domains,json=internalDomains,proto3" json:"internal_domains,omitempty"`
+	// Whether to confirm the redirects to the domains outside of the internal domains, unless the user opts out.
+	ConfirmExternalRedirects bool `protobuf:"varint,23,opt,name=confirm_external_redirects,json=confirmExternalRedirects,proto3" json:"confirm_external_redirects,omitempty"`
+	// The daily health checks of the links of the shortcuts.
+	LinkHealthCheck *LinkHealthCheckSetting `protobuf:"bytes,24,opt,name=link_health_check,json=linkHealthCheck,proto3" json:"link_health_check,omitempty"`
+	// The completion provider suggesting the title, description and tags of the new shortcuts. Only visible to admins.
+	Completion *CompletionSetting `protobuf:"bytes,25,opt,name=completion,proto3" json:"completion,omitempty"`
+	// Whether the suggestions of the shortcut metadata are enabled.
+	CompletionEnabled bool `protobuf:"varint,26,opt,name=completion_enabled,json=completionEnabled,proto3" json:"completion_enabled,omitempty"`
+	// Whether the semantic search of the shortcuts is enabled.
+	SemanticSearchEnabled bool `protobuf:"varint,27,opt,name=semantic_search_enabled,json=semanticSearchEnabled,proto3" json:"semantic_search_enabled,omitempty"`
+	// The names the shortcuts and their aliases can't take, nor start with as their first segment, e.g. "admin".
+	// The routes of Slash, e.g. "api" and "s", are always reserved.
+	ReservedShortcutNames []string `protobuf:"bytes,28,rep,name=reserved_shortcut_names,json=reservedShortcutNames,proto3" json:"reserved_shortcut_names,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *WorkspaceSetting) Reset() {
@@ -259,31 +467,181 @@ func (x *WorkspaceSetting) GetDisallowPasswordAuth() bool {
 	return false
 }
 
-type IdentityProvider struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The unique identifier of the identity provider.
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Type          IdentityProvider_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=slash.api.v1.IdentityProvider_Type" json:"type,omitempty"`
-	Config        *IdentityProviderConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WorkspaceSetting) GetAccessTokenInactivityDays() int32 {
+	if x != nil {
+		return x.AccessTokenInactivityDays
+	}
+	return 0
 }
 
-func (x *IdentityProvider) Reset() {
-	*x = IdentityProvider{}
+func (x *WorkspaceSetting) GetAnomalyAlert() *AnomalyAlertSetting {
+	if x != nil {
+		return x.AnomalyAlert
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetGitSync() *GitSyncSetting {
+	if x != nil {
+		return x.GitSync
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetNotFound() *NotFoundSetting {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetCollectionTemplates() []*CollectionTemplate {
+	if x != nil {
+		return x.CollectionTemplates
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetFederationSources() []*FederationSource {
+	if x != nil {
+		return x.FederationSources
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetLinkParamRules() *LinkParamRules {
+	if x != nil {
+		return x.LinkParamRules
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetViewDedupeWindowSeconds() int32 {
+	if x != nil {
+		return x.ViewDedupeWindowSeconds
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting) GetSmtp() *SmtpConfig {
+	if x != nil {
+		return x.Smtp
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetRequireEmailVerification() bool {
+	if x != nil {
+		return x.RequireEmailVerification
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetAttributeViewsToUsers() bool {
+	if x != nil {
+		return x.AttributeViewsToUsers
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetAccountHandoverUserId() int32 {
+	if x != nil {
+		return x.AccountHandoverUserId
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting) GetLanding() *LandingSetting {
+	if x != nil {
+		return x.Landing
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetDefaultRedirectCode() int32 {
+	if x != nil {
+		return x.DefaultRedirectCode
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting) GetInternalDomains() []string {
+	if x != nil {
+		return x.InternalDomains
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetConfirmExternalRedirects() bool {
+	if x != nil {
+		return x.ConfirmExternalRedirects
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetLinkHealthCheck() *LinkHealthCheckSetting {
+	if x != nil {
+		return x.LinkHealthCheck
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetCompletion() *CompletionSetting {
+	if x != nil {
+		return x.Completion
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetCompletionEnabled() bool {
+	if x != nil {
+		return x.CompletionEnabled
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetSemanticSearchEnabled() bool {
+	if x != nil {
+		return x.SemanticSearchEnabled
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetReservedShortcutNames() []string {
+	if x != nil {
+		return x.ReservedShortcutNames
+	}
+	return nil
+}
+
+type CompletionSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The base url of the OpenAI-compatible API, e.g. "https://api.openai.com/v1". Empty disables the suggestions.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The API key sent as a bearer token. Empty sends none, e.g. for a local model.
+	ApiKey string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The model of the chat completions, e.g. "gpt-4o-mini".
+	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// The model of the embeddings of the semantic search, e.g. "text-embedding-3-small". Empty disables the semantic search.
+	EmbeddingModel string `protobuf:"bytes,4,opt,name=embedding_model,json=embeddingModel,proto3" json:"embedding_model,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompletionSetting) Reset() {
+	*x = CompletionSetting{}
 	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IdentityProvider) String() string {
+func (x *CompletionSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IdentityProvider) ProtoMessage() {}
+func (*CompletionSetting) ProtoMessage() {}
 
-func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
+func (x *CompletionSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -295,63 +653,63 @@ func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IdentityProvider.ProtoReflect.Descriptor instead.
-func (*IdentityProvider) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompletionSetting.ProtoReflect.Descriptor instead.
+func (*CompletionSetting) Descriptor() ([]byte, []int) {
 	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *IdentityProvider) GetId() string {
+func (x *CompletionSetting) GetEndpoint() string {
 	if x != nil {
-		return x.Id
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *IdentityProvider) GetTitle() string {
+func (x *CompletionSetting) GetApiKey() string {
 	if x != nil {
-		return x.Title
+		return x.ApiKey
 	}
 	return ""
 }
 
-func (x *IdentityProvider) GetType() IdentityProvider_Type {
+func (x *CompletionSetting) GetModel() string {
 	if x != nil {
-		return x.Type
+		return x.Model
 	}
-	return IdentityProvider_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *IdentityProvider) GetConfig() *IdentityProviderConfig {
+func (x *CompletionSetting) GetEmbeddingModel() string {
 	if x != nil {
-		return x.Config
+		return x.EmbeddingModel
 	}
-	return nil
+	return ""
 }
 
-type IdentityProviderConfig struct {
+type LinkParamRules struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Config:
-	//
-	//	*IdentityProviderConfig_Oauth2
-	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
+	// The patterns of the query parameters to strip, where "*" matches any characters, e.g. "utm_*" and "fbclid".
+	Deny []string `protobuf:"bytes,1,rep,name=deny,proto3" json:"deny,omitempty"`
+	// The patterns of the query parameters to keep even if they match the deny patterns, e.g. "utm_id".
+	Allow         []string `protobuf:"bytes,2,rep,name=allow,proto3" json:"allow,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IdentityProviderConfig) Reset() {
-	*x = IdentityProviderConfig{}
+func (x *LinkParamRules) Reset() {
+	*x = LinkParamRules{}
 	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IdentityProviderConfig) String() string {
+func (x *LinkParamRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IdentityProviderConfig) ProtoMessage() {}
+func (*LinkParamRules) ProtoMessage() {}
 
-func (x *IdentityProviderConfig) ProtoReflect() protoreflect.Message {
+func (x *LinkParamRules) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,57 +721,54 @@ func (x *IdentityProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IdentityProviderConfig.ProtoReflect.Descriptor instead.
-func (*IdentityProviderConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkParamRules.ProtoReflect.Descriptor instead.
+func (*LinkParamRules) Descriptor() ([]byte, []int) {
 	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *IdentityProviderConfig) GetConfig() isIdentityProviderConfig_Config {
+func (x *LinkParamRules) GetDeny() []string {
 	if x != nil {
-		return x.Config
+		return x.Deny
 	}
 	return nil
 }
 
-func (x *IdentityProviderConfig) GetOauth2() *IdentityProviderConfig_OAuth2Config {
+func (x *LinkParamRules) GetAllow() []string {
 	if x != nil {
-		if x, ok := x.Config.(*IdentityProviderConfig_Oauth2); ok {
-			return x.Oauth2
-		}
+		return x.Allow
 	}
 	return nil
 }
 
-type isIdentityProviderConfig_Config interface {
-	isIdentityProviderConfig_Config()
-}
-
-type IdentityProviderConfig_Oauth2 struct {
-	Oauth2 *IdentityProviderConfig_OAuth2Config `protobuf:"bytes,1,opt,name=oauth2,proto3,oneof"`
-}
-
-func (*IdentityProviderConfig_Oauth2) isIdentityProviderConfig_Config() {}
-
-type GetWorkspaceProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type LandingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  LandingSetting_Type    `protobuf:"varint,1,opt,name=type,proto3,enum=slash.api.v1.LandingSetting_Type" json:"type,omitempty"`
+	// The name of the collection to redirect to.
+	CollectionName string `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The HTML of the custom page.
+	PageHtml string `protobuf:"bytes,3,opt,name=page_html,json=pageHtml,proto3" json:"page_html,omitempty"`
+	// The external url to redirect to.
+	RedirectUrl string `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	// Whether the signed-in users get the landing too, instead of the app, which stays at /shortcuts.
+	ApplyToSignedInUsers bool `protobuf:"varint,5,opt,name=apply_to_signed_in_users,json=applyToSignedInUsers,proto3" json:"apply_to_signed_in_users,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *GetWorkspaceProfileRequest) Reset() {
-	*x = GetWorkspaceProfileRequest{}
+func (x *LandingSetting) Reset() {
+	*x = LandingSetting{}
 	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkspaceProfileRequest) String() string {
+func (x *LandingSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspaceProfileRequest) ProtoMessage() {}
+func (*LandingSetting) ProtoMessage() {}
 
-func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *LandingSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -425,32 +780,1162 @@ func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspaceProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LandingSetting.ProtoReflect.Descriptor instead.
+func (*LandingSetting) Descriptor() ([]byte, []int) {
 	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4}
 }
 
-type GetWorkspaceSettingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LandingSetting) GetType() LandingSetting_Type {
+	if x != nil {
+		return x.Type
+	}
+	return LandingSetting_TYPE_UNSPECIFIED
 }
 
-func (x *GetWorkspaceSettingRequest) Reset() {
-	*x = GetWorkspaceSettingRequest{}
+func (x *LandingSetting) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *LandingSetting) GetPageHtml() string {
+	if x != nil {
+		return x.PageHtml
+	}
+	return ""
+}
+
+func (x *LandingSetting) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *LandingSetting) GetApplyToSignedInUsers() bool {
+	if x != nil {
+		return x.ApplyToSignedInUsers
+	}
+	return false
+}
+
+type NotFoundSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The url to redirect to when the shortcut doesn't exist, where `{name}` is replaced by the shortcut name,
+	// e.g. "https://search.example.com/?q={name}". Empty shows the not found page.
+	RedirectUrl string `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	// The message of the not found page.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Whether to hide the offer to create the missing shortcut from the signed-in users.
+	DisableCreateOffer bool `protobuf:"varint,3,opt,name=disable_create_offer,json=disableCreateOffer,proto3" json:"disable_create_offer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NotFoundSetting) Reset() {
+	*x = NotFoundSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotFoundSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotFoundSetting) ProtoMessage() {}
+
+func (x *NotFoundSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotFoundSetting.ProtoReflect.Descriptor instead.
+func (*NotFoundSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NotFoundSetting) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *NotFoundSetting) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NotFoundSetting) GetDisableCreateOffer() bool {
+	if x != nil {
+		return x.DisableCreateOffer
+	}
+	return false
+}
+
+type GitSyncSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to sync the shortcuts from the file in the GitHub repository.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The GitHub repository in the format of "owner/repo".
+	Repository string `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+	// The branch of the file. Defaults to "main".
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	// The path of the YAML/JSON file of the shortcut definitions.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// The GitHub token to read the file and to open pull requests.
+	AccessToken string `protobuf:"bytes,5,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The secret to verify the signatures of the GitHub push webhooks.
+	WebhookSecret string `protobuf:"bytes,6,opt,name=webhook_secret,json=webhookSecret,proto3" json:"webhook_secret,omitempty"`
+	// Whether to propose the manual changes of the synced shortcuts as pull requests.
+	WriteBack bool `protobuf:"varint,7,opt,name=write_back,json=writeBack,proto3" json:"write_back,omitempty"`
+	// Output only. The blob sha of the last applied file.
+	LastSyncedSha string `protobuf:"bytes,8,opt,name=last_synced_sha,json=lastSyncedSha,proto3" json:"last_synced_sha,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitSyncSetting) Reset() {
+	*x = GitSyncSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitSyncSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitSyncSetting) ProtoMessage() {}
+
+func (x *GitSyncSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitSyncSetting.ProtoReflect.Descriptor instead.
+func (*GitSyncSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GitSyncSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GitSyncSetting) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *GitSyncSetting) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *GitSyncSetting) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GitSyncSetting) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GitSyncSetting) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+func (x *GitSyncSetting) GetWriteBack() bool {
+	if x != nil {
+		return x.WriteBack
+	}
+	return false
+}
+
+func (x *GitSyncSetting) GetLastSyncedSha() string {
+	if x != nil {
+		return x.LastSyncedSha
+	}
+	return ""
+}
+
+type FederationSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique id of the source.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Whether to sync the source.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The url of the remote Slash instance, e.g. "https://slash.eng.example.com".
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// The access token of a user of the remote instance to list its shortcuts and collections.
+	AccessToken string `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The prefix of the names of the imported shortcuts and collections, e.g. "eng/".
+	Prefix string `protobuf:"bytes,5,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The minutes between the syncs. Defaults to 60.
+	IntervalMinutes int32 `protobuf:"varint,6,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"`
+	// Output only. The time of the last sync, successful or not.
+	LastSyncTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	// Output only. The error of the last sync. Empty when it succeeded.
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Output only. The number of the shortcuts imported from the source.
+	ShortcutCount int32 `protobuf:"varint,9,opt,name=shortcut_count,json=shortcutCount,proto3" json:"shortcut_count,omitempty"`
+	// Output only. The number of the collections imported from the source.
+	CollectionCount int32 `protobuf:"varint,10,opt,name=collection_count,json=collectionCount,proto3" json:"collection_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FederationSource) Reset() {
+	*x = FederationSource{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FederationSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationSource) ProtoMessage() {}
+
+func (x *FederationSource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationSource.ProtoReflect.Descriptor instead.
+func (*FederationSource) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FederationSource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FederationSource) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FederationSource) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FederationSource) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *FederationSource) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *FederationSource) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *FederationSource) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *FederationSource) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *FederationSource) GetShortcutCount() int32 {
+	if x != nil {
+		return x.ShortcutCount
+	}
+	return 0
+}
+
+func (x *FederationSource) GetCollectionCount() int32 {
+	if x != nil {
+		return x.CollectionCount
+	}
+	return 0
+}
+
+type AnomalyAlertSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to detect traffic spikes and drops of shortcuts.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The webhook url to post the alerts to.
+	WebhookUrl string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// The z-score of the hourly views to be an anomaly. Defaults to 3.
+	ZScoreThreshold float64 `protobuf:"fixed64,3,opt,name=z_score_threshold,json=zScoreThreshold,proto3" json:"z_score_threshold,omitempty"`
+	// The min hourly views of a spike, or of the baseline of a drop. Defaults to 10.
+	MinViews      int32 `protobuf:"varint,4,opt,name=min_views,json=minViews,proto3" json:"min_views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyAlertSetting) Reset() {
+	*x = AnomalyAlertSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyAlertSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyAlertSetting) ProtoMessage() {}
+
+func (x *AnomalyAlertSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyAlertSetting.ProtoReflect.Descriptor instead.
+func (*AnomalyAlertSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AnomalyAlertSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AnomalyAlertSetting) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *AnomalyAlertSetting) GetZScoreThreshold() float64 {
+	if x != nil {
+		return x.ZScoreThreshold
+	}
+	return 0
+}
+
+func (x *AnomalyAlertSetting) GetMinViews() int32 {
+	if x != nil {
+		return x.MinViews
+	}
+	return 0
+}
+
+type LinkHealthCheckSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to check the links of the shortcuts daily.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The webhook url to post the newly broken links to. Only visible to admins.
+	WebhookUrl string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Whether to email the creators of the shortcuts with newly broken links, with the SMTP server of the workspace.
+	NotifyCreators bool `protobuf:"varint,3,opt,name=notify_creators,json=notifyCreators,proto3" json:"notify_creators,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LinkHealthCheckSetting) Reset() {
+	*x = LinkHealthCheckSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkHealthCheckSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkHealthCheckSetting) ProtoMessage() {}
+
+func (x *LinkHealthCheckSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkHealthCheckSetting.ProtoReflect.Descriptor instead.
+func (*LinkHealthCheckSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LinkHealthCheckSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *LinkHealthCheckSetting) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *LinkHealthCheckSetting) GetNotifyCreators() bool {
+	if x != nil {
+		return x.NotifyCreators
+	}
+	return false
+}
+
+type IdentityProvider struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the identity provider.
+	Id     string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Type   IdentityProvider_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=slash.api.v1.IdentityProvider_Type" json:"type,omitempty"`
+	Config *IdentityProviderConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// The display order of the sign in button, ascending.
+	DisplayOrder int32 `protobuf:"varint,5,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	// The icon url of the sign in button.
+	IconUrl string `protobuf:"bytes,6,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// Whether to redirect to the identity provider automatically
+	// when it's the only available sign in method.
+	AutoRedirect  bool `protobuf:"varint,7,opt,name=auto_redirect,json=autoRedirect,proto3" json:"auto_redirect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProvider) Reset() {
+	*x = IdentityProvider{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProvider) ProtoMessage() {}
+
+func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProvider.ProtoReflect.Descriptor instead.
+func (*IdentityProvider) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IdentityProvider) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetType() IdentityProvider_Type {
+	if x != nil {
+		return x.Type
+	}
+	return IdentityProvider_TYPE_UNSPECIFIED
+}
+
+func (x *IdentityProvider) GetConfig() *IdentityProviderConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *IdentityProvider) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *IdentityProvider) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetAutoRedirect() bool {
+	if x != nil {
+		return x.AutoRedirect
+	}
+	return false
+}
+
+type IdentityProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Config:
+	//
+	//	*IdentityProviderConfig_Oauth2
+	//	*IdentityProviderConfig_Saml
+	//	*IdentityProviderConfig_Ldap
+	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig) Reset() {
+	*x = IdentityProviderConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IdentityProviderConfig) GetConfig() isIdentityProviderConfig_Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig) GetOauth2() *IdentityProviderConfig_OAuth2Config {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Oauth2); ok {
+			return x.Oauth2
+		}
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig) GetSaml() *IdentityProviderConfig_SAMLConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Saml); ok {
+			return x.Saml
+		}
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig) GetLdap() *IdentityProviderConfig_LDAPConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Ldap); ok {
+			return x.Ldap
+		}
+	}
+	return nil
+}
+
+type isIdentityProviderConfig_Config interface {
+	isIdentityProviderConfig_Config()
+}
+
+type IdentityProviderConfig_Oauth2 struct {
+	Oauth2 *IdentityProviderConfig_OAuth2Config `protobuf:"bytes,1,opt,name=oauth2,proto3,oneof"`
+}
+
+type IdentityProviderConfig_Saml struct {
+	Saml *IdentityProviderConfig_SAMLConfig `protobuf:"bytes,2,opt,name=saml,proto3,oneof"`
+}
+
+type IdentityProviderConfig_Ldap struct {
+	Ldap *IdentityProviderConfig_LDAPConfig `protobuf:"bytes,3,opt,name=ldap,proto3,oneof"`
+}
+
+func (*IdentityProviderConfig_Oauth2) isIdentityProviderConfig_Config() {}
+
+func (*IdentityProviderConfig_Saml) isIdentityProviderConfig_Config() {}
+
+func (*IdentityProviderConfig_Ldap) isIdentityProviderConfig_Config() {}
+
+type GetWorkspaceProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceProfileRequest) Reset() {
+	*x = GetWorkspaceProfileRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceProfileRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{12}
+}
+
+type GetWorkspaceSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceSettingRequest) Reset() {
+	*x = GetWorkspaceSettingRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceSettingRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{13}
+}
+
+type UpdateWorkspaceSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user setting.
+	Setting *WorkspaceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	// The update mask.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceSettingRequest) Reset() {
+	*x = UpdateWorkspaceSettingRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceSettingRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *UpdateWorkspaceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type SmtpConfig struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Host       string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port       int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Username   string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password   string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Encryption SmtpConfig_Encryption  `protobuf:"varint,5,opt,name=encryption,proto3,enum=slash.api.v1.SmtpConfig_Encryption" json:"encryption,omitempty"`
+	// The sender address, e.g. "Slash <noreply@example.com>".
+	From          string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SmtpConfig) Reset() {
+	*x = SmtpConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SmtpConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmtpConfig) ProtoMessage() {}
+
+func (x *SmtpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmtpConfig.ProtoReflect.Descriptor instead.
+func (*SmtpConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SmtpConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SmtpConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SmtpConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SmtpConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SmtpConfig) GetEncryption() SmtpConfig_Encryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return SmtpConfig_ENCRYPTION_UNSPECIFIED
+}
+
+func (x *SmtpConfig) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type TestIdentityProviderRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IdentityProvider *IdentityProvider      `protobuf:"bytes,1,opt,name=identity_provider,json=identityProvider,proto3" json:"identity_provider,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TestIdentityProviderRequest) Reset() {
+	*x = TestIdentityProviderRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestIdentityProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestIdentityProviderRequest) ProtoMessage() {}
+
+func (x *TestIdentityProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestIdentityProviderRequest.ProtoReflect.Descriptor instead.
+func (*TestIdentityProviderRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TestIdentityProviderRequest) GetIdentityProvider() *IdentityProvider {
+	if x != nil {
+		return x.IdentityProvider
+	}
+	return nil
+}
+
+type TestSmtpRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SmtpConfig *SmtpConfig            `protobuf:"bytes,1,opt,name=smtp_config,json=smtpConfig,proto3" json:"smtp_config,omitempty"`
+	// The recipient of the test message. Defaults to the email of current user.
+	Recipient     string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestSmtpRequest) Reset() {
+	*x = TestSmtpRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestSmtpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSmtpRequest) ProtoMessage() {}
+
+func (x *TestSmtpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSmtpRequest.ProtoReflect.Descriptor instead.
+func (*TestSmtpRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TestSmtpRequest) GetSmtpConfig() *SmtpConfig {
+	if x != nil {
+		return x.SmtpConfig
+	}
+	return nil
+}
+
+func (x *TestSmtpRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+type TestConnectionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether all checks are passed.
+	Ok            bool                            `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Checks        []*TestConnectionResponse_Check `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestConnectionResponse) Reset() {
+	*x = TestConnectionResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnectionResponse) ProtoMessage() {}
+
+func (x *TestConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnectionResponse.ProtoReflect.Descriptor instead.
+func (*TestConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TestConnectionResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TestConnectionResponse) GetChecks() []*TestConnectionResponse_Check {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type ExportWorkspaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The format of the export. Defaults to JSON.
+	Format        ExportWorkspaceRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=slash.api.v1.ExportWorkspaceRequest_Format" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWorkspaceRequest) Reset() {
+	*x = ExportWorkspaceRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkspaceRequest) ProtoMessage() {}
+
+func (x *ExportWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportWorkspaceRequest) GetFormat() ExportWorkspaceRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportWorkspaceRequest_FORMAT_UNSPECIFIED
+}
+
+type ExportWorkspaceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content of the exported file.
+	Content       []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWorkspaceResponse) Reset() {
+	*x = ExportWorkspaceResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkspaceResponse) ProtoMessage() {}
+
+func (x *ExportWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExportWorkspaceResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportWorkspaceResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportWorkspaceResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type GetInstanceStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceStatsRequest) Reset() {
+	*x = GetInstanceStatsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkspaceSettingRequest) String() string {
+func (x *GetInstanceStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspaceSettingRequest) ProtoMessage() {}
+func (*GetInstanceStatsRequest) ProtoMessage() {}
 
-func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+func (x *GetInstanceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,36 +1946,43 @@ func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetInstanceStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{21}
 }
 
-type UpdateWorkspaceSettingRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user setting.
-	Setting *WorkspaceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
-	// The update mask.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type InstanceStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutCount   int64                  `protobuf:"varint,1,opt,name=shortcut_count,json=shortcutCount,proto3" json:"shortcut_count,omitempty"`
+	CollectionCount int64                  `protobuf:"varint,2,opt,name=collection_count,json=collectionCount,proto3" json:"collection_count,omitempty"`
+	UserCount       int64                  `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	// The number of activities, e.g. the shortcut views, not archived.
+	ActivityCount int64 `protobuf:"varint,4,opt,name=activity_count,json=activityCount,proto3" json:"activity_count,omitempty"`
+	// The estimated size of the database in bytes.
+	DatabaseSizeBytes int64 `protobuf:"varint,5,opt,name=database_size_bytes,json=databaseSizeBytes,proto3" json:"database_size_bytes,omitempty"`
+	// The ratio of the shortcut lookups by id served by the cache of this instance since it started, from 0 to 1.
+	CacheHitRate float64 `protobuf:"fixed64,6,opt,name=cache_hit_rate,json=cacheHitRate,proto3" json:"cache_hit_rate,omitempty"`
+	// The average shortcut views per minute in the last 10 minutes, across the instances.
+	RedirectsPerMinute float64 `protobuf:"fixed64,7,opt,name=redirects_per_minute,json=redirectsPerMinute,proto3" json:"redirects_per_minute,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *UpdateWorkspaceSettingRequest) Reset() {
-	*x = UpdateWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+func (x *InstanceStats) Reset() {
+	*x = InstanceStats{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWorkspaceSettingRequest) String() string {
+func (x *InstanceStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkspaceSettingRequest) ProtoMessage() {}
+func (*InstanceStats) ProtoMessage() {}
 
-func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+func (x *InstanceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,36 +1993,76 @@ func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use InstanceStats.ProtoReflect.Descriptor instead.
+func (*InstanceStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
+func (x *InstanceStats) GetShortcutCount() int64 {
 	if x != nil {
-		return x.Setting
+		return x.ShortcutCount
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateWorkspaceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *InstanceStats) GetCollectionCount() int64 {
 	if x != nil {
-		return x.UpdateMask
+		return x.CollectionCount
 	}
-	return nil
+	return 0
+}
+
+func (x *InstanceStats) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetActivityCount() int64 {
+	if x != nil {
+		return x.ActivityCount
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetDatabaseSizeBytes() int64 {
+	if x != nil {
+		return x.DatabaseSizeBytes
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetCacheHitRate() float64 {
+	if x != nil {
+		return x.CacheHitRate
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetRedirectsPerMinute() float64 {
+	if x != nil {
+		return x.RedirectsPerMinute
+	}
+	return 0
 }
 
 type IdentityProviderConfig_FieldMapping struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Identifier  string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// groups is the claim or attribute with the groups of the user.
+	// When set, the role of the user is synced from the groups on every sign in.
+	Groups string `protobuf:"bytes,3,opt,name=groups,proto3" json:"groups,omitempty"`
+	// admin_group is the group whose members are admins, the others are users.
+	AdminGroup    string `protobuf:"bytes,4,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IdentityProviderConfig_FieldMapping) Reset() {
 	*x = IdentityProviderConfig_FieldMapping{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -542,7 +2074,7 @@ func (x *IdentityProviderConfig_FieldMapping) String() string {
 func (*IdentityProviderConfig_FieldMapping) ProtoMessage() {}
 
 func (x *IdentityProviderConfig_FieldMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +2087,7 @@ func (x *IdentityProviderConfig_FieldMapping) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use IdentityProviderConfig_FieldMapping.ProtoReflect.Descriptor instead.
 func (*IdentityProviderConfig_FieldMapping) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3, 0}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *IdentityProviderConfig_FieldMapping) GetIdentifier() string {
@@ -572,22 +2104,40 @@ func (x *IdentityProviderConfig_FieldMapping) GetDisplayName() string {
 	return ""
 }
 
+func (x *IdentityProviderConfig_FieldMapping) GetGroups() string {
+	if x != nil {
+		return x.Groups
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_FieldMapping) GetAdminGroup() string {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return ""
+}
+
 type IdentityProviderConfig_OAuth2Config struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	ClientId      string                               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ClientSecret  string                               `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
-	AuthUrl       string                               `protobuf:"bytes,3,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
-	TokenUrl      string                               `protobuf:"bytes,4,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
-	UserInfoUrl   string                               `protobuf:"bytes,5,opt,name=user_info_url,json=userInfoUrl,proto3" json:"user_info_url,omitempty"`
-	Scopes        []string                             `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	state        protoimpl.MessageState               `protogen:"open.v1"`
+	ClientId     string                               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string                               `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	AuthUrl      string                               `protobuf:"bytes,3,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	TokenUrl     string                               `protobuf:"bytes,4,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	UserInfoUrl  string                               `protobuf:"bytes,5,opt,name=user_info_url,json=userInfoUrl,proto3" json:"user_info_url,omitempty"`
+	Scopes       []string                             `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	FieldMapping *IdentityProviderConfig_FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	// The issuer url of the OpenID Connect provider, e.g. "https://accounts.google.com".
+	// When set, the endpoints are discovered from its "/.well-known/openid-configuration",
+	// and the ID token is verified on sign in.
+	IssuerUrl     string `protobuf:"bytes,8,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IdentityProviderConfig_OAuth2Config) Reset() {
 	*x = IdentityProviderConfig_OAuth2Config{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +2149,7 @@ func (x *IdentityProviderConfig_OAuth2Config) String() string {
 func (*IdentityProviderConfig_OAuth2Config) ProtoMessage() {}
 
 func (x *IdentityProviderConfig_OAuth2Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +2162,7 @@ func (x *IdentityProviderConfig_OAuth2Config) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use IdentityProviderConfig_OAuth2Config.ProtoReflect.Descriptor instead.
 func (*IdentityProviderConfig_OAuth2Config) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3, 1}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{11, 1}
 }
 
 func (x *IdentityProviderConfig_OAuth2Config) GetClientId() string {
@@ -664,18 +2214,260 @@ func (x *IdentityProviderConfig_OAuth2Config) GetFieldMapping() *IdentityProvide
 	return nil
 }
 
+func (x *IdentityProviderConfig_OAuth2Config) GetIssuerUrl() string {
+	if x != nil {
+		return x.IssuerUrl
+	}
+	return ""
+}
+
+// SAMLConfig is the config of a SAML 2.0 identity provider, where Slash is the service provider.
+// The identifier of the field mapping is the attribute name of the email, or empty for the NameID.
+type IdentityProviderConfig_SAMLConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entity id of the identity provider, i.e. the issuer of the responses.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// The single sign-on url of the identity provider with the HTTP-Redirect binding.
+	SsoUrl string `protobuf:"bytes,2,opt,name=sso_url,json=ssoUrl,proto3" json:"sso_url,omitempty"`
+	// The PEM encoded certificate to verify the signatures of the identity provider.
+	Certificate   string                               `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,4,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) Reset() {
+	*x = IdentityProviderConfig_SAMLConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_SAMLConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_SAMLConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_SAMLConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_SAMLConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{11, 2}
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetSsoUrl() string {
+	if x != nil {
+		return x.SsoUrl
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+// LDAPConfig is the config of an LDAP or Active Directory server, where users sign in with their directory credentials.
+// The identifier of the field mapping is the attribute of the email, e.g. "mail".
+type IdentityProviderConfig_LDAPConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The url of the server, e.g. "ldaps://ldap.example.com:636" or "ldap://ldap.example.com:389".
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Whether to upgrade the "ldap://" connection with StartTLS.
+	StartTls bool `protobuf:"varint,2,opt,name=start_tls,json=startTls,proto3" json:"start_tls,omitempty"`
+	// The DN and password to bind with to search the users, or empty to search anonymously.
+	BindDn       string `protobuf:"bytes,3,opt,name=bind_dn,json=bindDn,proto3" json:"bind_dn,omitempty"`
+	BindPassword string `protobuf:"bytes,4,opt,name=bind_password,json=bindPassword,proto3" json:"bind_password,omitempty"`
+	// The base DN to search the users under, e.g. "ou=people,dc=example,dc=com".
+	SearchBase string `protobuf:"bytes,5,opt,name=search_base,json=searchBase,proto3" json:"search_base,omitempty"`
+	// The filter to search the user, where "%s" is replaced with the escaped username,
+	// e.g. "(uid=%s)" or "(&(objectClass=user)(sAMAccountName=%s))".
+	UserFilter    string                               `protobuf:"bytes,6,opt,name=user_filter,json=userFilter,proto3" json:"user_filter,omitempty"`
+	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) Reset() {
+	*x = IdentityProviderConfig_LDAPConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_LDAPConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_LDAPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_LDAPConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_LDAPConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{11, 3}
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetStartTls() bool {
+	if x != nil {
+		return x.StartTls
+	}
+	return false
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetBindDn() string {
+	if x != nil {
+		return x.BindDn
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetBindPassword() string {
+	if x != nil {
+		return x.BindPassword
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetSearchBase() string {
+	if x != nil {
+		return x.SearchBase
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetUserFilter() string {
+	if x != nil {
+		return x.UserFilter
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+type TestConnectionResponse_Check struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the check, e.g. "token_url".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ok   bool   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	// The diagnostic message of the check.
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestConnectionResponse_Check) Reset() {
+	*x = TestConnectionResponse_Check{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestConnectionResponse_Check) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnectionResponse_Check) ProtoMessage() {}
+
+func (x *TestConnectionResponse_Check) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnectionResponse_Check.ProtoReflect.Descriptor instead.
+func (*TestConnectionResponse_Check) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *TestConnectionResponse_Check) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestConnectionResponse_Check) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TestConnectionResponse_Check) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_api_v1_workspace_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/v1/workspace_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a!api/v1/subscription_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a google/protobuf/field_mask.proto\"\xd5\x01\n" +
+	"\x1eapi/v1/workspace_service.proto\x12\fslash.api.v1\x1a\x1fapi/v1/collection_service.proto\x1a\x13api/v1/common.proto\x1a!api/v1/subscription_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd5\x01\n" +
 	"\x10WorkspaceProfile\x12\x12\n" +
 	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x14\n" +
 	"\x05owner\x18\x03 \x01(\tR\x05owner\x12>\n" +
 	"\fsubscription\x18\x04 \x01(\v2\x1a.slash.api.v1.SubscriptionR\fsubscription\x12!\n" +
 	"\fcustom_style\x18\x05 \x01(\tR\vcustomStyle\x12\x1a\n" +
-	"\bbranding\x18\x06 \x01(\fR\bbranding\"\x80\x03\n" +
+	"\bbranding\x18\x06 \x01(\fR\bbranding\"\x8c\r\n" +
 	"\x10WorkspaceSetting\x12!\n" +
 	"\finstance_url\x18\x01 \x01(\tR\vinstanceUrl\x12\x1a\n" +
 	"\bbranding\x18\x02 \x01(\fR\bbranding\x12!\n" +
@@ -683,23 +2475,117 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x12default_visibility\x18\x04 \x01(\x0e2\x18.slash.api.v1.VisibilityR\x11defaultVisibility\x12M\n" +
 	"\x12identity_providers\x18\x05 \x03(\v2\x1e.slash.api.v1.IdentityProviderR\x11identityProviders\x12<\n" +
 	"\x1adisallow_user_registration\x18\x06 \x01(\bR\x18disallowUserRegistration\x124\n" +
-	"\x16disallow_password_auth\x18\a \x01(\bR\x14disallowPasswordAuth\"\xd9\x01\n" +
+	"\x16disallow_password_auth\x18\a \x01(\bR\x14disallowPasswordAuth\x12?\n" +
+	"\x1caccess_token_inactivity_days\x18\b \x01(\x05R\x19accessTokenInactivityDays\x12F\n" +
+	"\ranomaly_alert\x18\t \x01(\v2!.slash.api.v1.AnomalyAlertSettingR\fanomalyAlert\x127\n" +
+	"\bgit_sync\x18\n" +
+	" \x01(\v2\x1c.slash.api.v1.GitSyncSettingR\agitSync\x12:\n" +
+	"\tnot_found\x18\v \x01(\v2\x1d.slash.api.v1.NotFoundSettingR\bnotFound\x12S\n" +
+	"\x14collection_templates\x18\f \x03(\v2 .slash.api.v1.CollectionTemplateR\x13collectionTemplates\x12M\n" +
+	"\x12federation_sources\x18\r \x03(\v2\x1e.slash.api.v1.FederationSourceR\x11federationSources\x12F\n" +
+	"\x10link_param_rules\x18\x0e \x01(\v2\x1c.slash.api.v1.LinkParamRulesR\x0elinkParamRules\x12;\n" +
+	"\x1aview_dedupe_window_seconds\x18\x0f \x01(\x05R\x17viewDedupeWindowSeconds\x12,\n" +
+	"\x04smtp\x18\x10 \x01(\v2\x18.slash.api.v1.SmtpConfigR\x04smtp\x12<\n" +
+	"\x1arequire_email_verification\x18\x11 \x01(\bR\x18requireEmailVerification\x127\n" +
+	"\x18attribute_views_to_users\x18\x12 \x01(\bR\x15attributeViewsToUsers\x127\n" +
+	"\x18account_handover_user_id\x18\x13 \x01(\x05R\x15accountHandoverUserId\x126\n" +
+	"\alanding\x18\x14 \x01(\v2\x1c.slash.api.v1.LandingSettingR\alanding\x122\n" +
+	"\x15default_redirect_code\x18\x15 \x01(\x05R\x13defaultRedirectCode\x12)\n" +
+	"\x10internal_domains\x18\x16 \x03(\tR\x0finternalDomains\x12<\n" +
+	"\x1aconfirm_external_redirects\x18\x17 \x01(\bR\x18confirmExternalRedirects\x12P\n" +
+	"\x11link_health_check\x18\x18 \x01(\v2$.slash.api.v1.LinkHealthCheckSettingR\x0flinkHealthCheck\x12?\n" +
+	"\n" +
+	"completion\x18\x19 \x01(\v2\x1f.slash.api.v1.CompletionSettingR\n" +
+	"completion\x12-\n" +
+	"\x12completion_enabled\x18\x1a \x01(\bR\x11completionEnabled\x126\n" +
+	"\x17semantic_search_enabled\x18\x1b \x01(\bR\x15semanticSearchEnabled\x126\n" +
+	"\x17reserved_shortcut_names\x18\x1c \x03(\tR\x15reservedShortcutNames\"\x87\x01\n" +
+	"\x11CompletionSetting\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12'\n" +
+	"\x0fembedding_model\x18\x04 \x01(\tR\x0eembeddingModel\":\n" +
+	"\x0eLinkParamRules\x12\x12\n" +
+	"\x04deny\x18\x01 \x03(\tR\x04deny\x12\x14\n" +
+	"\x05allow\x18\x02 \x03(\tR\x05allow\"\xae\x02\n" +
+	"\x0eLandingSetting\x125\n" +
+	"\x04type\x18\x01 \x01(\x0e2!.slash.api.v1.LandingSetting.TypeR\x04type\x12'\n" +
+	"\x0fcollection_name\x18\x02 \x01(\tR\x0ecollectionName\x12\x1b\n" +
+	"\tpage_html\x18\x03 \x01(\tR\bpageHtml\x12!\n" +
+	"\fredirect_url\x18\x04 \x01(\tR\vredirectUrl\x126\n" +
+	"\x18apply_to_signed_in_users\x18\x05 \x01(\bR\x14applyToSignedInUsers\"D\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"COLLECTION\x10\x01\x12\b\n" +
+	"\x04PAGE\x10\x02\x12\f\n" +
+	"\bREDIRECT\x10\x03\"\x80\x01\n" +
+	"\x0fNotFoundSetting\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x14disable_create_offer\x18\x03 \x01(\bR\x12disableCreateOffer\"\x87\x02\n" +
+	"\x0eGitSyncSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tR\n" +
+	"repository\x12\x16\n" +
+	"\x06branch\x18\x03 \x01(\tR\x06branch\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12!\n" +
+	"\faccess_token\x18\x05 \x01(\tR\vaccessToken\x12%\n" +
+	"\x0ewebhook_secret\x18\x06 \x01(\tR\rwebhookSecret\x12\x1d\n" +
+	"\n" +
+	"write_back\x18\a \x01(\bR\twriteBack\x12&\n" +
+	"\x0flast_synced_sha\x18\b \x01(\tR\rlastSyncedSha\"\xe7\x02\n" +
+	"\x10FederationSource\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12!\n" +
+	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12\x16\n" +
+	"\x06prefix\x18\x05 \x01(\tR\x06prefix\x12)\n" +
+	"\x10interval_minutes\x18\x06 \x01(\x05R\x0fintervalMinutes\x12@\n" +
+	"\x0elast_sync_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncTime\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12%\n" +
+	"\x0eshortcut_count\x18\t \x01(\x05R\rshortcutCount\x12)\n" +
+	"\x10collection_count\x18\n" +
+	" \x01(\x05R\x0fcollectionCount\"\x99\x01\n" +
+	"\x13AnomalyAlertSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vwebhook_url\x18\x02 \x01(\tR\n" +
+	"webhookUrl\x12*\n" +
+	"\x11z_score_threshold\x18\x03 \x01(\x01R\x0fzScoreThreshold\x12\x1b\n" +
+	"\tmin_views\x18\x04 \x01(\x05R\bminViews\"|\n" +
+	"\x16LinkHealthCheckSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vwebhook_url\x18\x02 \x01(\tR\n" +
+	"webhookUrl\x12'\n" +
+	"\x0fnotify_creators\x18\x03 \x01(\bR\x0enotifyCreators\"\xd2\x02\n" +
 	"\x10IdentityProvider\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x127\n" +
 	"\x04type\x18\x03 \x01(\x0e2#.slash.api.v1.IdentityProvider.TypeR\x04type\x12<\n" +
-	"\x06config\x18\x04 \x01(\v2$.slash.api.v1.IdentityProviderConfigR\x06config\"(\n" +
+	"\x06config\x18\x04 \x01(\v2$.slash.api.v1.IdentityProviderConfigR\x06config\x12#\n" +
+	"\rdisplay_order\x18\x05 \x01(\x05R\fdisplayOrder\x12\x19\n" +
+	"\bicon_url\x18\x06 \x01(\tR\aiconUrl\x12#\n" +
+	"\rauto_redirect\x18\a \x01(\bR\fautoRedirect\"<\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
-	"\x06OAUTH2\x10\x01\"\xe1\x03\n" +
+	"\x06OAUTH2\x10\x01\x12\b\n" +
+	"\x04SAML\x10\x02\x12\b\n" +
+	"\x04LDAP\x10\x03\"\x9d\t\n" +
 	"\x16IdentityProviderConfig\x12K\n" +
-	"\x06oauth2\x18\x01 \x01(\v21.slash.api.v1.IdentityProviderConfig.OAuth2ConfigH\x00R\x06oauth2\x1aQ\n" +
+	"\x06oauth2\x18\x01 \x01(\v21.slash.api.v1.IdentityProviderConfig.OAuth2ConfigH\x00R\x06oauth2\x12E\n" +
+	"\x04saml\x18\x02 \x01(\v2/.slash.api.v1.IdentityProviderConfig.SAMLConfigH\x00R\x04saml\x12E\n" +
+	"\x04ldap\x18\x03 \x01(\v2/.slash.api.v1.IdentityProviderConfig.LDAPConfigH\x00R\x04ldap\x1a\x8a\x01\n" +
 	"\fFieldMapping\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
 	"identifier\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x1a\x9c\x02\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06groups\x18\x03 \x01(\tR\x06groups\x12\x1f\n" +
+	"\vadmin_group\x18\x04 \x01(\tR\n" +
+	"adminGroup\x1a\xbb\x02\n" +
 	"\fOAuth2Config\x12\x1b\n" +
 	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x19\n" +
@@ -707,6 +2593,25 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\ttoken_url\x18\x04 \x01(\tR\btokenUrl\x12\"\n" +
 	"\ruser_info_url\x18\x05 \x01(\tR\vuserInfoUrl\x12\x16\n" +
 	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12V\n" +
+	"\rfield_mapping\x18\a \x01(\v21.slash.api.v1.IdentityProviderConfig.FieldMappingR\ffieldMapping\x12\x1d\n" +
+	"\n" +
+	"issuer_url\x18\b \x01(\tR\tissuerUrl\x1a\xbc\x01\n" +
+	"\n" +
+	"SAMLConfig\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x17\n" +
+	"\asso_url\x18\x02 \x01(\tR\x06ssoUrl\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\x12V\n" +
+	"\rfield_mapping\x18\x04 \x01(\v21.slash.api.v1.IdentityProviderConfig.FieldMappingR\ffieldMapping\x1a\x93\x02\n" +
+	"\n" +
+	"LDAPConfig\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1b\n" +
+	"\tstart_tls\x18\x02 \x01(\bR\bstartTls\x12\x17\n" +
+	"\abind_dn\x18\x03 \x01(\tR\x06bindDn\x12#\n" +
+	"\rbind_password\x18\x04 \x01(\tR\fbindPassword\x12\x1f\n" +
+	"\vsearch_base\x18\x05 \x01(\tR\n" +
+	"searchBase\x12\x1f\n" +
+	"\vuser_filter\x18\x06 \x01(\tR\n" +
+	"userFilter\x12V\n" +
 	"\rfield_mapping\x18\a \x01(\v21.slash.api.v1.IdentityProviderConfig.FieldMappingR\ffieldMappingB\b\n" +
 	"\x06config\"\x1c\n" +
 	"\x1aGetWorkspaceProfileRequest\"\x1c\n" +
@@ -714,11 +2619,63 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x1dUpdateWorkspaceSettingRequest\x128\n" +
 	"\asetting\x18\x01 \x01(\v2\x1e.slash.api.v1.WorkspaceSettingR\asetting\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask2\xc6\x03\n" +
+	"updateMask\"\x8a\x02\n" +
+	"\n" +
+	"SmtpConfig\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12C\n" +
+	"\n" +
+	"encryption\x18\x05 \x01(\x0e2#.slash.api.v1.SmtpConfig.EncryptionR\n" +
+	"encryption\x12\x12\n" +
+	"\x04from\x18\x06 \x01(\tR\x04from\"C\n" +
+	"\n" +
+	"Encryption\x12\x1a\n" +
+	"\x16ENCRYPTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aSSL_TLS\x10\x01\x12\f\n" +
+	"\bSTARTTLS\x10\x02\"j\n" +
+	"\x1bTestIdentityProviderRequest\x12K\n" +
+	"\x11identity_provider\x18\x01 \x01(\v2\x1e.slash.api.v1.IdentityProviderR\x10identityProvider\"j\n" +
+	"\x0fTestSmtpRequest\x129\n" +
+	"\vsmtp_config\x18\x01 \x01(\v2\x18.slash.api.v1.SmtpConfigR\n" +
+	"smtpConfig\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\tR\trecipient\"\xb3\x01\n" +
+	"\x16TestConnectionResponse\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\x12B\n" +
+	"\x06checks\x18\x02 \x03(\v2*.slash.api.v1.TestConnectionResponse.CheckR\x06checks\x1aE\n" +
+	"\x05Check\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x92\x01\n" +
+	"\x16ExportWorkspaceRequest\x12C\n" +
+	"\x06format\x18\x01 \x01(\x0e2+.slash.api.v1.ExportWorkspaceRequest.FormatR\x06format\"3\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04JSON\x10\x01\x12\a\n" +
+	"\x03CSV\x10\x02\"r\n" +
+	"\x17ExportWorkspaceResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"\x19\n" +
+	"\x17GetInstanceStatsRequest\"\xaf\x02\n" +
+	"\rInstanceStats\x12%\n" +
+	"\x0eshortcut_count\x18\x01 \x01(\x03R\rshortcutCount\x12)\n" +
+	"\x10collection_count\x18\x02 \x01(\x03R\x0fcollectionCount\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x03 \x01(\x03R\tuserCount\x12%\n" +
+	"\x0eactivity_count\x18\x04 \x01(\x03R\ractivityCount\x12.\n" +
+	"\x13database_size_bytes\x18\x05 \x01(\x03R\x11databaseSizeBytes\x12$\n" +
+	"\x0ecache_hit_rate\x18\x06 \x01(\x01R\fcacheHitRate\x120\n" +
+	"\x14redirects_per_minute\x18\a \x01(\x01R\x12redirectsPerMinute2\xba\a\n" +
 	"\x10WorkspaceService\x12\x82\x01\n" +
 	"\x13GetWorkspaceProfile\x12(.slash.api.v1.GetWorkspaceProfileRequest\x1a\x1e.slash.api.v1.WorkspaceProfile\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/profile\x12\x82\x01\n" +
 	"\x13GetWorkspaceSetting\x12(.slash.api.v1.GetWorkspaceSettingRequest\x1a\x1e.slash.api.v1.WorkspaceSetting\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/setting\x12\xa7\x01\n" +
-	"\x16UpdateWorkspaceSetting\x12+.slash.api.v1.UpdateWorkspaceSettingRequest\x1a\x1e.slash.api.v1.WorkspaceSetting\"@\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x02$:\asetting2\x19/api/v1/workspace/settingB.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
+	"\x16UpdateWorkspaceSetting\x12+.slash.api.v1.UpdateWorkspaceSettingRequest\x1a\x1e.slash.api.v1.WorkspaceSetting\"@\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x02$:\asetting2\x19/api/v1/workspace/setting\x12\x9d\x01\n" +
+	"\x14TestIdentityProvider\x12).slash.api.v1.TestIdentityProviderRequest\x1a$.slash.api.v1.TestConnectionResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/workspace/identity_providers/test\x12w\n" +
+	"\bTestSmtp\x12\x1d.slash.api.v1.TestSmtpRequest\x1a$.slash.api.v1.TestConnectionResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/workspace/smtp/test\x12`\n" +
+	"\x0fExportWorkspace\x12$.slash.api.v1.ExportWorkspaceRequest\x1a%.slash.api.v1.ExportWorkspaceResponse\"\x00\x12w\n" +
+	"\x10GetInstanceStats\x12%.slash.api.v1.GetInstanceStatsRequest\x1a\x1b.slash.api.v1.InstanceStats\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/workspace/statsB.Z,github.com/warthurton/slash/proto/gen/api/v1b\x06proto3"
 
 var (
 	file_api_v1_workspace_service_proto_rawDescOnce sync.Once
@@ -732,44 +2689,97 @@ func file_api_v1_workspace_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_workspace_service_proto_rawDescData
 }
 
-var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_api_v1_workspace_service_proto_goTypes = []any{
-	(IdentityProvider_Type)(0),                  // 0: slash.api.v1.IdentityProvider.Type
-	(*WorkspaceProfile)(nil),                    // 1: slash.api.v1.WorkspaceProfile
-	(*WorkspaceSetting)(nil),                    // 2: slash.api.v1.WorkspaceSetting
-	(*IdentityProvider)(nil),                    // 3: slash.api.v1.IdentityProvider
-	(*IdentityProviderConfig)(nil),              // 4: slash.api.v1.IdentityProviderConfig
-	(*GetWorkspaceProfileRequest)(nil),          // 5: slash.api.v1.GetWorkspaceProfileRequest
-	(*GetWorkspaceSettingRequest)(nil),          // 6: slash.api.v1.GetWorkspaceSettingRequest
-	(*UpdateWorkspaceSettingRequest)(nil),       // 7: slash.api.v1.UpdateWorkspaceSettingRequest
-	(*IdentityProviderConfig_FieldMapping)(nil), // 8: slash.api.v1.IdentityProviderConfig.FieldMapping
-	(*IdentityProviderConfig_OAuth2Config)(nil), // 9: slash.api.v1.IdentityProviderConfig.OAuth2Config
-	(*Subscription)(nil),                        // 10: slash.api.v1.Subscription
-	(Visibility)(0),                             // 11: slash.api.v1.Visibility
-	(*fieldmaskpb.FieldMask)(nil),               // 12: google.protobuf.FieldMask
+	(LandingSetting_Type)(0),                    // 0: slash.api.v1.LandingSetting.Type
+	(IdentityProvider_Type)(0),                  // 1: slash.api.v1.IdentityProvider.Type
+	(SmtpConfig_Encryption)(0),                  // 2: slash.api.v1.SmtpConfig.Encryption
+	(ExportWorkspaceRequest_Format)(0),          // 3: slash.api.v1.ExportWorkspaceRequest.Format
+	(*WorkspaceProfile)(nil),                    // 4: slash.api.v1.WorkspaceProfile
+	(*WorkspaceSetting)(nil),                    // 5: slash.api.v1.WorkspaceSetting
+	(*CompletionSetting)(nil),                   // 6: slash.api.v1.CompletionSetting
+	(*LinkParamRules)(nil),                      // 7: slash.api.v1.LinkParamRules
+	(*LandingSetting)(nil),                      // 8: slash.api.v1.LandingSetting
+	(*NotFoundSetting)(nil),                     // 9: slash.api.v1.NotFoundSetting
+	(*GitSyncSetting)(nil),                      // 10: slash.api.v1.GitSyncSetting
+	(*FederationSource)(nil),                    // 11: slash.api.v1.FederationSource
+	(*AnomalyAlertSetting)(nil),                 // 12: slash.api.v1.AnomalyAlertSetting
+	(*LinkHealthCheckSetting)(nil),              // 13: slash.api.v1.LinkHealthCheckSetting
+	(*IdentityProvider)(nil),                    // 14: slash.api.v1.IdentityProvider
+	(*IdentityProviderConfig)(nil),              // 15: slash.api.v1.IdentityProviderConfig
+	(*GetWorkspaceProfileRequest)(nil),          // 16: slash.api.v1.GetWorkspaceProfileRequest
+	(*GetWorkspaceSettingRequest)(nil),          // 17: slash.api.v1.GetWorkspaceSettingRequest
+	(*UpdateWorkspaceSettingRequest)(nil),       // 18: slash.api.v1.UpdateWorkspaceSettingRequest
+	(*SmtpConfig)(nil),                          // 19: slash.api.v1.SmtpConfig
+	(*TestIdentityProviderRequest)(nil),         // 20: slash.api.v1.TestIdentityProviderRequest
+	(*TestSmtpRequest)(nil),                     // 21: slash.api.v1.TestSmtpRequest
+	(*TestConnectionResponse)(nil),              // 22: slash.api.v1.TestConnectionResponse
+	(*ExportWorkspaceRequest)(nil),              // 23: slash.api.v1.ExportWorkspaceRequest
+	(*ExportWorkspaceResponse)(nil),             // 24: slash.api.v1.ExportWorkspaceResponse
+	(*GetInstanceStatsRequest)(nil),             // 25: slash.api.v1.GetInstanceStatsRequest
+	(*InstanceStats)(nil),                       // 26: slash.api.v1.InstanceStats
+	(*IdentityProviderConfig_FieldMapping)(nil), // 27: slash.api.v1.IdentityProviderConfig.FieldMapping
+	(*IdentityProviderConfig_OAuth2Config)(nil), // 28: slash.api.v1.IdentityProviderConfig.OAuth2Config
+	(*IdentityProviderConfig_SAMLConfig)(nil),   // 29: slash.api.v1.IdentityProviderConfig.SAMLConfig
+	(*IdentityProviderConfig_LDAPConfig)(nil),   // 30: slash.api.v1.IdentityProviderConfig.LDAPConfig
+	(*TestConnectionResponse_Check)(nil),        // 31: slash.api.v1.TestConnectionResponse.Check
+	(*Subscription)(nil),                        // 32: slash.api.v1.Subscription
+	(Visibility)(0),                             // 33: slash.api.v1.Visibility
+	(*CollectionTemplate)(nil),                  // 34: slash.api.v1.CollectionTemplate
+	(*timestamppb.Timestamp)(nil),               // 35: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),               // 36: google.protobuf.FieldMask
 }
 var file_api_v1_workspace_service_proto_depIdxs = []int32{
-	10, // 0: slash.api.v1.WorkspaceProfile.subscription:type_name -> slash.api.v1.Subscription
-	11, // 1: slash.api.v1.WorkspaceSetting.default_visibility:type_name -> slash.api.v1.Visibility
-	3,  // 2: slash.api.v1.WorkspaceSetting.identity_providers:type_name -> slash.api.v1.IdentityProvider
-	0,  // 3: slash.api.v1.IdentityProvider.type:type_name -> slash.api.v1.IdentityProvider.Type
-	4,  // 4: slash.api.v1.IdentityProvider.config:type_name -> slash.api.v1.IdentityProviderConfig
-	9,  // 5: slash.api.v1.IdentityProviderConfig.oauth2:type_name -> slash.api.v1.IdentityProviderConfig.OAuth2Config
-	2,  // 6: slash.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> slash.api.v1.WorkspaceSetting
-	12, // 7: slash.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	8,  // 8: slash.api.v1.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> slash.api.v1.IdentityProviderConfig.FieldMapping
-	5,  // 9: slash.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> slash.api.v1.GetWorkspaceProfileRequest
-	6,  // 10: slash.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> slash.api.v1.GetWorkspaceSettingRequest
-	7,  // 11: slash.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> slash.api.v1.UpdateWorkspaceSettingRequest
-	1,  // 12: slash.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> slash.api.v1.WorkspaceProfile
-	2,  // 13: slash.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> slash.api.v1.WorkspaceSetting
-	2,  // 14: slash.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> slash.api.v1.WorkspaceSetting
-	12, // [12:15] is the sub-list for method output_type
-	9,  // [9:12] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	32, // 0: slash.api.v1.WorkspaceProfile.subscription:type_name -> slash.api.v1.Subscription
+	33, // 1: slash.api.v1.WorkspaceSetting.default_visibility:type_name -> slash.api.v1.Visibility
+	14, // 2: slash.api.v1.WorkspaceSetting.identity_providers:type_name -> slash.api.v1.IdentityProvider
+	12, // 3: slash.api.v1.WorkspaceSetting.anomaly_alert:type_name -> slash.api.v1.AnomalyAlertSetting
+	10, // 4: slash.api.v1.WorkspaceSetting.git_sync:type_name -> slash.api.v1.GitSyncSetting
+	9,  // 5: slash.api.v1.WorkspaceSetting.not_found:type_name -> slash.api.v1.NotFoundSetting
+	34, // 6: slash.api.v1.WorkspaceSetting.collection_templates:type_name -> slash.api.v1.CollectionTemplate
+	11, // 7: slash.api.v1.WorkspaceSetting.federation_sources:type_name -> slash.api.v1.FederationSource
+	7,  // 8: slash.api.v1.WorkspaceSetting.link_param_rules:type_name -> slash.api.v1.LinkParamRules
+	19, // 9: slash.api.v1.WorkspaceSetting.smtp:type_name -> slash.api.v1.SmtpConfig
+	8,  // 10: slash.api.v1.WorkspaceSetting.landing:type_name -> slash.api.v1.LandingSetting
+	13, // 11: slash.api.v1.WorkspaceSetting.link_health_check:type_name -> slash.api.v1.LinkHealthCheckSetting
+	6,  // 12: slash.api.v1.WorkspaceSetting.completion:type_name -> slash.api.v1.CompletionSetting
+	0,  // 13: slash.api.v1.LandingSetting.type:type_name -> slash.api.v1.LandingSetting.Type
+	35, // 14: slash.api.v1.FederationSource.last_sync_time:type_name -> google.protobuf.Timestamp
+	1,  // 15: slash.api.v1.IdentityProvider.type:type_name -> slash.api.v1.IdentityProvider.Type
+	15, // 16: slash.api.v1.IdentityProvider.config:type_name -> slash.api.v1.IdentityProviderConfig
+	28, // 17: slash.api.v1.IdentityProviderConfig.oauth2:type_name -> slash.api.v1.IdentityProviderConfig.OAuth2Config
+	29, // 18: slash.api.v1.IdentityProviderConfig.saml:type_name -> slash.api.v1.IdentityProviderConfig.SAMLConfig
+	30, // 19: slash.api.v1.IdentityProviderConfig.ldap:type_name -> slash.api.v1.IdentityProviderConfig.LDAPConfig
+	5,  // 20: slash.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> slash.api.v1.WorkspaceSetting
+	36, // 21: slash.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 22: slash.api.v1.SmtpConfig.encryption:type_name -> slash.api.v1.SmtpConfig.Encryption
+	14, // 23: slash.api.v1.TestIdentityProviderRequest.identity_provider:type_name -> slash.api.v1.IdentityProvider
+	19, // 24: slash.api.v1.TestSmtpRequest.smtp_config:type_name -> slash.api.v1.SmtpConfig
+	31, // 25: slash.api.v1.TestConnectionResponse.checks:type_name -> slash.api.v1.TestConnectionResponse.Check
+	3,  // 26: slash.api.v1.ExportWorkspaceRequest.format:type_name -> slash.api.v1.ExportWorkspaceRequest.Format
+	27, // 27: slash.api.v1.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> slash.api.v1.IdentityProviderConfig.FieldMapping
+	27, // 28: slash.api.v1.IdentityProviderConfig.SAMLConfig.field_mapping:type_name -> slash.api.v1.IdentityProviderConfig.FieldMapping
+	27, // 29: slash.api.v1.IdentityProviderConfig.LDAPConfig.field_mapping:type_name -> slash.api.v1.IdentityProviderConfig.FieldMapping
+	16, // 30: slash.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> slash.api.v1.GetWorkspaceProfileRequest
+	17, // 31: slash.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> slash.api.v1.GetWorkspaceSettingRequest
+	18, // 32: slash.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> slash.api.v1.UpdateWorkspaceSettingRequest
+	20, // 33: slash.api.v1.WorkspaceService.TestIdentityProvider:input_type -> slash.api.v1.TestIdentityProviderRequest
+	21, // 34: slash.api.v1.WorkspaceService.TestSmtp:input_type -> slash.api.v1.TestSmtpRequest
+	23, // 35: slash.api.v1.WorkspaceService.ExportWorkspace:input_type -> slash.api.v1.ExportWorkspaceRequest
+	25, // 36: slash.api.v1.WorkspaceService.GetInstanceStats:input_type -> slash.api.v1.GetInstanceStatsRequest
+	4,  // 37: slash.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> slash.api.v1.WorkspaceProfile
+	5,  // 38: slash.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> slash.api.v1.WorkspaceSetting
+	5,  // 39: slash.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> slash.api.v1.WorkspaceSetting
+	22, // 40: slash.api.v1.WorkspaceService.TestIdentityProvider:output_type -> slash.api.v1.TestConnectionResponse
+	22, // 41: slash.api.v1.WorkspaceService.TestSmtp:output_type -> slash.api.v1.TestConnectionResponse
+	24, // 42: slash.api.v1.WorkspaceService.ExportWorkspace:output_type -> slash.api.v1.ExportWorkspaceResponse
+	26, // 43: slash.api.v1.WorkspaceService.GetInstanceStats:output_type -> slash.api.v1.InstanceStats
+	37, // [37:44] is the sub-list for method output_type
+	30, // [30:37] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_workspace_service_proto_init() }
@@ -777,18 +2787,21 @@ func file_api_v1_workspace_service_proto_init() {
 	if File_api_v1_workspace_service_proto != nil {
 		return
 	}
+	file_api_v1_collection_service_proto_init()
 	file_api_v1_common_proto_init()
 	file_api_v1_subscription_service_proto_init()
-	file_api_v1_workspace_service_proto_msgTypes[3].OneofWrappers = []any{
+	file_api_v1_workspace_service_proto_msgTypes[11].OneofWrappers = []any{
 		(*IdentityProviderConfig_Oauth2)(nil),
+		(*IdentityProviderConfig_Saml)(nil),
+		(*IdentityProviderConfig_Ldap)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_workspace_service_proto_rawDesc), len(file_api_v1_workspace_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,10 @@ const (
 	WorkspaceService_GetWorkspaceProfile_FullMethodName    = "/slash.api.v1.WorkspaceService/GetWorkspaceProfile"
 	WorkspaceService_GetWorkspaceSetting_FullMethodName    = "/slash.api.v1.WorkspaceService/GetWorkspaceSetting"
 	WorkspaceService_UpdateWorkspaceSetting_FullMethodName = "/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_TestIdentityProvider_FullMethodName   = "/slash.api.v1.WorkspaceService/TestIdentityProvider"
+	WorkspaceService_TestSmtp_FullMethodName               = "/slash.api.v1.WorkspaceService/TestSmtp"
+	WorkspaceService_ExportWorkspace_FullMethodName        = "/slash.api.v1.WorkspaceService/ExportWorkspace"
+	WorkspaceService_GetInstanceStats_FullMethodName       = "/slash.api.v1.WorkspaceService/GetInstanceStats"
 )
 
 // WorkspaceServiceClient is the client API for WorkspaceService service.
@@ -31,6 +35,15 @@ type WorkspaceServiceClient interface {
 	GetWorkspaceProfile(ctx context.Context, in *GetWorkspaceProfileRequest, opts ...grpc.CallOption) (*WorkspaceProfile, error)
 	GetWorkspaceSetting(ctx context.Context, in *GetWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
 	UpdateWorkspaceSetting(ctx context.Context, in *UpdateWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
+	// TestIdentityProvider checks the identity provider config before saving it.
+	TestIdentityProvider(ctx context.Context, in *TestIdentityProviderRequest, opts ...grpc.CallOption) (*TestConnectionResponse, error)
+	// TestSmtp checks the SMTP config by connecting to the server and sending a test message.
+	TestSmtp(ctx context.Context, in *TestSmtpRequest, opts ...grpc.CallOption) (*TestConnectionResponse, error)
+	// ExportWorkspace exports all the shortcuts and collections with their metadata.
+	// The export is also streamed by the HTTP endpoint "/api/v1/export?format={json|csv}".
+	ExportWorkspace(ctx context.Context, in *ExportWorkspaceRequest, opts ...grpc.CallOption) (*ExportWorkspaceResponse, error)
+	// GetInstanceStats returns the size and the load of the instance, for capacity planning. Only for admins.
+	GetInstanceStats(ctx context.Context, in *GetInstanceStatsRequest, opts ...grpc.CallOption) (*InstanceStats, error)
 }
 
 type workspaceServiceClient struct {
@@ -71,6 +84,46 @@ func (c *workspaceServiceClient) UpdateWorkspaceSetting(ctx context.Context, in
 	return out, nil
 }
 
+func (c *workspaceServiceClient) TestIdentityProvider(ctx context.Context, in *TestIdentityProviderRequest, opts ...grpc.CallOption) (*TestConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestConnectionResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_TestIdentityProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) TestSmtp(ctx context.Context, in *TestSmtpRequest, opts ...grpc.CallOption) (*TestConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestConnectionResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_TestSmtp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ExportWorkspace(ctx context.Context, in *ExportWorkspaceRequest, opts ...grpc.CallOption) (*ExportWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportWorkspaceResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ExportWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetInstanceStats(ctx context.Context, in *GetInstanceStatsRequest, opts ...grpc.CallOption) (*InstanceStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceStats)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetInstanceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility.
@@ -78,6 +131,15 @@ type WorkspaceServiceServer interface {
 	GetWorkspaceProfile(context.Context, *GetWorkspaceProfileRequest) (*WorkspaceProfile, error)
 	GetWorkspaceSetting(context.Context, *GetWorkspaceSettingRequest) (*WorkspaceSetting, error)
 	UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error)
+	// TestIdentityProvider checks the identity provider config before saving it.
+	TestIdentityProvider(context.Context, *TestIdentityProviderRequest) (*TestConnectionResponse, error)
+	// TestSmtp checks the SMTP config by connecting to the server and sending a test message.
+	TestSmtp(context.Context, *TestSmtpRequest) (*TestConnectionResponse, error)
+	// ExportWorkspace exports all the shortcuts and collections with their metadata.
+	// The export is also streamed by the HTTP endpoint "/api/v1/export?format={json|csv}".
+	ExportWorkspace(context.Context, *ExportWorkspaceRequest) (*ExportWorkspaceResponse, error)
+	// GetInstanceStats returns the size and the load of the instance, for capacity planning. Only for admins.
+	GetInstanceStats(context.Context, *GetInstanceStatsRequest) (*InstanceStats, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -97,6 +159,18 @@ func (UnimplementedWorkspaceServiceServer) GetWorkspaceSetting(context.Context,
 func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceSetting not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) TestIdentityProvider(context.Context, *TestIdentityProviderRequest) (*TestConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestIdentityProvider not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) TestSmtp(context.Context, *TestSmtpRequest) (*TestConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestSmtp not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ExportWorkspace(context.Context, *ExportWorkspaceRequest) (*ExportWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorkspace not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetInstanceStats(context.Context, *GetInstanceStatsRequest) (*InstanceStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceStats not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 func (UnimplementedWorkspaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -172,6 +246,78 @@ func _WorkspaceService_UpdateWorkspaceSetting_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_TestIdentityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestIdentityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).TestIdentityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_TestIdentityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).TestIdentityProvider(ctx, req.(*TestIdentityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_TestSmtp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestSmtpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).TestSmtp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_TestSmtp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).TestSmtp(ctx, req.(*TestSmtpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ExportWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ExportWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ExportWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ExportWorkspace(ctx, req.(*ExportWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetInstanceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetInstanceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetInstanceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetInstanceStats(ctx, req.(*GetInstanceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +337,22 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorkspaceSetting",
 			Handler:    _WorkspaceService_UpdateWorkspaceSetting_Handler,
 		},
+		{
+			MethodName: "TestIdentityProvider",
+			Handler:    _WorkspaceService_TestIdentityProvider_Handler,
+		},
+		{
+			MethodName: "TestSmtp",
+			Handler:    _WorkspaceService_TestSmtp_Handler,
+		},
+		{
+			MethodName: "ExportWorkspace",
+			Handler:    _WorkspaceService_ExportWorkspace_Handler,
+		},
+		{
+			MethodName: "GetInstanceStats",
+			Handler:    _WorkspaceService_GetInstanceStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/workspace_service.proto",
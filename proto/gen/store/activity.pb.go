@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ActivityShortcutAnomalyPayload_Direction int32
+
+const (
+	ActivityShortcutAnomalyPayload_DIRECTION_UNSPECIFIED ActivityShortcutAnomalyPayload_Direction = 0
+	ActivityShortcutAnomalyPayload_SPIKE                 ActivityShortcutAnomalyPayload_Direction = 1
+	ActivityShortcutAnomalyPayload_DROP                  ActivityShortcutAnomalyPayload_Direction = 2
+)
+
+// Enum value maps for ActivityShortcutAnomalyPayload_Direction.
+var (
+	ActivityShortcutAnomalyPayload_Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "SPIKE",
+		2: "DROP",
+	}
+	ActivityShortcutAnomalyPayload_Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"SPIKE":                 1,
+		"DROP":                  2,
+	}
+)
+
+func (x ActivityShortcutAnomalyPayload_Direction) Enum() *ActivityShortcutAnomalyPayload_Direction {
+	p := new(ActivityShortcutAnomalyPayload_Direction)
+	*p = x
+	return p
+}
+
+func (x ActivityShortcutAnomalyPayload_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityShortcutAnomalyPayload_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_activity_proto_enumTypes[0].Descriptor()
+}
+
+func (ActivityShortcutAnomalyPayload_Direction) Type() protoreflect.EnumType {
+	return &file_store_activity_proto_enumTypes[0]
+}
+
+func (x ActivityShortcutAnomalyPayload_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityShortcutAnomalyPayload_Direction.Descriptor instead.
+func (ActivityShortcutAnomalyPayload_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type ActivityShorcutCreatePayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
@@ -66,12 +115,18 @@ func (x *ActivityShorcutCreatePayload) GetShortcutId() int32 {
 }
 
 type ActivityShorcutViewPayload struct {
-	state         protoimpl.MessageState                           `protogen:"open.v1"`
-	ShortcutId    int32                                            `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
-	Ip            string                                           `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	Referer       string                                           `protobuf:"bytes,3,opt,name=referer,proto3" json:"referer,omitempty"`
-	UserAgent     string                                           `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	Params        map[string]*ActivityShorcutViewPayload_ValueList `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state      protoimpl.MessageState                           `protogen:"open.v1"`
+	ShortcutId int32                                            `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Ip         string                                           `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Referer    string                                           `protobuf:"bytes,3,opt,name=referer,proto3" json:"referer,omitempty"`
+	UserAgent  string                                           `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Params     map[string]*ActivityShorcutViewPayload_ValueList `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The ISO 3166-1 alpha-2 country code of the visitor, from the headers of the proxy/CDN.
+	Country string `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	// The id of the signed-in visitor, when the workspace attributes the views to the users. 0 otherwise.
+	UserId int32 `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The name of the variant of the shortcut the visitor was redirected to, empty without variants.
+	Variant       string `protobuf:"bytes,8,opt,name=variant,proto3" json:"variant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,6 +196,508 @@ func (x *ActivityShorcutViewPayload) GetParams() map[string]*ActivityShorcutView
 	return nil
 }
 
+func (x *ActivityShorcutViewPayload) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ActivityShorcutViewPayload) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ActivityShorcutViewPayload) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+type ActivityShortcutAnomalyPayload struct {
+	state      protoimpl.MessageState                   `protogen:"open.v1"`
+	ShortcutId int32                                    `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Direction  ActivityShortcutAnomalyPayload_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=slash.store.ActivityShortcutAnomalyPayload_Direction" json:"direction,omitempty"`
+	// The views in the detected hour.
+	ViewCount int32 `protobuf:"varint,3,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	// The mean of the hourly views in the baseline.
+	BaselineMean  float64 `protobuf:"fixed64,4,opt,name=baseline_mean,json=baselineMean,proto3" json:"baseline_mean,omitempty"`
+	ZScore        float64 `protobuf:"fixed64,5,opt,name=z_score,json=zScore,proto3" json:"z_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityShortcutAnomalyPayload) Reset() {
+	*x = ActivityShortcutAnomalyPayload{}
+	mi := &file_store_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityShortcutAnomalyPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityShortcutAnomalyPayload) ProtoMessage() {}
+
+func (x *ActivityShortcutAnomalyPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityShortcutAnomalyPayload.ProtoReflect.Descriptor instead.
+func (*ActivityShortcutAnomalyPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityShortcutAnomalyPayload) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ActivityShortcutAnomalyPayload) GetDirection() ActivityShortcutAnomalyPayload_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return ActivityShortcutAnomalyPayload_DIRECTION_UNSPECIFIED
+}
+
+func (x *ActivityShortcutAnomalyPayload) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *ActivityShortcutAnomalyPayload) GetBaselineMean() float64 {
+	if x != nil {
+		return x.BaselineMean
+	}
+	return 0
+}
+
+func (x *ActivityShortcutAnomalyPayload) GetZScore() float64 {
+	if x != nil {
+		return x.ZScore
+	}
+	return 0
+}
+
+type ActivityShortcutClickGoalPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Target        int32                  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	ViewCount     int32                  `protobuf:"varint,3,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityShortcutClickGoalPayload) Reset() {
+	*x = ActivityShortcutClickGoalPayload{}
+	mi := &file_store_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityShortcutClickGoalPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityShortcutClickGoalPayload) ProtoMessage() {}
+
+func (x *ActivityShortcutClickGoalPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityShortcutClickGoalPayload.ProtoReflect.Descriptor instead.
+func (*ActivityShortcutClickGoalPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityShortcutClickGoalPayload) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ActivityShortcutClickGoalPayload) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *ActivityShortcutClickGoalPayload) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+type ActivityShortcutLinkBrokenPayload struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	// The status code of the last check, 0 if the link couldn't be requested.
+	StatusCode    int32  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityShortcutLinkBrokenPayload) Reset() {
+	*x = ActivityShortcutLinkBrokenPayload{}
+	mi := &file_store_activity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityShortcutLinkBrokenPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityShortcutLinkBrokenPayload) ProtoMessage() {}
+
+func (x *ActivityShortcutLinkBrokenPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityShortcutLinkBrokenPayload.ProtoReflect.Descriptor instead.
+func (*ActivityShortcutLinkBrokenPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityShortcutLinkBrokenPayload) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ActivityShortcutLinkBrokenPayload) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ActivityShortcutLinkBrokenPayload) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ActivityWorkspaceSecretRotatePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The policy applied to the access tokens signed with the previous secret.
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The access tokens re-signed with the new secret.
+	ResignedTokenCount int32 `protobuf:"varint,2,opt,name=resigned_token_count,json=resignedTokenCount,proto3" json:"resigned_token_count,omitempty"`
+	// The access tokens revoked, including the expired ones.
+	RevokedTokenCount int32 `protobuf:"varint,3,opt,name=revoked_token_count,json=revokedTokenCount,proto3" json:"revoked_token_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ActivityWorkspaceSecretRotatePayload) Reset() {
+	*x = ActivityWorkspaceSecretRotatePayload{}
+	mi := &file_store_activity_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityWorkspaceSecretRotatePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityWorkspaceSecretRotatePayload) ProtoMessage() {}
+
+func (x *ActivityWorkspaceSecretRotatePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityWorkspaceSecretRotatePayload.ProtoReflect.Descriptor instead.
+func (*ActivityWorkspaceSecretRotatePayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivityWorkspaceSecretRotatePayload) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *ActivityWorkspaceSecretRotatePayload) GetResignedTokenCount() int32 {
+	if x != nil {
+		return x.ResignedTokenCount
+	}
+	return 0
+}
+
+func (x *ActivityWorkspaceSecretRotatePayload) GetRevokedTokenCount() int32 {
+	if x != nil {
+		return x.RevokedTokenCount
+	}
+	return 0
+}
+
+type ActivityUserImpersonatePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the impersonated user. The admin is the creator of the activity.
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The time the impersonation ends, in unix seconds.
+	ExpireTs      int64  `protobuf:"varint,2,opt,name=expire_ts,json=expireTs,proto3" json:"expire_ts,omitempty"`
+	Ip            string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityUserImpersonatePayload) Reset() {
+	*x = ActivityUserImpersonatePayload{}
+	mi := &file_store_activity_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityUserImpersonatePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityUserImpersonatePayload) ProtoMessage() {}
+
+func (x *ActivityUserImpersonatePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityUserImpersonatePayload.ProtoReflect.Descriptor instead.
+func (*ActivityUserImpersonatePayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActivityUserImpersonatePayload) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ActivityUserImpersonatePayload) GetExpireTs() int64 {
+	if x != nil {
+		return x.ExpireTs
+	}
+	return 0
+}
+
+func (x *ActivityUserImpersonatePayload) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ActivityUserImpersonatePayload) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type ActivityUserBreakGlassPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email of the break-glass admin account signing in.
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// The time the break-glass session ends, in unix seconds.
+	ExpireTs      int64  `protobuf:"varint,2,opt,name=expire_ts,json=expireTs,proto3" json:"expire_ts,omitempty"`
+	Ip            string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityUserBreakGlassPayload) Reset() {
+	*x = ActivityUserBreakGlassPayload{}
+	mi := &file_store_activity_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityUserBreakGlassPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityUserBreakGlassPayload) ProtoMessage() {}
+
+func (x *ActivityUserBreakGlassPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityUserBreakGlassPayload.ProtoReflect.Descriptor instead.
+func (*ActivityUserBreakGlassPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ActivityUserBreakGlassPayload) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ActivityUserBreakGlassPayload) GetExpireTs() int64 {
+	if x != nil {
+		return x.ExpireTs
+	}
+	return 0
+}
+
+func (x *ActivityUserBreakGlassPayload) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ActivityUserBreakGlassPayload) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type ActivityArchivePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the blob with the gzipped JSON lines of the archived activities.
+	BlobId int32 `protobuf:"varint,1,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	// The type of the archived activities.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The archived activities were created in [start_ts, end_ts).
+	StartTs       int64 `protobuf:"varint,3,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	EndTs         int64 `protobuf:"varint,4,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
+	ActivityCount int32 `protobuf:"varint,5,opt,name=activity_count,json=activityCount,proto3" json:"activity_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityArchivePayload) Reset() {
+	*x = ActivityArchivePayload{}
+	mi := &file_store_activity_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityArchivePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityArchivePayload) ProtoMessage() {}
+
+func (x *ActivityArchivePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityArchivePayload.ProtoReflect.Descriptor instead.
+func (*ActivityArchivePayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ActivityArchivePayload) GetBlobId() int32 {
+	if x != nil {
+		return x.BlobId
+	}
+	return 0
+}
+
+func (x *ActivityArchivePayload) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ActivityArchivePayload) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+func (x *ActivityArchivePayload) GetEndTs() int64 {
+	if x != nil {
+		return x.EndTs
+	}
+	return 0
+}
+
+func (x *ActivityArchivePayload) GetActivityCount() int32 {
+	if x != nil {
+		return x.ActivityCount
+	}
+	return 0
+}
+
 type ActivityShorcutViewPayload_ValueList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
@@ -150,7 +707,7 @@ type ActivityShorcutViewPayload_ValueList struct {
 
 func (x *ActivityShorcutViewPayload_ValueList) Reset() {
 	*x = ActivityShorcutViewPayload_ValueList{}
-	mi := &file_store_activity_proto_msgTypes[3]
+	mi := &file_store_activity_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +719,7 @@ func (x *ActivityShorcutViewPayload_ValueList) String() string {
 func (*ActivityShorcutViewPayload_ValueList) ProtoMessage() {}
 
 func (x *ActivityShorcutViewPayload_ValueList) ProtoReflect() protoreflect.Message {
-	mi := &file_store_activity_proto_msgTypes[3]
+	mi := &file_store_activity_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,7 +749,7 @@ const file_store_activity_proto_rawDesc = "" +
 	"\x14store/activity.proto\x12\vslash.store\"?\n" +
 	"\x1cActivityShorcutCreatePayload\x12\x1f\n" +
 	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
-	"shortcutId\"\xe6\x02\n" +
+	"shortcutId\"\xb3\x03\n" +
 	"\x1aActivityShorcutViewPayload\x12\x1f\n" +
 	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
 	"shortcutId\x12\x0e\n" +
@@ -200,12 +757,61 @@ const file_store_activity_proto_rawDesc = "" +
 	"\areferer\x18\x03 \x01(\tR\areferer\x12\x1d\n" +
 	"\n" +
 	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12K\n" +
-	"\x06params\x18\x05 \x03(\v23.slash.store.ActivityShorcutViewPayload.ParamsEntryR\x06params\x1al\n" +
+	"\x06params\x18\x05 \x03(\v23.slash.store.ActivityShorcutViewPayload.ParamsEntryR\x06params\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x12\x17\n" +
+	"\auser_id\x18\a \x01(\x05R\x06userId\x12\x18\n" +
+	"\avariant\x18\b \x01(\tR\avariant\x1al\n" +
 	"\vParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12G\n" +
 	"\x05value\x18\x02 \x01(\v21.slash.store.ActivityShorcutViewPayload.ValueListR\x05value:\x028\x01\x1a#\n" +
 	"\tValueList\x12\x16\n" +
-	"\x06values\x18\x01 \x03(\tR\x06valuesB-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xb0\x02\n" +
+	"\x1eActivityShortcutAnomalyPayload\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12S\n" +
+	"\tdirection\x18\x02 \x01(\x0e25.slash.store.ActivityShortcutAnomalyPayload.DirectionR\tdirection\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x03 \x01(\x05R\tviewCount\x12#\n" +
+	"\rbaseline_mean\x18\x04 \x01(\x01R\fbaselineMean\x12\x17\n" +
+	"\az_score\x18\x05 \x01(\x01R\x06zScore\";\n" +
+	"\tDirection\x12\x19\n" +
+	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05SPIKE\x10\x01\x12\b\n" +
+	"\x04DROP\x10\x02\"z\n" +
+	" ActivityShortcutClickGoalPayload\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\x05R\x06target\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x03 \x01(\x05R\tviewCount\"{\n" +
+	"!ActivityShortcutLinkBrokenPayload\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xa0\x01\n" +
+	"$ActivityWorkspaceSecretRotatePayload\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x120\n" +
+	"\x14resigned_token_count\x18\x02 \x01(\x05R\x12resignedTokenCount\x12.\n" +
+	"\x13revoked_token_count\x18\x03 \x01(\x05R\x11revokedTokenCount\"\x85\x01\n" +
+	"\x1eActivityUserImpersonatePayload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\texpire_ts\x18\x02 \x01(\x03R\bexpireTs\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"\x81\x01\n" +
+	"\x1dActivityUserBreakGlassPayload\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
+	"\texpire_ts\x18\x02 \x01(\x03R\bexpireTs\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"\x9e\x01\n" +
+	"\x16ActivityArchivePayload\x12\x17\n" +
+	"\ablob_id\x18\x01 \x01(\x05R\x06blobId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x19\n" +
+	"\bstart_ts\x18\x03 \x01(\x03R\astartTs\x12\x15\n" +
+	"\x06end_ts\x18\x04 \x01(\x03R\x05endTs\x12%\n" +
+	"\x0eactivity_count\x18\x05 \x01(\x05R\ractivityCountB-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
 
 var (
 	file_store_activity_proto_rawDescOnce sync.Once
@@ -219,21 +825,31 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_activity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_store_activity_proto_goTypes = []any{
-	(*ActivityShorcutCreatePayload)(nil), // 0: slash.store.ActivityShorcutCreatePayload
-	(*ActivityShorcutViewPayload)(nil),   // 1: slash.store.ActivityShorcutViewPayload
-	nil,                                  // 2: slash.store.ActivityShorcutViewPayload.ParamsEntry
-	(*ActivityShorcutViewPayload_ValueList)(nil), // 3: slash.store.ActivityShorcutViewPayload.ValueList
+	(ActivityShortcutAnomalyPayload_Direction)(0), // 0: slash.store.ActivityShortcutAnomalyPayload.Direction
+	(*ActivityShorcutCreatePayload)(nil),          // 1: slash.store.ActivityShorcutCreatePayload
+	(*ActivityShorcutViewPayload)(nil),            // 2: slash.store.ActivityShorcutViewPayload
+	(*ActivityShortcutAnomalyPayload)(nil),        // 3: slash.store.ActivityShortcutAnomalyPayload
+	(*ActivityShortcutClickGoalPayload)(nil),      // 4: slash.store.ActivityShortcutClickGoalPayload
+	(*ActivityShortcutLinkBrokenPayload)(nil),     // 5: slash.store.ActivityShortcutLinkBrokenPayload
+	(*ActivityWorkspaceSecretRotatePayload)(nil),  // 6: slash.store.ActivityWorkspaceSecretRotatePayload
+	(*ActivityUserImpersonatePayload)(nil),        // 7: slash.store.ActivityUserImpersonatePayload
+	(*ActivityUserBreakGlassPayload)(nil),         // 8: slash.store.ActivityUserBreakGlassPayload
+	(*ActivityArchivePayload)(nil),                // 9: slash.store.ActivityArchivePayload
+	nil,                                           // 10: slash.store.ActivityShorcutViewPayload.ParamsEntry
+	(*ActivityShorcutViewPayload_ValueList)(nil),  // 11: slash.store.ActivityShorcutViewPayload.ValueList
 }
 var file_store_activity_proto_depIdxs = []int32{
-	2, // 0: slash.store.ActivityShorcutViewPayload.params:type_name -> slash.store.ActivityShorcutViewPayload.ParamsEntry
-	3, // 1: slash.store.ActivityShorcutViewPayload.ParamsEntry.value:type_name -> slash.store.ActivityShorcutViewPayload.ValueList
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	10, // 0: slash.store.ActivityShorcutViewPayload.params:type_name -> slash.store.ActivityShorcutViewPayload.ParamsEntry
+	0,  // 1: slash.store.ActivityShortcutAnomalyPayload.direction:type_name -> slash.store.ActivityShortcutAnomalyPayload.Direction
+	11, // 2: slash.store.ActivityShorcutViewPayload.ParamsEntry.value:type_name -> slash.store.ActivityShorcutViewPayload.ValueList
+	3,  // [3:3] is the sub-list for method output_type
+	3,  // [3:3] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_store_activity_proto_init() }
@@ -246,13 +862,14 @@ func file_store_activity_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_activity_proto_rawDesc), len(file_store_activity_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_activity_proto_goTypes,
 		DependencyIndexes: file_store_activity_proto_depIdxs,
+		EnumInfos:         file_store_activity_proto_enumTypes,
 		MessageInfos:      file_store_activity_proto_msgTypes,
 	}.Build()
 	File_store_activity_proto = out.File
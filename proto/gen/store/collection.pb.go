@@ -22,16 +22,19 @@ const (
 )
 
 type Collection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatorId     int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTs     int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	UpdatedTs     int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	ShortcutIds   []int32                `protobuf:"varint,9,rep,packed,name=shortcut_ids,json=shortcutIds,proto3" json:"shortcut_ids,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,10,opt,name=visibility,proto3,enum=slash.store.Visibility" json:"visibility,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs   int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs   int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	RowStatus   RowStatus              `protobuf:"varint,5,opt,name=row_status,json=rowStatus,proto3,enum=slash.store.RowStatus" json:"row_status,omitempty"`
+	Name        string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Title       string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	ShortcutIds []int32                `protobuf:"varint,9,rep,packed,name=shortcut_ids,json=shortcutIds,proto3" json:"shortcut_ids,omitempty"`
+	Visibility  Visibility             `protobuf:"varint,10,opt,name=visibility,proto3,enum=slash.store.Visibility" json:"visibility,omitempty"`
+	// The id of the team the collection is visible to, when the visibility is TEAM.
+	TeamId        int32 `protobuf:"varint,11,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -94,6 +97,13 @@ func (x *Collection) GetUpdatedTs() int64 {
 	return 0
 }
 
+func (x *Collection) GetRowStatus() RowStatus {
+	if x != nil {
+		return x.RowStatus
+	}
+	return RowStatus_ROW_STATUS_UNSPECIFIED
+}
+
 func (x *Collection) GetName() string {
 	if x != nil {
 		return x.Name
@@ -129,11 +139,165 @@ func (x *Collection) GetVisibility() Visibility {
 	return Visibility_VISIBILITY_UNSPECIFIED
 }
 
+func (x *Collection) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type CollectionTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the template, e.g. "project-onboarding".
+	Id            string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string              `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string              `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Shortcuts     []*ShortcutTemplate `protobuf:"bytes,4,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionTemplate) Reset() {
+	*x = CollectionTemplate{}
+	mi := &file_store_collection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionTemplate) ProtoMessage() {}
+
+func (x *CollectionTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_store_collection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionTemplate.ProtoReflect.Descriptor instead.
+func (*CollectionTemplate) Descriptor() ([]byte, []int) {
+	return file_store_collection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CollectionTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetShortcuts() []*ShortcutTemplate {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+type ShortcutTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name, title, link and description may contain the placeholders
+	// `{name}` and `{title}` of the collection created from the template.
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title         string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Link          string   `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	Description   string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutTemplate) Reset() {
+	*x = ShortcutTemplate{}
+	mi := &file_store_collection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutTemplate) ProtoMessage() {}
+
+func (x *ShortcutTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_store_collection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutTemplate.ProtoReflect.Descriptor instead.
+func (*ShortcutTemplate) Descriptor() ([]byte, []int) {
+	return file_store_collection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShortcutTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShortcutTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortcutTemplate) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ShortcutTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShortcutTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 var File_store_collection_proto protoreflect.FileDescriptor
 
 const file_store_collection_proto_rawDesc = "" +
 	"\n" +
-	"\x16store/collection.proto\x12\vslash.store\x1a\x12store/common.proto\"\xa1\x02\n" +
+	"\x16store/collection.proto\x12\vslash.store\x1a\x12store/common.proto\"\xf1\x02\n" +
 	"\n" +
 	"Collection\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
@@ -142,7 +306,9 @@ const file_store_collection_proto_rawDesc = "" +
 	"\n" +
 	"created_ts\x18\x03 \x01(\x03R\tcreatedTs\x12\x1d\n" +
 	"\n" +
-	"updated_ts\x18\x04 \x01(\x03R\tupdatedTs\x12\x12\n" +
+	"updated_ts\x18\x04 \x01(\x03R\tupdatedTs\x125\n" +
+	"\n" +
+	"row_status\x18\x05 \x01(\x0e2\x16.slash.store.RowStatusR\trowStatus\x12\x12\n" +
 	"\x04name\x18\x06 \x01(\tR\x04name\x12\x14\n" +
 	"\x05title\x18\a \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\b \x01(\tR\vdescription\x12!\n" +
@@ -150,7 +316,19 @@ const file_store_collection_proto_rawDesc = "" +
 	"\n" +
 	"visibility\x18\n" +
 	" \x01(\x0e2\x17.slash.store.VisibilityR\n" +
-	"visibilityB-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
+	"visibility\x12\x17\n" +
+	"\ateam_id\x18\v \x01(\x05R\x06teamId\"\x99\x01\n" +
+	"\x12CollectionTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12;\n" +
+	"\tshortcuts\x18\x04 \x03(\v2\x1d.slash.store.ShortcutTemplateR\tshortcuts\"\x86\x01\n" +
+	"\x10ShortcutTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tagsB-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
 
 var (
 	file_store_collection_proto_rawDescOnce sync.Once
@@ -164,18 +342,23 @@ func file_store_collection_proto_rawDescGZIP() []byte {
 	return file_store_collection_proto_rawDescData
 }
 
-var file_store_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_collection_proto_goTypes = []any{
-	(*Collection)(nil), // 0: slash.store.Collection
-	(Visibility)(0),    // 1: slash.store.Visibility
+	(*Collection)(nil),         // 0: slash.store.Collection
+	(*CollectionTemplate)(nil), // 1: slash.store.CollectionTemplate
+	(*ShortcutTemplate)(nil),   // 2: slash.store.ShortcutTemplate
+	(RowStatus)(0),             // 3: slash.store.RowStatus
+	(Visibility)(0),            // 4: slash.store.Visibility
 }
 var file_store_collection_proto_depIdxs = []int32{
-	1, // 0: slash.store.Collection.visibility:type_name -> slash.store.Visibility
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: slash.store.Collection.row_status:type_name -> slash.store.RowStatus
+	4, // 1: slash.store.Collection.visibility:type_name -> slash.store.Visibility
+	2, // 2: slash.store.CollectionTemplate.shortcuts:type_name -> slash.store.ShortcutTemplate
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_store_collection_proto_init() }
@@ -190,7 +373,7 @@ func file_store_collection_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_collection_proto_rawDesc), len(file_store_collection_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -76,6 +76,10 @@ const (
 	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
 	Visibility_WORKSPACE              Visibility = 1
 	Visibility_PUBLIC                 Visibility = 2
+	// Only visible to the members of the team of the resource, its creator and the admins.
+	Visibility_TEAM Visibility = 3
+	// Only visible to its creator, the admins and the users it's shared with.
+	Visibility_PRIVATE Visibility = 4
 )
 
 // Enum value maps for Visibility.
@@ -84,11 +88,15 @@ var (
 		0: "VISIBILITY_UNSPECIFIED",
 		1: "WORKSPACE",
 		2: "PUBLIC",
+		3: "TEAM",
+		4: "PRIVATE",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED": 0,
 		"WORKSPACE":              1,
 		"PUBLIC":                 2,
+		"TEAM":                   3,
+		"PRIVATE":                4,
 	}
 )
 
@@ -128,13 +136,15 @@ const file_store_common_proto_rawDesc = "" +
 	"\x16ROW_STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06NORMAL\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02*C\n" +
+	"\bARCHIVED\x10\x02*Z\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x02B-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
+	"\x06PUBLIC\x10\x02\x12\b\n" +
+	"\x04TEAM\x10\x03\x12\v\n" +
+	"\aPRIVATE\x10\x04B-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
 
 var (
 	file_store_common_proto_rawDescOnce sync.Once
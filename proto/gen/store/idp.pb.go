@@ -26,6 +26,8 @@ type IdentityProvider_Type int32
 const (
 	IdentityProvider_TYPE_UNSPECIFIED IdentityProvider_Type = 0
 	IdentityProvider_OAUTH2           IdentityProvider_Type = 1
+	IdentityProvider_SAML             IdentityProvider_Type = 2
+	IdentityProvider_LDAP             IdentityProvider_Type = 3
 )
 
 // Enum value maps for IdentityProvider_Type.
@@ -33,10 +35,14 @@ var (
 	IdentityProvider_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "OAUTH2",
+		2: "SAML",
+		3: "LDAP",
 	}
 	IdentityProvider_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"OAUTH2":           1,
+		"SAML":             2,
+		"LDAP":             3,
 	}
 )
 
@@ -70,10 +76,17 @@ func (IdentityProvider_Type) EnumDescriptor() ([]byte, []int) {
 type IdentityProvider struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The unique identifier of the identity provider.
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Type          IdentityProvider_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=slash.store.IdentityProvider_Type" json:"type,omitempty"`
-	Config        *IdentityProviderConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	Id     string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Type   IdentityProvider_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=slash.store.IdentityProvider_Type" json:"type,omitempty"`
+	Config *IdentityProviderConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// The display order of the sign in button, ascending.
+	DisplayOrder int32 `protobuf:"varint,5,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	// The icon url of the sign in button.
+	IconUrl string `protobuf:"bytes,6,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// Whether to redirect to the identity provider automatically
+	// when it's the only available sign in method.
+	AutoRedirect  bool `protobuf:"varint,7,opt,name=auto_redirect,json=autoRedirect,proto3" json:"auto_redirect,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -136,11 +149,34 @@ func (x *IdentityProvider) GetConfig() *IdentityProviderConfig {
 	return nil
 }
 
+func (x *IdentityProvider) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *IdentityProvider) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetAutoRedirect() bool {
+	if x != nil {
+		return x.AutoRedirect
+	}
+	return false
+}
+
 type IdentityProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Config:
 	//
 	//	*IdentityProviderConfig_Oauth2
+	//	*IdentityProviderConfig_Saml
+	//	*IdentityProviderConfig_Ldap
 	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -192,6 +228,24 @@ func (x *IdentityProviderConfig) GetOauth2() *IdentityProviderConfig_OAuth2Confi
 	return nil
 }
 
+func (x *IdentityProviderConfig) GetSaml() *IdentityProviderConfig_SAMLConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Saml); ok {
+			return x.Saml
+		}
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig) GetLdap() *IdentityProviderConfig_LDAPConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Ldap); ok {
+			return x.Ldap
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderConfig_Config interface {
 	isIdentityProviderConfig_Config()
 }
@@ -200,12 +254,29 @@ type IdentityProviderConfig_Oauth2 struct {
 	Oauth2 *IdentityProviderConfig_OAuth2Config `protobuf:"bytes,1,opt,name=oauth2,proto3,oneof"`
 }
 
+type IdentityProviderConfig_Saml struct {
+	Saml *IdentityProviderConfig_SAMLConfig `protobuf:"bytes,2,opt,name=saml,proto3,oneof"`
+}
+
+type IdentityProviderConfig_Ldap struct {
+	Ldap *IdentityProviderConfig_LDAPConfig `protobuf:"bytes,3,opt,name=ldap,proto3,oneof"`
+}
+
 func (*IdentityProviderConfig_Oauth2) isIdentityProviderConfig_Config() {}
 
+func (*IdentityProviderConfig_Saml) isIdentityProviderConfig_Config() {}
+
+func (*IdentityProviderConfig_Ldap) isIdentityProviderConfig_Config() {}
+
 type IdentityProviderConfig_FieldMapping struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Identifier  string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// groups is the claim or attribute with the groups of the user.
+	// When set, the role of the user is synced from the groups on every sign in.
+	Groups string `protobuf:"bytes,3,opt,name=groups,proto3" json:"groups,omitempty"`
+	// admin_group is the group whose members are admins, the others are users.
+	AdminGroup    string `protobuf:"bytes,4,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -254,15 +325,33 @@ func (x *IdentityProviderConfig_FieldMapping) GetDisplayName() string {
 	return ""
 }
 
+func (x *IdentityProviderConfig_FieldMapping) GetGroups() string {
+	if x != nil {
+		return x.Groups
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_FieldMapping) GetAdminGroup() string {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return ""
+}
+
 type IdentityProviderConfig_OAuth2Config struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	ClientId      string                               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ClientSecret  string                               `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
-	AuthUrl       string                               `protobuf:"bytes,3,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
-	TokenUrl      string                               `protobuf:"bytes,4,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
-	UserInfoUrl   string                               `protobuf:"bytes,5,opt,name=user_info_url,json=userInfoUrl,proto3" json:"user_info_url,omitempty"`
-	Scopes        []string                             `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	state        protoimpl.MessageState               `protogen:"open.v1"`
+	ClientId     string                               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string                               `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	AuthUrl      string                               `protobuf:"bytes,3,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	TokenUrl     string                               `protobuf:"bytes,4,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	UserInfoUrl  string                               `protobuf:"bytes,5,opt,name=user_info_url,json=userInfoUrl,proto3" json:"user_info_url,omitempty"`
+	Scopes       []string                             `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	FieldMapping *IdentityProviderConfig_FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	// The issuer url of the OpenID Connect provider, e.g. "https://accounts.google.com".
+	// When set, the endpoints are discovered from its "/.well-known/openid-configuration",
+	// and the ID token is verified on sign in.
+	IssuerUrl     string `protobuf:"bytes,8,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -346,27 +435,217 @@ func (x *IdentityProviderConfig_OAuth2Config) GetFieldMapping() *IdentityProvide
 	return nil
 }
 
+func (x *IdentityProviderConfig_OAuth2Config) GetIssuerUrl() string {
+	if x != nil {
+		return x.IssuerUrl
+	}
+	return ""
+}
+
+// SAMLConfig is the config of a SAML 2.0 identity provider, where Slash is the service provider.
+// The identifier of the field mapping is the attribute name of the email, or empty for the NameID.
+type IdentityProviderConfig_SAMLConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entity id of the identity provider, i.e. the issuer of the responses.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// The single sign-on url of the identity provider with the HTTP-Redirect binding.
+	SsoUrl string `protobuf:"bytes,2,opt,name=sso_url,json=ssoUrl,proto3" json:"sso_url,omitempty"`
+	// The PEM encoded certificate to verify the signatures of the identity provider.
+	Certificate   string                               `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,4,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) Reset() {
+	*x = IdentityProviderConfig_SAMLConfig{}
+	mi := &file_store_idp_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_SAMLConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_SAMLConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_SAMLConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_SAMLConfig) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetSsoUrl() string {
+	if x != nil {
+		return x.SsoUrl
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+// LDAPConfig is the config of an LDAP or Active Directory server, where users sign in with their directory credentials.
+// The identifier of the field mapping is the attribute of the email, e.g. "mail".
+type IdentityProviderConfig_LDAPConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The url of the server, e.g. "ldaps://ldap.example.com:636" or "ldap://ldap.example.com:389".
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Whether to upgrade the "ldap://" connection with StartTLS.
+	StartTls bool `protobuf:"varint,2,opt,name=start_tls,json=startTls,proto3" json:"start_tls,omitempty"`
+	// The DN and password to bind with to search the users, or empty to search anonymously.
+	BindDn       string `protobuf:"bytes,3,opt,name=bind_dn,json=bindDn,proto3" json:"bind_dn,omitempty"`
+	BindPassword string `protobuf:"bytes,4,opt,name=bind_password,json=bindPassword,proto3" json:"bind_password,omitempty"`
+	// The base DN to search the users under, e.g. "ou=people,dc=example,dc=com".
+	SearchBase string `protobuf:"bytes,5,opt,name=search_base,json=searchBase,proto3" json:"search_base,omitempty"`
+	// The filter to search the user, where "%s" is replaced with the escaped username,
+	// e.g. "(uid=%s)" or "(&(objectClass=user)(sAMAccountName=%s))".
+	UserFilter    string                               `protobuf:"bytes,6,opt,name=user_filter,json=userFilter,proto3" json:"user_filter,omitempty"`
+	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) Reset() {
+	*x = IdentityProviderConfig_LDAPConfig{}
+	mi := &file_store_idp_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_LDAPConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_LDAPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_LDAPConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_LDAPConfig) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetStartTls() bool {
+	if x != nil {
+		return x.StartTls
+	}
+	return false
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetBindDn() string {
+	if x != nil {
+		return x.BindDn
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetBindPassword() string {
+	if x != nil {
+		return x.BindPassword
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetSearchBase() string {
+	if x != nil {
+		return x.SearchBase
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetUserFilter() string {
+	if x != nil {
+		return x.UserFilter
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
 var File_store_idp_proto protoreflect.FileDescriptor
 
 const file_store_idp_proto_rawDesc = "" +
 	"\n" +
-	"\x0fstore/idp.proto\x12\vslash.store\"\xd7\x01\n" +
+	"\x0fstore/idp.proto\x12\vslash.store\"\xd0\x02\n" +
 	"\x10IdentityProvider\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x126\n" +
 	"\x04type\x18\x03 \x01(\x0e2\".slash.store.IdentityProvider.TypeR\x04type\x12;\n" +
-	"\x06config\x18\x04 \x01(\v2#.slash.store.IdentityProviderConfigR\x06config\"(\n" +
+	"\x06config\x18\x04 \x01(\v2#.slash.store.IdentityProviderConfigR\x06config\x12#\n" +
+	"\rdisplay_order\x18\x05 \x01(\x05R\fdisplayOrder\x12\x19\n" +
+	"\bicon_url\x18\x06 \x01(\tR\aiconUrl\x12#\n" +
+	"\rauto_redirect\x18\a \x01(\bR\fautoRedirect\"<\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
-	"\x06OAUTH2\x10\x01\"\xdf\x03\n" +
+	"\x06OAUTH2\x10\x01\x12\b\n" +
+	"\x04SAML\x10\x02\x12\b\n" +
+	"\x04LDAP\x10\x03\"\x97\t\n" +
 	"\x16IdentityProviderConfig\x12J\n" +
-	"\x06oauth2\x18\x01 \x01(\v20.slash.store.IdentityProviderConfig.OAuth2ConfigH\x00R\x06oauth2\x1aQ\n" +
+	"\x06oauth2\x18\x01 \x01(\v20.slash.store.IdentityProviderConfig.OAuth2ConfigH\x00R\x06oauth2\x12D\n" +
+	"\x04saml\x18\x02 \x01(\v2..slash.store.IdentityProviderConfig.SAMLConfigH\x00R\x04saml\x12D\n" +
+	"\x04ldap\x18\x03 \x01(\v2..slash.store.IdentityProviderConfig.LDAPConfigH\x00R\x04ldap\x1a\x8a\x01\n" +
 	"\fFieldMapping\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
 	"identifier\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x1a\x9b\x02\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06groups\x18\x03 \x01(\tR\x06groups\x12\x1f\n" +
+	"\vadmin_group\x18\x04 \x01(\tR\n" +
+	"adminGroup\x1a\xba\x02\n" +
 	"\fOAuth2Config\x12\x1b\n" +
 	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x19\n" +
@@ -374,6 +653,25 @@ const file_store_idp_proto_rawDesc = "" +
 	"\ttoken_url\x18\x04 \x01(\tR\btokenUrl\x12\"\n" +
 	"\ruser_info_url\x18\x05 \x01(\tR\vuserInfoUrl\x12\x16\n" +
 	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12U\n" +
+	"\rfield_mapping\x18\a \x01(\v20.slash.store.IdentityProviderConfig.FieldMappingR\ffieldMapping\x12\x1d\n" +
+	"\n" +
+	"issuer_url\x18\b \x01(\tR\tissuerUrl\x1a\xbb\x01\n" +
+	"\n" +
+	"SAMLConfig\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x17\n" +
+	"\asso_url\x18\x02 \x01(\tR\x06ssoUrl\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\x12U\n" +
+	"\rfield_mapping\x18\x04 \x01(\v20.slash.store.IdentityProviderConfig.FieldMappingR\ffieldMapping\x1a\x92\x02\n" +
+	"\n" +
+	"LDAPConfig\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1b\n" +
+	"\tstart_tls\x18\x02 \x01(\bR\bstartTls\x12\x17\n" +
+	"\abind_dn\x18\x03 \x01(\tR\x06bindDn\x12#\n" +
+	"\rbind_password\x18\x04 \x01(\tR\fbindPassword\x12\x1f\n" +
+	"\vsearch_base\x18\x05 \x01(\tR\n" +
+	"searchBase\x12\x1f\n" +
+	"\vuser_filter\x18\x06 \x01(\tR\n" +
+	"userFilter\x12U\n" +
 	"\rfield_mapping\x18\a \x01(\v20.slash.store.IdentityProviderConfig.FieldMappingR\ffieldMappingB\b\n" +
 	"\x06configB-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
 
@@ -390,24 +688,30 @@ func file_store_idp_proto_rawDescGZIP() []byte {
 }
 
 var file_store_idp_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_idp_proto_goTypes = []any{
 	(IdentityProvider_Type)(0),                  // 0: slash.store.IdentityProvider.Type
 	(*IdentityProvider)(nil),                    // 1: slash.store.IdentityProvider
 	(*IdentityProviderConfig)(nil),              // 2: slash.store.IdentityProviderConfig
 	(*IdentityProviderConfig_FieldMapping)(nil), // 3: slash.store.IdentityProviderConfig.FieldMapping
 	(*IdentityProviderConfig_OAuth2Config)(nil), // 4: slash.store.IdentityProviderConfig.OAuth2Config
+	(*IdentityProviderConfig_SAMLConfig)(nil),   // 5: slash.store.IdentityProviderConfig.SAMLConfig
+	(*IdentityProviderConfig_LDAPConfig)(nil),   // 6: slash.store.IdentityProviderConfig.LDAPConfig
 }
 var file_store_idp_proto_depIdxs = []int32{
 	0, // 0: slash.store.IdentityProvider.type:type_name -> slash.store.IdentityProvider.Type
 	2, // 1: slash.store.IdentityProvider.config:type_name -> slash.store.IdentityProviderConfig
 	4, // 2: slash.store.IdentityProviderConfig.oauth2:type_name -> slash.store.IdentityProviderConfig.OAuth2Config
-	3, // 3: slash.store.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> slash.store.IdentityProviderConfig.FieldMapping
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 3: slash.store.IdentityProviderConfig.saml:type_name -> slash.store.IdentityProviderConfig.SAMLConfig
+	6, // 4: slash.store.IdentityProviderConfig.ldap:type_name -> slash.store.IdentityProviderConfig.LDAPConfig
+	3, // 5: slash.store.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> slash.store.IdentityProviderConfig.FieldMapping
+	3, // 6: slash.store.IdentityProviderConfig.SAMLConfig.field_mapping:type_name -> slash.store.IdentityProviderConfig.FieldMapping
+	3, // 7: slash.store.IdentityProviderConfig.LDAPConfig.field_mapping:type_name -> slash.store.IdentityProviderConfig.FieldMapping
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_store_idp_proto_init() }
@@ -417,6 +721,8 @@ func file_store_idp_proto_init() {
 	}
 	file_store_idp_proto_msgTypes[1].OneofWrappers = []any{
 		(*IdentityProviderConfig_Oauth2)(nil),
+		(*IdentityProviderConfig_Saml)(nil),
+		(*IdentityProviderConfig_Ldap)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -424,7 +730,7 @@ func file_store_idp_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_idp_proto_rawDesc), len(file_store_idp_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
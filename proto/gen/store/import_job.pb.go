@@ -0,0 +1,338 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.28.3
+// source: store/import_job.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImportJobPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shortcuts to import, in the order of the file.
+	Rows          []*ImportJobRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJobPayload) Reset() {
+	*x = ImportJobPayload{}
+	mi := &file_store_import_job_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJobPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobPayload) ProtoMessage() {}
+
+func (x *ImportJobPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_import_job_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobPayload.ProtoReflect.Descriptor instead.
+func (*ImportJobPayload) Descriptor() ([]byte, []int) {
+	return file_store_import_job_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImportJobPayload) GetRows() []*ImportJobRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ImportJobRow struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Link        string                 `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Tags        []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The visibility of the shortcut, the workspace default when unspecified.
+	Visibility    Visibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=slash.store.Visibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJobRow) Reset() {
+	*x = ImportJobRow{}
+	mi := &file_store_import_job_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJobRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobRow) ProtoMessage() {}
+
+func (x *ImportJobRow) ProtoReflect() protoreflect.Message {
+	mi := &file_store_import_job_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobRow.ProtoReflect.Descriptor instead.
+func (*ImportJobRow) Descriptor() ([]byte, []int) {
+	return file_store_import_job_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportJobRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportJobRow) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportJobRow) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ImportJobRow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportJobRow) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportJobRow) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+type ImportJobResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The errors of the rows which failed, at most the first 1000.
+	RowErrors     []*ImportJobRowError `protobuf:"bytes,1,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJobResult) Reset() {
+	*x = ImportJobResult{}
+	mi := &file_store_import_job_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobResult) ProtoMessage() {}
+
+func (x *ImportJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_store_import_job_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobResult.ProtoReflect.Descriptor instead.
+func (*ImportJobResult) Descriptor() ([]byte, []int) {
+	return file_store_import_job_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportJobResult) GetRowErrors() []*ImportJobRowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+type ImportJobRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The 1-based index of the row.
+	Row           int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJobRowError) Reset() {
+	*x = ImportJobRowError{}
+	mi := &file_store_import_job_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJobRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobRowError) ProtoMessage() {}
+
+func (x *ImportJobRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_store_import_job_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobRowError.ProtoReflect.Descriptor instead.
+func (*ImportJobRowError) Descriptor() ([]byte, []int) {
+	return file_store_import_job_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportJobRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportJobRowError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportJobRowError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_store_import_job_proto protoreflect.FileDescriptor
+
+const file_store_import_job_proto_rawDesc = "" +
+	"\n" +
+	"\x16store/import_job.proto\x12\vslash.store\x1a\x12store/common.proto\"A\n" +
+	"\x10ImportJobPayload\x12-\n" +
+	"\x04rows\x18\x01 \x03(\v2\x19.slash.store.ImportJobRowR\x04rows\"\xbb\x01\n" +
+	"\fImportJobRow\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x127\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\x0e2\x17.slash.store.VisibilityR\n" +
+	"visibility\"P\n" +
+	"\x0fImportJobResult\x12=\n" +
+	"\n" +
+	"row_errors\x18\x01 \x03(\v2\x1e.slash.store.ImportJobRowErrorR\trowErrors\"O\n" +
+	"\x11ImportJobRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05errorB-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
+
+var (
+	file_store_import_job_proto_rawDescOnce sync.Once
+	file_store_import_job_proto_rawDescData []byte
+)
+
+func file_store_import_job_proto_rawDescGZIP() []byte {
+	file_store_import_job_proto_rawDescOnce.Do(func() {
+		file_store_import_job_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_store_import_job_proto_rawDesc), len(file_store_import_job_proto_rawDesc)))
+	})
+	return file_store_import_job_proto_rawDescData
+}
+
+var file_store_import_job_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_import_job_proto_goTypes = []any{
+	(*ImportJobPayload)(nil),  // 0: slash.store.ImportJobPayload
+	(*ImportJobRow)(nil),      // 1: slash.store.ImportJobRow
+	(*ImportJobResult)(nil),   // 2: slash.store.ImportJobResult
+	(*ImportJobRowError)(nil), // 3: slash.store.ImportJobRowError
+	(Visibility)(0),           // 4: slash.store.Visibility
+}
+var file_store_import_job_proto_depIdxs = []int32{
+	1, // 0: slash.store.ImportJobPayload.rows:type_name -> slash.store.ImportJobRow
+	4, // 1: slash.store.ImportJobRow.visibility:type_name -> slash.store.Visibility
+	3, // 2: slash.store.ImportJobResult.row_errors:type_name -> slash.store.ImportJobRowError
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_store_import_job_proto_init() }
+func file_store_import_job_proto_init() {
+	if File_store_import_job_proto != nil {
+		return
+	}
+	file_store_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_import_job_proto_rawDesc), len(file_store_import_job_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_import_job_proto_goTypes,
+		DependencyIndexes: file_store_import_job_proto_depIdxs,
+		MessageInfos:      file_store_import_job_proto_msgTypes,
+	}.Build()
+	File_store_import_job_proto = out.File
+	file_store_import_job_proto_goTypes = nil
+	file_store_import_job_proto_depIdxs = nil
+}
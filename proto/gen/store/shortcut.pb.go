@@ -21,19 +21,144 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DeviceType is the type of the device of a visitor, from its user agent.
+type DeviceType int32
+
+const (
+	DeviceType_DEVICE_TYPE_UNSPECIFIED DeviceType = 0
+	DeviceType_DESKTOP                 DeviceType = 1
+	DeviceType_MOBILE                  DeviceType = 2
+)
+
+// Enum value maps for DeviceType.
+var (
+	DeviceType_name = map[int32]string{
+		0: "DEVICE_TYPE_UNSPECIFIED",
+		1: "DESKTOP",
+		2: "MOBILE",
+	}
+	DeviceType_value = map[string]int32{
+		"DEVICE_TYPE_UNSPECIFIED": 0,
+		"DESKTOP":                 1,
+		"MOBILE":                  2,
+	}
+)
+
+func (x DeviceType) Enum() *DeviceType {
+	p := new(DeviceType)
+	*p = x
+	return p
+}
+
+func (x DeviceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeviceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_shortcut_proto_enumTypes[0].Descriptor()
+}
+
+func (DeviceType) Type() protoreflect.EnumType {
+	return &file_store_shortcut_proto_enumTypes[0]
+}
+
+func (x DeviceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeviceType.Descriptor instead.
+func (DeviceType) EnumDescriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{0}
+}
+
+// DocumentMode is how a shortcut serves the documents it links to, which are proxied by the server.
+type DocumentMode int32
+
+const (
+	// The visitors are redirected to the link.
+	DocumentMode_DOCUMENT_MODE_UNSPECIFIED DocumentMode = 0
+	// The document is shown in a viewer page.
+	DocumentMode_INLINE DocumentMode = 1
+	// The document is downloaded as an attachment.
+	DocumentMode_DOWNLOAD DocumentMode = 2
+)
+
+// Enum value maps for DocumentMode.
+var (
+	DocumentMode_name = map[int32]string{
+		0: "DOCUMENT_MODE_UNSPECIFIED",
+		1: "INLINE",
+		2: "DOWNLOAD",
+	}
+	DocumentMode_value = map[string]int32{
+		"DOCUMENT_MODE_UNSPECIFIED": 0,
+		"INLINE":                    1,
+		"DOWNLOAD":                  2,
+	}
+)
+
+func (x DocumentMode) Enum() *DocumentMode {
+	p := new(DocumentMode)
+	*p = x
+	return p
+}
+
+func (x DocumentMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_shortcut_proto_enumTypes[1].Descriptor()
+}
+
+func (DocumentMode) Type() protoreflect.EnumType {
+	return &file_store_shortcut_proto_enumTypes[1]
+}
+
+func (x DocumentMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentMode.Descriptor instead.
+func (DocumentMode) EnumDescriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{1}
+}
+
 type Shortcut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatorId     int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTs     int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	UpdatedTs     int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Link          string                 `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
-	Title         string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
-	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	Description   string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,11,opt,name=visibility,proto3,enum=slash.store.Visibility" json:"visibility,omitempty"`
-	OgMetadata    *OpenGraphMetadata     `protobuf:"bytes,12,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs   int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs   int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	RowStatus   RowStatus              `protobuf:"varint,5,opt,name=row_status,json=rowStatus,proto3,enum=slash.store.RowStatus" json:"row_status,omitempty"`
+	Name        string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Link        string                 `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	Title       string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Tags        []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Description string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  Visibility             `protobuf:"varint,11,opt,name=visibility,proto3,enum=slash.store.Visibility" json:"visibility,omitempty"`
+	OgMetadata  *OpenGraphMetadata     `protobuf:"bytes,12,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	ClickGoal   *ClickGoal             `protobuf:"bytes,13,opt,name=click_goal,json=clickGoal,proto3" json:"click_goal,omitempty"`
+	// The time the shortcut expires, in unix seconds. 0 means never.
+	ExpireTs int64 `protobuf:"varint,14,opt,name=expire_ts,json=expireTs,proto3" json:"expire_ts,omitempty"`
+	// The time the shortcut starts resolving, in unix seconds. 0 means immediately.
+	ActivateTs int64 `protobuf:"varint,15,opt,name=activate_ts,json=activateTs,proto3" json:"activate_ts,omitempty"`
+	// The edits of the users other than the creator and the admins are proposed changes to approve.
+	Protected bool `protobuf:"varint,16,opt,name=protected,proto3" json:"protected,omitempty"`
+	// The id of the team the shortcut is visible to, when the visibility is TEAM.
+	TeamId int32 `protobuf:"varint,17,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// The HTTP status code of the redirect, e.g. 301. 0 means the default of the workspace.
+	RedirectCode int32 `protobuf:"varint,18,opt,name=redirect_code,json=redirectCode,proto3" json:"redirect_code,omitempty"`
+	// The result of the last health check of the link.
+	LinkHealth *LinkHealth `protobuf:"bytes,19,opt,name=link_health,json=linkHealth,proto3" json:"link_health,omitempty"`
+	// How the link is served when it's a document, e.g. a PDF, rather than a page.
+	DocumentMode DocumentMode `protobuf:"varint,20,opt,name=document_mode,json=documentMode,proto3,enum=slash.store.DocumentMode" json:"document_mode,omitempty"`
+	// Whether the description is internal, i.e. only shown to the signed-in users.
+	InternalDescription bool `protobuf:"varint,21,opt,name=internal_description,json=internalDescription,proto3" json:"internal_description,omitempty"`
+	// The rules redirecting the visitors to other links, e.g. by country. The first matching rule wins over the link.
+	RedirectRules *RedirectRules `protobuf:"bytes,22,opt,name=redirect_rules,json=redirectRules,proto3" json:"redirect_rules,omitempty"`
+	// The weighted links splitting the visits, e.g. for an A/B test. One is picked by weight on each visit instead of the link.
+	Variants      *Variants `protobuf:"bytes,23,opt,name=variants,proto3" json:"variants,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -96,6 +221,13 @@ func (x *Shortcut) GetUpdatedTs() int64 {
 	return 0
 }
 
+func (x *Shortcut) GetRowStatus() RowStatus {
+	if x != nil {
+		return x.RowStatus
+	}
+	return RowStatus_ROW_STATUS_UNSPECIFIED
+}
+
 func (x *Shortcut) GetName() string {
 	if x != nil {
 		return x.Name
@@ -145,18 +277,543 @@ func (x *Shortcut) GetOgMetadata() *OpenGraphMetadata {
 	return nil
 }
 
-type OpenGraphMetadata struct {
+func (x *Shortcut) GetClickGoal() *ClickGoal {
+	if x != nil {
+		return x.ClickGoal
+	}
+	return nil
+}
+
+func (x *Shortcut) GetExpireTs() int64 {
+	if x != nil {
+		return x.ExpireTs
+	}
+	return 0
+}
+
+func (x *Shortcut) GetActivateTs() int64 {
+	if x != nil {
+		return x.ActivateTs
+	}
+	return 0
+}
+
+func (x *Shortcut) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *Shortcut) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Shortcut) GetRedirectCode() int32 {
+	if x != nil {
+		return x.RedirectCode
+	}
+	return 0
+}
+
+func (x *Shortcut) GetLinkHealth() *LinkHealth {
+	if x != nil {
+		return x.LinkHealth
+	}
+	return nil
+}
+
+func (x *Shortcut) GetDocumentMode() DocumentMode {
+	if x != nil {
+		return x.DocumentMode
+	}
+	return DocumentMode_DOCUMENT_MODE_UNSPECIFIED
+}
+
+func (x *Shortcut) GetInternalDescription() bool {
+	if x != nil {
+		return x.InternalDescription
+	}
+	return false
+}
+
+func (x *Shortcut) GetRedirectRules() *RedirectRules {
+	if x != nil {
+		return x.RedirectRules
+	}
+	return nil
+}
+
+func (x *Shortcut) GetVariants() *Variants {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type RedirectRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*RedirectRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedirectRules) Reset() {
+	*x = RedirectRules{}
+	mi := &file_store_shortcut_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedirectRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectRules) ProtoMessage() {}
+
+func (x *RedirectRules) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectRules.ProtoReflect.Descriptor instead.
+func (*RedirectRules) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RedirectRules) GetRules() []*RedirectRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// RedirectRule redirects the visitors matching all of its conditions to its link.
+// The conditions without values match any visitor.
+type RedirectRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ISO 3166-1 alpha-2 codes of the countries, e.g. "DE".
+	Countries []string `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	// The languages matching the preferred language of the visitor, e.g. "pt-BR", or its base language, e.g. "pt".
+	Languages     []string     `protobuf:"bytes,2,rep,name=languages,proto3" json:"languages,omitempty"`
+	Devices       []DeviceType `protobuf:"varint,3,rep,packed,name=devices,proto3,enum=slash.store.DeviceType" json:"devices,omitempty"`
+	Link          string       `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedirectRule) Reset() {
+	*x = RedirectRule{}
+	mi := &file_store_shortcut_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedirectRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectRule) ProtoMessage() {}
+
+func (x *RedirectRule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectRule.ProtoReflect.Descriptor instead.
+func (*RedirectRule) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RedirectRule) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetDevices() []DeviceType {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type Variants struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Variants      []*Variant             `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Variants) Reset() {
+	*x = Variants{}
+	mi := &file_store_shortcut_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variants) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variants) ProtoMessage() {}
+
+func (x *Variants) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variants.ProtoReflect.Descriptor instead.
+func (*Variants) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Variants) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// Variant is a link of an A/B split, picked with the probability of its weight among the weights of the variants.
+type Variant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the variant in the analytics, e.g. "A".
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Link          string `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	Weight        int32  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	mi := &file_store_shortcut_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Variant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Variant) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Variant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// LinkHealth is the result of the last health check of the link of a shortcut.
+type LinkHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time of the last check, in unix seconds. 0 means never checked.
+	CheckedTs int64 `protobuf:"varint,1,opt,name=checked_ts,json=checkedTs,proto3" json:"checked_ts,omitempty"`
+	// The HTTP status code of the last check. 0 means the link couldn't be requested.
+	StatusCode int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The error of the last check. Empty means the link is healthy.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// The number of the consecutive failed checks, after which the link is broken.
+	FailureCount  int32 `protobuf:"varint,4,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkHealth) Reset() {
+	*x = LinkHealth{}
+	mi := &file_store_shortcut_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkHealth) ProtoMessage() {}
+
+func (x *LinkHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkHealth.ProtoReflect.Descriptor instead.
+func (*LinkHealth) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LinkHealth) GetCheckedTs() int64 {
+	if x != nil {
+		return x.CheckedTs
+	}
+	return 0
+}
+
+func (x *LinkHealth) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *LinkHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LinkHealth) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+type ShortcutProposedChangePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The proposed fields, as the paths of the update mask, e.g. "link".
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// The values of the fields when the change was proposed.
+	Previous *ShortcutContent `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	// The proposed values of the fields.
+	Proposed      *ShortcutContent `protobuf:"bytes,3,opt,name=proposed,proto3" json:"proposed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutProposedChangePayload) Reset() {
+	*x = ShortcutProposedChangePayload{}
+	mi := &file_store_shortcut_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutProposedChangePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutProposedChangePayload) ProtoMessage() {}
+
+func (x *ShortcutProposedChangePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutProposedChangePayload.ProtoReflect.Descriptor instead.
+func (*ShortcutProposedChangePayload) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ShortcutProposedChangePayload) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *ShortcutProposedChangePayload) GetPrevious() *ShortcutContent {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *ShortcutProposedChangePayload) GetProposed() *ShortcutContent {
+	if x != nil {
+		return x.Proposed
+	}
+	return nil
+}
+
+// ShortcutContent is the content of a shortcut which can be proposed to change.
+type ShortcutContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Link          string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Visibility    Visibility             `protobuf:"varint,6,opt,name=visibility,proto3,enum=slash.store.Visibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutContent) Reset() {
+	*x = ShortcutContent{}
+	mi := &file_store_shortcut_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutContent) ProtoMessage() {}
+
+func (x *ShortcutContent) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutContent.ProtoReflect.Descriptor instead.
+func (*ShortcutContent) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ShortcutContent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShortcutContent) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ShortcutContent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortcutContent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShortcutContent) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ShortcutContent) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+type OpenGraphMetadata struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Image       string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	// The query parameters appended to the link on redirect.
+	// They are kept with the metadata, as both are stored as JSON in the same column.
+	QueryParams []*QueryParam `protobuf:"bytes,4,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
+	// The url of the favicon of the link, fetched with the metadata.
+	Favicon       string `protobuf:"bytes,5,opt,name=favicon,proto3" json:"favicon,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OpenGraphMetadata) Reset() {
 	*x = OpenGraphMetadata{}
-	mi := &file_store_shortcut_proto_msgTypes[1]
+	mi := &file_store_shortcut_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -168,7 +825,7 @@ func (x *OpenGraphMetadata) String() string {
 func (*OpenGraphMetadata) ProtoMessage() {}
 
 func (x *OpenGraphMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_shortcut_proto_msgTypes[1]
+	mi := &file_store_shortcut_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -181,7 +838,7 @@ func (x *OpenGraphMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenGraphMetadata.ProtoReflect.Descriptor instead.
 func (*OpenGraphMetadata) Descriptor() ([]byte, []int) {
-	return file_store_shortcut_proto_rawDescGZIP(), []int{1}
+	return file_store_shortcut_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OpenGraphMetadata) GetTitle() string {
@@ -205,11 +862,142 @@ func (x *OpenGraphMetadata) GetImage() string {
 	return ""
 }
 
+func (x *OpenGraphMetadata) GetQueryParams() []*QueryParam {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+func (x *OpenGraphMetadata) GetFavicon() string {
+	if x != nil {
+		return x.Favicon
+	}
+	return ""
+}
+
+type QueryParam struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The value template, where {name} and {collection} are replaced by the shortcut name
+	// and the name of the collection the shortcut is opened from.
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryParam) Reset() {
+	*x = QueryParam{}
+	mi := &file_store_shortcut_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParam) ProtoMessage() {}
+
+func (x *QueryParam) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryParam.ProtoReflect.Descriptor instead.
+func (*QueryParam) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryParam) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *QueryParam) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ClickGoal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The target view count. 0 means no goal.
+	Target int32 `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The webhook url to post to when the goal is reached.
+	WebhookUrl string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// The time the goal was reached, in unix seconds. 0 means not reached yet.
+	ReachedTs     int64 `protobuf:"varint,3,opt,name=reached_ts,json=reachedTs,proto3" json:"reached_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClickGoal) Reset() {
+	*x = ClickGoal{}
+	mi := &file_store_shortcut_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClickGoal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickGoal) ProtoMessage() {}
+
+func (x *ClickGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickGoal.ProtoReflect.Descriptor instead.
+func (*ClickGoal) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClickGoal) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *ClickGoal) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *ClickGoal) GetReachedTs() int64 {
+	if x != nil {
+		return x.ReachedTs
+	}
+	return 0
+}
+
 var File_store_shortcut_proto protoreflect.FileDescriptor
 
 const file_store_shortcut_proto_rawDesc = "" +
 	"\n" +
-	"\x14store/shortcut.proto\x12\vslash.store\x1a\x12store/common.proto\"\xe5\x02\n" +
+	"\x14store/shortcut.proto\x12\vslash.store\x1a\x12store/common.proto\"\x90\a\n" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -217,7 +1005,9 @@ const file_store_shortcut_proto_rawDesc = "" +
 	"\n" +
 	"created_ts\x18\x03 \x01(\x03R\tcreatedTs\x12\x1d\n" +
 	"\n" +
-	"updated_ts\x18\x04 \x01(\x03R\tupdatedTs\x12\x12\n" +
+	"updated_ts\x18\x04 \x01(\x03R\tupdatedTs\x125\n" +
+	"\n" +
+	"row_status\x18\x05 \x01(\x0e2\x16.slash.store.RowStatusR\trowStatus\x12\x12\n" +
 	"\x04name\x18\x06 \x01(\tR\x04name\x12\x12\n" +
 	"\x04link\x18\a \x01(\tR\x04link\x12\x14\n" +
 	"\x05title\x18\b \x01(\tR\x05title\x12\x12\n" +
@@ -228,11 +1018,82 @@ const file_store_shortcut_proto_rawDesc = "" +
 	"visibility\x18\v \x01(\x0e2\x17.slash.store.VisibilityR\n" +
 	"visibility\x12?\n" +
 	"\vog_metadata\x18\f \x01(\v2\x1e.slash.store.OpenGraphMetadataR\n" +
-	"ogMetadata\"a\n" +
+	"ogMetadata\x125\n" +
+	"\n" +
+	"click_goal\x18\r \x01(\v2\x16.slash.store.ClickGoalR\tclickGoal\x12\x1b\n" +
+	"\texpire_ts\x18\x0e \x01(\x03R\bexpireTs\x12\x1f\n" +
+	"\vactivate_ts\x18\x0f \x01(\x03R\n" +
+	"activateTs\x12\x1c\n" +
+	"\tprotected\x18\x10 \x01(\bR\tprotected\x12\x17\n" +
+	"\ateam_id\x18\x11 \x01(\x05R\x06teamId\x12#\n" +
+	"\rredirect_code\x18\x12 \x01(\x05R\fredirectCode\x128\n" +
+	"\vlink_health\x18\x13 \x01(\v2\x17.slash.store.LinkHealthR\n" +
+	"linkHealth\x12>\n" +
+	"\rdocument_mode\x18\x14 \x01(\x0e2\x19.slash.store.DocumentModeR\fdocumentMode\x121\n" +
+	"\x14internal_description\x18\x15 \x01(\bR\x13internalDescription\x12A\n" +
+	"\x0eredirect_rules\x18\x16 \x01(\v2\x1a.slash.store.RedirectRulesR\rredirectRules\x121\n" +
+	"\bvariants\x18\x17 \x01(\v2\x15.slash.store.VariantsR\bvariants\"@\n" +
+	"\rRedirectRules\x12/\n" +
+	"\x05rules\x18\x01 \x03(\v2\x19.slash.store.RedirectRuleR\x05rules\"\x91\x01\n" +
+	"\fRedirectRule\x12\x1c\n" +
+	"\tcountries\x18\x01 \x03(\tR\tcountries\x12\x1c\n" +
+	"\tlanguages\x18\x02 \x03(\tR\tlanguages\x121\n" +
+	"\adevices\x18\x03 \x03(\x0e2\x17.slash.store.DeviceTypeR\adevices\x12\x12\n" +
+	"\x04link\x18\x04 \x01(\tR\x04link\"<\n" +
+	"\bVariants\x120\n" +
+	"\bvariants\x18\x01 \x03(\v2\x14.slash.store.VariantR\bvariants\"I\n" +
+	"\aVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\"\x87\x01\n" +
+	"\n" +
+	"LinkHealth\x12\x1d\n" +
+	"\n" +
+	"checked_ts\x18\x01 \x01(\x03R\tcheckedTs\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12#\n" +
+	"\rfailure_count\x18\x04 \x01(\x05R\ffailureCount\"\xa9\x01\n" +
+	"\x1dShortcutProposedChangePayload\x12\x14\n" +
+	"\x05paths\x18\x01 \x03(\tR\x05paths\x128\n" +
+	"\bprevious\x18\x02 \x01(\v2\x1c.slash.store.ShortcutContentR\bprevious\x128\n" +
+	"\bproposed\x18\x03 \x01(\v2\x1c.slash.store.ShortcutContentR\bproposed\"\xbe\x01\n" +
+	"\x0fShortcutContent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x127\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\x0e2\x17.slash.store.VisibilityR\n" +
+	"visibility\"\xb7\x01\n" +
 	"\x11OpenGraphMetadata\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05image\x18\x03 \x01(\tR\x05imageB-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
+	"\x05image\x18\x03 \x01(\tR\x05image\x12:\n" +
+	"\fquery_params\x18\x04 \x03(\v2\x17.slash.store.QueryParamR\vqueryParams\x12\x18\n" +
+	"\afavicon\x18\x05 \x01(\tR\afavicon\"4\n" +
+	"\n" +
+	"QueryParam\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"c\n" +
+	"\tClickGoal\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\x05R\x06target\x12\x1f\n" +
+	"\vwebhook_url\x18\x02 \x01(\tR\n" +
+	"webhookUrl\x12\x1d\n" +
+	"\n" +
+	"reached_ts\x18\x03 \x01(\x03R\treachedTs*B\n" +
+	"\n" +
+	"DeviceType\x12\x1b\n" +
+	"\x17DEVICE_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aDESKTOP\x10\x01\x12\n" +
+	"\n" +
+	"\x06MOBILE\x10\x02*G\n" +
+	"\fDocumentMode\x12\x1d\n" +
+	"\x19DOCUMENT_MODE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06INLINE\x10\x01\x12\f\n" +
+	"\bDOWNLOAD\x10\x02B-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
 
 var (
 	file_store_shortcut_proto_rawDescOnce sync.Once
@@ -246,20 +1107,46 @@ func file_store_shortcut_proto_rawDescGZIP() []byte {
 	return file_store_shortcut_proto_rawDescData
 }
 
-var file_store_shortcut_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_shortcut_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_shortcut_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_store_shortcut_proto_goTypes = []any{
-	(*Shortcut)(nil),          // 0: slash.store.Shortcut
-	(*OpenGraphMetadata)(nil), // 1: slash.store.OpenGraphMetadata
-	(Visibility)(0),           // 2: slash.store.Visibility
+	(DeviceType)(0),                       // 0: slash.store.DeviceType
+	(DocumentMode)(0),                     // 1: slash.store.DocumentMode
+	(*Shortcut)(nil),                      // 2: slash.store.Shortcut
+	(*RedirectRules)(nil),                 // 3: slash.store.RedirectRules
+	(*RedirectRule)(nil),                  // 4: slash.store.RedirectRule
+	(*Variants)(nil),                      // 5: slash.store.Variants
+	(*Variant)(nil),                       // 6: slash.store.Variant
+	(*LinkHealth)(nil),                    // 7: slash.store.LinkHealth
+	(*ShortcutProposedChangePayload)(nil), // 8: slash.store.ShortcutProposedChangePayload
+	(*ShortcutContent)(nil),               // 9: slash.store.ShortcutContent
+	(*OpenGraphMetadata)(nil),             // 10: slash.store.OpenGraphMetadata
+	(*QueryParam)(nil),                    // 11: slash.store.QueryParam
+	(*ClickGoal)(nil),                     // 12: slash.store.ClickGoal
+	(RowStatus)(0),                        // 13: slash.store.RowStatus
+	(Visibility)(0),                       // 14: slash.store.Visibility
 }
 var file_store_shortcut_proto_depIdxs = []int32{
-	2, // 0: slash.store.Shortcut.visibility:type_name -> slash.store.Visibility
-	1, // 1: slash.store.Shortcut.og_metadata:type_name -> slash.store.OpenGraphMetadata
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	13, // 0: slash.store.Shortcut.row_status:type_name -> slash.store.RowStatus
+	14, // 1: slash.store.Shortcut.visibility:type_name -> slash.store.Visibility
+	10, // 2: slash.store.Shortcut.og_metadata:type_name -> slash.store.OpenGraphMetadata
+	12, // 3: slash.store.Shortcut.click_goal:type_name -> slash.store.ClickGoal
+	7,  // 4: slash.store.Shortcut.link_health:type_name -> slash.store.LinkHealth
+	1,  // 5: slash.store.Shortcut.document_mode:type_name -> slash.store.DocumentMode
+	3,  // 6: slash.store.Shortcut.redirect_rules:type_name -> slash.store.RedirectRules
+	5,  // 7: slash.store.Shortcut.variants:type_name -> slash.store.Variants
+	4,  // 8: slash.store.RedirectRules.rules:type_name -> slash.store.RedirectRule
+	0,  // 9: slash.store.RedirectRule.devices:type_name -> slash.store.DeviceType
+	6,  // 10: slash.store.Variants.variants:type_name -> slash.store.Variant
+	9,  // 11: slash.store.ShortcutProposedChangePayload.previous:type_name -> slash.store.ShortcutContent
+	9,  // 12: slash.store.ShortcutProposedChangePayload.proposed:type_name -> slash.store.ShortcutContent
+	14, // 13: slash.store.ShortcutContent.visibility:type_name -> slash.store.Visibility
+	11, // 14: slash.store.OpenGraphMetadata.query_params:type_name -> slash.store.QueryParam
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_store_shortcut_proto_init() }
@@ -273,13 +1160,14 @@ func file_store_shortcut_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_shortcut_proto_rawDesc), len(file_store_shortcut_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_shortcut_proto_goTypes,
 		DependencyIndexes: file_store_shortcut_proto_depIdxs,
+		EnumInfos:         file_store_shortcut_proto_enumTypes,
 		MessageInfos:      file_store_shortcut_proto_msgTypes,
 	}.Build()
 	File_store_shortcut_proto = out.File
@@ -29,6 +29,8 @@ const (
 	UserSettingKey_USER_SETTING_GENERAL UserSettingKey = 1
 	// User access tokens.
 	UserSettingKey_USER_SETTING_ACCESS_TOKENS UserSettingKey = 2
+	// User passkeys.
+	UserSettingKey_USER_SETTING_PASSKEYS UserSettingKey = 4
 )
 
 // Enum value maps for UserSettingKey.
@@ -37,11 +39,13 @@ var (
 		0: "USER_SETTING_KEY_UNSPECIFIED",
 		1: "USER_SETTING_GENERAL",
 		2: "USER_SETTING_ACCESS_TOKENS",
+		4: "USER_SETTING_PASSKEYS",
 	}
 	UserSettingKey_value = map[string]int32{
 		"USER_SETTING_KEY_UNSPECIFIED": 0,
 		"USER_SETTING_GENERAL":         1,
 		"USER_SETTING_ACCESS_TOKENS":   2,
+		"USER_SETTING_PASSKEYS":        4,
 	}
 )
 
@@ -80,6 +84,7 @@ type UserSetting struct {
 	//
 	//	*UserSetting_General
 	//	*UserSetting_AccessTokens
+	//	*UserSetting_Passkeys
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -154,6 +159,15 @@ func (x *UserSetting) GetAccessTokens() *UserSetting_AccessTokensSetting {
 	return nil
 }
 
+func (x *UserSetting) GetPasskeys() *UserSetting_PasskeysSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Passkeys); ok {
+			return x.Passkeys
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -166,16 +180,27 @@ type UserSetting_AccessTokens struct {
 	AccessTokens *UserSetting_AccessTokensSetting `protobuf:"bytes,4,opt,name=access_tokens,json=accessTokens,proto3,oneof"`
 }
 
+type UserSetting_Passkeys struct {
+	Passkeys *UserSetting_PasskeysSetting `protobuf:"bytes,6,opt,name=passkeys,proto3,oneof"`
+}
+
 func (*UserSetting_General) isUserSetting_Value() {}
 
 func (*UserSetting_AccessTokens) isUserSetting_Value() {}
 
+func (*UserSetting_Passkeys) isUserSetting_Value() {}
+
 type UserSetting_GeneralSetting struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
-	ColorTheme    string                 `protobuf:"bytes,2,opt,name=color_theme,json=colorTheme,proto3" json:"color_theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Locale     string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	ColorTheme string                 `protobuf:"bytes,2,opt,name=color_theme,json=colorTheme,proto3" json:"color_theme,omitempty"`
+	// Whether the public profile page of the user is disabled.
+	DisablePublicProfile bool `protobuf:"varint,3,opt,name=disable_public_profile,json=disablePublicProfile,proto3" json:"disable_public_profile,omitempty"`
+	// Whether to confirm the redirects to the external domains of the workspace, "ALWAYS" or "NEVER".
+	// "WORKSPACE" or empty follows the workspace.
+	ExternalRedirectConfirmation string `protobuf:"bytes,4,opt,name=external_redirect_confirmation,json=externalRedirectConfirmation,proto3" json:"external_redirect_confirmation,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *UserSetting_GeneralSetting) Reset() {
@@ -222,6 +247,20 @@ func (x *UserSetting_GeneralSetting) GetColorTheme() string {
 	return ""
 }
 
+func (x *UserSetting_GeneralSetting) GetDisablePublicProfile() bool {
+	if x != nil {
+		return x.DisablePublicProfile
+	}
+	return false
+}
+
+func (x *UserSetting_GeneralSetting) GetExternalRedirectConfirmation() string {
+	if x != nil {
+		return x.ExternalRedirectConfirmation
+	}
+	return ""
+}
+
 type UserSetting_AccessTokensSetting struct {
 	state         protoimpl.MessageState                         `protogen:"open.v1"`
 	AccessTokens  []*UserSetting_AccessTokensSetting_AccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"` // Nested repeated field
@@ -266,19 +305,81 @@ func (x *UserSetting_AccessTokensSetting) GetAccessTokens() []*UserSetting_Acces
 	return nil
 }
 
+type UserSetting_PasskeysSetting struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Passkeys      []*UserSetting_PasskeysSetting_Passkey `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_PasskeysSetting) Reset() {
+	*x = UserSetting_PasskeysSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_PasskeysSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_PasskeysSetting) ProtoMessage() {}
+
+func (x *UserSetting_PasskeysSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting_PasskeysSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_PasskeysSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *UserSetting_PasskeysSetting) GetPasskeys() []*UserSetting_PasskeysSetting_Passkey {
+	if x != nil {
+		return x.Passkeys
+	}
+	return nil
+}
+
 type UserSetting_AccessTokensSetting_AccessToken struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The access token is a JWT token, including expiration time, issuer, etc.
+	// Deprecated: the access tokens are no longer stored in plaintext, the stored ones are
+	// replaced by their hash on startup.
 	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	// A description for the access token.
-	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The last time the access token was used, in unix seconds.
+	LastUsedTs int64 `protobuf:"varint,3,opt,name=last_used_ts,json=lastUsedTs,proto3" json:"last_used_ts,omitempty"`
+	// The scopes the access token is restricted to, e.g. "shortcuts:read". Empty means full access.
+	Scopes []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The id of the sign-in session the access token is issued on, 0 for the access tokens created by the user.
+	SessionId int32 `protobuf:"varint,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The id of the admin acting as the user with the access token, 0 if it's not an impersonation.
+	ImpersonatorId int32 `protobuf:"varint,6,opt,name=impersonator_id,json=impersonatorId,proto3" json:"impersonator_id,omitempty"`
+	// The salted SHA-256 hash of the access token in hex, which also identifies it.
+	AccessTokenHash string `protobuf:"bytes,7,opt,name=access_token_hash,json=accessTokenHash,proto3" json:"access_token_hash,omitempty"`
+	// The random salt of the hash in hex.
+	Salt string `protobuf:"bytes,8,opt,name=salt,proto3" json:"salt,omitempty"`
+	// The time the access token was issued, in unix seconds.
+	IssuedTs int64 `protobuf:"varint,9,opt,name=issued_ts,json=issuedTs,proto3" json:"issued_ts,omitempty"`
+	// The time the access token expires, in unix seconds. 0 means never.
+	ExpiresTs     int64 `protobuf:"varint,10,opt,name=expires_ts,json=expiresTs,proto3" json:"expires_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserSetting_AccessTokensSetting_AccessToken) Reset() {
 	*x = UserSetting_AccessTokensSetting_AccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -290,7 +391,7 @@ func (x *UserSetting_AccessTokensSetting_AccessToken) String() string {
 func (*UserSetting_AccessTokensSetting_AccessToken) ProtoMessage() {}
 
 func (x *UserSetting_AccessTokensSetting_AccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,30 +421,284 @@ func (x *UserSetting_AccessTokensSetting_AccessToken) GetDescription() string {
 	return ""
 }
 
+func (x *UserSetting_AccessTokensSetting_AccessToken) GetLastUsedTs() int64 {
+	if x != nil {
+		return x.LastUsedTs
+	}
+	return 0
+}
+
+func (x *UserSetting_AccessTokensSetting_AccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *UserSetting_AccessTokensSetting_AccessToken) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *UserSetting_AccessTokensSetting_AccessToken) GetImpersonatorId() int32 {
+	if x != nil {
+		return x.ImpersonatorId
+	}
+	return 0
+}
+
+func (x *UserSetting_AccessTokensSetting_AccessToken) GetAccessTokenHash() string {
+	if x != nil {
+		return x.AccessTokenHash
+	}
+	return ""
+}
+
+func (x *UserSetting_AccessTokensSetting_AccessToken) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *UserSetting_AccessTokensSetting_AccessToken) GetIssuedTs() int64 {
+	if x != nil {
+		return x.IssuedTs
+	}
+	return 0
+}
+
+func (x *UserSetting_AccessTokensSetting_AccessToken) GetExpiresTs() int64 {
+	if x != nil {
+		return x.ExpiresTs
+	}
+	return 0
+}
+
+// Passkey is a WebAuthn credential of the user.
+type UserSetting_PasskeysSetting_Passkey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The credential id.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The COSE encoded public key of the credential.
+	PublicKey       []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	AttestationType string   `protobuf:"bytes,3,opt,name=attestation_type,json=attestationType,proto3" json:"attestation_type,omitempty"`
+	Transports      []string `protobuf:"bytes,4,rep,name=transports,proto3" json:"transports,omitempty"`
+	UserPresent     bool     `protobuf:"varint,5,opt,name=user_present,json=userPresent,proto3" json:"user_present,omitempty"`
+	UserVerified    bool     `protobuf:"varint,6,opt,name=user_verified,json=userVerified,proto3" json:"user_verified,omitempty"`
+	BackupEligible  bool     `protobuf:"varint,7,opt,name=backup_eligible,json=backupEligible,proto3" json:"backup_eligible,omitempty"`
+	BackupState     bool     `protobuf:"varint,8,opt,name=backup_state,json=backupState,proto3" json:"backup_state,omitempty"`
+	// The AAGUID of the authenticator model.
+	Aaguid []byte `protobuf:"bytes,9,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
+	// The signature counter of the authenticator, to detect cloned authenticators.
+	SignCount  uint32 `protobuf:"varint,10,opt,name=sign_count,json=signCount,proto3" json:"sign_count,omitempty"`
+	Attachment string `protobuf:"bytes,11,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// A name for the passkey, e.g. the device.
+	Name string `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`
+	// The time the passkey was registered, in unix seconds.
+	CreatedTs int64 `protobuf:"varint,13,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The last time the passkey was used to sign in, in unix seconds.
+	LastUsedTs    int64 `protobuf:"varint,14,opt,name=last_used_ts,json=lastUsedTs,proto3" json:"last_used_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) Reset() {
+	*x = UserSetting_PasskeysSetting_Passkey{}
+	mi := &file_store_user_setting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_PasskeysSetting_Passkey) ProtoMessage() {}
+
+func (x *UserSetting_PasskeysSetting_Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting_PasskeysSetting_Passkey.ProtoReflect.Descriptor instead.
+func (*UserSetting_PasskeysSetting_Passkey) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetAttestationType() string {
+	if x != nil {
+		return x.AttestationType
+	}
+	return ""
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetUserPresent() bool {
+	if x != nil {
+		return x.UserPresent
+	}
+	return false
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetUserVerified() bool {
+	if x != nil {
+		return x.UserVerified
+	}
+	return false
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetBackupEligible() bool {
+	if x != nil {
+		return x.BackupEligible
+	}
+	return false
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetBackupState() bool {
+	if x != nil {
+		return x.BackupState
+	}
+	return false
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetAaguid() []byte {
+	if x != nil {
+		return x.Aaguid
+	}
+	return nil
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetSignCount() uint32 {
+	if x != nil {
+		return x.SignCount
+	}
+	return 0
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetAttachment() string {
+	if x != nil {
+		return x.Attachment
+	}
+	return ""
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *UserSetting_PasskeysSetting_Passkey) GetLastUsedTs() int64 {
+	if x != nil {
+		return x.LastUsedTs
+	}
+	return 0
+}
+
 var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vslash.store\"\x8e\x04\n" +
+	"\x18store/user_setting.proto\x12\vslash.store\"\x80\f\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12-\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1b.slash.store.UserSettingKeyR\x03key\x12C\n" +
 	"\ageneral\x18\x03 \x01(\v2'.slash.store.UserSetting.GeneralSettingH\x00R\ageneral\x12S\n" +
-	"\raccess_tokens\x18\x04 \x01(\v2,.slash.store.UserSetting.AccessTokensSettingH\x00R\faccessTokens\x1aI\n" +
+	"\raccess_tokens\x18\x04 \x01(\v2,.slash.store.UserSetting.AccessTokensSettingH\x00R\faccessTokens\x12F\n" +
+	"\bpasskeys\x18\x06 \x01(\v2(.slash.store.UserSetting.PasskeysSettingH\x00R\bpasskeys\x1a\xc5\x01\n" +
 	"\x0eGeneralSetting\x12\x16\n" +
 	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x1f\n" +
 	"\vcolor_theme\x18\x02 \x01(\tR\n" +
-	"colorTheme\x1a\xc8\x01\n" +
+	"colorTheme\x124\n" +
+	"\x16disable_public_profile\x18\x03 \x01(\bR\x14disablePublicProfile\x12D\n" +
+	"\x1eexternal_redirect_confirmation\x18\x04 \x01(\tR\x1cexternalRedirectConfirmation\x1a\xc7\x03\n" +
 	"\x13AccessTokensSetting\x12]\n" +
-	"\raccess_tokens\x18\x01 \x03(\v28.slash.store.UserSetting.AccessTokensSetting.AccessTokenR\faccessTokens\x1aR\n" +
+	"\raccess_tokens\x18\x01 \x03(\v28.slash.store.UserSetting.AccessTokensSetting.AccessTokenR\faccessTokens\x1a\xd0\x02\n" +
 	"\vAccessToken\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescriptionB\a\n" +
-	"\x05value*l\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\flast_used_ts\x18\x03 \x01(\x03R\n" +
+	"lastUsedTs\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\x05R\tsessionId\x12'\n" +
+	"\x0fimpersonator_id\x18\x06 \x01(\x05R\x0eimpersonatorId\x12*\n" +
+	"\x11access_token_hash\x18\a \x01(\tR\x0faccessTokenHash\x12\x12\n" +
+	"\x04salt\x18\b \x01(\tR\x04salt\x12\x1b\n" +
+	"\tissued_ts\x18\t \x01(\x03R\bissuedTs\x12\x1d\n" +
+	"\n" +
+	"expires_ts\x18\n" +
+	" \x01(\x03R\texpiresTs\x1a\xa5\x04\n" +
+	"\x0fPasskeysSetting\x12L\n" +
+	"\bpasskeys\x18\x01 \x03(\v20.slash.store.UserSetting.PasskeysSetting.PasskeyR\bpasskeys\x1a\xc3\x03\n" +
+	"\aPasskey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\fR\tpublicKey\x12)\n" +
+	"\x10attestation_type\x18\x03 \x01(\tR\x0fattestationType\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x04 \x03(\tR\n" +
+	"transports\x12!\n" +
+	"\fuser_present\x18\x05 \x01(\bR\vuserPresent\x12#\n" +
+	"\ruser_verified\x18\x06 \x01(\bR\fuserVerified\x12'\n" +
+	"\x0fbackup_eligible\x18\a \x01(\bR\x0ebackupEligible\x12!\n" +
+	"\fbackup_state\x18\b \x01(\bR\vbackupState\x12\x16\n" +
+	"\x06aaguid\x18\t \x01(\fR\x06aaguid\x12\x1d\n" +
+	"\n" +
+	"sign_count\x18\n" +
+	" \x01(\rR\tsignCount\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\v \x01(\tR\n" +
+	"attachment\x12\x12\n" +
+	"\x04name\x18\f \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\r \x01(\x03R\tcreatedTs\x12 \n" +
+	"\flast_used_ts\x18\x0e \x01(\x03R\n" +
+	"lastUsedTsB\a\n" +
+	"\x05valueJ\x04\b\x05\x10\x06*\xb3\x01\n" +
 	"\x0eUserSettingKey\x12 \n" +
 	"\x1cUSER_SETTING_KEY_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14USER_SETTING_GENERAL\x10\x01\x12\x1e\n" +
-	"\x1aUSER_SETTING_ACCESS_TOKENS\x10\x02B-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
+	"\x1aUSER_SETTING_ACCESS_TOKENS\x10\x02\x12\x19\n" +
+	"\x15USER_SETTING_PASSKEYS\x10\x04\"\x04\b\x03\x10\x03*$USER_SETTING_IDENTITY_PROVIDER_LINKSB-Z+github.com/warthurton/slash/proto/gen/storeb\x06proto3"
 
 var (
 	file_store_user_setting_proto_rawDescOnce sync.Once
@@ -358,24 +713,28 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 }
 
 var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_user_setting_proto_goTypes = []any{
 	(UserSettingKey)(0),                                 // 0: slash.store.UserSettingKey
 	(*UserSetting)(nil),                                 // 1: slash.store.UserSetting
 	(*UserSetting_GeneralSetting)(nil),                  // 2: slash.store.UserSetting.GeneralSetting
 	(*UserSetting_AccessTokensSetting)(nil),             // 3: slash.store.UserSetting.AccessTokensSetting
-	(*UserSetting_AccessTokensSetting_AccessToken)(nil), // 4: slash.store.UserSetting.AccessTokensSetting.AccessToken
+	(*UserSetting_PasskeysSetting)(nil),                 // 4: slash.store.UserSetting.PasskeysSetting
+	(*UserSetting_AccessTokensSetting_AccessToken)(nil), // 5: slash.store.UserSetting.AccessTokensSetting.AccessToken
+	(*UserSetting_PasskeysSetting_Passkey)(nil),         // 6: slash.store.UserSetting.PasskeysSetting.Passkey
 }
 var file_store_user_setting_proto_depIdxs = []int32{
 	0, // 0: slash.store.UserSetting.key:type_name -> slash.store.UserSettingKey
 	2, // 1: slash.store.UserSetting.general:type_name -> slash.store.UserSetting.GeneralSetting
 	3, // 2: slash.store.UserSetting.access_tokens:type_name -> slash.store.UserSetting.AccessTokensSetting
-	4, // 3: slash.store.UserSetting.AccessTokensSetting.access_tokens:type_name -> slash.store.UserSetting.AccessTokensSetting.AccessToken
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 3: slash.store.UserSetting.passkeys:type_name -> slash.store.UserSetting.PasskeysSetting
+	5, // 4: slash.store.UserSetting.AccessTokensSetting.access_tokens:type_name -> slash.store.UserSetting.AccessTokensSetting.AccessToken
+	6, // 5: slash.store.UserSetting.PasskeysSetting.passkeys:type_name -> slash.store.UserSetting.PasskeysSetting.Passkey
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -386,6 +745,7 @@ func file_store_user_setting_proto_init() {
 	file_store_user_setting_proto_msgTypes[0].OneofWrappers = []any{
 		(*UserSetting_General)(nil),
 		(*UserSetting_AccessTokens)(nil),
+		(*UserSetting_Passkeys)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -393,7 +753,7 @@ func file_store_user_setting_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -33,6 +33,20 @@ const (
 	WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED WorkspaceSettingKey = 3
 	// Workspace identity provider settings.
 	WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER WorkspaceSettingKey = 4
+	// Workspace git sync settings.
+	WorkspaceSettingKey_WORKSPACE_SETTING_GIT_SYNC WorkspaceSettingKey = 5
+	// Workspace settings of the missing shortcuts.
+	WorkspaceSettingKey_WORKSPACE_SETTING_NOT_FOUND WorkspaceSettingKey = 6
+	// Workspace collection template settings.
+	WorkspaceSettingKey_WORKSPACE_SETTING_COLLECTION_TEMPLATE WorkspaceSettingKey = 7
+	// Workspace federation settings.
+	WorkspaceSettingKey_WORKSPACE_SETTING_FEDERATION WorkspaceSettingKey = 8
+	// Workspace mail settings.
+	WorkspaceSettingKey_WORKSPACE_SETTING_MAIL WorkspaceSettingKey = 9
+	// Workspace settings of the root path.
+	WorkspaceSettingKey_WORKSPACE_SETTING_LANDING WorkspaceSettingKey = 14
+	// Workspace settings of the suggestions of the shortcut metadata.
+	WorkspaceSettingKey_WORKSPACE_SETTING_COMPLETION WorkspaceSettingKey = 15
 	// TODO: remove the following keys.
 	// The license key.
 	WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY WorkspaceSettingKey = 10
@@ -52,21 +66,35 @@ var (
 		2:  "WORKSPACE_SETTING_SECURITY",
 		3:  "WORKSPACE_SETTING_SHORTCUT_RELATED",
 		4:  "WORKSPACE_SETTING_IDENTITY_PROVIDER",
+		5:  "WORKSPACE_SETTING_GIT_SYNC",
+		6:  "WORKSPACE_SETTING_NOT_FOUND",
+		7:  "WORKSPACE_SETTING_COLLECTION_TEMPLATE",
+		8:  "WORKSPACE_SETTING_FEDERATION",
+		9:  "WORKSPACE_SETTING_MAIL",
+		14: "WORKSPACE_SETTING_LANDING",
+		15: "WORKSPACE_SETTING_COMPLETION",
 		10: "WORKSPACE_SETTING_LICENSE_KEY",
 		11: "WORKSPACE_SETTING_SECRET_SESSION",
 		12: "WORKSPACE_SETTING_CUSTOM_STYLE",
 		13: "WORKSPACE_SETTING_DEFAULT_VISIBILITY",
 	}
 	WorkspaceSettingKey_value = map[string]int32{
-		"WORKSPACE_SETTING_KEY_UNSPECIFIED":    0,
-		"WORKSPACE_SETTING_GENERAL":            1,
-		"WORKSPACE_SETTING_SECURITY":           2,
-		"WORKSPACE_SETTING_SHORTCUT_RELATED":   3,
-		"WORKSPACE_SETTING_IDENTITY_PROVIDER":  4,
-		"WORKSPACE_SETTING_LICENSE_KEY":        10,
-		"WORKSPACE_SETTING_SECRET_SESSION":     11,
-		"WORKSPACE_SETTING_CUSTOM_STYLE":       12,
-		"WORKSPACE_SETTING_DEFAULT_VISIBILITY": 13,
+		"WORKSPACE_SETTING_KEY_UNSPECIFIED":     0,
+		"WORKSPACE_SETTING_GENERAL":             1,
+		"WORKSPACE_SETTING_SECURITY":            2,
+		"WORKSPACE_SETTING_SHORTCUT_RELATED":    3,
+		"WORKSPACE_SETTING_IDENTITY_PROVIDER":   4,
+		"WORKSPACE_SETTING_GIT_SYNC":            5,
+		"WORKSPACE_SETTING_NOT_FOUND":           6,
+		"WORKSPACE_SETTING_COLLECTION_TEMPLATE": 7,
+		"WORKSPACE_SETTING_FEDERATION":          8,
+		"WORKSPACE_SETTING_MAIL":                9,
+		"WORKSPACE_SETTING_LANDING":             14,
+		"WORKSPACE_SETTING_COMPLETION":          15,
+		"WORKSPACE_SETTING_LICENSE_KEY":         10,
+		"WORKSPACE_SETTING_SECRET_SESSION":      11,
+		"WORKSPACE_SETTING_CUSTOM_STYLE":        12,
+		"WORKSPACE_SETTING_DEFAULT_VISIBILITY":  13,
 	}
 )
 
@@ -97,6 +125,108 @@ func (WorkspaceSettingKey) EnumDescriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0}
 }
 
+type WorkspaceSetting_MailSetting_Encryption int32
+
+const (
+	WorkspaceSetting_MailSetting_ENCRYPTION_UNSPECIFIED WorkspaceSetting_MailSetting_Encryption = 0
+	WorkspaceSetting_MailSetting_SSL_TLS                WorkspaceSetting_MailSetting_Encryption = 1
+	WorkspaceSetting_MailSetting_STARTTLS               WorkspaceSetting_MailSetting_Encryption = 2
+)
+
+// Enum value maps for WorkspaceSetting_MailSetting_Encryption.
+var (
+	WorkspaceSetting_MailSetting_Encryption_name = map[int32]string{
+		0: "ENCRYPTION_UNSPECIFIED",
+		1: "SSL_TLS",
+		2: "STARTTLS",
+	}
+	WorkspaceSetting_MailSetting_Encryption_value = map[string]int32{
+		"ENCRYPTION_UNSPECIFIED": 0,
+		"SSL_TLS":                1,
+		"STARTTLS":               2,
+	}
+)
+
+func (x WorkspaceSetting_MailSetting_Encryption) Enum() *WorkspaceSetting_MailSetting_Encryption {
+	p := new(WorkspaceSetting_MailSetting_Encryption)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_MailSetting_Encryption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_MailSetting_Encryption) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (WorkspaceSetting_MailSetting_Encryption) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[1]
+}
+
+func (x WorkspaceSetting_MailSetting_Encryption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_MailSetting_Encryption.Descriptor instead.
+func (WorkspaceSetting_MailSetting_Encryption) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 10, 0}
+}
+
+type WorkspaceSetting_LandingSetting_Type int32
+
+const (
+	// Serves the app.
+	WorkspaceSetting_LandingSetting_TYPE_UNSPECIFIED WorkspaceSetting_LandingSetting_Type = 0
+	WorkspaceSetting_LandingSetting_COLLECTION       WorkspaceSetting_LandingSetting_Type = 1
+	WorkspaceSetting_LandingSetting_PAGE             WorkspaceSetting_LandingSetting_Type = 2
+	WorkspaceSetting_LandingSetting_REDIRECT         WorkspaceSetting_LandingSetting_Type = 3
+)
+
+// Enum value maps for WorkspaceSetting_LandingSetting_Type.
+var (
+	WorkspaceSetting_LandingSetting_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "COLLECTION",
+		2: "PAGE",
+		3: "REDIRECT",
+	}
+	WorkspaceSetting_LandingSetting_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"COLLECTION":       1,
+		"PAGE":             2,
+		"REDIRECT":         3,
+	}
+)
+
+func (x WorkspaceSetting_LandingSetting_Type) Enum() *WorkspaceSetting_LandingSetting_Type {
+	p := new(WorkspaceSetting_LandingSetting_Type)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_LandingSetting_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_LandingSetting_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (WorkspaceSetting_LandingSetting_Type) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[2]
+}
+
+func (x WorkspaceSetting_LandingSetting_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_LandingSetting_Type.Descriptor instead.
+func (WorkspaceSetting_LandingSetting_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 11, 0}
+}
+
 type WorkspaceSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Key   WorkspaceSettingKey    `protobuf:"varint,1,opt,name=key,proto3,enum=slash.store.WorkspaceSettingKey" json:"key,omitempty"`
@@ -107,6 +237,13 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_Security
 	//	*WorkspaceSetting_ShortcutRelated
 	//	*WorkspaceSetting_IdentityProvider
+	//	*WorkspaceSetting_GitSync
+	//	*WorkspaceSetting_NotFound
+	//	*WorkspaceSetting_CollectionTemplate
+	//	*WorkspaceSetting_Federation
+	//	*WorkspaceSetting_Mail
+	//	*WorkspaceSetting_Landing
+	//	*WorkspaceSetting_Completion
 	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -199,6 +336,69 @@ func (x *WorkspaceSetting) GetIdentityProvider() *WorkspaceSetting_IdentityProvi
 	return nil
 }
 
+func (x *WorkspaceSetting) GetGitSync() *WorkspaceSetting_GitSyncSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_GitSync); ok {
+			return x.GitSync
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetNotFound() *WorkspaceSetting_NotFoundSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_NotFound); ok {
+			return x.NotFound
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetCollectionTemplate() *WorkspaceSetting_CollectionTemplateSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_CollectionTemplate); ok {
+			return x.CollectionTemplate
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetFederation() *WorkspaceSetting_FederationSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_Federation); ok {
+			return x.Federation
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetMail() *WorkspaceSetting_MailSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_Mail); ok {
+			return x.Mail
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetLanding() *WorkspaceSetting_LandingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_Landing); ok {
+			return x.Landing
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetCompletion() *WorkspaceSetting_CompletionSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_Completion); ok {
+			return x.Completion
+		}
+	}
+	return nil
+}
+
 type isWorkspaceSetting_Value interface {
 	isWorkspaceSetting_Value()
 }
@@ -219,6 +419,34 @@ type WorkspaceSetting_IdentityProvider struct {
 	IdentityProvider *WorkspaceSetting_IdentityProviderSetting `protobuf:"bytes,6,opt,name=identity_provider,json=identityProvider,proto3,oneof"`
 }
 
+type WorkspaceSetting_GitSync struct {
+	GitSync *WorkspaceSetting_GitSyncSetting `protobuf:"bytes,7,opt,name=git_sync,json=gitSync,proto3,oneof"`
+}
+
+type WorkspaceSetting_NotFound struct {
+	NotFound *WorkspaceSetting_NotFoundSetting `protobuf:"bytes,8,opt,name=not_found,json=notFound,proto3,oneof"`
+}
+
+type WorkspaceSetting_CollectionTemplate struct {
+	CollectionTemplate *WorkspaceSetting_CollectionTemplateSetting `protobuf:"bytes,9,opt,name=collection_template,json=collectionTemplate,proto3,oneof"`
+}
+
+type WorkspaceSetting_Federation struct {
+	Federation *WorkspaceSetting_FederationSetting `protobuf:"bytes,10,opt,name=federation,proto3,oneof"`
+}
+
+type WorkspaceSetting_Mail struct {
+	Mail *WorkspaceSetting_MailSetting `protobuf:"bytes,11,opt,name=mail,proto3,oneof"`
+}
+
+type WorkspaceSetting_Landing struct {
+	Landing *WorkspaceSetting_LandingSetting `protobuf:"bytes,12,opt,name=landing,proto3,oneof"`
+}
+
+type WorkspaceSetting_Completion struct {
+	Completion *WorkspaceSetting_CompletionSetting `protobuf:"bytes,13,opt,name=completion,proto3,oneof"`
+}
+
 func (*WorkspaceSetting_General) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_Security) isWorkspaceSetting_Value() {}
@@ -227,6 +455,20 @@ func (*WorkspaceSetting_ShortcutRelated) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_IdentityProvider) isWorkspaceSetting_Value() {}
 
+func (*WorkspaceSetting_GitSync) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_NotFound) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_CollectionTemplate) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_Federation) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_Mail) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_Landing) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_Completion) isWorkspaceSetting_Value() {}
+
 type WorkspaceSetting_GeneralSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SecretSession string                 `protobuf:"bytes,1,opt,name=secret_session,json=secretSession,proto3" json:"secret_session,omitempty"`
@@ -307,8 +549,13 @@ type WorkspaceSetting_SecuritySetting struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	DisallowUserRegistration bool                   `protobuf:"varint,1,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
 	DisallowPasswordAuth     bool                   `protobuf:"varint,2,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// Access tokens unused for this many days are revoked. 0 disables the policy.
+	AccessTokenInactivityDays int32 `protobuf:"varint,3,opt,name=access_token_inactivity_days,json=accessTokenInactivityDays,proto3" json:"access_token_inactivity_days,omitempty"`
+	// The user the shortcuts and collections are transferred to when their creator deletes their account.
+	// 0 means the users can only delete their data with their account.
+	AccountHandoverUserId int32 `protobuf:"varint,4,opt,name=account_handover_user_id,json=accountHandoverUserId,proto3" json:"account_handover_user_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *WorkspaceSetting_SecuritySetting) Reset() {
@@ -355,11 +602,42 @@ func (x *WorkspaceSetting_SecuritySetting) GetDisallowPasswordAuth() bool {
 	return false
 }
 
+func (x *WorkspaceSetting_SecuritySetting) GetAccessTokenInactivityDays() int32 {
+	if x != nil {
+		return x.AccessTokenInactivityDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_SecuritySetting) GetAccountHandoverUserId() int32 {
+	if x != nil {
+		return x.AccountHandoverUserId
+	}
+	return 0
+}
+
 type WorkspaceSetting_ShortcutRelatedSetting struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	DefaultVisibility Visibility             `protobuf:"varint,1,opt,name=default_visibility,json=defaultVisibility,proto3,enum=slash.store.Visibility" json:"default_visibility,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state             protoimpl.MessageState                `protogen:"open.v1"`
+	DefaultVisibility Visibility                            `protobuf:"varint,1,opt,name=default_visibility,json=defaultVisibility,proto3,enum=slash.store.Visibility" json:"default_visibility,omitempty"`
+	AnomalyAlert      *WorkspaceSetting_AnomalyAlertSetting `protobuf:"bytes,2,opt,name=anomaly_alert,json=anomalyAlert,proto3" json:"anomaly_alert,omitempty"`
+	LinkParamRules    *WorkspaceSetting_LinkParamRules      `protobuf:"bytes,3,opt,name=link_param_rules,json=linkParamRules,proto3" json:"link_param_rules,omitempty"`
+	// The window in seconds within which the repeated views of a shortcut from the same IP and user agent are counted once.
+	// 0 counts every view.
+	ViewDedupeWindowSeconds int32 `protobuf:"varint,4,opt,name=view_dedupe_window_seconds,json=viewDedupeWindowSeconds,proto3" json:"view_dedupe_window_seconds,omitempty"`
+	// Whether to record the signed-in users viewing the shortcuts, to see who uses them in the analytics.
+	AttributeViewsToUsers bool `protobuf:"varint,5,opt,name=attribute_views_to_users,json=attributeViewsToUsers,proto3" json:"attribute_views_to_users,omitempty"`
+	// The HTTP status code of the redirects of the shortcuts without one, e.g. 302.
+	// 0 serves the shortcut page, which redirects in the browser with the Open Graph metadata for link previews.
+	DefaultRedirectCode int32 `protobuf:"varint,6,opt,name=default_redirect_code,json=defaultRedirectCode,proto3" json:"default_redirect_code,omitempty"`
+	// The domains of the internal links, e.g. "example.com", which also covers its subdomains.
+	InternalDomains []string `protobuf:"bytes,7,rep,name=internal_domains,json=internalDomains,proto3" json:"internal_domains,omitempty"`
+	// Whether to confirm the redirects to the domains outside of the internal domains, unless the user opts out.
+	ConfirmExternalRedirects bool                                     `protobuf:"varint,8,opt,name=confirm_external_redirects,json=confirmExternalRedirects,proto3" json:"confirm_external_redirects,omitempty"`
+	LinkHealthCheck          *WorkspaceSetting_LinkHealthCheckSetting `protobuf:"bytes,9,opt,name=link_health_check,json=linkHealthCheck,proto3" json:"link_health_check,omitempty"`
+	// The names the shortcuts can't take, in addition to the routes of Slash, e.g. "admin".
+	ReservedNames []string `protobuf:"bytes,10,rep,name=reserved_names,json=reservedNames,proto3" json:"reserved_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorkspaceSetting_ShortcutRelatedSetting) Reset() {
@@ -399,27 +677,95 @@ func (x *WorkspaceSetting_ShortcutRelatedSetting) GetDefaultVisibility() Visibil
 	return Visibility_VISIBILITY_UNSPECIFIED
 }
 
-type WorkspaceSetting_IdentityProviderSetting struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	IdentityProviders []*IdentityProvider    `protobuf:"bytes,1,rep,name=identity_providers,json=identityProviders,proto3" json:"identity_providers,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetAnomalyAlert() *WorkspaceSetting_AnomalyAlertSetting {
+	if x != nil {
+		return x.AnomalyAlert
+	}
+	return nil
 }
 
-func (x *WorkspaceSetting_IdentityProviderSetting) Reset() {
-	*x = WorkspaceSetting_IdentityProviderSetting{}
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetLinkParamRules() *WorkspaceSetting_LinkParamRules {
+	if x != nil {
+		return x.LinkParamRules
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetViewDedupeWindowSeconds() int32 {
+	if x != nil {
+		return x.ViewDedupeWindowSeconds
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetAttributeViewsToUsers() bool {
+	if x != nil {
+		return x.AttributeViewsToUsers
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetDefaultRedirectCode() int32 {
+	if x != nil {
+		return x.DefaultRedirectCode
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetInternalDomains() []string {
+	if x != nil {
+		return x.InternalDomains
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetConfirmExternalRedirects() bool {
+	if x != nil {
+		return x.ConfirmExternalRedirects
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetLinkHealthCheck() *WorkspaceSetting_LinkHealthCheckSetting {
+	if x != nil {
+		return x.LinkHealthCheck
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetReservedNames() []string {
+	if x != nil {
+		return x.ReservedNames
+	}
+	return nil
+}
+
+type WorkspaceSetting_LinkHealthCheckSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to check the links of the shortcuts daily.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The webhook url to post the newly broken links to.
+	WebhookUrl string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Whether to email the creators of the shortcuts with newly broken links, with the SMTP server of the workspace.
+	NotifyCreators bool `protobuf:"varint,3,opt,name=notify_creators,json=notifyCreators,proto3" json:"notify_creators,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_LinkHealthCheckSetting) Reset() {
+	*x = WorkspaceSetting_LinkHealthCheckSetting{}
 	mi := &file_store_workspace_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceSetting_IdentityProviderSetting) String() string {
+func (x *WorkspaceSetting_LinkHealthCheckSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting_IdentityProviderSetting) ProtoMessage() {}
+func (*WorkspaceSetting_LinkHealthCheckSetting) ProtoMessage() {}
 
-func (x *WorkspaceSetting_IdentityProviderSetting) ProtoReflect() protoreflect.Message {
+func (x *WorkspaceSetting_LinkHealthCheckSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_workspace_setting_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,51 +777,990 @@ func (x *WorkspaceSetting_IdentityProviderSetting) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSetting_IdentityProviderSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting_IdentityProviderSetting) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkspaceSetting_LinkHealthCheckSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_LinkHealthCheckSetting) Descriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 3}
 }
 
-func (x *WorkspaceSetting_IdentityProviderSetting) GetIdentityProviders() []*IdentityProvider {
+func (x *WorkspaceSetting_LinkHealthCheckSetting) GetEnabled() bool {
 	if x != nil {
-		return x.IdentityProviders
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_LinkHealthCheckSetting) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_LinkHealthCheckSetting) GetNotifyCreators() bool {
+	if x != nil {
+		return x.NotifyCreators
+	}
+	return false
+}
+
+type WorkspaceSetting_LinkParamRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The patterns of the query parameters to strip from the links when saving the shortcuts,
+	// where "*" matches any characters, e.g. "utm_*" and "fbclid".
+	Deny []string `protobuf:"bytes,1,rep,name=deny,proto3" json:"deny,omitempty"`
+	// The patterns of the query parameters to keep even if they match the deny patterns, e.g. "utm_id".
+	Allow         []string `protobuf:"bytes,2,rep,name=allow,proto3" json:"allow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_LinkParamRules) Reset() {
+	*x = WorkspaceSetting_LinkParamRules{}
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_LinkParamRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_LinkParamRules) ProtoMessage() {}
+
+func (x *WorkspaceSetting_LinkParamRules) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_LinkParamRules.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_LinkParamRules) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *WorkspaceSetting_LinkParamRules) GetDeny() []string {
+	if x != nil {
+		return x.Deny
 	}
 	return nil
 }
 
-var File_store_workspace_setting_proto protoreflect.FileDescriptor
+func (x *WorkspaceSetting_LinkParamRules) GetAllow() []string {
+	if x != nil {
+		return x.Allow
+	}
+	return nil
+}
 
-const file_store_workspace_setting_proto_rawDesc = "" +
-	"\n" +
-	"\x1dstore/workspace_setting.proto\x12\vslash.store\x1a\x12store/common.proto\x1a\x0fstore/idp.proto\"\xd1\a\n" +
-	"\x10WorkspaceSetting\x122\n" +
-	"\x03key\x18\x01 \x01(\x0e2 .slash.store.WorkspaceSettingKeyR\x03key\x12\x10\n" +
-	"\x03raw\x18\x02 \x01(\tR\x03raw\x12H\n" +
-	"\ageneral\x18\x03 \x01(\v2,.slash.store.WorkspaceSetting.GeneralSettingH\x00R\ageneral\x12K\n" +
-	"\bsecurity\x18\x04 \x01(\v2-.slash.store.WorkspaceSetting.SecuritySettingH\x00R\bsecurity\x12a\n" +
-	"\x10shortcut_related\x18\x05 \x01(\v24.slash.store.WorkspaceSetting.ShortcutRelatedSettingH\x00R\x0fshortcutRelated\x12d\n" +
-	"\x11identity_provider\x18\x06 \x01(\v25.slash.store.WorkspaceSetting.IdentityProviderSettingH\x00R\x10identityProvider\x1a\xba\x01\n" +
-	"\x0eGeneralSetting\x12%\n" +
-	"\x0esecret_session\x18\x01 \x01(\tR\rsecretSession\x12\x1f\n" +
-	"\vlicense_key\x18\x02 \x01(\tR\n" +
-	"licenseKey\x12!\n" +
-	"\finstance_url\x18\x03 \x01(\tR\vinstanceUrl\x12\x1a\n" +
-	"\bbranding\x18\x04 \x01(\fR\bbranding\x12!\n" +
-	"\fcustom_style\x18\x05 \x01(\tR\vcustomStyle\x1a\x85\x01\n" +
-	"\x0fSecuritySetting\x12<\n" +
-	"\x1adisallow_user_registration\x18\x01 \x01(\bR\x18disallowUserRegistration\x124\n" +
-	"\x16disallow_password_auth\x18\x02 \x01(\bR\x14disallowPasswordAuth\x1a`\n" +
-	"\x16ShortcutRelatedSetting\x12F\n" +
-	"\x12default_visibility\x18\x01 \x01(\x0e2\x17.slash.store.VisibilityR\x11defaultVisibility\x1ag\n" +
-	"\x17IdentityProviderSetting\x12L\n" +
-	"\x12identity_providers\x18\x01 \x03(\v2\x1d.slash.store.IdentityProviderR\x11identityProvidersB\a\n" +
-	"\x05value*\xe3\x02\n" +
+type WorkspaceSetting_AnomalyAlertSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to detect traffic spikes and drops of shortcuts.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The webhook url to post the alerts to. Alerts are only recorded as activities when empty.
+	WebhookUrl string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// The z-score of the hourly views to be an anomaly. Defaults to 3.
+	ZScoreThreshold float64 `protobuf:"fixed64,3,opt,name=z_score_threshold,json=zScoreThreshold,proto3" json:"z_score_threshold,omitempty"`
+	// The min hourly views of a spike, or of the baseline of a drop, to filter out noise. Defaults to 10.
+	MinViews      int32 `protobuf:"varint,4,opt,name=min_views,json=minViews,proto3" json:"min_views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_AnomalyAlertSetting) Reset() {
+	*x = WorkspaceSetting_AnomalyAlertSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_AnomalyAlertSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_AnomalyAlertSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_AnomalyAlertSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_AnomalyAlertSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_AnomalyAlertSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *WorkspaceSetting_AnomalyAlertSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_AnomalyAlertSetting) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AnomalyAlertSetting) GetZScoreThreshold() float64 {
+	if x != nil {
+		return x.ZScoreThreshold
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AnomalyAlertSetting) GetMinViews() int32 {
+	if x != nil {
+		return x.MinViews
+	}
+	return 0
+}
+
+type WorkspaceSetting_IdentityProviderSetting struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IdentityProviders []*IdentityProvider    `protobuf:"bytes,1,rep,name=identity_providers,json=identityProviders,proto3" json:"identity_providers,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_IdentityProviderSetting) Reset() {
+	*x = WorkspaceSetting_IdentityProviderSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_IdentityProviderSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_IdentityProviderSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_IdentityProviderSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_IdentityProviderSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_IdentityProviderSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *WorkspaceSetting_IdentityProviderSetting) GetIdentityProviders() []*IdentityProvider {
+	if x != nil {
+		return x.IdentityProviders
+	}
+	return nil
+}
+
+type WorkspaceSetting_GitSyncSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to sync the shortcuts from the file in the GitHub repository.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The GitHub repository in the format of "owner/repo".
+	Repository string `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+	// The branch of the file. Defaults to "main".
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	// The path of the YAML/JSON file of the shortcut definitions.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// The GitHub token to read the file and to open pull requests.
+	AccessToken string `protobuf:"bytes,5,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The secret to verify the signatures of the GitHub push webhooks.
+	WebhookSecret string `protobuf:"bytes,6,opt,name=webhook_secret,json=webhookSecret,proto3" json:"webhook_secret,omitempty"`
+	// Whether to propose the manual changes of the synced shortcuts as pull requests.
+	WriteBack bool `protobuf:"varint,7,opt,name=write_back,json=writeBack,proto3" json:"write_back,omitempty"`
+	// The blob sha of the last applied file.
+	LastSyncedSha string `protobuf:"bytes,8,opt,name=last_synced_sha,json=lastSyncedSha,proto3" json:"last_synced_sha,omitempty"`
+	// The names of the shortcuts managed by the file.
+	ManagedShortcuts []string `protobuf:"bytes,9,rep,name=managed_shortcuts,json=managedShortcuts,proto3" json:"managed_shortcuts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) Reset() {
+	*x = WorkspaceSetting_GitSyncSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_GitSyncSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_GitSyncSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_GitSyncSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_GitSyncSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) GetWriteBack() bool {
+	if x != nil {
+		return x.WriteBack
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) GetLastSyncedSha() string {
+	if x != nil {
+		return x.LastSyncedSha
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GitSyncSetting) GetManagedShortcuts() []string {
+	if x != nil {
+		return x.ManagedShortcuts
+	}
+	return nil
+}
+
+type WorkspaceSetting_NotFoundSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The url to redirect to when the shortcut doesn't exist, where `{name}` is replaced by the shortcut name.
+	// Empty shows the not found page.
+	RedirectUrl string `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	// The message of the not found page.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Whether to hide the offer to create the missing shortcut from the signed-in users.
+	DisableCreateOffer bool `protobuf:"varint,3,opt,name=disable_create_offer,json=disableCreateOffer,proto3" json:"disable_create_offer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_NotFoundSetting) Reset() {
+	*x = WorkspaceSetting_NotFoundSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_NotFoundSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_NotFoundSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_NotFoundSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_NotFoundSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_NotFoundSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 8}
+}
+
+func (x *WorkspaceSetting_NotFoundSetting) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_NotFoundSetting) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_NotFoundSetting) GetDisableCreateOffer() bool {
+	if x != nil {
+		return x.DisableCreateOffer
+	}
+	return false
+}
+
+type WorkspaceSetting_CollectionTemplateSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The admin-defined templates, in addition to the built-in ones.
+	Templates     []*CollectionTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_CollectionTemplateSetting) Reset() {
+	*x = WorkspaceSetting_CollectionTemplateSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_CollectionTemplateSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_CollectionTemplateSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_CollectionTemplateSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_CollectionTemplateSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_CollectionTemplateSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 9}
+}
+
+func (x *WorkspaceSetting_CollectionTemplateSetting) GetTemplates() []*CollectionTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type WorkspaceSetting_MailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The SMTP server to send the emails with. Empty host disables the emails.
+	SmtpHost       string                                  `protobuf:"bytes,1,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	SmtpPort       int32                                   `protobuf:"varint,2,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	SmtpUsername   string                                  `protobuf:"bytes,3,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	SmtpPassword   string                                  `protobuf:"bytes,4,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	SmtpEncryption WorkspaceSetting_MailSetting_Encryption `protobuf:"varint,5,opt,name=smtp_encryption,json=smtpEncryption,proto3,enum=slash.store.WorkspaceSetting_MailSetting_Encryption" json:"smtp_encryption,omitempty"`
+	// The sender address, e.g. "Slash <noreply@example.com>".
+	From string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	// Whether the users signing up with a password have to verify their email before using Slash.
+	RequireEmailVerification bool `protobuf:"varint,7,opt,name=require_email_verification,json=requireEmailVerification,proto3" json:"require_email_verification,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_MailSetting) Reset() {
+	*x = WorkspaceSetting_MailSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_MailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_MailSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_MailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_MailSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_MailSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 10}
+}
+
+func (x *WorkspaceSetting_MailSetting) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_MailSetting) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_MailSetting) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_MailSetting) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_MailSetting) GetSmtpEncryption() WorkspaceSetting_MailSetting_Encryption {
+	if x != nil {
+		return x.SmtpEncryption
+	}
+	return WorkspaceSetting_MailSetting_ENCRYPTION_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_MailSetting) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_MailSetting) GetRequireEmailVerification() bool {
+	if x != nil {
+		return x.RequireEmailVerification
+	}
+	return false
+}
+
+type WorkspaceSetting_LandingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What the root path serves to the visitors.
+	Type WorkspaceSetting_LandingSetting_Type `protobuf:"varint,1,opt,name=type,proto3,enum=slash.store.WorkspaceSetting_LandingSetting_Type" json:"type,omitempty"`
+	// The name of the collection to redirect to.
+	CollectionName string `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The HTML of the custom page, e.g. a marketing page.
+	PageHtml string `protobuf:"bytes,3,opt,name=page_html,json=pageHtml,proto3" json:"page_html,omitempty"`
+	// The external url to redirect to, e.g. the intranet.
+	RedirectUrl string `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	// Whether the signed-in users get the landing too, instead of the app.
+	ApplyToSignedInUsers bool `protobuf:"varint,5,opt,name=apply_to_signed_in_users,json=applyToSignedInUsers,proto3" json:"apply_to_signed_in_users,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_LandingSetting) Reset() {
+	*x = WorkspaceSetting_LandingSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_LandingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_LandingSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_LandingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_LandingSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_LandingSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 11}
+}
+
+func (x *WorkspaceSetting_LandingSetting) GetType() WorkspaceSetting_LandingSetting_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WorkspaceSetting_LandingSetting_TYPE_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_LandingSetting) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_LandingSetting) GetPageHtml() string {
+	if x != nil {
+		return x.PageHtml
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_LandingSetting) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_LandingSetting) GetApplyToSignedInUsers() bool {
+	if x != nil {
+		return x.ApplyToSignedInUsers
+	}
+	return false
+}
+
+type WorkspaceSetting_CompletionSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The base url of the OpenAI-compatible API, e.g. "https://api.openai.com/v1". Empty disables the suggestions.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The API key sent as a bearer token. Empty sends none, e.g. for a local model.
+	ApiKey string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The model of the chat completions, e.g. "gpt-4o-mini".
+	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// The model of the embeddings of the semantic search, e.g. "text-embedding-3-small". Empty disables the semantic search.
+	EmbeddingModel string `protobuf:"bytes,4,opt,name=embedding_model,json=embeddingModel,proto3" json:"embedding_model,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_CompletionSetting) Reset() {
+	*x = WorkspaceSetting_CompletionSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_CompletionSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_CompletionSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_CompletionSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_CompletionSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_CompletionSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 12}
+}
+
+func (x *WorkspaceSetting_CompletionSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_CompletionSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_CompletionSetting) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_CompletionSetting) GetEmbeddingModel() string {
+	if x != nil {
+		return x.EmbeddingModel
+	}
+	return ""
+}
+
+type WorkspaceSetting_FederationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The remote Slash instances to import the public shortcuts and collections from.
+	Sources       []*WorkspaceSetting_FederationSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_FederationSetting) Reset() {
+	*x = WorkspaceSetting_FederationSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_FederationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_FederationSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_FederationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_FederationSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_FederationSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 13}
+}
+
+func (x *WorkspaceSetting_FederationSetting) GetSources() []*WorkspaceSetting_FederationSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type WorkspaceSetting_FederationSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique id of the source.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Whether to sync the source.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The url of the remote Slash instance, e.g. "https://slash.eng.example.com".
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// The access token of a user of the remote instance to list its shortcuts and collections.
+	AccessToken string `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The prefix of the names of the imported shortcuts and collections, e.g. "eng/".
+	Prefix string `protobuf:"bytes,5,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The minutes between the syncs. Defaults to 60.
+	IntervalMinutes int32 `protobuf:"varint,6,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"`
+	// The timestamp of the last sync, successful or not.
+	LastSyncedTs int64 `protobuf:"varint,7,opt,name=last_synced_ts,json=lastSyncedTs,proto3" json:"last_synced_ts,omitempty"`
+	// The error of the last sync. Empty when it succeeded.
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The names of the shortcuts managed by the source.
+	ManagedShortcuts []string `protobuf:"bytes,9,rep,name=managed_shortcuts,json=managedShortcuts,proto3" json:"managed_shortcuts,omitempty"`
+	// The names of the collections managed by the source.
+	ManagedCollections []string `protobuf:"bytes,10,rep,name=managed_collections,json=managedCollections,proto3" json:"managed_collections,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_FederationSource) Reset() {
+	*x = WorkspaceSetting_FederationSource{}
+	mi := &file_store_workspace_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_FederationSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_FederationSource) ProtoMessage() {}
+
+func (x *WorkspaceSetting_FederationSource) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_FederationSource.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_FederationSource) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 14}
+}
+
+func (x *WorkspaceSetting_FederationSource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_FederationSource) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_FederationSource) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_FederationSource) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_FederationSource) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_FederationSource) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_FederationSource) GetLastSyncedTs() int64 {
+	if x != nil {
+		return x.LastSyncedTs
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_FederationSource) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_FederationSource) GetManagedShortcuts() []string {
+	if x != nil {
+		return x.ManagedShortcuts
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_FederationSource) GetManagedCollections() []string {
+	if x != nil {
+		return x.ManagedCollections
+	}
+	return nil
+}
+
+var File_store_workspace_setting_proto protoreflect.FileDescriptor
+
+const file_store_workspace_setting_proto_rawDesc = "" +
+	"\n" +
+	"\x1dstore/workspace_setting.proto\x12\vslash.store\x1a\x16store/collection.proto\x1a\x12store/common.proto\x1a\x0fstore/idp.proto\"\xc8\"\n" +
+	"\x10WorkspaceSetting\x122\n" +
+	"\x03key\x18\x01 \x01(\x0e2 .slash.store.WorkspaceSettingKeyR\x03key\x12\x10\n" +
+	"\x03raw\x18\x02 \x01(\tR\x03raw\x12H\n" +
+	"\ageneral\x18\x03 \x01(\v2,.slash.store.WorkspaceSetting.GeneralSettingH\x00R\ageneral\x12K\n" +
+	"\bsecurity\x18\x04 \x01(\v2-.slash.store.WorkspaceSetting.SecuritySettingH\x00R\bsecurity\x12a\n" +
+	"\x10shortcut_related\x18\x05 \x01(\v24.slash.store.WorkspaceSetting.ShortcutRelatedSettingH\x00R\x0fshortcutRelated\x12d\n" +
+	"\x11identity_provider\x18\x06 \x01(\v25.slash.store.WorkspaceSetting.IdentityProviderSettingH\x00R\x10identityProvider\x12I\n" +
+	"\bgit_sync\x18\a \x01(\v2,.slash.store.WorkspaceSetting.GitSyncSettingH\x00R\agitSync\x12L\n" +
+	"\tnot_found\x18\b \x01(\v2-.slash.store.WorkspaceSetting.NotFoundSettingH\x00R\bnotFound\x12j\n" +
+	"\x13collection_template\x18\t \x01(\v27.slash.store.WorkspaceSetting.CollectionTemplateSettingH\x00R\x12collectionTemplate\x12Q\n" +
+	"\n" +
+	"federation\x18\n" +
+	" \x01(\v2/.slash.store.WorkspaceSetting.FederationSettingH\x00R\n" +
+	"federation\x12?\n" +
+	"\x04mail\x18\v \x01(\v2).slash.store.WorkspaceSetting.MailSettingH\x00R\x04mail\x12H\n" +
+	"\alanding\x18\f \x01(\v2,.slash.store.WorkspaceSetting.LandingSettingH\x00R\alanding\x12Q\n" +
+	"\n" +
+	"completion\x18\r \x01(\v2/.slash.store.WorkspaceSetting.CompletionSettingH\x00R\n" +
+	"completion\x1a\xba\x01\n" +
+	"\x0eGeneralSetting\x12%\n" +
+	"\x0esecret_session\x18\x01 \x01(\tR\rsecretSession\x12\x1f\n" +
+	"\vlicense_key\x18\x02 \x01(\tR\n" +
+	"licenseKey\x12!\n" +
+	"\finstance_url\x18\x03 \x01(\tR\vinstanceUrl\x12\x1a\n" +
+	"\bbranding\x18\x04 \x01(\fR\bbranding\x12!\n" +
+	"\fcustom_style\x18\x05 \x01(\tR\vcustomStyle\x1a\xff\x01\n" +
+	"\x0fSecuritySetting\x12<\n" +
+	"\x1adisallow_user_registration\x18\x01 \x01(\bR\x18disallowUserRegistration\x124\n" +
+	"\x16disallow_password_auth\x18\x02 \x01(\bR\x14disallowPasswordAuth\x12?\n" +
+	"\x1caccess_token_inactivity_days\x18\x03 \x01(\x05R\x19accessTokenInactivityDays\x127\n" +
+	"\x18account_handover_user_id\x18\x04 \x01(\x05R\x15accountHandoverUserId\x1a\xac\x05\n" +
+	"\x16ShortcutRelatedSetting\x12F\n" +
+	"\x12default_visibility\x18\x01 \x01(\x0e2\x17.slash.store.VisibilityR\x11defaultVisibility\x12V\n" +
+	"\ranomaly_alert\x18\x02 \x01(\v21.slash.store.WorkspaceSetting.AnomalyAlertSettingR\fanomalyAlert\x12V\n" +
+	"\x10link_param_rules\x18\x03 \x01(\v2,.slash.store.WorkspaceSetting.LinkParamRulesR\x0elinkParamRules\x12;\n" +
+	"\x1aview_dedupe_window_seconds\x18\x04 \x01(\x05R\x17viewDedupeWindowSeconds\x127\n" +
+	"\x18attribute_views_to_users\x18\x05 \x01(\bR\x15attributeViewsToUsers\x122\n" +
+	"\x15default_redirect_code\x18\x06 \x01(\x05R\x13defaultRedirectCode\x12)\n" +
+	"\x10internal_domains\x18\a \x03(\tR\x0finternalDomains\x12<\n" +
+	"\x1aconfirm_external_redirects\x18\b \x01(\bR\x18confirmExternalRedirects\x12`\n" +
+	"\x11link_health_check\x18\t \x01(\v24.slash.store.WorkspaceSetting.LinkHealthCheckSettingR\x0flinkHealthCheck\x12%\n" +
+	"\x0ereserved_names\x18\n" +
+	" \x03(\tR\rreservedNames\x1a|\n" +
+	"\x16LinkHealthCheckSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vwebhook_url\x18\x02 \x01(\tR\n" +
+	"webhookUrl\x12'\n" +
+	"\x0fnotify_creators\x18\x03 \x01(\bR\x0enotifyCreators\x1a:\n" +
+	"\x0eLinkParamRules\x12\x12\n" +
+	"\x04deny\x18\x01 \x03(\tR\x04deny\x12\x14\n" +
+	"\x05allow\x18\x02 \x03(\tR\x05allow\x1a\x99\x01\n" +
+	"\x13AnomalyAlertSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vwebhook_url\x18\x02 \x01(\tR\n" +
+	"webhookUrl\x12*\n" +
+	"\x11z_score_threshold\x18\x03 \x01(\x01R\x0fzScoreThreshold\x12\x1b\n" +
+	"\tmin_views\x18\x04 \x01(\x05R\bminViews\x1ag\n" +
+	"\x17IdentityProviderSetting\x12L\n" +
+	"\x12identity_providers\x18\x01 \x03(\v2\x1d.slash.store.IdentityProviderR\x11identityProviders\x1a\xb4\x02\n" +
+	"\x0eGitSyncSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tR\n" +
+	"repository\x12\x16\n" +
+	"\x06branch\x18\x03 \x01(\tR\x06branch\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12!\n" +
+	"\faccess_token\x18\x05 \x01(\tR\vaccessToken\x12%\n" +
+	"\x0ewebhook_secret\x18\x06 \x01(\tR\rwebhookSecret\x12\x1d\n" +
+	"\n" +
+	"write_back\x18\a \x01(\bR\twriteBack\x12&\n" +
+	"\x0flast_synced_sha\x18\b \x01(\tR\rlastSyncedSha\x12+\n" +
+	"\x11managed_shortcuts\x18\t \x03(\tR\x10managedShortcuts\x1a\x80\x01\n" +
+	"\x0fNotFoundSetting\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x14disable_create_offer\x18\x03 \x01(\bR\x12disableCreateOffer\x1aZ\n" +
+	"\x19CollectionTemplateSetting\x12=\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1f.slash.store.CollectionTemplateR\ttemplates\x1a\x87\x03\n" +
+	"\vMailSetting\x12\x1b\n" +
+	"\tsmtp_host\x18\x01 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x02 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x03 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x04 \x01(\tR\fsmtpPassword\x12]\n" +
+	"\x0fsmtp_encryption\x18\x05 \x01(\x0e24.slash.store.WorkspaceSetting.MailSetting.EncryptionR\x0esmtpEncryption\x12\x12\n" +
+	"\x04from\x18\x06 \x01(\tR\x04from\x12<\n" +
+	"\x1arequire_email_verification\x18\a \x01(\bR\x18requireEmailVerification\"C\n" +
+	"\n" +
+	"Encryption\x12\x1a\n" +
+	"\x16ENCRYPTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aSSL_TLS\x10\x01\x12\f\n" +
+	"\bSTARTTLS\x10\x02\x1a\xbe\x02\n" +
+	"\x0eLandingSetting\x12E\n" +
+	"\x04type\x18\x01 \x01(\x0e21.slash.store.WorkspaceSetting.LandingSetting.TypeR\x04type\x12'\n" +
+	"\x0fcollection_name\x18\x02 \x01(\tR\x0ecollectionName\x12\x1b\n" +
+	"\tpage_html\x18\x03 \x01(\tR\bpageHtml\x12!\n" +
+	"\fredirect_url\x18\x04 \x01(\tR\vredirectUrl\x126\n" +
+	"\x18apply_to_signed_in_users\x18\x05 \x01(\bR\x14applyToSignedInUsers\"D\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"COLLECTION\x10\x01\x12\b\n" +
+	"\x04PAGE\x10\x02\x12\f\n" +
+	"\bREDIRECT\x10\x03\x1a\x87\x01\n" +
+	"\x11CompletionSetting\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12'\n" +
+	"\x0fembedding_model\x18\x04 \x01(\tR\x0eembeddingModel\x1a]\n" +
+	"\x11FederationSetting\x12H\n" +
+	"\asources\x18\x01 \x03(\v2..slash.store.WorkspaceSetting.FederationSourceR\asources\x1a\xd7\x02\n" +
+	"\x10FederationSource\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12!\n" +
+	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12\x16\n" +
+	"\x06prefix\x18\x05 \x01(\tR\x06prefix\x12)\n" +
+	"\x10interval_minutes\x18\x06 \x01(\x05R\x0fintervalMinutes\x12$\n" +
+	"\x0elast_synced_ts\x18\a \x01(\x03R\flastSyncedTs\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12+\n" +
+	"\x11managed_shortcuts\x18\t \x03(\tR\x10managedShortcuts\x12/\n" +
+	"\x13managed_collections\x18\n" +
+	" \x03(\tR\x12managedCollectionsB\a\n" +
+	"\x05value*\xce\x04\n" +
 	"\x13WorkspaceSettingKey\x12%\n" +
 	"!WORKSPACE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19WORKSPACE_SETTING_GENERAL\x10\x01\x12\x1e\n" +
 	"\x1aWORKSPACE_SETTING_SECURITY\x10\x02\x12&\n" +
 	"\"WORKSPACE_SETTING_SHORTCUT_RELATED\x10\x03\x12'\n" +
-	"#WORKSPACE_SETTING_IDENTITY_PROVIDER\x10\x04\x12!\n" +
+	"#WORKSPACE_SETTING_IDENTITY_PROVIDER\x10\x04\x12\x1e\n" +
+	"\x1aWORKSPACE_SETTING_GIT_SYNC\x10\x05\x12\x1f\n" +
+	"\x1bWORKSPACE_SETTING_NOT_FOUND\x10\x06\x12)\n" +
+	"%WORKSPACE_SETTING_COLLECTION_TEMPLATE\x10\a\x12 \n" +
+	"\x1cWORKSPACE_SETTING_FEDERATION\x10\b\x12\x1a\n" +
+	"\x16WORKSPACE_SETTING_MAIL\x10\t\x12\x1d\n" +
+	"\x19WORKSPACE_SETTING_LANDING\x10\x0e\x12 \n" +
+	"\x1cWORKSPACE_SETTING_COMPLETION\x10\x0f\x12!\n" +
 	"\x1dWORKSPACE_SETTING_LICENSE_KEY\x10\n" +
 	"\x12$\n" +
 	" WORKSPACE_SETTING_SECRET_SESSION\x10\v\x12\"\n" +
@@ -494,31 +1779,59 @@ func file_store_workspace_setting_proto_rawDescGZIP() []byte {
 	return file_store_workspace_setting_proto_rawDescData
 }
 
-var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_store_workspace_setting_proto_goTypes = []any{
-	(WorkspaceSettingKey)(0),                         // 0: slash.store.WorkspaceSettingKey
-	(*WorkspaceSetting)(nil),                         // 1: slash.store.WorkspaceSetting
-	(*WorkspaceSetting_GeneralSetting)(nil),          // 2: slash.store.WorkspaceSetting.GeneralSetting
-	(*WorkspaceSetting_SecuritySetting)(nil),         // 3: slash.store.WorkspaceSetting.SecuritySetting
-	(*WorkspaceSetting_ShortcutRelatedSetting)(nil),  // 4: slash.store.WorkspaceSetting.ShortcutRelatedSetting
-	(*WorkspaceSetting_IdentityProviderSetting)(nil), // 5: slash.store.WorkspaceSetting.IdentityProviderSetting
-	(Visibility)(0),                                  // 6: slash.store.Visibility
-	(*IdentityProvider)(nil),                         // 7: slash.store.IdentityProvider
+	(WorkspaceSettingKey)(0),                           // 0: slash.store.WorkspaceSettingKey
+	(WorkspaceSetting_MailSetting_Encryption)(0),       // 1: slash.store.WorkspaceSetting.MailSetting.Encryption
+	(WorkspaceSetting_LandingSetting_Type)(0),          // 2: slash.store.WorkspaceSetting.LandingSetting.Type
+	(*WorkspaceSetting)(nil),                           // 3: slash.store.WorkspaceSetting
+	(*WorkspaceSetting_GeneralSetting)(nil),            // 4: slash.store.WorkspaceSetting.GeneralSetting
+	(*WorkspaceSetting_SecuritySetting)(nil),           // 5: slash.store.WorkspaceSetting.SecuritySetting
+	(*WorkspaceSetting_ShortcutRelatedSetting)(nil),    // 6: slash.store.WorkspaceSetting.ShortcutRelatedSetting
+	(*WorkspaceSetting_LinkHealthCheckSetting)(nil),    // 7: slash.store.WorkspaceSetting.LinkHealthCheckSetting
+	(*WorkspaceSetting_LinkParamRules)(nil),            // 8: slash.store.WorkspaceSetting.LinkParamRules
+	(*WorkspaceSetting_AnomalyAlertSetting)(nil),       // 9: slash.store.WorkspaceSetting.AnomalyAlertSetting
+	(*WorkspaceSetting_IdentityProviderSetting)(nil),   // 10: slash.store.WorkspaceSetting.IdentityProviderSetting
+	(*WorkspaceSetting_GitSyncSetting)(nil),            // 11: slash.store.WorkspaceSetting.GitSyncSetting
+	(*WorkspaceSetting_NotFoundSetting)(nil),           // 12: slash.store.WorkspaceSetting.NotFoundSetting
+	(*WorkspaceSetting_CollectionTemplateSetting)(nil), // 13: slash.store.WorkspaceSetting.CollectionTemplateSetting
+	(*WorkspaceSetting_MailSetting)(nil),               // 14: slash.store.WorkspaceSetting.MailSetting
+	(*WorkspaceSetting_LandingSetting)(nil),            // 15: slash.store.WorkspaceSetting.LandingSetting
+	(*WorkspaceSetting_CompletionSetting)(nil),         // 16: slash.store.WorkspaceSetting.CompletionSetting
+	(*WorkspaceSetting_FederationSetting)(nil),         // 17: slash.store.WorkspaceSetting.FederationSetting
+	(*WorkspaceSetting_FederationSource)(nil),          // 18: slash.store.WorkspaceSetting.FederationSource
+	(Visibility)(0),            // 19: slash.store.Visibility
+	(*IdentityProvider)(nil),   // 20: slash.store.IdentityProvider
+	(*CollectionTemplate)(nil), // 21: slash.store.CollectionTemplate
 }
 var file_store_workspace_setting_proto_depIdxs = []int32{
-	0, // 0: slash.store.WorkspaceSetting.key:type_name -> slash.store.WorkspaceSettingKey
-	2, // 1: slash.store.WorkspaceSetting.general:type_name -> slash.store.WorkspaceSetting.GeneralSetting
-	3, // 2: slash.store.WorkspaceSetting.security:type_name -> slash.store.WorkspaceSetting.SecuritySetting
-	4, // 3: slash.store.WorkspaceSetting.shortcut_related:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting
-	5, // 4: slash.store.WorkspaceSetting.identity_provider:type_name -> slash.store.WorkspaceSetting.IdentityProviderSetting
-	6, // 5: slash.store.WorkspaceSetting.ShortcutRelatedSetting.default_visibility:type_name -> slash.store.Visibility
-	7, // 6: slash.store.WorkspaceSetting.IdentityProviderSetting.identity_providers:type_name -> slash.store.IdentityProvider
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: slash.store.WorkspaceSetting.key:type_name -> slash.store.WorkspaceSettingKey
+	4,  // 1: slash.store.WorkspaceSetting.general:type_name -> slash.store.WorkspaceSetting.GeneralSetting
+	5,  // 2: slash.store.WorkspaceSetting.security:type_name -> slash.store.WorkspaceSetting.SecuritySetting
+	6,  // 3: slash.store.WorkspaceSetting.shortcut_related:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting
+	10, // 4: slash.store.WorkspaceSetting.identity_provider:type_name -> slash.store.WorkspaceSetting.IdentityProviderSetting
+	11, // 5: slash.store.WorkspaceSetting.git_sync:type_name -> slash.store.WorkspaceSetting.GitSyncSetting
+	12, // 6: slash.store.WorkspaceSetting.not_found:type_name -> slash.store.WorkspaceSetting.NotFoundSetting
+	13, // 7: slash.store.WorkspaceSetting.collection_template:type_name -> slash.store.WorkspaceSetting.CollectionTemplateSetting
+	17, // 8: slash.store.WorkspaceSetting.federation:type_name -> slash.store.WorkspaceSetting.FederationSetting
+	14, // 9: slash.store.WorkspaceSetting.mail:type_name -> slash.store.WorkspaceSetting.MailSetting
+	15, // 10: slash.store.WorkspaceSetting.landing:type_name -> slash.store.WorkspaceSetting.LandingSetting
+	16, // 11: slash.store.WorkspaceSetting.completion:type_name -> slash.store.WorkspaceSetting.CompletionSetting
+	19, // 12: slash.store.WorkspaceSetting.ShortcutRelatedSetting.default_visibility:type_name -> slash.store.Visibility
+	9,  // 13: slash.store.WorkspaceSetting.ShortcutRelatedSetting.anomaly_alert:type_name -> slash.store.WorkspaceSetting.AnomalyAlertSetting
+	8,  // 14: slash.store.WorkspaceSetting.ShortcutRelatedSetting.link_param_rules:type_name -> slash.store.WorkspaceSetting.LinkParamRules
+	7,  // 15: slash.store.WorkspaceSetting.ShortcutRelatedSetting.link_health_check:type_name -> slash.store.WorkspaceSetting.LinkHealthCheckSetting
+	20, // 16: slash.store.WorkspaceSetting.IdentityProviderSetting.identity_providers:type_name -> slash.store.IdentityProvider
+	21, // 17: slash.store.WorkspaceSetting.CollectionTemplateSetting.templates:type_name -> slash.store.CollectionTemplate
+	1,  // 18: slash.store.WorkspaceSetting.MailSetting.smtp_encryption:type_name -> slash.store.WorkspaceSetting.MailSetting.Encryption
+	2,  // 19: slash.store.WorkspaceSetting.LandingSetting.type:type_name -> slash.store.WorkspaceSetting.LandingSetting.Type
+	18, // 20: slash.store.WorkspaceSetting.FederationSetting.sources:type_name -> slash.store.WorkspaceSetting.FederationSource
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_store_workspace_setting_proto_init() }
@@ -526,6 +1839,7 @@ func file_store_workspace_setting_proto_init() {
 	if File_store_workspace_setting_proto != nil {
 		return
 	}
+	file_store_collection_proto_init()
 	file_store_common_proto_init()
 	file_store_idp_proto_init()
 	file_store_workspace_setting_proto_msgTypes[0].OneofWrappers = []any{
@@ -533,14 +1847,21 @@ func file_store_workspace_setting_proto_init() {
 		(*WorkspaceSetting_Security)(nil),
 		(*WorkspaceSetting_ShortcutRelated)(nil),
 		(*WorkspaceSetting_IdentityProvider)(nil),
+		(*WorkspaceSetting_GitSync)(nil),
+		(*WorkspaceSetting_NotFound)(nil),
+		(*WorkspaceSetting_CollectionTemplate)(nil),
+		(*WorkspaceSetting_Federation)(nil),
+		(*WorkspaceSetting_Mail)(nil),
+		(*WorkspaceSetting_Landing)(nil),
+		(*WorkspaceSetting_Completion)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_workspace_setting_proto_rawDesc), len(file_store_workspace_setting_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// rootPaths are the paths also served outside of the base path, so that the probes, the scrapers and the monitors
+// don't depend on the URL prefix of the reverse proxy.
+var rootPaths = []string{"/healthz", "/healthz/live", "/healthz/startup", "/healthz/ready", "/readyz", "/metrics", "/status"}
+
+// newBasePathMiddleware returns the middleware which strips the base path of the requests before they are routed,
+// e.g. "/slash/s/docs" is routed as "/s/docs". The requests outside of the base path are not found, except the root
+// which is redirected to the base path.
+func newBasePathMiddleware(basePath string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+			path, ok := stripBasePath(request.URL.Path, basePath)
+			if !ok {
+				if request.URL.Path == "/" {
+					return c.Redirect(http.StatusFound, basePath+"/")
+				}
+				for _, rootPath := range rootPaths {
+					if request.URL.Path == rootPath {
+						return next(c)
+					}
+				}
+				return echo.ErrNotFound
+			}
+			request.URL.Path = path
+			if request.URL.RawPath != "" {
+				if rawPath, ok := stripBasePath(request.URL.RawPath, basePath); ok {
+					request.URL.RawPath = rawPath
+				} else {
+					request.URL.RawPath = ""
+				}
+			}
+			return next(c)
+		}
+	}
+}
+
+// stripBasePath returns the path without the base path, or false if it's outside of the base path.
+func stripBasePath(path, basePath string) (string, bool) {
+	rest, ok := strings.CutPrefix(path, basePath)
+	if !ok || (rest != "" && !strings.HasPrefix(rest, "/")) {
+		return "", false
+	}
+	if rest == "" {
+		return "/", true
+	}
+	return rest, true
+}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// GetClientIP returns the IP of the client of a request from the peer IP and the X-Forwarded-For header values.
+// The X-Forwarded-For addresses are only trusted from right to left while the address which appended them is a
+// trusted proxy, so a client can't spoof its IP. The loopback is always trusted, as the REST gateway is a proxy.
+func GetClientIP(peerIP string, forwardedFor []string, trustedProxies []netip.Prefix) string {
+	forwardedIPs := []string{}
+	for _, value := range forwardedFor {
+		for _, forwardedIP := range strings.Split(value, ",") {
+			forwardedIPs = append(forwardedIPs, strings.TrimSpace(forwardedIP))
+		}
+	}
+
+	ip := peerIP
+	for _, forwardedIP := range slices.Backward(forwardedIPs) {
+		if !IsTrustedProxy(ip, trustedProxies) {
+			break
+		}
+		if _, err := netip.ParseAddr(forwardedIP); err != nil {
+			break
+		}
+		ip = forwardedIP
+	}
+	return ip
+}
+
+// IsTrustedProxy returns true if the IP is one of the trusted proxies or the loopback, whose headers about the client
+// are trusted.
+func IsTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	if addr.IsLoopback() {
+		return true
+	}
+	return slices.ContainsFunc(trustedProxies, func(prefix netip.Prefix) bool {
+		return prefix.Contains(addr)
+	})
+}
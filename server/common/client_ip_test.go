@@ -0,0 +1,52 @@
+package common
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClientIP(t *testing.T) {
+	trustedProxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	tests := []struct {
+		peerIP       string
+		forwardedFor []string
+		want         string
+	}{
+		{
+			peerIP: "203.0.113.7",
+			want:   "203.0.113.7",
+		},
+		{
+			// The X-Forwarded-For of an untrusted peer is spoofable.
+			peerIP:       "203.0.113.7",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "203.0.113.7",
+		},
+		{
+			peerIP:       "10.0.0.2",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "198.51.100.1",
+		},
+		{
+			// The REST gateway appends the address of the client after the spoofed ones.
+			peerIP:       "127.0.0.1",
+			forwardedFor: []string{"198.51.100.1, 203.0.113.7"},
+			want:         "203.0.113.7",
+		},
+		{
+			peerIP:       "127.0.0.1",
+			forwardedFor: []string{"198.51.100.1", "10.0.0.2, 10.0.0.3"},
+			want:         "198.51.100.1",
+		},
+		{
+			peerIP:       "::1",
+			forwardedFor: []string{"unknown, 10.0.0.2"},
+			want:         "10.0.0.2",
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, GetClientIP(test.peerIP, test.forwardedFor, trustedProxies))
+	}
+}
@@ -0,0 +1,23 @@
+package common
+
+import (
+	"github.com/warthurton/slash/plugin/mail"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+// NewSMTPClient creates the SMTP client of the mail setting of the workspace.
+func NewSMTPClient(mailSetting *storepb.WorkspaceSetting_MailSetting) *mail.SMTPClient {
+	smtpClient := mail.NewSMTPClient(mailSetting.SmtpHost, int(mailSetting.SmtpPort))
+	if mailSetting.SmtpUsername != "" {
+		smtpClient.SetAuthType(mail.SMTPAuthTypePlain).SetAuthCredentials(mailSetting.SmtpUsername, mailSetting.SmtpPassword)
+	}
+	switch mailSetting.SmtpEncryption {
+	case storepb.WorkspaceSetting_MailSetting_SSL_TLS:
+		smtpClient.SetEncryptionType(mail.SMTPEncryptionTypeSSLTLS)
+	case storepb.WorkspaceSetting_MailSetting_STARTTLS:
+		smtpClient.SetEncryptionType(mail.SMTPEncryptionTypeSTARTTLS)
+	default:
+		smtpClient.SetEncryptionType(mail.SMTPEncryptionTypeNone)
+	}
+	return smtpClient
+}
@@ -0,0 +1,132 @@
+package common
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ExternalRedirectConfirmationWorkspace follows the workspace on confirming the external redirects, like the empty value.
+	ExternalRedirectConfirmationWorkspace = "WORKSPACE"
+	// ExternalRedirectConfirmationAlways confirms the external redirects.
+	ExternalRedirectConfirmationAlways = "ALWAYS"
+	// ExternalRedirectConfirmationNever never confirms the redirects.
+	ExternalRedirectConfirmationNever = "NEVER"
+
+	// WildcardPlaceholder is the placeholder of the wildcard links, e.g. "https://jira.example.com/browse/{*}",
+	// which is substituted by the rest of the path of the short link, e.g. "ABC-123" of "/s/jira/ABC-123".
+	WildcardPlaceholder = "{*}"
+)
+
+// IsWildcardLink reports whether the link has the wildcard placeholder.
+func IsWildcardLink(link string) bool {
+	return strings.Contains(link, WildcardPlaceholder)
+}
+
+// ExpandWildcardLink substitutes the path suffix for the wildcard placeholder of the link, where each segment
+// of the suffix is escaped but for the unreserved characters. The placeholder is removed without a suffix.
+// An error is returned when the expanded link would point to another scheme or host than the link, e.g. with
+// the placeholder in the host.
+func ExpandWildcardLink(link, pathSuffix string) (string, error) {
+	if !IsWildcardLink(link) {
+		return link, nil
+	}
+	segments := []string{}
+	if pathSuffix != "" {
+		segments = strings.Split(pathSuffix, "/")
+	}
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segment = unescaped
+		}
+		segments[i] = escapeWildcardSegment(segment)
+	}
+	suffix := strings.Join(segments, "/")
+	expandedLink := strings.ReplaceAll(link, WildcardPlaceholder, suffix)
+
+	if scheme := wildcardSchemeRegexp.FindString(link); strings.Contains(scheme, WildcardPlaceholder) {
+		return "", errors.Errorf("the wildcard placeholder can't be in the scheme of the link")
+	}
+	// The links without a host, e.g. plain text, have no host to keep.
+	templateURL, err := url.Parse(strings.ReplaceAll(link, WildcardPlaceholder, ""))
+	if err != nil || templateURL.Scheme == "" || templateURL.Host == "" {
+		return expandedLink, nil
+	}
+	expandedURL, err := url.Parse(expandedLink)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid expanded link")
+	}
+	if expandedURL.Scheme != templateURL.Scheme || expandedURL.User.String() != templateURL.User.String() {
+		return "", errors.Errorf("the expanded link %s changes the scheme or the userinfo of the link", expandedLink)
+	}
+	if isWildcardInHost(link) {
+		// The suffix can only add labels or characters of a domain name to the host.
+		if !wildcardHostSuffixRegexp.MatchString(suffix) || expandedURL.Port() != templateURL.Port() {
+			return "", errors.Errorf("the expanded link %s changes the host of the link", expandedLink)
+		}
+	} else if expandedURL.Host != templateURL.Host {
+		return "", errors.Errorf("the expanded link %s changes the host of the link", expandedLink)
+	}
+	return expandedLink, nil
+}
+
+// wildcardSchemeRegexp matches the scheme of a link, which may have the wildcard placeholder.
+var wildcardSchemeRegexp = regexp.MustCompile(`^([A-Za-z0-9+.-]|\{\*\})+:`)
+
+// wildcardHostSuffixRegexp matches the suffixes substituted in the host of a link.
+var wildcardHostSuffixRegexp = regexp.MustCompile(`^[A-Za-z0-9.-]*$`)
+
+// isWildcardInHost reports whether the wildcard placeholder is in the authority of the link,
+// i.e. before the path, the query and the fragment.
+func isWildcardInHost(link string) bool {
+	authority := link
+	if _, rest, ok := strings.Cut(link, "://"); ok {
+		authority = rest
+	}
+	if i := strings.IndexAny(authority, "/?#"); i >= 0 {
+		authority = authority[:i]
+	}
+	return strings.Contains(authority, WildcardPlaceholder)
+}
+
+// escapeWildcardSegment escapes the segment but for the unreserved characters of RFC 3986, so that it can't add
+// a userinfo, a port or a scheme to the link, e.g. with "@" or ":".
+func escapeWildcardSegment(segment string) string {
+	var builder strings.Builder
+	for i := 0; i < len(segment); i++ {
+		c := segment[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~' {
+			builder.WriteByte(c)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", c)
+		}
+	}
+	return builder.String()
+}
+
+// IsInternalDomain reports whether the host, e.g. "wiki.example.com", is one of the internal domains or their subdomains.
+func IsInternalDomain(host string, internalDomains []string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, domain := range internalDomains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsRedirectConfirmation reports whether the redirect to the host is confirmed first,
+// from the confirmation preference of the user and the internal domains of the workspace.
+func NeedsRedirectConfirmation(host string, userPreference string, confirmExternalRedirects bool, internalDomains []string) bool {
+	switch userPreference {
+	case ExternalRedirectConfirmationAlways:
+		confirmExternalRedirects = true
+	case ExternalRedirectConfirmationNever:
+		confirmExternalRedirects = false
+	}
+	return confirmExternalRedirects && !IsInternalDomain(host, internalDomains)
+}
@@ -0,0 +1,167 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsInternalDomain(t *testing.T) {
+	internalDomains := []string{"example.com", "corp.internal"}
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{
+			host: "example.com",
+			want: true,
+		},
+		{
+			host: "wiki.example.com",
+			want: true,
+		},
+		{
+			host: "Wiki.Example.com.",
+			want: true,
+		},
+		{
+			host: "badexample.com",
+			want: false,
+		},
+		{
+			host: "example.com.evil.io",
+			want: false,
+		},
+		{
+			host: "jira.corp.internal",
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, IsInternalDomain(test.host, internalDomains), test.host)
+	}
+}
+
+func TestNeedsRedirectConfirmation(t *testing.T) {
+	internalDomains := []string{"example.com"}
+	tests := []struct {
+		host                     string
+		userPreference           string
+		confirmExternalRedirects bool
+		want                     bool
+	}{
+		{
+			host:                     "github.com",
+			userPreference:           "",
+			confirmExternalRedirects: true,
+			want:                     true,
+		},
+		{
+			host:                     "docs.example.com",
+			userPreference:           ExternalRedirectConfirmationAlways,
+			confirmExternalRedirects: true,
+			want:                     false,
+		},
+		{
+			host:                     "github.com",
+			userPreference:           ExternalRedirectConfirmationNever,
+			confirmExternalRedirects: true,
+			want:                     false,
+		},
+		{
+			host:                     "github.com",
+			userPreference:           ExternalRedirectConfirmationAlways,
+			confirmExternalRedirects: false,
+			want:                     true,
+		},
+		{
+			host:                     "github.com",
+			userPreference:           ExternalRedirectConfirmationWorkspace,
+			confirmExternalRedirects: false,
+			want:                     false,
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, NeedsRedirectConfirmation(test.host, test.userPreference, test.confirmExternalRedirects, internalDomains), test)
+	}
+}
+
+func TestExpandWildcardLink(t *testing.T) {
+	tests := []struct {
+		link       string
+		pathSuffix string
+		want       string
+	}{
+		{
+			link:       "https://jira.example.com/browse/{*}",
+			pathSuffix: "ABC-123",
+			want:       "https://jira.example.com/browse/ABC-123",
+		},
+		{
+			link:       "https://github.com/{*}/pulls",
+			pathSuffix: "acme/api",
+			want:       "https://github.com/acme/api/pulls",
+		},
+		{
+			link:       "https://wiki.example.com/search?q={*}",
+			pathSuffix: "on call",
+			want:       "https://wiki.example.com/search?q=on%20call",
+		},
+		{
+			link:       "https://wiki.example.com/{*}",
+			pathSuffix: "a%2Fb",
+			want:       "https://wiki.example.com/a%2Fb",
+		},
+		{
+			link:       "https://jira.example.com/browse/{*}",
+			pathSuffix: "",
+			want:       "https://jira.example.com/browse/",
+		},
+		{
+			link:       "https://example.com",
+			pathSuffix: "ignored",
+			want:       "https://example.com",
+		},
+		{
+			link:       "https://wiki.example.com/{*}",
+			pathSuffix: "x@evil.com:8080",
+			want:       "https://wiki.example.com/x%40evil.com%3A8080",
+		},
+		{
+			link:       "https://{*}.atlassian.net/jira",
+			pathSuffix: "acme",
+			want:       "https://acme.atlassian.net/jira",
+		},
+	}
+	for _, test := range tests {
+		got, err := ExpandWildcardLink(test.link, test.pathSuffix)
+		assert.NoError(t, err, test)
+		assert.Equal(t, test.want, got, test)
+	}
+
+	// The suffixes can't point the link to another host.
+	for _, test := range []struct {
+		link       string
+		pathSuffix string
+	}{
+		{
+			link:       "https://{*}.example.com/wiki",
+			pathSuffix: "evil.com/x",
+		},
+		{
+			link:       "https://host{*}/wiki",
+			pathSuffix: "x@evil.com",
+		},
+		{
+			link:       "https://host{*}/wiki",
+			pathSuffix: ":8080",
+		},
+		{
+			link:       "{*}://example.com",
+			pathSuffix: "javascript",
+		},
+	} {
+		_, err := ExpandWildcardLink(test.link, test.pathSuffix)
+		assert.Error(t, err, test)
+	}
+}
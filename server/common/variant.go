@@ -0,0 +1,47 @@
+package common
+
+import (
+	"context"
+	"math/rand/v2"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+type variantContextKey struct{}
+
+// PickVariant returns a variant picked with the probability of its weight among the weights of the variants,
+// or nil when there is none.
+func PickVariant(variants *storepb.Variants) *storepb.Variant {
+	return pickVariant(variants, rand.Int64N)
+}
+
+// pickVariant picks the variant of the random number in [0, the sum of the weights) given by randN.
+func pickVariant(variants *storepb.Variants, randN func(n int64) int64) *storepb.Variant {
+	totalWeight := int64(0)
+	for _, variant := range variants.GetVariants() {
+		totalWeight += int64(max(variant.Weight, 0))
+	}
+	if totalWeight == 0 {
+		return nil
+	}
+	n := randN(totalWeight)
+	for _, variant := range variants.GetVariants() {
+		weight := int64(max(variant.Weight, 0))
+		if n < weight {
+			return variant
+		}
+		n -= weight
+	}
+	return nil
+}
+
+// WithVariant returns the context of the visit of a short link redirected to the variant.
+func WithVariant(ctx context.Context, variant *storepb.Variant) context.Context {
+	return context.WithValue(ctx, variantContextKey{}, variant)
+}
+
+// GetVariant returns the variant picked for the visit of the short link of the context, or nil when there is none.
+func GetVariant(ctx context.Context) *storepb.Variant {
+	variant, _ := ctx.Value(variantContextKey{}).(*storepb.Variant)
+	return variant
+}
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+func TestPickVariant(t *testing.T) {
+	variants := &storepb.Variants{
+		Variants: []*storepb.Variant{
+			{Name: "A", Link: "https://example.com/a", Weight: 90},
+			{Name: "B", Link: "https://example.com/b", Weight: 0},
+			{Name: "C", Link: "https://example.com/c", Weight: 10},
+		},
+	}
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{n: 0, want: "A"},
+		{n: 89, want: "A"},
+		{n: 90, want: "C"},
+		{n: 99, want: "C"},
+	}
+	for _, test := range tests {
+		variant := pickVariant(variants, func(n int64) int64 {
+			assert.Equal(t, int64(100), n)
+			return test.n
+		})
+		assert.Equal(t, test.want, variant.GetName(), test.n)
+	}
+	assert.Nil(t, PickVariant(&storepb.Variants{}))
+	assert.Nil(t, PickVariant(nil))
+	assert.Contains(t, []string{"A", "C"}, PickVariant(variants).GetName())
+
+	ctx := context.Background()
+	assert.Nil(t, GetVariant(ctx))
+	assert.Equal(t, variants.Variants[2], GetVariant(WithVariant(ctx, variants.Variants[2])))
+}
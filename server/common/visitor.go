@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mssola/useragent"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+type visitorContextKey struct{}
+
+// Visitor is the visitor of a short link, which the redirect rules of the shortcuts match.
+type Visitor struct {
+	// Country is the ISO 3166-1 alpha-2 code of the country of the visitor, or empty when it's unknown.
+	Country string
+	// Language is the preferred language of the visitor, e.g. "pt-br", or empty when it's unknown.
+	Language string
+	Device   storepb.DeviceType
+}
+
+// NewVisitor returns the visitor with the country, the Accept-Language header and the user agent of the request.
+func NewVisitor(country, acceptLanguage, userAgent string) *Visitor {
+	device := storepb.DeviceType_DESKTOP
+	if useragent.New(userAgent).Mobile() {
+		device = storepb.DeviceType_MOBILE
+	}
+	return &Visitor{
+		Country:  strings.ToUpper(country),
+		Language: GetPreferredLanguage(acceptLanguage),
+		Device:   device,
+	}
+}
+
+// WithVisitor returns the context of the visit of a short link by the visitor.
+func WithVisitor(ctx context.Context, visitor *Visitor) context.Context {
+	return context.WithValue(ctx, visitorContextKey{}, visitor)
+}
+
+// GetVisitor returns the visitor of the short link of the context, or nil outside of the visits.
+func GetVisitor(ctx context.Context) *Visitor {
+	visitor, _ := ctx.Value(visitorContextKey{}).(*Visitor)
+	return visitor
+}
+
+// GetPreferredLanguage returns the lowercased language with the highest quality of the Accept-Language header,
+// e.g. "de" of "en;q=0.8, de", where the first one wins the ties. The wildcard isn't a language.
+func GetPreferredLanguage(acceptLanguage string) string {
+	language, quality := "", 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > quality {
+			language, quality = tag, q
+		}
+	}
+	return language
+}
+
+// MatchRedirectRule returns the link of the first redirect rule matching all of its conditions with the visitor,
+// or an empty string when there is none.
+func MatchRedirectRule(redirectRules *storepb.RedirectRules, visitor *Visitor) string {
+	if visitor == nil {
+		return ""
+	}
+	for _, rule := range redirectRules.GetRules() {
+		if len(rule.Countries) > 0 && !slices.Contains(rule.Countries, visitor.Country) {
+			continue
+		}
+		if len(rule.Languages) > 0 && !matchLanguages(rule.Languages, visitor.Language) {
+			continue
+		}
+		if len(rule.Devices) > 0 && !slices.Contains(rule.Devices, visitor.Device) {
+			continue
+		}
+		return rule.Link
+	}
+	return ""
+}
+
+// matchLanguages reports whether the language, e.g. "pt-br", or its base language, e.g. "pt", is one of the languages.
+func matchLanguages(languages []string, language string) bool {
+	if language == "" {
+		return false
+	}
+	base, _, _ := strings.Cut(language, "-")
+	for _, candidate := range languages {
+		candidate = strings.ToLower(candidate)
+		if candidate == language || candidate == base {
+			return true
+		}
+	}
+	return false
+}
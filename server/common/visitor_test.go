@@ -0,0 +1,96 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+func TestGetPreferredLanguage(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{
+			acceptLanguage: "de-DE,de;q=0.9,en;q=0.8",
+			want:           "de-de",
+		},
+		{
+			acceptLanguage: "en;q=0.8, fr",
+			want:           "fr",
+		},
+		{
+			acceptLanguage: "*, es;q=0.5",
+			want:           "es",
+		},
+		{
+			acceptLanguage: "it;q=invalid, nl;q=0.3",
+			want:           "nl",
+		},
+		{
+			acceptLanguage: "",
+			want:           "",
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, GetPreferredLanguage(test.acceptLanguage), test.acceptLanguage)
+	}
+}
+
+func TestMatchRedirectRule(t *testing.T) {
+	redirectRules := &storepb.RedirectRules{
+		Rules: []*storepb.RedirectRule{
+			{
+				Countries: []string{"DE", "FR"},
+				Devices:   []storepb.DeviceType{storepb.DeviceType_MOBILE},
+				Link:      "https://m.example.eu",
+			},
+			{
+				Countries: []string{"DE", "FR"},
+				Link:      "https://example.eu",
+			},
+			{
+				Languages: []string{"pt"},
+				Link:      "https://example.com.br",
+			},
+		},
+	}
+	iPhoneUserAgent := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+	macUserAgent := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15"
+	tests := []struct {
+		visitor *Visitor
+		want    string
+	}{
+		{
+			visitor: NewVisitor("de", "de", iPhoneUserAgent),
+			want:    "https://m.example.eu",
+		},
+		{
+			visitor: NewVisitor("FR", "pt-BR", macUserAgent),
+			want:    "https://example.eu",
+		},
+		{
+			visitor: NewVisitor("", "pt-BR,en;q=0.5", macUserAgent),
+			want:    "https://example.com.br",
+		},
+		{
+			visitor: NewVisitor("US", "en-US", iPhoneUserAgent),
+			want:    "",
+		},
+		{
+			visitor: nil,
+			want:    "",
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, MatchRedirectRule(redirectRules, test.visitor), test.visitor)
+	}
+
+	ctx := context.Background()
+	assert.Nil(t, GetVisitor(ctx))
+	visitor := NewVisitor("DE", "de", macUserAgent)
+	assert.Equal(t, visitor, GetVisitor(WithVisitor(ctx, visitor)))
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// healthCheckTimeout bounds the database check of the probes, so that a hanging database fails the probe.
+const healthCheckTimeout = 2 * time.Second
+
+// HealthCheck is the result of a check of a health probe.
+type HealthCheck struct {
+	Name    string `json:"name"`
+	OK      bool   `json:"ok"`
+	Message string `json:"message,omitempty"`
+}
+
+// HealthStatus is the response of a health probe.
+type HealthStatus struct {
+	OK     bool           `json:"ok"`
+	Checks []*HealthCheck `json:"checks"`
+}
+
+// registerHealthRoutes registers the probes for orchestrators such as Kubernetes:
+//   - /healthz/live succeeds as long as the server can handle requests.
+//   - /healthz/startup succeeds once the migrations are done.
+//   - /healthz/ready, or /readyz, succeeds when the server can serve traffic, i.e. the migrations are done,
+//     the database is reachable and the server isn't shutting down.
+func (s *Server) registerHealthRoutes(e *echo.Echo) {
+	e.GET("/healthz", func(c echo.Context) error {
+		return c.String(http.StatusOK, "Service ready.")
+	})
+	e.GET("/healthz/live", func(c echo.Context) error {
+		return respondHealthStatus(c, []*HealthCheck{})
+	})
+	e.GET("/healthz/startup", func(c echo.Context) error {
+		return respondHealthStatus(c, []*HealthCheck{s.checkMigration()})
+	})
+	e.GET("/healthz/ready", s.handleReady)
+	e.GET("/readyz", s.handleReady)
+}
+
+func (s *Server) handleReady(c echo.Context) error {
+	checks := []*HealthCheck{s.checkMigration(), s.checkDatabase(c.Request().Context())}
+	shutdownCheck := &HealthCheck{Name: "shutdown", OK: !s.shuttingDown.Load()}
+	if !shutdownCheck.OK {
+		shutdownCheck.Message = "server is shutting down"
+	}
+	checks = append(checks, shutdownCheck)
+	return respondHealthStatus(c, checks)
+}
+
+func (s *Server) checkMigration() *HealthCheck {
+	check := &HealthCheck{Name: "migration", OK: s.Store.IsMigrated()}
+	if !check.OK {
+		check.Message = "migrations are not done"
+	}
+	return check
+}
+
+func (s *Server) checkDatabase(ctx context.Context) *HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	check := &HealthCheck{Name: "database", OK: true}
+	if err := s.Store.Ping(ctx); err != nil {
+		check.OK = false
+		check.Message = err.Error()
+	}
+	return check
+}
+
+// respondHealthStatus responds 200 when all the checks pass, otherwise 503.
+func respondHealthStatus(c echo.Context, checks []*HealthCheck) error {
+	status := &HealthStatus{OK: true, Checks: checks}
+	for _, check := range checks {
+		if !check.OK {
+			status.OK = false
+		}
+	}
+	if !status.OK {
+		return c.JSON(http.StatusServiceUnavailable, status)
+	}
+	return c.JSON(http.StatusOK, status)
+}
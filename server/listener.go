@@ -0,0 +1,98 @@
+package server
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	// readHeaderTimeout bounds the read of the request headers, so that the slow clients don't hold the connections.
+	readHeaderTimeout = 10 * time.Second
+	// idleTimeout is the duration after which an idle connection is closed, long enough for the extension
+	// to reuse its connection between the lookups.
+	idleTimeout = 5 * time.Minute
+	// http2SendPingTimeout is the duration without frames after which the HTTP/2 connections are pinged,
+	// so that the connections of the clients which went away are closed, and the proxies keep them open.
+	http2SendPingTimeout = 30 * time.Second
+	// http2PingTimeout is the duration to wait for the response to a ping before closing the connection.
+	http2PingTimeout = 15 * time.Second
+	// http2MaxConcurrentStreams is the max number of concurrent requests of an HTTP/2 connection.
+	http2MaxConcurrentStreams = 250
+)
+
+// configureHTTPServer configures the echo server to serve HTTP/1.1 and HTTP/2 on the address, over TLS if enabled
+// or as h2c otherwise, with the native gRPC requests served by the gRPC server and the others, including
+// the gateway and gRPC-Web, by echo.
+func (s *Server) configureHTTPServer(address string) (*http.Server, error) {
+	server := s.e.Server
+	server.Addr = address
+	server.ReadHeaderTimeout = readHeaderTimeout
+	server.IdleTimeout = idleTimeout
+	server.HTTP2 = &http.HTTP2Config{
+		MaxConcurrentStreams: http2MaxConcurrentStreams,
+		SendPingTimeout:      http2SendPingTimeout,
+		PingTimeout:          http2PingTimeout,
+	}
+	server.Protocols = &http.Protocols{}
+	server.Protocols.SetHTTP1(true)
+	server.Protocols.SetHTTP2(true)
+	// The HTTP/2 connections without TLS use the prior knowledge, like the gRPC clients.
+	server.Protocols.SetUnencryptedHTTP2(!s.Profile.IsTLS())
+
+	switch {
+	case s.Profile.ACMEDomain != "":
+		server.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: s.e.AutoTLSManager.GetCertificate,
+			NextProtos:     []string{"h2", "http/1.1", acme.ALPNProto},
+		}
+	case s.Profile.TLSCert != "":
+		certificate, err := tls.LoadX509KeyPair(s.Profile.TLSCert, s.Profile.TLSKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load TLS certificate")
+		}
+		server.TLSConfig = &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{certificate},
+			NextProtos:   []string{"h2", "http/1.1"},
+		}
+	}
+
+	grpcServer := s.apiV1Service.GetGRPCServer()
+	server.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isGRPCRequest(r) {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		s.e.ServeHTTP(w, r)
+	})
+	return server, nil
+}
+
+// serveHTTP serves the requests of the configured server until it's shut down, and closes listening once it's bound.
+func serveHTTP(server *http.Server, listening chan<- struct{}) error {
+	listener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		return err
+	}
+	close(listening)
+	if server.TLSConfig != nil {
+		listener = tls.NewListener(listener, server.TLSConfig)
+	}
+	return server.Serve(listener)
+}
+
+// isGRPCRequest returns whether the request is a native gRPC request, rather than a gRPC-Web one.
+func isGRPCRequest(r *http.Request) bool {
+	if r.ProtoMajor != 2 {
+		return false
+	}
+	contentType := r.Header.Get("Content-Type")
+	return contentType == "application/grpc" || strings.HasPrefix(contentType, "application/grpc+")
+}
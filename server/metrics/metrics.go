@@ -0,0 +1,101 @@
+// Package metrics provides the Prometheus metrics of the server.
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+// MaxTopShortcutsLimit is the upper bound of the shortcuts with their own metric label,
+// which guards Prometheus against unbounded label cardinality.
+const MaxTopShortcutsLimit = 100
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	shortcutViews    prometheus.Counter
+	topShortcutViews *prometheus.CounterVec
+
+	// TopShortcutsLimit is the number of top shortcuts labelled in the metrics. 0 means disabled.
+	TopShortcutsLimit int
+	topShortcutsMutex sync.RWMutex
+	// topShortcuts maps the ids of the top shortcuts to their label values.
+	topShortcuts map[int32]string
+}
+
+func New(topShortcutsLimit int) *Metrics {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	m := &Metrics{
+		registry: registry,
+		shortcutViews: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "slash",
+			Name:      "shortcut_views_total",
+			Help:      "The total number of shortcut views.",
+		}),
+		TopShortcutsLimit: min(max(topShortcutsLimit, 0), MaxTopShortcutsLimit),
+		topShortcuts:      map[int32]string{},
+	}
+	registry.MustRegister(m.shortcutViews)
+	if m.TopShortcutsLimit > 0 {
+		m.topShortcutViews = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "slash",
+			Name:      "top_shortcut_views_total",
+			Help:      "The number of views of the top shortcuts.",
+		}, []string{"shortcut"})
+		registry.MustRegister(m.topShortcutViews)
+	}
+	return m
+}
+
+// Handler returns the http handler exposing the metrics.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveShortcutView records a view of the shortcut.
+func (m *Metrics) ObserveShortcutView(shortcut *storepb.Shortcut) {
+	m.shortcutViews.Inc()
+	if m.topShortcutViews == nil {
+		return
+	}
+
+	m.topShortcutsMutex.RLock()
+	defer m.topShortcutsMutex.RUnlock()
+	if label, ok := m.topShortcuts[shortcut.Id]; ok {
+		m.topShortcutViews.WithLabelValues(label).Inc()
+	}
+}
+
+// SetTopShortcuts replaces the shortcuts labelled in the metrics, keeping at most TopShortcutsLimit of them.
+// The series of the shortcuts dropped from the top are deleted.
+func (m *Metrics) SetTopShortcuts(shortcuts []*storepb.Shortcut) {
+	if m.topShortcutViews == nil {
+		return
+	}
+	if len(shortcuts) > m.TopShortcutsLimit {
+		shortcuts = shortcuts[:m.TopShortcutsLimit]
+	}
+	topShortcuts := make(map[int32]string, len(shortcuts))
+	for _, shortcut := range shortcuts {
+		topShortcuts[shortcut.Id] = shortcut.Name
+	}
+
+	m.topShortcutsMutex.Lock()
+	defer m.topShortcutsMutex.Unlock()
+	for id, label := range m.topShortcuts {
+		if topShortcuts[id] != label {
+			m.topShortcutViews.DeleteLabelValues(label)
+		}
+	}
+	m.topShortcuts = topShortcuts
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+func TestTopShortcutViews(t *testing.T) {
+	m := New(2)
+	first := &storepb.Shortcut{Id: 1, Name: "first"}
+	second := &storepb.Shortcut{Id: 2, Name: "second"}
+	third := &storepb.Shortcut{Id: 3, Name: "third"}
+	m.SetTopShortcuts([]*storepb.Shortcut{first, second, third})
+
+	m.ObserveShortcutView(first)
+	m.ObserveShortcutView(second)
+	m.ObserveShortcutView(third)
+	require.Equal(t, float64(3), testutil.ToFloat64(m.shortcutViews))
+	// Only the top shortcuts within the limit are labelled.
+	require.Equal(t, 2, testutil.CollectAndCount(m.topShortcutViews))
+
+	// The series of the shortcuts dropped from the top are deleted.
+	m.SetTopShortcuts([]*storepb.Shortcut{third})
+	require.Equal(t, 0, testutil.CollectAndCount(m.topShortcutViews))
+	m.ObserveShortcutView(third)
+	require.Equal(t, float64(1), testutil.ToFloat64(m.topShortcutViews.WithLabelValues("third")))
+}
+
+func TestTopShortcutsLimit(t *testing.T) {
+	require.Nil(t, New(0).topShortcutViews)
+	require.Equal(t, MaxTopShortcutsLimit, New(MaxTopShortcutsLimit+1).TopShortcutsLimit)
+}
@@ -2,12 +2,19 @@ package profile
 
 import (
 	"fmt"
+	"log/slog"
+	"net/netip"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/warthurton/slash/server/metrics"
 )
 
 // Profile is the configuration to start main server.
@@ -22,14 +29,137 @@ type Profile struct {
 	DSN string
 	// Driver is the database driver. Supported drivers are sqlite, postgres.
 	Driver string
+	// ShadowDriver is the database driver of the shadow database, which mirrors the writes and compares the reads.
+	ShadowDriver string
+	// ShadowDSN points to the shadow database. Empty means the shadow mode is disabled.
+	ShadowDSN string
 	// Version is the current version of server.
 	Version string
+	// CookieDomain is the Domain attribute of the access token cookie. Empty means host-only.
+	CookieDomain string
+	// CookieSecure forces the Secure attribute of the access token cookie.
+	CookieSecure bool
+	// CookieSameSite is the SameSite mode of the access token cookie, can be "Strict", "Lax" or "None".
+	CookieSameSite string
+	// TLSCert is the path of the certificate file to serve HTTPS, with TLSKey. Empty means HTTP.
+	TLSCert string
+	// TLSKey is the path of the private key file of the TLSCert.
+	TLSKey string
+	// ACMEDomain is the comma-separated domains whose certificates are provisioned and renewed by Let's Encrypt
+	// to serve HTTPS. Empty means disabled.
+	ACMEDomain string
+	// BasePath is the URL path prefix Slash is served under, e.g. "/slash", without trailing slash. Empty means the root.
+	BasePath string
+	// Metrics enables the Prometheus metrics endpoint.
+	Metrics bool
+	// ActivityArchiveDays is the age in days after which the shortcut views are archived into blobs. 0 means disabled.
+	ActivityArchiveDays int
+	// MetricsTopShortcuts is the number of top shortcuts labelled in the metrics. 0 means disabled.
+	MetricsTopShortcuts int
+	// AuthRateLimit is the max attempts per minute to sign in or up from an IP. 0 means unlimited.
+	AuthRateLimit int
+	// AuthLockoutThreshold is the number of consecutive failures to sign in after which an account is locked out. 0 means never.
+	AuthLockoutThreshold int
+	// ImportRateLimit is the max shortcuts per minute imported by a user, after the burst. 0 means unlimited.
+	ImportRateLimit int
+	// ImportBurst is the number of shortcuts a user can import at once before the rate limit applies.
+	ImportBurst int
+	// DrainTimeout is the max duration to wait for the in-flight requests on shutdown, before cutting them.
+	DrainTimeout time.Duration
+	// LogLevel is the min level of the logs, can be "debug", "info", "warn" or "error".
+	LogLevel string
+	// LogFormat is the format of the logs, can be "text" or "json".
+	LogFormat string
+	// Quiet suppresses the greeting banner and the server profile of the start, but not the "server started" line.
+	Quiet bool
+	// TrustedProxies is the comma-separated IPs or CIDRs of the reverse proxies whose X-Forwarded-For header is trusted
+	// for the IP of the client. Empty means that only the address of the peer is used.
+	TrustedProxies string
+	// BreakGlassEmail is the email of the emergency admin account, which doesn't depend on the store. Empty means disabled.
+	BreakGlassEmail string
+	// BreakGlassPasswordHash is the bcrypt hash of the password of the emergency admin account.
+	BreakGlassPasswordHash string
 }
 
 func (p *Profile) IsDev() bool {
 	return p.Mode != "prod"
 }
 
+// IsTLS returns whether the server serves HTTPS itself, rather than behind a reverse proxy.
+func (p *Profile) IsTLS() bool {
+	return p.TLSCert != "" || p.ACMEDomain != ""
+}
+
+// GetACMEDomains returns the domains of the ACMEDomain.
+func (p *Profile) GetACMEDomains() []string {
+	domains := []string{}
+	for _, domain := range strings.Split(p.ACMEDomain, ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	return domains
+}
+
+// GetTrustedProxies returns the address ranges of the TrustedProxies, the invalid ones being skipped.
+func (p *Profile) GetTrustedProxies() []netip.Prefix {
+	trustedProxies, _ := parseTrustedProxies(p.TrustedProxies)
+	return trustedProxies
+}
+
+func parseTrustedProxies(trustedProxies string) ([]netip.Prefix, error) {
+	prefixes := []netip.Prefix{}
+	var err error
+	for _, trustedProxy := range strings.Split(trustedProxies, ",") {
+		if trustedProxy = strings.TrimSpace(trustedProxy); trustedProxy == "" {
+			continue
+		}
+		var prefix netip.Prefix
+		if strings.Contains(trustedProxy, "/") {
+			prefix, err = netip.ParsePrefix(trustedProxy)
+		} else {
+			var addr netip.Addr
+			addr, err = netip.ParseAddr(trustedProxy)
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		if err != nil {
+			return prefixes, errors.Errorf("invalid trusted proxy %q, it must be an IP or a CIDR", trustedProxy)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// GetLogLevel returns the slog level of the LogLevel, info by default.
+func (p *Profile) GetLogLevel() slog.Level {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(p.LogLevel)); err != nil {
+		return slog.LevelInfo
+	}
+	return level
+}
+
+// basePathRegexp matches the base paths, made of the unreserved URL characters.
+var basePathRegexp = regexp.MustCompile(`^(/[A-Za-z0-9._~-]+)+$`)
+
+// normalizeBasePath returns the base path with a leading slash and without trailing slash, e.g. "slash/" is "/slash".
+func normalizeBasePath(basePath string) (string, error) {
+	basePath = strings.Trim(strings.TrimSpace(basePath), "/")
+	if basePath == "" {
+		return "", nil
+	}
+	basePath = "/" + basePath
+	if !basePathRegexp.MatchString(basePath) {
+		return "", errors.Errorf("invalid base path %q", basePath)
+	}
+	for _, segment := range strings.Split(basePath[1:], "/") {
+		if segment == "." || segment == ".." {
+			return "", errors.Errorf("invalid base path %q", basePath)
+		}
+	}
+	return basePath, nil
+}
+
 func checkDataDir(dataDir string) (string, error) {
 	// Convert to absolute path if relative path is supplied.
 	if !filepath.IsAbs(dataDir) {
@@ -76,6 +206,87 @@ func (p *Profile) Validate() error {
 		return err
 	}
 
+	if (p.TLSCert == "") != (p.TLSKey == "") {
+		return errors.New("TLS certificate and key must be set together")
+	}
+	if p.TLSCert != "" && p.ACMEDomain != "" {
+		return errors.New("TLS certificate and ACME domain are mutually exclusive")
+	}
+	if p.ACMEDomain != "" && len(p.GetACMEDomains()) == 0 {
+		return errors.Errorf("invalid ACME domain %q", p.ACMEDomain)
+	}
+	if p.IsTLS() {
+		// The access token cookie is only sent over HTTPS.
+		p.CookieSecure = true
+	}
+
+	if p.BasePath, err = normalizeBasePath(p.BasePath); err != nil {
+		return err
+	}
+
+	switch strings.ToLower(p.CookieSameSite) {
+	case "", "strict":
+		p.CookieSameSite = "Strict"
+	case "lax":
+		p.CookieSameSite = "Lax"
+	case "none":
+		// Browsers reject SameSite=None cookies without the Secure attribute.
+		if !p.CookieSecure {
+			return errors.New("cookie SameSite mode None requires secure cookies")
+		}
+		p.CookieSameSite = "None"
+	default:
+		return errors.Errorf("invalid cookie SameSite mode %q", p.CookieSameSite)
+	}
+
+	if p.MetricsTopShortcuts < 0 || p.MetricsTopShortcuts > metrics.MaxTopShortcutsLimit {
+		return errors.Errorf("metrics top shortcuts must be between 0 and %d", metrics.MaxTopShortcutsLimit)
+	}
+
+	if p.ActivityArchiveDays < 0 {
+		return errors.New("activity archive days must not be negative")
+	}
+
+	if p.AuthRateLimit < 0 || p.AuthLockoutThreshold < 0 {
+		return errors.New("auth rate limit and lockout threshold must not be negative")
+	}
+
+	if _, err := parseTrustedProxies(p.TrustedProxies); err != nil {
+		return err
+	}
+
+	if (p.BreakGlassEmail == "") != (p.BreakGlassPasswordHash == "") {
+		return errors.New("break-glass email and password hash must be set together")
+	}
+	if p.BreakGlassPasswordHash != "" {
+		if _, err := bcrypt.Cost([]byte(p.BreakGlassPasswordHash)); err != nil {
+			return errors.Wrap(err, "invalid break-glass password hash, it must be a bcrypt hash")
+		}
+	}
+
+	if p.DrainTimeout < 0 {
+		return errors.New("drain timeout must not be negative")
+	}
+
+	if p.LogLevel == "" {
+		p.LogLevel = "info"
+	}
+	var logLevel slog.Level
+	if err := logLevel.UnmarshalText([]byte(p.LogLevel)); err != nil {
+		return errors.Errorf("invalid log level %q", p.LogLevel)
+	}
+	switch p.LogFormat {
+	case "":
+		p.LogFormat = "text"
+	case "text", "json":
+	default:
+		return errors.Errorf("invalid log format %q", p.LogFormat)
+	}
+
+	if p.ShadowDSN != "" && p.ShadowDriver != "sqlite" && p.ShadowDriver != "postgres" {
+		return errors.Errorf("invalid shadow database driver %q", p.ShadowDriver)
+	}
+
 	p.Data = dataDir
 	if p.Driver == "sqlite" && p.DSN == "" {
 		dbFile := fmt.Sprintf("slash_%s.db", p.Mode)
@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestIDHeader is the header of the trace id of a request, which is forwarded to the gRPC server
+// by the gateway and returned in the response.
+const RequestIDHeader = "X-Request-Id"
+
+var (
+	// traceparentRegexp matches the W3C traceparent header, e.g. "00-<trace id>-<parent id>-<flags>".
+	traceparentRegexp = regexp.MustCompile(`^[0-9a-f]{2}-([0-9a-f]{32})-[0-9a-f]{16}-[0-9a-f]{2}$`)
+	// requestIDRegexp matches the request ids accepted from the clients, so that they can't forge log lines.
+	requestIDRegexp = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+)
+
+// httpRouteLogLevels are the levels of the access log of the HTTP routes which succeed, info by default.
+// The API requests are logged by the LoggerInterceptor, and the probes and assets are too frequent.
+var httpRouteLogLevels = map[string]slog.Level{
+	"/api/v1/*":        slog.LevelDebug,
+	"/slash.api.v1.*":  slog.LevelDebug,
+	"/healthz":         slog.LevelDebug,
+	"/healthz/live":    slog.LevelDebug,
+	"/healthz/startup": slog.LevelDebug,
+	"/healthz/ready":   slog.LevelDebug,
+	"/readyz":          slog.LevelDebug,
+	"/metrics":         slog.LevelDebug,
+	"/status":          slog.LevelDebug,
+	"/assets/*":        slog.LevelDebug,
+}
+
+// accessLogEntry is the state of the request logged once it's handled.
+type accessLogEntry struct {
+	traceID string
+	userID  atomic.Int32
+}
+
+// withAccessLogEntry returns the context with a new access log entry of the request.
+func withAccessLogEntry(ctx context.Context, traceID string) (context.Context, *accessLogEntry) {
+	entry := &accessLogEntry{traceID: traceID}
+	return context.WithValue(ctx, accessLogContextKey, entry), entry
+}
+
+// setAccessLogUserID records the user of the request in its access log, once authenticated.
+func setAccessLogUserID(ctx context.Context, userID int32) {
+	if entry, ok := ctx.Value(accessLogContextKey).(*accessLogEntry); ok {
+		entry.userID.Store(userID)
+	}
+}
+
+// AccessLogMiddleware logs the method, path, status, latency, user and trace id of the HTTP requests.
+func AccessLogMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		request := c.Request()
+		traceID := getHTTPTraceID(request)
+		// The trace id is forwarded to the gRPC server by the gateway.
+		request.Header.Set(RequestIDHeader, traceID)
+		c.Response().Header().Set(RequestIDHeader, traceID)
+		ctx, entry := withAccessLogEntry(request.Context(), traceID)
+		c.SetRequest(request.WithContext(ctx))
+
+		err := next(c)
+		if err != nil {
+			// The error is written now, so that its status is logged.
+			c.Error(err)
+		}
+
+		status := c.Response().Status
+		logLevel := slog.LevelInfo
+		if level, ok := httpRouteLogLevels[c.Path()]; ok {
+			logLevel = level
+		}
+		if status >= http.StatusInternalServerError {
+			logLevel = slog.LevelError
+		}
+		logAttrs := []slog.Attr{
+			slog.String("method", request.Method),
+			slog.String("path", request.URL.Path),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("trace_id", traceID),
+		}
+		if userID := entry.userID.Load(); userID != 0 {
+			logAttrs = append(logAttrs, slog.Int("user_id", int(userID)))
+		}
+		slog.LogAttrs(ctx, logLevel, "http request", logAttrs...)
+		return nil
+	}
+}
+
+// getHTTPTraceID returns the trace id of the traceparent header, or the request id of the client, or a new one.
+func getHTTPTraceID(request *http.Request) string {
+	if matches := traceparentRegexp.FindStringSubmatch(strings.TrimSpace(request.Header.Get("Traceparent"))); matches != nil {
+		return matches[1]
+	}
+	if requestID := request.Header.Get(RequestIDHeader); requestIDRegexp.MatchString(requestID) {
+		return requestID
+	}
+	return newTraceID()
+}
+
+// newTraceID returns a random trace id, in the format of the W3C trace context.
+func newTraceID() string {
+	traceID := make([]byte, 16)
+	if _, err := rand.Read(traceID); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(traceID)
+}
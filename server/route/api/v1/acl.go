@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
@@ -24,12 +25,23 @@ const (
 	// The key name used to store user id in the context
 	// user id is extracted from the jwt token subject field.
 	userIDContextKey ContextKey = iota
+	// The key name used to store the access token of the request in the context.
+	accessTokenContextKey
+	// The key name used to store the scopes of the access token of the request in the context.
+	accessTokenScopesContextKey
+	// The key name used to store the in-memory user of the break-glass admin in the context.
+	breakGlassUserContextKey
+	// The key name used to store the access log entry of the request in the context.
+	accessLogContextKey
 )
 
 // GRPCAuthInterceptor is the auth interceptor for gRPC server.
 type GRPCAuthInterceptor struct {
 	Store  *store.Store
 	secret string
+	// breakGlassEmail and breakGlassPasswordHash are the break-glass admin credential, only accepted when set.
+	breakGlassEmail        string
+	breakGlassPasswordHash string
 }
 
 // NewGRPCAuthInterceptor returns a new API auth interceptor.
@@ -51,18 +63,37 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 		return nil, status.Errorf(codes.Unauthenticated, "failed to get access token from metadata: %v", err)
 	}
 
-	userID, err := in.authenticate(ctx, accessToken)
+	userID, userAccessToken, err := in.authenticate(ctx, accessToken)
 	if err != nil {
 		if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
 			return handler(ctx, request)
 		}
 		return nil, err
 	}
-	user, err := in.Store.GetUser(ctx, &store.FindUser{
-		ID: &userID,
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get user")
+	if scopes := userAccessToken.Scopes; !isMethodAllowedForScopes(serverInfo.FullMethod, scopes) {
+		// The methods allowed when unauthorized are called anonymously, so the access token can't act as the user beyond its scopes.
+		if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
+			return handler(ctx, request)
+		}
+		return nil, status.Errorf(codes.PermissionDenied, "the access token is restricted to the scopes %s", strings.Join(scopes, ", "))
+	}
+	if err := auditImpersonatedRequest(serverInfo.FullMethod, request, userID, userAccessToken); err != nil {
+		return nil, err
+	}
+	var user *store.User
+	if userID == BreakGlassUserID {
+		if err := auditBreakGlassRequest(serverInfo.FullMethod); err != nil {
+			return nil, err
+		}
+		user = newBreakGlassUser(in.breakGlassEmail)
+		ctx = context.WithValue(ctx, breakGlassUserContextKey, user)
+	} else {
+		user, err = in.Store.GetUser(ctx, &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
 	}
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", userID)
@@ -70,17 +101,46 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	if isOnlyForAdminAllowedMethod(serverInfo.FullMethod) && user.Role != store.RoleAdmin {
 		return nil, status.Errorf(codes.PermissionDenied, "user ID %q is not admin", userID)
 	}
+	if !user.EmailVerified && !isEmailUnverifiedAllowedMethod(serverInfo.FullMethod) {
+		mailSetting, err := in.Store.GetWorkspaceMailSetting(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get workspace mail setting")
+		}
+		if isEmailVerificationRequired(mailSetting) {
+			return nil, status.Errorf(codes.PermissionDenied, "email %s is not verified", user.Email)
+		}
+	}
 
-	// Stores userID into context.
+	// Stores userID and access token into context.
 	childCtx := context.WithValue(ctx, userIDContextKey, userID)
+	childCtx = context.WithValue(childCtx, accessTokenContextKey, accessToken)
+	childCtx = context.WithValue(childCtx, accessTokenScopesContextKey, userAccessToken.Scopes)
 	return handler(childCtx, request)
 }
 
-func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (int32, error) {
+// AuthenticateHTTPRequest returns the id of the user signed in with the access token of the HTTP request, from the
+// authorization header or the cookie, for the routes served outside of the API, e.g. the shortcut redirects.
+func (in *GRPCAuthInterceptor) AuthenticateHTTPRequest(ctx context.Context, request *http.Request) (int32, error) {
+	md := metadata.MD{}
+	for key, values := range request.Header {
+		md.Append(key, values...)
+	}
+	accessToken, err := getTokenFromMetadata(md)
+	if err != nil {
+		return 0, status.Errorf(codes.Unauthenticated, "failed to get access token: %v", err)
+	}
+	userID, _, err := in.authenticate(ctx, accessToken)
+	return userID, err
+}
+
+// authenticate returns the id of the user of the access token and the stored access token,
+// with the scopes it's restricted to.
+func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (int32, *storepb.UserSetting_AccessTokensSetting_AccessToken, error) {
 	if accessToken == "" {
-		return 0, status.Errorf(codes.Unauthenticated, "access token not found")
+		return 0, nil, status.Errorf(codes.Unauthenticated, "access token not found")
 	}
 	claims := &ClaimsMessage{}
+	isBreakGlass := false
 	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
 		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
 			return nil, status.Errorf(codes.Unauthenticated, "unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
@@ -89,14 +149,29 @@ func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken str
 			if kid == "v1" {
 				return []byte(in.secret), nil
 			}
+			if kid == BreakGlassKeyID && in.breakGlassPasswordHash != "" {
+				isBreakGlass = true
+				return getBreakGlassSigningKey(in.secret, in.breakGlassPasswordHash), nil
+			}
 		}
 		return nil, status.Errorf(codes.Unauthenticated, "unexpected access token kid=%v", t.Header["kid"])
 	})
 	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "Invalid or expired access token")
+		return 0, nil, status.Errorf(codes.Unauthenticated, "Invalid or expired access token")
+	}
+	if isBreakGlass {
+		// The break-glass access tokens aren't stored, they're valid until they expire.
+		if !audienceContains(claims.Audience, BreakGlassAudienceName) || claims.Name != in.breakGlassEmail {
+			return 0, nil, status.Errorf(codes.Unauthenticated, "invalid break-glass access token")
+		}
+		setAccessLogUserID(ctx, BreakGlassUserID)
+		return BreakGlassUserID, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+			AccessToken: accessToken,
+			Description: BreakGlassAccessTokenDescription,
+		}, nil
 	}
 	if !audienceContains(claims.Audience, AccessTokenAudienceName) {
-		return 0, status.Errorf(codes.Unauthenticated,
+		return 0, nil, status.Errorf(codes.Unauthenticated,
 			"invalid access token, audience mismatch, got %q, expected %q. you may send request to the wrong environment",
 			claims.Audience,
 			AccessTokenAudienceName,
@@ -105,30 +180,40 @@ func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken str
 
 	userID, err := util.ConvertStringToInt32(claims.Subject)
 	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "malformed ID %q in the access token", claims.Subject)
+		return 0, nil, status.Errorf(codes.Unauthenticated, "malformed ID %q in the access token", claims.Subject)
 	}
 	user, err := in.Store.GetUser(ctx, &store.FindUser{
 		ID: &userID,
 	})
 	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", userID)
+		return 0, nil, status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", userID)
 	}
 	if user == nil {
-		return 0, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", userID)
+		return 0, nil, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", userID)
 	}
 	if user.RowStatus == storepb.RowStatus_ARCHIVED {
-		return 0, status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", userID)
+		return 0, nil, status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", userID)
 	}
 
 	accessTokens, err := in.Store.GetUserAccessTokens(ctx, user.ID)
 	if err != nil {
-		return 0, errors.Wrapf(err, "failed to get user access tokens")
+		return 0, nil, errors.Wrapf(err, "failed to get user access tokens")
+	}
+	userAccessToken := store.FindAccessToken(accessTokens, accessToken)
+	if userAccessToken == nil {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "invalid access token")
+	}
+	securitySetting, err := in.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to get workspace security setting")
 	}
-	if !validateAccessToken(accessToken, accessTokens) {
-		return 0, status.Errorf(codes.Unauthenticated, "invalid access token")
+	if isAccessTokenInactive(userAccessToken, claims, securitySetting.AccessTokenInactivityDays, time.Now()) {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "access token has expired due to inactivity")
 	}
+	in.Store.RecordAccessTokenUsage(user.ID, userAccessToken.AccessTokenHash, time.Now().Unix())
+	setAccessLogUserID(ctx, userID)
 
-	return userID, nil
+	return userID, userAccessToken, nil
 }
 
 func getTokenFromMetadata(md metadata.MD) (string, error) {
@@ -142,16 +227,20 @@ func getTokenFromMetadata(md metadata.MD) (string, error) {
 		return authHeaderParts[1], nil
 	}
 	// Try to get the token from the cookie header.
-	var accessToken string
+	return getCookieFromMetadata(md, AccessTokenCookieName), nil
+}
+
+func getCookieFromMetadata(md metadata.MD, name string) string {
+	var value string
 	for _, t := range append(md.Get("grpcgateway-cookie"), md.Get("cookie")...) {
 		header := http.Header{}
 		header.Add("Cookie", t)
 		request := http.Request{Header: header}
-		if v, _ := request.Cookie(AccessTokenCookieName); v != nil {
-			accessToken = v.Value
+		if v, _ := request.Cookie(name); v != nil {
+			value = v.Value
 		}
 	}
-	return accessToken, nil
+	return value
 }
 
 func audienceContains(audience jwt.ClaimStrings, token string) bool {
@@ -163,11 +252,23 @@ func audienceContains(audience jwt.ClaimStrings, token string) bool {
 	return false
 }
 
-func validateAccessToken(accessTokenString string, userAccessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
-	for _, userAccessToken := range userAccessTokens {
-		if accessTokenString == userAccessToken.AccessToken {
-			return true
-		}
+// isAccessTokenExpired reports whether the stored access token has expired, without its claims.
+func isAccessTokenExpired(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken, now time.Time) bool {
+	return userAccessToken.ExpiresTs > 0 && now.Unix() >= userAccessToken.ExpiresTs
+}
+
+// isAccessTokenInactive reports whether the access token has not been used within the inactivity window.
+// Tokens that have never been used are measured from their issued time.
+func isAccessTokenInactive(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken, claims *ClaimsMessage, inactivityDays int32, now time.Time) bool {
+	if inactivityDays <= 0 {
+		return false
 	}
-	return false
+	lastActiveTs := userAccessToken.LastUsedTs
+	if lastActiveTs == 0 && claims.IssuedAt != nil {
+		lastActiveTs = claims.IssuedAt.Unix()
+	}
+	if lastActiveTs == 0 {
+		return false
+	}
+	return now.Sub(time.Unix(lastActiveTs, 0)) > time.Duration(inactivityDays)*24*time.Hour
 }
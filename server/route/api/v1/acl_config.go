@@ -1,18 +1,33 @@
 package v1
 
-import "strings"
+import (
+	"slices"
+	"strings"
+)
 
 var allowedMethodsWhenUnauthorized = map[string]bool{
-	"/slash.api.v1.WorkspaceService/GetWorkspaceProfile":  true,
-	"/slash.api.v1.WorkspaceService/GetWorkspaceSetting":  true,
-	"/slash.api.v1.AuthService/GetAuthStatus":             true,
-	"/slash.api.v1.AuthService/SignIn":                    true,
-	"/slash.api.v1.AuthService/SignInWithSSO":             true,
-	"/slash.api.v1.AuthService/SignUp":                    true,
-	"/slash.api.v1.AuthService/SignOut":                   true,
-	"/slash.api.v1.ShortcutService/GetShortcut":           true,
-	"/slash.api.v1.ShortcutService/GetShortcutByName":     true,
-	"/slash.api.v1.CollectionService/GetCollectionByName": true,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceProfile":       true,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceSetting":       true,
+	"/slash.api.v1.AuthService/GetAuthStatus":                  true,
+	"/slash.api.v1.AuthService/SignIn":                         true,
+	"/slash.api.v1.AuthService/SignInWithSSO":                  true,
+	"/slash.api.v1.AuthService/SignInWithLDAP":                 true,
+	"/slash.api.v1.AuthService/LinkIdentityProvider":           true,
+	"/slash.api.v1.AuthService/BeginPasskeySignIn":             true,
+	"/slash.api.v1.AuthService/SignInWithPasskey":              true,
+	"/slash.api.v1.AuthService/SignUp":                         true,
+	"/slash.api.v1.AuthService/VerifyEmail":                    true,
+	"/slash.api.v1.AuthService/SignOut":                        true,
+	"/slash.api.v1.AuthService/CreateDeviceAuthorization":      true,
+	"/slash.api.v1.AuthService/PollDeviceToken":                true,
+	"/slash.api.v1.ShortcutService/GetShortcut":                true,
+	"/slash.api.v1.ShortcutService/GetShortcutByName":          true,
+	"/slash.api.v1.ShortcutService/ListShortcutSuggestions":    true,
+	"/slash.api.v1.ShortcutService/GetShortcutQRCode":          true,
+	"/slash.api.v1.CollectionService/GetCollectionByName":      true,
+	"/slash.api.v1.CollectionService/GetSharedCollection":      true,
+	"/slash.api.v1.ShortcutService/GetSharedShortcutAnalytics": true,
+	"/slash.api.v1.UserService/GetUserPublicProfile":           true,
 }
 
 // isUnauthorizeAllowedMethod returns true if the method is allowed to be called when the user is not authorized.
@@ -23,14 +38,184 @@ func isUnauthorizeAllowedMethod(methodName string) bool {
 	return allowedMethodsWhenUnauthorized[methodName]
 }
 
+// allowedMethodsWhenEmailUnverified are the methods the users who haven't verified their email can call,
+// besides the ones allowed when unauthorized, when the workspace requires the verification.
+var allowedMethodsWhenEmailUnverified = map[string]bool{
+	"/slash.api.v1.AuthService/SendVerificationEmail": true,
+	"/slash.api.v1.UserSettingService/GetUserSetting": true,
+}
+
+// isEmailUnverifiedAllowedMethod returns true if the method is allowed to be called when the email of the user isn't verified.
+func isEmailUnverifiedAllowedMethod(methodName string) bool {
+	return isUnauthorizeAllowedMethod(methodName) || allowedMethodsWhenEmailUnverified[methodName]
+}
+
+// allowedMethodsForBreakGlass are the methods the break-glass admin can call to recover the workspace,
+// which don't need the break-glass account to exist in the store.
+var allowedMethodsForBreakGlass = map[string]bool{
+	"/slash.api.v1.AuthService/GetAuthStatus":               true,
+	"/slash.api.v1.AuthService/SignOut":                     true,
+	"/slash.api.v1.UserService/ListUsers":                   true,
+	"/slash.api.v1.UserService/GetUser":                     true,
+	"/slash.api.v1.UserService/CreateUser":                  true,
+	"/slash.api.v1.UserService/UpdateUser":                  true,
+	"/slash.api.v1.UserService/DeleteUser":                  true,
+	"/slash.api.v1.UserSettingService/GetUserSetting":       true,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceProfile":    true,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceSetting":    true,
+	"/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting": true,
+	"/slash.api.v1.WorkspaceService/TestIdentityProvider":   true,
+	"/slash.api.v1.WorkspaceService/TestSmtp":               true,
+}
+
+// isBreakGlassAllowedMethod returns true if the method is allowed to be called by the break-glass admin.
+func isBreakGlassAllowedMethod(methodName string) bool {
+	return isUnauthorizeAllowedMethod(methodName) || allowedMethodsForBreakGlass[methodName]
+}
+
 var allowedMethodsOnlyForAdmin = map[string]bool{
 	"/slash.api.v1.UserService/CreateUser":                  true,
 	"/slash.api.v1.UserService/DeleteUser":                  true,
+	"/slash.api.v1.UserService/ImpersonateUser":             true,
 	"/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting": true,
+	"/slash.api.v1.WorkspaceService/TestIdentityProvider":   true,
+	"/slash.api.v1.WorkspaceService/TestSmtp":               true,
+	"/slash.api.v1.WorkspaceService/ExportWorkspace":        true,
+	"/slash.api.v1.WorkspaceService/GetInstanceStats":       true,
 	"/slash.api.v1.SubscriptionService/UpdateSubscription":  true,
+	"/slash.api.v1.ShortcutService/BulkUpdateShortcutTags":  true,
+	"/slash.api.v1.ShortcutService/MergeShortcuts":          true,
+	"/slash.api.v1.TeamService/CreateTeam":                  true,
+	"/slash.api.v1.TeamService/UpdateTeam":                  true,
+	"/slash.api.v1.TeamService/DeleteTeam":                  true,
+	"/slash.api.v1.TeamService/AddTeamMember":               true,
+	"/slash.api.v1.TeamService/RemoveTeamMember":            true,
 }
 
 // isOnlyForAdminAllowedMethod returns true if the method is allowed to be called only by admin.
 func isOnlyForAdminAllowedMethod(methodName string) bool {
 	return allowedMethodsOnlyForAdmin[methodName]
 }
+
+const (
+	AccessTokenScopeShortcutsRead    = "shortcuts:read"
+	AccessTokenScopeShortcutsWrite   = "shortcuts:write"
+	AccessTokenScopeCollectionsRead  = "collections:read"
+	AccessTokenScopeCollectionsWrite = "collections:write"
+	// AccessTokenScopeAdmin is full access, only for admins.
+	AccessTokenScopeAdmin = "admin"
+)
+
+// accessTokenScopes are the scopes an access token can be restricted to.
+var accessTokenScopes = []string{
+	AccessTokenScopeShortcutsRead,
+	AccessTokenScopeShortcutsWrite,
+	AccessTokenScopeCollectionsRead,
+	AccessTokenScopeCollectionsWrite,
+	AccessTokenScopeAdmin,
+}
+
+// methodScopes are the scopes of the methods which access tokens with scopes can call.
+// The other methods require full access.
+var methodScopes = map[string]string{
+	"/slash.api.v1.ShortcutService/ListShortcuts":                  AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/SearchShortcuts":                AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/ValidateLinks":                  AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/GetShortcut":                    AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/GetShortcutByName":              AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/ListShortcutSuggestions":        AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/ResolvePreview":                 AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/GetShortcutAnalytics":           AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/GetTrendingShortcuts":           AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/GetShortcutQRCode":              AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/ListBrokenShortcuts":            AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/GetResolutionSnapshot":          AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/GetImportJob":                   AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/ListImportJobs":                 AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/ListShortcutAnalyticsShares":    AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/GetSharedShortcutAnalytics":     AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/ListProposedChanges":            AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/ListShortcutRotations":          AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/ListShortcutACLs":               AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/CreateShortcut":                 AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/UpdateShortcut":                 AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/DeleteShortcut":                 AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/TransferShortcut":               AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/RefreshShortcutMetadata":        AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/SuggestShortcut":                AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/SemanticSearchShortcuts":        AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.ShortcutService/GenerateSignedRedirect":         AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/CreateShortcutAnalyticsShare":   AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/DeleteShortcutAnalyticsShare":   AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/ApproveProposedChange":          AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/RejectProposedChange":           AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/CreateShortcutRotation":         AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/DeleteShortcutRotation":         AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/UpsertShortcutACL":              AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/DeleteShortcutACL":              AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/CreateImportJob":                AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.ShortcutService/ResumeImportJob":                AccessTokenScopeShortcutsWrite,
+	"/slash.api.v1.SearchService/Search":                           AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.DashboardService/GetDashboard":                  AccessTokenScopeShortcutsRead,
+	"/slash.api.v1.CollectionService/ListCollections":              AccessTokenScopeCollectionsRead,
+	"/slash.api.v1.CollectionService/GetCollection":                AccessTokenScopeCollectionsRead,
+	"/slash.api.v1.CollectionService/GetCollectionByName":          AccessTokenScopeCollectionsRead,
+	"/slash.api.v1.CollectionService/ListCollectionShares":         AccessTokenScopeCollectionsRead,
+	"/slash.api.v1.CollectionService/GetSharedCollection":          AccessTokenScopeCollectionsRead,
+	"/slash.api.v1.CollectionService/ListCollectionTemplates":      AccessTokenScopeCollectionsRead,
+	"/slash.api.v1.CollectionService/CreateCollection":             AccessTokenScopeCollectionsWrite,
+	"/slash.api.v1.CollectionService/UpdateCollection":             AccessTokenScopeCollectionsWrite,
+	"/slash.api.v1.CollectionService/DeleteCollection":             AccessTokenScopeCollectionsWrite,
+	"/slash.api.v1.CollectionService/TransferCollection":           AccessTokenScopeCollectionsWrite,
+	"/slash.api.v1.CollectionService/ArchiveCollection":            AccessTokenScopeCollectionsWrite,
+	"/slash.api.v1.CollectionService/UnarchiveCollection":          AccessTokenScopeCollectionsWrite,
+	"/slash.api.v1.CollectionService/CreateCollectionShare":        AccessTokenScopeCollectionsWrite,
+	"/slash.api.v1.CollectionService/DeleteCollectionShare":        AccessTokenScopeCollectionsWrite,
+	"/slash.api.v1.CollectionService/CreateCollectionFromTemplate": AccessTokenScopeCollectionsWrite,
+}
+
+// allowedMethodsForAllScopes are the methods about the access token itself, which access tokens with any scopes can call.
+var allowedMethodsForAllScopes = map[string]bool{
+	"/slash.api.v1.AuthService/GetAuthStatus": true,
+	"/slash.api.v1.AuthService/SignOut":       true,
+}
+
+// isMethodAllowedForScopes returns true if an access token with the scopes can call the method.
+func isMethodAllowedForScopes(methodName string, scopes []string) bool {
+	if len(scopes) == 0 || slices.Contains(scopes, AccessTokenScopeAdmin) || allowedMethodsForAllScopes[methodName] {
+		return true
+	}
+	scope, ok := methodScopes[methodName]
+	if !ok {
+		return false
+	}
+	return hasAccessTokenScope(scopes, scope)
+}
+
+// hasAccessTokenScope returns true if the scopes include the scope, where the write scopes include the read ones.
+func hasAccessTokenScope(scopes []string, scope string) bool {
+	if len(scopes) == 0 || slices.Contains(scopes, AccessTokenScopeAdmin) || slices.Contains(scopes, scope) {
+		return true
+	}
+	if resource, ok := strings.CutSuffix(scope, ":read"); ok {
+		return slices.Contains(scopes, resource+":write")
+	}
+	return false
+}
+
+// coversAccessTokenScopes returns true if an access token with the scopes can grant an access token with the requested scopes,
+// where no scopes are full access.
+func coversAccessTokenScopes(scopes, requestedScopes []string) bool {
+	if len(scopes) == 0 || slices.Contains(scopes, AccessTokenScopeAdmin) {
+		return true
+	}
+	if len(requestedScopes) == 0 {
+		return false
+	}
+	for _, scope := range requestedScopes {
+		if !hasAccessTokenScope(scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,210 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/profile"
+	"github.com/warthurton/slash/store"
+	teststore "github.com/warthurton/slash/store/test"
+)
+
+const testSecret = "test-secret"
+
+// createTestAccessToken creates an access token of the user with the stored fields, e.g. the scopes it's restricted to,
+// and returns it.
+func createTestAccessToken(ctx context.Context, t *testing.T, ts *store.Store, user *store.User, userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) string {
+	s := &APIV1Service{
+		Secret:  testSecret,
+		Profile: &profile.Profile{},
+		Store:   ts,
+	}
+	// The access tokens issued in the same second differ by their expiration.
+	userAccessTokens, err := ts.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	expiresAt := time.Now().Add(time.Duration(len(userAccessTokens)+1) * time.Hour)
+	accessToken, err := GenerateAccessToken(user.Email, user.ID, expiresAt, []byte(testSecret))
+	require.NoError(t, err)
+	userAccessToken.AccessToken = accessToken
+	userAccessToken.Description = "test"
+	require.NoError(t, s.UpsertAccessTokenToStore(ctx, user, userAccessToken))
+	return accessToken
+}
+
+// callAuthenticationInterceptor calls the method through the interceptor with the access token, and returns the id
+// of the user the handler is called as, 0 when it's called anonymously.
+func callAuthenticationInterceptor(ctx context.Context, in *GRPCAuthInterceptor, fullMethod string, request any, accessToken string) (int32, error) {
+	if accessToken != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken))
+	} else {
+		ctx = metadata.NewIncomingContext(ctx, metadata.MD{})
+	}
+	var userID int32
+	_, err := in.AuthenticationInterceptor(ctx, request, &grpc.UnaryServerInfo{FullMethod: fullMethod}, func(ctx context.Context, _ any) (any, error) {
+		userID, _ = ctx.Value(userIDContextKey).(int32)
+		return nil, nil
+	})
+	return userID, err
+}
+
+func TestAuthenticationInterceptor(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	admin, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "admin@test.com",
+		Nickname: "admin",
+	})
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	breakGlassPasswordHash := "break-glass-password-hash"
+	in := NewGRPCAuthInterceptor(ts, testSecret)
+	in.breakGlassEmail = "break-glass@test.com"
+	in.breakGlassPasswordHash = breakGlassPasswordHash
+	breakGlassAccessToken, err := generateBreakGlassAccessToken(in.breakGlassEmail, time.Now().Add(time.Hour), testSecret, breakGlassPasswordHash)
+	require.NoError(t, err)
+	accessTokens := map[string]string{
+		"admin": createTestAccessToken(ctx, t, ts, admin, &storepb.UserSetting_AccessTokensSetting_AccessToken{}),
+		"user":  createTestAccessToken(ctx, t, ts, user, &storepb.UserSetting_AccessTokensSetting_AccessToken{}),
+		"shortcuts:read": createTestAccessToken(ctx, t, ts, user, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+			Scopes: []string{AccessTokenScopeShortcutsRead},
+		}),
+		"shortcuts:write": createTestAccessToken(ctx, t, ts, user, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+			Scopes: []string{AccessTokenScopeShortcutsWrite},
+		}),
+		"collections:read": createTestAccessToken(ctx, t, ts, user, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+			Scopes: []string{AccessTokenScopeCollectionsRead},
+		}),
+		"admin scope": createTestAccessToken(ctx, t, ts, user, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+			Scopes: []string{AccessTokenScopeAdmin},
+		}),
+		"impersonation": createTestAccessToken(ctx, t, ts, user, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+			ImpersonatorId: admin.ID,
+		}),
+		"break-glass": breakGlassAccessToken,
+		"anonymous":   "",
+		"invalid":     "invalid",
+	}
+
+	tests := []struct {
+		name        string
+		accessToken string
+		method      string
+		request     any
+		code        codes.Code
+		userID      int32
+	}{
+		// Anonymous and invalid access tokens only call the methods allowed when unauthorized.
+		{"anonymous shortcut", "anonymous", "/slash.api.v1.ShortcutService/GetShortcut", nil, codes.OK, 0},
+		{"anonymous list", "anonymous", "/slash.api.v1.ShortcutService/ListShortcuts", nil, codes.Unauthenticated, 0},
+		{"invalid shortcut", "invalid", "/slash.api.v1.ShortcutService/GetShortcut", nil, codes.OK, 0},
+		{"invalid list", "invalid", "/slash.api.v1.ShortcutService/ListShortcuts", nil, codes.Unauthenticated, 0},
+
+		// The scopes restrict the methods, where the write scopes include the read ones, and the methods allowed
+		// when unauthorized are called anonymously without their scope.
+		{"full access", "user", "/slash.api.v1.UserService/ListUsers", nil, codes.OK, user.ID},
+		{"read scope reads", "shortcuts:read", "/slash.api.v1.ShortcutService/ListShortcuts", nil, codes.OK, user.ID},
+		{"read scope writes", "shortcuts:read", "/slash.api.v1.ShortcutService/CreateShortcut", nil, codes.PermissionDenied, 0},
+		{"write scope reads", "shortcuts:write", "/slash.api.v1.ShortcutService/ListShortcuts", nil, codes.OK, user.ID},
+		{"write scope writes", "shortcuts:write", "/slash.api.v1.ShortcutService/CreateShortcut", nil, codes.OK, user.ID},
+		{"scope of another resource", "collections:read", "/slash.api.v1.ShortcutService/ListShortcuts", nil, codes.PermissionDenied, 0},
+		{"scope of another resource anonymously", "collections:read", "/slash.api.v1.ShortcutService/GetShortcut", nil, codes.OK, 0},
+		{"own scope", "shortcuts:read", "/slash.api.v1.ShortcutService/GetShortcut", nil, codes.OK, user.ID},
+		{"unscoped method", "shortcuts:write", "/slash.api.v1.UserService/ListUsers", nil, codes.PermissionDenied, 0},
+		{"method for all scopes", "collections:read", "/slash.api.v1.AuthService/GetAuthStatus", nil, codes.OK, user.ID},
+		{"admin scope", "admin scope", "/slash.api.v1.UserService/ListUsers", nil, codes.OK, user.ID},
+
+		// The admin methods are only for the admins, whatever the scopes.
+		{"admin method by admin", "admin", "/slash.api.v1.UserService/CreateUser", nil, codes.OK, admin.ID},
+		{"admin method by user", "user", "/slash.api.v1.UserService/CreateUser", nil, codes.PermissionDenied, 0},
+		{"admin method by admin scope", "admin scope", "/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting", nil, codes.PermissionDenied, 0},
+
+		// The impersonated sessions can't change the credentials of the account.
+		{"impersonation", "impersonation", "/slash.api.v1.ShortcutService/ListShortcuts", nil, codes.OK, user.ID},
+		{"impersonation creates access token", "impersonation", "/slash.api.v1.UserService/CreateUserAccessToken", nil, codes.PermissionDenied, 0},
+		{"impersonation impersonates", "impersonation", "/slash.api.v1.UserService/ImpersonateUser", nil, codes.PermissionDenied, 0},
+		{"impersonation updates password", "impersonation", "/slash.api.v1.UserService/UpdateUser", &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Id: user.ID, Password: "password"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}},
+		}, codes.PermissionDenied, 0},
+		{"impersonation updates nickname", "impersonation", "/slash.api.v1.UserService/UpdateUser", &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Id: user.ID, Nickname: "nickname"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"nickname"}},
+		}, codes.OK, user.ID},
+
+		// The break-glass admin only calls the methods to recover the workspace.
+		{"break-glass recovers", "break-glass", "/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting", nil, codes.OK, BreakGlassUserID},
+		{"break-glass creates user", "break-glass", "/slash.api.v1.UserService/CreateUser", nil, codes.OK, BreakGlassUserID},
+		{"break-glass lists shortcuts", "break-glass", "/slash.api.v1.ShortcutService/ListShortcuts", nil, codes.PermissionDenied, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			accessToken, ok := accessTokens[test.accessToken]
+			require.True(t, ok)
+			userID, err := callAuthenticationInterceptor(ctx, in, test.method, test.request, accessToken)
+			require.Equal(t, test.code, status.Code(err), err)
+			require.Equal(t, test.userID, userID)
+		})
+	}
+}
+
+func TestIsMethodAllowedForScopes(t *testing.T) {
+	tests := []struct {
+		method string
+		scopes []string
+		want   bool
+	}{
+		{"/slash.api.v1.UserService/ListUsers", nil, true},
+		{"/slash.api.v1.UserService/ListUsers", []string{AccessTokenScopeAdmin}, true},
+		{"/slash.api.v1.UserService/ListUsers", []string{AccessTokenScopeShortcutsWrite, AccessTokenScopeCollectionsWrite}, false},
+		{"/slash.api.v1.ShortcutService/ListShortcuts", []string{AccessTokenScopeShortcutsRead}, true},
+		{"/slash.api.v1.ShortcutService/ListShortcuts", []string{AccessTokenScopeShortcutsWrite}, true},
+		{"/slash.api.v1.ShortcutService/ListShortcuts", []string{AccessTokenScopeCollectionsWrite}, false},
+		{"/slash.api.v1.ShortcutService/CreateShortcut", []string{AccessTokenScopeShortcutsRead}, false},
+		{"/slash.api.v1.CollectionService/CreateCollection", []string{AccessTokenScopeShortcutsWrite, AccessTokenScopeCollectionsWrite}, true},
+		{"/slash.api.v1.AuthService/SignOut", []string{AccessTokenScopeCollectionsRead}, true},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, isMethodAllowedForScopes(test.method, test.scopes), "%s with %v", test.method, test.scopes)
+	}
+
+	// Every scoped method is a known scope.
+	for method, scope := range methodScopes {
+		require.Contains(t, accessTokenScopes, scope, method)
+	}
+}
+
+func TestCoversAccessTokenScopes(t *testing.T) {
+	tests := []struct {
+		scopes          []string
+		requestedScopes []string
+		want            bool
+	}{
+		{nil, nil, true},
+		{nil, []string{AccessTokenScopeAdmin}, true},
+		{[]string{AccessTokenScopeAdmin}, nil, true},
+		{[]string{AccessTokenScopeShortcutsWrite}, nil, false},
+		{[]string{AccessTokenScopeShortcutsWrite}, []string{AccessTokenScopeShortcutsRead}, true},
+		{[]string{AccessTokenScopeShortcutsRead}, []string{AccessTokenScopeShortcutsWrite}, false},
+		{[]string{AccessTokenScopeShortcutsWrite}, []string{AccessTokenScopeShortcutsRead, AccessTokenScopeCollectionsRead}, false},
+		{[]string{AccessTokenScopeShortcutsRead}, []string{AccessTokenScopeAdmin}, false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, coversAccessTokenScopes(test.scopes, test.requestedScopes), "%v covering %v", test.scopes, test.requestedScopes)
+	}
+}
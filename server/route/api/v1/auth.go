@@ -2,9 +2,13 @@ package v1
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/warthurton/slash/plugin/idp"
 )
 
 const (
@@ -22,6 +26,36 @@ const (
 	CookieExpDuration = AccessTokenDuration - 1*time.Minute
 	// AccessTokenCookieName is the cookie name of access token.
 	AccessTokenCookieName = "slash.access-token"
+	// SignInAccessTokenDescription is the description of access tokens issued on sign in.
+	SignInAccessTokenDescription = "user login"
+
+	// IdentityProviderLinkAudienceName is the audience name of the pending identity provider link token.
+	IdentityProviderLinkAudienceName = "user.idp-link"
+	// IdentityProviderLinkDuration is the duration to confirm a pending identity provider link.
+	IdentityProviderLinkDuration = 10 * time.Minute
+	// IdentityProviderLinkCookieName is the cookie name of the pending identity provider link token.
+	IdentityProviderLinkCookieName = "slash.idp-link"
+
+	// PasskeyCeremonyAudienceName is the audience name of the pending passkey ceremony token.
+	PasskeyCeremonyAudienceName = "user.passkey-ceremony"
+	// PasskeyCeremonyDuration is the duration to complete a passkey registration or sign in.
+	PasskeyCeremonyDuration = 5 * time.Minute
+	// PasskeyCeremonyCookieName is the cookie name of the pending passkey ceremony token.
+	PasskeyCeremonyCookieName = "slash.passkey-ceremony"
+
+	// SAMLAssertionAudienceName is the audience name of the validated SAML assertion token.
+	SAMLAssertionAudienceName = "user.saml-assertion"
+	// SAMLAssertionDuration is the duration to exchange the validated SAML assertion for a sign in.
+	SAMLAssertionDuration = 2 * time.Minute
+	// SAMLRequestDuration is the duration to complete the sign in with the SAML identity provider.
+	SAMLRequestDuration = 10 * time.Minute
+	// SAMLRequestCookieName is the cookie name of the pending SAML authentication request id.
+	SAMLRequestCookieName = "slash.saml-request"
+
+	// EmailVerificationAudienceName is the audience name of the token of the verification email.
+	EmailVerificationAudienceName = "user.email-verification"
+	// EmailVerificationDuration is the duration to confirm the email with the verification email.
+	EmailVerificationDuration = 24 * time.Hour
 )
 
 type ClaimsMessage struct {
@@ -29,6 +63,37 @@ type ClaimsMessage struct {
 	jwt.RegisteredClaims
 }
 
+// IdentityProviderLinkClaims is the claims of the pending identity provider link token.
+// The subject is the id of the existing user to be linked.
+type IdentityProviderLinkClaims struct {
+	IdpID      string `json:"idp_id"`
+	Identifier string `json:"identifier"`
+	jwt.RegisteredClaims
+}
+
+// PasskeyCeremonyClaims is the claims of the pending passkey ceremony token, which keeps the WebAuthn session
+// between its two steps. The subject is the id of the user registering a passkey, and 0 when signing in.
+type PasskeyCeremonyClaims struct {
+	Session string `json:"session"`
+	jwt.RegisteredClaims
+}
+
+// SAMLAssertionClaims is the claims of the validated SAML assertion token, which is passed to the auth callback as the code.
+type SAMLAssertionClaims struct {
+	IdpID       string   `json:"idp_id"`
+	Identifier  string   `json:"identifier"`
+	DisplayName string   `json:"display_name"`
+	Groups      []string `json:"groups,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// EmailVerificationClaims is the claims of the token of the verification email. The subject is the id of the user,
+// and the email is the one to confirm, so the token doesn't confirm the email the user changed to.
+type EmailVerificationClaims struct {
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
 // GenerateAccessToken generates an access token.
 // username is the email of the user.
 func GenerateAccessToken(username string, userID int32, expirationTime time.Time, secret []byte) (string, error) {
@@ -62,3 +127,159 @@ func generateToken(username string, userID int32, audience string, expirationTim
 
 	return tokenString, nil
 }
+
+// generateIdentityProviderLinkToken generates a token of the pending link between the identity and the user.
+func generateIdentityProviderLinkToken(userID int32, idpID, identifier string, expirationTime time.Time, secret []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &IdentityProviderLinkClaims{
+		IdpID:      idpID,
+		Identifier: identifier,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{IdentityProviderLinkAudienceName},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			Subject:   fmt.Sprint(userID),
+		},
+	})
+	token.Header["kid"] = KeyID
+	return token.SignedString(secret)
+}
+
+// parseIdentityProviderLinkToken parses and verifies the pending identity provider link token.
+func parseIdentityProviderLinkToken(tokenString string, secret []byte) (*IdentityProviderLinkClaims, error) {
+	claims := &IdentityProviderLinkClaims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		if kid, ok := t.Header["kid"].(string); ok && kid == KeyID {
+			return secret, nil
+		}
+		return nil, errors.Errorf("unexpected token kid=%v", t.Header["kid"])
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}), jwt.WithAudience(IdentityProviderLinkAudienceName)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// generatePasskeyCeremonyToken generates a token of the pending passkey ceremony with the WebAuthn session in JSON.
+func generatePasskeyCeremonyToken(userID int32, session string, expirationTime time.Time, secret []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &PasskeyCeremonyClaims{
+		Session: session,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{PasskeyCeremonyAudienceName},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			Subject:   fmt.Sprint(userID),
+		},
+	})
+	token.Header["kid"] = KeyID
+	return token.SignedString(secret)
+}
+
+// parsePasskeyCeremonyToken parses and verifies the pending passkey ceremony token.
+func parsePasskeyCeremonyToken(tokenString string, secret []byte) (*PasskeyCeremonyClaims, error) {
+	claims := &PasskeyCeremonyClaims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		if kid, ok := t.Header["kid"].(string); ok && kid == KeyID {
+			return secret, nil
+		}
+		return nil, errors.Errorf("unexpected token kid=%v", t.Header["kid"])
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}), jwt.WithAudience(PasskeyCeremonyAudienceName)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// generateSAMLAssertionToken generates a token of the user information of the validated SAML assertion.
+func generateSAMLAssertionToken(idpID string, userInfo *idp.IdentityProviderUserInfo, expirationTime time.Time, secret []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &SAMLAssertionClaims{
+		IdpID:       idpID,
+		Identifier:  userInfo.Identifier,
+		DisplayName: userInfo.DisplayName,
+		Groups:      userInfo.Groups,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{SAMLAssertionAudienceName},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+		},
+	})
+	token.Header["kid"] = KeyID
+	return token.SignedString(secret)
+}
+
+// parseSAMLAssertionToken parses and verifies the validated SAML assertion token.
+func parseSAMLAssertionToken(tokenString string, secret []byte) (*SAMLAssertionClaims, error) {
+	claims := &SAMLAssertionClaims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		if kid, ok := t.Header["kid"].(string); ok && kid == KeyID {
+			return secret, nil
+		}
+		return nil, errors.Errorf("unexpected token kid=%v", t.Header["kid"])
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}), jwt.WithAudience(SAMLAssertionAudienceName)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// generateEmailVerificationToken generates a token of the verification email of the user.
+func generateEmailVerificationToken(userID int32, email string, expirationTime time.Time, secret []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &EmailVerificationClaims{
+		Email: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{EmailVerificationAudienceName},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			Subject:   fmt.Sprint(userID),
+		},
+	})
+	token.Header["kid"] = KeyID
+	return token.SignedString(secret)
+}
+
+// parseEmailVerificationToken parses and verifies the token of the verification email.
+func parseEmailVerificationToken(tokenString string, secret []byte) (*EmailVerificationClaims, error) {
+	claims := &EmailVerificationClaims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		if kid, ok := t.Header["kid"].(string); ok && kid == KeyID {
+			return secret, nil
+		}
+		return nil, errors.Errorf("unexpected token kid=%v", t.Header["kid"])
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}), jwt.WithAudience(EmailVerificationAudienceName)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// buildAccessTokenCookie builds the Set-Cookie header value of the access token
+// with the cookie attributes configured in the server profile.
+func (s *APIV1Service) buildAccessTokenCookie(accessToken, expires string) string {
+	return s.buildCookie(AccessTokenCookieName, accessToken, expires)
+}
+
+// buildCookie builds the Set-Cookie header value with the cookie attributes configured in the server profile.
+func (s *APIV1Service) buildCookie(name, value, expires string) string {
+	// The cookie is sent to the base path only, e.g. "/slash" and its subpaths.
+	path := s.Profile.BasePath
+	if path == "" {
+		path = "/"
+	}
+	attributes := []string{
+		fmt.Sprintf("%s=%s", name, value),
+		fmt.Sprintf("Path=%s", path),
+		fmt.Sprintf("Expires=%s", expires),
+		"HttpOnly",
+	}
+	if s.Profile.CookieDomain != "" {
+		attributes = append(attributes, fmt.Sprintf("Domain=%s", s.Profile.CookieDomain))
+	}
+	if s.Profile.CookieSecure {
+		attributes = append(attributes, "Secure")
+	}
+	sameSite := s.Profile.CookieSameSite
+	if sameSite == "" {
+		sameSite = "Strict"
+	}
+	attributes = append(attributes, fmt.Sprintf("SameSite=%s", sameSite))
+	return strings.Join(attributes, "; ")
+}
@@ -2,9 +2,11 @@ package v1
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
+	"slices"
 	"time"
 
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/warthurton/slash/internal/util"
 	"github.com/warthurton/slash/plugin/idp"
+	"github.com/warthurton/slash/plugin/idp/ldap"
 	"github.com/warthurton/slash/plugin/idp/oauth2"
 	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
 	storepb "github.com/warthurton/slash/proto/gen/store"
@@ -37,6 +40,9 @@ func (s *APIV1Service) GetAuthStatus(ctx context.Context, _ *v1pb.GetAuthStatusR
 }
 
 func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest) (*v1pb.User, error) {
+	if s.Profile.BreakGlassEmail != "" && request.Email == s.Profile.BreakGlassEmail {
+		return s.signInWithBreakGlass(ctx, request.Password)
+	}
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
 		Email: &request.Email,
 	})
@@ -73,24 +79,9 @@ func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWi
 		return nil, status.Errorf(codes.PermissionDenied, "SSO is not available in the current plan")
 	}
 
-	identityProviderSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
-		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
-	})
+	identityProvider, err := s.getIdentityProvider(ctx, request.IdpId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %s", err)
-	}
-	if identityProviderSetting == nil || identityProviderSetting.GetIdentityProvider() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
-	}
-	var identityProvider *storepb.IdentityProvider
-	for _, idp := range identityProviderSetting.GetIdentityProvider().IdentityProviders {
-		if idp.Id == request.IdpId {
-			identityProvider = idp
-			break
-		}
-	}
-	if identityProvider == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
+		return nil, err
 	}
 
 	var userInfo *idp.IdentityProviderUserInfo
@@ -99,7 +90,7 @@ func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWi
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create oauth2 identity provider, err: %s", err)
 		}
-		token, err := oauth2IdentityProvider.ExchangeToken(ctx, request.RedirectUri, request.Code)
+		token, err := oauth2IdentityProvider.ExchangeToken(ctx, request.RedirectUri, request.Code, request.Nonce)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to exchange token, err: %s", err)
 		}
@@ -107,18 +98,132 @@ func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWi
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user info, err: %s", err)
 		}
+	} else if identityProvider.Type == storepb.IdentityProvider_SAML {
+		// The SAML response is validated by the assertion consumer service, which passes the user info as the code.
+		claims, err := parseSAMLAssertionToken(request.Code, []byte(s.Secret))
+		if err != nil || claims.IdpID != identityProvider.Id {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid or expired SAML sign in, please try again")
+		}
+		userInfo = &idp.IdentityProviderUserInfo{
+			Identifier:  claims.Identifier,
+			DisplayName: claims.DisplayName,
+			Groups:      claims.Groups,
+		}
+	}
+	if userInfo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported identity provider type: %s", identityProvider.Type)
 	}
+	return s.signInWithIdentityProvider(ctx, identityProvider, userInfo)
+}
 
+func (s *APIV1Service) SignInWithLDAP(ctx context.Context, request *v1pb.SignInWithLDAPRequest) (*v1pb.User, error) {
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+		return nil, status.Errorf(codes.PermissionDenied, "SSO is not available in the current plan")
+	}
+
+	identityProvider, err := s.getIdentityProvider(ctx, request.IdpId)
+	if err != nil {
+		return nil, err
+	}
+	if identityProvider.Type != storepb.IdentityProvider_LDAP {
+		return nil, status.Errorf(codes.InvalidArgument, "identity provider %q is not an LDAP identity provider", identityProvider.Id)
+	}
+	ldapIdentityProvider, err := ldap.NewIdentityProvider(identityProvider.Config.GetLdap())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create ldap identity provider, err: %s", err)
+	}
+	userInfo, err := ldapIdentityProvider.Authenticate(request.Username, request.Password)
+	if err != nil {
+		if errors.Is(err, ldap.ErrInvalidCredentials) {
+			return nil, status.Errorf(codes.InvalidArgument, "unmatched username and password")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to authenticate with ldap, err: %s", err)
+	}
+	return s.signInWithIdentityProvider(ctx, identityProvider, userInfo)
+}
+
+func (s *APIV1Service) getIdentityProvider(ctx context.Context, idpID string) (*storepb.IdentityProvider, error) {
+	identityProviderSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %s", err)
+	}
+	if identityProviderSetting == nil || identityProviderSetting.GetIdentityProvider() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
+	}
+	for _, identityProvider := range identityProviderSetting.GetIdentityProvider().IdentityProviders {
+		if identityProvider.Id == idpID {
+			return identityProvider, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
+}
+
+// signInWithIdentityProvider signs in the account linked to the identity, or with the same email, or creates a new account.
+func (s *APIV1Service) signInWithIdentityProvider(ctx context.Context, identityProvider *storepb.IdentityProvider, userInfo *idp.IdentityProviderUserInfo) (*v1pb.User, error) {
 	email := userInfo.Identifier
 	if !util.ValidateEmail(email) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid email address")
 	}
-	user, err := s.Store.GetUser(ctx, &store.FindUser{
-		Email: &email,
+	var user *store.User
+	identityProviderLink, err := s.Store.GetIdentityProviderLink(ctx, &store.FindIdentityProviderLink{
+		IdpID:      &identityProvider.Id,
+		Identifier: &userInfo.Identifier,
 	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find identity provider link, err: %s", err)
+	}
+	if identityProviderLink != nil {
+		user, err = s.Store.GetUser(ctx, &store.FindUser{
+			ID: &identityProviderLink.UserID,
+		})
+	} else {
+		user, err = s.Store.GetUser(ctx, &store.FindUser{
+			Email: &email,
+		})
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user, err: %s", err)
 	}
+	if user == nil {
+		// The email may be an unverified secondary email of the signed in user.
+		currentUser, err := getCurrentUser(ctx, s.Store)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user, err: %s", err)
+		}
+		if currentUser != nil {
+			userEmail, err := s.Store.GetUserEmail(ctx, &store.FindUserEmail{
+				UserID: &currentUser.ID,
+				Email:  &email,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user email, err: %s", err)
+			}
+			if userEmail != nil {
+				user = currentUser
+			}
+		}
+	}
+	if user != nil && identityProviderLink == nil {
+		// The identity is not linked to the existing account with the same email yet.
+		// Link it only when the account is signed in already, otherwise an explicit link step is required.
+		currentUser, err := getCurrentUser(ctx, s.Store)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user, err: %s", err)
+		}
+		if currentUser == nil || currentUser.ID != user.ID {
+			if err := s.setIdentityProviderLinkCookie(ctx, user, identityProvider.Id, userInfo.Identifier); err != nil {
+				return nil, err
+			}
+			return nil, status.Errorf(codes.FailedPrecondition, "an account with email %s already exists, confirm its password to link the identity provider", email)
+		}
+		if err := s.linkIdentityProvider(ctx, user, identityProvider.Id, userInfo.Identifier); err != nil {
+			return nil, err
+		}
+	}
+	// The role is synced from the groups of the user when the identity provider maps them.
+	groupRole := getRoleFromGroups(getIdentityProviderFieldMapping(identityProvider), userInfo.Groups)
 	if user == nil {
 		if err := s.checkSeatAvailability(ctx); err != nil {
 			return nil, err
@@ -128,6 +233,11 @@ func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWi
 			Nickname: userInfo.DisplayName,
 			// The new signup user should be normal user by default.
 			Role: store.RoleUser,
+			// The identity provider proves the ownership of the email.
+			EmailVerified: true,
+		}
+		if groupRole != nil {
+			userCreate.Role = *groupRole
 		}
 		password, err := util.RandomString(20)
 		if err != nil {
@@ -142,10 +252,22 @@ func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWi
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create user, err: %s", err)
 		}
+		if err := s.linkIdentityProvider(ctx, user, identityProvider.Id, userInfo.Identifier); err != nil {
+			return nil, err
+		}
 	}
 	if user.RowStatus == storepb.RowStatus_ARCHIVED {
 		return nil, status.Errorf(codes.PermissionDenied, "user has been archived")
 	}
+	if groupRole != nil && user.Role != *groupRole {
+		user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{
+			ID:   user.ID,
+			Role: groupRole,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update user role, err: %s", err)
+		}
+	}
 
 	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to sign in, err: %s", err)
@@ -153,6 +275,144 @@ func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWi
 	return convertUserFromStore(user), nil
 }
 
+func getIdentityProviderFieldMapping(identityProvider *storepb.IdentityProvider) *storepb.IdentityProviderConfig_FieldMapping {
+	switch identityProvider.Type {
+	case storepb.IdentityProvider_OAUTH2:
+		return identityProvider.Config.GetOauth2().GetFieldMapping()
+	case storepb.IdentityProvider_SAML:
+		return identityProvider.Config.GetSaml().GetFieldMapping()
+	case storepb.IdentityProvider_LDAP:
+		return identityProvider.Config.GetLdap().GetFieldMapping()
+	default:
+		return nil
+	}
+}
+
+// getRoleFromGroups returns the role of the user with the groups, or nil when the groups are not mapped.
+func getRoleFromGroups(fieldMapping *storepb.IdentityProviderConfig_FieldMapping, groups []string) *store.Role {
+	if fieldMapping.GetGroups() == "" || fieldMapping.GetAdminGroup() == "" {
+		return nil
+	}
+	role := store.RoleUser
+	if slices.Contains(groups, fieldMapping.AdminGroup) {
+		role = store.RoleAdmin
+	}
+	return &role
+}
+
+func (s *APIV1Service) LinkIdentityProvider(ctx context.Context, request *v1pb.LinkIdentityProviderRequest) (*v1pb.User, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse metadata from incoming context")
+	}
+	linkToken := getCookieFromMetadata(md, IdentityProviderLinkCookieName)
+	if linkToken == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "no pending identity provider link, sign in with SSO again")
+	}
+	claims, err := parseIdentityProviderLinkToken(linkToken, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid or expired identity provider link, sign in with SSO again")
+	}
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "malformed identity provider link")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "user not found")
+	}
+	// The ownership of the account is confirmed by its session, e.g. after signing in with another identity provider,
+	// which lets the accounts without a password link another identity provider, or else by its password.
+	// The password is only used to confirm the ownership together with the SSO identity,
+	// so it's accepted even if password authentication is disallowed.
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != user.ID {
+		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "incorrect password")
+		}
+	}
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.PermissionDenied, "user has been archived")
+	}
+
+	if err := s.linkIdentityProvider(ctx, user, claims.IdpID, claims.Identifier); err != nil {
+		return nil, err
+	}
+	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
+	}
+	// Expire the pending link.
+	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
+		"Set-Cookie": s.buildCookie(IdentityProviderLinkCookieName, "", "Thu, 01 Jan 1970 00:00:00 GMT"),
+	})); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+	}
+	return convertUserFromStore(user), nil
+}
+
+func (s *APIV1Service) setIdentityProviderLinkCookie(ctx context.Context, user *store.User, idpID, identifier string) error {
+	expireTime := time.Now().Add(IdentityProviderLinkDuration)
+	linkToken, err := generateIdentityProviderLinkToken(user.ID, idpID, identifier, expireTime, []byte(s.Secret))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to generate identity provider link token: %v", err)
+	}
+	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
+		"Set-Cookie": s.buildCookie(IdentityProviderLinkCookieName, linkToken, expireTime.Format(time.RFC1123)),
+	})); err != nil {
+		return status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+	}
+	return nil
+}
+
+func (s *APIV1Service) linkIdentityProvider(ctx context.Context, user *store.User, idpID, identifier string) error {
+	if _, err := s.Store.UpsertIdentityProviderLink(ctx, &store.IdentityProviderLink{
+		UserID:     user.ID,
+		IdpID:      idpID,
+		Identifier: identifier,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to link identity provider: %v", err)
+	}
+
+	// The identity provider proves the ownership of the email, so the secondary email is verified.
+	verified := false
+	userEmail, err := s.Store.GetUserEmail(ctx, &store.FindUserEmail{
+		UserID:   &user.ID,
+		Email:    &identifier,
+		Verified: &verified,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user email: %v", err)
+	}
+	if userEmail != nil {
+		// Skip it if the email has been taken by another user in the meantime.
+		existingUser, err := s.Store.GetUser(ctx, &store.FindUser{
+			Email: &identifier,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if existingUser != nil && existingUser.ID != user.ID {
+			return nil
+		}
+		verified = true
+		if _, err := s.Store.UpdateUserEmail(ctx, &store.UpdateUserEmail{
+			ID:       userEmail.ID,
+			Verified: &verified,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to verify user email: %v", err)
+		}
+	}
+	return nil
+}
+
 func (s *APIV1Service) SignUp(ctx context.Context, request *v1pb.SignUpRequest) (*v1pb.User, error) {
 	workspaceSecuritySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
 	if err != nil {
@@ -172,10 +432,16 @@ func (s *APIV1Service) SignUp(ctx context.Context, request *v1pb.SignUpRequest)
 		return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
 	}
 
+	mailSetting, err := s.Store.GetWorkspaceMailSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace mail setting: %v", err)
+	}
+
 	create := &store.User{
-		Email:        request.Email,
-		Nickname:     request.Nickname,
-		PasswordHash: string(passwordHash),
+		Email:         request.Email,
+		Nickname:      request.Nickname,
+		PasswordHash:  string(passwordHash),
+		EmailVerified: !isEmailVerificationRequired(mailSetting),
 	}
 	existingUsers, err := s.Store.ListUsers(ctx, &store.FindUser{})
 	if err != nil {
@@ -184,6 +450,7 @@ func (s *APIV1Service) SignUp(ctx context.Context, request *v1pb.SignUpRequest)
 	// The first user to sign up is an admin by default.
 	if len(existingUsers) == 0 {
 		create.Role = store.RoleAdmin
+		create.EmailVerified = true
 	} else {
 		create.Role = store.RoleUser
 	}
@@ -192,9 +459,18 @@ func (s *APIV1Service) SignUp(ctx context.Context, request *v1pb.SignUpRequest)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	if err := s.releaseUnverifiedEmail(ctx, user.Email); err != nil {
+		return nil, err
+	}
 	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
 	}
+	if !user.EmailVerified {
+		// The user can send the verification email again, so failing to send it doesn't fail the sign up.
+		if err := s.sendVerificationEmail(ctx, user, mailSetting); err != nil {
+			slog.Error("failed to send verification email", slog.Int("user", int(user.ID)), slog.Any("error", err))
+		}
+	}
 	return convertUserFromStore(user), nil
 }
 
@@ -203,11 +479,24 @@ func (s *APIV1Service) doSignIn(ctx context.Context, user *store.User, expireTim
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to generate access token: %v", err)
 	}
-	if err := s.UpsertAccessTokenToStore(ctx, user, accessToken, "user login"); err != nil {
+	userAgent, ip := getClientInfo(ctx, s.Profile.GetTrustedProxies())
+	userSession, err := s.Store.CreateUserSession(ctx, &store.UserSession{
+		UserID:    user.ID,
+		UserAgent: userAgent,
+		IP:        ip,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create user session: %v", err)
+	}
+	if err := s.UpsertAccessTokenToStore(ctx, user, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+		AccessToken: accessToken,
+		Description: SignInAccessTokenDescription,
+		SessionId:   userSession.ID,
+	}); err != nil {
 		return status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
 	}
 
-	cookie := fmt.Sprintf("%s=%s; Path=/; Expires=%s; HttpOnly; SameSite=Strict", AccessTokenCookieName, accessToken, time.Now().Add(AccessTokenDuration).Format(time.RFC1123))
+	cookie := s.buildAccessTokenCookie(accessToken, time.Now().Add(AccessTokenDuration).Format(time.RFC1123))
 	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
 		"Set-Cookie": cookie,
 	})); err != nil {
@@ -217,14 +506,60 @@ func (s *APIV1Service) doSignIn(ctx context.Context, user *store.User, expireTim
 	return nil
 }
 
-func (*APIV1Service) SignOut(ctx context.Context, _ *v1pb.SignOutRequest) (*emptypb.Empty, error) {
+func (s *APIV1Service) SignOut(ctx context.Context, _ *v1pb.SignOutRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	// Revoke the access token of the current session, so it can't be reused after signing out.
+	// The break-glass access tokens aren't stored, they expire on their own.
+	if accessToken, ok := ctx.Value(accessTokenContextKey).(string); ok && user != nil && user.ID != BreakGlassUserID {
+		if err := s.RevokeAccessTokensFromStore(ctx, user, func(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
+			return store.MatchAccessToken(userAccessToken, accessToken)
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke access token: %v", err)
+		}
+		if _, err := s.vacuumUserSessions(ctx, user); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to vacuum user sessions: %v", err)
+		}
+	}
+	if err := s.clearAccessTokenCookie(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) SignOutAllSessions(ctx context.Context, _ *v1pb.SignOutAllSessionsRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	// Only sign-in sessions are revoked, access tokens created by the user are kept.
+	if err := s.RevokeAccessTokensFromStore(ctx, user, func(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
+		return userAccessToken.Description == SignInAccessTokenDescription
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens: %v", err)
+	}
+	if _, err := s.vacuumUserSessions(ctx, user); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to vacuum user sessions: %v", err)
+	}
+	if err := s.clearAccessTokenCookie(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) clearAccessTokenCookie(ctx context.Context) error {
 	// Set the cookie header to expire access token.
 	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
-		"Set-Cookie": fmt.Sprintf("%s=; Path=/; Expires=Thu, 01 Jan 1970 00:00:00 GMT; HttpOnly; SameSite=Strict", AccessTokenCookieName),
+		"Set-Cookie": s.buildAccessTokenCookie("", "Thu, 01 Jan 1970 00:00:00 GMT"),
 	})); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+		return status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
 	}
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *APIV1Service) checkSeatAvailability(ctx context.Context) error {
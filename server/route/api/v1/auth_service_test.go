@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	"github.com/warthurton/slash/server/profile"
+	"github.com/warthurton/slash/store"
+	teststore "github.com/warthurton/slash/store/test"
+)
+
+// testServerTransportStream records the headers set by the methods, which need a server transport stream.
+type testServerTransportStream struct {
+	method string
+	header metadata.MD
+}
+
+func (s *testServerTransportStream) Method() string {
+	return s.method
+}
+
+func (s *testServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *testServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (*testServerTransportStream) SetTrailer(metadata.MD) error {
+	return nil
+}
+
+func TestLinkIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	// The user signed up with SSO, so it has no password.
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	s := &APIV1Service{
+		Secret:  testSecret,
+		Profile: &profile.Profile{},
+		Store:   ts,
+	}
+	linkToken, err := generateIdentityProviderLinkToken(user.ID, "sso", "user@test.com", time.Now().Add(IdentityProviderLinkDuration), []byte(testSecret))
+	require.NoError(t, err)
+	linkCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("cookie", IdentityProviderLinkCookieName+"="+linkToken))
+	linkCtx = grpc.NewContextWithServerTransportStream(linkCtx, &testServerTransportStream{method: "/slash.api.v1.AuthService/LinkIdentityProvider"})
+
+	_, err = s.LinkIdentityProvider(linkCtx, &v1pb.LinkIdentityProviderRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	anotherUser, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "another@test.com",
+		Nickname: "another",
+	})
+	require.NoError(t, err)
+	_, err = s.LinkIdentityProvider(context.WithValue(linkCtx, userIDContextKey, anotherUser.ID), &v1pb.LinkIdentityProviderRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The signed in account is linked without its password.
+	_, err = s.LinkIdentityProvider(context.WithValue(linkCtx, userIDContextKey, user.ID), &v1pb.LinkIdentityProviderRequest{})
+	require.NoError(t, err)
+	idpID, identifier := "sso", "user@test.com"
+	identityProviderLink, err := ts.GetIdentityProviderLink(ctx, &store.FindIdentityProviderLink{
+		IdpID:      &idpID,
+		Identifier: &identifier,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, identityProviderLink)
+	require.Equal(t, user.ID, identityProviderLink.UserID)
+}
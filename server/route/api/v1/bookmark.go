@@ -0,0 +1,203 @@
+package v1
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// BookmarkFormatNetscape is the Netscape bookmark file format supported by browsers and most bookmark managers.
+	BookmarkFormatNetscape = "netscape"
+	// BookmarkFormatLinkding is the JSON format of the Linkding bookmarks API.
+	BookmarkFormatLinkding = "linkding"
+	// BookmarkFormatLinkwarden is the JSON format of the Linkwarden links API.
+	BookmarkFormatLinkwarden = "linkwarden"
+)
+
+// LinkdingBookmarkList is the response of the Linkding bookmarks API.
+type LinkdingBookmarkList struct {
+	Count    int                 `json:"count"`
+	Next     *string             `json:"next"`
+	Previous *string             `json:"previous"`
+	Results  []*LinkdingBookmark `json:"results"`
+}
+
+type LinkdingBookmark struct {
+	ID           int32    `json:"id"`
+	URL          string   `json:"url"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	Notes        string   `json:"notes"`
+	IsArchived   bool     `json:"is_archived"`
+	Unread       bool     `json:"unread"`
+	Shared       bool     `json:"shared"`
+	TagNames     []string `json:"tag_names"`
+	DateAdded    string   `json:"date_added"`
+	DateModified string   `json:"date_modified"`
+}
+
+// LinkwardenLinkList is the response of the Linkwarden links API.
+type LinkwardenLinkList struct {
+	Response []*LinkwardenLink `json:"response"`
+}
+
+type LinkwardenLink struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	URL         string           `json:"url"`
+	Description string           `json:"description"`
+	Tags        []*LinkwardenTag `json:"tags"`
+	CreatedAt   string           `json:"createdAt"`
+	UpdatedAt   string           `json:"updatedAt"`
+}
+
+type LinkwardenTag struct {
+	Name string `json:"name"`
+}
+
+// registerBookmarkRoutes registers the read-only bookmarks endpoint, which mirrors the shortcuts into bookmark managers.
+// Anonymous requests get the public shortcuts, and requests with an access token get the workspace shortcuts as well.
+func (s *APIV1Service) registerBookmarkRoutes(e *echo.Echo) {
+	e.GET("/api/v1/bookmarks", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		md := metadata.MD{}
+		if authorization := c.Request().Header.Get(echo.HeaderAuthorization); authorization != "" {
+			md.Set("authorization", authorization)
+		}
+		if cookie := c.Request().Header.Get(echo.HeaderCookie); cookie != "" {
+			md.Set("cookie", cookie)
+		}
+		accessToken, err := getTokenFromMetadata(md)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		visibilityList := []storepb.Visibility{storepb.Visibility_PUBLIC}
+		if accessToken != "" {
+			_, userAccessToken, err := NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, accessToken)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid access token")
+			}
+			if !hasAccessTokenScope(userAccessToken.Scopes, AccessTokenScopeShortcutsRead) {
+				return echo.NewHTTPError(http.StatusForbidden, "the access token requires the shortcuts:read scope")
+			}
+			visibilityList = append(visibilityList, storepb.Visibility_WORKSPACE)
+		}
+
+		rowStatus := storepb.RowStatus_NORMAL
+		shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+			VisibilityList: visibilityList,
+			RowStatus:      &rowStatus,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to list shortcuts, err: %s", err))
+		}
+		now := time.Now()
+		bookmarkShortcuts := []*storepb.Shortcut{}
+		for _, shortcut := range shortcuts {
+			if isShortcutExpired(shortcut, now) || isShortcutScheduled(shortcut, now) {
+				continue
+			}
+			// The internal descriptions aren't mirrored anonymously. The shortcuts are cloned, as they are cached by the store.
+			if accessToken == "" && shortcut.InternalDescription {
+				shortcut = proto.Clone(shortcut).(*storepb.Shortcut)
+				shortcut.Description = ""
+			}
+			bookmarkShortcuts = append(bookmarkShortcuts, shortcut)
+		}
+
+		switch format := c.QueryParam("format"); format {
+		case "", BookmarkFormatNetscape:
+			return c.HTML(http.StatusOK, convertShortcutsToNetscapeBookmarks(bookmarkShortcuts))
+		case BookmarkFormatLinkding:
+			return c.JSON(http.StatusOK, convertShortcutsToLinkdingBookmarks(bookmarkShortcuts))
+		case BookmarkFormatLinkwarden:
+			return c.JSON(http.StatusOK, convertShortcutsToLinkwardenLinks(bookmarkShortcuts))
+		default:
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unsupported bookmark format %q", format))
+		}
+	})
+}
+
+// getShortcutBookmarkTitle returns the title of the shortcut, falling back to its name.
+func getShortcutBookmarkTitle(shortcut *storepb.Shortcut) string {
+	if shortcut.Title != "" {
+		return shortcut.Title
+	}
+	return shortcut.Name
+}
+
+func convertShortcutsToNetscapeBookmarks(shortcuts []*storepb.Shortcut) string {
+	var builder strings.Builder
+	builder.WriteString("<!DOCTYPE NETSCAPE-Bookmark-file-1>\n")
+	builder.WriteString(`<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">` + "\n")
+	builder.WriteString("<TITLE>Bookmarks</TITLE>\n")
+	builder.WriteString("<H1>Bookmarks</H1>\n")
+	builder.WriteString("<DL><p>\n")
+	for _, shortcut := range shortcuts {
+		builder.WriteString(fmt.Sprintf(`<DT><A HREF="%s" ADD_DATE="%d" LAST_MODIFIED="%d" TAGS="%s">%s</A>`+"\n",
+			html.EscapeString(shortcut.Link),
+			shortcut.CreatedTs,
+			shortcut.UpdatedTs,
+			html.EscapeString(strings.Join(shortcut.Tags, ",")),
+			html.EscapeString(getShortcutBookmarkTitle(shortcut)),
+		))
+		if shortcut.Description != "" {
+			builder.WriteString(fmt.Sprintf("<DD>%s\n", html.EscapeString(shortcut.Description)))
+		}
+	}
+	builder.WriteString("</DL><p>\n")
+	return builder.String()
+}
+
+func convertShortcutsToLinkdingBookmarks(shortcuts []*storepb.Shortcut) *LinkdingBookmarkList {
+	list := &LinkdingBookmarkList{
+		Count:   len(shortcuts),
+		Results: []*LinkdingBookmark{},
+	}
+	for _, shortcut := range shortcuts {
+		list.Results = append(list.Results, &LinkdingBookmark{
+			ID:           shortcut.Id,
+			URL:          shortcut.Link,
+			Title:        getShortcutBookmarkTitle(shortcut),
+			Description:  shortcut.Description,
+			Notes:        fmt.Sprintf("Slash shortcut: s/%s", shortcut.Name),
+			Shared:       shortcut.Visibility == storepb.Visibility_PUBLIC,
+			TagNames:     append([]string{}, shortcut.Tags...),
+			DateAdded:    time.Unix(shortcut.CreatedTs, 0).UTC().Format(time.RFC3339),
+			DateModified: time.Unix(shortcut.UpdatedTs, 0).UTC().Format(time.RFC3339),
+		})
+	}
+	return list
+}
+
+func convertShortcutsToLinkwardenLinks(shortcuts []*storepb.Shortcut) *LinkwardenLinkList {
+	list := &LinkwardenLinkList{
+		Response: []*LinkwardenLink{},
+	}
+	for _, shortcut := range shortcuts {
+		tags := []*LinkwardenTag{}
+		for _, tag := range shortcut.Tags {
+			tags = append(tags, &LinkwardenTag{Name: tag})
+		}
+		list.Response = append(list.Response, &LinkwardenLink{
+			ID:          shortcut.Id,
+			Name:        getShortcutBookmarkTitle(shortcut),
+			URL:         shortcut.Link,
+			Description: shortcut.Description,
+			Tags:        tags,
+			CreatedAt:   time.Unix(shortcut.CreatedTs, 0).UTC().Format(time.RFC3339),
+			UpdatedAt:   time.Unix(shortcut.UpdatedTs, 0).UTC().Format(time.RFC3339),
+		})
+	}
+	return list
+}
@@ -0,0 +1,126 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// BreakGlassUserID is the id of the break-glass admin account, which never collides with the stored users.
+	BreakGlassUserID int32 = -1
+	// BreakGlassKeyID is the key id of the break-glass access tokens, which are signed with the password hash
+	// as well, so changing or removing the credential revokes them.
+	BreakGlassKeyID = "break-glass"
+	// BreakGlassAudienceName is the audience name of the break-glass access token.
+	BreakGlassAudienceName = "user.break-glass"
+	// BreakGlassAccessTokenDuration is the duration of the break-glass access tokens, which can't be revoked.
+	BreakGlassAccessTokenDuration = 1 * time.Hour
+	// BreakGlassAccessTokenDescription is the description of the break-glass access tokens.
+	BreakGlassAccessTokenDescription = "break-glass login"
+)
+
+// signInWithBreakGlass signs in with the break-glass admin account configured in the environment,
+// without reading the users from the store.
+func (s *APIV1Service) signInWithBreakGlass(ctx context.Context, password string) (*v1pb.User, error) {
+	userAgent, ip := getClientInfo(ctx, s.Profile.GetTrustedProxies())
+	if err := bcrypt.CompareHashAndPassword([]byte(s.Profile.BreakGlassPasswordHash), []byte(password)); err != nil {
+		slog.Warn("failed break-glass sign in", slog.String("ip", ip), slog.String("user_agent", userAgent))
+		return nil, status.Errorf(codes.InvalidArgument, unmatchedEmailAndPasswordError)
+	}
+
+	expireTime := time.Now().Add(BreakGlassAccessTokenDuration)
+	accessToken, err := generateBreakGlassAccessToken(s.Profile.BreakGlassEmail, expireTime, s.Secret, s.Profile.BreakGlassPasswordHash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
+	}
+	slog.Warn("break-glass admin signed in",
+		slog.String("email", s.Profile.BreakGlassEmail),
+		slog.String("ip", ip),
+		slog.String("user_agent", userAgent),
+		slog.Time("expire", expireTime),
+	)
+	// The store may be what's broken, so failing to record the activity doesn't prevent the sign in.
+	payload, err := protojson.Marshal(&storepb.ActivityUserBreakGlassPayload{
+		Email:     s.Profile.BreakGlassEmail,
+		ExpireTs:  expireTime.Unix(),
+		Ip:        ip,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal activity payload: %v", err)
+	}
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: BreakGlassUserID,
+		Type:      store.ActivityUserBreakGlass,
+		Level:     store.ActivityWarn,
+		Payload:   string(payload),
+	}); err != nil {
+		slog.Error("failed to record break-glass sign in", slog.Any("error", err))
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
+		"Set-Cookie": s.buildAccessTokenCookie(accessToken, expireTime.Format(time.RFC1123)),
+	})); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+	}
+	return convertUserFromStore(newBreakGlassUser(s.Profile.BreakGlassEmail)), nil
+}
+
+// newBreakGlassUser returns the in-memory admin user of the break-glass account.
+func newBreakGlassUser(email string) *store.User {
+	return &store.User{
+		ID:            BreakGlassUserID,
+		RowStatus:     storepb.RowStatus_NORMAL,
+		Username:      "break-glass",
+		Email:         email,
+		Nickname:      "Break-glass admin",
+		Role:          store.RoleAdmin,
+		EmailVerified: true,
+	}
+}
+
+func generateBreakGlassAccessToken(email string, expirationTime time.Time, secret, passwordHash string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ClaimsMessage{
+		Name: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{BreakGlassAudienceName},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			Subject:   "break-glass",
+		},
+	})
+	token.Header["kid"] = BreakGlassKeyID
+	tokenString, err := token.SignedString(getBreakGlassSigningKey(secret, passwordHash))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign break-glass access token")
+	}
+	return tokenString, nil
+}
+
+func getBreakGlassSigningKey(secret, passwordHash string) []byte {
+	return []byte(secret + passwordHash)
+}
+
+// auditBreakGlassRequest checks the method called by the break-glass admin, and logs it.
+func auditBreakGlassRequest(fullMethod string) error {
+	if !isBreakGlassAllowedMethod(fullMethod) {
+		return status.Errorf(codes.PermissionDenied, "the method is not allowed for the break-glass admin")
+	}
+	slog.Warn("break-glass request", slog.String("method", fullMethod))
+	return nil
+}
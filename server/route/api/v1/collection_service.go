@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -9,25 +10,62 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/warthurton/slash/internal/util"
 	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
 	storepb "github.com/warthurton/slash/proto/gen/store"
 	"github.com/warthurton/slash/server/service/license"
 	"github.com/warthurton/slash/store"
 )
 
-func (s *APIV1Service) ListCollections(ctx context.Context, _ *v1pb.ListCollectionsRequest) (*v1pb.ListCollectionsResponse, error) {
-	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{})
+const (
+	// defaultCollectionShareDuration is the duration of the guest links without an expiration time.
+	defaultCollectionShareDuration = 7 * 24 * time.Hour
+	// maxCollectionShareDuration is the max duration of the guest links.
+	maxCollectionShareDuration = 90 * 24 * time.Hour
+	// collectionShareTokenLength is the length of the secret of the guest links.
+	collectionShareTokenLength = 32
+)
+
+func (s *APIV1Service) ListCollections(ctx context.Context, request *v1pb.ListCollectionsRequest) (*v1pb.ListCollectionsResponse, error) {
+	page, err := parsePagination(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	rowStatus, err := convertStateToRowStatusFilter(request.State)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	creatorID, err := getCreatorIDFilter(user, request.CreatorId, request.MineOnly)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	find := &store.FindCollection{
+		CreatorID: creatorID,
+		RowStatus: rowStatus,
+		ViewerID:  getViewerID(user),
+	}
+	find.Limit, find.Offset = page.limitOffset()
+	collections, err := s.Store.ListCollections(ctx, find)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get collection list, err: %v", err)
 	}
 
 	convertedCollections := []*v1pb.Collection{}
-	for _, collection := range collections {
-		convertedCollections = append(convertedCollections, convertCollectionFromStore(collection))
+	for _, collection := range collections[:page.truncate(len(collections))] {
+		convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+		}
+		convertedCollections = append(convertedCollections, convertedCollection)
 	}
 
 	response := &v1pb.ListCollectionsResponse{
-		Collections: convertedCollections,
+		Collections:   convertedCollections,
+		NextPageToken: page.nextPageToken(len(collections)),
 	}
 	return response, nil
 }
@@ -47,10 +85,18 @@ func (s *APIV1Service) GetCollection(ctx context.Context, request *v1pb.GetColle
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && collection.Visibility != storepb.Visibility_PUBLIC {
+	visible, err := s.canView(ctx, user, collection.Visibility, collection.CreatorId, collection.TeamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection visibility: %v", err)
+	}
+	if !visible {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
-	return convertCollectionFromStore(collection), nil
+	convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+	}
+	return convertedCollection, nil
 }
 
 func (s *APIV1Service) GetCollectionByName(ctx context.Context, request *v1pb.GetCollectionByNameRequest) (*v1pb.Collection, error) {
@@ -68,10 +114,18 @@ func (s *APIV1Service) GetCollectionByName(ctx context.Context, request *v1pb.Ge
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && collection.Visibility != storepb.Visibility_PUBLIC {
+	visible, err := s.canView(ctx, user, collection.Visibility, collection.CreatorId, collection.TeamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection visibility: %v", err)
+	}
+	if !visible {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
-	return convertCollectionFromStore(collection), nil
+	convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+	}
+	return convertedCollection, nil
 }
 
 func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.CreateCollectionRequest) (*v1pb.Collection, error) {
@@ -79,15 +133,8 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 		return nil, status.Errorf(codes.InvalidArgument, "name and title are required")
 	}
 
-	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedCollections) {
-		collections, err := s.Store.ListCollections(ctx, &store.FindCollection{})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get collection list, err: %v", err)
-		}
-		collectionsLimit := int(s.LicenseService.GetSubscription().CollectionsLimit)
-		if len(collections) >= collectionsLimit {
-			return nil, status.Errorf(codes.PermissionDenied, "Maximum number of collections %d reached", collectionsLimit)
-		}
+	if err := s.checkCollectionsLimit(ctx); err != nil {
+		return nil, err
 	}
 
 	user, err := getCurrentUser(ctx, s.Store)
@@ -102,12 +149,20 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 		ShortcutIds: request.Collection.ShortcutIds,
 		Visibility:  convertVisibilityToStorepb(request.Collection.Visibility),
 	}
+	collectionCreate.TeamId, err = s.getVisibilityTeamID(ctx, collectionCreate.Visibility, request.Collection.TeamId)
+	if err != nil {
+		return nil, err
+	}
 	collection, err := s.Store.CreateCollection(ctx, collectionCreate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create collection, err: %v", err)
 	}
 
-	return convertCollectionFromStore(collection), nil
+	convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+	}
+	return convertedCollection, nil
 }
 
 func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.UpdateCollectionRequest) (*v1pb.Collection, error) {
@@ -148,14 +203,34 @@ func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.Updat
 		case "visibility":
 			visibility := convertVisibilityToStorepb(request.Collection.Visibility)
 			update.Visibility = &visibility
+		case "team_id":
+			update.TeamID = &request.Collection.TeamId
+		}
+	}
+	if update.Visibility != nil || update.TeamID != nil {
+		visibility, teamID := collection.Visibility, collection.TeamId
+		if update.Visibility != nil {
+			visibility = *update.Visibility
+		}
+		if update.TeamID != nil {
+			teamID = *update.TeamID
 		}
+		teamID, err := s.getVisibilityTeamID(ctx, visibility, teamID)
+		if err != nil {
+			return nil, err
+		}
+		update.TeamID = &teamID
 	}
 	collection, err = s.Store.UpdateCollection(ctx, update)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update collection, err: %v", err)
 	}
 
-	return convertCollectionFromStore(collection), nil
+	convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+	}
+	return convertedCollection, nil
 }
 
 func (s *APIV1Service) DeleteCollection(ctx context.Context, request *v1pb.DeleteCollectionRequest) (*emptypb.Empty, error) {
@@ -185,16 +260,310 @@ func (s *APIV1Service) DeleteCollection(ctx context.Context, request *v1pb.Delet
 	return &emptypb.Empty{}, nil
 }
 
-func convertCollectionFromStore(collection *storepb.Collection) *v1pb.Collection {
-	return &v1pb.Collection{
-		Id:          collection.Id,
-		CreatorId:   collection.CreatorId,
-		CreatedTime: timestamppb.New(time.Unix(collection.CreatedTs, 0)),
-		UpdatedTime: timestamppb.New(time.Unix(collection.UpdatedTs, 0)),
-		Name:        collection.Name,
-		Title:       collection.Title,
-		Description: collection.Description,
-		ShortcutIds: collection.ShortcutIds,
-		Visibility:  convertVisibilityFromStorepb(collection.Visibility),
+func (s *APIV1Service) ArchiveCollection(ctx context.Context, request *v1pb.ArchiveCollectionRequest) (*v1pb.Collection, error) {
+	return s.updateCollectionRowStatus(ctx, request.Id, storepb.RowStatus_ARCHIVED)
+}
+
+func (s *APIV1Service) UnarchiveCollection(ctx context.Context, request *v1pb.UnarchiveCollectionRequest) (*v1pb.Collection, error) {
+	return s.updateCollectionRowStatus(ctx, request.Id, storepb.RowStatus_NORMAL)
+}
+
+// updateCollectionRowStatus archives or restores the collection, if the current user is its creator or an admin.
+func (s *APIV1Service) updateCollectionRowStatus(ctx context.Context, id int32, rowStatus storepb.RowStatus) (*v1pb.Collection, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	if collection.RowStatus != rowStatus {
+		collection, err = s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+			ID:        collection.Id,
+			RowStatus: &rowStatus,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update collection, err: %v", err)
+		}
+	}
+	convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+	}
+	return convertedCollection, nil
+}
+
+func (s *APIV1Service) TransferCollection(ctx context.Context, request *v1pb.TransferCollectionRequest) (*v1pb.Collection, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	recipient, err := s.getTransferRecipient(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err = s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:        collection.Id,
+		CreatorID: &recipient.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to transfer collection, err: %v", err)
+	}
+	convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+	}
+	return convertedCollection, nil
+}
+
+func (s *APIV1Service) CreateCollectionShare(ctx context.Context, request *v1pb.CreateCollectionShareRequest) (*v1pb.CollectionShare, error) {
+	email := strings.ToLower(strings.TrimSpace(request.Email))
+	if !util.ValidateEmail(email) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email %q", request.Email)
+	}
+	user, collection, err := s.checkCollectionSharePermission(ctx, request.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+	// Public collections are visible to anyone already.
+	if collection.Visibility != storepb.Visibility_WORKSPACE {
+		return nil, status.Errorf(codes.FailedPrecondition, "only collections visible to the workspace can be shared with guests")
+	}
+	// Members sign in to view the collection, so the guest links are only for the others.
+	if err := s.checkEmailAvailability(ctx, email); err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, status.Errorf(codes.AlreadyExists, "%s is a member of the workspace, share the collection link instead", email)
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	expireTime := now.Add(defaultCollectionShareDuration)
+	if request.ExpireTime != nil {
+		if err := request.ExpireTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire time: %v", err)
+		}
+		expireTime = request.ExpireTime.AsTime()
+	}
+	if !expireTime.After(now) || expireTime.After(now.Add(maxCollectionShareDuration)) {
+		return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the next %d days", int(maxCollectionShareDuration.Hours()/24))
+	}
+	token, err := util.RandomString(collectionShareTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	collectionShare, err := s.Store.CreateCollectionShare(ctx, &store.CollectionShare{
+		CollectionID: collection.Id,
+		CreatorID:    user.ID,
+		Email:        email,
+		Token:        token,
+		ExpireTs:     expireTime.Unix(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create collection share: %v", err)
+	}
+	return convertCollectionShareFromStore(collectionShare), nil
+}
+
+func (s *APIV1Service) ListCollectionShares(ctx context.Context, request *v1pb.ListCollectionSharesRequest) (*v1pb.ListCollectionSharesResponse, error) {
+	if _, _, err := s.checkCollectionSharePermission(ctx, request.CollectionId); err != nil {
+		return nil, err
+	}
+	collectionShares, err := s.Store.ListCollectionShares(ctx, &store.FindCollectionShare{
+		CollectionID: &request.CollectionId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collection shares: %v", err)
+	}
+	response := &v1pb.ListCollectionSharesResponse{
+		Shares: []*v1pb.CollectionShare{},
+	}
+	for _, collectionShare := range collectionShares {
+		response.Shares = append(response.Shares, convertCollectionShareFromStore(collectionShare))
 	}
+	return response, nil
+}
+
+func (s *APIV1Service) DeleteCollectionShare(ctx context.Context, request *v1pb.DeleteCollectionShareRequest) (*emptypb.Empty, error) {
+	if _, _, err := s.checkCollectionSharePermission(ctx, request.CollectionId); err != nil {
+		return nil, err
+	}
+	collectionShare, err := s.Store.GetCollectionShare(ctx, &store.FindCollectionShare{
+		ID:           &request.Id,
+		CollectionID: &request.CollectionId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection share: %v", err)
+	}
+	if collectionShare == nil {
+		return nil, status.Errorf(codes.NotFound, "collection share not found")
+	}
+	if err := s.Store.DeleteCollectionShare(ctx, &store.DeleteCollectionShare{
+		ID: collectionShare.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete collection share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) GetSharedCollection(ctx context.Context, request *v1pb.GetSharedCollectionRequest) (*v1pb.SharedCollection, error) {
+	if request.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	collectionShare, err := s.Store.GetCollectionShare(ctx, &store.FindCollectionShare{
+		Token: &request.Token,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection share: %v", err)
+	}
+	now := time.Now()
+	if collectionShare == nil || collectionShare.ExpireTs <= now.Unix() {
+		return nil, status.Errorf(codes.NotFound, "the guest link is invalid or has expired")
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID: &collectionShare.CollectionID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "the guest link is invalid or has expired")
+	}
+
+	convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+	}
+	sharedCollection := &v1pb.SharedCollection{
+		Collection: convertedCollection,
+		Shortcuts:  []*v1pb.Shortcut{},
+		ExpireTime: timestamppb.New(time.Unix(collectionShare.ExpireTs, 0)),
+	}
+	shortcutMap, err := s.Store.GetShortcutsByIDs(ctx, collection.ShortcutIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcuts: %v", err)
+	}
+	for _, shortcutID := range collection.ShortcutIds {
+		shortcut := shortcutMap[shortcutID]
+		if shortcut == nil || shortcut.RowStatus == storepb.RowStatus_ARCHIVED || isShortcutExpired(shortcut, now) || isShortcutScheduled(shortcut, now) {
+			continue
+		}
+		// The guests are never members of a team nor shared a shortcut with.
+		if shortcut.Visibility == storepb.Visibility_TEAM || shortcut.Visibility == storepb.Visibility_PRIVATE {
+			continue
+		}
+		convertedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		// The guest links are public, even when opened by a signed-in user.
+		if shortcut.InternalDescription {
+			convertedShortcut.Description = ""
+		}
+		sharedCollection.Shortcuts = append(sharedCollection.Shortcuts, convertedShortcut)
+	}
+
+	if err := s.Store.RecordCollectionShareView(ctx, collectionShare.ID, now.Unix()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record collection share view: %v", err)
+	}
+	return sharedCollection, nil
+}
+
+// checkCollectionsLimit checks that a collection can be created within the collections limit of the subscription.
+func (s *APIV1Service) checkCollectionsLimit(ctx context.Context) error {
+	if s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedCollections) {
+		return nil
+	}
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get collection list, err: %v", err)
+	}
+	collectionsLimit := int(s.LicenseService.GetSubscription().CollectionsLimit)
+	if len(collections) >= collectionsLimit {
+		return status.Errorf(codes.PermissionDenied, "Maximum number of collections %d reached", collectionsLimit)
+	}
+	return nil
+}
+
+// checkCollectionSharePermission checks that the current user can manage the guest links of the collection,
+// and returns the current user and the collection.
+func (s *APIV1Service) checkCollectionSharePermission(ctx context.Context, collectionID int32) (*store.User, *storepb.Collection, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID: &collectionID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get collection: %v", err)
+	}
+	if collection == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return user, collection, nil
+}
+
+func convertCollectionShareFromStore(collectionShare *store.CollectionShare) *v1pb.CollectionShare {
+	convertedCollectionShare := &v1pb.CollectionShare{
+		Id:           collectionShare.ID,
+		CollectionId: collectionShare.CollectionID,
+		CreatorId:    collectionShare.CreatorID,
+		CreatedTime:  timestamppb.New(time.Unix(collectionShare.CreatedTs, 0)),
+		Email:        collectionShare.Email,
+		Token:        collectionShare.Token,
+		ExpireTime:   timestamppb.New(time.Unix(collectionShare.ExpireTs, 0)),
+		ViewCount:    collectionShare.ViewCount,
+	}
+	if collectionShare.LastViewedTs > 0 {
+		convertedCollectionShare.LastViewedTime = timestamppb.New(time.Unix(collectionShare.LastViewedTs, 0))
+	}
+	return convertedCollectionShare
+}
+
+func (s *APIV1Service) convertCollectionFromStore(ctx context.Context, collection *storepb.Collection) (*v1pb.Collection, error) {
+	creatorUsername, err := s.getUsername(ctx, collection.CreatorId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.Collection{
+		Id:              collection.Id,
+		CreatorId:       collection.CreatorId,
+		CreatedTime:     timestamppb.New(time.Unix(collection.CreatedTs, 0)),
+		UpdatedTime:     timestamppb.New(time.Unix(collection.UpdatedTs, 0)),
+		Name:            collection.Name,
+		Title:           collection.Title,
+		Description:     collection.Description,
+		ShortcutIds:     collection.ShortcutIds,
+		Visibility:      convertVisibilityFromStorepb(collection.Visibility),
+		CreatorUsername: creatorUsername,
+		TeamId:          collection.TeamId,
+		State:           convertStateFromRowStatus(collection.RowStatus),
+	}, nil
 }
@@ -0,0 +1,328 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/warthurton/slash/internal/util"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/service/license"
+	"github.com/warthurton/slash/store"
+)
+
+// builtInCollectionTemplates are the collection templates available in every workspace.
+var builtInCollectionTemplates = []*storepb.CollectionTemplate{
+	{
+		Id:          "project-onboarding",
+		Title:       "New project onboarding",
+		Description: "The links everyone needs on the first day of a project.",
+		Shortcuts: []*storepb.ShortcutTemplate{
+			{
+				Name:        "{name}-docs",
+				Title:       "{title} docs",
+				Link:        "https://docs.example.com/{name}",
+				Description: "The design docs and the specs.",
+				Tags:        []string{"{name}", "docs"},
+			},
+			{
+				Name:        "{name}-repo",
+				Title:       "{title} repository",
+				Link:        "https://github.com/example/{name}",
+				Description: "The source code.",
+				Tags:        []string{"{name}", "code"},
+			},
+			{
+				Name:        "{name}-board",
+				Title:       "{title} board",
+				Link:        "https://tracker.example.com/{name}",
+				Description: "The tasks and the roadmap.",
+				Tags:        []string{"{name}", "planning"},
+			},
+			{
+				Name:        "{name}-chat",
+				Title:       "{title} chat",
+				Link:        "https://chat.example.com/{name}",
+				Description: "The channel of the project.",
+				Tags:        []string{"{name}", "chat"},
+			},
+		},
+	},
+	{
+		Id:          "team-hub",
+		Title:       "Team hub",
+		Description: "The home of a team with its rituals and on-call links.",
+		Shortcuts: []*storepb.ShortcutTemplate{
+			{
+				Name:  "{name}-wiki",
+				Title: "{title} wiki",
+				Link:  "https://wiki.example.com/{name}",
+				Tags:  []string{"{name}", "docs"},
+			},
+			{
+				Name:  "{name}-oncall",
+				Title: "{title} on-call",
+				Link:  "https://oncall.example.com/{name}",
+				Tags:  []string{"{name}", "oncall"},
+			},
+			{
+				Name:  "{name}-calendar",
+				Title: "{title} calendar",
+				Link:  "https://calendar.example.com/{name}",
+				Tags:  []string{"{name}", "calendar"},
+			},
+		},
+	},
+}
+
+func (s *APIV1Service) ListCollectionTemplates(ctx context.Context, _ *v1pb.ListCollectionTemplatesRequest) (*v1pb.ListCollectionTemplatesResponse, error) {
+	collectionTemplateSetting, err := s.Store.GetWorkspaceCollectionTemplateSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+
+	response := &v1pb.ListCollectionTemplatesResponse{}
+	for _, collectionTemplate := range builtInCollectionTemplates {
+		response.Templates = append(response.Templates, convertCollectionTemplateFromStore(collectionTemplate, true))
+	}
+	for _, collectionTemplate := range collectionTemplateSetting.Templates {
+		response.Templates = append(response.Templates, convertCollectionTemplateFromStore(collectionTemplate, false))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateCollectionFromTemplate(ctx context.Context, request *v1pb.CreateCollectionFromTemplateRequest) (*v1pb.Collection, error) {
+	collectionTemplate, err := s.getCollectionTemplate(ctx, request.TemplateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection template: %v", err)
+	}
+	if collectionTemplate == nil {
+		return nil, status.Errorf(codes.NotFound, "collection template %q not found", request.TemplateId)
+	}
+	if request.Collection == nil || request.Collection.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	title := request.Collection.Title
+	if title == "" {
+		title = collectionTemplate.Title
+	}
+
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkCollectionsLimit(ctx); err != nil {
+		return nil, err
+	}
+	existingCollection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		Name: &request.Collection.Name,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by name: %v", err)
+	}
+	if existingCollection != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "collection %q already exists", request.Collection.Name)
+	}
+	visibility := convertVisibilityToStorepb(request.Collection.Visibility)
+	if visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
+		workspaceSetting, err := s.GetWorkspaceSetting(ctx, nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
+		}
+		visibility = storepb.Visibility_WORKSPACE
+		if workspaceSetting.DefaultVisibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+			visibility = convertVisibilityToStorepb(workspaceSetting.DefaultVisibility)
+		}
+	}
+	teamID, err := s.getVisibilityTeamID(ctx, visibility, request.Collection.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The shortcuts which already exist, e.g. a shared handbook, are added to the collection as they are.
+	replacer := strings.NewReplacer("{name}", request.Collection.Name, "{title}", title)
+	// The shortcuts keep the order of the template.
+	shortcutIDs := make([]int32, len(collectionTemplate.Shortcuts))
+	shortcutCreates := map[int]*storepb.Shortcut{}
+	for i, shortcutTemplate := range collectionTemplate.Shortcuts {
+		name := replacer.Replace(shortcutTemplate.Name)
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+		}
+		if shortcut != nil {
+			shortcutIDs[i] = shortcut.Id
+			continue
+		}
+		if err := validateShortcutNamespace(name, user); err != nil {
+			return nil, err
+		}
+		if err := s.validateShortcutNameNotReserved(ctx, "shortcut name", name); err != nil {
+			return nil, err
+		}
+		link, err := s.normalizeShortcutLink(ctx, replacer.Replace(shortcutTemplate.Link))
+		if err != nil {
+			return nil, err
+		}
+		tags := []string{}
+		for _, tag := range shortcutTemplate.Tags {
+			tags = append(tags, replacer.Replace(tag))
+		}
+		shortcutCreates[i] = &storepb.Shortcut{
+			CreatorId:   user.ID,
+			Name:        name,
+			Link:        link,
+			Title:       replacer.Replace(shortcutTemplate.Title),
+			Tags:        tags,
+			Description: replacer.Replace(shortcutTemplate.Description),
+			Visibility:  visibility,
+			TeamId:      teamID,
+			OgMetadata:  &storepb.OpenGraphMetadata{},
+		}
+	}
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedShortcuts) && len(shortcutCreates) > 0 {
+		shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut list, err: %v", err)
+		}
+		shortcutsLimit := int(s.LicenseService.GetSubscription().ShortcutsLimit)
+		if len(shortcuts)+len(shortcutCreates) > shortcutsLimit {
+			return nil, status.Errorf(codes.PermissionDenied, "Maximum number of shortcuts %d reached", shortcutsLimit)
+		}
+	}
+	for i := range collectionTemplate.Shortcuts {
+		shortcutCreate, ok := shortcutCreates[i]
+		if !ok {
+			continue
+		}
+		shortcut, err := s.Store.CreateShortcut(ctx, shortcutCreate)
+		if errors.Is(err, store.ErrShortcutNameTaken) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create shortcut, err: %v", err)
+		}
+		if err := s.createShortcutCreateActivity(ctx, shortcut); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create activity, err: %v", err)
+		}
+		shortcutIDs[i] = shortcut.Id
+	}
+
+	collection, err := s.Store.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        request.Collection.Name,
+		Title:       title,
+		Description: request.Collection.Description,
+		ShortcutIds: shortcutIDs,
+		Visibility:  visibility,
+		TeamId:      teamID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create collection, err: %v", err)
+	}
+	convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+	}
+	return convertedCollection, nil
+}
+
+// getCollectionTemplate returns the built-in or the admin-defined collection template by id.
+func (s *APIV1Service) getCollectionTemplate(ctx context.Context, id string) (*storepb.CollectionTemplate, error) {
+	for _, collectionTemplate := range builtInCollectionTemplates {
+		if collectionTemplate.Id == id {
+			return collectionTemplate, nil
+		}
+	}
+	collectionTemplateSetting, err := s.Store.GetWorkspaceCollectionTemplateSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, collectionTemplate := range collectionTemplateSetting.Templates {
+		if collectionTemplate.Id == id {
+			return collectionTemplate, nil
+		}
+	}
+	return nil, nil
+}
+
+// validateCollectionTemplates validates the admin-defined collection templates.
+func validateCollectionTemplates(collectionTemplates []*v1pb.CollectionTemplate) error {
+	ids := map[string]bool{}
+	for _, collectionTemplate := range builtInCollectionTemplates {
+		ids[collectionTemplate.Id] = true
+	}
+	// Validate the links with sample values of the placeholders.
+	replacer := strings.NewReplacer("{name}", "name", "{title}", "title")
+	for _, collectionTemplate := range collectionTemplates {
+		if collectionTemplate.Id == "" || collectionTemplate.Title == "" {
+			return status.Errorf(codes.InvalidArgument, "id and title of the collection template are required")
+		}
+		if ids[collectionTemplate.Id] {
+			return status.Errorf(codes.InvalidArgument, "collection template %q already exists", collectionTemplate.Id)
+		}
+		ids[collectionTemplate.Id] = true
+		names := map[string]bool{}
+		for _, shortcutTemplate := range collectionTemplate.Shortcuts {
+			if shortcutTemplate.Name == "" || shortcutTemplate.Link == "" {
+				return status.Errorf(codes.InvalidArgument, "name and link of the shortcuts in collection template %q are required", collectionTemplate.Id)
+			}
+			if names[shortcutTemplate.Name] {
+				return status.Errorf(codes.InvalidArgument, "duplicate shortcut %q in collection template %q", shortcutTemplate.Name, collectionTemplate.Id)
+			}
+			names[shortcutTemplate.Name] = true
+			if !util.ValidateURI(replacer.Replace(shortcutTemplate.Link)) {
+				return status.Errorf(codes.InvalidArgument, "invalid link %q in collection template %q", shortcutTemplate.Link, collectionTemplate.Id)
+			}
+			for _, tag := range shortcutTemplate.Tags {
+				if err := validateTag(tag); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func convertCollectionTemplateFromStore(collectionTemplate *storepb.CollectionTemplate, builtIn bool) *v1pb.CollectionTemplate {
+	convertedCollectionTemplate := &v1pb.CollectionTemplate{
+		Id:          collectionTemplate.Id,
+		Title:       collectionTemplate.Title,
+		Description: collectionTemplate.Description,
+		BuiltIn:     builtIn,
+	}
+	for _, shortcutTemplate := range collectionTemplate.Shortcuts {
+		convertedCollectionTemplate.Shortcuts = append(convertedCollectionTemplate.Shortcuts, &v1pb.CollectionTemplate_ShortcutTemplate{
+			Name:        shortcutTemplate.Name,
+			Title:       shortcutTemplate.Title,
+			Link:        shortcutTemplate.Link,
+			Description: shortcutTemplate.Description,
+			Tags:        shortcutTemplate.Tags,
+		})
+	}
+	return convertedCollectionTemplate
+}
+
+func convertCollectionTemplateToStore(collectionTemplate *v1pb.CollectionTemplate) *storepb.CollectionTemplate {
+	convertedCollectionTemplate := &storepb.CollectionTemplate{
+		Id:          collectionTemplate.Id,
+		Title:       collectionTemplate.Title,
+		Description: collectionTemplate.Description,
+	}
+	for _, shortcutTemplate := range collectionTemplate.Shortcuts {
+		convertedCollectionTemplate.Shortcuts = append(convertedCollectionTemplate.Shortcuts, &storepb.ShortcutTemplate{
+			Name:        shortcutTemplate.Name,
+			Title:       shortcutTemplate.Title,
+			Link:        shortcutTemplate.Link,
+			Description: shortcutTemplate.Description,
+			Tags:        shortcutTemplate.Tags,
+		})
+	}
+	return convertedCollectionTemplate
+}
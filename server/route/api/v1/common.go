@@ -2,6 +2,10 @@ package v1
 
 import (
 	"context"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/pkg/errors"
 
 	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
 	storepb "github.com/warthurton/slash/proto/gen/store"
@@ -9,6 +13,9 @@ import (
 )
 
 func getCurrentUser(ctx context.Context, s *store.Store) (*store.User, error) {
+	if user, ok := ctx.Value(breakGlassUserContextKey).(*store.User); ok {
+		return user, nil
+	}
 	userID, ok := ctx.Value(userIDContextKey).(int32)
 	if !ok {
 		return nil, nil
@@ -45,12 +52,45 @@ func ConvertStateToRowStatus(state v1pb.State) storepb.RowStatus {
 	}
 }
 
+// convertStateToRowStatusFilter converts the state filter of a list request, where unset is no filter.
+func convertStateToRowStatusFilter(state v1pb.State) (*storepb.RowStatus, error) {
+	if state == v1pb.State_STATE_UNSPECIFIED {
+		return nil, nil
+	}
+	rowStatus := ConvertStateToRowStatus(state)
+	if rowStatus == storepb.RowStatus_ROW_STATUS_UNSPECIFIED {
+		return nil, errors.Errorf("invalid state %s", state)
+	}
+	return &rowStatus, nil
+}
+
+// getCreatorIDFilter returns the creator filter of a list request, the current user if mineOnly, where unset is no filter.
+func getCreatorIDFilter(user *store.User, creatorID int32, mineOnly bool) (*int32, error) {
+	if mineOnly {
+		if user == nil {
+			return nil, errors.New("mine only requires a signed-in user")
+		}
+		if creatorID != 0 && creatorID != user.ID {
+			return nil, errors.New("creator id and mine only filter different creators")
+		}
+		return &user.ID, nil
+	}
+	if creatorID != 0 {
+		return &creatorID, nil
+	}
+	return nil, nil
+}
+
 func convertVisibilityFromStorepb(visibility storepb.Visibility) v1pb.Visibility {
 	switch visibility {
 	case storepb.Visibility_WORKSPACE:
 		return v1pb.Visibility_WORKSPACE
 	case storepb.Visibility_PUBLIC:
 		return v1pb.Visibility_PUBLIC
+	case storepb.Visibility_TEAM:
+		return v1pb.Visibility_TEAM
+	case storepb.Visibility_PRIVATE:
+		return v1pb.Visibility_PRIVATE
 	default:
 		return v1pb.Visibility_VISIBILITY_UNSPECIFIED
 	}
@@ -62,7 +102,72 @@ func convertVisibilityToStorepb(visibility v1pb.Visibility) storepb.Visibility {
 		return storepb.Visibility_WORKSPACE
 	case v1pb.Visibility_PUBLIC:
 		return storepb.Visibility_PUBLIC
+	case v1pb.Visibility_TEAM:
+		return storepb.Visibility_TEAM
+	case v1pb.Visibility_PRIVATE:
+		return storepb.Visibility_PRIVATE
 	default:
 		return storepb.Visibility_VISIBILITY_UNSPECIFIED
 	}
 }
+
+// maxPageSize is the max page size of the list RPCs.
+const maxPageSize = 1000
+
+// pagination is the page requested by the page size and the page token of a list RPC.
+type pagination struct {
+	// limit is 0 when all the remaining items are requested.
+	limit  int
+	offset int
+}
+
+func parsePagination(pageSize int32, pageToken string) (*pagination, error) {
+	if pageSize < 0 {
+		return nil, errors.New("page size must not be negative")
+	}
+	page := &pagination{
+		limit: min(int(pageSize), maxPageSize),
+	}
+	if pageToken != "" {
+		data, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return nil, errors.New("invalid page token")
+		}
+		offset, err := strconv.Atoi(string(data))
+		if err != nil || offset < 0 {
+			return nil, errors.New("invalid page token")
+		}
+		page.offset = offset
+	}
+	return page, nil
+}
+
+// limitOffset returns the limit and offset to find the page. One more item than the page size is
+// requested to tell whether there is a next page.
+func (p *pagination) limitOffset() (*int, *int) {
+	var limit, offset *int
+	if p.limit > 0 {
+		fetchLimit := p.limit + 1
+		limit = &fetchLimit
+	}
+	if p.offset > 0 {
+		offset = &p.offset
+	}
+	return limit, offset
+}
+
+// nextPageToken returns the token of the next page, or an empty string when there is no next page.
+func (p *pagination) nextPageToken(count int) string {
+	if p.limit == 0 || count <= p.limit {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(p.offset + p.limit)))
+}
+
+// truncate returns the number of items in the page out of the count of found items.
+func (p *pagination) truncate(count int) int {
+	if p.limit > 0 && count > p.limit {
+		return p.limit
+	}
+	return count
+}
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	"github.com/warthurton/slash/store"
+)
+
+const getDashboardMethod = "/slash.api.v1.DashboardService/GetDashboard"
+
+func (s *APIV1Service) GetDashboard(ctx context.Context, request *v1pb.GetDashboardRequest) (*v1pb.Dashboard, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	dashboard := &v1pb.Dashboard{
+		Shortcuts:         []*v1pb.Shortcut{},
+		TrendingShortcuts: []*v1pb.GetTrendingShortcutsResponse_TrendingShortcut{},
+		Collections:       []*v1pb.Dashboard_CollectionSummary{},
+		Errors:            []*v1pb.Dashboard_SectionError{},
+	}
+
+	// The sections are loaded concurrently, each setting its own field, and a failing section doesn't fail the others.
+	mutex := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	load := func(section v1pb.Dashboard_Section, loader func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := loadDashboardSection(ctx, loader); err != nil {
+				mutex.Lock()
+				defer mutex.Unlock()
+				dashboard.Errors = append(dashboard.Errors, &v1pb.Dashboard_SectionError{
+					Section: section,
+					Message: status.Convert(sanitizeError(ctx, getDashboardMethod, err)).Message(),
+				})
+			}
+		}()
+	}
+	load(v1pb.Dashboard_SHORTCUTS, func() error {
+		response, err := s.ListShortcuts(ctx, &v1pb.ListShortcutsRequest{})
+		if err != nil {
+			return err
+		}
+		dashboard.Shortcuts = response.Shortcuts
+		return nil
+	})
+	load(v1pb.Dashboard_TRENDING_SHORTCUTS, func() error {
+		response, err := s.GetTrendingShortcuts(ctx, &v1pb.GetTrendingShortcutsRequest{
+			Window: request.TrendingWindow,
+			Limit:  request.TrendingLimit,
+		})
+		if err != nil {
+			return err
+		}
+		dashboard.TrendingShortcuts = response.TrendingShortcuts
+		return nil
+	})
+	// A scoped access token only gets the collections when it's allowed to read them.
+	scopes, _ := ctx.Value(accessTokenScopesContextKey).([]string)
+	if hasAccessTokenScope(scopes, AccessTokenScopeCollectionsRead) {
+		load(v1pb.Dashboard_COLLECTIONS, func() error {
+			collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+				ViewerID: getViewerID(user),
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to list collections: %v", err)
+			}
+			for _, collection := range collections {
+				dashboard.Collections = append(dashboard.Collections, &v1pb.Dashboard_CollectionSummary{
+					Id:            collection.Id,
+					Name:          collection.Name,
+					Title:         collection.Title,
+					ShortcutCount: int32(len(collection.ShortcutIds)),
+				})
+			}
+			return nil
+		})
+	}
+	wg.Wait()
+
+	slices.SortFunc(dashboard.Errors, func(a, b *v1pb.Dashboard_SectionError) int {
+		return cmp.Compare(a.Section, b.Section)
+	})
+	return dashboard, nil
+}
+
+// loadDashboardSection runs the loader of a section, converting its panic into an internal error
+// as the recovery interceptor doesn't cover the goroutines of the sections.
+func loadDashboardSection(ctx context.Context, loader func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "panic recovered",
+				slog.String("method", getDashboardMethod),
+				slog.String("panic", fmt.Sprint(r)),
+				slog.String("stack", string(debug.Stack())),
+			)
+			err = status.Errorf(codes.Internal, "panic: %v", r)
+		}
+	}()
+	return loader()
+}
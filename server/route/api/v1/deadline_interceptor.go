@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxHandlerDuration is the maximum duration a single request is allowed to run.
+const MaxHandlerDuration = 30 * time.Second
+
+type DeadlineInterceptor struct {
+	timeout time.Duration
+}
+
+func NewDeadlineInterceptor(timeout time.Duration) *DeadlineInterceptor {
+	return &DeadlineInterceptor{
+		timeout: timeout,
+	}
+}
+
+// DeadlineInterceptor bounds the handler with the configured timeout. The deadline is only
+// applied when it is earlier than the one already carried by the incoming context.
+func (in *DeadlineInterceptor) DeadlineInterceptor(ctx context.Context, request any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if in.timeout <= 0 {
+		return handler(ctx, request)
+	}
+	ctx, cancel := context.WithTimeout(ctx, in.timeout)
+	defer cancel()
+
+	resp, err := handler(ctx, request)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, status.Errorf(codes.DeadlineExceeded, "request exceeded the maximum duration of %s", in.timeout)
+	}
+	return resp, err
+}
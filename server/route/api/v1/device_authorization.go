@@ -0,0 +1,244 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/warthurton/slash/internal/util"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// deviceAuthorizationDuration is how long the user has to approve the client.
+	deviceAuthorizationDuration = 10 * time.Minute
+	// deviceAuthorizationInterval is the minimum interval between the polls of the client.
+	deviceAuthorizationInterval = 5 * time.Second
+	// maxPendingDeviceAuthorizations bounds the device authorizations kept in memory.
+	maxPendingDeviceAuthorizations = 1000
+	// deviceVerificationPath is the page of the web app where the user enters the user code.
+	deviceVerificationPath = "/device"
+	// defaultDeviceClientName is the name of the clients which don't give one.
+	defaultDeviceClientName = "Device"
+	// userCodeAlphabet has no vowels, so that the user codes don't spell words, and no look-alike characters.
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+// deviceAuthorization is a device authorization pending the approval of the user, or approved and waiting for its poll.
+type deviceAuthorization struct {
+	deviceCode   string
+	userCode     string
+	clientName   string
+	scopes       []string
+	expireTime   time.Time
+	lastPollTime time.Time
+	state        v1pb.PollDeviceTokenResponse_State
+	accessToken  *v1pb.UserAccessToken
+}
+
+// deviceAuthorizations are the device authorizations in memory by device code.
+// They are lost on restart, when the clients start over.
+type deviceAuthorizations struct {
+	mutex sync.Mutex
+	list  map[string]*deviceAuthorization
+}
+
+func newDeviceAuthorizations() *deviceAuthorizations {
+	return &deviceAuthorizations{
+		list: map[string]*deviceAuthorization{},
+	}
+}
+
+// pruneExpired drops the expired device authorizations. The mutex must be held.
+func (d *deviceAuthorizations) pruneExpired(now time.Time) {
+	for deviceCode, authorization := range d.list {
+		if !now.Before(authorization.expireTime) {
+			delete(d.list, deviceCode)
+		}
+	}
+}
+
+// findByUserCode returns the unexpired device authorization of the user code. The mutex must be held.
+func (d *deviceAuthorizations) findByUserCode(userCode string, now time.Time) *deviceAuthorization {
+	userCode = normalizeUserCode(userCode)
+	for _, authorization := range d.list {
+		if authorization.userCode == userCode && now.Before(authorization.expireTime) {
+			return authorization
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) CreateDeviceAuthorization(ctx context.Context, request *v1pb.CreateDeviceAuthorizationRequest) (*v1pb.CreateDeviceAuthorizationResponse, error) {
+	scopes, err := normalizeAccessTokenScopes(request.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	clientName, _ := util.TruncateString(strings.TrimSpace(request.ClientName), 64)
+	if clientName == "" {
+		clientName = defaultDeviceClientName
+	}
+	deviceCode, err := util.RandomString(40)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate device code: %v", err)
+	}
+	userCode, err := generateUserCode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate user code: %v", err)
+	}
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+
+	now := time.Now()
+	s.deviceAuthorizations.mutex.Lock()
+	defer s.deviceAuthorizations.mutex.Unlock()
+	s.deviceAuthorizations.pruneExpired(now)
+	if len(s.deviceAuthorizations.list) >= maxPendingDeviceAuthorizations {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many pending device authorizations, try again later")
+	}
+	// The user codes are short, so they may collide with a pending one.
+	if s.deviceAuthorizations.findByUserCode(userCode, now) != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to generate a unique user code, try again")
+	}
+	s.deviceAuthorizations.list[deviceCode] = &deviceAuthorization{
+		deviceCode: deviceCode,
+		userCode:   userCode,
+		clientName: clientName,
+		scopes:     scopes,
+		expireTime: now.Add(deviceAuthorizationDuration),
+		state:      v1pb.PollDeviceTokenResponse_PENDING,
+	}
+
+	// The verification uri is relative without the instance url, to the instance the client called.
+	verificationURI := strings.TrimSuffix(generalSetting.InstanceUrl, "/") + deviceVerificationPath
+	return &v1pb.CreateDeviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                formatUserCode(userCode),
+		VerificationUri:         verificationURI,
+		VerificationUriComplete: fmt.Sprintf("%s?code=%s", verificationURI, formatUserCode(userCode)),
+		ExpiresIn:               int32(deviceAuthorizationDuration.Seconds()),
+		Interval:                int32(deviceAuthorizationInterval.Seconds()),
+	}, nil
+}
+
+func (s *APIV1Service) GetDeviceAuthorization(_ context.Context, request *v1pb.GetDeviceAuthorizationRequest) (*v1pb.DeviceAuthorization, error) {
+	s.deviceAuthorizations.mutex.Lock()
+	defer s.deviceAuthorizations.mutex.Unlock()
+	authorization := s.deviceAuthorizations.findByUserCode(request.UserCode, time.Now())
+	if authorization == nil || authorization.state != v1pb.PollDeviceTokenResponse_PENDING {
+		return nil, status.Errorf(codes.NotFound, "the code is invalid or has expired")
+	}
+	return &v1pb.DeviceAuthorization{
+		UserCode:   formatUserCode(authorization.userCode),
+		ClientName: authorization.clientName,
+		Scopes:     authorization.scopes,
+		ExpireTime: timestamppb.New(authorization.expireTime),
+	}, nil
+}
+
+func (s *APIV1Service) ApproveDeviceAuthorization(ctx context.Context, request *v1pb.ApproveDeviceAuthorizationRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	s.deviceAuthorizations.mutex.Lock()
+	defer s.deviceAuthorizations.mutex.Unlock()
+	authorization := s.deviceAuthorizations.findByUserCode(request.UserCode, time.Now())
+	if authorization == nil || authorization.state != v1pb.PollDeviceTokenResponse_PENDING {
+		return nil, status.Errorf(codes.NotFound, "the code is invalid or has expired")
+	}
+	if slices.Contains(authorization.scopes, AccessTokenScopeAdmin) && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins can approve access tokens with the admin scope")
+	}
+	// The access token approving can't grant more than its own scopes.
+	if scopes, _ := ctx.Value(accessTokenScopesContextKey).([]string); !coversAccessTokenScopes(scopes, authorization.scopes) {
+		return nil, status.Errorf(codes.PermissionDenied, "the access token is restricted to the scopes %s", strings.Join(scopes, ", "))
+	}
+	description := fmt.Sprintf("%s (device authorization)", authorization.clientName)
+	accessToken, err := s.createUserAccessToken(ctx, user, description, authorization.scopes, time.Time{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token: %v", err)
+	}
+	authorization.state = v1pb.PollDeviceTokenResponse_APPROVED
+	authorization.accessToken = accessToken
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) DenyDeviceAuthorization(_ context.Context, request *v1pb.DenyDeviceAuthorizationRequest) (*emptypb.Empty, error) {
+	s.deviceAuthorizations.mutex.Lock()
+	defer s.deviceAuthorizations.mutex.Unlock()
+	authorization := s.deviceAuthorizations.findByUserCode(request.UserCode, time.Now())
+	if authorization == nil || authorization.state != v1pb.PollDeviceTokenResponse_PENDING {
+		return nil, status.Errorf(codes.NotFound, "the code is invalid or has expired")
+	}
+	authorization.state = v1pb.PollDeviceTokenResponse_DENIED
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) PollDeviceToken(_ context.Context, request *v1pb.PollDeviceTokenRequest) (*v1pb.PollDeviceTokenResponse, error) {
+	now := time.Now()
+	s.deviceAuthorizations.mutex.Lock()
+	defer s.deviceAuthorizations.mutex.Unlock()
+	authorization, ok := s.deviceAuthorizations.list[request.DeviceCode]
+	if !ok || !now.Before(authorization.expireTime) {
+		return nil, status.Errorf(codes.NotFound, "the device code is invalid or has expired")
+	}
+	// A second of leeway, as the polls of the clients drift.
+	if now.Sub(authorization.lastPollTime) < deviceAuthorizationInterval-time.Second {
+		return nil, status.Errorf(codes.ResourceExhausted, "slow down, poll every %d seconds", int(deviceAuthorizationInterval.Seconds()))
+	}
+	authorization.lastPollTime = now
+
+	response := &v1pb.PollDeviceTokenResponse{
+		State: authorization.state,
+	}
+	// The access token is only returned once, as the approved and denied authorizations are done.
+	if authorization.state != v1pb.PollDeviceTokenResponse_PENDING {
+		response.AccessToken = authorization.accessToken
+		delete(s.deviceAuthorizations.list, request.DeviceCode)
+	}
+	return response, nil
+}
+
+// generateUserCode generates a random user code, without its separator.
+func generateUserCode() (string, error) {
+	var sb strings.Builder
+	for i := 0; i < userCodeLength; i++ {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(userCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(userCodeAlphabet[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// formatUserCode formats the user code in two halves for reading, e.g. "WDJB-MJHT".
+func formatUserCode(userCode string) string {
+	return userCode[:userCodeLength/2] + "-" + userCode[userCodeLength/2:]
+}
+
+// normalizeUserCode normalizes the user code entered by the user, e.g. "wdjb mjht" to "WDJBMJHT".
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(userCode))
+}
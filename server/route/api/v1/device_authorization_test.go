@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/profile"
+	"github.com/warthurton/slash/store"
+	teststore "github.com/warthurton/slash/store/test"
+)
+
+func TestApproveDeviceAuthorization(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	s := &APIV1Service{
+		Secret:               "test-secret",
+		Profile:              &profile.Profile{},
+		Store:                ts,
+		deviceAuthorizations: newDeviceAuthorizations(),
+	}
+	approve := func(callerScopes, requestedScopes []string) (*deviceAuthorization, error) {
+		authorization := &deviceAuthorization{
+			deviceCode: "device-code",
+			userCode:   "BCDFGHJK",
+			clientName: "CLI",
+			scopes:     requestedScopes,
+			expireTime: time.Now().Add(deviceAuthorizationDuration),
+			state:      v1pb.PollDeviceTokenResponse_PENDING,
+		}
+		s.deviceAuthorizations.list[authorization.deviceCode] = authorization
+		userCtx := context.WithValue(ctx, userIDContextKey, user.ID)
+		userCtx = context.WithValue(userCtx, accessTokenScopesContextKey, callerScopes)
+		_, err := s.ApproveDeviceAuthorization(userCtx, &v1pb.ApproveDeviceAuthorizationRequest{
+			UserCode: "BCDF-GHJK",
+		})
+		return authorization, err
+	}
+
+	// The access token approving can't grant more than its own scopes.
+	authorization, err := approve([]string{AccessTokenScopeShortcutsRead}, []string{AccessTokenScopeShortcutsWrite})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, v1pb.PollDeviceTokenResponse_PENDING, authorization.state)
+	authorization, err = approve([]string{AccessTokenScopeShortcutsWrite}, nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, v1pb.PollDeviceTokenResponse_PENDING, authorization.state)
+
+	authorization, err = approve([]string{AccessTokenScopeShortcutsWrite}, []string{AccessTokenScopeShortcutsRead})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.PollDeviceTokenResponse_APPROVED, authorization.state)
+	require.Equal(t, []string{AccessTokenScopeShortcutsRead}, authorization.accessToken.Scopes)
+	authorization, err = approve(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, v1pb.PollDeviceTokenResponse_APPROVED, authorization.state)
+}
+
+func TestAuditImpersonatedRequest(t *testing.T) {
+	impersonationAccessToken := &storepb.UserSetting_AccessTokensSetting_AccessToken{
+		ImpersonatorId: 1,
+	}
+	err := auditImpersonatedRequest("/slash.api.v1.AuthService/ApproveDeviceAuthorization", &v1pb.ApproveDeviceAuthorizationRequest{}, 2, impersonationAccessToken)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = auditImpersonatedRequest("/slash.api.v1.UserService/UpdateUser", &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Id: 2, Password: "password"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}},
+	}, 2, impersonationAccessToken)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = auditImpersonatedRequest("/slash.api.v1.UserService/UpdateUser", &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Id: 2, Nickname: "nickname"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"nickname"}},
+	}, 2, impersonationAccessToken)
+	require.NoError(t, err)
+
+	// The requests of the user themselves aren't restricted.
+	err = auditImpersonatedRequest("/slash.api.v1.AuthService/ApproveDeviceAuthorization", &v1pb.ApproveDeviceAuthorizationRequest{}, 2, &storepb.UserSetting_AccessTokensSetting_AccessToken{})
+	require.NoError(t, err)
+}
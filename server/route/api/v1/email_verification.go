@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/warthurton/slash/internal/util"
+	"github.com/warthurton/slash/plugin/mail"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/store"
+)
+
+func (s *APIV1Service) VerifyEmail(ctx context.Context, request *v1pb.VerifyEmailRequest) (*v1pb.User, error) {
+	claims, err := parseEmailVerificationToken(request.Token, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired verification token")
+	}
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "malformed user id in the verification token")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.Email != claims.Email {
+		return nil, status.Errorf(codes.InvalidArgument, "the email of the user has changed since the verification email was sent")
+	}
+	if user.EmailVerified {
+		return convertUserFromStore(user), nil
+	}
+
+	emailVerified := true
+	user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:            user.ID,
+		EmailVerified: &emailVerified,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	}
+	return convertUserFromStore(user), nil
+}
+
+func (s *APIV1Service) SendVerificationEmail(ctx context.Context, _ *v1pb.SendVerificationEmailRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	if user.EmailVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "email %s is already verified", user.Email)
+	}
+	mailSetting, err := s.Store.GetWorkspaceMailSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace mail setting: %v", err)
+	}
+	if mailSetting.SmtpHost == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the SMTP server is not configured")
+	}
+	if err := s.sendVerificationEmail(ctx, user, mailSetting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to send verification email: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// sendVerificationEmail sends the email with the link to confirm the email of the user.
+func (s *APIV1Service) sendVerificationEmail(ctx context.Context, user *store.User, mailSetting *storepb.WorkspaceSetting_MailSetting) error {
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace general setting")
+	}
+	// The link in the email has to be absolute.
+	if generalSetting.InstanceUrl == "" {
+		return errors.New("the instance url is not configured")
+	}
+	token, err := generateEmailVerificationToken(user.ID, user.Email, time.Now().Add(EmailVerificationDuration), []byte(s.Secret))
+	if err != nil {
+		return errors.Wrap(err, "failed to generate verification token")
+	}
+	link := fmt.Sprintf("%s/auth/verify-email?token=%s", generalSetting.InstanceUrl, url.QueryEscape(token))
+	email := mail.NewEmailMsg().
+		SetFrom(mailSetting.From).
+		AddTo(user.Email).
+		SetSubject("Verify your email for Slash").
+		SetBody(fmt.Sprintf(
+			`<p>Hi %s,</p><p>Confirm your email to start using Slash by opening the link below within %d hours.</p><p><a href="%s">%s</a></p>`,
+			html.EscapeString(user.Nickname),
+			int(EmailVerificationDuration.Hours()),
+			html.EscapeString(link),
+			html.EscapeString(link),
+		))
+	return common.NewSMTPClient(mailSetting).SendMail(email)
+}
+
+// isEmailVerificationRequired returns true if the users signing up have to verify their email,
+// which needs the SMTP server to send the verification emails.
+func isEmailVerificationRequired(mailSetting *storepb.WorkspaceSetting_MailSetting) bool {
+	return mailSetting.RequireEmailVerification && mailSetting.SmtpHost != ""
+}
+
+func convertSmtpConfigFromStore(mailSetting *storepb.WorkspaceSetting_MailSetting) *v1pb.SmtpConfig {
+	return &v1pb.SmtpConfig{
+		Host:       mailSetting.SmtpHost,
+		Port:       mailSetting.SmtpPort,
+		Username:   mailSetting.SmtpUsername,
+		Password:   mailSetting.SmtpPassword,
+		Encryption: v1pb.SmtpConfig_Encryption(mailSetting.SmtpEncryption),
+		From:       mailSetting.From,
+	}
+}
+
+// convertSmtpConfigToStore converts the SMTP config to a mail setting, without the email verification requirement.
+func convertSmtpConfigToStore(smtpConfig *v1pb.SmtpConfig) *storepb.WorkspaceSetting_MailSetting {
+	return &storepb.WorkspaceSetting_MailSetting{
+		SmtpHost:       smtpConfig.Host,
+		SmtpPort:       smtpConfig.Port,
+		SmtpUsername:   smtpConfig.Username,
+		SmtpPassword:   smtpConfig.Password,
+		SmtpEncryption: storepb.WorkspaceSetting_MailSetting_Encryption(smtpConfig.Encryption),
+		From:           smtpConfig.From,
+	}
+}
@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/warthurton/slash/internal/util"
+)
+
+// MaxErrorMessageLength bounds the messages of the errors returned to the clients, which may echo their input.
+const MaxErrorMessageLength = 512
+
+type ErrorInterceptor struct {
+}
+
+func NewErrorInterceptor() *ErrorInterceptor {
+	return &ErrorInterceptor{}
+}
+
+// ErrorInterceptor hides the details of the server errors, e.g. SQL fragments or file paths, from the clients.
+// The details are logged with an error id, which the clients get instead to report the failure.
+func (*ErrorInterceptor) ErrorInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, request)
+	if err == nil {
+		return resp, nil
+	}
+	return resp, sanitizeError(ctx, serverInfo.FullMethod, err)
+}
+
+func sanitizeError(ctx context.Context, fullMethod string, err error) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		errorID := util.GenUUID()
+		slog.LogAttrs(ctx, slog.LevelError, "internal error",
+			slog.String("method", fullMethod),
+			slog.String("error_id", errorID),
+			slog.String("error", st.Message()),
+		)
+		return status.Error(st.Code(), internalErrorMessage(errorID))
+	}
+	if message, truncated := util.TruncateString(st.Message(), MaxErrorMessageLength); truncated {
+		sanitized := st.Proto()
+		sanitized.Message = message + "..."
+		return status.ErrorProto(sanitized)
+	}
+	return err
+}
+
+// SanitizeHTTPError is the counterpart of the ErrorInterceptor for the errors of the HTTP handlers.
+func SanitizeHTTPError(c echo.Context, err error) error {
+	httpError := &echo.HTTPError{}
+	if !errors.As(err, &httpError) {
+		httpError = echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if httpError.Code < http.StatusInternalServerError {
+		if message, ok := httpError.Message.(string); ok {
+			if truncated, ok := util.TruncateString(message, MaxErrorMessageLength); ok {
+				return echo.NewHTTPError(httpError.Code, truncated+"...")
+			}
+		}
+		return httpError
+	}
+
+	errorID := util.GenUUID()
+	slog.Error("internal error",
+		slog.String("path", c.Request().URL.Path),
+		slog.String("error_id", errorID),
+		slog.String("error", err.Error()),
+	)
+	return echo.NewHTTPError(httpError.Code, internalErrorMessage(errorID))
+}
+
+func internalErrorMessage(errorID string) string {
+	return fmt.Sprintf("internal server error (error id: %s)", errorID)
+}
@@ -0,0 +1,302 @@
+package v1
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// ExportFormatJSON is the JSON document of the shortcuts and collections.
+	ExportFormatJSON = "json"
+	// ExportFormatCSV is the CSV table of the shortcuts and collections, distinguished by the type column.
+	ExportFormatCSV = "csv"
+)
+
+var exportCSVHeader = []string{"type", "id", "name", "title", "link", "description", "tags", "visibility", "state", "creator", "created_time", "updated_time", "expire_time", "view_count", "shortcuts"}
+
+// ExportShortcut is a shortcut in the JSON export.
+type ExportShortcut struct {
+	ID           int32                      `json:"id"`
+	Name         string                     `json:"name"`
+	Title        string                     `json:"title"`
+	Link         string                     `json:"link"`
+	Description  string                     `json:"description"`
+	Tags         []string                   `json:"tags"`
+	Visibility   string                     `json:"visibility"`
+	State        string                     `json:"state"`
+	Creator      string                     `json:"creator"`
+	CreatedTime  string                     `json:"createdTime"`
+	UpdatedTime  string                     `json:"updatedTime"`
+	ExpireTime   string                     `json:"expireTime,omitempty"`
+	ActivateTime string                     `json:"activateTime,omitempty"`
+	ViewCount    int32                      `json:"viewCount"`
+	OgMetadata   *storepb.OpenGraphMetadata `json:"ogMetadata,omitempty"`
+}
+
+// ExportCollection is a collection in the JSON export.
+type ExportCollection struct {
+	ID          int32  `json:"id"`
+	Name        string `json:"name"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+	Creator     string `json:"creator"`
+	CreatedTime string `json:"createdTime"`
+	UpdatedTime string `json:"updatedTime"`
+	// Shortcuts are the names of the shortcuts in the collection.
+	Shortcuts []string `json:"shortcuts"`
+}
+
+// registerExportRoutes registers the export endpoint, which streams the dump of all the shortcuts and collections to admins.
+func (s *APIV1Service) registerExportRoutes(e *echo.Echo) {
+	e.GET("/api/v1/export", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		md := metadata.MD{}
+		if authorization := c.Request().Header.Get(echo.HeaderAuthorization); authorization != "" {
+			md.Set("authorization", authorization)
+		}
+		if cookie := c.Request().Header.Get(echo.HeaderCookie); cookie != "" {
+			md.Set("cookie", cookie)
+		}
+		accessToken, err := getTokenFromMetadata(md)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		userID, userAccessToken, err := NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, accessToken)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "invalid access token")
+		}
+		if !hasAccessTokenScope(userAccessToken.Scopes, AccessTokenScopeAdmin) {
+			return echo.NewHTTPError(http.StatusForbidden, "the access token requires the admin scope")
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get user, err: %s", err))
+		}
+		if user == nil || user.Role != store.RoleAdmin {
+			return echo.NewHTTPError(http.StatusForbidden, "only admins can export the workspace")
+		}
+
+		format := c.QueryParam("format")
+		if format == "" {
+			format = ExportFormatJSON
+		}
+		contentType, filename, err := getExportFile(format, time.Now())
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		c.Response().Header().Set(echo.HeaderContentType, contentType)
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+		c.Response().WriteHeader(http.StatusOK)
+		// The headers are sent already, so the error can only be logged by the error handler.
+		return s.writeWorkspaceExport(ctx, c.Response(), format)
+	})
+}
+
+// getExportFile returns the content type and the file name of the export in the format.
+func getExportFile(format string, now time.Time) (string, string, error) {
+	filename := fmt.Sprintf("slash-export-%s.%s", now.Format("20060102"), format)
+	switch format {
+	case ExportFormatJSON:
+		return echo.MIMEApplicationJSONCharsetUTF8, filename, nil
+	case ExportFormatCSV:
+		return "text/csv; charset=UTF-8", filename, nil
+	default:
+		return "", "", errors.Errorf("unsupported export format %q", format)
+	}
+}
+
+// writeWorkspaceExport writes the export of all the shortcuts and collections to w.
+// The shortcuts and collections are written one by one, so that the export isn't buffered.
+func (s *APIV1Service) writeWorkspaceExport(ctx context.Context, w io.Writer, format string) error {
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list users")
+	}
+	usernames := map[int32]string{}
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcuts")
+	}
+	viewCounts, err := s.Store.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcut view counts")
+	}
+	viewCountMap := map[int32]int32{}
+	for _, viewCount := range viewCounts {
+		viewCountMap[viewCount.ShortcutID] = viewCount.Count
+	}
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list collections")
+	}
+
+	exportShortcuts := make([]*ExportShortcut, 0, len(shortcuts))
+	shortcutNames := map[int32]string{}
+	for _, shortcut := range shortcuts {
+		shortcutNames[shortcut.Id] = shortcut.Name
+		exportShortcut := &ExportShortcut{
+			ID:           shortcut.Id,
+			Name:         shortcut.Name,
+			Title:        shortcut.Title,
+			Link:         shortcut.Link,
+			Description:  shortcut.Description,
+			Tags:         shortcut.Tags,
+			Visibility:   shortcut.Visibility.String(),
+			State:        convertStateFromRowStatus(shortcut.RowStatus).String(),
+			Creator:      usernames[shortcut.CreatorId],
+			CreatedTime:  formatExportTime(shortcut.CreatedTs),
+			UpdatedTime:  formatExportTime(shortcut.UpdatedTs),
+			ExpireTime:   formatExportTime(shortcut.ExpireTs),
+			ActivateTime: formatExportTime(shortcut.ActivateTs),
+			ViewCount:    viewCountMap[shortcut.Id],
+			OgMetadata:   shortcut.OgMetadata,
+		}
+		if exportShortcut.Tags == nil {
+			exportShortcut.Tags = []string{}
+		}
+		exportShortcuts = append(exportShortcuts, exportShortcut)
+	}
+	exportCollections := make([]*ExportCollection, 0, len(collections))
+	for _, collection := range collections {
+		names := []string{}
+		for _, shortcutID := range collection.ShortcutIds {
+			if name, ok := shortcutNames[shortcutID]; ok {
+				names = append(names, name)
+			}
+		}
+		exportCollections = append(exportCollections, &ExportCollection{
+			ID:          collection.Id,
+			Name:        collection.Name,
+			Title:       collection.Title,
+			Description: collection.Description,
+			Visibility:  collection.Visibility.String(),
+			Creator:     usernames[collection.CreatorId],
+			CreatedTime: formatExportTime(collection.CreatedTs),
+			UpdatedTime: formatExportTime(collection.UpdatedTs),
+			Shortcuts:   names,
+		})
+	}
+
+	switch format {
+	case ExportFormatJSON:
+		return writeJSONExport(w, exportShortcuts, exportCollections)
+	case ExportFormatCSV:
+		return writeCSVExport(w, exportShortcuts, exportCollections)
+	default:
+		return errors.Errorf("unsupported export format %q", format)
+	}
+}
+
+func writeJSONExport(w io.Writer, shortcuts []*ExportShortcut, collections []*ExportCollection) error {
+	if _, err := io.WriteString(w, `{"shortcuts":[`); err != nil {
+		return err
+	}
+	for i, shortcut := range shortcuts {
+		if err := writeJSONExportItem(w, i, shortcut); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(w, `],"collections":[`); err != nil {
+		return err
+	}
+	for i, collection := range collections {
+		if err := writeJSONExportItem(w, i, collection); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "]}\n")
+	return err
+}
+
+func writeJSONExportItem(w io.Writer, index int, item any) error {
+	if index > 0 {
+		if _, err := io.WriteString(w, ","); err != nil {
+			return err
+		}
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal export item")
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func writeCSVExport(w io.Writer, shortcuts []*ExportShortcut, collections []*ExportCollection) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportCSVHeader); err != nil {
+		return err
+	}
+	for _, shortcut := range shortcuts {
+		if err := writer.Write([]string{
+			"shortcut",
+			strconv.Itoa(int(shortcut.ID)),
+			shortcut.Name,
+			shortcut.Title,
+			shortcut.Link,
+			shortcut.Description,
+			strings.Join(shortcut.Tags, " "),
+			shortcut.Visibility,
+			shortcut.State,
+			shortcut.Creator,
+			shortcut.CreatedTime,
+			shortcut.UpdatedTime,
+			shortcut.ExpireTime,
+			strconv.Itoa(int(shortcut.ViewCount)),
+			"",
+		}); err != nil {
+			return err
+		}
+	}
+	for _, collection := range collections {
+		if err := writer.Write([]string{
+			"collection",
+			strconv.Itoa(int(collection.ID)),
+			collection.Name,
+			collection.Title,
+			"",
+			collection.Description,
+			"",
+			collection.Visibility,
+			"",
+			collection.Creator,
+			collection.CreatedTime,
+			collection.UpdatedTime,
+			"",
+			"",
+			strings.Join(collection.Shortcuts, " "),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// formatExportTime formats the unix seconds as RFC 3339, or returns an empty string for 0.
+func formatExportTime(ts int64) string {
+	if ts == 0 {
+		return ""
+	}
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
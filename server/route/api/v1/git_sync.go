@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/service/gitsync"
+)
+
+// maxGitSyncWebhookPayloadSize is the max size of the GitHub webhook payloads.
+const maxGitSyncWebhookPayloadSize = 25 << 20
+
+// registerGitSyncRoutes registers the GitHub push webhook, which syncs the shortcuts as soon as
+// the file of shortcut definitions is pushed instead of waiting for the next poll.
+func (s *APIV1Service) registerGitSyncRoutes(e *echo.Echo) {
+	e.POST("/api/v1/git-sync/webhook", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		setting, err := s.Store.GetWorkspaceGitSyncSetting(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get workspace git sync setting")
+		}
+		if !setting.Enabled || setting.WebhookSecret == "" {
+			return echo.NewHTTPError(http.StatusNotFound, "git sync webhook is not enabled")
+		}
+		payload, err := io.ReadAll(io.LimitReader(c.Request().Body, maxGitSyncWebhookPayloadSize))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "failed to read payload")
+		}
+		if !gitsync.VerifySignature(setting.WebhookSecret, payload, c.Request().Header.Get("X-Hub-Signature-256")) {
+			return echo.NewHTTPError(http.StatusUnauthorized, "invalid signature")
+		}
+
+		switch event := c.Request().Header.Get("X-GitHub-Event"); event {
+		case "ping":
+			return c.NoContent(http.StatusOK)
+		case "push":
+			push := struct {
+				Ref string `json:"ref"`
+			}{}
+			if err := json.Unmarshal(payload, &push); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid push payload")
+			}
+			if push.Ref != "refs/heads/"+gitsync.GetBranch(setting) {
+				return c.NoContent(http.StatusNoContent)
+			}
+			// The sync outlives the request, so that GitHub isn't kept waiting.
+			s.runInBackground(func() {
+				if err := s.GitSyncService.Sync(context.Background()); err != nil {
+					slog.Error("failed to sync shortcuts from git", slog.Any("error", err))
+				}
+			})
+			return c.NoContent(http.StatusAccepted)
+		default:
+			return c.NoContent(http.StatusNoContent)
+		}
+	})
+}
+
+// proposeGitSyncChange proposes the manual change of the shortcut synced from git as a pull request
+// in the background. The shortcut is nil when it is deleted.
+func (s *APIV1Service) proposeGitSyncChange(name string, shortcut *storepb.Shortcut) {
+	go func() {
+		ctx := context.Background()
+		url, err := s.GitSyncService.ProposeChange(ctx, name, shortcut)
+		if err != nil {
+			slog.Error("failed to propose shortcut change to git", slog.String("name", name), slog.Any("error", err))
+			return
+		}
+		if url != "" {
+			slog.Info("proposed shortcut change to git", slog.String("name", name), slog.String("pullRequest", url))
+		}
+	}()
+}
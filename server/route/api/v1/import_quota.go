@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// importQuotaPruneSize is the number of the tracked users over which the full buckets are pruned.
+const importQuotaPruneSize = 10000
+
+// importBucket is the credits of a user to import rows, refilled over time up to the burst.
+// The credits are negative when rows are waiting for them.
+type importBucket struct {
+	credits    float64
+	updateTime time.Time
+}
+
+// importQuota throttles the rows imported per user, so that large imports slow down instead of failing.
+// The users have burst credits for the small imports, then get the rate limit. The buckets are in memory,
+// so each instance throttles the imports it serves.
+type importQuota struct {
+	// rate is the credits per second. 0 means unlimited.
+	rate float64
+	// burst is the max credits of a user.
+	burst float64
+
+	mutex   sync.Mutex
+	buckets map[int32]*importBucket
+}
+
+// newImportQuota creates the quota of rowsPerMinute per user, with burst credits.
+func newImportQuota(rowsPerMinute, burst int) *importQuota {
+	return &importQuota{
+		rate:    float64(rowsPerMinute) / 60,
+		burst:   float64(max(burst, 1)),
+		buckets: map[int32]*importBucket{},
+	}
+}
+
+// reserve takes a credit of the user for a row, and returns how long the row must wait for it.
+func (q *importQuota) reserve(userID int32, now time.Time) time.Duration {
+	if q.rate == 0 {
+		return 0
+	}
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	bucket := q.getBucket(userID, now)
+	bucket.credits--
+	if bucket.credits >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.credits / q.rate * float64(time.Second))
+}
+
+// cancel gives back the credit of a row which didn't wait for it, e.g. the import was canceled.
+func (q *importQuota) cancel(userID int32, now time.Time) {
+	if q.rate == 0 {
+		return
+	}
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	bucket := q.getBucket(userID, now)
+	bucket.credits = min(bucket.credits+1, q.burst)
+}
+
+// wait waits for a credit of the user for a row. onThrottle is called before waiting.
+func (q *importQuota) wait(ctx context.Context, userID int32, onThrottle func(time.Duration)) error {
+	delay := q.reserve(userID, time.Now())
+	if delay == 0 {
+		return nil
+	}
+	onThrottle(delay)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		q.cancel(userID, time.Now())
+		return ctx.Err()
+	}
+}
+
+// getBucket returns the bucket of the user refilled until now. The mutex must be held.
+func (q *importQuota) getBucket(userID int32, now time.Time) *importBucket {
+	bucket, ok := q.buckets[userID]
+	if !ok {
+		if len(q.buckets) >= importQuotaPruneSize {
+			q.pruneFull(now)
+		}
+		bucket = &importBucket{
+			credits:    q.burst,
+			updateTime: now,
+		}
+		q.buckets[userID] = bucket
+	}
+	if elapsed := now.Sub(bucket.updateTime); elapsed > 0 {
+		bucket.credits = min(bucket.credits+elapsed.Seconds()*q.rate, q.burst)
+		bucket.updateTime = now
+	}
+	return bucket
+}
+
+// pruneFull drops the buckets refilled up to the burst, which are the same as new ones. The mutex must be held.
+func (q *importQuota) pruneFull(now time.Time) {
+	for userID, bucket := range q.buckets {
+		if bucket.credits+now.Sub(bucket.updateTime).Seconds()*q.rate >= q.burst {
+			delete(q.buckets, userID)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/warthurton/slash/internal/util"
+	"github.com/warthurton/slash/plugin/httpgetter"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+const (
+	maxValidateLinks = 100
+	// The links are requested in parallel by this many workers,
+	// so that checking the max number of links fits in the deadline of the handler.
+	linkReachabilityConcurrency = 20
+)
+
+func (s *APIV1Service) ValidateLinks(ctx context.Context, request *v1pb.ValidateLinksRequest) (*v1pb.ValidateLinksResponse, error) {
+	if len(request.Links) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "links are required")
+	}
+	if len(request.Links) > maxValidateLinks {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d links can be validated at once", maxValidateLinks)
+	}
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
+	}
+	linkParamRules := shortcutRelatedSetting.GetLinkParamRules()
+
+	response := &v1pb.ValidateLinksResponse{}
+	for _, link := range request.Links {
+		result := &v1pb.ValidateLinksResponse_Result{
+			Link: link,
+		}
+		link = strings.TrimSpace(link)
+		switch {
+		case link == "":
+			result.Error = "the link is empty"
+		case !redirectableLinkRegexp.MatchString(link):
+			result.Error = "the link is not a http(s) or ftp url, so it would be shown as plain text"
+		default:
+			result.Valid = true
+			result.NormalizedLink = util.StripQueryParams(link, linkParamRules.GetDeny(), linkParamRules.GetAllow())
+		}
+		response.Results = append(response.Results, result)
+	}
+	if request.CheckReachability {
+		checkLinksReachability(ctx, response.Results)
+	}
+	return response, nil
+}
+
+// checkLinksReachability requests the normalized links of the valid results in parallel and sets their reachability.
+func checkLinksReachability(ctx context.Context, results []*v1pb.ValidateLinksResponse_Result) {
+	semaphore := make(chan struct{}, linkReachabilityConcurrency)
+	wg := sync.WaitGroup{}
+	for _, result := range results {
+		// The ftp links can't be requested over http.
+		if !result.Valid || !strings.HasPrefix(strings.ToLower(result.NormalizedLink), "http") {
+			continue
+		}
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(result *v1pb.ValidateLinksResponse_Result) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			statusCode, err := httpgetter.CheckReachability(ctx, result.NormalizedLink)
+			reachable := err == nil
+			result.Reachable = &reachable
+			result.StatusCode = int32(statusCode)
+			if err != nil {
+				result.ReachabilityError = err.Error()
+			}
+		}(result)
+	}
+	wg.Wait()
+}
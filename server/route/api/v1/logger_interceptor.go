@@ -3,12 +3,21 @@ package v1
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// methodLogLevels are the levels of the access log of the methods which succeed, info by default.
+// The frontend polls them on every page.
+var methodLogLevels = map[string]slog.Level{
+	"/slash.api.v1.AuthService/GetAuthStatus":            slog.LevelDebug,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceProfile": slog.LevelDebug,
+}
+
 type LoggerInterceptor struct {
 }
 
@@ -17,20 +26,25 @@ func NewLoggerInterceptor() *LoggerInterceptor {
 }
 
 func (in *LoggerInterceptor) LoggerInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	ctx, entry := withAccessLogEntry(ctx, getGRPCTraceID(ctx))
 	resp, err := handler(ctx, request)
-	in.loggerInterceptorDo(ctx, serverInfo.FullMethod, err)
+	in.loggerInterceptorDo(ctx, serverInfo.FullMethod, entry, time.Since(start), err)
 	return resp, err
 }
 
-func (*LoggerInterceptor) loggerInterceptorDo(ctx context.Context, fullMethod string, err error) {
+func (*LoggerInterceptor) loggerInterceptorDo(ctx context.Context, fullMethod string, entry *accessLogEntry, latency time.Duration, err error) {
 	st := status.Convert(err)
 	var logLevel slog.Level
 	var logMsg string
 	switch st.Code() {
 	case codes.OK:
 		logLevel = slog.LevelInfo
+		if level, ok := methodLogLevels[fullMethod]; ok {
+			logLevel = level
+		}
 		logMsg = "OK"
-	case codes.Unauthenticated, codes.OutOfRange, codes.PermissionDenied, codes.NotFound:
+	case codes.Unauthenticated, codes.OutOfRange, codes.PermissionDenied, codes.NotFound, codes.ResourceExhausted:
 		logLevel = slog.LevelInfo
 		logMsg = "client error"
 	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
@@ -40,9 +54,27 @@ func (*LoggerInterceptor) loggerInterceptorDo(ctx context.Context, fullMethod st
 		logLevel = slog.LevelError
 		logMsg = "unknown error"
 	}
-	logAttrs := []slog.Attr{slog.String("method", fullMethod)}
+	logAttrs := []slog.Attr{
+		slog.String("method", fullMethod),
+		slog.String("code", st.Code().String()),
+		slog.Duration("latency", latency),
+		slog.String("trace_id", entry.traceID),
+	}
+	if userID := entry.userID.Load(); userID != 0 {
+		logAttrs = append(logAttrs, slog.Int("user_id", int(userID)))
+	}
 	if err != nil {
 		logAttrs = append(logAttrs, slog.String("error", err.Error()))
 	}
 	slog.LogAttrs(ctx, logLevel, logMsg, logAttrs...)
 }
+
+// getGRPCTraceID returns the trace id forwarded by the gateway, or a new one for the direct gRPC requests.
+func getGRPCTraceID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && requestIDRegexp.MatchString(values[0]) {
+			return values[0]
+		}
+	}
+	return newTraceID()
+}
@@ -0,0 +1,401 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/warthurton/slash/internal/util"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// defaultPasskeyName is the name of the passkeys registered without a name.
+	defaultPasskeyName = "Passkey"
+	// passkeyChallengesPruneSize is the number of the used challenges over which the expired ones are pruned.
+	passkeyChallengesPruneSize = 10000
+)
+
+// passkeyChallenges are the challenges of the passkey ceremonies which were finished, until their ceremony expires,
+// so that a ceremony and its assertion can't be replayed. They're in memory, so each instance rejects the replays
+// of the ceremonies it finished.
+type passkeyChallenges struct {
+	mutex sync.Mutex
+	used  map[string]time.Time
+}
+
+func newPasskeyChallenges() *passkeyChallenges {
+	return &passkeyChallenges{
+		used: map[string]time.Time{},
+	}
+}
+
+// use marks the challenge as used until the expire time, and returns false if it was already used.
+func (c *passkeyChallenges) use(challenge string, expireTime, now time.Time) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if usedExpireTime, ok := c.used[challenge]; ok && now.Before(usedExpireTime) {
+		return false
+	}
+	if len(c.used) >= passkeyChallengesPruneSize {
+		for k, usedExpireTime := range c.used {
+			if !now.Before(usedExpireTime) {
+				delete(c.used, k)
+			}
+		}
+	}
+	c.used[challenge] = expireTime
+	return true
+}
+
+// passkeyUser adapts the user and their passkeys to webauthn.User.
+// The user handle is the user id, so that the discoverable passkeys resolve to the user when signing in.
+type passkeyUser struct {
+	user     *store.User
+	passkeys []*storepb.UserSetting_PasskeysSetting_Passkey
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return []byte(strconv.Itoa(int(u.user.ID)))
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.user.Email
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.user.Nickname
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := []webauthn.Credential{}
+	for _, passkey := range u.passkeys {
+		credentials = append(credentials, convertPasskeyToCredential(passkey))
+	}
+	return credentials
+}
+
+func (s *APIV1Service) BeginPasskeySignIn(ctx context.Context, _ *v1pb.BeginPasskeySignInRequest) (*v1pb.BeginPasskeySignInResponse, error) {
+	webAuthn, err := s.newWebAuthn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	assertion, session, err := webAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin passkey sign in: %v", err)
+	}
+	if err := s.setPasskeyCeremonyCookie(ctx, 0, session); err != nil {
+		return nil, err
+	}
+	options, err := json.Marshal(assertion)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal passkey sign in options: %v", err)
+	}
+	return &v1pb.BeginPasskeySignInResponse{
+		Options: string(options),
+	}, nil
+}
+
+func (s *APIV1Service) SignInWithPasskey(ctx context.Context, request *v1pb.SignInWithPasskeyRequest) (*v1pb.User, error) {
+	webAuthn, err := s.newWebAuthn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, session, err := s.usePasskeyCeremony(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parsedResponse, err := protocol.ParseCredentialRequestResponseBytes([]byte(request.Credential))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey credential: %v", err)
+	}
+
+	var user *store.User
+	var passkeys []*storepb.UserSetting_PasskeysSetting_Passkey
+	_, credential, err := webAuthn.ValidatePasskeyLogin(func(_, userHandle []byte) (webauthn.User, error) {
+		userID, err := util.ConvertStringToInt32(string(userHandle))
+		if err != nil {
+			return nil, errors.Wrap(err, "malformed user handle")
+		}
+		user, err = s.Store.GetUser(ctx, &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, errors.New("user not found")
+		}
+		passkeys, err = s.Store.GetUserPasskeys(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &passkeyUser{user: user, passkeys: passkeys}, nil
+	}, *session, parsedResponse)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to verify passkey: %v", err)
+	}
+	// A signature counter that goes backwards means that the authenticator may have been cloned.
+	if credential.Authenticator.CloneWarning {
+		return nil, status.Errorf(codes.PermissionDenied, "passkey may have been cloned, sign in with another method")
+	}
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.PermissionDenied, "user has been archived")
+	}
+
+	for _, passkey := range passkeys {
+		if !bytes.Equal(passkey.Id, credential.ID) {
+			continue
+		}
+		passkey.SignCount = credential.Authenticator.SignCount
+		passkey.BackupState = credential.Flags.BackupState
+		passkey.LastUsedTs = time.Now().Unix()
+		if err := s.Store.UpsertUserPasskey(ctx, user.ID, passkey); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update passkey: %v", err)
+		}
+	}
+	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
+	}
+	if err := s.clearPasskeyCeremonyCookie(ctx); err != nil {
+		return nil, err
+	}
+	return convertUserFromStore(user), nil
+}
+
+func (s *APIV1Service) BeginPasskeyRegistration(ctx context.Context, _ *v1pb.BeginPasskeyRegistrationRequest) (*v1pb.BeginPasskeyRegistrationResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	webAuthn, err := s.newWebAuthn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	passkeys, err := s.Store.GetUserPasskeys(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user passkeys: %v", err)
+	}
+	webAuthnUser := &passkeyUser{user: user, passkeys: passkeys}
+	creation, session, err := webAuthn.BeginRegistration(
+		webAuthnUser,
+		// Exclude the registered passkeys, so that the same authenticator isn't registered twice.
+		webauthn.WithExclusions(webauthn.Credentials(webAuthnUser.WebAuthnCredentials()).CredentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin passkey registration: %v", err)
+	}
+	if err := s.setPasskeyCeremonyCookie(ctx, user.ID, session); err != nil {
+		return nil, err
+	}
+	options, err := json.Marshal(creation)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal passkey registration options: %v", err)
+	}
+	return &v1pb.BeginPasskeyRegistrationResponse{
+		Options: string(options),
+	}, nil
+}
+
+func (s *APIV1Service) FinishPasskeyRegistration(ctx context.Context, request *v1pb.FinishPasskeyRegistrationRequest) (*v1pb.UserPasskey, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	webAuthn, err := s.newWebAuthn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, session, err := s.usePasskeyCeremony(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userID != user.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "the passkey registration was started by another user")
+	}
+	parsedResponse, err := protocol.ParseCredentialCreationResponseBytes([]byte(request.Credential))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey credential: %v", err)
+	}
+	passkeys, err := s.Store.GetUserPasskeys(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user passkeys: %v", err)
+	}
+	credential, err := webAuthn.CreateCredential(&passkeyUser{user: user, passkeys: passkeys}, *session, parsedResponse)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to verify passkey: %v", err)
+	}
+
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		name = defaultPasskeyName
+	}
+	passkey := convertPasskeyFromCredential(credential)
+	passkey.Name = name
+	passkey.CreatedTs = time.Now().Unix()
+	if err := s.Store.UpsertUserPasskey(ctx, user.ID, passkey); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save passkey: %v", err)
+	}
+	if err := s.clearPasskeyCeremonyCookie(ctx); err != nil {
+		return nil, err
+	}
+	return convertUserPasskeyFromStore(passkey), nil
+}
+
+// newWebAuthn creates the WebAuthn relying party of the instance url, since the passkeys are bound to its domain.
+func (s *APIV1Service) newWebAuthn(ctx context.Context) (*webauthn.WebAuthn, error) {
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	if generalSetting.InstanceUrl == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "passkeys require the instance url in the workspace settings")
+	}
+	instanceURL, err := url.Parse(generalSetting.InstanceUrl)
+	if err != nil || instanceURL.Hostname() == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid instance url %q", generalSetting.InstanceUrl)
+	}
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          instanceURL.Hostname(),
+		RPDisplayName: "Slash",
+		RPOrigins:     []string{instanceURL.Scheme + "://" + instanceURL.Host},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to create passkey relying party: %v", err)
+	}
+	return webAuthn, nil
+}
+
+// setPasskeyCeremonyCookie keeps the WebAuthn session in a signed cookie until the ceremony is finished.
+func (s *APIV1Service) setPasskeyCeremonyCookie(ctx context.Context, userID int32, session *webauthn.SessionData) error {
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal passkey session: %v", err)
+	}
+	expireTime := time.Now().Add(PasskeyCeremonyDuration)
+	ceremonyToken, err := generatePasskeyCeremonyToken(userID, string(sessionJSON), expireTime, []byte(s.Secret))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to generate passkey ceremony token: %v", err)
+	}
+	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
+		"Set-Cookie": s.buildCookie(PasskeyCeremonyCookieName, ceremonyToken, expireTime.Format(time.RFC1123)),
+	})); err != nil {
+		return status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+	}
+	return nil
+}
+
+// usePasskeyCeremony returns the user id and the WebAuthn session of the pending passkey ceremony, and marks its
+// challenge as used, so that the ceremony can only be finished once, even if it fails.
+func (s *APIV1Service) usePasskeyCeremony(ctx context.Context) (int32, *webauthn.SessionData, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil, status.Errorf(codes.InvalidArgument, "failed to parse metadata from incoming context")
+	}
+	ceremonyToken := getCookieFromMetadata(md, PasskeyCeremonyCookieName)
+	if ceremonyToken == "" {
+		return 0, nil, status.Errorf(codes.FailedPrecondition, "no pending passkey ceremony, try again")
+	}
+	claims, err := parsePasskeyCeremonyToken(ceremonyToken, []byte(s.Secret))
+	if err != nil {
+		return 0, nil, status.Errorf(codes.FailedPrecondition, "invalid or expired passkey ceremony, try again")
+	}
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.FailedPrecondition, "malformed passkey ceremony")
+	}
+	session := &webauthn.SessionData{}
+	if err := json.Unmarshal([]byte(claims.Session), session); err != nil {
+		return 0, nil, status.Errorf(codes.FailedPrecondition, "malformed passkey ceremony")
+	}
+	// The ceremony expires within its duration, until when its challenge is remembered.
+	now := time.Now()
+	if !s.passkeyChallenges.use(session.Challenge, now.Add(PasskeyCeremonyDuration), now) {
+		return 0, nil, status.Errorf(codes.FailedPrecondition, "the passkey ceremony was already finished, try again")
+	}
+	return userID, session, nil
+}
+
+func (s *APIV1Service) clearPasskeyCeremonyCookie(ctx context.Context) error {
+	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
+		"Set-Cookie": s.buildCookie(PasskeyCeremonyCookieName, "", "Thu, 01 Jan 1970 00:00:00 GMT"),
+	})); err != nil {
+		return status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+	}
+	return nil
+}
+
+func convertPasskeyFromCredential(credential *webauthn.Credential) *storepb.UserSetting_PasskeysSetting_Passkey {
+	transports := []string{}
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+	return &storepb.UserSetting_PasskeysSetting_Passkey{
+		Id:              credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports:      transports,
+		UserPresent:     credential.Flags.UserPresent,
+		UserVerified:    credential.Flags.UserVerified,
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+		Aaguid:          credential.Authenticator.AAGUID,
+		SignCount:       credential.Authenticator.SignCount,
+		Attachment:      string(credential.Authenticator.Attachment),
+	}
+}
+
+func convertPasskeyToCredential(passkey *storepb.UserSetting_PasskeysSetting_Passkey) webauthn.Credential {
+	transports := []protocol.AuthenticatorTransport{}
+	for _, transport := range passkey.Transports {
+		transports = append(transports, protocol.AuthenticatorTransport(transport))
+	}
+	return webauthn.Credential{
+		ID:              passkey.Id,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		Transport:       transports,
+		Flags: webauthn.CredentialFlags{
+			UserPresent:    passkey.UserPresent,
+			UserVerified:   passkey.UserVerified,
+			BackupEligible: passkey.BackupEligible,
+			BackupState:    passkey.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:     passkey.Aaguid,
+			SignCount:  passkey.SignCount,
+			Attachment: protocol.AuthenticatorAttachment(passkey.Attachment),
+		},
+	}
+}
+
+func convertUserPasskeyFromStore(passkey *storepb.UserSetting_PasskeysSetting_Passkey) *v1pb.UserPasskey {
+	userPasskey := &v1pb.UserPasskey{
+		Id:          base64.RawURLEncoding.EncodeToString(passkey.Id),
+		Name:        passkey.Name,
+		CreatedTime: timestamppb.New(time.Unix(passkey.CreatedTs, 0)),
+	}
+	if passkey.LastUsedTs > 0 {
+		userPasskey.LastUsedTime = timestamppb.New(time.Unix(passkey.LastUsedTs, 0))
+	}
+	return userPasskey
+}
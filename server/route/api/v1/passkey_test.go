@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasskeyChallenges(t *testing.T) {
+	challenges := newPasskeyChallenges()
+	now := time.Now()
+	expireTime := now.Add(PasskeyCeremonyDuration)
+
+	// A ceremony can only be finished once until it expires.
+	require.True(t, challenges.use("challenge", expireTime, now))
+	require.False(t, challenges.use("challenge", expireTime, now.Add(time.Minute)))
+	require.True(t, challenges.use("another-challenge", expireTime, now))
+	require.True(t, challenges.use("challenge", expireTime.Add(PasskeyCeremonyDuration), expireTime))
+}
@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+const (
+	// authAttemptWindow is the window of the attempts limit per IP.
+	authAttemptWindow = time.Minute
+	// authLockoutBaseDuration is the lockout duration of an account from an IP once it reaches the failures threshold,
+	// which doubles with each further failure.
+	authLockoutBaseDuration = time.Minute
+	// authLockoutMaxDuration is the max lockout duration of an account from an IP, after which its failures are also forgotten.
+	authLockoutMaxDuration = time.Hour
+	// authRateLimitPruneSize is the number of the tracked IPs or accounts from IPs over which the stale ones are pruned.
+	authRateLimitPruneSize = 10000
+)
+
+// authRateLimitedMethods are the methods signing in or up, linking an identity provider, verifying the email,
+// or starting a device authorization, which are rate limited.
+var authRateLimitedMethods = map[string]bool{
+	"/slash.api.v1.AuthService/SignIn":                    true,
+	"/slash.api.v1.AuthService/SignUp":                    true,
+	"/slash.api.v1.AuthService/SignInWithSSO":             true,
+	"/slash.api.v1.AuthService/SignInWithLDAP":            true,
+	"/slash.api.v1.AuthService/SignInWithPasskey":         true,
+	"/slash.api.v1.AuthService/LinkIdentityProvider":      true,
+	"/slash.api.v1.AuthService/VerifyEmail":               true,
+	"/slash.api.v1.AuthService/SendVerificationEmail":     true,
+	"/slash.api.v1.AuthService/CreateDeviceAuthorization": true,
+}
+
+type authAttempts struct {
+	windowStart time.Time
+	count       int
+}
+
+type authFailures struct {
+	count       int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+// RateLimitInterceptor limits the attempts to sign in or up per IP, and locks out the accounts with repeated failures
+// from an IP. The lockout is per IP, so a client can't lock the other users out of their accounts.
+// The counters are in memory, so each instance limits the requests it serves.
+type RateLimitInterceptor struct {
+	// attemptLimit is the max attempts per minute from an IP. 0 means unlimited.
+	attemptLimit int
+	// lockoutThreshold is the number of consecutive failures after which an account is locked out from an IP. 0 means never.
+	lockoutThreshold int
+	// trustedProxies are the proxies whose X-Forwarded-For header is trusted for the IP of the client.
+	trustedProxies []netip.Prefix
+	// secret verifies the identity provider link of the account LinkIdentityProvider checks the password of.
+	secret string
+
+	mutex    sync.Mutex
+	attempts map[string]*authAttempts
+	failures map[string]*authFailures
+}
+
+func NewRateLimitInterceptor(attemptLimit, lockoutThreshold int, trustedProxies []netip.Prefix, secret string) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		attemptLimit:     attemptLimit,
+		lockoutThreshold: lockoutThreshold,
+		trustedProxies:   trustedProxies,
+		secret:           secret,
+		attempts:         map[string]*authAttempts{},
+		failures:         map[string]*authFailures{},
+	}
+}
+
+func (in *RateLimitInterceptor) RateLimitInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !authRateLimitedMethods[serverInfo.FullMethod] {
+		return handler(ctx, request)
+	}
+	_, ip := getClientInfo(ctx, in.trustedProxies)
+	account := in.getAuthAccount(ctx, request)
+	if account != "" {
+		account += " from " + ip
+	}
+	if err := in.checkAttempt(ip, account, time.Now()); err != nil {
+		return nil, err
+	}
+
+	resp, err := handler(ctx, request)
+	if account != "" {
+		switch status.Code(err) {
+		case codes.OK:
+			in.resetFailures(account)
+		case codes.InvalidArgument, codes.Unauthenticated, codes.PermissionDenied, codes.NotFound:
+			in.recordFailure(account, time.Now())
+		}
+	}
+	return resp, err
+}
+
+// checkAttempt counts the attempt from the IP, and rejects it if the IP is over the limit or the account is locked out from the IP.
+func (in *RateLimitInterceptor) checkAttempt(ip, account string, now time.Time) error {
+	in.mutex.Lock()
+	defer in.mutex.Unlock()
+
+	if account != "" {
+		if failures, ok := in.failures[account]; ok && now.Before(failures.lockedUntil) {
+			return status.Errorf(codes.ResourceExhausted, "too many failed attempts, try again in %s", failures.lockedUntil.Sub(now).Round(time.Second))
+		}
+	}
+	if in.attemptLimit <= 0 || ip == "" {
+		return nil
+	}
+	attempts, ok := in.attempts[ip]
+	if !ok || now.Sub(attempts.windowStart) >= authAttemptWindow {
+		if len(in.attempts) >= authRateLimitPruneSize {
+			for k, v := range in.attempts {
+				if now.Sub(v.windowStart) >= authAttemptWindow {
+					delete(in.attempts, k)
+				}
+			}
+		}
+		attempts = &authAttempts{
+			windowStart: now,
+		}
+		in.attempts[ip] = attempts
+	}
+	if attempts.count >= in.attemptLimit {
+		return status.Errorf(codes.ResourceExhausted, "too many attempts, try again in %s", attempts.windowStart.Add(authAttemptWindow).Sub(now).Round(time.Second))
+	}
+	attempts.count++
+	return nil
+}
+
+// recordFailure counts the failure of the account from an IP, and locks it out with an exponential backoff once it reaches the threshold.
+func (in *RateLimitInterceptor) recordFailure(account string, now time.Time) {
+	if in.lockoutThreshold <= 0 {
+		return
+	}
+	in.mutex.Lock()
+	defer in.mutex.Unlock()
+
+	failures, ok := in.failures[account]
+	if !ok || now.Sub(failures.lastFailure) >= authLockoutMaxDuration {
+		if len(in.failures) >= authRateLimitPruneSize {
+			for k, v := range in.failures {
+				if now.Sub(v.lastFailure) >= authLockoutMaxDuration && !now.Before(v.lockedUntil) {
+					delete(in.failures, k)
+				}
+			}
+		}
+		failures = &authFailures{}
+		in.failures[account] = failures
+	}
+	failures.count++
+	failures.lastFailure = now
+	if failures.count >= in.lockoutThreshold {
+		lockoutDuration := authLockoutMaxDuration
+		// Avoid overflowing the shift, the duration is capped long before.
+		if exponent := failures.count - in.lockoutThreshold; exponent < 16 {
+			lockoutDuration = min(authLockoutBaseDuration<<exponent, authLockoutMaxDuration)
+		}
+		failures.lockedUntil = now.Add(lockoutDuration)
+	}
+}
+
+func (in *RateLimitInterceptor) resetFailures(account string) {
+	in.mutex.Lock()
+	defer in.mutex.Unlock()
+
+	delete(in.failures, account)
+}
+
+// getAuthAccount returns the account the request signs in or up, or links an identity provider to, or empty
+// if it's not known before handling the request.
+func (in *RateLimitInterceptor) getAuthAccount(ctx context.Context, request any) string {
+	switch request := request.(type) {
+	case *v1pb.SignInRequest:
+		if request.Email != "" {
+			return "email:" + strings.ToLower(request.Email)
+		}
+	case *v1pb.SignUpRequest:
+		if request.Email != "" {
+			return "email:" + strings.ToLower(request.Email)
+		}
+	case *v1pb.SignInWithLDAPRequest:
+		if request.Username != "" {
+			return "ldap:" + request.IdpId + ":" + strings.ToLower(request.Username)
+		}
+	case *v1pb.LinkIdentityProviderRequest:
+		// The account is the one of the pending link, whose password is checked.
+		md, _ := metadata.FromIncomingContext(ctx)
+		if claims, err := parseIdentityProviderLinkToken(getCookieFromMetadata(md, IdentityProviderLinkCookieName), []byte(in.secret)); err == nil {
+			return "user:" + claims.Subject
+		}
+	}
+	return ""
+}
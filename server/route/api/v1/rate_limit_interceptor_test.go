@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+func TestRateLimitInterceptorLinkIdentityProvider(t *testing.T) {
+	in := NewRateLimitInterceptor(0, 2, nil, testSecret)
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "/slash.api.v1.AuthService/LinkIdentityProvider"}
+	link := func(userID int32) error {
+		linkToken, err := generateIdentityProviderLinkToken(userID, "sso", "user@test.com", time.Now().Add(IdentityProviderLinkDuration), []byte(testSecret))
+		require.NoError(t, err)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("cookie", IdentityProviderLinkCookieName+"="+linkToken))
+		_, err = in.RateLimitInterceptor(ctx, &v1pb.LinkIdentityProviderRequest{Password: "wrong"}, serverInfo, func(context.Context, any) (any, error) {
+			return nil, status.Errorf(codes.InvalidArgument, "incorrect password")
+		})
+		return err
+	}
+
+	// The wrong passwords lock out the account of the pending link.
+	require.Equal(t, codes.InvalidArgument, status.Code(link(1)))
+	require.Equal(t, codes.InvalidArgument, status.Code(link(1)))
+	require.Equal(t, codes.ResourceExhausted, status.Code(link(1)))
+	require.Equal(t, codes.InvalidArgument, status.Code(link(2)))
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	type step struct {
+		method string
+		ip     string
+		email  string
+		// result is the code the handler returns.
+		result codes.Code
+		want   codes.Code
+	}
+	signIn := "/slash.api.v1.AuthService/SignIn"
+	tests := []struct {
+		name             string
+		attemptLimit     int
+		lockoutThreshold int
+		steps            []step
+	}{
+		{
+			name:         "attempts limited per IP",
+			attemptLimit: 2,
+			steps: []step{
+				{signIn, "1.1.1.1", "a@test.com", codes.OK, codes.OK},
+				{signIn, "1.1.1.1", "b@test.com", codes.OK, codes.OK},
+				{signIn, "1.1.1.1", "c@test.com", codes.OK, codes.ResourceExhausted},
+				{signIn, "2.2.2.2", "c@test.com", codes.OK, codes.OK},
+				// The other methods aren't limited.
+				{"/slash.api.v1.ShortcutService/ListShortcuts", "1.1.1.1", "", codes.OK, codes.OK},
+			},
+		},
+		{
+			name:             "account locked out from an IP",
+			lockoutThreshold: 2,
+			steps: []step{
+				{signIn, "1.1.1.1", "a@test.com", codes.InvalidArgument, codes.InvalidArgument},
+				{signIn, "1.1.1.1", "A@test.com", codes.InvalidArgument, codes.InvalidArgument},
+				{signIn, "1.1.1.1", "a@test.com", codes.OK, codes.ResourceExhausted},
+				// The lockout is per IP and per account.
+				{signIn, "2.2.2.2", "a@test.com", codes.OK, codes.OK},
+				{signIn, "1.1.1.1", "b@test.com", codes.OK, codes.OK},
+			},
+		},
+		{
+			name:             "success resets the failures",
+			lockoutThreshold: 2,
+			steps: []step{
+				{signIn, "1.1.1.1", "a@test.com", codes.InvalidArgument, codes.InvalidArgument},
+				{signIn, "1.1.1.1", "a@test.com", codes.OK, codes.OK},
+				{signIn, "1.1.1.1", "a@test.com", codes.InvalidArgument, codes.InvalidArgument},
+				{signIn, "1.1.1.1", "a@test.com", codes.OK, codes.OK},
+			},
+		},
+		{
+			name:             "internal errors aren't failures",
+			lockoutThreshold: 1,
+			steps: []step{
+				{signIn, "1.1.1.1", "a@test.com", codes.Internal, codes.Internal},
+				{signIn, "1.1.1.1", "a@test.com", codes.OK, codes.OK},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := NewRateLimitInterceptor(test.attemptLimit, test.lockoutThreshold, nil, testSecret)
+			for i, step := range test.steps {
+				ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(step.ip), Port: 1234}})
+				_, err := in.RateLimitInterceptor(ctx, &v1pb.SignInRequest{Email: step.email}, &grpc.UnaryServerInfo{FullMethod: step.method}, func(context.Context, any) (any, error) {
+					if step.result == codes.OK {
+						return nil, nil
+					}
+					return nil, status.Errorf(step.result, "error")
+				})
+				require.Equal(t, step.want, status.Code(err), "step %d", i)
+			}
+		})
+	}
+}
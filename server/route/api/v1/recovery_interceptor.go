@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RecoveryInterceptor struct {
+}
+
+func NewRecoveryInterceptor() *RecoveryInterceptor {
+	return &RecoveryInterceptor{}
+}
+
+// RecoveryInterceptor recovers from panics in the handler and converts them into internal errors,
+// so that a single bad request cannot crash the whole process.
+func (*RecoveryInterceptor) RecoveryInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "panic recovered",
+				slog.String("method", serverInfo.FullMethod),
+				slog.String("panic", fmt.Sprint(r)),
+				slog.String("stack", string(debug.Stack())),
+			)
+			resp, err = nil, status.Errorf(codes.Internal, "internal server error")
+		}
+	}()
+	return handler(ctx, request)
+}
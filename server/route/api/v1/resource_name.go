@@ -2,4 +2,6 @@ package v1
 
 const (
 	UserNamePrefix = "users/"
+	// PersonalNamespacePrefix is the prefix of shortcut names in personal namespaces, e.g. "~username/name".
+	PersonalNamespacePrefix = "~"
 )
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/warthurton/slash/plugin/idp/saml"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/service/license"
+	"github.com/warthurton/slash/store"
+)
+
+// registerSAMLRoutes registers the endpoints of Slash as the SAML service provider.
+// The sign in starts from the login endpoint, and the identity provider posts the response to the assertion consumer service,
+// which passes the validated user information to the auth callback page as a short-lived code for SignInWithSSO.
+func (s *APIV1Service) registerSAMLRoutes(e *echo.Echo) {
+	e.GET("/api/v1/saml/:idpId/metadata", func(c echo.Context) error {
+		identityProvider, err := s.getSAMLIdentityProvider(c.Request().Context(), c.Param("idpId"))
+		if err != nil {
+			return err
+		}
+		metadata, err := identityProvider.Metadata()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get metadata, err: %s", err))
+		}
+		return c.Blob(http.StatusOK, echo.MIMEApplicationXMLCharsetUTF8, metadata)
+	})
+
+	e.GET("/api/v1/saml/:idpId/login", func(c echo.Context) error {
+		if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+			return echo.NewHTTPError(http.StatusForbidden, "SSO is not available in the current plan")
+		}
+		idpID := c.Param("idpId")
+		identityProvider, err := s.getSAMLIdentityProvider(c.Request().Context(), idpID)
+		if err != nil {
+			return err
+		}
+		redirectURL, requestID, err := identityProvider.AuthnRequestURL(idpID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to make authentication request, err: %s", err))
+		}
+		expireTime := time.Now().Add(SAMLRequestDuration)
+		c.Response().Header().Add(echo.HeaderSetCookie, s.buildSAMLRequestCookie(requestID, expireTime.Format(time.RFC1123)))
+		return c.Redirect(http.StatusFound, redirectURL)
+	})
+
+	e.POST("/api/v1/saml/:idpId/acs", func(c echo.Context) error {
+		if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+			return echo.NewHTTPError(http.StatusForbidden, "SSO is not available in the current plan")
+		}
+		idpID := c.Param("idpId")
+		identityProvider, err := s.getSAMLIdentityProvider(c.Request().Context(), idpID)
+		if err != nil {
+			return err
+		}
+		// Only the responses to the authentication requests started by this browser are accepted.
+		requestCookie, err := c.Cookie(SAMLRequestCookieName)
+		if err != nil || requestCookie.Value == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "the SAML sign in is not started from Slash or has expired")
+		}
+		userInfo, err := identityProvider.UserInfo(c.FormValue("SAMLResponse"), []string{requestCookie.Value})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		code, err := generateSAMLAssertionToken(idpID, userInfo, time.Now().Add(SAMLAssertionDuration), []byte(s.Secret))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to generate SAML assertion token, err: %s", err))
+		}
+		c.Response().Header().Add(echo.HeaderSetCookie, s.buildSAMLRequestCookie("", "Thu, 01 Jan 1970 00:00:00 GMT"))
+		return c.Redirect(http.StatusSeeOther, s.Profile.BasePath+"/auth/callback?"+url.Values{"state": {idpID}, "code": {code}}.Encode())
+	})
+}
+
+// buildSAMLRequestCookie builds the Set-Cookie header value of the pending SAML authentication request id.
+// Unlike the other cookies, it must be sent with the cross-site POST of the identity provider, so it's always SameSite=None and Secure.
+func (s *APIV1Service) buildSAMLRequestCookie(requestID, expires string) string {
+	attributes := []string{
+		fmt.Sprintf("%s=%s", SAMLRequestCookieName, requestID),
+		fmt.Sprintf("Path=%s/api/v1/saml", s.Profile.BasePath),
+		fmt.Sprintf("Expires=%s", expires),
+		"HttpOnly",
+		"Secure",
+		"SameSite=None",
+	}
+	if s.Profile.CookieDomain != "" {
+		attributes = append(attributes, fmt.Sprintf("Domain=%s", s.Profile.CookieDomain))
+	}
+	return strings.Join(attributes, "; ")
+}
+
+// getSAMLIdentityProvider returns the SAML identity provider of the id in the workspace settings.
+// The urls of Slash as the service provider are derived from the instance url.
+func (s *APIV1Service) getSAMLIdentityProvider(ctx context.Context, idpID string) (*saml.IdentityProvider, error) {
+	identityProviderSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err))
+	}
+	var identityProvider *storepb.IdentityProvider
+	for _, idp := range identityProviderSetting.GetIdentityProvider().GetIdentityProviders() {
+		if idp.Id == idpID && idp.Type == storepb.IdentityProvider_SAML {
+			identityProvider = idp
+			break
+		}
+	}
+	if identityProvider == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "identity provider not found")
+	}
+
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace general setting, err: %s", err))
+	}
+	if generalSetting.InstanceUrl == "" {
+		return nil, echo.NewHTTPError(http.StatusPreconditionFailed, "SAML requires the instance url in the workspace settings")
+	}
+	entityID, acsURL := getSAMLServiceProviderURLs(generalSetting.InstanceUrl, idpID)
+	samlIdentityProvider, err := saml.NewIdentityProvider(identityProvider.Config.GetSaml(), entityID, acsURL)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusPreconditionFailed, fmt.Sprintf("invalid SAML identity provider, err: %s", err))
+	}
+	return samlIdentityProvider, nil
+}
+
+// getSAMLServiceProviderURLs returns the entity id and the assertion consumer service url of Slash
+// as the service provider of the identity provider.
+func getSAMLServiceProviderURLs(instanceURL, idpID string) (string, string) {
+	baseURL := fmt.Sprintf("%s/api/v1/saml/%s", instanceURL, url.PathEscape(idpID))
+	return baseURL + "/metadata", baseURL + "/acs"
+}
@@ -0,0 +1,326 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	// maxSearchShortcutCandidates is the max number of shortcuts, ranked by the store, which are ranked again
+	// among the other results.
+	maxSearchShortcutCandidates = 200
+)
+
+// The weights of the fields in the scores of the results.
+const (
+	searchWeightName        = 4
+	searchWeightTitle       = 3
+	searchWeightTag         = 2
+	searchWeightDescription = 1
+	searchWeightLink        = 1
+	// searchBonusExactName and searchBonusNamePrefix rank first the results named like the query.
+	searchBonusExactName  = 10
+	searchBonusNamePrefix = 5
+)
+
+// searchField is a field of a result matched against the search terms.
+type searchField struct {
+	name   string
+	value  string
+	weight float64
+}
+
+func (s *APIV1Service) Search(ctx context.Context, request *v1pb.SearchRequest) (*v1pb.SearchResponse, error) {
+	limit := int(request.Limit)
+	if limit < 0 || limit > maxSearchLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", maxSearchLimit)
+	}
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	types := map[v1pb.SearchResult_Type]bool{}
+	for _, resultType := range request.Types {
+		if resultType == v1pb.SearchResult_TYPE_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "unspecified result type")
+		}
+		types[resultType] = true
+	}
+	includes := func(resultType v1pb.SearchResult_Type) bool {
+		return len(types) == 0 || types[resultType]
+	}
+	response := &v1pb.SearchResponse{
+		Results: []*v1pb.SearchResult{},
+	}
+	terms := store.SplitSearchTerms(request.Query)
+	if len(terms) == 0 {
+		return response, nil
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	viewerID := getViewerID(user)
+
+	if includes(v1pb.SearchResult_SHORTCUT) {
+		results, err := s.searchShortcuts(ctx, viewerID, request.Query, terms)
+		if err != nil {
+			return nil, err
+		}
+		response.Results = append(response.Results, results...)
+	}
+	// A scoped access token only gets the collections when it's allowed to read them.
+	scopes, _ := ctx.Value(accessTokenScopesContextKey).([]string)
+	if includes(v1pb.SearchResult_COLLECTION) && hasAccessTokenScope(scopes, AccessTokenScopeCollectionsRead) {
+		results, err := s.searchCollections(ctx, viewerID, terms)
+		if err != nil {
+			return nil, err
+		}
+		response.Results = append(response.Results, results...)
+	}
+	if includes(v1pb.SearchResult_TAG) {
+		results, err := s.searchTags(ctx, viewerID, terms)
+		if err != nil {
+			return nil, err
+		}
+		response.Results = append(response.Results, results...)
+	}
+
+	// The ties are broken by type, then by name, so the order is stable.
+	slices.SortStableFunc(response.Results, func(a, b *v1pb.SearchResult) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(getSearchResultName(a), getSearchResultName(b)),
+		)
+	})
+	if len(response.Results) > limit {
+		response.Results = response.Results[:limit]
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) searchShortcuts(ctx context.Context, viewerID *int32, query string, terms []string) ([]*v1pb.SearchResult, error) {
+	limit := maxSearchShortcutCandidates
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		Search:   &query,
+		Limit:    &limit,
+		ViewerID: viewerID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search shortcuts, err: %v", err)
+	}
+	results := []*v1pb.SearchResult{}
+	for _, shortcut := range shortcuts {
+		// The converted shortcut is matched, so that the hidden links of the scheduled shortcuts aren't.
+		convertedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		score, highlights := matchSearchFields(terms, []searchField{
+			{name: "name", value: convertedShortcut.Name, weight: searchWeightName},
+			{name: "title", value: convertedShortcut.Title, weight: searchWeightTitle},
+			{name: "tags", value: strings.Join(convertedShortcut.Tags, " "), weight: searchWeightTag},
+			{name: "description", value: convertedShortcut.Description, weight: searchWeightDescription},
+			{name: "link", value: convertedShortcut.Link, weight: searchWeightLink},
+		})
+		if score == 0 {
+			continue
+		}
+		results = append(results, &v1pb.SearchResult{
+			Type:       v1pb.SearchResult_SHORTCUT,
+			Score:      score + getSearchNameBonus(terms, convertedShortcut.Name),
+			Highlights: highlights,
+			Item:       &v1pb.SearchResult_Shortcut{Shortcut: convertedShortcut},
+		})
+	}
+	return results, nil
+}
+
+func (s *APIV1Service) searchCollections(ctx context.Context, viewerID *int32, terms []string) ([]*v1pb.SearchResult, error) {
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		ViewerID: viewerID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection list, err: %v", err)
+	}
+	results := []*v1pb.SearchResult{}
+	for _, collection := range collections {
+		score, highlights := matchSearchFields(terms, []searchField{
+			{name: "name", value: collection.Name, weight: searchWeightName},
+			{name: "title", value: collection.Title, weight: searchWeightTitle},
+			{name: "description", value: collection.Description, weight: searchWeightDescription},
+		})
+		if score == 0 {
+			continue
+		}
+		convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert collection, err: %v", err)
+		}
+		results = append(results, &v1pb.SearchResult{
+			Type:       v1pb.SearchResult_COLLECTION,
+			Score:      score + getSearchNameBonus(terms, collection.Name),
+			Highlights: highlights,
+			Item:       &v1pb.SearchResult_Collection{Collection: convertedCollection},
+		})
+	}
+	return results, nil
+}
+
+func (s *APIV1Service) searchTags(ctx context.Context, viewerID *int32, terms []string) ([]*v1pb.SearchResult, error) {
+	// The shortcuts with a tag containing the first term include all the ones with a matching tag.
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		Tag:      &terms[0],
+		ViewerID: viewerID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+	}
+	shortcutCounts := map[string]int32{}
+	for _, shortcut := range shortcuts {
+		for _, tag := range shortcut.Tags {
+			shortcutCounts[tag]++
+		}
+	}
+	results := []*v1pb.SearchResult{}
+	for tag, shortcutCount := range shortcutCounts {
+		score, highlights := matchSearchFields(terms, []searchField{
+			{name: "name", value: tag, weight: searchWeightName},
+		})
+		if score == 0 {
+			continue
+		}
+		results = append(results, &v1pb.SearchResult{
+			Type:       v1pb.SearchResult_TAG,
+			Score:      score + getSearchNameBonus(terms, tag),
+			Highlights: highlights,
+			Item: &v1pb.SearchResult_Tag_{Tag: &v1pb.SearchResult_Tag{
+				Name:          tag,
+				ShortcutCount: shortcutCount,
+			}},
+		})
+	}
+	return results, nil
+}
+
+// matchSearchFields returns the score of the fields, i.e. the sum of the best weight of the fields matching
+// each term, with the highlights of the matching fields. The score is 0 unless all the terms match.
+func matchSearchFields(terms []string, fields []searchField) (float64, []*v1pb.SearchResult_Highlight) {
+	var score float64
+	for _, term := range terms {
+		var bestWeight float64
+		for _, field := range fields {
+			if field.weight > bestWeight && len(findSearchTermMatches(field.value, []string{term})) > 0 {
+				bestWeight = field.weight
+			}
+		}
+		if bestWeight == 0 {
+			return 0, nil
+		}
+		score += bestWeight
+	}
+
+	highlights := []*v1pb.SearchResult_Highlight{}
+	for _, field := range fields {
+		matches := findSearchTermMatches(field.value, terms)
+		if len(matches) == 0 {
+			continue
+		}
+		highlight := &v1pb.SearchResult_Highlight{
+			Field: field.name,
+		}
+		value, start := []rune(field.value), 0
+		for _, match := range matches {
+			if match[0] > start {
+				highlight.Segments = append(highlight.Segments, &v1pb.SearchResult_Segment{Text: string(value[start:match[0]])})
+			}
+			highlight.Segments = append(highlight.Segments, &v1pb.SearchResult_Segment{Text: string(value[match[0]:match[1]]), Matched: true})
+			start = match[1]
+		}
+		if start < len(value) {
+			highlight.Segments = append(highlight.Segments, &v1pb.SearchResult_Segment{Text: string(value[start:])})
+		}
+		highlights = append(highlights, highlight)
+	}
+	return score, highlights
+}
+
+// findSearchTermMatches returns the rune ranges of the words of the value starting with one of the terms,
+// limited to the longest matching term. The words are split like the search terms.
+func findSearchTermMatches(value string, terms []string) [][2]int {
+	matches := [][2]int{}
+	runes := []rune(value)
+	for start := 0; start < len(runes); {
+		if !isSearchTermRune(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && isSearchTermRune(runes[end]) {
+			end++
+		}
+		length := 0
+		for _, term := range terms {
+			if termRunes := []rune(term); len(termRunes) > length && hasSearchTermPrefix(runes[start:end], termRunes) {
+				length = len(termRunes)
+			}
+		}
+		if length > 0 {
+			matches = append(matches, [2]int{start, start + length})
+		}
+		start = end
+	}
+	return matches
+}
+
+func isSearchTermRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func hasSearchTermPrefix(word, term []rune) bool {
+	if len(term) > len(word) {
+		return false
+	}
+	for i, r := range term {
+		if unicode.ToLower(word[i]) != r {
+			return false
+		}
+	}
+	return true
+}
+
+// getSearchNameBonus returns the bonus of a result named like the query, or starting like it.
+func getSearchNameBonus(terms []string, name string) float64 {
+	query, nameTerms := strings.Join(terms, " "), strings.Join(store.SplitSearchTerms(name), " ")
+	if nameTerms == query {
+		return searchBonusExactName
+	}
+	if strings.HasPrefix(nameTerms, query) {
+		return searchBonusNamePrefix
+	}
+	return 0
+}
+
+func getSearchResultName(result *v1pb.SearchResult) string {
+	switch item := result.Item.(type) {
+	case *v1pb.SearchResult_Shortcut:
+		return item.Shortcut.Name
+	case *v1pb.SearchResult_Collection:
+		return item.Collection.Name
+	case *v1pb.SearchResult_Tag_:
+		return item.Tag.Name
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func (s *APIV1Service) ListShortcutACLs(ctx context.Context, request *v1pb.ListShortcutACLsRequest) (*v1pb.ListShortcutACLsResponse, error) {
+	_, shortcut, err := s.checkShortcutOwnerOrAdmin(ctx, request.ShortcutId)
+	if err != nil {
+		return nil, err
+	}
+	shortcutACLs, err := s.Store.ListShortcutACLs(ctx, &store.FindShortcutACL{
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut acls: %v", err)
+	}
+	response := &v1pb.ListShortcutACLsResponse{
+		Acls: []*v1pb.ShortcutACL{},
+	}
+	for _, shortcutACL := range shortcutACLs {
+		response.Acls = append(response.Acls, convertShortcutACLFromStore(shortcutACL))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) UpsertShortcutACL(ctx context.Context, request *v1pb.UpsertShortcutACLRequest) (*v1pb.ShortcutACL, error) {
+	acl := request.Acl
+	if acl == nil || acl.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user id is required")
+	}
+	role, ok := map[v1pb.ShortcutACL_Role]store.ShortcutACLRole{
+		v1pb.ShortcutACL_READ: store.ShortcutACLRoleRead,
+		v1pb.ShortcutACL_EDIT: store.ShortcutACLRoleEdit,
+	}[acl.Role]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role %s", acl.Role)
+	}
+	_, shortcut, err := s.checkShortcutOwnerOrAdmin(ctx, request.ShortcutId)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &acl.UserId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.ID == shortcut.CreatorId {
+		return nil, status.Errorf(codes.InvalidArgument, "the shortcut can't be shared with its creator")
+	}
+	shortcutACL, err := s.Store.UpsertShortcutACL(ctx, &store.ShortcutACL{
+		ShortcutID: shortcut.Id,
+		UserID:     user.ID,
+		Role:       role,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert shortcut acl: %v", err)
+	}
+	return convertShortcutACLFromStore(shortcutACL), nil
+}
+
+func (s *APIV1Service) DeleteShortcutACL(ctx context.Context, request *v1pb.DeleteShortcutACLRequest) (*emptypb.Empty, error) {
+	_, shortcut, err := s.checkShortcutOwnerOrAdmin(ctx, request.ShortcutId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteShortcutACL(ctx, &store.DeleteShortcutACL{
+		ShortcutID: shortcut.Id,
+		UserID:     request.UserId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete shortcut acl: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkShortcutOwnerOrAdmin checks that the current user is the creator of the shortcut or an admin,
+// who manage its ACLs, analytics share links and rotations, and returns the current user and the shortcut.
+func (s *APIV1Service) checkShortcutOwnerOrAdmin(ctx context.Context, shortcutID int32) (*store.User, *storepb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcutID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+	}
+	if shortcut == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return user, shortcut, nil
+}
+
+// canViewShortcut returns whether the user, nil when not signed in, can view the shortcut,
+// either from its visibility or from being shared with the user.
+func (s *APIV1Service) canViewShortcut(ctx context.Context, user *store.User, shortcut *storepb.Shortcut) (bool, error) {
+	visible, err := s.canView(ctx, user, shortcut.Visibility, shortcut.CreatorId, shortcut.TeamId)
+	if err != nil || visible || user == nil {
+		return visible, err
+	}
+	shortcutACL, err := s.getShortcutACL(ctx, shortcut.Id, user.ID)
+	if err != nil {
+		return false, err
+	}
+	return shortcutACL != nil, nil
+}
+
+func (s *APIV1Service) getShortcutACL(ctx context.Context, shortcutID, userID int32) (*store.ShortcutACL, error) {
+	return s.Store.GetShortcutACL(ctx, &store.FindShortcutACL{
+		ShortcutID: &shortcutID,
+		UserID:     &userID,
+	})
+}
+
+func convertShortcutACLFromStore(shortcutACL *store.ShortcutACL) *v1pb.ShortcutACL {
+	role := v1pb.ShortcutACL_READ
+	if shortcutACL.Role == store.ShortcutACLRoleEdit {
+		role = v1pb.ShortcutACL_EDIT
+	}
+	return &v1pb.ShortcutACL{
+		ShortcutId:  shortcutACL.ShortcutID,
+		UserId:      shortcutACL.UserID,
+		Role:        role,
+		CreatedTime: timestamppb.New(time.Unix(shortcutACL.CreatedTs, 0)),
+	}
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/warthurton/slash/store"
+)
+
+// maxShortcutAliases is the max number of aliases of a shortcut.
+const maxShortcutAliases = 20
+
+// normalizeShortcutAliases validates the aliases of the shortcut named name, e.g. its names in other languages
+// like "hilfe" for "help", and returns them sorted. The aliases must be unique among the names and the aliases
+// of the other shortcuts. The shortcut id is 0 when the shortcut is being created.
+func (s *APIV1Service) normalizeShortcutAliases(ctx context.Context, shortcutID int32, name string, aliases []string, creator *store.User) ([]string, error) {
+	if len(aliases) > maxShortcutAliases {
+		return nil, status.Errorf(codes.InvalidArgument, "a shortcut has at most %d aliases", maxShortcutAliases)
+	}
+	names := []string{}
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		if alias == "" || strings.ContainsAny(alias, " \t\n") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid alias %q", alias)
+		}
+		if alias == name {
+			return nil, status.Errorf(codes.InvalidArgument, "alias %q is the name of the shortcut", alias)
+		}
+		if slices.Contains(names, alias) {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicated alias %q", alias)
+		}
+		if err := validateShortcutNamespace(alias, creator); err != nil {
+			return nil, err
+		}
+		if err := s.validateShortcutNameNotReserved(ctx, "alias", alias); err != nil {
+			return nil, err
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &alias,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+		}
+		if shortcut != nil && shortcut.Id != shortcutID {
+			return nil, status.Errorf(codes.AlreadyExists, "alias %q is the name of another shortcut", alias)
+		}
+		shortcutAlias, err := s.Store.GetShortcutAlias(ctx, &store.FindShortcutAlias{
+			Name: &alias,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut alias: %v", err)
+		}
+		if shortcutAlias != nil && shortcutAlias.ShortcutID != shortcutID {
+			return nil, status.Errorf(codes.AlreadyExists, "alias %q is an alias of another shortcut", alias)
+		}
+		names = append(names, alias)
+	}
+	slices.Sort(names)
+	return names, nil
+}
@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/warthurton/slash/internal/util"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// defaultShortcutAnalyticsShareDuration is the duration of the analytics share links without an expiration time.
+	defaultShortcutAnalyticsShareDuration = 7 * 24 * time.Hour
+	// maxShortcutAnalyticsShareDuration is the max duration of the analytics share links.
+	maxShortcutAnalyticsShareDuration = 90 * 24 * time.Hour
+	// shortcutAnalyticsShareTokenLength is the length of the secret of the analytics share links.
+	shortcutAnalyticsShareTokenLength = 32
+	// maxShortcutAnalyticsShareDescriptionLength is the max length of the description of the analytics share links.
+	maxShortcutAnalyticsShareDescriptionLength = 256
+)
+
+func (s *APIV1Service) CreateShortcutAnalyticsShare(ctx context.Context, request *v1pb.CreateShortcutAnalyticsShareRequest) (*v1pb.ShortcutAnalyticsShare, error) {
+	description := strings.TrimSpace(request.Description)
+	if len(description) > maxShortcutAnalyticsShareDescriptionLength {
+		return nil, status.Errorf(codes.InvalidArgument, "description must be at most %d characters", maxShortcutAnalyticsShareDescriptionLength)
+	}
+	user, shortcut, err := s.checkShortcutOwnerOrAdmin(ctx, request.ShortcutId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expireTime := now.Add(defaultShortcutAnalyticsShareDuration)
+	if request.ExpireTime != nil {
+		if err := request.ExpireTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire time: %v", err)
+		}
+		expireTime = request.ExpireTime.AsTime()
+	}
+	if !expireTime.After(now) || expireTime.After(now.Add(maxShortcutAnalyticsShareDuration)) {
+		return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the next %d days", int(maxShortcutAnalyticsShareDuration.Hours()/24))
+	}
+	token, err := util.RandomString(shortcutAnalyticsShareTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	shortcutAnalyticsShare, err := s.Store.CreateShortcutAnalyticsShare(ctx, &store.ShortcutAnalyticsShare{
+		ShortcutID:  shortcut.Id,
+		CreatorID:   user.ID,
+		Description: description,
+		Token:       token,
+		ExpireTs:    expireTime.Unix(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create shortcut analytics share: %v", err)
+	}
+	return convertShortcutAnalyticsShareFromStore(shortcutAnalyticsShare), nil
+}
+
+func (s *APIV1Service) ListShortcutAnalyticsShares(ctx context.Context, request *v1pb.ListShortcutAnalyticsSharesRequest) (*v1pb.ListShortcutAnalyticsSharesResponse, error) {
+	if _, _, err := s.checkShortcutOwnerOrAdmin(ctx, request.ShortcutId); err != nil {
+		return nil, err
+	}
+	shortcutAnalyticsShares, err := s.Store.ListShortcutAnalyticsShares(ctx, &store.FindShortcutAnalyticsShare{
+		ShortcutID: &request.ShortcutId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut analytics shares: %v", err)
+	}
+	response := &v1pb.ListShortcutAnalyticsSharesResponse{
+		Shares: []*v1pb.ShortcutAnalyticsShare{},
+	}
+	for _, shortcutAnalyticsShare := range shortcutAnalyticsShares {
+		response.Shares = append(response.Shares, convertShortcutAnalyticsShareFromStore(shortcutAnalyticsShare))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) DeleteShortcutAnalyticsShare(ctx context.Context, request *v1pb.DeleteShortcutAnalyticsShareRequest) (*emptypb.Empty, error) {
+	if _, _, err := s.checkShortcutOwnerOrAdmin(ctx, request.ShortcutId); err != nil {
+		return nil, err
+	}
+	shortcutAnalyticsShare, err := s.Store.GetShortcutAnalyticsShare(ctx, &store.FindShortcutAnalyticsShare{
+		ID:         &request.Id,
+		ShortcutID: &request.ShortcutId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut analytics share: %v", err)
+	}
+	if shortcutAnalyticsShare == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut analytics share not found")
+	}
+	if err := s.Store.DeleteShortcutAnalyticsShare(ctx, &store.DeleteShortcutAnalyticsShare{
+		ID: shortcutAnalyticsShare.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete shortcut analytics share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) GetSharedShortcutAnalytics(ctx context.Context, request *v1pb.GetSharedShortcutAnalyticsRequest) (*v1pb.SharedShortcutAnalytics, error) {
+	if request.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	shortcutAnalyticsShare, err := s.Store.GetShortcutAnalyticsShare(ctx, &store.FindShortcutAnalyticsShare{
+		Token: &request.Token,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut analytics share: %v", err)
+	}
+	now := time.Now()
+	if shortcutAnalyticsShare == nil || shortcutAnalyticsShare.ExpireTs <= now.Unix() {
+		return nil, status.Errorf(codes.NotFound, "the share link is invalid or has expired")
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcutAnalyticsShare.ShortcutID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "the share link is invalid or has expired")
+	}
+
+	analytics, err := s.getShortcutAnalytics(ctx, shortcut, request.Interval)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.RecordShortcutAnalyticsShareView(ctx, shortcutAnalyticsShare.ID, now.Unix()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record shortcut analytics share view: %v", err)
+	}
+	return &v1pb.SharedShortcutAnalytics{
+		ShortcutName:  shortcut.Name,
+		ShortcutTitle: shortcut.Title,
+		Analytics:     analytics,
+		ExpireTime:    timestamppb.New(time.Unix(shortcutAnalyticsShare.ExpireTs, 0)),
+	}, nil
+}
+
+func convertShortcutAnalyticsShareFromStore(shortcutAnalyticsShare *store.ShortcutAnalyticsShare) *v1pb.ShortcutAnalyticsShare {
+	convertedShortcutAnalyticsShare := &v1pb.ShortcutAnalyticsShare{
+		Id:          shortcutAnalyticsShare.ID,
+		ShortcutId:  shortcutAnalyticsShare.ShortcutID,
+		CreatorId:   shortcutAnalyticsShare.CreatorID,
+		CreatedTime: timestamppb.New(time.Unix(shortcutAnalyticsShare.CreatedTs, 0)),
+		Description: shortcutAnalyticsShare.Description,
+		Token:       shortcutAnalyticsShare.Token,
+		ExpireTime:  timestamppb.New(time.Unix(shortcutAnalyticsShare.ExpireTs, 0)),
+		ViewCount:   shortcutAnalyticsShare.ViewCount,
+	}
+	if shortcutAnalyticsShare.LastViewedTs > 0 {
+		convertedShortcutAnalyticsShare.LastViewedTime = timestamppb.New(time.Unix(shortcutAnalyticsShare.LastViewedTs, 0))
+	}
+	return convertedShortcutAnalyticsShare
+}
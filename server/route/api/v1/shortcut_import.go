@@ -0,0 +1,409 @@
+package v1
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// ImportPath is the path of the import endpoint, which streams its progress for longer than the API timeout.
+	ImportPath = "/api/v1/import"
+
+	// ImportFormatCSV is the CSV table of the export, only its shortcut rows are imported.
+	ImportFormatCSV = "csv"
+	// ImportFormatNetscape is the Netscape bookmark file exported by browsers and most bookmark managers.
+	ImportFormatNetscape = BookmarkFormatNetscape
+
+	// ImportEventRow is the event of an imported row, with the error if it failed.
+	ImportEventRow = "row"
+	// ImportEventThrottled is the event of the import waiting for the quota of the user.
+	ImportEventThrottled = "throttled"
+	// ImportEventDone is the last event of the import.
+	ImportEventDone = "done"
+
+	// maxImportSize is the max size of an imported file.
+	maxImportSize = 10 << 20
+	// maxImportRows is the max number of rows of an import.
+	maxImportRows = 5000
+)
+
+// ImportEvent is a line of the progress of an import, streamed as newline-delimited JSON.
+type ImportEvent struct {
+	Type string `json:"type"`
+	// Row is the 1-based index of the row of the event.
+	Row   int    `json:"row,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Error string `json:"error,omitempty"`
+	// WaitSeconds is how long the import waits for the quota.
+	WaitSeconds float64 `json:"waitSeconds,omitempty"`
+	Processed   int     `json:"processed"`
+	Total       int     `json:"total"`
+	Created     int     `json:"created"`
+	Failed      int     `json:"failed"`
+}
+
+// registerImportRoutes registers the import endpoint, which creates the shortcuts of a file for the user.
+// The rows are throttled by the import quota of the user, and the progress is streamed, so that large imports
+// slow down instead of failing midway.
+func (s *APIV1Service) registerImportRoutes(e *echo.Echo) {
+	e.POST(ImportPath, func(c echo.Context) error {
+		userCtx, user, err := s.authenticateImportRequest(c, AccessTokenScopeShortcutsWrite)
+		if err != nil {
+			return err
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxImportSize+1))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("failed to read the file, err: %s", err))
+		}
+		if len(body) > maxImportSize {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("the file is larger than %d MB", maxImportSize>>20))
+		}
+		rows, err := parseImportRows(c.QueryParam("format"), body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		if len(rows) > maxImportRows {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("the file has %d shortcuts, at most %d can be imported at once", len(rows), maxImportRows))
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, "application/x-ndjson")
+		c.Response().WriteHeader(http.StatusOK)
+		// The headers are sent already, so the error can only be logged by the error handler.
+		return s.importShortcuts(userCtx, user.ID, rows, func(event *ImportEvent) error {
+			if err := json.NewEncoder(c.Response()).Encode(event); err != nil {
+				return err
+			}
+			c.Response().Flush()
+			return nil
+		})
+	})
+}
+
+// authenticateImportRequest authenticates the request to the import endpoints with an access token with the scope,
+// and returns the context of the user, like the API one, and the user.
+func (s *APIV1Service) authenticateImportRequest(c echo.Context, scope string) (context.Context, *store.User, error) {
+	ctx := c.Request().Context()
+	md := metadata.MD{}
+	if authorization := c.Request().Header.Get(echo.HeaderAuthorization); authorization != "" {
+		md.Set("authorization", authorization)
+	}
+	if cookie := c.Request().Header.Get(echo.HeaderCookie); cookie != "" {
+		md.Set("cookie", cookie)
+	}
+	accessToken, err := getTokenFromMetadata(md)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	userID, userAccessToken, err := NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, accessToken)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid access token")
+	}
+	if !hasAccessTokenScope(userAccessToken.Scopes, scope) {
+		return nil, nil, echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("the access token requires the %s scope", scope))
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get user, err: %s", err))
+	}
+	if user == nil || user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, "user not found")
+	}
+	if !user.EmailVerified {
+		mailSetting, err := s.Store.GetWorkspaceMailSetting(ctx)
+		if err != nil {
+			return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace mail setting, err: %s", err))
+		}
+		if isEmailVerificationRequired(mailSetting) {
+			return nil, nil, echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("email %s is not verified", user.Email))
+		}
+	}
+
+	userCtx := context.WithValue(ctx, userIDContextKey, userID)
+	userCtx = context.WithValue(userCtx, accessTokenContextKey, accessToken)
+	userCtx = context.WithValue(userCtx, accessTokenScopesContextKey, userAccessToken.Scopes)
+	return userCtx, user, nil
+}
+
+// importShortcuts creates the shortcuts of the rows one by one, waiting for the import quota of the user,
+// and sends the progress. A failed row doesn't stop the import, only the cancellation of the request does.
+func (s *APIV1Service) importShortcuts(ctx context.Context, userID int32, rows []*storepb.ImportJobRow, send func(*ImportEvent) error) error {
+	progress := &ImportEvent{
+		Total: len(rows),
+	}
+	for i, row := range rows {
+		var sendErr error
+		if err := s.importQuota.wait(ctx, userID, func(delay time.Duration) {
+			event := *progress
+			event.Type = ImportEventThrottled
+			event.Row = i + 1
+			event.WaitSeconds = delay.Seconds()
+			sendErr = send(&event)
+		}); err != nil {
+			return err
+		}
+		if sendErr != nil {
+			return sendErr
+		}
+
+		event := *progress
+		event.Type = ImportEventRow
+		event.Row = i + 1
+		event.Name = row.Name
+		if err := s.importShortcut(ctx, row); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			progress.Failed++
+			event.Error = status.Convert(err).Message()
+		} else {
+			progress.Created++
+		}
+		progress.Processed++
+		event.Processed, event.Created, event.Failed = progress.Processed, progress.Created, progress.Failed
+		if err := send(&event); err != nil {
+			return err
+		}
+	}
+	done := *progress
+	done.Type = ImportEventDone
+	return send(&done)
+}
+
+// ImportShortcut creates the shortcut of the row as the user, for the import jobs.
+func (s *APIV1Service) ImportShortcut(ctx context.Context, userID int32, row *storepb.ImportJobRow) error {
+	return s.importShortcut(context.WithValue(ctx, userIDContextKey, userID), row)
+}
+
+// WaitImportQuota waits for the import quota of the user for a row, for the import jobs.
+func (s *APIV1Service) WaitImportQuota(ctx context.Context, userID int32) error {
+	return s.importQuota.wait(ctx, userID, func(time.Duration) {})
+}
+
+// importShortcut creates the shortcut of the row as the user of the context. A taken name is an AlreadyExists error,
+// to tell it apart from the failures of the store.
+func (s *APIV1Service) importShortcut(ctx context.Context, row *storepb.ImportJobRow) error {
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		Name: &row.Name,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get shortcut, err: %v", err)
+	}
+	if shortcut != nil {
+		return status.Errorf(codes.AlreadyExists, "shortcut %s already exists", row.Name)
+	}
+	_, err = s.CreateShortcut(ctx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{
+			Name:        row.Name,
+			Title:       row.Title,
+			Link:        row.Link,
+			Description: row.Description,
+			Tags:        row.Tags,
+			Visibility:  convertVisibilityFromStorepb(row.Visibility),
+		},
+	})
+	return err
+}
+
+// parseImportRows parses the shortcuts of the file in the format.
+func parseImportRows(format string, body []byte) ([]*storepb.ImportJobRow, error) {
+	switch format {
+	case ImportFormatCSV:
+		return parseCSVImportRows(body)
+	case ImportFormatNetscape:
+		return parseNetscapeImportRows(body), nil
+	default:
+		return nil, errors.Errorf("unsupported import format %q", format)
+	}
+}
+
+// parseCSVImportRows parses the shortcut rows of the CSV export. The columns are found by their header,
+// so that only the name and the link are required.
+func parseCSVImportRows(body []byte) ([]*storepb.ImportJobRow, error) {
+	reader := csv.NewReader(strings.NewReader(string(body)))
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the CSV header")
+	}
+	columns := map[string]int{}
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, errors.New("the CSV has no name column")
+	}
+	if _, ok := columns["link"]; !ok {
+		return nil, errors.New("the CSV has no link column")
+	}
+	getField := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rows := []*storepb.ImportJobRow{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the CSV")
+		}
+		if rowType := getField(record, "type"); rowType != "" && rowType != "shortcut" {
+			continue
+		}
+		visibility := storepb.Visibility(storepb.Visibility_value[strings.ToUpper(getField(record, "visibility"))])
+		if visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
+			visibility = storepb.Visibility_PRIVATE
+		}
+		rows = append(rows, &storepb.ImportJobRow{
+			Name:        getField(record, "name"),
+			Title:       getField(record, "title"),
+			Link:        getField(record, "link"),
+			Description: getField(record, "description"),
+			Tags:        strings.Fields(getField(record, "tags")),
+			Visibility:  visibility,
+		})
+	}
+	return rows, nil
+}
+
+// parseNetscapeImportRows parses the links of the bookmark file as private shortcuts. The names are the slugs
+// of the titles, or of the hosts, made unique within the file.
+func parseNetscapeImportRows(body []byte) []*storepb.ImportJobRow {
+	rows := []*storepb.ImportJobRow{}
+	names := map[string]bool{}
+	var link *storepb.ImportJobRow
+	var text strings.Builder
+	inLink, inDescription := false, false
+	finishDescription := func() {
+		if inDescription && link != nil {
+			link.Description = strings.TrimSpace(text.String())
+		}
+		inDescription = false
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(string(body)))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+		token := tokenizer.Token()
+		switch tokenType {
+		case html.StartTagToken:
+			switch token.DataAtom {
+			case atom.A:
+				finishDescription()
+				link = &storepb.ImportJobRow{
+					Visibility: storepb.Visibility_PRIVATE,
+				}
+				for _, attribute := range token.Attr {
+					switch strings.ToLower(attribute.Key) {
+					case "href":
+						link.Link = strings.TrimSpace(attribute.Val)
+					case "tags":
+						for _, tag := range strings.Split(attribute.Val, ",") {
+							if tag = strings.TrimSpace(tag); tag != "" {
+								link.Tags = append(link.Tags, tag)
+							}
+						}
+					}
+				}
+				inLink = true
+				text.Reset()
+			case atom.Dd:
+				inDescription = true
+				text.Reset()
+			case atom.Dt, atom.Dl, atom.H3:
+				finishDescription()
+			}
+		case html.EndTagToken:
+			switch token.DataAtom {
+			case atom.A:
+				if inLink && link.Link != "" && !strings.HasPrefix(strings.ToLower(link.Link), "javascript:") {
+					link.Title = strings.TrimSpace(text.String())
+					link.Name = getUniqueImportName(getImportName(link), names)
+					rows = append(rows, link)
+				}
+				inLink = false
+			case atom.Dl:
+				finishDescription()
+			}
+		case html.TextToken:
+			if inLink || inDescription {
+				text.WriteString(token.Data)
+			}
+		}
+	}
+	finishDescription()
+	return rows
+}
+
+// getImportName returns the slug of the title of the bookmark, or of its host.
+func getImportName(row *storepb.ImportJobRow) string {
+	if name := slugifyImportName(row.Title); name != "" {
+		return name
+	}
+	if u, err := url.Parse(row.Link); err == nil {
+		if name := slugifyImportName(strings.TrimPrefix(u.Hostname(), "www.")); name != "" {
+			return name
+		}
+	}
+	return "bookmark"
+}
+
+func slugifyImportName(s string) string {
+	var builder strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			builder.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+		if builder.Len() >= 64 {
+			break
+		}
+	}
+	return builder.String()
+}
+
+// getUniqueImportName returns the name with a number suffix if it's taken, and marks it as taken.
+func getUniqueImportName(name string, names map[string]bool) string {
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%s-%d", name, i)
+	}
+	names[unique] = true
+	return unique
+}
@@ -0,0 +1,216 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// ImportJobEventsPath is the path of the progress stream of an import job, which lasts longer than the API timeout.
+	ImportJobEventsPath = "/api/v1/import-jobs/:id/events"
+
+	// importJobEventsInterval is the interval to check the progress of the streamed job.
+	importJobEventsInterval = time.Second
+)
+
+func (s *APIV1Service) CreateImportJob(ctx context.Context, request *v1pb.CreateImportJobRequest) (*v1pb.ImportJob, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	rows, err := parseImportRows(request.Format, []byte(request.Content))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if len(rows) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "the file has no shortcuts")
+	}
+	if len(rows) > maxImportRows {
+		return nil, status.Errorf(codes.InvalidArgument, "the file has %d shortcuts, at most %d can be imported at once", len(rows), maxImportRows)
+	}
+	importJob, err := s.Store.CreateImportJob(ctx, &store.ImportJob{
+		CreatorID: user.ID,
+		Format:    request.Format,
+		Payload: &storepb.ImportJobPayload{
+			Rows: rows,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create import job: %v", err)
+	}
+	return convertImportJobFromStore(importJob, true), nil
+}
+
+func (s *APIV1Service) GetImportJob(ctx context.Context, request *v1pb.GetImportJobRequest) (*v1pb.ImportJob, error) {
+	importJob, err := s.getImportJob(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return convertImportJobFromStore(importJob, true), nil
+}
+
+func (s *APIV1Service) ListImportJobs(ctx context.Context, _ *v1pb.ListImportJobsRequest) (*v1pb.ListImportJobsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	importJobs, err := s.Store.ListImportJobs(ctx, &store.FindImportJob{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list import jobs: %v", err)
+	}
+	response := &v1pb.ListImportJobsResponse{
+		ImportJobs: []*v1pb.ImportJob{},
+	}
+	for _, importJob := range importJobs {
+		response.ImportJobs = append(response.ImportJobs, convertImportJobFromStore(importJob, false))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) ResumeImportJob(ctx context.Context, request *v1pb.ResumeImportJobRequest) (*v1pb.ImportJob, error) {
+	importJob, err := s.getImportJob(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if importJob.Status != store.ImportJobFailed {
+		return nil, status.Errorf(codes.FailedPrecondition, "only the failed import jobs can be resumed, the job is %s", importJob.Status)
+	}
+	pending, jobError := store.ImportJobPending, ""
+	importJob, err = s.Store.UpdateImportJob(ctx, &store.UpdateImportJob{
+		ID:     importJob.ID,
+		Status: &pending,
+		Error:  &jobError,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update import job: %v", err)
+	}
+	return convertImportJobFromStore(importJob, true), nil
+}
+
+// getImportJob returns the import job, if the current user is its creator or an admin.
+func (s *APIV1Service) getImportJob(ctx context.Context, id int32) (*store.ImportJob, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	importJob, err := s.Store.GetImportJob(ctx, &store.FindImportJob{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get import job: %v", err)
+	}
+	if importJob == nil || (importJob.CreatorID != user.ID && user.Role != store.RoleAdmin) {
+		return nil, status.Errorf(codes.NotFound, "import job not found")
+	}
+	return importJob, nil
+}
+
+// registerImportJobRoutes registers the progress stream of the import jobs. It writes the job as a line of
+// newline-delimited JSON when it changes, until it succeeds or fails.
+func (s *APIV1Service) registerImportJobRoutes(e *echo.Echo) {
+	e.GET(ImportJobEventsPath, func(c echo.Context) error {
+		ctx, _, err := s.authenticateImportRequest(c, AccessTokenScopeShortcutsRead)
+		if err != nil {
+			return err
+		}
+		id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid import job id %q", c.Param("id")))
+		}
+		importJob, err := s.getImportJob(ctx, int32(id))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusNotFound, status.Convert(err).Message())
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, "application/x-ndjson")
+		c.Response().WriteHeader(http.StatusOK)
+		ticker := time.NewTicker(importJobEventsInterval)
+		defer ticker.Stop()
+		var last *store.ImportJob
+		for {
+			if last == nil || importJob.UpdatedTs != last.UpdatedTs || importJob.Status != last.Status || importJob.ProcessedRows != last.ProcessedRows {
+				// The fields are all written, like with the gateway.
+				eventBytes, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(convertImportJobFromStore(importJob, true))
+				if err != nil {
+					return err
+				}
+				if _, err := c.Response().Write(append(eventBytes, '\n')); err != nil {
+					return err
+				}
+				c.Response().Flush()
+			}
+			if importJob.Status == store.ImportJobSucceeded || importJob.Status == store.ImportJobFailed {
+				return nil
+			}
+			last = importJob
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return nil
+			}
+			// The headers are sent already, so the error can only be logged by the error handler.
+			if importJob, err = s.getImportJob(ctx, int32(id)); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// convertImportJobFromStore converts the import job, with its row errors if withRowErrors.
+func convertImportJobFromStore(importJob *store.ImportJob, withRowErrors bool) *v1pb.ImportJob {
+	job := &v1pb.ImportJob{
+		Id:            importJob.ID,
+		CreatorId:     importJob.CreatorID,
+		CreatedTime:   timestamppb.New(time.Unix(importJob.CreatedTs, 0)),
+		UpdatedTime:   timestamppb.New(time.Unix(importJob.UpdatedTs, 0)),
+		Status:        convertImportJobStatusFromStore(importJob.Status),
+		Format:        importJob.Format,
+		TotalRows:     int32(len(importJob.Payload.GetRows())),
+		ProcessedRows: importJob.ProcessedRows,
+		CreatedCount:  importJob.CreatedCount,
+		FailedCount:   importJob.FailedCount,
+		RowErrors:     []*v1pb.ImportJob_RowError{},
+		Error:         importJob.Error,
+	}
+	if withRowErrors {
+		for _, rowError := range importJob.Result.GetRowErrors() {
+			job.RowErrors = append(job.RowErrors, &v1pb.ImportJob_RowError{
+				Row:   rowError.Row,
+				Name:  rowError.Name,
+				Error: rowError.Error,
+			})
+		}
+	}
+	return job
+}
+
+func convertImportJobStatusFromStore(importJobStatus store.ImportJobStatus) v1pb.ImportJob_Status {
+	switch importJobStatus {
+	case store.ImportJobPending:
+		return v1pb.ImportJob_PENDING
+	case store.ImportJobRunning:
+		return v1pb.ImportJob_RUNNING
+	case store.ImportJobSucceeded:
+		return v1pb.ImportJob_SUCCEEDED
+	case store.ImportJobFailed:
+		return v1pb.ImportJob_FAILED
+	default:
+		return v1pb.ImportJob_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/warthurton/slash/internal/util"
+	"github.com/warthurton/slash/plugin/completion"
+	"github.com/warthurton/slash/plugin/httpgetter"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// shortcutMetadataTimeout bounds the fetch of the metadata of a link, with its redirects.
+	shortcutMetadataTimeout      = 15 * time.Second
+	maxMetadataTitleLength       = 256
+	maxMetadataDescriptionLength = 1024
+	// suggestionPageTimeout bounds the fetch of the page of a suggestion, so that the completion gets the rest
+	// of the duration of the request.
+	suggestionPageTimeout = 10 * time.Second
+	// maxSuggestionTextLength bounds the text of the page sent to the completion provider.
+	maxSuggestionTextLength = 8000
+)
+
+func (s *APIV1Service) RefreshShortcutMetadata(ctx context.Context, request *v1pb.RefreshShortcutMetadataRequest) (*v1pb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		shortcutACL, err := s.getShortcutACL(ctx, shortcut.Id, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut acl: %v", err)
+		}
+		if shortcutACL == nil || shortcutACL.Role != store.ShortcutACLRoleEdit {
+			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		}
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, shortcutMetadataTimeout)
+	defer cancel()
+	link, err := common.ExpandWildcardLink(shortcut.Link, "")
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid link: %v", err)
+	}
+	htmlMeta, err := httpgetter.GetHTMLMeta(fetchCtx, link)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to fetch the metadata of the link: %v", err)
+	}
+	shortcut, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:                shortcut.Id,
+		OpenGraphMetadata: mergeHTMLMeta(shortcut.OgMetadata, htmlMeta),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
+	}
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return composedShortcut, nil
+}
+
+func (s *APIV1Service) SuggestShortcut(ctx context.Context, request *v1pb.SuggestShortcutRequest) (*v1pb.SuggestShortcutResponse, error) {
+	if strings.TrimSpace(request.Link) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "link is required")
+	}
+	completionSetting, err := s.Store.GetWorkspaceCompletionSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	if completionSetting.Endpoint == "" || completionSetting.Model == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the suggestions are disabled")
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, suggestionPageTimeout)
+	defer cancel()
+	htmlPage, err := httpgetter.GetHTMLPage(fetchCtx, strings.TrimSpace(request.Link), maxSuggestionTextLength)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to fetch the page of the link: %v", err)
+	}
+	suggestion, err := newCompletionProvider(completionSetting).Suggest(ctx, &completion.Page{
+		URL:         request.Link,
+		Title:       htmlPage.Title,
+		Description: htmlPage.Description,
+		Text:        htmlPage.Text,
+	})
+	if err != nil {
+		slog.Warn("failed to suggest shortcut", slog.Any("error", err))
+		return nil, status.Errorf(codes.Unavailable, "failed to get the suggestions of the completion provider")
+	}
+	response := &v1pb.SuggestShortcutResponse{
+		Tags: suggestion.Tags,
+	}
+	response.Title, _ = util.TruncateString(suggestion.Title, maxMetadataTitleLength)
+	response.Description, _ = util.TruncateString(suggestion.Description, maxMetadataDescriptionLength)
+	return response, nil
+}
+
+// newCompletionProvider returns the completion provider of the workspace setting.
+func newCompletionProvider(completionSetting *storepb.WorkspaceSetting_CompletionSetting) completion.Provider {
+	return completion.NewOpenAIProvider(completionSetting.Endpoint, completionSetting.ApiKey, completionSetting.Model)
+}
+
+// fetchShortcutMetadata fetches the metadata of the link of the shortcut in the background,
+// so that creating and updating shortcuts isn't slowed down by the destination.
+func (s *APIV1Service) fetchShortcutMetadata(shortcutID int32, link string) {
+	s.runInBackground(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shortcutMetadataTimeout)
+		defer cancel()
+		link, err := common.ExpandWildcardLink(link, "")
+		if err != nil {
+			slog.Debug("failed to expand shortcut link", slog.Int("shortcutID", int(shortcutID)), slog.Any("error", err))
+			return
+		}
+		htmlMeta, err := httpgetter.GetHTMLMeta(ctx, link)
+		if err != nil {
+			slog.Debug("failed to fetch shortcut metadata", slog.Int("shortcutID", int(shortcutID)), slog.Any("error", err))
+			return
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID: &shortcutID,
+		})
+		if err != nil {
+			slog.Error("failed to get shortcut", slog.Int("shortcutID", int(shortcutID)), slog.Any("error", err))
+			return
+		}
+		// The link may have changed again during the fetch, then its own fetch updates the metadata.
+		if shortcut == nil || shortcut.Link != link {
+			return
+		}
+		if _, err := s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:                shortcut.Id,
+			OpenGraphMetadata: mergeHTMLMeta(shortcut.OgMetadata, htmlMeta),
+		}); err != nil {
+			slog.Error("failed to update shortcut metadata", slog.Int("shortcutID", int(shortcutID)), slog.Any("error", err))
+		}
+	})
+}
+
+// mergeHTMLMeta returns the Open Graph metadata with the fetched metadata, keeping the query params stored with it.
+func mergeHTMLMeta(ogMetadata *storepb.OpenGraphMetadata, htmlMeta *httpgetter.HTMLMeta) *storepb.OpenGraphMetadata {
+	merged := &storepb.OpenGraphMetadata{}
+	if ogMetadata != nil {
+		merged = proto.Clone(ogMetadata).(*storepb.OpenGraphMetadata)
+	}
+	merged.Title, _ = util.TruncateString(htmlMeta.Title, maxMetadataTitleLength)
+	merged.Description, _ = util.TruncateString(htmlMeta.Description, maxMetadataDescriptionLength)
+	merged.Image = htmlMeta.Image
+	merged.Favicon = htmlMeta.Favicon
+	return merged
+}
+
+// isOpenGraphMetadataEmpty returns true if the user didn't set any of the fetched metadata.
+func isOpenGraphMetadataEmpty(ogMetadata *v1pb.Shortcut_OpenGraphMetadata) bool {
+	return ogMetadata.GetTitle() == "" && ogMetadata.GetDescription() == "" && ogMetadata.GetImage() == ""
+}
@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+)
+
+// collectionSearchParam is the search param of the shortcut page with the name of the collection the shortcut is opened from.
+const collectionSearchParam = "slash_collection"
+
+// redirectableLinkRegexp matches the links the shortcut page redirects to, the others are shown as plain text.
+// It's the same as `isURL` of the frontend.
+var redirectableLinkRegexp = regexp.MustCompile(`(?i)^(https?|ftp)://[^\s/$.?#].[^\s]*$`)
+
+func (s *APIV1Service) ResolvePreview(ctx context.Context, request *v1pb.ResolvePreviewRequest) (*v1pb.ResolvePreviewResponse, error) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	resolveContext := request.Context
+	if resolveContext == nil {
+		resolveContext = &v1pb.ResolveContext{}
+	}
+	visitTime := time.Now()
+	if resolveContext.Time != nil {
+		if err := resolveContext.Time.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid time: %v", err)
+		}
+		visitTime = resolveContext.Time.AsTime()
+	}
+	query, err := url.ParseQuery(resolveContext.Query)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+	visitor, err := convertResolveContextToVisitor(resolveContext)
+	if err != nil {
+		return nil, err
+	}
+	collection := resolveContext.Collection
+	if collection == "" {
+		collection = query.Get(collectionSearchParam)
+	}
+
+	shortcut, err := s.Store.GetShortcutByNameOrAlias(ctx, name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+	}
+	if shortcut == nil {
+		notFoundSetting, err := s.Store.GetWorkspaceNotFoundSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace not found setting: %v", err)
+		}
+		if notFoundSetting.RedirectUrl != "" {
+			return &v1pb.ResolvePreviewResponse{
+				Outcome: v1pb.ResolvePreviewResponse_FALLBACK_REDIRECT,
+				Target:  strings.ReplaceAll(notFoundSetting.RedirectUrl, "{name}", url.QueryEscape(name)),
+				Reason:  "the shortcut doesn't exist, so the fallback redirect of the workspace is used",
+			}, nil
+		}
+		suggestions, err := s.findShortcutSuggestions(ctx, name)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find shortcut suggestions: %v", err)
+		}
+		return &v1pb.ResolvePreviewResponse{
+			Outcome:     v1pb.ResolvePreviewResponse_NOT_FOUND,
+			Reason:      "the shortcut doesn't exist",
+			Suggestions: suggestions,
+		}, nil
+	}
+
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	visible, err := s.canViewShortcut(ctx, user, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	// The rotations are resolved at the time of the visit, not now.
+	ruleLink := ""
+	var variant *storepb.Variant
+	if composedShortcut.Link != "" {
+		if composedShortcut.CurrentLink, err = s.getShortcutLinkAt(ctx, shortcut, visitTime); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut link: %v", err)
+		}
+		if ruleLink = common.MatchRedirectRule(shortcut.RedirectRules, visitor); ruleLink != "" {
+			composedShortcut.CurrentLink = ruleLink
+		} else if variant = common.PickVariant(shortcut.Variants); variant != nil {
+			// The variant is picked by weight like for a visit, so the previews vary too.
+			composedShortcut.CurrentLink = variant.Link
+		}
+	}
+	response := &v1pb.ResolvePreviewResponse{
+		Shortcut: composedShortcut,
+	}
+	reasons := []string{}
+	if shortcut.Name != name {
+		reasons = append(reasons, fmt.Sprintf("%q is an alias of %q", name, shortcut.Name))
+	}
+	switch {
+	case shortcut.RowStatus == storepb.RowStatus_ARCHIVED:
+		response.Outcome = v1pb.ResolvePreviewResponse_EXPIRED
+		reasons = append(reasons, "the shortcut is archived")
+	case isShortcutExpired(shortcut, visitTime):
+		response.Outcome = v1pb.ResolvePreviewResponse_EXPIRED
+		reasons = append(reasons, fmt.Sprintf("the shortcut expired at %s", time.Unix(shortcut.ExpireTs, 0).UTC().Format(time.RFC3339)))
+	case isShortcutScheduled(shortcut, visitTime):
+		response.Outcome = v1pb.ResolvePreviewResponse_NOT_ACTIVE
+		reasons = append(reasons, fmt.Sprintf("the shortcut activates at %s", time.Unix(shortcut.ActivateTs, 0).UTC().Format(time.RFC3339)))
+	case composedShortcut.Link == "":
+		// The link of a scheduled shortcut is kept secret from the others, even when previewing a later time.
+		response.Outcome = v1pb.ResolvePreviewResponse_NOT_ACTIVE
+		reasons = append(reasons, "the link of the scheduled shortcut is only visible to its creator and the admins")
+	case !redirectableLinkRegexp.MatchString(composedShortcut.CurrentLink):
+		response.Outcome = v1pb.ResolvePreviewResponse_PLAIN_TEXT
+		response.Target = composedShortcut.CurrentLink
+		reasons = append(reasons, "the link is not a url, so it's shown as plain text")
+	default:
+		target, err := buildShortcutRedirectURL(shortcut, composedShortcut.CurrentLink, collection, resolveContext.Query)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build the redirect url: %v", err)
+		}
+		redirectCode, err := s.getShortcutRedirectCode(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut redirect code: %v", err)
+		}
+		response.Outcome = v1pb.ResolvePreviewResponse_REDIRECT
+		response.Target = target
+		response.RedirectCode = redirectCode
+		if ruleLink != "" {
+			reasons = append(reasons, "redirects to the link of the redirect rule matching the visitor")
+		} else if variant != nil {
+			reasons = append(reasons, fmt.Sprintf("redirects to the variant %q picked by weight among %d variants", variant.Name, len(shortcut.Variants.Variants)))
+		} else if composedShortcut.CurrentLink != composedShortcut.Link {
+			reasons = append(reasons, "redirects to the link of the rotation active at the time")
+		} else {
+			reasons = append(reasons, "redirects to the link of the shortcut")
+		}
+	}
+	response.Reason = strings.Join(reasons, "; ")
+	return response, nil
+}
+
+// convertResolveContextToVisitor returns the visitor the redirect rules match in the simulated context of the visit.
+func convertResolveContextToVisitor(resolveContext *v1pb.ResolveContext) (*common.Visitor, error) {
+	visitor := &common.Visitor{
+		Country:  strings.ToUpper(strings.TrimSpace(resolveContext.Country)),
+		Language: common.GetPreferredLanguage(resolveContext.Language),
+	}
+	switch strings.ToLower(strings.TrimSpace(resolveContext.Device)) {
+	case "":
+	case "desktop":
+		visitor.Device = storepb.DeviceType_DESKTOP
+	case "mobile":
+		visitor.Device = storepb.DeviceType_MOBILE
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid device %q, it must be mobile or desktop", resolveContext.Device)
+	}
+	return visitor, nil
+}
+
+// buildShortcutRedirectURL builds the url the shortcut redirects to, like the frontend does:
+// the query of the visit is appended to the link, then the query params of the shortcut which are in neither.
+func buildShortcutRedirectURL(shortcut *storepb.Shortcut, link, collection, rawQuery string) (string, error) {
+	target, err := url.Parse(link)
+	if err != nil {
+		return "", err
+	}
+	existing := target.Query()
+	parts := []string{}
+	if target.RawQuery != "" {
+		parts = append(parts, target.RawQuery)
+	}
+	for _, part := range strings.Split(rawQuery, "&") {
+		if part == "" {
+			continue
+		}
+		rawKey, rawValue, _ := strings.Cut(part, "=")
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			return "", err
+		}
+		// The collection the shortcut is opened from is not passed on.
+		if key == collectionSearchParam {
+			continue
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, part)
+		existing.Add(key, value)
+	}
+	for _, queryParam := range shortcut.OgMetadata.GetQueryParams() {
+		if existing.Has(queryParam.Key) {
+			continue
+		}
+		value := strings.NewReplacer("{name}", shortcut.Name, "{collection}", collection).Replace(queryParam.Value)
+		parts = append(parts, url.QueryEscape(queryParam.Key)+"="+url.QueryEscape(value))
+		existing.Set(queryParam.Key, value)
+	}
+	target.RawQuery = strings.Join(parts, "&")
+	return target.String(), nil
+}
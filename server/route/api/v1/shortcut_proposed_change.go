@@ -0,0 +1,280 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+// proposableShortcutPaths are the paths of the fields which the users other than the creator and admins
+// can propose to change.
+var proposableShortcutPaths = []string{"name", "link", "title", "description", "tags", "visibility"}
+
+func (s *APIV1Service) ListProposedChanges(ctx context.Context, request *v1pb.ListProposedChangesRequest) (*v1pb.ListProposedChangesResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.ShortcutId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+
+	find := &store.FindShortcutProposedChange{
+		ShortcutID: &shortcut.Id,
+	}
+	// The other users only see the changes they proposed.
+	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		find.ProposerID = &user.ID
+	}
+	if request.Status != v1pb.ProposedChange_STATUS_UNSPECIFIED {
+		proposedChangeStatus := convertProposedChangeStatusToStore(request.Status)
+		find.Status = &proposedChangeStatus
+	}
+	shortcutProposedChanges, err := s.Store.ListShortcutProposedChanges(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list proposed changes: %v", err)
+	}
+	response := &v1pb.ListProposedChangesResponse{
+		ProposedChanges: []*v1pb.ProposedChange{},
+	}
+	for _, shortcutProposedChange := range shortcutProposedChanges {
+		proposedChange, err := s.convertProposedChangeFromStore(ctx, shortcutProposedChange)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert proposed change: %v", err)
+		}
+		response.ProposedChanges = append(response.ProposedChanges, proposedChange)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) ApproveProposedChange(ctx context.Context, request *v1pb.ApproveProposedChangeRequest) (*v1pb.ProposedChange, error) {
+	user, shortcut, shortcutProposedChange, err := s.getPendingProposedChange(ctx, request.ShortcutId, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	proposed := shortcutProposedChange.Payload.GetProposed()
+	if _, err := s.updateShortcut(ctx, shortcut, &v1pb.Shortcut{
+		Name:        proposed.GetName(),
+		Link:        proposed.GetLink(),
+		Title:       proposed.GetTitle(),
+		Description: proposed.GetDescription(),
+		Tags:        proposed.GetTags(),
+		Visibility:  convertVisibilityFromStorepb(proposed.GetVisibility()),
+	}, shortcutProposedChange.Payload.GetPaths()); err != nil {
+		return nil, err
+	}
+	return s.reviewProposedChange(ctx, user, shortcutProposedChange, store.ShortcutProposedChangeApproved)
+}
+
+func (s *APIV1Service) RejectProposedChange(ctx context.Context, request *v1pb.RejectProposedChangeRequest) (*v1pb.ProposedChange, error) {
+	user, _, shortcutProposedChange, err := s.getPendingProposedChange(ctx, request.ShortcutId, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return s.reviewProposedChange(ctx, user, shortcutProposedChange, store.ShortcutProposedChangeRejected)
+}
+
+// proposeShortcutChange creates a proposed change of the fields of the paths of the protected shortcut.
+func (s *APIV1Service) proposeShortcutChange(ctx context.Context, user *store.User, shortcut *storepb.Shortcut, requestShortcut *v1pb.Shortcut, paths []string) error {
+	previous := convertShortcutContentFromStorepb(shortcut)
+	proposed := &storepb.ShortcutContent{}
+	changedPaths := []string{}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			proposed.Name = requestShortcut.Name
+		case "link":
+			link, err := s.normalizeShortcutLink(ctx, requestShortcut.Link)
+			if err != nil {
+				return err
+			}
+			proposed.Link = link
+		case "title":
+			proposed.Title = requestShortcut.Title
+		case "description":
+			proposed.Description = requestShortcut.Description
+		case "tags":
+			proposed.Tags = requestShortcut.Tags
+		case "visibility":
+			// The team is chosen by the creator, so it can't be proposed.
+			if requestShortcut.Visibility == v1pb.Visibility_TEAM {
+				return status.Errorf(codes.InvalidArgument, "the team visibility can't be proposed")
+			}
+			proposed.Visibility = convertVisibilityToStorepb(requestShortcut.Visibility)
+		default:
+			return status.Errorf(codes.InvalidArgument, "the shortcut is protected, only its %s can be proposed to change", strings.Join(proposableShortcutPaths, ", "))
+		}
+		if getShortcutContentField(previous, path) != getShortcutContentField(proposed, path) && !slices.Contains(changedPaths, path) {
+			changedPaths = append(changedPaths, path)
+		}
+	}
+	if len(changedPaths) == 0 {
+		return status.Errorf(codes.InvalidArgument, "no change is proposed")
+	}
+
+	if _, err := s.Store.CreateShortcutProposedChange(ctx, &store.ShortcutProposedChange{
+		ShortcutID: shortcut.Id,
+		ProposerID: user.ID,
+		Payload: &storepb.ShortcutProposedChangePayload{
+			Paths:    changedPaths,
+			Previous: previous,
+			Proposed: proposed,
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to create proposed change: %v", err)
+	}
+	return nil
+}
+
+// getPendingProposedChange returns the current user, who must be the creator of the shortcut or an admin,
+// the shortcut and its pending proposed change.
+func (s *APIV1Service) getPendingProposedChange(ctx context.Context, shortcutID, id int32) (*store.User, *storepb.Shortcut, *store.ShortcutProposedChange, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcutID,
+	})
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+	}
+	if shortcut == nil {
+		return nil, nil, nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, nil, nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	shortcutProposedChange, err := s.Store.GetShortcutProposedChange(ctx, &store.FindShortcutProposedChange{
+		ID:         &id,
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get proposed change: %v", err)
+	}
+	if shortcutProposedChange == nil {
+		return nil, nil, nil, status.Errorf(codes.NotFound, "proposed change not found")
+	}
+	if shortcutProposedChange.Status != store.ShortcutProposedChangePending {
+		return nil, nil, nil, status.Errorf(codes.FailedPrecondition, "the proposed change is already %s", strings.ToLower(shortcutProposedChange.Status.String()))
+	}
+	return user, shortcut, shortcutProposedChange, nil
+}
+
+func (s *APIV1Service) reviewProposedChange(ctx context.Context, user *store.User, shortcutProposedChange *store.ShortcutProposedChange, proposedChangeStatus store.ShortcutProposedChangeStatus) (*v1pb.ProposedChange, error) {
+	reviewedProposedChange, err := s.Store.UpdateShortcutProposedChange(ctx, &store.UpdateShortcutProposedChange{
+		ID:         shortcutProposedChange.ID,
+		Status:     proposedChangeStatus,
+		ReviewerID: user.ID,
+		ReviewedTs: time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update proposed change: %v", err)
+	}
+	if reviewedProposedChange == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "the proposed change is already reviewed")
+	}
+	proposedChange, err := s.convertProposedChangeFromStore(ctx, reviewedProposedChange)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert proposed change: %v", err)
+	}
+	return proposedChange, nil
+}
+
+func (s *APIV1Service) convertProposedChangeFromStore(ctx context.Context, shortcutProposedChange *store.ShortcutProposedChange) (*v1pb.ProposedChange, error) {
+	proposerUsername, err := s.getUsername(ctx, shortcutProposedChange.ProposerID)
+	if err != nil {
+		return nil, err
+	}
+	proposedChange := &v1pb.ProposedChange{
+		Id:               shortcutProposedChange.ID,
+		ShortcutId:       shortcutProposedChange.ShortcutID,
+		ProposerId:       shortcutProposedChange.ProposerID,
+		ProposerUsername: proposerUsername,
+		CreatedTime:      timestamppb.New(time.Unix(shortcutProposedChange.CreatedTs, 0)),
+		Status:           convertProposedChangeStatusFromStore(shortcutProposedChange.Status),
+		Changes:          []*v1pb.ProposedChange_FieldChange{},
+		ReviewerId:       shortcutProposedChange.ReviewerID,
+	}
+	for _, path := range shortcutProposedChange.Payload.GetPaths() {
+		proposedChange.Changes = append(proposedChange.Changes, &v1pb.ProposedChange_FieldChange{
+			Field:         path,
+			PreviousValue: getShortcutContentField(shortcutProposedChange.Payload.GetPrevious(), path),
+			ProposedValue: getShortcutContentField(shortcutProposedChange.Payload.GetProposed(), path),
+		})
+	}
+	if shortcutProposedChange.ReviewedTs > 0 {
+		proposedChange.ReviewedTime = timestamppb.New(time.Unix(shortcutProposedChange.ReviewedTs, 0))
+	}
+	return proposedChange, nil
+}
+
+func convertShortcutContentFromStorepb(shortcut *storepb.Shortcut) *storepb.ShortcutContent {
+	return &storepb.ShortcutContent{
+		Name:        shortcut.Name,
+		Link:        shortcut.Link,
+		Title:       shortcut.Title,
+		Description: shortcut.Description,
+		Tags:        shortcut.Tags,
+		Visibility:  shortcut.Visibility,
+	}
+}
+
+// getShortcutContentField returns the value of the field of the path as a string, to compare and show in a diff.
+func getShortcutContentField(content *storepb.ShortcutContent, path string) string {
+	switch path {
+	case "name":
+		return content.GetName()
+	case "link":
+		return content.GetLink()
+	case "title":
+		return content.GetTitle()
+	case "description":
+		return content.GetDescription()
+	case "tags":
+		return strings.Join(content.GetTags(), " ")
+	case "visibility":
+		return convertVisibilityFromStorepb(content.GetVisibility()).String()
+	}
+	return ""
+}
+
+func convertProposedChangeStatusFromStore(proposedChangeStatus store.ShortcutProposedChangeStatus) v1pb.ProposedChange_Status {
+	switch proposedChangeStatus {
+	case store.ShortcutProposedChangePending:
+		return v1pb.ProposedChange_PENDING
+	case store.ShortcutProposedChangeApproved:
+		return v1pb.ProposedChange_APPROVED
+	case store.ShortcutProposedChangeRejected:
+		return v1pb.ProposedChange_REJECTED
+	default:
+		return v1pb.ProposedChange_STATUS_UNSPECIFIED
+	}
+}
+
+func convertProposedChangeStatusToStore(proposedChangeStatus v1pb.ProposedChange_Status) store.ShortcutProposedChangeStatus {
+	switch proposedChangeStatus {
+	case v1pb.ProposedChange_APPROVED:
+		return store.ShortcutProposedChangeApproved
+	case v1pb.ProposedChange_REJECTED:
+		return store.ShortcutProposedChangeRejected
+	default:
+		return store.ShortcutProposedChangePending
+	}
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/warthurton/slash/plugin/qrcode"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/service/license"
+	"github.com/warthurton/slash/store"
+)
+
+func (s *APIV1Service) GetShortcutQRCode(ctx context.Context, request *v1pb.GetShortcutQRCodeRequest) (*v1pb.GetShortcutQRCodeResponse, error) {
+	size := int(request.Size)
+	if size == 0 {
+		size = qrcode.DefaultSize
+	}
+	if size < qrcode.MinSize || size > qrcode.MaxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "size must be between %d and %d", qrcode.MinSize, qrcode.MaxSize)
+	}
+	format := qrcode.FormatPNG
+	if request.Format == v1pb.GetShortcutQRCodeRequest_SVG {
+		format = qrcode.FormatSVG
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	visible, err := s.canViewShortcut(ctx, user, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	// The short link in the QR code has to be absolute.
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	if generalSetting.InstanceUrl == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "QR codes require the instance url in the workspace settings")
+	}
+	content, err := s.RenderShortcutQRCode(ctx, shortcut, generalSetting.InstanceUrl, format, size)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render qr code: %v", err)
+	}
+	return &v1pb.GetShortcutQRCodeResponse{
+		ContentType: format.ContentType(),
+		Content:     content,
+	}, nil
+}
+
+// RenderShortcutQRCode renders the QR code of the short link of the shortcut on the base url, e.g. the instance url,
+// with the branding of the workspace in the center when it's set.
+func (s *APIV1Service) RenderShortcutQRCode(ctx context.Context, shortcut *storepb.Shortcut, baseURL string, format qrcode.Format, size int) ([]byte, error) {
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace general setting")
+	}
+	link := fmt.Sprintf("%s/s/%s", baseURL, shortcut.Name)
+	options := &qrcode.Options{
+		Format: format,
+		Size:   size,
+	}
+	if s.LicenseService.IsFeatureEnabled(license.FeatureTypeCustomeBranding) {
+		options.Logo = string(generalSetting.Branding)
+	}
+	content, err := qrcode.Encode(link, options)
+	if err != nil && options.Logo != "" {
+		// The branding may not be drawable, e.g. an svg logo in a png, so the QR code goes without it.
+		slog.Warn("failed to render qr code with the branding", slog.String("error", err.Error()))
+		options.Logo = ""
+		content, err = qrcode.Encode(link, options)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
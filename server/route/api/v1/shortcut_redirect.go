@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+)
+
+// redirectCodes are the HTTP status codes the shortcuts can redirect with.
+// 301 is cached by the browsers, so the later views of the shortcut aren't counted.
+var redirectCodes = []int32{http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect}
+
+// validateRedirectCode checks the redirect code of a shortcut or the workspace, where 0 means the default.
+func validateRedirectCode(redirectCode int32) error {
+	if redirectCode != 0 && !slices.Contains(redirectCodes, redirectCode) {
+		return status.Errorf(codes.InvalidArgument, "invalid redirect code %d, must be 301, 302 or 307", redirectCode)
+	}
+	return nil
+}
+
+// getShortcutRedirectCode returns the redirect code of the shortcut, or the default of the workspace.
+// 0 means the shortcut page redirects in the browser.
+func (s *APIV1Service) getShortcutRedirectCode(ctx context.Context, shortcut *storepb.Shortcut) (int32, error) {
+	if shortcut.RedirectCode != 0 {
+		return shortcut.RedirectCode, nil
+	}
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return shortcutRelatedSetting.DefaultRedirectCode, nil
+}
+
+// ResolveShortcutRedirect returns the redirect code of the shortcut and the url it redirects to now with the raw query of the visit.
+// The code is 0 when the shortcut page is served instead, i.e. without a redirect code or when the link is not a url.
+func (s *APIV1Service) ResolveShortcutRedirect(ctx context.Context, shortcut *storepb.Shortcut, rawQuery string) (int, string, error) {
+	redirectCode, err := s.getShortcutRedirectCode(ctx, shortcut)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "failed to get shortcut redirect code")
+	}
+	if redirectCode == 0 {
+		return 0, "", nil
+	}
+	target, err := s.ResolveShortcutTarget(ctx, shortcut, rawQuery)
+	if err != nil {
+		return 0, "", err
+	}
+	if target == "" {
+		return 0, "", nil
+	}
+	return int(redirectCode), target, nil
+}
+
+// ResolveShortcutTarget returns the url the shortcut resolves to now with the raw query of the visit,
+// or an empty string when the link is not a url.
+func (s *APIV1Service) ResolveShortcutTarget(ctx context.Context, shortcut *storepb.Shortcut, rawQuery string) (string, error) {
+	return s.resolveShortcutTarget(ctx, shortcut, "", rawQuery)
+}
+
+// ResolveServerShortcutRedirect returns the redirect code of the shortcut and the url it redirects to now, with the rest
+// of the path of the visit of a wildcard shortcut, e.g. "ABC-123" of "/s/jira/ABC-123", and its raw query.
+// The code is 302 when the shortcut has none, as the shortcut page resolves neither the wildcards, the redirect rules
+// nor the variants, and 0 when the link is not a url.
+func (s *APIV1Service) ResolveServerShortcutRedirect(ctx context.Context, shortcut *storepb.Shortcut, pathSuffix, rawQuery string) (int, string, error) {
+	target, err := s.resolveShortcutTarget(ctx, shortcut, pathSuffix, rawQuery)
+	if err != nil || target == "" {
+		return 0, "", err
+	}
+	redirectCode, err := s.getShortcutRedirectCode(ctx, shortcut)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "failed to get shortcut redirect code")
+	}
+	if redirectCode == 0 {
+		redirectCode = http.StatusFound
+	}
+	return int(redirectCode), target, nil
+}
+
+func (s *APIV1Service) resolveShortcutTarget(ctx context.Context, shortcut *storepb.Shortcut, pathSuffix, rawQuery string) (string, error) {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid query")
+	}
+	link, err := s.getShortcutLinkAt(ctx, shortcut, time.Now())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get shortcut link")
+	}
+	// The redirect rules matching the visitor win over the variant picked for the visit, and both over the current link.
+	if ruleLink := common.MatchRedirectRule(shortcut.RedirectRules, common.GetVisitor(ctx)); ruleLink != "" {
+		link = ruleLink
+	} else if variant := common.GetVariant(ctx); variant != nil {
+		link = variant.Link
+	}
+	// The wildcard placeholder is removed from the link visited without the rest of the path.
+	link, err = common.ExpandWildcardLink(link, pathSuffix)
+	if err != nil {
+		// The visits which would expand the link to another host aren't redirected, like the links which aren't urls.
+		return "", nil
+	}
+	if !redirectableLinkRegexp.MatchString(link) {
+		return "", nil
+	}
+	target, err := buildShortcutRedirectURL(shortcut, link, query.Get(collectionSearchParam), rawQuery)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to build the redirect url")
+	}
+	return target, nil
+}
+
+// externalRedirectConfirmations are the confirmation preferences of the users for the external redirects, where empty follows the workspace.
+var externalRedirectConfirmations = []string{
+	"",
+	common.ExternalRedirectConfirmationWorkspace,
+	common.ExternalRedirectConfirmationAlways,
+	common.ExternalRedirectConfirmationNever,
+}
+
+// normalizeInternalDomains lowercases the internal domains of the workspace, e.g. "*.Example.com" to "example.com", and drops the empty and duplicate ones.
+func normalizeInternalDomains(internalDomains []string) ([]string, error) {
+	normalizedDomains := []string{}
+	for _, domain := range internalDomains {
+		domain = strings.TrimSuffix(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "*."), ".")
+		if domain == "" || slices.Contains(normalizedDomains, domain) {
+			continue
+		}
+		if strings.ContainsAny(domain, "/:*? ") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid internal domain %q, e.g. example.com", domain)
+		}
+		normalizedDomains = append(normalizedDomains, domain)
+	}
+	return normalizedDomains, nil
+}
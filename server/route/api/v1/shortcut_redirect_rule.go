@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+const (
+	// maxShortcutRedirectRules is the max number of redirect rules of a shortcut.
+	maxShortcutRedirectRules = 20
+)
+
+var (
+	countryCodeRegexp = regexp.MustCompile(`^[A-Z]{2}$`)
+	languageTagRegexp = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+)
+
+// convertRedirectRulesToStorepb validates the redirect rules, and normalizes their conditions and their links.
+func (s *APIV1Service) convertRedirectRulesToStorepb(ctx context.Context, redirectRules []*v1pb.Shortcut_RedirectRule) (*storepb.RedirectRules, error) {
+	if len(redirectRules) > maxShortcutRedirectRules {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d redirect rules are allowed", maxShortcutRedirectRules)
+	}
+	rules := []*storepb.RedirectRule{}
+	for i, redirectRule := range redirectRules {
+		rule := &storepb.RedirectRule{}
+		for _, country := range redirectRule.Countries {
+			country = strings.ToUpper(strings.TrimSpace(country))
+			if !countryCodeRegexp.MatchString(country) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid country %q in redirect rule %d, e.g. DE", country, i+1)
+			}
+			if !slices.Contains(rule.Countries, country) {
+				rule.Countries = append(rule.Countries, country)
+			}
+		}
+		for _, language := range redirectRule.Languages {
+			language = strings.ToLower(strings.TrimSpace(language))
+			if !languageTagRegexp.MatchString(language) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid language %q in redirect rule %d, e.g. de or pt-BR", language, i+1)
+			}
+			if !slices.Contains(rule.Languages, language) {
+				rule.Languages = append(rule.Languages, language)
+			}
+		}
+		for _, device := range redirectRule.Devices {
+			storeDevice := convertDeviceTypeToStorepb(device)
+			if storeDevice == storepb.DeviceType_DEVICE_TYPE_UNSPECIFIED {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid device %v in redirect rule %d", device, i+1)
+			}
+			if !slices.Contains(rule.Devices, storeDevice) {
+				rule.Devices = append(rule.Devices, storeDevice)
+			}
+		}
+		// The rules without conditions would shadow the link.
+		if len(rule.Countries) == 0 && len(rule.Languages) == 0 && len(rule.Devices) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "redirect rule %d must have a country, a language or a device", i+1)
+		}
+		link := strings.TrimSpace(redirectRule.Link)
+		if !redirectableLinkRegexp.MatchString(link) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid link %q in redirect rule %d, it must be a url", link, i+1)
+		}
+		link, err := s.normalizeShortcutLink(ctx, link)
+		if err != nil {
+			return nil, err
+		}
+		rule.Link = link
+		rules = append(rules, rule)
+	}
+	return &storepb.RedirectRules{
+		Rules: rules,
+	}, nil
+}
+
+func convertRedirectRulesFromStorepb(redirectRules *storepb.RedirectRules) []*v1pb.Shortcut_RedirectRule {
+	rules := []*v1pb.Shortcut_RedirectRule{}
+	for _, rule := range redirectRules.GetRules() {
+		devices := []v1pb.Shortcut_RedirectRule_DeviceType{}
+		for _, device := range rule.Devices {
+			devices = append(devices, convertDeviceTypeFromStorepb(device))
+		}
+		rules = append(rules, &v1pb.Shortcut_RedirectRule{
+			Countries: rule.Countries,
+			Languages: rule.Languages,
+			Devices:   devices,
+			Link:      rule.Link,
+		})
+	}
+	return rules
+}
+
+func convertDeviceTypeFromStorepb(device storepb.DeviceType) v1pb.Shortcut_RedirectRule_DeviceType {
+	switch device {
+	case storepb.DeviceType_DESKTOP:
+		return v1pb.Shortcut_RedirectRule_DESKTOP
+	case storepb.DeviceType_MOBILE:
+		return v1pb.Shortcut_RedirectRule_MOBILE
+	default:
+		return v1pb.Shortcut_RedirectRule_DEVICE_TYPE_UNSPECIFIED
+	}
+}
+
+func convertDeviceTypeToStorepb(device v1pb.Shortcut_RedirectRule_DeviceType) storepb.DeviceType {
+	switch device {
+	case v1pb.Shortcut_RedirectRule_DESKTOP:
+		return storepb.DeviceType_DESKTOP
+	case v1pb.Shortcut_RedirectRule_MOBILE:
+		return storepb.DeviceType_MOBILE
+	default:
+		return storepb.DeviceType_DEVICE_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// builtinReservedShortcutNames are the first segments of the routes of the server and of the frontend,
+// which the shortcuts can't be named after.
+var builtinReservedShortcutNames = []string{
+	"analytics", "api", "assets", "auth", "c", "collections", "device", "healthz", "metrics",
+	"readyz", "s", "setting", "shortcut", "shortcuts", "slash.api.v1", "status", "u",
+}
+
+// validateShortcutNameNotReserved checks that neither the name nor its first segment, e.g. "api" of "api/docs",
+// is a route of Slash or a reserved name of the workspace. The kind is "shortcut name" or "alias" for the error.
+func (s *APIV1Service) validateShortcutNameNotReserved(ctx context.Context, kind, name string) error {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	firstSegment, _, _ := strings.Cut(strings.ToLower(name), "/")
+	if slices.Contains(builtinReservedShortcutNames, firstSegment) || slices.Contains(shortcutRelatedSetting.ReservedNames, firstSegment) {
+		if firstSegment != strings.ToLower(name) {
+			return status.Errorf(codes.InvalidArgument, "%s %q starts with the reserved name %q", kind, name, firstSegment)
+		}
+		return status.Errorf(codes.InvalidArgument, "%s %q is reserved", kind, name)
+	}
+	return nil
+}
+
+// normalizeReservedShortcutNames lowercases the reserved names of the workspace, and drops the empty and duplicate ones.
+func normalizeReservedShortcutNames(reservedNames []string) ([]string, error) {
+	normalizedNames := []string{}
+	for _, name := range reservedNames {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || slices.Contains(normalizedNames, name) {
+			continue
+		}
+		if strings.ContainsAny(name, "/ \t\n") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid reserved name %q, it must be a single segment", name)
+		}
+		normalizedNames = append(normalizedNames, name)
+	}
+	return normalizedNames, nil
+}
@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+// maxShortcutRotations is the max number of rotations in the calendar of a shortcut.
+const maxShortcutRotations = 100
+
+func (s *APIV1Service) ListShortcutRotations(ctx context.Context, request *v1pb.ListShortcutRotationsRequest) (*v1pb.ListShortcutRotationsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.ShortcutId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	visible, err := s.canViewShortcut(ctx, user, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	// Like its link, the rotations of a scheduled shortcut are kept secret until it's activated.
+	if isShortcutScheduled(shortcut, time.Now()) && shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	shortcutRotations, err := s.Store.ListShortcutRotations(ctx, &store.FindShortcutRotation{
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut rotations: %v", err)
+	}
+	response := &v1pb.ListShortcutRotationsResponse{
+		Rotations: []*v1pb.ShortcutRotation{},
+	}
+	for _, shortcutRotation := range shortcutRotations {
+		response.Rotations = append(response.Rotations, convertShortcutRotationFromStore(shortcutRotation))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateShortcutRotation(ctx context.Context, request *v1pb.CreateShortcutRotationRequest) (*v1pb.ShortcutRotation, error) {
+	rotation := request.Rotation
+	if rotation == nil || strings.TrimSpace(rotation.Link) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "link is required")
+	}
+	if rotation.StartTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start time is required")
+	}
+	if err := rotation.StartTime.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start time: %v", err)
+	}
+	var endTs int64
+	if rotation.EndTime != nil {
+		if err := rotation.EndTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end time: %v", err)
+		}
+		if !rotation.EndTime.AsTime().After(rotation.StartTime.AsTime()) {
+			return nil, status.Errorf(codes.InvalidArgument, "end time must be after start time")
+		}
+		if !rotation.EndTime.AsTime().After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "end time must be in the future")
+		}
+		endTs = rotation.EndTime.AsTime().Unix()
+	}
+	user, shortcut, err := s.checkShortcutOwnerOrAdmin(ctx, request.ShortcutId)
+	if err != nil {
+		return nil, err
+	}
+	shortcutRotations, err := s.Store.ListShortcutRotations(ctx, &store.FindShortcutRotation{
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut rotations: %v", err)
+	}
+	if len(shortcutRotations) >= maxShortcutRotations {
+		return nil, status.Errorf(codes.FailedPrecondition, "at most %d rotations are allowed, delete the past ones first", maxShortcutRotations)
+	}
+	link, err := s.normalizeShortcutLink(ctx, strings.TrimSpace(rotation.Link))
+	if err != nil {
+		return nil, err
+	}
+
+	shortcutRotation, err := s.Store.CreateShortcutRotation(ctx, &store.ShortcutRotation{
+		ShortcutID: shortcut.Id,
+		CreatorID:  user.ID,
+		Link:       link,
+		StartTs:    rotation.StartTime.AsTime().Unix(),
+		EndTs:      endTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create shortcut rotation: %v", err)
+	}
+	return convertShortcutRotationFromStore(shortcutRotation), nil
+}
+
+func (s *APIV1Service) DeleteShortcutRotation(ctx context.Context, request *v1pb.DeleteShortcutRotationRequest) (*emptypb.Empty, error) {
+	if _, _, err := s.checkShortcutOwnerOrAdmin(ctx, request.ShortcutId); err != nil {
+		return nil, err
+	}
+	shortcutRotation, err := s.Store.GetShortcutRotation(ctx, &store.FindShortcutRotation{
+		ID:         &request.Id,
+		ShortcutID: &request.ShortcutId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut rotation: %v", err)
+	}
+	if shortcutRotation == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut rotation not found")
+	}
+	if err := s.Store.DeleteShortcutRotation(ctx, &store.DeleteShortcutRotation{
+		ID: shortcutRotation.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete shortcut rotation: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getShortcutLinkAt returns the link the shortcut resolves to at the time, i.e. the link of the rotation
+// started last among the active ones, otherwise the link of the shortcut.
+func (s *APIV1Service) getShortcutLinkAt(ctx context.Context, shortcut *storepb.Shortcut, t time.Time) (string, error) {
+	activeTs := t.Unix()
+	shortcutRotation, err := s.Store.GetShortcutRotation(ctx, &store.FindShortcutRotation{
+		ShortcutID: &shortcut.Id,
+		ActiveTs:   &activeTs,
+	})
+	if err != nil {
+		return "", err
+	}
+	if shortcutRotation == nil {
+		return shortcut.Link, nil
+	}
+	return shortcutRotation.Link, nil
+}
+
+func convertShortcutRotationFromStore(shortcutRotation *store.ShortcutRotation) *v1pb.ShortcutRotation {
+	convertedShortcutRotation := &v1pb.ShortcutRotation{
+		Id:          shortcutRotation.ID,
+		ShortcutId:  shortcutRotation.ShortcutID,
+		CreatorId:   shortcutRotation.CreatorID,
+		CreatedTime: timestamppb.New(time.Unix(shortcutRotation.CreatedTs, 0)),
+		Link:        shortcutRotation.Link,
+		StartTime:   timestamppb.New(time.Unix(shortcutRotation.StartTs, 0)),
+	}
+	if shortcutRotation.EndTs > 0 {
+		convertedShortcutRotation.EndTime = timestamppb.New(time.Unix(shortcutRotation.EndTs, 0))
+	}
+	return convertedShortcutRotation
+}
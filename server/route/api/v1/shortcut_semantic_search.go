@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/warthurton/slash/plugin/embedding"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	defaultSemanticSearchLimit = 10
+	maxSemanticSearchLimit     = 50
+)
+
+func (s *APIV1Service) SemanticSearchShortcuts(ctx context.Context, request *v1pb.SemanticSearchShortcutsRequest) (*v1pb.SemanticSearchShortcutsResponse, error) {
+	limit := int(request.Limit)
+	if limit < 0 || limit > maxSemanticSearchLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", maxSemanticSearchLimit)
+	}
+	if limit == 0 {
+		limit = defaultSemanticSearchLimit
+	}
+	query := strings.TrimSpace(request.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	completionSetting, err := s.Store.GetWorkspaceCompletionSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	if completionSetting.Endpoint == "" || completionSetting.EmbeddingModel == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the semantic search is disabled")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	// The embeddings are compared in memory, with only the shortcuts the user can view.
+	rowStatus := storepb.RowStatus_NORMAL
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &rowStatus,
+		ViewerID:  getViewerID(user),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts: %v", err)
+	}
+	shortcutByID := map[int32]*storepb.Shortcut{}
+	for _, shortcut := range shortcuts {
+		shortcutByID[shortcut.Id] = shortcut
+	}
+	shortcutEmbeddings, err := s.Store.ListShortcutEmbeddings(ctx, &store.FindShortcutEmbedding{
+		Model: &completionSetting.EmbeddingModel,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut embeddings: %v", err)
+	}
+	response := &v1pb.SemanticSearchShortcutsResponse{
+		Results: []*v1pb.SemanticSearchShortcutsResponse_Result{},
+	}
+	if len(shortcutEmbeddings) == 0 {
+		return response, nil
+	}
+
+	provider := embedding.NewOpenAIProvider(completionSetting.Endpoint, completionSetting.ApiKey, completionSetting.EmbeddingModel)
+	embeddings, err := provider.Embed(ctx, []string{query})
+	if err != nil {
+		slog.Warn("failed to embed semantic search query", slog.Any("error", err))
+		return nil, status.Errorf(codes.Unavailable, "failed to get the embedding of the query from the provider")
+	}
+	type scoredShortcut struct {
+		shortcut *storepb.Shortcut
+		score    float64
+	}
+	scoredShortcuts := []scoredShortcut{}
+	for _, shortcutEmbedding := range shortcutEmbeddings {
+		if shortcut, ok := shortcutByID[shortcutEmbedding.ShortcutID]; ok {
+			scoredShortcuts = append(scoredShortcuts, scoredShortcut{
+				shortcut: shortcut,
+				score:    embedding.CosineSimilarity(embeddings[0], shortcutEmbedding.Embedding),
+			})
+		}
+	}
+	// The ties are broken by name, so the order is stable.
+	slices.SortFunc(scoredShortcuts, func(a, b scoredShortcut) int {
+		return cmp.Or(cmp.Compare(b.score, a.score), cmp.Compare(a.shortcut.Name, b.shortcut.Name))
+	})
+	if len(scoredShortcuts) > limit {
+		scoredShortcuts = scoredShortcuts[:limit]
+	}
+	for _, scoredShortcut := range scoredShortcuts {
+		shortcut, err := s.convertShortcutFromStorepb(ctx, scoredShortcut.shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		response.Results = append(response.Results, &v1pb.SemanticSearchShortcutsResponse_Result{
+			Shortcut: shortcut,
+			Score:    scoredShortcut.score,
+		})
+	}
+	return response, nil
+}
@@ -3,8 +3,10 @@ package v1
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/mssola/useragent"
 	"github.com/pkg/errors"
@@ -12,23 +14,62 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/warthurton/slash/internal/util"
+	"github.com/warthurton/slash/plugin/webhook"
 	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
 	storepb "github.com/warthurton/slash/proto/gen/store"
 	"github.com/warthurton/slash/server/service/license"
 	"github.com/warthurton/slash/store"
 )
 
-func (s *APIV1Service) ListShortcuts(ctx context.Context, _ *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
-	shortcutList, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+const (
+	defaultTrendingShortcutsLimit = 10
+	maxTrendingShortcutsLimit     = 50
+
+	maxShortcutQueryParams = 20
+
+	// The bulk tag updates are written in transactions of this many shortcuts.
+	bulkUpdateShortcutTagsBatchSize  = 100
+	bulkUpdateShortcutTagsSampleSize = 10
+)
+
+// queryParamPlaceholderRegexp matches the placeholders in the query param value templates.
+var queryParamPlaceholderRegexp = regexp.MustCompile(`\{[^{}]*\}`)
+
+func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
+	page, err := parsePagination(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	rowStatus, err := convertStateToRowStatusFilter(request.State)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	creatorID, err := getCreatorIDFilter(user, request.CreatorId, request.MineOnly)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	find := &store.FindShortcut{
+		CreatorID: creatorID,
+		RowStatus: rowStatus,
+		ViewerID:  getViewerID(user),
+	}
+	find.Limit, find.Offset = page.limitOffset()
+	shortcutList, err := s.Store.ListShortcuts(ctx, find)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
 	}
 
 	shortcutMessageList := []*v1pb.Shortcut{}
-	for _, shortcut := range shortcutList {
+	for _, shortcut := range shortcutList[:page.truncate(len(shortcutList))] {
 		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
@@ -37,11 +78,247 @@ func (s *APIV1Service) ListShortcuts(ctx context.Context, _ *v1pb.ListShortcutsR
 	}
 
 	response := &v1pb.ListShortcutsResponse{
-		Shortcuts: shortcutMessageList,
+		Shortcuts:     shortcutMessageList,
+		NextPageToken: page.nextPageToken(len(shortcutList)),
 	}
 	return response, nil
 }
 
+func (s *APIV1Service) SearchShortcuts(ctx context.Context, request *v1pb.SearchShortcutsRequest) (*v1pb.SearchShortcutsResponse, error) {
+	page, err := parsePagination(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	find, err := s.convertShortcutSearchQueryToFind(ctx, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	if find == nil {
+		return &v1pb.SearchShortcutsResponse{Shortcuts: []*v1pb.Shortcut{}}, nil
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	find.ViewerID = getViewerID(user)
+	find.Limit, find.Offset = page.limitOffset()
+	shortcutList, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search shortcuts, err: %v", err)
+	}
+
+	shortcutMessageList := []*v1pb.Shortcut{}
+	for _, shortcut := range shortcutList[:page.truncate(len(shortcutList))] {
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		shortcutMessageList = append(shortcutMessageList, composedShortcut)
+	}
+	return &v1pb.SearchShortcutsResponse{
+		Shortcuts:     shortcutMessageList,
+		NextPageToken: page.nextPageToken(len(shortcutList)),
+	}, nil
+}
+
+func (s *APIV1Service) BulkUpdateShortcutTags(ctx context.Context, request *v1pb.BulkUpdateShortcutTagsRequest) (*v1pb.BulkUpdateShortcutTagsResponse, error) {
+	if err := validateTag(request.Tag); err != nil {
+		return nil, err
+	}
+	switch request.Operation {
+	case v1pb.BulkUpdateShortcutTagsRequest_ADD, v1pb.BulkUpdateShortcutTagsRequest_REMOVE:
+	case v1pb.BulkUpdateShortcutTagsRequest_REPLACE:
+		if err := validateTag(request.NewTag); err != nil {
+			return nil, err
+		}
+		if request.NewTag == request.Tag {
+			return nil, status.Errorf(codes.InvalidArgument, "new tag must differ from the tag")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "operation is required")
+	}
+
+	response := &v1pb.BulkUpdateShortcutTagsResponse{
+		Sample: []*v1pb.Shortcut{},
+	}
+	find, err := s.convertShortcutSearchQueryToFind(ctx, request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if find == nil {
+		return response, nil
+	}
+	shortcuts, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+	}
+
+	affectedShortcuts := []*storepb.Shortcut{}
+	for _, shortcut := range shortcuts {
+		tags := applyTagOperation(shortcut.Tags, request.Operation, request.Tag, request.NewTag)
+		if slices.Equal(tags, shortcut.Tags) {
+			continue
+		}
+		affectedShortcut := proto.Clone(shortcut).(*storepb.Shortcut)
+		affectedShortcut.Tags = tags
+		affectedShortcuts = append(affectedShortcuts, affectedShortcut)
+	}
+	response.AffectedCount = int32(len(affectedShortcuts))
+	for _, shortcut := range affectedShortcuts[:min(len(affectedShortcuts), bulkUpdateShortcutTagsSampleSize)] {
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		response.Sample = append(response.Sample, composedShortcut)
+	}
+	if request.DryRun {
+		return response, nil
+	}
+
+	for start := 0; start < len(affectedShortcuts); start += bulkUpdateShortcutTagsBatchSize {
+		batch := affectedShortcuts[start:min(start+bulkUpdateShortcutTagsBatchSize, len(affectedShortcuts))]
+		update := &store.UpdateShortcutTags{
+			Tags: map[int32][]string{},
+		}
+		for _, shortcut := range batch {
+			update.Tags[shortcut.Id] = shortcut.Tags
+		}
+		if err := s.Store.UpdateShortcutTags(ctx, update); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update shortcut tags after %d shortcuts, err: %v", start, err)
+		}
+		for _, shortcut := range batch {
+			s.proposeGitSyncChange(shortcut.Name, shortcut)
+		}
+	}
+	return response, nil
+}
+
+// validateTag validates a tag, which can't contain spaces as the tags are stored separated by spaces.
+func validateTag(tag string) error {
+	if tag == "" {
+		return status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	if strings.ContainsFunc(tag, unicode.IsSpace) {
+		return status.Errorf(codes.InvalidArgument, "tag %q must not contain spaces", tag)
+	}
+	return nil
+}
+
+// applyTagOperation returns the tags after the operation, keeping their order.
+func applyTagOperation(tags []string, operation v1pb.BulkUpdateShortcutTagsRequest_Operation, tag, newTag string) []string {
+	result := []string{}
+	switch operation {
+	case v1pb.BulkUpdateShortcutTagsRequest_ADD:
+		result = append(result, tags...)
+		if !slices.Contains(tags, tag) {
+			result = append(result, tag)
+		}
+	case v1pb.BulkUpdateShortcutTagsRequest_REMOVE:
+		for _, t := range tags {
+			if t != tag {
+				result = append(result, t)
+			}
+		}
+	case v1pb.BulkUpdateShortcutTagsRequest_REPLACE:
+		if !slices.Contains(tags, tag) {
+			return append(result, tags...)
+		}
+		for _, t := range tags {
+			if t == tag {
+				t = newTag
+			}
+			if !slices.Contains(result, t) {
+				result = append(result, t)
+			}
+		}
+	}
+	return result
+}
+
+// convertShortcutSearchQueryToFind converts the search query to the find of the matching shortcuts.
+// It returns nil when no shortcut matches, e.g. the creator doesn't exist.
+func (s *APIV1Service) convertShortcutSearchQueryToFind(ctx context.Context, text string) (*store.FindShortcut, error) {
+	query, err := parseShortcutSearchQuery(text)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+	find := &store.FindShortcut{
+		Tag: query.tag,
+	}
+	if len(query.keywords) > 0 {
+		search := strings.Join(query.keywords, " ")
+		find.Search = &search
+	}
+	if query.visibility != nil {
+		find.VisibilityList = []storepb.Visibility{*query.visibility}
+	}
+	if query.creator != nil {
+		creator, err := s.Store.GetUser(ctx, &store.FindUser{
+			Username: query.creator,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get creator, err: %v", err)
+		}
+		if creator == nil {
+			return nil, nil
+		}
+		find.CreatorID = &creator.ID
+	}
+	return find, nil
+}
+
+// shortcutSearchQuery is the parsed query of SearchShortcuts.
+type shortcutSearchQuery struct {
+	keywords   []string
+	tag        *string
+	visibility *storepb.Visibility
+	creator    *string
+}
+
+// parseShortcutSearchQuery parses the keywords and the `tag:`, `visibility:` and `creator:` filters of the query.
+// Words with an unknown prefix, e.g. links, are keywords.
+func parseShortcutSearchQuery(text string) (*shortcutSearchQuery, error) {
+	query := &shortcutSearchQuery{}
+	for _, word := range strings.Fields(text) {
+		key, value, found := strings.Cut(word, ":")
+		if !found {
+			query.keywords = append(query.keywords, word)
+			continue
+		}
+		switch key {
+		case "tag":
+			if query.tag != nil {
+				return nil, errors.New("duplicated tag filter")
+			}
+			if value == "" {
+				return nil, errors.New("empty tag filter")
+			}
+			query.tag = &value
+		case "visibility":
+			if query.visibility != nil {
+				return nil, errors.New("duplicated visibility filter")
+			}
+			visibility, ok := v1pb.Visibility_value[strings.ToUpper(value)]
+			if !ok || visibility == int32(v1pb.Visibility_VISIBILITY_UNSPECIFIED) {
+				return nil, errors.Errorf("unknown visibility %q", value)
+			}
+			storeVisibility := convertVisibilityToStorepb(v1pb.Visibility(visibility))
+			query.visibility = &storeVisibility
+		case "creator":
+			if query.creator != nil {
+				return nil, errors.New("duplicated creator filter")
+			}
+			if value == "" {
+				return nil, errors.New("empty creator filter")
+			}
+			query.creator = &value
+		default:
+			query.keywords = append(query.keywords, word)
+		}
+	}
+	return query, nil
+}
+
 func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcutRequest) (*v1pb.Shortcut, error) {
 	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
 		ID: &request.Id,
@@ -57,7 +334,11 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && shortcut.Visibility != storepb.Visibility_PUBLIC {
+	visible, err := s.canViewShortcut(ctx, user, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -69,21 +350,23 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 }
 
 func (s *APIV1Service) GetShortcutByName(ctx context.Context, request *v1pb.GetShortcutByNameRequest) (*v1pb.Shortcut, error) {
-	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-		Name: &request.Name,
-	})
+	shortcut, err := s.Store.GetShortcutByNameOrAlias(ctx, request.Name)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
 	}
 	if shortcut == nil {
-		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+		return nil, s.newShortcutNotFoundError(ctx, request.Name)
 	}
 
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && shortcut.Visibility != storepb.Visibility_PUBLIC {
+	visible, err := s.canViewShortcut(ctx, user, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -114,15 +397,50 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
+	if err := validateShortcutNamespace(request.Shortcut.Name, user); err != nil {
+		return nil, err
+	}
+	if err := s.validateShortcutNameNotReserved(ctx, "shortcut name", request.Shortcut.Name); err != nil {
+		return nil, err
+	}
+	aliases, err := s.normalizeShortcutAliases(ctx, 0, request.Shortcut.Name, request.Shortcut.Aliases, user)
+	if err != nil {
+		return nil, err
+	}
+	link, err := s.normalizeShortcutLink(ctx, request.Shortcut.Link)
+	if err != nil {
+		return nil, err
+	}
 	shortcutCreate := &storepb.Shortcut{
-		CreatorId:   user.ID,
-		Name:        request.Shortcut.Name,
-		Link:        request.Shortcut.Link,
-		Title:       request.Shortcut.Title,
-		Tags:        request.Shortcut.Tags,
-		Description: request.Shortcut.Description,
-		Visibility:  convertVisibilityToStorepb(request.Shortcut.Visibility),
-		OgMetadata:  &storepb.OpenGraphMetadata{},
+		CreatorId:           user.ID,
+		Name:                request.Shortcut.Name,
+		Link:                link,
+		Title:               request.Shortcut.Title,
+		Tags:                request.Shortcut.Tags,
+		Description:         request.Shortcut.Description,
+		Visibility:          convertVisibilityToStorepb(request.Shortcut.Visibility),
+		OgMetadata:          &storepb.OpenGraphMetadata{},
+		Protected:           request.Shortcut.Protected,
+		InternalDescription: request.Shortcut.InternalDescription,
+	}
+	if err := validateRedirectCode(request.Shortcut.RedirectCode); err != nil {
+		return nil, err
+	}
+	shortcutCreate.RedirectCode = request.Shortcut.RedirectCode
+	shortcutCreate.DocumentMode = convertDocumentModeToStorepb(request.Shortcut.DocumentMode)
+	if len(request.Shortcut.RedirectRules) > 0 {
+		redirectRules, err := s.convertRedirectRulesToStorepb(ctx, request.Shortcut.RedirectRules)
+		if err != nil {
+			return nil, err
+		}
+		shortcutCreate.RedirectRules = redirectRules
+	}
+	if len(request.Shortcut.Variants) > 0 {
+		variants, err := s.convertVariantsToStorepb(ctx, request.Shortcut.Variants)
+		if err != nil {
+			return nil, err
+		}
+		shortcutCreate.Variants = variants
 	}
 	if shortcutCreate.Visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
 		workspaceSetting, err := s.GetWorkspaceSetting(ctx, nil)
@@ -135,6 +453,10 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		}
 		shortcutCreate.Visibility = convertVisibilityToStorepb(visibility)
 	}
+	shortcutCreate.TeamId, err = s.getVisibilityTeamID(ctx, shortcutCreate.Visibility, request.Shortcut.TeamId)
+	if err != nil {
+		return nil, err
+	}
 	if request.Shortcut.OgMetadata != nil {
 		shortcutCreate.OgMetadata = &storepb.OpenGraphMetadata{
 			Title:       request.Shortcut.OgMetadata.Title,
@@ -142,13 +464,55 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 			Image:       request.Shortcut.OgMetadata.Image,
 		}
 	}
+	queryParams, err := convertQueryParamsToStorepb(request.Shortcut.QueryParams)
+	if err != nil {
+		return nil, err
+	}
+	shortcutCreate.OgMetadata.QueryParams = queryParams
+	if request.Shortcut.ExpireTime != nil {
+		expireTs, err := convertExpireTimeToStorepb(request.Shortcut.ExpireTime)
+		if err != nil {
+			return nil, err
+		}
+		shortcutCreate.ExpireTs = expireTs
+	}
+	if request.Shortcut.ActivateTime != nil {
+		activateTs, err := convertActivateTimeToStorepb(request.Shortcut.ActivateTime)
+		if err != nil {
+			return nil, err
+		}
+		shortcutCreate.ActivateTs = activateTs
+	}
+	if request.Shortcut.ClickGoal != nil {
+		clickGoal, err := convertClickGoalToStorepb(request.Shortcut.ClickGoal)
+		if err != nil {
+			return nil, err
+		}
+		shortcutCreate.ClickGoal = clickGoal
+	}
 	shortcut, err := s.Store.CreateShortcut(ctx, shortcutCreate)
+	if errors.Is(err, store.ErrShortcutNameTaken) {
+		return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create shortcut, err: %v", err)
 	}
+	if len(aliases) > 0 {
+		if err := s.Store.UpdateShortcutAliases(ctx, &store.UpdateShortcutAliases{
+			ShortcutID: shortcut.Id,
+			Names:      aliases,
+		}); errors.Is(err, store.ErrShortcutNameTaken) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update shortcut aliases, err: %v", err)
+		}
+	}
 	if err := s.createShortcutCreateActivity(ctx, shortcut); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create activity, err: %v", err)
 	}
+	if isOpenGraphMetadataEmpty(request.Shortcut.OgMetadata) {
+		s.fetchShortcutMetadata(shortcut.Id, shortcut.Link)
+	}
 
 	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
 	if err != nil {
@@ -176,48 +540,227 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
 	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		shortcutACL, err := s.getShortcutACL(ctx, shortcut.Id, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut acl: %v", err)
+		}
+		if shortcutACL != nil && shortcutACL.Role == store.ShortcutACLRoleEdit {
+			// Who can view the shortcut stays decided by its creator and the admins.
+			for _, path := range request.UpdateMask.Paths {
+				if slices.Contains([]string{"visibility", "team_id", "protected"}, path) {
+					return nil, status.Errorf(codes.PermissionDenied, "only the creator and admins can update %s", path)
+				}
+			}
+			return s.updateAndConvertShortcut(ctx, shortcut, request)
+		}
+		visible, err := s.canViewShortcut(ctx, user, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+		}
+		// The edits of the other users to a protected shortcut are proposed to its creator and the admins.
+		if !visible || !shortcut.Protected {
+			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		}
+		if err := s.proposeShortcutChange(ctx, user, shortcut, request.Shortcut, request.UpdateMask.Paths); err != nil {
+			return nil, err
+		}
+		// The shortcut is unchanged until the change is approved.
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		return composedShortcut, nil
+	}
+	return s.updateAndConvertShortcut(ctx, shortcut, request)
+}
+
+func (s *APIV1Service) updateAndConvertShortcut(ctx context.Context, shortcut *storepb.Shortcut, request *v1pb.UpdateShortcutRequest) (*v1pb.Shortcut, error) {
+	shortcut, err := s.updateShortcut(ctx, shortcut, request.Shortcut, request.UpdateMask.Paths)
+	if err != nil {
+		return nil, err
 	}
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return composedShortcut, nil
+}
 
+// updateShortcut updates the fields of the paths of the shortcut with the values of the requested shortcut.
+func (s *APIV1Service) updateShortcut(ctx context.Context, shortcut *storepb.Shortcut, requestShortcut *v1pb.Shortcut, paths []string) (*storepb.Shortcut, error) {
 	update := &store.UpdateShortcut{
 		ID: shortcut.Id,
 	}
-	for _, path := range request.UpdateMask.Paths {
+	// The query params are stored with the Open Graph metadata, so updating either keeps the other.
+	getOpenGraphMetadataUpdate := func() *storepb.OpenGraphMetadata {
+		if update.OpenGraphMetadata == nil {
+			update.OpenGraphMetadata = &storepb.OpenGraphMetadata{}
+			if shortcut.OgMetadata != nil {
+				update.OpenGraphMetadata = proto.Clone(shortcut.OgMetadata).(*storepb.OpenGraphMetadata)
+			}
+		}
+		return update.OpenGraphMetadata
+	}
+	var aliases []string
+	for _, path := range paths {
 		switch path {
 		case "name":
-			update.Name = &request.Shortcut.Name
+			creator, err := s.Store.GetUser(ctx, &store.FindUser{
+				ID: &shortcut.CreatorId,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get creator: %v", err)
+			}
+			if err := validateShortcutNamespace(requestShortcut.Name, creator); err != nil {
+				return nil, err
+			}
+			if err := s.validateShortcutNameNotReserved(ctx, "shortcut name", requestShortcut.Name); err != nil {
+				return nil, err
+			}
+			update.Name = &requestShortcut.Name
+		case "aliases":
+			// The aliases are validated against the updated name, after the other paths.
+			aliases = append([]string{}, requestShortcut.Aliases...)
 		case "link":
-			update.Link = &request.Shortcut.Link
+			link, err := s.normalizeShortcutLink(ctx, requestShortcut.Link)
+			if err != nil {
+				return nil, err
+			}
+			update.Link = &link
 		case "title":
-			update.Title = &request.Shortcut.Title
+			update.Title = &requestShortcut.Title
 		case "description":
-			update.Description = &request.Shortcut.Description
+			update.Description = &requestShortcut.Description
 		case "tags":
-			tag := strings.Join(request.Shortcut.Tags, " ")
+			tag := strings.Join(requestShortcut.Tags, " ")
 			update.Tag = &tag
 		case "visibility":
-			visibility := convertVisibilityToStorepb(request.Shortcut.Visibility)
+			visibility := convertVisibilityToStorepb(requestShortcut.Visibility)
 			update.Visibility = &visibility
+		case "team_id":
+			update.TeamID = &requestShortcut.TeamId
 		case "og_metadata":
-			if request.Shortcut.OgMetadata != nil {
-				update.OpenGraphMetadata = &storepb.OpenGraphMetadata{
-					Title:       request.Shortcut.OgMetadata.Title,
-					Description: request.Shortcut.OgMetadata.Description,
-					Image:       request.Shortcut.OgMetadata.Image,
-				}
+			if requestShortcut.OgMetadata != nil {
+				openGraphMetadata := getOpenGraphMetadataUpdate()
+				openGraphMetadata.Title = requestShortcut.OgMetadata.Title
+				openGraphMetadata.Description = requestShortcut.OgMetadata.Description
+				openGraphMetadata.Image = requestShortcut.OgMetadata.Image
+			}
+		case "query_params":
+			queryParams, err := convertQueryParamsToStorepb(requestShortcut.QueryParams)
+			if err != nil {
+				return nil, err
+			}
+			getOpenGraphMetadataUpdate().QueryParams = queryParams
+		case "click_goal":
+			clickGoal, err := convertClickGoalToStorepb(requestShortcut.ClickGoal)
+			if err != nil {
+				return nil, err
+			}
+			// Keep the reached time unless the target changes, so that the event isn't fired again.
+			if clickGoal.Target == shortcut.ClickGoal.GetTarget() {
+				clickGoal.ReachedTs = shortcut.ClickGoal.GetReachedTs()
+			}
+			update.ClickGoal = clickGoal
+		case "expire_time":
+			expireTs, err := convertExpireTimeToStorepb(requestShortcut.ExpireTime)
+			if err != nil {
+				return nil, err
+			}
+			update.ExpireTs = &expireTs
+			// Restore the shortcut archived by the expiration reaper, as the new expire time is in the future.
+			if shortcut.RowStatus == storepb.RowStatus_ARCHIVED && isShortcutExpired(shortcut, time.Now()) {
+				rowStatus := storepb.RowStatus_NORMAL
+				update.RowStatus = &rowStatus
+			}
+		case "activate_time":
+			activateTs, err := convertActivateTimeToStorepb(requestShortcut.ActivateTime)
+			if err != nil {
+				return nil, err
+			}
+			update.ActivateTs = &activateTs
+		case "protected":
+			update.Protected = &requestShortcut.Protected
+		case "redirect_code":
+			if err := validateRedirectCode(requestShortcut.RedirectCode); err != nil {
+				return nil, err
+			}
+			update.RedirectCode = &requestShortcut.RedirectCode
+		case "document_mode":
+			documentMode := convertDocumentModeToStorepb(requestShortcut.DocumentMode)
+			update.DocumentMode = &documentMode
+		case "internal_description":
+			update.InternalDescription = &requestShortcut.InternalDescription
+		case "redirect_rules":
+			redirectRules, err := s.convertRedirectRulesToStorepb(ctx, requestShortcut.RedirectRules)
+			if err != nil {
+				return nil, err
+			}
+			update.RedirectRules = redirectRules
+		case "variants":
+			variants, err := s.convertVariantsToStorepb(ctx, requestShortcut.Variants)
+			if err != nil {
+				return nil, err
 			}
+			update.Variants = variants
 		}
 	}
-	shortcut, err = s.Store.UpdateShortcut(ctx, update)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
+	if update.Visibility != nil || update.TeamID != nil {
+		visibility, teamID := shortcut.Visibility, shortcut.TeamId
+		if update.Visibility != nil {
+			visibility = *update.Visibility
+		}
+		if update.TeamID != nil {
+			teamID = *update.TeamID
+		}
+		teamID, err := s.getVisibilityTeamID(ctx, visibility, teamID)
+		if err != nil {
+			return nil, err
+		}
+		update.TeamID = &teamID
 	}
-
-	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	if aliases != nil {
+		name := shortcut.Name
+		if update.Name != nil {
+			name = *update.Name
+		}
+		creator, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &shortcut.CreatorId,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get creator: %v", err)
+		}
+		if aliases, err = s.normalizeShortcutAliases(ctx, shortcut.Id, name, aliases, creator); err != nil {
+			return nil, err
+		}
 	}
-	return composedShortcut, nil
+	// The aliases are stored apart, so updating only them leaves the shortcut as it is.
+	if *update != (store.UpdateShortcut{ID: shortcut.Id}) {
+		updatedShortcut, err := s.Store.UpdateShortcut(ctx, update)
+		if errors.Is(err, store.ErrShortcutNameTaken) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
+		}
+		s.proposeGitSyncChange(shortcut.Name, updatedShortcut)
+		// The metadata of the previous link doesn't describe the new one, unless the user updates both.
+		if updatedShortcut.Link != shortcut.Link && !slices.Contains(paths, "og_metadata") {
+			s.fetchShortcutMetadata(updatedShortcut.Id, updatedShortcut.Link)
+		}
+		shortcut = updatedShortcut
+	}
+	if aliases != nil {
+		if err := s.Store.UpdateShortcutAliases(ctx, &store.UpdateShortcutAliases{
+			ShortcutID: shortcut.Id,
+			Names:      aliases,
+		}); errors.Is(err, store.ErrShortcutNameTaken) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update shortcut aliases, err: %v", err)
+		}
+	}
+	return shortcut, nil
 }
 
 func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteShortcutRequest) (*emptypb.Empty, error) {
@@ -244,10 +787,15 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete shortcut, err: %v", err)
 	}
+	s.proposeGitSyncChange(shortcut.Name, nil)
 	return &emptypb.Empty{}, nil
 }
 
-func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.GetShortcutAnalyticsRequest) (*v1pb.GetShortcutAnalyticsResponse, error) {
+func (s *APIV1Service) TransferShortcut(ctx context.Context, request *v1pb.TransferShortcutRequest) (*v1pb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
 	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
 		ID: &request.Id,
 	})
@@ -257,58 +805,503 @@ func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.G
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
+	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	recipient, err := s.getTransferRecipient(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	// A shortcut in a personal namespace has to be renamed before it changes hands.
+	if err := validateShortcutNamespace(shortcut.Name, recipient); err != nil {
+		return nil, err
+	}
 
-	activityFind := &store.FindActivity{
-		Type:              store.ActivityShortcutView,
-		PayloadShortcutID: &shortcut.Id,
+	shortcut, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		CreatorID: &recipient.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to transfer shortcut, err: %v", err)
 	}
-	// For non-advanced analytics users, we limit the activity to the last 14 days.
-	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeAdvancedAnalytics) {
-		createdTsAfter := time.Now().AddDate(0, 0, -14).Unix()
-		activityFind.CreatedTsAfter = &createdTsAfter
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
 	}
-	activities, err := s.Store.ListActivities(ctx, activityFind)
+	return composedShortcut, nil
+}
+
+// getTransferRecipient returns the active user a shortcut or a collection is transferred to.
+func (s *APIV1Service) getTransferRecipient(ctx context.Context, userID int32) (*store.User, error) {
+	recipient, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get activities, err: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
+	if recipient == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if recipient.RowStatus != storepb.RowStatus_NORMAL {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot transfer to an archived user")
+	}
+	return recipient, nil
+}
 
-	referenceMap := make(map[string]int32)
-	deviceMap := make(map[string]int32)
-	browserMap := make(map[string]int32)
-	for _, activity := range activities {
-		payload := &storepb.ActivityShorcutViewPayload{}
-		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal payload, err: %v", err))
+func (s *APIV1Service) MergeShortcuts(ctx context.Context, request *v1pb.MergeShortcutsRequest) (*v1pb.Shortcut, error) {
+	shortcuts := []*storepb.Shortcut{}
+	for _, id := range request.Ids {
+		if slices.ContainsFunc(shortcuts, func(shortcut *storepb.Shortcut) bool { return shortcut.Id == id }) {
+			continue
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID: &id,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+		}
+		if shortcut == nil {
+			return nil, status.Errorf(codes.NotFound, "shortcut %d not found", id)
 		}
+		shortcuts = append(shortcuts, shortcut)
+	}
+	if len(shortcuts) < 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least two shortcuts are required")
+	}
 
-		if _, ok := referenceMap[payload.Referer]; !ok {
-			referenceMap[payload.Referer] = 0
+	survivor, err := s.pickMergeSurvivor(ctx, shortcuts, request.SurvivorId)
+	if err != nil {
+		return nil, err
+	}
+	merged := []*storepb.Shortcut{}
+	for _, shortcut := range shortcuts {
+		if shortcut.Id != survivor.Id {
+			merged = append(merged, shortcut)
 		}
-		referenceMap[payload.Referer]++
+	}
+	mergedIDs := []int32{}
+	for _, shortcut := range merged {
+		mergedIDs = append(mergedIDs, shortcut.Id)
+	}
 
-		ua := useragent.New(payload.UserAgent)
-		deviceName := ua.OSInfo().Name
-		browserName, _ := ua.Browser()
+	// The collections are updated first, as a collection referencing a shortcut twice is harmless.
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collections, err: %v", err)
+	}
+	for _, collection := range collections {
+		shortcutIDs, changed := []int32{}, false
+		for _, id := range collection.ShortcutIds {
+			if slices.Contains(mergedIDs, id) {
+				id, changed = survivor.Id, true
+			}
+			if !slices.Contains(shortcutIDs, id) {
+				shortcutIDs = append(shortcutIDs, id)
+			}
+		}
+		if !changed {
+			continue
+		}
+		if _, err := s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+			ID:          collection.Id,
+			ShortcutIDs: shortcutIDs,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update collection, err: %v", err)
+		}
+	}
+
+	if err := s.Store.MergeShortcuts(ctx, &store.MergeShortcuts{
+		SurvivorID:  survivor.Id,
+		ShortcutIDs: mergedIDs,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to merge shortcuts, err: %v", err)
+	}
+	for _, shortcut := range merged {
+		s.proposeGitSyncChange(shortcut.Name, nil)
+	}
+
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, survivor)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return composedShortcut, nil
+}
+
+// pickMergeSurvivor returns the shortcut with the survivor id, or else the most viewed shortcut, then the oldest.
+func (s *APIV1Service) pickMergeSurvivor(ctx context.Context, shortcuts []*storepb.Shortcut, survivorID int32) (*storepb.Shortcut, error) {
+	if survivorID != 0 {
+		index := slices.IndexFunc(shortcuts, func(shortcut *storepb.Shortcut) bool { return shortcut.Id == survivorID })
+		if index < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "survivor must be one of the shortcuts")
+		}
+		return shortcuts[index], nil
+	}
+
+	var survivor *storepb.Shortcut
+	var survivorViewCount int32
+	for _, shortcut := range shortcuts {
+		viewCounts, err := s.Store.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+			ShortcutID: &shortcut.Id,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count views, err: %v", err)
+		}
+		var viewCount int32
+		if len(viewCounts) > 0 {
+			viewCount = viewCounts[0].Count
+		}
+		if survivor == nil || viewCount > survivorViewCount || (viewCount == survivorViewCount && shortcut.Id < survivor.Id) {
+			survivor, survivorViewCount = shortcut, viewCount
+		}
+	}
+	return survivor, nil
+}
+
+func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.GetShortcutAnalyticsRequest) (*v1pb.GetShortcutAnalyticsResponse, error) {
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	visible, err := s.canViewShortcut(ctx, currentUser, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	response, err := s.getShortcutAnalytics(ctx, shortcut, request.Interval)
+	if err != nil {
+		return nil, err
+	}
+	// Who views the shortcut is only visible to admins, and never in the shared analytics.
+	if currentUser != nil && currentUser.Role == store.RoleAdmin {
+		users, err := s.getShortcutViewUsers(ctx, shortcut.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to aggregate views by user, err: %v", err)
+		}
+		response.Users = users
+	}
+	return response, nil
+}
 
-		if _, ok := deviceMap[deviceName]; !ok {
-			deviceMap[deviceName] = 0
+// getShortcutViewUsers returns the views of the shortcut per user by email.
+func (s *APIV1Service) getShortcutViewUsers(ctx context.Context, shortcutID int32) ([]*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem, error) {
+	viewGroups, err := s.Store.ListShortcutViewGroups(ctx, &store.FindShortcutViewGroup{
+		ShortcutID:     shortcutID,
+		Field:          store.ShortcutViewFieldUserID,
+		CreatedTsAfter: s.getAnalyticsCreatedTsAfter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The users of the views are fetched at once, where the views without user have no id.
+	userIDs := make([]int32, len(viewGroups))
+	for i, viewGroup := range viewGroups {
+		if viewGroup.Value == "" {
+			continue
 		}
-		deviceMap[deviceName]++
+		if userIDs[i], err = util.ConvertStringToInt32(viewGroup.Value); err != nil {
+			return nil, errors.Wrapf(err, "invalid user id %q", viewGroup.Value)
+		}
+	}
+	users, err := s.Store.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	userMap := make(map[string]int32)
+	for i, viewGroup := range viewGroups {
+		if viewGroup.Value == "" {
+			userMap[""] += viewGroup.Count
+			continue
+		}
+		// The views of the deleted users are kept, without their email.
+		name := fmt.Sprintf("%s%d", UserNamePrefix, userIDs[i])
+		if user := users[userIDs[i]]; user != nil {
+			name = user.Email
+		}
+		userMap[name] += viewGroup.Count
+	}
+	return mapToAnalyticsSlice(userMap), nil
+}
+
+// getAnalyticsCreatedTsAfter returns the time after which the views are in the analytics.
+// For non-advanced analytics users, we limit the activity to the last 14 days.
+func (s *APIV1Service) getAnalyticsCreatedTsAfter() *int64 {
+	if s.LicenseService.IsFeatureEnabled(license.FeatureTypeAdvancedAnalytics) {
+		return nil
+	}
+	ts := time.Now().AddDate(0, 0, -14).Unix()
+	return &ts
+}
 
-		if _, ok := browserMap[browserName]; !ok {
-			browserMap[browserName] = 0
+// getShortcutAnalytics aggregates the views of the shortcut into the analytics.
+func (s *APIV1Service) getShortcutAnalytics(ctx context.Context, shortcut *storepb.Shortcut, interval v1pb.GetShortcutAnalyticsRequest_Interval) (*v1pb.GetShortcutAnalyticsResponse, error) {
+	createdTsAfter := s.getAnalyticsCreatedTsAfter()
+	// The views are aggregated in the database, so that the activities aren't loaded into memory.
+	viewGroupsMap := map[store.ShortcutViewField][]*store.ShortcutViewGroup{}
+	for _, field := range []store.ShortcutViewField{store.ShortcutViewFieldReferer, store.ShortcutViewFieldUserAgent, store.ShortcutViewFieldCountry, store.ShortcutViewFieldVariant} {
+		viewGroups, err := s.Store.ListShortcutViewGroups(ctx, &store.FindShortcutViewGroup{
+			ShortcutID:     shortcut.Id,
+			Field:          field,
+			CreatedTsAfter: createdTsAfter,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to aggregate views by %s, err: %v", field, err)
 		}
-		browserMap[browserName]++
+		viewGroupsMap[field] = viewGroups
+	}
+
+	referenceMap := make(map[string]int32)
+	for _, viewGroup := range viewGroupsMap[store.ShortcutViewFieldReferer] {
+		referenceMap[viewGroup.Value] += viewGroup.Count
+	}
+	// User agents are parsed per distinct value, then merged by device and browser.
+	deviceMap := make(map[string]int32)
+	browserMap := make(map[string]int32)
+	for _, viewGroup := range viewGroupsMap[store.ShortcutViewFieldUserAgent] {
+		ua := useragent.New(viewGroup.Value)
+		deviceName := ua.OSInfo().Name
+		browserName, _ := ua.Browser()
+		deviceMap[deviceName] += viewGroup.Count
+		browserMap[browserName] += viewGroup.Count
+	}
+	countryMap := make(map[string]int32)
+	for _, viewGroup := range viewGroupsMap[store.ShortcutViewFieldCountry] {
+		countryMap[viewGroup.Value] += viewGroup.Count
+	}
+	// The views are only split by variant once the shortcut had variants.
+	variantMap := make(map[string]int32)
+	for _, viewGroup := range viewGroupsMap[store.ShortcutViewFieldVariant] {
+		variantMap[viewGroup.Value] += viewGroup.Count
+	}
+	if _, ok := variantMap[""]; ok && len(variantMap) == 1 {
+		variantMap = map[string]int32{}
+	}
+
+	timeseries, err := s.getShortcutViewTimeseries(ctx, shortcut.Id, interval, createdTsAfter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to aggregate views by time, err: %v", err)
 	}
 
 	response := &v1pb.GetShortcutAnalyticsResponse{
 		References: mapToAnalyticsSlice(referenceMap),
 		Devices:    mapToAnalyticsSlice(deviceMap),
 		Browsers:   mapToAnalyticsSlice(browserMap),
+		Timeseries: timeseries,
+		Countries:  mapToAnalyticsSlice(countryMap),
+		Variants:   mapToAnalyticsSlice(variantMap),
+	}
+	if clickGoal := shortcut.ClickGoal; clickGoal.GetTarget() > 0 {
+		// The progress counts all the views regardless of the analytics limit.
+		viewCounts, err := s.Store.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+			ShortcutID: &shortcut.Id,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count views, err: %v", err)
+		}
+		response.ClickGoalProgress = &v1pb.GetShortcutAnalyticsResponse_ClickGoalProgress{
+			Target: clickGoal.Target,
+		}
+		if len(viewCounts) > 0 {
+			response.ClickGoalProgress.ViewCount = viewCounts[0].Count
+		}
+		if clickGoal.ReachedTs > 0 {
+			response.ClickGoalProgress.ReachedTime = timestamppb.New(time.Unix(clickGoal.ReachedTs, 0))
+		}
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetTrendingShortcuts(ctx context.Context, request *v1pb.GetTrendingShortcutsRequest) (*v1pb.GetTrendingShortcutsResponse, error) {
+	window := 24 * time.Hour
+	if request.Window == v1pb.GetTrendingShortcutsRequest_WEEK {
+		window = 7 * 24 * time.Hour
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultTrendingShortcutsLimit
+	} else if limit > maxTrendingShortcutsLimit {
+		limit = maxTrendingShortcutsLimit
+	}
+
+	now := time.Now()
+	currentTsAfter, previousTsAfter := now.Add(-window).Unix(), now.Add(-2*window).Unix()
+	viewCounts, err := s.Store.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+		CreatedTsAfter: &currentTsAfter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list view counts, err: %v", err)
+	}
+	previousViewCounts, err := s.Store.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+		CreatedTsAfter:  &previousTsAfter,
+		CreatedTsBefore: &currentTsAfter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list view counts, err: %v", err)
+	}
+	previousViewCountMap := make(map[int32]int32)
+	for _, viewCount := range previousViewCounts {
+		previousViewCountMap[viewCount.ShortcutID] = viewCount.Count
+	}
+
+	// Only the shortcuts with growing views are trending.
+	trendingViewCounts := []*store.ShortcutViewCount{}
+	for _, viewCount := range viewCounts {
+		if viewCount.Count > previousViewCountMap[viewCount.ShortcutID] {
+			trendingViewCounts = append(trendingViewCounts, viewCount)
+		}
+	}
+	slices.SortFunc(trendingViewCounts, func(i, j *store.ShortcutViewCount) int {
+		iGrowth, jGrowth := i.Count-previousViewCountMap[i.ShortcutID], j.Count-previousViewCountMap[j.ShortcutID]
+		if iGrowth != jGrowth {
+			return int(jGrowth - iGrowth)
+		}
+		if i.Count != j.Count {
+			return int(j.Count - i.Count)
+		}
+		return int(i.ShortcutID - j.ShortcutID)
+	})
+
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	response := &v1pb.GetTrendingShortcutsResponse{
+		TrendingShortcuts: []*v1pb.GetTrendingShortcutsResponse_TrendingShortcut{},
+	}
+	shortcutIDs := []int32{}
+	for _, viewCount := range trendingViewCounts {
+		shortcutIDs = append(shortcutIDs, viewCount.ShortcutID)
+	}
+	shortcutMap, err := s.Store.GetShortcutsByIDs(ctx, shortcutIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcuts by ids: %v", err)
+	}
+	for _, viewCount := range trendingViewCounts {
+		if len(response.TrendingShortcuts) >= limit {
+			break
+		}
+		shortcut := shortcutMap[viewCount.ShortcutID]
+		// Skip the views of deleted shortcuts.
+		if shortcut == nil {
+			continue
+		}
+		visible, err := s.canViewShortcut(ctx, user, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+		}
+		if !visible {
+			continue
+		}
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		response.TrendingShortcuts = append(response.TrendingShortcuts, &v1pb.GetTrendingShortcutsResponse_TrendingShortcut{
+			Shortcut:          composedShortcut,
+			ViewCount:         viewCount.Count,
+			PreviousViewCount: previousViewCountMap[viewCount.ShortcutID],
+		})
 	}
 	return response, nil
 }
 
+func (s *APIV1Service) ListBrokenShortcuts(ctx context.Context, _ *v1pb.ListBrokenShortcutsRequest) (*v1pb.ListBrokenShortcutsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	rowStatus := storepb.RowStatus_NORMAL
+	shortcutList, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &rowStatus,
+		ViewerID:  getViewerID(user),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+	}
+	brokenShortcutList := []*storepb.Shortcut{}
+	for _, shortcut := range shortcutList {
+		if store.IsLinkBroken(shortcut.LinkHealth) {
+			brokenShortcutList = append(brokenShortcutList, shortcut)
+		}
+	}
+	slices.SortFunc(brokenShortcutList, func(i, j *storepb.Shortcut) int {
+		if i.LinkHealth.CheckedTs != j.LinkHealth.CheckedTs {
+			return int(j.LinkHealth.CheckedTs - i.LinkHealth.CheckedTs)
+		}
+		return int(i.Id - j.Id)
+	})
+
+	response := &v1pb.ListBrokenShortcutsResponse{
+		Shortcuts: []*v1pb.Shortcut{},
+	}
+	for _, shortcut := range brokenShortcutList {
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		response.Shortcuts = append(response.Shortcuts, composedShortcut)
+	}
+	return response, nil
+}
+
+const (
+	analyticsDay  = 24 * 60 * 60
+	analyticsWeek = 7 * analyticsDay
+	// analyticsWeekOffset aligns the weeks to Monday, as the unix epoch is a Thursday.
+	analyticsWeekOffset = 4 * analyticsDay
+)
+
+// getShortcutViewTimeseries returns the view counts of the shortcut per interval, including the intervals without views.
+func (s *APIV1Service) getShortcutViewTimeseries(ctx context.Context, shortcutID int32, interval v1pb.GetShortcutAnalyticsRequest_Interval, createdTsAfter *int64) ([]*v1pb.GetShortcutAnalyticsResponse_TimeseriesItem, error) {
+	bucketSize, bucketOffset := int64(analyticsDay), int64(0)
+	if interval == v1pb.GetShortcutAnalyticsRequest_WEEK {
+		bucketSize, bucketOffset = analyticsWeek, analyticsWeekOffset
+	}
+	buckets, err := s.Store.ListShortcutViewBuckets(ctx, &store.FindShortcutViewBucket{
+		ShortcutID:     shortcutID,
+		CreatedTsAfter: createdTsAfter,
+		BucketSize:     bucketSize,
+		BucketOffset:   bucketOffset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bucketStart := func(ts int64) int64 {
+		return (ts-bucketOffset)/bucketSize*bucketSize + bucketOffset
+	}
+	var startTs int64
+	if createdTsAfter != nil {
+		startTs = bucketStart(*createdTsAfter)
+	} else if len(buckets) > 0 {
+		startTs = buckets[0].StartTs
+	} else {
+		return []*v1pb.GetShortcutAnalyticsResponse_TimeseriesItem{}, nil
+	}
+	countMap := make(map[int64]int32, len(buckets))
+	for _, bucket := range buckets {
+		countMap[bucket.StartTs] = bucket.Count
+	}
+	timeseries := []*v1pb.GetShortcutAnalyticsResponse_TimeseriesItem{}
+	for ts := startTs; ts <= bucketStart(time.Now().Unix()); ts += bucketSize {
+		timeseries = append(timeseries, &v1pb.GetShortcutAnalyticsResponse_TimeseriesItem{
+			StartTime: timestamppb.New(time.Unix(ts, 0)),
+			Count:     countMap[ts],
+		})
+	}
+	return timeseries, nil
+}
+
 func mapToAnalyticsSlice(m map[string]int32) []*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem {
 	analyticsSlice := make([]*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem, 0)
 	for key, value := range m {
@@ -344,12 +1337,60 @@ func (s *APIV1Service) createShortcutCreateActivity(ctx context.Context, shortcu
 	return nil
 }
 
+// validateShortcutNamespace checks that the shortcut in a personal namespace like "~username/name"
+// belongs to the owner of the namespace.
+func validateShortcutNamespace(name string, creator *store.User) error {
+	if !strings.HasPrefix(name, PersonalNamespacePrefix) {
+		return nil
+	}
+	if creator == nil {
+		return status.Errorf(codes.PermissionDenied, "personal namespace requires a creator")
+	}
+	username, _, found := strings.Cut(strings.TrimPrefix(name, PersonalNamespacePrefix), "/")
+	if !found {
+		return status.Errorf(codes.InvalidArgument, "shortcut name in personal namespace must be like %s%s/name", PersonalNamespacePrefix, creator.Username)
+	}
+	if username != creator.Username {
+		return status.Errorf(codes.PermissionDenied, "personal namespace %s%s belongs to another user", PersonalNamespacePrefix, username)
+	}
+	return nil
+}
+
+// renameShortcutNamespace moves the shortcuts of the user to the personal namespace of the new username.
+func (s *APIV1Service) renameShortcutNamespace(ctx context.Context, userID int32, previousUsername, username string) error {
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return err
+	}
+	previousPrefix := PersonalNamespacePrefix + previousUsername + "/"
+	for _, shortcut := range shortcuts {
+		if !strings.HasPrefix(shortcut.Name, previousPrefix) {
+			continue
+		}
+		name := PersonalNamespacePrefix + username + "/" + strings.TrimPrefix(shortcut.Name, previousPrefix)
+		if _, err := s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:   shortcut.Id,
+			Name: &name,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut *storepb.Shortcut) (*v1pb.Shortcut, error) {
+	creatorUsername, err := s.getUsername(ctx, shortcut.CreatorId)
+	if err != nil {
+		return nil, err
+	}
 	composedShortcut := &v1pb.Shortcut{
 		Id:          shortcut.Id,
 		CreatorId:   shortcut.CreatorId,
 		CreatedTime: timestamppb.New(time.Unix(shortcut.CreatedTs, 0)),
 		UpdatedTime: timestamppb.New(time.Unix(shortcut.UpdatedTs, 0)),
+		State:       convertStateFromRowStatus(shortcut.RowStatus),
 		Name:        shortcut.Name,
 		Link:        shortcut.Link,
 		Title:       shortcut.Title,
@@ -360,7 +1401,81 @@ func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut
 			Title:       shortcut.OgMetadata.Title,
 			Description: shortcut.OgMetadata.Description,
 			Image:       shortcut.OgMetadata.Image,
+			Favicon:     shortcut.OgMetadata.Favicon,
 		},
+		CreatorUsername:     creatorUsername,
+		Protected:           shortcut.Protected,
+		TeamId:              shortcut.TeamId,
+		RedirectCode:        shortcut.RedirectCode,
+		DocumentMode:        convertDocumentModeFromStorepb(shortcut.DocumentMode),
+		InternalDescription: shortcut.InternalDescription,
+		RedirectRules:       convertRedirectRulesFromStorepb(shortcut.RedirectRules),
+		Variants:            convertVariantsFromStorepb(shortcut.Variants),
+	}
+	// The internal description is hidden from the visitors who aren't signed in, e.g. of a public shortcut.
+	if shortcut.InternalDescription {
+		currentUser, err := getCurrentUser(ctx, s.Store)
+		if err != nil {
+			return nil, err
+		}
+		if currentUser == nil {
+			composedShortcut.Description = ""
+		}
+	}
+	currentLink, err := s.getShortcutLinkAt(ctx, shortcut, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	composedShortcut.CurrentLink = currentLink
+	for _, queryParam := range shortcut.OgMetadata.GetQueryParams() {
+		composedShortcut.QueryParams = append(composedShortcut.QueryParams, &v1pb.Shortcut_QueryParam{
+			Key:   queryParam.Key,
+			Value: queryParam.Value,
+		})
+	}
+	if shortcut.ExpireTs > 0 {
+		composedShortcut.ExpireTime = timestamppb.New(time.Unix(shortcut.ExpireTs, 0))
+	}
+	if shortcut.ActivateTs > 0 {
+		composedShortcut.ActivateTime = timestamppb.New(time.Unix(shortcut.ActivateTs, 0))
+		// The link of a scheduled shortcut is kept secret until it's activated.
+		if isShortcutScheduled(shortcut, time.Now()) {
+			currentUser, err := getCurrentUser(ctx, s.Store)
+			if err != nil {
+				return nil, err
+			}
+			if currentUser == nil || (currentUser.ID != shortcut.CreatorId && currentUser.Role != store.RoleAdmin) {
+				composedShortcut.Link = ""
+				composedShortcut.CurrentLink = ""
+				composedShortcut.QueryParams = nil
+				composedShortcut.RedirectRules = nil
+				composedShortcut.Variants = nil
+			}
+		}
+	}
+	if linkHealth := shortcut.LinkHealth; linkHealth.GetCheckedTs() > 0 && composedShortcut.Link != "" {
+		composedShortcut.LinkHealth = &v1pb.Shortcut_LinkHealth{
+			CheckTime:  timestamppb.New(time.Unix(linkHealth.CheckedTs, 0)),
+			StatusCode: linkHealth.StatusCode,
+			Error:      linkHealth.Error,
+			Broken:     store.IsLinkBroken(linkHealth),
+		}
+	}
+	if clickGoal := shortcut.ClickGoal; clickGoal.GetTarget() > 0 {
+		composedShortcut.ClickGoal = &v1pb.Shortcut_ClickGoal{
+			Target: clickGoal.Target,
+		}
+		if clickGoal.ReachedTs > 0 {
+			composedShortcut.ClickGoal.ReachedTime = timestamppb.New(time.Unix(clickGoal.ReachedTs, 0))
+		}
+		// The webhook url may contain a secret token.
+		currentUser, err := getCurrentUser(ctx, s.Store)
+		if err != nil {
+			return nil, err
+		}
+		if currentUser != nil && (currentUser.ID == shortcut.CreatorId || currentUser.Role == store.RoleAdmin) {
+			composedShortcut.ClickGoal.WebhookUrl = clickGoal.WebhookUrl
+		}
 	}
 
 	activityList, err := s.Store.ListActivities(ctx, &store.FindActivity{
@@ -373,5 +1488,129 @@ func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut
 	}
 	composedShortcut.ViewCount = int32(len(activityList))
 
+	aliases, err := s.Store.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutID: &composedShortcut.Id,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list aliases")
+	}
+	for _, alias := range aliases {
+		composedShortcut.Aliases = append(composedShortcut.Aliases, alias.Name)
+	}
+
 	return composedShortcut, nil
 }
+
+func convertExpireTimeToStorepb(expireTime *timestamppb.Timestamp) (int64, error) {
+	if expireTime == nil {
+		return 0, nil
+	}
+	if err := expireTime.CheckValid(); err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid expire time: %v", err)
+	}
+	if !expireTime.AsTime().After(time.Now()) {
+		return 0, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+	}
+	return expireTime.AsTime().Unix(), nil
+}
+
+// isShortcutExpired returns whether the shortcut has an expire time at or before the given time.
+func isShortcutExpired(shortcut *storepb.Shortcut, now time.Time) bool {
+	return shortcut.ExpireTs > 0 && shortcut.ExpireTs <= now.Unix()
+}
+
+func convertActivateTimeToStorepb(activateTime *timestamppb.Timestamp) (int64, error) {
+	if activateTime == nil {
+		return 0, nil
+	}
+	if err := activateTime.CheckValid(); err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid activate time: %v", err)
+	}
+	if !activateTime.AsTime().After(time.Now()) {
+		return 0, status.Errorf(codes.InvalidArgument, "activate time must be in the future")
+	}
+	return activateTime.AsTime().Unix(), nil
+}
+
+// isShortcutScheduled returns whether the shortcut has an activate time after the given time.
+func isShortcutScheduled(shortcut *storepb.Shortcut, now time.Time) bool {
+	return shortcut.ActivateTs > now.Unix()
+}
+
+func convertDocumentModeFromStorepb(documentMode storepb.DocumentMode) v1pb.Shortcut_DocumentMode {
+	switch documentMode {
+	case storepb.DocumentMode_INLINE:
+		return v1pb.Shortcut_INLINE
+	case storepb.DocumentMode_DOWNLOAD:
+		return v1pb.Shortcut_DOWNLOAD
+	default:
+		return v1pb.Shortcut_DOCUMENT_MODE_UNSPECIFIED
+	}
+}
+
+func convertDocumentModeToStorepb(documentMode v1pb.Shortcut_DocumentMode) storepb.DocumentMode {
+	switch documentMode {
+	case v1pb.Shortcut_INLINE:
+		return storepb.DocumentMode_INLINE
+	case v1pb.Shortcut_DOWNLOAD:
+		return storepb.DocumentMode_DOWNLOAD
+	default:
+		return storepb.DocumentMode_DOCUMENT_MODE_UNSPECIFIED
+	}
+}
+
+func convertClickGoalToStorepb(clickGoal *v1pb.Shortcut_ClickGoal) (*storepb.ClickGoal, error) {
+	if clickGoal == nil {
+		return &storepb.ClickGoal{}, nil
+	}
+	if clickGoal.Target < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "click goal target must not be negative")
+	}
+	if clickGoal.WebhookUrl != "" {
+		if err := webhook.ValidateUserURL(clickGoal.WebhookUrl); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+	return &storepb.ClickGoal{
+		Target:     clickGoal.Target,
+		WebhookUrl: clickGoal.WebhookUrl,
+	}, nil
+}
+
+// normalizeShortcutLink strips the query parameters denied by the link parameter rules of the workspace from the link.
+func (s *APIV1Service) normalizeShortcutLink(ctx context.Context, link string) (string, error) {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
+	}
+	linkParamRules := shortcutRelatedSetting.GetLinkParamRules()
+	return util.StripQueryParams(link, linkParamRules.GetDeny(), linkParamRules.GetAllow()), nil
+}
+
+func convertQueryParamsToStorepb(queryParams []*v1pb.Shortcut_QueryParam) ([]*storepb.QueryParam, error) {
+	if len(queryParams) > maxShortcutQueryParams {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d query params are allowed", maxShortcutQueryParams)
+	}
+	list := []*storepb.QueryParam{}
+	keys := map[string]bool{}
+	for _, queryParam := range queryParams {
+		key := strings.TrimSpace(queryParam.Key)
+		if key == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "query param key is required")
+		}
+		if keys[key] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate query param %q", key)
+		}
+		keys[key] = true
+		for _, placeholder := range queryParamPlaceholderRegexp.FindAllString(queryParam.Value, -1) {
+			if placeholder != "{name}" && placeholder != "{collection}" {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown placeholder %s in query param %q, use {name} or {collection}", placeholder, key)
+			}
+		}
+		list = append(list, &storepb.QueryParam{
+			Key:   key,
+			Value: queryParam.Value,
+		})
+	}
+	return list, nil
+}
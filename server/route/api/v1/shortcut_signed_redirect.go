@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+const (
+	// defaultSignedRedirectDuration is the duration of the signed short links without an expiration time.
+	defaultSignedRedirectDuration = 7 * 24 * time.Hour
+	// maxSignedRedirectDuration is the max duration of the signed short links.
+	maxSignedRedirectDuration = 90 * 24 * time.Hour
+
+	signedRedirectSignatureParam = "sig"
+	signedRedirectExpireParam    = "exp"
+)
+
+func (s *APIV1Service) GenerateSignedRedirect(ctx context.Context, request *v1pb.GenerateSignedRedirectRequest) (*v1pb.GenerateSignedRedirectResponse, error) {
+	// The signed short links bypass the visibility of the shortcut, like the analytics shares do for its analytics.
+	_, shortcut, err := s.checkShortcutOwnerOrAdmin(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	expireTime := now.Add(defaultSignedRedirectDuration)
+	if request.ExpireTime != nil {
+		if err := request.ExpireTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire time: %v", err)
+		}
+		expireTime = request.ExpireTime.AsTime()
+	}
+	if !expireTime.After(now) || expireTime.After(now.Add(maxSignedRedirectDuration)) {
+		return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the next %d days", int(maxSignedRedirectDuration.Hours()/24))
+	}
+
+	// The signed short link has to be absolute, to be sent.
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	if generalSetting.InstanceUrl == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "signed redirects require the instance url in the workspace settings")
+	}
+	expireTs := expireTime.Unix()
+	query := url.Values{}
+	query.Set(signedRedirectExpireParam, strconv.FormatInt(expireTs, 10))
+	query.Set(signedRedirectSignatureParam, signShortcutRedirect(s.Secret, shortcut, expireTs))
+	return &v1pb.GenerateSignedRedirectResponse{
+		Url:        fmt.Sprintf("%s/s/%s?%s", strings.TrimSuffix(generalSetting.InstanceUrl, "/"), shortcut.Name, query.Encode()),
+		ExpireTime: timestamppb.New(time.Unix(expireTs, 0)),
+	}, nil
+}
+
+// ResolveSignedShortcutRedirect returns the redirect code of the shortcut and the url it redirects to now, when the
+// raw query of the visit has an unexpired signature of the shortcut. The signature parameters aren't passed to the link,
+// and the code is 302 when the shortcut has none. The code is 0 without a valid signature, or when the link is not a url.
+func (s *APIV1Service) ResolveSignedShortcutRedirect(ctx context.Context, shortcut *storepb.Shortcut, rawQuery string) (int, string, error) {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "invalid query")
+	}
+	signature := query.Get(signedRedirectSignatureParam)
+	if signature == "" {
+		return 0, "", nil
+	}
+	expireTs, err := strconv.ParseInt(query.Get(signedRedirectExpireParam), 10, 64)
+	if err != nil || expireTs <= time.Now().Unix() {
+		return 0, "", nil
+	}
+	if !hmac.Equal([]byte(signature), []byte(signShortcutRedirect(s.Secret, shortcut, expireTs))) {
+		return 0, "", nil
+	}
+
+	query.Del(signedRedirectSignatureParam)
+	query.Del(signedRedirectExpireParam)
+	target, err := s.ResolveShortcutTarget(ctx, shortcut, query.Encode())
+	if err != nil || target == "" {
+		return 0, "", err
+	}
+	redirectCode, err := s.getShortcutRedirectCode(ctx, shortcut)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "failed to get shortcut redirect code")
+	}
+	if redirectCode == 0 {
+		redirectCode = http.StatusFound
+	}
+	return int(redirectCode), target, nil
+}
+
+// signShortcutRedirect signs the short link of the shortcut until the expiration time with the workspace secret.
+// The signature covers the id and the name of the shortcut, so it's invalidated by renaming the shortcut,
+// as well as by rotating the secret.
+func signShortcutRedirect(secret string, shortcut *storepb.Shortcut, expireTs int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("signed-redirect:%d:%s:%d", shortcut.Id, shortcut.Name, expireTs)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
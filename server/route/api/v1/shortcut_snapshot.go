@@ -0,0 +1,240 @@
+package v1
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// resolutionSnapshotSignaturePrefix versions the payload signed by the resolution snapshots.
+	resolutionSnapshotSignaturePrefix = "slash-snapshot-v1"
+	// maxResolutionSnapshotsPerUser is the number of the last snapshots of a user kept for the deltas,
+	// e.g. one for the CLI and one for the browser extension of each device.
+	maxResolutionSnapshotsPerUser = 4
+	// maxResolutionSnapshotUsers bounds the users whose snapshots are kept in memory.
+	maxResolutionSnapshotUsers = 1000
+)
+
+// resolutionSnapshot is a snapshot sent to a client, kept to compute the deltas from it.
+type resolutionSnapshot struct {
+	version string
+	links   map[string]string
+}
+
+// userResolutionSnapshots are the last snapshots of a user, the latest last.
+type userResolutionSnapshots struct {
+	list       []*resolutionSnapshot
+	lastUsedTs int64
+}
+
+// resolutionSnapshots are the snapshots in memory by user id.
+// They are lost on restart, when the clients get a full snapshot.
+type resolutionSnapshots struct {
+	mutex sync.Mutex
+	users map[int32]*userResolutionSnapshots
+}
+
+func newResolutionSnapshots() *resolutionSnapshots {
+	return &resolutionSnapshots{
+		users: map[int32]*userResolutionSnapshots{},
+	}
+}
+
+// get returns the snapshot of the user with the version, or nil when it isn't kept.
+func (r *resolutionSnapshots) get(userID int32, version string) *resolutionSnapshot {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	userSnapshots, ok := r.users[userID]
+	if !ok {
+		return nil
+	}
+	for _, snapshot := range userSnapshots.list {
+		if snapshot.version == version {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// add keeps the snapshot of the user, evicting the least recently used user when there are too many.
+func (r *resolutionSnapshots) add(userID int32, snapshot *resolutionSnapshot, now time.Time) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	userSnapshots, ok := r.users[userID]
+	if !ok {
+		if len(r.users) >= maxResolutionSnapshotUsers {
+			r.evictLeastRecentlyUsed()
+		}
+		userSnapshots = &userResolutionSnapshots{}
+		r.users[userID] = userSnapshots
+	}
+	userSnapshots.lastUsedTs = now.Unix()
+	userSnapshots.list = slices.DeleteFunc(userSnapshots.list, func(s *resolutionSnapshot) bool {
+		return s.version == snapshot.version
+	})
+	userSnapshots.list = append(userSnapshots.list, snapshot)
+	if len(userSnapshots.list) > maxResolutionSnapshotsPerUser {
+		userSnapshots.list = userSnapshots.list[len(userSnapshots.list)-maxResolutionSnapshotsPerUser:]
+	}
+}
+
+// evictLeastRecentlyUsed drops the snapshots of the user who got one the longest ago. The mutex must be held.
+func (r *resolutionSnapshots) evictLeastRecentlyUsed() {
+	var evictedUserID int32
+	var evictedTs int64
+	for userID, userSnapshots := range r.users {
+		if evictedTs == 0 || userSnapshots.lastUsedTs < evictedTs {
+			evictedUserID, evictedTs = userID, userSnapshots.lastUsedTs
+		}
+	}
+	delete(r.users, evictedUserID)
+}
+
+func (s *APIV1Service) GetResolutionSnapshot(ctx context.Context, request *v1pb.GetResolutionSnapshotRequest) (*v1pb.ResolutionSnapshot, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	now := time.Now()
+	links, err := s.getResolutionLinks(ctx, user, now)
+	if err != nil {
+		return nil, err
+	}
+	version, payload := buildResolutionSnapshotPayload(links)
+	privateKey := getResolutionSnapshotSigningKey(s.Secret)
+	response := &v1pb.ResolutionSnapshot{
+		Version:    version,
+		Links:      links,
+		Signature:  ed25519.Sign(privateKey, payload),
+		PublicKey:  privateKey.Public().(ed25519.PublicKey),
+		CreateTime: timestamppb.New(now),
+	}
+	if request.SinceVersion != "" {
+		if baseSnapshot := s.resolutionSnapshots.get(user.ID, request.SinceVersion); baseSnapshot != nil {
+			response.BaseVersion = baseSnapshot.version
+			response.Links, response.RemovedNames = diffResolutionLinks(baseSnapshot.links, links)
+		}
+	}
+	s.resolutionSnapshots.add(user.ID, &resolutionSnapshot{
+		version: version,
+		links:   links,
+	}, now)
+	return response, nil
+}
+
+// getResolutionLinks returns the links the shortcuts visible to the user redirect to now, by name and alias.
+// The shortcuts which aren't redirected to, e.g. expired or with a plain text link, are left out.
+func (s *APIV1Service) getResolutionLinks(ctx context.Context, user *store.User, now time.Time) (map[string]string, error) {
+	normalStatus := storepb.RowStatus_NORMAL
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &normalStatus,
+		ViewerID:  getViewerID(user),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts: %v", err)
+	}
+	aliases, err := s.Store.ListShortcutAliases(ctx, &store.FindShortcutAlias{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut aliases: %v", err)
+	}
+	aliasesByShortcutID := map[int32][]string{}
+	for _, alias := range aliases {
+		aliasesByShortcutID[alias.ShortcutID] = append(aliasesByShortcutID[alias.ShortcutID], alias.Name)
+	}
+	activeTs := now.Unix()
+	rotations, err := s.Store.ListShortcutRotations(ctx, &store.FindShortcutRotation{
+		ActiveTs: &activeTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut rotations: %v", err)
+	}
+	// The rotations are ordered by start time, so the first one of a shortcut is the one it resolves to.
+	rotationLinks := map[int32]string{}
+	for _, rotation := range rotations {
+		if _, ok := rotationLinks[rotation.ShortcutID]; !ok {
+			rotationLinks[rotation.ShortcutID] = rotation.Link
+		}
+	}
+
+	links := map[string]string{}
+	for _, shortcut := range shortcuts {
+		if isShortcutExpired(shortcut, now) || isShortcutScheduled(shortcut, now) {
+			continue
+		}
+		// The names are lines of the signed payload.
+		if strings.ContainsAny(shortcut.Name, "\t\n") {
+			continue
+		}
+		link := shortcut.Link
+		if rotationLink, ok := rotationLinks[shortcut.Id]; ok {
+			link = rotationLink
+		}
+		if !redirectableLinkRegexp.MatchString(link) {
+			continue
+		}
+		target, err := buildShortcutRedirectURL(shortcut, link, "", "")
+		if err != nil {
+			continue
+		}
+		links[shortcut.Name] = target
+		for _, alias := range aliasesByShortcutID[shortcut.Id] {
+			links[alias] = target
+		}
+	}
+	return links, nil
+}
+
+// buildResolutionSnapshotPayload returns the version of the links and the payload to sign.
+// The links are redirectable urls, without spaces, and the names have no tabs nor newlines, so the lines are unambiguous.
+func buildResolutionSnapshotPayload(links map[string]string) (string, []byte) {
+	var builder strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(links)) {
+		builder.WriteString(name)
+		builder.WriteByte('\t')
+		builder.WriteString(links[name])
+		builder.WriteByte('\n')
+	}
+	hash := sha256.Sum256([]byte(builder.String()))
+	version := hex.EncodeToString(hash[:16])
+	return version, []byte(resolutionSnapshotSignaturePrefix + "\n" + version + "\n" + builder.String())
+}
+
+// diffResolutionLinks returns the added and changed links, and the removed names, from the base links.
+func diffResolutionLinks(baseLinks, links map[string]string) (map[string]string, []string) {
+	changedLinks := map[string]string{}
+	for name, link := range links {
+		if baseLink, ok := baseLinks[name]; !ok || baseLink != link {
+			changedLinks[name] = link
+		}
+	}
+	removedNames := []string{}
+	for name := range baseLinks {
+		if _, ok := links[name]; !ok {
+			removedNames = append(removedNames, name)
+		}
+	}
+	slices.Sort(removedNames)
+	return changedLinks, removedNames
+}
+
+// getResolutionSnapshotSigningKey derives the key signing the snapshots from the workspace secret,
+// so that the clients keep the same public key until the secret is rotated.
+func getResolutionSnapshotSigningKey(secret string) ed25519.PrivateKey {
+	seed := sha256.Sum256([]byte(resolutionSnapshotSignaturePrefix + ":" + secret))
+	return ed25519.NewKeyFromSeed(seed[:])
+}
@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+// maxShortcutSuggestions is the max number of suggested names for a shortcut not found.
+const maxShortcutSuggestions = 5
+
+func (s *APIV1Service) ListShortcutSuggestions(ctx context.Context, request *v1pb.ListShortcutSuggestionsRequest) (*v1pb.ListShortcutSuggestionsResponse, error) {
+	suggestions, err := s.findShortcutSuggestions(ctx, request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find shortcut suggestions: %v", err)
+	}
+	return &v1pb.ListShortcutSuggestionsResponse{
+		Suggestions: suggestions,
+	}, nil
+}
+
+// newShortcutNotFoundError returns the not found error of the shortcut name, with the suggested names in its details.
+func (s *APIV1Service) newShortcutNotFoundError(ctx context.Context, name string) error {
+	notFound := status.New(codes.NotFound, "shortcut not found")
+	suggestions, err := s.findShortcutSuggestions(ctx, name)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find shortcut suggestions: %v", err)
+	}
+	notFoundWithDetails, err := notFound.WithDetails(&v1pb.ShortcutNotFoundDetails{
+		Name:        name,
+		Suggestions: suggestions,
+	})
+	if err != nil {
+		return notFound.Err()
+	}
+	return notFoundWithDetails.Err()
+}
+
+// findShortcutSuggestions returns the names of the active shortcuts the current user can visit which are close
+// to the name, the closest first: the ones within a few typos, then the ones starting with it or it starts with.
+func (s *APIV1Service) findShortcutSuggestions(ctx context.Context, name string) ([]string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return []string{}, nil
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, err
+	}
+	rowStatus := storepb.RowStatus_NORMAL
+	find := &store.FindShortcut{
+		RowStatus: &rowStatus,
+		ViewerID:  getViewerID(user),
+	}
+	// The visitors only get the names of the public shortcuts, like they only visit them.
+	if user == nil {
+		find.VisibilityList = []storepb.Visibility{storepb.Visibility_PUBLIC}
+	}
+	shortcuts, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	type suggestion struct {
+		name     string
+		distance int
+	}
+	suggestions := []suggestion{}
+	maxDistance, now := getMaxShortcutNameDistance(name), time.Now().Unix()
+	for _, shortcut := range shortcuts {
+		if shortcut.ExpireTs > 0 && shortcut.ExpireTs <= now {
+			continue
+		}
+		shortcutName := strings.ToLower(shortcut.Name)
+		distance := getShortcutNameDistance(name, shortcutName)
+		if distance > maxDistance {
+			if !isShortcutNamePrefixMatch(name, shortcutName) {
+				continue
+			}
+			// The prefix matches rank after the typos.
+			distance = maxDistance + 1
+		}
+		suggestions = append(suggestions, suggestion{name: shortcut.Name, distance: distance})
+	}
+	slices.SortFunc(suggestions, func(a, b suggestion) int {
+		return cmp.Or(
+			cmp.Compare(a.distance, b.distance),
+			cmp.Compare(len(a.name), len(b.name)),
+			cmp.Compare(a.name, b.name),
+		)
+	})
+
+	names := []string{}
+	for _, suggestion := range suggestions[:min(len(suggestions), maxShortcutSuggestions)] {
+		names = append(names, suggestion.name)
+	}
+	return names, nil
+}
+
+// getMaxShortcutNameDistance returns the max number of typos in a name of the length, so that the short names
+// don't get suggestions unrelated to them.
+func getMaxShortcutNameDistance(name string) int {
+	switch length := len([]rune(name)); {
+	case length <= 2:
+		return 0
+	case length <= 5:
+		return 1
+	case length <= 10:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// isShortcutNamePrefixMatch returns true if a name starts with the other one, of at least 2 characters.
+func isShortcutNamePrefixMatch(a, b string) bool {
+	if len([]rune(a)) < 2 || len([]rune(b)) < 2 {
+		return false
+	}
+	return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+}
+
+// getShortcutNameDistance returns the number of typos between the names, i.e. the characters inserted, deleted,
+// substituted or swapped with the next one.
+func getShortcutNameDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	// distances[i][j] is the distance between the first i runes of the source and the first j runes of the target.
+	distances := make([][]int, len(source)+1)
+	for i := range distances {
+		distances[i] = make([]int, len(target)+1)
+		distances[i][0] = i
+	}
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+	for i := 1; i <= len(source); i++ {
+		for j := 1; j <= len(target); j++ {
+			substitution := 1
+			if source[i-1] == target[j-1] {
+				substitution = 0
+			}
+			distances[i][j] = min(
+				distances[i-1][j]+1,
+				distances[i][j-1]+1,
+				distances[i-1][j-1]+substitution,
+			)
+			if i > 1 && j > 1 && source[i-1] == target[j-2] && source[i-2] == target[j-1] {
+				distances[i][j] = min(distances[i][j], distances[i-2][j-2]+1)
+			}
+		}
+	}
+	return distances[len(source)][len(target)]
+}
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+const (
+	// maxShortcutVariants is the max number of variants of a shortcut.
+	maxShortcutVariants = 10
+	// maxShortcutVariantNameLength is the max length of the name of a variant, which is recorded with each view.
+	maxShortcutVariantNameLength = 32
+	// maxShortcutVariantWeight is the max weight of a variant.
+	maxShortcutVariantWeight = 10000
+)
+
+// convertVariantsToStorepb validates the variants, and normalizes their names and their links.
+// The variants weighted 0 are paused, but one of them at least must be weighted.
+func (s *APIV1Service) convertVariantsToStorepb(ctx context.Context, variants []*v1pb.Shortcut_Variant) (*storepb.Variants, error) {
+	if len(variants) == 0 {
+		return &storepb.Variants{}, nil
+	}
+	if len(variants) < 2 || len(variants) > maxShortcutVariants {
+		return nil, status.Errorf(codes.InvalidArgument, "a split needs between 2 and %d variants", maxShortcutVariants)
+	}
+	storeVariants := []*storepb.Variant{}
+	names := map[string]bool{}
+	totalWeight := int32(0)
+	for i, variant := range variants {
+		name := strings.TrimSpace(variant.Name)
+		if name == "" || len(name) > maxShortcutVariantNameLength {
+			return nil, status.Errorf(codes.InvalidArgument, "the name of variant %d must have 1 to %d characters", i+1, maxShortcutVariantNameLength)
+		}
+		if names[name] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate variant name %q", name)
+		}
+		names[name] = true
+		if variant.Weight < 0 || variant.Weight > maxShortcutVariantWeight {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid weight %d of variant %q, it must be between 0 and %d", variant.Weight, name, maxShortcutVariantWeight)
+		}
+		totalWeight += variant.Weight
+		link := strings.TrimSpace(variant.Link)
+		if !redirectableLinkRegexp.MatchString(link) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid link %q of variant %q, it must be a url", link, name)
+		}
+		link, err := s.normalizeShortcutLink(ctx, link)
+		if err != nil {
+			return nil, err
+		}
+		storeVariants = append(storeVariants, &storepb.Variant{
+			Name:   name,
+			Link:   link,
+			Weight: variant.Weight,
+		})
+	}
+	if totalWeight == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "one variant at least must have a weight")
+	}
+	return &storepb.Variants{
+		Variants: storeVariants,
+	}, nil
+}
+
+func convertVariantsFromStorepb(variants *storepb.Variants) []*v1pb.Shortcut_Variant {
+	list := []*v1pb.Shortcut_Variant{}
+	for _, variant := range variants.GetVariants() {
+		list = append(list, &v1pb.Shortcut_Variant{
+			Name:   variant.Name,
+			Link:   variant.Link,
+			Weight: variant.Weight,
+		})
+	}
+	return list
+}
@@ -0,0 +1,285 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func (s *APIV1Service) ListTeams(ctx context.Context, _ *v1pb.ListTeamsRequest) (*v1pb.ListTeamsResponse, error) {
+	teams, err := s.Store.ListTeams(ctx, &store.FindTeam{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list teams: %v", err)
+	}
+	convertedTeams := []*v1pb.Team{}
+	for _, team := range teams {
+		convertedTeam, err := s.convertTeamFromStore(ctx, team)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert team: %v", err)
+		}
+		convertedTeams = append(convertedTeams, convertedTeam)
+	}
+	return &v1pb.ListTeamsResponse{
+		Teams: convertedTeams,
+	}, nil
+}
+
+func (s *APIV1Service) GetTeam(ctx context.Context, request *v1pb.GetTeamRequest) (*v1pb.Team, error) {
+	team, err := s.getTeam(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	convertedTeam, err := s.convertTeamFromStore(ctx, team)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert team: %v", err)
+	}
+	return convertedTeam, nil
+}
+
+func (s *APIV1Service) CreateTeam(ctx context.Context, request *v1pb.CreateTeamRequest) (*v1pb.Team, error) {
+	if request.Team == nil || request.Team.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if err := s.checkTeamNameAvailable(ctx, request.Team.Name); err != nil {
+		return nil, err
+	}
+	team, err := s.Store.CreateTeam(ctx, &store.Team{
+		Name:        request.Team.Name,
+		Description: request.Team.Description,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create team: %v", err)
+	}
+	convertedTeam, err := s.convertTeamFromStore(ctx, team)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert team: %v", err)
+	}
+	return convertedTeam, nil
+}
+
+func (s *APIV1Service) UpdateTeam(ctx context.Context, request *v1pb.UpdateTeamRequest) (*v1pb.Team, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "updateMask is required")
+	}
+	if request.Team == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "team is required")
+	}
+	team, err := s.getTeam(ctx, request.Team.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateTeam{
+		ID: team.ID,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "name":
+			if request.Team.Name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "name is required")
+			}
+			if request.Team.Name != team.Name {
+				if err := s.checkTeamNameAvailable(ctx, request.Team.Name); err != nil {
+					return nil, err
+				}
+			}
+			update.Name = &request.Team.Name
+		case "description":
+			update.Description = &request.Team.Description
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	team, err = s.Store.UpdateTeam(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update team: %v", err)
+	}
+	convertedTeam, err := s.convertTeamFromStore(ctx, team)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert team: %v", err)
+	}
+	return convertedTeam, nil
+}
+
+func (s *APIV1Service) DeleteTeam(ctx context.Context, request *v1pb.DeleteTeamRequest) (*emptypb.Empty, error) {
+	team, err := s.getTeam(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteTeam(ctx, &store.DeleteTeam{
+		ID: team.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete team: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) AddTeamMember(ctx context.Context, request *v1pb.AddTeamMemberRequest) (*v1pb.Team, error) {
+	team, err := s.getTeam(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.UserId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	isMember, err := s.isTeamMember(ctx, team.ID, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get team member: %v", err)
+	}
+	// Adding a member twice is a no-op.
+	if !isMember {
+		if _, err := s.Store.CreateTeamMember(ctx, &store.TeamMember{
+			TeamID: team.ID,
+			UserID: user.ID,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create team member: %v", err)
+		}
+	}
+	convertedTeam, err := s.convertTeamFromStore(ctx, team)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert team: %v", err)
+	}
+	return convertedTeam, nil
+}
+
+func (s *APIV1Service) RemoveTeamMember(ctx context.Context, request *v1pb.RemoveTeamMemberRequest) (*v1pb.Team, error) {
+	team, err := s.getTeam(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteTeamMember(ctx, &store.DeleteTeamMember{
+		TeamID: team.ID,
+		UserID: request.UserId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete team member: %v", err)
+	}
+	convertedTeam, err := s.convertTeamFromStore(ctx, team)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert team: %v", err)
+	}
+	return convertedTeam, nil
+}
+
+func (s *APIV1Service) getTeam(ctx context.Context, id int32) (*store.Team, error) {
+	team, err := s.Store.GetTeam(ctx, &store.FindTeam{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get team: %v", err)
+	}
+	if team == nil {
+		return nil, status.Errorf(codes.NotFound, "team not found")
+	}
+	return team, nil
+}
+
+func (s *APIV1Service) checkTeamNameAvailable(ctx context.Context, name string) error {
+	team, err := s.Store.GetTeam(ctx, &store.FindTeam{
+		Name: &name,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get team: %v", err)
+	}
+	if team != nil {
+		return status.Errorf(codes.AlreadyExists, "team %q already exists", name)
+	}
+	return nil
+}
+
+func (s *APIV1Service) isTeamMember(ctx context.Context, teamID, userID int32) (bool, error) {
+	teamMember, err := s.Store.GetTeamMember(ctx, &store.FindTeamMember{
+		TeamID: &teamID,
+		UserID: &userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return teamMember != nil, nil
+}
+
+// canView returns whether the user, nil when not signed in, can view a shortcut or a collection
+// with the visibility, created by the creator and shared with the team.
+func (s *APIV1Service) canView(ctx context.Context, user *store.User, visibility storepb.Visibility, creatorID, teamID int32) (bool, error) {
+	if visibility == storepb.Visibility_PUBLIC {
+		return true, nil
+	}
+	if user == nil {
+		return false, nil
+	}
+	if user.Role == store.RoleAdmin || user.ID == creatorID {
+		return true, nil
+	}
+	switch visibility {
+	case storepb.Visibility_WORKSPACE:
+		return true, nil
+	case storepb.Visibility_TEAM:
+		return s.isTeamMember(ctx, teamID, user.ID)
+	default:
+		return false, nil
+	}
+}
+
+// getViewerID returns the viewer id filtering the listed shortcuts and collections of the user.
+// The admins see all the team shortcuts and collections.
+func getViewerID(user *store.User) *int32 {
+	if user == nil || user.Role == store.RoleAdmin {
+		return nil
+	}
+	return &user.ID
+}
+
+// getVisibilityTeamID returns the team id to store with the visibility, which must be an existing team
+// for the TEAM visibility, and is cleared for the other visibilities.
+func (s *APIV1Service) getVisibilityTeamID(ctx context.Context, visibility storepb.Visibility, teamID int32) (int32, error) {
+	if visibility != storepb.Visibility_TEAM {
+		return 0, nil
+	}
+	if teamID == 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "team_id is required for the team visibility")
+	}
+	team, err := s.Store.GetTeam(ctx, &store.FindTeam{
+		ID: &teamID,
+	})
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get team: %v", err)
+	}
+	if team == nil {
+		return 0, status.Errorf(codes.InvalidArgument, "team %d not found", teamID)
+	}
+	return team.ID, nil
+}
+
+func (s *APIV1Service) convertTeamFromStore(ctx context.Context, team *store.Team) (*v1pb.Team, error) {
+	teamMembers, err := s.Store.ListTeamMembers(ctx, &store.FindTeamMember{
+		TeamID: &team.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	memberIDs := []int32{}
+	for _, teamMember := range teamMembers {
+		memberIDs = append(memberIDs, teamMember.UserID)
+	}
+	return &v1pb.Team{
+		Id:          team.ID,
+		CreatedTime: timestamppb.New(time.Unix(team.CreatedTs, 0)),
+		UpdatedTime: timestamppb.New(time.Unix(team.UpdatedTs, 0)),
+		Name:        team.Name,
+		Description: team.Description,
+		MemberIds:   memberIDs,
+	}, nil
+}
@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+// ImpersonationDuration is the duration of the access tokens acting as another user.
+const ImpersonationDuration = 1 * time.Hour
+
+// methodsDisallowedForImpersonation are the methods which would outlive or escalate an impersonation,
+// i.e. create credentials of the user or take over the account.
+var methodsDisallowedForImpersonation = map[string]bool{
+	"/slash.api.v1.UserService/CreateUserAccessToken":      true,
+	"/slash.api.v1.UserService/ImpersonateUser":            true,
+	"/slash.api.v1.UserService/DeleteMyAccount":            true,
+	"/slash.api.v1.UserService/CreateUserEmail":            true,
+	"/slash.api.v1.UserService/SetUserPrimaryEmail":        true,
+	"/slash.api.v1.AuthService/BeginPasskeyRegistration":   true,
+	"/slash.api.v1.AuthService/FinishPasskeyRegistration":  true,
+	"/slash.api.v1.AuthService/LinkIdentityProvider":       true,
+	"/slash.api.v1.AuthService/ApproveDeviceAuthorization": true,
+}
+
+// userFieldsDisallowedForImpersonation are the fields of the user which UpdateUser can't change while impersonating,
+// as they're the credentials of the account.
+var userFieldsDisallowedForImpersonation = map[string]bool{
+	"password": true,
+	"email":    true,
+}
+
+func (s *APIV1Service) ImpersonateUser(ctx context.Context, request *v1pb.ImpersonateUserRequest) (*v1pb.ImpersonateUserResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	if currentUser.ID == request.Id {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot impersonate yourself")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	// Impersonating another admin would act with the same privileges under a different name.
+	if user.Role == store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot impersonate an admin")
+	}
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot impersonate an archived user")
+	}
+
+	expireTime := time.Now().Add(ImpersonationDuration)
+	accessToken, err := GenerateAccessToken(user.Email, user.ID, expireTime, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
+	}
+	// The access token is listed in the access tokens of the user, who can revoke it.
+	if err := s.UpsertAccessTokenToStore(ctx, user, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+		AccessToken:    accessToken,
+		Description:    fmt.Sprintf("Impersonation by %s", currentUser.Username),
+		ImpersonatorId: currentUser.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
+	}
+
+	userAgent, ip := getClientInfo(ctx, s.Profile.GetTrustedProxies())
+	payload, err := protojson.Marshal(&storepb.ActivityUserImpersonatePayload{
+		UserId:    user.ID,
+		ExpireTs:  expireTime.Unix(),
+		Ip:        ip,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal activity payload: %v", err)
+	}
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: currentUser.ID,
+		Type:      store.ActivityUserImpersonate,
+		Level:     store.ActivityWarn,
+		Payload:   string(payload),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create activity: %v", err)
+	}
+	slog.Warn("user impersonated",
+		slog.Int("impersonator", int(currentUser.ID)),
+		slog.Int("user", int(user.ID)),
+		slog.String("ip", ip),
+		slog.Time("expire", expireTime),
+	)
+
+	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
+		"Set-Cookie": s.buildAccessTokenCookie(accessToken, expireTime.Format(time.RFC1123)),
+	})); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+	}
+	return &v1pb.ImpersonateUserResponse{
+		AccessToken: accessToken,
+		ExpireTime:  timestamppb.New(expireTime),
+		User:        convertUserFromStore(user),
+	}, nil
+}
+
+// auditImpersonatedRequest checks the method called with an impersonation access token, and logs it
+// with the admin acting as the user.
+func auditImpersonatedRequest(fullMethod string, request any, userID int32, userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) error {
+	if userAccessToken.GetImpersonatorId() == 0 {
+		return nil
+	}
+	if methodsDisallowedForImpersonation[fullMethod] {
+		return status.Errorf(codes.PermissionDenied, "the method is not allowed while impersonating a user")
+	}
+	if request, ok := request.(*v1pb.UpdateUserRequest); ok {
+		for _, path := range request.GetUpdateMask().GetPaths() {
+			if userFieldsDisallowedForImpersonation[path] {
+				return status.Errorf(codes.PermissionDenied, "updating the %s is not allowed while impersonating a user", path)
+			}
+		}
+	}
+	slog.Info("impersonated request",
+		slog.String("method", fullMethod),
+		slog.Int("impersonator", int(userAccessToken.ImpersonatorId)),
+		slog.Int("user", int(userID)),
+	)
+	return nil
+}
@@ -2,9 +2,13 @@ package v1
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/exp/slices"
@@ -13,23 +17,36 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/warthurton/slash/internal/util"
 	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
 	storepb "github.com/warthurton/slash/proto/gen/store"
 	"github.com/warthurton/slash/store"
 )
 
-func (s *APIV1Service) ListUsers(ctx context.Context, _ *v1pb.ListUsersRequest) (*v1pb.ListUsersResponse, error) {
-	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+// MaxAvatarSize is the max size of the avatar in bytes.
+const MaxAvatarSize = 1 << 20
+
+var allowedAvatarTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
+
+func (s *APIV1Service) ListUsers(ctx context.Context, request *v1pb.ListUsersRequest) (*v1pb.ListUsersResponse, error) {
+	page, err := parsePagination(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	find := &store.FindUser{}
+	find.Limit, find.Offset = page.limitOffset()
+	users, err := s.Store.ListUsers(ctx, find)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
 	}
 
 	userMessages := []*v1pb.User{}
-	for _, user := range users {
+	for _, user := range users[:page.truncate(len(users))] {
 		userMessages = append(userMessages, convertUserFromStore(user))
 	}
 	response := &v1pb.ListUsersResponse{
-		Users: userMessages,
+		Users:         userMessages,
+		NextPageToken: page.nextPageToken(len(users)),
 	}
 	return response, nil
 }
@@ -57,44 +74,166 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 		return nil, err
 	}
 
+	if request.User.Username != "" {
+		if err := s.checkUsernameAvailability(ctx, request.User.Username); err != nil {
+			return nil, err
+		}
+	}
+
 	user, err := s.Store.CreateUser(ctx, &store.User{
 		Email:        request.User.Email,
 		Nickname:     request.User.Nickname,
 		Role:         store.RoleUser,
 		PasswordHash: string(passwordHash),
+		Username:     request.User.Username,
+		// The users created by admins are trusted.
+		EmailVerified: true,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	if err := s.releaseUnverifiedEmail(ctx, user.Email); err != nil {
+		return nil, err
+	}
 	return convertUserFromStore(user), nil
 }
 
 func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRequest) (*v1pb.User, error) {
-	user, err := getCurrentUser(ctx, s.Store)
+	currentUser, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user.ID != request.User.Id {
+	// Admins can update the other users.
+	isAdmin := currentUser.Role == store.RoleAdmin
+	if currentUser.ID != request.User.Id && !isAdmin {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "UpdateMask is empty")
 	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.User.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
 
 	userUpdate := &store.UpdateUser{
 		ID: request.User.Id,
 	}
+	// The avatar is stored once the other paths are valid, so that it isn't orphaned by an invalid one.
+	updateAvatar := false
+	// The sessions of the user are revoked when their password changes, except the current one of a self change.
+	revokeSessions := false
 	for _, path := range request.UpdateMask.Paths {
 		if path == "email" {
+			if request.User.Email != user.Email {
+				if err := s.checkEmailAvailability(ctx, request.User.Email); err != nil {
+					return nil, err
+				}
+			}
 			userUpdate.Email = &request.User.Email
 		} else if path == "nickname" {
 			userUpdate.Nickname = &request.User.Nickname
+		} else if path == "username" {
+			if request.User.Username != user.Username {
+				if err := s.checkUsernameAvailability(ctx, request.User.Username); err != nil {
+					return nil, err
+				}
+			}
+			userUpdate.Username = &request.User.Username
+		} else if path == "avatar_url" {
+			updateAvatar = true
+		} else if path == "password" {
+			if request.User.Password == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "password is required")
+			}
+			passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.User.Password), bcrypt.DefaultCost)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+			}
+			passwordHashString := string(passwordHash)
+			userUpdate.PasswordHash = &passwordHashString
+			revokeSessions = true
+		} else if path == "role" {
+			if !isAdmin {
+				return nil, status.Errorf(codes.PermissionDenied, "only admins can change the role")
+			}
+			if currentUser.ID == user.ID {
+				return nil, status.Errorf(codes.InvalidArgument, "cannot change your own role")
+			}
+			role, err := convertUserRoleToStore(request.User.Role)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			userUpdate.Role = &role
+		} else if path == "state" {
+			if !isAdmin {
+				return nil, status.Errorf(codes.PermissionDenied, "only admins can change the state")
+			}
+			if currentUser.ID == user.ID {
+				return nil, status.Errorf(codes.InvalidArgument, "cannot change your own state")
+			}
+			rowStatus := ConvertStateToRowStatus(request.User.State)
+			if rowStatus == storepb.RowStatus_ROW_STATUS_UNSPECIFIED {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid state %s", request.User.State)
+			}
+			userUpdate.RowStatus = &rowStatus
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
 		}
 	}
+	if updateAvatar {
+		avatarBlobID, err := s.createUserAvatarBlob(ctx, user.ID, request.User.AvatarUrl)
+		if err != nil {
+			return nil, err
+		}
+		userUpdate.AvatarBlobID = &avatarBlobID
+	}
+	previousUsername, previousAvatarBlobID := user.Username, user.AvatarBlobID
 	user, err = s.Store.UpdateUser(ctx, userUpdate)
 	if err != nil {
+		// Delete the new avatar, which no user refers to.
+		if userUpdate.AvatarBlobID != nil && *userUpdate.AvatarBlobID != 0 {
+			if err := s.Store.DeleteBlob(ctx, &store.DeleteBlob{ID: *userUpdate.AvatarBlobID}); err != nil {
+				slog.Error("failed to delete the avatar of a failed user update", slog.Int("blobID", int(*userUpdate.AvatarBlobID)), slog.Any("error", err))
+			}
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	if userUpdate.Email != nil {
+		if err := s.releaseUnverifiedEmail(ctx, user.Email); err != nil {
+			return nil, err
+		}
+	}
+	if user.Username != previousUsername {
+		if err := s.renameShortcutNamespace(ctx, user.ID, previousUsername, user.Username); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rename personal shortcuts: %v", err)
+		}
+	}
+	if previousAvatarBlobID != 0 && user.AvatarBlobID != previousAvatarBlobID {
+		if err := s.Store.DeleteBlob(ctx, &store.DeleteBlob{ID: previousAvatarBlobID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete previous avatar: %v", err)
+		}
+	}
+	if revokeSessions {
+		currentAccessToken := ""
+		if currentUser.ID == user.ID {
+			currentAccessToken, _ = ctx.Value(accessTokenContextKey).(string)
+		}
+		// Only sign-in sessions are revoked, access tokens created by the user are kept.
+		if err := s.RevokeAccessTokensFromStore(ctx, user, func(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
+			return userAccessToken.Description == SignInAccessTokenDescription && !store.MatchAccessToken(userAccessToken, currentAccessToken)
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke access tokens: %v", err)
+		}
+		if _, err := s.vacuumUserSessions(ctx, user); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to vacuum user sessions: %v", err)
+		}
+	}
 	return convertUserFromStore(user), nil
 }
 
@@ -113,6 +252,70 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	return &emptypb.Empty{}, nil
 }
 
+func (s *APIV1Service) DeleteMyAccount(ctx context.Context, request *v1pb.DeleteMyAccountRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	if user.Role == store.RoleAdmin {
+		role, rowStatus := store.RoleAdmin, storepb.RowStatus_NORMAL
+		admins, err := s.Store.ListUsers(ctx, &store.FindUser{
+			Role:      &role,
+			RowStatus: &rowStatus,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list admins: %v", err)
+		}
+		if len(admins) <= 1 {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot delete the last admin account")
+		}
+	}
+
+	userDelete := &store.DeleteUser{
+		ID: user.ID,
+	}
+	switch request.DataHandling {
+	case v1pb.DeleteMyAccountRequest_DELETE:
+	case v1pb.DeleteMyAccountRequest_TRANSFER:
+		securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+		}
+		handoverUserID := securitySetting.AccountHandoverUserId
+		if handoverUserID == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "no handover user is designated in the workspace")
+		}
+		if handoverUserID == user.ID {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot hand over to yourself, delete your data or ask an admin to designate another handover user")
+		}
+		handoverUser, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &handoverUserID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get handover user: %v", err)
+		}
+		if handoverUser == nil || handoverUser.RowStatus != storepb.RowStatus_NORMAL {
+			return nil, status.Errorf(codes.FailedPrecondition, "the handover user of the workspace is no longer active")
+		}
+		userDelete.HandoverUserID = &handoverUserID
+		// The personal namespace goes away with the user.
+		userDelete.PersonalShortcutPrefix = PersonalNamespacePrefix + user.Username + "/"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "choose whether to delete or transfer your data")
+	}
+
+	if err := s.Store.DeleteUser(ctx, userDelete); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
+	}
+	if err := s.clearAccessTokenCookie(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.ListUserAccessTokensRequest) (*v1pb.ListUserAccessTokensResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -128,33 +331,13 @@ func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.L
 	}
 
 	accessTokens := []*v1pb.UserAccessToken{}
+	now := time.Now()
 	for _, userAccessToken := range userAccessTokens {
-		claims := &ClaimsMessage{}
-		_, err := jwt.ParseWithClaims(userAccessToken.AccessToken, claims, func(t *jwt.Token) (any, error) {
-			if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-				return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
-			}
-			if kid, ok := t.Header["kid"].(string); ok {
-				if kid == "v1" {
-					return []byte(s.Secret), nil
-				}
-			}
-			return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
-		})
-		if err != nil {
-			// If the access token is invalid or expired, just ignore it.
+		// If the access token is expired, just ignore it.
+		if isAccessTokenExpired(userAccessToken, now) {
 			continue
 		}
-
-		userAccessToken := &v1pb.UserAccessToken{
-			AccessToken: userAccessToken.AccessToken,
-			Description: userAccessToken.Description,
-			IssuedAt:    timestamppb.New(claims.IssuedAt.Time),
-		}
-		if claims.ExpiresAt != nil {
-			userAccessToken.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
-		}
-		accessTokens = append(accessTokens, userAccessToken)
+		accessTokens = append(accessTokens, convertUserAccessTokenFromStore(userAccessToken))
 	}
 
 	// Sort by issued time in descending order.
@@ -175,112 +358,499 @@ func (s *APIV1Service) CreateUserAccessToken(ctx context.Context, request *v1pb.
 	if user.ID != request.Id {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
+	scopes, err := normalizeAccessTokenScopes(request.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(scopes, AccessTokenScopeAdmin) && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins can create access tokens with the admin scope")
+	}
 
 	expiresAt := time.Time{}
 	if request.ExpiresAt != nil {
 		expiresAt = request.ExpiresAt.AsTime()
 	}
-	accessToken, err := GenerateAccessToken(user.Email, user.ID, expiresAt, []byte(s.Secret))
+	userAccessToken, err := s.createUserAccessToken(ctx, user, request.Description, scopes, expiresAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token: %v", err)
+	}
+	return userAccessToken, nil
+}
+
+func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.DeleteUserAccessTokenRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.Store.UpdateUserAccessTokens(ctx, user.ID, func(userAccessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) []*storepb.UserSetting_AccessTokensSetting_AccessToken {
+		return slices.DeleteFunc(userAccessTokens, func(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
+			return userAccessToken.AccessTokenHash == request.AccessToken || store.MatchAccessToken(userAccessToken, request.AccessToken)
+		})
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update access tokens: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListUserPasskeys(ctx context.Context, request *v1pb.ListUserPasskeysRequest) (*v1pb.ListUserPasskeysResponse, error) {
+	if _, err := s.checkUserPasskeyPermission(ctx, request.Id); err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.Store.GetUserPasskeys(ctx, request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user passkeys: %v", err)
+	}
+	response := &v1pb.ListUserPasskeysResponse{
+		Passkeys: []*v1pb.UserPasskey{},
+	}
+	for _, passkey := range passkeys {
+		response.Passkeys = append(response.Passkeys, convertUserPasskeyFromStore(passkey))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) DeleteUserPasskey(ctx context.Context, request *v1pb.DeleteUserPasskeyRequest) (*emptypb.Empty, error) {
+	if _, err := s.checkUserPasskeyPermission(ctx, request.Id); err != nil {
+		return nil, err
+	}
+	passkeyID, err := base64.RawURLEncoding.DecodeString(request.PasskeyId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey id: %v", err)
+	}
+
+	if err := s.Store.DeleteUserPasskey(ctx, request.Id, passkeyID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete user passkey: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListUserEmails(ctx context.Context, request *v1pb.ListUserEmailsRequest) (*v1pb.ListUserEmailsResponse, error) {
+	if _, err := s.checkUserEmailPermission(ctx, request.Id); err != nil {
+		return nil, err
 	}
 
-	claims := &ClaimsMessage{}
-	_, err = jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
-		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-			return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+	userEmails, err := s.Store.ListUserEmails(ctx, &store.FindUserEmail{
+		UserID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user emails: %v", err)
+	}
+	response := &v1pb.ListUserEmailsResponse{
+		Emails: []*v1pb.UserEmail{},
+	}
+	for _, userEmail := range userEmails {
+		response.Emails = append(response.Emails, convertUserEmailFromStore(userEmail))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateUserEmail(ctx context.Context, request *v1pb.CreateUserEmailRequest) (*v1pb.UserEmail, error) {
+	currentUser, err := s.checkUserEmailPermission(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !util.ValidateEmail(request.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email address")
+	}
+	if err := s.checkEmailAvailability(ctx, request.Email); err != nil {
+		return nil, err
+	}
+	// Emails added by admins are trusted, otherwise they're verified by signing in with SSO.
+	verified := currentUser.Role == store.RoleAdmin
+	if verified {
+		if err := s.releaseUnverifiedEmail(ctx, request.Email); err != nil {
+			return nil, err
 		}
-		if kid, ok := t.Header["kid"].(string); ok {
-			if kid == "v1" {
-				return []byte(s.Secret), nil
-			}
+	} else {
+		// The first unverified claim of the email stands until a verified one.
+		userEmail, err := s.Store.GetUserEmail(ctx, &store.FindUserEmail{
+			Email: &request.Email,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user email: %v", err)
 		}
-		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
+		if userEmail != nil {
+			return nil, status.Errorf(codes.AlreadyExists, "email %s is already in use", request.Email)
+		}
+	}
+
+	userEmail, err := s.Store.CreateUserEmail(ctx, &store.UserEmail{
+		UserID:   request.Id,
+		Email:    request.Email,
+		Verified: verified,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to parse access token: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create user email: %v", err)
 	}
+	return convertUserEmailFromStore(userEmail), nil
+}
 
-	// Upsert the access token to user setting store.
-	if err := s.UpsertAccessTokenToStore(ctx, user, accessToken, request.Description); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
+func (s *APIV1Service) DeleteUserEmail(ctx context.Context, request *v1pb.DeleteUserEmailRequest) (*emptypb.Empty, error) {
+	if _, err := s.checkUserEmailPermission(ctx, request.Id); err != nil {
+		return nil, err
 	}
 
-	userAccessToken := &v1pb.UserAccessToken{
-		AccessToken: accessToken,
-		Description: request.Description,
-		IssuedAt:    timestamppb.New(claims.IssuedAt.Time),
+	userEmail, err := s.Store.GetUserEmail(ctx, &store.FindUserEmail{
+		UserID: &request.Id,
+		Email:  &request.Email,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user email: %v", err)
 	}
-	if claims.ExpiresAt != nil {
-		userAccessToken.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
+	if userEmail == nil {
+		return nil, status.Errorf(codes.NotFound, "user email not found")
 	}
-	return userAccessToken, nil
+	if err := s.Store.DeleteUserEmail(ctx, &store.DeleteUserEmail{ID: userEmail.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete user email: %v", err)
+	}
+	return &emptypb.Empty{}, nil
 }
 
-func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.DeleteUserAccessTokenRequest) (*emptypb.Empty, error) {
-	user, err := getCurrentUser(ctx, s.Store)
+func (s *APIV1Service) SetUserPrimaryEmail(ctx context.Context, request *v1pb.SetUserPrimaryEmailRequest) (*v1pb.User, error) {
+	if _, err := s.checkUserEmailPermission(ctx, request.Id); err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	userEmail, err := s.Store.GetUserEmail(ctx, &store.FindUserEmail{
+		UserID: &request.Id,
+		Email:  &request.Email,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user email: %v", err)
+	}
+	if userEmail == nil {
+		return nil, status.Errorf(codes.NotFound, "user email not found")
+	}
+	if !userEmail.Verified {
+		return nil, status.Errorf(codes.FailedPrecondition, "email %s is not verified", userEmail.Email)
+	}
+
+	// Swap the primary email with the secondary one, keeping the previous primary email verified.
+	user, err = s.Store.SetUserPrimaryEmail(ctx, &store.SetUserPrimaryEmail{
+		UserID:      request.Id,
+		UserEmailID: userEmail.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set user primary email: %v", err)
+	}
+	return convertUserFromStore(user), nil
+}
+
+func (s *APIV1Service) GetUserPublicProfile(ctx context.Context, request *v1pb.GetUserPublicProfileRequest) (*v1pb.UserPublicProfile, error) {
+	normalStatus := storepb.RowStatus_NORMAL
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Username:  &request.Username,
+		RowStatus: &normalStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_GENERAL,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	// Respond the same as a missing user so that the existence of the user isn't leaked.
+	if generalSetting != nil && generalSetting.GetGeneral().GetDisablePublicProfile() {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		CreatorID:      &user.ID,
+		VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts: %v", err)
+	}
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		CreatorID:      &user.ID,
+		VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collections: %v", err)
+	}
+
+	profile := &v1pb.UserPublicProfile{
+		Username:    user.Username,
+		Nickname:    user.Nickname,
+		AvatarUrl:   getUserAvatarURL(user),
+		Shortcuts:   []*v1pb.Shortcut{},
+		Collections: []*v1pb.Collection{},
+	}
+	for _, shortcut := range shortcuts {
+		convertedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut: %v", err)
+		}
+		profile.Shortcuts = append(profile.Shortcuts, convertedShortcut)
+	}
+	for _, collection := range collections {
+		convertedCollection, err := s.convertCollectionFromStore(ctx, collection)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert collection: %v", err)
+		}
+		profile.Collections = append(profile.Collections, convertedCollection)
+	}
+	return profile, nil
+}
+
+// checkUsernameAvailability checks that the username is valid and not taken by any user.
+func (s *APIV1Service) checkUsernameAvailability(ctx context.Context, username string) error {
+	if !util.ValidateUsername(username) {
+		return status.Errorf(codes.InvalidArgument, "invalid username, it must be 2-32 characters of lowercase letters, digits, \"-\" and \"_\"")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Username: &username,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user != nil {
+		return status.Errorf(codes.AlreadyExists, "username %s is already taken", username)
+	}
+	return nil
+}
+
+// createUserAvatarBlob stores the avatar from the data uri and returns the blob id.
+// An empty avatar url returns 0, which means no avatar.
+func (s *APIV1Service) createUserAvatarBlob(ctx context.Context, userID int32, avatarURL string) (int32, error) {
+	if avatarURL == "" {
+		return 0, nil
+	}
+	// The data uri looks like "data:image/png;base64,iVBORw0KGgo...".
+	header, data, found := strings.Cut(strings.TrimPrefix(avatarURL, "data:"), ",")
+	if !found || !strings.HasPrefix(avatarURL, "data:") || !strings.HasSuffix(header, ";base64") {
+		return 0, status.Errorf(codes.InvalidArgument, "avatar must be a base64 data uri")
+	}
+	if base64.StdEncoding.DecodedLen(len(data)) > MaxAvatarSize {
+		return 0, status.Errorf(codes.InvalidArgument, "avatar is too large, the max size is %d bytes", MaxAvatarSize)
+	}
+	blob, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid avatar data: %v", err)
+	}
+	// Detect the type from the content rather than trusting the declared one.
+	contentType := http.DetectContentType(blob)
+	if !slices.Contains(allowedAvatarTypes, contentType) {
+		return 0, status.Errorf(codes.InvalidArgument, "unsupported avatar type %s", contentType)
+	}
+	avatar, err := s.Store.CreateBlob(ctx, &store.Blob{
+		CreatorID: userID,
+		Type:      contentType,
+		Blob:      blob,
+	})
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to create avatar: %v", err)
+	}
+	return avatar.ID, nil
+}
+
+// getUsername returns the username of the user, or empty if the user doesn't exist.
+func (s *APIV1Service) getUsername(ctx context.Context, userID int32) (string, error) {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return "", nil
+	}
+	return user.Username, nil
+}
+
+// checkUserEmailPermission checks that the current user can manage the emails of the user, and returns the current user.
+func (s *APIV1Service) checkUserEmailPermission(ctx context.Context, userID int32) (*store.User, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return currentUser, nil
+}
+
+// checkUserPasskeyPermission checks that the current user can manage the passkeys of the user, and returns the current user.
+// Admins can list and remove the passkeys of others, e.g. a lost one, but only the user can register them.
+func (s *APIV1Service) checkUserPasskeyPermission(ctx context.Context, userID int32) (*store.User, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list access tokens: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	updatedUserAccessTokens := []*storepb.UserSetting_AccessTokensSetting_AccessToken{}
-	for _, userAccessToken := range userAccessTokens {
-		if userAccessToken.AccessToken == request.AccessToken {
-			continue
+	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return currentUser, nil
+}
+
+// checkEmailAvailability checks that the email is neither a primary nor a verified secondary email of any user.
+// The unverified secondary emails don't count, as anyone can add them, and they're released by the verified claims.
+func (s *APIV1Service) checkEmailAvailability(ctx context.Context, email string) error {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Email: &email,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	verified := true
+	userEmail, err := s.Store.GetUserEmail(ctx, &store.FindUserEmail{
+		Email:    &email,
+		Verified: &verified,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user email: %v", err)
+	}
+	if user != nil || userEmail != nil {
+		return status.Errorf(codes.AlreadyExists, "email %s is already in use", email)
+	}
+	return nil
+}
+
+// releaseUnverifiedEmail deletes the unverified secondary emails of the email, once it's claimed as a primary email
+// or a verified one, so that nobody can hold the email of someone else.
+func (s *APIV1Service) releaseUnverifiedEmail(ctx context.Context, email string) error {
+	verified := false
+	userEmails, err := s.Store.ListUserEmails(ctx, &store.FindUserEmail{
+		Email:    &email,
+		Verified: &verified,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list user emails: %v", err)
+	}
+	for _, userEmail := range userEmails {
+		if err := s.Store.DeleteUserEmail(ctx, &store.DeleteUserEmail{ID: userEmail.ID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete user email: %v", err)
 		}
-		updatedUserAccessTokens = append(updatedUserAccessTokens, userAccessToken)
-	}
-	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: user.ID,
-		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-		Value: &storepb.UserSetting_AccessTokens{
-			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
-				AccessTokens: updatedUserAccessTokens,
-			},
-		},
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 	}
+	return nil
+}
 
-	return &emptypb.Empty{}, nil
+// normalizeAccessTokenScopes checks that the scopes are known, and drops the duplicate ones.
+func normalizeAccessTokenScopes(requestScopes []string) ([]string, error) {
+	scopes := []string{}
+	for _, scope := range requestScopes {
+		if !slices.Contains(accessTokenScopes, scope) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown scope %q, the scopes are %s", scope, strings.Join(accessTokenScopes, ", "))
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
 }
 
-func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken, description string) error {
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+// createUserAccessToken generates an access token of the user and stores it,
+// and returns it with the access token itself, which is only returned here as only its hash is stored.
+func (s *APIV1Service) createUserAccessToken(ctx context.Context, user *store.User, description string, scopes []string, expiresAt time.Time) (*v1pb.UserAccessToken, error) {
+	accessToken, err := GenerateAccessToken(user.Email, user.ID, expiresAt, []byte(s.Secret))
 	if err != nil {
-		return errors.Wrap(err, "failed to get user access tokens")
+		return nil, errors.Wrap(err, "failed to generate access token")
 	}
-	userAccessToken := storepb.UserSetting_AccessTokensSetting_AccessToken{
+	storedAccessToken := &storepb.UserSetting_AccessTokensSetting_AccessToken{
 		AccessToken: accessToken,
 		Description: description,
+		Scopes:      scopes,
+	}
+	if err := s.UpsertAccessTokenToStore(ctx, user, storedAccessToken); err != nil {
+		return nil, errors.Wrap(err, "failed to upsert access token to store")
 	}
-	userAccessTokens = append(userAccessTokens, &userAccessToken)
-	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: user.ID,
-		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-		Value: &storepb.UserSetting_AccessTokens{
-			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
-				AccessTokens: userAccessTokens,
-			},
-		},
+	userAccessToken := convertUserAccessTokenFromStore(storedAccessToken)
+	userAccessToken.AccessToken = accessToken
+	return userAccessToken, nil
+}
+
+// UpsertAccessTokenToStore adds the access token to the user, storing the hash of the access token
+// in place of the access token itself, with the times of its claims.
+func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) error {
+	claims, err := s.parseAccessTokenClaims(userAccessToken.AccessToken)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse access token")
+	}
+	if claims.IssuedAt != nil {
+		userAccessToken.IssuedTs = claims.IssuedAt.Unix()
+	}
+	if claims.ExpiresAt != nil {
+		userAccessToken.ExpiresTs = claims.ExpiresAt.Unix()
+	}
+	if err := store.SetAccessTokenHash(userAccessToken, userAccessToken.AccessToken); err != nil {
+		return errors.Wrap(err, "failed to hash access token")
+	}
+	if err := s.Store.UpdateUserAccessTokens(ctx, user.ID, func(userAccessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) []*storepb.UserSetting_AccessTokensSetting_AccessToken {
+		return append(userAccessTokens, userAccessToken)
+	}); err != nil {
+		return errors.Wrap(err, "failed to update access tokens")
+	}
+	return nil
+}
+
+// RevokeAccessTokensFromStore removes the access tokens of the user matched by revoke.
+// Removed access tokens are rejected by the auth interceptor immediately.
+func (s *APIV1Service) RevokeAccessTokensFromStore(ctx context.Context, user *store.User, revoke func(*storepb.UserSetting_AccessTokensSetting_AccessToken) bool) error {
+	if err := s.Store.UpdateUserAccessTokens(ctx, user.ID, func(userAccessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) []*storepb.UserSetting_AccessTokensSetting_AccessToken {
+		return slices.DeleteFunc(userAccessTokens, revoke)
 	}); err != nil {
-		return errors.Wrap(err, "failed to upsert user setting")
+		return errors.Wrap(err, "failed to update access tokens")
 	}
 	return nil
 }
 
 func convertUserFromStore(user *store.User) *v1pb.User {
 	return &v1pb.User{
-		Id:          int32(user.ID),
-		State:       convertStateFromRowStatus(user.RowStatus),
-		CreatedTime: timestamppb.New(time.Unix(user.CreatedTs, 0)),
-		UpdatedTime: timestamppb.New(time.Unix(user.UpdatedTs, 0)),
-		Role:        convertUserRoleFromStore(user.Role),
-		Email:       user.Email,
-		Nickname:    user.Nickname,
+		Id:            int32(user.ID),
+		State:         convertStateFromRowStatus(user.RowStatus),
+		CreatedTime:   timestamppb.New(time.Unix(user.CreatedTs, 0)),
+		UpdatedTime:   timestamppb.New(time.Unix(user.UpdatedTs, 0)),
+		Role:          convertUserRoleFromStore(user.Role),
+		Email:         user.Email,
+		Nickname:      user.Nickname,
+		Username:      user.Username,
+		AvatarUrl:     getUserAvatarURL(user),
+		EmailVerified: user.EmailVerified,
+	}
+}
+
+// getUserAvatarURL returns the url of the avatar route of the user.
+// The blob id is appended as version to bust caches when the avatar changes.
+func getUserAvatarURL(user *store.User) string {
+	if user.AvatarBlobID == 0 {
+		return fmt.Sprintf("/u/%d/avatar", user.ID)
+	}
+	return fmt.Sprintf("/u/%d/avatar?v=%d", user.ID, user.AvatarBlobID)
+}
+
+func convertUserEmailFromStore(userEmail *store.UserEmail) *v1pb.UserEmail {
+	return &v1pb.UserEmail{
+		Email:       userEmail.Email,
+		Verified:    userEmail.Verified,
+		CreatedTime: timestamppb.New(time.Unix(userEmail.CreatedTs, 0)),
+	}
+}
+
+func convertUserRoleToStore(role v1pb.Role) (store.Role, error) {
+	switch role {
+	case v1pb.Role_ADMIN:
+		return store.RoleAdmin, nil
+	case v1pb.Role_USER:
+		return store.RoleUser, nil
+	default:
+		return "", errors.Errorf("invalid role %s", role)
 	}
 }
 
@@ -294,3 +864,19 @@ func convertUserRoleFromStore(role store.Role) v1pb.Role {
 		return v1pb.Role_ROLE_UNSPECIFIED
 	}
 }
+
+func convertUserAccessTokenFromStore(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) *v1pb.UserAccessToken {
+	convertedUserAccessToken := &v1pb.UserAccessToken{
+		Id:          userAccessToken.AccessTokenHash,
+		Description: userAccessToken.Description,
+		Scopes:      userAccessToken.Scopes,
+		IssuedAt:    timestamppb.New(time.Unix(userAccessToken.IssuedTs, 0)),
+	}
+	if userAccessToken.ExpiresTs > 0 {
+		convertedUserAccessToken.ExpiresAt = timestamppb.New(time.Unix(userAccessToken.ExpiresTs, 0))
+	}
+	if userAccessToken.LastUsedTs > 0 {
+		convertedUserAccessToken.LastUsedAt = timestamppb.New(time.Unix(userAccessToken.LastUsedTs, 0))
+	}
+	return convertedUserAccessToken
+}
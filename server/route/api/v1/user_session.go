@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mssola/useragent"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/store"
+)
+
+func (s *APIV1Service) ListUserSessions(ctx context.Context, request *v1pb.ListUserSessionsRequest) (*v1pb.ListUserSessionsResponse, error) {
+	user, err := s.checkUserSessionPermission(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	sessionAccessTokens, err := s.vacuumUserSessions(ctx, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to vacuum user sessions: %v", err)
+	}
+	userSessions, err := s.Store.ListUserSessions(ctx, &store.FindUserSession{
+		UserID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user sessions: %v", err)
+	}
+
+	currentAccessToken, _ := ctx.Value(accessTokenContextKey).(string)
+	response := &v1pb.ListUserSessionsResponse{
+		Sessions: []*v1pb.UserSession{},
+	}
+	for _, userSession := range userSessions {
+		userAccessToken := sessionAccessTokens[userSession.ID]
+		if userAccessToken == nil {
+			continue
+		}
+		convertedUserSession := &v1pb.UserSession{
+			Id:          userSession.ID,
+			Device:      getDeviceName(userSession.UserAgent),
+			UserAgent:   userSession.UserAgent,
+			Ip:          userSession.IP,
+			CreatedTime: timestamppb.New(time.Unix(userSession.CreatedTs, 0)),
+			Current:     store.MatchAccessToken(userAccessToken, currentAccessToken),
+		}
+		if userAccessToken.LastUsedTs > 0 {
+			convertedUserSession.LastSeenTime = timestamppb.New(time.Unix(userAccessToken.LastUsedTs, 0))
+		}
+		if userAccessToken.ExpiresTs > 0 {
+			convertedUserSession.ExpireTime = timestamppb.New(time.Unix(userAccessToken.ExpiresTs, 0))
+		}
+		response.Sessions = append(response.Sessions, convertedUserSession)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RevokeUserSession(ctx context.Context, request *v1pb.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	user, err := s.checkUserSessionPermission(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	userSession, err := s.Store.GetUserSession(ctx, &store.FindUserSession{
+		ID:     &request.SessionId,
+		UserID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user session: %v", err)
+	}
+	if userSession == nil {
+		return nil, status.Errorf(codes.NotFound, "session not found")
+	}
+	// The access tokens of the session are rejected by the auth interceptor right after they're revoked.
+	if err := s.RevokeAccessTokensFromStore(ctx, user, func(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
+		return userAccessToken.SessionId == userSession.ID
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens: %v", err)
+	}
+	if err := s.Store.DeleteUserSession(ctx, &store.DeleteUserSession{
+		ID: userSession.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete user session: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// vacuumUserSessions deletes the sessions of the user which have no valid access tokens left, e.g. signed out or expired,
+// and returns the access tokens of the remaining sessions by the session id.
+func (s *APIV1Service) vacuumUserSessions(ctx context.Context, user *store.User) (map[int32]*storepb.UserSetting_AccessTokensSetting_AccessToken, error) {
+	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user access tokens")
+	}
+	sessionAccessTokens := map[int32]*storepb.UserSetting_AccessTokensSetting_AccessToken{}
+	now := time.Now()
+	for _, userAccessToken := range userAccessTokens {
+		if userAccessToken.SessionId == 0 {
+			continue
+		}
+		if isAccessTokenExpired(userAccessToken, now) {
+			continue
+		}
+		sessionAccessTokens[userAccessToken.SessionId] = userAccessToken
+	}
+	userSessions, err := s.Store.ListUserSessions(ctx, &store.FindUserSession{
+		UserID: &user.ID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user sessions")
+	}
+	for _, userSession := range userSessions {
+		if sessionAccessTokens[userSession.ID] != nil {
+			continue
+		}
+		if err := s.Store.DeleteUserSession(ctx, &store.DeleteUserSession{
+			ID: userSession.ID,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to delete user session")
+		}
+	}
+	return sessionAccessTokens, nil
+}
+
+// parseAccessTokenClaims parses and verifies the access token signed with the secret of the server.
+func (s *APIV1Service) parseAccessTokenClaims(accessToken string) (*ClaimsMessage, error) {
+	claims := &ClaimsMessage{}
+	if _, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
+		if kid, ok := t.Header["kid"].(string); ok && kid == KeyID {
+			return []byte(s.Secret), nil
+		}
+		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}), jwt.WithAudience(AccessTokenAudienceName)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// checkUserSessionPermission checks that the current user is the user of the sessions, and returns the current user.
+func (s *APIV1Service) checkUserSessionPermission(ctx context.Context, userID int32) (*store.User, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil || user.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return user, nil
+}
+
+// getClientInfo returns the user agent and the IP address of the client of the request.
+// The IP address is the one forwarded by the trusted proxies if any, otherwise the address of the peer.
+func getClientInfo(ctx context.Context, trustedProxies []netip.Prefix) (string, string) {
+	var userAgent, peerIP string
+	var forwardedFor []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+			if values := md.Get(key); len(values) > 0 && values[0] != "" {
+				userAgent = values[0]
+				break
+			}
+		}
+		forwardedFor = md.Get("x-forwarded-for")
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(peerIP); err == nil {
+			peerIP = host
+		}
+	}
+	return userAgent, common.GetClientIP(peerIP, forwardedFor, trustedProxies)
+}
+
+// getDeviceName returns the browser and the OS of the user agent, e.g. "Chrome on macOS".
+func getDeviceName(userAgent string) string {
+	if userAgent == "" {
+		return ""
+	}
+	ua := useragent.New(userAgent)
+	browserName, _ := ua.Browser()
+	osName := ua.OSInfo().Name
+	if osName == "" {
+		return browserName
+	}
+	return fmt.Sprintf("%s on %s", browserName, osName)
+}
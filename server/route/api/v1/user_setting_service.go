@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -31,13 +32,18 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 	}
 	for _, path := range request.UpdateMask.Paths {
 		if path == "general" {
+			if !slices.Contains(externalRedirectConfirmations, request.UserSetting.General.ExternalRedirectConfirmation) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid external redirect confirmation %q", request.UserSetting.General.ExternalRedirectConfirmation)
+			}
 			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
 				UserId: user.ID,
 				Key:    storepb.UserSettingKey_USER_SETTING_GENERAL,
 				Value: &storepb.UserSetting_General{
 					General: &storepb.UserSetting_GeneralSetting{
-						Locale:     request.UserSetting.General.Locale,
-						ColorTheme: request.UserSetting.General.ColorTheme,
+						Locale:                       request.UserSetting.General.Locale,
+						ColorTheme:                   request.UserSetting.General.ColorTheme,
+						DisablePublicProfile:         request.UserSetting.General.DisablePublicProfile,
+						ExternalRedirectConfirmation: request.UserSetting.General.ExternalRedirectConfirmation,
 					},
 				},
 			}); err != nil {
@@ -73,8 +79,10 @@ func getUserSetting(ctx context.Context, s *store.Store, userID int32) (*v1pb.Us
 	for _, setting := range userSettings {
 		if setting.Key == storepb.UserSettingKey_USER_SETTING_GENERAL {
 			userSetting.General = &v1pb.UserSetting_GeneralSetting{
-				Locale:     setting.GetGeneral().Locale,
-				ColorTheme: setting.GetGeneral().ColorTheme,
+				Locale:                       setting.GetGeneral().Locale,
+				ColorTheme:                   setting.GetGeneral().ColorTheme,
+				DisablePublicProfile:         setting.GetGeneral().DisablePublicProfile,
+				ExternalRedirectConfirmation: setting.GetGeneral().ExternalRedirectConfirmation,
 			}
 		}
 	}
@@ -3,6 +3,8 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net/textproto"
+	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -13,6 +15,8 @@ import (
 
 	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
 	"github.com/warthurton/slash/server/profile"
+	"github.com/warthurton/slash/server/service/federation"
+	"github.com/warthurton/slash/server/service/gitsync"
 	"github.com/warthurton/slash/server/service/license"
 	"github.com/warthurton/slash/store"
 )
@@ -25,31 +29,57 @@ type APIV1Service struct {
 	v1pb.UnimplementedUserSettingServiceServer
 	v1pb.UnimplementedShortcutServiceServer
 	v1pb.UnimplementedCollectionServiceServer
+	v1pb.UnimplementedSearchServiceServer
+	v1pb.UnimplementedDashboardServiceServer
+	v1pb.UnimplementedTeamServiceServer
 
-	Secret         string
-	Profile        *profile.Profile
-	Store          *store.Store
-	LicenseService *license.LicenseService
+	Secret            string
+	Profile           *profile.Profile
+	Store             *store.Store
+	LicenseService    *license.LicenseService
+	GitSyncService    *gitsync.Service
+	FederationService *federation.Service
 
-	grpcServer     *grpc.Server
-	grpcServerPort int
+	grpcServer           *grpc.Server
+	grpcServerPort       int
+	deviceAuthorizations *deviceAuthorizations
+	passkeyChallenges    *passkeyChallenges
+	resolutionSnapshots  *resolutionSnapshots
+	importQuota          *importQuota
+	// backgroundTasks are the writes outliving their request, e.g. the fetch of the shortcut metadata,
+	// which are waited for on shutdown.
+	backgroundTasks sync.WaitGroup
 }
 
-func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, grpcServerPort int) *APIV1Service {
+func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, gitSyncService *gitsync.Service, federationService *federation.Service, grpcServerPort int) *APIV1Service {
 	authProvider := NewGRPCAuthInterceptor(store, secret)
+	authProvider.breakGlassEmail = profile.BreakGlassEmail
+	authProvider.breakGlassPasswordHash = profile.BreakGlassPasswordHash
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			NewLoggerInterceptor().LoggerInterceptor,
+			NewErrorInterceptor().ErrorInterceptor,
+			NewRecoveryInterceptor().RecoveryInterceptor,
+			NewDeadlineInterceptor(MaxHandlerDuration).DeadlineInterceptor,
+			NewRateLimitInterceptor(profile.AuthRateLimit, profile.AuthLockoutThreshold, profile.GetTrustedProxies(), secret).RateLimitInterceptor,
 			authProvider.AuthenticationInterceptor,
 		),
+		// No keepalive options, as ServeHTTP ignores them on the main port, where the clients connect.
+		// The idle HTTP/2 connections are pinged by the HTTP/2 settings of the http.Server instead.
 	)
 	apiV1Service := &APIV1Service{
-		Secret:         secret,
-		Profile:        profile,
-		Store:          store,
-		LicenseService: licenseService,
-		grpcServer:     grpcServer,
-		grpcServerPort: grpcServerPort,
+		Secret:               secret,
+		Profile:              profile,
+		Store:                store,
+		LicenseService:       licenseService,
+		GitSyncService:       gitSyncService,
+		FederationService:    federationService,
+		grpcServer:           grpcServer,
+		grpcServerPort:       grpcServerPort,
+		deviceAuthorizations: newDeviceAuthorizations(),
+		passkeyChallenges:    newPasskeyChallenges(),
+		resolutionSnapshots:  newResolutionSnapshots(),
+		importQuota:          newImportQuota(profile.ImportRateLimit, profile.ImportBurst),
 	}
 
 	v1pb.RegisterSubscriptionServiceServer(grpcServer, apiV1Service)
@@ -59,6 +89,9 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterUserSettingServiceServer(grpcServer, apiV1Service)
 	v1pb.RegisterShortcutServiceServer(grpcServer, apiV1Service)
 	v1pb.RegisterCollectionServiceServer(grpcServer, apiV1Service)
+	v1pb.RegisterSearchServiceServer(grpcServer, apiV1Service)
+	v1pb.RegisterDashboardServiceServer(grpcServer, apiV1Service)
+	v1pb.RegisterTeamServiceServer(grpcServer, apiV1Service)
 	reflection.Register(grpcServer)
 
 	return apiV1Service
@@ -68,6 +101,30 @@ func (s *APIV1Service) GetGRPCServer() *grpc.Server {
 	return s.grpcServer
 }
 
+// runInBackground runs the task outliving its request in a goroutine, which is waited for on shutdown.
+func (s *APIV1Service) runInBackground(task func()) {
+	s.backgroundTasks.Add(1)
+	go func() {
+		defer s.backgroundTasks.Done()
+		task()
+	}()
+}
+
+// WaitBackgroundTasks waits for the background tasks, or returns the error of the context once it's done.
+func (s *APIV1Service) WaitBackgroundTasks(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.backgroundTasks.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // RegisterGateway registers the gRPC-Gateway with the given Echo instance.
 func (s *APIV1Service) RegisterGateway(_ context.Context, e *echo.Echo) error {
 	// Create a client connection to the gRPC Server we just started.
@@ -80,7 +137,15 @@ func (s *APIV1Service) RegisterGateway(_ context.Context, e *echo.Echo) error {
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		// The trace id of the access log is forwarded along with the default headers.
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if textproto.CanonicalMIMEHeaderKey(key) == RequestIDHeader {
+				return RequestIDHeader, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+	)
 	if err := v1pb.RegisterSubscriptionServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
@@ -102,6 +167,21 @@ func (s *APIV1Service) RegisterGateway(_ context.Context, e *echo.Echo) error {
 	if err := v1pb.RegisterCollectionServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterSearchServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterDashboardServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterTeamServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	s.registerBookmarkRoutes(e)
+	s.registerGitSyncRoutes(e)
+	s.registerExportRoutes(e)
+	s.registerImportRoutes(e)
+	s.registerImportJobRoutes(e)
+	s.registerSAMLRoutes(e)
 	e.Any("/api/v1/*", echo.WrapHandler(gwMux))
 
 	// GRPC web proxy.
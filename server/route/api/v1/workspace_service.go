@@ -1,15 +1,32 @@
 package v1
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/warthurton/slash/internal/util"
+	"github.com/warthurton/slash/plugin/completion"
+	"github.com/warthurton/slash/plugin/github"
+	"github.com/warthurton/slash/plugin/idp/ldap"
+	"github.com/warthurton/slash/plugin/idp/oauth2"
+	"github.com/warthurton/slash/plugin/idp/saml"
+	"github.com/warthurton/slash/plugin/mail"
+	"github.com/warthurton/slash/plugin/webhook"
 	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
 	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/server/service/federation"
 	"github.com/warthurton/slash/store"
 )
 
@@ -53,15 +70,51 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorks
 	for _, v := range workspaceSettings {
 		if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL {
 			generalSetting := v.GetGeneral()
+			workspaceSetting.InstanceUrl = generalSetting.GetInstanceUrl()
 			workspaceSetting.Branding = generalSetting.GetBranding()
 			workspaceSetting.CustomStyle = generalSetting.GetCustomStyle()
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY {
 			securitySetting := v.GetSecurity()
 			workspaceSetting.DisallowUserRegistration = securitySetting.GetDisallowUserRegistration()
 			workspaceSetting.DisallowPasswordAuth = securitySetting.GetDisallowPasswordAuth()
+			workspaceSetting.AccessTokenInactivityDays = securitySetting.GetAccessTokenInactivityDays()
+			workspaceSetting.AccountHandoverUserId = securitySetting.GetAccountHandoverUserId()
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED {
 			shortcutRelatedSetting := v.GetShortcutRelated()
 			workspaceSetting.DefaultVisibility = convertVisibilityFromStorepb(shortcutRelatedSetting.GetDefaultVisibility())
+			if anomalyAlertSetting := shortcutRelatedSetting.GetAnomalyAlert(); anomalyAlertSetting != nil {
+				workspaceSetting.AnomalyAlert = &v1pb.AnomalyAlertSetting{
+					Enabled:         anomalyAlertSetting.Enabled,
+					ZScoreThreshold: anomalyAlertSetting.ZScoreThreshold,
+					MinViews:        anomalyAlertSetting.MinViews,
+				}
+				// The webhook url may contain a secret token.
+				if currentUser != nil && currentUser.Role == store.RoleAdmin {
+					workspaceSetting.AnomalyAlert.WebhookUrl = anomalyAlertSetting.WebhookUrl
+				}
+			}
+			if linkHealthCheckSetting := shortcutRelatedSetting.GetLinkHealthCheck(); linkHealthCheckSetting != nil {
+				workspaceSetting.LinkHealthCheck = &v1pb.LinkHealthCheckSetting{
+					Enabled:        linkHealthCheckSetting.Enabled,
+					NotifyCreators: linkHealthCheckSetting.NotifyCreators,
+				}
+				// The webhook url may contain a secret token.
+				if currentUser != nil && currentUser.Role == store.RoleAdmin {
+					workspaceSetting.LinkHealthCheck.WebhookUrl = linkHealthCheckSetting.WebhookUrl
+				}
+			}
+			if linkParamRules := shortcutRelatedSetting.GetLinkParamRules(); linkParamRules != nil {
+				workspaceSetting.LinkParamRules = &v1pb.LinkParamRules{
+					Deny:  linkParamRules.Deny,
+					Allow: linkParamRules.Allow,
+				}
+			}
+			workspaceSetting.ViewDedupeWindowSeconds = shortcutRelatedSetting.GetViewDedupeWindowSeconds()
+			workspaceSetting.AttributeViewsToUsers = shortcutRelatedSetting.GetAttributeViewsToUsers()
+			workspaceSetting.DefaultRedirectCode = shortcutRelatedSetting.GetDefaultRedirectCode()
+			workspaceSetting.InternalDomains = shortcutRelatedSetting.GetInternalDomains()
+			workspaceSetting.ReservedShortcutNames = shortcutRelatedSetting.GetReservedNames()
+			workspaceSetting.ConfirmExternalRedirects = shortcutRelatedSetting.GetConfirmExternalRedirects()
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER {
 			identityProviderSetting := v.GetIdentityProvider()
 			workspaceSetting.IdentityProviders = []*v1pb.IdentityProvider{}
@@ -72,9 +125,78 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorks
 					if oauth2Config != nil {
 						oauth2Config.ClientSecret = ""
 					}
+					ldapConfig := identityProviderV1pb.Config.GetLdap()
+					if ldapConfig != nil {
+						ldapConfig.BindPassword = ""
+					}
 				}
 				workspaceSetting.IdentityProviders = append(workspaceSetting.IdentityProviders, identityProviderV1pb)
 			}
+			slices.SortStableFunc(workspaceSetting.IdentityProviders, func(a, b *v1pb.IdentityProvider) int {
+				return int(a.DisplayOrder - b.DisplayOrder)
+			})
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GIT_SYNC {
+			// The git sync setting contains the GitHub token and the webhook secret.
+			if currentUser != nil && currentUser.Role == store.RoleAdmin {
+				gitSyncSetting := v.GetGitSync()
+				workspaceSetting.GitSync = &v1pb.GitSyncSetting{
+					Enabled:       gitSyncSetting.GetEnabled(),
+					Repository:    gitSyncSetting.GetRepository(),
+					Branch:        gitSyncSetting.GetBranch(),
+					Path:          gitSyncSetting.GetPath(),
+					AccessToken:   gitSyncSetting.GetAccessToken(),
+					WebhookSecret: gitSyncSetting.GetWebhookSecret(),
+					WriteBack:     gitSyncSetting.GetWriteBack(),
+					LastSyncedSha: gitSyncSetting.GetLastSyncedSha(),
+				}
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_NOT_FOUND {
+			notFoundSetting := v.GetNotFound()
+			workspaceSetting.NotFound = &v1pb.NotFoundSetting{
+				RedirectUrl:        notFoundSetting.GetRedirectUrl(),
+				Message:            notFoundSetting.GetMessage(),
+				DisableCreateOffer: notFoundSetting.GetDisableCreateOffer(),
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COLLECTION_TEMPLATE {
+			for _, collectionTemplate := range v.GetCollectionTemplate().GetTemplates() {
+				workspaceSetting.CollectionTemplates = append(workspaceSetting.CollectionTemplates, convertCollectionTemplateFromStore(collectionTemplate, false))
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FEDERATION {
+			// The federation sources contain the access tokens of the remote instances.
+			if currentUser != nil && currentUser.Role == store.RoleAdmin {
+				for _, source := range v.GetFederation().GetSources() {
+					workspaceSetting.FederationSources = append(workspaceSetting.FederationSources, convertFederationSourceFromStore(source))
+				}
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_MAIL {
+			mailSetting := v.GetMail()
+			workspaceSetting.RequireEmailVerification = isEmailVerificationRequired(mailSetting)
+			// The SMTP config contains the password of the SMTP server.
+			if currentUser != nil && currentUser.Role == store.RoleAdmin {
+				workspaceSetting.Smtp = convertSmtpConfigFromStore(mailSetting)
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LANDING {
+			landingSetting := v.GetLanding()
+			workspaceSetting.Landing = &v1pb.LandingSetting{
+				Type:                 v1pb.LandingSetting_Type(landingSetting.GetType()),
+				CollectionName:       landingSetting.GetCollectionName(),
+				PageHtml:             landingSetting.GetPageHtml(),
+				RedirectUrl:          landingSetting.GetRedirectUrl(),
+				ApplyToSignedInUsers: landingSetting.GetApplyToSignedInUsers(),
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COMPLETION {
+			completionSetting := v.GetCompletion()
+			workspaceSetting.CompletionEnabled = completionSetting.GetEndpoint() != "" && completionSetting.GetModel() != ""
+			workspaceSetting.SemanticSearchEnabled = completionSetting.GetEndpoint() != "" && completionSetting.GetEmbeddingModel() != ""
+			// The completion setting contains the API key of the provider.
+			if currentUser != nil && currentUser.Role == store.RoleAdmin {
+				workspaceSetting.Completion = &v1pb.CompletionSetting{
+					Endpoint:       completionSetting.GetEndpoint(),
+					ApiKey:         completionSetting.GetApiKey(),
+					Model:          completionSetting.GetModel(),
+					EmbeddingModel: completionSetting.GetEmbeddingModel(),
+				}
+			}
 		}
 	}
 	return workspaceSetting, nil
@@ -100,6 +222,26 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "instance_url" {
+			instanceURL := strings.TrimRight(strings.TrimSpace(request.Setting.InstanceUrl), "/")
+			if instanceURL != "" {
+				if u, err := url.Parse(instanceURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid instance url %q", request.Setting.InstanceUrl)
+				}
+			}
+			generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			generalSetting.InstanceUrl = instanceURL
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL,
+				Value: &storepb.WorkspaceSetting_General{
+					General: generalSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else if path == "custom_style" {
 			generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
 			if err != nil {
@@ -115,6 +257,10 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
 		} else if path == "default_visibility" {
+			// The team of the shortcuts is chosen when creating them, so it can't be a default.
+			if request.Setting.DefaultVisibility == v1pb.Visibility_TEAM {
+				return nil, status.Errorf(codes.InvalidArgument, "the team visibility can't be the default visibility")
+			}
 			shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
 				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
 			})
@@ -138,10 +284,220 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "link_param_rules" {
+			linkParamRules := request.Setting.LinkParamRules
+			if linkParamRules == nil {
+				linkParamRules = &v1pb.LinkParamRules{}
+			}
+			if err := validateLinkParamPatterns(linkParamRules.Deny); err != nil {
+				return nil, err
+			}
+			if err := validateLinkParamPatterns(linkParamRules.Allow); err != nil {
+				return nil, err
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.LinkParamRules = &storepb.WorkspaceSetting_LinkParamRules{
+				Deny:  linkParamRules.Deny,
+				Allow: linkParamRules.Allow,
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "view_dedupe_window_seconds" {
+			if request.Setting.ViewDedupeWindowSeconds < 0 || request.Setting.ViewDedupeWindowSeconds > maxViewDedupeWindowSeconds {
+				return nil, status.Errorf(codes.InvalidArgument, "view dedupe window must be between 0 and %d seconds", maxViewDedupeWindowSeconds)
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.ViewDedupeWindowSeconds = request.Setting.ViewDedupeWindowSeconds
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "default_redirect_code" {
+			if err := validateRedirectCode(request.Setting.DefaultRedirectCode); err != nil {
+				return nil, err
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.DefaultRedirectCode = request.Setting.DefaultRedirectCode
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "internal_domains" {
+			internalDomains, err := normalizeInternalDomains(request.Setting.InternalDomains)
+			if err != nil {
+				return nil, err
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.InternalDomains = internalDomains
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "reserved_shortcut_names" {
+			reservedNames, err := normalizeReservedShortcutNames(request.Setting.ReservedShortcutNames)
+			if err != nil {
+				return nil, err
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.ReservedNames = reservedNames
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "confirm_external_redirects" {
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.ConfirmExternalRedirects = request.Setting.ConfirmExternalRedirects
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "attribute_views_to_users" {
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.AttributeViewsToUsers = request.Setting.AttributeViewsToUsers
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "anomaly_alert" {
+			anomalyAlert := request.Setting.AnomalyAlert
+			if anomalyAlert == nil {
+				anomalyAlert = &v1pb.AnomalyAlertSetting{}
+			}
+			if anomalyAlert.ZScoreThreshold < 0 || anomalyAlert.MinViews < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "z-score threshold and min views must not be negative")
+			}
+			if anomalyAlert.WebhookUrl != "" {
+				if err := webhook.ValidateURL(anomalyAlert.WebhookUrl); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+				}
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.AnomalyAlert = &storepb.WorkspaceSetting_AnomalyAlertSetting{
+				Enabled:         anomalyAlert.Enabled,
+				WebhookUrl:      anomalyAlert.WebhookUrl,
+				ZScoreThreshold: anomalyAlert.ZScoreThreshold,
+				MinViews:        anomalyAlert.MinViews,
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "link_health_check" {
+			linkHealthCheck := request.Setting.LinkHealthCheck
+			if linkHealthCheck == nil {
+				linkHealthCheck = &v1pb.LinkHealthCheckSetting{}
+			}
+			if linkHealthCheck.WebhookUrl != "" {
+				if err := webhook.ValidateURL(linkHealthCheck.WebhookUrl); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+				}
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.LinkHealthCheck = &storepb.WorkspaceSetting_LinkHealthCheckSetting{
+				Enabled:        linkHealthCheck.Enabled,
+				WebhookUrl:     linkHealthCheck.WebhookUrl,
+				NotifyCreators: linkHealthCheck.NotifyCreators,
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else if path == "identity_providers" {
 			identityProviderSetting := &storepb.WorkspaceSetting_IdentityProviderSetting{}
+			autoRedirectCount := 0
+			for _, identityProvider := range request.Setting.IdentityProviders {
+				if identityProvider.AutoRedirect {
+					autoRedirectCount++
+				}
+			}
+			if autoRedirectCount > 1 {
+				return nil, status.Errorf(codes.InvalidArgument, "only one identity provider can enable auto redirect")
+			}
 			for _, identityProvider := range request.Setting.IdentityProviders {
-				identityProviderSetting.IdentityProviders = append(identityProviderSetting.IdentityProviders, convertIdentityProviderToStore(identityProvider))
+				storeIdentityProvider := convertIdentityProviderToStore(identityProvider)
+				if fieldMapping := getIdentityProviderFieldMapping(storeIdentityProvider); fieldMapping.GetGroups() != "" && fieldMapping.GetAdminGroup() == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "the admin group of identity provider %q is required to map the groups", identityProvider.Id)
+				}
+				if storeIdentityProvider.Type == storepb.IdentityProvider_OAUTH2 && storeIdentityProvider.Config.GetOauth2().GetIssuerUrl() != "" {
+					// The endpoints are discovered on save, so signing in doesn't depend on the discovery document.
+					if err := oauth2.Discover(ctx, storeIdentityProvider.Config.GetOauth2()); err != nil {
+						return nil, status.Errorf(codes.InvalidArgument, "invalid OAuth2 identity provider %q: %v", identityProvider.Id, err)
+					}
+				} else if storeIdentityProvider.Type == storepb.IdentityProvider_SAML {
+					if _, err := saml.NewIdentityProvider(storeIdentityProvider.Config.GetSaml(), "", ""); err != nil {
+						return nil, status.Errorf(codes.InvalidArgument, "invalid SAML identity provider %q: %v", identityProvider.Id, err)
+					}
+				} else if storeIdentityProvider.Type == storepb.IdentityProvider_LDAP {
+					if _, err := ldap.NewIdentityProvider(storeIdentityProvider.Config.GetLdap()); err != nil {
+						return nil, status.Errorf(codes.InvalidArgument, "invalid LDAP identity provider %q: %v", identityProvider.Id, err)
+					}
+				}
+				identityProviderSetting.IdentityProviders = append(identityProviderSetting.IdentityProviders, storeIdentityProvider)
 			}
 			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
 				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
@@ -151,6 +507,235 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "git_sync" {
+			gitSync := request.Setting.GitSync
+			if gitSync == nil {
+				gitSync = &v1pb.GitSyncSetting{}
+			}
+			if gitSync.Enabled {
+				if err := github.ValidateRepository(gitSync.Repository); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+				}
+				if gitSync.Path == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "path is required")
+				}
+			}
+			gitSyncSetting, err := s.Store.GetWorkspaceGitSyncSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			// Apply the file again when it moves.
+			lastSyncedSha := gitSyncSetting.LastSyncedSha
+			if gitSync.Repository != gitSyncSetting.Repository || gitSync.Branch != gitSyncSetting.Branch || gitSync.Path != gitSyncSetting.Path {
+				lastSyncedSha = ""
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GIT_SYNC,
+				Value: &storepb.WorkspaceSetting_GitSync{
+					GitSync: &storepb.WorkspaceSetting_GitSyncSetting{
+						Enabled:          gitSync.Enabled,
+						Repository:       gitSync.Repository,
+						Branch:           gitSync.Branch,
+						Path:             gitSync.Path,
+						AccessToken:      gitSync.AccessToken,
+						WebhookSecret:    gitSync.WebhookSecret,
+						WriteBack:        gitSync.WriteBack,
+						LastSyncedSha:    lastSyncedSha,
+						ManagedShortcuts: gitSyncSetting.ManagedShortcuts,
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+			if gitSync.Enabled {
+				s.runInBackground(func() {
+					if err := s.GitSyncService.Sync(context.Background()); err != nil {
+						slog.Error("failed to sync shortcuts from git", slog.Any("error", err))
+					}
+				})
+			}
+		} else if path == "federation_sources" {
+			federationSetting, err := s.Store.GetWorkspaceFederationSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			sources := []*storepb.WorkspaceSetting_FederationSource{}
+			for _, source := range request.Setting.FederationSources {
+				if slices.ContainsFunc(sources, func(other *storepb.WorkspaceSetting_FederationSource) bool { return other.Id == source.Id }) {
+					return nil, status.Errorf(codes.InvalidArgument, "duplicate federation source id %q", source.Id)
+				}
+				storeSource := &storepb.WorkspaceSetting_FederationSource{
+					Id:              source.Id,
+					Enabled:         source.Enabled,
+					Url:             strings.TrimRight(strings.TrimSpace(source.Url), "/"),
+					AccessToken:     source.AccessToken,
+					Prefix:          source.Prefix,
+					IntervalMinutes: source.IntervalMinutes,
+				}
+				if err := federation.ValidateSource(storeSource); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid federation source %q: %v", source.Id, err)
+				}
+				// Keep the state of the existing source, which is synced again soon when its config changes.
+				if i := slices.IndexFunc(federationSetting.Sources, func(other *storepb.WorkspaceSetting_FederationSource) bool { return other.Id == source.Id }); i >= 0 {
+					existing := federationSetting.Sources[i]
+					storeSource.ManagedShortcuts = existing.ManagedShortcuts
+					storeSource.ManagedCollections = existing.ManagedCollections
+					storeSource.LastError = existing.LastError
+					if existing.Enabled == storeSource.Enabled && existing.Url == storeSource.Url && existing.AccessToken == storeSource.AccessToken && existing.Prefix == storeSource.Prefix {
+						storeSource.LastSyncedTs = existing.LastSyncedTs
+					}
+				}
+				sources = append(sources, storeSource)
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FEDERATION,
+				Value: &storepb.WorkspaceSetting_Federation{
+					Federation: &storepb.WorkspaceSetting_FederationSetting{
+						Sources: sources,
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+			s.runInBackground(func() {
+				if err := s.FederationService.Sync(context.Background()); err != nil {
+					slog.Error("failed to sync federation sources", slog.Any("error", err))
+				}
+			})
+		} else if path == "not_found" {
+			notFound := request.Setting.NotFound
+			if notFound == nil {
+				notFound = &v1pb.NotFoundSetting{}
+			}
+			if notFound.RedirectUrl != "" && !util.ValidateURI(strings.ReplaceAll(notFound.RedirectUrl, "{name}", "name")) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid redirect url %q", notFound.RedirectUrl)
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_NOT_FOUND,
+				Value: &storepb.WorkspaceSetting_NotFound{
+					NotFound: &storepb.WorkspaceSetting_NotFoundSetting{
+						RedirectUrl:        notFound.RedirectUrl,
+						Message:            notFound.Message,
+						DisableCreateOffer: notFound.DisableCreateOffer,
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "landing" {
+			landing := request.Setting.Landing
+			if landing == nil {
+				landing = &v1pb.LandingSetting{}
+			}
+			if err := s.validateLandingSetting(ctx, landing); err != nil {
+				return nil, err
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LANDING,
+				Value: &storepb.WorkspaceSetting_Landing{
+					Landing: &storepb.WorkspaceSetting_LandingSetting{
+						Type:                 storepb.WorkspaceSetting_LandingSetting_Type(landing.Type),
+						CollectionName:       landing.CollectionName,
+						PageHtml:             landing.PageHtml,
+						RedirectUrl:          landing.RedirectUrl,
+						ApplyToSignedInUsers: landing.ApplyToSignedInUsers,
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "collection_templates" {
+			if err := validateCollectionTemplates(request.Setting.CollectionTemplates); err != nil {
+				return nil, err
+			}
+			collectionTemplateSetting := &storepb.WorkspaceSetting_CollectionTemplateSetting{}
+			for _, collectionTemplate := range request.Setting.CollectionTemplates {
+				collectionTemplateSetting.Templates = append(collectionTemplateSetting.Templates, convertCollectionTemplateToStore(collectionTemplate))
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COLLECTION_TEMPLATE,
+				Value: &storepb.WorkspaceSetting_CollectionTemplate{
+					CollectionTemplate: collectionTemplateSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "completion" {
+			completionSetting := request.Setting.Completion
+			if completionSetting == nil {
+				completionSetting = &v1pb.CompletionSetting{}
+			}
+			endpoint := strings.TrimSpace(completionSetting.Endpoint)
+			if endpoint != "" {
+				if err := completion.ValidateEndpoint(endpoint); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+				}
+				if strings.TrimSpace(completionSetting.Model) == "" && strings.TrimSpace(completionSetting.EmbeddingModel) == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "the model of the completion or of the embeddings is required")
+				}
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COMPLETION,
+				Value: &storepb.WorkspaceSetting_Completion{
+					Completion: &storepb.WorkspaceSetting_CompletionSetting{
+						Endpoint:       endpoint,
+						ApiKey:         completionSetting.ApiKey,
+						Model:          strings.TrimSpace(completionSetting.Model),
+						EmbeddingModel: strings.TrimSpace(completionSetting.EmbeddingModel),
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "smtp" {
+			smtpConfig := request.Setting.Smtp
+			if smtpConfig == nil {
+				smtpConfig = &v1pb.SmtpConfig{}
+			}
+			if smtpConfig.Host != "" && (smtpConfig.Port <= 0 || smtpConfig.Port > 65535) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid smtp port %d", smtpConfig.Port)
+			}
+			if smtpConfig.Host != "" && smtpConfig.From == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "the sender address is required")
+			}
+			mailSetting, err := s.Store.GetWorkspaceMailSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			newMailSetting := convertSmtpConfigToStore(smtpConfig)
+			newMailSetting.RequireEmailVerification = mailSetting.RequireEmailVerification
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_MAIL,
+				Value: &storepb.WorkspaceSetting_Mail{
+					Mail: newMailSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "require_email_verification" {
+			mailSetting, err := s.Store.GetWorkspaceMailSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			if request.Setting.RequireEmailVerification {
+				generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+				}
+				// The verification emails are sent with the SMTP server, and link to the instance url.
+				if mailSetting.SmtpHost == "" || generalSetting.InstanceUrl == "" {
+					return nil, status.Errorf(codes.FailedPrecondition, "the SMTP server and the instance url are required to verify the emails")
+				}
+			}
+			mailSetting.RequireEmailVerification = request.Setting.RequireEmailVerification
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_MAIL,
+				Value: &storepb.WorkspaceSetting_Mail{
+					Mail: mailSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else if path == "disallow_user_registration" {
 			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
 			if err != nil {
@@ -179,6 +764,48 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "access_token_inactivity_days" {
+			if request.Setting.AccessTokenInactivityDays < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "access token inactivity days must not be negative")
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.AccessTokenInactivityDays = request.Setting.AccessTokenInactivityDays
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "account_handover_user_id" {
+			if handoverUserID := request.Setting.AccountHandoverUserId; handoverUserID != 0 {
+				handoverUser, err := s.Store.GetUser(ctx, &store.FindUser{
+					ID: &handoverUserID,
+				})
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+				}
+				if handoverUser == nil || handoverUser.RowStatus != storepb.RowStatus_NORMAL {
+					return nil, status.Errorf(codes.InvalidArgument, "handover user not found")
+				}
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.AccountHandoverUserId = request.Setting.AccountHandoverUserId
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid path: %s", path)
 		}
@@ -191,6 +818,56 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 	return workspaceSetting, nil
 }
 
+func (s *APIV1Service) ExportWorkspace(ctx context.Context, request *v1pb.ExportWorkspaceRequest) (*v1pb.ExportWorkspaceResponse, error) {
+	format := ExportFormatJSON
+	if request.Format == v1pb.ExportWorkspaceRequest_CSV {
+		format = ExportFormatCSV
+	}
+	contentType, filename, err := getExportFile(format, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	content := &bytes.Buffer{}
+	if err := s.writeWorkspaceExport(ctx, content, format); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export workspace: %v", err)
+	}
+	return &v1pb.ExportWorkspaceResponse{
+		Content:     content.Bytes(),
+		ContentType: contentType,
+		Filename:    filename,
+	}, nil
+}
+
+// instanceStatsRedirectWindow is the window of the average redirects per minute of the instance stats.
+const instanceStatsRedirectWindow = 10 * time.Minute
+
+func (s *APIV1Service) GetInstanceStats(ctx context.Context, _ *v1pb.GetInstanceStatsRequest) (*v1pb.InstanceStats, error) {
+	stats, err := s.Store.GetInstanceStats(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance stats: %v", err)
+	}
+	createdTsAfter := time.Now().Add(-instanceStatsRedirectWindow).Unix()
+	viewCounts, err := s.Store.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+		CreatedTsAfter: &createdTsAfter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut view counts: %v", err)
+	}
+	views := 0
+	for _, viewCount := range viewCounts {
+		views += int(viewCount.Count)
+	}
+	return &v1pb.InstanceStats{
+		ShortcutCount:      stats.ShortcutCount,
+		CollectionCount:    stats.CollectionCount,
+		UserCount:          stats.UserCount,
+		ActivityCount:      stats.ActivityCount,
+		DatabaseSizeBytes:  stats.DatabaseSize,
+		CacheHitRate:       s.Store.GetShortcutCacheHitRate(),
+		RedirectsPerMinute: float64(views) / instanceStatsRedirectWindow.Minutes(),
+	}, nil
+}
+
 var ownerCache *v1pb.User
 
 func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error) {
@@ -213,15 +890,192 @@ func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error)
 	return ownerCache, nil
 }
 
+func (s *APIV1Service) TestIdentityProvider(ctx context.Context, request *v1pb.TestIdentityProviderRequest) (*v1pb.TestConnectionResponse, error) {
+	if request.IdentityProvider == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "identity provider is required")
+	}
+	if request.IdentityProvider.Type == v1pb.IdentityProvider_SAML {
+		return testSAMLIdentityProvider(ctx, request.IdentityProvider)
+	}
+	if request.IdentityProvider.Type == v1pb.IdentityProvider_LDAP {
+		return testLDAPIdentityProvider(request.IdentityProvider)
+	}
+	if request.IdentityProvider.Type != v1pb.IdentityProvider_OAUTH2 {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported identity provider type: %s", request.IdentityProvider.Type)
+	}
+	if request.IdentityProvider.Config.GetOauth2().GetFieldMapping() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "oauth2 config and its field mapping are required")
+	}
+
+	identityProvider := convertIdentityProviderToStore(request.IdentityProvider)
+	response := &v1pb.TestConnectionResponse{}
+	if identityProvider.Config.GetOauth2().GetIssuerUrl() != "" {
+		err := oauth2.Discover(ctx, identityProvider.Config.GetOauth2())
+		response.Checks = append(response.Checks, newConnectionCheck("issuerUrl", err))
+		if err != nil {
+			response.Ok = isAllConnectionChecksOk(response.Checks)
+			return response, nil
+		}
+	}
+	oauth2IdentityProvider, err := oauth2.NewIdentityProvider(identityProvider.Config.GetOauth2())
+	response.Checks = append(response.Checks, newConnectionCheck("config", err))
+	if err == nil {
+		for _, endpointCheck := range oauth2IdentityProvider.CheckEndpoints(ctx) {
+			response.Checks = append(response.Checks, newConnectionCheck(endpointCheck.Name, endpointCheck.Err))
+		}
+	}
+	response.Ok = isAllConnectionChecksOk(response.Checks)
+	return response, nil
+}
+
+func testSAMLIdentityProvider(ctx context.Context, identityProvider *v1pb.IdentityProvider) (*v1pb.TestConnectionResponse, error) {
+	if identityProvider.Config.GetSaml() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "saml config is required")
+	}
+
+	response := &v1pb.TestConnectionResponse{}
+	// The urls of Slash as the service provider don't matter to the checks.
+	samlIdentityProvider, err := saml.NewIdentityProvider(convertIdentityProviderToStore(identityProvider).Config.GetSaml(), "", "")
+	response.Checks = append(response.Checks, newConnectionCheck("config", err))
+	if err == nil {
+		for _, endpointCheck := range samlIdentityProvider.CheckEndpoints(ctx) {
+			response.Checks = append(response.Checks, newConnectionCheck(endpointCheck.Name, endpointCheck.Err))
+		}
+	}
+	response.Ok = isAllConnectionChecksOk(response.Checks)
+	return response, nil
+}
+
+func testLDAPIdentityProvider(identityProvider *v1pb.IdentityProvider) (*v1pb.TestConnectionResponse, error) {
+	if identityProvider.Config.GetLdap() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ldap config is required")
+	}
+
+	response := &v1pb.TestConnectionResponse{}
+	ldapIdentityProvider, err := ldap.NewIdentityProvider(convertIdentityProviderToStore(identityProvider).Config.GetLdap())
+	response.Checks = append(response.Checks, newConnectionCheck("config", err))
+	if err == nil {
+		for _, endpointCheck := range ldapIdentityProvider.CheckEndpoints() {
+			response.Checks = append(response.Checks, newConnectionCheck(endpointCheck.Name, endpointCheck.Err))
+		}
+	}
+	response.Ok = isAllConnectionChecksOk(response.Checks)
+	return response, nil
+}
+
+func (s *APIV1Service) TestSmtp(ctx context.Context, request *v1pb.TestSmtpRequest) (*v1pb.TestConnectionResponse, error) {
+	smtpConfig := request.SmtpConfig
+	if smtpConfig == nil || smtpConfig.Host == "" || smtpConfig.Port <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "smtp host and port are required")
+	}
+	recipient := request.Recipient
+	if recipient == "" {
+		user, err := getCurrentUser(ctx, s.Store)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
+		recipient = user.Email
+	}
+
+	smtpClient := common.NewSMTPClient(convertSmtpConfigToStore(smtpConfig))
+	response := &v1pb.TestConnectionResponse{}
+	err := smtpClient.Verify()
+	response.Checks = append(response.Checks, newConnectionCheck("connection", err))
+	if err == nil {
+		email := mail.NewEmailMsg().
+			SetFrom(smtpConfig.From).
+			AddTo(recipient).
+			SetSubject("Slash SMTP test").
+			SetBody("<p>This is a test message from Slash, your SMTP settings work.</p>")
+		response.Checks = append(response.Checks, newConnectionCheck("send", smtpClient.SendMail(email)))
+	}
+	response.Ok = isAllConnectionChecksOk(response.Checks)
+	return response, nil
+}
+
+func newConnectionCheck(name string, err error) *v1pb.TestConnectionResponse_Check {
+	check := &v1pb.TestConnectionResponse_Check{
+		Name: name,
+		Ok:   err == nil,
+	}
+	if err != nil {
+		check.Message = err.Error()
+	}
+	return check
+}
+
+func isAllConnectionChecksOk(checks []*v1pb.TestConnectionResponse_Check) bool {
+	for _, check := range checks {
+		if !check.Ok {
+			return false
+		}
+	}
+	return true
+}
+
+// maxViewDedupeWindowSeconds is the max dedupe window of the shortcut views, a day.
+const maxViewDedupeWindowSeconds = 24 * 60 * 60
+
+// maxLinkParamPatterns is the max number of the deny or the allow patterns of the link parameters.
+const maxLinkParamPatterns = 50
+
+// maxLandingPageSize is the max size of the HTML of the custom landing page.
+const maxLandingPageSize = 256 * 1024
+
+func (s *APIV1Service) validateLandingSetting(ctx context.Context, landing *v1pb.LandingSetting) error {
+	switch landing.Type {
+	case v1pb.LandingSetting_TYPE_UNSPECIFIED:
+	case v1pb.LandingSetting_COLLECTION:
+		collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+			Name: &landing.CollectionName,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get collection: %v", err)
+		}
+		if collection == nil {
+			return status.Errorf(codes.InvalidArgument, "collection %q not found", landing.CollectionName)
+		}
+	case v1pb.LandingSetting_PAGE:
+		if strings.TrimSpace(landing.PageHtml) == "" {
+			return status.Errorf(codes.InvalidArgument, "the landing page is empty")
+		}
+		if len(landing.PageHtml) > maxLandingPageSize {
+			return status.Errorf(codes.InvalidArgument, "the landing page is larger than %d KB", maxLandingPageSize/1024)
+		}
+	case v1pb.LandingSetting_REDIRECT:
+		if !util.ValidateURI(landing.RedirectUrl) {
+			return status.Errorf(codes.InvalidArgument, "invalid redirect url %q", landing.RedirectUrl)
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid landing type %v", landing.Type)
+	}
+	return nil
+}
+
+func validateLinkParamPatterns(patterns []string) error {
+	if len(patterns) > maxLinkParamPatterns {
+		return status.Errorf(codes.InvalidArgument, "at most %d link parameter patterns are allowed", maxLinkParamPatterns)
+	}
+	for _, pattern := range patterns {
+		if err := util.ValidateQueryParamPattern(pattern); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid link parameter pattern %q: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
 func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider) *v1pb.IdentityProvider {
 	if identityProvider == nil {
 		return nil
 	}
 	return &v1pb.IdentityProvider{
-		Id:     identityProvider.Id,
-		Title:  identityProvider.Title,
-		Type:   v1pb.IdentityProvider_Type(identityProvider.Type),
-		Config: convertIdentityProviderConfigFromStore(identityProvider.Config),
+		Id:           identityProvider.Id,
+		Title:        identityProvider.Title,
+		Type:         v1pb.IdentityProvider_Type(identityProvider.Type),
+		Config:       convertIdentityProviderConfigFromStore(identityProvider.Config),
+		DisplayOrder: identityProvider.DisplayOrder,
+		IconUrl:      identityProvider.IconUrl,
+		AutoRedirect: identityProvider.AutoRedirect,
 	}
 }
 
@@ -237,9 +1091,51 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 					TokenUrl:     oauth2Config.TokenUrl,
 					UserInfoUrl:  oauth2Config.UserInfoUrl,
 					Scopes:       oauth2Config.Scopes,
+					IssuerUrl:    oauth2Config.IssuerUrl,
 					FieldMapping: &v1pb.IdentityProviderConfig_FieldMapping{
 						Identifier:  oauth2Config.FieldMapping.Identifier,
 						DisplayName: oauth2Config.FieldMapping.DisplayName,
+						Groups:      oauth2Config.FieldMapping.Groups,
+						AdminGroup:  oauth2Config.FieldMapping.AdminGroup,
+					},
+				},
+			},
+		}
+	}
+	samlConfig := identityProviderConfig.GetSaml()
+	if samlConfig != nil {
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_Saml{
+				Saml: &v1pb.IdentityProviderConfig_SAMLConfig{
+					EntityId:    samlConfig.EntityId,
+					SsoUrl:      samlConfig.SsoUrl,
+					Certificate: samlConfig.Certificate,
+					FieldMapping: &v1pb.IdentityProviderConfig_FieldMapping{
+						Identifier:  samlConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: samlConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      samlConfig.GetFieldMapping().GetGroups(),
+						AdminGroup:  samlConfig.GetFieldMapping().GetAdminGroup(),
+					},
+				},
+			},
+		}
+	}
+	ldapConfig := identityProviderConfig.GetLdap()
+	if ldapConfig != nil {
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_Ldap{
+				Ldap: &v1pb.IdentityProviderConfig_LDAPConfig{
+					Url:          ldapConfig.Url,
+					StartTls:     ldapConfig.StartTls,
+					BindDn:       ldapConfig.BindDn,
+					BindPassword: ldapConfig.BindPassword,
+					SearchBase:   ldapConfig.SearchBase,
+					UserFilter:   ldapConfig.UserFilter,
+					FieldMapping: &v1pb.IdentityProviderConfig_FieldMapping{
+						Identifier:  ldapConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: ldapConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      ldapConfig.GetFieldMapping().GetGroups(),
+						AdminGroup:  ldapConfig.GetFieldMapping().GetAdminGroup(),
 					},
 				},
 			},
@@ -253,10 +1149,13 @@ func convertIdentityProviderToStore(identityProvider *v1pb.IdentityProvider) *st
 		return nil
 	}
 	return &storepb.IdentityProvider{
-		Id:     identityProvider.Id,
-		Title:  identityProvider.Title,
-		Type:   storepb.IdentityProvider_Type(identityProvider.Type),
-		Config: convertIdentityProviderConfigToStore(identityProvider.Config),
+		Id:           identityProvider.Id,
+		Title:        identityProvider.Title,
+		Type:         storepb.IdentityProvider_Type(identityProvider.Type),
+		Config:       convertIdentityProviderConfigToStore(identityProvider.Config),
+		DisplayOrder: identityProvider.DisplayOrder,
+		IconUrl:      identityProvider.IconUrl,
+		AutoRedirect: identityProvider.AutoRedirect,
 	}
 }
 
@@ -272,9 +1171,51 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 					TokenUrl:     oauth2Config.TokenUrl,
 					UserInfoUrl:  oauth2Config.UserInfoUrl,
 					Scopes:       oauth2Config.Scopes,
+					IssuerUrl:    oauth2Config.IssuerUrl,
 					FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
 						Identifier:  oauth2Config.FieldMapping.Identifier,
 						DisplayName: oauth2Config.FieldMapping.DisplayName,
+						Groups:      oauth2Config.FieldMapping.Groups,
+						AdminGroup:  oauth2Config.FieldMapping.AdminGroup,
+					},
+				},
+			},
+		}
+	}
+	samlConfig := identityProviderConfig.GetSaml()
+	if samlConfig != nil {
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_Saml{
+				Saml: &storepb.IdentityProviderConfig_SAMLConfig{
+					EntityId:    samlConfig.EntityId,
+					SsoUrl:      samlConfig.SsoUrl,
+					Certificate: samlConfig.Certificate,
+					FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+						Identifier:  samlConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: samlConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      samlConfig.GetFieldMapping().GetGroups(),
+						AdminGroup:  samlConfig.GetFieldMapping().GetAdminGroup(),
+					},
+				},
+			},
+		}
+	}
+	ldapConfig := identityProviderConfig.GetLdap()
+	if ldapConfig != nil {
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_Ldap{
+				Ldap: &storepb.IdentityProviderConfig_LDAPConfig{
+					Url:          ldapConfig.Url,
+					StartTls:     ldapConfig.StartTls,
+					BindDn:       ldapConfig.BindDn,
+					BindPassword: ldapConfig.BindPassword,
+					SearchBase:   ldapConfig.SearchBase,
+					UserFilter:   ldapConfig.UserFilter,
+					FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+						Identifier:  ldapConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: ldapConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      ldapConfig.GetFieldMapping().GetGroups(),
+						AdminGroup:  ldapConfig.GetFieldMapping().GetAdminGroup(),
 					},
 				},
 			},
@@ -282,3 +1223,21 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 	}
 	return nil
 }
+
+func convertFederationSourceFromStore(source *storepb.WorkspaceSetting_FederationSource) *v1pb.FederationSource {
+	federationSource := &v1pb.FederationSource{
+		Id:              source.Id,
+		Enabled:         source.Enabled,
+		Url:             source.Url,
+		AccessToken:     source.AccessToken,
+		Prefix:          source.Prefix,
+		IntervalMinutes: source.IntervalMinutes,
+		LastError:       source.LastError,
+		ShortcutCount:   int32(len(source.ManagedShortcuts)),
+		CollectionCount: int32(len(source.ManagedCollections)),
+	}
+	if source.LastSyncedTs > 0 {
+		federationSource.LastSyncTime = timestamppb.New(time.Unix(source.LastSyncedTs, 0))
+	}
+	return federationSource
+}
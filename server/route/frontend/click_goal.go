@@ -0,0 +1,111 @@
+package frontend
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/warthurton/slash/plugin/webhook"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/store"
+)
+
+// ClickGoalPayload is the webhook payload posted when a shortcut reaches its click goal.
+type ClickGoalPayload struct {
+	Type         string `json:"type"`
+	ShortcutID   int32  `json:"shortcutId"`
+	ShortcutName string `json:"shortcutName"`
+	Link         string `json:"link"`
+	Target       int32  `json:"target"`
+	ViewCount    int32  `json:"viewCount"`
+	ReachedTime  string `json:"reachedTime"`
+}
+
+// checkShortcutClickGoal marks the click goal of the shortcut as reached once its views hit the target,
+// and fires the goal reached event.
+func (s *FrontendService) checkShortcutClickGoal(ctx context.Context, shortcut *storepb.Shortcut) error {
+	if shortcut.ClickGoal.GetTarget() <= 0 || shortcut.ClickGoal.GetReachedTs() > 0 {
+		return nil
+	}
+
+	s.clickGoalMutex.Lock()
+	defer s.clickGoalMutex.Unlock()
+
+	// Reload the shortcut as the goal may be reached by a concurrent view.
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get shortcut")
+	}
+	if shortcut == nil || shortcut.ClickGoal.GetTarget() <= 0 || shortcut.ClickGoal.GetReachedTs() > 0 {
+		return nil
+	}
+	viewCounts, err := s.Store.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to count shortcut views")
+	}
+	viewCount := int32(0)
+	if len(viewCounts) > 0 {
+		viewCount = viewCounts[0].Count
+	}
+	clickGoal := shortcut.ClickGoal
+	if viewCount < clickGoal.Target {
+		return nil
+	}
+
+	reachedTime := time.Now()
+	if _, err := s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID: shortcut.Id,
+		ClickGoal: &storepb.ClickGoal{
+			Target:     clickGoal.Target,
+			WebhookUrl: clickGoal.WebhookUrl,
+			ReachedTs:  reachedTime.Unix(),
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to update shortcut click goal")
+	}
+
+	payloadStr, err := protojson.Marshal(&storepb.ActivityShortcutClickGoalPayload{
+		ShortcutId: shortcut.Id,
+		Target:     clickGoal.Target,
+		ViewCount:  viewCount,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: common.BotID,
+		Type:      store.ActivityShortcutClickGoalReached,
+		Level:     store.ActivityInfo,
+		Payload:   string(payloadStr),
+	}); err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+
+	if clickGoal.WebhookUrl == "" {
+		return nil
+	}
+	payload := &ClickGoalPayload{
+		Type:         store.ActivityShortcutClickGoalReached.String(),
+		ShortcutID:   shortcut.Id,
+		ShortcutName: shortcut.Name,
+		Link:         shortcut.Link,
+		Target:       clickGoal.Target,
+		ViewCount:    viewCount,
+		ReachedTime:  reachedTime.UTC().Format(time.RFC3339),
+	}
+	// Post the webhook in the background so that the redirection isn't blocked.
+	go func(webhookURL string) {
+		if err := webhook.PostToUserURL(context.Background(), webhookURL, payload); err != nil {
+			slog.Error("failed to post click goal webhook", slog.Int("shortcutID", int(payload.ShortcutID)), slog.Any("error", err))
+		}
+	}(clickGoal.WebhookUrl)
+	return nil
+}
@@ -0,0 +1,138 @@
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/warthurton/slash/plugin/httpgetter"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+// documentPathSuffix is the suffix of the short links serving their document to the viewer page, e.g. "/s/handbook/document".
+const documentPathSuffix = "/document"
+
+// documentViewerTemplate is the viewer page of the documents, which embeds the document served at the short link with the suffix.
+var documentViewerTemplate = template.Must(template.New("document").Parse(`<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8" />
+    <meta name="viewport" content="width=device-width, initial-scale=1.0" />
+    <title>{{.Title}}</title>
+    <style>
+      html, body { margin: 0; height: 100%; font-family: sans-serif; }
+      body { display: flex; flex-direction: column; }
+      header { display: flex; justify-content: space-between; align-items: center; gap: 1rem; padding: 0.5rem 1rem; border-bottom: 1px solid #e5e7eb; }
+      header span { overflow: hidden; text-overflow: ellipsis; white-space: nowrap; }
+      iframe { flex: 1; width: 100%; border: none; }
+    </style>
+  </head>
+  <body>
+    <header>
+      <span>{{.Title}}</span>
+      <a href="{{.DocumentURL}}" download="{{.Filename}}">Download</a>
+    </header>
+    <iframe src="{{.DocumentURL}}" title="{{.Title}}"></iframe>
+  </body>
+</html>
+`))
+
+// serveShortcutDocument serves the document the shortcut links to as its document mode says: the viewer page, or the
+// document as an attachment. It returns false when the link isn't a document, so that it's redirected to as usual.
+func (s *FrontendService) serveShortcutDocument(c echo.Context, shortcut *storepb.Shortcut) (bool, error) {
+	ctx := c.Request().Context()
+	document, err := s.getShortcutDocument(ctx, shortcut, c.Request().URL.RawQuery)
+	if err != nil {
+		if !errors.Is(err, httpgetter.ErrNotDocument) {
+			slog.Warn("failed to get shortcut document", slog.Int("shortcutID", int(shortcut.Id)), slog.String("error", err.Error()))
+		}
+		return false, nil
+	}
+	if shortcut.DocumentMode == storepb.DocumentMode_DOWNLOAD {
+		return true, s.proxyDocument(c, shortcut, document, "attachment")
+	}
+
+	// The document is requested again by the viewer page.
+	document.Body.Close()
+	title := shortcut.Title
+	if title == "" {
+		title = shortcut.Name
+	}
+	documentURL := s.Profile.BasePath + "/s/" + shortcut.Name + documentPathSuffix
+	if rawQuery := c.Request().URL.RawQuery; rawQuery != "" {
+		documentURL += "?" + rawQuery
+	}
+	var buffer bytes.Buffer
+	if err := documentViewerTemplate.Execute(&buffer, map[string]string{
+		"Title":       title,
+		"DocumentURL": documentURL,
+		"Filename":    getDocumentFilename(shortcut, document),
+	}); err != nil {
+		return true, echo.NewHTTPError(http.StatusInternalServerError, "failed to render document viewer")
+	}
+	return true, c.HTMLBlob(http.StatusOK, buffer.Bytes())
+}
+
+// serveShortcutDocumentContent serves the document of the shortcut inline, for its viewer page.
+// The visits of the document aren't counted as views, the ones of the viewer page are.
+func (s *FrontendService) serveShortcutDocumentContent(c echo.Context, shortcut *storepb.Shortcut) error {
+	ctx := c.Request().Context()
+	now := time.Now().Unix()
+	if shortcut.DocumentMode == storepb.DocumentMode_DOCUMENT_MODE_UNSPECIFIED || shortcut.RowStatus == storepb.RowStatus_ARCHIVED ||
+		(shortcut.ExpireTs > 0 && shortcut.ExpireTs <= now) || shortcut.ActivateTs > now || !s.canViewShortcut(ctx, c.Request(), shortcut) {
+		return echo.NewHTTPError(http.StatusNotFound, "shortcut not found")
+	}
+	document, err := s.getShortcutDocument(ctx, shortcut, c.Request().URL.RawQuery)
+	if err != nil {
+		slog.Warn("failed to get shortcut document", slog.Int("shortcutID", int(shortcut.Id)), slog.String("error", err.Error()))
+		return echo.NewHTTPError(http.StatusBadGateway, "failed to get document")
+	}
+	return s.proxyDocument(c, shortcut, document, "inline")
+}
+
+func (s *FrontendService) getShortcutDocument(ctx context.Context, shortcut *storepb.Shortcut, rawQuery string) (*httpgetter.Document, error) {
+	target, err := s.Redirector.ResolveShortcutTarget(ctx, shortcut, rawQuery)
+	if err != nil {
+		return nil, err
+	}
+	if target == "" {
+		return nil, httpgetter.ErrNotDocument
+	}
+	return httpgetter.GetDocument(ctx, target)
+}
+
+// proxyDocument streams the document with the disposition, inline or attachment.
+func (s *FrontendService) proxyDocument(c echo.Context, shortcut *storepb.Shortcut, document *httpgetter.Document, disposition string) error {
+	defer document.Body.Close()
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType(disposition, map[string]string{"filename": getDocumentFilename(shortcut, document)}))
+	if document.ContentLength >= 0 {
+		header.Set(echo.HeaderContentLength, fmt.Sprint(document.ContentLength))
+	}
+	// The documents are served on the origin of Slash, so the scripts of e.g. the SVG documents aren't run.
+	header.Set(echo.HeaderContentSecurityPolicy, "script-src 'none'")
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	header.Set(echo.HeaderCacheControl, "private, no-store")
+	header.Set(echo.HeaderContentType, document.ContentType)
+	c.Response().WriteHeader(http.StatusOK)
+	_, err := io.Copy(c.Response(), document.Body)
+	return err
+}
+
+// getDocumentFilename returns the file name of the document, or else the last segment of the name of the shortcut.
+func getDocumentFilename(shortcut *storepb.Shortcut, document *httpgetter.Document) string {
+	if document.Filename != "" {
+		return document.Filename
+	}
+	return path.Base(shortcut.Name)
+}
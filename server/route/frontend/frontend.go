@@ -2,12 +2,19 @@ package frontend
 
 import (
 	"context"
+	"crypto/md5"
 	"embed"
+	"encoding/hex"
 	"fmt"
+	"html"
 	"io/fs"
 	"log/slog"
+	"net"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -15,8 +22,10 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/warthurton/slash/internal/util"
+	"github.com/warthurton/slash/plugin/qrcode"
 	storepb "github.com/warthurton/slash/proto/gen/store"
 	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/server/metrics"
 	"github.com/warthurton/slash/server/profile"
 	"github.com/warthurton/slash/store"
 )
@@ -28,15 +37,58 @@ const (
 	headerMetadataPlaceholder = "<!-- slash.metadata -->"
 )
 
+// Authenticator authenticates the user signed in with the access token of an HTTP request.
+type Authenticator interface {
+	AuthenticateHTTPRequest(ctx context.Context, request *http.Request) (int32, error)
+}
+
+// Redirector resolves the HTTP redirect of a shortcut.
+type Redirector interface {
+	// ResolveShortcutRedirect returns the redirect code and the url the shortcut redirects to with the raw query of the visit.
+	// The code is 0 when the shortcut page is served instead.
+	ResolveShortcutRedirect(ctx context.Context, shortcut *storepb.Shortcut, rawQuery string) (int, string, error)
+	// ResolveShortcutTarget returns the url the shortcut resolves to with the raw query of the visit, or an empty string
+	// when the link is not a url.
+	ResolveShortcutTarget(ctx context.Context, shortcut *storepb.Shortcut, rawQuery string) (string, error)
+	// ResolveSignedShortcutRedirect returns the redirect code and the url of the signed short link with the raw query of the visit.
+	// The code is 0 when the raw query has no valid signature.
+	ResolveSignedShortcutRedirect(ctx context.Context, shortcut *storepb.Shortcut, rawQuery string) (int, string, error)
+	// ResolveServerShortcutRedirect returns the redirect code and the url of the shortcut redirected by the server, with the rest
+	// of the path of a wildcard shortcut and the raw query of the visit. The code is 0 when the link is not a url.
+	ResolveServerShortcutRedirect(ctx context.Context, shortcut *storepb.Shortcut, pathSuffix, rawQuery string) (int, string, error)
+}
+
+// QRCodeRenderer renders the QR codes of the short links.
+type QRCodeRenderer interface {
+	// RenderShortcutQRCode renders the QR code of the short link of the shortcut on the base url.
+	RenderShortcutQRCode(ctx context.Context, shortcut *storepb.Shortcut, baseURL string, format qrcode.Format, size int) ([]byte, error)
+}
+
 type FrontendService struct {
 	Profile *profile.Profile
 	Store   *store.Store
+	// Metrics is nil unless the metrics are enabled.
+	Metrics        *metrics.Metrics
+	Authenticator  Authenticator
+	Redirector     Redirector
+	QRCodeRenderer QRCodeRenderer
+
+	// clickGoalMutex serializes the click goal checks so that the goal reached event is fired only once.
+	clickGoalMutex sync.Mutex
+	// viewDeduper counts the repeated views within the dedupe window of the workspace once.
+	viewDeduper *viewDeduper
 }
 
-func NewFrontendService(profile *profile.Profile, store *store.Store) *FrontendService {
+func NewFrontendService(profile *profile.Profile, store *store.Store, metrics *metrics.Metrics, authenticator Authenticator, redirector Redirector, qrCodeRenderer QRCodeRenderer) *FrontendService {
 	return &FrontendService{
-		Profile: profile,
-		Store:   store,
+		Profile:        profile,
+		Store:          store,
+		Metrics:        metrics,
+		Authenticator:  authenticator,
+		Redirector:     redirector,
+		QRCodeRenderer: qrCodeRenderer,
+
+		viewDeduper: newViewDeduper(),
 	}
 }
 
@@ -47,7 +99,7 @@ func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 		HTML5:      true,
 		Filesystem: getFileSystem("dist"),
 		Skipper: func(c echo.Context) bool {
-			return util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/s/:shortcutName", "/c/:collectionName")
+			return c.Path() == "/" || util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/s/*", "/c/:collectionName", "/u/:id/avatar")
 		},
 	}))
 
@@ -56,7 +108,7 @@ func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 	// Reference: https://echo.labstack.com/docs/middleware/gzip
 	assetsGroup.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Skipper: func(c echo.Context) bool {
-			return util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/s/:shortcutName", "/c/:collectionName")
+			return c.Path() == "/" || util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/s/*", "/c/:collectionName", "/u/:id/avatar")
 		},
 		Level: 5,
 	}))
@@ -70,7 +122,7 @@ func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 		HTML5:      true,
 		Filesystem: getFileSystem("dist/assets"),
 		Skipper: func(c echo.Context) bool {
-			return util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/s/:shortcutName", "/c/:collectionName")
+			return c.Path() == "/" || util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/s/*", "/c/:collectionName", "/u/:id/avatar")
 		},
 	}))
 
@@ -79,21 +131,132 @@ func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 
 func (s *FrontendService) registerRoutes(e *echo.Echo) {
 	rawIndexHTML := getRawIndexHTML()
+	if s.Profile.BasePath != "" {
+		rawIndexHTML = rewriteIndexHTMLBasePath(rawIndexHTML, s.Profile.BasePath)
+		// The routes of the frontend get the rewritten `index.html` too, rather than the one of the static middleware.
+		e.GET("/*", func(c echo.Context) error {
+			return c.HTML(http.StatusOK, rawIndexHTML)
+		})
+	}
+
+	e.GET("/", func(c echo.Context) error {
+		return s.serveLanding(c, rawIndexHTML)
+	})
 
-	e.GET("/s/:shortcutName", func(c echo.Context) error {
+	e.GET("/s/*", func(c echo.Context) error {
+		// The redirect rules of the shortcuts match the visitor, e.g. by country.
+		request := c.Request()
+		visitor := common.NewVisitor(s.getRequestCountry(request), request.Header.Get("Accept-Language"), request.UserAgent())
+		c.SetRequest(request.WithContext(common.WithVisitor(request.Context(), visitor)))
 		ctx := c.Request().Context()
-		shortcutName := c.Param("shortcutName")
-		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-			Name: &shortcutName,
-		})
-		// If any error occurs or the shortcut is not found, return the raw `index.html`.
-		if err != nil || shortcut == nil {
+		// Use wildcard param to support names in personal namespaces, e.g. "~username/name".
+		shortcutName := c.Param("*")
+		shortcut, err := s.Store.GetShortcutByNameOrAlias(ctx, shortcutName)
+		// If any error occurs, return the raw `index.html`.
+		if err != nil {
 			return c.HTML(http.StatusOK, rawIndexHTML)
 		}
+		// The rest of the path of the visit of a wildcard shortcut, e.g. "ABC-123" of "/s/jira/ABC-123".
+		pathSuffix := ""
+		if shortcut == nil {
+			// The QR code of a shortcut is served at its short link with the suffix, unless a shortcut is named so.
+			if name, ok := strings.CutSuffix(shortcutName, qrCodePathSuffix); ok {
+				shortcut, err := s.Store.GetShortcutByNameOrAlias(ctx, name)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, "failed to get shortcut")
+				}
+				if shortcut != nil {
+					return s.serveShortcutQRCode(c, shortcut)
+				}
+			}
+			// The document of a shortcut is served to its viewer page at its short link with the suffix.
+			if name, ok := strings.CutSuffix(shortcutName, documentPathSuffix); ok {
+				shortcut, err := s.Store.GetShortcutByNameOrAlias(ctx, name)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, "failed to get shortcut")
+				}
+				// The wildcard shortcuts pass the suffix to their link instead.
+				if shortcut != nil && !common.IsWildcardLink(shortcut.Link) {
+					return s.serveShortcutDocumentContent(c, shortcut)
+				}
+			}
+			// The wildcard shortcuts, e.g. "jira" linking to "https://jira.example.com/browse/{*}", resolve the longer names they prefix.
+			shortcut, pathSuffix, err = s.findWildcardShortcut(ctx, shortcutName)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to get shortcut")
+			}
+			if shortcut == nil {
+				return s.serveShortcutNotFound(c, shortcutName, rawIndexHTML)
+			}
+		}
+		// Expired shortcuts are gone, even before the reaper archives them.
+		if shortcut.RowStatus == storepb.RowStatus_ARCHIVED || (shortcut.ExpireTs > 0 && shortcut.ExpireTs <= time.Now().Unix()) {
+			return c.HTML(http.StatusGone, rawIndexHTML)
+		}
+		// Scheduled shortcuts don't resolve yet, so neither the views nor the metadata are exposed.
+		if shortcut.ActivateTs > time.Now().Unix() {
+			return c.HTML(http.StatusNotFound, rawIndexHTML)
+		}
+		// The variant of the A/B split is picked once per visit, so that the view records the variant the visitor is redirected to.
+		if variant := common.PickVariant(shortcut.Variants); variant != nil {
+			ctx = common.WithVariant(ctx, variant)
+			c.SetRequest(c.Request().WithContext(ctx))
+		}
+		// The signed short links, e.g. in emails, redirect right away, also the visitors who can't view the shortcut.
+		signedRedirectCode, signedTarget := 0, ""
+		if pathSuffix == "" {
+			signedRedirectCode, signedTarget, err = s.Redirector.ResolveSignedShortcutRedirect(ctx, shortcut, c.Request().URL.RawQuery)
+			if err != nil {
+				slog.Warn("failed to resolve signed shortcut redirect", slog.String("error", err.Error()))
+			}
+		}
+		// The others are denied the shortcut by the API, so neither the views nor the metadata are exposed.
+		if signedRedirectCode == 0 && !s.canViewShortcut(ctx, c.Request(), shortcut) {
+			return c.HTML(http.StatusOK, rawIndexHTML)
+		}
+
+		// Repeated views, e.g. refreshes, are not counted again within the dedupe window.
+		if !s.isDuplicateShortcutView(ctx, c.Request(), shortcut) {
+			if s.Metrics != nil {
+				s.Metrics.ObserveShortcutView(shortcut)
+			}
+
+			// Create shortcut view activity. The queued views are created later, so the click goal is checked by the next view.
+			if queued, err := s.createShortcutViewActivity(ctx, c.Request(), shortcut); err != nil {
+				slog.Warn("failed to create shortcut view activity", slog.String("error", err.Error()))
+			} else if queued {
+				slog.Warn("queued shortcut view activity", slog.Int("shortcutID", int(shortcut.Id)))
+			} else if err := s.checkShortcutClickGoal(ctx, shortcut); err != nil {
+				slog.Warn("failed to check shortcut click goal", slog.String("error", err.Error()))
+			}
+		}
+
+		if signedRedirectCode != 0 {
+			return c.Redirect(signedRedirectCode, signedTarget)
+		}
+		// The shortcut page doesn't resolve the wildcards, so the wildcard shortcuts are redirected by the server.
+		if common.IsWildcardLink(shortcut.Link) {
+			return s.redirectShortcutFromServer(c, shortcut, pathSuffix, rawIndexHTML)
+		}
+
+		// The documents are proxied as the document mode of the shortcut says, and the other links are redirected as usual.
+		if shortcut.DocumentMode != storepb.DocumentMode_DOCUMENT_MODE_UNSPECIFIED {
+			if served, err := s.serveShortcutDocument(c, shortcut); served {
+				return err
+			}
+		}
+		// The shortcut page doesn't match the redirect rules with the visitor, nor redirects to the variants, either.
+		if len(shortcut.RedirectRules.GetRules()) > 0 || common.GetVariant(ctx) != nil {
+			return s.redirectShortcutFromServer(c, shortcut, "", rawIndexHTML)
+		}
 
-		// Create shortcut view activity.
-		if err := s.createShortcutViewActivity(ctx, c.Request(), shortcut); err != nil {
-			slog.Warn("failed to create shortcut view activity", slog.String("error", err.Error()))
+		// The shortcuts with a redirect code are redirected right away, the others by the shortcut page,
+		// which also confirms the redirects to the external domains.
+		redirectCode, target, err := s.Redirector.ResolveShortcutRedirect(ctx, shortcut, c.Request().URL.RawQuery)
+		if err != nil {
+			slog.Warn("failed to resolve shortcut redirect", slog.String("error", err.Error()))
+		} else if redirectCode != 0 && !s.needsRedirectConfirmation(ctx, c.Request(), target) {
+			return c.Redirect(redirectCode, target)
 		}
 
 		// Inject shortcut metadata into `index.html`.
@@ -116,10 +279,83 @@ func (s *FrontendService) registerRoutes(e *echo.Echo) {
 		indexHTML := strings.ReplaceAll(rawIndexHTML, headerMetadataPlaceholder, generateCollectionMetadata(collection).String())
 		return c.HTML(http.StatusOK, indexHTML)
 	})
+
+	e.GET("/u/:id/avatar", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid user id")
+		}
+		id := int32(userID)
+		user, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &id,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
+		}
+		visible, err := s.isUserProfilePublic(ctx, user)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user setting")
+		}
+		// The users who aren't public are only visible to themselves, and respond the same as a missing user,
+		// so that neither their existence nor the hash of their email is leaked.
+		cacheControl := "public"
+		if !visible && user != nil && user.RowStatus == storepb.RowStatus_NORMAL {
+			if requesterID, err := s.Authenticator.AuthenticateHTTPRequest(ctx, c.Request()); err == nil && requesterID == user.ID {
+				visible, cacheControl = true, "private"
+			}
+		}
+		if !visible {
+			return echo.NewHTTPError(http.StatusNotFound, "user not found")
+		}
+
+		c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("%s, max-age=%d", cacheControl, avatarCacheMaxAge))
+		if user.AvatarBlobID != 0 {
+			blob, err := s.Store.GetBlob(ctx, &store.FindBlob{
+				ID: &user.AvatarBlobID,
+			})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to get avatar")
+			}
+			if blob != nil {
+				etag := fmt.Sprintf(`"%d"`, blob.ID)
+				c.Response().Header().Set("ETag", etag)
+				if c.Request().Header.Get("If-None-Match") == etag {
+					return c.NoContent(http.StatusNotModified)
+				}
+				return c.Blob(http.StatusOK, blob.Type, blob.Blob)
+			}
+		}
+		// Fallback to the Gravatar of the primary email.
+		return c.Redirect(http.StatusFound, getGravatarURL(user.Email))
+	})
+}
+
+// avatarCacheMaxAge is the max age in seconds of the avatar in caches.
+const avatarCacheMaxAge = 3600
+
+// isUserProfilePublic returns true if the user exists, isn't archived and hasn't disabled their public profile.
+func (s *FrontendService) isUserProfilePublic(ctx context.Context, user *store.User) (bool, error) {
+	if user == nil || user.RowStatus != storepb.RowStatus_NORMAL {
+		return false, nil
+	}
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_GENERAL,
+	})
+	if err != nil {
+		return false, err
+	}
+	return !generalSetting.GetGeneral().GetDisablePublicProfile(), nil
+}
+
+func getGravatarURL(email string) string {
+	hash := md5.Sum([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return fmt.Sprintf("https://www.gravatar.com/avatar/%s?d=identicon", hex.EncodeToString(hash[:]))
 }
 
-func (s *FrontendService) createShortcutViewActivity(ctx context.Context, request *http.Request, shortcut *storepb.Shortcut) error {
-	ip := getReadUserIP(request)
+func (s *FrontendService) createShortcutViewActivity(ctx context.Context, request *http.Request, shortcut *storepb.Shortcut) (bool, error) {
+	ip := s.getClientIP(request)
 	referer := request.Header.Get("Referer")
 	userAgent := request.Header.Get("User-Agent")
 	params := map[string]*storepb.ActivityShorcutViewPayload_ValueList{}
@@ -132,10 +368,25 @@ func (s *FrontendService) createShortcutViewActivity(ctx context.Context, reques
 		Referer:    referer,
 		UserAgent:  userAgent,
 		Params:     params,
+		Country:    s.getRequestCountry(request),
+	}
+	// The variant is only recorded when no redirect rule wins over it, so that the analytics compare the split visits.
+	if variant := common.GetVariant(ctx); variant != nil && common.MatchRedirectRule(shortcut.RedirectRules, common.GetVisitor(ctx)) == "" {
+		payload.Variant = variant.Name
+	}
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "Failed to get workspace shortcut related setting")
+	}
+	if shortcutRelatedSetting.AttributeViewsToUsers {
+		// The views of the visitors not signed in aren't attributed.
+		if userID, err := s.Authenticator.AuthenticateHTTPRequest(ctx, request); err == nil {
+			payload.UserId = userID
+		}
 	}
 	payloadStr, err := protojson.Marshal(payload)
 	if err != nil {
-		return errors.Wrap(err, "Failed to marshal activity payload")
+		return false, errors.Wrap(err, "Failed to marshal activity payload")
 	}
 	activity := &store.Activity{
 		CreatorID: common.BotID,
@@ -143,22 +394,46 @@ func (s *FrontendService) createShortcutViewActivity(ctx context.Context, reques
 		Level:     store.ActivityInfo,
 		Payload:   string(payloadStr),
 	}
-	_, err = s.Store.CreateActivity(ctx, activity)
+	// The view is queued in the write-ahead log when the database can't be written, e.g. on shutdown.
+	queued, err := s.Store.QueueActivity(ctx, activity)
 	if err != nil {
-		return errors.Wrap(err, "Failed to create activity")
+		return false, errors.Wrap(err, "Failed to create activity")
 	}
-	return nil
+	return queued, nil
 }
 
-func getReadUserIP(r *http.Request) string {
-	ip := r.Header.Get("X-Real-Ip")
-	if ip == "" {
-		ip = r.Header.Get("X-Forwarded-For")
+// countryHeaders are the headers set by proxies/CDNs with the country code of the client, e.g. Cloudflare and CloudFront.
+var countryHeaders = []string{"CF-IPCountry", "CloudFront-Viewer-Country", "X-Country-Code"}
+
+// getRequestCountry returns the country code of the client given by the proxy or the CDN in front of Slash.
+// The headers are only trusted from the trusted proxies, as the clients could set them otherwise.
+func (s *FrontendService) getRequestCountry(r *http.Request) string {
+	if !common.IsTrustedProxy(getPeerIP(r), s.Profile.GetTrustedProxies()) {
+		return ""
+	}
+	for _, header := range countryHeaders {
+		country := strings.ToUpper(strings.TrimSpace(r.Header.Get(header)))
+		// XX is used for unknown countries.
+		if len(country) == 2 && country != "XX" {
+			return country
+		}
 	}
-	if ip == "" {
-		ip = r.RemoteAddr
+	return ""
+}
+
+// getClientIP returns the IP of the client of the request, from the X-Forwarded-For header of the trusted proxies
+// if any, otherwise the address of the peer.
+func (s *FrontendService) getClientIP(r *http.Request) string {
+	return common.GetClientIP(getPeerIP(r), r.Header.Values("X-Forwarded-For"), s.Profile.GetTrustedProxies())
+}
+
+// getPeerIP returns the IP of the peer of the request, without the port of the connection, which differs between
+// the requests of the client.
+func getPeerIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
 	}
-	return ip
+	return r.RemoteAddr
 }
 
 func getFileSystem(path string) http.FileSystem {
@@ -173,6 +448,10 @@ func getFileSystem(path string) http.FileSystem {
 func generateShortcutMetadata(shortcut *storepb.Shortcut) *Metadata {
 	metadata := getDefaultMetadata()
 	title, description := shortcut.Title, shortcut.Description
+	// The unfurls are public, so they show the title without the internal description.
+	if shortcut.InternalDescription {
+		description = ""
+	}
 	if shortcut.OgMetadata != nil {
 		if shortcut.OgMetadata.Title != "" {
 			title = shortcut.OgMetadata.Title
@@ -199,6 +478,17 @@ func getRawIndexHTML() string {
 	return string(bytes)
 }
 
+// rewriteIndexHTMLBasePath prefixes the absolute paths of the assets of `index.html` with the base path,
+// and adds the base path as metadata for the frontend.
+func rewriteIndexHTMLBasePath(indexHTML, basePath string) string {
+	indexHTML = strings.NewReplacer(
+		` src="/`, fmt.Sprintf(` src="%s/`, basePath),
+		` href="/`, fmt.Sprintf(` href="%s/`, basePath),
+	).Replace(indexHTML)
+	return strings.Replace(indexHTML, "<head>", fmt.Sprintf(`<head>
+    <meta name="slash:base-path" content="%s" />`, html.EscapeString(basePath)), 1)
+}
+
 type Metadata struct {
 	Title       string
 	Description string
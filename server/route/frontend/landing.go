@@ -0,0 +1,44 @@
+package frontend
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+// serveLanding serves the root path as configured in the landing setting of the workspace.
+// The setting is read from the cache of the store, so the root path doesn't query the database.
+func (s *FrontendService) serveLanding(c echo.Context, indexHTML string) error {
+	ctx := c.Request().Context()
+	landingSetting, err := s.Store.GetWorkspaceLandingSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get workspace landing setting", slog.Any("error", err))
+		return c.HTML(http.StatusOK, indexHTML)
+	}
+	if landingSetting.Type == storepb.WorkspaceSetting_LandingSetting_TYPE_UNSPECIFIED {
+		return c.HTML(http.StatusOK, indexHTML)
+	}
+	// The signed-in users get the app unless the landing applies to them too.
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderCookie)
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAuthorization)
+	if !landingSetting.ApplyToSignedInUsers {
+		if userID, err := s.Authenticator.AuthenticateHTTPRequest(ctx, c.Request()); err == nil && userID != 0 {
+			return c.HTML(http.StatusOK, indexHTML)
+		}
+	}
+
+	switch landingSetting.Type {
+	case storepb.WorkspaceSetting_LandingSetting_COLLECTION:
+		return c.Redirect(http.StatusFound, s.Profile.BasePath+"/c/"+url.PathEscape(landingSetting.CollectionName))
+	case storepb.WorkspaceSetting_LandingSetting_PAGE:
+		return c.HTML(http.StatusOK, landingSetting.PageHtml)
+	case storepb.WorkspaceSetting_LandingSetting_REDIRECT:
+		return c.Redirect(http.StatusFound, landingSetting.RedirectUrl)
+	default:
+		return c.HTML(http.StatusOK, indexHTML)
+	}
+}
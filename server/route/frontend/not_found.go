@@ -0,0 +1,29 @@
+package frontend
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// serveShortcutNotFound redirects to the configured fallback url when the shortcut doesn't exist,
+// otherwise it serves the not found page of the frontend.
+func (s *FrontendService) serveShortcutNotFound(c echo.Context, shortcutName, indexHTML string) error {
+	notFoundSetting, err := s.Store.GetWorkspaceNotFoundSetting(c.Request().Context())
+	if err != nil {
+		slog.Warn("failed to get workspace not found setting", slog.Any("error", err))
+		return c.HTML(http.StatusNotFound, indexHTML)
+	}
+	if notFoundSetting.RedirectUrl != "" {
+		return c.Redirect(http.StatusFound, buildNotFoundRedirectURL(notFoundSetting.RedirectUrl, shortcutName))
+	}
+	return c.HTML(http.StatusNotFound, indexHTML)
+}
+
+// buildNotFoundRedirectURL replaces the `{name}` placeholders of the redirect url with the escaped shortcut name.
+func buildNotFoundRedirectURL(redirectURL, shortcutName string) string {
+	return strings.ReplaceAll(redirectURL, "{name}", url.QueryEscape(shortcutName))
+}
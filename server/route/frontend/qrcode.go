@@ -0,0 +1,64 @@
+package frontend
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/warthurton/slash/plugin/qrcode"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+const (
+	// qrCodePathSuffix is the suffix of the short links serving their QR code, e.g. "/s/docs/qrcode".
+	qrCodePathSuffix = "/qrcode"
+	// qrCodeCacheMaxAge is the max age in seconds of the QR codes in caches.
+	qrCodeCacheMaxAge = 3600
+)
+
+// serveShortcutQRCode serves the QR code of the short link of the shortcut, in the format and size of the query,
+// e.g. "?format=svg&size=512". The visits of the QR code aren't counted as views.
+func (s *FrontendService) serveShortcutQRCode(c echo.Context, shortcut *storepb.Shortcut) error {
+	ctx := c.Request().Context()
+	if !s.canViewShortcut(ctx, c.Request(), shortcut) {
+		return echo.NewHTTPError(http.StatusNotFound, "shortcut not found")
+	}
+	size := qrcode.DefaultSize
+	if value := c.QueryParam("size"); value != "" {
+		var err error
+		if size, err = strconv.Atoi(value); err != nil || size < qrcode.MinSize || size > qrcode.MaxSize {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("size must be between %d and %d", qrcode.MinSize, qrcode.MaxSize))
+		}
+	}
+	format := qrcode.Format(c.QueryParam("format"))
+	if format == "" {
+		format = qrcode.FormatPNG
+	}
+	if format != qrcode.FormatPNG && format != qrcode.FormatSVG {
+		return echo.NewHTTPError(http.StatusBadRequest, "format must be png or svg")
+	}
+
+	// The short link in the QR code is on the instance url, or else on the host of the request.
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get workspace general setting")
+	}
+	baseURL := generalSetting.InstanceUrl
+	if baseURL == "" {
+		baseURL = fmt.Sprintf("%s://%s%s", c.Scheme(), c.Request().Host, s.Profile.BasePath)
+	}
+	content, err := s.QRCodeRenderer.RenderShortcutQRCode(ctx, shortcut, baseURL, format, size)
+	if err != nil {
+		slog.Warn("failed to render qr code", slog.String("error", err.Error()))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to render qr code")
+	}
+	cacheControl := "public"
+	if shortcut.Visibility == storepb.Visibility_TEAM || shortcut.Visibility == storepb.Visibility_PRIVATE {
+		cacheControl = "private"
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("%s, max-age=%d", cacheControl, qrCodeCacheMaxAge))
+	return c.Blob(http.StatusOK, format.ContentType(), content)
+}
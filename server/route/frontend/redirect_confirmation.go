@@ -0,0 +1,96 @@
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/store"
+)
+
+// needsRedirectConfirmation reports whether the redirect to the target is confirmed on the shortcut page first,
+// as it's outside of the internal domains of the workspace and the confirmation is on for the user.
+func (s *FrontendService) needsRedirectConfirmation(ctx context.Context, request *http.Request, target string) bool {
+	targetURL, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get workspace shortcut related setting", slog.String("error", err.Error()))
+		return false
+	}
+	if common.IsInternalDomain(targetURL.Hostname(), shortcutRelatedSetting.InternalDomains) {
+		return false
+	}
+	userPreference := ""
+	// The visitors not signed in follow the workspace.
+	if userID, err := s.Authenticator.AuthenticateHTTPRequest(ctx, request); err == nil {
+		generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+			UserID: &userID,
+			Key:    storepb.UserSettingKey_USER_SETTING_GENERAL,
+		})
+		if err != nil {
+			slog.Warn("failed to get user setting", slog.String("error", err.Error()))
+		} else if generalSetting != nil {
+			userPreference = generalSetting.GetGeneral().GetExternalRedirectConfirmation()
+		}
+	}
+	return common.NeedsRedirectConfirmation(targetURL.Hostname(), userPreference, shortcutRelatedSetting.ConfirmExternalRedirects, shortcutRelatedSetting.InternalDomains)
+}
+
+// redirectConfirmationTemplate is the confirmation page of the external redirects of the shortcuts redirected by the server,
+// which the shortcut page can't resolve.
+var redirectConfirmationTemplate = template.Must(template.New("confirmation").Parse(`<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8" />
+    <meta name="viewport" content="width=device-width, initial-scale=1.0" />
+    <meta name="robots" content="noindex" />
+    <title>{{.Name}}</title>
+    <style>
+      html, body { margin: 0; height: 100%; font-family: sans-serif; }
+      body { display: flex; flex-direction: column; justify-content: center; align-items: center; gap: 1rem; padding: 1rem; text-align: center; }
+      code { word-break: break-all; }
+    </style>
+  </head>
+  <body>
+    <p>Shortcut <code>{{.Name}}</code> redirects to an external site:</p>
+    <p><code>{{.Target}}</code></p>
+    <a href="{{.Target}}" rel="noreferrer">Continue</a>
+  </body>
+</html>
+`))
+
+// redirectShortcutFromServer redirects the visit of the shortcut with the rest of its path, if any, once confirmed when
+// it's external. The link without a url is served by the shortcut page.
+func (s *FrontendService) redirectShortcutFromServer(c echo.Context, shortcut *storepb.Shortcut, pathSuffix, indexHTML string) error {
+	ctx := c.Request().Context()
+	redirectCode, target, err := s.Redirector.ResolveServerShortcutRedirect(ctx, shortcut, pathSuffix, c.Request().URL.RawQuery)
+	if err != nil {
+		slog.Warn("failed to resolve shortcut redirect", slog.String("error", err.Error()))
+		return c.HTML(http.StatusOK, indexHTML)
+	}
+	if redirectCode == 0 {
+		return c.HTML(http.StatusOK, indexHTML)
+	}
+	if !s.needsRedirectConfirmation(ctx, c.Request(), target) {
+		return c.Redirect(redirectCode, target)
+	}
+
+	var buffer bytes.Buffer
+	if err := redirectConfirmationTemplate.Execute(&buffer, map[string]string{
+		"Name":   shortcut.Name,
+		"Target": target,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to render redirect confirmation")
+	}
+	return c.HTMLBlob(http.StatusOK, buffer.Bytes())
+}
@@ -0,0 +1,81 @@
+package frontend
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+// viewDedupeMaxSize is the max number of the remembered views. Once it's reached, the expired ones are pruned,
+// and if there are still too many, e.g. during a flood of views, arbitrary ones are forgotten down to 3/4 of it.
+const viewDedupeMaxSize = 10000
+
+type viewKey struct {
+	shortcutID int32
+	ip         string
+	userAgent  string
+}
+
+// viewDeduper remembers when the views of the shortcuts were last counted, per IP and user agent.
+// It's in memory, so each instance dedupes the views it serves.
+type viewDeduper struct {
+	mutex sync.Mutex
+	views map[viewKey]time.Time
+}
+
+func newViewDeduper() *viewDeduper {
+	return &viewDeduper{
+		views: map[viewKey]time.Time{},
+	}
+}
+
+// isDuplicate reports whether the view repeats one counted within the window, otherwise it's remembered as counted.
+func (d *viewDeduper) isDuplicate(key viewKey, window time.Duration, now time.Time) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if countedTime, ok := d.views[key]; ok && now.Sub(countedTime) < window {
+		return true
+	}
+	if len(d.views) >= viewDedupeMaxSize {
+		for k, countedTime := range d.views {
+			if now.Sub(countedTime) >= window {
+				delete(d.views, k)
+			}
+		}
+	}
+	if len(d.views) >= viewDedupeMaxSize {
+		// The views are forgotten in bulk, so that the cost is amortized over the following views.
+		for k := range d.views {
+			if len(d.views) < viewDedupeMaxSize*3/4 {
+				break
+			}
+			delete(d.views, k)
+		}
+	}
+	d.views[key] = now
+	return false
+}
+
+// isDuplicateShortcutView reports whether the view of the shortcut is repeated within the dedupe window of the workspace.
+func (s *FrontendService) isDuplicateShortcutView(ctx context.Context, request *http.Request, shortcut *storepb.Shortcut) bool {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get workspace shortcut related setting", slog.String("error", err.Error()))
+		return false
+	}
+	window := time.Duration(shortcutRelatedSetting.ViewDedupeWindowSeconds) * time.Second
+	if window <= 0 {
+		return false
+	}
+	key := viewKey{
+		shortcutID: shortcut.Id,
+		ip:         s.getClientIP(request),
+		userAgent:  request.Header.Get("User-Agent"),
+	}
+	return s.viewDeduper.isDuplicate(key, window, time.Now())
+}
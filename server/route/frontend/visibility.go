@@ -0,0 +1,61 @@
+package frontend
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+// canViewShortcut reports whether the user signed in with the request can view the team or private shortcut,
+// as an admin, its creator, a member of its team or a user it's shared with.
+// The other shortcuts are visible to anyone reaching the redirect.
+func (s *FrontendService) canViewShortcut(ctx context.Context, request *http.Request, shortcut *storepb.Shortcut) bool {
+	if shortcut.Visibility != storepb.Visibility_TEAM && shortcut.Visibility != storepb.Visibility_PRIVATE {
+		return true
+	}
+	userID, err := s.Authenticator.AuthenticateHTTPRequest(ctx, request)
+	if err != nil {
+		return false
+	}
+	if userID == shortcut.CreatorId {
+		return true
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		slog.Warn("failed to get user", slog.String("error", err.Error()))
+		return false
+	}
+	if user == nil {
+		return false
+	}
+	if user.Role == store.RoleAdmin {
+		return true
+	}
+	if shortcut.Visibility == storepb.Visibility_TEAM {
+		teamMember, err := s.Store.GetTeamMember(ctx, &store.FindTeamMember{
+			TeamID: &shortcut.TeamId,
+			UserID: &user.ID,
+		})
+		if err != nil {
+			slog.Warn("failed to get team member", slog.String("error", err.Error()))
+			return false
+		}
+		if teamMember != nil {
+			return true
+		}
+	}
+	shortcutACL, err := s.Store.GetShortcutACL(ctx, &store.FindShortcutACL{
+		ShortcutID: &shortcut.Id,
+		UserID:     &user.ID,
+	})
+	if err != nil {
+		slog.Warn("failed to get shortcut acl", slog.String("error", err.Error()))
+		return false
+	}
+	return shortcutACL != nil
+}
@@ -0,0 +1,29 @@
+package frontend
+
+import (
+	"context"
+	"strings"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+)
+
+// findWildcardShortcut returns the wildcard shortcut with the longest name or alias prefixing the segments of the name,
+// e.g. "jira" of "jira/ABC-123", and the rest of the name, or nil when there is none.
+func (s *FrontendService) findWildcardShortcut(ctx context.Context, shortcutName string) (*storepb.Shortcut, string, error) {
+	prefix := shortcutName
+	for {
+		index := strings.LastIndex(prefix, "/")
+		if index <= 0 {
+			return nil, "", nil
+		}
+		prefix = prefix[:index]
+		shortcut, err := s.Store.GetShortcutByNameOrAlias(ctx, prefix)
+		if err != nil {
+			return nil, "", err
+		}
+		if shortcut != nil && common.IsWildcardLink(shortcut.Link) {
+			return shortcut, shortcutName[index+1:], nil
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Package accesstoken provides a runner to persist access token usages and revoke inactive access tokens.
+package accesstoken
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every minute.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.Store.FlushAccessTokenUsage(ctx); err != nil {
+		slog.Error("failed to flush access token usage", slog.Any("error", err))
+	}
+	if err := r.revokeInactiveAccessTokens(ctx); err != nil {
+		slog.Error("failed to revoke inactive access tokens", slog.Any("error", err))
+	}
+}
+
+func (r *Runner) revokeInactiveAccessTokens(ctx context.Context) error {
+	securitySetting, err := r.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return err
+	}
+	if securitySetting.AccessTokenInactivityDays <= 0 {
+		return nil
+	}
+
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+	})
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(-time.Duration(securitySetting.AccessTokenInactivityDays) * 24 * time.Hour)
+	for _, userSetting := range userSettings {
+		revokedCount := 0
+		if err := r.Store.UpdateUserAccessTokens(ctx, userSetting.UserId, func(accessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) []*storepb.UserSetting_AccessTokensSetting_AccessToken {
+			activeAccessTokens := make([]*storepb.UserSetting_AccessTokensSetting_AccessToken, 0, len(accessTokens))
+			for _, accessToken := range accessTokens {
+				if lastActiveTime := getLastActiveTime(accessToken); lastActiveTime.IsZero() || lastActiveTime.After(deadline) {
+					activeAccessTokens = append(activeAccessTokens, accessToken)
+				}
+			}
+			revokedCount = len(accessTokens) - len(activeAccessTokens)
+			return activeAccessTokens
+		}); err != nil {
+			return err
+		}
+		if revokedCount > 0 {
+			slog.Info("revoked inactive access tokens", slog.Int("userID", int(userSetting.UserId)), slog.Int("count", revokedCount))
+		}
+	}
+	return nil
+}
+
+// getLastActiveTime returns the last used time of the access token,
+// falling back to its issued time when it has never been used.
+func getLastActiveTime(accessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) time.Time {
+	if accessToken.LastUsedTs > 0 {
+		return time.Unix(accessToken.LastUsedTs, 0)
+	}
+	if accessToken.IssuedTs > 0 {
+		return time.Unix(accessToken.IssuedTs, 0)
+	}
+	return time.Time{}
+}
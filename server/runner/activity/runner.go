@@ -0,0 +1,182 @@
+// Package activity provides a runner to keep the activity table small:
+// it creates the monthly partitions ahead of time and archives the cold shortcut views into blobs.
+// It also replays the activities queued in the write-ahead log while the database couldn't be written.
+package activity
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/server/profile"
+	"github.com/warthurton/slash/store"
+)
+
+type Runner struct {
+	Store   *store.Store
+	Profile *profile.Profile
+}
+
+func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
+	return &Runner{
+		Store:   store,
+		Profile: profile,
+	}
+}
+
+const (
+	// Schedule runner every hour.
+	runnerInterval = time.Hour
+	// The views are archived by day, so that a single archive fits in memory.
+	archiveInterval = 24 * time.Hour
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	now := time.Now().UTC()
+	// Create the partition of the next month before its first activity.
+	for _, month := range []time.Time{now, time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)} {
+		if err := r.Store.EnsureActivityPartition(ctx, month); err != nil {
+			slog.Error("failed to create activity partition", slog.String("month", month.Format("2006-01")), slog.Any("error", err))
+		}
+	}
+	// The replay runs on start, so the views queued on the last shutdown are counted right away.
+	if count, err := r.Store.ReplayActivityWAL(ctx); err != nil {
+		slog.Error("failed to replay activity wal", slog.Int("count", count), slog.Any("error", err))
+	} else if count > 0 {
+		slog.Info("replayed activity wal", slog.Int("count", count))
+	}
+	if r.Profile.ActivityArchiveDays > 0 {
+		if err := r.archiveShortcutViews(ctx, now); err != nil {
+			slog.Error("failed to archive shortcut views", slog.Any("error", err))
+		}
+	}
+}
+
+// archiveShortcutViews archives the shortcut views older than the archive days, one day at a time from the oldest.
+func (r *Runner) archiveShortcutViews(ctx context.Context, now time.Time) error {
+	cutoff := now.AddDate(0, 0, -r.Profile.ActivityArchiveDays).Truncate(archiveInterval)
+	createdTsBefore, limit := cutoff.Unix()-1, 1
+	for {
+		oldest, err := r.Store.GetActivity(ctx, &store.FindActivity{
+			Type:            store.ActivityShortcutView,
+			CreatedTsBefore: &createdTsBefore,
+			Limit:           &limit,
+		})
+		if err != nil {
+			return err
+		}
+		if oldest == nil {
+			return nil
+		}
+		start := time.Unix(oldest.CreatedTs, 0).UTC().Truncate(archiveInterval)
+		if err := r.archive(ctx, store.ActivityShortcutView, start, start.Add(archiveInterval)); err != nil {
+			return errors.Wrapf(err, "failed to archive %s", start.Format(time.DateOnly))
+		}
+	}
+}
+
+// archivedActivity is an activity in the archives.
+type archivedActivity struct {
+	ID        int32  `json:"id"`
+	CreatorID int32  `json:"creatorId"`
+	CreatedTs int64  `json:"createdTs"`
+	Type      string `json:"type"`
+	Level     string `json:"level"`
+	Payload   string `json:"payload"`
+}
+
+// archive moves the activities of the type created in [start, end) into a blob of gzipped JSON lines,
+// and records the blob in an archive activity.
+func (r *Runner) archive(ctx context.Context, activityType store.ActivityType, start, end time.Time) error {
+	createdTsAfter, createdTsBefore := start.Unix()-1, end.Unix()-1
+	activities, err := r.Store.ListActivities(ctx, &store.FindActivity{
+		Type:            activityType,
+		CreatedTsAfter:  &createdTsAfter,
+		CreatedTsBefore: &createdTsBefore,
+	})
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	writer.Name = start.Format("activity-20060102.jsonl")
+	encoder := json.NewEncoder(writer)
+	for _, activity := range activities {
+		if err := encoder.Encode(&archivedActivity{
+			ID:        activity.ID,
+			CreatorID: activity.CreatorID,
+			CreatedTs: activity.CreatedTs,
+			Type:      activity.Type.String(),
+			Level:     activity.Level.String(),
+			Payload:   activity.Payload,
+		}); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	if buffer.Len() > math.MaxInt32 {
+		return errors.Errorf("archive of %d bytes is too large", buffer.Len())
+	}
+	blob, err := r.Store.CreateBlob(ctx, &store.Blob{
+		CreatorID: common.BotID,
+		Type:      "application/gzip",
+		Blob:      buffer.Bytes(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create blob")
+	}
+
+	payload, err := protojson.Marshal(&storepb.ActivityArchivePayload{
+		BlobId:        blob.ID,
+		Type:          activityType.String(),
+		StartTs:       start.Unix(),
+		EndTs:         end.Unix(),
+		ActivityCount: int32(len(activities)),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: common.BotID,
+		Type:      store.ActivityArchive,
+		Level:     store.ActivityInfo,
+		Payload:   string(payload),
+	}); err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if err := r.Store.DeleteActivities(ctx, &store.DeleteActivities{
+		Type:            activityType,
+		CreatedTsAfter:  &createdTsAfter,
+		CreatedTsBefore: &createdTsBefore,
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete archived activities")
+	}
+	slog.Info("archived activities", slog.String("type", activityType.String()), slog.String("day", start.Format(time.DateOnly)), slog.Int("count", len(activities)), slog.Int("blobID", int(blob.ID)))
+	return nil
+}
@@ -0,0 +1,205 @@
+// Package anomaly provides a runner to detect traffic spikes and drops of shortcuts.
+package anomaly
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/warthurton/slash/plugin/webhook"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// Schedule runner every hour.
+	runnerInterval = time.Hour
+	// The number of hours before the detected hour used as the baseline.
+	baselineHours = 24
+
+	defaultZScoreThreshold = 3
+	defaultMinViews        = 10
+)
+
+type Runner struct {
+	Store *store.Store
+
+	// lastDetectedTs is the end of the last detected hour, to avoid duplicated alerts.
+	lastDetectedTs int64
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.detectAnomalies(ctx); err != nil {
+		slog.Error("failed to detect shortcut anomalies", slog.Any("error", err))
+	}
+}
+
+// AlertPayload is the payload posted to the webhook on anomalies.
+type AlertPayload struct {
+	Type         string  `json:"type"`
+	Direction    string  `json:"direction"`
+	ShortcutID   int32   `json:"shortcutId"`
+	ShortcutName string  `json:"shortcutName"`
+	Link         string  `json:"link"`
+	ViewCount    int32   `json:"viewCount"`
+	BaselineMean float64 `json:"baselineMean"`
+	ZScore       float64 `json:"zScore"`
+	HourStart    string  `json:"hourStart"`
+}
+
+func (r *Runner) detectAnomalies(ctx context.Context) error {
+	shortcutRelatedSetting, err := r.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return err
+	}
+	setting := shortcutRelatedSetting.GetAnomalyAlert()
+	if !setting.GetEnabled() {
+		return nil
+	}
+	threshold, minViews := setting.ZScoreThreshold, setting.MinViews
+	if threshold <= 0 {
+		threshold = defaultZScoreThreshold
+	}
+	if minViews <= 0 {
+		minViews = defaultMinViews
+	}
+
+	// Detect the last full hour against the hours before it.
+	end := time.Now().Truncate(time.Hour)
+	if end.Unix() == r.lastDetectedTs {
+		return nil
+	}
+	// hourlyViews maps shortcut id to its views of each hour, the last one is the detected hour.
+	hourlyViews := map[int32][]int32{}
+	for i := 0; i <= baselineHours; i++ {
+		hourStart := end.Add(-time.Duration(baselineHours-i+1) * time.Hour).Unix()
+		hourEnd := hourStart + int64(time.Hour.Seconds())
+		viewCounts, err := r.Store.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+			CreatedTsAfter:  &hourStart,
+			CreatedTsBefore: &hourEnd,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list view counts")
+		}
+		for _, viewCount := range viewCounts {
+			if _, ok := hourlyViews[viewCount.ShortcutID]; !ok {
+				hourlyViews[viewCount.ShortcutID] = make([]int32, baselineHours+1)
+			}
+			hourlyViews[viewCount.ShortcutID][i] = viewCount.Count
+		}
+	}
+	r.lastDetectedTs = end.Unix()
+
+	for shortcutID, views := range hourlyViews {
+		current := views[baselineHours]
+		direction, mean, zScore := detectAnomaly(views[:baselineHours], current, threshold, minViews)
+		if direction == storepb.ActivityShortcutAnomalyPayload_DIRECTION_UNSPECIFIED {
+			continue
+		}
+		shortcut, err := r.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID: &shortcutID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get shortcut")
+		}
+		if shortcut == nil {
+			continue
+		}
+		payload := &storepb.ActivityShortcutAnomalyPayload{
+			ShortcutId:   shortcutID,
+			Direction:    direction,
+			ViewCount:    current,
+			BaselineMean: mean,
+			ZScore:       zScore,
+		}
+		if err := r.alert(ctx, setting.WebhookUrl, shortcut, payload, end.Add(-time.Hour)); err != nil {
+			slog.Error("failed to alert shortcut anomaly", slog.Int("shortcutID", int(shortcutID)), slog.Any("error", err))
+		}
+	}
+	return nil
+}
+
+func (r *Runner) alert(ctx context.Context, webhookURL string, shortcut *storepb.Shortcut, payload *storepb.ActivityShortcutAnomalyPayload, hourStart time.Time) error {
+	slog.Warn("detected shortcut anomaly", slog.String("shortcut", shortcut.Name), slog.String("direction", payload.Direction.String()), slog.Int("views", int(payload.ViewCount)), slog.Float64("zScore", payload.ZScore))
+	payloadStr, err := protojson.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	if _, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: common.BotID,
+		Type:      store.ActivityShortcutAnomaly,
+		Level:     store.ActivityWarn,
+		Payload:   string(payloadStr),
+	}); err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+
+	if webhookURL == "" {
+		return nil
+	}
+	return webhook.Post(ctx, webhookURL, &AlertPayload{
+		Type:         store.ActivityShortcutAnomaly.String(),
+		Direction:    strings.ToLower(payload.Direction.String()),
+		ShortcutID:   shortcut.Id,
+		ShortcutName: shortcut.Name,
+		Link:         shortcut.Link,
+		ViewCount:    payload.ViewCount,
+		BaselineMean: payload.BaselineMean,
+		ZScore:       payload.ZScore,
+		HourStart:    hourStart.UTC().Format(time.RFC3339),
+	})
+}
+
+// detectAnomaly returns the direction of the anomaly of the current views against the baseline views,
+// with the mean of the baseline and the z-score of the current views.
+func detectAnomaly(baseline []int32, current int32, threshold float64, minViews int32) (storepb.ActivityShortcutAnomalyPayload_Direction, float64, float64) {
+	if len(baseline) == 0 {
+		return storepb.ActivityShortcutAnomalyPayload_DIRECTION_UNSPECIFIED, 0, 0
+	}
+	var sum float64
+	for _, views := range baseline {
+		sum += float64(views)
+	}
+	mean := sum / float64(len(baseline))
+	var variance float64
+	for _, views := range baseline {
+		variance += (float64(views) - mean) * (float64(views) - mean)
+	}
+	// Use at least 1 as the standard deviation so that flat traffic isn't over sensitive.
+	stddev := math.Max(math.Sqrt(variance/float64(len(baseline))), 1)
+	zScore := (float64(current) - mean) / stddev
+
+	if zScore >= threshold && current >= minViews {
+		return storepb.ActivityShortcutAnomalyPayload_SPIKE, mean, zScore
+	}
+	if zScore <= -threshold && mean >= float64(minViews) {
+		return storepb.ActivityShortcutAnomalyPayload_DROP, mean, zScore
+	}
+	return storepb.ActivityShortcutAnomalyPayload_DIRECTION_UNSPECIFIED, mean, zScore
+}
@@ -0,0 +1,49 @@
+package anomaly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+func TestDetectAnomaly(t *testing.T) {
+	tests := []struct {
+		baseline []int32
+		current  int32
+		want     storepb.ActivityShortcutAnomalyPayload_Direction
+	}{
+		{
+			baseline: []int32{10, 12, 11, 9, 10, 11},
+			current:  11,
+			want:     storepb.ActivityShortcutAnomalyPayload_DIRECTION_UNSPECIFIED,
+		},
+		{
+			baseline: []int32{10, 12, 11, 9, 10, 11},
+			current:  50,
+			want:     storepb.ActivityShortcutAnomalyPayload_SPIKE,
+		},
+		{
+			baseline: []int32{20, 22, 21, 19, 20, 21},
+			current:  0,
+			want:     storepb.ActivityShortcutAnomalyPayload_DROP,
+		},
+		{
+			// Spikes under the min views are noise.
+			baseline: []int32{0, 0, 1, 0, 0, 0},
+			current:  5,
+			want:     storepb.ActivityShortcutAnomalyPayload_DIRECTION_UNSPECIFIED,
+		},
+		{
+			// Drops of low traffic shortcuts are noise.
+			baseline: []int32{5, 6, 5, 6, 5, 6},
+			current:  0,
+			want:     storepb.ActivityShortcutAnomalyPayload_DIRECTION_UNSPECIFIED,
+		},
+	}
+	for _, test := range tests {
+		direction, _, _ := detectAnomaly(test.baseline, test.current, 3, 10)
+		require.Equal(t, test.want, direction)
+	}
+}
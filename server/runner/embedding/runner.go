@@ -0,0 +1,136 @@
+// Package embedding provides a runner to embed the shortcuts for the semantic search.
+package embedding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/warthurton/slash/plugin/embedding"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// Schedule runner every 10 minutes, so that the new and the changed shortcuts are found soon.
+	runnerInterval = 10 * time.Minute
+	// maxEmbeddingsPerRun bounds the shortcuts embedded in a run, the others are embedded in the next runs.
+	maxEmbeddingsPerRun = 500
+	// embeddingBatchSize is the number of shortcuts embedded by a request.
+	embeddingBatchSize = 50
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.embedShortcuts(ctx); err != nil {
+		slog.Error("failed to embed shortcuts", slog.Any("error", err))
+	}
+}
+
+// embedShortcuts embeds the shortcuts which aren't embedded with the model of the workspace yet, or changed since.
+func (r *Runner) embedShortcuts(ctx context.Context) error {
+	completionSetting, err := r.Store.GetWorkspaceCompletionSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if completionSetting.Endpoint == "" || completionSetting.EmbeddingModel == "" {
+		return nil
+	}
+
+	rowStatus := storepb.RowStatus_NORMAL
+	shortcuts, err := r.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &rowStatus,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcuts")
+	}
+	shortcutEmbeddings, err := r.Store.ListShortcutEmbeddings(ctx, &store.FindShortcutEmbedding{
+		Model: &completionSetting.EmbeddingModel,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcut embeddings")
+	}
+	contentHashes := map[int32]string{}
+	for _, shortcutEmbedding := range shortcutEmbeddings {
+		contentHashes[shortcutEmbedding.ShortcutID] = shortcutEmbedding.ContentHash
+	}
+	pending, texts := []*storepb.Shortcut{}, []string{}
+	for _, shortcut := range shortcuts {
+		text := GetShortcutEmbeddingText(shortcut)
+		if contentHashes[shortcut.Id] == hashText(text) {
+			continue
+		}
+		pending, texts = append(pending, shortcut), append(texts, text)
+		if len(pending) == maxEmbeddingsPerRun {
+			break
+		}
+	}
+
+	provider := embedding.NewOpenAIProvider(completionSetting.Endpoint, completionSetting.ApiKey, completionSetting.EmbeddingModel)
+	for start := 0; start < len(pending); start += embeddingBatchSize {
+		end := min(start+embeddingBatchSize, len(pending))
+		embeddings, err := provider.Embed(ctx, texts[start:end])
+		if err != nil {
+			return errors.Wrap(err, "failed to embed shortcuts")
+		}
+		for i, shortcut := range pending[start:end] {
+			if _, err := r.Store.UpsertShortcutEmbedding(ctx, &store.ShortcutEmbedding{
+				ShortcutID:  shortcut.Id,
+				Model:       completionSetting.EmbeddingModel,
+				ContentHash: hashText(texts[start+i]),
+				Embedding:   embeddings[i],
+			}); err != nil {
+				return errors.Wrap(err, "failed to upsert shortcut embedding")
+			}
+		}
+	}
+	return nil
+}
+
+// GetShortcutEmbeddingText returns the text of the shortcut which is embedded, with the fields describing it.
+func GetShortcutEmbeddingText(shortcut *storepb.Shortcut) string {
+	lines := []string{
+		"Name: " + shortcut.Name,
+		"Title: " + shortcut.Title,
+		"Description: " + shortcut.Description,
+		"Tags: " + strings.Join(shortcut.Tags, ", "),
+		"Link: " + shortcut.Link,
+	}
+	if ogMetadata := shortcut.OgMetadata; ogMetadata != nil {
+		lines = append(lines, "Page title: "+ogMetadata.Title, "Page description: "+ogMetadata.Description)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func hashText(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,100 @@
+package embedding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+	teststore "github.com/warthurton/slash/store/test"
+)
+
+func TestEmbedShortcuts(t *testing.T) {
+	var embeddedCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := struct {
+			Input []string `json:"input"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		embeddedCount.Add(int32(len(request.Input)))
+		data := []string{}
+		for i, input := range request.Input {
+			// The embedding tells the billing shortcuts apart from the others.
+			value := 0
+			if strings.Contains(strings.ToLower(input), "billing") {
+				value = 1
+			}
+			data = append(data, fmt.Sprintf(`{"index":%d,"embedding":[%d,1]}`, i, value))
+		}
+		_, _ = w.Write([]byte(`{"data":[` + strings.Join(data, ",") + `]}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	shortcuts := []*storepb.Shortcut{}
+	for _, name := range []string{"billing", "wiki"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  1,
+			Name:       name,
+			Link:       "https://" + name + ".example.com",
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		shortcuts = append(shortcuts, shortcut)
+	}
+
+	// The shortcuts aren't embedded without an embedding model.
+	runner := NewRunner(ts)
+	runner.RunOnce(ctx)
+	require.Zero(t, embeddedCount.Load())
+
+	_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COMPLETION,
+		Value: &storepb.WorkspaceSetting_Completion{
+			Completion: &storepb.WorkspaceSetting_CompletionSetting{
+				Endpoint:       server.URL,
+				Model:          "chat",
+				EmbeddingModel: "embedding",
+			},
+		},
+	})
+	require.NoError(t, err)
+	runner.RunOnce(ctx)
+	require.Equal(t, int32(2), embeddedCount.Load())
+	model := "embedding"
+	shortcutEmbeddings, err := ts.ListShortcutEmbeddings(ctx, &store.FindShortcutEmbedding{
+		Model: &model,
+	})
+	require.NoError(t, err)
+	require.Len(t, shortcutEmbeddings, 2)
+	require.Equal(t, []float32{1, 1}, shortcutEmbeddings[0].Embedding)
+	require.Equal(t, []float32{0, 1}, shortcutEmbeddings[1].Embedding)
+
+	// Only the changed shortcuts are embedded again.
+	title := "Billing dashboard"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:    shortcuts[1].Id,
+		Title: &title,
+	})
+	require.NoError(t, err)
+	runner.RunOnce(ctx)
+	require.Equal(t, int32(3), embeddedCount.Load())
+	shortcutEmbeddings, err = ts.ListShortcutEmbeddings(ctx, &store.FindShortcutEmbedding{
+		ShortcutID: &shortcuts[1].Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []float32{1, 1}, shortcutEmbeddings[0].Embedding)
+}
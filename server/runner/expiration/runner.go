@@ -0,0 +1,66 @@
+// Package expiration provides a runner to archive expired shortcuts.
+package expiration
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every minute.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.archiveExpiredShortcuts(ctx, time.Now()); err != nil {
+		slog.Error("failed to archive expired shortcuts", slog.Any("error", err))
+	}
+}
+
+func (r *Runner) archiveExpiredShortcuts(ctx context.Context, now time.Time) error {
+	normalStatus, archivedStatus := storepb.RowStatus_NORMAL, storepb.RowStatus_ARCHIVED
+	nowTs := now.Unix()
+	shortcuts, err := r.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus:      &normalStatus,
+		ExpireTsBefore: &nowTs,
+	})
+	if err != nil {
+		return err
+	}
+	for _, shortcut := range shortcuts {
+		if _, err := r.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:        shortcut.Id,
+			RowStatus: &archivedStatus,
+		}); err != nil {
+			return err
+		}
+		slog.Info("archived expired shortcut", slog.Int("shortcutID", int(shortcut.Id)), slog.String("name", shortcut.Name))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Package federation provides a runner to import the shortcuts and collections of the remote Slash instances.
+package federation
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/warthurton/slash/server/service/federation"
+	"github.com/warthurton/slash/store"
+)
+
+type Runner struct {
+	Store             *store.Store
+	FederationService *federation.Service
+}
+
+func NewRunner(store *store.Store, federationService *federation.Service) *Runner {
+	return &Runner{
+		Store:             store,
+		FederationService: federationService,
+	}
+}
+
+// Schedule runner every 5 minutes. Each source is synced when its own interval has passed.
+const runnerInterval = 5 * time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.FederationService.Sync(ctx); err != nil {
+		slog.Error("failed to sync federation sources", slog.Any("error", err))
+	}
+}
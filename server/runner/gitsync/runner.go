@@ -0,0 +1,46 @@
+// Package gitsync provides a runner to poll the file of shortcut definitions in the git repository.
+package gitsync
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/warthurton/slash/server/service/gitsync"
+	"github.com/warthurton/slash/store"
+)
+
+type Runner struct {
+	Store          *store.Store
+	GitSyncService *gitsync.Service
+}
+
+func NewRunner(store *store.Store, gitSyncService *gitsync.Service) *Runner {
+	return &Runner{
+		Store:          store,
+		GitSyncService: gitSyncService,
+	}
+}
+
+// Schedule runner every 5 minutes. The push webhook syncs the changes immediately.
+const runnerInterval = 5 * time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.GitSyncService.Sync(ctx); err != nil {
+		slog.Error("failed to sync shortcuts from git", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,223 @@
+// Package importjob provides a runner to run the import jobs in the background.
+package importjob
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// runnerInterval is the interval to claim the pending jobs.
+	runnerInterval = 5 * time.Second
+	// cleanupInterval is the interval to fail the stale jobs and delete the old ones.
+	cleanupInterval = time.Hour
+	// staleDuration is the duration without progress after which a running job is failed, e.g. its instance crashed.
+	staleDuration = 10 * time.Minute
+	// retentionDuration is the duration after which the succeeded and failed jobs are deleted.
+	retentionDuration = 7 * 24 * time.Hour
+	// maxRowErrors is the max number of row errors kept in a job.
+	maxRowErrors = 1000
+	// shutdownTimeout bounds the update of the job interrupted by the shutdown.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Importer imports the rows of the jobs.
+type Importer interface {
+	// WaitImportQuota waits for the import quota of the user for a row.
+	WaitImportQuota(ctx context.Context, userID int32) error
+	// ImportShortcut creates the shortcut of the row as the user.
+	ImportShortcut(ctx context.Context, userID int32, row *storepb.ImportJobRow) error
+}
+
+type Runner struct {
+	Store    *store.Store
+	Importer Importer
+}
+
+func NewRunner(store *store.Store, importer Importer) *Runner {
+	return &Runner{
+		Store:    store,
+		Importer: importer,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(cleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.runPendingJobs(ctx)
+		case <-cleanupTicker.C:
+			r.cleanup(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.cleanup(ctx)
+	r.runPendingJobs(ctx)
+}
+
+func (r *Runner) cleanup(ctx context.Context) {
+	if err := r.failStaleJobs(ctx, time.Now()); err != nil {
+		slog.Error("failed to fail stale import jobs", slog.Any("error", err))
+	}
+	if err := r.deleteOldJobs(ctx, time.Now()); err != nil {
+		slog.Error("failed to delete old import jobs", slog.Any("error", err))
+	}
+}
+
+// runPendingJobs runs the pending jobs one by one, the oldest first.
+func (r *Runner) runPendingJobs(ctx context.Context) {
+	for ctx.Err() == nil {
+		importJob, err := r.Store.ClaimImportJob(ctx)
+		if err != nil {
+			slog.Error("failed to claim import job", slog.Any("error", err))
+			return
+		}
+		if importJob == nil {
+			return
+		}
+		if err := r.runJob(ctx, importJob); err != nil {
+			slog.Error("failed to run import job", slog.Int("importJobID", int(importJob.ID)), slog.Any("error", err))
+		}
+	}
+}
+
+// runJob imports the rows of the job from the first one not processed. The rows which fail, e.g. with a taken name,
+// are recorded, and the job stops at the failures of the store, to be resumed.
+func (r *Runner) runJob(ctx context.Context, importJob *store.ImportJob) error {
+	rows := importJob.Payload.GetRows()
+	processedRows, createdCount, failedCount := importJob.ProcessedRows, importJob.CreatedCount, importJob.FailedCount
+	result := proto.Clone(importJob.Result).(*storepb.ImportJobResult)
+	for processedRows < int32(len(rows)) {
+		row := rows[processedRows]
+		err := r.Importer.WaitImportQuota(ctx, importJob.CreatorID)
+		if err == nil {
+			err = r.Importer.ImportShortcut(ctx, importJob.CreatorID, row)
+		}
+		if ctx.Err() != nil {
+			// The server shuts down, so the job is run again from the row on the next start.
+			shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+			defer cancel()
+			pending := store.ImportJobPending
+			_, updateErr := r.Store.UpdateImportJob(shutdownCtx, &store.UpdateImportJob{
+				ID:     importJob.ID,
+				Status: &pending,
+			})
+			return updateErr
+		}
+
+		update := &store.UpdateImportJob{
+			ID:            importJob.ID,
+			ProcessedRows: &processedRows,
+			CreatedCount:  &createdCount,
+			FailedCount:   &failedCount,
+		}
+		if err != nil {
+			if isJobError(err) {
+				failed, jobError := store.ImportJobFailed, status.Convert(err).Message()
+				update.Status, update.Error = &failed, &jobError
+				_, updateErr := r.Store.UpdateImportJob(ctx, update)
+				return updateErr
+			}
+			failedCount++
+			if len(result.RowErrors) < maxRowErrors {
+				result.RowErrors = append(result.RowErrors, &storepb.ImportJobRowError{
+					Row:   processedRows + 1,
+					Name:  row.Name,
+					Error: status.Convert(err).Message(),
+				})
+				update.Result = result
+			}
+		} else {
+			createdCount++
+		}
+		processedRows++
+		if _, err := r.Store.UpdateImportJob(ctx, update); err != nil {
+			return err
+		}
+	}
+
+	succeeded := store.ImportJobSucceeded
+	_, err := r.Store.UpdateImportJob(ctx, &store.UpdateImportJob{
+		ID:     importJob.ID,
+		Status: &succeeded,
+	})
+	return err
+}
+
+// isJobError returns whether the error of a row stops the job, i.e. it's a failure of the store
+// which the next rows would likely get too, rather than an invalid row.
+func isJobError(err error) bool {
+	switch status.Code(err) {
+	case codes.Internal, codes.Unavailable, codes.Unknown, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// failStaleJobs fails the running jobs without progress for staleDuration, e.g. their instance crashed,
+// so that they can be resumed.
+func (r *Runner) failStaleJobs(ctx context.Context, now time.Time) error {
+	running := store.ImportJobRunning
+	importJobs, err := r.Store.ListImportJobs(ctx, &store.FindImportJob{
+		Status: &running,
+	})
+	if err != nil {
+		return err
+	}
+	for _, importJob := range importJobs {
+		if now.Sub(time.Unix(importJob.UpdatedTs, 0)) < staleDuration {
+			continue
+		}
+		failed, jobError := store.ImportJobFailed, "the import was interrupted, e.g. the server crashed"
+		if _, err := r.Store.UpdateImportJob(ctx, &store.UpdateImportJob{
+			ID:     importJob.ID,
+			Status: &failed,
+			Error:  &jobError,
+		}); err != nil {
+			return err
+		}
+		slog.Warn("failed stale import job", slog.Int("importJobID", int(importJob.ID)))
+	}
+	return nil
+}
+
+// deleteOldJobs deletes the succeeded and failed jobs without progress for retentionDuration.
+func (r *Runner) deleteOldJobs(ctx context.Context, now time.Time) error {
+	for _, importJobStatus := range []store.ImportJobStatus{store.ImportJobSucceeded, store.ImportJobFailed} {
+		importJobs, err := r.Store.ListImportJobs(ctx, &store.FindImportJob{
+			Status: &importJobStatus,
+		})
+		if err != nil {
+			return err
+		}
+		for _, importJob := range importJobs {
+			if now.Sub(time.Unix(importJob.UpdatedTs, 0)) < retentionDuration {
+				continue
+			}
+			if err := r.Store.DeleteImportJob(ctx, &store.DeleteImportJob{
+				ID: importJob.ID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package importjob
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+	teststore "github.com/warthurton/slash/store/test"
+)
+
+type fakeImporter struct {
+	errors map[string]error
+	names  []string
+}
+
+func (*fakeImporter) WaitImportQuota(context.Context, int32) error {
+	return nil
+}
+
+func (f *fakeImporter) ImportShortcut(_ context.Context, _ int32, row *storepb.ImportJobRow) error {
+	if err, ok := f.errors[row.Name]; ok {
+		return err
+	}
+	f.names = append(f.names, row.Name)
+	return nil
+}
+
+func TestRunJob(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	importJob, err := ts.CreateImportJob(ctx, &store.ImportJob{
+		CreatorID: 1,
+		Format:    "csv",
+		Payload: &storepb.ImportJobPayload{
+			Rows: []*storepb.ImportJobRow{
+				{Name: "docs", Link: "https://docs.example.com"},
+				{Name: "taken", Link: "https://taken.example.com"},
+				{Name: "wiki", Link: "https://wiki.example.com"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// The taken name fails the row, and the failure of the store stops the job at the row.
+	importer := &fakeImporter{
+		errors: map[string]error{
+			"taken": status.Errorf(codes.AlreadyExists, "shortcut taken already exists"),
+			"wiki":  status.Errorf(codes.Internal, "database is locked"),
+		},
+	}
+	runner := NewRunner(ts, importer)
+	runner.RunOnce(ctx)
+	importJob, err = ts.GetImportJob(ctx, &store.FindImportJob{ID: &importJob.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.ImportJobFailed, importJob.Status)
+	require.Equal(t, "database is locked", importJob.Error)
+	require.Equal(t, int32(2), importJob.ProcessedRows)
+	require.Equal(t, int32(1), importJob.CreatedCount)
+	require.Equal(t, int32(1), importJob.FailedCount)
+	require.Len(t, importJob.Result.RowErrors, 1)
+	require.Equal(t, int32(2), importJob.Result.RowErrors[0].Row)
+
+	// The resumed job continues from the row it stopped at.
+	delete(importer.errors, "wiki")
+	pending := store.ImportJobPending
+	_, err = ts.UpdateImportJob(ctx, &store.UpdateImportJob{ID: importJob.ID, Status: &pending})
+	require.NoError(t, err)
+	runner.RunOnce(ctx)
+	importJob, err = ts.GetImportJob(ctx, &store.FindImportJob{ID: &importJob.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.ImportJobSucceeded, importJob.Status)
+	require.Equal(t, int32(3), importJob.ProcessedRows)
+	require.Equal(t, int32(2), importJob.CreatedCount)
+	require.Equal(t, []string{"docs", "wiki"}, importer.names)
+}
@@ -0,0 +1,267 @@
+// Package linkhealth provides a runner to check the links of the shortcuts and report the broken ones.
+package linkhealth
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/warthurton/slash/plugin/httpgetter"
+	"github.com/warthurton/slash/plugin/mail"
+	"github.com/warthurton/slash/plugin/webhook"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	"github.com/warthurton/slash/store"
+)
+
+const (
+	// Schedule runner every hour.
+	runnerInterval = time.Hour
+	// The links are checked once a day.
+	checkInterval = 24 * time.Hour
+	// maxChecksPerRun bounds the links checked in a run, the others are checked in the next runs.
+	maxChecksPerRun = 200
+	// maxConcurrentChecks bounds the links requested at the same time.
+	maxConcurrentChecks = 8
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.checkLinks(ctx); err != nil {
+		slog.Error("failed to check shortcut links", slog.Any("error", err))
+	}
+}
+
+// BrokenLinkPayload is the payload posted to the webhook when the link of a shortcut becomes broken.
+type BrokenLinkPayload struct {
+	Type         string `json:"type"`
+	ShortcutID   int32  `json:"shortcutId"`
+	ShortcutName string `json:"shortcutName"`
+	Link         string `json:"link"`
+	StatusCode   int32  `json:"statusCode"`
+	Error        string `json:"error"`
+	CheckedTime  string `json:"checkedTime"`
+}
+
+func (r *Runner) checkLinks(ctx context.Context) error {
+	shortcutRelatedSetting, err := r.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return err
+	}
+	setting := shortcutRelatedSetting.GetLinkHealthCheck()
+	if !setting.GetEnabled() {
+		return nil
+	}
+
+	rowStatus := storepb.RowStatus_NORMAL
+	shortcuts, err := r.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &rowStatus,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcuts")
+	}
+	now := time.Now()
+	shortcuts = selectShortcutsToCheck(shortcuts, now)
+
+	linkHealths := make([]*storepb.LinkHealth, len(shortcuts))
+	semaphore := make(chan struct{}, maxConcurrentChecks)
+	var wg sync.WaitGroup
+	for i, shortcut := range shortcuts {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, shortcut *storepb.Shortcut) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			link, err := common.ExpandWildcardLink(shortcut.Link, "")
+			if err != nil {
+				linkHealths[i] = nextLinkHealth(shortcut.LinkHealth, 0, err, time.Now())
+				return
+			}
+			statusCode, err := httpgetter.CheckReachability(ctx, link)
+			linkHealths[i] = nextLinkHealth(shortcut.LinkHealth, int32(statusCode), err, time.Now())
+		}(i, shortcut)
+	}
+	wg.Wait()
+
+	for i, shortcut := range shortcuts {
+		linkHealth := linkHealths[i]
+		if _, err := r.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:         shortcut.Id,
+			LinkHealth: linkHealth,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update shortcut link health")
+		}
+		// Only report the link once, when it becomes broken.
+		if store.IsLinkBroken(shortcut.LinkHealth) || !store.IsLinkBroken(linkHealth) {
+			continue
+		}
+		if err := r.report(ctx, setting, shortcut, linkHealth); err != nil {
+			slog.Error("failed to report broken shortcut link", slog.Int("shortcutID", int(shortcut.Id)), slog.Any("error", err))
+		}
+	}
+	return nil
+}
+
+func (r *Runner) report(ctx context.Context, setting *storepb.WorkspaceSetting_LinkHealthCheckSetting, shortcut *storepb.Shortcut, linkHealth *storepb.LinkHealth) error {
+	slog.Warn("detected broken shortcut link", slog.String("shortcut", shortcut.Name), slog.Int("statusCode", int(linkHealth.StatusCode)), slog.String("error", linkHealth.Error))
+	payloadStr, err := protojson.Marshal(&storepb.ActivityShortcutLinkBrokenPayload{
+		ShortcutId: shortcut.Id,
+		StatusCode: linkHealth.StatusCode,
+		Error:      linkHealth.Error,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	if _, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: common.BotID,
+		Type:      store.ActivityShortcutLinkBroken,
+		Level:     store.ActivityWarn,
+		Payload:   string(payloadStr),
+	}); err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+
+	if setting.NotifyCreators {
+		if err := r.notifyCreator(ctx, shortcut, linkHealth); err != nil {
+			slog.Error("failed to notify the creator of the broken shortcut link", slog.Int("shortcutID", int(shortcut.Id)), slog.Any("error", err))
+		}
+	}
+	if setting.WebhookUrl == "" {
+		return nil
+	}
+	return webhook.Post(ctx, setting.WebhookUrl, &BrokenLinkPayload{
+		Type:         store.ActivityShortcutLinkBroken.String(),
+		ShortcutID:   shortcut.Id,
+		ShortcutName: shortcut.Name,
+		Link:         shortcut.Link,
+		StatusCode:   linkHealth.StatusCode,
+		Error:        linkHealth.Error,
+		CheckedTime:  time.Unix(linkHealth.CheckedTs, 0).UTC().Format(time.RFC3339),
+	})
+}
+
+// notifyCreator emails the creator of the shortcut, if the SMTP server is configured.
+func (r *Runner) notifyCreator(ctx context.Context, shortcut *storepb.Shortcut, linkHealth *storepb.LinkHealth) error {
+	mailSetting, err := r.Store.GetWorkspaceMailSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace mail setting")
+	}
+	if mailSetting.SmtpHost == "" {
+		return nil
+	}
+	creator, err := r.Store.GetUser(ctx, &store.FindUser{
+		ID: &shortcut.CreatorId,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get creator")
+	}
+	if creator == nil || creator.Email == "" {
+		return nil
+	}
+	generalSetting, err := r.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace general setting")
+	}
+
+	reason := linkHealth.Error
+	if reason == "" {
+		reason = fmt.Sprintf("status %d", linkHealth.StatusCode)
+	}
+	body := fmt.Sprintf(
+		`<p>Hi %s,</p><p>The link of your shortcut <b>%s</b> is broken: <a href="%s">%s</a> failed its last checks with %s.</p>`,
+		html.EscapeString(creator.Nickname),
+		html.EscapeString(shortcut.Name),
+		html.EscapeString(shortcut.Link),
+		html.EscapeString(shortcut.Link),
+		html.EscapeString(reason),
+	)
+	if generalSetting.InstanceUrl != "" {
+		editLink := fmt.Sprintf("%s/shortcut/%d", generalSetting.InstanceUrl, shortcut.Id)
+		body += fmt.Sprintf(`<p>Update it from <a href="%s">%s</a>.</p>`, html.EscapeString(editLink), html.EscapeString(editLink))
+	}
+	email := mail.NewEmailMsg().
+		SetFrom(mailSetting.From).
+		AddTo(creator.Email).
+		SetSubject(fmt.Sprintf("The link of your shortcut %s is broken", shortcut.Name)).
+		SetBody(body)
+	return common.NewSMTPClient(mailSetting).SendMail(email)
+}
+
+// selectShortcutsToCheck returns the active shortcuts with a http(s) link not checked within the check interval,
+// the least recently checked first.
+func selectShortcutsToCheck(shortcuts []*storepb.Shortcut, now time.Time) []*storepb.Shortcut {
+	selected := []*storepb.Shortcut{}
+	for _, shortcut := range shortcuts {
+		if shortcut.ActivateTs > now.Unix() || (shortcut.ExpireTs > 0 && shortcut.ExpireTs <= now.Unix()) {
+			continue
+		}
+		u, err := url.Parse(shortcut.Link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			continue
+		}
+		if now.Sub(time.Unix(shortcut.LinkHealth.GetCheckedTs(), 0)) < checkInterval {
+			continue
+		}
+		selected = append(selected, shortcut)
+	}
+	slices.SortStableFunc(selected, func(i, j *storepb.Shortcut) int {
+		return int(i.LinkHealth.GetCheckedTs() - j.LinkHealth.GetCheckedTs())
+	})
+	if len(selected) > maxChecksPerRun {
+		selected = selected[:maxChecksPerRun]
+	}
+	return selected
+}
+
+// nextLinkHealth returns the link health after a check, counting the consecutive failed checks.
+func nextLinkHealth(previous *storepb.LinkHealth, statusCode int32, err error, now time.Time) *storepb.LinkHealth {
+	linkHealth := &storepb.LinkHealth{
+		CheckedTs:  now.Unix(),
+		StatusCode: statusCode,
+	}
+	if err != nil {
+		// The errors of the requests repeat the link, which is already known.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		linkHealth.Error = err.Error()
+		// The links on the network of the server aren't requested, so they're not broken, only unchecked.
+		if errors.Is(err, httpgetter.ErrForbiddenAddress) {
+			return linkHealth
+		}
+		linkHealth.FailureCount = previous.GetFailureCount() + 1
+	}
+	return linkHealth
+}
@@ -0,0 +1,55 @@
+package linkhealth
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warthurton/slash/plugin/httpgetter"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestNextLinkHealth(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	linkHealth := nextLinkHealth(&storepb.LinkHealth{}, 404, errors.New("unexpected status: 404 Not Found"), now)
+	require.Equal(t, int64(1700000000), linkHealth.CheckedTs)
+	require.Equal(t, int32(1), linkHealth.FailureCount)
+	require.False(t, store.IsLinkBroken(linkHealth))
+
+	linkHealth = nextLinkHealth(linkHealth, 0, &url.Error{Op: "Head", URL: "https://wiki.example.com", Err: errors.New("no such host")}, now)
+	require.Equal(t, int32(2), linkHealth.FailureCount)
+	require.Equal(t, "no such host", linkHealth.Error)
+	require.True(t, store.IsLinkBroken(linkHealth))
+
+	linkHealth = nextLinkHealth(linkHealth, 200, nil, now)
+	require.Equal(t, int32(0), linkHealth.FailureCount)
+	require.Equal(t, "", linkHealth.Error)
+	require.False(t, store.IsLinkBroken(linkHealth))
+
+	linkHealth = nextLinkHealth(linkHealth, 0, &url.Error{Op: "Head", URL: "http://intranet", Err: httpgetter.ErrForbiddenAddress}, now)
+	require.Equal(t, int32(0), linkHealth.FailureCount)
+	require.Equal(t, httpgetter.ErrForbiddenAddress.Error(), linkHealth.Error)
+	require.False(t, store.IsLinkBroken(linkHealth))
+}
+
+func TestSelectShortcutsToCheck(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	shortcuts := []*storepb.Shortcut{
+		{Id: 1, Link: "https://checked.example.com", LinkHealth: &storepb.LinkHealth{CheckedTs: now.Add(-time.Hour).Unix()}},
+		{Id: 2, Link: "https://stale.example.com", LinkHealth: &storepb.LinkHealth{CheckedTs: now.Add(-48 * time.Hour).Unix()}},
+		{Id: 3, Link: "https://unchecked.example.com", LinkHealth: &storepb.LinkHealth{}},
+		{Id: 4, Link: "mailto:team@example.com", LinkHealth: &storepb.LinkHealth{}},
+		{Id: 5, Link: "https://scheduled.example.com", ActivateTs: now.Add(time.Hour).Unix(), LinkHealth: &storepb.LinkHealth{}},
+		{Id: 6, Link: "https://expired.example.com", ExpireTs: now.Add(-time.Hour).Unix(), LinkHealth: &storepb.LinkHealth{}},
+	}
+	selected := selectShortcutsToCheck(shortcuts, now)
+	ids := []int32{}
+	for _, shortcut := range selected {
+		ids = append(ids, shortcut.Id)
+	}
+	require.Equal(t, []int32{3, 2}, ids)
+}
@@ -0,0 +1,91 @@
+// Package topshortcut provides a runner to refresh the top shortcuts labelled in the metrics.
+package topshortcut
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/metrics"
+	"github.com/warthurton/slash/store"
+)
+
+type Runner struct {
+	Store   *store.Store
+	Metrics *metrics.Metrics
+}
+
+func NewRunner(store *store.Store, metrics *metrics.Metrics) *Runner {
+	return &Runner{
+		Store:   store,
+		Metrics: metrics,
+	}
+}
+
+const (
+	// Schedule runner every hour.
+	runnerInterval = time.Hour
+	// The top shortcuts are ranked by their views in the window.
+	rankingWindow = 7 * 24 * time.Hour
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if r.Metrics == nil || r.Metrics.TopShortcutsLimit <= 0 {
+		return
+	}
+	if err := r.refreshTopShortcuts(ctx, time.Now()); err != nil {
+		slog.Error("failed to refresh top shortcuts of metrics", slog.Any("error", err))
+	}
+}
+
+func (r *Runner) refreshTopShortcuts(ctx context.Context, now time.Time) error {
+	createdTsAfter := now.Add(-rankingWindow).Unix()
+	viewCounts, err := r.Store.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+		CreatedTsAfter: &createdTsAfter,
+	})
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(viewCounts, func(a, b *store.ShortcutViewCount) int {
+		if a.Count != b.Count {
+			return int(b.Count - a.Count)
+		}
+		return int(a.ShortcutID - b.ShortcutID)
+	})
+
+	topShortcuts := []*storepb.Shortcut{}
+	for _, viewCount := range viewCounts {
+		if len(topShortcuts) >= r.Metrics.TopShortcutsLimit {
+			break
+		}
+		shortcut, err := r.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID: &viewCount.ShortcutID,
+		})
+		if err != nil {
+			return err
+		}
+		// Skip the views of deleted shortcuts.
+		if shortcut == nil {
+			continue
+		}
+		topShortcuts = append(topShortcuts, shortcut)
+	}
+	r.Metrics.SetTopShortcuts(topShortcuts)
+	return nil
+}
@@ -5,19 +5,35 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
-	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/acme/autocert"
+	"google.golang.org/grpc"
 
-	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/metrics"
 	"github.com/warthurton/slash/server/profile"
 	apiv1 "github.com/warthurton/slash/server/route/api/v1"
 	"github.com/warthurton/slash/server/route/frontend"
+	"github.com/warthurton/slash/server/runner/accesstoken"
+	"github.com/warthurton/slash/server/runner/activity"
+	"github.com/warthurton/slash/server/runner/anomaly"
+	embeddingrn "github.com/warthurton/slash/server/runner/embedding"
+	"github.com/warthurton/slash/server/runner/expiration"
+	federationrn "github.com/warthurton/slash/server/runner/federation"
+	gitsyncrn "github.com/warthurton/slash/server/runner/gitsync"
+	"github.com/warthurton/slash/server/runner/importjob"
 	licensern "github.com/warthurton/slash/server/runner/license"
+	"github.com/warthurton/slash/server/runner/linkhealth"
+	"github.com/warthurton/slash/server/runner/topshortcut"
 	"github.com/warthurton/slash/server/runner/version"
+	"github.com/warthurton/slash/server/service/federation"
+	"github.com/warthurton/slash/server/service/gitsync"
 	"github.com/warthurton/slash/server/service/license"
 	"github.com/warthurton/slash/store"
 )
@@ -30,47 +46,109 @@ type Server struct {
 	Secret  string
 
 	licenseService *license.LicenseService
+	gitSyncService *gitsync.Service
+	// federationService imports the shortcuts and collections of the remote Slash instances.
+	federationService *federation.Service
+	// metrics is nil unless the metrics are enabled.
+	metrics *metrics.Metrics
 
 	// API services.
 	apiV1Service *apiv1.APIV1Service
+
+	// startedTime is the time the server was created, for the uptime of the status page.
+	startedTime time.Time
+	// emailCheck is the cached check of the SMTP server of the status page.
+	emailCheck emailCheck
+	// listening is closed once the HTTP listener is bound, so that the server accepts the connections.
+	listening chan struct{}
+
+	// shuttingDown is set once the shutdown starts, so that the server is no longer ready.
+	shuttingDown atomic.Bool
+	// cancelRunners stops the background runners, and runners waits for them on shutdown.
+	cancelRunners context.CancelFunc
+	runners       sync.WaitGroup
 }
 
+// shutdownFlushTimeout bounds the stop of the runners and the flush of the pending writes on shutdown,
+// after the requests are drained.
+const shutdownFlushTimeout = 10 * time.Second
+
 func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store) (*Server, error) {
 	e := echo.New()
 	e.Debug = true
 	e.HideBanner = true
 	e.HidePort = true
+	if profile.ACMEDomain != "" {
+		// The certificates are provisioned with the TLS-ALPN challenge, on the HTTPS port, and cached in the data directory.
+		e.AutoTLSManager.HostPolicy = autocert.HostWhitelist(profile.GetACMEDomains()...)
+		e.AutoTLSManager.Cache = autocert.DirCache(filepath.Join(profile.Data, "acme"))
+	}
+	if profile.BasePath != "" {
+		// The routes are registered without the base path, which is stripped before the routing.
+		e.Pre(newBasePathMiddleware(profile.BasePath))
+	}
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		e.DefaultHTTPErrorHandler(apiv1.SanitizeHTTPError(c, err), c)
+	}
+
+	// Log the HTTP requests, including the ones which panic.
+	e.Use(apiv1.AccessLogMiddleware)
+	// Recover from panics in HTTP handlers and bound their duration.
+	e.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{
+		LogErrorFunc: func(c echo.Context, err error, stack []byte) error {
+			slog.Error("panic recovered", slog.String("path", c.Path()), slog.String("error", err.Error()), slog.String("stack", string(stack)))
+			return err
+		},
+	}))
+	e.Use(middleware.ContextTimeoutWithConfig(middleware.ContextTimeoutConfig{
+		// The imports stream their progress while they are throttled, until the client cancels them.
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == apiv1.ImportPath || c.Path() == apiv1.ImportJobEventsPath
+		},
+		Timeout: apiv1.MaxHandlerDuration,
+	}))
 
 	licenseService := license.NewLicenseService(profile, store)
+	gitSyncService := gitsync.NewService(store)
+	federationService := federation.NewService(store)
 
 	s := &Server{
-		e:              e,
-		Profile:        profile,
-		Store:          store,
-		licenseService: licenseService,
+		e:                 e,
+		Profile:           profile,
+		Store:             store,
+		licenseService:    licenseService,
+		gitSyncService:    gitSyncService,
+		federationService: federationService,
+		startedTime:       time.Now(),
+		listening:         make(chan struct{}),
 	}
 
-	// Serve frontend.
-	frontendService := frontend.NewFrontendService(profile, store)
-	frontendService.Serve(ctx, e)
+	if profile.Metrics {
+		s.metrics = metrics.New(profile.MetricsTopShortcuts)
+		e.GET("/metrics", echo.WrapHandler(s.metrics.Handler()))
+	}
 
 	// In dev mode, we'd like to set the const secret key to make signin session persistence.
 	secret := "slash"
 	if profile.Mode == "prod" {
 		var err error
-		secret, err = s.getSecretSession(ctx)
+		secret, err = store.GetOrCreateWorkspaceSecretSession(ctx)
 		if err != nil {
 			return nil, err
 		}
 	}
 	s.Secret = secret
 
-	// Register healthz endpoint.
-	e.GET("/healthz", func(c echo.Context) error {
-		return c.String(http.StatusOK, "Service ready.")
-	})
+	s.apiV1Service = apiv1.NewAPIV1Service(secret, profile, store, licenseService, gitSyncService, federationService, s.Profile.Port+1)
+
+	// Serve frontend.
+	frontendService := frontend.NewFrontendService(profile, store, s.metrics, apiv1.NewGRPCAuthInterceptor(store, secret), s.apiV1Service, s.apiV1Service)
+	frontendService.Serve(ctx, e)
+
+	// Register health probes and the status page.
+	s.registerHealthRoutes(e)
+	s.registerStatusRoutes(e)
 
-	s.apiV1Service = apiv1.NewAPIV1Service(secret, profile, store, licenseService, s.Profile.Port+1)
 	// Register gRPC gateway as api v1.
 	if err := s.apiV1Service.RegisterGateway(ctx, e); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -80,7 +158,9 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 }
 
 func (s *Server) Start(ctx context.Context) error {
-	s.StartBackgroundRunners(ctx)
+	runnerCtx, cancelRunners := context.WithCancel(ctx)
+	s.cancelRunners = cancelRunners
+	s.StartBackgroundRunners(runnerCtx)
 	// Start gRPC server.
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", s.Profile.Port+1))
 	if err != nil {
@@ -92,26 +172,96 @@ func (s *Server) Start(ctx context.Context) error {
 		}
 	}()
 
-	return s.e.Start(fmt.Sprintf(":%d", s.Profile.Port))
+	// The gRPC requests are served on the port too, over HTTP/2.
+	server, err := s.configureHTTPServer(fmt.Sprintf(":%d", s.Profile.Port))
+	if err != nil {
+		return err
+	}
+	return serveHTTP(server, s.listening)
+}
+
+// Listening returns a channel closed once the server accepts the HTTP connections.
+func (s *Server) Listening() <-chan struct{} {
+	return s.listening
 }
 
+// Shutdown stops accepting connections and waits for the in-flight requests up to the drain timeout,
+// then stops the runners, flushes the pending writes and closes the database.
 func (s *Server) Shutdown(ctx context.Context) {
-	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
+	s.shuttingDown.Store(true)
+
+	drainCtx, cancelDrain := context.WithTimeout(ctx, s.Profile.DrainTimeout)
+	defer cancelDrain()
+	// Shutdown echo server, the requests still running after the drain timeout are cut.
+	if err := s.e.Shutdown(drainCtx); err != nil {
+		slog.Warn("failed to drain requests, closing the connections", slog.Any("error", err))
+		if err := s.e.Close(); err != nil {
+			slog.Error("failed to close server", slog.Any("error", err))
+		}
+	}
+	// The gateway requests are drained with the echo server, the direct gRPC requests are drained here.
+	stopGRPCServer(drainCtx, s.apiV1Service.GetGRPCServer())
+
+	flushCtx, cancelFlush := context.WithTimeout(context.WithoutCancel(ctx), shutdownFlushTimeout)
+	defer cancelFlush()
+	// Stop the runners, e.g. the import jobs record their progress, and wait for the background writes.
+	if s.cancelRunners != nil {
+		s.cancelRunners()
+	}
+	if err := waitGroupContext(flushCtx, &s.runners); err != nil {
+		slog.Warn("failed to wait for background runners", slog.Any("error", err))
+	}
+	if err := s.apiV1Service.WaitBackgroundTasks(flushCtx); err != nil {
+		slog.Warn("failed to wait for background tasks", slog.Any("error", err))
+	}
 
-	// Shutdown echo server.
-	if err := s.e.Shutdown(ctx); err != nil {
-		fmt.Printf("failed to shutdown server, error: %v\n", err)
+	// Persist buffered access token usages.
+	if err := s.Store.FlushAccessTokenUsage(flushCtx); err != nil {
+		slog.Error("failed to flush access token usage", slog.Any("error", err))
+	}
+	// Create the activities queued in the write-ahead log, e.g. during a database outage, while the database is open.
+	if _, err := s.Store.ReplayActivityWAL(flushCtx); err != nil {
+		slog.Error("failed to replay activity write-ahead log", slog.Any("error", err))
 	}
 
 	// Close database connection.
 	if err := s.Store.Close(); err != nil {
-		fmt.Printf("failed to close database, error: %v\n", err)
+		slog.Error("failed to close database", slog.Any("error", err))
 	}
 
 	fmt.Printf("server stopped properly\n")
 }
 
+// stopGRPCServer stops the gRPC server gracefully, or right away once the context is done.
+func stopGRPCServer(ctx context.Context, grpcServer *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+		<-stopped
+	}
+}
+
+// waitGroupContext waits for the wait group, or returns the error of the context once it's done.
+func waitGroupContext(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (s *Server) GetEcho() *echo.Echo {
 	return s.e
 }
@@ -121,30 +271,57 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 	licenseRunner.RunOnce(ctx)
 	versionRunner := version.NewRunner(s.Store, s.Profile)
 	versionRunner.RunOnce(ctx)
+	accessTokenRunner := accesstoken.NewRunner(s.Store)
+	accessTokenRunner.RunOnce(ctx)
+	anomalyRunner := anomaly.NewRunner(s.Store)
+	anomalyRunner.RunOnce(ctx)
+	linkHealthRunner := linkhealth.NewRunner(s.Store)
+	embeddingRunner := embeddingrn.NewRunner(s.Store)
+	expirationRunner := expiration.NewRunner(s.Store)
+	expirationRunner.RunOnce(ctx)
+	activityRunner := activity.NewRunner(s.Store, s.Profile)
+	activityRunner.RunOnce(ctx)
+	topShortcutRunner := topshortcut.NewRunner(s.Store, s.metrics)
+	topShortcutRunner.RunOnce(ctx)
+	gitSyncRunner := gitsyncrn.NewRunner(s.Store, s.gitSyncService)
+	gitSyncRunner.RunOnce(ctx)
+	federationRunner := federationrn.NewRunner(s.Store, s.federationService)
+	federationRunner.RunOnce(ctx)
+	importJobRunner := importjob.NewRunner(s.Store, s.apiV1Service)
 
-	go licenseRunner.Run(ctx)
-	go versionRunner.Run(ctx)
+	s.runInBackground(func() { licenseRunner.Run(ctx) })
+	s.runInBackground(func() { versionRunner.Run(ctx) })
+	s.runInBackground(func() { accessTokenRunner.Run(ctx) })
+	s.runInBackground(func() { anomalyRunner.Run(ctx) })
+	// The first links are checked in the background too, not to delay the start with the requests.
+	s.runInBackground(func() {
+		linkHealthRunner.RunOnce(ctx)
+		linkHealthRunner.Run(ctx)
+	})
+	// The shortcuts are embedded in the background too, not to delay the start with the requests.
+	s.runInBackground(func() {
+		embeddingRunner.RunOnce(ctx)
+		embeddingRunner.Run(ctx)
+	})
+	s.runInBackground(func() { expirationRunner.Run(ctx) })
+	s.runInBackground(func() { activityRunner.Run(ctx) })
+	s.runInBackground(func() { gitSyncRunner.Run(ctx) })
+	s.runInBackground(func() { federationRunner.Run(ctx) })
+	// The pending imports are run in the background too, as they are throttled by the import quota.
+	s.runInBackground(func() {
+		importJobRunner.RunOnce(ctx)
+		importJobRunner.Run(ctx)
+	})
+	if s.metrics != nil {
+		s.runInBackground(func() { topShortcutRunner.Run(ctx) })
+	}
 }
 
-func (s *Server) getSecretSession(ctx context.Context) (string, error) {
-	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
-	if err != nil {
-		return "", err
-	}
-	secretSession := workspaceGeneralSetting.SecretSession
-	if secretSession == "" {
-		secretSession = uuid.New().String()
-		_, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
-			Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL,
-			Value: &storepb.WorkspaceSetting_General{
-				General: &storepb.WorkspaceSetting_GeneralSetting{
-					SecretSession: secretSession,
-				},
-			},
-		})
-		if err != nil {
-			return "", err
-		}
-	}
-	return secretSession, nil
+// runInBackground runs the runner in a goroutine, which is waited for on shutdown.
+func (s *Server) runInBackground(run func()) {
+	s.runners.Add(1)
+	go func() {
+		defer s.runners.Done()
+		run()
+	}()
 }
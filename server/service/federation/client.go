@@ -0,0 +1,103 @@
+package federation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+)
+
+// maxPageSize is the max page size of the list APIs of the remote instance.
+const maxPageSize = 1000
+
+// maxResponseSize is the max size of a page of the remote instance.
+const maxResponseSize = 32 << 20
+
+// Client lists the shortcuts and collections of a remote Slash instance with its REST API.
+type Client struct {
+	baseURL     string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// NewClient creates a client of the remote Slash instance at baseURL.
+func NewClient(baseURL, accessToken string) *Client {
+	return &Client{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		accessToken: accessToken,
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// ListShortcuts lists all the shortcuts of the remote instance.
+func (c *Client) ListShortcuts(ctx context.Context) ([]*v1pb.Shortcut, error) {
+	shortcuts := []*v1pb.Shortcut{}
+	pageToken := ""
+	for {
+		response := &v1pb.ListShortcutsResponse{}
+		if err := c.list(ctx, "/api/v1/shortcuts", pageToken, response); err != nil {
+			return nil, err
+		}
+		shortcuts = append(shortcuts, response.Shortcuts...)
+		if response.NextPageToken == "" {
+			return shortcuts, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
+// ListCollections lists all the collections of the remote instance.
+func (c *Client) ListCollections(ctx context.Context) ([]*v1pb.Collection, error) {
+	collections := []*v1pb.Collection{}
+	pageToken := ""
+	for {
+		response := &v1pb.ListCollectionsResponse{}
+		if err := c.list(ctx, "/api/v1/collections", pageToken, response); err != nil {
+			return nil, err
+		}
+		collections = append(collections, response.Collections...)
+		if response.NextPageToken == "" {
+			return collections, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
+func (c *Client) list(ctx context.Context, path, pageToken string, response proto.Message) error {
+	query := url.Values{"pageSize": {fmt.Sprint(maxPageSize)}}
+	if pageToken != "" {
+		query.Set("pageToken", pageToken)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return errors.Wrap(err, "invalid url")
+	}
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to request %s", path)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the response of %s", path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to request %s: %s", path, resp.Status)
+	}
+	// The remote instance may be of a newer version with unknown fields.
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, response); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal the response of %s", path)
+	}
+	return nil
+}
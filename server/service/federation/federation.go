@@ -0,0 +1,352 @@
+// Package federation imports the public shortcuts and collections of remote Slash instances,
+// e.g. the per-department instances of an organization, with a prefix of their names.
+//
+// Each source is applied declaratively like the git sync: the imported shortcuts and collections
+// are created or updated, and the ones removed from the remote instance are deleted. The existing
+// shortcuts and collections which aren't imported from the source are never overwritten.
+// The imported ones are only visible to the workspace, so that the instances importing each other
+// don't import them back.
+package federation
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/warthurton/slash/internal/util"
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+// DefaultIntervalMinutes is the minutes between the syncs of a source when none is configured.
+const DefaultIntervalMinutes = 60
+
+// personalNamespacePrefix is the prefix of the shortcuts in the personal namespaces, which aren't imported.
+const personalNamespacePrefix = "~"
+
+type Service struct {
+	Store *store.Store
+
+	// mutex serializes the syncs, so that a source isn't applied twice concurrently.
+	mutex sync.Mutex
+}
+
+// NewService creates a new federation service.
+func NewService(store *store.Store) *Service {
+	return &Service{
+		Store: store,
+	}
+}
+
+// ValidateSource validates the config of the source.
+func ValidateSource(source *storepb.WorkspaceSetting_FederationSource) error {
+	if source.Id == "" {
+		return errors.New("id is required")
+	}
+	if !util.ValidateURI(source.Url) || (!strings.HasPrefix(source.Url, "http://") && !strings.HasPrefix(source.Url, "https://")) {
+		return errors.Errorf("invalid url %q", source.Url)
+	}
+	if source.AccessToken == "" {
+		return errors.New("access token is required")
+	}
+	if source.Prefix == "" {
+		return errors.New("prefix is required to avoid conflicts with the existing shortcuts")
+	}
+	if strings.HasPrefix(source.Prefix, personalNamespacePrefix) {
+		return errors.Errorf("prefix must not start with %q", personalNamespacePrefix)
+	}
+	if source.IntervalMinutes < 0 {
+		return errors.New("interval minutes must not be negative")
+	}
+	return nil
+}
+
+// Sync syncs the enabled sources whose interval has passed since their last sync.
+func (s *Service) Sync(ctx context.Context) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	setting, err := s.Store.GetWorkspaceFederationSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace federation setting")
+	}
+	now := time.Now()
+	for _, source := range setting.Sources {
+		if !source.Enabled || !isDue(source, now) {
+			continue
+		}
+		managed, syncErr := s.syncSource(ctx, source)
+		if syncErr != nil {
+			slog.Error("failed to sync federation source", slog.String("source", source.Id), slog.Any("error", syncErr))
+		} else {
+			slog.Info("synced federation source", slog.String("source", source.Id), slog.Int("shortcuts", len(managed.shortcuts)), slog.Int("collections", len(managed.collections)))
+		}
+		if err := s.saveSyncResult(ctx, source.Id, now, managed, syncErr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// managedNames is the names of the shortcuts and collections managed by a source.
+type managedNames struct {
+	shortcuts   []string
+	collections []string
+}
+
+// syncSource applies the public shortcuts and collections of the source. When it fails halfway,
+// the returned names still include the ones created so far, so that they're managed by the source.
+func (s *Service) syncSource(ctx context.Context, source *storepb.WorkspaceSetting_FederationSource) (*managedNames, error) {
+	managed := &managedNames{
+		shortcuts:   slices.Clone(source.ManagedShortcuts),
+		collections: slices.Clone(source.ManagedCollections),
+	}
+	client := NewClient(source.Url, source.AccessToken)
+	remoteShortcuts, err := client.ListShortcuts(ctx)
+	if err != nil {
+		return managed, err
+	}
+	remoteCollections, err := client.ListCollections(ctx)
+	if err != nil {
+		return managed, err
+	}
+
+	adminRole := store.RoleAdmin
+	admins, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Role: &adminRole,
+	})
+	if err != nil {
+		return managed, errors.Wrap(err, "failed to list admins")
+	}
+	if len(admins) == 0 {
+		return managed, errors.New("no admin to own the imported shortcuts")
+	}
+	creatorID := admins[0].ID
+
+	// localShortcutIDs maps the ids of the remote shortcuts to the ids of the imported ones.
+	localShortcutIDs := map[int32]int32{}
+	shortcutNames := []string{}
+	for _, remoteShortcut := range remoteShortcuts {
+		if !isImported(remoteShortcut.Visibility, remoteShortcut.Name) || remoteShortcut.State == v1pb.State_INACTIVE {
+			continue
+		}
+		name := source.Prefix + remoteShortcut.Name
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		if err != nil {
+			return managed, errors.Wrapf(err, "failed to get shortcut %s", name)
+		}
+		if shortcut == nil {
+			shortcut, err = s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+				CreatorId:   creatorID,
+				Name:        name,
+				Link:        remoteShortcut.Link,
+				Title:       remoteShortcut.Title,
+				Description: remoteShortcut.Description,
+				Tags:        remoteShortcut.Tags,
+				Visibility:  storepb.Visibility_WORKSPACE,
+				OgMetadata:  convertOpenGraphMetadata(remoteShortcut.OgMetadata),
+			})
+			if err != nil {
+				return managed, errors.Wrapf(err, "failed to create shortcut %s", name)
+			}
+			managed.shortcuts = appendName(managed.shortcuts, name)
+		} else if !slices.Contains(source.ManagedShortcuts, name) {
+			slog.Warn("skip the federated shortcut conflicting with an existing one", slog.String("source", source.Id), slog.String("name", name))
+			continue
+		} else if update := getShortcutUpdate(shortcut, remoteShortcut); update != nil {
+			if _, err := s.Store.UpdateShortcut(ctx, update); err != nil {
+				return managed, errors.Wrapf(err, "failed to update shortcut %s", name)
+			}
+		}
+		localShortcutIDs[remoteShortcut.Id] = shortcut.Id
+		shortcutNames = append(shortcutNames, name)
+	}
+
+	collectionNames := []string{}
+	for _, remoteCollection := range remoteCollections {
+		if !isImported(remoteCollection.Visibility, remoteCollection.Name) {
+			continue
+		}
+		name := source.Prefix + remoteCollection.Name
+		shortcutIDs := []int32{}
+		for _, remoteShortcutID := range remoteCollection.ShortcutIds {
+			if shortcutID, ok := localShortcutIDs[remoteShortcutID]; ok {
+				shortcutIDs = append(shortcutIDs, shortcutID)
+			}
+		}
+		collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+			Name: &name,
+		})
+		if err != nil {
+			return managed, errors.Wrapf(err, "failed to get collection %s", name)
+		}
+		if collection == nil {
+			if _, err := s.Store.CreateCollection(ctx, &storepb.Collection{
+				CreatorId:   creatorID,
+				Name:        name,
+				Title:       remoteCollection.Title,
+				Description: remoteCollection.Description,
+				ShortcutIds: shortcutIDs,
+				Visibility:  storepb.Visibility_WORKSPACE,
+			}); err != nil {
+				return managed, errors.Wrapf(err, "failed to create collection %s", name)
+			}
+			managed.collections = appendName(managed.collections, name)
+		} else if !slices.Contains(source.ManagedCollections, name) {
+			slog.Warn("skip the federated collection conflicting with an existing one", slog.String("source", source.Id), slog.String("name", name))
+			continue
+		} else if collection.Title != remoteCollection.Title || collection.Description != remoteCollection.Description || !slices.Equal(collection.ShortcutIds, shortcutIDs) {
+			if _, err := s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+				ID:          collection.Id,
+				Title:       &remoteCollection.Title,
+				Description: &remoteCollection.Description,
+				ShortcutIDs: shortcutIDs,
+			}); err != nil {
+				return managed, errors.Wrapf(err, "failed to update collection %s", name)
+			}
+		}
+		collectionNames = append(collectionNames, name)
+	}
+
+	// Delete the collections first, since they refer to the shortcuts.
+	for _, name := range source.ManagedCollections {
+		if slices.Contains(collectionNames, name) {
+			continue
+		}
+		collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+			Name: &name,
+		})
+		if err != nil {
+			return managed, errors.Wrapf(err, "failed to get collection %s", name)
+		}
+		if collection != nil {
+			if err := s.Store.DeleteCollection(ctx, &store.DeleteCollection{
+				ID: collection.Id,
+			}); err != nil {
+				return managed, errors.Wrapf(err, "failed to delete collection %s", name)
+			}
+		}
+	}
+	for _, name := range source.ManagedShortcuts {
+		if slices.Contains(shortcutNames, name) {
+			continue
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		if err != nil {
+			return managed, errors.Wrapf(err, "failed to get shortcut %s", name)
+		}
+		if shortcut != nil {
+			if err := s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
+				ID: shortcut.Id,
+			}); err != nil {
+				return managed, errors.Wrapf(err, "failed to delete shortcut %s", name)
+			}
+		}
+	}
+	return &managedNames{
+		shortcuts:   shortcutNames,
+		collections: collectionNames,
+	}, nil
+}
+
+// saveSyncResult records the result of the sync to the source, unless the source is removed meanwhile.
+func (s *Service) saveSyncResult(ctx context.Context, sourceID string, syncTime time.Time, managed *managedNames, syncErr error) error {
+	// Reload the setting, so that a concurrent update of the setting isn't overwritten.
+	setting, err := s.Store.GetWorkspaceFederationSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace federation setting")
+	}
+	index := slices.IndexFunc(setting.Sources, func(source *storepb.WorkspaceSetting_FederationSource) bool {
+		return source.Id == sourceID
+	})
+	if index < 0 {
+		return nil
+	}
+	source := setting.Sources[index]
+	source.LastSyncedTs = syncTime.Unix()
+	source.LastError = ""
+	if syncErr != nil {
+		source.LastError = syncErr.Error()
+	}
+	source.ManagedShortcuts = managed.shortcuts
+	source.ManagedCollections = managed.collections
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FEDERATION,
+		Value: &storepb.WorkspaceSetting_Federation{
+			Federation: setting,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to update workspace federation setting")
+	}
+	return nil
+}
+
+// isDue returns whether the interval of the source has passed since its last sync.
+func isDue(source *storepb.WorkspaceSetting_FederationSource, now time.Time) bool {
+	intervalMinutes := source.IntervalMinutes
+	if intervalMinutes <= 0 {
+		intervalMinutes = DefaultIntervalMinutes
+	}
+	return now.Unix() >= source.LastSyncedTs+int64(intervalMinutes)*60
+}
+
+// isImported returns whether the remote shortcut or collection is imported, which must be public
+// and not in a personal namespace.
+func isImported(visibility v1pb.Visibility, name string) bool {
+	return visibility == v1pb.Visibility_PUBLIC && name != "" && !strings.HasPrefix(name, personalNamespacePrefix)
+}
+
+func appendName(names []string, name string) []string {
+	if slices.Contains(names, name) {
+		return names
+	}
+	return append(names, name)
+}
+
+func convertOpenGraphMetadata(ogMetadata *v1pb.Shortcut_OpenGraphMetadata) *storepb.OpenGraphMetadata {
+	return &storepb.OpenGraphMetadata{
+		Title:       ogMetadata.GetTitle(),
+		Description: ogMetadata.GetDescription(),
+		Image:       ogMetadata.GetImage(),
+		Favicon:     ogMetadata.GetFavicon(),
+	}
+}
+
+// getShortcutUpdate returns the update of the shortcut to match the remote shortcut, or nil when it matches already.
+func getShortcutUpdate(shortcut *storepb.Shortcut, remoteShortcut *v1pb.Shortcut) *store.UpdateShortcut {
+	update := &store.UpdateShortcut{
+		ID: shortcut.Id,
+	}
+	changed := false
+	if shortcut.Link != remoteShortcut.Link {
+		update.Link, changed = &remoteShortcut.Link, true
+	}
+	if shortcut.Title != remoteShortcut.Title {
+		update.Title, changed = &remoteShortcut.Title, true
+	}
+	if shortcut.Description != remoteShortcut.Description {
+		update.Description, changed = &remoteShortcut.Description, true
+	}
+	if !slices.Equal(shortcut.Tags, remoteShortcut.Tags) && (len(shortcut.Tags) > 0 || len(remoteShortcut.Tags) > 0) {
+		tag := strings.Join(remoteShortcut.Tags, " ")
+		update.Tag, changed = &tag, true
+	}
+	ogMetadata := convertOpenGraphMetadata(remoteShortcut.OgMetadata)
+	if shortcut.OgMetadata.GetTitle() != ogMetadata.Title || shortcut.OgMetadata.GetDescription() != ogMetadata.Description || shortcut.OgMetadata.GetImage() != ogMetadata.Image {
+		update.OpenGraphMetadata, changed = ogMetadata, true
+	}
+	if !changed {
+		return nil
+	}
+	return update
+}
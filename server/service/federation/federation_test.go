@@ -0,0 +1,113 @@
+package federation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/warthurton/slash/proto/gen/api/v1"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+func TestClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path + "?" + r.URL.Query().Get("pageToken") {
+		case "/api/v1/shortcuts?":
+			_, _ = w.Write([]byte(`{"shortcuts": [{"id": 1, "name": "wiki", "link": "https://wiki.example.com", "visibility": "PUBLIC", "unknownField": 1}], "nextPageToken": "2"}`))
+		case "/api/v1/shortcuts?2":
+			_, _ = w.Write([]byte(`{"shortcuts": [{"id": 2, "name": "docs", "link": "https://docs.example.com", "visibility": "WORKSPACE"}]}`))
+		case "/api/v1/collections?":
+			_, _ = w.Write([]byte(`{"collections": [{"id": 1, "name": "onboarding", "shortcutIds": [1, 2], "visibility": "PUBLIC"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient(server.URL+"/", "token")
+	shortcuts, err := client.ListShortcuts(ctx)
+	require.NoError(t, err)
+	require.Len(t, shortcuts, 2)
+	require.Equal(t, "wiki", shortcuts[0].Name)
+	require.Equal(t, v1pb.Visibility_PUBLIC, shortcuts[0].Visibility)
+	require.Equal(t, "docs", shortcuts[1].Name)
+	collections, err := client.ListCollections(ctx)
+	require.NoError(t, err)
+	require.Len(t, collections, 1)
+	require.Equal(t, []int32{1, 2}, collections[0].ShortcutIds)
+
+	_, err = NewClient(server.URL, "invalid").ListShortcuts(ctx)
+	require.ErrorContains(t, err, "401 Unauthorized")
+}
+
+func TestValidateSource(t *testing.T) {
+	source := &storepb.WorkspaceSetting_FederationSource{
+		Id:          "eng",
+		Url:         "https://slash.eng.example.com",
+		AccessToken: "token",
+		Prefix:      "eng/",
+	}
+	require.NoError(t, ValidateSource(source))
+
+	for _, invalid := range []*storepb.WorkspaceSetting_FederationSource{
+		{Url: source.Url, AccessToken: source.AccessToken, Prefix: source.Prefix},
+		{Id: source.Id, Url: "slash.eng.example.com", AccessToken: source.AccessToken, Prefix: source.Prefix},
+		{Id: source.Id, Url: "ftp://slash.eng.example.com", AccessToken: source.AccessToken, Prefix: source.Prefix},
+		{Id: source.Id, Url: source.Url, Prefix: source.Prefix},
+		{Id: source.Id, Url: source.Url, AccessToken: source.AccessToken},
+		{Id: source.Id, Url: source.Url, AccessToken: source.AccessToken, Prefix: "~eng/"},
+		{Id: source.Id, Url: source.Url, AccessToken: source.AccessToken, Prefix: source.Prefix, IntervalMinutes: -1},
+	} {
+		require.Error(t, ValidateSource(invalid))
+	}
+}
+
+func TestIsDue(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	require.True(t, isDue(&storepb.WorkspaceSetting_FederationSource{}, now))
+	require.False(t, isDue(&storepb.WorkspaceSetting_FederationSource{LastSyncedTs: now.Add(-30 * time.Minute).Unix()}, now))
+	require.True(t, isDue(&storepb.WorkspaceSetting_FederationSource{LastSyncedTs: now.Add(-time.Hour).Unix()}, now))
+	require.True(t, isDue(&storepb.WorkspaceSetting_FederationSource{LastSyncedTs: now.Add(-30 * time.Minute).Unix(), IntervalMinutes: 15}, now))
+}
+
+func TestIsImported(t *testing.T) {
+	require.True(t, isImported(v1pb.Visibility_PUBLIC, "wiki"))
+	require.False(t, isImported(v1pb.Visibility_WORKSPACE, "wiki"))
+	require.False(t, isImported(v1pb.Visibility_PUBLIC, "~jane/wiki"))
+}
+
+func TestGetShortcutUpdate(t *testing.T) {
+	shortcut := &storepb.Shortcut{
+		Id:         1,
+		Name:       "eng/wiki",
+		Link:       "https://wiki.example.com",
+		Title:      "Wiki",
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	}
+	require.Nil(t, getShortcutUpdate(shortcut, &v1pb.Shortcut{
+		Name:  "wiki",
+		Link:  "https://wiki.example.com",
+		Title: "Wiki",
+	}))
+
+	update := getShortcutUpdate(shortcut, &v1pb.Shortcut{
+		Name:  "wiki",
+		Link:  "https://wiki.example.com/home",
+		Title: "Wiki",
+		Tags:  []string{"eng"},
+	})
+	require.NotNil(t, update)
+	require.Equal(t, "https://wiki.example.com/home", *update.Link)
+	require.Equal(t, "eng", *update.Tag)
+	require.Nil(t, update.Title)
+}
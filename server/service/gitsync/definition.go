@@ -0,0 +1,128 @@
+package gitsync
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+// Definitions is the content of the file of shortcut definitions, e.g.
+//
+//	shortcuts:
+//	  - name: docs
+//	    link: https://docs.example.com
+//	    tags: [eng]
+//	    visibility: PUBLIC
+type Definitions struct {
+	Shortcuts []*Definition `yaml:"shortcuts" json:"shortcuts"`
+}
+
+// Definition is the definition of a shortcut.
+type Definition struct {
+	Name        string   `yaml:"name" json:"name"`
+	Link        string   `yaml:"link" json:"link"`
+	Title       string   `yaml:"title,omitempty" json:"title,omitempty"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Tags        []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	// Visibility is either PUBLIC or WORKSPACE. Defaults to WORKSPACE.
+	Visibility string `yaml:"visibility,omitempty" json:"visibility,omitempty"`
+}
+
+// ParseDefinitions parses the YAML or JSON content of the file of shortcut definitions.
+func ParseDefinitions(content []byte) (*Definitions, error) {
+	definitions := &Definitions{}
+	// JSON is a subset of YAML, so both are parsed by the YAML decoder.
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(definitions); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "failed to parse shortcut definitions")
+	}
+
+	names := map[string]bool{}
+	for _, definition := range definitions.Shortcuts {
+		if definition == nil || definition.Name == "" || definition.Link == "" {
+			return nil, errors.New("shortcut name and link are required")
+		}
+		if names[definition.Name] {
+			return nil, errors.Errorf("duplicated shortcut name %s", definition.Name)
+		}
+		names[definition.Name] = true
+		if _, err := definition.visibility(); err != nil {
+			return nil, err
+		}
+	}
+	return definitions, nil
+}
+
+// MarshalDefinitions marshals the definitions as JSON when the path ends with .json, otherwise as YAML.
+func MarshalDefinitions(path string, definitions *Definitions) ([]byte, error) {
+	if strings.HasSuffix(strings.ToLower(path), ".json") {
+		content, err := json.MarshalIndent(definitions, "", "  ")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal shortcut definitions")
+		}
+		return append(content, '\n'), nil
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(definitions); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal shortcut definitions")
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal shortcut definitions")
+	}
+	return buf.Bytes(), nil
+}
+
+// NewDefinition converts the shortcut to its definition.
+func NewDefinition(shortcut *storepb.Shortcut) *Definition {
+	return &Definition{
+		Name:        shortcut.Name,
+		Link:        shortcut.Link,
+		Title:       shortcut.Title,
+		Description: shortcut.Description,
+		Tags:        shortcut.Tags,
+		Visibility:  shortcut.Visibility.String(),
+	}
+}
+
+// Replace replaces the definition of the shortcut named name with the definition.
+// The shortcut is removed when the definition is nil, and appended when it isn't defined yet.
+func (d *Definitions) Replace(name string, definition *Definition) {
+	shortcuts := []*Definition{}
+	replaced := false
+	for _, shortcut := range d.Shortcuts {
+		if shortcut.Name != name {
+			shortcuts = append(shortcuts, shortcut)
+			continue
+		}
+		replaced = true
+		if definition != nil {
+			shortcuts = append(shortcuts, definition)
+		}
+	}
+	if !replaced && definition != nil {
+		shortcuts = append(shortcuts, definition)
+	}
+	d.Shortcuts = shortcuts
+}
+
+func (d *Definition) visibility() (storepb.Visibility, error) {
+	if d.Visibility == "" {
+		return storepb.Visibility_WORKSPACE, nil
+	}
+	value, ok := storepb.Visibility_value[strings.ToUpper(d.Visibility)]
+	// The definitions have no team, so the team visibility isn't supported.
+	if !ok || value == int32(storepb.Visibility_VISIBILITY_UNSPECIFIED) || value == int32(storepb.Visibility_TEAM) {
+		return storepb.Visibility_VISIBILITY_UNSPECIFIED, errors.Errorf("invalid visibility %s of shortcut %s", d.Visibility, d.Name)
+	}
+	return storepb.Visibility(value), nil
+}
@@ -0,0 +1,283 @@
+// Package gitsync syncs the shortcuts with a file of shortcut definitions in a GitHub repository.
+//
+// The file is applied declaratively: the shortcuts defined in the file are created or
+// updated, and the shortcuts removed from the file are deleted. The manual changes of the
+// synced shortcuts can be proposed back to the repository as pull requests.
+package gitsync
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/warthurton/slash/plugin/github"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+// DefaultBranch is the branch of the file when none is configured.
+const DefaultBranch = "main"
+
+var branchNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+type Service struct {
+	Store *store.Store
+
+	// mutex serializes the syncs, so that the file isn't applied twice concurrently.
+	mutex sync.Mutex
+}
+
+// NewService creates a new git sync service.
+func NewService(store *store.Store) *Service {
+	return &Service{
+		Store: store,
+	}
+}
+
+// Sync applies the file of shortcut definitions when it changed since the last sync.
+// It does nothing when the git sync is disabled.
+func (s *Service) Sync(ctx context.Context) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	setting, err := s.Store.GetWorkspaceGitSyncSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace git sync setting")
+	}
+	if !setting.Enabled {
+		return nil
+	}
+
+	file, err := newClient(setting).GetFile(ctx, GetBranch(setting), setting.Path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get file %s", setting.Path)
+	}
+	if file.SHA == setting.LastSyncedSha {
+		return nil
+	}
+	definitions, err := ParseDefinitions(file.Content)
+	if err != nil {
+		return err
+	}
+	managedShortcuts, err := s.apply(ctx, definitions, setting.ManagedShortcuts)
+	if err != nil {
+		return err
+	}
+
+	// Reload the setting, so that a concurrent update of the setting isn't overwritten.
+	setting, err = s.Store.GetWorkspaceGitSyncSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace git sync setting")
+	}
+	setting.LastSyncedSha = file.SHA
+	setting.ManagedShortcuts = managedShortcuts
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GIT_SYNC,
+		Value: &storepb.WorkspaceSetting_GitSync{
+			GitSync: setting,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to update workspace git sync setting")
+	}
+	slog.Info("synced shortcuts from git", slog.String("repository", setting.Repository), slog.String("sha", file.SHA), slog.Int("count", len(managedShortcuts)))
+	return nil
+}
+
+// apply creates or updates the defined shortcuts and deletes the previously managed
+// shortcuts that are no longer defined. It returns the names of the managed shortcuts.
+func (s *Service) apply(ctx context.Context, definitions *Definitions, previousManagedShortcuts []string) ([]string, error) {
+	adminRole := store.RoleAdmin
+	admins, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Role: &adminRole,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list admins")
+	}
+	if len(admins) == 0 {
+		return nil, errors.New("no admin to own the synced shortcuts")
+	}
+	creatorID := admins[0].ID
+
+	managedShortcuts := []string{}
+	for _, definition := range definitions.Shortcuts {
+		visibility, err := definition.visibility()
+		if err != nil {
+			return nil, err
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &definition.Name,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get shortcut %s", definition.Name)
+		}
+		if shortcut == nil {
+			if _, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+				CreatorId:   creatorID,
+				Name:        definition.Name,
+				Link:        definition.Link,
+				Title:       definition.Title,
+				Description: definition.Description,
+				Tags:        definition.Tags,
+				Visibility:  visibility,
+				OgMetadata:  &storepb.OpenGraphMetadata{},
+			}); err != nil {
+				return nil, errors.Wrapf(err, "failed to create shortcut %s", definition.Name)
+			}
+		} else if update := getShortcutUpdate(shortcut, definition, visibility); update != nil {
+			if _, err := s.Store.UpdateShortcut(ctx, update); err != nil {
+				return nil, errors.Wrapf(err, "failed to update shortcut %s", definition.Name)
+			}
+		}
+		managedShortcuts = append(managedShortcuts, definition.Name)
+	}
+
+	for _, name := range previousManagedShortcuts {
+		if slices.Contains(managedShortcuts, name) {
+			continue
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get shortcut %s", name)
+		}
+		if shortcut == nil {
+			continue
+		}
+		if err := s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
+			ID: shortcut.Id,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete shortcut %s", name)
+		}
+	}
+	return managedShortcuts, nil
+}
+
+// IsManaged returns whether the shortcut named name is managed by the file and the
+// manual changes of it are proposed back to the repository.
+func (s *Service) IsManaged(ctx context.Context, name string) (bool, error) {
+	setting, err := s.Store.GetWorkspaceGitSyncSetting(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get workspace git sync setting")
+	}
+	return setting.Enabled && setting.WriteBack && slices.Contains(setting.ManagedShortcuts, name), nil
+}
+
+// ProposeChange opens a pull request that replaces the definition of the managed shortcut
+// named name with the shortcut, or removes it when the shortcut is nil.
+// It returns the url of the pull request, or an empty string when there is nothing to propose.
+func (s *Service) ProposeChange(ctx context.Context, name string, shortcut *storepb.Shortcut) (string, error) {
+	managed, err := s.IsManaged(ctx, name)
+	if err != nil || !managed {
+		return "", err
+	}
+	setting, err := s.Store.GetWorkspaceGitSyncSetting(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get workspace git sync setting")
+	}
+
+	client := newClient(setting)
+	base := GetBranch(setting)
+	baseSHA, err := client.GetBranchSHA(ctx, base)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get branch %s", base)
+	}
+	file, err := client.GetFile(ctx, baseSHA, setting.Path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get file %s", setting.Path)
+	}
+	definitions, err := ParseDefinitions(file.Content)
+	if err != nil {
+		return "", err
+	}
+	title := fmt.Sprintf("Delete shortcut %s", name)
+	if shortcut != nil {
+		definitions.Replace(name, NewDefinition(shortcut))
+		title = fmt.Sprintf("Update shortcut %s", name)
+	} else {
+		definitions.Replace(name, nil)
+	}
+	content, err := MarshalDefinitions(setting.Path, definitions)
+	if err != nil {
+		return "", err
+	}
+
+	head := fmt.Sprintf("slash/%s-%d", branchNameInvalidChars.ReplaceAllString(name, "-"), time.Now().Unix())
+	if err := client.CreateBranch(ctx, head, baseSHA); err != nil {
+		return "", errors.Wrapf(err, "failed to create branch %s", head)
+	}
+	if err := client.UpdateFile(ctx, head, setting.Path, content, file.SHA, title); err != nil {
+		return "", errors.Wrapf(err, "failed to update file %s", setting.Path)
+	}
+	body := fmt.Sprintf("The shortcut `%s` was changed in Slash. Merge this pull request to keep the change, otherwise it is reverted on the next change of the file.", name)
+	url, err := client.CreatePullRequest(ctx, base, head, title, body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create pull request")
+	}
+	return url, nil
+}
+
+// VerifySignature verifies the X-Hub-Signature-256 header of a GitHub webhook delivery.
+func VerifySignature(secret string, payload []byte, signature string) bool {
+	digest, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok || secret == "" {
+		return false
+	}
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// GetBranch returns the branch of the file.
+func GetBranch(setting *storepb.WorkspaceSetting_GitSyncSetting) string {
+	if setting.Branch == "" {
+		return DefaultBranch
+	}
+	return setting.Branch
+}
+
+func newClient(setting *storepb.WorkspaceSetting_GitSyncSetting) *github.Client {
+	return github.NewClient(setting.AccessToken, setting.Repository)
+}
+
+// getShortcutUpdate returns the update of the shortcut to match the definition, or nil when it matches already.
+func getShortcutUpdate(shortcut *storepb.Shortcut, definition *Definition, visibility storepb.Visibility) *store.UpdateShortcut {
+	update := &store.UpdateShortcut{
+		ID: shortcut.Id,
+	}
+	changed := false
+	if shortcut.Link != definition.Link {
+		update.Link, changed = &definition.Link, true
+	}
+	if shortcut.Title != definition.Title {
+		update.Title, changed = &definition.Title, true
+	}
+	if shortcut.Description != definition.Description {
+		update.Description, changed = &definition.Description, true
+	}
+	if !slices.Equal(shortcut.Tags, definition.Tags) && (len(shortcut.Tags) > 0 || len(definition.Tags) > 0) {
+		tag := strings.Join(definition.Tags, " ")
+		update.Tag, changed = &tag, true
+	}
+	if shortcut.Visibility != visibility {
+		update.Visibility, changed = &visibility, true
+	}
+	if !changed {
+		return nil
+	}
+	return update
+}
@@ -0,0 +1,109 @@
+package gitsync
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+func TestParseDefinitions(t *testing.T) {
+	definitions, err := ParseDefinitions([]byte(`
+shortcuts:
+  - name: docs
+    link: https://docs.example.com
+    tags: [eng, docs]
+    visibility: public
+  - name: wiki
+    link: https://wiki.example.com
+`))
+	require.NoError(t, err)
+	require.Len(t, definitions.Shortcuts, 2)
+	require.Equal(t, []string{"eng", "docs"}, definitions.Shortcuts[0].Tags)
+	visibility, err := definitions.Shortcuts[0].visibility()
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_PUBLIC, visibility)
+	visibility, err = definitions.Shortcuts[1].visibility()
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_WORKSPACE, visibility)
+
+	definitions, err = ParseDefinitions([]byte(`{"shortcuts": [{"name": "docs", "link": "https://docs.example.com"}]}`))
+	require.NoError(t, err)
+	require.Len(t, definitions.Shortcuts, 1)
+
+	definitions, err = ParseDefinitions([]byte{})
+	require.NoError(t, err)
+	require.Empty(t, definitions.Shortcuts)
+
+	_, err = ParseDefinitions([]byte(`shortcuts: [{name: docs}]`))
+	require.Error(t, err)
+	_, err = ParseDefinitions([]byte(`shortcuts: [{name: docs, link: a}, {name: docs, link: b}]`))
+	require.Error(t, err)
+	_, err = ParseDefinitions([]byte(`shortcuts: [{name: docs, link: a, visibility: secret}]`))
+	require.Error(t, err)
+	_, err = ParseDefinitions([]byte(`shortcuts: [{name: docs, link: a, unknown: b}]`))
+	require.Error(t, err)
+}
+
+func TestMarshalDefinitions(t *testing.T) {
+	definitions := &Definitions{
+		Shortcuts: []*Definition{
+			{Name: "docs", Link: "https://docs.example.com"},
+			{Name: "wiki", Link: "https://wiki.example.com"},
+		},
+	}
+	definitions.Replace("docs", NewDefinition(&storepb.Shortcut{
+		Name:       "docs",
+		Link:       "https://docs.example.com/v2",
+		Tags:       []string{"eng"},
+		Visibility: storepb.Visibility_PUBLIC,
+	}))
+	definitions.Replace("wiki", nil)
+	definitions.Replace("blog", &Definition{Name: "blog", Link: "https://blog.example.com"})
+
+	for _, path := range []string{"shortcuts.yaml", "shortcuts.json"} {
+		content, err := MarshalDefinitions(path, definitions)
+		require.NoError(t, err)
+		parsed, err := ParseDefinitions(content)
+		require.NoError(t, err)
+		require.Equal(t, definitions, parsed)
+	}
+	require.Equal(t, "docs", definitions.Shortcuts[0].Name)
+	require.Equal(t, "https://docs.example.com/v2", definitions.Shortcuts[0].Link)
+	require.Equal(t, "blog", definitions.Shortcuts[1].Name)
+}
+
+func TestGetShortcutUpdate(t *testing.T) {
+	shortcut := &storepb.Shortcut{
+		Id:         1,
+		Name:       "docs",
+		Link:       "https://docs.example.com",
+		Visibility: storepb.Visibility_WORKSPACE,
+	}
+	definition := &Definition{Name: "docs", Link: "https://docs.example.com"}
+	require.Nil(t, getShortcutUpdate(shortcut, definition, storepb.Visibility_WORKSPACE))
+
+	definition.Tags = []string{"eng", "docs"}
+	update := getShortcutUpdate(shortcut, definition, storepb.Visibility_PUBLIC)
+	require.NotNil(t, update)
+	require.Nil(t, update.Link)
+	require.Equal(t, "eng docs", *update.Tag)
+	require.Equal(t, storepb.Visibility_PUBLIC, *update.Visibility)
+}
+
+func TestVerifySignature(t *testing.T) {
+	payload := []byte(`{"ref":"refs/heads/main"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	require.True(t, VerifySignature("secret", payload, signature))
+	require.False(t, VerifySignature("other", payload, signature))
+	require.False(t, VerifySignature("", payload, signature))
+	require.False(t, VerifySignature("secret", payload, "sha1=abc"))
+	require.False(t, VerifySignature("secret", []byte(`{}`), signature))
+}
@@ -0,0 +1,154 @@
+// Package secret rotates the workspace secret which signs the access tokens.
+package secret
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+	v1 "github.com/warthurton/slash/server/route/api/v1"
+	"github.com/warthurton/slash/store"
+)
+
+// Policy is what happens to the access tokens signed with the previous secret.
+type Policy string
+
+const (
+	// PolicyRevoke revokes all the access tokens, so every user has to sign in again
+	// and create new personal access tokens.
+	PolicyRevoke Policy = "revoke"
+	// PolicyResign re-signs the personal access tokens with the new secret, keeping their
+	// expiration, and revokes the sign-in sessions. The re-signed tokens are only returned
+	// by the rotation, as the store keeps their hash, while the previous token strings stop working.
+	PolicyResign Policy = "resign"
+)
+
+// RotateResult is the result of a secret rotation.
+type RotateResult struct {
+	ResignedTokenCount int
+	RevokedTokenCount  int
+	ResignedTokens     []*ResignedToken
+}
+
+// ResignedToken is a personal access token re-signed with the new secret.
+type ResignedToken struct {
+	UserID      int32
+	Description string
+	AccessToken string
+}
+
+// Rotate generates a new workspace secret, applies the policy to the access tokens and
+// records the rotation in the activities. A running server keeps signing with the previous
+// secret until it's restarted.
+func Rotate(ctx context.Context, s *store.Store, policy Policy) (*RotateResult, error) {
+	if policy != PolicyRevoke && policy != PolicyResign {
+		return nil, errors.Errorf("unsupported policy %q", policy)
+	}
+	generalSetting, err := s.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace general setting")
+	}
+	newSecret := uuid.New().String()
+
+	userSettings, err := s.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list access tokens")
+	}
+	result := &RotateResult{}
+	now := time.Now()
+	for _, userSetting := range userSettings {
+		user, err := s.GetUser(ctx, &store.FindUser{
+			ID: &userSetting.UserId,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		if err := s.UpdateUserAccessTokens(ctx, userSetting.UserId, func(userAccessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) []*storepb.UserSetting_AccessTokensSetting_AccessToken {
+			accessTokens := []*storepb.UserSetting_AccessTokensSetting_AccessToken{}
+			for _, accessToken := range userAccessTokens {
+				if policy == PolicyResign && user != nil && accessToken.Description != v1.SignInAccessTokenDescription {
+					resigned, resignedAccessToken, err := resignAccessToken(user, accessToken, []byte(newSecret), now)
+					if err == nil {
+						accessTokens = append(accessTokens, resignedAccessToken)
+						result.ResignedTokens = append(result.ResignedTokens, &ResignedToken{
+							UserID:      user.ID,
+							Description: accessToken.Description,
+							AccessToken: resigned,
+						})
+						result.ResignedTokenCount++
+						continue
+					}
+					slog.Warn("revoke access token failed to be re-signed", slog.Int("userID", int(userSetting.UserId)), slog.Any("error", err))
+				}
+				result.RevokedTokenCount++
+			}
+			return accessTokens
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to update access tokens")
+		}
+	}
+
+	generalSetting.SecretSession = newSecret
+	if _, err := s.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL,
+		Value: &storepb.WorkspaceSetting_General{
+			General: generalSetting,
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to update workspace secret")
+	}
+
+	payload, err := protojson.Marshal(&storepb.ActivityWorkspaceSecretRotatePayload{
+		Policy:             string(policy),
+		ResignedTokenCount: int32(result.ResignedTokenCount),
+		RevokedTokenCount:  int32(result.RevokedTokenCount),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal activity payload")
+	}
+	if _, err := s.CreateActivity(ctx, &store.Activity{
+		CreatorID: common.BotID,
+		Type:      store.ActivityWorkspaceSecretRotate,
+		Level:     store.ActivityInfo,
+		Payload:   string(payload),
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to create activity")
+	}
+	return result, nil
+}
+
+// resignAccessToken signs a new access token of the user with the new secret, keeping the expiration, description
+// and scopes of the stored access token, and returns it with the access token to store in its place.
+// Expired tokens are revoked instead.
+func resignAccessToken(user *store.User, accessToken *storepb.UserSetting_AccessTokensSetting_AccessToken, newSecret []byte, now time.Time) (string, *storepb.UserSetting_AccessTokensSetting_AccessToken, error) {
+	expiresAt := time.Time{}
+	if accessToken.ExpiresTs > 0 {
+		expiresAt = time.Unix(accessToken.ExpiresTs, 0)
+		if !now.Before(expiresAt) {
+			return "", nil, errors.New("access token has expired")
+		}
+	}
+	resigned, err := v1.GenerateAccessToken(user.Email, user.ID, expiresAt, newSecret)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to generate access token")
+	}
+	resignedAccessToken := &storepb.UserSetting_AccessTokensSetting_AccessToken{
+		Description: accessToken.Description,
+		LastUsedTs:  accessToken.LastUsedTs,
+		Scopes:      accessToken.Scopes,
+		IssuedTs:    now.Unix(),
+		ExpiresTs:   accessToken.ExpiresTs,
+	}
+	if err := store.SetAccessTokenHash(resignedAccessToken, resigned); err != nil {
+		return "", nil, err
+	}
+	return resigned, resignedAccessToken, nil
+}
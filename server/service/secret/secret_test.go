@@ -0,0 +1,53 @@
+package secret
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	v1 "github.com/warthurton/slash/server/route/api/v1"
+	"github.com/warthurton/slash/store"
+)
+
+func TestResignAccessToken(t *testing.T) {
+	newSecret := []byte("new")
+	now := time.Now()
+	expiresAt := now.Add(time.Hour).Truncate(time.Second)
+	user := &store.User{
+		ID:    1,
+		Email: "slash@example.com",
+	}
+	accessToken := &storepb.UserSetting_AccessTokensSetting_AccessToken{
+		Description: "cli",
+		LastUsedTs:  100,
+		Scopes:      []string{v1.AccessTokenScopeShortcutsRead},
+		ExpiresTs:   expiresAt.Unix(),
+	}
+
+	resigned, resignedAccessToken, err := resignAccessToken(user, accessToken, newSecret, now)
+	require.NoError(t, err)
+	claims := &v1.ClaimsMessage{}
+	_, err = jwt.ParseWithClaims(resigned, claims, func(*jwt.Token) (any, error) {
+		return newSecret, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1", claims.Subject)
+	require.Equal(t, "slash@example.com", claims.Name)
+	require.Equal(t, jwt.ClaimStrings{v1.AccessTokenAudienceName}, claims.Audience)
+	require.True(t, claims.ExpiresAt.Time.Equal(expiresAt))
+	// Only the hash of the re-signed token is stored, with the previous description, scopes and expiration.
+	require.Empty(t, resignedAccessToken.AccessToken)
+	require.True(t, store.MatchAccessToken(resignedAccessToken, resigned))
+	require.Equal(t, "cli", resignedAccessToken.Description)
+	require.Equal(t, int64(100), resignedAccessToken.LastUsedTs)
+	require.Equal(t, accessToken.Scopes, resignedAccessToken.Scopes)
+	require.Equal(t, expiresAt.Unix(), resignedAccessToken.ExpiresTs)
+
+	// Expired tokens are not re-signed.
+	accessToken.ExpiresTs = now.Add(-time.Hour).Unix()
+	_, _, err = resignAccessToken(user, accessToken, newSecret, now)
+	require.Error(t, err)
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/warthurton/slash/plugin/webhook"
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/server/common"
+)
+
+const (
+	// emailCheckInterval is the interval of the checks of the SMTP server by the status page, which connect to it.
+	emailCheckInterval = 5 * time.Minute
+	// webhookBacklogThreshold is the number of the webhook requests in flight from which the webhooks are degraded.
+	webhookBacklogThreshold = 50
+)
+
+// Status is the public status of the server, for the external uptime monitors.
+type Status struct {
+	// Status is "ok", "degraded" when a feature is degraded, or "down" when the database is unreachable.
+	Status        string          `json:"status"`
+	Version       string          `json:"version"`
+	StartedTime   time.Time       `json:"startedTime"`
+	UptimeSeconds int64           `json:"uptimeSeconds"`
+	Database      string          `json:"database"`
+	Degraded      *DegradedStatus `json:"degraded"`
+}
+
+// DegradedStatus flags the degraded features.
+type DegradedStatus struct {
+	// Email is set when the SMTP server of the workspace failed its last check.
+	Email bool `json:"email"`
+	// WebhookBacklog is set when the webhook requests in flight pile up.
+	WebhookBacklog bool `json:"webhookBacklog"`
+}
+
+// registerStatusRoutes registers the public status page, which responds 503 when the database is unreachable and 200 otherwise.
+// It's a JSON body, or an HTML page for the browsers or with the `format=html` query.
+func (s *Server) registerStatusRoutes(e *echo.Echo) {
+	e.GET("/status", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		status := s.getStatus(ctx)
+		code := http.StatusOK
+		if status.Status == "down" {
+			code = http.StatusServiceUnavailable
+		}
+		format := c.QueryParam("format")
+		if format == "html" || (format == "" && strings.Contains(c.Request().Header.Get(echo.HeaderAccept), echo.MIMETextHTML)) {
+			var buffer bytes.Buffer
+			if err := statusTemplate.Execute(&buffer, status); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to render status page").SetInternal(err)
+			}
+			return c.HTMLBlob(code, buffer.Bytes())
+		}
+		return c.JSON(code, status)
+	})
+}
+
+func (s *Server) getStatus(ctx context.Context) *Status {
+	status := &Status{
+		Status:        "ok",
+		Version:       s.Profile.Version,
+		StartedTime:   s.startedTime.UTC(),
+		UptimeSeconds: int64(time.Since(s.startedTime).Seconds()),
+		Database:      "ok",
+		Degraded: &DegradedStatus{
+			WebhookBacklog: webhook.Pending() >= webhookBacklogThreshold,
+		},
+	}
+	// The errors of the checks aren't shown, as they may contain SQL fragments or hosts.
+	if check := s.checkDatabase(ctx); !check.OK {
+		status.Status = "down"
+		status.Database = "down"
+		return status
+	}
+	mailSetting, err := s.Store.GetWorkspaceMailSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get workspace mail setting", slog.Any("error", err))
+	} else {
+		status.Degraded.Email = s.emailCheck.isDown(mailSetting)
+	}
+	if status.Degraded.Email || status.Degraded.WebhookBacklog {
+		status.Status = "degraded"
+	}
+	return status
+}
+
+// emailCheck is the cached check of the SMTP server of the mail setting of the workspace.
+type emailCheck struct {
+	mutex       sync.Mutex
+	mailSetting *storepb.WorkspaceSetting_MailSetting
+	checkedTime time.Time
+	checking    bool
+	err         error
+}
+
+// isDown reports whether the last check of the SMTP server of the mail setting failed. The check is run again in the
+// background once it's stale or the mail setting changed, so that the status page doesn't wait for the SMTP server.
+func (c *emailCheck) isDown(mailSetting *storepb.WorkspaceSetting_MailSetting) bool {
+	if mailSetting.SmtpHost == "" {
+		return false
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !proto.Equal(c.mailSetting, mailSetting) {
+		c.mailSetting = mailSetting
+		c.checkedTime = time.Time{}
+		c.err = nil
+	}
+	if !c.checking && time.Since(c.checkedTime) >= emailCheckInterval {
+		c.checking = true
+		go c.check(mailSetting)
+	}
+	return c.err != nil
+}
+
+func (c *emailCheck) check(mailSetting *storepb.WorkspaceSetting_MailSetting) {
+	err := common.NewSMTPClient(mailSetting).Verify()
+	if err != nil {
+		slog.Warn("failed to check SMTP server", slog.String("host", mailSetting.SmtpHost), slog.Any("error", err))
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.checking = false
+	// The result of the previous mail setting is stale.
+	if !proto.Equal(c.mailSetting, mailSetting) {
+		return
+	}
+	c.checkedTime = time.Now()
+	c.err = err
+}
+
+// statusTemplate is the minimal HTML page of the status, without the assets of the frontend.
+var statusTemplate = template.Must(template.New("status").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8" />
+<meta name="viewport" content="width=device-width, initial-scale=1.0" />
+<meta http-equiv="refresh" content="60" />
+<title>Slash status</title>
+<style>
+body { font-family: system-ui, sans-serif; max-width: 32rem; margin: 4rem auto; padding: 0 1rem; color: #1f2937; }
+.ok { color: #16a34a; } .degraded { color: #d97706; } .down { color: #dc2626; }
+td { padding: 0.25rem 1rem 0.25rem 0; }
+</style>
+</head>
+<body>
+<h1 class="{{.Status}}">{{if eq .Status "ok"}}All systems operational{{else if eq .Status "degraded"}}Degraded{{else}}Down{{end}}</h1>
+<table>
+<tr><td>Database</td><td class="{{.Database}}">{{.Database}}</td></tr>
+{{with .Degraded}}
+<tr><td>Email</td>{{if .Email}}<td class="degraded">degraded</td>{{else}}<td class="ok">ok</td>{{end}}</tr>
+<tr><td>Webhooks</td>{{if .WebhookBacklog}}<td class="degraded">backlogged</td>{{else}}<td class="ok">ok</td>{{end}}</tr>
+{{end}}
+<tr><td>Version</td><td>{{.Version}}</td></tr>
+<tr><td>Up since</td><td>{{.StartedTime.Format "2006-01-02 15:04:05 MST"}}</td></tr>
+</table>
+</body>
+</html>
+`))
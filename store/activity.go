@@ -2,6 +2,9 @@ package store
 
 import (
 	"context"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 type ActivityType string
@@ -11,6 +14,20 @@ const (
 	ActivityShortcutCreate ActivityType = "shortcut.create"
 	// ActivityShortcutView is the activity type of shortcut view.
 	ActivityShortcutView ActivityType = "shortcut.view"
+	// ActivityShortcutAnomaly is the activity type of shortcut traffic anomaly.
+	ActivityShortcutAnomaly ActivityType = "shortcut.anomaly"
+	// ActivityShortcutClickGoalReached is the activity type of shortcut click goal reached.
+	ActivityShortcutClickGoalReached ActivityType = "shortcut.click_goal_reached"
+	// ActivityShortcutLinkBroken is the activity type of a shortcut whose link became broken.
+	ActivityShortcutLinkBroken ActivityType = "shortcut.link_broken"
+	// ActivityWorkspaceSecretRotate is the activity type of workspace secret rotation.
+	ActivityWorkspaceSecretRotate ActivityType = "workspace.secret_rotate"
+	// ActivityUserImpersonate is the activity type of an admin impersonating a user.
+	ActivityUserImpersonate ActivityType = "user.impersonate"
+	// ActivityUserBreakGlass is the activity type of a sign in with the break-glass admin account.
+	ActivityUserBreakGlass ActivityType = "user.break_glass"
+	// ActivityArchive is the activity type of the archival of cold activities.
+	ActivityArchive ActivityType = "activity.archive"
 )
 
 func (t ActivityType) String() string {
@@ -19,6 +36,20 @@ func (t ActivityType) String() string {
 		return "shortcut.create"
 	case ActivityShortcutView:
 		return "shortcut.view"
+	case ActivityShortcutAnomaly:
+		return "shortcut.anomaly"
+	case ActivityShortcutClickGoalReached:
+		return "shortcut.click_goal_reached"
+	case ActivityShortcutLinkBroken:
+		return "shortcut.link_broken"
+	case ActivityWorkspaceSecretRotate:
+		return "workspace.secret_rotate"
+	case ActivityUserImpersonate:
+		return "user.impersonate"
+	case ActivityUserBreakGlass:
+		return "user.break_glass"
+	case ActivityArchive:
+		return "activity.archive"
 	}
 	return ""
 }
@@ -60,6 +91,65 @@ type FindActivity struct {
 	Level             ActivityLevel
 	PayloadShortcutID *int32
 	CreatedTsAfter    *int64
+	CreatedTsBefore   *int64
+	// The activities are ordered by id, i.e. the oldest first.
+	Limit *int
+}
+
+type DeleteActivities struct {
+	Type            ActivityType
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+}
+
+// ShortcutViewCount is the number of views of a shortcut.
+type ShortcutViewCount struct {
+	ShortcutID int32
+	Count      int32
+}
+
+type FindShortcutViewCount struct {
+	ShortcutID      *int32
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+}
+
+// ShortcutViewField is a field of the shortcut view payload to group the views by.
+type ShortcutViewField string
+
+const (
+	ShortcutViewFieldReferer   ShortcutViewField = "referer"
+	ShortcutViewFieldUserAgent ShortcutViewField = "userAgent"
+	ShortcutViewFieldCountry   ShortcutViewField = "country"
+	ShortcutViewFieldUserID    ShortcutViewField = "userId"
+	ShortcutViewFieldVariant   ShortcutViewField = "variant"
+)
+
+// ShortcutViewGroup is the number of views of a shortcut with the same value of a payload field.
+type ShortcutViewGroup struct {
+	Value string
+	Count int32
+}
+
+type FindShortcutViewGroup struct {
+	ShortcutID     int32
+	Field          ShortcutViewField
+	CreatedTsAfter *int64
+}
+
+// ShortcutViewBucket is the number of views of a shortcut in a time bucket.
+type ShortcutViewBucket struct {
+	StartTs int64
+	Count   int32
+}
+
+type FindShortcutViewBucket struct {
+	ShortcutID     int32
+	CreatedTsAfter *int64
+	// BucketSize is the size of the buckets in seconds.
+	BucketSize int64
+	// BucketOffset aligns the bucket starts, e.g. to mondays for weekly buckets.
+	BucketOffset int64
 }
 
 func (s *Store) CreateActivity(ctx context.Context, create *Activity) (*Activity, error) {
@@ -70,6 +160,17 @@ func (s *Store) ListActivities(ctx context.Context, find *FindActivity) ([]*Acti
 	return s.driver.ListActivities(ctx, find)
 }
 
+// DeleteActivities deletes the activities of the type in the time range, e.g. once they're archived.
+func (s *Store) DeleteActivities(ctx context.Context, delete *DeleteActivities) error {
+	return s.driver.DeleteActivities(ctx, delete)
+}
+
+// EnsureActivityPartition creates the partition of the activity table for the month of the time,
+// for the drivers partitioning the table.
+func (s *Store) EnsureActivityPartition(ctx context.Context, month time.Time) error {
+	return s.driver.EnsureActivityPartition(ctx, month)
+}
+
 func (s *Store) GetActivity(ctx context.Context, find *FindActivity) (*Activity, error) {
 	list, err := s.ListActivities(ctx, find)
 	if err != nil {
@@ -83,3 +184,26 @@ func (s *Store) GetActivity(ctx context.Context, find *FindActivity) (*Activity,
 	activity := list[0]
 	return activity, nil
 }
+
+// ListShortcutViewCounts aggregates the shortcut view activities by shortcut.
+func (s *Store) ListShortcutViewCounts(ctx context.Context, find *FindShortcutViewCount) ([]*ShortcutViewCount, error) {
+	return s.driver.ListShortcutViewCounts(ctx, find)
+}
+
+// ListShortcutViewGroups aggregates the views of a shortcut by a payload field, ordered by the count descending.
+func (s *Store) ListShortcutViewGroups(ctx context.Context, find *FindShortcutViewGroup) ([]*ShortcutViewGroup, error) {
+	switch find.Field {
+	case ShortcutViewFieldReferer, ShortcutViewFieldUserAgent, ShortcutViewFieldCountry, ShortcutViewFieldUserID, ShortcutViewFieldVariant:
+	default:
+		return nil, errors.Errorf("unsupported shortcut view field %q", find.Field)
+	}
+	return s.driver.ListShortcutViewGroups(ctx, find)
+}
+
+// ListShortcutViewBuckets aggregates the views of a shortcut by time buckets, ordered by the start time.
+func (s *Store) ListShortcutViewBuckets(ctx context.Context, find *FindShortcutViewBucket) ([]*ShortcutViewBucket, error) {
+	if find.BucketSize <= 0 {
+		return nil, errors.Errorf("invalid bucket size %d", find.BucketSize)
+	}
+	return s.driver.ListShortcutViewBuckets(ctx, find)
+}
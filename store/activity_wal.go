@@ -0,0 +1,162 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// activityWALFileName is the write-ahead log of the activities in the data directory.
+const activityWALFileName = "activity.wal"
+
+// activityWALEntry is a line of the write-ahead log.
+type activityWALEntry struct {
+	CreatorID int32         `json:"creatorId"`
+	CreatedTs int64         `json:"createdTs"`
+	Type      ActivityType  `json:"type"`
+	Level     ActivityLevel `json:"level"`
+	Payload   string        `json:"payload"`
+}
+
+// QueueActivity creates the activity, or appends it to the write-ahead log of the data directory when the database
+// can't be written, e.g. restarting or already closed on shutdown, for ReplayActivityWAL to create it later.
+// It returns whether the activity was queued.
+func (s *Store) QueueActivity(ctx context.Context, create *Activity) (bool, error) {
+	createdTs := create.CreatedTs
+	if createdTs == 0 {
+		createdTs = time.Now().Unix()
+	}
+	if _, err := s.CreateActivity(ctx, create); err == nil {
+		return false, nil
+	} else if s.profile.Data == "" {
+		return false, err
+	}
+	entry := &activityWALEntry{
+		CreatorID: create.CreatorID,
+		CreatedTs: createdTs,
+		Type:      create.Type,
+		Level:     create.Level,
+		Payload:   create.Payload,
+	}
+	if err := s.appendActivityWAL([]*activityWALEntry{entry}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReplayActivityWAL creates the activities of the write-ahead log in the order they were queued, with their time,
+// and returns their number. It stops at the first failure, keeping the activities left for the next replay.
+// An activity may be created twice if the server crashes during the replay.
+func (s *Store) ReplayActivityWAL(ctx context.Context) (int, error) {
+	if s.profile.Data == "" {
+		return 0, nil
+	}
+	s.activityWALMutex.Lock()
+	defer s.activityWALMutex.Unlock()
+
+	walPath := filepath.Join(s.profile.Data, activityWALFileName)
+	walBytes, err := os.ReadFile(walPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "failed to read activity wal")
+	}
+	entries := []*activityWALEntry{}
+	scanner := bufio.NewScanner(bytes.NewReader(walBytes))
+	scanner.Buffer(nil, len(walBytes)+1)
+	for scanner.Scan() {
+		entry := &activityWALEntry{}
+		// The last line is partial if the server crashed while appending it.
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			slog.Warn("skipped invalid activity wal entry", slog.Any("error", err))
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	for i, entry := range entries {
+		if _, err := s.CreateActivity(ctx, &Activity{
+			CreatorID: entry.CreatorID,
+			CreatedTs: entry.CreatedTs,
+			Type:      entry.Type,
+			Level:     entry.Level,
+			Payload:   entry.Payload,
+		}); err != nil {
+			if rewriteErr := s.writeActivityWAL(entries[i:]); rewriteErr != nil {
+				return i, errors.Wrap(rewriteErr, "failed to rewrite activity wal")
+			}
+			return i, errors.Wrap(err, "failed to create activity")
+		}
+	}
+	if err := os.Remove(walPath); err != nil {
+		return len(entries), errors.Wrap(err, "failed to remove activity wal")
+	}
+	return len(entries), nil
+}
+
+// appendActivityWAL appends the entries to the write-ahead log, synced to the disk.
+func (s *Store) appendActivityWAL(entries []*activityWALEntry) error {
+	s.activityWALMutex.Lock()
+	defer s.activityWALMutex.Unlock()
+
+	walBytes, err := marshalActivityWALEntries(entries)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(filepath.Join(s.profile.Data, activityWALFileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to open activity wal")
+	}
+	defer file.Close()
+	if _, err := file.Write(walBytes); err != nil {
+		return errors.Wrap(err, "failed to write activity wal")
+	}
+	return errors.Wrap(file.Sync(), "failed to sync activity wal")
+}
+
+// writeActivityWAL replaces the write-ahead log with the entries, atomically. The mutex must be held.
+func (s *Store) writeActivityWAL(entries []*activityWALEntry) error {
+	walBytes, err := marshalActivityWALEntries(entries)
+	if err != nil {
+		return err
+	}
+	walPath := filepath.Join(s.profile.Data, activityWALFileName)
+	file, err := os.CreateTemp(s.profile.Data, activityWALFileName+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(walBytes); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), walPath)
+}
+
+func marshalActivityWALEntries(entries []*activityWALEntry) ([]byte, error) {
+	var buffer bytes.Buffer
+	for _, entry := range entries {
+		entryBytes, err := json.Marshal(entry)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal activity wal entry")
+		}
+		buffer.Write(entryBytes)
+		buffer.WriteByte('\n')
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+)
+
+// Blob is a binary object stored in the database, e.g. the avatar of a user.
+type Blob struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	Type      string
+	Size      int32
+	Blob      []byte
+}
+
+type FindBlob struct {
+	ID        *int32
+	CreatorID *int32
+}
+
+type DeleteBlob struct {
+	ID int32
+}
+
+func (s *Store) CreateBlob(ctx context.Context, create *Blob) (*Blob, error) {
+	create.Size = int32(len(create.Blob))
+	return s.driver.CreateBlob(ctx, create)
+}
+
+func (s *Store) ListBlobs(ctx context.Context, find *FindBlob) ([]*Blob, error) {
+	return s.driver.ListBlobs(ctx, find)
+}
+
+func (s *Store) GetBlob(ctx context.Context, find *FindBlob) (*Blob, error) {
+	list, err := s.ListBlobs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) DeleteBlob(ctx context.Context, delete *DeleteBlob) error {
+	return s.driver.DeleteBlob(ctx, delete)
+}
@@ -7,13 +7,18 @@ import (
 )
 
 type UpdateCollection struct {
-	ID          int32
+	ID int32
+
+	// CreatorID transfers the ownership of the collection to another user.
+	CreatorID   *int32
+	RowStatus   *storepb.RowStatus
 	Name        *string
 	Link        *string
 	Title       *string
 	Description *string
 	ShortcutIDs []int32
 	Visibility  *storepb.Visibility
+	TeamID      *int32
 }
 
 type FindCollection struct {
@@ -21,6 +26,13 @@ type FindCollection struct {
 	CreatorID      *int32
 	Name           *string
 	VisibilityList []storepb.Visibility
+	RowStatus      *storepb.RowStatus
+	// ViewerID filters the team and private collections to the ones of the teams of the viewer, or created by the viewer.
+	ViewerID *int32
+
+	// Limit and Offset paginate the list.
+	Limit  *int
+	Offset *int
 }
 
 type DeleteCollection struct {
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+)
+
+// CollectionShare is a guest link to view a collection, issued to an email that isn't a member of the workspace.
+type CollectionShare struct {
+	ID           int32
+	CollectionID int32
+	CreatorID    int32
+	CreatedTs    int64
+	Email        string
+	// Token is the secret of the guest link.
+	Token    string
+	ExpireTs int64
+	// ViewCount is the number of times the guest link was opened.
+	ViewCount    int32
+	LastViewedTs int64
+}
+
+type FindCollectionShare struct {
+	ID           *int32
+	CollectionID *int32
+	Token        *string
+}
+
+type DeleteCollectionShare struct {
+	ID int32
+}
+
+func (s *Store) CreateCollectionShare(ctx context.Context, create *CollectionShare) (*CollectionShare, error) {
+	return s.driver.CreateCollectionShare(ctx, create)
+}
+
+func (s *Store) ListCollectionShares(ctx context.Context, find *FindCollectionShare) ([]*CollectionShare, error) {
+	return s.driver.ListCollectionShares(ctx, find)
+}
+
+func (s *Store) GetCollectionShare(ctx context.Context, find *FindCollectionShare) (*CollectionShare, error) {
+	list, err := s.ListCollectionShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+// RecordCollectionShareView increases the view count of the guest link, and sets the last viewed time to viewedTs.
+func (s *Store) RecordCollectionShareView(ctx context.Context, id int32, viewedTs int64) error {
+	return s.driver.RecordCollectionShareView(ctx, id, viewedTs)
+}
+
+func (s *Store) DeleteCollectionShare(ctx context.Context, delete *DeleteCollectionShare) error {
+	return s.driver.DeleteCollectionShare(ctx, delete)
+}
@@ -16,6 +16,28 @@ func ConvertVisibilityStringToStorepb(visibility string) storepb.Visibility {
 	if visibility == "PUBLIC" {
 		return storepb.Visibility_PUBLIC
 	}
+	if visibility == "TEAM" {
+		return storepb.Visibility_TEAM
+	}
+	if visibility == "PRIVATE" {
+		return storepb.Visibility_PRIVATE
+	}
 	// Otherwise, fallback to workspace visibility.
 	return storepb.Visibility_WORKSPACE
 }
+
+// maxIDListSize bounds the ids of a single IN query, under the limit of SQLite on the number of query parameters.
+const maxIDListSize = 500
+
+// batchIDs splits the ids into batches of at most maxIDListSize ids.
+func batchIDs(ids []int32) [][]int32 {
+	batches := [][]int32{}
+	for len(ids) > maxIDListSize {
+		batches = append(batches, ids[:maxIDListSize])
+		ids = ids[maxIDListSize:]
+	}
+	if len(ids) > 0 {
+		batches = append(batches, ids)
+	}
+	return batches
+}
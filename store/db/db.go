@@ -1,29 +1,51 @@
 package db
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 
 	"github.com/warthurton/slash/server/profile"
 	"github.com/warthurton/slash/store"
 	"github.com/warthurton/slash/store/db/postgres"
+	"github.com/warthurton/slash/store/db/shadow"
 	"github.com/warthurton/slash/store/db/sqlite"
 )
 
 // NewDBDriver creates new db driver based on profile.
+// With a shadow DSN, the driver mirrors the writes to the shadow database and compares the reads.
 func NewDBDriver(profile *profile.Profile) (store.Driver, error) {
-	var driver store.Driver
-	var err error
+	driver, err := newDriver(profile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create db driver")
+	}
+	if profile.ShadowDSN == "" {
+		return driver, nil
+	}
 
+	shadowProfile := *profile
+	shadowProfile.Driver, shadowProfile.DSN = profile.ShadowDriver, profile.ShadowDSN
+	shadowDriver, err := newDriver(&shadowProfile)
+	if err != nil {
+		driver.Close()
+		return nil, errors.Wrap(err, "failed to create shadow db driver")
+	}
+	// The shadow database is migrated here, as the store only migrates the primary one.
+	if err := store.New(shadowDriver, &shadowProfile).Migrate(context.Background()); err != nil {
+		driver.Close()
+		shadowDriver.Close()
+		return nil, errors.Wrap(err, "failed to migrate shadow db")
+	}
+	return shadow.NewDB(driver, shadowDriver), nil
+}
+
+func newDriver(profile *profile.Profile) (store.Driver, error) {
 	switch profile.Driver {
 	case "sqlite":
-		driver, err = sqlite.NewDB(profile)
+		return sqlite.NewDB(profile)
 	case "postgres":
-		driver, err = postgres.NewDB(profile)
+		return postgres.NewDB(profile)
 	default:
 		return nil, errors.New("unknown db driver")
 	}
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create db driver")
-	}
-	return driver, nil
 }
@@ -4,27 +4,26 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/warthurton/slash/store"
 )
 
 func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store.Activity, error) {
+	set := []string{"creator_id", "type", "level", "payload"}
+	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), create.Payload}
+	// The time is kept when given, e.g. for the activities replayed from the write-ahead log.
+	if create.CreatedTs != 0 {
+		set, args = append(set, "created_ts"), append(args, create.CreatedTs)
+	}
 	stmt := `
 		INSERT INTO activity (
-			creator_id,
-			type,
-			level,
-			payload
+			` + strings.Join(set, ", ") + `
 		)
-		VALUES ($1, $2, $3, $4)
+		VALUES (` + placeholders(len(args)) + `)
 		RETURNING id, created_ts
 	`
-	if err := d.db.QueryRowContext(ctx, stmt,
-		create.CreatorID,
-		create.Type.String(),
-		create.Level.String(),
-		create.Payload,
-	).Scan(
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 	); err != nil {
@@ -49,6 +48,9 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	if find.CreatedTsAfter != nil {
 		where, args = append(where, "created_ts > "+placeholder(len(args)+1)), append(args, *find.CreatedTsAfter)
 	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts <= "+placeholder(len(args)+1)), append(args, *find.CreatedTsBefore)
+	}
 
 	query := `
 		SELECT
@@ -59,7 +61,8 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 			level,
 			payload
 		FROM activity
-		WHERE ` + strings.Join(where, " AND ")
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY id ASC` + limitOffset(find.Limit, nil)
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -89,3 +92,178 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 
 	return list, nil
 }
+
+func (d *DB) DeleteActivities(ctx context.Context, delete *store.DeleteActivities) error {
+	where, args := []string{"type = $1"}, []any{delete.Type.String()}
+	if delete.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts > "+placeholder(len(args)+1)), append(args, *delete.CreatedTsAfter)
+	}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts <= "+placeholder(len(args)+1)), append(args, *delete.CreatedTsBefore)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM activity WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
+
+// EnsureActivityPartition creates the monthly partition of the activity table, e.g. activity_202601.
+// The rows of the month in the default partition are moved into it, as Postgres refuses to attach it otherwise.
+func (d *DB) EnsureActivityPartition(ctx context.Context, month time.Time) error {
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	name := fmt.Sprintf("activity_%s", start.Format("200601"))
+	var exists bool
+	if err := d.db.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", name).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	// The table name and bounds are generated above, and DDL statements don't take parameters.
+	stmts := []string{
+		fmt.Sprintf("CREATE TABLE %s (LIKE activity INCLUDING DEFAULTS INCLUDING CONSTRAINTS)", name),
+		fmt.Sprintf(`WITH moved AS (
+			DELETE FROM activity_default WHERE created_ts >= %d AND created_ts < %d RETURNING *
+		) INSERT INTO %s SELECT * FROM moved`, start.Unix(), end.Unix(), name),
+		fmt.Sprintf("ALTER TABLE activity ATTACH PARTITION %s FOR VALUES FROM (%d) TO (%d)", name, start.Unix(), end.Unix()),
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *DB) ListShortcutViewCounts(ctx context.Context, find *store.FindShortcutViewCount) ([]*store.ShortcutViewCount, error) {
+	where, args := []string{"type = $1"}, []any{store.ActivityShortcutView.String()}
+	if find.ShortcutID != nil {
+		where, args = append(where, fmt.Sprintf("CAST(payload::JSON->>'shortcutId' AS INTEGER) = %s", placeholder(len(args)+1))), append(args, *find.ShortcutID)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts > "+placeholder(len(args)+1)), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts <= "+placeholder(len(args)+1)), append(args, *find.CreatedTsBefore)
+	}
+
+	query := `
+		SELECT
+			CAST(payload::JSON->>'shortcutId' AS INTEGER) AS shortcut_id,
+			COUNT(*)
+		FROM activity
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY shortcut_id
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewCount{}
+	for rows.Next() {
+		viewCount := &store.ShortcutViewCount{}
+		if err := rows.Scan(
+			&viewCount.ShortcutID,
+			&viewCount.Count,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, viewCount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) ListShortcutViewGroups(ctx context.Context, find *store.FindShortcutViewGroup) ([]*store.ShortcutViewGroup, error) {
+	where, args := []string{"type = $1", "CAST(payload::JSON->>'shortcutId' AS INTEGER) = $2"}, []any{store.ActivityShortcutView.String(), find.ShortcutID}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts > "+placeholder(len(args)+1)), append(args, *find.CreatedTsAfter)
+	}
+
+	// The field is validated by the store, so it's safe to be used in the json path.
+	query := `
+		SELECT
+			COALESCE(payload::JSON->>'` + string(find.Field) + `', '') AS value,
+			COUNT(*) AS count
+		FROM activity
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY value
+		ORDER BY count DESC, value ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewGroup{}
+	for rows.Next() {
+		group := &store.ShortcutViewGroup{}
+		if err := rows.Scan(
+			&group.Value,
+			&group.Count,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) ListShortcutViewBuckets(ctx context.Context, find *store.FindShortcutViewBucket) ([]*store.ShortcutViewBucket, error) {
+	where, args := []string{"type = $1", "CAST(payload::JSON->>'shortcutId' AS INTEGER) = $2"}, []any{store.ActivityShortcutView.String(), find.ShortcutID}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts > "+placeholder(len(args)+1)), append(args, *find.CreatedTsAfter)
+	}
+	offset, size := placeholder(len(args)+1), placeholder(len(args)+2)
+	args = append(args, find.BucketOffset, find.BucketSize)
+
+	query := `
+		SELECT
+			((created_ts - ` + offset + `) / ` + size + `) * ` + size + ` + ` + offset + ` AS start_ts,
+			COUNT(*)
+		FROM activity
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY start_ts
+		ORDER BY start_ts ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewBucket{}
+	for rows.Next() {
+		bucket := &store.ShortcutViewBucket{}
+		if err := rows.Scan(
+			&bucket.StartTs,
+			&bucket.Count,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -14,27 +14,36 @@ import (
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String()}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "team_id"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String(), create.TeamId}
 
 	stmt := `
 		INSERT INTO collection (` + strings.Join(set, ", ") + `)
 		VALUES (` + placeholders(len(args)) + `)
-		RETURNING id, created_ts, updated_ts
+		RETURNING id, created_ts, updated_ts, row_status
 	`
+	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.Id,
 		&create.CreatedTs,
 		&create.UpdatedTs,
+		&rowStatus,
 	); err != nil {
 		return nil, err
 	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	collection := create
 	return collection, nil
 }
 
 func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollection) (*storepb.Collection, error) {
 	set, args := []string{}, []any{}
+	if update.CreatorID != nil {
+		set, args = append(set, "creator_id = "+placeholder(len(args)+1)), append(args, *update.CreatorID)
+	}
+	if update.RowStatus != nil {
+		set, args = append(set, "row_status = "+placeholder(len(args)+1)), append(args, update.RowStatus.String())
+	}
 	if update.Name != nil {
 		set, args = append(set, "name = "+placeholder(len(args)+1)), append(args, *update.Name)
 	}
@@ -50,6 +59,9 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = "+placeholder(len(args)+1)), append(args, update.Visibility.String())
 	}
+	if update.TeamID != nil {
+		set, args = append(set, "team_id = "+placeholder(len(args)+1)), append(args, *update.TeamID)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -58,22 +70,24 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		UPDATE collection
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility
+		RETURNING id, creator_id, created_ts, updated_ts, row_status, name, title, description, shortcut_ids, visibility, team_id
 	`
 	args = append(args, update.ID)
 	collection := &storepb.Collection{}
 	var shortcutIDs []sql.NullInt32
-	var visibility string
+	var rowStatus, visibility string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&collection.Id,
 		&collection.CreatorId,
 		&collection.CreatedTs,
 		&collection.UpdatedTs,
+		&rowStatus,
 		&collection.Name,
 		&collection.Title,
 		&collection.Description,
 		pq.Array(&shortcutIDs),
 		&visibility,
+		&collection.TeamId,
 	); err != nil {
 		return nil, err
 	}
@@ -84,6 +98,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 			collection.ShortcutIds = append(collection.ShortcutIds, id.Int32)
 		}
 	}
+	collection.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	collection.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 	return collection, nil
 }
@@ -106,6 +121,14 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 		}
 		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = "+placeholder(len(args)+1)), append(args, v.String())
+	}
+	if v := find.ViewerID; v != nil {
+		viewer := placeholder(len(args) + 1)
+		where = append(where, fmt.Sprintf("(visibility NOT IN ('TEAM', 'PRIVATE') OR creator_id = %s OR (visibility = 'TEAM' AND team_id IN (SELECT team_id FROM team_member WHERE user_id = %s)))", viewer, viewer))
+		args = append(args, *v)
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -113,14 +136,16 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			creator_id,
 			created_ts,
 			updated_ts,
+			row_status,
 			name,
 			title,
 			description,
 			shortcut_ids,
-			visibility
+			visibility,
+			team_id
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
-		ORDER BY created_ts DESC`,
+		ORDER BY created_ts DESC, id DESC`+limitOffset(find.Limit, find.Offset),
 		args...,
 	)
 	if err != nil {
@@ -132,17 +157,19 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	for rows.Next() {
 		collection := &storepb.Collection{}
 		var shortcutIDs []sql.NullInt32
-		var visibility string
+		var rowStatus, visibility string
 		if err := rows.Scan(
 			&collection.Id,
 			&collection.CreatorId,
 			&collection.CreatedTs,
 			&collection.UpdatedTs,
+			&rowStatus,
 			&collection.Name,
 			&collection.Title,
 			&collection.Description,
 			pq.Array(&shortcutIDs),
 			&visibility,
+			&collection.TeamId,
 		); err != nil {
 			return nil, err
 		}
@@ -153,6 +180,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 				collection.ShortcutIds = append(collection.ShortcutIds, id.Int32)
 			}
 		}
+		collection.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 		collection.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 		list = append(list, collection)
 	}
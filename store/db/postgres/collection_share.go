@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) CreateCollectionShare(ctx context.Context, create *store.CollectionShare) (*store.CollectionShare, error) {
+	stmt := `
+		INSERT INTO collection_share (
+			collection_id,
+			creator_id,
+			email,
+			token,
+			expire_ts
+		)
+		VALUES (` + placeholders(5) + `)
+		RETURNING id, created_ts, view_count, last_viewed_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, create.CollectionID, create.CreatorID, create.Email, create.Token, create.ExpireTs).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.ViewCount,
+		&create.LastViewedTs,
+	); err != nil {
+		return nil, err
+	}
+	collectionShare := create
+	return collectionShare, nil
+}
+
+func (d *DB) ListCollectionShares(ctx context.Context, find *store.FindCollectionShare) ([]*store.CollectionShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CollectionID; v != nil {
+		where, args = append(where, "collection_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Token; v != nil {
+		where, args = append(where, "token = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			collection_id,
+			creator_id,
+			created_ts,
+			email,
+			token,
+			expire_ts,
+			view_count,
+			last_viewed_ts
+		FROM collection_share
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.CollectionShare{}
+	for rows.Next() {
+		collectionShare := &store.CollectionShare{}
+		if err := rows.Scan(
+			&collectionShare.ID,
+			&collectionShare.CollectionID,
+			&collectionShare.CreatorID,
+			&collectionShare.CreatedTs,
+			&collectionShare.Email,
+			&collectionShare.Token,
+			&collectionShare.ExpireTs,
+			&collectionShare.ViewCount,
+			&collectionShare.LastViewedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, collectionShare)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) RecordCollectionShareView(ctx context.Context, id int32, viewedTs int64) error {
+	stmt := `UPDATE collection_share SET view_count = view_count + 1, last_viewed_ts = $1 WHERE id = $2`
+	if _, err := d.db.ExecContext(ctx, stmt, viewedTs, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DB) DeleteCollectionShare(ctx context.Context, delete *store.DeleteCollectionShare) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection_share WHERE id = $1`, delete.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
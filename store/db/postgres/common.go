@@ -24,3 +24,15 @@ func placeholders(n int) string {
 	}
 	return strings.Join(list, ", ")
 }
+
+// limitOffset returns the LIMIT/OFFSET clause of the pagination, or an empty string without pagination.
+func limitOffset(limit, offset *int) string {
+	clause := ""
+	if limit != nil {
+		clause += fmt.Sprintf(" LIMIT %d", *limit)
+	}
+	if offset != nil {
+		clause += fmt.Sprintf(" OFFSET %d", *offset)
+	}
+	return clause
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) UpsertIdentityProviderLink(ctx context.Context, upsert *store.IdentityProviderLink) (*store.IdentityProviderLink, error) {
+	stmt := `
+		INSERT INTO identity_provider_link (
+			user_id,
+			idp_id,
+			identifier
+		)
+		VALUES ($1, $2, $3)
+		ON CONFLICT(user_id, idp_id) DO UPDATE
+		SET identifier = EXCLUDED.identifier, created_ts = EXCLUDED.created_ts
+		RETURNING created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.UserID, upsert.IdpID, upsert.Identifier).Scan(
+		&upsert.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	identityProviderLink := upsert
+	return identityProviderLink, nil
+}
+
+func (d *DB) ListIdentityProviderLinks(ctx context.Context, find *store.FindIdentityProviderLink) ([]*store.IdentityProviderLink, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.IdpID; v != nil {
+		where, args = append(where, "idp_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Identifier; v != nil {
+		where, args = append(where, "identifier = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			user_id,
+			idp_id,
+			identifier,
+			created_ts
+		FROM identity_provider_link
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts ASC, idp_id ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.IdentityProviderLink{}
+	for rows.Next() {
+		identityProviderLink := &store.IdentityProviderLink{}
+		if err := rows.Scan(
+			&identityProviderLink.UserID,
+			&identityProviderLink.IdpID,
+			&identityProviderLink.Identifier,
+			&identityProviderLink.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, identityProviderLink)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
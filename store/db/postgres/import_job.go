@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+const importJobColumns = "id, creator_id, created_ts, updated_ts, status, format, payload, processed_rows, created_count, failed_count, result, error"
+
+func (d *DB) CreateImportJob(ctx context.Context, create *store.ImportJob) (*store.ImportJob, error) {
+	payloadBytes, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+	stmt := `
+		INSERT INTO import_job (
+			creator_id,
+			format,
+			payload
+		)
+		VALUES (` + placeholders(3) + `)
+		RETURNING ` + importJobColumns + `
+	`
+	return scanImportJob(d.db.QueryRowContext(ctx, stmt, create.CreatorID, create.Format, string(payloadBytes)))
+}
+
+func (d *DB) UpdateImportJob(ctx context.Context, update *store.UpdateImportJob) (*store.ImportJob, error) {
+	set, args := []string{"updated_ts = EXTRACT(EPOCH FROM NOW())"}, []any{}
+	if update.Status != nil {
+		set, args = append(set, "status = "+placeholder(len(args)+1)), append(args, update.Status.String())
+	}
+	if update.ProcessedRows != nil {
+		set, args = append(set, "processed_rows = "+placeholder(len(args)+1)), append(args, *update.ProcessedRows)
+	}
+	if update.CreatedCount != nil {
+		set, args = append(set, "created_count = "+placeholder(len(args)+1)), append(args, *update.CreatedCount)
+	}
+	if update.FailedCount != nil {
+		set, args = append(set, "failed_count = "+placeholder(len(args)+1)), append(args, *update.FailedCount)
+	}
+	if update.Result != nil {
+		resultBytes, err := protojson.Marshal(update.Result)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal import job result")
+		}
+		set, args = append(set, "result = "+placeholder(len(args)+1)), append(args, string(resultBytes))
+	}
+	if update.Error != nil {
+		set, args = append(set, "error = "+placeholder(len(args)+1)), append(args, *update.Error)
+	}
+	args = append(args, update.ID)
+
+	stmt := `
+		UPDATE import_job
+		SET ` + strings.Join(set, ", ") + `
+		WHERE id = ` + placeholder(len(args)) + `
+		RETURNING ` + importJobColumns + `
+	`
+	return scanImportJob(d.db.QueryRowContext(ctx, stmt, args...))
+}
+
+func (d *DB) ClaimImportJob(ctx context.Context) (*store.ImportJob, error) {
+	stmt := `
+		UPDATE import_job
+		SET status = $1, updated_ts = EXTRACT(EPOCH FROM NOW())
+		WHERE id = (SELECT id FROM import_job WHERE status = $2 ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED) AND status = $2
+		RETURNING ` + importJobColumns + `
+	`
+	pending := store.ImportJobPending.String()
+	importJob, err := scanImportJob(d.db.QueryRowContext(ctx, stmt, store.ImportJobRunning.String(), pending))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return importJob, nil
+}
+
+func (d *DB) ListImportJobs(ctx context.Context, find *store.FindImportJob) ([]*store.ImportJob, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Status; v != nil {
+		where, args = append(where, "status = "+placeholder(len(args)+1)), append(args, v.String())
+	}
+
+	query := `
+		SELECT ` + importJobColumns + `
+		FROM import_job
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ImportJob{}
+	for rows.Next() {
+		importJob, err := scanImportJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, importJob)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteImportJob(ctx context.Context, delete *store.DeleteImportJob) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM import_job WHERE id = $1`, delete.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanImportJob(row interface{ Scan(...any) error }) (*store.ImportJob, error) {
+	importJob := &store.ImportJob{}
+	var status, payload, result string
+	if err := row.Scan(
+		&importJob.ID,
+		&importJob.CreatorID,
+		&importJob.CreatedTs,
+		&importJob.UpdatedTs,
+		&status,
+		&importJob.Format,
+		&payload,
+		&importJob.ProcessedRows,
+		&importJob.CreatedCount,
+		&importJob.FailedCount,
+		&result,
+		&importJob.Error,
+	); err != nil {
+		return nil, err
+	}
+	importJob.Status = store.ImportJobStatus(status)
+	importJob.Payload = &storepb.ImportJobPayload{}
+	if err := protojson.Unmarshal([]byte(payload), importJob.Payload); err != nil {
+		return nil, err
+	}
+	importJob.Result = &storepb.ImportJobResult{}
+	if err := protojson.Unmarshal([]byte(result), importJob.Result); err != nil {
+		return nil, err
+	}
+	return importJob, nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) GetInstanceStats(ctx context.Context) (*store.InstanceStats, error) {
+	stmt := `
+		SELECT
+			(SELECT COUNT(*) FROM shortcut),
+			(SELECT COUNT(*) FROM collection),
+			(SELECT COUNT(*) FROM "user"),
+			(SELECT COUNT(*) FROM activity),
+			pg_database_size(current_database())
+	`
+	stats := &store.InstanceStats{}
+	if err := d.db.QueryRowContext(ctx, stmt).Scan(
+		&stats.ShortcutCount,
+		&stats.CollectionCount,
+		&stats.UserCount,
+		&stats.ActivityCount,
+		&stats.DatabaseSize,
+	); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
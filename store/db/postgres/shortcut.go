@@ -13,8 +13,8 @@ import (
 )
 
 func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
-	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag"}
-	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " ")}
+	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag", "expire_ts", "activate_ts", "protected", "team_id", "redirect_code", "document_mode", "internal_description"}
+	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " "), create.ExpireTs, create.ActivateTs, create.Protected, create.TeamId, create.RedirectCode, create.DocumentMode.String(), create.InternalDescription}
 	if create.OgMetadata != nil {
 		set = append(set, "og_metadata")
 		openGraphMetadataBytes, err := protojson.Marshal(create.OgMetadata)
@@ -23,25 +23,66 @@ func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*sto
 		}
 		args = append(args, string(openGraphMetadataBytes))
 	}
+	if create.ClickGoal != nil {
+		set = append(set, "click_goal")
+		clickGoalBytes, err := protojson.Marshal(create.ClickGoal)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(clickGoalBytes))
+	}
+	if create.LinkHealth != nil {
+		set = append(set, "link_health")
+		linkHealthBytes, err := protojson.Marshal(create.LinkHealth)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(linkHealthBytes))
+	}
+	if create.RedirectRules != nil {
+		set = append(set, "redirect_rules")
+		redirectRulesBytes, err := protojson.Marshal(create.RedirectRules)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(redirectRulesBytes))
+	}
+	if create.Variants != nil {
+		set = append(set, "variants")
+		variantsBytes, err := protojson.Marshal(create.Variants)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(variantsBytes))
+	}
 
 	stmt := fmt.Sprintf(`
 		INSERT INTO shortcut (%s)
 		VALUES (%s)
-		RETURNING id, created_ts, updated_ts
+		RETURNING id, created_ts, updated_ts, row_status
 	`, strings.Join(set, ","), placeholders(len(args)))
+	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.Id,
 		&create.CreatedTs,
 		&create.UpdatedTs,
+		&rowStatus,
 	); err != nil {
 		return nil, err
 	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut := create
 	return shortcut, nil
 }
 
 func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
 	set, args := []string{}, []any{}
+	if update.CreatorID != nil {
+		set, args = append(set, fmt.Sprintf("creator_id = $%d", len(args)+1)), append(args, *update.CreatorID)
+	}
+	if update.RowStatus != nil {
+		set, args = append(set, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, update.RowStatus.String())
+	}
 	if update.Name != nil {
 		set, args = append(set, fmt.Sprintf("name = $%d", len(args)+1)), append(args, *update.Name)
 	}
@@ -67,6 +108,55 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		}
 		set, args = append(set, fmt.Sprintf("og_metadata = $%d", len(args)+1)), append(args, string(openGraphMetadataBytes))
 	}
+	if update.ClickGoal != nil {
+		clickGoalBytes, err := protojson.Marshal(update.ClickGoal)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal click goal")
+		}
+		set, args = append(set, fmt.Sprintf("click_goal = $%d", len(args)+1)), append(args, string(clickGoalBytes))
+	}
+	if update.ExpireTs != nil {
+		set, args = append(set, fmt.Sprintf("expire_ts = $%d", len(args)+1)), append(args, *update.ExpireTs)
+	}
+	if update.ActivateTs != nil {
+		set, args = append(set, fmt.Sprintf("activate_ts = $%d", len(args)+1)), append(args, *update.ActivateTs)
+	}
+	if update.Protected != nil {
+		set, args = append(set, fmt.Sprintf("protected = $%d", len(args)+1)), append(args, *update.Protected)
+	}
+	if update.TeamID != nil {
+		set, args = append(set, fmt.Sprintf("team_id = $%d", len(args)+1)), append(args, *update.TeamID)
+	}
+	if update.RedirectCode != nil {
+		set, args = append(set, fmt.Sprintf("redirect_code = $%d", len(args)+1)), append(args, *update.RedirectCode)
+	}
+	if update.DocumentMode != nil {
+		set, args = append(set, fmt.Sprintf("document_mode = $%d", len(args)+1)), append(args, update.DocumentMode.String())
+	}
+	if update.InternalDescription != nil {
+		set, args = append(set, fmt.Sprintf("internal_description = $%d", len(args)+1)), append(args, *update.InternalDescription)
+	}
+	if update.LinkHealth != nil {
+		linkHealthBytes, err := protojson.Marshal(update.LinkHealth)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal link health")
+		}
+		set, args = append(set, fmt.Sprintf("link_health = $%d", len(args)+1)), append(args, string(linkHealthBytes))
+	}
+	if update.RedirectRules != nil {
+		redirectRulesBytes, err := protojson.Marshal(update.RedirectRules)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal redirect rules")
+		}
+		set, args = append(set, fmt.Sprintf("redirect_rules = $%d", len(args)+1)), append(args, string(redirectRulesBytes))
+	}
+	if update.Variants != nil {
+		variantsBytes, err := protojson.Marshal(update.Variants)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal variants")
+		}
+		set, args = append(set, fmt.Sprintf("variants = $%d", len(args)+1)), append(args, string(variantsBytes))
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -76,16 +166,17 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		UPDATE shortcut
 		SET %s
 		WHERE id = $%d
-		RETURNING id, creator_id, created_ts, updated_ts, name, link, title, description, visibility, tag, og_metadata
+		RETURNING id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, click_goal, expire_ts, activate_ts, protected, team_id, redirect_code, link_health, document_mode, internal_description, redirect_rules, variants
 	`, strings.Join(set, ","), len(args))
 
 	shortcut := &storepb.Shortcut{}
-	var visibility, tags, openGraphMetadataString string
+	var rowStatus, visibility, tags, openGraphMetadataString, clickGoalString, linkHealthString, documentMode, redirectRulesString, variantsString string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&shortcut.Id,
 		&shortcut.CreatorId,
 		&shortcut.CreatedTs,
 		&shortcut.UpdatedTs,
+		&rowStatus,
 		&shortcut.Name,
 		&shortcut.Link,
 		&shortcut.Title,
@@ -93,24 +184,87 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		&visibility,
 		&tags,
 		&openGraphMetadataString,
+		&clickGoalString,
+		&shortcut.ExpireTs,
+		&shortcut.ActivateTs,
+		&shortcut.Protected,
+		&shortcut.TeamId,
+		&shortcut.RedirectCode,
+		&linkHealthString,
+		&documentMode,
+		&shortcut.InternalDescription,
+		&redirectRulesString,
+		&variantsString,
 	); err != nil {
 		return nil, err
 	}
+	shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+	shortcut.DocumentMode = storepb.DocumentMode(storepb.DocumentMode_value[documentMode])
 	shortcut.Tags = filterTags(strings.Split(tags, " "))
 	var ogMetadata storepb.OpenGraphMetadata
 	if err := protojson.Unmarshal([]byte(openGraphMetadataString), &ogMetadata); err != nil {
 		return nil, err
 	}
 	shortcut.OgMetadata = &ogMetadata
+	var clickGoal storepb.ClickGoal
+	if err := protojson.Unmarshal([]byte(clickGoalString), &clickGoal); err != nil {
+		return nil, err
+	}
+	shortcut.ClickGoal = &clickGoal
+	var linkHealth storepb.LinkHealth
+	if err := protojson.Unmarshal([]byte(linkHealthString), &linkHealth); err != nil {
+		return nil, err
+	}
+	shortcut.LinkHealth = &linkHealth
+	var redirectRules storepb.RedirectRules
+	if err := protojson.Unmarshal([]byte(redirectRulesString), &redirectRules); err != nil {
+		return nil, err
+	}
+	shortcut.RedirectRules = &redirectRules
+	var variants storepb.Variants
+	if err := protojson.Unmarshal([]byte(variantsString), &variants); err != nil {
+		return nil, err
+	}
+	shortcut.Variants = &variants
 	return shortcut, nil
 }
 
+func (d *DB) UpdateShortcutTags(ctx context.Context, update *store.UpdateShortcutTags) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `UPDATE shortcut SET tag = $1 WHERE id = $2`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for id, tags := range update.Tags {
+		if _, err := stmt.ExecContext(ctx, strings.Join(tags, " "), id); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*storepb.Shortcut, error) {
 	where, args := []string{"1 = 1"}, []any{}
 	if v := find.ID; v != nil {
 		where, args = append(where, fmt.Sprintf("id = %s", placeholder(len(args)+1))), append(args, *v)
 	}
+	if v := find.IDList; v != nil {
+		list := []string{}
+		for _, id := range v {
+			list = append(list, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		// An empty list matches no rows.
+		list = append(list, "NULL")
+		where = append(where, fmt.Sprintf("id IN (%s)", strings.Join(list, ",")))
+	}
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, fmt.Sprintf("creator_id = %s", placeholder(len(args)+1))), append(args, *v)
 	}
@@ -121,13 +275,38 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 		list := []string{}
 		for _, visibility := range v {
 			list = append(list, placeholder(len(args)+1))
-			args = append(args, visibility)
+			args = append(args, visibility.String())
 		}
 		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
 	}
 	if v := find.Tag; v != nil {
 		where, args = append(where, fmt.Sprintf("tag LIKE %s", placeholder(len(args)+1))), append(args, "%"+*v+"%")
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, fmt.Sprintf("row_status = %s", placeholder(len(args)+1))), append(args, v.String())
+	}
+	if v := find.ExpireTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("expire_ts > 0 AND expire_ts <= %s", placeholder(len(args)+1))), append(args, *v)
+	}
+	if v := find.ViewerID; v != nil {
+		viewer := placeholder(len(args) + 1)
+		where = append(where, fmt.Sprintf(`(visibility NOT IN ('TEAM', 'PRIVATE') OR creator_id = %s
+			OR (visibility = 'TEAM' AND team_id IN (SELECT team_id FROM team_member WHERE user_id = %s))
+			OR id IN (SELECT shortcut_id FROM shortcut_acl WHERE user_id = %s))`, viewer, viewer, viewer))
+		args = append(args, *v)
+	}
+	orderBy := "created_ts DESC, id DESC"
+	if v := find.Search; v != nil {
+		if terms := store.SplitSearchTerms(*v); len(terms) > 0 {
+			query := []string{}
+			for _, term := range terms {
+				query = append(query, term+":*")
+			}
+			tsQuery := fmt.Sprintf("to_tsquery('simple', %s)", placeholder(len(args)+1))
+			where, args = append(where, "search_vector @@ "+tsQuery), append(args, strings.Join(query, " & "))
+			orderBy = fmt.Sprintf("ts_rank(search_vector, %s) DESC, %s", tsQuery, orderBy)
+		}
+	}
 
 	rows, err := d.db.QueryContext(ctx, fmt.Sprintf(`
 		SELECT
@@ -135,17 +314,29 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			creator_id,
 			created_ts,
 			updated_ts,
+			row_status,
 			name,
 			link,
 			title,
 			description,
 			visibility,
 			tag,
-			og_metadata
+			og_metadata,
+			click_goal,
+			expire_ts,
+			activate_ts,
+			protected,
+			team_id,
+			redirect_code,
+			link_health,
+			document_mode,
+			internal_description,
+			redirect_rules,
+			variants
 		FROM shortcut
 		WHERE %s
-		ORDER BY created_ts DESC
-	`, strings.Join(where, " AND ")), args...)
+		ORDER BY %s
+	`, strings.Join(where, " AND "), orderBy)+limitOffset(find.Limit, find.Offset), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,12 +345,13 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	list := make([]*storepb.Shortcut, 0)
 	for rows.Next() {
 		shortcut := &storepb.Shortcut{}
-		var visibility, tags, openGraphMetadataString string
+		var rowStatus, visibility, tags, openGraphMetadataString, clickGoalString, linkHealthString, documentMode, redirectRulesString, variantsString string
 		if err := rows.Scan(
 			&shortcut.Id,
 			&shortcut.CreatorId,
 			&shortcut.CreatedTs,
 			&shortcut.UpdatedTs,
+			&rowStatus,
 			&shortcut.Name,
 			&shortcut.Link,
 			&shortcut.Title,
@@ -167,16 +359,49 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			&visibility,
 			&tags,
 			&openGraphMetadataString,
+			&clickGoalString,
+			&shortcut.ExpireTs,
+			&shortcut.ActivateTs,
+			&shortcut.Protected,
+			&shortcut.TeamId,
+			&shortcut.RedirectCode,
+			&linkHealthString,
+			&documentMode,
+			&shortcut.InternalDescription,
+			&redirectRulesString,
+			&variantsString,
 		); err != nil {
 			return nil, err
 		}
+		shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 		shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+		shortcut.DocumentMode = storepb.DocumentMode(storepb.DocumentMode_value[documentMode])
 		shortcut.Tags = filterTags(strings.Split(tags, " "))
 		var ogMetadata storepb.OpenGraphMetadata
 		if err := protojson.Unmarshal([]byte(openGraphMetadataString), &ogMetadata); err != nil {
 			return nil, err
 		}
 		shortcut.OgMetadata = &ogMetadata
+		var clickGoal storepb.ClickGoal
+		if err := protojson.Unmarshal([]byte(clickGoalString), &clickGoal); err != nil {
+			return nil, err
+		}
+		shortcut.ClickGoal = &clickGoal
+		var linkHealth storepb.LinkHealth
+		if err := protojson.Unmarshal([]byte(linkHealthString), &linkHealth); err != nil {
+			return nil, err
+		}
+		shortcut.LinkHealth = &linkHealth
+		var redirectRules storepb.RedirectRules
+		if err := protojson.Unmarshal([]byte(redirectRulesString), &redirectRules); err != nil {
+			return nil, err
+		}
+		shortcut.RedirectRules = &redirectRules
+		var variants storepb.Variants
+		if err := protojson.Unmarshal([]byte(variantsString), &variants); err != nil {
+			return nil, err
+		}
+		shortcut.Variants = &variants
 		list = append(list, shortcut)
 	}
 
@@ -191,6 +416,39 @@ func (d *DB) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) e
 	return err
 }
 
+func (d *DB) MergeShortcuts(ctx context.Context, merge *store.MergeShortcuts) error {
+	ids := []any{}
+	for _, id := range merge.ShortcutIDs {
+		ids = append(ids, id)
+	}
+	in, survivor, activityType := placeholders(len(ids)), placeholder(len(ids)+1), placeholder(len(ids)+2)
+	withSurvivor := append(append([]any{}, ids...), merge.SurvivorID)
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmts := []struct {
+		query string
+		args  []any
+	}{
+		// A merged name may still be the alias of another shortcut, as the shortcuts take precedence over the aliases.
+		{`DELETE FROM shortcut_alias WHERE name IN (SELECT name FROM shortcut WHERE id IN (` + in + `))`, ids},
+		{`UPDATE shortcut_alias SET shortcut_id = ` + survivor + ` WHERE shortcut_id IN (` + in + `)`, withSurvivor},
+		{`INSERT INTO shortcut_alias (name, shortcut_id) SELECT name, CAST(` + survivor + ` AS INTEGER) FROM shortcut WHERE id IN (` + in + `)`, withSurvivor},
+		{`UPDATE activity SET payload = jsonb_set(payload::JSONB, '{shortcutId}', to_jsonb(CAST(` + survivor + ` AS INTEGER)))::TEXT WHERE type = ` + activityType + ` AND CAST(payload::JSON->>'shortcutId' AS INTEGER) IN (` + in + `)`, append(withSurvivor, store.ActivityShortcutView.String())},
+		{`DELETE FROM shortcut WHERE id IN (` + in + `)`, ids},
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func filterTags(tags []string) []string {
 	result := []string{}
 	for _, tag := range tags {
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) CreateShortcutRotation(ctx context.Context, create *store.ShortcutRotation) (*store.ShortcutRotation, error) {
+	stmt := `
+		INSERT INTO shortcut_rotation (
+			shortcut_id,
+			creator_id,
+			link,
+			start_ts,
+			end_ts
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, create.ShortcutID, create.CreatorID, create.Link, create.StartTs, create.EndTs).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	shortcutRotation := create
+	return shortcutRotation, nil
+}
+
+func (d *DB) ListShortcutRotations(ctx context.Context, find *store.FindShortcutRotation) ([]*store.ShortcutRotation, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.ActiveTs; v != nil {
+		where, args = append(where, "start_ts <= "+placeholder(len(args)+1)+" AND (end_ts = 0 OR end_ts > "+placeholder(len(args)+2)+")"), append(args, *v, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			shortcut_id,
+			creator_id,
+			created_ts,
+			link,
+			start_ts,
+			end_ts
+		FROM shortcut_rotation
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY start_ts DESC, id DESC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutRotation{}
+	for rows.Next() {
+		shortcutRotation := &store.ShortcutRotation{}
+		if err := rows.Scan(
+			&shortcutRotation.ID,
+			&shortcutRotation.ShortcutID,
+			&shortcutRotation.CreatorID,
+			&shortcutRotation.CreatedTs,
+			&shortcutRotation.Link,
+			&shortcutRotation.StartTs,
+			&shortcutRotation.EndTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, shortcutRotation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteShortcutRotation(ctx context.Context, delete *store.DeleteShortcutRotation) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut_rotation WHERE id = $1`, delete.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
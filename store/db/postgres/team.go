@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) CreateTeam(ctx context.Context, create *store.Team) (*store.Team, error) {
+	stmt := `
+		INSERT INTO team (
+			name,
+			description
+		)
+		VALUES ($1, $2)
+		RETURNING id, created_ts, updated_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, create.Name, create.Description).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	team := create
+	return team, nil
+}
+
+func (d *DB) UpdateTeam(ctx context.Context, update *store.UpdateTeam) (*store.Team, error) {
+	set, args := []string{}, []any{}
+	if update.Name != nil {
+		set, args = append(set, "name = "+placeholder(len(args)+1)), append(args, *update.Name)
+	}
+	if update.Description != nil {
+		set, args = append(set, "description = "+placeholder(len(args)+1)), append(args, *update.Description)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update specified")
+	}
+
+	stmt := `
+		UPDATE team
+		SET ` + strings.Join(set, ", ") + `
+		WHERE id = ` + placeholder(len(args)+1) + `
+		RETURNING id, created_ts, updated_ts, name, description
+	`
+	args = append(args, update.ID)
+	team := &store.Team{}
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&team.ID,
+		&team.CreatedTs,
+		&team.UpdatedTs,
+		&team.Name,
+		&team.Description,
+	); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+func (d *DB) ListTeams(ctx context.Context, find *store.FindTeam) ([]*store.Team, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			name,
+			description
+		FROM team
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY name ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Team{}
+	for rows.Next() {
+		team := &store.Team{}
+		if err := rows.Scan(
+			&team.ID,
+			&team.CreatedTs,
+			&team.UpdatedTs,
+			&team.Name,
+			&team.Description,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, team)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteTeam(ctx context.Context, delete *store.DeleteTeam) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM team WHERE id = $1`, delete.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DB) CreateTeamMember(ctx context.Context, create *store.TeamMember) (*store.TeamMember, error) {
+	stmt := `
+		INSERT INTO team_member (
+			team_id,
+			user_id
+		)
+		VALUES ($1, $2)
+		RETURNING created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, create.TeamID, create.UserID).Scan(
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	teamMember := create
+	return teamMember, nil
+}
+
+func (d *DB) ListTeamMembers(ctx context.Context, find *store.FindTeamMember) ([]*store.TeamMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.TeamID; v != nil {
+		where, args = append(where, "team_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			team_id,
+			user_id,
+			created_ts
+		FROM team_member
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts ASC, user_id ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.TeamMember{}
+	for rows.Next() {
+		teamMember := &store.TeamMember{}
+		if err := rows.Scan(
+			&teamMember.TeamID,
+			&teamMember.UserID,
+			&teamMember.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, teamMember)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteTeamMember(ctx context.Context, delete *store.DeleteTeamMember) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM team_member WHERE team_id = $1 AND user_id = $2`, delete.TeamID, delete.UserID); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/warthurton/slash/store"
 )
@@ -14,9 +16,11 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 			email,
 			nickname,
 			password_hash,
-			role
+			role,
+			username,
+			email_verified
 		)
-		VALUES ($1, $2, $3, $4)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id, created_ts, updated_ts, row_status
 	`
 	var rowStatus string
@@ -25,6 +29,8 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 		create.Nickname,
 		create.PasswordHash,
 		create.Role,
+		create.Username,
+		create.EmailVerified,
 	).Scan(
 		&create.ID,
 		&create.CreatedTs,
@@ -56,6 +62,15 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	if v := update.Role; v != nil {
 		set, args = append(set, "role = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.Username; v != nil {
+		set, args = append(set, "username = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.AvatarBlobID; v != nil {
+		set, args = append(set, "avatar_blob_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.EmailVerified; v != nil {
+		set, args = append(set, "email_verified = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no fields to update")
 	}
@@ -64,7 +79,7 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		UPDATE "user"
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, created_ts, updated_ts, row_status, email, nickname, password_hash, role
+		RETURNING id, created_ts, updated_ts, row_status, email, nickname, password_hash, role, username, avatar_blob_id, email_verified
 	`
 	args = append(args, update.ID)
 	user := &store.User{}
@@ -78,6 +93,9 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		&user.Nickname,
 		&user.PasswordHash,
 		&user.Role,
+		&user.Username,
+		&user.AvatarBlobID,
+		&user.EmailVerified,
 	); err != nil {
 		return nil, err
 	}
@@ -92,11 +110,25 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.ID; v != nil {
 		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.IDList; v != nil {
+		list := []string{}
+		for _, id := range v {
+			list = append(list, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		// An empty list matches no rows.
+		list = append(list, "NULL")
+		where = append(where, fmt.Sprintf("id IN (%s)", strings.Join(list, ",")))
+	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "row_status = "+placeholder(len(args)+1)), append(args, v.String())
 	}
 	if v := find.Email; v != nil {
-		where, args = append(where, "email = "+placeholder(len(args)+1)), append(args, *v)
+		// Match the primary email or any verified secondary email.
+		where, args = append(where, "(email = "+placeholder(len(args)+1)+" OR id IN (SELECT user_id FROM user_email WHERE email = "+placeholder(len(args)+2)+" AND verified = TRUE))"), append(args, *v, *v)
+	}
+	if v := find.Username; v != nil {
+		where, args = append(where, "username = "+placeholder(len(args)+1)), append(args, *v)
 	}
 	if v := find.Nickname; v != nil {
 		where, args = append(where, "nickname = "+placeholder(len(args)+1)), append(args, *v)
@@ -114,11 +146,14 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			email,
 			nickname,
 			password_hash,
-			role
+			role,
+			username,
+			avatar_blob_id,
+			email_verified
 		FROM "user"
 		WHERE ` + strings.Join(where, " AND ") + `
-		ORDER BY updated_ts DESC, created_ts DESC
-	`
+		ORDER BY updated_ts DESC, created_ts DESC, id DESC
+	` + limitOffset(find.Limit, find.Offset)
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -138,6 +173,9 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			&user.Nickname,
 			&user.PasswordHash,
 			&user.Role,
+			&user.Username,
+			&user.AvatarBlobID,
+			&user.EmailVerified,
 		); err != nil {
 			return nil, err
 		}
@@ -153,8 +191,40 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 }
 
 func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM "user" WHERE id = $1`, delete.ID); err != nil {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if delete.HandoverUserID != nil {
+		where, args := "creator_id = $2", []any{*delete.HandoverUserID, delete.ID}
+		if delete.PersonalShortcutPrefix != "" {
+			where, args = where+" AND substr(name, 1, $3) != $4", append(args, utf8.RuneCountInString(delete.PersonalShortcutPrefix), delete.PersonalShortcutPrefix)
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE shortcut SET creator_id = $1 WHERE `+where, args...); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE shortcut_rotation SET creator_id = $1 WHERE creator_id = $2`, *delete.HandoverUserID, delete.ID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE collection SET creator_id = $1 WHERE creator_id = $2`, *delete.HandoverUserID, delete.ID); err != nil {
+			return err
+		}
+	}
+	// The shortcuts and collections left don't cascade with their creator.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM shortcut WHERE creator_id = $1`, delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection WHERE creator_id = $1`, delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM "user" WHERE id = $1`, delete.ID); err != nil {
+		return err
+	}
+	// The blobs don't reference the users, as the blobs of the bot have no user.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM blob WHERE creator_id = $1`, delete.ID); err != nil {
 		return err
 	}
-	return nil
+	return tx.Commit()
 }
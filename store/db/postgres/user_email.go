@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) CreateUserEmail(ctx context.Context, create *store.UserEmail) (*store.UserEmail, error) {
+	stmt := `
+		INSERT INTO user_email (
+			user_id,
+			email,
+			verified
+		)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt,
+		create.UserID,
+		create.Email,
+		create.Verified,
+	).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	userEmail := create
+	return userEmail, nil
+}
+
+func (d *DB) UpdateUserEmail(ctx context.Context, update *store.UpdateUserEmail) (*store.UserEmail, error) {
+	set, args := []string{}, []any{}
+	if v := update.Verified; v != nil {
+		set, args = append(set, "verified = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	if len(set) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	stmt := `
+		UPDATE user_email
+		SET ` + strings.Join(set, ", ") + `
+		WHERE id = ` + placeholder(len(args)+1) + `
+		RETURNING id, user_id, created_ts, email, verified
+	`
+	args = append(args, update.ID)
+	userEmail := &store.UserEmail{}
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&userEmail.ID,
+		&userEmail.UserID,
+		&userEmail.CreatedTs,
+		&userEmail.Email,
+		&userEmail.Verified,
+	); err != nil {
+		return nil, err
+	}
+	return userEmail, nil
+}
+
+func (d *DB) ListUserEmails(ctx context.Context, find *store.FindUserEmail) ([]*store.UserEmail, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Email; v != nil {
+		where, args = append(where, "email = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Verified; v != nil {
+		where, args = append(where, "verified = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			user_id,
+			created_ts,
+			email,
+			verified
+		FROM user_email
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts ASC, id ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.UserEmail, 0)
+	for rows.Next() {
+		userEmail := &store.UserEmail{}
+		if err := rows.Scan(
+			&userEmail.ID,
+			&userEmail.UserID,
+			&userEmail.CreatedTs,
+			&userEmail.Email,
+			&userEmail.Verified,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, userEmail)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUserEmail(ctx context.Context, delete *store.DeleteUserEmail) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM user_email WHERE id = $1`, delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) SetUserPrimaryEmail(ctx context.Context, set *store.SetUserPrimaryEmail) (*store.User, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var previousEmail, email string
+	if err := tx.QueryRowContext(ctx, `SELECT email FROM "user" WHERE id = $1`, set.UserID).Scan(&previousEmail); err != nil {
+		return nil, err
+	}
+	if err := tx.QueryRowContext(ctx, `
+		DELETE FROM user_email WHERE id = $1 AND user_id = $2 AND verified = TRUE
+		RETURNING email
+	`, set.UserEmailID, set.UserID).Scan(&email); err != nil {
+		return nil, err
+	}
+	// The previous primary email is verified, so it replaces the unverified secondary emails of the others.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_email WHERE email = $1 AND verified = FALSE`, previousEmail); err != nil {
+		return nil, err
+	}
+	user := &store.User{}
+	var rowStatus string
+	if err := tx.QueryRowContext(ctx, `
+		UPDATE "user"
+		SET email = $1
+		WHERE id = $2
+		RETURNING id, created_ts, updated_ts, row_status, email, nickname, password_hash, role, username, avatar_blob_id, email_verified
+	`, email, set.UserID).Scan(
+		&user.ID,
+		&user.CreatedTs,
+		&user.UpdatedTs,
+		&rowStatus,
+		&user.Email,
+		&user.Nickname,
+		&user.PasswordHash,
+		&user.Role,
+		&user.Username,
+		&user.AvatarBlobID,
+		&user.EmailVerified,
+	); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO user_email (user_id, email, verified)
+		VALUES ($1, $2, TRUE)
+	`, set.UserID, previousEmail); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	user.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
+	return user, nil
+}
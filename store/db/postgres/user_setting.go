@@ -35,6 +35,12 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting)
 			return nil, err
 		}
 		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_PASSKEYS {
+		valueBytes, err := protojson.Marshal(upsert.GetPasskeys())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
 	} else {
 		return nil, errors.New("invalid user setting key")
 	}
@@ -98,6 +104,14 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 			userSetting.Value = &storepb.UserSetting_General{
 				General: userSettingGeneral,
 			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_PASSKEYS {
+			userSettingPasskeys := &storepb.UserSetting_PasskeysSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), userSettingPasskeys); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_Passkeys{
+				Passkeys: userSettingPasskeys,
+			}
 		} else {
 			// Skip unknown key.
 			continue
@@ -47,6 +47,48 @@ func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Workspa
 			return nil, err
 		}
 		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GIT_SYNC {
+		valueBytes, err := protojson.Marshal(upsert.GetGitSync())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_NOT_FOUND {
+		valueBytes, err := protojson.Marshal(upsert.GetNotFound())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COLLECTION_TEMPLATE {
+		valueBytes, err := protojson.Marshal(upsert.GetCollectionTemplate())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FEDERATION {
+		valueBytes, err := protojson.Marshal(upsert.GetFederation())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_MAIL {
+		valueBytes, err := protojson.Marshal(upsert.GetMail())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LANDING {
+		valueBytes, err := protojson.Marshal(upsert.GetLanding())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COMPLETION {
+		valueBytes, err := protojson.Marshal(upsert.GetCompletion())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
 	} else {
 		return nil, errors.New("invalid workspace setting key")
 	}
@@ -122,6 +164,62 @@ func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspac
 			workspaceSetting.Value = &storepb.WorkspaceSetting_IdentityProvider{
 				IdentityProvider: workspaceSettingIdentityProvider,
 			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GIT_SYNC {
+			workspaceSettingGitSync := &storepb.WorkspaceSetting_GitSyncSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingGitSync); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_GitSync{
+				GitSync: workspaceSettingGitSync,
+			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_NOT_FOUND {
+			workspaceSettingNotFound := &storepb.WorkspaceSetting_NotFoundSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingNotFound); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_NotFound{
+				NotFound: workspaceSettingNotFound,
+			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COLLECTION_TEMPLATE {
+			workspaceSettingCollectionTemplate := &storepb.WorkspaceSetting_CollectionTemplateSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingCollectionTemplate); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_CollectionTemplate{
+				CollectionTemplate: workspaceSettingCollectionTemplate,
+			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FEDERATION {
+			workspaceSettingFederation := &storepb.WorkspaceSetting_FederationSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingFederation); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Federation{
+				Federation: workspaceSettingFederation,
+			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_MAIL {
+			workspaceSettingMail := &storepb.WorkspaceSetting_MailSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingMail); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Mail{
+				Mail: workspaceSettingMail,
+			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LANDING {
+			workspaceSettingLanding := &storepb.WorkspaceSetting_LandingSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingLanding); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Landing{
+				Landing: workspaceSettingLanding,
+			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COMPLETION {
+			workspaceSettingCompletion := &storepb.WorkspaceSetting_CompletionSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingCompletion); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Completion{
+				Completion: workspaceSettingCompletion,
+			}
 		} else if slices.Contains([]storepb.WorkspaceSettingKey{
 			storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY,
 			storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION,
@@ -0,0 +1,914 @@
+// Package shadow mirrors the writes of the primary database to a shadow database and compares their reads,
+// to validate a migration target, e.g. Postgres, against the live database before cutting over.
+//
+// The primary database stays the source of truth: the shadow writes happen after the primary ones
+// succeed, and the failures and divergences of the shadow database are only logged.
+package shadow
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+// ignoreTimestamps ignores the timestamps set by the databases on write, which may differ by a second.
+var ignoreTimestamps = cmp.Options{
+	protocmp.Transform(),
+	protocmp.IgnoreFields(&storepb.Shortcut{}, "created_ts", "updated_ts"),
+	protocmp.IgnoreFields(&storepb.Collection{}, "created_ts", "updated_ts"),
+	cmpopts.IgnoreFields(store.User{}, "CreatedTs", "UpdatedTs"),
+	cmpopts.IgnoreFields(store.Activity{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.Blob{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.UserEmail{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.IdentityProviderLink{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.ShortcutAlias{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.ShortcutACL{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.ShortcutEmbedding{}, "UpdatedTs"),
+	cmpopts.IgnoreFields(store.CollectionShare{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.ShortcutAnalyticsShare{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.UserSession{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.ShortcutProposedChange{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.ShortcutRotation{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.Team{}, "CreatedTs", "UpdatedTs"),
+	cmpopts.IgnoreFields(store.TeamMember{}, "CreatedTs"),
+	cmpopts.IgnoreFields(store.ImportJob{}, "CreatedTs", "UpdatedTs"),
+}
+
+type DB struct {
+	primary store.Driver
+	shadow  store.Driver
+}
+
+// NewDB creates a driver which mirrors the primary driver to the shadow driver.
+// The shadow database is expected to start as a copy of the primary one, so that the ids match.
+func NewDB(primary, shadow store.Driver) *DB {
+	return &DB{
+		primary: primary,
+		shadow:  shadow,
+	}
+}
+
+// compare runs the shadow call and logs its failure or the divergence of its result from the primary result.
+func compare[T any](method string, primaryResult T, shadowCall func() (T, error), opts ...cmp.Option) {
+	shadowResult, err := shadowCall()
+	if err != nil {
+		slog.Warn("shadow database failed", slog.String("method", method), slog.Any("error", err))
+		return
+	}
+	if diff := cmp.Diff(primaryResult, shadowResult, append(opts, ignoreTimestamps)...); diff != "" {
+		slog.Warn("shadow database diverged", slog.String("method", method), slog.String("diff", diff))
+	}
+}
+
+// compareError runs the shadow call and logs its failure.
+func compareError(method string, shadowCall func() error) {
+	if err := shadowCall(); err != nil {
+		slog.Warn("shadow database failed", slog.String("method", method), slog.Any("error", err))
+	}
+}
+
+func (d *DB) GetDB() *sql.DB {
+	return d.primary.GetDB()
+}
+
+func (d *DB) Close() error {
+	if err := d.shadow.Close(); err != nil {
+		slog.Warn("failed to close shadow database", slog.Any("error", err))
+	}
+	return d.primary.Close()
+}
+
+// The stats are the ones of the primary database, as the sizes of the databases differ.
+
+func (d *DB) GetInstanceStats(ctx context.Context) (*store.InstanceStats, error) {
+	return d.primary.GetInstanceStats(ctx)
+}
+
+// The migration histories aren't mirrored, as the shadow database is migrated on its own.
+
+func (d *DB) UpsertMigrationHistory(ctx context.Context, upsert *store.UpsertMigrationHistory) (*store.MigrationHistory, error) {
+	return d.primary.UpsertMigrationHistory(ctx, upsert)
+}
+
+func (d *DB) ListMigrationHistories(ctx context.Context, find *store.FindMigrationHistory) ([]*store.MigrationHistory, error) {
+	return d.primary.ListMigrationHistories(ctx, find)
+}
+
+func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store.Activity, error) {
+	shadowCreate := *create
+	activity, err := d.primary.CreateActivity(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateActivity", activity, func() (*store.Activity, error) {
+		return d.shadow.CreateActivity(ctx, &shadowCreate)
+	})
+	return activity, nil
+}
+
+func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*store.Activity, error) {
+	list, err := d.primary.ListActivities(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListActivities", list, func() ([]*store.Activity, error) {
+		return d.shadow.ListActivities(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteActivities(ctx context.Context, delete *store.DeleteActivities) error {
+	if err := d.primary.DeleteActivities(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteActivities", func() error {
+		return d.shadow.DeleteActivities(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) EnsureActivityPartition(ctx context.Context, month time.Time) error {
+	if err := d.primary.EnsureActivityPartition(ctx, month); err != nil {
+		return err
+	}
+	compareError("EnsureActivityPartition", func() error {
+		return d.shadow.EnsureActivityPartition(ctx, month)
+	})
+	return nil
+}
+
+func (d *DB) ListShortcutViewCounts(ctx context.Context, find *store.FindShortcutViewCount) ([]*store.ShortcutViewCount, error) {
+	list, err := d.primary.ListShortcutViewCounts(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListShortcutViewCounts", list, func() ([]*store.ShortcutViewCount, error) {
+		return d.shadow.ListShortcutViewCounts(ctx, find)
+	}, cmpopts.SortSlices(func(a, b *store.ShortcutViewCount) bool {
+		return a.ShortcutID < b.ShortcutID
+	}))
+	return list, nil
+}
+
+func (d *DB) ListShortcutViewGroups(ctx context.Context, find *store.FindShortcutViewGroup) ([]*store.ShortcutViewGroup, error) {
+	list, err := d.primary.ListShortcutViewGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	// The groups with the same count may be ordered differently.
+	compare("ListShortcutViewGroups", list, func() ([]*store.ShortcutViewGroup, error) {
+		return d.shadow.ListShortcutViewGroups(ctx, find)
+	}, cmpopts.SortSlices(func(a, b *store.ShortcutViewGroup) bool {
+		return a.Value < b.Value
+	}))
+	return list, nil
+}
+
+func (d *DB) ListShortcutViewBuckets(ctx context.Context, find *store.FindShortcutViewBucket) ([]*store.ShortcutViewBucket, error) {
+	list, err := d.primary.ListShortcutViewBuckets(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListShortcutViewBuckets", list, func() ([]*store.ShortcutViewBucket, error) {
+		return d.shadow.ListShortcutViewBuckets(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) CreateBlob(ctx context.Context, create *store.Blob) (*store.Blob, error) {
+	shadowCreate := *create
+	blob, err := d.primary.CreateBlob(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateBlob", blob, func() (*store.Blob, error) {
+		return d.shadow.CreateBlob(ctx, &shadowCreate)
+	})
+	return blob, nil
+}
+
+func (d *DB) ListBlobs(ctx context.Context, find *store.FindBlob) ([]*store.Blob, error) {
+	list, err := d.primary.ListBlobs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListBlobs", list, func() ([]*store.Blob, error) {
+		return d.shadow.ListBlobs(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteBlob(ctx context.Context, delete *store.DeleteBlob) error {
+	if err := d.primary.DeleteBlob(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteBlob", func() error {
+		return d.shadow.DeleteBlob(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
+	shadowCreate := proto.Clone(create).(*storepb.Collection)
+	collection, err := d.primary.CreateCollection(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateCollection", collection, func() (*storepb.Collection, error) {
+		return d.shadow.CreateCollection(ctx, shadowCreate)
+	})
+	return collection, nil
+}
+
+func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollection) (*storepb.Collection, error) {
+	collection, err := d.primary.UpdateCollection(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpdateCollection", collection, func() (*storepb.Collection, error) {
+		return d.shadow.UpdateCollection(ctx, update)
+	})
+	return collection, nil
+}
+
+func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([]*storepb.Collection, error) {
+	list, err := d.primary.ListCollections(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListCollections", list, func() ([]*storepb.Collection, error) {
+		return d.shadow.ListCollections(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollection) error {
+	if err := d.primary.DeleteCollection(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteCollection", func() error {
+		return d.shadow.DeleteCollection(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateUserSession(ctx context.Context, create *store.UserSession) (*store.UserSession, error) {
+	shadowCreate := *create
+	userSession, err := d.primary.CreateUserSession(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateUserSession", userSession, func() (*store.UserSession, error) {
+		return d.shadow.CreateUserSession(ctx, &shadowCreate)
+	})
+	return userSession, nil
+}
+
+func (d *DB) ListUserSessions(ctx context.Context, find *store.FindUserSession) ([]*store.UserSession, error) {
+	list, err := d.primary.ListUserSessions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListUserSessions", list, func() ([]*store.UserSession, error) {
+		return d.shadow.ListUserSessions(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteUserSession(ctx context.Context, delete *store.DeleteUserSession) error {
+	if err := d.primary.DeleteUserSession(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteUserSession", func() error {
+		return d.shadow.DeleteUserSession(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateCollectionShare(ctx context.Context, create *store.CollectionShare) (*store.CollectionShare, error) {
+	shadowCreate := *create
+	collectionShare, err := d.primary.CreateCollectionShare(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateCollectionShare", collectionShare, func() (*store.CollectionShare, error) {
+		return d.shadow.CreateCollectionShare(ctx, &shadowCreate)
+	})
+	return collectionShare, nil
+}
+
+func (d *DB) ListCollectionShares(ctx context.Context, find *store.FindCollectionShare) ([]*store.CollectionShare, error) {
+	list, err := d.primary.ListCollectionShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListCollectionShares", list, func() ([]*store.CollectionShare, error) {
+		return d.shadow.ListCollectionShares(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) RecordCollectionShareView(ctx context.Context, id int32, viewedTs int64) error {
+	if err := d.primary.RecordCollectionShareView(ctx, id, viewedTs); err != nil {
+		return err
+	}
+	compareError("RecordCollectionShareView", func() error {
+		return d.shadow.RecordCollectionShareView(ctx, id, viewedTs)
+	})
+	return nil
+}
+
+func (d *DB) DeleteCollectionShare(ctx context.Context, delete *store.DeleteCollectionShare) error {
+	if err := d.primary.DeleteCollectionShare(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteCollectionShare", func() error {
+		return d.shadow.DeleteCollectionShare(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateShortcutAnalyticsShare(ctx context.Context, create *store.ShortcutAnalyticsShare) (*store.ShortcutAnalyticsShare, error) {
+	shadowCreate := *create
+	shortcutAnalyticsShare, err := d.primary.CreateShortcutAnalyticsShare(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateShortcutAnalyticsShare", shortcutAnalyticsShare, func() (*store.ShortcutAnalyticsShare, error) {
+		return d.shadow.CreateShortcutAnalyticsShare(ctx, &shadowCreate)
+	})
+	return shortcutAnalyticsShare, nil
+}
+
+func (d *DB) ListShortcutAnalyticsShares(ctx context.Context, find *store.FindShortcutAnalyticsShare) ([]*store.ShortcutAnalyticsShare, error) {
+	list, err := d.primary.ListShortcutAnalyticsShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListShortcutAnalyticsShares", list, func() ([]*store.ShortcutAnalyticsShare, error) {
+		return d.shadow.ListShortcutAnalyticsShares(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) RecordShortcutAnalyticsShareView(ctx context.Context, id int32, viewedTs int64) error {
+	if err := d.primary.RecordShortcutAnalyticsShareView(ctx, id, viewedTs); err != nil {
+		return err
+	}
+	compareError("RecordShortcutAnalyticsShareView", func() error {
+		return d.shadow.RecordShortcutAnalyticsShareView(ctx, id, viewedTs)
+	})
+	return nil
+}
+
+func (d *DB) DeleteShortcutAnalyticsShare(ctx context.Context, delete *store.DeleteShortcutAnalyticsShare) error {
+	if err := d.primary.DeleteShortcutAnalyticsShare(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteShortcutAnalyticsShare", func() error {
+		return d.shadow.DeleteShortcutAnalyticsShare(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateShortcutProposedChange(ctx context.Context, create *store.ShortcutProposedChange) (*store.ShortcutProposedChange, error) {
+	shadowCreate := *create
+	shortcutProposedChange, err := d.primary.CreateShortcutProposedChange(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateShortcutProposedChange", shortcutProposedChange, func() (*store.ShortcutProposedChange, error) {
+		return d.shadow.CreateShortcutProposedChange(ctx, &shadowCreate)
+	})
+	return shortcutProposedChange, nil
+}
+
+func (d *DB) UpdateShortcutProposedChange(ctx context.Context, update *store.UpdateShortcutProposedChange) (*store.ShortcutProposedChange, error) {
+	shortcutProposedChange, err := d.primary.UpdateShortcutProposedChange(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpdateShortcutProposedChange", shortcutProposedChange, func() (*store.ShortcutProposedChange, error) {
+		return d.shadow.UpdateShortcutProposedChange(ctx, update)
+	})
+	return shortcutProposedChange, nil
+}
+
+func (d *DB) ListShortcutProposedChanges(ctx context.Context, find *store.FindShortcutProposedChange) ([]*store.ShortcutProposedChange, error) {
+	list, err := d.primary.ListShortcutProposedChanges(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListShortcutProposedChanges", list, func() ([]*store.ShortcutProposedChange, error) {
+		return d.shadow.ListShortcutProposedChanges(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) CreateShortcutRotation(ctx context.Context, create *store.ShortcutRotation) (*store.ShortcutRotation, error) {
+	shadowCreate := *create
+	shortcutRotation, err := d.primary.CreateShortcutRotation(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateShortcutRotation", shortcutRotation, func() (*store.ShortcutRotation, error) {
+		return d.shadow.CreateShortcutRotation(ctx, &shadowCreate)
+	})
+	return shortcutRotation, nil
+}
+
+func (d *DB) ListShortcutRotations(ctx context.Context, find *store.FindShortcutRotation) ([]*store.ShortcutRotation, error) {
+	list, err := d.primary.ListShortcutRotations(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListShortcutRotations", list, func() ([]*store.ShortcutRotation, error) {
+		return d.shadow.ListShortcutRotations(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteShortcutRotation(ctx context.Context, delete *store.DeleteShortcutRotation) error {
+	if err := d.primary.DeleteShortcutRotation(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteShortcutRotation", func() error {
+		return d.shadow.DeleteShortcutRotation(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateImportJob(ctx context.Context, create *store.ImportJob) (*store.ImportJob, error) {
+	shadowCreate := *create
+	importJob, err := d.primary.CreateImportJob(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateImportJob", importJob, func() (*store.ImportJob, error) {
+		return d.shadow.CreateImportJob(ctx, &shadowCreate)
+	})
+	return importJob, nil
+}
+
+func (d *DB) UpdateImportJob(ctx context.Context, update *store.UpdateImportJob) (*store.ImportJob, error) {
+	importJob, err := d.primary.UpdateImportJob(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpdateImportJob", importJob, func() (*store.ImportJob, error) {
+		return d.shadow.UpdateImportJob(ctx, update)
+	})
+	return importJob, nil
+}
+
+func (d *DB) ClaimImportJob(ctx context.Context) (*store.ImportJob, error) {
+	importJob, err := d.primary.ClaimImportJob(ctx)
+	if err != nil {
+		return nil, err
+	}
+	compare("ClaimImportJob", importJob, func() (*store.ImportJob, error) {
+		return d.shadow.ClaimImportJob(ctx)
+	})
+	return importJob, nil
+}
+
+func (d *DB) ListImportJobs(ctx context.Context, find *store.FindImportJob) ([]*store.ImportJob, error) {
+	list, err := d.primary.ListImportJobs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListImportJobs", list, func() ([]*store.ImportJob, error) {
+		return d.shadow.ListImportJobs(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteImportJob(ctx context.Context, delete *store.DeleteImportJob) error {
+	if err := d.primary.DeleteImportJob(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteImportJob", func() error {
+		return d.shadow.DeleteImportJob(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
+	shadowCreate := proto.Clone(create).(*storepb.Shortcut)
+	shortcut, err := d.primary.CreateShortcut(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateShortcut", shortcut, func() (*storepb.Shortcut, error) {
+		return d.shadow.CreateShortcut(ctx, shadowCreate)
+	})
+	return shortcut, nil
+}
+
+func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
+	shortcut, err := d.primary.UpdateShortcut(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpdateShortcut", shortcut, func() (*storepb.Shortcut, error) {
+		return d.shadow.UpdateShortcut(ctx, update)
+	})
+	return shortcut, nil
+}
+
+func (d *DB) MergeShortcuts(ctx context.Context, merge *store.MergeShortcuts) error {
+	if err := d.primary.MergeShortcuts(ctx, merge); err != nil {
+		return err
+	}
+	compareError("MergeShortcuts", func() error {
+		return d.shadow.MergeShortcuts(ctx, merge)
+	})
+	return nil
+}
+
+func (d *DB) ListShortcutAliases(ctx context.Context, find *store.FindShortcutAlias) ([]*store.ShortcutAlias, error) {
+	list, err := d.primary.ListShortcutAliases(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListShortcutAliases", list, func() ([]*store.ShortcutAlias, error) {
+		return d.shadow.ListShortcutAliases(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) UpdateShortcutAliases(ctx context.Context, update *store.UpdateShortcutAliases) error {
+	if err := d.primary.UpdateShortcutAliases(ctx, update); err != nil {
+		return err
+	}
+	compareError("UpdateShortcutAliases", func() error {
+		return d.shadow.UpdateShortcutAliases(ctx, update)
+	})
+	return nil
+}
+
+func (d *DB) UpsertShortcutACL(ctx context.Context, upsert *store.ShortcutACL) (*store.ShortcutACL, error) {
+	shadowUpsert := *upsert
+	shortcutACL, err := d.primary.UpsertShortcutACL(ctx, upsert)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpsertShortcutACL", shortcutACL, func() (*store.ShortcutACL, error) {
+		return d.shadow.UpsertShortcutACL(ctx, &shadowUpsert)
+	})
+	return shortcutACL, nil
+}
+
+func (d *DB) ListShortcutACLs(ctx context.Context, find *store.FindShortcutACL) ([]*store.ShortcutACL, error) {
+	list, err := d.primary.ListShortcutACLs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListShortcutACLs", list, func() ([]*store.ShortcutACL, error) {
+		return d.shadow.ListShortcutACLs(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteShortcutACL(ctx context.Context, delete *store.DeleteShortcutACL) error {
+	if err := d.primary.DeleteShortcutACL(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteShortcutACL", func() error {
+		return d.shadow.DeleteShortcutACL(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) UpsertShortcutEmbedding(ctx context.Context, upsert *store.ShortcutEmbedding) (*store.ShortcutEmbedding, error) {
+	shadowUpsert := *upsert
+	shortcutEmbedding, err := d.primary.UpsertShortcutEmbedding(ctx, upsert)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpsertShortcutEmbedding", shortcutEmbedding, func() (*store.ShortcutEmbedding, error) {
+		return d.shadow.UpsertShortcutEmbedding(ctx, &shadowUpsert)
+	})
+	return shortcutEmbedding, nil
+}
+
+func (d *DB) ListShortcutEmbeddings(ctx context.Context, find *store.FindShortcutEmbedding) ([]*store.ShortcutEmbedding, error) {
+	list, err := d.primary.ListShortcutEmbeddings(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListShortcutEmbeddings", list, func() ([]*store.ShortcutEmbedding, error) {
+		return d.shadow.ListShortcutEmbeddings(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteShortcutEmbedding(ctx context.Context, delete *store.DeleteShortcutEmbedding) error {
+	if err := d.primary.DeleteShortcutEmbedding(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteShortcutEmbedding", func() error {
+		return d.shadow.DeleteShortcutEmbedding(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) UpdateShortcutTags(ctx context.Context, update *store.UpdateShortcutTags) error {
+	if err := d.primary.UpdateShortcutTags(ctx, update); err != nil {
+		return err
+	}
+	compareError("UpdateShortcutTags", func() error {
+		return d.shadow.UpdateShortcutTags(ctx, update)
+	})
+	return nil
+}
+
+func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*storepb.Shortcut, error) {
+	list, err := d.primary.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	opts := []cmp.Option{}
+	// The databases rank the search results differently.
+	if find.Search != nil {
+		opts = append(opts, cmpopts.SortSlices(func(a, b *storepb.Shortcut) bool {
+			return a.Id < b.Id
+		}))
+	}
+	compare("ListShortcuts", list, func() ([]*storepb.Shortcut, error) {
+		return d.shadow.ListShortcuts(ctx, find)
+	}, opts...)
+	return list, nil
+}
+
+func (d *DB) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) error {
+	if err := d.primary.DeleteShortcut(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteShortcut", func() error {
+		return d.shadow.DeleteShortcut(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateTeam(ctx context.Context, create *store.Team) (*store.Team, error) {
+	shadowCreate := *create
+	team, err := d.primary.CreateTeam(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateTeam", team, func() (*store.Team, error) {
+		return d.shadow.CreateTeam(ctx, &shadowCreate)
+	})
+	return team, nil
+}
+
+func (d *DB) UpdateTeam(ctx context.Context, update *store.UpdateTeam) (*store.Team, error) {
+	team, err := d.primary.UpdateTeam(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpdateTeam", team, func() (*store.Team, error) {
+		return d.shadow.UpdateTeam(ctx, update)
+	})
+	return team, nil
+}
+
+func (d *DB) ListTeams(ctx context.Context, find *store.FindTeam) ([]*store.Team, error) {
+	list, err := d.primary.ListTeams(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListTeams", list, func() ([]*store.Team, error) {
+		return d.shadow.ListTeams(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteTeam(ctx context.Context, delete *store.DeleteTeam) error {
+	if err := d.primary.DeleteTeam(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteTeam", func() error {
+		return d.shadow.DeleteTeam(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateTeamMember(ctx context.Context, create *store.TeamMember) (*store.TeamMember, error) {
+	shadowCreate := *create
+	teamMember, err := d.primary.CreateTeamMember(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateTeamMember", teamMember, func() (*store.TeamMember, error) {
+		return d.shadow.CreateTeamMember(ctx, &shadowCreate)
+	})
+	return teamMember, nil
+}
+
+func (d *DB) ListTeamMembers(ctx context.Context, find *store.FindTeamMember) ([]*store.TeamMember, error) {
+	list, err := d.primary.ListTeamMembers(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListTeamMembers", list, func() ([]*store.TeamMember, error) {
+		return d.shadow.ListTeamMembers(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteTeamMember(ctx context.Context, delete *store.DeleteTeamMember) error {
+	if err := d.primary.DeleteTeamMember(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteTeamMember", func() error {
+		return d.shadow.DeleteTeamMember(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, error) {
+	shadowCreate := *create
+	user, err := d.primary.CreateUser(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateUser", user, func() (*store.User, error) {
+		return d.shadow.CreateUser(ctx, &shadowCreate)
+	})
+	return user, nil
+}
+
+func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.User, error) {
+	user, err := d.primary.UpdateUser(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpdateUser", user, func() (*store.User, error) {
+		return d.shadow.UpdateUser(ctx, update)
+	})
+	return user, nil
+}
+
+func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User, error) {
+	list, err := d.primary.ListUsers(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListUsers", list, func() ([]*store.User, error) {
+		return d.shadow.ListUsers(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
+	if err := d.primary.DeleteUser(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteUser", func() error {
+		return d.shadow.DeleteUser(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) CreateUserEmail(ctx context.Context, create *store.UserEmail) (*store.UserEmail, error) {
+	shadowCreate := *create
+	userEmail, err := d.primary.CreateUserEmail(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	compare("CreateUserEmail", userEmail, func() (*store.UserEmail, error) {
+		return d.shadow.CreateUserEmail(ctx, &shadowCreate)
+	})
+	return userEmail, nil
+}
+
+func (d *DB) UpdateUserEmail(ctx context.Context, update *store.UpdateUserEmail) (*store.UserEmail, error) {
+	userEmail, err := d.primary.UpdateUserEmail(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpdateUserEmail", userEmail, func() (*store.UserEmail, error) {
+		return d.shadow.UpdateUserEmail(ctx, update)
+	})
+	return userEmail, nil
+}
+
+func (d *DB) ListUserEmails(ctx context.Context, find *store.FindUserEmail) ([]*store.UserEmail, error) {
+	list, err := d.primary.ListUserEmails(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListUserEmails", list, func() ([]*store.UserEmail, error) {
+		return d.shadow.ListUserEmails(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteUserEmail(ctx context.Context, delete *store.DeleteUserEmail) error {
+	if err := d.primary.DeleteUserEmail(ctx, delete); err != nil {
+		return err
+	}
+	compareError("DeleteUserEmail", func() error {
+		return d.shadow.DeleteUserEmail(ctx, delete)
+	})
+	return nil
+}
+
+func (d *DB) SetUserPrimaryEmail(ctx context.Context, set *store.SetUserPrimaryEmail) (*store.User, error) {
+	user, err := d.primary.SetUserPrimaryEmail(ctx, set)
+	if err != nil {
+		return nil, err
+	}
+	compare("SetUserPrimaryEmail", user, func() (*store.User, error) {
+		return d.shadow.SetUserPrimaryEmail(ctx, set)
+	})
+	return user, nil
+}
+
+func (d *DB) UpsertIdentityProviderLink(ctx context.Context, upsert *store.IdentityProviderLink) (*store.IdentityProviderLink, error) {
+	shadowUpsert := *upsert
+	identityProviderLink, err := d.primary.UpsertIdentityProviderLink(ctx, upsert)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpsertIdentityProviderLink", identityProviderLink, func() (*store.IdentityProviderLink, error) {
+		return d.shadow.UpsertIdentityProviderLink(ctx, &shadowUpsert)
+	})
+	return identityProviderLink, nil
+}
+
+func (d *DB) ListIdentityProviderLinks(ctx context.Context, find *store.FindIdentityProviderLink) ([]*store.IdentityProviderLink, error) {
+	list, err := d.primary.ListIdentityProviderLinks(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListIdentityProviderLinks", list, func() ([]*store.IdentityProviderLink, error) {
+		return d.shadow.ListIdentityProviderLinks(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error) {
+	userSetting, err := d.primary.UpsertUserSetting(ctx, upsert)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpsertUserSetting", userSetting, func() (*storepb.UserSetting, error) {
+		return d.shadow.UpsertUserSetting(ctx, upsert)
+	})
+	return userSetting, nil
+}
+
+func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting) ([]*storepb.UserSetting, error) {
+	list, err := d.primary.ListUserSettings(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListUserSettings", list, func() ([]*storepb.UserSetting, error) {
+		return d.shadow.ListUserSettings(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.WorkspaceSetting) (*storepb.WorkspaceSetting, error) {
+	workspaceSetting, err := d.primary.UpsertWorkspaceSetting(ctx, upsert)
+	if err != nil {
+		return nil, err
+	}
+	compare("UpsertWorkspaceSetting", workspaceSetting, func() (*storepb.WorkspaceSetting, error) {
+		return d.shadow.UpsertWorkspaceSetting(ctx, upsert)
+	})
+	return workspaceSetting, nil
+}
+
+func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspaceSetting) ([]*storepb.WorkspaceSetting, error) {
+	list, err := d.primary.ListWorkspaceSettings(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	compare("ListWorkspaceSettings", list, func() ([]*storepb.WorkspaceSetting, error) {
+		return d.shadow.ListWorkspaceSettings(ctx, find)
+	})
+	return list, nil
+}
+
+func (d *DB) DeleteWorkspaceSetting(ctx context.Context, key storepb.WorkspaceSettingKey) error {
+	if err := d.primary.DeleteWorkspaceSetting(ctx, key); err != nil {
+		return err
+	}
+	compareError("DeleteWorkspaceSetting", func() error {
+		return d.shadow.DeleteWorkspaceSetting(ctx, key)
+	})
+	return nil
+}
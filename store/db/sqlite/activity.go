@@ -3,27 +3,27 @@ package sqlite
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/warthurton/slash/store"
 )
 
 func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store.Activity, error) {
+	set := []string{"creator_id", "type", "level", "payload"}
+	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), create.Payload}
+	placeholder := []string{"?", "?", "?", "?"}
+	// The time is kept when given, e.g. for the activities replayed from the write-ahead log.
+	if create.CreatedTs != 0 {
+		set, args, placeholder = append(set, "created_ts"), append(args, create.CreatedTs), append(placeholder, "?")
+	}
 	stmt := `
 		INSERT INTO activity (
-			creator_id,
-			type,
-			level,
-			payload
+			` + strings.Join(set, ", ") + `
 		)
-		VALUES (?, ?, ?, ?)
+		VALUES (` + strings.Join(placeholder, ", ") + `)
 		RETURNING id, created_ts
 	`
-	if err := d.db.QueryRowContext(ctx, stmt,
-		create.CreatorID,
-		create.Type.String(),
-		create.Level.String(),
-		create.Payload,
-	).Scan(
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 	); err != nil {
@@ -48,6 +48,9 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	if find.CreatedTsAfter != nil {
 		where, args = append(where, "created_ts > ?"), append(args, *find.CreatedTsAfter)
 	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts <= ?"), append(args, *find.CreatedTsBefore)
+	}
 
 	query := `
 		SELECT
@@ -58,7 +61,8 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 			level,
 			payload
 		FROM activity
-		WHERE ` + strings.Join(where, " AND ")
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY id ASC` + limitOffset(find.Limit, nil)
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -88,3 +92,147 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 
 	return list, nil
 }
+
+func (d *DB) DeleteActivities(ctx context.Context, delete *store.DeleteActivities) error {
+	where, args := []string{"type = ?"}, []any{delete.Type.String()}
+	if delete.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts > ?"), append(args, *delete.CreatedTsAfter)
+	}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts <= ?"), append(args, *delete.CreatedTsBefore)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM activity WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
+
+// EnsureActivityPartition does nothing, as SQLite doesn't support partitioning.
+func (*DB) EnsureActivityPartition(context.Context, time.Time) error {
+	return nil
+}
+
+func (d *DB) ListShortcutViewCounts(ctx context.Context, find *store.FindShortcutViewCount) ([]*store.ShortcutViewCount, error) {
+	where, args := []string{"type = ?"}, []any{store.ActivityShortcutView.String()}
+	if find.ShortcutID != nil {
+		where, args = append(where, "json_extract(payload, '$.shortcutId') = ?"), append(args, *find.ShortcutID)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts > ?"), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts <= ?"), append(args, *find.CreatedTsBefore)
+	}
+
+	query := `
+		SELECT
+			json_extract(payload, '$.shortcutId') AS shortcut_id,
+			COUNT(*)
+		FROM activity
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY shortcut_id
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewCount{}
+	for rows.Next() {
+		viewCount := &store.ShortcutViewCount{}
+		if err := rows.Scan(
+			&viewCount.ShortcutID,
+			&viewCount.Count,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, viewCount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) ListShortcutViewGroups(ctx context.Context, find *store.FindShortcutViewGroup) ([]*store.ShortcutViewGroup, error) {
+	where, args := []string{"type = ?", "json_extract(payload, '$.shortcutId') = ?"}, []any{store.ActivityShortcutView.String(), find.ShortcutID}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts > ?"), append(args, *find.CreatedTsAfter)
+	}
+
+	// The field is validated by the store, so it's safe to be used in the json path.
+	query := `
+		SELECT
+			COALESCE(json_extract(payload, '$.` + string(find.Field) + `'), '') AS value,
+			COUNT(*) AS count
+		FROM activity
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY value
+		ORDER BY count DESC, value ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewGroup{}
+	for rows.Next() {
+		group := &store.ShortcutViewGroup{}
+		if err := rows.Scan(
+			&group.Value,
+			&group.Count,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) ListShortcutViewBuckets(ctx context.Context, find *store.FindShortcutViewBucket) ([]*store.ShortcutViewBucket, error) {
+	where, args := []string{"type = ?", "json_extract(payload, '$.shortcutId') = ?"}, []any{store.ActivityShortcutView.String(), find.ShortcutID}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts > ?"), append(args, *find.CreatedTsAfter)
+	}
+
+	query := `
+		SELECT
+			((created_ts - ?) / ?) * ? + ? AS start_ts,
+			COUNT(*)
+		FROM activity
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY start_ts
+		ORDER BY start_ts ASC
+	`
+	args = append([]any{find.BucketOffset, find.BucketSize, find.BucketSize, find.BucketOffset}, args...)
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewBucket{}
+	for rows.Next() {
+		bucket := &store.ShortcutViewBucket{}
+		if err := rows.Scan(
+			&bucket.StartTs,
+			&bucket.Count,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
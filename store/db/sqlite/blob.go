@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) CreateBlob(ctx context.Context, create *store.Blob) (*store.Blob, error) {
+	stmt := `
+		INSERT INTO blob (
+			creator_id,
+			type,
+			size,
+			blob
+		)
+		VALUES (?, ?, ?, ?)
+		RETURNING id, created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt,
+		create.CreatorID,
+		create.Type,
+		create.Size,
+		create.Blob,
+	).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	blob := create
+	return blob, nil
+}
+
+func (d *DB) ListBlobs(ctx context.Context, find *store.FindBlob) ([]*store.Blob, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = ?"), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			type,
+			size,
+			blob
+		FROM blob
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.Blob, 0)
+	for rows.Next() {
+		blob := &store.Blob{}
+		if err := rows.Scan(
+			&blob.ID,
+			&blob.CreatorID,
+			&blob.CreatedTs,
+			&blob.Type,
+			&blob.Size,
+			&blob.Blob,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, blob)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteBlob(ctx context.Context, delete *store.DeleteBlob) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM blob WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func vacuumBlob(ctx context.Context, tx *sql.Tx) error {
+	// Keep the blobs of the bot, e.g. the activity archives.
+	stmt := `DELETE FROM blob WHERE creator_id != 0 AND creator_id NOT IN (SELECT id FROM user)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
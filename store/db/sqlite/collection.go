@@ -14,30 +14,39 @@ import (
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String()}
-	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "team_id"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String(), create.TeamId}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
 
 	stmt := `
 		INSERT INTO collection (
 			` + strings.Join(set, ", ") + `
 		)
 		VALUES (` + strings.Join(placeholder, ",") + `)
-		RETURNING id, created_ts, updated_ts
+		RETURNING id, created_ts, updated_ts, row_status
 	`
+	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.Id,
 		&create.CreatedTs,
 		&create.UpdatedTs,
+		&rowStatus,
 	); err != nil {
 		return nil, err
 	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	collection := create
 	return collection, nil
 }
 
 func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollection) (*storepb.Collection, error) {
 	set, args := []string{}, []any{}
+	if update.CreatorID != nil {
+		set, args = append(set, "creator_id = ?"), append(args, *update.CreatorID)
+	}
+	if update.RowStatus != nil {
+		set, args = append(set, "row_status = ?"), append(args, update.RowStatus.String())
+	}
 	if update.Name != nil {
 		set, args = append(set, "name = ?"), append(args, *update.Name)
 	}
@@ -53,6 +62,9 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
 	}
+	if update.TeamID != nil {
+		set, args = append(set, "team_id = ?"), append(args, *update.TeamID)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -64,20 +76,22 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 			` + strings.Join(set, ", ") + `
 		WHERE
 			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility
+		RETURNING id, creator_id, created_ts, updated_ts, row_status, name, title, description, shortcut_ids, visibility, team_id
 	`
 	collection := &storepb.Collection{}
-	var shortcutIDs, visibility string
+	var rowStatus, shortcutIDs, visibility string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&collection.Id,
 		&collection.CreatorId,
 		&collection.CreatedTs,
 		&collection.UpdatedTs,
+		&rowStatus,
 		&collection.Name,
 		&collection.Title,
 		&collection.Description,
 		&shortcutIDs,
 		&visibility,
+		&collection.TeamId,
 	); err != nil {
 		return nil, err
 	}
@@ -92,6 +106,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 			collection.ShortcutIds = append(collection.ShortcutIds, shortcutID)
 		}
 	}
+	collection.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	collection.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 	return collection, nil
 }
@@ -115,6 +130,13 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 		}
 		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, v.String())
+	}
+	if v := find.ViewerID; v != nil {
+		where = append(where, "(visibility NOT IN ('TEAM', 'PRIVATE') OR creator_id = ? OR (visibility = 'TEAM' AND team_id IN (SELECT team_id FROM team_member WHERE user_id = ?)))")
+		args = append(args, *v, *v)
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -122,14 +144,16 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			creator_id,
 			created_ts,
 			updated_ts,
+			row_status,
 			name,
 			title,
 			description,
 			shortcut_ids,
-			visibility
+			visibility,
+			team_id
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
-		ORDER BY created_ts DESC`,
+		ORDER BY created_ts DESC, id DESC`+limitOffset(find.Limit, find.Offset),
 		args...,
 	)
 	if err != nil {
@@ -140,17 +164,19 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	list := make([]*storepb.Collection, 0)
 	for rows.Next() {
 		collection := &storepb.Collection{}
-		var shortcutIDs, visibility string
+		var rowStatus, shortcutIDs, visibility string
 		if err := rows.Scan(
 			&collection.Id,
 			&collection.CreatorId,
 			&collection.CreatedTs,
 			&collection.UpdatedTs,
+			&rowStatus,
 			&collection.Name,
 			&collection.Title,
 			&collection.Description,
 			&shortcutIDs,
 			&visibility,
+			&collection.TeamId,
 		); err != nil {
 			return nil, err
 		}
@@ -165,6 +191,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 				collection.ShortcutIds = append(collection.ShortcutIds, shortcutID)
 			}
 		}
+		collection.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 		collection.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 		list = append(list, collection)
 	}
@@ -176,11 +203,20 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 }
 
 func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollection) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection WHERE id = ?`, delete.ID); err != nil {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	return nil
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+	if err := vacuumCollectionShare(ctx, tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func vacuumCollection(ctx context.Context, tx *sql.Tx) error {
@@ -0,0 +1,119 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) CreateCollectionShare(ctx context.Context, create *store.CollectionShare) (*store.CollectionShare, error) {
+	stmt := `
+		INSERT INTO collection_share (
+			collection_id,
+			creator_id,
+			email,
+			token,
+			expire_ts
+		)
+		VALUES (?, ?, ?, ?, ?)
+		RETURNING id, created_ts, view_count, last_viewed_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, create.CollectionID, create.CreatorID, create.Email, create.Token, create.ExpireTs).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.ViewCount,
+		&create.LastViewedTs,
+	); err != nil {
+		return nil, err
+	}
+	collectionShare := create
+	return collectionShare, nil
+}
+
+func (d *DB) ListCollectionShares(ctx context.Context, find *store.FindCollectionShare) ([]*store.CollectionShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.CollectionID; v != nil {
+		where, args = append(where, "collection_id = ?"), append(args, *v)
+	}
+	if v := find.Token; v != nil {
+		where, args = append(where, "token = ?"), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			collection_id,
+			creator_id,
+			created_ts,
+			email,
+			token,
+			expire_ts,
+			view_count,
+			last_viewed_ts
+		FROM collection_share
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.CollectionShare{}
+	for rows.Next() {
+		collectionShare := &store.CollectionShare{}
+		if err := rows.Scan(
+			&collectionShare.ID,
+			&collectionShare.CollectionID,
+			&collectionShare.CreatorID,
+			&collectionShare.CreatedTs,
+			&collectionShare.Email,
+			&collectionShare.Token,
+			&collectionShare.ExpireTs,
+			&collectionShare.ViewCount,
+			&collectionShare.LastViewedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, collectionShare)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) RecordCollectionShareView(ctx context.Context, id int32, viewedTs int64) error {
+	stmt := `UPDATE collection_share SET view_count = view_count + 1, last_viewed_ts = ? WHERE id = ?`
+	if _, err := d.db.ExecContext(ctx, stmt, viewedTs, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DB) DeleteCollectionShare(ctx context.Context, delete *store.DeleteCollectionShare) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection_share WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func vacuumCollectionShare(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM collection_share WHERE collection_id NOT IN (SELECT id FROM collection) OR creator_id NOT IN (SELECT id FROM user)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
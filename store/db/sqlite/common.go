@@ -1,9 +1,29 @@
 package sqlite
 
-import "google.golang.org/protobuf/encoding/protojson"
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
 
 var (
 	protojsonUnmarshaler = protojson.UnmarshalOptions{
 		DiscardUnknown: true,
 	}
 )
+
+// limitOffset returns the LIMIT/OFFSET clause of the pagination, or an empty string without pagination.
+func limitOffset(limit, offset *int) string {
+	clause := ""
+	if limit != nil {
+		clause += fmt.Sprintf(" LIMIT %d", *limit)
+	}
+	if offset != nil {
+		// SQLite requires a LIMIT before OFFSET, and -1 means no limit.
+		if limit == nil {
+			clause += " LIMIT -1"
+		}
+		clause += fmt.Sprintf(" OFFSET %d", *offset)
+	}
+	return clause
+}
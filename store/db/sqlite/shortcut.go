@@ -14,9 +14,9 @@ import (
 )
 
 func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
-	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag"}
-	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " ")}
-	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag", "expire_ts", "activate_ts", "protected", "team_id", "redirect_code", "document_mode", "internal_description"}
+	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " "), create.ExpireTs, create.ActivateTs, create.Protected, create.TeamId, create.RedirectCode, create.DocumentMode.String(), create.InternalDescription}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
 	if create.OgMetadata != nil {
 		set = append(set, "og_metadata")
 		openGraphMetadataBytes, err := protojson.Marshal(create.OgMetadata)
@@ -26,27 +26,72 @@ func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*sto
 		args = append(args, string(openGraphMetadataBytes))
 		placeholder = append(placeholder, "?")
 	}
+	if create.ClickGoal != nil {
+		set = append(set, "click_goal")
+		clickGoalBytes, err := protojson.Marshal(create.ClickGoal)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(clickGoalBytes))
+		placeholder = append(placeholder, "?")
+	}
+	if create.LinkHealth != nil {
+		set = append(set, "link_health")
+		linkHealthBytes, err := protojson.Marshal(create.LinkHealth)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(linkHealthBytes))
+		placeholder = append(placeholder, "?")
+	}
+	if create.RedirectRules != nil {
+		set = append(set, "redirect_rules")
+		redirectRulesBytes, err := protojson.Marshal(create.RedirectRules)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(redirectRulesBytes))
+		placeholder = append(placeholder, "?")
+	}
+	if create.Variants != nil {
+		set = append(set, "variants")
+		variantsBytes, err := protojson.Marshal(create.Variants)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(variantsBytes))
+		placeholder = append(placeholder, "?")
+	}
 
 	stmt := `
 		INSERT INTO shortcut (
 			` + strings.Join(set, ", ") + `
 		)
 		VALUES (` + strings.Join(placeholder, ",") + `)
-		RETURNING id, created_ts, updated_ts
+		RETURNING id, created_ts, updated_ts, row_status
 	`
+	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.Id,
 		&create.CreatedTs,
 		&create.UpdatedTs,
+		&rowStatus,
 	); err != nil {
 		return nil, err
 	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut := create
 	return shortcut, nil
 }
 
 func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
 	set, args := []string{}, []any{}
+	if update.CreatorID != nil {
+		set, args = append(set, "creator_id = ?"), append(args, *update.CreatorID)
+	}
+	if update.RowStatus != nil {
+		set, args = append(set, "row_status = ?"), append(args, update.RowStatus.String())
+	}
 	if update.Name != nil {
 		set, args = append(set, "name = ?"), append(args, *update.Name)
 	}
@@ -72,6 +117,55 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		}
 		set, args = append(set, "og_metadata = ?"), append(args, string(openGraphMetadataBytes))
 	}
+	if update.ClickGoal != nil {
+		clickGoalBytes, err := protojson.Marshal(update.ClickGoal)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal click goal")
+		}
+		set, args = append(set, "click_goal = ?"), append(args, string(clickGoalBytes))
+	}
+	if update.ExpireTs != nil {
+		set, args = append(set, "expire_ts = ?"), append(args, *update.ExpireTs)
+	}
+	if update.ActivateTs != nil {
+		set, args = append(set, "activate_ts = ?"), append(args, *update.ActivateTs)
+	}
+	if update.Protected != nil {
+		set, args = append(set, "protected = ?"), append(args, *update.Protected)
+	}
+	if update.TeamID != nil {
+		set, args = append(set, "team_id = ?"), append(args, *update.TeamID)
+	}
+	if update.RedirectCode != nil {
+		set, args = append(set, "redirect_code = ?"), append(args, *update.RedirectCode)
+	}
+	if update.DocumentMode != nil {
+		set, args = append(set, "document_mode = ?"), append(args, update.DocumentMode.String())
+	}
+	if update.InternalDescription != nil {
+		set, args = append(set, "internal_description = ?"), append(args, *update.InternalDescription)
+	}
+	if update.LinkHealth != nil {
+		linkHealthBytes, err := protojson.Marshal(update.LinkHealth)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal link health")
+		}
+		set, args = append(set, "link_health = ?"), append(args, string(linkHealthBytes))
+	}
+	if update.RedirectRules != nil {
+		redirectRulesBytes, err := protojson.Marshal(update.RedirectRules)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal redirect rules")
+		}
+		set, args = append(set, "redirect_rules = ?"), append(args, string(redirectRulesBytes))
+	}
+	if update.Variants != nil {
+		variantsBytes, err := protojson.Marshal(update.Variants)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal variants")
+		}
+		set, args = append(set, "variants = ?"), append(args, string(variantsBytes))
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -83,15 +177,16 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 			` + strings.Join(set, ", ") + `
 		WHERE
 			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, link, title, description, visibility, tag, og_metadata
+		RETURNING id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, click_goal, expire_ts, activate_ts, protected, team_id, redirect_code, link_health, document_mode, internal_description, redirect_rules, variants
 	`
 	shortcut := &storepb.Shortcut{}
-	var visibility, tags, openGraphMetadataString string
+	var rowStatus, visibility, tags, openGraphMetadataString, clickGoalString, linkHealthString, documentMode, redirectRulesString, variantsString string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&shortcut.Id,
 		&shortcut.CreatorId,
 		&shortcut.CreatedTs,
 		&shortcut.UpdatedTs,
+		&rowStatus,
 		&shortcut.Name,
 		&shortcut.Link,
 		&shortcut.Title,
@@ -99,24 +194,87 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		&visibility,
 		&tags,
 		&openGraphMetadataString,
+		&clickGoalString,
+		&shortcut.ExpireTs,
+		&shortcut.ActivateTs,
+		&shortcut.Protected,
+		&shortcut.TeamId,
+		&shortcut.RedirectCode,
+		&linkHealthString,
+		&documentMode,
+		&shortcut.InternalDescription,
+		&redirectRulesString,
+		&variantsString,
 	); err != nil {
 		return nil, err
 	}
+	shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+	shortcut.DocumentMode = storepb.DocumentMode(storepb.DocumentMode_value[documentMode])
 	shortcut.Tags = filterTags(strings.Split(tags, " "))
 	var ogMetadata storepb.OpenGraphMetadata
 	if err := protojson.Unmarshal([]byte(openGraphMetadataString), &ogMetadata); err != nil {
 		return nil, err
 	}
 	shortcut.OgMetadata = &ogMetadata
+	var clickGoal storepb.ClickGoal
+	if err := protojson.Unmarshal([]byte(clickGoalString), &clickGoal); err != nil {
+		return nil, err
+	}
+	shortcut.ClickGoal = &clickGoal
+	var linkHealth storepb.LinkHealth
+	if err := protojson.Unmarshal([]byte(linkHealthString), &linkHealth); err != nil {
+		return nil, err
+	}
+	shortcut.LinkHealth = &linkHealth
+	var redirectRules storepb.RedirectRules
+	if err := protojson.Unmarshal([]byte(redirectRulesString), &redirectRules); err != nil {
+		return nil, err
+	}
+	shortcut.RedirectRules = &redirectRules
+	var variants storepb.Variants
+	if err := protojson.Unmarshal([]byte(variantsString), &variants); err != nil {
+		return nil, err
+	}
+	shortcut.Variants = &variants
 	return shortcut, nil
 }
 
+func (d *DB) UpdateShortcutTags(ctx context.Context, update *store.UpdateShortcutTags) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `UPDATE shortcut SET tag = ? WHERE id = ?`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for id, tags := range update.Tags {
+		if _, err := stmt.ExecContext(ctx, strings.Join(tags, " "), id); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*storepb.Shortcut, error) {
 	where, args := []string{"1 = 1"}, []any{}
 	if v := find.ID; v != nil {
 		where, args = append(where, "id = ?"), append(args, *v)
 	}
+	if v := find.IDList; v != nil {
+		list := []string{}
+		for _, id := range v {
+			list = append(list, "?")
+			args = append(args, id)
+		}
+		// An empty list matches no rows.
+		list = append(list, "NULL")
+		where = append(where, fmt.Sprintf("id IN (%s)", strings.Join(list, ",")))
+	}
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "creator_id = ?"), append(args, *v)
 	}
@@ -126,7 +284,7 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
-			list = append(list, fmt.Sprintf("$%d", len(args)+1))
+			list = append(list, "?")
 			args = append(args, visibility.String())
 		}
 		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
@@ -134,6 +292,31 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	if v := find.Tag; v != nil {
 		where, args = append(where, "tag LIKE ?"), append(args, "%"+*v+"%")
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, v.String())
+	}
+	if v := find.ExpireTsBefore; v != nil {
+		where, args = append(where, "expire_ts > 0 AND expire_ts <= ?"), append(args, *v)
+	}
+	if v := find.ViewerID; v != nil {
+		where = append(where, `(visibility NOT IN ('TEAM', 'PRIVATE') OR creator_id = ?
+			OR (visibility = 'TEAM' AND team_id IN (SELECT team_id FROM team_member WHERE user_id = ?))
+			OR id IN (SELECT shortcut_id FROM shortcut_acl WHERE user_id = ?))`)
+		args = append(args, *v, *v, *v)
+	}
+	from, orderBy := "shortcut", "created_ts DESC, id DESC"
+	if v := find.Search; v != nil {
+		if terms := store.SplitSearchTerms(*v); len(terms) > 0 {
+			match := []string{}
+			for _, term := range terms {
+				match = append(match, fmt.Sprintf(`"%s"*`, term))
+			}
+			from = "shortcut JOIN (SELECT rowid AS search_id, rank AS search_rank FROM shortcut_fts WHERE shortcut_fts MATCH ?) ON search_id = id"
+			orderBy = "search_rank, " + orderBy
+			// The placeholder of the search precedes the ones of the where clause.
+			args = append([]any{strings.Join(match, " ")}, args...)
+		}
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -141,16 +324,28 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			creator_id,
 			created_ts,
 			updated_ts,
+			row_status,
 			name,
 			link,
 			title,
 			description,
 			visibility,
 			tag,
-			og_metadata
-		FROM shortcut
+			og_metadata,
+			click_goal,
+			expire_ts,
+			activate_ts,
+			protected,
+			team_id,
+			redirect_code,
+			link_health,
+			document_mode,
+			internal_description,
+			redirect_rules,
+			variants
+		FROM `+from+`
 		WHERE `+strings.Join(where, " AND ")+`
-		ORDER BY created_ts DESC`,
+		ORDER BY `+orderBy+limitOffset(find.Limit, find.Offset),
 		args...,
 	)
 	if err != nil {
@@ -161,12 +356,13 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	list := make([]*storepb.Shortcut, 0)
 	for rows.Next() {
 		shortcut := &storepb.Shortcut{}
-		var visibility, tags, openGraphMetadataString string
+		var rowStatus, visibility, tags, openGraphMetadataString, clickGoalString, linkHealthString, documentMode, redirectRulesString, variantsString string
 		if err := rows.Scan(
 			&shortcut.Id,
 			&shortcut.CreatorId,
 			&shortcut.CreatedTs,
 			&shortcut.UpdatedTs,
+			&rowStatus,
 			&shortcut.Name,
 			&shortcut.Link,
 			&shortcut.Title,
@@ -174,16 +370,49 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			&visibility,
 			&tags,
 			&openGraphMetadataString,
+			&clickGoalString,
+			&shortcut.ExpireTs,
+			&shortcut.ActivateTs,
+			&shortcut.Protected,
+			&shortcut.TeamId,
+			&shortcut.RedirectCode,
+			&linkHealthString,
+			&documentMode,
+			&shortcut.InternalDescription,
+			&redirectRulesString,
+			&variantsString,
 		); err != nil {
 			return nil, err
 		}
+		shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 		shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+		shortcut.DocumentMode = storepb.DocumentMode(storepb.DocumentMode_value[documentMode])
 		shortcut.Tags = filterTags(strings.Split(tags, " "))
 		var ogMetadata storepb.OpenGraphMetadata
 		if err := protojson.Unmarshal([]byte(openGraphMetadataString), &ogMetadata); err != nil {
 			return nil, err
 		}
 		shortcut.OgMetadata = &ogMetadata
+		var clickGoal storepb.ClickGoal
+		if err := protojson.Unmarshal([]byte(clickGoalString), &clickGoal); err != nil {
+			return nil, err
+		}
+		shortcut.ClickGoal = &clickGoal
+		var linkHealth storepb.LinkHealth
+		if err := protojson.Unmarshal([]byte(linkHealthString), &linkHealth); err != nil {
+			return nil, err
+		}
+		shortcut.LinkHealth = &linkHealth
+		var redirectRules storepb.RedirectRules
+		if err := protojson.Unmarshal([]byte(redirectRulesString), &redirectRules); err != nil {
+			return nil, err
+		}
+		shortcut.RedirectRules = &redirectRules
+		var variants storepb.Variants
+		if err := protojson.Unmarshal([]byte(variantsString), &variants); err != nil {
+			return nil, err
+		}
+		shortcut.Variants = &variants
 		list = append(list, shortcut)
 	}
 
@@ -194,11 +423,82 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 }
 
 func (d *DB) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ?`, delete.ID); err != nil {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	return nil
+	if _, err := tx.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+	if err := vacuumShortcutAlias(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutAnalyticsShare(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutProposedChange(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutRotation(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutACL(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutEmbedding(ctx, tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (d *DB) MergeShortcuts(ctx context.Context, merge *store.MergeShortcuts) error {
+	ids, list := []any{}, []string{}
+	for _, id := range merge.ShortcutIDs {
+		ids, list = append(ids, id), append(list, "?")
+	}
+	in := strings.Join(list, ",")
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmts := []struct {
+		query string
+		args  []any
+	}{
+		// A merged name may still be the alias of another shortcut, as the shortcuts take precedence over the aliases.
+		{`DELETE FROM shortcut_alias WHERE name IN (SELECT name FROM shortcut WHERE id IN (` + in + `))`, ids},
+		{`UPDATE shortcut_alias SET shortcut_id = ? WHERE shortcut_id IN (` + in + `)`, append([]any{merge.SurvivorID}, ids...)},
+		{`INSERT INTO shortcut_alias (name, shortcut_id) SELECT name, ? FROM shortcut WHERE id IN (` + in + `)`, append([]any{merge.SurvivorID}, ids...)},
+		{`UPDATE activity SET payload = json_set(payload, '$.shortcutId', ?) WHERE type = ? AND json_extract(payload, '$.shortcutId') IN (` + in + `)`, append([]any{merge.SurvivorID, store.ActivityShortcutView.String()}, ids...)},
+		{`DELETE FROM shortcut WHERE id IN (` + in + `)`, ids},
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+			return err
+		}
+	}
+	if err := vacuumShortcutAnalyticsShare(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutProposedChange(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutRotation(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutACL(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutEmbedding(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func vacuumShortcut(ctx context.Context, tx *sql.Tx) error {
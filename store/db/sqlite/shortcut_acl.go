@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) UpsertShortcutACL(ctx context.Context, upsert *store.ShortcutACL) (*store.ShortcutACL, error) {
+	stmt := `
+		INSERT INTO shortcut_acl (
+			shortcut_id,
+			user_id,
+			role
+		)
+		VALUES (?, ?, ?)
+		ON CONFLICT(shortcut_id, user_id) DO UPDATE
+		SET role = EXCLUDED.role
+		RETURNING created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.ShortcutID, upsert.UserID, upsert.Role).Scan(
+		&upsert.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	shortcutACL := upsert
+	return shortcutACL, nil
+}
+
+func (d *DB) ListShortcutACLs(ctx context.Context, find *store.FindShortcutACL) ([]*store.ShortcutACL, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			shortcut_id,
+			user_id,
+			role,
+			created_ts
+		FROM shortcut_acl
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts ASC, user_id ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutACL{}
+	for rows.Next() {
+		shortcutACL := &store.ShortcutACL{}
+		if err := rows.Scan(
+			&shortcutACL.ShortcutID,
+			&shortcutACL.UserID,
+			&shortcutACL.Role,
+			&shortcutACL.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, shortcutACL)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteShortcutACL(ctx context.Context, delete *store.DeleteShortcutACL) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut_acl WHERE shortcut_id = ? AND user_id = ?`, delete.ShortcutID, delete.UserID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func vacuumShortcutACL(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM shortcut_acl WHERE shortcut_id NOT IN (SELECT id FROM shortcut) OR user_id NOT IN (SELECT id FROM user)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) ListShortcutAliases(ctx context.Context, find *store.FindShortcutAlias) ([]*store.ShortcutAlias, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = ?"), append(args, *v)
+	}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			name,
+			shortcut_id,
+			created_ts
+		FROM shortcut_alias
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY name ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutAlias{}
+	for rows.Next() {
+		alias := &store.ShortcutAlias{}
+		if err := rows.Scan(
+			&alias.Name,
+			&alias.ShortcutID,
+			&alias.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, alias)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateShortcutAliases(ctx context.Context, update *store.UpdateShortcutAliases) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM shortcut_alias WHERE shortcut_id = ?`, update.ShortcutID); err != nil {
+		return err
+	}
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO shortcut_alias (name, shortcut_id) VALUES (?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, name := range update.Names {
+		if _, err := stmt.ExecContext(ctx, name, update.ShortcutID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func vacuumShortcutAlias(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM shortcut_alias WHERE shortcut_id NOT IN (SELECT id FROM shortcut)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) CreateShortcutAnalyticsShare(ctx context.Context, create *store.ShortcutAnalyticsShare) (*store.ShortcutAnalyticsShare, error) {
+	stmt := `
+		INSERT INTO shortcut_analytics_share (
+			shortcut_id,
+			creator_id,
+			description,
+			token,
+			expire_ts
+		)
+		VALUES (?, ?, ?, ?, ?)
+		RETURNING id, created_ts, view_count, last_viewed_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, create.ShortcutID, create.CreatorID, create.Description, create.Token, create.ExpireTs).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.ViewCount,
+		&create.LastViewedTs,
+	); err != nil {
+		return nil, err
+	}
+	shortcutAnalyticsShare := create
+	return shortcutAnalyticsShare, nil
+}
+
+func (d *DB) ListShortcutAnalyticsShares(ctx context.Context, find *store.FindShortcutAnalyticsShare) ([]*store.ShortcutAnalyticsShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *v)
+	}
+	if v := find.Token; v != nil {
+		where, args = append(where, "token = ?"), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			shortcut_id,
+			creator_id,
+			created_ts,
+			description,
+			token,
+			expire_ts,
+			view_count,
+			last_viewed_ts
+		FROM shortcut_analytics_share
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutAnalyticsShare{}
+	for rows.Next() {
+		shortcutAnalyticsShare := &store.ShortcutAnalyticsShare{}
+		if err := rows.Scan(
+			&shortcutAnalyticsShare.ID,
+			&shortcutAnalyticsShare.ShortcutID,
+			&shortcutAnalyticsShare.CreatorID,
+			&shortcutAnalyticsShare.CreatedTs,
+			&shortcutAnalyticsShare.Description,
+			&shortcutAnalyticsShare.Token,
+			&shortcutAnalyticsShare.ExpireTs,
+			&shortcutAnalyticsShare.ViewCount,
+			&shortcutAnalyticsShare.LastViewedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, shortcutAnalyticsShare)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) RecordShortcutAnalyticsShareView(ctx context.Context, id int32, viewedTs int64) error {
+	stmt := `UPDATE shortcut_analytics_share SET view_count = view_count + 1, last_viewed_ts = ? WHERE id = ?`
+	if _, err := d.db.ExecContext(ctx, stmt, viewedTs, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DB) DeleteShortcutAnalyticsShare(ctx context.Context, delete *store.DeleteShortcutAnalyticsShare) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut_analytics_share WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func vacuumShortcutAnalyticsShare(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM shortcut_analytics_share WHERE shortcut_id NOT IN (SELECT id FROM shortcut) OR creator_id NOT IN (SELECT id FROM user)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) UpsertShortcutEmbedding(ctx context.Context, upsert *store.ShortcutEmbedding) (*store.ShortcutEmbedding, error) {
+	stmt := `
+		INSERT INTO shortcut_embedding (
+			shortcut_id,
+			model,
+			content_hash,
+			embedding
+		)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(shortcut_id) DO UPDATE
+		SET model = EXCLUDED.model, content_hash = EXCLUDED.content_hash, embedding = EXCLUDED.embedding, updated_ts = (strftime('%s', 'now'))
+		RETURNING updated_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.ShortcutID, upsert.Model, upsert.ContentHash, store.MarshalEmbedding(upsert.Embedding)).Scan(
+		&upsert.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	shortcutEmbedding := upsert
+	return shortcutEmbedding, nil
+}
+
+func (d *DB) ListShortcutEmbeddings(ctx context.Context, find *store.FindShortcutEmbedding) ([]*store.ShortcutEmbedding, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *v)
+	}
+	if v := find.Model; v != nil {
+		where, args = append(where, "model = ?"), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			shortcut_id,
+			model,
+			content_hash,
+			embedding,
+			updated_ts
+		FROM shortcut_embedding
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY shortcut_id ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutEmbedding{}
+	for rows.Next() {
+		shortcutEmbedding := &store.ShortcutEmbedding{}
+		var embedding []byte
+		if err := rows.Scan(
+			&shortcutEmbedding.ShortcutID,
+			&shortcutEmbedding.Model,
+			&shortcutEmbedding.ContentHash,
+			&embedding,
+			&shortcutEmbedding.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		if shortcutEmbedding.Embedding, err = store.UnmarshalEmbedding(embedding); err != nil {
+			return nil, err
+		}
+		list = append(list, shortcutEmbedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteShortcutEmbedding(ctx context.Context, delete *store.DeleteShortcutEmbedding) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut_embedding WHERE shortcut_id = ?`, delete.ShortcutID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func vacuumShortcutEmbedding(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM shortcut_embedding WHERE shortcut_id NOT IN (SELECT id FROM shortcut)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) CreateShortcutProposedChange(ctx context.Context, create *store.ShortcutProposedChange) (*store.ShortcutProposedChange, error) {
+	payloadBytes, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+	stmt := `
+		INSERT INTO shortcut_proposed_change (
+			shortcut_id,
+			proposer_id,
+			payload
+		)
+		VALUES (?, ?, ?)
+		RETURNING id, created_ts, status, reviewer_id, reviewed_ts
+	`
+	var status string
+	if err := d.db.QueryRowContext(ctx, stmt, create.ShortcutID, create.ProposerID, string(payloadBytes)).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&status,
+		&create.ReviewerID,
+		&create.ReviewedTs,
+	); err != nil {
+		return nil, err
+	}
+	create.Status = store.ShortcutProposedChangeStatus(status)
+	shortcutProposedChange := create
+	return shortcutProposedChange, nil
+}
+
+func (d *DB) UpdateShortcutProposedChange(ctx context.Context, update *store.UpdateShortcutProposedChange) (*store.ShortcutProposedChange, error) {
+	stmt := `
+		UPDATE shortcut_proposed_change
+		SET status = ?, reviewer_id = ?, reviewed_ts = ?
+		WHERE id = ? AND status = ?
+		RETURNING id, shortcut_id, proposer_id, created_ts, status, payload, reviewer_id, reviewed_ts
+	`
+	shortcutProposedChange, err := scanShortcutProposedChange(d.db.QueryRowContext(ctx, stmt, update.Status.String(), update.ReviewerID, update.ReviewedTs, update.ID, store.ShortcutProposedChangePending.String()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return shortcutProposedChange, nil
+}
+
+func (d *DB) ListShortcutProposedChanges(ctx context.Context, find *store.FindShortcutProposedChange) ([]*store.ShortcutProposedChange, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *v)
+	}
+	if v := find.ProposerID; v != nil {
+		where, args = append(where, "proposer_id = ?"), append(args, *v)
+	}
+	if v := find.Status; v != nil {
+		where, args = append(where, "status = ?"), append(args, v.String())
+	}
+
+	query := `
+		SELECT
+			id,
+			shortcut_id,
+			proposer_id,
+			created_ts,
+			status,
+			payload,
+			reviewer_id,
+			reviewed_ts
+		FROM shortcut_proposed_change
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutProposedChange{}
+	for rows.Next() {
+		shortcutProposedChange, err := scanShortcutProposedChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, shortcutProposedChange)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func scanShortcutProposedChange(row interface{ Scan(...any) error }) (*store.ShortcutProposedChange, error) {
+	shortcutProposedChange := &store.ShortcutProposedChange{}
+	var status, payload string
+	if err := row.Scan(
+		&shortcutProposedChange.ID,
+		&shortcutProposedChange.ShortcutID,
+		&shortcutProposedChange.ProposerID,
+		&shortcutProposedChange.CreatedTs,
+		&status,
+		&payload,
+		&shortcutProposedChange.ReviewerID,
+		&shortcutProposedChange.ReviewedTs,
+	); err != nil {
+		return nil, err
+	}
+	shortcutProposedChange.Status = store.ShortcutProposedChangeStatus(status)
+	shortcutProposedChange.Payload = &storepb.ShortcutProposedChangePayload{}
+	if err := protojson.Unmarshal([]byte(payload), shortcutProposedChange.Payload); err != nil {
+		return nil, err
+	}
+	return shortcutProposedChange, nil
+}
+
+func vacuumShortcutProposedChange(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM shortcut_proposed_change WHERE shortcut_id NOT IN (SELECT id FROM shortcut) OR proposer_id NOT IN (SELECT id FROM user)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -2,8 +2,11 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/warthurton/slash/store"
 )
@@ -14,9 +17,11 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 			email,
 			nickname,
 			password_hash,
-			role
+			role,
+			username,
+			email_verified
 		)
-		VALUES (?, ?, ?, ?)
+		VALUES (?, ?, ?, ?, ?, ?)
 		RETURNING id, created_ts, updated_ts, row_status
 	`
 	var rowStatus string
@@ -25,6 +30,8 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 		create.Nickname,
 		create.PasswordHash,
 		create.Role,
+		create.Username,
+		create.EmailVerified,
 	).Scan(
 		&create.ID,
 		&create.CreatedTs,
@@ -56,6 +63,15 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	if v := update.Role; v != nil {
 		set, args = append(set, "role = ?"), append(args, *v)
 	}
+	if v := update.Username; v != nil {
+		set, args = append(set, "username = ?"), append(args, *v)
+	}
+	if v := update.AvatarBlobID; v != nil {
+		set, args = append(set, "avatar_blob_id = ?"), append(args, *v)
+	}
+	if v := update.EmailVerified; v != nil {
+		set, args = append(set, "email_verified = ?"), append(args, *v)
+	}
 
 	if len(set) == 0 {
 		return nil, errors.New("no fields to update")
@@ -65,7 +81,7 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		UPDATE user
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ?
-		RETURNING id, created_ts, updated_ts, row_status, email, nickname, password_hash, role
+		RETURNING id, created_ts, updated_ts, row_status, email, nickname, password_hash, role, username, avatar_blob_id, email_verified
 	`
 	args = append(args, update.ID)
 	user := &store.User{}
@@ -79,6 +95,9 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		&user.Nickname,
 		&user.PasswordHash,
 		&user.Role,
+		&user.Username,
+		&user.AvatarBlobID,
+		&user.EmailVerified,
 	); err != nil {
 		return nil, err
 	}
@@ -92,11 +111,25 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.ID; v != nil {
 		where, args = append(where, "id = ?"), append(args, *v)
 	}
+	if v := find.IDList; v != nil {
+		list := []string{}
+		for _, id := range v {
+			list = append(list, "?")
+			args = append(args, id)
+		}
+		// An empty list matches no rows.
+		list = append(list, "NULL")
+		where = append(where, fmt.Sprintf("id IN (%s)", strings.Join(list, ",")))
+	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "row_status = ?"), append(args, v.String())
 	}
 	if v := find.Email; v != nil {
-		where, args = append(where, "email = ?"), append(args, *v)
+		// Match the primary email or any verified secondary email.
+		where, args = append(where, "(email = ? OR id IN (SELECT user_id FROM user_email WHERE email = ? AND verified = 1))"), append(args, *v, *v)
+	}
+	if v := find.Username; v != nil {
+		where, args = append(where, "username = ?"), append(args, *v)
 	}
 	if v := find.Nickname; v != nil {
 		where, args = append(where, "nickname = ?"), append(args, *v)
@@ -114,11 +147,14 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			email,
 			nickname,
 			password_hash,
-			role
+			role,
+			username,
+			avatar_blob_id,
+			email_verified
 		FROM user
 		WHERE ` + strings.Join(where, " AND ") + `
-		ORDER BY updated_ts DESC, created_ts DESC
-	`
+		ORDER BY updated_ts DESC, created_ts DESC, id DESC
+	` + limitOffset(find.Limit, find.Offset)
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -138,6 +174,9 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			&user.Nickname,
 			&user.PasswordHash,
 			&user.Role,
+			&user.Username,
+			&user.AvatarBlobID,
+			&user.EmailVerified,
 		); err != nil {
 			return nil, err
 		}
@@ -159,6 +198,11 @@ func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
 	}
 	defer tx.Rollback()
 
+	if delete.HandoverUserID != nil {
+		if err := handoverUserData(ctx, tx, delete); err != nil {
+			return err
+		}
+	}
 	if _, err := tx.ExecContext(ctx, `
 		DELETE FROM user WHERE id = ?
 	`, delete.ID); err != nil {
@@ -168,12 +212,70 @@ func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
 	if err := vacuumUserSetting(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumUserEmail(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumIdentityProviderLink(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumUserSession(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumBlob(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumShortcut(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumShortcutAlias(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumCollection(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumCollectionShare(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutAnalyticsShare(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutProposedChange(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutRotation(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutACL(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutEmbedding(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumTeamMember(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumImportJob(ctx, tx); err != nil {
+		return err
+	}
 
 	return tx.Commit()
 }
+
+// handoverUserData transfers the shortcuts, their rotations and the collections of the deleted user
+// to the handover user, so they aren't vacuumed with the user.
+func handoverUserData(ctx context.Context, tx *sql.Tx, delete *store.DeleteUser) error {
+	where, args := []string{"creator_id = ?"}, []any{*delete.HandoverUserID, delete.ID}
+	if delete.PersonalShortcutPrefix != "" {
+		where, args = append(where, "substr(name, 1, ?) != ?"), append(args, utf8.RuneCountInString(delete.PersonalShortcutPrefix), delete.PersonalShortcutPrefix)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE shortcut SET creator_id = ? WHERE `+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE shortcut_rotation SET creator_id = ? WHERE creator_id = ?`, *delete.HandoverUserID, delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE collection SET creator_id = ? WHERE creator_id = ?`, *delete.HandoverUserID, delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/warthurton/slash/store"
+)
+
+func (d *DB) CreateUserSession(ctx context.Context, create *store.UserSession) (*store.UserSession, error) {
+	stmt := `
+		INSERT INTO user_session (
+			user_id,
+			user_agent,
+			ip
+		)
+		VALUES (?, ?, ?)
+		RETURNING id, created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, create.UserID, create.UserAgent, create.IP).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	userSession := create
+	return userSession, nil
+}
+
+func (d *DB) ListUserSessions(ctx context.Context, find *store.FindUserSession) ([]*store.UserSession, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			user_id,
+			created_ts,
+			user_agent,
+			ip
+		FROM user_session
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserSession{}
+	for rows.Next() {
+		userSession := &store.UserSession{}
+		if err := rows.Scan(
+			&userSession.ID,
+			&userSession.UserID,
+			&userSession.CreatedTs,
+			&userSession.UserAgent,
+			&userSession.IP,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, userSession)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUserSession(ctx context.Context, delete *store.DeleteUserSession) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM user_session WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func vacuumUserSession(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM user_session WHERE user_id NOT IN (SELECT id FROM user)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	storepb "github.com/warthurton/slash/proto/gen/store"
 )
@@ -12,6 +13,7 @@ import (
 type Driver interface {
 	GetDB() *sql.DB
 	Close() error
+	GetInstanceStats(ctx context.Context) (*InstanceStats, error)
 
 	// MigrationHistory model related methods.
 	UpsertMigrationHistory(ctx context.Context, upsert *UpsertMigrationHistory) (*MigrationHistory, error)
@@ -20,6 +22,16 @@ type Driver interface {
 	// Activity model related methods.
 	CreateActivity(ctx context.Context, create *Activity) (*Activity, error)
 	ListActivities(ctx context.Context, find *FindActivity) ([]*Activity, error)
+	DeleteActivities(ctx context.Context, delete *DeleteActivities) error
+	EnsureActivityPartition(ctx context.Context, month time.Time) error
+	ListShortcutViewCounts(ctx context.Context, find *FindShortcutViewCount) ([]*ShortcutViewCount, error)
+	ListShortcutViewGroups(ctx context.Context, find *FindShortcutViewGroup) ([]*ShortcutViewGroup, error)
+	ListShortcutViewBuckets(ctx context.Context, find *FindShortcutViewBucket) ([]*ShortcutViewBucket, error)
+
+	// Blob model related methods.
+	CreateBlob(ctx context.Context, create *Blob) (*Blob, error)
+	ListBlobs(ctx context.Context, find *FindBlob) ([]*Blob, error)
+	DeleteBlob(ctx context.Context, delete *DeleteBlob) error
 
 	// Collection model related methods.
 	CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error)
@@ -27,11 +39,72 @@ type Driver interface {
 	ListCollections(ctx context.Context, find *FindCollection) ([]*storepb.Collection, error)
 	DeleteCollection(ctx context.Context, delete *DeleteCollection) error
 
+	// UserSession model related methods.
+	CreateUserSession(ctx context.Context, create *UserSession) (*UserSession, error)
+	ListUserSessions(ctx context.Context, find *FindUserSession) ([]*UserSession, error)
+	DeleteUserSession(ctx context.Context, delete *DeleteUserSession) error
+
+	// CollectionShare model related methods.
+	CreateCollectionShare(ctx context.Context, create *CollectionShare) (*CollectionShare, error)
+	ListCollectionShares(ctx context.Context, find *FindCollectionShare) ([]*CollectionShare, error)
+	RecordCollectionShareView(ctx context.Context, id int32, viewedTs int64) error
+	DeleteCollectionShare(ctx context.Context, delete *DeleteCollectionShare) error
+
+	// ShortcutAnalyticsShare model related methods.
+	CreateShortcutAnalyticsShare(ctx context.Context, create *ShortcutAnalyticsShare) (*ShortcutAnalyticsShare, error)
+	ListShortcutAnalyticsShares(ctx context.Context, find *FindShortcutAnalyticsShare) ([]*ShortcutAnalyticsShare, error)
+	RecordShortcutAnalyticsShareView(ctx context.Context, id int32, viewedTs int64) error
+	DeleteShortcutAnalyticsShare(ctx context.Context, delete *DeleteShortcutAnalyticsShare) error
+
+	// ShortcutProposedChange model related methods.
+	CreateShortcutProposedChange(ctx context.Context, create *ShortcutProposedChange) (*ShortcutProposedChange, error)
+	UpdateShortcutProposedChange(ctx context.Context, update *UpdateShortcutProposedChange) (*ShortcutProposedChange, error)
+	ListShortcutProposedChanges(ctx context.Context, find *FindShortcutProposedChange) ([]*ShortcutProposedChange, error)
+
+	// ImportJob model related methods.
+	CreateImportJob(ctx context.Context, create *ImportJob) (*ImportJob, error)
+	UpdateImportJob(ctx context.Context, update *UpdateImportJob) (*ImportJob, error)
+	ClaimImportJob(ctx context.Context) (*ImportJob, error)
+	ListImportJobs(ctx context.Context, find *FindImportJob) ([]*ImportJob, error)
+	DeleteImportJob(ctx context.Context, delete *DeleteImportJob) error
+
+	// ShortcutRotation model related methods.
+	CreateShortcutRotation(ctx context.Context, create *ShortcutRotation) (*ShortcutRotation, error)
+	ListShortcutRotations(ctx context.Context, find *FindShortcutRotation) ([]*ShortcutRotation, error)
+	DeleteShortcutRotation(ctx context.Context, delete *DeleteShortcutRotation) error
+
 	// Shortcut model related methods.
 	CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error)
 	UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*storepb.Shortcut, error)
+	UpdateShortcutTags(ctx context.Context, update *UpdateShortcutTags) error
 	ListShortcuts(ctx context.Context, find *FindShortcut) ([]*storepb.Shortcut, error)
 	DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error
+	MergeShortcuts(ctx context.Context, merge *MergeShortcuts) error
+
+	// ShortcutAlias model related methods.
+	ListShortcutAliases(ctx context.Context, find *FindShortcutAlias) ([]*ShortcutAlias, error)
+	UpdateShortcutAliases(ctx context.Context, update *UpdateShortcutAliases) error
+
+	// ShortcutACL model related methods.
+	UpsertShortcutACL(ctx context.Context, upsert *ShortcutACL) (*ShortcutACL, error)
+	ListShortcutACLs(ctx context.Context, find *FindShortcutACL) ([]*ShortcutACL, error)
+	DeleteShortcutACL(ctx context.Context, delete *DeleteShortcutACL) error
+
+	// ShortcutEmbedding model related methods.
+	UpsertShortcutEmbedding(ctx context.Context, upsert *ShortcutEmbedding) (*ShortcutEmbedding, error)
+	ListShortcutEmbeddings(ctx context.Context, find *FindShortcutEmbedding) ([]*ShortcutEmbedding, error)
+	DeleteShortcutEmbedding(ctx context.Context, delete *DeleteShortcutEmbedding) error
+
+	// Team model related methods.
+	CreateTeam(ctx context.Context, create *Team) (*Team, error)
+	UpdateTeam(ctx context.Context, update *UpdateTeam) (*Team, error)
+	ListTeams(ctx context.Context, find *FindTeam) ([]*Team, error)
+	DeleteTeam(ctx context.Context, delete *DeleteTeam) error
+
+	// TeamMember model related methods.
+	CreateTeamMember(ctx context.Context, create *TeamMember) (*TeamMember, error)
+	ListTeamMembers(ctx context.Context, find *FindTeamMember) ([]*TeamMember, error)
+	DeleteTeamMember(ctx context.Context, delete *DeleteTeamMember) error
 
 	// User model related methods.
 	CreateUser(ctx context.Context, create *User) (*User, error)
@@ -39,6 +112,17 @@ type Driver interface {
 	ListUsers(ctx context.Context, find *FindUser) ([]*User, error)
 	DeleteUser(ctx context.Context, delete *DeleteUser) error
 
+	// UserEmail model related methods.
+	CreateUserEmail(ctx context.Context, create *UserEmail) (*UserEmail, error)
+	UpdateUserEmail(ctx context.Context, update *UpdateUserEmail) (*UserEmail, error)
+	ListUserEmails(ctx context.Context, find *FindUserEmail) ([]*UserEmail, error)
+	DeleteUserEmail(ctx context.Context, delete *DeleteUserEmail) error
+	SetUserPrimaryEmail(ctx context.Context, set *SetUserPrimaryEmail) (*User, error)
+
+	// IdentityProviderLink model related methods.
+	UpsertIdentityProviderLink(ctx context.Context, upsert *IdentityProviderLink) (*IdentityProviderLink, error)
+	ListIdentityProviderLinks(ctx context.Context, find *FindIdentityProviderLink) ([]*IdentityProviderLink, error)
+
 	// UserSetting model related methods.
 	UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error)
 	ListUserSettings(ctx context.Context, find *FindUserSetting) ([]*storepb.UserSetting, error)
@@ -0,0 +1,45 @@
+package store
+
+import (
+	"context"
+)
+
+// IdentityProviderLink links the identity of a user in an identity provider to the user,
+// so that signing in with the identity signs in as the user.
+type IdentityProviderLink struct {
+	UserID int32
+	// The id of the identity provider.
+	IdpID string
+	// The user identifier returned by the identity provider.
+	Identifier string
+	CreatedTs  int64
+}
+
+type FindIdentityProviderLink struct {
+	UserID     *int32
+	IdpID      *string
+	Identifier *string
+}
+
+// UpsertIdentityProviderLink links the identity to the user, replacing the existing link of the user
+// to the same identity provider.
+func (s *Store) UpsertIdentityProviderLink(ctx context.Context, upsert *IdentityProviderLink) (*IdentityProviderLink, error) {
+	return s.driver.UpsertIdentityProviderLink(ctx, upsert)
+}
+
+func (s *Store) ListIdentityProviderLinks(ctx context.Context, find *FindIdentityProviderLink) ([]*IdentityProviderLink, error) {
+	return s.driver.ListIdentityProviderLinks(ctx, find)
+}
+
+func (s *Store) GetIdentityProviderLink(ctx context.Context, find *FindIdentityProviderLink) (*IdentityProviderLink, error) {
+	list, err := s.ListIdentityProviderLinks(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
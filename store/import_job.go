@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+type ImportJobStatus string
+
+const (
+	// ImportJobPending is the status of the jobs waiting to be run, new or resumed.
+	ImportJobPending ImportJobStatus = "PENDING"
+	// ImportJobRunning is the status of the job run by an instance, which updates it with its progress.
+	ImportJobRunning ImportJobStatus = "RUNNING"
+	// ImportJobSucceeded is the status of the jobs whose rows are all processed.
+	ImportJobSucceeded ImportJobStatus = "SUCCEEDED"
+	// ImportJobFailed is the status of the jobs stopped at a row, which can be resumed.
+	ImportJobFailed ImportJobStatus = "FAILED"
+)
+
+func (s ImportJobStatus) String() string {
+	return string(s)
+}
+
+// ImportJob is the import of the shortcuts of a file as its creator, run in the background.
+type ImportJob struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+	Status    ImportJobStatus
+	Format    string
+	Payload   *storepb.ImportJobPayload
+	// ProcessedRows is the number of rows processed, the job resumes from the next one.
+	ProcessedRows int32
+	CreatedCount  int32
+	FailedCount   int32
+	Result        *storepb.ImportJobResult
+	// Error is the reason the job failed.
+	Error string
+}
+
+type UpdateImportJob struct {
+	ID int32
+
+	Status        *ImportJobStatus
+	ProcessedRows *int32
+	CreatedCount  *int32
+	FailedCount   *int32
+	Result        *storepb.ImportJobResult
+	Error         *string
+}
+
+type FindImportJob struct {
+	ID        *int32
+	CreatorID *int32
+	Status    *ImportJobStatus
+}
+
+type DeleteImportJob struct {
+	ID int32
+}
+
+func (s *Store) CreateImportJob(ctx context.Context, create *ImportJob) (*ImportJob, error) {
+	return s.driver.CreateImportJob(ctx, create)
+}
+
+// UpdateImportJob updates the job, and its updated_ts to now.
+func (s *Store) UpdateImportJob(ctx context.Context, update *UpdateImportJob) (*ImportJob, error) {
+	return s.driver.UpdateImportJob(ctx, update)
+}
+
+// ClaimImportJob marks the oldest pending job as running and returns it, or nil if there is none.
+// A job is claimed once, even by several instances sharing the database.
+func (s *Store) ClaimImportJob(ctx context.Context) (*ImportJob, error) {
+	return s.driver.ClaimImportJob(ctx)
+}
+
+func (s *Store) ListImportJobs(ctx context.Context, find *FindImportJob) ([]*ImportJob, error) {
+	return s.driver.ListImportJobs(ctx, find)
+}
+
+func (s *Store) GetImportJob(ctx context.Context, find *FindImportJob) (*ImportJob, error) {
+	list, err := s.ListImportJobs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) DeleteImportJob(ctx context.Context, delete *DeleteImportJob) error {
+	return s.driver.DeleteImportJob(ctx, delete)
+}
@@ -0,0 +1,29 @@
+package store
+
+import (
+	"context"
+)
+
+// InstanceStats is the size of the instance in the database.
+type InstanceStats struct {
+	ShortcutCount   int64
+	CollectionCount int64
+	UserCount       int64
+	ActivityCount   int64
+	// DatabaseSize is the estimated size of the database in bytes.
+	DatabaseSize int64
+}
+
+func (s *Store) GetInstanceStats(ctx context.Context) (*InstanceStats, error) {
+	return s.driver.GetInstanceStats(ctx)
+}
+
+// GetShortcutCacheHitRate returns the ratio of the shortcut lookups by id served by the cache since the start,
+// or 0 if there was none.
+func (s *Store) GetShortcutCacheHitRate() float64 {
+	hits, misses := s.shortcutCacheHits.Load(), s.shortcutCacheMisses.Load()
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
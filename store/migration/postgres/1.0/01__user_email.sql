@@ -0,0 +1,9 @@
+CREATE TABLE user_email (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  email TEXT NOT NULL UNIQUE,
+  verified BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX idx_user_email_user_id ON user_email(user_id);
@@ -0,0 +1,3 @@
+ALTER TABLE "user" ADD COLUMN username TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX idx_user_username ON "user"(username) WHERE username != '';
@@ -0,0 +1,12 @@
+CREATE TABLE blob (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  type TEXT NOT NULL DEFAULT '',
+  size INTEGER NOT NULL DEFAULT 0,
+  blob BYTEA NOT NULL
+);
+
+CREATE INDEX idx_blob_creator_id ON blob(creator_id);
+
+ALTER TABLE "user" ADD COLUMN avatar_blob_id INTEGER NOT NULL DEFAULT 0;
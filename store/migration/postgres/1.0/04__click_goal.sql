@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN click_goal TEXT NOT NULL DEFAULT '{}';
@@ -0,0 +1,3 @@
+ALTER TABLE shortcut ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', name || ' ' || title || ' ' || description || ' ' || tag || ' ' || link)) STORED;
+
+CREATE INDEX idx_shortcut_search_vector ON shortcut USING GIN (search_vector);
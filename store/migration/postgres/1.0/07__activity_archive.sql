@@ -0,0 +1,26 @@
+CREATE TABLE activity_partitioned (
+  id SERIAL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  type TEXT NOT NULL DEFAULT '',
+  level TEXT NOT NULL CHECK (level IN ('INFO', 'WARN', 'ERROR')) DEFAULT 'INFO',
+  payload TEXT NOT NULL DEFAULT '{}',
+  PRIMARY KEY (id, created_ts)
+) PARTITION BY RANGE (created_ts);
+
+-- The monthly partitions are created by the server, and the default partition keeps the rows of the other months.
+CREATE TABLE activity_default PARTITION OF activity_partitioned DEFAULT;
+
+INSERT INTO activity_partitioned (id, creator_id, created_ts, type, level, payload)
+SELECT id, creator_id, created_ts, type, level, payload FROM activity;
+
+SELECT setval(pg_get_serial_sequence('activity_partitioned', 'id'), COALESCE((SELECT MAX(id) FROM activity_partitioned), 0) + 1, false);
+
+DROP TABLE activity;
+
+ALTER TABLE activity_partitioned RENAME TO activity;
+
+CREATE INDEX idx_activity_created_ts ON activity (created_ts);
+
+-- The activity archives are blobs of the bot, which isn't a user.
+ALTER TABLE blob DROP CONSTRAINT blob_creator_id_fkey;
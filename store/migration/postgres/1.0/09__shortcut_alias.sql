@@ -0,0 +1,7 @@
+CREATE TABLE shortcut_alias (
+  name TEXT NOT NULL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
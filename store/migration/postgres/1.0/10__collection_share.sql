@@ -0,0 +1,13 @@
+CREATE TABLE collection_share (
+  id SERIAL PRIMARY KEY,
+  collection_id INTEGER REFERENCES collection(id) ON DELETE CASCADE NOT NULL,
+  creator_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  email TEXT NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  expire_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_collection_share_collection_id ON collection_share(collection_id);
@@ -0,0 +1,13 @@
+CREATE TABLE shortcut_analytics_share (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  creator_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  description TEXT NOT NULL DEFAULT '',
+  token TEXT NOT NULL UNIQUE,
+  expire_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_analytics_share_shortcut_id ON shortcut_analytics_share(shortcut_id);
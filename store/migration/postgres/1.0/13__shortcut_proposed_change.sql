@@ -0,0 +1,14 @@
+ALTER TABLE shortcut ADD COLUMN protected BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE shortcut_proposed_change (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  proposer_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')) DEFAULT 'PENDING',
+  payload TEXT NOT NULL DEFAULT '{}',
+  reviewer_id INTEGER NOT NULL DEFAULT 0,
+  reviewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_proposed_change_shortcut_id ON shortcut_proposed_change(shortcut_id);
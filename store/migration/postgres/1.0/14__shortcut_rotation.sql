@@ -0,0 +1,11 @@
+CREATE TABLE shortcut_rotation (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  creator_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  link TEXT NOT NULL,
+  start_ts BIGINT NOT NULL,
+  end_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_rotation_shortcut_id ON shortcut_rotation(shortcut_id);
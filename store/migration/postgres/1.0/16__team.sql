@@ -0,0 +1,24 @@
+CREATE TABLE team (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE team_member (
+  team_id INTEGER REFERENCES team(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_member_user_id ON team_member(user_id);
+
+ALTER TABLE shortcut DROP CONSTRAINT IF EXISTS shortcut_visibility_check;
+
+ALTER TABLE shortcut ADD COLUMN team_id INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE collection DROP CONSTRAINT IF EXISTS collection_visibility_check;
+
+ALTER TABLE collection ADD COLUMN team_id INTEGER NOT NULL DEFAULT 0;
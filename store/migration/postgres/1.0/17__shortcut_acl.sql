@@ -0,0 +1,9 @@
+CREATE TABLE shortcut_acl (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('READ', 'EDIT')) DEFAULT 'READ',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  PRIMARY KEY (shortcut_id, user_id)
+);
+
+CREATE INDEX idx_shortcut_acl_user_id ON shortcut_acl(user_id);
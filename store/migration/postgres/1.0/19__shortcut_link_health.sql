@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN link_health TEXT NOT NULL DEFAULT '{}';
@@ -0,0 +1,18 @@
+CREATE TABLE import_job (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED')) DEFAULT 'PENDING',
+  format TEXT NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  processed_rows INTEGER NOT NULL DEFAULT 0,
+  created_count INTEGER NOT NULL DEFAULT 0,
+  failed_count INTEGER NOT NULL DEFAULT 0,
+  result TEXT NOT NULL DEFAULT '{}',
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_import_job_creator_id ON import_job(creator_id);
+
+CREATE INDEX idx_import_job_status ON import_job(status);
@@ -0,0 +1 @@
+ALTER TABLE collection ADD COLUMN row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL';
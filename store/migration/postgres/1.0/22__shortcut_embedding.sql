@@ -0,0 +1,9 @@
+CREATE TABLE shortcut_embedding (
+  shortcut_id INTEGER PRIMARY KEY REFERENCES shortcut(id) ON DELETE CASCADE,
+  model TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  embedding BYTEA NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_shortcut_embedding_model ON shortcut_embedding(model);
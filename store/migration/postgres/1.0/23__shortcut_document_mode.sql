@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN document_mode TEXT NOT NULL DEFAULT 'DOCUMENT_MODE_UNSPECIFIED';
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN internal_description BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN redirect_rules TEXT NOT NULL DEFAULT '{}';
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN variants TEXT NOT NULL DEFAULT '{}';
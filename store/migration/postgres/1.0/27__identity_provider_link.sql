@@ -0,0 +1,20 @@
+CREATE TABLE identity_provider_link (
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  idp_id TEXT NOT NULL,
+  identifier TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  PRIMARY KEY (user_id, idp_id),
+  UNIQUE(idp_id, identifier)
+);
+
+INSERT INTO identity_provider_link (user_id, idp_id, identifier, created_ts)
+SELECT
+  user_setting.user_id,
+  link ->> 'idpId',
+  link ->> 'identifier',
+  COALESCE((link ->> 'createdTs')::BIGINT, EXTRACT(EPOCH FROM NOW()))
+FROM user_setting, jsonb_array_elements(user_setting.value::jsonb -> 'identityProviderLinks') AS link
+WHERE user_setting.key = 'USER_SETTING_IDENTITY_PROVIDER_LINKS'
+ON CONFLICT DO NOTHING;
+
+DELETE FROM user_setting WHERE key = 'USER_SETTING_IDENTITY_PROVIDER_LINKS';
@@ -19,11 +19,16 @@ CREATE TABLE "user" (
   email TEXT NOT NULL UNIQUE,
   nickname TEXT NOT NULL,
   password_hash TEXT NOT NULL,
-  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER')) DEFAULT 'USER'
+  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER')) DEFAULT 'USER',
+  username TEXT NOT NULL DEFAULT '',
+  avatar_blob_id INTEGER NOT NULL DEFAULT 0,
+  email_verified BOOLEAN NOT NULL DEFAULT TRUE
 );
 
 CREATE INDEX idx_user_email ON "user"(email);
 
+CREATE UNIQUE INDEX idx_user_username ON "user"(username) WHERE username != '';
+
 -- user_setting
 CREATE TABLE user_setting (
   user_id INTEGER REFERENCES "user"(id) NOT NULL,
@@ -32,6 +37,39 @@ CREATE TABLE user_setting (
   PRIMARY KEY (user_id, key)
 );
 
+-- user_email
+CREATE TABLE user_email (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  email TEXT NOT NULL UNIQUE,
+  verified BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX idx_user_email_user_id ON user_email(user_id);
+
+-- identity_provider_link
+CREATE TABLE identity_provider_link (
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  idp_id TEXT NOT NULL,
+  identifier TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  PRIMARY KEY (user_id, idp_id),
+  UNIQUE(idp_id, identifier)
+);
+
+-- blob
+CREATE TABLE blob (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  type TEXT NOT NULL DEFAULT '',
+  size INTEGER NOT NULL DEFAULT 0,
+  blob BYTEA NOT NULL
+);
+
+CREATE INDEX idx_blob_creator_id ON blob(creator_id);
+
 -- shortcut
 CREATE TABLE shortcut (
   id SERIAL PRIMARY KEY,
@@ -43,22 +81,51 @@ CREATE TABLE shortcut (
   link TEXT NOT NULL,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'TEAM')) DEFAULT 'PRIVATE',
   tag TEXT NOT NULL DEFAULT '',
-  og_metadata TEXT NOT NULL DEFAULT '{}'
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  click_goal TEXT NOT NULL DEFAULT '{}',
+  expire_ts BIGINT NOT NULL DEFAULT 0,
+  activate_ts BIGINT NOT NULL DEFAULT 0,
+  protected BOOLEAN NOT NULL DEFAULT FALSE,
+  team_id INTEGER NOT NULL DEFAULT 0,
+  redirect_code INTEGER NOT NULL DEFAULT 0,
+  link_health TEXT NOT NULL DEFAULT '{}',
+  document_mode TEXT NOT NULL DEFAULT 'DOCUMENT_MODE_UNSPECIFIED',
+  internal_description BOOLEAN NOT NULL DEFAULT FALSE,
+  redirect_rules TEXT NOT NULL DEFAULT '{}',
+  variants TEXT NOT NULL DEFAULT '{}',
+  search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', name || ' ' || title || ' ' || description || ' ' || tag || ' ' || link)) STORED
 );
 
 CREATE INDEX idx_shortcut_name ON shortcut(name);
 
+CREATE INDEX idx_shortcut_search_vector ON shortcut USING GIN (search_vector);
+
+-- shortcut_alias
+CREATE TABLE shortcut_alias (
+  name TEXT NOT NULL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
+
 -- activity
 CREATE TABLE activity (
-  id SERIAL PRIMARY KEY,
+  id SERIAL,
   creator_id INTEGER NOT NULL,
   created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   type TEXT NOT NULL DEFAULT '',
   level TEXT NOT NULL CHECK (level IN ('INFO', 'WARN', 'ERROR')) DEFAULT 'INFO',
-  payload TEXT NOT NULL DEFAULT '{}'
-);
+  payload TEXT NOT NULL DEFAULT '{}',
+  PRIMARY KEY (id, created_ts)
+) PARTITION BY RANGE (created_ts);
+
+-- The monthly partitions are created by the server, and the default partition keeps the rows of the other months.
+CREATE TABLE activity_default PARTITION OF activity DEFAULT;
+
+CREATE INDEX idx_activity_created_ts ON activity (created_ts);
 
 -- collection
 CREATE TABLE collection (
@@ -66,11 +133,142 @@ CREATE TABLE collection (
   creator_id INTEGER REFERENCES "user"(id) NOT NULL,
   created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
   name TEXT NOT NULL UNIQUE,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   shortcut_ids INTEGER ARRAY NOT NULL,
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE'
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'TEAM')) DEFAULT 'PRIVATE',
+  team_id INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_collection_name ON collection(name);
+
+-- collection_share
+CREATE TABLE collection_share (
+  id SERIAL PRIMARY KEY,
+  collection_id INTEGER REFERENCES collection(id) ON DELETE CASCADE NOT NULL,
+  creator_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  email TEXT NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  expire_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_collection_share_collection_id ON collection_share(collection_id);
+
+-- shortcut_analytics_share
+CREATE TABLE shortcut_analytics_share (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  creator_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  description TEXT NOT NULL DEFAULT '',
+  token TEXT NOT NULL UNIQUE,
+  expire_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_analytics_share_shortcut_id ON shortcut_analytics_share(shortcut_id);
+
+-- user_session
+CREATE TABLE user_session (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  user_agent TEXT NOT NULL DEFAULT '',
+  ip TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_user_session_user_id ON user_session(user_id);
+
+-- shortcut_proposed_change
+CREATE TABLE shortcut_proposed_change (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  proposer_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')) DEFAULT 'PENDING',
+  payload TEXT NOT NULL DEFAULT '{}',
+  reviewer_id INTEGER NOT NULL DEFAULT 0,
+  reviewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_proposed_change_shortcut_id ON shortcut_proposed_change(shortcut_id);
+
+-- shortcut_rotation
+CREATE TABLE shortcut_rotation (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  creator_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  link TEXT NOT NULL,
+  start_ts BIGINT NOT NULL,
+  end_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_rotation_shortcut_id ON shortcut_rotation(shortcut_id);
+
+-- team
+CREATE TABLE team (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- team_member
+CREATE TABLE team_member (
+  team_id INTEGER REFERENCES team(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_member_user_id ON team_member(user_id);
+
+-- shortcut_acl
+CREATE TABLE shortcut_acl (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('READ', 'EDIT')) DEFAULT 'READ',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  PRIMARY KEY (shortcut_id, user_id)
+);
+
+CREATE INDEX idx_shortcut_acl_user_id ON shortcut_acl(user_id);
+
+-- import_job
+CREATE TABLE import_job (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED')) DEFAULT 'PENDING',
+  format TEXT NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  processed_rows INTEGER NOT NULL DEFAULT 0,
+  created_count INTEGER NOT NULL DEFAULT 0,
+  failed_count INTEGER NOT NULL DEFAULT 0,
+  result TEXT NOT NULL DEFAULT '{}',
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_import_job_creator_id ON import_job(creator_id);
+
+CREATE INDEX idx_import_job_status ON import_job(status);
+
+-- shortcut_embedding
+CREATE TABLE shortcut_embedding (
+  shortcut_id INTEGER PRIMARY KEY REFERENCES shortcut(id) ON DELETE CASCADE,
+  model TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  embedding BYTEA NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_shortcut_embedding_model ON shortcut_embedding(model);
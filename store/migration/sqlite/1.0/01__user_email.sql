@@ -0,0 +1,9 @@
+CREATE TABLE user_email (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  email TEXT NOT NULL UNIQUE,
+  verified INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_user_email_user_id ON user_email(user_id);
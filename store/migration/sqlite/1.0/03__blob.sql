@@ -0,0 +1,12 @@
+CREATE TABLE blob (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  type TEXT NOT NULL DEFAULT '',
+  size INTEGER NOT NULL DEFAULT 0,
+  blob BLOB NOT NULL
+);
+
+CREATE INDEX idx_blob_creator_id ON blob(creator_id);
+
+ALTER TABLE user ADD COLUMN avatar_blob_id INTEGER NOT NULL DEFAULT 0;
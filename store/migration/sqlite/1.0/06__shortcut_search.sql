@@ -0,0 +1,16 @@
+CREATE VIRTUAL TABLE shortcut_fts USING fts5(name, title, description, tag, link, content='shortcut', content_rowid='id');
+
+CREATE TRIGGER shortcut_fts_insert AFTER INSERT ON shortcut BEGIN
+  INSERT INTO shortcut_fts(rowid, name, title, description, tag, link) VALUES (new.id, new.name, new.title, new.description, new.tag, new.link);
+END;
+
+CREATE TRIGGER shortcut_fts_delete AFTER DELETE ON shortcut BEGIN
+  INSERT INTO shortcut_fts(shortcut_fts, rowid, name, title, description, tag, link) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag, old.link);
+END;
+
+CREATE TRIGGER shortcut_fts_update AFTER UPDATE OF name, title, description, tag, link ON shortcut BEGIN
+  INSERT INTO shortcut_fts(shortcut_fts, rowid, name, title, description, tag, link) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag, old.link);
+  INSERT INTO shortcut_fts(rowid, name, title, description, tag, link) VALUES (new.id, new.name, new.title, new.description, new.tag, new.link);
+END;
+
+INSERT INTO shortcut_fts(shortcut_fts) VALUES ('rebuild');
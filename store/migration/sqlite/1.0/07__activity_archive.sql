@@ -0,0 +1 @@
+CREATE INDEX idx_activity_created_ts ON activity(created_ts);
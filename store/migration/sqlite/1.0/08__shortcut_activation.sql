@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN activate_ts BIGINT NOT NULL DEFAULT 0;
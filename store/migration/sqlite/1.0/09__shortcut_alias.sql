@@ -0,0 +1,7 @@
+CREATE TABLE shortcut_alias (
+  name TEXT NOT NULL PRIMARY KEY,
+  shortcut_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
@@ -0,0 +1,13 @@
+CREATE TABLE shortcut_analytics_share (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  description TEXT NOT NULL DEFAULT '',
+  token TEXT NOT NULL UNIQUE,
+  expire_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_analytics_share_shortcut_id ON shortcut_analytics_share(shortcut_id);
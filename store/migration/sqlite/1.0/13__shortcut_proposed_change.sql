@@ -0,0 +1,14 @@
+ALTER TABLE shortcut ADD COLUMN protected INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE shortcut_proposed_change (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  proposer_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')) DEFAULT 'PENDING',
+  payload TEXT NOT NULL DEFAULT '{}',
+  reviewer_id INTEGER NOT NULL DEFAULT 0,
+  reviewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_proposed_change_shortcut_id ON shortcut_proposed_change(shortcut_id);
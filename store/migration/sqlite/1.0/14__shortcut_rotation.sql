@@ -0,0 +1,11 @@
+CREATE TABLE shortcut_rotation (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  link TEXT NOT NULL,
+  start_ts BIGINT NOT NULL,
+  end_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_rotation_shortcut_id ON shortcut_rotation(shortcut_id);
@@ -0,0 +1 @@
+ALTER TABLE user ADD COLUMN email_verified INTEGER NOT NULL DEFAULT 1;
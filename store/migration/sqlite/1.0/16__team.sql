@@ -0,0 +1,137 @@
+CREATE TABLE team (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE team_member (
+  team_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_member_user_id ON team_member(user_id);
+
+-- The visibility check of the fresh installs doesn't allow TEAM, so the tables are rebuilt without it.
+ALTER TABLE shortcut RENAME TO shortcut_old;
+
+CREATE TABLE shortcut (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  name TEXT NOT NULL UNIQUE,
+  link TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL DEFAULT 'WORKSPACE',
+  tag TEXT NOT NULL DEFAULT '',
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  click_goal TEXT NOT NULL DEFAULT '{}',
+  expire_ts BIGINT NOT NULL DEFAULT 0,
+  activate_ts BIGINT NOT NULL DEFAULT 0,
+  protected INTEGER NOT NULL DEFAULT 0,
+  team_id INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO shortcut (
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  row_status,
+  name,
+  link,
+  title,
+  description,
+  visibility,
+  tag,
+  og_metadata,
+  click_goal,
+  expire_ts,
+  activate_ts,
+  protected
+)
+SELECT
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  row_status,
+  name,
+  link,
+  title,
+  description,
+  visibility,
+  tag,
+  og_metadata,
+  click_goal,
+  expire_ts,
+  activate_ts,
+  protected
+FROM shortcut_old;
+
+DROP TABLE shortcut_old;
+
+CREATE INDEX idx_shortcut_name ON shortcut(name);
+
+CREATE TRIGGER shortcut_fts_insert AFTER INSERT ON shortcut BEGIN
+  INSERT INTO shortcut_fts(rowid, name, title, description, tag, link) VALUES (new.id, new.name, new.title, new.description, new.tag, new.link);
+END;
+
+CREATE TRIGGER shortcut_fts_delete AFTER DELETE ON shortcut BEGIN
+  INSERT INTO shortcut_fts(shortcut_fts, rowid, name, title, description, tag, link) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag, old.link);
+END;
+
+CREATE TRIGGER shortcut_fts_update AFTER UPDATE OF name, title, description, tag, link ON shortcut BEGIN
+  INSERT INTO shortcut_fts(shortcut_fts, rowid, name, title, description, tag, link) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag, old.link);
+  INSERT INTO shortcut_fts(rowid, name, title, description, tag, link) VALUES (new.id, new.name, new.title, new.description, new.tag, new.link);
+END;
+
+INSERT INTO shortcut_fts(shortcut_fts) VALUES ('rebuild');
+
+ALTER TABLE collection RENAME TO collection_old;
+
+CREATE TABLE collection (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  shortcut_ids INTEGER[] NOT NULL,
+  visibility TEXT NOT NULL DEFAULT 'WORKSPACE',
+  team_id INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO collection (
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  name,
+  title,
+  description,
+  shortcut_ids,
+  visibility
+)
+SELECT
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  name,
+  title,
+  description,
+  shortcut_ids,
+  visibility
+FROM collection_old;
+
+DROP TABLE collection_old;
+
+CREATE INDEX idx_collection_name ON collection(name);
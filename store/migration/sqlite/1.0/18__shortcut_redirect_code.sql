@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN redirect_code INTEGER NOT NULL DEFAULT 0;
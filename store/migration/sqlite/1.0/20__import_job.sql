@@ -0,0 +1,18 @@
+CREATE TABLE import_job (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED')) DEFAULT 'PENDING',
+  format TEXT NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  processed_rows INTEGER NOT NULL DEFAULT 0,
+  created_count INTEGER NOT NULL DEFAULT 0,
+  failed_count INTEGER NOT NULL DEFAULT 0,
+  result TEXT NOT NULL DEFAULT '{}',
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_import_job_creator_id ON import_job(creator_id);
+
+CREATE INDEX idx_import_job_status ON import_job(status);
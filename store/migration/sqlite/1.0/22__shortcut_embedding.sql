@@ -0,0 +1,9 @@
+CREATE TABLE shortcut_embedding (
+  shortcut_id INTEGER NOT NULL PRIMARY KEY,
+  model TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  embedding BLOB NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_shortcut_embedding_model ON shortcut_embedding(model);
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN internal_description INTEGER NOT NULL DEFAULT 0;
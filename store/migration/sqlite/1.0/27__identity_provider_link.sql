@@ -0,0 +1,19 @@
+CREATE TABLE identity_provider_link (
+  user_id INTEGER NOT NULL,
+  idp_id TEXT NOT NULL,
+  identifier TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  PRIMARY KEY (user_id, idp_id),
+  UNIQUE(idp_id, identifier)
+);
+
+INSERT OR IGNORE INTO identity_provider_link (user_id, idp_id, identifier, created_ts)
+SELECT
+  user_setting.user_id,
+  json_extract(link.value, '$.idpId'),
+  json_extract(link.value, '$.identifier'),
+  COALESCE(CAST(json_extract(link.value, '$.createdTs') AS INTEGER), strftime('%s', 'now'))
+FROM user_setting, json_each(user_setting.value, '$.identityProviderLinks') AS link
+WHERE user_setting.key = 'USER_SETTING_IDENTITY_PROVIDER_LINKS';
+
+DELETE FROM user_setting WHERE key = 'USER_SETTING_IDENTITY_PROVIDER_LINKS';
@@ -19,11 +19,16 @@ CREATE TABLE user (
   email TEXT NOT NULL UNIQUE,
   nickname TEXT NOT NULL,
   password_hash TEXT NOT NULL,
-  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER')) DEFAULT 'USER'
+  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER')) DEFAULT 'USER',
+  username TEXT NOT NULL DEFAULT '',
+  avatar_blob_id INTEGER NOT NULL DEFAULT 0,
+  email_verified INTEGER NOT NULL DEFAULT 1
 );
 
 CREATE INDEX idx_user_email ON user(email);
 
+CREATE UNIQUE INDEX idx_user_username ON user(username) WHERE username != '';
+
 -- user_setting
 CREATE TABLE user_setting (
   user_id INTEGER NOT NULL,
@@ -32,6 +37,39 @@ CREATE TABLE user_setting (
   UNIQUE(user_id, key)
 );
 
+-- user_email
+CREATE TABLE user_email (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  email TEXT NOT NULL UNIQUE,
+  verified INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_user_email_user_id ON user_email(user_id);
+
+-- identity_provider_link
+CREATE TABLE identity_provider_link (
+  user_id INTEGER NOT NULL,
+  idp_id TEXT NOT NULL,
+  identifier TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  PRIMARY KEY (user_id, idp_id),
+  UNIQUE(idp_id, identifier)
+);
+
+-- blob
+CREATE TABLE blob (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  type TEXT NOT NULL DEFAULT '',
+  size INTEGER NOT NULL DEFAULT 0,
+  blob BLOB NOT NULL
+);
+
+CREATE INDEX idx_blob_creator_id ON blob(creator_id);
+
 -- shortcut
 CREATE TABLE shortcut (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -43,13 +81,48 @@ CREATE TABLE shortcut (
   link TEXT NOT NULL,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'TEAM')) DEFAULT 'PRIVATE',
   tag TEXT NOT NULL DEFAULT '',
-  og_metadata TEXT NOT NULL DEFAULT '{}'
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  click_goal TEXT NOT NULL DEFAULT '{}',
+  expire_ts BIGINT NOT NULL DEFAULT 0,
+  activate_ts BIGINT NOT NULL DEFAULT 0,
+  protected INTEGER NOT NULL DEFAULT 0,
+  team_id INTEGER NOT NULL DEFAULT 0,
+  redirect_code INTEGER NOT NULL DEFAULT 0,
+  link_health TEXT NOT NULL DEFAULT '{}',
+  document_mode TEXT NOT NULL DEFAULT 'DOCUMENT_MODE_UNSPECIFIED',
+  internal_description INTEGER NOT NULL DEFAULT 0,
+  redirect_rules TEXT NOT NULL DEFAULT '{}',
+  variants TEXT NOT NULL DEFAULT '{}'
 );
 
 CREATE INDEX idx_shortcut_name ON shortcut(name);
 
+CREATE VIRTUAL TABLE shortcut_fts USING fts5(name, title, description, tag, link, content='shortcut', content_rowid='id');
+
+CREATE TRIGGER shortcut_fts_insert AFTER INSERT ON shortcut BEGIN
+  INSERT INTO shortcut_fts(rowid, name, title, description, tag, link) VALUES (new.id, new.name, new.title, new.description, new.tag, new.link);
+END;
+
+CREATE TRIGGER shortcut_fts_delete AFTER DELETE ON shortcut BEGIN
+  INSERT INTO shortcut_fts(shortcut_fts, rowid, name, title, description, tag, link) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag, old.link);
+END;
+
+CREATE TRIGGER shortcut_fts_update AFTER UPDATE OF name, title, description, tag, link ON shortcut BEGIN
+  INSERT INTO shortcut_fts(shortcut_fts, rowid, name, title, description, tag, link) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag, old.link);
+  INSERT INTO shortcut_fts(rowid, name, title, description, tag, link) VALUES (new.id, new.name, new.title, new.description, new.tag, new.link);
+END;
+
+-- shortcut_alias
+CREATE TABLE shortcut_alias (
+  name TEXT NOT NULL PRIMARY KEY,
+  shortcut_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
+
 -- activity
 CREATE TABLE activity (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -60,17 +133,150 @@ CREATE TABLE activity (
   payload TEXT NOT NULL DEFAULT '{}'
 );
 
+CREATE INDEX idx_activity_created_ts ON activity(created_ts);
+
 -- collection
 CREATE TABLE collection (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
   creator_id INTEGER NOT NULL,
   created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
   updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
   name TEXT NOT NULL UNIQUE,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   shortcut_ids INTEGER[] NOT NULL,
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE'
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'TEAM')) DEFAULT 'PRIVATE',
+  team_id INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_collection_name ON collection(name);
+
+-- collection_share
+CREATE TABLE collection_share (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  collection_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  email TEXT NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  expire_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_collection_share_collection_id ON collection_share(collection_id);
+
+-- shortcut_analytics_share
+CREATE TABLE shortcut_analytics_share (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  description TEXT NOT NULL DEFAULT '',
+  token TEXT NOT NULL UNIQUE,
+  expire_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_analytics_share_shortcut_id ON shortcut_analytics_share(shortcut_id);
+
+-- user_session
+CREATE TABLE user_session (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  user_agent TEXT NOT NULL DEFAULT '',
+  ip TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_user_session_user_id ON user_session(user_id);
+
+-- shortcut_proposed_change
+CREATE TABLE shortcut_proposed_change (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  proposer_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')) DEFAULT 'PENDING',
+  payload TEXT NOT NULL DEFAULT '{}',
+  reviewer_id INTEGER NOT NULL DEFAULT 0,
+  reviewed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_proposed_change_shortcut_id ON shortcut_proposed_change(shortcut_id);
+
+-- shortcut_rotation
+CREATE TABLE shortcut_rotation (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  link TEXT NOT NULL,
+  start_ts BIGINT NOT NULL,
+  end_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_rotation_shortcut_id ON shortcut_rotation(shortcut_id);
+
+-- team
+CREATE TABLE team (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- team_member
+CREATE TABLE team_member (
+  team_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_member_user_id ON team_member(user_id);
+
+-- shortcut_acl
+CREATE TABLE shortcut_acl (
+  shortcut_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('READ', 'EDIT')) DEFAULT 'READ',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  PRIMARY KEY (shortcut_id, user_id)
+);
+
+CREATE INDEX idx_shortcut_acl_user_id ON shortcut_acl(user_id);
+
+-- import_job
+CREATE TABLE import_job (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED')) DEFAULT 'PENDING',
+  format TEXT NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  processed_rows INTEGER NOT NULL DEFAULT 0,
+  created_count INTEGER NOT NULL DEFAULT 0,
+  failed_count INTEGER NOT NULL DEFAULT 0,
+  result TEXT NOT NULL DEFAULT '{}',
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_import_job_creator_id ON import_job(creator_id);
+
+CREATE INDEX idx_import_job_status ON import_job(status);
+
+-- shortcut_embedding
+CREATE TABLE shortcut_embedding (
+  shortcut_id INTEGER NOT NULL PRIMARY KEY,
+  model TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  embedding BLOB NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_shortcut_embedding_model ON shortcut_embedding(model);
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 
 	storepb "github.com/warthurton/slash/proto/gen/store"
@@ -111,6 +112,13 @@ func (s *Store) Migrate(ctx context.Context) error {
 	if err := s.migrateWorkspaceSettings(ctx); err != nil {
 		return errors.Wrap(err, "failed to migrate workspace settings")
 	}
+	if err := s.migrateUsernames(ctx); err != nil {
+		return errors.Wrap(err, "failed to migrate usernames")
+	}
+	if err := s.migrateAccessTokens(ctx); err != nil {
+		return errors.Wrap(err, "failed to migrate access tokens")
+	}
+	s.migrated.Store(true)
 	return nil
 }
 
@@ -323,6 +331,69 @@ func (s *Store) normalizedMigrationHistoryList(ctx context.Context) error {
 	})
 }
 
+// migrateUsernames backfills the usernames of users created before usernames were introduced.
+func (s *Store) migrateUsernames(ctx context.Context) error {
+	emptyUsername := ""
+	users, err := s.driver.ListUsers(ctx, &FindUser{
+		Username: &emptyUsername,
+	})
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		username, err := s.GenerateUsername(ctx, user.Email)
+		if err != nil {
+			return err
+		}
+		if _, err := s.UpdateUser(ctx, &UpdateUser{
+			ID:       user.ID,
+			Username: &username,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateAccessTokens replaces the access tokens stored in plaintext with their hash.
+func (s *Store) migrateAccessTokens(ctx context.Context) error {
+	userSettings, err := s.driver.ListUserSettings(ctx, &FindUserSetting{
+		Key: storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+	})
+	if err != nil {
+		return err
+	}
+	for _, userSetting := range userSettings {
+		updated := false
+		for _, accessToken := range userSetting.GetAccessTokens().GetAccessTokens() {
+			if accessToken.AccessToken == "" {
+				continue
+			}
+			// The times are kept from the claims, which are verified when the access token is used.
+			claims := &jwt.RegisteredClaims{}
+			if _, _, err := jwt.NewParser().ParseUnverified(accessToken.AccessToken, claims); err == nil {
+				if claims.IssuedAt != nil {
+					accessToken.IssuedTs = claims.IssuedAt.Unix()
+				}
+				if claims.ExpiresAt != nil {
+					accessToken.ExpiresTs = claims.ExpiresAt.Unix()
+				}
+			}
+			if err := SetAccessTokenHash(accessToken, accessToken.AccessToken); err != nil {
+				return err
+			}
+			updated = true
+		}
+		if !updated {
+			continue
+		}
+		if _, err := s.UpsertUserSetting(ctx, userSetting); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // migrateWorkspaceSettings migrates workspace settings manually.
 func (s *Store) migrateWorkspaceSettings(ctx context.Context) error {
 	workspaceSettings, err := s.driver.ListWorkspaceSettings(ctx, &FindWorkspaceSetting{})
@@ -2,6 +2,9 @@ package store
 
 import (
 	"context"
+	"slices"
+	"strings"
+	"unicode"
 
 	storepb "github.com/warthurton/slash/proto/gen/store"
 )
@@ -9,21 +12,62 @@ import (
 type UpdateShortcut struct {
 	ID int32
 
-	Name              *string
-	Link              *string
-	Title             *string
-	Description       *string
-	Visibility        *storepb.Visibility
-	Tag               *string
-	OpenGraphMetadata *storepb.OpenGraphMetadata
+	// CreatorID transfers the ownership of the shortcut to another user.
+	CreatorID           *int32
+	RowStatus           *storepb.RowStatus
+	Name                *string
+	Link                *string
+	Title               *string
+	Description         *string
+	Visibility          *storepb.Visibility
+	Tag                 *string
+	OpenGraphMetadata   *storepb.OpenGraphMetadata
+	ClickGoal           *storepb.ClickGoal
+	ExpireTs            *int64
+	ActivateTs          *int64
+	Protected           *bool
+	TeamID              *int32
+	RedirectCode        *int32
+	LinkHealth          *storepb.LinkHealth
+	DocumentMode        *storepb.DocumentMode
+	InternalDescription *bool
+	RedirectRules       *storepb.RedirectRules
+	Variants            *storepb.Variants
+}
+
+// UpdateShortcutTags updates the tags of several shortcuts in a single transaction.
+type UpdateShortcutTags struct {
+	// Tags are the new tags by shortcut id.
+	Tags map[int32][]string
+}
+
+// MergeShortcuts merges the shortcuts into the survivor in a single transaction:
+// their names and aliases become aliases of the survivor, their views are moved to it, and they are deleted.
+type MergeShortcuts struct {
+	SurvivorID  int32
+	ShortcutIDs []int32
 }
 
 type FindShortcut struct {
 	ID             *int32
+	IDList         []int32
 	CreatorID      *int32
 	Name           *string
 	VisibilityList []storepb.Visibility
 	Tag            *string
+	RowStatus      *storepb.RowStatus
+	// ExpireTsBefore filters the shortcuts that expire at or before the given time.
+	ExpireTsBefore *int64
+	// Search filters the shortcuts whose name, title, description, tags or link contain all the search terms
+	// as word prefixes, ordered by relevance.
+	Search *string
+	// ViewerID filters the team and private shortcuts to the ones of the teams of the viewer,
+	// shared with the viewer, or created by the viewer.
+	ViewerID *int32
+
+	// Limit and Offset paginate the list.
+	Limit  *int
+	Offset *int
 }
 
 type DeleteShortcut struct {
@@ -31,6 +75,20 @@ type DeleteShortcut struct {
 }
 
 func (s *Store) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
+	if create.ClickGoal == nil {
+		create.ClickGoal = &storepb.ClickGoal{}
+	}
+	if create.RedirectRules == nil {
+		create.RedirectRules = &storepb.RedirectRules{}
+	}
+	if create.Variants == nil {
+		create.Variants = &storepb.Variants{}
+	}
+	// The links of the new shortcuts are unchecked.
+	create.LinkHealth = &storepb.LinkHealth{}
+	if err := s.checkShortcutNameAvailable(ctx, create.Name, 0); err != nil {
+		return nil, err
+	}
 	shortcut, err := s.driver.CreateShortcut(ctx, create)
 	if err != nil {
 		return nil, err
@@ -40,6 +98,11 @@ func (s *Store) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*
 }
 
 func (s *Store) UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*storepb.Shortcut, error) {
+	if update.Name != nil {
+		if err := s.checkShortcutNameAvailable(ctx, *update.Name, update.ID); err != nil {
+			return nil, err
+		}
+	}
 	shortcut, err := s.driver.UpdateShortcut(ctx, update)
 	if err != nil {
 		return nil, err
@@ -48,6 +111,26 @@ func (s *Store) UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*st
 	return shortcut, nil
 }
 
+func (s *Store) UpdateShortcutTags(ctx context.Context, update *UpdateShortcutTags) error {
+	if err := s.driver.UpdateShortcutTags(ctx, update); err != nil {
+		return err
+	}
+	for id := range update.Tags {
+		s.shortcutCache.Delete(id)
+	}
+	return nil
+}
+
+func (s *Store) MergeShortcuts(ctx context.Context, merge *MergeShortcuts) error {
+	if err := s.driver.MergeShortcuts(ctx, merge); err != nil {
+		return err
+	}
+	for _, id := range merge.ShortcutIDs {
+		s.shortcutCache.Delete(id)
+	}
+	return nil
+}
+
 func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*storepb.Shortcut, error) {
 	list, err := s.driver.ListShortcuts(ctx, find)
 	if err != nil {
@@ -63,9 +146,11 @@ func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.S
 	if find.ID != nil {
 		if cache, ok := s.shortcutCache.Load(*find.ID); ok {
 			if shortcut, ok := cache.(*storepb.Shortcut); ok {
+				s.shortcutCacheHits.Add(1)
 				return shortcut, nil
 			}
 		}
+		s.shortcutCacheMisses.Add(1)
 	}
 
 	shortcuts, err := s.ListShortcuts(ctx, find)
@@ -82,6 +167,39 @@ func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.S
 	return shortcut, nil
 }
 
+// GetShortcutsByIDs returns the shortcuts with the ids by id, from the cache or with a single query for the others.
+// The missing shortcuts, e.g. deleted ones, aren't in the map.
+func (s *Store) GetShortcutsByIDs(ctx context.Context, ids []int32) (map[int32]*storepb.Shortcut, error) {
+	shortcutMap := map[int32]*storepb.Shortcut{}
+	missingIDs := []int32{}
+	for _, id := range ids {
+		if _, ok := shortcutMap[id]; ok || slices.Contains(missingIDs, id) {
+			continue
+		}
+		if cache, ok := s.shortcutCache.Load(id); ok {
+			if shortcut, ok := cache.(*storepb.Shortcut); ok {
+				s.shortcutCacheHits.Add(1)
+				shortcutMap[id] = shortcut
+				continue
+			}
+		}
+		s.shortcutCacheMisses.Add(1)
+		missingIDs = append(missingIDs, id)
+	}
+	for _, batch := range batchIDs(missingIDs) {
+		shortcuts, err := s.ListShortcuts(ctx, &FindShortcut{
+			IDList: batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, shortcut := range shortcuts {
+			shortcutMap[shortcut.Id] = shortcut
+		}
+	}
+	return shortcutMap, nil
+}
+
 func (s *Store) DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error {
 	if err := s.driver.DeleteShortcut(ctx, delete); err != nil {
 		return err
@@ -90,3 +208,19 @@ func (s *Store) DeleteShortcut(ctx context.Context, delete *DeleteShortcut) erro
 	s.shortcutCache.Delete(delete.ID)
 	return nil
 }
+
+// SplitSearchTerms splits the search text into terms of letters and digits, as the full-text indexes tokenize the shortcuts.
+func SplitSearchTerms(search string) []string {
+	return strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// LinkBrokenFailureCount is the number of consecutive failed checks after which the link of a shortcut is broken,
+// so that a single outage of the destination isn't reported.
+const LinkBrokenFailureCount = 2
+
+// IsLinkBroken returns true if the link of the shortcut failed the consecutive checks.
+func IsLinkBroken(linkHealth *storepb.LinkHealth) bool {
+	return linkHealth.GetFailureCount() >= LinkBrokenFailureCount
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+)
+
+// ShortcutACLRole is the access a shortcut ACL gives to the user.
+type ShortcutACLRole string
+
+const (
+	// ShortcutACLRoleRead lets the user view the shortcut.
+	ShortcutACLRoleRead ShortcutACLRole = "READ"
+	// ShortcutACLRoleEdit lets the user view and edit the shortcut.
+	ShortcutACLRoleEdit ShortcutACLRole = "EDIT"
+)
+
+// ShortcutACL shares a shortcut with a user, whatever the visibility of the shortcut.
+type ShortcutACL struct {
+	ShortcutID int32
+	UserID     int32
+	Role       ShortcutACLRole
+	CreatedTs  int64
+}
+
+type FindShortcutACL struct {
+	ShortcutID *int32
+	UserID     *int32
+}
+
+type DeleteShortcutACL struct {
+	ShortcutID int32
+	UserID     int32
+}
+
+// UpsertShortcutACL shares the shortcut with the user, or changes the role of the user.
+func (s *Store) UpsertShortcutACL(ctx context.Context, upsert *ShortcutACL) (*ShortcutACL, error) {
+	return s.driver.UpsertShortcutACL(ctx, upsert)
+}
+
+func (s *Store) ListShortcutACLs(ctx context.Context, find *FindShortcutACL) ([]*ShortcutACL, error) {
+	return s.driver.ListShortcutACLs(ctx, find)
+}
+
+func (s *Store) GetShortcutACL(ctx context.Context, find *FindShortcutACL) (*ShortcutACL, error) {
+	list, err := s.ListShortcutACLs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) DeleteShortcutACL(ctx context.Context, delete *DeleteShortcutACL) error {
+	return s.driver.DeleteShortcutACL(ctx, delete)
+}
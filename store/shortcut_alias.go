@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+// ShortcutAlias is another name of a shortcut, e.g. the name of a shortcut merged into it,
+// or its name in another language.
+type ShortcutAlias struct {
+	Name       string
+	ShortcutID int32
+	CreatedTs  int64
+}
+
+// ErrShortcutNameTaken is returned when a name of a shortcut, or one of its aliases, is the name or an alias
+// of another shortcut, so that each name resolves to a single shortcut.
+var ErrShortcutNameTaken = errors.New("the name is taken by another shortcut")
+
+type FindShortcutAlias struct {
+	Name       *string
+	ShortcutID *int32
+}
+
+// UpdateShortcutAliases replaces the aliases of a shortcut in a single transaction.
+type UpdateShortcutAliases struct {
+	ShortcutID int32
+	Names      []string
+}
+
+func (s *Store) ListShortcutAliases(ctx context.Context, find *FindShortcutAlias) ([]*ShortcutAlias, error) {
+	return s.driver.ListShortcutAliases(ctx, find)
+}
+
+func (s *Store) GetShortcutAlias(ctx context.Context, find *FindShortcutAlias) (*ShortcutAlias, error) {
+	list, err := s.ListShortcutAliases(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) UpdateShortcutAliases(ctx context.Context, update *UpdateShortcutAliases) error {
+	for _, name := range update.Names {
+		if err := s.checkShortcutNameAvailable(ctx, name, update.ShortcutID); err != nil {
+			return err
+		}
+	}
+	return s.driver.UpdateShortcutAliases(ctx, update)
+}
+
+// checkShortcutNameAvailable returns ErrShortcutNameTaken when the name is the name or an alias of a shortcut
+// other than the one with the id, which is 0 for a new shortcut.
+func (s *Store) checkShortcutNameAvailable(ctx context.Context, name string, shortcutID int32) error {
+	shortcut, err := s.GetShortcut(ctx, &FindShortcut{
+		Name: &name,
+	})
+	if err != nil {
+		return err
+	}
+	if shortcut != nil && shortcut.Id != shortcutID {
+		return errors.Wrapf(ErrShortcutNameTaken, "%q is the name of another shortcut", name)
+	}
+	alias, err := s.GetShortcutAlias(ctx, &FindShortcutAlias{
+		Name: &name,
+	})
+	if err != nil {
+		return err
+	}
+	if alias != nil && alias.ShortcutID != shortcutID {
+		return errors.Wrapf(ErrShortcutNameTaken, "%q is an alias of another shortcut", name)
+	}
+	return nil
+}
+
+// GetShortcutByNameOrAlias returns the shortcut with the name, or else the shortcut the name is an alias of.
+func (s *Store) GetShortcutByNameOrAlias(ctx context.Context, name string) (*storepb.Shortcut, error) {
+	shortcut, err := s.GetShortcut(ctx, &FindShortcut{
+		Name: &name,
+	})
+	if err != nil || shortcut != nil {
+		return shortcut, err
+	}
+
+	alias, err := s.GetShortcutAlias(ctx, &FindShortcutAlias{
+		Name: &name,
+	})
+	if err != nil || alias == nil {
+		return nil, err
+	}
+	return s.GetShortcut(ctx, &FindShortcut{
+		ID: &alias.ShortcutID,
+	})
+}
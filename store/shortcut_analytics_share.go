@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+)
+
+// ShortcutAnalyticsShare is a read-only link to view the analytics of a shortcut without an account.
+type ShortcutAnalyticsShare struct {
+	ID         int32
+	ShortcutID int32
+	CreatorID  int32
+	CreatedTs  int64
+	// Description tells whom the link is shared with, e.g. a client.
+	Description string
+	// Token is the secret of the share link.
+	Token    string
+	ExpireTs int64
+	// ViewCount is the number of times the share link was opened.
+	ViewCount    int32
+	LastViewedTs int64
+}
+
+type FindShortcutAnalyticsShare struct {
+	ID         *int32
+	ShortcutID *int32
+	Token      *string
+}
+
+type DeleteShortcutAnalyticsShare struct {
+	ID int32
+}
+
+func (s *Store) CreateShortcutAnalyticsShare(ctx context.Context, create *ShortcutAnalyticsShare) (*ShortcutAnalyticsShare, error) {
+	return s.driver.CreateShortcutAnalyticsShare(ctx, create)
+}
+
+func (s *Store) ListShortcutAnalyticsShares(ctx context.Context, find *FindShortcutAnalyticsShare) ([]*ShortcutAnalyticsShare, error) {
+	return s.driver.ListShortcutAnalyticsShares(ctx, find)
+}
+
+func (s *Store) GetShortcutAnalyticsShare(ctx context.Context, find *FindShortcutAnalyticsShare) (*ShortcutAnalyticsShare, error) {
+	list, err := s.ListShortcutAnalyticsShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+// RecordShortcutAnalyticsShareView increases the view count of the share link, and sets the last viewed time to viewedTs.
+func (s *Store) RecordShortcutAnalyticsShareView(ctx context.Context, id int32, viewedTs int64) error {
+	return s.driver.RecordShortcutAnalyticsShareView(ctx, id, viewedTs)
+}
+
+func (s *Store) DeleteShortcutAnalyticsShare(ctx context.Context, delete *DeleteShortcutAnalyticsShare) error {
+	return s.driver.DeleteShortcutAnalyticsShare(ctx, delete)
+}
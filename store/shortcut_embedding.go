@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// ShortcutEmbedding is the embedding of the text of a shortcut, compared with the embedding of the query
+// by the semantic search.
+type ShortcutEmbedding struct {
+	ShortcutID int32
+	// Model is the model of the embedding, as the embeddings of different models aren't comparable.
+	Model string
+	// ContentHash is the hash of the embedded text, so that the shortcut is embedded again once it changes.
+	ContentHash string
+	Embedding   []float32
+	UpdatedTs   int64
+}
+
+type FindShortcutEmbedding struct {
+	ShortcutID *int32
+	Model      *string
+}
+
+type DeleteShortcutEmbedding struct {
+	ShortcutID int32
+}
+
+func (s *Store) UpsertShortcutEmbedding(ctx context.Context, upsert *ShortcutEmbedding) (*ShortcutEmbedding, error) {
+	return s.driver.UpsertShortcutEmbedding(ctx, upsert)
+}
+
+func (s *Store) ListShortcutEmbeddings(ctx context.Context, find *FindShortcutEmbedding) ([]*ShortcutEmbedding, error) {
+	return s.driver.ListShortcutEmbeddings(ctx, find)
+}
+
+func (s *Store) DeleteShortcutEmbedding(ctx context.Context, delete *DeleteShortcutEmbedding) error {
+	return s.driver.DeleteShortcutEmbedding(ctx, delete)
+}
+
+// MarshalEmbedding encodes the embedding as little-endian float32 values.
+func MarshalEmbedding(embedding []float32) []byte {
+	bytes := make([]byte, 4*len(embedding))
+	for i, value := range embedding {
+		binary.LittleEndian.PutUint32(bytes[4*i:], math.Float32bits(value))
+	}
+	return bytes
+}
+
+// UnmarshalEmbedding decodes the embedding encoded by MarshalEmbedding.
+func UnmarshalEmbedding(bytes []byte) ([]float32, error) {
+	if len(bytes)%4 != 0 {
+		return nil, errors.Errorf("invalid embedding of %d bytes", len(bytes))
+	}
+	embedding := make([]float32, len(bytes)/4)
+	for i := range embedding {
+		embedding[i] = math.Float32frombits(binary.LittleEndian.Uint32(bytes[4*i:]))
+	}
+	return embedding, nil
+}
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+)
+
+type ShortcutProposedChangeStatus string
+
+const (
+	// ShortcutProposedChangePending is the status of the changes waiting for approval.
+	ShortcutProposedChangePending ShortcutProposedChangeStatus = "PENDING"
+	// ShortcutProposedChangeApproved is the status of the changes applied to the shortcut.
+	ShortcutProposedChangeApproved ShortcutProposedChangeStatus = "APPROVED"
+	// ShortcutProposedChangeRejected is the status of the rejected changes.
+	ShortcutProposedChangeRejected ShortcutProposedChangeStatus = "REJECTED"
+)
+
+func (s ShortcutProposedChangeStatus) String() string {
+	return string(s)
+}
+
+// ShortcutProposedChange is a change of a protected shortcut proposed by a user other than its creator and the admins.
+type ShortcutProposedChange struct {
+	ID         int32
+	ShortcutID int32
+	ProposerID int32
+	CreatedTs  int64
+	Status     ShortcutProposedChangeStatus
+	Payload    *storepb.ShortcutProposedChangePayload
+	// ReviewerID is the user who approved or rejected the change, 0 while it's pending.
+	ReviewerID int32
+	ReviewedTs int64
+}
+
+type UpdateShortcutProposedChange struct {
+	ID int32
+
+	Status     ShortcutProposedChangeStatus
+	ReviewerID int32
+	ReviewedTs int64
+}
+
+type FindShortcutProposedChange struct {
+	ID         *int32
+	ShortcutID *int32
+	ProposerID *int32
+	Status     *ShortcutProposedChangeStatus
+}
+
+func (s *Store) CreateShortcutProposedChange(ctx context.Context, create *ShortcutProposedChange) (*ShortcutProposedChange, error) {
+	return s.driver.CreateShortcutProposedChange(ctx, create)
+}
+
+// UpdateShortcutProposedChange updates the status of the change, if it's still pending.
+// It returns nil if the change was reviewed in the meantime.
+func (s *Store) UpdateShortcutProposedChange(ctx context.Context, update *UpdateShortcutProposedChange) (*ShortcutProposedChange, error) {
+	return s.driver.UpdateShortcutProposedChange(ctx, update)
+}
+
+func (s *Store) ListShortcutProposedChanges(ctx context.Context, find *FindShortcutProposedChange) ([]*ShortcutProposedChange, error) {
+	return s.driver.ListShortcutProposedChanges(ctx, find)
+}
+
+func (s *Store) GetShortcutProposedChange(ctx context.Context, find *FindShortcutProposedChange) (*ShortcutProposedChange, error) {
+	list, err := s.ListShortcutProposedChanges(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
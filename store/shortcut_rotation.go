@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+)
+
+// ShortcutRotation is a scheduled link of a shortcut, which the shortcut resolves to from its start time until its end time.
+type ShortcutRotation struct {
+	ID         int32
+	ShortcutID int32
+	CreatorID  int32
+	CreatedTs  int64
+	Link       string
+	StartTs    int64
+	// EndTs is the time the rotation stops, 0 means until a later rotation starts.
+	EndTs int64
+}
+
+type FindShortcutRotation struct {
+	ID         *int32
+	ShortcutID *int32
+	// ActiveTs finds the rotations active at the time, in unix seconds.
+	ActiveTs *int64
+}
+
+type DeleteShortcutRotation struct {
+	ID int32
+}
+
+func (s *Store) CreateShortcutRotation(ctx context.Context, create *ShortcutRotation) (*ShortcutRotation, error) {
+	return s.driver.CreateShortcutRotation(ctx, create)
+}
+
+// ListShortcutRotations returns the rotations ordered by start time, the latest first.
+func (s *Store) ListShortcutRotations(ctx context.Context, find *FindShortcutRotation) ([]*ShortcutRotation, error) {
+	return s.driver.ListShortcutRotations(ctx, find)
+}
+
+func (s *Store) GetShortcutRotation(ctx context.Context, find *FindShortcutRotation) (*ShortcutRotation, error) {
+	list, err := s.ListShortcutRotations(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) DeleteShortcutRotation(ctx context.Context, delete *DeleteShortcutRotation) error {
+	return s.driver.DeleteShortcutRotation(ctx, delete)
+}
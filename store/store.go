@@ -1,7 +1,9 @@
 package store
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/warthurton/slash/server/profile"
 )
@@ -15,6 +17,20 @@ type Store struct {
 	userCache             sync.Map // map[int]*User
 	userSettingCache      sync.Map // map[string]*UserSetting
 	shortcutCache         sync.Map // map[int]*Shortcut
+
+	// shortcutCacheHits and shortcutCacheMisses count the shortcut lookups by id, for the cache hit rate.
+	shortcutCacheHits   atomic.Int64
+	shortcutCacheMisses atomic.Int64
+
+	accessTokenUsageBuffer sync.Map // map[accessTokenUsageKey]int64
+	// accessTokenMutexes serializes the updates of the access tokens of each user.
+	accessTokenMutexes sync.Map // map[int32]*sync.Mutex
+
+	// activityWALMutex serializes the writes of the activity write-ahead log.
+	activityWALMutex sync.Mutex
+
+	// migrated is set once the migrations are done.
+	migrated atomic.Bool
 }
 
 // New creates a new instance of Store.
@@ -25,6 +41,16 @@ func New(driver Driver, profile *profile.Profile) *Store {
 	}
 }
 
+// Ping checks that the database is reachable.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.driver.GetDB().PingContext(ctx)
+}
+
+// IsMigrated returns whether the migrations are done.
+func (s *Store) IsMigrated() bool {
+	return s.migrated.Load()
+}
+
 // Close closes the database connection.
 func (s *Store) Close() error {
 	return s.driver.Close()
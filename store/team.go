@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+)
+
+// Team is a group of users, which the shortcuts and collections with the TEAM visibility are shared with.
+type Team struct {
+	ID          int32
+	CreatedTs   int64
+	UpdatedTs   int64
+	Name        string
+	Description string
+}
+
+type UpdateTeam struct {
+	ID          int32
+	Name        *string
+	Description *string
+}
+
+type FindTeam struct {
+	ID   *int32
+	Name *string
+}
+
+// DeleteTeam deletes the team with its memberships.
+type DeleteTeam struct {
+	ID int32
+}
+
+// TeamMember is the membership of a user in a team.
+type TeamMember struct {
+	TeamID    int32
+	UserID    int32
+	CreatedTs int64
+}
+
+type FindTeamMember struct {
+	TeamID *int32
+	UserID *int32
+}
+
+type DeleteTeamMember struct {
+	TeamID int32
+	UserID int32
+}
+
+func (s *Store) CreateTeam(ctx context.Context, create *Team) (*Team, error) {
+	return s.driver.CreateTeam(ctx, create)
+}
+
+func (s *Store) UpdateTeam(ctx context.Context, update *UpdateTeam) (*Team, error) {
+	return s.driver.UpdateTeam(ctx, update)
+}
+
+func (s *Store) ListTeams(ctx context.Context, find *FindTeam) ([]*Team, error) {
+	return s.driver.ListTeams(ctx, find)
+}
+
+func (s *Store) GetTeam(ctx context.Context, find *FindTeam) (*Team, error) {
+	list, err := s.ListTeams(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) DeleteTeam(ctx context.Context, delete *DeleteTeam) error {
+	return s.driver.DeleteTeam(ctx, delete)
+}
+
+func (s *Store) CreateTeamMember(ctx context.Context, create *TeamMember) (*TeamMember, error) {
+	return s.driver.CreateTeamMember(ctx, create)
+}
+
+func (s *Store) ListTeamMembers(ctx context.Context, find *FindTeamMember) ([]*TeamMember, error) {
+	return s.driver.ListTeamMembers(ctx, find)
+}
+
+func (s *Store) GetTeamMember(ctx context.Context, find *FindTeamMember) (*TeamMember, error) {
+	list, err := s.ListTeamMembers(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) DeleteTeamMember(ctx context.Context, delete *DeleteTeamMember) error {
+	return s.driver.DeleteTeamMember(ctx, delete)
+}
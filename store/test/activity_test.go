@@ -2,11 +2,14 @@ package teststore
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/warthurton/slash/store"
+	"github.com/warthurton/slash/store/db"
 )
 
 func TestActivityStore(t *testing.T) {
@@ -29,3 +32,194 @@ func TestActivityStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	require.Equal(t, activity, list[0])
 }
+
+func TestShortcutViewCounts(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, shortcutID := range []int32{1, 1, 2} {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcutID),
+		})
+		require.NoError(t, err)
+	}
+	viewCounts, err := ts.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{})
+	require.NoError(t, err)
+	countMap := map[int32]int32{}
+	for _, viewCount := range viewCounts {
+		countMap[viewCount.ShortcutID] = viewCount.Count
+	}
+	require.Equal(t, map[int32]int32{1: 2, 2: 1}, countMap)
+	createdTsAfter := time.Now().Add(time.Hour).Unix()
+	viewCounts, err = ts.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+		CreatedTsAfter: &createdTsAfter,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(viewCounts))
+}
+
+func TestShortcutViewAggregation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, payload := range []string{
+		`{"shortcutId":1,"referer":"https://a.com","country":"US","userId":2,"variant":"A"}`,
+		`{"shortcutId":1,"referer":"https://a.com","country":"DE","userId":2}`,
+		`{"shortcutId":1,"country":"US","variant":"A"}`,
+		`{"shortcutId":2,"referer":"https://b.com"}`,
+	} {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   payload,
+		})
+		require.NoError(t, err)
+	}
+
+	viewGroups, err := ts.ListShortcutViewGroups(ctx, &store.FindShortcutViewGroup{
+		ShortcutID: 1,
+		Field:      store.ShortcutViewFieldReferer,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutViewGroup{{Value: "https://a.com", Count: 2}, {Value: "", Count: 1}}, viewGroups)
+	viewGroups, err = ts.ListShortcutViewGroups(ctx, &store.FindShortcutViewGroup{
+		ShortcutID: 1,
+		Field:      store.ShortcutViewFieldCountry,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutViewGroup{{Value: "US", Count: 2}, {Value: "DE", Count: 1}}, viewGroups)
+	viewGroups, err = ts.ListShortcutViewGroups(ctx, &store.FindShortcutViewGroup{
+		ShortcutID: 1,
+		Field:      store.ShortcutViewFieldUserID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutViewGroup{{Value: "2", Count: 2}, {Value: "", Count: 1}}, viewGroups)
+	viewGroups, err = ts.ListShortcutViewGroups(ctx, &store.FindShortcutViewGroup{
+		ShortcutID: 1,
+		Field:      store.ShortcutViewFieldVariant,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutViewGroup{{Value: "A", Count: 2}, {Value: "", Count: 1}}, viewGroups)
+	_, err = ts.ListShortcutViewGroups(ctx, &store.FindShortcutViewGroup{
+		ShortcutID: 1,
+		Field:      store.ShortcutViewField("ip') FROM user --"),
+	})
+	require.Error(t, err)
+
+	day := int64(24 * 60 * 60)
+	viewBuckets, err := ts.ListShortcutViewBuckets(ctx, &store.FindShortcutViewBucket{
+		ShortcutID: 1,
+		BucketSize: day,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(viewBuckets))
+	require.Equal(t, int32(3), viewBuckets[0].Count)
+	require.Equal(t, time.Now().Unix()/day*day, viewBuckets[0].StartTs)
+}
+
+func TestDeleteActivities(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, activityType := range []store.ActivityType{store.ActivityShortcutView, store.ActivityShortcutView, store.ActivityShortcutCreate} {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      activityType,
+			Level:     store.ActivityInfo,
+			Payload:   "{}",
+		})
+		require.NoError(t, err)
+	}
+
+	limit := 1
+	oldest, err := ts.GetActivity(ctx, &store.FindActivity{
+		Type:  store.ActivityShortcutView,
+		Limit: &limit,
+	})
+	require.NoError(t, err)
+	list, err := ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Equal(t, list[0].ID, oldest.ID)
+
+	createdTsBefore := time.Now().Unix() - 3600
+	list, err = ts.ListActivities(ctx, &store.FindActivity{
+		CreatedTsBefore: &createdTsBefore,
+	})
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	// Only the activities of the type are deleted.
+	createdTsBefore = time.Now().Unix() + 3600
+	require.NoError(t, ts.DeleteActivities(ctx, &store.DeleteActivities{
+		Type:            store.ActivityShortcutView,
+		CreatedTsBefore: &createdTsBefore,
+	}))
+	list, err = ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, store.ActivityShortcutCreate, list[0].Type)
+	require.NoError(t, ts.EnsureActivityPartition(ctx, time.Now()))
+}
+
+func TestActivityWAL(t *testing.T) {
+	ctx := context.Background()
+	profile := getTestingProfile(t)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	resetTestingDB(ctx, profile, dbDriver)
+	ts := store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	queued, err := ts.QueueActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutView,
+		Level:     store.ActivityInfo,
+		Payload:   `{"shortcutId":1}`,
+	})
+	require.NoError(t, err)
+	require.False(t, queued)
+
+	// The views are queued once the database is closed, e.g. on shutdown.
+	require.NoError(t, ts.Close())
+	createdTs := time.Now().Add(-time.Hour).Unix()
+	for i := 0; i < 2; i++ {
+		queued, err := ts.QueueActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			CreatedTs: createdTs,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   `{"shortcutId":2}`,
+		})
+		require.NoError(t, err)
+		require.True(t, queued)
+	}
+
+	// The queued views are created with their time on the next start.
+	dbDriver, err = db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts = store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+	count, err := ts.ReplayActivityWAL(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	list, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutView,
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	require.Equal(t, createdTs, list[2].CreatedTs)
+
+	// The log is removed once replayed.
+	count, err = ts.ReplayActivityWAL(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
@@ -0,0 +1,45 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/warthurton/slash/store"
+)
+
+func TestBlobStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	blob, err := ts.CreateBlob(ctx, &store.Blob{
+		CreatorID: user.ID,
+		Type:      "image/png",
+		Blob:      []byte("avatar"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(6), blob.Size)
+	user, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:           user.ID,
+		AvatarBlobID: &blob.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, blob.ID, user.AvatarBlobID)
+	found, err := ts.GetBlob(ctx, &store.FindBlob{
+		ID: &user.AvatarBlobID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", found.Type)
+	require.Equal(t, []byte("avatar"), found.Blob)
+	err = ts.DeleteBlob(ctx, &store.DeleteBlob{
+		ID: blob.ID,
+	})
+	require.NoError(t, err)
+	blobs, err := ts.ListBlobs(ctx, &store.FindBlob{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(blobs))
+}
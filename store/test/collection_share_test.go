@@ -0,0 +1,97 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestCollectionShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "test",
+		Title:       "My collection",
+		ShortcutIds: []int32{},
+		Visibility:  storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+
+	expireTs := time.Now().Add(24 * time.Hour).Unix()
+	collectionShare, err := ts.CreateCollectionShare(ctx, &store.CollectionShare{
+		CollectionID: collection.Id,
+		CreatorID:    user.ID,
+		Email:        "guest@example.com",
+		Token:        "secret",
+		ExpireTs:     expireTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), collectionShare.ViewCount)
+	token := "secret"
+	found, err := ts.GetCollectionShare(ctx, &store.FindCollectionShare{
+		Token: &token,
+	})
+	require.NoError(t, err)
+	require.Equal(t, collectionShare, found)
+
+	viewedTs := time.Now().Unix()
+	err = ts.RecordCollectionShareView(ctx, collectionShare.ID, viewedTs)
+	require.NoError(t, err)
+	err = ts.RecordCollectionShareView(ctx, collectionShare.ID, viewedTs)
+	require.NoError(t, err)
+	found, err = ts.GetCollectionShare(ctx, &store.FindCollectionShare{
+		ID: &collectionShare.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), found.ViewCount)
+	require.Equal(t, viewedTs, found.LastViewedTs)
+
+	err = ts.DeleteCollectionShare(ctx, &store.DeleteCollectionShare{
+		ID: collectionShare.ID,
+	})
+	require.NoError(t, err)
+	collectionShares, err := ts.ListCollectionShares(ctx, &store.FindCollectionShare{
+		CollectionID: &collection.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collectionShares))
+}
+
+func TestCollectionShareDeletedWithCollection(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "test",
+		Title:       "My collection",
+		ShortcutIds: []int32{},
+		Visibility:  storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollectionShare(ctx, &store.CollectionShare{
+		CollectionID: collection.Id,
+		CreatorID:    user.ID,
+		Email:        "guest@example.com",
+		Token:        "secret",
+		ExpireTs:     time.Now().Add(24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	err = ts.DeleteCollection(ctx, &store.DeleteCollection{
+		ID: collection.Id,
+	})
+	require.NoError(t, err)
+	collectionShares, err := ts.ListCollectionShares(ctx, &store.FindCollectionShare{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collectionShares))
+}
@@ -50,3 +50,40 @@ func TestCollectionStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(collections))
 }
+
+func TestCollectionRowStatus(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Title:      "My collection",
+		Visibility: storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_NORMAL, collection.RowStatus)
+	archived := storepb.RowStatus_ARCHIVED
+	archivedCollection, err := ts.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:        collection.Id,
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, archivedCollection.RowStatus)
+
+	normal := storepb.RowStatus_NORMAL
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{
+		RowStatus: &normal,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collections))
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+}
@@ -0,0 +1,82 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/warthurton/slash/store"
+)
+
+func TestIdentityProviderLinkStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	idpID, identifier := "idp", "user@example.com"
+
+	identityProviderLink, err := ts.GetIdentityProviderLink(ctx, &store.FindIdentityProviderLink{
+		IdpID:      &idpID,
+		Identifier: &identifier,
+	})
+	require.NoError(t, err)
+	require.Nil(t, identityProviderLink)
+
+	_, err = ts.UpsertIdentityProviderLink(ctx, &store.IdentityProviderLink{
+		UserID:     user.ID,
+		IdpID:      idpID,
+		Identifier: identifier,
+	})
+	require.NoError(t, err)
+	identityProviderLink, err = ts.GetIdentityProviderLink(ctx, &store.FindIdentityProviderLink{
+		IdpID:      &idpID,
+		Identifier: &identifier,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, identityProviderLink)
+	require.Equal(t, user.ID, identityProviderLink.UserID)
+
+	// Linking another identity of the same identity provider replaces the existing link.
+	_, err = ts.UpsertIdentityProviderLink(ctx, &store.IdentityProviderLink{
+		UserID:     user.ID,
+		IdpID:      idpID,
+		Identifier: "another@example.com",
+	})
+	require.NoError(t, err)
+	identityProviderLinks, err := ts.ListIdentityProviderLinks(ctx, &store.FindIdentityProviderLink{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(identityProviderLinks))
+	require.Equal(t, "another@example.com", identityProviderLinks[0].Identifier)
+	identityProviderLink, err = ts.GetIdentityProviderLink(ctx, &store.FindIdentityProviderLink{
+		IdpID:      &idpID,
+		Identifier: &identifier,
+	})
+	require.NoError(t, err)
+	require.Nil(t, identityProviderLink)
+
+	// An identity is linked to a single user.
+	anotherUser, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "another@example.com",
+		Nickname: "another",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertIdentityProviderLink(ctx, &store.IdentityProviderLink{
+		UserID:     anotherUser.ID,
+		IdpID:      idpID,
+		Identifier: "another@example.com",
+	})
+	require.Error(t, err)
+
+	// The links are deleted with the user.
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: user.ID,
+	})
+	require.NoError(t, err)
+	identityProviderLinks, err = ts.ListIdentityProviderLinks(ctx, &store.FindIdentityProviderLink{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(identityProviderLinks))
+}
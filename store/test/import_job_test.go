@@ -0,0 +1,91 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestImportJobStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	payload := &storepb.ImportJobPayload{
+		Rows: []*storepb.ImportJobRow{
+			{Name: "docs", Link: "https://docs.example.com", Tags: []string{"eng"}},
+			{Name: "wiki", Link: "https://wiki.example.com", Visibility: storepb.Visibility_PRIVATE},
+		},
+	}
+	first, err := ts.CreateImportJob(ctx, &store.ImportJob{
+		CreatorID: user.ID,
+		Format:    "csv",
+		Payload:   payload,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.ImportJobPending, first.Status)
+	require.True(t, proto.Equal(payload, first.Payload))
+	second, err := ts.CreateImportJob(ctx, &store.ImportJob{
+		CreatorID: user.ID,
+		Format:    "netscape",
+		Payload:   &storepb.ImportJobPayload{},
+	})
+	require.NoError(t, err)
+
+	// The pending jobs are claimed once, the oldest first.
+	claimed, err := ts.ClaimImportJob(ctx)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, claimed.ID)
+	require.Equal(t, store.ImportJobRunning, claimed.Status)
+	claimed, err = ts.ClaimImportJob(ctx)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, claimed.ID)
+	claimed, err = ts.ClaimImportJob(ctx)
+	require.NoError(t, err)
+	require.Nil(t, claimed)
+
+	failed, processedRows, failedCount, jobError := store.ImportJobFailed, int32(1), int32(1), "database is locked"
+	result := &storepb.ImportJobResult{
+		RowErrors: []*storepb.ImportJobRowError{{Row: 1, Name: "docs", Error: "name taken"}},
+	}
+	updated, err := ts.UpdateImportJob(ctx, &store.UpdateImportJob{
+		ID:            first.ID,
+		Status:        &failed,
+		ProcessedRows: &processedRows,
+		FailedCount:   &failedCount,
+		Result:        result,
+		Error:         &jobError,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.ImportJobFailed, updated.Status)
+	require.Equal(t, int32(1), updated.ProcessedRows)
+	require.Equal(t, jobError, updated.Error)
+	found, err := ts.GetImportJob(ctx, &store.FindImportJob{
+		ID: &first.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(result, found.Result))
+	require.True(t, proto.Equal(payload, found.Payload))
+
+	importJobs, err := ts.ListImportJobs(ctx, &store.FindImportJob{
+		CreatorID: &user.ID,
+		Status:    &failed,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(importJobs))
+
+	require.NoError(t, ts.DeleteImportJob(ctx, &store.DeleteImportJob{
+		ID: second.ID,
+	}))
+	importJobs, err = ts.ListImportJobs(ctx, &store.FindImportJob{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(importJobs))
+}
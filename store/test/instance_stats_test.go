@@ -0,0 +1,41 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestInstanceStats(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+	stats, err := ts.GetInstanceStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.ShortcutCount)
+	require.Equal(t, int64(0), stats.CollectionCount)
+	require.Equal(t, int64(1), stats.UserCount)
+	require.Equal(t, int64(0), stats.ActivityCount)
+	require.Positive(t, stats.DatabaseSize)
+
+	// The created shortcut is cached, the missing one isn't.
+	require.Equal(t, float64(0), ts.GetShortcutCacheHitRate())
+	_, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	missingID := shortcut.Id + 1
+	_, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &missingID})
+	require.NoError(t, err)
+	require.Equal(t, 0.5, ts.GetShortcutCacheHitRate())
+}
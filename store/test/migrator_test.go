@@ -1,6 +1,7 @@
 package teststore
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -16,11 +17,11 @@ func TestGetCurrentSchemaVersion(t *testing.T) {
 	}{
 		{
 			driver:   "sqlite",
-			expected: "1.0.1",
+			expected: "1.0.28",
 		},
 		{
 			driver:   "postgres",
-			expected: "1.0.1",
+			expected: "1.0.28",
 		},
 	}
 
@@ -43,6 +44,16 @@ func newTestingStoreWithConfig(driver string) *store.Store {
 	return store.New(nil, profile)
 }
 
+func TestMigrateMarksStoreMigrated(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingStoreWithConfig("sqlite")
+	require.False(t, s.IsMigrated())
+
+	ts := NewTestingStore(ctx, t)
+	require.True(t, ts.IsMigrated())
+	require.NoError(t, ts.Ping(ctx))
+}
+
 func TestMigratorValidation(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -120,7 +131,7 @@ func TestGetSchemaVersionOfMigrateScript(t *testing.T) {
 		{
 			name:     "latest schema file",
 			filePath: "migration/sqlite/LATEST.sql",
-			want:     "1.0.1", // This depends on current version
+			want:     "1.0.28", // This depends on current version
 			wantErr:  false,
 		},
 		{
@@ -0,0 +1,76 @@
+package teststore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+	"github.com/warthurton/slash/store/db"
+)
+
+func TestShadowDriver(t *testing.T) {
+	ctx := context.Background()
+	profile := getTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("the shadow driver test runs on sqlite")
+	}
+	profile.ShadowDriver = "sqlite"
+	profile.ShadowDSN = fmt.Sprintf("%s/slash_shadow.db", profile.Data)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+	defer ts.Close()
+
+	var logs bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	defer slog.SetDefault(defaultLogger)
+
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "docs",
+		Link:       "https://docs.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		Tags:       []string{"eng"},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Empty(t, logs.String())
+
+	// The writes are mirrored to the shadow database.
+	shadowProfile := *profile
+	shadowProfile.DSN, shadowProfile.ShadowDSN = profile.ShadowDSN, ""
+	shadowDriver, err := db.NewDBDriver(&shadowProfile)
+	require.NoError(t, err)
+	shadowStore := store.New(shadowDriver, &shadowProfile)
+	defer shadowStore.Close()
+	shadowShortcuts, err := shadowStore.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Len(t, shadowShortcuts, 1)
+	require.Equal(t, shortcut.Name, shadowShortcuts[0].Name)
+	require.Equal(t, shortcut.Id, shadowShortcuts[0].Id)
+
+	// The divergences are logged, while the primary result is returned.
+	link := "https://diverged.link"
+	_, err = shadowStore.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Link: &link,
+	})
+	require.NoError(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, "https://docs.link", shortcuts[0].Link)
+	require.Contains(t, logs.String(), "shadow database diverged")
+	require.Contains(t, logs.String(), "method=ListShortcuts")
+}
@@ -0,0 +1,121 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestShortcutACLStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	creator, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@example.com",
+		Username: "user",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  creator.ID,
+		Name:       "salaries",
+		Link:       "https://example.com/salaries",
+		Visibility: storepb.Visibility_PRIVATE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_PRIVATE, shortcut.Visibility)
+
+	// The private shortcuts are hidden from the users they aren't shared with.
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ViewerID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+
+	shortcutACL, err := ts.UpsertShortcutACL(ctx, &store.ShortcutACL{
+		ShortcutID: shortcut.Id,
+		UserID:     user.ID,
+		Role:       store.ShortcutACLRoleRead,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.ShortcutACLRoleRead, shortcutACL.Role)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		ViewerID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		ViewerID: &creator.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+
+	// Upserting again changes the role.
+	_, err = ts.UpsertShortcutACL(ctx, &store.ShortcutACL{
+		ShortcutID: shortcut.Id,
+		UserID:     user.ID,
+		Role:       store.ShortcutACLRoleEdit,
+	})
+	require.NoError(t, err)
+	shortcutACLs, err := ts.ListShortcutACLs(ctx, &store.FindShortcutACL{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcutACLs))
+	require.Equal(t, store.ShortcutACLRoleEdit, shortcutACLs[0].Role)
+
+	err = ts.DeleteShortcutACL(ctx, &store.DeleteShortcutACL{
+		ShortcutID: shortcut.Id,
+		UserID:     user.ID,
+	})
+	require.NoError(t, err)
+	shortcutACL, err = ts.GetShortcutACL(ctx, &store.FindShortcutACL{
+		ShortcutID: &shortcut.Id,
+		UserID:     &user.ID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, shortcutACL)
+
+	// The ACLs are deleted with the shortcut and with the user.
+	_, err = ts.UpsertShortcutACL(ctx, &store.ShortcutACL{
+		ShortcutID: shortcut.Id,
+		UserID:     user.ID,
+		Role:       store.ShortcutACLRoleRead,
+	})
+	require.NoError(t, err)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: user.ID,
+	})
+	require.NoError(t, err)
+	shortcutACLs, err = ts.ListShortcutACLs(ctx, &store.FindShortcutACL{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcutACLs))
+
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@example.com",
+		Username: "other",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertShortcutACL(ctx, &store.ShortcutACL{
+		ShortcutID: shortcut.Id,
+		UserID:     other.ID,
+		Role:       store.ShortcutACLRoleRead,
+	})
+	require.NoError(t, err)
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	shortcutACLs, err = ts.ListShortcutACLs(ctx, &store.FindShortcutACL{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcutACLs))
+}
@@ -0,0 +1,207 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestMergeShortcuts(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcuts := []*storepb.Shortcut{}
+	for _, name := range []string{"docs", "doc", "documentation", "handbook"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://" + name + ".link",
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		shortcuts = append(shortcuts, shortcut)
+	}
+	for _, shortcut := range shortcuts[:3] {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcut.Id),
+		})
+		require.NoError(t, err)
+	}
+
+	survivor := shortcuts[0]
+	err = ts.MergeShortcuts(ctx, &store.MergeShortcuts{
+		SurvivorID:  survivor.Id,
+		ShortcutIDs: []int32{shortcuts[1].Id, shortcuts[2].Id},
+	})
+	require.NoError(t, err)
+	list, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	aliases, err := ts.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutID: &survivor.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(aliases))
+	require.Equal(t, "doc", aliases[0].Name)
+	require.Equal(t, "documentation", aliases[1].Name)
+	viewCounts, err := ts.ListShortcutViewCounts(ctx, &store.FindShortcutViewCount{
+		ShortcutID: &survivor.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(viewCounts))
+	require.Equal(t, int32(3), viewCounts[0].Count)
+	shortcut, err := ts.GetShortcutByNameOrAlias(ctx, "documentation")
+	require.NoError(t, err)
+	require.Equal(t, survivor.Id, shortcut.Id)
+
+	// The aliases of a merged shortcut follow it into the survivor.
+	err = ts.MergeShortcuts(ctx, &store.MergeShortcuts{
+		SurvivorID:  shortcuts[3].Id,
+		ShortcutIDs: []int32{survivor.Id},
+	})
+	require.NoError(t, err)
+	aliases, err = ts.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutID: &shortcuts[3].Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(aliases))
+	shortcut, err = ts.GetShortcutByNameOrAlias(ctx, "doc")
+	require.NoError(t, err)
+	require.Equal(t, shortcuts[3].Id, shortcut.Id)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcuts[3].Id,
+	})
+	require.NoError(t, err)
+	aliases, err = ts.ListShortcutAliases(ctx, &store.FindShortcutAlias{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(aliases))
+	shortcut, err = ts.GetShortcutByNameOrAlias(ctx, "doc")
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+}
+
+func TestUpdateShortcutAliases(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "help",
+		Link:       "https://help.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	err = ts.UpdateShortcutAliases(ctx, &store.UpdateShortcutAliases{
+		ShortcutID: shortcut.Id,
+		Names:      []string{"hilfe", "aide", "帮助"},
+	})
+	require.NoError(t, err)
+	found, err := ts.GetShortcutByNameOrAlias(ctx, "帮助")
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Id, found.Id)
+
+	// The aliases are replaced as a set.
+	err = ts.UpdateShortcutAliases(ctx, &store.UpdateShortcutAliases{
+		ShortcutID: shortcut.Id,
+		Names:      []string{"ayuda", "hilfe"},
+	})
+	require.NoError(t, err)
+	aliases, err := ts.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(aliases))
+	require.Equal(t, "ayuda", aliases[0].Name)
+	require.Equal(t, "hilfe", aliases[1].Name)
+	found, err = ts.GetShortcutByNameOrAlias(ctx, "aide")
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	// An alias can't be shared with another shortcut, and the failed update keeps the previous aliases.
+	other, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "support",
+		Link:       "https://support.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	err = ts.UpdateShortcutAliases(ctx, &store.UpdateShortcutAliases{
+		ShortcutID: other.Id,
+		Names:      []string{"soporte", "ayuda"},
+	})
+	require.Error(t, err)
+	aliases, err = ts.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutID: &other.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(aliases))
+}
+
+func TestShortcutNamesAndAliasesUnique(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcuts := []*storepb.Shortcut{}
+	for _, name := range []string{"help", "support"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://" + name + ".link",
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		shortcuts = append(shortcuts, shortcut)
+	}
+	err = ts.UpdateShortcutAliases(ctx, &store.UpdateShortcutAliases{
+		ShortcutID: shortcuts[0].Id,
+		Names:      []string{"hilfe"},
+	})
+	require.NoError(t, err)
+
+	// The aliases can't take the names of the other shortcuts.
+	err = ts.UpdateShortcutAliases(ctx, &store.UpdateShortcutAliases{
+		ShortcutID: shortcuts[0].Id,
+		Names:      []string{"support"},
+	})
+	require.ErrorIs(t, err, store.ErrShortcutNameTaken)
+
+	// The shortcuts can't be created or renamed with the aliases of the others.
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "hilfe",
+		Link:       "https://hilfe.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.ErrorIs(t, err, store.ErrShortcutNameTaken)
+	name := "hilfe"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcuts[1].Id,
+		Name: &name,
+	})
+	require.ErrorIs(t, err, store.ErrShortcutNameTaken)
+
+	// A shortcut can be renamed to its own alias.
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcuts[0].Id,
+		Name: &name,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,92 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestShortcutAnalyticsShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "launch",
+		Link:       "https://example.com/launch",
+		Visibility: storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+
+	expireTs := time.Now().Add(24 * time.Hour).Unix()
+	shortcutAnalyticsShare, err := ts.CreateShortcutAnalyticsShare(ctx, &store.ShortcutAnalyticsShare{
+		ShortcutID:  shortcut.Id,
+		CreatorID:   user.ID,
+		Description: "Acme",
+		Token:       "secret",
+		ExpireTs:    expireTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), shortcutAnalyticsShare.ViewCount)
+	token := "secret"
+	found, err := ts.GetShortcutAnalyticsShare(ctx, &store.FindShortcutAnalyticsShare{
+		Token: &token,
+	})
+	require.NoError(t, err)
+	require.Equal(t, shortcutAnalyticsShare, found)
+
+	viewedTs := time.Now().Unix()
+	err = ts.RecordShortcutAnalyticsShareView(ctx, shortcutAnalyticsShare.ID, viewedTs)
+	require.NoError(t, err)
+	found, err = ts.GetShortcutAnalyticsShare(ctx, &store.FindShortcutAnalyticsShare{
+		ID: &shortcutAnalyticsShare.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), found.ViewCount)
+	require.Equal(t, viewedTs, found.LastViewedTs)
+
+	err = ts.DeleteShortcutAnalyticsShare(ctx, &store.DeleteShortcutAnalyticsShare{
+		ID: shortcutAnalyticsShare.ID,
+	})
+	require.NoError(t, err)
+	shortcutAnalyticsShares, err := ts.ListShortcutAnalyticsShares(ctx, &store.FindShortcutAnalyticsShare{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcutAnalyticsShares))
+}
+
+func TestShortcutAnalyticsShareDeletedWithShortcut(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "launch",
+		Link:       "https://example.com/launch",
+		Visibility: storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcutAnalyticsShare(ctx, &store.ShortcutAnalyticsShare{
+		ShortcutID: shortcut.Id,
+		CreatorID:  user.ID,
+		Token:      "secret",
+		ExpireTs:   time.Now().Add(24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	shortcutAnalyticsShares, err := ts.ListShortcutAnalyticsShares(ctx, &store.FindShortcutAnalyticsShare{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcutAnalyticsShares))
+}
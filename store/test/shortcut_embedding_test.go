@@ -0,0 +1,83 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestShortcutEmbeddingStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "billing",
+		Link:       "https://billing.example.com/dashboard",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	shortcutEmbedding, err := ts.UpsertShortcutEmbedding(ctx, &store.ShortcutEmbedding{
+		ShortcutID:  shortcut.Id,
+		Model:       "small",
+		ContentHash: "a",
+		Embedding:   []float32{0.5, -1, 2},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, shortcutEmbedding.UpdatedTs)
+
+	// A shortcut has an embedding, of the last model.
+	_, err = ts.UpsertShortcutEmbedding(ctx, &store.ShortcutEmbedding{
+		ShortcutID:  shortcut.Id,
+		Model:       "large",
+		ContentHash: "b",
+		Embedding:   []float32{0.25, 1},
+	})
+	require.NoError(t, err)
+	model := "small"
+	list, err := ts.ListShortcutEmbeddings(ctx, &store.FindShortcutEmbedding{
+		Model: &model,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list))
+	model = "large"
+	list, err = ts.ListShortcutEmbeddings(ctx, &store.FindShortcutEmbedding{
+		Model: &model,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, shortcut.Id, list[0].ShortcutID)
+	require.Equal(t, "b", list[0].ContentHash)
+	require.Equal(t, []float32{0.25, 1}, list[0].Embedding)
+
+	err = ts.DeleteShortcutEmbedding(ctx, &store.DeleteShortcutEmbedding{
+		ShortcutID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	list, err = ts.ListShortcutEmbeddings(ctx, &store.FindShortcutEmbedding{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list))
+
+	// The embedding is deleted with its shortcut.
+	_, err = ts.UpsertShortcutEmbedding(ctx, &store.ShortcutEmbedding{
+		ShortcutID:  shortcut.Id,
+		Model:       "large",
+		ContentHash: "c",
+		Embedding:   []float32{1, 1},
+	})
+	require.NoError(t, err)
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	list, err = ts.ListShortcutEmbeddings(ctx, &store.FindShortcutEmbedding{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list))
+}
@@ -0,0 +1,87 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestShortcutProposedChangeStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "handbook",
+		Link:       "https://example.com/handbook",
+		Visibility: storepb.Visibility_WORKSPACE,
+		Protected:  true,
+	})
+	require.NoError(t, err)
+	found, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.True(t, found.Protected)
+
+	payload := &storepb.ShortcutProposedChangePayload{
+		Paths: []string{"link"},
+		Previous: &storepb.ShortcutContent{
+			Link: "https://example.com/handbook",
+		},
+		Proposed: &storepb.ShortcutContent{
+			Link: "https://example.com/handbook/v2",
+		},
+	}
+	shortcutProposedChange, err := ts.CreateShortcutProposedChange(ctx, &store.ShortcutProposedChange{
+		ShortcutID: shortcut.Id,
+		ProposerID: user.ID,
+		Payload:    payload,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.ShortcutProposedChangePending, shortcutProposedChange.Status)
+	pending := store.ShortcutProposedChangePending
+	shortcutProposedChanges, err := ts.ListShortcutProposedChanges(ctx, &store.FindShortcutProposedChange{
+		ShortcutID: &shortcut.Id,
+		Status:     &pending,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcutProposedChanges))
+	require.True(t, proto.Equal(payload, shortcutProposedChanges[0].Payload))
+
+	reviewedTs := time.Now().Unix()
+	approved, err := ts.UpdateShortcutProposedChange(ctx, &store.UpdateShortcutProposedChange{
+		ID:         shortcutProposedChange.ID,
+		Status:     store.ShortcutProposedChangeApproved,
+		ReviewerID: user.ID,
+		ReviewedTs: reviewedTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.ShortcutProposedChangeApproved, approved.Status)
+	require.Equal(t, user.ID, approved.ReviewerID)
+	require.Equal(t, reviewedTs, approved.ReviewedTs)
+	// A reviewed change can't be reviewed again.
+	rejected, err := ts.UpdateShortcutProposedChange(ctx, &store.UpdateShortcutProposedChange{
+		ID:         shortcutProposedChange.ID,
+		Status:     store.ShortcutProposedChangeRejected,
+		ReviewerID: user.ID,
+		ReviewedTs: reviewedTs,
+	})
+	require.NoError(t, err)
+	require.Nil(t, rejected)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	shortcutProposedChanges, err = ts.ListShortcutProposedChanges(ctx, &store.FindShortcutProposedChange{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcutProposedChanges))
+}
@@ -0,0 +1,84 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestShortcutRotationStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "menu",
+		Link:       "https://example.com/menu",
+		Visibility: storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+
+	week1, err := ts.CreateShortcutRotation(ctx, &store.ShortcutRotation{
+		ShortcutID: shortcut.Id,
+		CreatorID:  user.ID,
+		Link:       "https://example.com/menu/week-1",
+		StartTs:    1000,
+		EndTs:      2000,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, week1.ID)
+	week2, err := ts.CreateShortcutRotation(ctx, &store.ShortcutRotation{
+		ShortcutID: shortcut.Id,
+		CreatorID:  user.ID,
+		Link:       "https://example.com/menu/week-2",
+		StartTs:    3000,
+	})
+	require.NoError(t, err)
+	shortcutRotations, err := ts.ListShortcutRotations(ctx, &store.FindShortcutRotation{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcutRotations))
+	// The latest rotation comes first.
+	require.Equal(t, week2.ID, shortcutRotations[0].ID)
+
+	for _, tc := range []struct {
+		activeTs int64
+		expected *store.ShortcutRotation
+	}{
+		{activeTs: 999, expected: nil},
+		{activeTs: 1000, expected: week1},
+		{activeTs: 2000, expected: nil},
+		{activeTs: 3000, expected: week2},
+		{activeTs: 9000, expected: week2},
+	} {
+		activeTs := tc.activeTs
+		shortcutRotation, err := ts.GetShortcutRotation(ctx, &store.FindShortcutRotation{
+			ShortcutID: &shortcut.Id,
+			ActiveTs:   &activeTs,
+		})
+		require.NoError(t, err)
+		if tc.expected == nil {
+			require.Nil(t, shortcutRotation)
+		} else {
+			require.Equal(t, tc.expected.Link, shortcutRotation.Link)
+		}
+	}
+
+	err = ts.DeleteShortcutRotation(ctx, &store.DeleteShortcutRotation{
+		ID: week1.ID,
+	})
+	require.NoError(t, err)
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	shortcutRotations, err = ts.ListShortcutRotations(ctx, &store.FindShortcutRotation{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcutRotations))
+}
@@ -53,3 +53,618 @@ func TestShortcutStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(shortcuts))
 }
+
+func TestShortcutClickGoal(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "campaign",
+		Link:       "https://campaign.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		ClickGoal: &storepb.ClickGoal{
+			Target:     1000,
+			WebhookUrl: "https://hooks.example.com/slash",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1000), shortcut.ClickGoal.Target)
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID: shortcut.Id,
+		ClickGoal: &storepb.ClickGoal{
+			Target:     1000,
+			WebhookUrl: "https://hooks.example.com/slash",
+			ReachedTs:  1700000000,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1700000000), updatedShortcut.ClickGoal.ReachedTs)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, "https://hooks.example.com/slash", shortcuts[0].ClickGoal.WebhookUrl)
+	require.Equal(t, int64(1700000000), shortcuts[0].ClickGoal.ReachedTs)
+}
+
+func TestShortcutLinkHealth(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), shortcut.LinkHealth.CheckedTs)
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID: shortcut.Id,
+		LinkHealth: &storepb.LinkHealth{
+			CheckedTs:    1700000000,
+			StatusCode:   404,
+			FailureCount: 2,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(404), updatedShortcut.LinkHealth.StatusCode)
+	require.Equal(t, shortcut.UpdatedTs, updatedShortcut.UpdatedTs)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, int64(1700000000), shortcuts[0].LinkHealth.CheckedTs)
+	require.Equal(t, int32(2), shortcuts[0].LinkHealth.FailureCount)
+}
+
+func TestShortcutRedirectCode(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:    user.ID,
+		Name:         "docs",
+		Link:         "https://docs.example.com",
+		Visibility:   storepb.Visibility_PUBLIC,
+		OgMetadata:   &storepb.OpenGraphMetadata{},
+		RedirectCode: 301,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(301), shortcut.RedirectCode)
+	redirectCode := int32(0)
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:           shortcut.Id,
+		RedirectCode: &redirectCode,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), updatedShortcut.RedirectCode)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, int32(0), shortcuts[0].RedirectCode)
+}
+
+func TestShortcutDocumentMode(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:    user.ID,
+		Name:         "handbook",
+		Link:         "https://docs.example.com/handbook.pdf",
+		Visibility:   storepb.Visibility_PUBLIC,
+		OgMetadata:   &storepb.OpenGraphMetadata{},
+		DocumentMode: storepb.DocumentMode_INLINE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.DocumentMode_INLINE, shortcut.DocumentMode)
+	documentMode := storepb.DocumentMode_DOWNLOAD
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:           shortcut.Id,
+		DocumentMode: &documentMode,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.DocumentMode_DOWNLOAD, updatedShortcut.DocumentMode)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, storepb.DocumentMode_DOWNLOAD, shortcuts[0].DocumentMode)
+}
+
+func TestShortcutInternalDescription(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:           user.ID,
+		Name:                "status",
+		Link:                "https://status.example.com",
+		Description:         "Ask the on-call in #ops before posting it.",
+		Visibility:          storepb.Visibility_PUBLIC,
+		OgMetadata:          &storepb.OpenGraphMetadata{},
+		InternalDescription: true,
+	})
+	require.NoError(t, err)
+	require.True(t, shortcut.InternalDescription)
+	internalDescription := false
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:                  shortcut.Id,
+		InternalDescription: &internalDescription,
+	})
+	require.NoError(t, err)
+	require.False(t, updatedShortcut.InternalDescription)
+	require.Equal(t, "Ask the on-call in #ops before posting it.", updatedShortcut.Description)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.False(t, shortcuts[0].InternalDescription)
+}
+
+func TestShortcutExpiration(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "expiring",
+		Link:       "https://expiring.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		ExpireTs:   1700000000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_NORMAL, shortcut.RowStatus)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "forever",
+		Link:       "https://forever.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	normalStatus, archivedStatus := storepb.RowStatus_NORMAL, storepb.RowStatus_ARCHIVED
+	expireTsBefore := int64(1700000000)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus:      &normalStatus,
+		ExpireTsBefore: &expireTsBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, shortcut.Id, shortcuts[0].Id)
+
+	archivedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		RowStatus: &archivedStatus,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, archivedShortcut.RowStatus)
+	require.Equal(t, int64(1700000000), archivedShortcut.ExpireTs)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus:      &normalStatus,
+		ExpireTsBefore: &expireTsBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+}
+
+func TestShortcutActivation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "launch",
+		Link:       "https://launch.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		ActivateTs: 1900000000,
+	})
+	require.NoError(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, int64(1900000000), shortcuts[0].ActivateTs)
+
+	activateTs := int64(0)
+	activatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:         shortcut.Id,
+		ActivateTs: &activateTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), activatedShortcut.ActivateTs)
+	require.Equal(t, "https://launch.link", activatedShortcut.Link)
+}
+
+func TestShortcutPagination(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://" + name + ".link",
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+
+	all, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+
+	names := []string{}
+	for offset := 0; offset < 5; offset += 2 {
+		limit, offset := 2, offset
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		require.NoError(t, err)
+		for _, shortcut := range shortcuts {
+			names = append(names, shortcut.Name)
+		}
+	}
+	expected := []string{}
+	for _, shortcut := range all {
+		expected = append(expected, shortcut.Name)
+	}
+	require.Equal(t, expected, names)
+
+	offset := 3
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		Offset: &offset,
+	})
+	require.NoError(t, err)
+	require.Len(t, shortcuts, 2)
+}
+
+func TestGetShortcutsByIDs(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	ids := []int32{}
+	for _, name := range []string{"a", "b", "c"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://" + name + ".link",
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		ids = append(ids, shortcut.Id)
+	}
+
+	// The ids are looked up in a single query, where the missing ones are skipped.
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		IDList: []int32{ids[0], ids[2], 404},
+	})
+	require.NoError(t, err)
+	require.Len(t, shortcuts, 2)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		IDList: []int32{},
+	})
+	require.NoError(t, err)
+	require.Len(t, shortcuts, 0)
+
+	shortcutMap, err := ts.GetShortcutsByIDs(ctx, []int32{ids[2], ids[0], ids[2], 404})
+	require.NoError(t, err)
+	require.Len(t, shortcutMap, 2)
+	require.Equal(t, "a", shortcutMap[ids[0]].Name)
+	require.Equal(t, "c", shortcutMap[ids[2]].Name)
+	require.Nil(t, shortcutMap[404])
+}
+
+func TestShortcutSearch(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	docs, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   user.ID,
+		Name:        "docs",
+		Link:        "https://docs.example.com",
+		Title:       "Engineering documentation",
+		Visibility:  storepb.Visibility_WORKSPACE,
+		Tags:        []string{"eng"},
+		OgMetadata:  &storepb.OpenGraphMetadata{},
+		Description: "Design docs and runbooks",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.example.com",
+		Title:      "Company wiki",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	searchNames := func(find *store.FindShortcut) []string {
+		shortcuts, err := ts.ListShortcuts(ctx, find)
+		require.NoError(t, err)
+		names := []string{}
+		for _, shortcut := range shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		return names
+	}
+	search := func(text string) *string {
+		return &text
+	}
+	require.Equal(t, []string{"docs"}, searchNames(&store.FindShortcut{Search: search("runbook")}))
+	require.Equal(t, []string{"docs"}, searchNames(&store.FindShortcut{Search: search("ENGINEER doc")}))
+	require.ElementsMatch(t, []string{"docs", "wiki"}, searchNames(&store.FindShortcut{Search: search("example")}))
+	require.Empty(t, searchNames(&store.FindShortcut{Search: search("runbook wiki")}))
+	require.Equal(t, []string{"wiki"}, searchNames(&store.FindShortcut{
+		Search:         search("example"),
+		VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC},
+	}))
+	// Search text without terms doesn't filter.
+	require.Len(t, searchNames(&store.FindShortcut{Search: search(`"*`)}), 2)
+
+	// The index follows the updates and deletions.
+	title := "Architecture"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:    docs.Id,
+		Title: &title,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"docs"}, searchNames(&store.FindShortcut{Search: search("architecture")}))
+	require.Empty(t, searchNames(&store.FindShortcut{Search: search("engineering")}))
+	require.NoError(t, ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: docs.Id}))
+	require.Empty(t, searchNames(&store.FindShortcut{Search: search("architecture")}))
+}
+
+func TestShortcutQueryParams(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "blog",
+		Link:       "https://blog.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{
+			Title: "Blog",
+			QueryParams: []*storepb.QueryParam{
+				{Key: "utm_source", Value: "slash"},
+				{Key: "utm_campaign", Value: "{collection}-{name}"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, "Blog", shortcuts[0].OgMetadata.Title)
+	require.Len(t, shortcuts[0].OgMetadata.QueryParams, 2)
+	require.Equal(t, "utm_campaign", shortcuts[0].OgMetadata.QueryParams[1].Key)
+	require.Equal(t, "{collection}-{name}", shortcuts[0].OgMetadata.QueryParams[1].Value)
+}
+
+func TestUpdateShortcutTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	ids := []int32{}
+	for _, name := range []string{"a", "b"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://" + name + ".link",
+			Visibility: storepb.Visibility_WORKSPACE,
+			Tags:       []string{"old"},
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		// Cache the shortcut, which must be refreshed after the update.
+		_, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+		require.NoError(t, err)
+		ids = append(ids, shortcut.Id)
+	}
+
+	require.NoError(t, ts.UpdateShortcutTags(ctx, &store.UpdateShortcutTags{
+		Tags: map[int32][]string{
+			ids[0]: {"new", "eng"},
+			ids[1]: {},
+		},
+	}))
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &ids[0]})
+	require.NoError(t, err)
+	require.Equal(t, []string{"new", "eng"}, shortcut.Tags)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &ids[1]})
+	require.NoError(t, err)
+	require.Empty(t, shortcut.Tags)
+}
+
+func TestShortcutTransfer(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	recipient, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "recipient@example.com",
+		Nickname: "recipient",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "handbook",
+		Link:       "https://handbook.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "onboarding",
+		Title:       "Onboarding",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+
+	shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		CreatorID: &recipient.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipient.ID, shortcut.CreatorId)
+	require.Equal(t, "https://handbook.link", shortcut.Link)
+	collection, err = ts.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:        collection.Id,
+		CreatorID: &recipient.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipient.ID, collection.CreatorId)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		CreatorID: &recipient.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{
+		CreatorID: &recipient.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+}
+
+func TestShortcutRedirectRules(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "store",
+		Link:       "https://store.example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		RedirectRules: &storepb.RedirectRules{
+			Rules: []*storepb.RedirectRule{
+				{
+					Countries: []string{"DE", "FR"},
+					Link:      "https://store.example.eu",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcut.RedirectRules.Rules))
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID: shortcut.Id,
+		RedirectRules: &storepb.RedirectRules{
+			Rules: []*storepb.RedirectRule{
+				{
+					Languages: []string{"pt"},
+					Devices:   []storepb.DeviceType{storepb.DeviceType_MOBILE},
+					Link:      "https://m.store.example.com.br",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"pt"}, updatedShortcut.RedirectRules.Rules[0].Languages)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, 1, len(shortcuts[0].RedirectRules.Rules))
+	require.Equal(t, []storepb.DeviceType{storepb.DeviceType_MOBILE}, shortcuts[0].RedirectRules.Rules[0].Devices)
+	require.Equal(t, "https://m.store.example.com.br", shortcuts[0].RedirectRules.Rules[0].Link)
+
+	// The shortcuts created without rules have none.
+	plainShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "plain",
+		Link:       "https://plain.example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &plainShortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts[0].RedirectRules.GetRules()))
+}
+
+func TestShortcutVariants(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "pricing",
+		Link:       "https://example.com/pricing",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Variants: &storepb.Variants{
+			Variants: []*storepb.Variant{
+				{Name: "A", Link: "https://example.com/pricing", Weight: 50},
+				{Name: "B", Link: "https://example.com/pricing-new", Weight: 50},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcut.Variants.Variants))
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID: shortcut.Id,
+		Variants: &storepb.Variants{
+			Variants: []*storepb.Variant{
+				{Name: "A", Link: "https://example.com/pricing", Weight: 90},
+				{Name: "B", Link: "https://example.com/pricing-new", Weight: 10},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(90), updatedShortcut.Variants.Variants[0].Weight)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, "B", shortcuts[0].Variants.Variants[1].Name)
+	require.Equal(t, int32(10), shortcuts[0].Variants.Variants[1].Weight)
+	updatedShortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:       shortcut.Id,
+		Variants: &storepb.Variants{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(updatedShortcut.Variants.GetVariants()))
+}
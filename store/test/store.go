@@ -36,6 +36,8 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS workspace_setting CASCADE;
 		DROP TABLE IF EXISTS "user" CASCADE;
 		DROP TABLE IF EXISTS user_setting CASCADE;
+		DROP TABLE IF EXISTS user_email CASCADE;
+		DROP TABLE IF EXISTS blob CASCADE;
 		DROP TABLE IF EXISTS shortcut CASCADE;
 		DROP TABLE IF EXISTS activity CASCADE;
 		DROP TABLE IF EXISTS collection CASCADE;`)
@@ -0,0 +1,189 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/warthurton/slash/proto/gen/store"
+	"github.com/warthurton/slash/store"
+)
+
+func TestTeamStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	team, err := ts.CreateTeam(ctx, &store.Team{
+		Name:        "engineering",
+		Description: "The engineers",
+	})
+	require.NoError(t, err)
+	name := "engineering"
+	found, err := ts.GetTeam(ctx, &store.FindTeam{
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, team, found)
+	description := "The builders"
+	team, err = ts.UpdateTeam(ctx, &store.UpdateTeam{
+		ID:          team.ID,
+		Description: &description,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "engineering", team.Name)
+	require.Equal(t, description, team.Description)
+
+	_, err = ts.CreateTeamMember(ctx, &store.TeamMember{
+		TeamID: team.ID,
+		UserID: user.ID,
+	})
+	require.NoError(t, err)
+	members, err := ts.ListTeamMembers(ctx, &store.FindTeamMember{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(members))
+	require.Equal(t, team.ID, members[0].TeamID)
+	err = ts.DeleteTeamMember(ctx, &store.DeleteTeamMember{
+		TeamID: team.ID,
+		UserID: user.ID,
+	})
+	require.NoError(t, err)
+	members, err = ts.ListTeamMembers(ctx, &store.FindTeamMember{
+		TeamID: &team.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(members))
+
+	// The memberships are deleted with the team.
+	_, err = ts.CreateTeamMember(ctx, &store.TeamMember{
+		TeamID: team.ID,
+		UserID: user.ID,
+	})
+	require.NoError(t, err)
+	err = ts.DeleteTeam(ctx, &store.DeleteTeam{
+		ID: team.ID,
+	})
+	require.NoError(t, err)
+	teams, err := ts.ListTeams(ctx, &store.FindTeam{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(teams))
+	members, err = ts.ListTeamMembers(ctx, &store.FindTeamMember{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(members))
+}
+
+func TestTeamVisibility(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "member@example.com",
+		Username: "member",
+		Nickname: "member",
+	})
+	require.NoError(t, err)
+	outsider, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "outsider@example.com",
+		Username: "outsider",
+		Nickname: "outsider",
+	})
+	require.NoError(t, err)
+	team, err := ts.CreateTeam(ctx, &store.Team{
+		Name: "engineering",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateTeamMember(ctx, &store.TeamMember{
+		TeamID: team.ID,
+		UserID: member.ID,
+	})
+	require.NoError(t, err)
+
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  admin.ID,
+		Name:       "oncall",
+		Link:       "https://example.com/oncall",
+		Visibility: storepb.Visibility_TEAM,
+		TeamId:     team.ID,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, team.ID, shortcut.TeamId)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  admin.ID,
+		Name:       "handbook",
+		Link:       "https://example.com/handbook",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   admin.ID,
+		Name:        "engineering",
+		Title:       "Engineering",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_TEAM,
+		TeamId:      team.ID,
+	})
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		viewerID  int32
+		shortcuts int
+	}{
+		{viewerID: member.ID, shortcuts: 2},
+		{viewerID: outsider.ID, shortcuts: 1},
+		// The creators see their team shortcuts, even when they aren't members.
+		{viewerID: admin.ID, shortcuts: 2},
+	} {
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+			ViewerID: &tt.viewerID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tt.shortcuts, len(shortcuts))
+		collections, err := ts.ListCollections(ctx, &store.FindCollection{
+			ViewerID: &tt.viewerID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tt.shortcuts-1, len(collections))
+	}
+
+	// The team is kept when the visibility changes.
+	visibility := storepb.Visibility_WORKSPACE
+	shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:         shortcut.Id,
+		Visibility: &visibility,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_WORKSPACE, shortcut.Visibility)
+	require.Equal(t, team.ID, shortcut.TeamId)
+	teamID := int32(0)
+	collection, err = ts.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:         collection.Id,
+		Visibility: &visibility,
+		TeamID:     &teamID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), collection.TeamId)
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{
+		ViewerID: &outsider.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+
+	// The memberships are deleted with the user.
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: member.ID,
+	})
+	require.NoError(t, err)
+	members, err := ts.ListTeamMembers(ctx, &store.FindTeamMember{
+		TeamID: &team.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(members))
+}
@@ -0,0 +1,114 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/warthurton/slash/store"
+)
+
+func TestUserEmailStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	userEmail, err := ts.CreateUserEmail(ctx, &store.UserEmail{
+		UserID: user.ID,
+		Email:  "secondary@test.com",
+	})
+	require.NoError(t, err)
+	require.False(t, userEmail.Verified)
+	userEmails, err := ts.ListUserEmails(ctx, &store.FindUserEmail{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(userEmails))
+	require.Equal(t, userEmail, userEmails[0])
+
+	// Unverified secondary emails are not matched when finding users.
+	foundUser, err := ts.GetUser(ctx, &store.FindUser{
+		Email: &userEmail.Email,
+	})
+	require.NoError(t, err)
+	require.Nil(t, foundUser)
+	verified := true
+	userEmail, err = ts.UpdateUserEmail(ctx, &store.UpdateUserEmail{
+		ID:       userEmail.ID,
+		Verified: &verified,
+	})
+	require.NoError(t, err)
+	require.True(t, userEmail.Verified)
+	foundUser, err = ts.GetUser(ctx, &store.FindUser{
+		Email: &userEmail.Email,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, foundUser)
+	require.Equal(t, user.ID, foundUser.ID)
+
+	err = ts.DeleteUserEmail(ctx, &store.DeleteUserEmail{
+		ID: userEmail.ID,
+	})
+	require.NoError(t, err)
+	userEmails, err = ts.ListUserEmails(ctx, &store.FindUserEmail{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(userEmails))
+}
+
+func TestSetUserPrimaryEmail(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	otherUser, err := ts.CreateUser(ctx, &store.User{
+		Role:  store.RoleUser,
+		Email: "other@test.com",
+	})
+	require.NoError(t, err)
+	unverifiedEmail, err := ts.CreateUserEmail(ctx, &store.UserEmail{
+		UserID: user.ID,
+		Email:  "unverified@test.com",
+	})
+	require.NoError(t, err)
+	verifiedEmail, err := ts.CreateUserEmail(ctx, &store.UserEmail{
+		UserID:   user.ID,
+		Email:    "secondary@test.com",
+		Verified: true,
+	})
+	require.NoError(t, err)
+
+	// The unverified emails and the emails of the other users can't be primary.
+	_, err = ts.SetUserPrimaryEmail(ctx, &store.SetUserPrimaryEmail{
+		UserID:      user.ID,
+		UserEmailID: unverifiedEmail.ID,
+	})
+	require.Error(t, err)
+	_, err = ts.SetUserPrimaryEmail(ctx, &store.SetUserPrimaryEmail{
+		UserID:      otherUser.ID,
+		UserEmailID: verifiedEmail.ID,
+	})
+	require.Error(t, err)
+	foundUser, err := ts.GetUser(ctx, &store.FindUser{
+		ID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test@test.com", foundUser.Email)
+
+	user, err = ts.SetUserPrimaryEmail(ctx, &store.SetUserPrimaryEmail{
+		UserID:      user.ID,
+		UserEmailID: verifiedEmail.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "secondary@test.com", user.Email)
+	userEmails, err := ts.ListUserEmails(ctx, &store.FindUserEmail{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(userEmails))
+	require.Equal(t, "unverified@test.com", userEmails[0].Email)
+	require.Equal(t, "test@test.com", userEmails[1].Email)
+	require.True(t, userEmails[1].Verified)
+}
@@ -0,0 +1,58 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/warthurton/slash/store"
+)
+
+func TestUserSessionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	userSession, err := ts.CreateUserSession(ctx, &store.UserSession{
+		UserID:    user.ID,
+		UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)",
+		IP:        "203.0.113.1",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, userSession.ID)
+	found, err := ts.GetUserSession(ctx, &store.FindUserSession{
+		ID: &userSession.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, userSession, found)
+
+	_, err = ts.CreateUserSession(ctx, &store.UserSession{
+		UserID: user.ID,
+	})
+	require.NoError(t, err)
+	userSessions, err := ts.ListUserSessions(ctx, &store.FindUserSession{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(userSessions))
+
+	err = ts.DeleteUserSession(ctx, &store.DeleteUserSession{
+		ID: userSession.ID,
+	})
+	require.NoError(t, err)
+	userSessions, err = ts.ListUserSessions(ctx, &store.FindUserSession{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(userSessions))
+
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: user.ID,
+	})
+	require.NoError(t, err)
+	userSessions, err = ts.ListUserSessions(ctx, &store.FindUserSession{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(userSessions))
+}
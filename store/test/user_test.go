@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 
+	storepb "github.com/warthurton/slash/proto/gen/store"
 	"github.com/warthurton/slash/store"
 )
 
@@ -36,6 +37,145 @@ func TestUserStore(t *testing.T) {
 	require.Equal(t, 0, len(users))
 }
 
+func TestUserUsername(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	require.Equal(t, "test", user.Username)
+	anotherUser, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "test@example.com",
+		Nickname: "another_nickname",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test-2", anotherUser.Username)
+	username := "test-2"
+	found, err := ts.GetUser(ctx, &store.FindUser{
+		Username: &username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, anotherUser.ID, found.ID)
+}
+
+func TestUserEmailVerified(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "unverified@example.com",
+		Nickname: "unverified",
+	})
+	require.NoError(t, err)
+	require.False(t, user.EmailVerified)
+	emailVerified := true
+	user, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:            user.ID,
+		EmailVerified: &emailVerified,
+	})
+	require.NoError(t, err)
+	require.True(t, user.EmailVerified)
+	users, err := ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(users))
+	require.True(t, users[0].EmailVerified)
+}
+
+func TestGetUsersByIDs(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@example.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+
+	users, err := ts.ListUsers(ctx, &store.FindUser{
+		IDList: []int32{admin.ID, user.ID, 404},
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+
+	userMap, err := ts.GetUsersByIDs(ctx, []int32{user.ID, admin.ID, 404})
+	require.NoError(t, err)
+	require.Len(t, userMap, 2)
+	require.Equal(t, "user@example.com", userMap[user.ID].Email)
+	require.Equal(t, admin.Email, userMap[admin.ID].Email)
+
+	// The deleted users are missing from the map.
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: user.ID,
+	})
+	require.NoError(t, err)
+	userMap, err = ts.GetUsersByIDs(ctx, []int32{user.ID, admin.ID})
+	require.NoError(t, err)
+	require.Len(t, userMap, 1)
+	require.Nil(t, userMap[user.ID])
+}
+
+func TestDeleteUserWithHandover(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "leaving@example.com",
+		Username: "leaving",
+		Nickname: "leaving",
+	})
+	require.NoError(t, err)
+	for _, name := range []string{"shared", "~leaving/personal"} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:  user.ID,
+		Name:       "team",
+		Title:      "Team",
+		Visibility: storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID:                     user.ID,
+		HandoverUserID:         &admin.ID,
+		PersonalShortcutPrefix: "~leaving/",
+	})
+	require.NoError(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, "shared", shortcuts[0].Name)
+	require.Equal(t, admin.ID, shortcuts[0].CreatorId)
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	require.Equal(t, collection.Id, collections[0].Id)
+	require.Equal(t, admin.ID, collections[0].CreatorId)
+
+	// Without a handover user, the data is deleted with the user.
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: admin.ID,
+	})
+	require.NoError(t, err)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collections))
+}
+
 // createTestingAdminUser creates a testing admin user.
 func createTestingAdminUser(ctx context.Context, ts *store.Store) (*store.User, error) {
 	userCreate := &store.User{
@@ -34,3 +34,244 @@ func TestWorkspaceSettingStore(t *testing.T) {
 	require.Equal(t, 1, len(workspaceSettings))
 	require.Equal(t, foundWorkspaceSetting, workspaceSettings[0])
 }
+
+func TestWorkspaceGitSyncSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	gitSyncSetting, err := ts.GetWorkspaceGitSyncSetting(ctx)
+	require.NoError(t, err)
+	require.False(t, gitSyncSetting.Enabled)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GIT_SYNC,
+		Value: &storepb.WorkspaceSetting_GitSync{
+			GitSync: &storepb.WorkspaceSetting_GitSyncSetting{
+				Enabled:          true,
+				Repository:       "acme/links",
+				Path:             "shortcuts.yaml",
+				LastSyncedSha:    "abc",
+				ManagedShortcuts: []string{"docs", "wiki"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	gitSyncSetting, err = ts.GetWorkspaceGitSyncSetting(ctx)
+	require.NoError(t, err)
+	require.True(t, gitSyncSetting.Enabled)
+	require.Equal(t, "acme/links", gitSyncSetting.Repository)
+	require.Equal(t, []string{"docs", "wiki"}, gitSyncSetting.ManagedShortcuts)
+}
+
+func TestWorkspaceNotFoundSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	notFoundSetting, err := ts.GetWorkspaceNotFoundSetting(ctx)
+	require.NoError(t, err)
+	require.Empty(t, notFoundSetting.RedirectUrl)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_NOT_FOUND,
+		Value: &storepb.WorkspaceSetting_NotFound{
+			NotFound: &storepb.WorkspaceSetting_NotFoundSetting{
+				RedirectUrl:        "https://search.example.com/?q={name}",
+				Message:            "Ask #help for a shortcut.",
+				DisableCreateOffer: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	list, err := ts.ListWorkspaceSettings(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_NOT_FOUND,
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "https://search.example.com/?q={name}", list[0].GetNotFound().RedirectUrl)
+	require.Equal(t, "Ask #help for a shortcut.", list[0].GetNotFound().Message)
+	require.True(t, list[0].GetNotFound().DisableCreateOffer)
+}
+
+func TestWorkspaceCollectionTemplateSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	collectionTemplateSetting, err := ts.GetWorkspaceCollectionTemplateSetting(ctx)
+	require.NoError(t, err)
+	require.Empty(t, collectionTemplateSetting.Templates)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COLLECTION_TEMPLATE,
+		Value: &storepb.WorkspaceSetting_CollectionTemplate{
+			CollectionTemplate: &storepb.WorkspaceSetting_CollectionTemplateSetting{
+				Templates: []*storepb.CollectionTemplate{
+					{
+						Id:    "launch",
+						Title: "Product launch",
+						Shortcuts: []*storepb.ShortcutTemplate{
+							{
+								Name: "{name}-brief",
+								Link: "https://docs.example.com/{name}/brief",
+								Tags: []string{"launch"},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	list, err := ts.ListWorkspaceSettings(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COLLECTION_TEMPLATE,
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	templates := list[0].GetCollectionTemplate().Templates
+	require.Len(t, templates, 1)
+	require.Equal(t, "launch", templates[0].Id)
+	require.Equal(t, "{name}-brief", templates[0].Shortcuts[0].Name)
+	require.Equal(t, []string{"launch"}, templates[0].Shortcuts[0].Tags)
+}
+
+func TestWorkspaceFederationSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	federationSetting, err := ts.GetWorkspaceFederationSetting(ctx)
+	require.NoError(t, err)
+	require.Empty(t, federationSetting.Sources)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FEDERATION,
+		Value: &storepb.WorkspaceSetting_Federation{
+			Federation: &storepb.WorkspaceSetting_FederationSetting{
+				Sources: []*storepb.WorkspaceSetting_FederationSource{
+					{
+						Id:               "eng",
+						Enabled:          true,
+						Url:              "https://slash.eng.example.com",
+						AccessToken:      "token",
+						Prefix:           "eng/",
+						ManagedShortcuts: []string{"eng/wiki"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	federationSetting, err = ts.GetWorkspaceFederationSetting(ctx)
+	require.NoError(t, err)
+	require.Len(t, federationSetting.Sources, 1)
+	require.Equal(t, "eng/", federationSetting.Sources[0].Prefix)
+	require.Equal(t, []string{"eng/wiki"}, federationSetting.Sources[0].ManagedShortcuts)
+}
+
+func TestWorkspaceLinkParamRules(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	shortcutRelatedSetting, err := ts.GetWorkspaceShortcutRelatedSetting(ctx)
+	require.NoError(t, err)
+	require.Nil(t, shortcutRelatedSetting.LinkParamRules)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				DefaultVisibility: storepb.Visibility_PUBLIC,
+				LinkParamRules: &storepb.WorkspaceSetting_LinkParamRules{
+					Deny:  []string{"utm_*", "fbclid"},
+					Allow: []string{"utm_id"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	shortcutRelatedSetting, err = ts.GetWorkspaceShortcutRelatedSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_PUBLIC, shortcutRelatedSetting.DefaultVisibility)
+	require.Equal(t, []string{"utm_*", "fbclid"}, shortcutRelatedSetting.LinkParamRules.Deny)
+	require.Equal(t, []string{"utm_id"}, shortcutRelatedSetting.LinkParamRules.Allow)
+}
+
+func TestWorkspaceMailSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	mailSetting, err := ts.GetWorkspaceMailSetting(ctx)
+	require.NoError(t, err)
+	require.Empty(t, mailSetting.SmtpHost)
+	require.False(t, mailSetting.RequireEmailVerification)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_MAIL,
+		Value: &storepb.WorkspaceSetting_Mail{
+			Mail: &storepb.WorkspaceSetting_MailSetting{
+				SmtpHost:                 "smtp.example.com",
+				SmtpPort:                 587,
+				SmtpEncryption:           storepb.WorkspaceSetting_MailSetting_STARTTLS,
+				From:                     "Slash <noreply@example.com>",
+				RequireEmailVerification: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	mailSetting, err = ts.GetWorkspaceMailSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "smtp.example.com", mailSetting.SmtpHost)
+	require.Equal(t, storepb.WorkspaceSetting_MailSetting_STARTTLS, mailSetting.SmtpEncryption)
+	require.True(t, mailSetting.RequireEmailVerification)
+}
+
+func TestWorkspaceLandingSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	// The missing setting is cached, and replaced once the setting is stored.
+	landingSetting, err := ts.GetWorkspaceLandingSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, storepb.WorkspaceSetting_LandingSetting_TYPE_UNSPECIFIED, landingSetting.Type)
+	landingSetting, err = ts.GetWorkspaceLandingSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, storepb.WorkspaceSetting_LandingSetting_TYPE_UNSPECIFIED, landingSetting.Type)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LANDING,
+		Value: &storepb.WorkspaceSetting_Landing{
+			Landing: &storepb.WorkspaceSetting_LandingSetting{
+				Type:                 storepb.WorkspaceSetting_LandingSetting_REDIRECT,
+				RedirectUrl:          "https://intranet.example.com",
+				ApplyToSignedInUsers: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	landingSetting, err = ts.GetWorkspaceLandingSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, storepb.WorkspaceSetting_LandingSetting_REDIRECT, landingSetting.Type)
+	require.Equal(t, "https://intranet.example.com", landingSetting.RedirectUrl)
+	list, err := ts.ListWorkspaceSettings(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LANDING,
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.True(t, list[0].GetLanding().ApplyToSignedInUsers)
+}
+
+func TestWorkspaceCompletionSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	completionSetting, err := ts.GetWorkspaceCompletionSetting(ctx)
+	require.NoError(t, err)
+	require.Empty(t, completionSetting.Endpoint)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COMPLETION,
+		Value: &storepb.WorkspaceSetting_Completion{
+			Completion: &storepb.WorkspaceSetting_CompletionSetting{
+				Endpoint: "https://api.openai.com/v1",
+				ApiKey:   "sk-test",
+				Model:    "gpt-4o-mini",
+			},
+		},
+	})
+	require.NoError(t, err)
+	completionSetting, err = ts.GetWorkspaceCompletionSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "https://api.openai.com/v1", completionSetting.Endpoint)
+	require.Equal(t, "sk-test", completionSetting.ApiKey)
+	require.Equal(t, "gpt-4o-mini", completionSetting.Model)
+}
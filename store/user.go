@@ -2,7 +2,10 @@ package store
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
+	"github.com/warthurton/slash/internal/util"
 	storepb "github.com/warthurton/slash/proto/gen/store"
 )
 
@@ -29,31 +32,57 @@ type User struct {
 	Nickname     string
 	PasswordHash string
 	Role         Role
+	Username     string
+	AvatarBlobID int32
+	// EmailVerified is false until the user confirms the email signed up with, when the workspace requires it.
+	EmailVerified bool
 }
 
 type UpdateUser struct {
 	ID int32
 
-	RowStatus    *storepb.RowStatus
-	Email        *string
-	Nickname     *string
-	PasswordHash *string
-	Role         *Role
+	RowStatus     *storepb.RowStatus
+	Email         *string
+	Nickname      *string
+	PasswordHash  *string
+	Role          *Role
+	Username      *string
+	AvatarBlobID  *int32
+	EmailVerified *bool
 }
 
 type FindUser struct {
 	ID        *int32
+	IDList    []int32
 	RowStatus *storepb.RowStatus
 	Email     *string
 	Nickname  *string
 	Role      *Role
+	Username  *string
+
+	// Limit and Offset paginate the list.
+	Limit  *int
+	Offset *int
 }
 
 type DeleteUser struct {
 	ID int32
+
+	// HandoverUserID is the user the shortcuts and collections are transferred to, instead of being deleted.
+	HandoverUserID *int32
+	// PersonalShortcutPrefix is the name prefix of the shortcuts in the personal namespace of the user,
+	// which are deleted even when handing over.
+	PersonalShortcutPrefix string
 }
 
 func (s *Store) CreateUser(ctx context.Context, create *User) (*User, error) {
+	if create.Username == "" {
+		username, err := s.GenerateUsername(ctx, create.Email)
+		if err != nil {
+			return nil, err
+		}
+		create.Username = username
+	}
 	user, err := s.driver.CreateUser(ctx, create)
 	if err != nil {
 		return nil, err
@@ -103,11 +132,62 @@ func (s *Store) GetUser(ctx context.Context, find *FindUser) (*User, error) {
 	return list[0], nil
 }
 
+// GetUsersByIDs returns the users with the ids by id, from the cache or with a single query for the others.
+// The missing users, e.g. deleted ones, aren't in the map.
+func (s *Store) GetUsersByIDs(ctx context.Context, ids []int32) (map[int32]*User, error) {
+	userMap := map[int32]*User{}
+	missingIDs := []int32{}
+	for _, id := range ids {
+		if _, ok := userMap[id]; ok || slices.Contains(missingIDs, id) {
+			continue
+		}
+		if cache, ok := s.userCache.Load(id); ok {
+			if user, ok := cache.(*User); ok {
+				userMap[id] = user
+				continue
+			}
+		}
+		missingIDs = append(missingIDs, id)
+	}
+	for _, batch := range batchIDs(missingIDs) {
+		users, err := s.ListUsers(ctx, &FindUser{
+			IDList: batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			userMap[user.ID] = user
+		}
+	}
+	return userMap, nil
+}
+
+// GenerateUsername generates an unused username from the email.
+func (s *Store) GenerateUsername(ctx context.Context, email string) (string, error) {
+	base := util.NormalizeUsername(email)
+	username := base
+	for i := 2; ; i++ {
+		user, err := s.GetUser(ctx, &FindUser{
+			Username: &username,
+		})
+		if err != nil {
+			return "", err
+		}
+		if user == nil {
+			return username, nil
+		}
+		username = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
 func (s *Store) DeleteUser(ctx context.Context, delete *DeleteUser) error {
 	if err := s.driver.DeleteUser(ctx, delete); err != nil {
 		return err
 	}
 
 	s.userCache.Delete(delete.ID)
+	// The shortcuts of the user are deleted or handed over with the user.
+	s.shortcutCache.Clear()
 	return nil
 }
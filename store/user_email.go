@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+)
+
+// UserEmail is a secondary email of the user.
+// The primary email is stored in the user itself.
+type UserEmail struct {
+	ID        int32
+	UserID    int32
+	CreatedTs int64
+	Email     string
+	Verified  bool
+}
+
+type UpdateUserEmail struct {
+	ID int32
+
+	Verified *bool
+}
+
+type FindUserEmail struct {
+	ID       *int32
+	UserID   *int32
+	Email    *string
+	Verified *bool
+}
+
+type DeleteUserEmail struct {
+	ID int32
+}
+
+// SetUserPrimaryEmail swaps the primary email of the user with the verified secondary email,
+// keeping the previous primary email as a verified secondary one.
+type SetUserPrimaryEmail struct {
+	UserID      int32
+	UserEmailID int32
+}
+
+func (s *Store) CreateUserEmail(ctx context.Context, create *UserEmail) (*UserEmail, error) {
+	return s.driver.CreateUserEmail(ctx, create)
+}
+
+func (s *Store) UpdateUserEmail(ctx context.Context, update *UpdateUserEmail) (*UserEmail, error) {
+	return s.driver.UpdateUserEmail(ctx, update)
+}
+
+func (s *Store) ListUserEmails(ctx context.Context, find *FindUserEmail) ([]*UserEmail, error) {
+	return s.driver.ListUserEmails(ctx, find)
+}
+
+func (s *Store) GetUserEmail(ctx context.Context, find *FindUserEmail) (*UserEmail, error) {
+	list, err := s.ListUserEmails(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) DeleteUserEmail(ctx context.Context, delete *DeleteUserEmail) error {
+	return s.driver.DeleteUserEmail(ctx, delete)
+}
+
+// SetUserPrimaryEmail swaps the primary email of the user with the secondary email in a transaction.
+func (s *Store) SetUserPrimaryEmail(ctx context.Context, set *SetUserPrimaryEmail) (*User, error) {
+	user, err := s.driver.SetUserPrimaryEmail(ctx, set)
+	if err != nil {
+		return nil, err
+	}
+	s.userCache.Store(user.ID, user)
+	return user, nil
+}
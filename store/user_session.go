@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+)
+
+// UserSession is a sign-in session of the user, with the device and the IP address it's signed in from.
+// The access tokens issued on the sign in carry the id of the session.
+type UserSession struct {
+	ID        int32
+	UserID    int32
+	CreatedTs int64
+	UserAgent string
+	IP        string
+}
+
+type FindUserSession struct {
+	ID     *int32
+	UserID *int32
+}
+
+type DeleteUserSession struct {
+	ID int32
+}
+
+func (s *Store) CreateUserSession(ctx context.Context, create *UserSession) (*UserSession, error) {
+	return s.driver.CreateUserSession(ctx, create)
+}
+
+func (s *Store) ListUserSessions(ctx context.Context, find *FindUserSession) ([]*UserSession, error) {
+	return s.driver.ListUserSessions(ctx, find)
+}
+
+func (s *Store) GetUserSession(ctx context.Context, find *FindUserSession) (*UserSession, error) {
+	list, err := s.ListUserSessions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+func (s *Store) DeleteUserSession(ctx context.Context, delete *DeleteUserSession) error {
+	return s.driver.DeleteUserSession(ctx, delete)
+}
@@ -1,7 +1,17 @@
 package store
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"slices"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/warthurton/slash/proto/gen/store"
 )
@@ -71,3 +81,198 @@ func (s *Store) GetUserAccessTokens(ctx context.Context, userID int32) ([]*store
 	accessTokensUserSetting := userSetting.GetAccessTokens()
 	return accessTokensUserSetting.AccessTokens, nil
 }
+
+// UpdateUserAccessTokens replaces the access tokens of the user with the ones returned by update, given a copy of the
+// current ones. The updates of the access tokens of a user are serialized, so that they don't overwrite each other,
+// e.g. a flush of the usages doesn't restore a revoked access token.
+func (s *Store) UpdateUserAccessTokens(ctx context.Context, userID int32, update func([]*storepb.UserSetting_AccessTokensSetting_AccessToken) []*storepb.UserSetting_AccessTokensSetting_AccessToken) error {
+	value, _ := s.accessTokenMutexes.LoadOrStore(userID, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	userAccessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+	// The cached access tokens are copied, as update may change them.
+	currentAccessTokens := make([]*storepb.UserSetting_AccessTokensSetting_AccessToken, 0, len(userAccessTokens))
+	for _, userAccessToken := range userAccessTokens {
+		currentAccessTokens = append(currentAccessTokens, proto.Clone(userAccessToken).(*storepb.UserSetting_AccessTokensSetting_AccessToken))
+	}
+	updatedAccessTokens := update(currentAccessTokens)
+	if slices.EqualFunc(updatedAccessTokens, userAccessTokens, func(a, b *storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
+		return proto.Equal(a, b)
+	}) {
+		return nil
+	}
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
+				AccessTokens: updatedAccessTokens,
+			},
+		},
+	})
+	return err
+}
+
+// HashAccessToken returns the salted SHA-256 hash of the access token in hex.
+func HashAccessToken(accessToken, salt string) string {
+	sum := sha256.Sum256([]byte(salt + accessToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// SetAccessTokenHash replaces the plaintext access token with its hash and a new random salt,
+// so a leaked store doesn't give out valid access tokens.
+func SetAccessTokenHash(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken, accessToken string) error {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return errors.Wrap(err, "failed to generate salt")
+	}
+	userAccessToken.Salt = hex.EncodeToString(salt)
+	userAccessToken.AccessTokenHash = HashAccessToken(accessToken, userAccessToken.Salt)
+	userAccessToken.AccessToken = ""
+	return nil
+}
+
+// MatchAccessToken returns true if the stored access token is the hash of the access token.
+func MatchAccessToken(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken, accessToken string) bool {
+	if userAccessToken.AccessTokenHash == "" {
+		return false
+	}
+	hash := HashAccessToken(accessToken, userAccessToken.Salt)
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(userAccessToken.AccessTokenHash)) == 1
+}
+
+// FindAccessToken returns the stored access token matching the access token, or nil.
+func FindAccessToken(userAccessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken, accessToken string) *storepb.UserSetting_AccessTokensSetting_AccessToken {
+	for _, userAccessToken := range userAccessTokens {
+		if MatchAccessToken(userAccessToken, accessToken) {
+			return userAccessToken
+		}
+	}
+	return nil
+}
+
+// GetUserPasskeys returns the passkeys of the user.
+func (s *Store) GetUserPasskeys(ctx context.Context, userID int32) ([]*storepb.UserSetting_PasskeysSetting_Passkey, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PASSKEYS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.UserSetting_PasskeysSetting_Passkey{}, nil
+	}
+	return userSetting.GetPasskeys().Passkeys, nil
+}
+
+// UpsertUserPasskey adds the passkey to the user, or replaces the existing passkey with the same id.
+func (s *Store) UpsertUserPasskey(ctx context.Context, userID int32, passkey *storepb.UserSetting_PasskeysSetting_Passkey) error {
+	passkeys, err := s.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return err
+	}
+	updatedPasskeys, replaced := []*storepb.UserSetting_PasskeysSetting_Passkey{}, false
+	for _, existingPasskey := range passkeys {
+		if bytes.Equal(existingPasskey.Id, passkey.Id) {
+			existingPasskey, replaced = passkey, true
+		}
+		updatedPasskeys = append(updatedPasskeys, existingPasskey)
+	}
+	if !replaced {
+		updatedPasskeys = append(updatedPasskeys, passkey)
+	}
+	return s.setUserPasskeys(ctx, userID, updatedPasskeys)
+}
+
+// DeleteUserPasskey deletes the passkey with the id from the user.
+func (s *Store) DeleteUserPasskey(ctx context.Context, userID int32, passkeyID []byte) error {
+	passkeys, err := s.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return err
+	}
+	updatedPasskeys := []*storepb.UserSetting_PasskeysSetting_Passkey{}
+	for _, passkey := range passkeys {
+		if !bytes.Equal(passkey.Id, passkeyID) {
+			updatedPasskeys = append(updatedPasskeys, passkey)
+		}
+	}
+	return s.setUserPasskeys(ctx, userID, updatedPasskeys)
+}
+
+func (s *Store) setUserPasskeys(ctx context.Context, userID int32, passkeys []*storepb.UserSetting_PasskeysSetting_Passkey) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PASSKEYS,
+		Value: &storepb.UserSetting_Passkeys{
+			Passkeys: &storepb.UserSetting_PasskeysSetting{
+				Passkeys: passkeys,
+			},
+		},
+	})
+	return err
+}
+
+type accessTokenUsageKey struct {
+	userID          int32
+	accessTokenHash string
+}
+
+// RecordAccessTokenUsage buffers the last used time of the access token with the hash.
+// The buffered usages are persisted in batch by FlushAccessTokenUsage.
+func (s *Store) RecordAccessTokenUsage(userID int32, accessTokenHash string, usedTs int64) {
+	s.accessTokenUsageBuffer.Store(accessTokenUsageKey{userID: userID, accessTokenHash: accessTokenHash}, usedTs)
+}
+
+// FlushAccessTokenUsage writes the buffered access token usages into the user settings.
+func (s *Store) FlushAccessTokenUsage(ctx context.Context) error {
+	usages := map[int32]map[string]int64{}
+	s.accessTokenUsageBuffer.Range(func(key, value any) bool {
+		if !s.accessTokenUsageBuffer.CompareAndDelete(key, value) {
+			return true
+		}
+		usageKey, usedTs := key.(accessTokenUsageKey), value.(int64)
+		if usages[usageKey.userID] == nil {
+			usages[usageKey.userID] = map[string]int64{}
+		}
+		usages[usageKey.userID][usageKey.accessTokenHash] = usedTs
+		return true
+	})
+
+	var flushErr error
+	for userID, tokenUsages := range usages {
+		// The usages are applied to the access tokens still there, as they may have been revoked since.
+		if err := s.UpdateUserAccessTokens(ctx, userID, func(accessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) []*storepb.UserSetting_AccessTokensSetting_AccessToken {
+			for _, accessToken := range accessTokens {
+				if usedTs, ok := tokenUsages[accessToken.AccessTokenHash]; ok && usedTs > accessToken.LastUsedTs {
+					accessToken.LastUsedTs = usedTs
+				}
+			}
+			return accessTokens
+		}); err != nil {
+			// Buffer the usages again, so the next flush retries them.
+			for accessTokenHash, usedTs := range tokenUsages {
+				s.restoreAccessTokenUsage(accessTokenUsageKey{userID: userID, accessTokenHash: accessTokenHash}, usedTs)
+			}
+			if flushErr == nil {
+				flushErr = errors.Wrapf(err, "failed to update access tokens of user %d", userID)
+			}
+		}
+	}
+	return flushErr
+}
+
+// restoreAccessTokenUsage buffers the usage again, unless a newer usage has been buffered since.
+func (s *Store) restoreAccessTokenUsage(key accessTokenUsageKey, usedTs int64) {
+	for {
+		value, loaded := s.accessTokenUsageBuffer.LoadOrStore(key, usedTs)
+		if !loaded || value.(int64) >= usedTs || s.accessTokenUsageBuffer.CompareAndSwap(key, value, usedTs) {
+			return
+		}
+	}
+}
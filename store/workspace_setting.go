@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	storepb "github.com/warthurton/slash/proto/gen/store"
@@ -32,12 +33,18 @@ func (s *Store) ListWorkspaceSettings(ctx context.Context, find *FindWorkspaceSe
 	return list, nil
 }
 
+// missingWorkspaceSetting is cached for the settings which aren't stored.
+type missingWorkspaceSetting struct{}
+
 func (s *Store) GetWorkspaceSetting(ctx context.Context, find *FindWorkspaceSetting) (*storepb.WorkspaceSetting, error) {
 	if find.Key != storepb.WorkspaceSettingKey_WORKSPACE_SETTING_KEY_UNSPECIFIED {
 		if cache, ok := s.workspaceSettingCache.Load(find.Key); ok {
 			if workspaceSetting, ok := cache.(*storepb.WorkspaceSetting); ok {
 				return workspaceSetting, nil
 			}
+			if _, ok := cache.(missingWorkspaceSetting); ok {
+				return nil, nil
+			}
 		}
 	}
 
@@ -46,6 +53,10 @@ func (s *Store) GetWorkspaceSetting(ctx context.Context, find *FindWorkspaceSett
 		return nil, err
 	}
 	if len(list) == 0 {
+		// The settings read on every request, e.g. the landing of the root path, are often never set.
+		if find.Key != storepb.WorkspaceSettingKey_WORKSPACE_SETTING_KEY_UNSPECIFIED {
+			s.workspaceSettingCache.Store(find.Key, missingWorkspaceSetting{})
+		}
 		return nil, nil
 	}
 
@@ -76,6 +87,27 @@ func (s *Store) GetWorkspaceGeneralSetting(ctx context.Context) (*storepb.Worksp
 	return generalSetting, nil
 }
 
+// GetOrCreateWorkspaceSecretSession returns the secret to sign the access tokens, generating it when missing.
+func (s *Store) GetOrCreateWorkspaceSecretSession(ctx context.Context) (string, error) {
+	generalSetting, err := s.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", err
+	}
+	if generalSetting.SecretSession != "" {
+		return generalSetting.SecretSession, nil
+	}
+	generalSetting.SecretSession = uuid.New().String()
+	if _, err := s.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL,
+		Value: &storepb.WorkspaceSetting_General{
+			General: generalSetting,
+		},
+	}); err != nil {
+		return "", err
+	}
+	return generalSetting.SecretSession, nil
+}
+
 func (s *Store) GetWorkspaceSecuritySetting(ctx context.Context) (*storepb.WorkspaceSetting_SecuritySetting, error) {
 	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
 		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
@@ -92,3 +124,115 @@ func (s *Store) GetWorkspaceSecuritySetting(ctx context.Context) (*storepb.Works
 	}
 	return securitySetting, nil
 }
+
+func (s *Store) GetWorkspaceShortcutRelatedSetting(ctx context.Context) (*storepb.WorkspaceSetting_ShortcutRelatedSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+	})
+	if err != nil {
+		return nil, err
+	}
+	shortcutRelatedSetting := &storepb.WorkspaceSetting_ShortcutRelatedSetting{}
+	if setting != nil && setting.GetShortcutRelated() != nil {
+		shortcutRelatedSetting = setting.GetShortcutRelated()
+	}
+	return shortcutRelatedSetting, nil
+}
+
+func (s *Store) GetWorkspaceGitSyncSetting(ctx context.Context) (*storepb.WorkspaceSetting_GitSyncSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GIT_SYNC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	gitSyncSetting := &storepb.WorkspaceSetting_GitSyncSetting{}
+	if setting != nil && setting.GetGitSync() != nil {
+		gitSyncSetting = setting.GetGitSync()
+	}
+	return gitSyncSetting, nil
+}
+
+func (s *Store) GetWorkspaceNotFoundSetting(ctx context.Context) (*storepb.WorkspaceSetting_NotFoundSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_NOT_FOUND,
+	})
+	if err != nil {
+		return nil, err
+	}
+	notFoundSetting := &storepb.WorkspaceSetting_NotFoundSetting{}
+	if setting != nil && setting.GetNotFound() != nil {
+		notFoundSetting = setting.GetNotFound()
+	}
+	return notFoundSetting, nil
+}
+
+func (s *Store) GetWorkspaceCollectionTemplateSetting(ctx context.Context) (*storepb.WorkspaceSetting_CollectionTemplateSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COLLECTION_TEMPLATE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	collectionTemplateSetting := &storepb.WorkspaceSetting_CollectionTemplateSetting{}
+	if setting != nil && setting.GetCollectionTemplate() != nil {
+		collectionTemplateSetting = setting.GetCollectionTemplate()
+	}
+	return collectionTemplateSetting, nil
+}
+
+func (s *Store) GetWorkspaceFederationSetting(ctx context.Context) (*storepb.WorkspaceSetting_FederationSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FEDERATION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	federationSetting := &storepb.WorkspaceSetting_FederationSetting{}
+	if setting != nil && setting.GetFederation() != nil {
+		federationSetting = setting.GetFederation()
+	}
+	return federationSetting, nil
+}
+
+func (s *Store) GetWorkspaceMailSetting(ctx context.Context) (*storepb.WorkspaceSetting_MailSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_MAIL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	mailSetting := &storepb.WorkspaceSetting_MailSetting{}
+	if setting != nil && setting.GetMail() != nil {
+		mailSetting = setting.GetMail()
+	}
+	return mailSetting, nil
+}
+
+func (s *Store) GetWorkspaceLandingSetting(ctx context.Context) (*storepb.WorkspaceSetting_LandingSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LANDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	landingSetting := &storepb.WorkspaceSetting_LandingSetting{}
+	if setting != nil && setting.GetLanding() != nil {
+		landingSetting = setting.GetLanding()
+	}
+	return landingSetting, nil
+}
+
+func (s *Store) GetWorkspaceCompletionSetting(ctx context.Context) (*storepb.WorkspaceSetting_CompletionSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_COMPLETION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	completionSetting := &storepb.WorkspaceSetting_CompletionSetting{}
+	if setting != nil && setting.GetCompletion() != nil {
+		completionSetting = setting.GetCompletion()
+	}
+	return completionSetting, nil
+}